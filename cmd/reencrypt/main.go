@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// main re-encrypts all answers to sensitive questions with the current answer key.
+// Run this after adding a new key to App.AnswerKeys, then remove the old key from the configuration.
+func main() {
+	// Start a new container.
+	c := services.NewContainer()
+	defer func() {
+		// Gracefully shutdown all services.
+		if err := c.Shutdown(); err != nil {
+			log.Default().Error("shutdown failed", "error", err)
+		}
+	}()
+
+	count, err := c.Encryption.ReencryptAnswers(context.Background(), c.ORM)
+	if err != nil {
+		fmt.Printf("[ERROR] %s\n", err)
+		fmt.Printf("%d answers were re-encrypted before the failure\n", count)
+		os.Exit(1)
+	}
+
+	fmt.Println("")
+	fmt.Println("-- ANSWERS RE-ENCRYPTED --")
+	fmt.Printf("Updated: %d\n", count)
+	fmt.Println("----")
+	fmt.Println("")
+}
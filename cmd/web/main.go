@@ -40,8 +40,15 @@ func main() {
 	// TODO: Tasks disabled for MySQL
 	// c.Tasks.Start(context.Background())
 
-	// Register all job handlers.
-	tasks.RegisterJobs(c)
+	// Register all job handlers and schedules.
+	if err := tasks.RegisterJobs(c); err != nil {
+		fatal("failed to register jobs", err)
+	}
+
+	// Start the job worker unless jobs are run by cmd/worker.
+	if c.Config.Tasks.InProcess {
+		c.Jobs.Start()
+	}
 
 	// Start the server.
 	go func() {
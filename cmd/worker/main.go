@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/occult/pagode/pkg/handlers"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tasks"
+	"github.com/subosito/gotenv"
+)
+
+func init() {
+	_ = gotenv.Load()
+}
+
+// main runs the job worker without the web server, so jobs can be processed by dedicated processes.
+// Set tasks.inProcess to false to stop the web processes from running jobs as well.
+func main() {
+	// Start a new container.
+	c := services.NewContainer()
+	var failed bool
+	defer func() {
+		// Gracefully shutdown all services, which waits for running jobs to finish.
+		fatal("shutdown failed", c.Shutdown())
+		if failed {
+			os.Exit(1)
+		}
+	}()
+
+	// Build the router without serving it, so jobs can link to pages by their route name.
+	if err := handlers.BuildRouter(c); err != nil {
+		fatal("failed to build the router", err)
+	}
+
+	// Register all job handlers and schedules.
+	if err := tasks.RegisterJobs(c); err != nil {
+		fatal("failed to register jobs", err)
+	}
+
+	c.Jobs.Start()
+
+	// Serve the health check. Errors are received below so the worker still shuts down gracefully.
+	var healthErr chan error
+	if port := c.Config.Tasks.HealthPort; port != 0 {
+		healthErr = make(chan error, 1)
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
+			health := c.Jobs.Health()
+
+			w.Header().Set("Content-Type", "application/json")
+			if !health.Healthy {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+			_ = json.NewEncoder(w).Encode(health)
+		})
+
+		srv := &http.Server{
+			Addr:         fmt.Sprintf("%s:%d", c.Config.HTTP.Hostname, port),
+			Handler:      mux,
+			ReadTimeout:  c.Config.HTTP.ReadTimeout,
+			WriteTimeout: c.Config.HTTP.WriteTimeout,
+		}
+		defer srv.Close()
+
+		go func() {
+			if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				healthErr <- err
+			}
+		}()
+	}
+
+	// Wait for a signal, or for the health check server to fail, to gracefully shut down the worker.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	select {
+	case sig := <-quit:
+		log.Default().Info("Shutting down the job worker", "signal", sig.String())
+	case err := <-healthErr:
+		log.Default().Error("Shutting down the job worker since the health check server failed", "error", err)
+		failed = true
+	}
+}
+
+// fatal logs an error and terminates the application, if the error is not nil.
+func fatal(msg string, err error) {
+	if err != nil {
+		log.Default().Error(msg, "error", err)
+		os.Exit(1)
+	}
+}
@@ -58,6 +58,7 @@ type (
 		Tasks    TasksConfig
 		Mail     MailConfig
 		Payment  PaymentConfig
+		Usage    UsageConfig
 		OpenAI   OpenAIConfig
 	}
 
@@ -82,6 +83,10 @@ type (
 		Host          string
 		Environment   environment
 		EncryptionKey string
+		// AnswerKeys are the secrets used to encrypt sensitive answers, newest first.
+		// Older keys are only used for decryption so they can be rotated out with the reencrypt command.
+		// If empty, a key is derived from EncryptionKey.
+		AnswerKeys    []string
 		Timeout       time.Duration
 		PasswordToken struct {
 			Expiration time.Duration
@@ -130,10 +135,26 @@ type (
 
 	// TasksConfig stores the tasks configuration.
 	TasksConfig struct {
+		// InProcess runs the job worker inside the web process. Disable it when jobs are run by cmd/worker.
+		InProcess bool
+		// HealthPort is the port cmd/worker serves its health check on, zero disables it.
+		HealthPort      uint16
 		Goroutines      int
+		PollInterval    time.Duration
+		Queues          map[string]int
 		ReleaseAfter    time.Duration
 		CleanupInterval time.Duration
 		ShutdownTimeout time.Duration
+		// Alerts are sent when the share of a queue's recently finished jobs which failed reaches
+		// FailureRate. Alerting is disabled when FailureRate is zero.
+		Alerts struct {
+			FailureRate float64
+			MinJobs     int
+			Window      time.Duration
+			Cooldown    time.Duration
+			Email       bool
+			Webhook     string
+		}
 	}
 
 	// MailConfig stores the mail configuration.
@@ -150,6 +171,78 @@ type (
 	PaymentConfig struct {
 		Provider string
 		Stripe   StripeConfig
+		Fake     FakePaymentConfig
+		// FreePlan is the ID of the plan users are on when they have not paid for another one.
+		FreePlan string
+		// Plans is the plan catalog, ordered from the lowest to the highest plan.
+		Plans    []PlanConfig
+		Products []ProductConfig
+		// LegacyProduct is the ID of the product which purchases made before products were recorded with
+		// their payments are treated as. Empty grants nothing for them.
+		LegacyProduct string
+		Dunning       DunningConfig
+		Trials        TrialsConfig
+	}
+
+	// TrialsConfig stores how users on a free trial are notified.
+	TrialsConfig struct {
+		// Reminder is how long before a trial ends its user is reminded that they will be charged. Zero
+		// disables the reminder.
+		Reminder time.Duration
+	}
+
+	// DunningConfig stores how subscriptions whose payments fail are chased.
+	DunningConfig struct {
+		// GracePeriod is how long a subscription keeps its plan after its first failed payment. It is canceled
+		// once the grace period ends, which moves the user to the free plan.
+		GracePeriod time.Duration
+		// Reminders are how long after the first failed payment reminders are emailed, in ascending order.
+		Reminders []time.Duration
+	}
+
+	// PlanConfig stores a plan of the catalog.
+	PlanConfig struct {
+		ID          string
+		Name        string
+		Description string
+		// Highlights are the selling points listed on the plans page.
+		Highlights []string
+		// Features are the features the plan unlocks, see services.Feature.
+		Features []string
+		Limits   PlanLimits
+		// Prices are the provider prices the plan can be subscribed to with. The free plan has none.
+		Prices []PriceConfig
+		// TrialDays is how long new subscribers try the plan before their first payment. Customers only get
+		// one trial.
+		TrialDays int
+	}
+
+	// PlanLimits stores the limits of a plan. Negative limits are unlimited.
+	PlanLimits struct {
+		Forms             int
+		ResponsesPerMonth int
+		StorageMB         int
+		CustomDomains     int
+	}
+
+	// PriceConfig stores a provider price of a plan.
+	PriceConfig struct {
+		ID string
+		// Amount is in the smallest unit of the currency, see currency.Decimals.
+		Amount   int64
+		Currency string
+		Interval string
+	}
+
+	// ProductConfig stores a product bought with a one-time payment.
+	ProductConfig struct {
+		ID          string
+		Name        string
+		Description string
+		Amount      int64
+		Currency    string
+		// Plan is the ID of the plan the product grants.
+		Plan string
 	}
 
 	// StripeConfig stores the Stripe-specific configuration.
@@ -157,7 +250,40 @@ type (
 		SecretKey      string
 		PublishableKey string
 		WebhookSecret  string
-		Currency       string
+		// ConnectWebhookSecret verifies events of connected accounts, which are sent to a separate endpoint.
+		ConnectWebhookSecret string
+		// Currency is the default currency of payment questions which don't set one.
+		Currency string
+	}
+
+	// FakePaymentConfig stores the configuration of the in-memory payment provider used for local development
+	// and tests.
+	FakePaymentConfig struct {
+		// Outcome is the outcome of charges made with payment methods other than the test cards, which is either
+		// succeeded, declined or requires_action.
+		Outcome       string
+		WebhookSecret string
+		// PromotionCodes are the promotion codes customers can redeem.
+		PromotionCodes []FakePromotionCodeConfig
+	}
+
+	// FakePromotionCodeConfig stores a promotion code of the fake payment provider. Codes take either a
+	// percentage or an amount off, for once, a number of months, or forever.
+	FakePromotionCodeConfig struct {
+		Code             string
+		PercentOff       float64
+		AmountOff        int64
+		Currency         string
+		Duration         string
+		DurationInMonths int
+	}
+
+	// UsageConfig stores the configuration of usage metering against plan limits.
+	UsageConfig struct {
+		// WarnAt is the share of a limit, from 0 to 1, at which users are warned that they are close to it.
+		WarnAt float64
+		// Overage lets usage continue past a plan's limits instead of blocking it. The usage page shows the overage.
+		Overage bool
 	}
 
 	// OpenAIConfig stores the OpenAI configuration.
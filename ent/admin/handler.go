@@ -12,15 +12,25 @@ import (
 
 	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/ent/answer"
+	"github.com/occult/pagode/ent/discount"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
 	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/processedevent"
 	"github.com/occult/pagode/ent/question"
+	"github.com/occult/pagode/ent/reportsubscription"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
 	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/usage"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -43,24 +53,44 @@ func (h *Handler) Create(ctx echo.Context, entityType string) error {
 	switch entityType {
 	case "Answer":
 		return h.AnswerCreate(ctx)
+	case "Discount":
+		return h.DiscountCreate(ctx)
 	case "Form":
 		return h.FormCreate(ctx)
+	case "Invoice":
+		return h.InvoiceCreate(ctx)
 	case "Job":
 		return h.JobCreate(ctx)
+	case "JobAttempt":
+		return h.JobAttemptCreate(ctx)
 	case "PasswordToken":
 		return h.PasswordTokenCreate(ctx)
+	case "PaymentAccount":
+		return h.PaymentAccountCreate(ctx)
 	case "PaymentCustomer":
 		return h.PaymentCustomerCreate(ctx)
 	case "PaymentIntent":
 		return h.PaymentIntentCreate(ctx)
 	case "PaymentMethod":
 		return h.PaymentMethodCreate(ctx)
+	case "ProcessedEvent":
+		return h.ProcessedEventCreate(ctx)
 	case "Question":
 		return h.QuestionCreate(ctx)
+	case "ReportSubscription":
+		return h.ReportSubscriptionCreate(ctx)
 	case "Response":
 		return h.ResponseCreate(ctx)
+	case "ResponseActivity":
+		return h.ResponseActivityCreate(ctx)
+	case "ResponseNote":
+		return h.ResponseNoteCreate(ctx)
+	case "ResponseTag":
+		return h.ResponseTagCreate(ctx)
 	case "Subscription":
 		return h.SubscriptionCreate(ctx)
+	case "Usage":
+		return h.UsageCreate(ctx)
 	case "User":
 		return h.UserCreate(ctx)
 	default:
@@ -72,24 +102,44 @@ func (h *Handler) Get(ctx echo.Context, entityType string, id int) (url.Values,
 	switch entityType {
 	case "Answer":
 		return h.AnswerGet(ctx, id)
+	case "Discount":
+		return h.DiscountGet(ctx, id)
 	case "Form":
 		return h.FormGet(ctx, id)
+	case "Invoice":
+		return h.InvoiceGet(ctx, id)
 	case "Job":
 		return h.JobGet(ctx, id)
+	case "JobAttempt":
+		return h.JobAttemptGet(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenGet(ctx, id)
+	case "PaymentAccount":
+		return h.PaymentAccountGet(ctx, id)
 	case "PaymentCustomer":
 		return h.PaymentCustomerGet(ctx, id)
 	case "PaymentIntent":
 		return h.PaymentIntentGet(ctx, id)
 	case "PaymentMethod":
 		return h.PaymentMethodGet(ctx, id)
+	case "ProcessedEvent":
+		return h.ProcessedEventGet(ctx, id)
 	case "Question":
 		return h.QuestionGet(ctx, id)
+	case "ReportSubscription":
+		return h.ReportSubscriptionGet(ctx, id)
 	case "Response":
 		return h.ResponseGet(ctx, id)
+	case "ResponseActivity":
+		return h.ResponseActivityGet(ctx, id)
+	case "ResponseNote":
+		return h.ResponseNoteGet(ctx, id)
+	case "ResponseTag":
+		return h.ResponseTagGet(ctx, id)
 	case "Subscription":
 		return h.SubscriptionGet(ctx, id)
+	case "Usage":
+		return h.UsageGet(ctx, id)
 	case "User":
 		return h.UserGet(ctx, id)
 	default:
@@ -101,24 +151,44 @@ func (h *Handler) Delete(ctx echo.Context, entityType string, id int) error {
 	switch entityType {
 	case "Answer":
 		return h.AnswerDelete(ctx, id)
+	case "Discount":
+		return h.DiscountDelete(ctx, id)
 	case "Form":
 		return h.FormDelete(ctx, id)
+	case "Invoice":
+		return h.InvoiceDelete(ctx, id)
 	case "Job":
 		return h.JobDelete(ctx, id)
+	case "JobAttempt":
+		return h.JobAttemptDelete(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenDelete(ctx, id)
+	case "PaymentAccount":
+		return h.PaymentAccountDelete(ctx, id)
 	case "PaymentCustomer":
 		return h.PaymentCustomerDelete(ctx, id)
 	case "PaymentIntent":
 		return h.PaymentIntentDelete(ctx, id)
 	case "PaymentMethod":
 		return h.PaymentMethodDelete(ctx, id)
+	case "ProcessedEvent":
+		return h.ProcessedEventDelete(ctx, id)
 	case "Question":
 		return h.QuestionDelete(ctx, id)
+	case "ReportSubscription":
+		return h.ReportSubscriptionDelete(ctx, id)
 	case "Response":
 		return h.ResponseDelete(ctx, id)
+	case "ResponseActivity":
+		return h.ResponseActivityDelete(ctx, id)
+	case "ResponseNote":
+		return h.ResponseNoteDelete(ctx, id)
+	case "ResponseTag":
+		return h.ResponseTagDelete(ctx, id)
 	case "Subscription":
 		return h.SubscriptionDelete(ctx, id)
+	case "Usage":
+		return h.UsageDelete(ctx, id)
 	case "User":
 		return h.UserDelete(ctx, id)
 	default:
@@ -130,24 +200,44 @@ func (h *Handler) Update(ctx echo.Context, entityType string, id int) error {
 	switch entityType {
 	case "Answer":
 		return h.AnswerUpdate(ctx, id)
+	case "Discount":
+		return h.DiscountUpdate(ctx, id)
 	case "Form":
 		return h.FormUpdate(ctx, id)
+	case "Invoice":
+		return h.InvoiceUpdate(ctx, id)
 	case "Job":
 		return h.JobUpdate(ctx, id)
+	case "JobAttempt":
+		return h.JobAttemptUpdate(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenUpdate(ctx, id)
+	case "PaymentAccount":
+		return h.PaymentAccountUpdate(ctx, id)
 	case "PaymentCustomer":
 		return h.PaymentCustomerUpdate(ctx, id)
 	case "PaymentIntent":
 		return h.PaymentIntentUpdate(ctx, id)
 	case "PaymentMethod":
 		return h.PaymentMethodUpdate(ctx, id)
+	case "ProcessedEvent":
+		return h.ProcessedEventUpdate(ctx, id)
 	case "Question":
 		return h.QuestionUpdate(ctx, id)
+	case "ReportSubscription":
+		return h.ReportSubscriptionUpdate(ctx, id)
 	case "Response":
 		return h.ResponseUpdate(ctx, id)
+	case "ResponseActivity":
+		return h.ResponseActivityUpdate(ctx, id)
+	case "ResponseNote":
+		return h.ResponseNoteUpdate(ctx, id)
+	case "ResponseTag":
+		return h.ResponseTagUpdate(ctx, id)
 	case "Subscription":
 		return h.SubscriptionUpdate(ctx, id)
+	case "Usage":
+		return h.UsageUpdate(ctx, id)
 	case "User":
 		return h.UserUpdate(ctx, id)
 	default:
@@ -159,24 +249,44 @@ func (h *Handler) List(ctx echo.Context, entityType string) (*EntityList, error)
 	switch entityType {
 	case "Answer":
 		return h.AnswerList(ctx)
+	case "Discount":
+		return h.DiscountList(ctx)
 	case "Form":
 		return h.FormList(ctx)
+	case "Invoice":
+		return h.InvoiceList(ctx)
 	case "Job":
 		return h.JobList(ctx)
+	case "JobAttempt":
+		return h.JobAttemptList(ctx)
 	case "PasswordToken":
 		return h.PasswordTokenList(ctx)
+	case "PaymentAccount":
+		return h.PaymentAccountList(ctx)
 	case "PaymentCustomer":
 		return h.PaymentCustomerList(ctx)
 	case "PaymentIntent":
 		return h.PaymentIntentList(ctx)
 	case "PaymentMethod":
 		return h.PaymentMethodList(ctx)
+	case "ProcessedEvent":
+		return h.ProcessedEventList(ctx)
 	case "Question":
 		return h.QuestionList(ctx)
+	case "ReportSubscription":
+		return h.ReportSubscriptionList(ctx)
 	case "Response":
 		return h.ResponseList(ctx)
+	case "ResponseActivity":
+		return h.ResponseActivityList(ctx)
+	case "ResponseNote":
+		return h.ResponseNoteList(ctx)
+	case "ResponseTag":
+		return h.ResponseTagList(ctx)
 	case "Subscription":
 		return h.SubscriptionList(ctx)
+	case "Usage":
+		return h.UsageList(ctx)
 	case "User":
 		return h.UserList(ctx)
 	default:
@@ -268,6 +378,201 @@ func (h *Handler) AnswerGet(ctx echo.Context, id int) (url.Values, error) {
 	return v, err
 }
 
+func (h *Handler) DiscountCreate(ctx echo.Context) error {
+	var payload Discount
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Discount.Create()
+	op.SetProviderDiscountID(payload.ProviderDiscountID)
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	op.SetProviderCouponID(payload.ProviderCouponID)
+	if payload.ProviderPromotionCodeID != nil {
+		op.SetProviderPromotionCodeID(*payload.ProviderPromotionCodeID)
+	}
+	if payload.Code != nil {
+		op.SetCode(*payload.Code)
+	}
+	if payload.PercentOff != nil {
+		op.SetPercentOff(*payload.PercentOff)
+	}
+	if payload.AmountOff != nil {
+		op.SetAmountOff(*payload.AmountOff)
+	}
+	if payload.Currency != nil {
+		op.SetCurrency(*payload.Currency)
+	}
+	op.SetDuration(payload.Duration)
+	if payload.DurationInMonths != nil {
+		op.SetDurationInMonths(*payload.DurationInMonths)
+	}
+	if payload.StartsAt != nil {
+		op.SetStartsAt(*payload.StartsAt)
+	}
+	if payload.EndsAt != nil {
+		op.SetEndsAt(*payload.EndsAt)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) DiscountUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Discount.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Discount
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetProviderDiscountID(payload.ProviderDiscountID)
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
+	} else {
+		op.SetProvider(*payload.Provider)
+	}
+	op.SetProviderCouponID(payload.ProviderCouponID)
+	if payload.ProviderPromotionCodeID == nil {
+		op.ClearProviderPromotionCodeID()
+	} else {
+		op.SetProviderPromotionCodeID(*payload.ProviderPromotionCodeID)
+	}
+	if payload.Code == nil {
+		op.ClearCode()
+	} else {
+		op.SetCode(*payload.Code)
+	}
+	if payload.PercentOff == nil {
+		op.ClearPercentOff()
+	} else {
+		op.SetPercentOff(*payload.PercentOff)
+	}
+	if payload.AmountOff == nil {
+		op.ClearAmountOff()
+	} else {
+		op.SetAmountOff(*payload.AmountOff)
+	}
+	if payload.Currency == nil {
+		op.ClearCurrency()
+	} else {
+		op.SetCurrency(*payload.Currency)
+	}
+	op.SetDuration(payload.Duration)
+	if payload.DurationInMonths == nil {
+		op.ClearDurationInMonths()
+	} else {
+		op.SetDurationInMonths(*payload.DurationInMonths)
+	}
+	if payload.StartsAt == nil {
+		var empty time.Time
+		op.SetStartsAt(empty)
+	} else {
+		op.SetStartsAt(*payload.StartsAt)
+	}
+	if payload.EndsAt == nil {
+		op.ClearEndsAt()
+	} else {
+		op.SetEndsAt(*payload.EndsAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) DiscountDelete(ctx echo.Context, id int) error {
+	return h.client.Discount.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) DiscountList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Discount.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(discount.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider discount ID",
+			"Provider",
+			"Provider coupon ID",
+			"Provider promotion code ID",
+			"Code",
+			"Percent off",
+			"Amount off",
+			"Currency",
+			"Duration",
+			"Duration in months",
+			"Starts at",
+			"Ends at",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].ProviderDiscountID,
+				res[i].Provider,
+				res[i].ProviderCouponID,
+				res[i].ProviderPromotionCodeID,
+				res[i].Code,
+				fmt.Sprint(res[i].PercentOff),
+				fmt.Sprint(res[i].AmountOff),
+				res[i].Currency,
+				fmt.Sprint(res[i].Duration),
+				fmt.Sprint(res[i].DurationInMonths),
+				res[i].StartsAt.Format(h.Config.TimeFormat),
+				res[i].EndsAt.Format(h.Config.TimeFormat),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) DiscountGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Discount.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("provider_discount_id", entity.ProviderDiscountID)
+	v.Set("provider", entity.Provider)
+	v.Set("provider_coupon_id", entity.ProviderCouponID)
+	v.Set("provider_promotion_code_id", entity.ProviderPromotionCodeID)
+	v.Set("code", entity.Code)
+	v.Set("percent_off", fmt.Sprint(entity.PercentOff))
+	v.Set("amount_off", fmt.Sprint(entity.AmountOff))
+	v.Set("currency", entity.Currency)
+	v.Set("duration", fmt.Sprint(entity.Duration))
+	v.Set("duration_in_months", fmt.Sprint(entity.DurationInMonths))
+	v.Set("starts_at", entity.StartsAt.Format(dateTimeFormat))
+	v.Set("ends_at", entity.EndsAt.Format(dateTimeFormat))
+	return v, err
+}
+
 func (h *Handler) FormCreate(ctx echo.Context) error {
 	var payload Form
 	if err := h.bind(ctx, &payload); err != nil {
@@ -284,6 +589,13 @@ func (h *Handler) FormCreate(ctx echo.Context) error {
 	if payload.DisplayMode != nil {
 		op.SetDisplayMode(*payload.DisplayMode)
 	}
+	if payload.MetadataStorage != nil {
+		op.SetMetadataStorage(*payload.MetadataStorage)
+	}
+	if payload.RetentionDays != nil {
+		op.SetRetentionDays(*payload.RetentionDays)
+	}
+	op.SetNotifyOnResponse(payload.NotifyOnResponse)
 	op.SetUserID(payload.UserID)
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
@@ -321,6 +633,19 @@ func (h *Handler) FormUpdate(ctx echo.Context, id int) error {
 	} else {
 		op.SetDisplayMode(*payload.DisplayMode)
 	}
+	if payload.MetadataStorage == nil {
+		var empty form.MetadataStorage
+		op.SetMetadataStorage(empty)
+	} else {
+		op.SetMetadataStorage(*payload.MetadataStorage)
+	}
+	if payload.RetentionDays == nil {
+		var empty int
+		op.SetRetentionDays(empty)
+	} else {
+		op.SetRetentionDays(*payload.RetentionDays)
+	}
+	op.SetNotifyOnResponse(payload.NotifyOnResponse)
 	op.SetUserID(payload.UserID)
 	if payload.UpdatedAt == nil {
 		var empty time.Time
@@ -357,6 +682,9 @@ func (h *Handler) FormList(ctx echo.Context) (*EntityList, error) {
 			"Published",
 			"Slug",
 			"Display mode",
+			"Metadata storage",
+			"Retention days",
+			"Notify on response",
 			"User ID",
 			"Created at",
 			"Updated at",
@@ -375,6 +703,9 @@ func (h *Handler) FormList(ctx echo.Context) (*EntityList, error) {
 				fmt.Sprint(res[i].Published),
 				res[i].Slug,
 				fmt.Sprint(res[i].DisplayMode),
+				fmt.Sprint(res[i].MetadataStorage),
+				fmt.Sprint(res[i].RetentionDays),
+				fmt.Sprint(res[i].NotifyOnResponse),
 				fmt.Sprint(res[i].UserID),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 				res[i].UpdatedAt.Format(h.Config.TimeFormat),
@@ -397,100 +728,204 @@ func (h *Handler) FormGet(ctx echo.Context, id int) (url.Values, error) {
 	v.Set("published", fmt.Sprint(entity.Published))
 	v.Set("slug", entity.Slug)
 	v.Set("display_mode", fmt.Sprint(entity.DisplayMode))
+	v.Set("metadata_storage", fmt.Sprint(entity.MetadataStorage))
+	v.Set("retention_days", fmt.Sprint(entity.RetentionDays))
+	v.Set("notify_on_response", fmt.Sprint(entity.NotifyOnResponse))
 	v.Set("user_id", fmt.Sprint(entity.UserID))
 	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
 	return v, err
 }
 
-func (h *Handler) JobCreate(ctx echo.Context) error {
-	var payload Job
+func (h *Handler) InvoiceCreate(ctx echo.Context) error {
+	var payload Invoice
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.Job.Create()
-	op.SetQueue(payload.Queue)
-	op.SetPayload(payload.Payload)
-	if payload.Attempts != nil {
-		op.SetAttempts(*payload.Attempts)
+	op := h.client.Invoice.Create()
+	op.SetProviderInvoiceID(payload.ProviderInvoiceID)
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
 	}
-	if payload.MaxAttempts != nil {
-		op.SetMaxAttempts(*payload.MaxAttempts)
+	if payload.ProviderSubscriptionID != nil {
+		op.SetProviderSubscriptionID(*payload.ProviderSubscriptionID)
+	}
+	if payload.Number != nil {
+		op.SetNumber(*payload.Number)
 	}
 	if payload.Status != nil {
 		op.SetStatus(*payload.Status)
 	}
-	if payload.Error != nil {
-		op.SetError(*payload.Error)
+	if payload.Currency != nil {
+		op.SetCurrency(*payload.Currency)
+	}
+	if payload.Subtotal != nil {
+		op.SetSubtotal(*payload.Subtotal)
+	}
+	if payload.Tax != nil {
+		op.SetTax(*payload.Tax)
+	}
+	if payload.Total != nil {
+		op.SetTotal(*payload.Total)
+	}
+	if payload.AmountDue != nil {
+		op.SetAmountDue(*payload.AmountDue)
+	}
+	if payload.AmountPaid != nil {
+		op.SetAmountPaid(*payload.AmountPaid)
+	}
+	if payload.HostedLink != nil {
+		op.SetHostedLink(*payload.HostedLink)
+	}
+	if payload.PdfLink != nil {
+		op.SetPdfLink(*payload.PdfLink)
+	}
+	if payload.PeriodStart != nil {
+		op.SetPeriodStart(*payload.PeriodStart)
+	}
+	if payload.PeriodEnd != nil {
+		op.SetPeriodEnd(*payload.PeriodEnd)
+	}
+	if payload.PaidAt != nil {
+		op.SetPaidAt(*payload.PaidAt)
+	}
+	if payload.IssuedAt != nil {
+		op.SetIssuedAt(*payload.IssuedAt)
 	}
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.ProcessedAt != nil {
-		op.SetProcessedAt(*payload.ProcessedAt)
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
 	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) JobUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.Job.Get(ctx.Request().Context(), id)
+func (h *Handler) InvoiceUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Invoice.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload Job
+	var payload Invoice
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetQueue(payload.Queue)
-	op.SetPayload(payload.Payload)
-	if payload.Attempts == nil {
-		var empty int
-		op.SetAttempts(empty)
+	op.SetProviderInvoiceID(payload.ProviderInvoiceID)
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
 	} else {
-		op.SetAttempts(*payload.Attempts)
+		op.SetProvider(*payload.Provider)
 	}
-	if payload.MaxAttempts == nil {
-		var empty int
-		op.SetMaxAttempts(empty)
+	if payload.ProviderSubscriptionID == nil {
+		op.ClearProviderSubscriptionID()
 	} else {
-		op.SetMaxAttempts(*payload.MaxAttempts)
+		op.SetProviderSubscriptionID(*payload.ProviderSubscriptionID)
+	}
+	if payload.Number == nil {
+		op.ClearNumber()
+	} else {
+		op.SetNumber(*payload.Number)
 	}
 	if payload.Status == nil {
-		var empty job.Status
+		var empty invoice.Status
 		op.SetStatus(empty)
 	} else {
 		op.SetStatus(*payload.Status)
 	}
-	if payload.Error == nil {
-		op.ClearError()
+	if payload.Currency == nil {
+		var empty string
+		op.SetCurrency(empty)
 	} else {
-		op.SetError(*payload.Error)
+		op.SetCurrency(*payload.Currency)
 	}
-	if payload.ProcessedAt == nil {
-		op.ClearProcessedAt()
+	if payload.Subtotal == nil {
+		var empty int64
+		op.SetSubtotal(empty)
 	} else {
-		op.SetProcessedAt(*payload.ProcessedAt)
+		op.SetSubtotal(*payload.Subtotal)
+	}
+	if payload.Tax == nil {
+		var empty int64
+		op.SetTax(empty)
+	} else {
+		op.SetTax(*payload.Tax)
+	}
+	if payload.Total == nil {
+		var empty int64
+		op.SetTotal(empty)
+	} else {
+		op.SetTotal(*payload.Total)
+	}
+	if payload.AmountDue == nil {
+		var empty int64
+		op.SetAmountDue(empty)
+	} else {
+		op.SetAmountDue(*payload.AmountDue)
+	}
+	if payload.AmountPaid == nil {
+		var empty int64
+		op.SetAmountPaid(empty)
+	} else {
+		op.SetAmountPaid(*payload.AmountPaid)
+	}
+	if payload.HostedLink == nil {
+		op.ClearHostedLink()
+	} else {
+		op.SetHostedLink(*payload.HostedLink)
+	}
+	if payload.PdfLink == nil {
+		op.ClearPdfLink()
+	} else {
+		op.SetPdfLink(*payload.PdfLink)
+	}
+	if payload.PeriodStart == nil {
+		op.ClearPeriodStart()
+	} else {
+		op.SetPeriodStart(*payload.PeriodStart)
+	}
+	if payload.PeriodEnd == nil {
+		op.ClearPeriodEnd()
+	} else {
+		op.SetPeriodEnd(*payload.PeriodEnd)
+	}
+	if payload.PaidAt == nil {
+		op.ClearPaidAt()
+	} else {
+		op.SetPaidAt(*payload.PaidAt)
+	}
+	if payload.IssuedAt == nil {
+		var empty time.Time
+		op.SetIssuedAt(empty)
+	} else {
+		op.SetIssuedAt(*payload.IssuedAt)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
 	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) JobDelete(ctx echo.Context, id int) error {
-	return h.client.Job.DeleteOneID(id).
+func (h *Handler) InvoiceDelete(ctx echo.Context, id int) error {
+	return h.client.Invoice.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) JobList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) InvoiceList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.Job.
+	res, err := h.client.Invoice.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(job.ByID(sql.OrderDesc())).
+		Order(invoice.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -499,14 +934,25 @@ func (h *Handler) JobList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Queue",
-			"Payload",
-			"Attempts",
-			"Max attempts",
+			"Provider invoice ID",
+			"Provider",
+			"Provider subscription ID",
+			"Number",
 			"Status",
-			"Error",
+			"Currency",
+			"Subtotal",
+			"Tax",
+			"Total",
+			"Amount due",
+			"Amount paid",
+			"Hosted link",
+			"Pdf link",
+			"Period start",
+			"Period end",
+			"Paid at",
+			"Issued at",
 			"Created at",
-			"Processed at",
+			"Updated at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -517,14 +963,25 @@ func (h *Handler) JobList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].Queue,
-				fmt.Sprint(res[i].Payload),
-				fmt.Sprint(res[i].Attempts),
-				fmt.Sprint(res[i].MaxAttempts),
+				res[i].ProviderInvoiceID,
+				res[i].Provider,
+				res[i].ProviderSubscriptionID,
+				res[i].Number,
 				fmt.Sprint(res[i].Status),
-				res[i].Error,
+				res[i].Currency,
+				fmt.Sprint(res[i].Subtotal),
+				fmt.Sprint(res[i].Tax),
+				fmt.Sprint(res[i].Total),
+				fmt.Sprint(res[i].AmountDue),
+				fmt.Sprint(res[i].AmountPaid),
+				res[i].HostedLink,
+				res[i].PdfLink,
+				res[i].PeriodStart.Format(h.Config.TimeFormat),
+				res[i].PeriodEnd.Format(h.Config.TimeFormat),
+				res[i].PaidAt.Format(h.Config.TimeFormat),
+				res[i].IssuedAt.Format(h.Config.TimeFormat),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
-				res[i].ProcessedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -532,79 +989,167 @@ func (h *Handler) JobList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) JobGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.Job.Get(ctx.Request().Context(), id)
+func (h *Handler) InvoiceGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Invoice.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("queue", entity.Queue)
-	v.Set("payload", fmt.Sprint(entity.Payload))
-	v.Set("attempts", fmt.Sprint(entity.Attempts))
-	v.Set("max_attempts", fmt.Sprint(entity.MaxAttempts))
+	v.Set("provider_invoice_id", entity.ProviderInvoiceID)
+	v.Set("provider", entity.Provider)
+	v.Set("provider_subscription_id", entity.ProviderSubscriptionID)
+	v.Set("number", entity.Number)
 	v.Set("status", fmt.Sprint(entity.Status))
-	v.Set("error", entity.Error)
-	v.Set("processed_at", entity.ProcessedAt.Format(dateTimeFormat))
+	v.Set("currency", entity.Currency)
+	v.Set("subtotal", fmt.Sprint(entity.Subtotal))
+	v.Set("tax", fmt.Sprint(entity.Tax))
+	v.Set("total", fmt.Sprint(entity.Total))
+	v.Set("amount_due", fmt.Sprint(entity.AmountDue))
+	v.Set("amount_paid", fmt.Sprint(entity.AmountPaid))
+	v.Set("hosted_link", entity.HostedLink)
+	v.Set("pdf_link", entity.PdfLink)
+	v.Set("period_start", entity.PeriodStart.Format(dateTimeFormat))
+	v.Set("period_end", entity.PeriodEnd.Format(dateTimeFormat))
+	v.Set("paid_at", entity.PaidAt.Format(dateTimeFormat))
+	v.Set("issued_at", entity.IssuedAt.Format(dateTimeFormat))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
 	return v, err
 }
 
-func (h *Handler) PasswordTokenCreate(ctx echo.Context) error {
-	var payload PasswordToken
+func (h *Handler) JobCreate(ctx echo.Context) error {
+	var payload Job
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PasswordToken.Create()
-	if payload.Token != nil {
-		op.SetToken(*payload.Token)
+	op := h.client.Job.Create()
+	op.SetQueue(payload.Queue)
+	op.SetPayload(payload.Payload)
+	if payload.Attempts != nil {
+		op.SetAttempts(*payload.Attempts)
 	}
-	op.SetUserID(payload.UserID)
-	if payload.CreatedAt != nil {
+	if payload.MaxAttempts != nil {
+		op.SetMaxAttempts(*payload.MaxAttempts)
+	}
+	if payload.Status != nil {
+		op.SetStatus(*payload.Status)
+	}
+	if payload.Priority != nil {
+		op.SetPriority(*payload.Priority)
+	}
+	if payload.RunAt != nil {
+		op.SetRunAt(*payload.RunAt)
+	}
+	if payload.ScheduledFor != nil {
+		op.SetScheduledFor(*payload.ScheduledFor)
+	}
+	if payload.UniqueKey != nil {
+		op.SetUniqueKey(*payload.UniqueKey)
+	}
+	if payload.UniqueLock != nil {
+		op.SetUniqueLock(*payload.UniqueLock)
+	}
+	if payload.Error != nil {
+		op.SetError(*payload.Error)
+	}
+	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
+	if payload.ProcessedAt != nil {
+		op.SetProcessedAt(*payload.ProcessedAt)
+	}
+	if payload.LockedBy != nil {
+		op.SetLockedBy(*payload.LockedBy)
+	}
+	if payload.LockedUntil != nil {
+		op.SetLockedUntil(*payload.LockedUntil)
+	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PasswordTokenUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+func (h *Handler) JobUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Job.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PasswordToken
+	var payload Job
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	if payload.Token != nil {
-		op.SetToken(*payload.Token)
+	op.SetQueue(payload.Queue)
+	op.SetPayload(payload.Payload)
+	if payload.Attempts == nil {
+		var empty int
+		op.SetAttempts(empty)
+	} else {
+		op.SetAttempts(*payload.Attempts)
 	}
-	op.SetUserID(payload.UserID)
-	if payload.CreatedAt == nil {
+	if payload.MaxAttempts == nil {
+		var empty int
+		op.SetMaxAttempts(empty)
+	} else {
+		op.SetMaxAttempts(*payload.MaxAttempts)
+	}
+	if payload.Status == nil {
+		var empty job.Status
+		op.SetStatus(empty)
+	} else {
+		op.SetStatus(*payload.Status)
+	}
+	if payload.Priority == nil {
+		var empty int
+		op.SetPriority(empty)
+	} else {
+		op.SetPriority(*payload.Priority)
+	}
+	if payload.RunAt == nil {
 		var empty time.Time
-		op.SetCreatedAt(empty)
+		op.SetRunAt(empty)
 	} else {
-		op.SetCreatedAt(*payload.CreatedAt)
+		op.SetRunAt(*payload.RunAt)
+	}
+	if payload.UniqueLock == nil {
+		op.ClearUniqueLock()
+	} else {
+		op.SetUniqueLock(*payload.UniqueLock)
+	}
+	if payload.Error == nil {
+		op.ClearError()
+	} else {
+		op.SetError(*payload.Error)
+	}
+	if payload.ProcessedAt == nil {
+		op.ClearProcessedAt()
+	} else {
+		op.SetProcessedAt(*payload.ProcessedAt)
+	}
+	if payload.LockedBy == nil {
+		op.ClearLockedBy()
+	} else {
+		op.SetLockedBy(*payload.LockedBy)
 	}
+	op.SetNillableLockedUntil(payload.LockedUntil)
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PasswordTokenDelete(ctx echo.Context, id int) error {
-	return h.client.PasswordToken.DeleteOneID(id).
+func (h *Handler) JobDelete(ctx echo.Context, id int) error {
+	return h.client.Job.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) JobList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PasswordToken.
+	res, err := h.client.Job.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(passwordtoken.ByID(sql.OrderDesc())).
+		Order(job.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -613,8 +1158,21 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"User ID",
+			"Queue",
+			"Payload",
+			"Attempts",
+			"Max attempts",
+			"Status",
+			"Priority",
+			"Run at",
+			"Scheduled for",
+			"Unique key",
+			"Unique lock",
+			"Error",
 			"Created at",
+			"Processed at",
+			"Locked by",
+			"Locked until",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -625,8 +1183,21 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				fmt.Sprint(res[i].UserID),
+				res[i].Queue,
+				fmt.Sprint(res[i].Payload),
+				fmt.Sprint(res[i].Attempts),
+				fmt.Sprint(res[i].MaxAttempts),
+				fmt.Sprint(res[i].Status),
+				fmt.Sprint(res[i].Priority),
+				res[i].RunAt.Format(h.Config.TimeFormat),
+				res[i].ScheduledFor.Format(h.Config.TimeFormat),
+				res[i].UniqueKey,
+				res[i].UniqueLock,
+				res[i].Error,
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].ProcessedAt.Format(h.Config.TimeFormat),
+				res[i].LockedBy,
+				res[i].LockedUntil.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -634,98 +1205,86 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PasswordTokenGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+func (h *Handler) JobGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Job.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("user_id", fmt.Sprint(entity.UserID))
-	v.Set("created_at", entity.CreatedAt.Format(dateTimeFormat))
+	v.Set("queue", entity.Queue)
+	v.Set("payload", fmt.Sprint(entity.Payload))
+	v.Set("attempts", fmt.Sprint(entity.Attempts))
+	v.Set("max_attempts", fmt.Sprint(entity.MaxAttempts))
+	v.Set("status", fmt.Sprint(entity.Status))
+	v.Set("priority", fmt.Sprint(entity.Priority))
+	v.Set("run_at", entity.RunAt.Format(dateTimeFormat))
+	v.Set("unique_lock", entity.UniqueLock)
+	v.Set("error", entity.Error)
+	v.Set("processed_at", entity.ProcessedAt.Format(dateTimeFormat))
+	v.Set("locked_by", entity.LockedBy)
+	v.Set("locked_until", entity.LockedUntil.Format(dateTimeFormat))
 	return v, err
 }
 
-func (h *Handler) PaymentCustomerCreate(ctx echo.Context) error {
-	var payload PaymentCustomer
+func (h *Handler) JobAttemptCreate(ctx echo.Context) error {
+	var payload JobAttempt
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PaymentCustomer.Create()
-	op.SetProviderCustomerID(payload.ProviderCustomerID)
-	if payload.Provider != nil {
-		op.SetProvider(*payload.Provider)
-	}
-	op.SetEmail(payload.Email)
-	if payload.Name != nil {
-		op.SetName(*payload.Name)
-	}
-	if payload.Metadata != nil {
-		op.SetMetadata(*payload.Metadata)
-	}
-	if payload.CreatedAt != nil {
-		op.SetCreatedAt(*payload.CreatedAt)
-	}
-	if payload.UpdatedAt != nil {
-		op.SetUpdatedAt(*payload.UpdatedAt)
+	op := h.client.JobAttempt.Create()
+	op.SetJobID(payload.JobID)
+	op.SetAttempt(payload.Attempt)
+	op.SetWorker(payload.Worker)
+	op.SetStartedAt(payload.StartedAt)
+	op.SetFinishedAt(payload.FinishedAt)
+	op.SetDurationMs(payload.DurationMs)
+	if payload.Error != nil {
+		op.SetError(*payload.Error)
 	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentCustomerUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PaymentCustomer.Get(ctx.Request().Context(), id)
+func (h *Handler) JobAttemptUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.JobAttempt.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PaymentCustomer
+	var payload JobAttempt
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetProviderCustomerID(payload.ProviderCustomerID)
-	if payload.Provider == nil {
-		var empty string
-		op.SetProvider(empty)
-	} else {
-		op.SetProvider(*payload.Provider)
-	}
-	op.SetEmail(payload.Email)
-	if payload.Name == nil {
-		op.ClearName()
-	} else {
-		op.SetName(*payload.Name)
-	}
-	if payload.Metadata == nil {
-		op.ClearMetadata()
-	} else {
-		op.SetMetadata(*payload.Metadata)
-	}
-	if payload.UpdatedAt == nil {
-		var empty time.Time
-		op.SetUpdatedAt(empty)
+	op.SetAttempt(payload.Attempt)
+	op.SetWorker(payload.Worker)
+	op.SetStartedAt(payload.StartedAt)
+	op.SetFinishedAt(payload.FinishedAt)
+	op.SetDurationMs(payload.DurationMs)
+	if payload.Error == nil {
+		op.ClearError()
 	} else {
-		op.SetUpdatedAt(*payload.UpdatedAt)
+		op.SetError(*payload.Error)
 	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentCustomerDelete(ctx echo.Context, id int) error {
-	return h.client.PaymentCustomer.DeleteOneID(id).
+func (h *Handler) JobAttemptDelete(ctx echo.Context, id int) error {
+	return h.client.JobAttempt.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) JobAttemptList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PaymentCustomer.
+	res, err := h.client.JobAttempt.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(paymentcustomer.ByID(sql.OrderDesc())).
+		Order(jobattempt.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -734,13 +1293,13 @@ func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Provider customer ID",
-			"Provider",
-			"Email",
-			"Name",
-			"Metadata",
-			"Created at",
-			"Updated at",
+			"Job ID",
+			"Attempt",
+			"Worker",
+			"Started at",
+			"Finished at",
+			"Duration ms",
+			"Error",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -751,13 +1310,13 @@ func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].ProviderCustomerID,
-				res[i].Provider,
-				res[i].Email,
-				res[i].Name,
-				fmt.Sprint(res[i].Metadata),
-				res[i].CreatedAt.Format(h.Config.TimeFormat),
-				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+				fmt.Sprint(res[i].JobID),
+				fmt.Sprint(res[i].Attempt),
+				res[i].Worker,
+				res[i].StartedAt.Format(h.Config.TimeFormat),
+				res[i].FinishedAt.Format(h.Config.TimeFormat),
+				fmt.Sprint(res[i].DurationMs),
+				res[i].Error,
 			},
 		})
 	}
@@ -765,104 +1324,163 @@ func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PaymentCustomerGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PaymentCustomer.Get(ctx.Request().Context(), id)
+func (h *Handler) JobAttemptGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.JobAttempt.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("provider_customer_id", entity.ProviderCustomerID)
-	v.Set("provider", entity.Provider)
-	v.Set("email", entity.Email)
-	v.Set("name", entity.Name)
-	v.Set("metadata", fmt.Sprint(entity.Metadata))
-	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("attempt", fmt.Sprint(entity.Attempt))
+	v.Set("worker", entity.Worker)
+	v.Set("started_at", entity.StartedAt.Format(dateTimeFormat))
+	v.Set("finished_at", entity.FinishedAt.Format(dateTimeFormat))
+	v.Set("duration_ms", fmt.Sprint(entity.DurationMs))
+	v.Set("error", entity.Error)
 	return v, err
 }
 
-func (h *Handler) PaymentIntentCreate(ctx echo.Context) error {
-	var payload PaymentIntent
+func (h *Handler) PasswordTokenCreate(ctx echo.Context) error {
+	var payload PasswordToken
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PaymentIntent.Create()
-	op.SetProviderPaymentIntentID(payload.ProviderPaymentIntentID)
-	if payload.Provider != nil {
-		op.SetProvider(*payload.Provider)
-	}
-	if payload.Status != nil {
-		op.SetStatus(*payload.Status)
-	}
-	op.SetAmount(payload.Amount)
-	if payload.Currency != nil {
-		op.SetCurrency(*payload.Currency)
-	}
-	if payload.Description != nil {
-		op.SetDescription(*payload.Description)
-	}
-	if payload.ClientSecret != nil {
-		op.SetClientSecret(*payload.ClientSecret)
-	}
-	if payload.Metadata != nil {
-		op.SetMetadata(*payload.Metadata)
+	op := h.client.PasswordToken.Create()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
 	}
+	op.SetUserID(payload.UserID)
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.UpdatedAt != nil {
-		op.SetUpdatedAt(*payload.UpdatedAt)
-	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentIntentUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PaymentIntent.Get(ctx.Request().Context(), id)
+func (h *Handler) PasswordTokenUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PaymentIntent
+	var payload PasswordToken
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetProviderPaymentIntentID(payload.ProviderPaymentIntentID)
-	if payload.Provider == nil {
-		var empty string
-		op.SetProvider(empty)
-	} else {
-		op.SetProvider(*payload.Provider)
-	}
-	if payload.Status == nil {
-		var empty paymentintent.Status
-		op.SetStatus(empty)
-	} else {
-		op.SetStatus(*payload.Status)
-	}
-	op.SetAmount(payload.Amount)
-	if payload.Currency == nil {
-		var empty string
-		op.SetCurrency(empty)
-	} else {
-		op.SetCurrency(*payload.Currency)
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
 	}
-	if payload.Description == nil {
-		op.ClearDescription()
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt == nil {
+		var empty time.Time
+		op.SetCreatedAt(empty)
 	} else {
-		op.SetDescription(*payload.Description)
-	}
-	if payload.ClientSecret != nil {
-		op.SetClientSecret(*payload.ClientSecret)
+		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.Metadata == nil {
-		op.ClearMetadata()
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PasswordTokenDelete(ctx echo.Context, id int) error {
+	return h.client.PasswordToken.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PasswordToken.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(passwordtoken.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].UserID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PasswordTokenGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("created_at", entity.CreatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) PaymentAccountCreate(ctx echo.Context) error {
+	var payload PaymentAccount
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.PaymentAccount.Create()
+	op.SetProviderAccountID(payload.ProviderAccountID)
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	op.SetChargesEnabled(payload.ChargesEnabled)
+	op.SetDetailsSubmitted(payload.DetailsSubmitted)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentAccountUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PaymentAccount.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PaymentAccount
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetProviderAccountID(payload.ProviderAccountID)
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
 	} else {
-		op.SetMetadata(*payload.Metadata)
+		op.SetProvider(*payload.Provider)
 	}
+	op.SetChargesEnabled(payload.ChargesEnabled)
+	op.SetDetailsSubmitted(payload.DetailsSubmitted)
 	if payload.UpdatedAt == nil {
 		var empty time.Time
 		op.SetUpdatedAt(empty)
@@ -873,18 +1491,18 @@ func (h *Handler) PaymentIntentUpdate(ctx echo.Context, id int) error {
 	return err
 }
 
-func (h *Handler) PaymentIntentDelete(ctx echo.Context, id int) error {
-	return h.client.PaymentIntent.DeleteOneID(id).
+func (h *Handler) PaymentAccountDelete(ctx echo.Context, id int) error {
+	return h.client.PaymentAccount.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) PaymentAccountList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PaymentIntent.
+	res, err := h.client.PaymentAccount.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(paymentintent.ByID(sql.OrderDesc())).
+		Order(paymentaccount.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -893,13 +1511,10 @@ func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Provider payment intent ID",
+			"Provider account ID",
 			"Provider",
-			"Status",
-			"Amount",
-			"Currency",
-			"Description",
-			"Metadata",
+			"Charges enabled",
+			"Details submitted",
 			"Created at",
 			"Updated at",
 		},
@@ -912,13 +1527,10 @@ func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].ProviderPaymentIntentID,
+				res[i].ProviderAccountID,
 				res[i].Provider,
-				fmt.Sprint(res[i].Status),
-				fmt.Sprint(res[i].Amount),
-				res[i].Currency,
-				res[i].Description,
-				fmt.Sprint(res[i].Metadata),
+				fmt.Sprint(res[i].ChargesEnabled),
+				fmt.Sprint(res[i].DetailsSubmitted),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 				res[i].UpdatedAt.Format(h.Config.TimeFormat),
 			},
@@ -928,51 +1540,36 @@ func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PaymentIntentGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PaymentIntent.Get(ctx.Request().Context(), id)
+func (h *Handler) PaymentAccountGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PaymentAccount.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("provider_payment_intent_id", entity.ProviderPaymentIntentID)
+	v.Set("provider_account_id", entity.ProviderAccountID)
 	v.Set("provider", entity.Provider)
-	v.Set("status", fmt.Sprint(entity.Status))
-	v.Set("amount", fmt.Sprint(entity.Amount))
-	v.Set("currency", entity.Currency)
-	v.Set("description", entity.Description)
-	v.Set("metadata", fmt.Sprint(entity.Metadata))
+	v.Set("charges_enabled", fmt.Sprint(entity.ChargesEnabled))
+	v.Set("details_submitted", fmt.Sprint(entity.DetailsSubmitted))
 	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
 	return v, err
 }
 
-func (h *Handler) PaymentMethodCreate(ctx echo.Context) error {
-	var payload PaymentMethod
+func (h *Handler) PaymentCustomerCreate(ctx echo.Context) error {
+	var payload PaymentCustomer
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PaymentMethod.Create()
-	op.SetProviderPaymentMethodID(payload.ProviderPaymentMethodID)
+	op := h.client.PaymentCustomer.Create()
+	op.SetProviderCustomerID(payload.ProviderCustomerID)
 	if payload.Provider != nil {
 		op.SetProvider(*payload.Provider)
 	}
-	if payload.Type != nil {
-		op.SetType(*payload.Type)
-	}
-	if payload.LastFour != nil {
-		op.SetLastFour(*payload.LastFour)
-	}
-	if payload.Brand != nil {
-		op.SetBrand(*payload.Brand)
-	}
-	if payload.ExpMonth != nil {
-		op.SetExpMonth(*payload.ExpMonth)
-	}
-	if payload.ExpYear != nil {
-		op.SetExpYear(*payload.ExpYear)
+	op.SetEmail(payload.Email)
+	if payload.Name != nil {
+		op.SetName(*payload.Name)
 	}
-	op.SetIsDefault(payload.IsDefault)
 	if payload.Metadata != nil {
 		op.SetMetadata(*payload.Metadata)
 	}
@@ -986,52 +1583,31 @@ func (h *Handler) PaymentMethodCreate(ctx echo.Context) error {
 	return err
 }
 
-func (h *Handler) PaymentMethodUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PaymentMethod.Get(ctx.Request().Context(), id)
+func (h *Handler) PaymentCustomerUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PaymentCustomer.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PaymentMethod
+	var payload PaymentCustomer
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetProviderPaymentMethodID(payload.ProviderPaymentMethodID)
+	op.SetProviderCustomerID(payload.ProviderCustomerID)
 	if payload.Provider == nil {
 		var empty string
 		op.SetProvider(empty)
 	} else {
 		op.SetProvider(*payload.Provider)
 	}
-	if payload.Type == nil {
-		var empty paymentmethod.Type
-		op.SetType(empty)
-	} else {
-		op.SetType(*payload.Type)
-	}
-	if payload.LastFour == nil {
-		op.ClearLastFour()
-	} else {
-		op.SetLastFour(*payload.LastFour)
-	}
-	if payload.Brand == nil {
-		op.ClearBrand()
-	} else {
-		op.SetBrand(*payload.Brand)
-	}
-	if payload.ExpMonth == nil {
-		op.ClearExpMonth()
-	} else {
-		op.SetExpMonth(*payload.ExpMonth)
-	}
-	if payload.ExpYear == nil {
-		op.ClearExpYear()
+	op.SetEmail(payload.Email)
+	if payload.Name == nil {
+		op.ClearName()
 	} else {
-		op.SetExpYear(*payload.ExpYear)
+		op.SetName(*payload.Name)
 	}
-	op.SetIsDefault(payload.IsDefault)
 	if payload.Metadata == nil {
 		op.ClearMetadata()
 	} else {
@@ -1047,18 +1623,18 @@ func (h *Handler) PaymentMethodUpdate(ctx echo.Context, id int) error {
 	return err
 }
 
-func (h *Handler) PaymentMethodDelete(ctx echo.Context, id int) error {
-	return h.client.PaymentMethod.DeleteOneID(id).
+func (h *Handler) PaymentCustomerDelete(ctx echo.Context, id int) error {
+	return h.client.PaymentCustomer.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PaymentMethod.
+	res, err := h.client.PaymentCustomer.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(paymentmethod.ByID(sql.OrderDesc())).
+		Order(paymentcustomer.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -1067,14 +1643,10 @@ func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Provider payment method ID",
+			"Provider customer ID",
 			"Provider",
-			"Type",
-			"Last four",
-			"Brand",
-			"Exp month",
-			"Exp year",
-			"Is default",
+			"Email",
+			"Name",
 			"Metadata",
 			"Created at",
 			"Updated at",
@@ -1088,14 +1660,10 @@ func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].ProviderPaymentMethodID,
+				res[i].ProviderCustomerID,
 				res[i].Provider,
-				fmt.Sprint(res[i].Type),
-				res[i].LastFour,
-				res[i].Brand,
-				fmt.Sprint(res[i].ExpMonth),
-				fmt.Sprint(res[i].ExpYear),
-				fmt.Sprint(res[i].IsDefault),
+				res[i].Email,
+				res[i].Name,
 				fmt.Sprint(res[i].Metadata),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 				res[i].UpdatedAt.Format(h.Config.TimeFormat),
@@ -1106,131 +1674,1051 @@ func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PaymentMethodGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PaymentMethod.Get(ctx.Request().Context(), id)
+func (h *Handler) PaymentCustomerGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PaymentCustomer.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("provider_payment_method_id", entity.ProviderPaymentMethodID)
+	v.Set("provider_customer_id", entity.ProviderCustomerID)
 	v.Set("provider", entity.Provider)
-	v.Set("type", fmt.Sprint(entity.Type))
-	v.Set("last_four", entity.LastFour)
-	v.Set("brand", entity.Brand)
-	v.Set("exp_month", fmt.Sprint(entity.ExpMonth))
-	v.Set("exp_year", fmt.Sprint(entity.ExpYear))
-	v.Set("is_default", fmt.Sprint(entity.IsDefault))
+	v.Set("email", entity.Email)
+	v.Set("name", entity.Name)
 	v.Set("metadata", fmt.Sprint(entity.Metadata))
 	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
 	return v, err
 }
 
-func (h *Handler) QuestionCreate(ctx echo.Context) error {
-	var payload Question
+func (h *Handler) PaymentIntentCreate(ctx echo.Context) error {
+	var payload PaymentIntent
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.Question.Create()
-	if payload.Type != nil {
-		op.SetType(*payload.Type)
+	op := h.client.PaymentIntent.Create()
+	op.SetProviderPaymentIntentID(payload.ProviderPaymentIntentID)
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	if payload.Status != nil {
+		op.SetStatus(*payload.Status)
+	}
+	op.SetAmount(payload.Amount)
+	if payload.AmountRefunded != nil {
+		op.SetAmountRefunded(*payload.AmountRefunded)
+	}
+	if payload.Currency != nil {
+		op.SetCurrency(*payload.Currency)
 	}
-	op.SetTitle(payload.Title)
 	if payload.Description != nil {
 		op.SetDescription(*payload.Description)
 	}
-	if payload.Placeholder != nil {
-		op.SetPlaceholder(*payload.Placeholder)
+	if payload.ClientSecret != nil {
+		op.SetClientSecret(*payload.ClientSecret)
 	}
-	op.SetRequired(payload.Required)
-	if payload.Order != nil {
-		op.SetOrder(*payload.Order)
+	if payload.ProviderAccountID != nil {
+		op.SetProviderAccountID(*payload.ProviderAccountID)
 	}
-	if payload.Options != nil {
-		op.SetOptions(*payload.Options)
+	if payload.Metadata != nil {
+		op.SetMetadata(*payload.Metadata)
 	}
-	if payload.Validation != nil {
-		op.SetValidation(*payload.Validation)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentIntentUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PaymentIntent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PaymentIntent
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetProviderPaymentIntentID(payload.ProviderPaymentIntentID)
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
+	} else {
+		op.SetProvider(*payload.Provider)
+	}
+	if payload.Status == nil {
+		var empty paymentintent.Status
+		op.SetStatus(empty)
+	} else {
+		op.SetStatus(*payload.Status)
+	}
+	op.SetAmount(payload.Amount)
+	if payload.AmountRefunded == nil {
+		var empty int64
+		op.SetAmountRefunded(empty)
+	} else {
+		op.SetAmountRefunded(*payload.AmountRefunded)
+	}
+	if payload.Currency == nil {
+		var empty string
+		op.SetCurrency(empty)
+	} else {
+		op.SetCurrency(*payload.Currency)
+	}
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.ClientSecret != nil {
+		op.SetClientSecret(*payload.ClientSecret)
+	}
+	if payload.ProviderAccountID == nil {
+		op.ClearProviderAccountID()
+	} else {
+		op.SetProviderAccountID(*payload.ProviderAccountID)
+	}
+	if payload.Metadata == nil {
+		op.ClearMetadata()
+	} else {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentIntentDelete(ctx echo.Context, id int) error {
+	return h.client.PaymentIntent.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PaymentIntent.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(paymentintent.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider payment intent ID",
+			"Provider",
+			"Status",
+			"Amount",
+			"Amount refunded",
+			"Currency",
+			"Description",
+			"Provider account ID",
+			"Metadata",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].ProviderPaymentIntentID,
+				res[i].Provider,
+				fmt.Sprint(res[i].Status),
+				fmt.Sprint(res[i].Amount),
+				fmt.Sprint(res[i].AmountRefunded),
+				res[i].Currency,
+				res[i].Description,
+				res[i].ProviderAccountID,
+				fmt.Sprint(res[i].Metadata),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PaymentIntentGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PaymentIntent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("provider_payment_intent_id", entity.ProviderPaymentIntentID)
+	v.Set("provider", entity.Provider)
+	v.Set("status", fmt.Sprint(entity.Status))
+	v.Set("amount", fmt.Sprint(entity.Amount))
+	v.Set("amount_refunded", fmt.Sprint(entity.AmountRefunded))
+	v.Set("currency", entity.Currency)
+	v.Set("description", entity.Description)
+	v.Set("provider_account_id", entity.ProviderAccountID)
+	v.Set("metadata", fmt.Sprint(entity.Metadata))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) PaymentMethodCreate(ctx echo.Context) error {
+	var payload PaymentMethod
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.PaymentMethod.Create()
+	op.SetProviderPaymentMethodID(payload.ProviderPaymentMethodID)
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	if payload.Type != nil {
+		op.SetType(*payload.Type)
+	}
+	if payload.LastFour != nil {
+		op.SetLastFour(*payload.LastFour)
+	}
+	if payload.Brand != nil {
+		op.SetBrand(*payload.Brand)
+	}
+	if payload.ExpMonth != nil {
+		op.SetExpMonth(*payload.ExpMonth)
+	}
+	if payload.ExpYear != nil {
+		op.SetExpYear(*payload.ExpYear)
+	}
+	op.SetIsDefault(payload.IsDefault)
+	if payload.Metadata != nil {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentMethodUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PaymentMethod.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PaymentMethod
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetProviderPaymentMethodID(payload.ProviderPaymentMethodID)
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
+	} else {
+		op.SetProvider(*payload.Provider)
+	}
+	if payload.Type == nil {
+		var empty paymentmethod.Type
+		op.SetType(empty)
+	} else {
+		op.SetType(*payload.Type)
+	}
+	if payload.LastFour == nil {
+		op.ClearLastFour()
+	} else {
+		op.SetLastFour(*payload.LastFour)
+	}
+	if payload.Brand == nil {
+		op.ClearBrand()
+	} else {
+		op.SetBrand(*payload.Brand)
+	}
+	if payload.ExpMonth == nil {
+		op.ClearExpMonth()
+	} else {
+		op.SetExpMonth(*payload.ExpMonth)
+	}
+	if payload.ExpYear == nil {
+		op.ClearExpYear()
+	} else {
+		op.SetExpYear(*payload.ExpYear)
+	}
+	op.SetIsDefault(payload.IsDefault)
+	if payload.Metadata == nil {
+		op.ClearMetadata()
+	} else {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentMethodDelete(ctx echo.Context, id int) error {
+	return h.client.PaymentMethod.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PaymentMethod.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(paymentmethod.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider payment method ID",
+			"Provider",
+			"Type",
+			"Last four",
+			"Brand",
+			"Exp month",
+			"Exp year",
+			"Is default",
+			"Metadata",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].ProviderPaymentMethodID,
+				res[i].Provider,
+				fmt.Sprint(res[i].Type),
+				res[i].LastFour,
+				res[i].Brand,
+				fmt.Sprint(res[i].ExpMonth),
+				fmt.Sprint(res[i].ExpYear),
+				fmt.Sprint(res[i].IsDefault),
+				fmt.Sprint(res[i].Metadata),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PaymentMethodGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PaymentMethod.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("provider_payment_method_id", entity.ProviderPaymentMethodID)
+	v.Set("provider", entity.Provider)
+	v.Set("type", fmt.Sprint(entity.Type))
+	v.Set("last_four", entity.LastFour)
+	v.Set("brand", entity.Brand)
+	v.Set("exp_month", fmt.Sprint(entity.ExpMonth))
+	v.Set("exp_year", fmt.Sprint(entity.ExpYear))
+	v.Set("is_default", fmt.Sprint(entity.IsDefault))
+	v.Set("metadata", fmt.Sprint(entity.Metadata))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) ProcessedEventCreate(ctx echo.Context) error {
+	var payload ProcessedEvent
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.ProcessedEvent.Create()
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	op.SetEventID(payload.EventID)
+	op.SetType(payload.Type)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ProcessedEventUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.ProcessedEvent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload ProcessedEvent
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
+	} else {
+		op.SetProvider(*payload.Provider)
+	}
+	op.SetEventID(payload.EventID)
+	op.SetType(payload.Type)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ProcessedEventDelete(ctx echo.Context, id int) error {
+	return h.client.ProcessedEvent.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) ProcessedEventList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.ProcessedEvent.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(processedevent.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider",
+			"Event ID",
+			"Type",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Provider,
+				res[i].EventID,
+				res[i].Type,
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) ProcessedEventGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.ProcessedEvent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("provider", entity.Provider)
+	v.Set("event_id", entity.EventID)
+	v.Set("type", entity.Type)
+	return v, err
+}
+
+func (h *Handler) QuestionCreate(ctx echo.Context) error {
+	var payload Question
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Question.Create()
+	if payload.Type != nil {
+		op.SetType(*payload.Type)
+	}
+	op.SetTitle(payload.Title)
+	if payload.Description != nil {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.Placeholder != nil {
+		op.SetPlaceholder(*payload.Placeholder)
+	}
+	op.SetRequired(payload.Required)
+	op.SetSensitive(payload.Sensitive)
+	if payload.Order != nil {
+		op.SetOrder(*payload.Order)
+	}
+	if payload.Options != nil {
+		op.SetOptions(*payload.Options)
+	}
+	if payload.Validation != nil {
+		op.SetValidation(*payload.Validation)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) QuestionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Question.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Question
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Type == nil {
+		var empty question.Type
+		op.SetType(empty)
+	} else {
+		op.SetType(*payload.Type)
+	}
+	op.SetTitle(payload.Title)
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.Placeholder == nil {
+		op.ClearPlaceholder()
+	} else {
+		op.SetPlaceholder(*payload.Placeholder)
+	}
+	op.SetRequired(payload.Required)
+	op.SetSensitive(payload.Sensitive)
+	if payload.Order == nil {
+		var empty int
+		op.SetOrder(empty)
+	} else {
+		op.SetOrder(*payload.Order)
+	}
+	if payload.Options == nil {
+		op.ClearOptions()
+	} else {
+		op.SetOptions(*payload.Options)
+	}
+	if payload.Validation == nil {
+		op.ClearValidation()
+	} else {
+		op.SetValidation(*payload.Validation)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) QuestionDelete(ctx echo.Context, id int) error {
+	return h.client.Question.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) QuestionList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Question.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(question.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Type",
+			"Title",
+			"Description",
+			"Placeholder",
+			"Required",
+			"Sensitive",
+			"Order",
+			"Options",
+			"Validation",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].Type),
+				res[i].Title,
+				res[i].Description,
+				res[i].Placeholder,
+				fmt.Sprint(res[i].Required),
+				fmt.Sprint(res[i].Sensitive),
+				fmt.Sprint(res[i].Order),
+				fmt.Sprint(res[i].Options),
+				fmt.Sprint(res[i].Validation),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) QuestionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Question.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("type", fmt.Sprint(entity.Type))
+	v.Set("title", entity.Title)
+	v.Set("description", entity.Description)
+	v.Set("placeholder", entity.Placeholder)
+	v.Set("required", fmt.Sprint(entity.Required))
+	v.Set("sensitive", fmt.Sprint(entity.Sensitive))
+	v.Set("order", fmt.Sprint(entity.Order))
+	v.Set("options", fmt.Sprint(entity.Options))
+	v.Set("validation", fmt.Sprint(entity.Validation))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) ReportSubscriptionCreate(ctx echo.Context) error {
+	var payload ReportSubscription
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.ReportSubscription.Create()
+	if payload.Frequency != nil {
+		op.SetFrequency(*payload.Frequency)
+	}
+	if payload.Timezone != nil {
+		op.SetTimezone(*payload.Timezone)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.FormID != nil {
+		op.SetFormID(*payload.FormID)
+	}
+	if payload.LastPeriodStart != nil {
+		op.SetLastPeriodStart(*payload.LastPeriodStart)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ReportSubscriptionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.ReportSubscription.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload ReportSubscription
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Frequency == nil {
+		var empty reportsubscription.Frequency
+		op.SetFrequency(empty)
+	} else {
+		op.SetFrequency(*payload.Frequency)
+	}
+	if payload.Timezone == nil {
+		var empty string
+		op.SetTimezone(empty)
+	} else {
+		op.SetTimezone(*payload.Timezone)
+	}
+	op.SetUserID(payload.UserID)
+	op.SetNillableFormID(payload.FormID)
+	op.SetNillableLastPeriodStart(payload.LastPeriodStart)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ReportSubscriptionDelete(ctx echo.Context, id int) error {
+	return h.client.ReportSubscription.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) ReportSubscriptionList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.ReportSubscription.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(reportsubscription.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Frequency",
+			"Timezone",
+			"User ID",
+			"Form ID",
+			"Last period start",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].Frequency),
+				res[i].Timezone,
+				fmt.Sprint(res[i].UserID),
+				fmt.Sprint(res[i].FormID),
+				res[i].LastPeriodStart.Format(h.Config.TimeFormat),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) ReportSubscriptionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.ReportSubscription.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("frequency", fmt.Sprint(entity.Frequency))
+	v.Set("timezone", entity.Timezone)
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("form_id", fmt.Sprint(entity.FormID))
+	v.Set("last_period_start", entity.LastPeriodStart.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) ResponseCreate(ctx echo.Context) error {
+	var payload Response
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Response.Create()
+	if payload.SubmittedAt != nil {
+		op.SetSubmittedAt(*payload.SubmittedAt)
+	}
+	op.SetCompleted(payload.Completed)
+	if payload.IPAddress != nil {
+		op.SetIPAddress(*payload.IPAddress)
+	}
+	if payload.UserAgent != nil {
+		op.SetUserAgent(*payload.UserAgent)
+	}
+	if payload.Status != nil {
+		op.SetStatus(*payload.Status)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ResponseUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Response.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Response
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetCompleted(payload.Completed)
+	if payload.IPAddress == nil {
+		op.ClearIPAddress()
+	} else {
+		op.SetIPAddress(*payload.IPAddress)
+	}
+	if payload.UserAgent == nil {
+		op.ClearUserAgent()
+	} else {
+		op.SetUserAgent(*payload.UserAgent)
+	}
+	if payload.Status == nil {
+		var empty response.Status
+		op.SetStatus(empty)
+	} else {
+		op.SetStatus(*payload.Status)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ResponseDelete(ctx echo.Context, id int) error {
+	return h.client.Response.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) ResponseList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Response.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(response.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Submitted at",
+			"Completed",
+			"IPAddress",
+			"UserAgent",
+			"Status",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].SubmittedAt.Format(h.Config.TimeFormat),
+				fmt.Sprint(res[i].Completed),
+				res[i].IPAddress,
+				res[i].UserAgent,
+				fmt.Sprint(res[i].Status),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) ResponseGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Response.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("completed", fmt.Sprint(entity.Completed))
+	v.Set("IPAddress", entity.IPAddress)
+	v.Set("UserAgent", entity.UserAgent)
+	v.Set("status", fmt.Sprint(entity.Status))
+	return v, err
+}
+
+func (h *Handler) ResponseActivityCreate(ctx echo.Context) error {
+	var payload ResponseActivity
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.ResponseActivity.Create()
+	op.SetAction(payload.Action)
+	if payload.Changes != nil {
+		op.SetChanges(*payload.Changes)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ResponseActivityUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.ResponseActivity.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload ResponseActivity
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetAction(payload.Action)
+	if payload.Changes == nil {
+		op.ClearChanges()
+	} else {
+		op.SetChanges(*payload.Changes)
+	}
+	op.SetUserID(payload.UserID)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ResponseActivityDelete(ctx echo.Context, id int) error {
+	return h.client.ResponseActivity.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) ResponseActivityList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.ResponseActivity.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(responseactivity.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Action",
+			"Changes",
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].Action),
+				fmt.Sprint(res[i].Changes),
+				fmt.Sprint(res[i].UserID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) ResponseActivityGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.ResponseActivity.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("action", fmt.Sprint(entity.Action))
+	v.Set("changes", fmt.Sprint(entity.Changes))
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	return v, err
+}
+
+func (h *Handler) ResponseNoteCreate(ctx echo.Context) error {
+	var payload ResponseNote
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
 	}
+
+	op := h.client.ResponseNote.Create()
+	op.SetBody(payload.Body)
+	op.SetUserID(payload.UserID)
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.UpdatedAt != nil {
-		op.SetUpdatedAt(*payload.UpdatedAt)
-	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) QuestionUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.Question.Get(ctx.Request().Context(), id)
+func (h *Handler) ResponseNoteUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.ResponseNote.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload Question
+	var payload ResponseNote
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	if payload.Type == nil {
-		var empty question.Type
-		op.SetType(empty)
-	} else {
-		op.SetType(*payload.Type)
-	}
-	op.SetTitle(payload.Title)
-	if payload.Description == nil {
-		op.ClearDescription()
-	} else {
-		op.SetDescription(*payload.Description)
-	}
-	if payload.Placeholder == nil {
-		op.ClearPlaceholder()
-	} else {
-		op.SetPlaceholder(*payload.Placeholder)
-	}
-	op.SetRequired(payload.Required)
-	if payload.Order == nil {
-		var empty int
-		op.SetOrder(empty)
-	} else {
-		op.SetOrder(*payload.Order)
-	}
-	if payload.Options == nil {
-		op.ClearOptions()
-	} else {
-		op.SetOptions(*payload.Options)
-	}
-	if payload.Validation == nil {
-		op.ClearValidation()
-	} else {
-		op.SetValidation(*payload.Validation)
-	}
-	if payload.UpdatedAt == nil {
-		var empty time.Time
-		op.SetUpdatedAt(empty)
-	} else {
-		op.SetUpdatedAt(*payload.UpdatedAt)
-	}
+	op.SetBody(payload.Body)
+	op.SetUserID(payload.UserID)
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) QuestionDelete(ctx echo.Context, id int) error {
-	return h.client.Question.DeleteOneID(id).
+func (h *Handler) ResponseNoteDelete(ctx echo.Context, id int) error {
+	return h.client.ResponseNote.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) QuestionList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) ResponseNoteList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.Question.
+	res, err := h.client.ResponseNote.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(question.ByID(sql.OrderDesc())).
+		Order(responsenote.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -1239,16 +2727,9 @@ func (h *Handler) QuestionList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Type",
-			"Title",
-			"Description",
-			"Placeholder",
-			"Required",
-			"Order",
-			"Options",
-			"Validation",
+			"Body",
+			"User ID",
 			"Created at",
-			"Updated at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -1259,16 +2740,9 @@ func (h *Handler) QuestionList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				fmt.Sprint(res[i].Type),
-				res[i].Title,
-				res[i].Description,
-				res[i].Placeholder,
-				fmt.Sprint(res[i].Required),
-				fmt.Sprint(res[i].Order),
-				fmt.Sprint(res[i].Options),
-				fmt.Sprint(res[i].Validation),
+				res[i].Body,
+				fmt.Sprint(res[i].UserID),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
-				res[i].UpdatedAt.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -1276,85 +2750,64 @@ func (h *Handler) QuestionList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) QuestionGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.Question.Get(ctx.Request().Context(), id)
+func (h *Handler) ResponseNoteGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.ResponseNote.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("type", fmt.Sprint(entity.Type))
-	v.Set("title", entity.Title)
-	v.Set("description", entity.Description)
-	v.Set("placeholder", entity.Placeholder)
-	v.Set("required", fmt.Sprint(entity.Required))
-	v.Set("order", fmt.Sprint(entity.Order))
-	v.Set("options", fmt.Sprint(entity.Options))
-	v.Set("validation", fmt.Sprint(entity.Validation))
-	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("body", entity.Body)
+	v.Set("user_id", fmt.Sprint(entity.UserID))
 	return v, err
 }
 
-func (h *Handler) ResponseCreate(ctx echo.Context) error {
-	var payload Response
+func (h *Handler) ResponseTagCreate(ctx echo.Context) error {
+	var payload ResponseTag
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.Response.Create()
-	if payload.SubmittedAt != nil {
-		op.SetSubmittedAt(*payload.SubmittedAt)
-	}
-	op.SetCompleted(payload.Completed)
-	if payload.IPAddress != nil {
-		op.SetIPAddress(*payload.IPAddress)
-	}
-	if payload.UserAgent != nil {
-		op.SetUserAgent(*payload.UserAgent)
+	op := h.client.ResponseTag.Create()
+	op.SetName(payload.Name)
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
 	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) ResponseUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.Response.Get(ctx.Request().Context(), id)
+func (h *Handler) ResponseTagUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.ResponseTag.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload Response
+	var payload ResponseTag
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetCompleted(payload.Completed)
-	if payload.IPAddress == nil {
-		op.ClearIPAddress()
-	} else {
-		op.SetIPAddress(*payload.IPAddress)
-	}
-	if payload.UserAgent == nil {
-		op.ClearUserAgent()
-	} else {
-		op.SetUserAgent(*payload.UserAgent)
-	}
+	op.SetName(payload.Name)
+	op.SetUserID(payload.UserID)
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) ResponseDelete(ctx echo.Context, id int) error {
-	return h.client.Response.DeleteOneID(id).
+func (h *Handler) ResponseTagDelete(ctx echo.Context, id int) error {
+	return h.client.ResponseTag.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) ResponseList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) ResponseTagList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.Response.
+	res, err := h.client.ResponseTag.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(response.ByID(sql.OrderDesc())).
+		Order(responsetag.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -1363,10 +2816,9 @@ func (h *Handler) ResponseList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Submitted at",
-			"Completed",
-			"IPAddress",
-			"UserAgent",
+			"Name",
+			"User ID",
+			"Created at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -1377,10 +2829,9 @@ func (h *Handler) ResponseList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].SubmittedAt.Format(h.Config.TimeFormat),
-				fmt.Sprint(res[i].Completed),
-				res[i].IPAddress,
-				res[i].UserAgent,
+				res[i].Name,
+				fmt.Sprint(res[i].UserID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -1388,16 +2839,15 @@ func (h *Handler) ResponseList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) ResponseGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.Response.Get(ctx.Request().Context(), id)
+func (h *Handler) ResponseTagGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.ResponseTag.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("completed", fmt.Sprint(entity.Completed))
-	v.Set("IPAddress", entity.IPAddress)
-	v.Set("UserAgent", entity.UserAgent)
+	v.Set("name", entity.Name)
+	v.Set("user_id", fmt.Sprint(entity.UserID))
 	return v, err
 }
 
@@ -1442,6 +2892,25 @@ func (h *Handler) SubscriptionCreate(ctx echo.Context) error {
 	if payload.EndedAt != nil {
 		op.SetEndedAt(*payload.EndedAt)
 	}
+	op.SetCancelAtPeriodEnd(payload.CancelAtPeriodEnd)
+	if payload.PendingPriceID != nil {
+		op.SetPendingPriceID(*payload.PendingPriceID)
+	}
+	if payload.PendingChangeAt != nil {
+		op.SetPendingChangeAt(*payload.PendingChangeAt)
+	}
+	if payload.PastDueAt != nil {
+		op.SetPastDueAt(*payload.PastDueAt)
+	}
+	if payload.DunningReminders != nil {
+		op.SetDunningReminders(*payload.DunningReminders)
+	}
+	if payload.TrialRemindedAt != nil {
+		op.SetTrialRemindedAt(*payload.TrialRemindedAt)
+	}
+	if payload.LastEventAt != nil {
+		op.SetLastEventAt(*payload.LastEventAt)
+	}
 	if payload.Metadata != nil {
 		op.SetMetadata(*payload.Metadata)
 	}
@@ -1525,6 +2994,38 @@ func (h *Handler) SubscriptionUpdate(ctx echo.Context, id int) error {
 	} else {
 		op.SetEndedAt(*payload.EndedAt)
 	}
+	op.SetCancelAtPeriodEnd(payload.CancelAtPeriodEnd)
+	if payload.PendingPriceID == nil {
+		op.ClearPendingPriceID()
+	} else {
+		op.SetPendingPriceID(*payload.PendingPriceID)
+	}
+	if payload.PendingChangeAt == nil {
+		op.ClearPendingChangeAt()
+	} else {
+		op.SetPendingChangeAt(*payload.PendingChangeAt)
+	}
+	if payload.PastDueAt == nil {
+		op.ClearPastDueAt()
+	} else {
+		op.SetPastDueAt(*payload.PastDueAt)
+	}
+	if payload.DunningReminders == nil {
+		var empty int
+		op.SetDunningReminders(empty)
+	} else {
+		op.SetDunningReminders(*payload.DunningReminders)
+	}
+	if payload.TrialRemindedAt == nil {
+		op.ClearTrialRemindedAt()
+	} else {
+		op.SetTrialRemindedAt(*payload.TrialRemindedAt)
+	}
+	if payload.LastEventAt == nil {
+		op.ClearLastEventAt()
+	} else {
+		op.SetLastEventAt(*payload.LastEventAt)
+	}
 	if payload.Metadata == nil {
 		op.ClearMetadata()
 	} else {
@@ -1574,6 +3075,13 @@ func (h *Handler) SubscriptionList(ctx echo.Context) (*EntityList, error) {
 			"Trial end",
 			"Canceled at",
 			"Ended at",
+			"Cancel at period end",
+			"Pending price ID",
+			"Pending change at",
+			"Past due at",
+			"Dunning reminders",
+			"Trial reminded at",
+			"Last event at",
 			"Metadata",
 			"Created at",
 			"Updated at",
@@ -1601,6 +3109,13 @@ func (h *Handler) SubscriptionList(ctx echo.Context) (*EntityList, error) {
 				res[i].TrialEnd.Format(h.Config.TimeFormat),
 				res[i].CanceledAt.Format(h.Config.TimeFormat),
 				res[i].EndedAt.Format(h.Config.TimeFormat),
+				fmt.Sprint(res[i].CancelAtPeriodEnd),
+				res[i].PendingPriceID,
+				res[i].PendingChangeAt.Format(h.Config.TimeFormat),
+				res[i].PastDueAt.Format(h.Config.TimeFormat),
+				fmt.Sprint(res[i].DunningReminders),
+				res[i].TrialRemindedAt.Format(h.Config.TimeFormat),
+				res[i].LastEventAt.Format(h.Config.TimeFormat),
 				fmt.Sprint(res[i].Metadata),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 				res[i].UpdatedAt.Format(h.Config.TimeFormat),
@@ -1632,11 +3147,160 @@ func (h *Handler) SubscriptionGet(ctx echo.Context, id int) (url.Values, error)
 	v.Set("trial_end", entity.TrialEnd.Format(dateTimeFormat))
 	v.Set("canceled_at", entity.CanceledAt.Format(dateTimeFormat))
 	v.Set("ended_at", entity.EndedAt.Format(dateTimeFormat))
+	v.Set("cancel_at_period_end", fmt.Sprint(entity.CancelAtPeriodEnd))
+	v.Set("pending_price_id", entity.PendingPriceID)
+	v.Set("pending_change_at", entity.PendingChangeAt.Format(dateTimeFormat))
+	v.Set("past_due_at", entity.PastDueAt.Format(dateTimeFormat))
+	v.Set("dunning_reminders", fmt.Sprint(entity.DunningReminders))
+	v.Set("trial_reminded_at", entity.TrialRemindedAt.Format(dateTimeFormat))
+	v.Set("last_event_at", entity.LastEventAt.Format(dateTimeFormat))
 	v.Set("metadata", fmt.Sprint(entity.Metadata))
 	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
 	return v, err
 }
 
+func (h *Handler) UsageCreate(ctx echo.Context) error {
+	var payload Usage
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Usage.Create()
+	op.SetUserID(payload.UserID)
+	op.SetPeriodStart(payload.PeriodStart)
+	op.SetPeriodEnd(payload.PeriodEnd)
+	if payload.Responses != nil {
+		op.SetResponses(*payload.Responses)
+	}
+	if payload.Forms != nil {
+		op.SetForms(*payload.Forms)
+	}
+	if payload.StorageBytes != nil {
+		op.SetStorageBytes(*payload.StorageBytes)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) UsageUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Usage.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Usage
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetUserID(payload.UserID)
+	op.SetPeriodStart(payload.PeriodStart)
+	op.SetPeriodEnd(payload.PeriodEnd)
+	if payload.Responses == nil {
+		var empty int64
+		op.SetResponses(empty)
+	} else {
+		op.SetResponses(*payload.Responses)
+	}
+	if payload.Forms == nil {
+		var empty int64
+		op.SetForms(empty)
+	} else {
+		op.SetForms(*payload.Forms)
+	}
+	if payload.StorageBytes == nil {
+		var empty int64
+		op.SetStorageBytes(empty)
+	} else {
+		op.SetStorageBytes(*payload.StorageBytes)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) UsageDelete(ctx echo.Context, id int) error {
+	return h.client.Usage.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) UsageList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Usage.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(usage.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"User ID",
+			"Period start",
+			"Period end",
+			"Responses",
+			"Forms",
+			"Storage bytes",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].UserID),
+				res[i].PeriodStart.Format(h.Config.TimeFormat),
+				res[i].PeriodEnd.Format(h.Config.TimeFormat),
+				fmt.Sprint(res[i].Responses),
+				fmt.Sprint(res[i].Forms),
+				fmt.Sprint(res[i].StorageBytes),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) UsageGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Usage.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("period_start", entity.PeriodStart.Format(dateTimeFormat))
+	v.Set("period_end", entity.PeriodEnd.Format(dateTimeFormat))
+	v.Set("responses", fmt.Sprint(entity.Responses))
+	v.Set("forms", fmt.Sprint(entity.Forms))
+	v.Set("storage_bytes", fmt.Sprint(entity.StorageBytes))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
 func (h *Handler) UserCreate(ctx echo.Context) error {
 	var payload User
 	if err := h.bind(ctx, &payload); err != nil {
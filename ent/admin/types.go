@@ -4,11 +4,16 @@ package admin
 import (
 	"time"
 
+	"github.com/occult/pagode/ent/discount"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/job"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
 	"github.com/occult/pagode/ent/question"
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
 	"github.com/occult/pagode/ent/subscription"
 	"github.com/occult/pagode/ent/user"
 )
@@ -18,26 +23,84 @@ type Answer struct {
 	CreatedAt *time.Time `form:"created_at"`
 }
 
+type Discount struct {
+	ProviderDiscountID      string            `form:"provider_discount_id"`
+	Provider                *string           `form:"provider"`
+	ProviderCouponID        string            `form:"provider_coupon_id"`
+	ProviderPromotionCodeID *string           `form:"provider_promotion_code_id"`
+	Code                    *string           `form:"code"`
+	PercentOff              *float64          `form:"percent_off"`
+	AmountOff               *int64            `form:"amount_off"`
+	Currency                *string           `form:"currency"`
+	Duration                discount.Duration `form:"duration"`
+	DurationInMonths        *int              `form:"duration_in_months"`
+	StartsAt                *time.Time        `form:"starts_at"`
+	EndsAt                  *time.Time        `form:"ends_at"`
+	CreatedAt               *time.Time        `form:"created_at"`
+}
+
 type Form struct {
-	Title       string            `form:"title"`
-	Description *string           `form:"description"`
-	Published   bool              `form:"published"`
-	Slug        string            `form:"slug"`
-	DisplayMode *form.DisplayMode `form:"display_mode"`
-	UserID      int               `form:"user_id"`
-	CreatedAt   *time.Time        `form:"created_at"`
-	UpdatedAt   *time.Time        `form:"updated_at"`
+	Title            string                `form:"title"`
+	Description      *string               `form:"description"`
+	Published        bool                  `form:"published"`
+	Slug             string                `form:"slug"`
+	DisplayMode      *form.DisplayMode     `form:"display_mode"`
+	MetadataStorage  *form.MetadataStorage `form:"metadata_storage"`
+	RetentionDays    *int                  `form:"retention_days"`
+	NotifyOnResponse bool                  `form:"notify_on_response"`
+	UserID           int                   `form:"user_id"`
+	CreatedAt        *time.Time            `form:"created_at"`
+	UpdatedAt        *time.Time            `form:"updated_at"`
+}
+
+type Invoice struct {
+	ProviderInvoiceID      string          `form:"provider_invoice_id"`
+	Provider               *string         `form:"provider"`
+	ProviderSubscriptionID *string         `form:"provider_subscription_id"`
+	Number                 *string         `form:"number"`
+	Status                 *invoice.Status `form:"status"`
+	Currency               *string         `form:"currency"`
+	Subtotal               *int64          `form:"subtotal"`
+	Tax                    *int64          `form:"tax"`
+	Total                  *int64          `form:"total"`
+	AmountDue              *int64          `form:"amount_due"`
+	AmountPaid             *int64          `form:"amount_paid"`
+	HostedLink             *string         `form:"hosted_link"`
+	PdfLink                *string         `form:"pdf_link"`
+	PeriodStart            *time.Time      `form:"period_start"`
+	PeriodEnd              *time.Time      `form:"period_end"`
+	PaidAt                 *time.Time      `form:"paid_at"`
+	IssuedAt               *time.Time      `form:"issued_at"`
+	CreatedAt              *time.Time      `form:"created_at"`
+	UpdatedAt              *time.Time      `form:"updated_at"`
 }
 
 type Job struct {
-	Queue       string                 `form:"queue"`
-	Payload     map[string]interface{} `form:"payload"`
-	Attempts    *int                   `form:"attempts"`
-	MaxAttempts *int                   `form:"max_attempts"`
-	Status      *job.Status            `form:"status"`
-	Error       *string                `form:"error"`
-	CreatedAt   *time.Time             `form:"created_at"`
-	ProcessedAt *time.Time             `form:"processed_at"`
+	Queue        string                 `form:"queue"`
+	Payload      map[string]interface{} `form:"payload"`
+	Attempts     *int                   `form:"attempts"`
+	MaxAttempts  *int                   `form:"max_attempts"`
+	Status       *job.Status            `form:"status"`
+	Priority     *int                   `form:"priority"`
+	RunAt        *time.Time             `form:"run_at"`
+	ScheduledFor *time.Time             `form:"scheduled_for"`
+	UniqueKey    *string                `form:"unique_key"`
+	UniqueLock   *string                `form:"unique_lock"`
+	Error        *string                `form:"error"`
+	CreatedAt    *time.Time             `form:"created_at"`
+	ProcessedAt  *time.Time             `form:"processed_at"`
+	LockedBy     *string                `form:"locked_by"`
+	LockedUntil  *time.Time             `form:"locked_until"`
+}
+
+type JobAttempt struct {
+	JobID      int       `form:"job_id"`
+	Attempt    int       `form:"attempt"`
+	Worker     string    `form:"worker"`
+	StartedAt  time.Time `form:"started_at"`
+	FinishedAt time.Time `form:"finished_at"`
+	DurationMs int64     `form:"duration_ms"`
+	Error      *string   `form:"error"`
 }
 
 type PasswordToken struct {
@@ -46,6 +109,15 @@ type PasswordToken struct {
 	CreatedAt *time.Time `form:"created_at"`
 }
 
+type PaymentAccount struct {
+	ProviderAccountID string     `form:"provider_account_id"`
+	Provider          *string    `form:"provider"`
+	ChargesEnabled    bool       `form:"charges_enabled"`
+	DetailsSubmitted  bool       `form:"details_submitted"`
+	CreatedAt         *time.Time `form:"created_at"`
+	UpdatedAt         *time.Time `form:"updated_at"`
+}
+
 type PaymentCustomer struct {
 	ProviderCustomerID string                  `form:"provider_customer_id"`
 	Provider           *string                 `form:"provider"`
@@ -61,9 +133,11 @@ type PaymentIntent struct {
 	Provider                *string                 `form:"provider"`
 	Status                  *paymentintent.Status   `form:"status"`
 	Amount                  int64                   `form:"amount"`
+	AmountRefunded          *int64                  `form:"amount_refunded"`
 	Currency                *string                 `form:"currency"`
 	Description             *string                 `form:"description"`
 	ClientSecret            *string                 `form:"client_secret"`
+	ProviderAccountID       *string                 `form:"provider_account_id"`
 	Metadata                *map[string]interface{} `form:"metadata"`
 	CreatedAt               *time.Time              `form:"created_at"`
 	UpdatedAt               *time.Time              `form:"updated_at"`
@@ -83,12 +157,20 @@ type PaymentMethod struct {
 	UpdatedAt               *time.Time              `form:"updated_at"`
 }
 
+type ProcessedEvent struct {
+	Provider  *string    `form:"provider"`
+	EventID   string     `form:"event_id"`
+	Type      string     `form:"type"`
+	CreatedAt *time.Time `form:"created_at"`
+}
+
 type Question struct {
 	Type        *question.Type          `form:"type"`
 	Title       string                  `form:"title"`
 	Description *string                 `form:"description"`
 	Placeholder *string                 `form:"placeholder"`
 	Required    bool                    `form:"required"`
+	Sensitive   bool                    `form:"sensitive"`
 	Order       *int                    `form:"order"`
 	Options     *map[string]interface{} `form:"options"`
 	Validation  *map[string]interface{} `form:"validation"`
@@ -96,11 +178,40 @@ type Question struct {
 	UpdatedAt   *time.Time              `form:"updated_at"`
 }
 
+type ReportSubscription struct {
+	Frequency       *reportsubscription.Frequency `form:"frequency"`
+	Timezone        *string                       `form:"timezone"`
+	UserID          int                           `form:"user_id"`
+	FormID          *int                          `form:"form_id"`
+	LastPeriodStart *time.Time                    `form:"last_period_start"`
+	CreatedAt       *time.Time                    `form:"created_at"`
+}
+
 type Response struct {
-	SubmittedAt *time.Time `form:"submitted_at"`
-	Completed   bool       `form:"completed"`
-	IPAddress   *string    `form:"IPAddress"`
-	UserAgent   *string    `form:"UserAgent"`
+	SubmittedAt *time.Time       `form:"submitted_at"`
+	Completed   bool             `form:"completed"`
+	IPAddress   *string          `form:"IPAddress"`
+	UserAgent   *string          `form:"UserAgent"`
+	Status      *response.Status `form:"status"`
+}
+
+type ResponseActivity struct {
+	Action    responseactivity.Action `form:"action"`
+	Changes   *map[string]interface{} `form:"changes"`
+	UserID    int                     `form:"user_id"`
+	CreatedAt *time.Time              `form:"created_at"`
+}
+
+type ResponseNote struct {
+	Body      string     `form:"body"`
+	UserID    int        `form:"user_id"`
+	CreatedAt *time.Time `form:"created_at"`
+}
+
+type ResponseTag struct {
+	Name      string     `form:"name"`
+	UserID    int        `form:"user_id"`
+	CreatedAt *time.Time `form:"created_at"`
 }
 
 type Subscription struct {
@@ -118,11 +229,29 @@ type Subscription struct {
 	TrialEnd               *time.Time              `form:"trial_end"`
 	CanceledAt             *time.Time              `form:"canceled_at"`
 	EndedAt                *time.Time              `form:"ended_at"`
+	CancelAtPeriodEnd      bool                    `form:"cancel_at_period_end"`
+	PendingPriceID         *string                 `form:"pending_price_id"`
+	PendingChangeAt        *time.Time              `form:"pending_change_at"`
+	PastDueAt              *time.Time              `form:"past_due_at"`
+	DunningReminders       *int                    `form:"dunning_reminders"`
+	TrialRemindedAt        *time.Time              `form:"trial_reminded_at"`
+	LastEventAt            *time.Time              `form:"last_event_at"`
 	Metadata               *map[string]interface{} `form:"metadata"`
 	CreatedAt              *time.Time              `form:"created_at"`
 	UpdatedAt              *time.Time              `form:"updated_at"`
 }
 
+type Usage struct {
+	UserID       int        `form:"user_id"`
+	PeriodStart  time.Time  `form:"period_start"`
+	PeriodEnd    time.Time  `form:"period_end"`
+	Responses    *int64     `form:"responses"`
+	Forms        *int64     `form:"forms"`
+	StorageBytes *int64     `form:"storage_bytes"`
+	CreatedAt    *time.Time `form:"created_at"`
+	UpdatedAt    *time.Time `form:"updated_at"`
+}
+
 type User struct {
 	Name                 string                  `form:"name"`
 	Email                string                  `form:"email"`
@@ -161,15 +290,25 @@ type HandlerConfig struct {
 func GetEntityTypeNames() []string {
 	return []string{
 		"Answer",
+		"Discount",
 		"Form",
+		"Invoice",
 		"Job",
+		"JobAttempt",
 		"PasswordToken",
+		"PaymentAccount",
 		"PaymentCustomer",
 		"PaymentIntent",
 		"PaymentMethod",
+		"ProcessedEvent",
 		"Question",
+		"ReportSubscription",
 		"Response",
+		"ResponseActivity",
+		"ResponseNote",
+		"ResponseTag",
 		"Subscription",
+		"Usage",
 		"User",
 	}
 }
@@ -16,15 +16,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/occult/pagode/ent/answer"
+	"github.com/occult/pagode/ent/discount"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
 	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/processedevent"
 	"github.com/occult/pagode/ent/question"
+	"github.com/occult/pagode/ent/reportsubscription"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
 	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/usage"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -35,24 +45,44 @@ type Client struct {
 	Schema *migrate.Schema
 	// Answer is the client for interacting with the Answer builders.
 	Answer *AnswerClient
+	// Discount is the client for interacting with the Discount builders.
+	Discount *DiscountClient
 	// Form is the client for interacting with the Form builders.
 	Form *FormClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
 	// Job is the client for interacting with the Job builders.
 	Job *JobClient
+	// JobAttempt is the client for interacting with the JobAttempt builders.
+	JobAttempt *JobAttemptClient
 	// PasswordToken is the client for interacting with the PasswordToken builders.
 	PasswordToken *PasswordTokenClient
+	// PaymentAccount is the client for interacting with the PaymentAccount builders.
+	PaymentAccount *PaymentAccountClient
 	// PaymentCustomer is the client for interacting with the PaymentCustomer builders.
 	PaymentCustomer *PaymentCustomerClient
 	// PaymentIntent is the client for interacting with the PaymentIntent builders.
 	PaymentIntent *PaymentIntentClient
 	// PaymentMethod is the client for interacting with the PaymentMethod builders.
 	PaymentMethod *PaymentMethodClient
+	// ProcessedEvent is the client for interacting with the ProcessedEvent builders.
+	ProcessedEvent *ProcessedEventClient
 	// Question is the client for interacting with the Question builders.
 	Question *QuestionClient
+	// ReportSubscription is the client for interacting with the ReportSubscription builders.
+	ReportSubscription *ReportSubscriptionClient
 	// Response is the client for interacting with the Response builders.
 	Response *ResponseClient
+	// ResponseActivity is the client for interacting with the ResponseActivity builders.
+	ResponseActivity *ResponseActivityClient
+	// ResponseNote is the client for interacting with the ResponseNote builders.
+	ResponseNote *ResponseNoteClient
+	// ResponseTag is the client for interacting with the ResponseTag builders.
+	ResponseTag *ResponseTagClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
+	// Usage is the client for interacting with the Usage builders.
+	Usage *UsageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -67,15 +97,25 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Answer = NewAnswerClient(c.config)
+	c.Discount = NewDiscountClient(c.config)
 	c.Form = NewFormClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
 	c.Job = NewJobClient(c.config)
+	c.JobAttempt = NewJobAttemptClient(c.config)
 	c.PasswordToken = NewPasswordTokenClient(c.config)
+	c.PaymentAccount = NewPaymentAccountClient(c.config)
 	c.PaymentCustomer = NewPaymentCustomerClient(c.config)
 	c.PaymentIntent = NewPaymentIntentClient(c.config)
 	c.PaymentMethod = NewPaymentMethodClient(c.config)
+	c.ProcessedEvent = NewProcessedEventClient(c.config)
 	c.Question = NewQuestionClient(c.config)
+	c.ReportSubscription = NewReportSubscriptionClient(c.config)
 	c.Response = NewResponseClient(c.config)
+	c.ResponseActivity = NewResponseActivityClient(c.config)
+	c.ResponseNote = NewResponseNoteClient(c.config)
+	c.ResponseTag = NewResponseTagClient(c.config)
 	c.Subscription = NewSubscriptionClient(c.config)
+	c.Usage = NewUsageClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -167,19 +207,29 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		Answer:          NewAnswerClient(cfg),
-		Form:            NewFormClient(cfg),
-		Job:             NewJobClient(cfg),
-		PasswordToken:   NewPasswordTokenClient(cfg),
-		PaymentCustomer: NewPaymentCustomerClient(cfg),
-		PaymentIntent:   NewPaymentIntentClient(cfg),
-		PaymentMethod:   NewPaymentMethodClient(cfg),
-		Question:        NewQuestionClient(cfg),
-		Response:        NewResponseClient(cfg),
-		Subscription:    NewSubscriptionClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Answer:             NewAnswerClient(cfg),
+		Discount:           NewDiscountClient(cfg),
+		Form:               NewFormClient(cfg),
+		Invoice:            NewInvoiceClient(cfg),
+		Job:                NewJobClient(cfg),
+		JobAttempt:         NewJobAttemptClient(cfg),
+		PasswordToken:      NewPasswordTokenClient(cfg),
+		PaymentAccount:     NewPaymentAccountClient(cfg),
+		PaymentCustomer:    NewPaymentCustomerClient(cfg),
+		PaymentIntent:      NewPaymentIntentClient(cfg),
+		PaymentMethod:      NewPaymentMethodClient(cfg),
+		ProcessedEvent:     NewProcessedEventClient(cfg),
+		Question:           NewQuestionClient(cfg),
+		ReportSubscription: NewReportSubscriptionClient(cfg),
+		Response:           NewResponseClient(cfg),
+		ResponseActivity:   NewResponseActivityClient(cfg),
+		ResponseNote:       NewResponseNoteClient(cfg),
+		ResponseTag:        NewResponseTagClient(cfg),
+		Subscription:       NewSubscriptionClient(cfg),
+		Usage:              NewUsageClient(cfg),
+		User:               NewUserClient(cfg),
 	}, nil
 }
 
@@ -197,19 +247,29 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		Answer:          NewAnswerClient(cfg),
-		Form:            NewFormClient(cfg),
-		Job:             NewJobClient(cfg),
-		PasswordToken:   NewPasswordTokenClient(cfg),
-		PaymentCustomer: NewPaymentCustomerClient(cfg),
-		PaymentIntent:   NewPaymentIntentClient(cfg),
-		PaymentMethod:   NewPaymentMethodClient(cfg),
-		Question:        NewQuestionClient(cfg),
-		Response:        NewResponseClient(cfg),
-		Subscription:    NewSubscriptionClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Answer:             NewAnswerClient(cfg),
+		Discount:           NewDiscountClient(cfg),
+		Form:               NewFormClient(cfg),
+		Invoice:            NewInvoiceClient(cfg),
+		Job:                NewJobClient(cfg),
+		JobAttempt:         NewJobAttemptClient(cfg),
+		PasswordToken:      NewPasswordTokenClient(cfg),
+		PaymentAccount:     NewPaymentAccountClient(cfg),
+		PaymentCustomer:    NewPaymentCustomerClient(cfg),
+		PaymentIntent:      NewPaymentIntentClient(cfg),
+		PaymentMethod:      NewPaymentMethodClient(cfg),
+		ProcessedEvent:     NewProcessedEventClient(cfg),
+		Question:           NewQuestionClient(cfg),
+		ReportSubscription: NewReportSubscriptionClient(cfg),
+		Response:           NewResponseClient(cfg),
+		ResponseActivity:   NewResponseActivityClient(cfg),
+		ResponseNote:       NewResponseNoteClient(cfg),
+		ResponseTag:        NewResponseTagClient(cfg),
+		Subscription:       NewSubscriptionClient(cfg),
+		Usage:              NewUsageClient(cfg),
+		User:               NewUserClient(cfg),
 	}, nil
 }
 
@@ -239,8 +299,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Answer, c.Form, c.Job, c.PasswordToken, c.PaymentCustomer, c.PaymentIntent,
-		c.PaymentMethod, c.Question, c.Response, c.Subscription, c.User,
+		c.Answer, c.Discount, c.Form, c.Invoice, c.Job, c.JobAttempt, c.PasswordToken,
+		c.PaymentAccount, c.PaymentCustomer, c.PaymentIntent, c.PaymentMethod,
+		c.ProcessedEvent, c.Question, c.ReportSubscription, c.Response,
+		c.ResponseActivity, c.ResponseNote, c.ResponseTag, c.Subscription, c.Usage,
+		c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -250,8 +313,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Answer, c.Form, c.Job, c.PasswordToken, c.PaymentCustomer, c.PaymentIntent,
-		c.PaymentMethod, c.Question, c.Response, c.Subscription, c.User,
+		c.Answer, c.Discount, c.Form, c.Invoice, c.Job, c.JobAttempt, c.PasswordToken,
+		c.PaymentAccount, c.PaymentCustomer, c.PaymentIntent, c.PaymentMethod,
+		c.ProcessedEvent, c.Question, c.ReportSubscription, c.Response,
+		c.ResponseActivity, c.ResponseNote, c.ResponseTag, c.Subscription, c.Usage,
+		c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -262,24 +328,44 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AnswerMutation:
 		return c.Answer.mutate(ctx, m)
+	case *DiscountMutation:
+		return c.Discount.mutate(ctx, m)
 	case *FormMutation:
 		return c.Form.mutate(ctx, m)
+	case *InvoiceMutation:
+		return c.Invoice.mutate(ctx, m)
 	case *JobMutation:
 		return c.Job.mutate(ctx, m)
+	case *JobAttemptMutation:
+		return c.JobAttempt.mutate(ctx, m)
 	case *PasswordTokenMutation:
 		return c.PasswordToken.mutate(ctx, m)
+	case *PaymentAccountMutation:
+		return c.PaymentAccount.mutate(ctx, m)
 	case *PaymentCustomerMutation:
 		return c.PaymentCustomer.mutate(ctx, m)
 	case *PaymentIntentMutation:
 		return c.PaymentIntent.mutate(ctx, m)
 	case *PaymentMethodMutation:
 		return c.PaymentMethod.mutate(ctx, m)
+	case *ProcessedEventMutation:
+		return c.ProcessedEvent.mutate(ctx, m)
 	case *QuestionMutation:
 		return c.Question.mutate(ctx, m)
+	case *ReportSubscriptionMutation:
+		return c.ReportSubscription.mutate(ctx, m)
 	case *ResponseMutation:
 		return c.Response.mutate(ctx, m)
+	case *ResponseActivityMutation:
+		return c.ResponseActivity.mutate(ctx, m)
+	case *ResponseNoteMutation:
+		return c.ResponseNote.mutate(ctx, m)
+	case *ResponseTagMutation:
+		return c.ResponseTag.mutate(ctx, m)
 	case *SubscriptionMutation:
 		return c.Subscription.mutate(ctx, m)
+	case *UsageMutation:
+		return c.Usage.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -452,6 +538,155 @@ func (c *AnswerClient) mutate(ctx context.Context, m *AnswerMutation) (Value, er
 	}
 }
 
+// DiscountClient is a client for the Discount schema.
+type DiscountClient struct {
+	config
+}
+
+// NewDiscountClient returns a client for the Discount from the given config.
+func NewDiscountClient(c config) *DiscountClient {
+	return &DiscountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discount.Hooks(f(g(h())))`.
+func (c *DiscountClient) Use(hooks ...Hook) {
+	c.hooks.Discount = append(c.hooks.Discount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discount.Intercept(f(g(h())))`.
+func (c *DiscountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Discount = append(c.inters.Discount, interceptors...)
+}
+
+// Create returns a builder for creating a Discount entity.
+func (c *DiscountClient) Create() *DiscountCreate {
+	mutation := newDiscountMutation(c.config, OpCreate)
+	return &DiscountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Discount entities.
+func (c *DiscountClient) CreateBulk(builders ...*DiscountCreate) *DiscountCreateBulk {
+	return &DiscountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscountClient) MapCreateBulk(slice any, setFunc func(*DiscountCreate, int)) *DiscountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscountCreateBulk{err: fmt.Errorf("calling to DiscountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Discount.
+func (c *DiscountClient) Update() *DiscountUpdate {
+	mutation := newDiscountMutation(c.config, OpUpdate)
+	return &DiscountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscountClient) UpdateOne(d *Discount) *DiscountUpdateOne {
+	mutation := newDiscountMutation(c.config, OpUpdateOne, withDiscount(d))
+	return &DiscountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscountClient) UpdateOneID(id int) *DiscountUpdateOne {
+	mutation := newDiscountMutation(c.config, OpUpdateOne, withDiscountID(id))
+	return &DiscountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Discount.
+func (c *DiscountClient) Delete() *DiscountDelete {
+	mutation := newDiscountMutation(c.config, OpDelete)
+	return &DiscountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscountClient) DeleteOne(d *Discount) *DiscountDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscountClient) DeleteOneID(id int) *DiscountDeleteOne {
+	builder := c.Delete().Where(discount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscountDeleteOne{builder}
+}
+
+// Query returns a query builder for Discount.
+func (c *DiscountClient) Query() *DiscountQuery {
+	return &DiscountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Discount entity by its id.
+func (c *DiscountClient) Get(ctx context.Context, id int) (*Discount, error) {
+	return c.Query().Where(discount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscountClient) GetX(ctx context.Context, id int) *Discount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscription queries the subscription edge of a Discount.
+func (c *DiscountClient) QuerySubscription(d *Discount) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discount.Table, discount.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discount.SubscriptionTable, discount.SubscriptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscountClient) Hooks() []Hook {
+	return c.hooks.Discount
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscountClient) Interceptors() []Interceptor {
+	return c.inters.Discount
+}
+
+func (c *DiscountClient) mutate(ctx context.Context, m *DiscountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Discount mutation op: %q", m.Op())
+	}
+}
+
 // FormClient is a client for the Form schema.
 type FormClient struct {
 	config
@@ -633,6 +868,155 @@ func (c *FormClient) mutate(ctx context.Context, m *FormMutation) (Value, error)
 	}
 }
 
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
+	config
+}
+
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+}
+
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceClient) UpdateOne(i *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(i))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceClient) UpdateOneID(id int) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceClient) DeleteOne(i *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceClient) DeleteOneID(id int) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id int) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCustomer queries the customer edge of a Invoice.
+func (c *InvoiceClient) QueryCustomer(i *Invoice) *PaymentCustomerQuery {
+	query := (&PaymentCustomerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(paymentcustomer.Table, paymentcustomer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.CustomerTable, invoice.CustomerColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
+}
+
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invoice mutation op: %q", m.Op())
+	}
+}
+
 // JobClient is a client for the Job schema.
 type JobClient struct {
 	config
@@ -741,6 +1125,22 @@ func (c *JobClient) GetX(ctx context.Context, id int) *Job {
 	return obj
 }
 
+// QueryHistory queries the history edge of a Job.
+func (c *JobClient) QueryHistory(j *Job) *JobAttemptQuery {
+	query := (&JobAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := j.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(jobattempt.Table, jobattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, job.HistoryTable, job.HistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(j.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *JobClient) Hooks() []Hook {
 	return c.hooks.Job
@@ -766,107 +1166,107 @@ func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
 	}
 }
 
-// PasswordTokenClient is a client for the PasswordToken schema.
-type PasswordTokenClient struct {
+// JobAttemptClient is a client for the JobAttempt schema.
+type JobAttemptClient struct {
 	config
 }
 
-// NewPasswordTokenClient returns a client for the PasswordToken from the given config.
-func NewPasswordTokenClient(c config) *PasswordTokenClient {
-	return &PasswordTokenClient{config: c}
+// NewJobAttemptClient returns a client for the JobAttempt from the given config.
+func NewJobAttemptClient(c config) *JobAttemptClient {
+	return &JobAttemptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `passwordtoken.Hooks(f(g(h())))`.
-func (c *PasswordTokenClient) Use(hooks ...Hook) {
-	c.hooks.PasswordToken = append(c.hooks.PasswordToken, hooks...)
+// A call to `Use(f, g, h)` equals to `jobattempt.Hooks(f(g(h())))`.
+func (c *JobAttemptClient) Use(hooks ...Hook) {
+	c.hooks.JobAttempt = append(c.hooks.JobAttempt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `passwordtoken.Intercept(f(g(h())))`.
-func (c *PasswordTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PasswordToken = append(c.inters.PasswordToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `jobattempt.Intercept(f(g(h())))`.
+func (c *JobAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobAttempt = append(c.inters.JobAttempt, interceptors...)
 }
 
-// Create returns a builder for creating a PasswordToken entity.
-func (c *PasswordTokenClient) Create() *PasswordTokenCreate {
-	mutation := newPasswordTokenMutation(c.config, OpCreate)
-	return &PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a JobAttempt entity.
+func (c *JobAttemptClient) Create() *JobAttemptCreate {
+	mutation := newJobAttemptMutation(c.config, OpCreate)
+	return &JobAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PasswordToken entities.
-func (c *PasswordTokenClient) CreateBulk(builders ...*PasswordTokenCreate) *PasswordTokenCreateBulk {
-	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of JobAttempt entities.
+func (c *JobAttemptClient) CreateBulk(builders ...*JobAttemptCreate) *JobAttemptCreateBulk {
+	return &JobAttemptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PasswordTokenClient) MapCreateBulk(slice any, setFunc func(*PasswordTokenCreate, int)) *PasswordTokenCreateBulk {
+func (c *JobAttemptClient) MapCreateBulk(slice any, setFunc func(*JobAttemptCreate, int)) *JobAttemptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PasswordTokenCreateBulk{err: fmt.Errorf("calling to PasswordTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &JobAttemptCreateBulk{err: fmt.Errorf("calling to JobAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PasswordTokenCreate, rv.Len())
+	builders := make([]*JobAttemptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+	return &JobAttemptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PasswordToken.
-func (c *PasswordTokenClient) Update() *PasswordTokenUpdate {
-	mutation := newPasswordTokenMutation(c.config, OpUpdate)
-	return &PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for JobAttempt.
+func (c *JobAttemptClient) Update() *JobAttemptUpdate {
+	mutation := newJobAttemptMutation(c.config, OpUpdate)
+	return &JobAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PasswordTokenClient) UpdateOne(pt *PasswordToken) *PasswordTokenUpdateOne {
-	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordToken(pt))
-	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *JobAttemptClient) UpdateOne(ja *JobAttempt) *JobAttemptUpdateOne {
+	mutation := newJobAttemptMutation(c.config, OpUpdateOne, withJobAttempt(ja))
+	return &JobAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PasswordTokenClient) UpdateOneID(id int) *PasswordTokenUpdateOne {
-	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordTokenID(id))
-	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *JobAttemptClient) UpdateOneID(id int) *JobAttemptUpdateOne {
+	mutation := newJobAttemptMutation(c.config, OpUpdateOne, withJobAttemptID(id))
+	return &JobAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PasswordToken.
-func (c *PasswordTokenClient) Delete() *PasswordTokenDelete {
-	mutation := newPasswordTokenMutation(c.config, OpDelete)
-	return &PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for JobAttempt.
+func (c *JobAttemptClient) Delete() *JobAttemptDelete {
+	mutation := newJobAttemptMutation(c.config, OpDelete)
+	return &JobAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PasswordTokenClient) DeleteOne(pt *PasswordToken) *PasswordTokenDeleteOne {
-	return c.DeleteOneID(pt.ID)
+func (c *JobAttemptClient) DeleteOne(ja *JobAttempt) *JobAttemptDeleteOne {
+	return c.DeleteOneID(ja.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PasswordTokenClient) DeleteOneID(id int) *PasswordTokenDeleteOne {
-	builder := c.Delete().Where(passwordtoken.ID(id))
+func (c *JobAttemptClient) DeleteOneID(id int) *JobAttemptDeleteOne {
+	builder := c.Delete().Where(jobattempt.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PasswordTokenDeleteOne{builder}
+	return &JobAttemptDeleteOne{builder}
 }
 
-// Query returns a query builder for PasswordToken.
-func (c *PasswordTokenClient) Query() *PasswordTokenQuery {
-	return &PasswordTokenQuery{
+// Query returns a query builder for JobAttempt.
+func (c *JobAttemptClient) Query() *JobAttemptQuery {
+	return &JobAttemptQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePasswordToken},
+		ctx:    &QueryContext{Type: TypeJobAttempt},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PasswordToken entity by its id.
-func (c *PasswordTokenClient) Get(ctx context.Context, id int) (*PasswordToken, error) {
-	return c.Query().Where(passwordtoken.ID(id)).Only(ctx)
+// Get returns a JobAttempt entity by its id.
+func (c *JobAttemptClient) Get(ctx context.Context, id int) (*JobAttempt, error) {
+	return c.Query().Where(jobattempt.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
+func (c *JobAttemptClient) GetX(ctx context.Context, id int) *JobAttempt {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -874,61 +1274,359 @@ func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
 	return obj
 }
 
-// QueryUser queries the user edge of a PasswordToken.
-func (c *PasswordTokenClient) QueryUser(pt *PasswordToken) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryJob queries the job edge of a JobAttempt.
+func (c *JobAttemptClient) QueryJob(ja *JobAttempt) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pt.ID
+		id := ja.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(passwordtoken.Table, passwordtoken.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, passwordtoken.UserTable, passwordtoken.UserColumn),
+			sqlgraph.From(jobattempt.Table, jobattempt.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, jobattempt.JobTable, jobattempt.JobColumn),
 		)
-		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ja.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PasswordTokenClient) Hooks() []Hook {
-	hooks := c.hooks.PasswordToken
-	return append(hooks[:len(hooks):len(hooks)], passwordtoken.Hooks[:]...)
+func (c *JobAttemptClient) Hooks() []Hook {
+	return c.hooks.JobAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *PasswordTokenClient) Interceptors() []Interceptor {
-	return c.inters.PasswordToken
+func (c *JobAttemptClient) Interceptors() []Interceptor {
+	return c.inters.JobAttempt
 }
 
-func (c *PasswordTokenClient) mutate(ctx context.Context, m *PasswordTokenMutation) (Value, error) {
+func (c *JobAttemptClient) mutate(ctx context.Context, m *JobAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&JobAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&JobAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&JobAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&JobAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PasswordToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown JobAttempt mutation op: %q", m.Op())
 	}
 }
 
-// PaymentCustomerClient is a client for the PaymentCustomer schema.
-type PaymentCustomerClient struct {
+// PasswordTokenClient is a client for the PasswordToken schema.
+type PasswordTokenClient struct {
 	config
 }
 
-// NewPaymentCustomerClient returns a client for the PaymentCustomer from the given config.
-func NewPaymentCustomerClient(c config) *PaymentCustomerClient {
-	return &PaymentCustomerClient{config: c}
+// NewPasswordTokenClient returns a client for the PasswordToken from the given config.
+func NewPasswordTokenClient(c config) *PasswordTokenClient {
+	return &PasswordTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentcustomer.Hooks(f(g(h())))`.
-func (c *PaymentCustomerClient) Use(hooks ...Hook) {
+// A call to `Use(f, g, h)` equals to `passwordtoken.Hooks(f(g(h())))`.
+func (c *PasswordTokenClient) Use(hooks ...Hook) {
+	c.hooks.PasswordToken = append(c.hooks.PasswordToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordtoken.Intercept(f(g(h())))`.
+func (c *PasswordTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordToken = append(c.inters.PasswordToken, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordToken entity.
+func (c *PasswordTokenClient) Create() *PasswordTokenCreate {
+	mutation := newPasswordTokenMutation(c.config, OpCreate)
+	return &PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordToken entities.
+func (c *PasswordTokenClient) CreateBulk(builders ...*PasswordTokenCreate) *PasswordTokenCreateBulk {
+	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordTokenClient) MapCreateBulk(slice any, setFunc func(*PasswordTokenCreate, int)) *PasswordTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordTokenCreateBulk{err: fmt.Errorf("calling to PasswordTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordToken.
+func (c *PasswordTokenClient) Update() *PasswordTokenUpdate {
+	mutation := newPasswordTokenMutation(c.config, OpUpdate)
+	return &PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordTokenClient) UpdateOne(pt *PasswordToken) *PasswordTokenUpdateOne {
+	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordToken(pt))
+	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordTokenClient) UpdateOneID(id int) *PasswordTokenUpdateOne {
+	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordTokenID(id))
+	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordToken.
+func (c *PasswordTokenClient) Delete() *PasswordTokenDelete {
+	mutation := newPasswordTokenMutation(c.config, OpDelete)
+	return &PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordTokenClient) DeleteOne(pt *PasswordToken) *PasswordTokenDeleteOne {
+	return c.DeleteOneID(pt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordTokenClient) DeleteOneID(id int) *PasswordTokenDeleteOne {
+	builder := c.Delete().Where(passwordtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordToken.
+func (c *PasswordTokenClient) Query() *PasswordTokenQuery {
+	return &PasswordTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordToken entity by its id.
+func (c *PasswordTokenClient) Get(ctx context.Context, id int) (*PasswordToken, error) {
+	return c.Query().Where(passwordtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PasswordToken.
+func (c *PasswordTokenClient) QueryUser(pt *PasswordToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passwordtoken.Table, passwordtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, passwordtoken.UserTable, passwordtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordTokenClient) Hooks() []Hook {
+	hooks := c.hooks.PasswordToken
+	return append(hooks[:len(hooks):len(hooks)], passwordtoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordTokenClient) Interceptors() []Interceptor {
+	return c.inters.PasswordToken
+}
+
+func (c *PasswordTokenClient) mutate(ctx context.Context, m *PasswordTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordToken mutation op: %q", m.Op())
+	}
+}
+
+// PaymentAccountClient is a client for the PaymentAccount schema.
+type PaymentAccountClient struct {
+	config
+}
+
+// NewPaymentAccountClient returns a client for the PaymentAccount from the given config.
+func NewPaymentAccountClient(c config) *PaymentAccountClient {
+	return &PaymentAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentaccount.Hooks(f(g(h())))`.
+func (c *PaymentAccountClient) Use(hooks ...Hook) {
+	c.hooks.PaymentAccount = append(c.hooks.PaymentAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentaccount.Intercept(f(g(h())))`.
+func (c *PaymentAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentAccount = append(c.inters.PaymentAccount, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentAccount entity.
+func (c *PaymentAccountClient) Create() *PaymentAccountCreate {
+	mutation := newPaymentAccountMutation(c.config, OpCreate)
+	return &PaymentAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentAccount entities.
+func (c *PaymentAccountClient) CreateBulk(builders ...*PaymentAccountCreate) *PaymentAccountCreateBulk {
+	return &PaymentAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentAccountClient) MapCreateBulk(slice any, setFunc func(*PaymentAccountCreate, int)) *PaymentAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentAccountCreateBulk{err: fmt.Errorf("calling to PaymentAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentAccount.
+func (c *PaymentAccountClient) Update() *PaymentAccountUpdate {
+	mutation := newPaymentAccountMutation(c.config, OpUpdate)
+	return &PaymentAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentAccountClient) UpdateOne(pa *PaymentAccount) *PaymentAccountUpdateOne {
+	mutation := newPaymentAccountMutation(c.config, OpUpdateOne, withPaymentAccount(pa))
+	return &PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentAccountClient) UpdateOneID(id int) *PaymentAccountUpdateOne {
+	mutation := newPaymentAccountMutation(c.config, OpUpdateOne, withPaymentAccountID(id))
+	return &PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentAccount.
+func (c *PaymentAccountClient) Delete() *PaymentAccountDelete {
+	mutation := newPaymentAccountMutation(c.config, OpDelete)
+	return &PaymentAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentAccountClient) DeleteOne(pa *PaymentAccount) *PaymentAccountDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentAccountClient) DeleteOneID(id int) *PaymentAccountDeleteOne {
+	builder := c.Delete().Where(paymentaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentAccount.
+func (c *PaymentAccountClient) Query() *PaymentAccountQuery {
+	return &PaymentAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentAccount entity by its id.
+func (c *PaymentAccountClient) Get(ctx context.Context, id int) (*PaymentAccount, error) {
+	return c.Query().Where(paymentaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentAccountClient) GetX(ctx context.Context, id int) *PaymentAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PaymentAccount.
+func (c *PaymentAccountClient) QueryUser(pa *PaymentAccount) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentaccount.Table, paymentaccount.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentaccount.UserTable, paymentaccount.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentAccountClient) Hooks() []Hook {
+	return c.hooks.PaymentAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentAccountClient) Interceptors() []Interceptor {
+	return c.inters.PaymentAccount
+}
+
+func (c *PaymentAccountClient) mutate(ctx context.Context, m *PaymentAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentAccount mutation op: %q", m.Op())
+	}
+}
+
+// PaymentCustomerClient is a client for the PaymentCustomer schema.
+type PaymentCustomerClient struct {
+	config
+}
+
+// NewPaymentCustomerClient returns a client for the PaymentCustomer from the given config.
+func NewPaymentCustomerClient(c config) *PaymentCustomerClient {
+	return &PaymentCustomerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentcustomer.Hooks(f(g(h())))`.
+func (c *PaymentCustomerClient) Use(hooks ...Hook) {
 	c.hooks.PaymentCustomer = append(c.hooks.PaymentCustomer, hooks...)
 }
 
@@ -1088,6 +1786,22 @@ func (c *PaymentCustomerClient) QueryPaymentMethods(pc *PaymentCustomer) *Paymen
 	return query
 }
 
+// QueryInvoices queries the invoices edge of a PaymentCustomer.
+func (c *PaymentCustomerClient) QueryInvoices(pc *PaymentCustomer) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentcustomer.Table, paymentcustomer.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentcustomer.InvoicesTable, paymentcustomer.InvoicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PaymentCustomerClient) Hooks() []Hook {
 	return c.hooks.PaymentCustomer
@@ -1237,15 +1951,31 @@ func (c *PaymentIntentClient) QueryCustomer(pi *PaymentIntent) *PaymentCustomerQ
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *PaymentIntentClient) Hooks() []Hook {
-	return c.hooks.PaymentIntent
-}
-
-// Interceptors returns the client interceptors.
-func (c *PaymentIntentClient) Interceptors() []Interceptor {
-	return c.inters.PaymentIntent
-}
+// QueryResponse queries the response edge of a PaymentIntent.
+func (c *PaymentIntentClient) QueryResponse(pi *PaymentIntent) *ResponseQuery {
+	query := (&ResponseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentintent.Table, paymentintent.FieldID, id),
+			sqlgraph.To(response.Table, response.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, paymentintent.ResponseTable, paymentintent.ResponseColumn),
+		)
+		fromV = sqlgraph.Neighbors(pi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentIntentClient) Hooks() []Hook {
+	return c.hooks.PaymentIntent
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentIntentClient) Interceptors() []Interceptor {
+	return c.inters.PaymentIntent
+}
 
 func (c *PaymentIntentClient) mutate(ctx context.Context, m *PaymentIntentMutation) (Value, error) {
 	switch m.Op() {
@@ -1411,6 +2141,139 @@ func (c *PaymentMethodClient) mutate(ctx context.Context, m *PaymentMethodMutati
 	}
 }
 
+// ProcessedEventClient is a client for the ProcessedEvent schema.
+type ProcessedEventClient struct {
+	config
+}
+
+// NewProcessedEventClient returns a client for the ProcessedEvent from the given config.
+func NewProcessedEventClient(c config) *ProcessedEventClient {
+	return &ProcessedEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `processedevent.Hooks(f(g(h())))`.
+func (c *ProcessedEventClient) Use(hooks ...Hook) {
+	c.hooks.ProcessedEvent = append(c.hooks.ProcessedEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `processedevent.Intercept(f(g(h())))`.
+func (c *ProcessedEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProcessedEvent = append(c.inters.ProcessedEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ProcessedEvent entity.
+func (c *ProcessedEventClient) Create() *ProcessedEventCreate {
+	mutation := newProcessedEventMutation(c.config, OpCreate)
+	return &ProcessedEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProcessedEvent entities.
+func (c *ProcessedEventClient) CreateBulk(builders ...*ProcessedEventCreate) *ProcessedEventCreateBulk {
+	return &ProcessedEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProcessedEventClient) MapCreateBulk(slice any, setFunc func(*ProcessedEventCreate, int)) *ProcessedEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProcessedEventCreateBulk{err: fmt.Errorf("calling to ProcessedEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProcessedEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProcessedEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProcessedEvent.
+func (c *ProcessedEventClient) Update() *ProcessedEventUpdate {
+	mutation := newProcessedEventMutation(c.config, OpUpdate)
+	return &ProcessedEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProcessedEventClient) UpdateOne(pe *ProcessedEvent) *ProcessedEventUpdateOne {
+	mutation := newProcessedEventMutation(c.config, OpUpdateOne, withProcessedEvent(pe))
+	return &ProcessedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProcessedEventClient) UpdateOneID(id int) *ProcessedEventUpdateOne {
+	mutation := newProcessedEventMutation(c.config, OpUpdateOne, withProcessedEventID(id))
+	return &ProcessedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProcessedEvent.
+func (c *ProcessedEventClient) Delete() *ProcessedEventDelete {
+	mutation := newProcessedEventMutation(c.config, OpDelete)
+	return &ProcessedEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProcessedEventClient) DeleteOne(pe *ProcessedEvent) *ProcessedEventDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProcessedEventClient) DeleteOneID(id int) *ProcessedEventDeleteOne {
+	builder := c.Delete().Where(processedevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProcessedEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ProcessedEvent.
+func (c *ProcessedEventClient) Query() *ProcessedEventQuery {
+	return &ProcessedEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProcessedEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProcessedEvent entity by its id.
+func (c *ProcessedEventClient) Get(ctx context.Context, id int) (*ProcessedEvent, error) {
+	return c.Query().Where(processedevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProcessedEventClient) GetX(ctx context.Context, id int) *ProcessedEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProcessedEventClient) Hooks() []Hook {
+	return c.hooks.ProcessedEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProcessedEventClient) Interceptors() []Interceptor {
+	return c.inters.ProcessedEvent
+}
+
+func (c *ProcessedEventClient) mutate(ctx context.Context, m *ProcessedEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProcessedEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProcessedEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProcessedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProcessedEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProcessedEvent mutation op: %q", m.Op())
+	}
+}
+
 // QuestionClient is a client for the Question schema.
 type QuestionClient struct {
 	config
@@ -1484,34 +2347,774 @@ func (c *QuestionClient) Delete() *QuestionDelete {
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *QuestionClient) DeleteOne(q *Question) *QuestionDeleteOne {
-	return c.DeleteOneID(q.ID)
+func (c *QuestionClient) DeleteOne(q *Question) *QuestionDeleteOne {
+	return c.DeleteOneID(q.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestionClient) DeleteOneID(id int) *QuestionDeleteOne {
+	builder := c.Delete().Where(question.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionDeleteOne{builder}
+}
+
+// Query returns a query builder for Question.
+func (c *QuestionClient) Query() *QuestionQuery {
+	return &QuestionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Question entity by its id.
+func (c *QuestionClient) Get(ctx context.Context, id int) (*Question, error) {
+	return c.Query().Where(question.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionClient) GetX(ctx context.Context, id int) *Question {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryForm queries the form edge of a Question.
+func (c *QuestionClient) QueryForm(q *Question) *FormQuery {
+	query := (&FormClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(form.Table, form.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, question.FormTable, question.FormColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnswers queries the answers edge of a Question.
+func (c *QuestionClient) QueryAnswers(q *Question) *AnswerQuery {
+	query := (&AnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.AnswersTable, question.AnswersColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionClient) Hooks() []Hook {
+	return c.hooks.Question
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionClient) Interceptors() []Interceptor {
+	return c.inters.Question
+}
+
+func (c *QuestionClient) mutate(ctx context.Context, m *QuestionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Question mutation op: %q", m.Op())
+	}
+}
+
+// ReportSubscriptionClient is a client for the ReportSubscription schema.
+type ReportSubscriptionClient struct {
+	config
+}
+
+// NewReportSubscriptionClient returns a client for the ReportSubscription from the given config.
+func NewReportSubscriptionClient(c config) *ReportSubscriptionClient {
+	return &ReportSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reportsubscription.Hooks(f(g(h())))`.
+func (c *ReportSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.ReportSubscription = append(c.hooks.ReportSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reportsubscription.Intercept(f(g(h())))`.
+func (c *ReportSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReportSubscription = append(c.inters.ReportSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a ReportSubscription entity.
+func (c *ReportSubscriptionClient) Create() *ReportSubscriptionCreate {
+	mutation := newReportSubscriptionMutation(c.config, OpCreate)
+	return &ReportSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReportSubscription entities.
+func (c *ReportSubscriptionClient) CreateBulk(builders ...*ReportSubscriptionCreate) *ReportSubscriptionCreateBulk {
+	return &ReportSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReportSubscriptionClient) MapCreateBulk(slice any, setFunc func(*ReportSubscriptionCreate, int)) *ReportSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReportSubscriptionCreateBulk{err: fmt.Errorf("calling to ReportSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReportSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReportSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReportSubscription.
+func (c *ReportSubscriptionClient) Update() *ReportSubscriptionUpdate {
+	mutation := newReportSubscriptionMutation(c.config, OpUpdate)
+	return &ReportSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReportSubscriptionClient) UpdateOne(rs *ReportSubscription) *ReportSubscriptionUpdateOne {
+	mutation := newReportSubscriptionMutation(c.config, OpUpdateOne, withReportSubscription(rs))
+	return &ReportSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReportSubscriptionClient) UpdateOneID(id int) *ReportSubscriptionUpdateOne {
+	mutation := newReportSubscriptionMutation(c.config, OpUpdateOne, withReportSubscriptionID(id))
+	return &ReportSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReportSubscription.
+func (c *ReportSubscriptionClient) Delete() *ReportSubscriptionDelete {
+	mutation := newReportSubscriptionMutation(c.config, OpDelete)
+	return &ReportSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReportSubscriptionClient) DeleteOne(rs *ReportSubscription) *ReportSubscriptionDeleteOne {
+	return c.DeleteOneID(rs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReportSubscriptionClient) DeleteOneID(id int) *ReportSubscriptionDeleteOne {
+	builder := c.Delete().Where(reportsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReportSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for ReportSubscription.
+func (c *ReportSubscriptionClient) Query() *ReportSubscriptionQuery {
+	return &ReportSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReportSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReportSubscription entity by its id.
+func (c *ReportSubscriptionClient) Get(ctx context.Context, id int) (*ReportSubscription, error) {
+	return c.Query().Where(reportsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReportSubscriptionClient) GetX(ctx context.Context, id int) *ReportSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ReportSubscription.
+func (c *ReportSubscriptionClient) QueryUser(rs *ReportSubscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportsubscription.Table, reportsubscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, reportsubscription.UserTable, reportsubscription.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryForm queries the form edge of a ReportSubscription.
+func (c *ReportSubscriptionClient) QueryForm(rs *ReportSubscription) *FormQuery {
+	query := (&FormClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportsubscription.Table, reportsubscription.FieldID, id),
+			sqlgraph.To(form.Table, form.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, reportsubscription.FormTable, reportsubscription.FormColumn),
+		)
+		fromV = sqlgraph.Neighbors(rs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReportSubscriptionClient) Hooks() []Hook {
+	return c.hooks.ReportSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReportSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.ReportSubscription
+}
+
+func (c *ReportSubscriptionClient) mutate(ctx context.Context, m *ReportSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReportSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReportSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReportSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReportSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReportSubscription mutation op: %q", m.Op())
+	}
+}
+
+// ResponseClient is a client for the Response schema.
+type ResponseClient struct {
+	config
+}
+
+// NewResponseClient returns a client for the Response from the given config.
+func NewResponseClient(c config) *ResponseClient {
+	return &ResponseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `response.Hooks(f(g(h())))`.
+func (c *ResponseClient) Use(hooks ...Hook) {
+	c.hooks.Response = append(c.hooks.Response, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `response.Intercept(f(g(h())))`.
+func (c *ResponseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Response = append(c.inters.Response, interceptors...)
+}
+
+// Create returns a builder for creating a Response entity.
+func (c *ResponseClient) Create() *ResponseCreate {
+	mutation := newResponseMutation(c.config, OpCreate)
+	return &ResponseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Response entities.
+func (c *ResponseClient) CreateBulk(builders ...*ResponseCreate) *ResponseCreateBulk {
+	return &ResponseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResponseClient) MapCreateBulk(slice any, setFunc func(*ResponseCreate, int)) *ResponseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResponseCreateBulk{err: fmt.Errorf("calling to ResponseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResponseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResponseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Response.
+func (c *ResponseClient) Update() *ResponseUpdate {
+	mutation := newResponseMutation(c.config, OpUpdate)
+	return &ResponseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResponseClient) UpdateOne(r *Response) *ResponseUpdateOne {
+	mutation := newResponseMutation(c.config, OpUpdateOne, withResponse(r))
+	return &ResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResponseClient) UpdateOneID(id int) *ResponseUpdateOne {
+	mutation := newResponseMutation(c.config, OpUpdateOne, withResponseID(id))
+	return &ResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Response.
+func (c *ResponseClient) Delete() *ResponseDelete {
+	mutation := newResponseMutation(c.config, OpDelete)
+	return &ResponseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResponseClient) DeleteOne(r *Response) *ResponseDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResponseClient) DeleteOneID(id int) *ResponseDeleteOne {
+	builder := c.Delete().Where(response.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResponseDeleteOne{builder}
+}
+
+// Query returns a query builder for Response.
+func (c *ResponseClient) Query() *ResponseQuery {
+	return &ResponseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResponse},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Response entity by its id.
+func (c *ResponseClient) Get(ctx context.Context, id int) (*Response, error) {
+	return c.Query().Where(response.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResponseClient) GetX(ctx context.Context, id int) *Response {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryForm queries the form edge of a Response.
+func (c *ResponseClient) QueryForm(r *Response) *FormQuery {
+	query := (&FormClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, id),
+			sqlgraph.To(form.Table, form.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, response.FormTable, response.FormColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Response.
+func (c *ResponseClient) QueryUser(r *Response) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, response.UserTable, response.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnswers queries the answers edge of a Response.
+func (c *ResponseClient) QueryAnswers(r *Response) *AnswerQuery {
+	query := (&AnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, id),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, response.AnswersTable, response.AnswersColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotes queries the notes edge of a Response.
+func (c *ResponseClient) QueryNotes(r *Response) *ResponseNoteQuery {
+	query := (&ResponseNoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, id),
+			sqlgraph.To(responsenote.Table, responsenote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, response.NotesTable, response.NotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Response.
+func (c *ResponseClient) QueryTags(r *Response) *ResponseTagQuery {
+	query := (&ResponseTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, id),
+			sqlgraph.To(responsetag.Table, responsetag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, response.TagsTable, response.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActivities queries the activities edge of a Response.
+func (c *ResponseClient) QueryActivities(r *Response) *ResponseActivityQuery {
+	query := (&ResponseActivityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, id),
+			sqlgraph.To(responseactivity.Table, responseactivity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, response.ActivitiesTable, response.ActivitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayment queries the payment edge of a Response.
+func (c *ResponseClient) QueryPayment(r *Response) *PaymentIntentQuery {
+	query := (&PaymentIntentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, id),
+			sqlgraph.To(paymentintent.Table, paymentintent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, response.PaymentTable, response.PaymentColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResponseClient) Hooks() []Hook {
+	return c.hooks.Response
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResponseClient) Interceptors() []Interceptor {
+	return c.inters.Response
+}
+
+func (c *ResponseClient) mutate(ctx context.Context, m *ResponseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResponseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResponseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResponseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Response mutation op: %q", m.Op())
+	}
+}
+
+// ResponseActivityClient is a client for the ResponseActivity schema.
+type ResponseActivityClient struct {
+	config
+}
+
+// NewResponseActivityClient returns a client for the ResponseActivity from the given config.
+func NewResponseActivityClient(c config) *ResponseActivityClient {
+	return &ResponseActivityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `responseactivity.Hooks(f(g(h())))`.
+func (c *ResponseActivityClient) Use(hooks ...Hook) {
+	c.hooks.ResponseActivity = append(c.hooks.ResponseActivity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `responseactivity.Intercept(f(g(h())))`.
+func (c *ResponseActivityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResponseActivity = append(c.inters.ResponseActivity, interceptors...)
+}
+
+// Create returns a builder for creating a ResponseActivity entity.
+func (c *ResponseActivityClient) Create() *ResponseActivityCreate {
+	mutation := newResponseActivityMutation(c.config, OpCreate)
+	return &ResponseActivityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResponseActivity entities.
+func (c *ResponseActivityClient) CreateBulk(builders ...*ResponseActivityCreate) *ResponseActivityCreateBulk {
+	return &ResponseActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResponseActivityClient) MapCreateBulk(slice any, setFunc func(*ResponseActivityCreate, int)) *ResponseActivityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResponseActivityCreateBulk{err: fmt.Errorf("calling to ResponseActivityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResponseActivityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResponseActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResponseActivity.
+func (c *ResponseActivityClient) Update() *ResponseActivityUpdate {
+	mutation := newResponseActivityMutation(c.config, OpUpdate)
+	return &ResponseActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResponseActivityClient) UpdateOne(ra *ResponseActivity) *ResponseActivityUpdateOne {
+	mutation := newResponseActivityMutation(c.config, OpUpdateOne, withResponseActivity(ra))
+	return &ResponseActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResponseActivityClient) UpdateOneID(id int) *ResponseActivityUpdateOne {
+	mutation := newResponseActivityMutation(c.config, OpUpdateOne, withResponseActivityID(id))
+	return &ResponseActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResponseActivity.
+func (c *ResponseActivityClient) Delete() *ResponseActivityDelete {
+	mutation := newResponseActivityMutation(c.config, OpDelete)
+	return &ResponseActivityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResponseActivityClient) DeleteOne(ra *ResponseActivity) *ResponseActivityDeleteOne {
+	return c.DeleteOneID(ra.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResponseActivityClient) DeleteOneID(id int) *ResponseActivityDeleteOne {
+	builder := c.Delete().Where(responseactivity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResponseActivityDeleteOne{builder}
+}
+
+// Query returns a query builder for ResponseActivity.
+func (c *ResponseActivityClient) Query() *ResponseActivityQuery {
+	return &ResponseActivityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResponseActivity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResponseActivity entity by its id.
+func (c *ResponseActivityClient) Get(ctx context.Context, id int) (*ResponseActivity, error) {
+	return c.Query().Where(responseactivity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResponseActivityClient) GetX(ctx context.Context, id int) *ResponseActivity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResponse queries the response edge of a ResponseActivity.
+func (c *ResponseActivityClient) QueryResponse(ra *ResponseActivity) *ResponseQuery {
+	query := (&ResponseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ra.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(responseactivity.Table, responseactivity.FieldID, id),
+			sqlgraph.To(response.Table, response.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, responseactivity.ResponseTable, responseactivity.ResponseColumn),
+		)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActor queries the actor edge of a ResponseActivity.
+func (c *ResponseActivityClient) QueryActor(ra *ResponseActivity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ra.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(responseactivity.Table, responseactivity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, responseactivity.ActorTable, responseactivity.ActorColumn),
+		)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResponseActivityClient) Hooks() []Hook {
+	return c.hooks.ResponseActivity
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResponseActivityClient) Interceptors() []Interceptor {
+	return c.inters.ResponseActivity
+}
+
+func (c *ResponseActivityClient) mutate(ctx context.Context, m *ResponseActivityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResponseActivityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResponseActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResponseActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResponseActivityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResponseActivity mutation op: %q", m.Op())
+	}
+}
+
+// ResponseNoteClient is a client for the ResponseNote schema.
+type ResponseNoteClient struct {
+	config
+}
+
+// NewResponseNoteClient returns a client for the ResponseNote from the given config.
+func NewResponseNoteClient(c config) *ResponseNoteClient {
+	return &ResponseNoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `responsenote.Hooks(f(g(h())))`.
+func (c *ResponseNoteClient) Use(hooks ...Hook) {
+	c.hooks.ResponseNote = append(c.hooks.ResponseNote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `responsenote.Intercept(f(g(h())))`.
+func (c *ResponseNoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResponseNote = append(c.inters.ResponseNote, interceptors...)
+}
+
+// Create returns a builder for creating a ResponseNote entity.
+func (c *ResponseNoteClient) Create() *ResponseNoteCreate {
+	mutation := newResponseNoteMutation(c.config, OpCreate)
+	return &ResponseNoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResponseNote entities.
+func (c *ResponseNoteClient) CreateBulk(builders ...*ResponseNoteCreate) *ResponseNoteCreateBulk {
+	return &ResponseNoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResponseNoteClient) MapCreateBulk(slice any, setFunc func(*ResponseNoteCreate, int)) *ResponseNoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResponseNoteCreateBulk{err: fmt.Errorf("calling to ResponseNoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResponseNoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResponseNoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResponseNote.
+func (c *ResponseNoteClient) Update() *ResponseNoteUpdate {
+	mutation := newResponseNoteMutation(c.config, OpUpdate)
+	return &ResponseNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResponseNoteClient) UpdateOne(rn *ResponseNote) *ResponseNoteUpdateOne {
+	mutation := newResponseNoteMutation(c.config, OpUpdateOne, withResponseNote(rn))
+	return &ResponseNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResponseNoteClient) UpdateOneID(id int) *ResponseNoteUpdateOne {
+	mutation := newResponseNoteMutation(c.config, OpUpdateOne, withResponseNoteID(id))
+	return &ResponseNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResponseNote.
+func (c *ResponseNoteClient) Delete() *ResponseNoteDelete {
+	mutation := newResponseNoteMutation(c.config, OpDelete)
+	return &ResponseNoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResponseNoteClient) DeleteOne(rn *ResponseNote) *ResponseNoteDeleteOne {
+	return c.DeleteOneID(rn.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *QuestionClient) DeleteOneID(id int) *QuestionDeleteOne {
-	builder := c.Delete().Where(question.ID(id))
+func (c *ResponseNoteClient) DeleteOneID(id int) *ResponseNoteDeleteOne {
+	builder := c.Delete().Where(responsenote.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &QuestionDeleteOne{builder}
+	return &ResponseNoteDeleteOne{builder}
 }
 
-// Query returns a query builder for Question.
-func (c *QuestionClient) Query() *QuestionQuery {
-	return &QuestionQuery{
+// Query returns a query builder for ResponseNote.
+func (c *ResponseNoteClient) Query() *ResponseNoteQuery {
+	return &ResponseNoteQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeQuestion},
+		ctx:    &QueryContext{Type: TypeResponseNote},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Question entity by its id.
-func (c *QuestionClient) Get(ctx context.Context, id int) (*Question, error) {
-	return c.Query().Where(question.ID(id)).Only(ctx)
+// Get returns a ResponseNote entity by its id.
+func (c *ResponseNoteClient) Get(ctx context.Context, id int) (*ResponseNote, error) {
+	return c.Query().Where(responsenote.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *QuestionClient) GetX(ctx context.Context, id int) *Question {
+func (c *ResponseNoteClient) GetX(ctx context.Context, id int) *ResponseNote {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1519,164 +3122,164 @@ func (c *QuestionClient) GetX(ctx context.Context, id int) *Question {
 	return obj
 }
 
-// QueryForm queries the form edge of a Question.
-func (c *QuestionClient) QueryForm(q *Question) *FormQuery {
-	query := (&FormClient{config: c.config}).Query()
+// QueryResponse queries the response edge of a ResponseNote.
+func (c *ResponseNoteClient) QueryResponse(rn *ResponseNote) *ResponseQuery {
+	query := (&ResponseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := q.ID
+		id := rn.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(form.Table, form.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, question.FormTable, question.FormColumn),
+			sqlgraph.From(responsenote.Table, responsenote.FieldID, id),
+			sqlgraph.To(response.Table, response.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, responsenote.ResponseTable, responsenote.ResponseColumn),
 		)
-		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rn.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryAnswers queries the answers edge of a Question.
-func (c *QuestionClient) QueryAnswers(q *Question) *AnswerQuery {
-	query := (&AnswerClient{config: c.config}).Query()
+// QueryAuthor queries the author edge of a ResponseNote.
+func (c *ResponseNoteClient) QueryAuthor(rn *ResponseNote) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := q.ID
+		id := rn.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(question.Table, question.FieldID, id),
-			sqlgraph.To(answer.Table, answer.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, question.AnswersTable, question.AnswersColumn),
+			sqlgraph.From(responsenote.Table, responsenote.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, responsenote.AuthorTable, responsenote.AuthorColumn),
 		)
-		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rn.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *QuestionClient) Hooks() []Hook {
-	return c.hooks.Question
+func (c *ResponseNoteClient) Hooks() []Hook {
+	return c.hooks.ResponseNote
 }
 
 // Interceptors returns the client interceptors.
-func (c *QuestionClient) Interceptors() []Interceptor {
-	return c.inters.Question
+func (c *ResponseNoteClient) Interceptors() []Interceptor {
+	return c.inters.ResponseNote
 }
 
-func (c *QuestionClient) mutate(ctx context.Context, m *QuestionMutation) (Value, error) {
+func (c *ResponseNoteClient) mutate(ctx context.Context, m *ResponseNoteMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResponseNoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResponseNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResponseNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResponseNoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Question mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ResponseNote mutation op: %q", m.Op())
 	}
 }
 
-// ResponseClient is a client for the Response schema.
-type ResponseClient struct {
+// ResponseTagClient is a client for the ResponseTag schema.
+type ResponseTagClient struct {
 	config
 }
 
-// NewResponseClient returns a client for the Response from the given config.
-func NewResponseClient(c config) *ResponseClient {
-	return &ResponseClient{config: c}
+// NewResponseTagClient returns a client for the ResponseTag from the given config.
+func NewResponseTagClient(c config) *ResponseTagClient {
+	return &ResponseTagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `response.Hooks(f(g(h())))`.
-func (c *ResponseClient) Use(hooks ...Hook) {
-	c.hooks.Response = append(c.hooks.Response, hooks...)
+// A call to `Use(f, g, h)` equals to `responsetag.Hooks(f(g(h())))`.
+func (c *ResponseTagClient) Use(hooks ...Hook) {
+	c.hooks.ResponseTag = append(c.hooks.ResponseTag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `response.Intercept(f(g(h())))`.
-func (c *ResponseClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Response = append(c.inters.Response, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `responsetag.Intercept(f(g(h())))`.
+func (c *ResponseTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResponseTag = append(c.inters.ResponseTag, interceptors...)
 }
 
-// Create returns a builder for creating a Response entity.
-func (c *ResponseClient) Create() *ResponseCreate {
-	mutation := newResponseMutation(c.config, OpCreate)
-	return &ResponseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResponseTag entity.
+func (c *ResponseTagClient) Create() *ResponseTagCreate {
+	mutation := newResponseTagMutation(c.config, OpCreate)
+	return &ResponseTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Response entities.
-func (c *ResponseClient) CreateBulk(builders ...*ResponseCreate) *ResponseCreateBulk {
-	return &ResponseCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResponseTag entities.
+func (c *ResponseTagClient) CreateBulk(builders ...*ResponseTagCreate) *ResponseTagCreateBulk {
+	return &ResponseTagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResponseClient) MapCreateBulk(slice any, setFunc func(*ResponseCreate, int)) *ResponseCreateBulk {
+func (c *ResponseTagClient) MapCreateBulk(slice any, setFunc func(*ResponseTagCreate, int)) *ResponseTagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResponseCreateBulk{err: fmt.Errorf("calling to ResponseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResponseTagCreateBulk{err: fmt.Errorf("calling to ResponseTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResponseCreate, rv.Len())
+	builders := make([]*ResponseTagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResponseCreateBulk{config: c.config, builders: builders}
+	return &ResponseTagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Response.
-func (c *ResponseClient) Update() *ResponseUpdate {
-	mutation := newResponseMutation(c.config, OpUpdate)
-	return &ResponseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResponseTag.
+func (c *ResponseTagClient) Update() *ResponseTagUpdate {
+	mutation := newResponseTagMutation(c.config, OpUpdate)
+	return &ResponseTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResponseClient) UpdateOne(r *Response) *ResponseUpdateOne {
-	mutation := newResponseMutation(c.config, OpUpdateOne, withResponse(r))
-	return &ResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResponseTagClient) UpdateOne(rt *ResponseTag) *ResponseTagUpdateOne {
+	mutation := newResponseTagMutation(c.config, OpUpdateOne, withResponseTag(rt))
+	return &ResponseTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResponseClient) UpdateOneID(id int) *ResponseUpdateOne {
-	mutation := newResponseMutation(c.config, OpUpdateOne, withResponseID(id))
-	return &ResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResponseTagClient) UpdateOneID(id int) *ResponseTagUpdateOne {
+	mutation := newResponseTagMutation(c.config, OpUpdateOne, withResponseTagID(id))
+	return &ResponseTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Response.
-func (c *ResponseClient) Delete() *ResponseDelete {
-	mutation := newResponseMutation(c.config, OpDelete)
-	return &ResponseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResponseTag.
+func (c *ResponseTagClient) Delete() *ResponseTagDelete {
+	mutation := newResponseTagMutation(c.config, OpDelete)
+	return &ResponseTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResponseClient) DeleteOne(r *Response) *ResponseDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *ResponseTagClient) DeleteOne(rt *ResponseTag) *ResponseTagDeleteOne {
+	return c.DeleteOneID(rt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResponseClient) DeleteOneID(id int) *ResponseDeleteOne {
-	builder := c.Delete().Where(response.ID(id))
+func (c *ResponseTagClient) DeleteOneID(id int) *ResponseTagDeleteOne {
+	builder := c.Delete().Where(responsetag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResponseDeleteOne{builder}
+	return &ResponseTagDeleteOne{builder}
 }
 
-// Query returns a query builder for Response.
-func (c *ResponseClient) Query() *ResponseQuery {
-	return &ResponseQuery{
+// Query returns a query builder for ResponseTag.
+func (c *ResponseTagClient) Query() *ResponseTagQuery {
+	return &ResponseTagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResponse},
+		ctx:    &QueryContext{Type: TypeResponseTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Response entity by its id.
-func (c *ResponseClient) Get(ctx context.Context, id int) (*Response, error) {
-	return c.Query().Where(response.ID(id)).Only(ctx)
+// Get returns a ResponseTag entity by its id.
+func (c *ResponseTagClient) Get(ctx context.Context, id int) (*ResponseTag, error) {
+	return c.Query().Where(responsetag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResponseClient) GetX(ctx context.Context, id int) *Response {
+func (c *ResponseTagClient) GetX(ctx context.Context, id int) *ResponseTag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1684,76 +3287,60 @@ func (c *ResponseClient) GetX(ctx context.Context, id int) *Response {
 	return obj
 }
 
-// QueryForm queries the form edge of a Response.
-func (c *ResponseClient) QueryForm(r *Response) *FormQuery {
-	query := (&FormClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(response.Table, response.FieldID, id),
-			sqlgraph.To(form.Table, form.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, response.FormTable, response.FormColumn),
-		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUser queries the user edge of a Response.
-func (c *ResponseClient) QueryUser(r *Response) *UserQuery {
+// QueryOwner queries the owner edge of a ResponseTag.
+func (c *ResponseTagClient) QueryOwner(rt *ResponseTag) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := rt.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(response.Table, response.FieldID, id),
+			sqlgraph.From(responsetag.Table, responsetag.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, response.UserTable, response.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, responsetag.OwnerTable, responsetag.OwnerColumn),
 		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryAnswers queries the answers edge of a Response.
-func (c *ResponseClient) QueryAnswers(r *Response) *AnswerQuery {
-	query := (&AnswerClient{config: c.config}).Query()
+// QueryResponses queries the responses edge of a ResponseTag.
+func (c *ResponseTagClient) QueryResponses(rt *ResponseTag) *ResponseQuery {
+	query := (&ResponseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := rt.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(response.Table, response.FieldID, id),
-			sqlgraph.To(answer.Table, answer.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, response.AnswersTable, response.AnswersColumn),
+			sqlgraph.From(responsetag.Table, responsetag.FieldID, id),
+			sqlgraph.To(response.Table, response.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, responsetag.ResponsesTable, responsetag.ResponsesPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResponseClient) Hooks() []Hook {
-	return c.hooks.Response
+func (c *ResponseTagClient) Hooks() []Hook {
+	return c.hooks.ResponseTag
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResponseClient) Interceptors() []Interceptor {
-	return c.inters.Response
+func (c *ResponseTagClient) Interceptors() []Interceptor {
+	return c.inters.ResponseTag
 }
 
-func (c *ResponseClient) mutate(ctx context.Context, m *ResponseMutation) (Value, error) {
+func (c *ResponseTagClient) mutate(ctx context.Context, m *ResponseTagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResponseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResponseTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResponseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResponseTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResponseTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResponseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResponseTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Response mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ResponseTag mutation op: %q", m.Op())
 	}
 }
 
@@ -1881,6 +3468,22 @@ func (c *SubscriptionClient) QueryCustomer(s *Subscription) *PaymentCustomerQuer
 	return query
 }
 
+// QueryDiscounts queries the discounts edge of a Subscription.
+func (c *SubscriptionClient) QueryDiscounts(s *Subscription) *DiscountQuery {
+	query := (&DiscountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(discount.Table, discount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subscription.DiscountsTable, subscription.DiscountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SubscriptionClient) Hooks() []Hook {
 	return c.hooks.Subscription
@@ -1906,6 +3509,155 @@ func (c *SubscriptionClient) mutate(ctx context.Context, m *SubscriptionMutation
 	}
 }
 
+// UsageClient is a client for the Usage schema.
+type UsageClient struct {
+	config
+}
+
+// NewUsageClient returns a client for the Usage from the given config.
+func NewUsageClient(c config) *UsageClient {
+	return &UsageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usage.Hooks(f(g(h())))`.
+func (c *UsageClient) Use(hooks ...Hook) {
+	c.hooks.Usage = append(c.hooks.Usage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usage.Intercept(f(g(h())))`.
+func (c *UsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Usage = append(c.inters.Usage, interceptors...)
+}
+
+// Create returns a builder for creating a Usage entity.
+func (c *UsageClient) Create() *UsageCreate {
+	mutation := newUsageMutation(c.config, OpCreate)
+	return &UsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Usage entities.
+func (c *UsageClient) CreateBulk(builders ...*UsageCreate) *UsageCreateBulk {
+	return &UsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageClient) MapCreateBulk(slice any, setFunc func(*UsageCreate, int)) *UsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageCreateBulk{err: fmt.Errorf("calling to UsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Usage.
+func (c *UsageClient) Update() *UsageUpdate {
+	mutation := newUsageMutation(c.config, OpUpdate)
+	return &UsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageClient) UpdateOne(u *Usage) *UsageUpdateOne {
+	mutation := newUsageMutation(c.config, OpUpdateOne, withUsage(u))
+	return &UsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageClient) UpdateOneID(id int) *UsageUpdateOne {
+	mutation := newUsageMutation(c.config, OpUpdateOne, withUsageID(id))
+	return &UsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Usage.
+func (c *UsageClient) Delete() *UsageDelete {
+	mutation := newUsageMutation(c.config, OpDelete)
+	return &UsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageClient) DeleteOne(u *Usage) *UsageDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageClient) DeleteOneID(id int) *UsageDeleteOne {
+	builder := c.Delete().Where(usage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageDeleteOne{builder}
+}
+
+// Query returns a query builder for Usage.
+func (c *UsageClient) Query() *UsageQuery {
+	return &UsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Usage entity by its id.
+func (c *UsageClient) Get(ctx context.Context, id int) (*Usage, error) {
+	return c.Query().Where(usage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageClient) GetX(ctx context.Context, id int) *Usage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Usage.
+func (c *UsageClient) QueryUser(u *Usage) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usage.Table, usage.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usage.UserTable, usage.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UsageClient) Hooks() []Hook {
+	return c.hooks.Usage
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageClient) Interceptors() []Interceptor {
+	return c.inters.Usage
+}
+
+func (c *UsageClient) mutate(ctx context.Context, m *UsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Usage mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -2046,6 +3798,22 @@ func (c *UserClient) QueryPaymentCustomer(u *User) *PaymentCustomerQuery {
 	return query
 }
 
+// QueryPaymentAccount queries the payment_account edge of a User.
+func (c *UserClient) QueryPaymentAccount(u *User) *PaymentAccountQuery {
+	query := (&PaymentAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(paymentaccount.Table, paymentaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, user.PaymentAccountTable, user.PaymentAccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryForms queries the forms edge of a User.
 func (c *UserClient) QueryForms(u *User) *FormQuery {
 	query := (&FormClient{config: c.config}).Query()
@@ -2078,6 +3846,22 @@ func (c *UserClient) QueryResponses(u *User) *ResponseQuery {
 	return query
 }
 
+// QueryUsage queries the usage edge of a User.
+func (c *UserClient) QueryUsage(u *User) *UsageQuery {
+	query := (&UsageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usage.Table, usage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsageTable, user.UsageColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
@@ -2107,11 +3891,15 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Answer, Form, Job, PasswordToken, PaymentCustomer, PaymentIntent, PaymentMethod,
-		Question, Response, Subscription, User []ent.Hook
+		Answer, Discount, Form, Invoice, Job, JobAttempt, PasswordToken, PaymentAccount,
+		PaymentCustomer, PaymentIntent, PaymentMethod, ProcessedEvent, Question,
+		ReportSubscription, Response, ResponseActivity, ResponseNote, ResponseTag,
+		Subscription, Usage, User []ent.Hook
 	}
 	inters struct {
-		Answer, Form, Job, PasswordToken, PaymentCustomer, PaymentIntent, PaymentMethod,
-		Question, Response, Subscription, User []ent.Interceptor
+		Answer, Discount, Form, Invoice, Job, JobAttempt, PasswordToken, PaymentAccount,
+		PaymentCustomer, PaymentIntent, PaymentMethod, ProcessedEvent, Question,
+		ReportSubscription, Response, ResponseActivity, ResponseNote, ResponseTag,
+		Subscription, Usage, User []ent.Interceptor
 	}
 )
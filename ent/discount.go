@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/discount"
+	"github.com/occult/pagode/ent/subscription"
+)
+
+// Discount is the model entity for the Discount schema.
+type Discount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// External payment provider discount ID
+	ProviderDiscountID string `json:"provider_discount_id,omitempty"`
+	// Payment provider name
+	Provider string `json:"provider,omitempty"`
+	// External payment provider ID of the coupon the discount applies
+	ProviderCouponID string `json:"provider_coupon_id,omitempty"`
+	// External payment provider ID of the promotion code the coupon was redeemed with
+	ProviderPromotionCodeID string `json:"provider_promotion_code_id,omitempty"`
+	// Promotion code entered by the customer
+	Code string `json:"code,omitempty"`
+	// Percentage taken off, if the coupon takes a percentage off
+	PercentOff float64 `json:"percent_off,omitempty"`
+	// Amount taken off in smallest currency unit, if the coupon takes an amount off
+	AmountOff int64 `json:"amount_off,omitempty"`
+	// Three-letter ISO currency code of the amount taken off
+	Currency string `json:"currency,omitempty"`
+	// How long the discount applies for
+	Duration discount.Duration `json:"duration,omitempty"`
+	// Number of months a repeating discount applies for
+	DurationInMonths int `json:"duration_in_months,omitempty"`
+	// When the discount started to apply
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// When the discount stops applying, unset for discounts which apply once or forever
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DiscountQuery when eager-loading is set.
+	Edges                  DiscountEdges `json:"edges"`
+	subscription_discounts *int
+	selectValues           sql.SelectValues
+}
+
+// DiscountEdges holds the relations/edges for other nodes in the graph.
+type DiscountEdges struct {
+	// Subscription the discount applies to
+	Subscription *Subscription `json:"subscription,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DiscountEdges) SubscriptionOrErr() (*Subscription, error) {
+	if e.Subscription != nil {
+		return e.Subscription, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: subscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Discount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discount.FieldPercentOff:
+			values[i] = new(sql.NullFloat64)
+		case discount.FieldID, discount.FieldAmountOff, discount.FieldDurationInMonths:
+			values[i] = new(sql.NullInt64)
+		case discount.FieldProviderDiscountID, discount.FieldProvider, discount.FieldProviderCouponID, discount.FieldProviderPromotionCodeID, discount.FieldCode, discount.FieldCurrency, discount.FieldDuration:
+			values[i] = new(sql.NullString)
+		case discount.FieldStartsAt, discount.FieldEndsAt, discount.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case discount.ForeignKeys[0]: // subscription_discounts
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Discount fields.
+func (d *Discount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = int(value.Int64)
+		case discount.FieldProviderDiscountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_discount_id", values[i])
+			} else if value.Valid {
+				d.ProviderDiscountID = value.String
+			}
+		case discount.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				d.Provider = value.String
+			}
+		case discount.FieldProviderCouponID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_coupon_id", values[i])
+			} else if value.Valid {
+				d.ProviderCouponID = value.String
+			}
+		case discount.FieldProviderPromotionCodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_promotion_code_id", values[i])
+			} else if value.Valid {
+				d.ProviderPromotionCodeID = value.String
+			}
+		case discount.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				d.Code = value.String
+			}
+		case discount.FieldPercentOff:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field percent_off", values[i])
+			} else if value.Valid {
+				d.PercentOff = value.Float64
+			}
+		case discount.FieldAmountOff:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_off", values[i])
+			} else if value.Valid {
+				d.AmountOff = value.Int64
+			}
+		case discount.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				d.Currency = value.String
+			}
+		case discount.FieldDuration:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				d.Duration = discount.Duration(value.String)
+			}
+		case discount.FieldDurationInMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_in_months", values[i])
+			} else if value.Valid {
+				d.DurationInMonths = int(value.Int64)
+			}
+		case discount.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				d.StartsAt = value.Time
+			}
+		case discount.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				d.EndsAt = value.Time
+			}
+		case discount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case discount.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field subscription_discounts", value)
+			} else if value.Valid {
+				d.subscription_discounts = new(int)
+				*d.subscription_discounts = int(value.Int64)
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Discount.
+// This includes values selected through modifiers, order, etc.
+func (d *Discount) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QuerySubscription queries the "subscription" edge of the Discount entity.
+func (d *Discount) QuerySubscription() *SubscriptionQuery {
+	return NewDiscountClient(d.config).QuerySubscription(d)
+}
+
+// Update returns a builder for updating this Discount.
+// Note that you need to call Discount.Unwrap() before calling this method if this Discount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Discount) Update() *DiscountUpdateOne {
+	return NewDiscountClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Discount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Discount) Unwrap() *Discount {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Discount is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Discount) String() string {
+	var builder strings.Builder
+	builder.WriteString("Discount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("provider_discount_id=")
+	builder.WriteString(d.ProviderDiscountID)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(d.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("provider_coupon_id=")
+	builder.WriteString(d.ProviderCouponID)
+	builder.WriteString(", ")
+	builder.WriteString("provider_promotion_code_id=")
+	builder.WriteString(d.ProviderPromotionCodeID)
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(d.Code)
+	builder.WriteString(", ")
+	builder.WriteString("percent_off=")
+	builder.WriteString(fmt.Sprintf("%v", d.PercentOff))
+	builder.WriteString(", ")
+	builder.WriteString("amount_off=")
+	builder.WriteString(fmt.Sprintf("%v", d.AmountOff))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(d.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", d.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("duration_in_months=")
+	builder.WriteString(fmt.Sprintf("%v", d.DurationInMonths))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(d.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(d.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Discounts is a parsable slice of Discount.
+type Discounts []*Discount
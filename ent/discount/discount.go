@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discount
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the discount type in the database.
+	Label = "discount"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProviderDiscountID holds the string denoting the provider_discount_id field in the database.
+	FieldProviderDiscountID = "provider_discount_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldProviderCouponID holds the string denoting the provider_coupon_id field in the database.
+	FieldProviderCouponID = "provider_coupon_id"
+	// FieldProviderPromotionCodeID holds the string denoting the provider_promotion_code_id field in the database.
+	FieldProviderPromotionCodeID = "provider_promotion_code_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldPercentOff holds the string denoting the percent_off field in the database.
+	FieldPercentOff = "percent_off"
+	// FieldAmountOff holds the string denoting the amount_off field in the database.
+	FieldAmountOff = "amount_off"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
+	// FieldDurationInMonths holds the string denoting the duration_in_months field in the database.
+	FieldDurationInMonths = "duration_in_months"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeSubscription holds the string denoting the subscription edge name in mutations.
+	EdgeSubscription = "subscription"
+	// Table holds the table name of the discount in the database.
+	Table = "discounts"
+	// SubscriptionTable is the table that holds the subscription relation/edge.
+	SubscriptionTable = "discounts"
+	// SubscriptionInverseTable is the table name for the Subscription entity.
+	// It exists in this package in order to avoid circular dependency with the "subscription" package.
+	SubscriptionInverseTable = "subscriptions"
+	// SubscriptionColumn is the table column denoting the subscription relation/edge.
+	SubscriptionColumn = "subscription_discounts"
+)
+
+// Columns holds all SQL columns for discount fields.
+var Columns = []string{
+	FieldID,
+	FieldProviderDiscountID,
+	FieldProvider,
+	FieldProviderCouponID,
+	FieldProviderPromotionCodeID,
+	FieldCode,
+	FieldPercentOff,
+	FieldAmountOff,
+	FieldCurrency,
+	FieldDuration,
+	FieldDurationInMonths,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "discounts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"subscription_discounts",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderDiscountIDValidator is a validator for the "provider_discount_id" field. It is called by the builders before save.
+	ProviderDiscountIDValidator func(string) error
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// ProviderCouponIDValidator is a validator for the "provider_coupon_id" field. It is called by the builders before save.
+	ProviderCouponIDValidator func(string) error
+	// DefaultStartsAt holds the default value on creation for the "starts_at" field.
+	DefaultStartsAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Duration defines the type for the "duration" enum field.
+type Duration string
+
+// Duration values.
+const (
+	DurationOnce      Duration = "once"
+	DurationRepeating Duration = "repeating"
+	DurationForever   Duration = "forever"
+)
+
+func (d Duration) String() string {
+	return string(d)
+}
+
+// DurationValidator is a validator for the "duration" field enum values. It is called by the builders before save.
+func DurationValidator(d Duration) error {
+	switch d {
+	case DurationOnce, DurationRepeating, DurationForever:
+		return nil
+	default:
+		return fmt.Errorf("discount: invalid enum value for duration field: %q", d)
+	}
+}
+
+// OrderOption defines the ordering options for the Discount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProviderDiscountID orders the results by the provider_discount_id field.
+func ByProviderDiscountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderDiscountID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByProviderCouponID orders the results by the provider_coupon_id field.
+func ByProviderCouponID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderCouponID, opts...).ToFunc()
+}
+
+// ByProviderPromotionCodeID orders the results by the provider_promotion_code_id field.
+func ByProviderPromotionCodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderPromotionCodeID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByPercentOff orders the results by the percent_off field.
+func ByPercentOff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPercentOff, opts...).ToFunc()
+}
+
+// ByAmountOff orders the results by the amount_off field.
+func ByAmountOff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountOff, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByDuration orders the results by the duration field.
+func ByDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuration, opts...).ToFunc()
+}
+
+// ByDurationInMonths orders the results by the duration_in_months field.
+func ByDurationInMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationInMonths, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// BySubscriptionField orders the results by subscription field.
+func BySubscriptionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubscriptionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSubscriptionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubscriptionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+	)
+}
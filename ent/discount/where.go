@@ -0,0 +1,874 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldID, id))
+}
+
+// ProviderDiscountID applies equality check predicate on the "provider_discount_id" field. It's identical to ProviderDiscountIDEQ.
+func ProviderDiscountID(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldProviderDiscountID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderCouponID applies equality check predicate on the "provider_coupon_id" field. It's identical to ProviderCouponIDEQ.
+func ProviderCouponID(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldProviderCouponID, v))
+}
+
+// ProviderPromotionCodeID applies equality check predicate on the "provider_promotion_code_id" field. It's identical to ProviderPromotionCodeIDEQ.
+func ProviderPromotionCodeID(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldProviderPromotionCodeID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldCode, v))
+}
+
+// PercentOff applies equality check predicate on the "percent_off" field. It's identical to PercentOffEQ.
+func PercentOff(v float64) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldPercentOff, v))
+}
+
+// AmountOff applies equality check predicate on the "amount_off" field. It's identical to AmountOffEQ.
+func AmountOff(v int64) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldAmountOff, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldCurrency, v))
+}
+
+// DurationInMonths applies equality check predicate on the "duration_in_months" field. It's identical to DurationInMonthsEQ.
+func DurationInMonths(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldDurationInMonths, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProviderDiscountIDEQ applies the EQ predicate on the "provider_discount_id" field.
+func ProviderDiscountIDEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDNEQ applies the NEQ predicate on the "provider_discount_id" field.
+func ProviderDiscountIDNEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDIn applies the In predicate on the "provider_discount_id" field.
+func ProviderDiscountIDIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldProviderDiscountID, vs...))
+}
+
+// ProviderDiscountIDNotIn applies the NotIn predicate on the "provider_discount_id" field.
+func ProviderDiscountIDNotIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldProviderDiscountID, vs...))
+}
+
+// ProviderDiscountIDGT applies the GT predicate on the "provider_discount_id" field.
+func ProviderDiscountIDGT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDGTE applies the GTE predicate on the "provider_discount_id" field.
+func ProviderDiscountIDGTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDLT applies the LT predicate on the "provider_discount_id" field.
+func ProviderDiscountIDLT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDLTE applies the LTE predicate on the "provider_discount_id" field.
+func ProviderDiscountIDLTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDContains applies the Contains predicate on the "provider_discount_id" field.
+func ProviderDiscountIDContains(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContains(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDHasPrefix applies the HasPrefix predicate on the "provider_discount_id" field.
+func ProviderDiscountIDHasPrefix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasPrefix(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDHasSuffix applies the HasSuffix predicate on the "provider_discount_id" field.
+func ProviderDiscountIDHasSuffix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasSuffix(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDEqualFold applies the EqualFold predicate on the "provider_discount_id" field.
+func ProviderDiscountIDEqualFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEqualFold(FieldProviderDiscountID, v))
+}
+
+// ProviderDiscountIDContainsFold applies the ContainsFold predicate on the "provider_discount_id" field.
+func ProviderDiscountIDContainsFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContainsFold(FieldProviderDiscountID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ProviderCouponIDEQ applies the EQ predicate on the "provider_coupon_id" field.
+func ProviderCouponIDEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDNEQ applies the NEQ predicate on the "provider_coupon_id" field.
+func ProviderCouponIDNEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDIn applies the In predicate on the "provider_coupon_id" field.
+func ProviderCouponIDIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldProviderCouponID, vs...))
+}
+
+// ProviderCouponIDNotIn applies the NotIn predicate on the "provider_coupon_id" field.
+func ProviderCouponIDNotIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldProviderCouponID, vs...))
+}
+
+// ProviderCouponIDGT applies the GT predicate on the "provider_coupon_id" field.
+func ProviderCouponIDGT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDGTE applies the GTE predicate on the "provider_coupon_id" field.
+func ProviderCouponIDGTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDLT applies the LT predicate on the "provider_coupon_id" field.
+func ProviderCouponIDLT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDLTE applies the LTE predicate on the "provider_coupon_id" field.
+func ProviderCouponIDLTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDContains applies the Contains predicate on the "provider_coupon_id" field.
+func ProviderCouponIDContains(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContains(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDHasPrefix applies the HasPrefix predicate on the "provider_coupon_id" field.
+func ProviderCouponIDHasPrefix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasPrefix(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDHasSuffix applies the HasSuffix predicate on the "provider_coupon_id" field.
+func ProviderCouponIDHasSuffix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasSuffix(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDEqualFold applies the EqualFold predicate on the "provider_coupon_id" field.
+func ProviderCouponIDEqualFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEqualFold(FieldProviderCouponID, v))
+}
+
+// ProviderCouponIDContainsFold applies the ContainsFold predicate on the "provider_coupon_id" field.
+func ProviderCouponIDContainsFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContainsFold(FieldProviderCouponID, v))
+}
+
+// ProviderPromotionCodeIDEQ applies the EQ predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDNEQ applies the NEQ predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDNEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDIn applies the In predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldProviderPromotionCodeID, vs...))
+}
+
+// ProviderPromotionCodeIDNotIn applies the NotIn predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDNotIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldProviderPromotionCodeID, vs...))
+}
+
+// ProviderPromotionCodeIDGT applies the GT predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDGT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDGTE applies the GTE predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDGTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDLT applies the LT predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDLT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDLTE applies the LTE predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDLTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDContains applies the Contains predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDContains(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContains(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDHasPrefix applies the HasPrefix predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDHasPrefix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasPrefix(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDHasSuffix applies the HasSuffix predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDHasSuffix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasSuffix(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDIsNil applies the IsNil predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDIsNil() predicate.Discount {
+	return predicate.Discount(sql.FieldIsNull(FieldProviderPromotionCodeID))
+}
+
+// ProviderPromotionCodeIDNotNil applies the NotNil predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDNotNil() predicate.Discount {
+	return predicate.Discount(sql.FieldNotNull(FieldProviderPromotionCodeID))
+}
+
+// ProviderPromotionCodeIDEqualFold applies the EqualFold predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDEqualFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEqualFold(FieldProviderPromotionCodeID, v))
+}
+
+// ProviderPromotionCodeIDContainsFold applies the ContainsFold predicate on the "provider_promotion_code_id" field.
+func ProviderPromotionCodeIDContainsFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContainsFold(FieldProviderPromotionCodeID, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeIsNil applies the IsNil predicate on the "code" field.
+func CodeIsNil() predicate.Discount {
+	return predicate.Discount(sql.FieldIsNull(FieldCode))
+}
+
+// CodeNotNil applies the NotNil predicate on the "code" field.
+func CodeNotNil() predicate.Discount {
+	return predicate.Discount(sql.FieldNotNull(FieldCode))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContainsFold(FieldCode, v))
+}
+
+// PercentOffEQ applies the EQ predicate on the "percent_off" field.
+func PercentOffEQ(v float64) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldPercentOff, v))
+}
+
+// PercentOffNEQ applies the NEQ predicate on the "percent_off" field.
+func PercentOffNEQ(v float64) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldPercentOff, v))
+}
+
+// PercentOffIn applies the In predicate on the "percent_off" field.
+func PercentOffIn(vs ...float64) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldPercentOff, vs...))
+}
+
+// PercentOffNotIn applies the NotIn predicate on the "percent_off" field.
+func PercentOffNotIn(vs ...float64) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldPercentOff, vs...))
+}
+
+// PercentOffGT applies the GT predicate on the "percent_off" field.
+func PercentOffGT(v float64) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldPercentOff, v))
+}
+
+// PercentOffGTE applies the GTE predicate on the "percent_off" field.
+func PercentOffGTE(v float64) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldPercentOff, v))
+}
+
+// PercentOffLT applies the LT predicate on the "percent_off" field.
+func PercentOffLT(v float64) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldPercentOff, v))
+}
+
+// PercentOffLTE applies the LTE predicate on the "percent_off" field.
+func PercentOffLTE(v float64) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldPercentOff, v))
+}
+
+// PercentOffIsNil applies the IsNil predicate on the "percent_off" field.
+func PercentOffIsNil() predicate.Discount {
+	return predicate.Discount(sql.FieldIsNull(FieldPercentOff))
+}
+
+// PercentOffNotNil applies the NotNil predicate on the "percent_off" field.
+func PercentOffNotNil() predicate.Discount {
+	return predicate.Discount(sql.FieldNotNull(FieldPercentOff))
+}
+
+// AmountOffEQ applies the EQ predicate on the "amount_off" field.
+func AmountOffEQ(v int64) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldAmountOff, v))
+}
+
+// AmountOffNEQ applies the NEQ predicate on the "amount_off" field.
+func AmountOffNEQ(v int64) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldAmountOff, v))
+}
+
+// AmountOffIn applies the In predicate on the "amount_off" field.
+func AmountOffIn(vs ...int64) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldAmountOff, vs...))
+}
+
+// AmountOffNotIn applies the NotIn predicate on the "amount_off" field.
+func AmountOffNotIn(vs ...int64) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldAmountOff, vs...))
+}
+
+// AmountOffGT applies the GT predicate on the "amount_off" field.
+func AmountOffGT(v int64) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldAmountOff, v))
+}
+
+// AmountOffGTE applies the GTE predicate on the "amount_off" field.
+func AmountOffGTE(v int64) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldAmountOff, v))
+}
+
+// AmountOffLT applies the LT predicate on the "amount_off" field.
+func AmountOffLT(v int64) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldAmountOff, v))
+}
+
+// AmountOffLTE applies the LTE predicate on the "amount_off" field.
+func AmountOffLTE(v int64) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldAmountOff, v))
+}
+
+// AmountOffIsNil applies the IsNil predicate on the "amount_off" field.
+func AmountOffIsNil() predicate.Discount {
+	return predicate.Discount(sql.FieldIsNull(FieldAmountOff))
+}
+
+// AmountOffNotNil applies the NotNil predicate on the "amount_off" field.
+func AmountOffNotNil() predicate.Discount {
+	return predicate.Discount(sql.FieldNotNull(FieldAmountOff))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.Discount {
+	return predicate.Discount(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.Discount {
+	return predicate.Discount(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v Duration) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v Duration) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...Duration) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...Duration) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationInMonthsEQ applies the EQ predicate on the "duration_in_months" field.
+func DurationInMonthsEQ(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldDurationInMonths, v))
+}
+
+// DurationInMonthsNEQ applies the NEQ predicate on the "duration_in_months" field.
+func DurationInMonthsNEQ(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldDurationInMonths, v))
+}
+
+// DurationInMonthsIn applies the In predicate on the "duration_in_months" field.
+func DurationInMonthsIn(vs ...int) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldDurationInMonths, vs...))
+}
+
+// DurationInMonthsNotIn applies the NotIn predicate on the "duration_in_months" field.
+func DurationInMonthsNotIn(vs ...int) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldDurationInMonths, vs...))
+}
+
+// DurationInMonthsGT applies the GT predicate on the "duration_in_months" field.
+func DurationInMonthsGT(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldDurationInMonths, v))
+}
+
+// DurationInMonthsGTE applies the GTE predicate on the "duration_in_months" field.
+func DurationInMonthsGTE(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldDurationInMonths, v))
+}
+
+// DurationInMonthsLT applies the LT predicate on the "duration_in_months" field.
+func DurationInMonthsLT(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldDurationInMonths, v))
+}
+
+// DurationInMonthsLTE applies the LTE predicate on the "duration_in_months" field.
+func DurationInMonthsLTE(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldDurationInMonths, v))
+}
+
+// DurationInMonthsIsNil applies the IsNil predicate on the "duration_in_months" field.
+func DurationInMonthsIsNil() predicate.Discount {
+	return predicate.Discount(sql.FieldIsNull(FieldDurationInMonths))
+}
+
+// DurationInMonthsNotNil applies the NotNil predicate on the "duration_in_months" field.
+func DurationInMonthsNotNil() predicate.Discount {
+	return predicate.Discount(sql.FieldNotNull(FieldDurationInMonths))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.Discount {
+	return predicate.Discount(sql.FieldIsNull(FieldEndsAt))
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.Discount {
+	return predicate.Discount(sql.FieldNotNull(FieldEndsAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasSubscription applies the HasEdge predicate on the "subscription" edge.
+func HasSubscription() predicate.Discount {
+	return predicate.Discount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionWith applies the HasEdge predicate on the "subscription" edge with a given conditions (other predicates).
+func HasSubscriptionWith(preds ...predicate.Subscription) predicate.Discount {
+	return predicate.Discount(func(s *sql.Selector) {
+		step := newSubscriptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Discount) predicate.Discount {
+	return predicate.Discount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Discount) predicate.Discount {
+	return predicate.Discount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Discount) predicate.Discount {
+	return predicate.Discount(sql.NotPredicates(p))
+}
@@ -0,0 +1,1392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/discount"
+	"github.com/occult/pagode/ent/subscription"
+)
+
+// DiscountCreate is the builder for creating a Discount entity.
+type DiscountCreate struct {
+	config
+	mutation *DiscountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProviderDiscountID sets the "provider_discount_id" field.
+func (dc *DiscountCreate) SetProviderDiscountID(s string) *DiscountCreate {
+	dc.mutation.SetProviderDiscountID(s)
+	return dc
+}
+
+// SetProvider sets the "provider" field.
+func (dc *DiscountCreate) SetProvider(s string) *DiscountCreate {
+	dc.mutation.SetProvider(s)
+	return dc
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableProvider(s *string) *DiscountCreate {
+	if s != nil {
+		dc.SetProvider(*s)
+	}
+	return dc
+}
+
+// SetProviderCouponID sets the "provider_coupon_id" field.
+func (dc *DiscountCreate) SetProviderCouponID(s string) *DiscountCreate {
+	dc.mutation.SetProviderCouponID(s)
+	return dc
+}
+
+// SetProviderPromotionCodeID sets the "provider_promotion_code_id" field.
+func (dc *DiscountCreate) SetProviderPromotionCodeID(s string) *DiscountCreate {
+	dc.mutation.SetProviderPromotionCodeID(s)
+	return dc
+}
+
+// SetNillableProviderPromotionCodeID sets the "provider_promotion_code_id" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableProviderPromotionCodeID(s *string) *DiscountCreate {
+	if s != nil {
+		dc.SetProviderPromotionCodeID(*s)
+	}
+	return dc
+}
+
+// SetCode sets the "code" field.
+func (dc *DiscountCreate) SetCode(s string) *DiscountCreate {
+	dc.mutation.SetCode(s)
+	return dc
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableCode(s *string) *DiscountCreate {
+	if s != nil {
+		dc.SetCode(*s)
+	}
+	return dc
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (dc *DiscountCreate) SetPercentOff(f float64) *DiscountCreate {
+	dc.mutation.SetPercentOff(f)
+	return dc
+}
+
+// SetNillablePercentOff sets the "percent_off" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillablePercentOff(f *float64) *DiscountCreate {
+	if f != nil {
+		dc.SetPercentOff(*f)
+	}
+	return dc
+}
+
+// SetAmountOff sets the "amount_off" field.
+func (dc *DiscountCreate) SetAmountOff(i int64) *DiscountCreate {
+	dc.mutation.SetAmountOff(i)
+	return dc
+}
+
+// SetNillableAmountOff sets the "amount_off" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableAmountOff(i *int64) *DiscountCreate {
+	if i != nil {
+		dc.SetAmountOff(*i)
+	}
+	return dc
+}
+
+// SetCurrency sets the "currency" field.
+func (dc *DiscountCreate) SetCurrency(s string) *DiscountCreate {
+	dc.mutation.SetCurrency(s)
+	return dc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableCurrency(s *string) *DiscountCreate {
+	if s != nil {
+		dc.SetCurrency(*s)
+	}
+	return dc
+}
+
+// SetDuration sets the "duration" field.
+func (dc *DiscountCreate) SetDuration(d discount.Duration) *DiscountCreate {
+	dc.mutation.SetDuration(d)
+	return dc
+}
+
+// SetDurationInMonths sets the "duration_in_months" field.
+func (dc *DiscountCreate) SetDurationInMonths(i int) *DiscountCreate {
+	dc.mutation.SetDurationInMonths(i)
+	return dc
+}
+
+// SetNillableDurationInMonths sets the "duration_in_months" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableDurationInMonths(i *int) *DiscountCreate {
+	if i != nil {
+		dc.SetDurationInMonths(*i)
+	}
+	return dc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (dc *DiscountCreate) SetStartsAt(t time.Time) *DiscountCreate {
+	dc.mutation.SetStartsAt(t)
+	return dc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableStartsAt(t *time.Time) *DiscountCreate {
+	if t != nil {
+		dc.SetStartsAt(*t)
+	}
+	return dc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (dc *DiscountCreate) SetEndsAt(t time.Time) *DiscountCreate {
+	dc.mutation.SetEndsAt(t)
+	return dc
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableEndsAt(t *time.Time) *DiscountCreate {
+	if t != nil {
+		dc.SetEndsAt(*t)
+	}
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DiscountCreate) SetCreatedAt(t time.Time) *DiscountCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableCreatedAt(t *time.Time) *DiscountCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetSubscriptionID sets the "subscription" edge to the Subscription entity by ID.
+func (dc *DiscountCreate) SetSubscriptionID(id int) *DiscountCreate {
+	dc.mutation.SetSubscriptionID(id)
+	return dc
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (dc *DiscountCreate) SetSubscription(s *Subscription) *DiscountCreate {
+	return dc.SetSubscriptionID(s.ID)
+}
+
+// Mutation returns the DiscountMutation object of the builder.
+func (dc *DiscountCreate) Mutation() *DiscountMutation {
+	return dc.mutation
+}
+
+// Save creates the Discount in the database.
+func (dc *DiscountCreate) Save(ctx context.Context) (*Discount, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DiscountCreate) SaveX(ctx context.Context) *Discount {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DiscountCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DiscountCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DiscountCreate) defaults() {
+	if _, ok := dc.mutation.Provider(); !ok {
+		v := discount.DefaultProvider
+		dc.mutation.SetProvider(v)
+	}
+	if _, ok := dc.mutation.StartsAt(); !ok {
+		v := discount.DefaultStartsAt()
+		dc.mutation.SetStartsAt(v)
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := discount.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DiscountCreate) check() error {
+	if _, ok := dc.mutation.ProviderDiscountID(); !ok {
+		return &ValidationError{Name: "provider_discount_id", err: errors.New(`ent: missing required field "Discount.provider_discount_id"`)}
+	}
+	if v, ok := dc.mutation.ProviderDiscountID(); ok {
+		if err := discount.ProviderDiscountIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_discount_id", err: fmt.Errorf(`ent: validator failed for field "Discount.provider_discount_id": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "Discount.provider"`)}
+	}
+	if v, ok := dc.mutation.Provider(); ok {
+		if err := discount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Discount.provider": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.ProviderCouponID(); !ok {
+		return &ValidationError{Name: "provider_coupon_id", err: errors.New(`ent: missing required field "Discount.provider_coupon_id"`)}
+	}
+	if v, ok := dc.mutation.ProviderCouponID(); ok {
+		if err := discount.ProviderCouponIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_coupon_id", err: fmt.Errorf(`ent: validator failed for field "Discount.provider_coupon_id": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "Discount.duration"`)}
+	}
+	if v, ok := dc.mutation.Duration(); ok {
+		if err := discount.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "Discount.duration": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "Discount.starts_at"`)}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Discount.created_at"`)}
+	}
+	if len(dc.mutation.SubscriptionIDs()) == 0 {
+		return &ValidationError{Name: "subscription", err: errors.New(`ent: missing required edge "Discount.subscription"`)}
+	}
+	return nil
+}
+
+func (dc *DiscountCreate) sqlSave(ctx context.Context) (*Discount, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DiscountCreate) createSpec() (*Discount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Discount{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(discount.Table, sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = dc.conflict
+	if value, ok := dc.mutation.ProviderDiscountID(); ok {
+		_spec.SetField(discount.FieldProviderDiscountID, field.TypeString, value)
+		_node.ProviderDiscountID = value
+	}
+	if value, ok := dc.mutation.Provider(); ok {
+		_spec.SetField(discount.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := dc.mutation.ProviderCouponID(); ok {
+		_spec.SetField(discount.FieldProviderCouponID, field.TypeString, value)
+		_node.ProviderCouponID = value
+	}
+	if value, ok := dc.mutation.ProviderPromotionCodeID(); ok {
+		_spec.SetField(discount.FieldProviderPromotionCodeID, field.TypeString, value)
+		_node.ProviderPromotionCodeID = value
+	}
+	if value, ok := dc.mutation.Code(); ok {
+		_spec.SetField(discount.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := dc.mutation.PercentOff(); ok {
+		_spec.SetField(discount.FieldPercentOff, field.TypeFloat64, value)
+		_node.PercentOff = value
+	}
+	if value, ok := dc.mutation.AmountOff(); ok {
+		_spec.SetField(discount.FieldAmountOff, field.TypeInt64, value)
+		_node.AmountOff = value
+	}
+	if value, ok := dc.mutation.Currency(); ok {
+		_spec.SetField(discount.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := dc.mutation.Duration(); ok {
+		_spec.SetField(discount.FieldDuration, field.TypeEnum, value)
+		_node.Duration = value
+	}
+	if value, ok := dc.mutation.DurationInMonths(); ok {
+		_spec.SetField(discount.FieldDurationInMonths, field.TypeInt, value)
+		_node.DurationInMonths = value
+	}
+	if value, ok := dc.mutation.StartsAt(); ok {
+		_spec.SetField(discount.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := dc.mutation.EndsAt(); ok {
+		_spec.SetField(discount.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(discount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := dc.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discount.SubscriptionTable,
+			Columns: []string{discount.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.subscription_discounts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Discount.Create().
+//		SetProviderDiscountID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscountUpsert) {
+//			SetProviderDiscountID(v+v).
+//		}).
+//		Exec(ctx)
+func (dc *DiscountCreate) OnConflict(opts ...sql.ConflictOption) *DiscountUpsertOne {
+	dc.conflict = opts
+	return &DiscountUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Discount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dc *DiscountCreate) OnConflictColumns(columns ...string) *DiscountUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DiscountUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DiscountUpsertOne is the builder for "upsert"-ing
+	//  one Discount node.
+	DiscountUpsertOne struct {
+		create *DiscountCreate
+	}
+
+	// DiscountUpsert is the "OnConflict" setter.
+	DiscountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProviderDiscountID sets the "provider_discount_id" field.
+func (u *DiscountUpsert) SetProviderDiscountID(v string) *DiscountUpsert {
+	u.Set(discount.FieldProviderDiscountID, v)
+	return u
+}
+
+// UpdateProviderDiscountID sets the "provider_discount_id" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateProviderDiscountID() *DiscountUpsert {
+	u.SetExcluded(discount.FieldProviderDiscountID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *DiscountUpsert) SetProvider(v string) *DiscountUpsert {
+	u.Set(discount.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateProvider() *DiscountUpsert {
+	u.SetExcluded(discount.FieldProvider)
+	return u
+}
+
+// SetProviderCouponID sets the "provider_coupon_id" field.
+func (u *DiscountUpsert) SetProviderCouponID(v string) *DiscountUpsert {
+	u.Set(discount.FieldProviderCouponID, v)
+	return u
+}
+
+// UpdateProviderCouponID sets the "provider_coupon_id" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateProviderCouponID() *DiscountUpsert {
+	u.SetExcluded(discount.FieldProviderCouponID)
+	return u
+}
+
+// SetProviderPromotionCodeID sets the "provider_promotion_code_id" field.
+func (u *DiscountUpsert) SetProviderPromotionCodeID(v string) *DiscountUpsert {
+	u.Set(discount.FieldProviderPromotionCodeID, v)
+	return u
+}
+
+// UpdateProviderPromotionCodeID sets the "provider_promotion_code_id" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateProviderPromotionCodeID() *DiscountUpsert {
+	u.SetExcluded(discount.FieldProviderPromotionCodeID)
+	return u
+}
+
+// ClearProviderPromotionCodeID clears the value of the "provider_promotion_code_id" field.
+func (u *DiscountUpsert) ClearProviderPromotionCodeID() *DiscountUpsert {
+	u.SetNull(discount.FieldProviderPromotionCodeID)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *DiscountUpsert) SetCode(v string) *DiscountUpsert {
+	u.Set(discount.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateCode() *DiscountUpsert {
+	u.SetExcluded(discount.FieldCode)
+	return u
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DiscountUpsert) ClearCode() *DiscountUpsert {
+	u.SetNull(discount.FieldCode)
+	return u
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (u *DiscountUpsert) SetPercentOff(v float64) *DiscountUpsert {
+	u.Set(discount.FieldPercentOff, v)
+	return u
+}
+
+// UpdatePercentOff sets the "percent_off" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdatePercentOff() *DiscountUpsert {
+	u.SetExcluded(discount.FieldPercentOff)
+	return u
+}
+
+// AddPercentOff adds v to the "percent_off" field.
+func (u *DiscountUpsert) AddPercentOff(v float64) *DiscountUpsert {
+	u.Add(discount.FieldPercentOff, v)
+	return u
+}
+
+// ClearPercentOff clears the value of the "percent_off" field.
+func (u *DiscountUpsert) ClearPercentOff() *DiscountUpsert {
+	u.SetNull(discount.FieldPercentOff)
+	return u
+}
+
+// SetAmountOff sets the "amount_off" field.
+func (u *DiscountUpsert) SetAmountOff(v int64) *DiscountUpsert {
+	u.Set(discount.FieldAmountOff, v)
+	return u
+}
+
+// UpdateAmountOff sets the "amount_off" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateAmountOff() *DiscountUpsert {
+	u.SetExcluded(discount.FieldAmountOff)
+	return u
+}
+
+// AddAmountOff adds v to the "amount_off" field.
+func (u *DiscountUpsert) AddAmountOff(v int64) *DiscountUpsert {
+	u.Add(discount.FieldAmountOff, v)
+	return u
+}
+
+// ClearAmountOff clears the value of the "amount_off" field.
+func (u *DiscountUpsert) ClearAmountOff() *DiscountUpsert {
+	u.SetNull(discount.FieldAmountOff)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *DiscountUpsert) SetCurrency(v string) *DiscountUpsert {
+	u.Set(discount.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateCurrency() *DiscountUpsert {
+	u.SetExcluded(discount.FieldCurrency)
+	return u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *DiscountUpsert) ClearCurrency() *DiscountUpsert {
+	u.SetNull(discount.FieldCurrency)
+	return u
+}
+
+// SetDuration sets the "duration" field.
+func (u *DiscountUpsert) SetDuration(v discount.Duration) *DiscountUpsert {
+	u.Set(discount.FieldDuration, v)
+	return u
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateDuration() *DiscountUpsert {
+	u.SetExcluded(discount.FieldDuration)
+	return u
+}
+
+// SetDurationInMonths sets the "duration_in_months" field.
+func (u *DiscountUpsert) SetDurationInMonths(v int) *DiscountUpsert {
+	u.Set(discount.FieldDurationInMonths, v)
+	return u
+}
+
+// UpdateDurationInMonths sets the "duration_in_months" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateDurationInMonths() *DiscountUpsert {
+	u.SetExcluded(discount.FieldDurationInMonths)
+	return u
+}
+
+// AddDurationInMonths adds v to the "duration_in_months" field.
+func (u *DiscountUpsert) AddDurationInMonths(v int) *DiscountUpsert {
+	u.Add(discount.FieldDurationInMonths, v)
+	return u
+}
+
+// ClearDurationInMonths clears the value of the "duration_in_months" field.
+func (u *DiscountUpsert) ClearDurationInMonths() *DiscountUpsert {
+	u.SetNull(discount.FieldDurationInMonths)
+	return u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *DiscountUpsert) SetStartsAt(v time.Time) *DiscountUpsert {
+	u.Set(discount.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateStartsAt() *DiscountUpsert {
+	u.SetExcluded(discount.FieldStartsAt)
+	return u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *DiscountUpsert) SetEndsAt(v time.Time) *DiscountUpsert {
+	u.Set(discount.FieldEndsAt, v)
+	return u
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *DiscountUpsert) UpdateEndsAt() *DiscountUpsert {
+	u.SetExcluded(discount.FieldEndsAt)
+	return u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *DiscountUpsert) ClearEndsAt() *DiscountUpsert {
+	u.SetNull(discount.FieldEndsAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Discount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DiscountUpsertOne) UpdateNewValues() *DiscountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(discount.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Discount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscountUpsertOne) Ignore() *DiscountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscountUpsertOne) DoNothing() *DiscountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscountCreate.OnConflict
+// documentation for more info.
+func (u *DiscountUpsertOne) Update(set func(*DiscountUpsert)) *DiscountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderDiscountID sets the "provider_discount_id" field.
+func (u *DiscountUpsertOne) SetProviderDiscountID(v string) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetProviderDiscountID(v)
+	})
+}
+
+// UpdateProviderDiscountID sets the "provider_discount_id" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateProviderDiscountID() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateProviderDiscountID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *DiscountUpsertOne) SetProvider(v string) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateProvider() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetProviderCouponID sets the "provider_coupon_id" field.
+func (u *DiscountUpsertOne) SetProviderCouponID(v string) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetProviderCouponID(v)
+	})
+}
+
+// UpdateProviderCouponID sets the "provider_coupon_id" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateProviderCouponID() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateProviderCouponID()
+	})
+}
+
+// SetProviderPromotionCodeID sets the "provider_promotion_code_id" field.
+func (u *DiscountUpsertOne) SetProviderPromotionCodeID(v string) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetProviderPromotionCodeID(v)
+	})
+}
+
+// UpdateProviderPromotionCodeID sets the "provider_promotion_code_id" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateProviderPromotionCodeID() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateProviderPromotionCodeID()
+	})
+}
+
+// ClearProviderPromotionCodeID clears the value of the "provider_promotion_code_id" field.
+func (u *DiscountUpsertOne) ClearProviderPromotionCodeID() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearProviderPromotionCodeID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *DiscountUpsertOne) SetCode(v string) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateCode() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DiscountUpsertOne) ClearCode() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (u *DiscountUpsertOne) SetPercentOff(v float64) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetPercentOff(v)
+	})
+}
+
+// AddPercentOff adds v to the "percent_off" field.
+func (u *DiscountUpsertOne) AddPercentOff(v float64) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.AddPercentOff(v)
+	})
+}
+
+// UpdatePercentOff sets the "percent_off" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdatePercentOff() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdatePercentOff()
+	})
+}
+
+// ClearPercentOff clears the value of the "percent_off" field.
+func (u *DiscountUpsertOne) ClearPercentOff() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearPercentOff()
+	})
+}
+
+// SetAmountOff sets the "amount_off" field.
+func (u *DiscountUpsertOne) SetAmountOff(v int64) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetAmountOff(v)
+	})
+}
+
+// AddAmountOff adds v to the "amount_off" field.
+func (u *DiscountUpsertOne) AddAmountOff(v int64) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.AddAmountOff(v)
+	})
+}
+
+// UpdateAmountOff sets the "amount_off" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateAmountOff() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateAmountOff()
+	})
+}
+
+// ClearAmountOff clears the value of the "amount_off" field.
+func (u *DiscountUpsertOne) ClearAmountOff() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearAmountOff()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *DiscountUpsertOne) SetCurrency(v string) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateCurrency() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *DiscountUpsertOne) ClearCurrency() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *DiscountUpsertOne) SetDuration(v discount.Duration) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateDuration() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// SetDurationInMonths sets the "duration_in_months" field.
+func (u *DiscountUpsertOne) SetDurationInMonths(v int) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetDurationInMonths(v)
+	})
+}
+
+// AddDurationInMonths adds v to the "duration_in_months" field.
+func (u *DiscountUpsertOne) AddDurationInMonths(v int) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.AddDurationInMonths(v)
+	})
+}
+
+// UpdateDurationInMonths sets the "duration_in_months" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateDurationInMonths() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateDurationInMonths()
+	})
+}
+
+// ClearDurationInMonths clears the value of the "duration_in_months" field.
+func (u *DiscountUpsertOne) ClearDurationInMonths() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearDurationInMonths()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *DiscountUpsertOne) SetStartsAt(v time.Time) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateStartsAt() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *DiscountUpsertOne) SetEndsAt(v time.Time) *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *DiscountUpsertOne) UpdateEndsAt() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *DiscountUpsertOne) ClearEndsAt() *DiscountUpsertOne {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscountUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscountUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscountCreateBulk is the builder for creating many Discount entities in bulk.
+type DiscountCreateBulk struct {
+	config
+	err      error
+	builders []*DiscountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Discount entities in the database.
+func (dcb *DiscountCreateBulk) Save(ctx context.Context) ([]*Discount, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Discount, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DiscountCreateBulk) SaveX(ctx context.Context) []*Discount {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DiscountCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DiscountCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Discount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscountUpsert) {
+//			SetProviderDiscountID(v+v).
+//		}).
+//		Exec(ctx)
+func (dcb *DiscountCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscountUpsertBulk {
+	dcb.conflict = opts
+	return &DiscountUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Discount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dcb *DiscountCreateBulk) OnConflictColumns(columns ...string) *DiscountUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DiscountUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DiscountUpsertBulk is the builder for "upsert"-ing
+// a bulk of Discount nodes.
+type DiscountUpsertBulk struct {
+	create *DiscountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Discount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DiscountUpsertBulk) UpdateNewValues() *DiscountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(discount.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Discount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscountUpsertBulk) Ignore() *DiscountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscountUpsertBulk) DoNothing() *DiscountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscountCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscountUpsertBulk) Update(set func(*DiscountUpsert)) *DiscountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderDiscountID sets the "provider_discount_id" field.
+func (u *DiscountUpsertBulk) SetProviderDiscountID(v string) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetProviderDiscountID(v)
+	})
+}
+
+// UpdateProviderDiscountID sets the "provider_discount_id" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateProviderDiscountID() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateProviderDiscountID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *DiscountUpsertBulk) SetProvider(v string) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateProvider() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetProviderCouponID sets the "provider_coupon_id" field.
+func (u *DiscountUpsertBulk) SetProviderCouponID(v string) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetProviderCouponID(v)
+	})
+}
+
+// UpdateProviderCouponID sets the "provider_coupon_id" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateProviderCouponID() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateProviderCouponID()
+	})
+}
+
+// SetProviderPromotionCodeID sets the "provider_promotion_code_id" field.
+func (u *DiscountUpsertBulk) SetProviderPromotionCodeID(v string) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetProviderPromotionCodeID(v)
+	})
+}
+
+// UpdateProviderPromotionCodeID sets the "provider_promotion_code_id" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateProviderPromotionCodeID() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateProviderPromotionCodeID()
+	})
+}
+
+// ClearProviderPromotionCodeID clears the value of the "provider_promotion_code_id" field.
+func (u *DiscountUpsertBulk) ClearProviderPromotionCodeID() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearProviderPromotionCodeID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *DiscountUpsertBulk) SetCode(v string) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateCode() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DiscountUpsertBulk) ClearCode() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (u *DiscountUpsertBulk) SetPercentOff(v float64) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetPercentOff(v)
+	})
+}
+
+// AddPercentOff adds v to the "percent_off" field.
+func (u *DiscountUpsertBulk) AddPercentOff(v float64) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.AddPercentOff(v)
+	})
+}
+
+// UpdatePercentOff sets the "percent_off" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdatePercentOff() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdatePercentOff()
+	})
+}
+
+// ClearPercentOff clears the value of the "percent_off" field.
+func (u *DiscountUpsertBulk) ClearPercentOff() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearPercentOff()
+	})
+}
+
+// SetAmountOff sets the "amount_off" field.
+func (u *DiscountUpsertBulk) SetAmountOff(v int64) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetAmountOff(v)
+	})
+}
+
+// AddAmountOff adds v to the "amount_off" field.
+func (u *DiscountUpsertBulk) AddAmountOff(v int64) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.AddAmountOff(v)
+	})
+}
+
+// UpdateAmountOff sets the "amount_off" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateAmountOff() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateAmountOff()
+	})
+}
+
+// ClearAmountOff clears the value of the "amount_off" field.
+func (u *DiscountUpsertBulk) ClearAmountOff() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearAmountOff()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *DiscountUpsertBulk) SetCurrency(v string) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateCurrency() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *DiscountUpsertBulk) ClearCurrency() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *DiscountUpsertBulk) SetDuration(v discount.Duration) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateDuration() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// SetDurationInMonths sets the "duration_in_months" field.
+func (u *DiscountUpsertBulk) SetDurationInMonths(v int) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetDurationInMonths(v)
+	})
+}
+
+// AddDurationInMonths adds v to the "duration_in_months" field.
+func (u *DiscountUpsertBulk) AddDurationInMonths(v int) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.AddDurationInMonths(v)
+	})
+}
+
+// UpdateDurationInMonths sets the "duration_in_months" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateDurationInMonths() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateDurationInMonths()
+	})
+}
+
+// ClearDurationInMonths clears the value of the "duration_in_months" field.
+func (u *DiscountUpsertBulk) ClearDurationInMonths() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearDurationInMonths()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *DiscountUpsertBulk) SetStartsAt(v time.Time) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateStartsAt() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *DiscountUpsertBulk) SetEndsAt(v time.Time) *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *DiscountUpsertBulk) UpdateEndsAt() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *DiscountUpsertBulk) ClearEndsAt() *DiscountUpsertBulk {
+	return u.Update(func(s *DiscountUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/discount"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/subscription"
+)
+
+// DiscountUpdate is the builder for updating Discount entities.
+type DiscountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscountMutation
+}
+
+// Where appends a list predicates to the DiscountUpdate builder.
+func (du *DiscountUpdate) Where(ps ...predicate.Discount) *DiscountUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetProviderDiscountID sets the "provider_discount_id" field.
+func (du *DiscountUpdate) SetProviderDiscountID(s string) *DiscountUpdate {
+	du.mutation.SetProviderDiscountID(s)
+	return du
+}
+
+// SetNillableProviderDiscountID sets the "provider_discount_id" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableProviderDiscountID(s *string) *DiscountUpdate {
+	if s != nil {
+		du.SetProviderDiscountID(*s)
+	}
+	return du
+}
+
+// SetProvider sets the "provider" field.
+func (du *DiscountUpdate) SetProvider(s string) *DiscountUpdate {
+	du.mutation.SetProvider(s)
+	return du
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableProvider(s *string) *DiscountUpdate {
+	if s != nil {
+		du.SetProvider(*s)
+	}
+	return du
+}
+
+// SetProviderCouponID sets the "provider_coupon_id" field.
+func (du *DiscountUpdate) SetProviderCouponID(s string) *DiscountUpdate {
+	du.mutation.SetProviderCouponID(s)
+	return du
+}
+
+// SetNillableProviderCouponID sets the "provider_coupon_id" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableProviderCouponID(s *string) *DiscountUpdate {
+	if s != nil {
+		du.SetProviderCouponID(*s)
+	}
+	return du
+}
+
+// SetProviderPromotionCodeID sets the "provider_promotion_code_id" field.
+func (du *DiscountUpdate) SetProviderPromotionCodeID(s string) *DiscountUpdate {
+	du.mutation.SetProviderPromotionCodeID(s)
+	return du
+}
+
+// SetNillableProviderPromotionCodeID sets the "provider_promotion_code_id" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableProviderPromotionCodeID(s *string) *DiscountUpdate {
+	if s != nil {
+		du.SetProviderPromotionCodeID(*s)
+	}
+	return du
+}
+
+// ClearProviderPromotionCodeID clears the value of the "provider_promotion_code_id" field.
+func (du *DiscountUpdate) ClearProviderPromotionCodeID() *DiscountUpdate {
+	du.mutation.ClearProviderPromotionCodeID()
+	return du
+}
+
+// SetCode sets the "code" field.
+func (du *DiscountUpdate) SetCode(s string) *DiscountUpdate {
+	du.mutation.SetCode(s)
+	return du
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableCode(s *string) *DiscountUpdate {
+	if s != nil {
+		du.SetCode(*s)
+	}
+	return du
+}
+
+// ClearCode clears the value of the "code" field.
+func (du *DiscountUpdate) ClearCode() *DiscountUpdate {
+	du.mutation.ClearCode()
+	return du
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (du *DiscountUpdate) SetPercentOff(f float64) *DiscountUpdate {
+	du.mutation.ResetPercentOff()
+	du.mutation.SetPercentOff(f)
+	return du
+}
+
+// SetNillablePercentOff sets the "percent_off" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillablePercentOff(f *float64) *DiscountUpdate {
+	if f != nil {
+		du.SetPercentOff(*f)
+	}
+	return du
+}
+
+// AddPercentOff adds f to the "percent_off" field.
+func (du *DiscountUpdate) AddPercentOff(f float64) *DiscountUpdate {
+	du.mutation.AddPercentOff(f)
+	return du
+}
+
+// ClearPercentOff clears the value of the "percent_off" field.
+func (du *DiscountUpdate) ClearPercentOff() *DiscountUpdate {
+	du.mutation.ClearPercentOff()
+	return du
+}
+
+// SetAmountOff sets the "amount_off" field.
+func (du *DiscountUpdate) SetAmountOff(i int64) *DiscountUpdate {
+	du.mutation.ResetAmountOff()
+	du.mutation.SetAmountOff(i)
+	return du
+}
+
+// SetNillableAmountOff sets the "amount_off" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableAmountOff(i *int64) *DiscountUpdate {
+	if i != nil {
+		du.SetAmountOff(*i)
+	}
+	return du
+}
+
+// AddAmountOff adds i to the "amount_off" field.
+func (du *DiscountUpdate) AddAmountOff(i int64) *DiscountUpdate {
+	du.mutation.AddAmountOff(i)
+	return du
+}
+
+// ClearAmountOff clears the value of the "amount_off" field.
+func (du *DiscountUpdate) ClearAmountOff() *DiscountUpdate {
+	du.mutation.ClearAmountOff()
+	return du
+}
+
+// SetCurrency sets the "currency" field.
+func (du *DiscountUpdate) SetCurrency(s string) *DiscountUpdate {
+	du.mutation.SetCurrency(s)
+	return du
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableCurrency(s *string) *DiscountUpdate {
+	if s != nil {
+		du.SetCurrency(*s)
+	}
+	return du
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (du *DiscountUpdate) ClearCurrency() *DiscountUpdate {
+	du.mutation.ClearCurrency()
+	return du
+}
+
+// SetDuration sets the "duration" field.
+func (du *DiscountUpdate) SetDuration(d discount.Duration) *DiscountUpdate {
+	du.mutation.SetDuration(d)
+	return du
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableDuration(d *discount.Duration) *DiscountUpdate {
+	if d != nil {
+		du.SetDuration(*d)
+	}
+	return du
+}
+
+// SetDurationInMonths sets the "duration_in_months" field.
+func (du *DiscountUpdate) SetDurationInMonths(i int) *DiscountUpdate {
+	du.mutation.ResetDurationInMonths()
+	du.mutation.SetDurationInMonths(i)
+	return du
+}
+
+// SetNillableDurationInMonths sets the "duration_in_months" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableDurationInMonths(i *int) *DiscountUpdate {
+	if i != nil {
+		du.SetDurationInMonths(*i)
+	}
+	return du
+}
+
+// AddDurationInMonths adds i to the "duration_in_months" field.
+func (du *DiscountUpdate) AddDurationInMonths(i int) *DiscountUpdate {
+	du.mutation.AddDurationInMonths(i)
+	return du
+}
+
+// ClearDurationInMonths clears the value of the "duration_in_months" field.
+func (du *DiscountUpdate) ClearDurationInMonths() *DiscountUpdate {
+	du.mutation.ClearDurationInMonths()
+	return du
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (du *DiscountUpdate) SetStartsAt(t time.Time) *DiscountUpdate {
+	du.mutation.SetStartsAt(t)
+	return du
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableStartsAt(t *time.Time) *DiscountUpdate {
+	if t != nil {
+		du.SetStartsAt(*t)
+	}
+	return du
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (du *DiscountUpdate) SetEndsAt(t time.Time) *DiscountUpdate {
+	du.mutation.SetEndsAt(t)
+	return du
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableEndsAt(t *time.Time) *DiscountUpdate {
+	if t != nil {
+		du.SetEndsAt(*t)
+	}
+	return du
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (du *DiscountUpdate) ClearEndsAt() *DiscountUpdate {
+	du.mutation.ClearEndsAt()
+	return du
+}
+
+// SetSubscriptionID sets the "subscription" edge to the Subscription entity by ID.
+func (du *DiscountUpdate) SetSubscriptionID(id int) *DiscountUpdate {
+	du.mutation.SetSubscriptionID(id)
+	return du
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (du *DiscountUpdate) SetSubscription(s *Subscription) *DiscountUpdate {
+	return du.SetSubscriptionID(s.ID)
+}
+
+// Mutation returns the DiscountMutation object of the builder.
+func (du *DiscountUpdate) Mutation() *DiscountMutation {
+	return du.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (du *DiscountUpdate) ClearSubscription() *DiscountUpdate {
+	du.mutation.ClearSubscription()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DiscountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DiscountUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DiscountUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DiscountUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DiscountUpdate) check() error {
+	if v, ok := du.mutation.ProviderDiscountID(); ok {
+		if err := discount.ProviderDiscountIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_discount_id", err: fmt.Errorf(`ent: validator failed for field "Discount.provider_discount_id": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Provider(); ok {
+		if err := discount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Discount.provider": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.ProviderCouponID(); ok {
+		if err := discount.ProviderCouponIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_coupon_id", err: fmt.Errorf(`ent: validator failed for field "Discount.provider_coupon_id": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Duration(); ok {
+		if err := discount.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "Discount.duration": %w`, err)}
+		}
+	}
+	if du.mutation.SubscriptionCleared() && len(du.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Discount.subscription"`)
+	}
+	return nil
+}
+
+func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discount.Table, discount.Columns, sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.ProviderDiscountID(); ok {
+		_spec.SetField(discount.FieldProviderDiscountID, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Provider(); ok {
+		_spec.SetField(discount.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := du.mutation.ProviderCouponID(); ok {
+		_spec.SetField(discount.FieldProviderCouponID, field.TypeString, value)
+	}
+	if value, ok := du.mutation.ProviderPromotionCodeID(); ok {
+		_spec.SetField(discount.FieldProviderPromotionCodeID, field.TypeString, value)
+	}
+	if du.mutation.ProviderPromotionCodeIDCleared() {
+		_spec.ClearField(discount.FieldProviderPromotionCodeID, field.TypeString)
+	}
+	if value, ok := du.mutation.Code(); ok {
+		_spec.SetField(discount.FieldCode, field.TypeString, value)
+	}
+	if du.mutation.CodeCleared() {
+		_spec.ClearField(discount.FieldCode, field.TypeString)
+	}
+	if value, ok := du.mutation.PercentOff(); ok {
+		_spec.SetField(discount.FieldPercentOff, field.TypeFloat64, value)
+	}
+	if value, ok := du.mutation.AddedPercentOff(); ok {
+		_spec.AddField(discount.FieldPercentOff, field.TypeFloat64, value)
+	}
+	if du.mutation.PercentOffCleared() {
+		_spec.ClearField(discount.FieldPercentOff, field.TypeFloat64)
+	}
+	if value, ok := du.mutation.AmountOff(); ok {
+		_spec.SetField(discount.FieldAmountOff, field.TypeInt64, value)
+	}
+	if value, ok := du.mutation.AddedAmountOff(); ok {
+		_spec.AddField(discount.FieldAmountOff, field.TypeInt64, value)
+	}
+	if du.mutation.AmountOffCleared() {
+		_spec.ClearField(discount.FieldAmountOff, field.TypeInt64)
+	}
+	if value, ok := du.mutation.Currency(); ok {
+		_spec.SetField(discount.FieldCurrency, field.TypeString, value)
+	}
+	if du.mutation.CurrencyCleared() {
+		_spec.ClearField(discount.FieldCurrency, field.TypeString)
+	}
+	if value, ok := du.mutation.Duration(); ok {
+		_spec.SetField(discount.FieldDuration, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.DurationInMonths(); ok {
+		_spec.SetField(discount.FieldDurationInMonths, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedDurationInMonths(); ok {
+		_spec.AddField(discount.FieldDurationInMonths, field.TypeInt, value)
+	}
+	if du.mutation.DurationInMonthsCleared() {
+		_spec.ClearField(discount.FieldDurationInMonths, field.TypeInt)
+	}
+	if value, ok := du.mutation.StartsAt(); ok {
+		_spec.SetField(discount.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.EndsAt(); ok {
+		_spec.SetField(discount.FieldEndsAt, field.TypeTime, value)
+	}
+	if du.mutation.EndsAtCleared() {
+		_spec.ClearField(discount.FieldEndsAt, field.TypeTime)
+	}
+	if du.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discount.SubscriptionTable,
+			Columns: []string{discount.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discount.SubscriptionTable,
+			Columns: []string{discount.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DiscountUpdateOne is the builder for updating a single Discount entity.
+type DiscountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscountMutation
+}
+
+// SetProviderDiscountID sets the "provider_discount_id" field.
+func (duo *DiscountUpdateOne) SetProviderDiscountID(s string) *DiscountUpdateOne {
+	duo.mutation.SetProviderDiscountID(s)
+	return duo
+}
+
+// SetNillableProviderDiscountID sets the "provider_discount_id" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableProviderDiscountID(s *string) *DiscountUpdateOne {
+	if s != nil {
+		duo.SetProviderDiscountID(*s)
+	}
+	return duo
+}
+
+// SetProvider sets the "provider" field.
+func (duo *DiscountUpdateOne) SetProvider(s string) *DiscountUpdateOne {
+	duo.mutation.SetProvider(s)
+	return duo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableProvider(s *string) *DiscountUpdateOne {
+	if s != nil {
+		duo.SetProvider(*s)
+	}
+	return duo
+}
+
+// SetProviderCouponID sets the "provider_coupon_id" field.
+func (duo *DiscountUpdateOne) SetProviderCouponID(s string) *DiscountUpdateOne {
+	duo.mutation.SetProviderCouponID(s)
+	return duo
+}
+
+// SetNillableProviderCouponID sets the "provider_coupon_id" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableProviderCouponID(s *string) *DiscountUpdateOne {
+	if s != nil {
+		duo.SetProviderCouponID(*s)
+	}
+	return duo
+}
+
+// SetProviderPromotionCodeID sets the "provider_promotion_code_id" field.
+func (duo *DiscountUpdateOne) SetProviderPromotionCodeID(s string) *DiscountUpdateOne {
+	duo.mutation.SetProviderPromotionCodeID(s)
+	return duo
+}
+
+// SetNillableProviderPromotionCodeID sets the "provider_promotion_code_id" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableProviderPromotionCodeID(s *string) *DiscountUpdateOne {
+	if s != nil {
+		duo.SetProviderPromotionCodeID(*s)
+	}
+	return duo
+}
+
+// ClearProviderPromotionCodeID clears the value of the "provider_promotion_code_id" field.
+func (duo *DiscountUpdateOne) ClearProviderPromotionCodeID() *DiscountUpdateOne {
+	duo.mutation.ClearProviderPromotionCodeID()
+	return duo
+}
+
+// SetCode sets the "code" field.
+func (duo *DiscountUpdateOne) SetCode(s string) *DiscountUpdateOne {
+	duo.mutation.SetCode(s)
+	return duo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableCode(s *string) *DiscountUpdateOne {
+	if s != nil {
+		duo.SetCode(*s)
+	}
+	return duo
+}
+
+// ClearCode clears the value of the "code" field.
+func (duo *DiscountUpdateOne) ClearCode() *DiscountUpdateOne {
+	duo.mutation.ClearCode()
+	return duo
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (duo *DiscountUpdateOne) SetPercentOff(f float64) *DiscountUpdateOne {
+	duo.mutation.ResetPercentOff()
+	duo.mutation.SetPercentOff(f)
+	return duo
+}
+
+// SetNillablePercentOff sets the "percent_off" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillablePercentOff(f *float64) *DiscountUpdateOne {
+	if f != nil {
+		duo.SetPercentOff(*f)
+	}
+	return duo
+}
+
+// AddPercentOff adds f to the "percent_off" field.
+func (duo *DiscountUpdateOne) AddPercentOff(f float64) *DiscountUpdateOne {
+	duo.mutation.AddPercentOff(f)
+	return duo
+}
+
+// ClearPercentOff clears the value of the "percent_off" field.
+func (duo *DiscountUpdateOne) ClearPercentOff() *DiscountUpdateOne {
+	duo.mutation.ClearPercentOff()
+	return duo
+}
+
+// SetAmountOff sets the "amount_off" field.
+func (duo *DiscountUpdateOne) SetAmountOff(i int64) *DiscountUpdateOne {
+	duo.mutation.ResetAmountOff()
+	duo.mutation.SetAmountOff(i)
+	return duo
+}
+
+// SetNillableAmountOff sets the "amount_off" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableAmountOff(i *int64) *DiscountUpdateOne {
+	if i != nil {
+		duo.SetAmountOff(*i)
+	}
+	return duo
+}
+
+// AddAmountOff adds i to the "amount_off" field.
+func (duo *DiscountUpdateOne) AddAmountOff(i int64) *DiscountUpdateOne {
+	duo.mutation.AddAmountOff(i)
+	return duo
+}
+
+// ClearAmountOff clears the value of the "amount_off" field.
+func (duo *DiscountUpdateOne) ClearAmountOff() *DiscountUpdateOne {
+	duo.mutation.ClearAmountOff()
+	return duo
+}
+
+// SetCurrency sets the "currency" field.
+func (duo *DiscountUpdateOne) SetCurrency(s string) *DiscountUpdateOne {
+	duo.mutation.SetCurrency(s)
+	return duo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableCurrency(s *string) *DiscountUpdateOne {
+	if s != nil {
+		duo.SetCurrency(*s)
+	}
+	return duo
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (duo *DiscountUpdateOne) ClearCurrency() *DiscountUpdateOne {
+	duo.mutation.ClearCurrency()
+	return duo
+}
+
+// SetDuration sets the "duration" field.
+func (duo *DiscountUpdateOne) SetDuration(d discount.Duration) *DiscountUpdateOne {
+	duo.mutation.SetDuration(d)
+	return duo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableDuration(d *discount.Duration) *DiscountUpdateOne {
+	if d != nil {
+		duo.SetDuration(*d)
+	}
+	return duo
+}
+
+// SetDurationInMonths sets the "duration_in_months" field.
+func (duo *DiscountUpdateOne) SetDurationInMonths(i int) *DiscountUpdateOne {
+	duo.mutation.ResetDurationInMonths()
+	duo.mutation.SetDurationInMonths(i)
+	return duo
+}
+
+// SetNillableDurationInMonths sets the "duration_in_months" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableDurationInMonths(i *int) *DiscountUpdateOne {
+	if i != nil {
+		duo.SetDurationInMonths(*i)
+	}
+	return duo
+}
+
+// AddDurationInMonths adds i to the "duration_in_months" field.
+func (duo *DiscountUpdateOne) AddDurationInMonths(i int) *DiscountUpdateOne {
+	duo.mutation.AddDurationInMonths(i)
+	return duo
+}
+
+// ClearDurationInMonths clears the value of the "duration_in_months" field.
+func (duo *DiscountUpdateOne) ClearDurationInMonths() *DiscountUpdateOne {
+	duo.mutation.ClearDurationInMonths()
+	return duo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (duo *DiscountUpdateOne) SetStartsAt(t time.Time) *DiscountUpdateOne {
+	duo.mutation.SetStartsAt(t)
+	return duo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableStartsAt(t *time.Time) *DiscountUpdateOne {
+	if t != nil {
+		duo.SetStartsAt(*t)
+	}
+	return duo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (duo *DiscountUpdateOne) SetEndsAt(t time.Time) *DiscountUpdateOne {
+	duo.mutation.SetEndsAt(t)
+	return duo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableEndsAt(t *time.Time) *DiscountUpdateOne {
+	if t != nil {
+		duo.SetEndsAt(*t)
+	}
+	return duo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (duo *DiscountUpdateOne) ClearEndsAt() *DiscountUpdateOne {
+	duo.mutation.ClearEndsAt()
+	return duo
+}
+
+// SetSubscriptionID sets the "subscription" edge to the Subscription entity by ID.
+func (duo *DiscountUpdateOne) SetSubscriptionID(id int) *DiscountUpdateOne {
+	duo.mutation.SetSubscriptionID(id)
+	return duo
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (duo *DiscountUpdateOne) SetSubscription(s *Subscription) *DiscountUpdateOne {
+	return duo.SetSubscriptionID(s.ID)
+}
+
+// Mutation returns the DiscountMutation object of the builder.
+func (duo *DiscountUpdateOne) Mutation() *DiscountMutation {
+	return duo.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (duo *DiscountUpdateOne) ClearSubscription() *DiscountUpdateOne {
+	duo.mutation.ClearSubscription()
+	return duo
+}
+
+// Where appends a list predicates to the DiscountUpdate builder.
+func (duo *DiscountUpdateOne) Where(ps ...predicate.Discount) *DiscountUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DiscountUpdateOne) Select(field string, fields ...string) *DiscountUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Discount entity.
+func (duo *DiscountUpdateOne) Save(ctx context.Context) (*Discount, error) {
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DiscountUpdateOne) SaveX(ctx context.Context) *Discount {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DiscountUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DiscountUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DiscountUpdateOne) check() error {
+	if v, ok := duo.mutation.ProviderDiscountID(); ok {
+		if err := discount.ProviderDiscountIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_discount_id", err: fmt.Errorf(`ent: validator failed for field "Discount.provider_discount_id": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Provider(); ok {
+		if err := discount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Discount.provider": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.ProviderCouponID(); ok {
+		if err := discount.ProviderCouponIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_coupon_id", err: fmt.Errorf(`ent: validator failed for field "Discount.provider_coupon_id": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Duration(); ok {
+		if err := discount.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "Discount.duration": %w`, err)}
+		}
+	}
+	if duo.mutation.SubscriptionCleared() && len(duo.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Discount.subscription"`)
+	}
+	return nil
+}
+
+func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discount.Table, discount.Columns, sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Discount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discount.FieldID)
+		for _, f := range fields {
+			if !discount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.ProviderDiscountID(); ok {
+		_spec.SetField(discount.FieldProviderDiscountID, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Provider(); ok {
+		_spec.SetField(discount.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.ProviderCouponID(); ok {
+		_spec.SetField(discount.FieldProviderCouponID, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.ProviderPromotionCodeID(); ok {
+		_spec.SetField(discount.FieldProviderPromotionCodeID, field.TypeString, value)
+	}
+	if duo.mutation.ProviderPromotionCodeIDCleared() {
+		_spec.ClearField(discount.FieldProviderPromotionCodeID, field.TypeString)
+	}
+	if value, ok := duo.mutation.Code(); ok {
+		_spec.SetField(discount.FieldCode, field.TypeString, value)
+	}
+	if duo.mutation.CodeCleared() {
+		_spec.ClearField(discount.FieldCode, field.TypeString)
+	}
+	if value, ok := duo.mutation.PercentOff(); ok {
+		_spec.SetField(discount.FieldPercentOff, field.TypeFloat64, value)
+	}
+	if value, ok := duo.mutation.AddedPercentOff(); ok {
+		_spec.AddField(discount.FieldPercentOff, field.TypeFloat64, value)
+	}
+	if duo.mutation.PercentOffCleared() {
+		_spec.ClearField(discount.FieldPercentOff, field.TypeFloat64)
+	}
+	if value, ok := duo.mutation.AmountOff(); ok {
+		_spec.SetField(discount.FieldAmountOff, field.TypeInt64, value)
+	}
+	if value, ok := duo.mutation.AddedAmountOff(); ok {
+		_spec.AddField(discount.FieldAmountOff, field.TypeInt64, value)
+	}
+	if duo.mutation.AmountOffCleared() {
+		_spec.ClearField(discount.FieldAmountOff, field.TypeInt64)
+	}
+	if value, ok := duo.mutation.Currency(); ok {
+		_spec.SetField(discount.FieldCurrency, field.TypeString, value)
+	}
+	if duo.mutation.CurrencyCleared() {
+		_spec.ClearField(discount.FieldCurrency, field.TypeString)
+	}
+	if value, ok := duo.mutation.Duration(); ok {
+		_spec.SetField(discount.FieldDuration, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.DurationInMonths(); ok {
+		_spec.SetField(discount.FieldDurationInMonths, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedDurationInMonths(); ok {
+		_spec.AddField(discount.FieldDurationInMonths, field.TypeInt, value)
+	}
+	if duo.mutation.DurationInMonthsCleared() {
+		_spec.ClearField(discount.FieldDurationInMonths, field.TypeInt)
+	}
+	if value, ok := duo.mutation.StartsAt(); ok {
+		_spec.SetField(discount.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.EndsAt(); ok {
+		_spec.SetField(discount.FieldEndsAt, field.TypeTime, value)
+	}
+	if duo.mutation.EndsAtCleared() {
+		_spec.ClearField(discount.FieldEndsAt, field.TypeTime)
+	}
+	if duo.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discount.SubscriptionTable,
+			Columns: []string{discount.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discount.SubscriptionTable,
+			Columns: []string{discount.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Discount{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
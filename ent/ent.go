@@ -13,15 +13,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/occult/pagode/ent/answer"
+	"github.com/occult/pagode/ent/discount"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
 	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/processedevent"
 	"github.com/occult/pagode/ent/question"
+	"github.com/occult/pagode/ent/reportsubscription"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
 	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/usage"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -83,17 +93,27 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			answer.Table:          answer.ValidColumn,
-			form.Table:            form.ValidColumn,
-			job.Table:             job.ValidColumn,
-			passwordtoken.Table:   passwordtoken.ValidColumn,
-			paymentcustomer.Table: paymentcustomer.ValidColumn,
-			paymentintent.Table:   paymentintent.ValidColumn,
-			paymentmethod.Table:   paymentmethod.ValidColumn,
-			question.Table:        question.ValidColumn,
-			response.Table:        response.ValidColumn,
-			subscription.Table:    subscription.ValidColumn,
-			user.Table:            user.ValidColumn,
+			answer.Table:             answer.ValidColumn,
+			discount.Table:           discount.ValidColumn,
+			form.Table:               form.ValidColumn,
+			invoice.Table:            invoice.ValidColumn,
+			job.Table:                job.ValidColumn,
+			jobattempt.Table:         jobattempt.ValidColumn,
+			passwordtoken.Table:      passwordtoken.ValidColumn,
+			paymentaccount.Table:     paymentaccount.ValidColumn,
+			paymentcustomer.Table:    paymentcustomer.ValidColumn,
+			paymentintent.Table:      paymentintent.ValidColumn,
+			paymentmethod.Table:      paymentmethod.ValidColumn,
+			processedevent.Table:     processedevent.ValidColumn,
+			question.Table:           question.ValidColumn,
+			reportsubscription.Table: reportsubscription.ValidColumn,
+			response.Table:           response.ValidColumn,
+			responseactivity.Table:   responseactivity.ValidColumn,
+			responsenote.Table:       responsenote.ValidColumn,
+			responsetag.Table:        responsetag.ValidColumn,
+			subscription.Table:       subscription.ValidColumn,
+			usage.Table:              usage.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -13,7 +13,9 @@ import (
 
 func main() {
 	err := entc.Generate("./schema",
-		&gen.Config{},
+		&gen.Config{
+			Features: []gen.Feature{gen.FeatureLock, gen.FeatureUpsert},
+		},
 		entc.Extensions(&admin.Extension{}),
 	)
 	if err != nil {
@@ -28,6 +28,12 @@ type Form struct {
 	Slug string `json:"slug,omitempty"`
 	// DisplayMode holds the value of the "display_mode" field.
 	DisplayMode form.DisplayMode `json:"display_mode,omitempty"`
+	// How respondent IP addresses and user agents are stored
+	MetadataStorage form.MetadataStorage `json:"metadata_storage,omitempty"`
+	// Responses older than this many days are purged, zero keeps them forever
+	RetentionDays int `json:"retention_days,omitempty"`
+	// Email the owner whenever a response is submitted
+	NotifyOnResponse bool `json:"notify_on_response,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -87,11 +93,11 @@ func (*Form) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case form.FieldPublished:
+		case form.FieldPublished, form.FieldNotifyOnResponse:
 			values[i] = new(sql.NullBool)
-		case form.FieldID, form.FieldUserID:
+		case form.FieldID, form.FieldRetentionDays, form.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case form.FieldTitle, form.FieldDescription, form.FieldSlug, form.FieldDisplayMode:
+		case form.FieldTitle, form.FieldDescription, form.FieldSlug, form.FieldDisplayMode, form.FieldMetadataStorage:
 			values[i] = new(sql.NullString)
 		case form.FieldCreatedAt, form.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -146,6 +152,24 @@ func (f *Form) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.DisplayMode = form.DisplayMode(value.String)
 			}
+		case form.FieldMetadataStorage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata_storage", values[i])
+			} else if value.Valid {
+				f.MetadataStorage = form.MetadataStorage(value.String)
+			}
+		case form.FieldRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_days", values[i])
+			} else if value.Valid {
+				f.RetentionDays = int(value.Int64)
+			}
+		case form.FieldNotifyOnResponse:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_on_response", values[i])
+			} else if value.Valid {
+				f.NotifyOnResponse = value.Bool
+			}
 		case form.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -230,6 +254,15 @@ func (f *Form) String() string {
 	builder.WriteString("display_mode=")
 	builder.WriteString(fmt.Sprintf("%v", f.DisplayMode))
 	builder.WriteString(", ")
+	builder.WriteString("metadata_storage=")
+	builder.WriteString(fmt.Sprintf("%v", f.MetadataStorage))
+	builder.WriteString(", ")
+	builder.WriteString("retention_days=")
+	builder.WriteString(fmt.Sprintf("%v", f.RetentionDays))
+	builder.WriteString(", ")
+	builder.WriteString("notify_on_response=")
+	builder.WriteString(fmt.Sprintf("%v", f.NotifyOnResponse))
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", f.UserID))
 	builder.WriteString(", ")
@@ -25,6 +25,12 @@ const (
 	FieldSlug = "slug"
 	// FieldDisplayMode holds the string denoting the display_mode field in the database.
 	FieldDisplayMode = "display_mode"
+	// FieldMetadataStorage holds the string denoting the metadata_storage field in the database.
+	FieldMetadataStorage = "metadata_storage"
+	// FieldRetentionDays holds the string denoting the retention_days field in the database.
+	FieldRetentionDays = "retention_days"
+	// FieldNotifyOnResponse holds the string denoting the notify_on_response field in the database.
+	FieldNotifyOnResponse = "notify_on_response"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -70,6 +76,9 @@ var Columns = []string{
 	FieldPublished,
 	FieldSlug,
 	FieldDisplayMode,
+	FieldMetadataStorage,
+	FieldRetentionDays,
+	FieldNotifyOnResponse,
 	FieldUserID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -92,6 +101,12 @@ var (
 	DefaultPublished bool
 	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	SlugValidator func(string) error
+	// DefaultRetentionDays holds the default value on creation for the "retention_days" field.
+	DefaultRetentionDays int
+	// RetentionDaysValidator is a validator for the "retention_days" field. It is called by the builders before save.
+	RetentionDaysValidator func(int) error
+	// DefaultNotifyOnResponse holds the default value on creation for the "notify_on_response" field.
+	DefaultNotifyOnResponse bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -126,6 +141,34 @@ func DisplayModeValidator(dm DisplayMode) error {
 	}
 }
 
+// MetadataStorage defines the type for the "metadata_storage" enum field.
+type MetadataStorage string
+
+// MetadataStorageFull is the default value of the MetadataStorage enum.
+const DefaultMetadataStorage = MetadataStorageFull
+
+// MetadataStorage values.
+const (
+	MetadataStorageFull      MetadataStorage = "full"
+	MetadataStorageTruncated MetadataStorage = "truncated"
+	MetadataStorageHashed    MetadataStorage = "hashed"
+	MetadataStorageNone      MetadataStorage = "none"
+)
+
+func (ms MetadataStorage) String() string {
+	return string(ms)
+}
+
+// MetadataStorageValidator is a validator for the "metadata_storage" field enum values. It is called by the builders before save.
+func MetadataStorageValidator(ms MetadataStorage) error {
+	switch ms {
+	case MetadataStorageFull, MetadataStorageTruncated, MetadataStorageHashed, MetadataStorageNone:
+		return nil
+	default:
+		return fmt.Errorf("form: invalid enum value for metadata_storage field: %q", ms)
+	}
+}
+
 // OrderOption defines the ordering options for the Form queries.
 type OrderOption func(*sql.Selector)
 
@@ -159,6 +202,21 @@ func ByDisplayMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDisplayMode, opts...).ToFunc()
 }
 
+// ByMetadataStorage orders the results by the metadata_storage field.
+func ByMetadataStorage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetadataStorage, opts...).ToFunc()
+}
+
+// ByRetentionDays orders the results by the retention_days field.
+func ByRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionDays, opts...).ToFunc()
+}
+
+// ByNotifyOnResponse orders the results by the notify_on_response field.
+func ByNotifyOnResponse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyOnResponse, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -75,6 +75,16 @@ func Slug(v string) predicate.Form {
 	return predicate.Form(sql.FieldEQ(FieldSlug, v))
 }
 
+// RetentionDays applies equality check predicate on the "retention_days" field. It's identical to RetentionDaysEQ.
+func RetentionDays(v int) predicate.Form {
+	return predicate.Form(sql.FieldEQ(FieldRetentionDays, v))
+}
+
+// NotifyOnResponse applies equality check predicate on the "notify_on_response" field. It's identical to NotifyOnResponseEQ.
+func NotifyOnResponse(v bool) predicate.Form {
+	return predicate.Form(sql.FieldEQ(FieldNotifyOnResponse, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v int) predicate.Form {
 	return predicate.Form(sql.FieldEQ(FieldUserID, v))
@@ -325,6 +335,76 @@ func DisplayModeNotIn(vs ...DisplayMode) predicate.Form {
 	return predicate.Form(sql.FieldNotIn(FieldDisplayMode, vs...))
 }
 
+// MetadataStorageEQ applies the EQ predicate on the "metadata_storage" field.
+func MetadataStorageEQ(v MetadataStorage) predicate.Form {
+	return predicate.Form(sql.FieldEQ(FieldMetadataStorage, v))
+}
+
+// MetadataStorageNEQ applies the NEQ predicate on the "metadata_storage" field.
+func MetadataStorageNEQ(v MetadataStorage) predicate.Form {
+	return predicate.Form(sql.FieldNEQ(FieldMetadataStorage, v))
+}
+
+// MetadataStorageIn applies the In predicate on the "metadata_storage" field.
+func MetadataStorageIn(vs ...MetadataStorage) predicate.Form {
+	return predicate.Form(sql.FieldIn(FieldMetadataStorage, vs...))
+}
+
+// MetadataStorageNotIn applies the NotIn predicate on the "metadata_storage" field.
+func MetadataStorageNotIn(vs ...MetadataStorage) predicate.Form {
+	return predicate.Form(sql.FieldNotIn(FieldMetadataStorage, vs...))
+}
+
+// RetentionDaysEQ applies the EQ predicate on the "retention_days" field.
+func RetentionDaysEQ(v int) predicate.Form {
+	return predicate.Form(sql.FieldEQ(FieldRetentionDays, v))
+}
+
+// RetentionDaysNEQ applies the NEQ predicate on the "retention_days" field.
+func RetentionDaysNEQ(v int) predicate.Form {
+	return predicate.Form(sql.FieldNEQ(FieldRetentionDays, v))
+}
+
+// RetentionDaysIn applies the In predicate on the "retention_days" field.
+func RetentionDaysIn(vs ...int) predicate.Form {
+	return predicate.Form(sql.FieldIn(FieldRetentionDays, vs...))
+}
+
+// RetentionDaysNotIn applies the NotIn predicate on the "retention_days" field.
+func RetentionDaysNotIn(vs ...int) predicate.Form {
+	return predicate.Form(sql.FieldNotIn(FieldRetentionDays, vs...))
+}
+
+// RetentionDaysGT applies the GT predicate on the "retention_days" field.
+func RetentionDaysGT(v int) predicate.Form {
+	return predicate.Form(sql.FieldGT(FieldRetentionDays, v))
+}
+
+// RetentionDaysGTE applies the GTE predicate on the "retention_days" field.
+func RetentionDaysGTE(v int) predicate.Form {
+	return predicate.Form(sql.FieldGTE(FieldRetentionDays, v))
+}
+
+// RetentionDaysLT applies the LT predicate on the "retention_days" field.
+func RetentionDaysLT(v int) predicate.Form {
+	return predicate.Form(sql.FieldLT(FieldRetentionDays, v))
+}
+
+// RetentionDaysLTE applies the LTE predicate on the "retention_days" field.
+func RetentionDaysLTE(v int) predicate.Form {
+	return predicate.Form(sql.FieldLTE(FieldRetentionDays, v))
+}
+
+// NotifyOnResponseEQ applies the EQ predicate on the "notify_on_response" field.
+func NotifyOnResponseEQ(v bool) predicate.Form {
+	return predicate.Form(sql.FieldEQ(FieldNotifyOnResponse, v))
+}
+
+// NotifyOnResponseNEQ applies the NEQ predicate on the "notify_on_response" field.
+func NotifyOnResponseNEQ(v bool) predicate.Form {
+	return predicate.Form(sql.FieldNEQ(FieldNotifyOnResponse, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v int) predicate.Form {
 	return predicate.Form(sql.FieldEQ(FieldUserID, v))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/form"
@@ -21,6 +22,7 @@ type FormCreate struct {
 	config
 	mutation *FormMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetTitle sets the "title" field.
@@ -77,6 +79,48 @@ func (fc *FormCreate) SetNillableDisplayMode(fm *form.DisplayMode) *FormCreate {
 	return fc
 }
 
+// SetMetadataStorage sets the "metadata_storage" field.
+func (fc *FormCreate) SetMetadataStorage(fs form.MetadataStorage) *FormCreate {
+	fc.mutation.SetMetadataStorage(fs)
+	return fc
+}
+
+// SetNillableMetadataStorage sets the "metadata_storage" field if the given value is not nil.
+func (fc *FormCreate) SetNillableMetadataStorage(fs *form.MetadataStorage) *FormCreate {
+	if fs != nil {
+		fc.SetMetadataStorage(*fs)
+	}
+	return fc
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (fc *FormCreate) SetRetentionDays(i int) *FormCreate {
+	fc.mutation.SetRetentionDays(i)
+	return fc
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (fc *FormCreate) SetNillableRetentionDays(i *int) *FormCreate {
+	if i != nil {
+		fc.SetRetentionDays(*i)
+	}
+	return fc
+}
+
+// SetNotifyOnResponse sets the "notify_on_response" field.
+func (fc *FormCreate) SetNotifyOnResponse(b bool) *FormCreate {
+	fc.mutation.SetNotifyOnResponse(b)
+	return fc
+}
+
+// SetNillableNotifyOnResponse sets the "notify_on_response" field if the given value is not nil.
+func (fc *FormCreate) SetNillableNotifyOnResponse(b *bool) *FormCreate {
+	if b != nil {
+		fc.SetNotifyOnResponse(*b)
+	}
+	return fc
+}
+
 // SetUserID sets the "user_id" field.
 func (fc *FormCreate) SetUserID(i int) *FormCreate {
 	fc.mutation.SetUserID(i)
@@ -195,6 +239,18 @@ func (fc *FormCreate) defaults() {
 		v := form.DefaultDisplayMode
 		fc.mutation.SetDisplayMode(v)
 	}
+	if _, ok := fc.mutation.MetadataStorage(); !ok {
+		v := form.DefaultMetadataStorage
+		fc.mutation.SetMetadataStorage(v)
+	}
+	if _, ok := fc.mutation.RetentionDays(); !ok {
+		v := form.DefaultRetentionDays
+		fc.mutation.SetRetentionDays(v)
+	}
+	if _, ok := fc.mutation.NotifyOnResponse(); !ok {
+		v := form.DefaultNotifyOnResponse
+		fc.mutation.SetNotifyOnResponse(v)
+	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
 		v := form.DefaultCreatedAt()
 		fc.mutation.SetCreatedAt(v)
@@ -234,6 +290,25 @@ func (fc *FormCreate) check() error {
 			return &ValidationError{Name: "display_mode", err: fmt.Errorf(`ent: validator failed for field "Form.display_mode": %w`, err)}
 		}
 	}
+	if _, ok := fc.mutation.MetadataStorage(); !ok {
+		return &ValidationError{Name: "metadata_storage", err: errors.New(`ent: missing required field "Form.metadata_storage"`)}
+	}
+	if v, ok := fc.mutation.MetadataStorage(); ok {
+		if err := form.MetadataStorageValidator(v); err != nil {
+			return &ValidationError{Name: "metadata_storage", err: fmt.Errorf(`ent: validator failed for field "Form.metadata_storage": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.RetentionDays(); !ok {
+		return &ValidationError{Name: "retention_days", err: errors.New(`ent: missing required field "Form.retention_days"`)}
+	}
+	if v, ok := fc.mutation.RetentionDays(); ok {
+		if err := form.RetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "retention_days", err: fmt.Errorf(`ent: validator failed for field "Form.retention_days": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.NotifyOnResponse(); !ok {
+		return &ValidationError{Name: "notify_on_response", err: errors.New(`ent: missing required field "Form.notify_on_response"`)}
+	}
 	if _, ok := fc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Form.user_id"`)}
 	}
@@ -272,6 +347,7 @@ func (fc *FormCreate) createSpec() (*Form, *sqlgraph.CreateSpec) {
 		_node = &Form{config: fc.config}
 		_spec = sqlgraph.NewCreateSpec(form.Table, sqlgraph.NewFieldSpec(form.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = fc.conflict
 	if value, ok := fc.mutation.Title(); ok {
 		_spec.SetField(form.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -292,6 +368,18 @@ func (fc *FormCreate) createSpec() (*Form, *sqlgraph.CreateSpec) {
 		_spec.SetField(form.FieldDisplayMode, field.TypeEnum, value)
 		_node.DisplayMode = value
 	}
+	if value, ok := fc.mutation.MetadataStorage(); ok {
+		_spec.SetField(form.FieldMetadataStorage, field.TypeEnum, value)
+		_node.MetadataStorage = value
+	}
+	if value, ok := fc.mutation.RetentionDays(); ok {
+		_spec.SetField(form.FieldRetentionDays, field.TypeInt, value)
+		_node.RetentionDays = value
+	}
+	if value, ok := fc.mutation.NotifyOnResponse(); ok {
+		_spec.SetField(form.FieldNotifyOnResponse, field.TypeBool, value)
+		_node.NotifyOnResponse = value
+	}
 	if value, ok := fc.mutation.CreatedAt(); ok {
 		_spec.SetField(form.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -352,11 +440,425 @@ func (fc *FormCreate) createSpec() (*Form, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Form.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FormUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (fc *FormCreate) OnConflict(opts ...sql.ConflictOption) *FormUpsertOne {
+	fc.conflict = opts
+	return &FormUpsertOne{
+		create: fc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Form.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fc *FormCreate) OnConflictColumns(columns ...string) *FormUpsertOne {
+	fc.conflict = append(fc.conflict, sql.ConflictColumns(columns...))
+	return &FormUpsertOne{
+		create: fc,
+	}
+}
+
+type (
+	// FormUpsertOne is the builder for "upsert"-ing
+	//  one Form node.
+	FormUpsertOne struct {
+		create *FormCreate
+	}
+
+	// FormUpsert is the "OnConflict" setter.
+	FormUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *FormUpsert) SetTitle(v string) *FormUpsert {
+	u.Set(form.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FormUpsert) UpdateTitle() *FormUpsert {
+	u.SetExcluded(form.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *FormUpsert) SetDescription(v string) *FormUpsert {
+	u.Set(form.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *FormUpsert) UpdateDescription() *FormUpsert {
+	u.SetExcluded(form.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *FormUpsert) ClearDescription() *FormUpsert {
+	u.SetNull(form.FieldDescription)
+	return u
+}
+
+// SetPublished sets the "published" field.
+func (u *FormUpsert) SetPublished(v bool) *FormUpsert {
+	u.Set(form.FieldPublished, v)
+	return u
+}
+
+// UpdatePublished sets the "published" field to the value that was provided on create.
+func (u *FormUpsert) UpdatePublished() *FormUpsert {
+	u.SetExcluded(form.FieldPublished)
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *FormUpsert) SetSlug(v string) *FormUpsert {
+	u.Set(form.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *FormUpsert) UpdateSlug() *FormUpsert {
+	u.SetExcluded(form.FieldSlug)
+	return u
+}
+
+// SetDisplayMode sets the "display_mode" field.
+func (u *FormUpsert) SetDisplayMode(v form.DisplayMode) *FormUpsert {
+	u.Set(form.FieldDisplayMode, v)
+	return u
+}
+
+// UpdateDisplayMode sets the "display_mode" field to the value that was provided on create.
+func (u *FormUpsert) UpdateDisplayMode() *FormUpsert {
+	u.SetExcluded(form.FieldDisplayMode)
+	return u
+}
+
+// SetMetadataStorage sets the "metadata_storage" field.
+func (u *FormUpsert) SetMetadataStorage(v form.MetadataStorage) *FormUpsert {
+	u.Set(form.FieldMetadataStorage, v)
+	return u
+}
+
+// UpdateMetadataStorage sets the "metadata_storage" field to the value that was provided on create.
+func (u *FormUpsert) UpdateMetadataStorage() *FormUpsert {
+	u.SetExcluded(form.FieldMetadataStorage)
+	return u
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (u *FormUpsert) SetRetentionDays(v int) *FormUpsert {
+	u.Set(form.FieldRetentionDays, v)
+	return u
+}
+
+// UpdateRetentionDays sets the "retention_days" field to the value that was provided on create.
+func (u *FormUpsert) UpdateRetentionDays() *FormUpsert {
+	u.SetExcluded(form.FieldRetentionDays)
+	return u
+}
+
+// AddRetentionDays adds v to the "retention_days" field.
+func (u *FormUpsert) AddRetentionDays(v int) *FormUpsert {
+	u.Add(form.FieldRetentionDays, v)
+	return u
+}
+
+// SetNotifyOnResponse sets the "notify_on_response" field.
+func (u *FormUpsert) SetNotifyOnResponse(v bool) *FormUpsert {
+	u.Set(form.FieldNotifyOnResponse, v)
+	return u
+}
+
+// UpdateNotifyOnResponse sets the "notify_on_response" field to the value that was provided on create.
+func (u *FormUpsert) UpdateNotifyOnResponse() *FormUpsert {
+	u.SetExcluded(form.FieldNotifyOnResponse)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FormUpsert) SetUserID(v int) *FormUpsert {
+	u.Set(form.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FormUpsert) UpdateUserID() *FormUpsert {
+	u.SetExcluded(form.FieldUserID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FormUpsert) SetUpdatedAt(v time.Time) *FormUpsert {
+	u.Set(form.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FormUpsert) UpdateUpdatedAt() *FormUpsert {
+	u.SetExcluded(form.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Form.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FormUpsertOne) UpdateNewValues() *FormUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(form.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Form.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FormUpsertOne) Ignore() *FormUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FormUpsertOne) DoNothing() *FormUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FormCreate.OnConflict
+// documentation for more info.
+func (u *FormUpsertOne) Update(set func(*FormUpsert)) *FormUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FormUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *FormUpsertOne) SetTitle(v string) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateTitle() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *FormUpsertOne) SetDescription(v string) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateDescription() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *FormUpsertOne) ClearDescription() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPublished sets the "published" field.
+func (u *FormUpsertOne) SetPublished(v bool) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetPublished(v)
+	})
+}
+
+// UpdatePublished sets the "published" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdatePublished() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdatePublished()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *FormUpsertOne) SetSlug(v string) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateSlug() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetDisplayMode sets the "display_mode" field.
+func (u *FormUpsertOne) SetDisplayMode(v form.DisplayMode) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetDisplayMode(v)
+	})
+}
+
+// UpdateDisplayMode sets the "display_mode" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateDisplayMode() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateDisplayMode()
+	})
+}
+
+// SetMetadataStorage sets the "metadata_storage" field.
+func (u *FormUpsertOne) SetMetadataStorage(v form.MetadataStorage) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetMetadataStorage(v)
+	})
+}
+
+// UpdateMetadataStorage sets the "metadata_storage" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateMetadataStorage() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateMetadataStorage()
+	})
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (u *FormUpsertOne) SetRetentionDays(v int) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetRetentionDays(v)
+	})
+}
+
+// AddRetentionDays adds v to the "retention_days" field.
+func (u *FormUpsertOne) AddRetentionDays(v int) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.AddRetentionDays(v)
+	})
+}
+
+// UpdateRetentionDays sets the "retention_days" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateRetentionDays() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateRetentionDays()
+	})
+}
+
+// SetNotifyOnResponse sets the "notify_on_response" field.
+func (u *FormUpsertOne) SetNotifyOnResponse(v bool) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetNotifyOnResponse(v)
+	})
+}
+
+// UpdateNotifyOnResponse sets the "notify_on_response" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateNotifyOnResponse() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateNotifyOnResponse()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FormUpsertOne) SetUserID(v int) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateUserID() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FormUpsertOne) SetUpdatedAt(v time.Time) *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FormUpsertOne) UpdateUpdatedAt() *FormUpsertOne {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *FormUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FormCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FormUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FormUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FormUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // FormCreateBulk is the builder for creating many Form entities in bulk.
 type FormCreateBulk struct {
 	config
 	err      error
 	builders []*FormCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Form entities in the database.
@@ -386,6 +888,7 @@ func (fcb *FormCreateBulk) Save(ctx context.Context) ([]*Form, error) {
 					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = fcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -439,3 +942,268 @@ func (fcb *FormCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Form.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FormUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (fcb *FormCreateBulk) OnConflict(opts ...sql.ConflictOption) *FormUpsertBulk {
+	fcb.conflict = opts
+	return &FormUpsertBulk{
+		create: fcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Form.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fcb *FormCreateBulk) OnConflictColumns(columns ...string) *FormUpsertBulk {
+	fcb.conflict = append(fcb.conflict, sql.ConflictColumns(columns...))
+	return &FormUpsertBulk{
+		create: fcb,
+	}
+}
+
+// FormUpsertBulk is the builder for "upsert"-ing
+// a bulk of Form nodes.
+type FormUpsertBulk struct {
+	create *FormCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Form.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FormUpsertBulk) UpdateNewValues() *FormUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(form.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Form.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FormUpsertBulk) Ignore() *FormUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FormUpsertBulk) DoNothing() *FormUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FormCreateBulk.OnConflict
+// documentation for more info.
+func (u *FormUpsertBulk) Update(set func(*FormUpsert)) *FormUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FormUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *FormUpsertBulk) SetTitle(v string) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateTitle() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *FormUpsertBulk) SetDescription(v string) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateDescription() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *FormUpsertBulk) ClearDescription() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPublished sets the "published" field.
+func (u *FormUpsertBulk) SetPublished(v bool) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetPublished(v)
+	})
+}
+
+// UpdatePublished sets the "published" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdatePublished() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdatePublished()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *FormUpsertBulk) SetSlug(v string) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateSlug() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetDisplayMode sets the "display_mode" field.
+func (u *FormUpsertBulk) SetDisplayMode(v form.DisplayMode) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetDisplayMode(v)
+	})
+}
+
+// UpdateDisplayMode sets the "display_mode" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateDisplayMode() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateDisplayMode()
+	})
+}
+
+// SetMetadataStorage sets the "metadata_storage" field.
+func (u *FormUpsertBulk) SetMetadataStorage(v form.MetadataStorage) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetMetadataStorage(v)
+	})
+}
+
+// UpdateMetadataStorage sets the "metadata_storage" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateMetadataStorage() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateMetadataStorage()
+	})
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (u *FormUpsertBulk) SetRetentionDays(v int) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetRetentionDays(v)
+	})
+}
+
+// AddRetentionDays adds v to the "retention_days" field.
+func (u *FormUpsertBulk) AddRetentionDays(v int) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.AddRetentionDays(v)
+	})
+}
+
+// UpdateRetentionDays sets the "retention_days" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateRetentionDays() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateRetentionDays()
+	})
+}
+
+// SetNotifyOnResponse sets the "notify_on_response" field.
+func (u *FormUpsertBulk) SetNotifyOnResponse(v bool) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetNotifyOnResponse(v)
+	})
+}
+
+// UpdateNotifyOnResponse sets the "notify_on_response" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateNotifyOnResponse() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateNotifyOnResponse()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FormUpsertBulk) SetUserID(v int) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateUserID() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FormUpsertBulk) SetUpdatedAt(v time.Time) *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FormUpsertBulk) UpdateUpdatedAt() *FormUpsertBulk {
+	return u.Update(func(s *FormUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *FormUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FormCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FormCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FormUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -107,6 +107,55 @@ func (fu *FormUpdate) SetNillableDisplayMode(fm *form.DisplayMode) *FormUpdate {
 	return fu
 }
 
+// SetMetadataStorage sets the "metadata_storage" field.
+func (fu *FormUpdate) SetMetadataStorage(fs form.MetadataStorage) *FormUpdate {
+	fu.mutation.SetMetadataStorage(fs)
+	return fu
+}
+
+// SetNillableMetadataStorage sets the "metadata_storage" field if the given value is not nil.
+func (fu *FormUpdate) SetNillableMetadataStorage(fs *form.MetadataStorage) *FormUpdate {
+	if fs != nil {
+		fu.SetMetadataStorage(*fs)
+	}
+	return fu
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (fu *FormUpdate) SetRetentionDays(i int) *FormUpdate {
+	fu.mutation.ResetRetentionDays()
+	fu.mutation.SetRetentionDays(i)
+	return fu
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (fu *FormUpdate) SetNillableRetentionDays(i *int) *FormUpdate {
+	if i != nil {
+		fu.SetRetentionDays(*i)
+	}
+	return fu
+}
+
+// AddRetentionDays adds i to the "retention_days" field.
+func (fu *FormUpdate) AddRetentionDays(i int) *FormUpdate {
+	fu.mutation.AddRetentionDays(i)
+	return fu
+}
+
+// SetNotifyOnResponse sets the "notify_on_response" field.
+func (fu *FormUpdate) SetNotifyOnResponse(b bool) *FormUpdate {
+	fu.mutation.SetNotifyOnResponse(b)
+	return fu
+}
+
+// SetNillableNotifyOnResponse sets the "notify_on_response" field if the given value is not nil.
+func (fu *FormUpdate) SetNillableNotifyOnResponse(b *bool) *FormUpdate {
+	if b != nil {
+		fu.SetNotifyOnResponse(*b)
+	}
+	return fu
+}
+
 // SetUserID sets the "user_id" field.
 func (fu *FormUpdate) SetUserID(i int) *FormUpdate {
 	fu.mutation.SetUserID(i)
@@ -274,6 +323,16 @@ func (fu *FormUpdate) check() error {
 			return &ValidationError{Name: "display_mode", err: fmt.Errorf(`ent: validator failed for field "Form.display_mode": %w`, err)}
 		}
 	}
+	if v, ok := fu.mutation.MetadataStorage(); ok {
+		if err := form.MetadataStorageValidator(v); err != nil {
+			return &ValidationError{Name: "metadata_storage", err: fmt.Errorf(`ent: validator failed for field "Form.metadata_storage": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.RetentionDays(); ok {
+		if err := form.RetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "retention_days", err: fmt.Errorf(`ent: validator failed for field "Form.retention_days": %w`, err)}
+		}
+	}
 	if fu.mutation.OwnerCleared() && len(fu.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Form.owner"`)
 	}
@@ -310,6 +369,18 @@ func (fu *FormUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fu.mutation.DisplayMode(); ok {
 		_spec.SetField(form.FieldDisplayMode, field.TypeEnum, value)
 	}
+	if value, ok := fu.mutation.MetadataStorage(); ok {
+		_spec.SetField(form.FieldMetadataStorage, field.TypeEnum, value)
+	}
+	if value, ok := fu.mutation.RetentionDays(); ok {
+		_spec.SetField(form.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedRetentionDays(); ok {
+		_spec.AddField(form.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.NotifyOnResponse(); ok {
+		_spec.SetField(form.FieldNotifyOnResponse, field.TypeBool, value)
+	}
 	if value, ok := fu.mutation.UpdatedAt(); ok {
 		_spec.SetField(form.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -528,6 +599,55 @@ func (fuo *FormUpdateOne) SetNillableDisplayMode(fm *form.DisplayMode) *FormUpda
 	return fuo
 }
 
+// SetMetadataStorage sets the "metadata_storage" field.
+func (fuo *FormUpdateOne) SetMetadataStorage(fs form.MetadataStorage) *FormUpdateOne {
+	fuo.mutation.SetMetadataStorage(fs)
+	return fuo
+}
+
+// SetNillableMetadataStorage sets the "metadata_storage" field if the given value is not nil.
+func (fuo *FormUpdateOne) SetNillableMetadataStorage(fs *form.MetadataStorage) *FormUpdateOne {
+	if fs != nil {
+		fuo.SetMetadataStorage(*fs)
+	}
+	return fuo
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (fuo *FormUpdateOne) SetRetentionDays(i int) *FormUpdateOne {
+	fuo.mutation.ResetRetentionDays()
+	fuo.mutation.SetRetentionDays(i)
+	return fuo
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (fuo *FormUpdateOne) SetNillableRetentionDays(i *int) *FormUpdateOne {
+	if i != nil {
+		fuo.SetRetentionDays(*i)
+	}
+	return fuo
+}
+
+// AddRetentionDays adds i to the "retention_days" field.
+func (fuo *FormUpdateOne) AddRetentionDays(i int) *FormUpdateOne {
+	fuo.mutation.AddRetentionDays(i)
+	return fuo
+}
+
+// SetNotifyOnResponse sets the "notify_on_response" field.
+func (fuo *FormUpdateOne) SetNotifyOnResponse(b bool) *FormUpdateOne {
+	fuo.mutation.SetNotifyOnResponse(b)
+	return fuo
+}
+
+// SetNillableNotifyOnResponse sets the "notify_on_response" field if the given value is not nil.
+func (fuo *FormUpdateOne) SetNillableNotifyOnResponse(b *bool) *FormUpdateOne {
+	if b != nil {
+		fuo.SetNotifyOnResponse(*b)
+	}
+	return fuo
+}
+
 // SetUserID sets the "user_id" field.
 func (fuo *FormUpdateOne) SetUserID(i int) *FormUpdateOne {
 	fuo.mutation.SetUserID(i)
@@ -708,6 +828,16 @@ func (fuo *FormUpdateOne) check() error {
 			return &ValidationError{Name: "display_mode", err: fmt.Errorf(`ent: validator failed for field "Form.display_mode": %w`, err)}
 		}
 	}
+	if v, ok := fuo.mutation.MetadataStorage(); ok {
+		if err := form.MetadataStorageValidator(v); err != nil {
+			return &ValidationError{Name: "metadata_storage", err: fmt.Errorf(`ent: validator failed for field "Form.metadata_storage": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.RetentionDays(); ok {
+		if err := form.RetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "retention_days", err: fmt.Errorf(`ent: validator failed for field "Form.retention_days": %w`, err)}
+		}
+	}
 	if fuo.mutation.OwnerCleared() && len(fuo.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Form.owner"`)
 	}
@@ -761,6 +891,18 @@ func (fuo *FormUpdateOne) sqlSave(ctx context.Context) (_node *Form, err error)
 	if value, ok := fuo.mutation.DisplayMode(); ok {
 		_spec.SetField(form.FieldDisplayMode, field.TypeEnum, value)
 	}
+	if value, ok := fuo.mutation.MetadataStorage(); ok {
+		_spec.SetField(form.FieldMetadataStorage, field.TypeEnum, value)
+	}
+	if value, ok := fuo.mutation.RetentionDays(); ok {
+		_spec.SetField(form.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedRetentionDays(); ok {
+		_spec.AddField(form.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.NotifyOnResponse(); ok {
+		_spec.SetField(form.FieldNotifyOnResponse, field.TypeBool, value)
+	}
 	if value, ok := fuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(form.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/invoice"
+	"github.com/occult/pagode/ent/paymentcustomer"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// External payment provider invoice ID
+	ProviderInvoiceID string `json:"provider_invoice_id,omitempty"`
+	// Payment provider name
+	Provider string `json:"provider,omitempty"`
+	// External payment provider ID of the subscription the invoice was created for
+	ProviderSubscriptionID string `json:"provider_subscription_id,omitempty"`
+	// Invoice number shown to the customer, assigned once the invoice is finalized
+	Number string `json:"number,omitempty"`
+	// Invoice status from provider
+	Status invoice.Status `json:"status,omitempty"`
+	// Three-letter ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Total before tax in smallest currency unit
+	Subtotal int64 `json:"subtotal,omitempty"`
+	// Tax in smallest currency unit
+	Tax int64 `json:"tax,omitempty"`
+	// Total after tax in smallest currency unit
+	Total int64 `json:"total,omitempty"`
+	// Amount due in smallest currency unit
+	AmountDue int64 `json:"amount_due,omitempty"`
+	// Amount paid in smallest currency unit
+	AmountPaid int64 `json:"amount_paid,omitempty"`
+	// URL of the invoice page hosted by the provider
+	HostedLink string `json:"hosted_link,omitempty"`
+	// URL of the invoice PDF
+	PdfLink string `json:"pdf_link,omitempty"`
+	// Start of the period the invoice is for
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// End of the period the invoice is for
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// When the invoice was paid
+	PaidAt time.Time `json:"paid_at,omitempty"`
+	// When the invoice was created by the provider
+	IssuedAt time.Time `json:"issued_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceQuery when eager-loading is set.
+	Edges                     InvoiceEdges `json:"edges"`
+	payment_customer_invoices *int
+	selectValues              sql.SelectValues
+}
+
+// InvoiceEdges holds the relations/edges for other nodes in the graph.
+type InvoiceEdges struct {
+	// Payment customer the invoice was issued to
+	Customer *PaymentCustomer `json:"customer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CustomerOrErr returns the Customer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) CustomerOrErr() (*PaymentCustomer, error) {
+	if e.Customer != nil {
+		return e.Customer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentcustomer.Label}
+	}
+	return nil, &NotLoadedError{edge: "customer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldID, invoice.FieldSubtotal, invoice.FieldTax, invoice.FieldTotal, invoice.FieldAmountDue, invoice.FieldAmountPaid:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldProviderInvoiceID, invoice.FieldProvider, invoice.FieldProviderSubscriptionID, invoice.FieldNumber, invoice.FieldStatus, invoice.FieldCurrency, invoice.FieldHostedLink, invoice.FieldPdfLink:
+			values[i] = new(sql.NullString)
+		case invoice.FieldPeriodStart, invoice.FieldPeriodEnd, invoice.FieldPaidAt, invoice.FieldIssuedAt, invoice.FieldCreatedAt, invoice.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case invoice.ForeignKeys[0]: // payment_customer_invoices
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (i *Invoice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invoice.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case invoice.FieldProviderInvoiceID:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_invoice_id", values[j])
+			} else if value.Valid {
+				i.ProviderInvoiceID = value.String
+			}
+		case invoice.FieldProvider:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[j])
+			} else if value.Valid {
+				i.Provider = value.String
+			}
+		case invoice.FieldProviderSubscriptionID:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_subscription_id", values[j])
+			} else if value.Valid {
+				i.ProviderSubscriptionID = value.String
+			}
+		case invoice.FieldNumber:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[j])
+			} else if value.Valid {
+				i.Number = value.String
+			}
+		case invoice.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = invoice.Status(value.String)
+			}
+		case invoice.FieldCurrency:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[j])
+			} else if value.Valid {
+				i.Currency = value.String
+			}
+		case invoice.FieldSubtotal:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[j])
+			} else if value.Valid {
+				i.Subtotal = value.Int64
+			}
+		case invoice.FieldTax:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax", values[j])
+			} else if value.Valid {
+				i.Tax = value.Int64
+			}
+		case invoice.FieldTotal:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[j])
+			} else if value.Valid {
+				i.Total = value.Int64
+			}
+		case invoice.FieldAmountDue:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_due", values[j])
+			} else if value.Valid {
+				i.AmountDue = value.Int64
+			}
+		case invoice.FieldAmountPaid:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_paid", values[j])
+			} else if value.Valid {
+				i.AmountPaid = value.Int64
+			}
+		case invoice.FieldHostedLink:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hosted_link", values[j])
+			} else if value.Valid {
+				i.HostedLink = value.String
+			}
+		case invoice.FieldPdfLink:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_link", values[j])
+			} else if value.Valid {
+				i.PdfLink = value.String
+			}
+		case invoice.FieldPeriodStart:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[j])
+			} else if value.Valid {
+				i.PeriodStart = value.Time
+			}
+		case invoice.FieldPeriodEnd:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[j])
+			} else if value.Valid {
+				i.PeriodEnd = value.Time
+			}
+		case invoice.FieldPaidAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_at", values[j])
+			} else if value.Valid {
+				i.PaidAt = value.Time
+			}
+		case invoice.FieldIssuedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[j])
+			} else if value.Valid {
+				i.IssuedAt = value.Time
+			}
+		case invoice.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		case invoice.FieldUpdatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[j])
+			} else if value.Valid {
+				i.UpdatedAt = value.Time
+			}
+		case invoice.ForeignKeys[0]:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field payment_customer_invoices", value)
+			} else if value.Valid {
+				i.payment_customer_invoices = new(int)
+				*i.payment_customer_invoices = int(value.Int64)
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invoice.
+// This includes values selected through modifiers, order, etc.
+func (i *Invoice) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QueryCustomer queries the "customer" edge of the Invoice entity.
+func (i *Invoice) QueryCustomer() *PaymentCustomerQuery {
+	return NewInvoiceClient(i.config).QueryCustomer(i)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invoice) Update() *InvoiceUpdateOne {
+	return NewInvoiceClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invoice) Unwrap() *Invoice {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invoice is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("provider_invoice_id=")
+	builder.WriteString(i.ProviderInvoiceID)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(i.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("provider_subscription_id=")
+	builder.WriteString(i.ProviderSubscriptionID)
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(i.Number)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", i.Status))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(i.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", i.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("tax=")
+	builder.WriteString(fmt.Sprintf("%v", i.Tax))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", i.Total))
+	builder.WriteString(", ")
+	builder.WriteString("amount_due=")
+	builder.WriteString(fmt.Sprintf("%v", i.AmountDue))
+	builder.WriteString(", ")
+	builder.WriteString("amount_paid=")
+	builder.WriteString(fmt.Sprintf("%v", i.AmountPaid))
+	builder.WriteString(", ")
+	builder.WriteString("hosted_link=")
+	builder.WriteString(i.HostedLink)
+	builder.WriteString(", ")
+	builder.WriteString("pdf_link=")
+	builder.WriteString(i.PdfLink)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(i.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(i.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("paid_at=")
+	builder.WriteString(i.PaidAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("issued_at=")
+	builder.WriteString(i.IssuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(i.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
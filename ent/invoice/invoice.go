@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProviderInvoiceID holds the string denoting the provider_invoice_id field in the database.
+	FieldProviderInvoiceID = "provider_invoice_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldProviderSubscriptionID holds the string denoting the provider_subscription_id field in the database.
+	FieldProviderSubscriptionID = "provider_subscription_id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldTax holds the string denoting the tax field in the database.
+	FieldTax = "tax"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldAmountDue holds the string denoting the amount_due field in the database.
+	FieldAmountDue = "amount_due"
+	// FieldAmountPaid holds the string denoting the amount_paid field in the database.
+	FieldAmountPaid = "amount_paid"
+	// FieldHostedLink holds the string denoting the hosted_link field in the database.
+	FieldHostedLink = "hosted_link"
+	// FieldPdfLink holds the string denoting the pdf_link field in the database.
+	FieldPdfLink = "pdf_link"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldPaidAt holds the string denoting the paid_at field in the database.
+	FieldPaidAt = "paid_at"
+	// FieldIssuedAt holds the string denoting the issued_at field in the database.
+	FieldIssuedAt = "issued_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCustomer holds the string denoting the customer edge name in mutations.
+	EdgeCustomer = "customer"
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+	// CustomerTable is the table that holds the customer relation/edge.
+	CustomerTable = "invoices"
+	// CustomerInverseTable is the table name for the PaymentCustomer entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentcustomer" package.
+	CustomerInverseTable = "payment_customers"
+	// CustomerColumn is the table column denoting the customer relation/edge.
+	CustomerColumn = "payment_customer_invoices"
+)
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldID,
+	FieldProviderInvoiceID,
+	FieldProvider,
+	FieldProviderSubscriptionID,
+	FieldNumber,
+	FieldStatus,
+	FieldCurrency,
+	FieldSubtotal,
+	FieldTax,
+	FieldTotal,
+	FieldAmountDue,
+	FieldAmountPaid,
+	FieldHostedLink,
+	FieldPdfLink,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldPaidAt,
+	FieldIssuedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "invoices"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"payment_customer_invoices",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderInvoiceIDValidator is a validator for the "provider_invoice_id" field. It is called by the builders before save.
+	ProviderInvoiceIDValidator func(string) error
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
+	DefaultSubtotal int64
+	// DefaultTax holds the default value on creation for the "tax" field.
+	DefaultTax int64
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal int64
+	// DefaultAmountDue holds the default value on creation for the "amount_due" field.
+	DefaultAmountDue int64
+	// DefaultAmountPaid holds the default value on creation for the "amount_paid" field.
+	DefaultAmountPaid int64
+	// DefaultIssuedAt holds the default value on creation for the "issued_at" field.
+	DefaultIssuedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusDraft is the default value of the Status enum.
+const DefaultStatus = StatusDraft
+
+// Status values.
+const (
+	StatusDraft         Status = "draft"
+	StatusOpen          Status = "open"
+	StatusPaid          Status = "paid"
+	StatusUncollectible Status = "uncollectible"
+	StatusVoid          Status = "void"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusDraft, StatusOpen, StatusPaid, StatusUncollectible, StatusVoid:
+		return nil
+	default:
+		return fmt.Errorf("invoice: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Invoice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProviderInvoiceID orders the results by the provider_invoice_id field.
+func ByProviderInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderInvoiceID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByProviderSubscriptionID orders the results by the provider_subscription_id field.
+func ByProviderSubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderSubscriptionID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// BySubtotal orders the results by the subtotal field.
+func BySubtotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotal, opts...).ToFunc()
+}
+
+// ByTax orders the results by the tax field.
+func ByTax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTax, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByAmountDue orders the results by the amount_due field.
+func ByAmountDue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountDue, opts...).ToFunc()
+}
+
+// ByAmountPaid orders the results by the amount_paid field.
+func ByAmountPaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountPaid, opts...).ToFunc()
+}
+
+// ByHostedLink orders the results by the hosted_link field.
+func ByHostedLink(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostedLink, opts...).ToFunc()
+}
+
+// ByPdfLink orders the results by the pdf_link field.
+func ByPdfLink(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPdfLink, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByPaidAt orders the results by the paid_at field.
+func ByPaidAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidAt, opts...).ToFunc()
+}
+
+// ByIssuedAt orders the results by the issued_at field.
+func ByIssuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCustomerField orders the results by customer field.
+func ByCustomerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCustomerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+	)
+}
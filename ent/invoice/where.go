@@ -0,0 +1,1169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldID, id))
+}
+
+// ProviderInvoiceID applies equality check predicate on the "provider_invoice_id" field. It's identical to ProviderInvoiceIDEQ.
+func ProviderInvoiceID(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldProviderInvoiceID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderSubscriptionID applies equality check predicate on the "provider_subscription_id" field. It's identical to ProviderSubscriptionIDEQ.
+func ProviderSubscriptionID(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldProviderSubscriptionID, v))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldNumber, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// Tax applies equality check predicate on the "tax" field. It's identical to TaxEQ.
+func Tax(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTax, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotal, v))
+}
+
+// AmountDue applies equality check predicate on the "amount_due" field. It's identical to AmountDueEQ.
+func AmountDue(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAmountDue, v))
+}
+
+// AmountPaid applies equality check predicate on the "amount_paid" field. It's identical to AmountPaidEQ.
+func AmountPaid(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// HostedLink applies equality check predicate on the "hosted_link" field. It's identical to HostedLinkEQ.
+func HostedLink(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldHostedLink, v))
+}
+
+// PdfLink applies equality check predicate on the "pdf_link" field. It's identical to PdfLinkEQ.
+func PdfLink(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPdfLink, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PaidAt applies equality check predicate on the "paid_at" field. It's identical to PaidAtEQ.
+func PaidAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProviderInvoiceIDEQ applies the EQ predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDNEQ applies the NEQ predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDIn applies the In predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldProviderInvoiceID, vs...))
+}
+
+// ProviderInvoiceIDNotIn applies the NotIn predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldProviderInvoiceID, vs...))
+}
+
+// ProviderInvoiceIDGT applies the GT predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDGTE applies the GTE predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDLT applies the LT predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDLTE applies the LTE predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDContains applies the Contains predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDHasPrefix applies the HasPrefix predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDHasSuffix applies the HasSuffix predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDEqualFold applies the EqualFold predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldProviderInvoiceID, v))
+}
+
+// ProviderInvoiceIDContainsFold applies the ContainsFold predicate on the "provider_invoice_id" field.
+func ProviderInvoiceIDContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldProviderInvoiceID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ProviderSubscriptionIDEQ applies the EQ predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDNEQ applies the NEQ predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDIn applies the In predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldProviderSubscriptionID, vs...))
+}
+
+// ProviderSubscriptionIDNotIn applies the NotIn predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldProviderSubscriptionID, vs...))
+}
+
+// ProviderSubscriptionIDGT applies the GT predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDGTE applies the GTE predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDLT applies the LT predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDLTE applies the LTE predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDContains applies the Contains predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDHasPrefix applies the HasPrefix predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDHasSuffix applies the HasSuffix predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDIsNil applies the IsNil predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldProviderSubscriptionID))
+}
+
+// ProviderSubscriptionIDNotNil applies the NotNil predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldProviderSubscriptionID))
+}
+
+// ProviderSubscriptionIDEqualFold applies the EqualFold predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDContainsFold applies the ContainsFold predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldProviderSubscriptionID, v))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldNumber, v))
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldNumber, v))
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldNumber, v))
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldNumber, v))
+}
+
+// NumberIsNil applies the IsNil predicate on the "number" field.
+func NumberIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldNumber))
+}
+
+// NumberNotNil applies the NotNil predicate on the "number" field.
+func NumberNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldNumber))
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldNumber, v))
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldNumber, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSubtotal, v))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSubtotal, vs...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSubtotal, vs...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSubtotal, v))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSubtotal, v))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSubtotal, v))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSubtotal, v))
+}
+
+// TaxEQ applies the EQ predicate on the "tax" field.
+func TaxEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTax, v))
+}
+
+// TaxNEQ applies the NEQ predicate on the "tax" field.
+func TaxNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTax, v))
+}
+
+// TaxIn applies the In predicate on the "tax" field.
+func TaxIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTax, vs...))
+}
+
+// TaxNotIn applies the NotIn predicate on the "tax" field.
+func TaxNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTax, vs...))
+}
+
+// TaxGT applies the GT predicate on the "tax" field.
+func TaxGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTax, v))
+}
+
+// TaxGTE applies the GTE predicate on the "tax" field.
+func TaxGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTax, v))
+}
+
+// TaxLT applies the LT predicate on the "tax" field.
+func TaxLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTax, v))
+}
+
+// TaxLTE applies the LTE predicate on the "tax" field.
+func TaxLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTax, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTotal, v))
+}
+
+// AmountDueEQ applies the EQ predicate on the "amount_due" field.
+func AmountDueEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAmountDue, v))
+}
+
+// AmountDueNEQ applies the NEQ predicate on the "amount_due" field.
+func AmountDueNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldAmountDue, v))
+}
+
+// AmountDueIn applies the In predicate on the "amount_due" field.
+func AmountDueIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldAmountDue, vs...))
+}
+
+// AmountDueNotIn applies the NotIn predicate on the "amount_due" field.
+func AmountDueNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldAmountDue, vs...))
+}
+
+// AmountDueGT applies the GT predicate on the "amount_due" field.
+func AmountDueGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldAmountDue, v))
+}
+
+// AmountDueGTE applies the GTE predicate on the "amount_due" field.
+func AmountDueGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldAmountDue, v))
+}
+
+// AmountDueLT applies the LT predicate on the "amount_due" field.
+func AmountDueLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldAmountDue, v))
+}
+
+// AmountDueLTE applies the LTE predicate on the "amount_due" field.
+func AmountDueLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldAmountDue, v))
+}
+
+// AmountPaidEQ applies the EQ predicate on the "amount_paid" field.
+func AmountPaidEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidNEQ applies the NEQ predicate on the "amount_paid" field.
+func AmountPaidNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidIn applies the In predicate on the "amount_paid" field.
+func AmountPaidIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidNotIn applies the NotIn predicate on the "amount_paid" field.
+func AmountPaidNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidGT applies the GT predicate on the "amount_paid" field.
+func AmountPaidGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldAmountPaid, v))
+}
+
+// AmountPaidGTE applies the GTE predicate on the "amount_paid" field.
+func AmountPaidGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldAmountPaid, v))
+}
+
+// AmountPaidLT applies the LT predicate on the "amount_paid" field.
+func AmountPaidLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldAmountPaid, v))
+}
+
+// AmountPaidLTE applies the LTE predicate on the "amount_paid" field.
+func AmountPaidLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldAmountPaid, v))
+}
+
+// HostedLinkEQ applies the EQ predicate on the "hosted_link" field.
+func HostedLinkEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldHostedLink, v))
+}
+
+// HostedLinkNEQ applies the NEQ predicate on the "hosted_link" field.
+func HostedLinkNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldHostedLink, v))
+}
+
+// HostedLinkIn applies the In predicate on the "hosted_link" field.
+func HostedLinkIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldHostedLink, vs...))
+}
+
+// HostedLinkNotIn applies the NotIn predicate on the "hosted_link" field.
+func HostedLinkNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldHostedLink, vs...))
+}
+
+// HostedLinkGT applies the GT predicate on the "hosted_link" field.
+func HostedLinkGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldHostedLink, v))
+}
+
+// HostedLinkGTE applies the GTE predicate on the "hosted_link" field.
+func HostedLinkGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldHostedLink, v))
+}
+
+// HostedLinkLT applies the LT predicate on the "hosted_link" field.
+func HostedLinkLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldHostedLink, v))
+}
+
+// HostedLinkLTE applies the LTE predicate on the "hosted_link" field.
+func HostedLinkLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldHostedLink, v))
+}
+
+// HostedLinkContains applies the Contains predicate on the "hosted_link" field.
+func HostedLinkContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldHostedLink, v))
+}
+
+// HostedLinkHasPrefix applies the HasPrefix predicate on the "hosted_link" field.
+func HostedLinkHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldHostedLink, v))
+}
+
+// HostedLinkHasSuffix applies the HasSuffix predicate on the "hosted_link" field.
+func HostedLinkHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldHostedLink, v))
+}
+
+// HostedLinkIsNil applies the IsNil predicate on the "hosted_link" field.
+func HostedLinkIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldHostedLink))
+}
+
+// HostedLinkNotNil applies the NotNil predicate on the "hosted_link" field.
+func HostedLinkNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldHostedLink))
+}
+
+// HostedLinkEqualFold applies the EqualFold predicate on the "hosted_link" field.
+func HostedLinkEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldHostedLink, v))
+}
+
+// HostedLinkContainsFold applies the ContainsFold predicate on the "hosted_link" field.
+func HostedLinkContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldHostedLink, v))
+}
+
+// PdfLinkEQ applies the EQ predicate on the "pdf_link" field.
+func PdfLinkEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPdfLink, v))
+}
+
+// PdfLinkNEQ applies the NEQ predicate on the "pdf_link" field.
+func PdfLinkNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPdfLink, v))
+}
+
+// PdfLinkIn applies the In predicate on the "pdf_link" field.
+func PdfLinkIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPdfLink, vs...))
+}
+
+// PdfLinkNotIn applies the NotIn predicate on the "pdf_link" field.
+func PdfLinkNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPdfLink, vs...))
+}
+
+// PdfLinkGT applies the GT predicate on the "pdf_link" field.
+func PdfLinkGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPdfLink, v))
+}
+
+// PdfLinkGTE applies the GTE predicate on the "pdf_link" field.
+func PdfLinkGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPdfLink, v))
+}
+
+// PdfLinkLT applies the LT predicate on the "pdf_link" field.
+func PdfLinkLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPdfLink, v))
+}
+
+// PdfLinkLTE applies the LTE predicate on the "pdf_link" field.
+func PdfLinkLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPdfLink, v))
+}
+
+// PdfLinkContains applies the Contains predicate on the "pdf_link" field.
+func PdfLinkContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldPdfLink, v))
+}
+
+// PdfLinkHasPrefix applies the HasPrefix predicate on the "pdf_link" field.
+func PdfLinkHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldPdfLink, v))
+}
+
+// PdfLinkHasSuffix applies the HasSuffix predicate on the "pdf_link" field.
+func PdfLinkHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldPdfLink, v))
+}
+
+// PdfLinkIsNil applies the IsNil predicate on the "pdf_link" field.
+func PdfLinkIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldPdfLink))
+}
+
+// PdfLinkNotNil applies the NotNil predicate on the "pdf_link" field.
+func PdfLinkNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldPdfLink))
+}
+
+// PdfLinkEqualFold applies the EqualFold predicate on the "pdf_link" field.
+func PdfLinkEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldPdfLink, v))
+}
+
+// PdfLinkContainsFold applies the ContainsFold predicate on the "pdf_link" field.
+func PdfLinkContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldPdfLink, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodStartIsNil applies the IsNil predicate on the "period_start" field.
+func PeriodStartIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldPeriodStart))
+}
+
+// PeriodStartNotNil applies the NotNil predicate on the "period_start" field.
+func PeriodStartNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldPeriodStart))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndIsNil applies the IsNil predicate on the "period_end" field.
+func PeriodEndIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldPeriodEnd))
+}
+
+// PeriodEndNotNil applies the NotNil predicate on the "period_end" field.
+func PeriodEndNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldPeriodEnd))
+}
+
+// PaidAtEQ applies the EQ predicate on the "paid_at" field.
+func PaidAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// PaidAtNEQ applies the NEQ predicate on the "paid_at" field.
+func PaidAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPaidAt, v))
+}
+
+// PaidAtIn applies the In predicate on the "paid_at" field.
+func PaidAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPaidAt, vs...))
+}
+
+// PaidAtNotIn applies the NotIn predicate on the "paid_at" field.
+func PaidAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPaidAt, vs...))
+}
+
+// PaidAtGT applies the GT predicate on the "paid_at" field.
+func PaidAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPaidAt, v))
+}
+
+// PaidAtGTE applies the GTE predicate on the "paid_at" field.
+func PaidAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPaidAt, v))
+}
+
+// PaidAtLT applies the LT predicate on the "paid_at" field.
+func PaidAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPaidAt, v))
+}
+
+// PaidAtLTE applies the LTE predicate on the "paid_at" field.
+func PaidAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPaidAt, v))
+}
+
+// PaidAtIsNil applies the IsNil predicate on the "paid_at" field.
+func PaidAtIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldPaidAt))
+}
+
+// PaidAtNotNil applies the NotNil predicate on the "paid_at" field.
+func PaidAtNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldPaidAt))
+}
+
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldIssuedAt, v))
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldIssuedAt, v))
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldIssuedAt, v))
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldIssuedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasCustomer applies the HasEdge predicate on the "customer" edge.
+func HasCustomer() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomerWith applies the HasEdge predicate on the "customer" edge with a given conditions (other predicates).
+func HasCustomerWith(preds ...predicate.PaymentCustomer) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newCustomerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.NotPredicates(p))
+}
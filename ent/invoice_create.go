@@ -0,0 +1,1846 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/invoice"
+	"github.com/occult/pagode/ent/paymentcustomer"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProviderInvoiceID sets the "provider_invoice_id" field.
+func (ic *InvoiceCreate) SetProviderInvoiceID(s string) *InvoiceCreate {
+	ic.mutation.SetProviderInvoiceID(s)
+	return ic
+}
+
+// SetProvider sets the "provider" field.
+func (ic *InvoiceCreate) SetProvider(s string) *InvoiceCreate {
+	ic.mutation.SetProvider(s)
+	return ic
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableProvider(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetProvider(*s)
+	}
+	return ic
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (ic *InvoiceCreate) SetProviderSubscriptionID(s string) *InvoiceCreate {
+	ic.mutation.SetProviderSubscriptionID(s)
+	return ic
+}
+
+// SetNillableProviderSubscriptionID sets the "provider_subscription_id" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableProviderSubscriptionID(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetProviderSubscriptionID(*s)
+	}
+	return ic
+}
+
+// SetNumber sets the "number" field.
+func (ic *InvoiceCreate) SetNumber(s string) *InvoiceCreate {
+	ic.mutation.SetNumber(s)
+	return ic
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableNumber(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetNumber(*s)
+	}
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *InvoiceCreate) SetStatus(i invoice.Status) *InvoiceCreate {
+	ic.mutation.SetStatus(i)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableStatus(i *invoice.Status) *InvoiceCreate {
+	if i != nil {
+		ic.SetStatus(*i)
+	}
+	return ic
+}
+
+// SetCurrency sets the "currency" field.
+func (ic *InvoiceCreate) SetCurrency(s string) *InvoiceCreate {
+	ic.mutation.SetCurrency(s)
+	return ic
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCurrency(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetCurrency(*s)
+	}
+	return ic
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (ic *InvoiceCreate) SetSubtotal(i int64) *InvoiceCreate {
+	ic.mutation.SetSubtotal(i)
+	return ic
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableSubtotal(i *int64) *InvoiceCreate {
+	if i != nil {
+		ic.SetSubtotal(*i)
+	}
+	return ic
+}
+
+// SetTax sets the "tax" field.
+func (ic *InvoiceCreate) SetTax(i int64) *InvoiceCreate {
+	ic.mutation.SetTax(i)
+	return ic
+}
+
+// SetNillableTax sets the "tax" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTax(i *int64) *InvoiceCreate {
+	if i != nil {
+		ic.SetTax(*i)
+	}
+	return ic
+}
+
+// SetTotal sets the "total" field.
+func (ic *InvoiceCreate) SetTotal(i int64) *InvoiceCreate {
+	ic.mutation.SetTotal(i)
+	return ic
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTotal(i *int64) *InvoiceCreate {
+	if i != nil {
+		ic.SetTotal(*i)
+	}
+	return ic
+}
+
+// SetAmountDue sets the "amount_due" field.
+func (ic *InvoiceCreate) SetAmountDue(i int64) *InvoiceCreate {
+	ic.mutation.SetAmountDue(i)
+	return ic
+}
+
+// SetNillableAmountDue sets the "amount_due" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableAmountDue(i *int64) *InvoiceCreate {
+	if i != nil {
+		ic.SetAmountDue(*i)
+	}
+	return ic
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (ic *InvoiceCreate) SetAmountPaid(i int64) *InvoiceCreate {
+	ic.mutation.SetAmountPaid(i)
+	return ic
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableAmountPaid(i *int64) *InvoiceCreate {
+	if i != nil {
+		ic.SetAmountPaid(*i)
+	}
+	return ic
+}
+
+// SetHostedLink sets the "hosted_link" field.
+func (ic *InvoiceCreate) SetHostedLink(s string) *InvoiceCreate {
+	ic.mutation.SetHostedLink(s)
+	return ic
+}
+
+// SetNillableHostedLink sets the "hosted_link" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableHostedLink(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetHostedLink(*s)
+	}
+	return ic
+}
+
+// SetPdfLink sets the "pdf_link" field.
+func (ic *InvoiceCreate) SetPdfLink(s string) *InvoiceCreate {
+	ic.mutation.SetPdfLink(s)
+	return ic
+}
+
+// SetNillablePdfLink sets the "pdf_link" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePdfLink(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetPdfLink(*s)
+	}
+	return ic
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (ic *InvoiceCreate) SetPeriodStart(t time.Time) *InvoiceCreate {
+	ic.mutation.SetPeriodStart(t)
+	return ic
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePeriodStart(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetPeriodStart(*t)
+	}
+	return ic
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (ic *InvoiceCreate) SetPeriodEnd(t time.Time) *InvoiceCreate {
+	ic.mutation.SetPeriodEnd(t)
+	return ic
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePeriodEnd(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetPeriodEnd(*t)
+	}
+	return ic
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (ic *InvoiceCreate) SetPaidAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetPaidAt(t)
+	return ic
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePaidAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetPaidAt(*t)
+	}
+	return ic
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (ic *InvoiceCreate) SetIssuedAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetIssuedAt(t)
+	return ic
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableIssuedAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetIssuedAt(*t)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InvoiceCreate) SetCreatedAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCreatedAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ic *InvoiceCreate) SetUpdatedAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetUpdatedAt(t)
+	return ic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableUpdatedAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetUpdatedAt(*t)
+	}
+	return ic
+}
+
+// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by ID.
+func (ic *InvoiceCreate) SetCustomerID(id int) *InvoiceCreate {
+	ic.mutation.SetCustomerID(id)
+	return ic
+}
+
+// SetCustomer sets the "customer" edge to the PaymentCustomer entity.
+func (ic *InvoiceCreate) SetCustomer(p *PaymentCustomer) *InvoiceCreate {
+	return ic.SetCustomerID(p.ID)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (ic *InvoiceCreate) Mutation() *InvoiceMutation {
+	return ic.mutation
+}
+
+// Save creates the Invoice in the database.
+func (ic *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvoiceCreate) defaults() {
+	if _, ok := ic.mutation.Provider(); !ok {
+		v := invoice.DefaultProvider
+		ic.mutation.SetProvider(v)
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		v := invoice.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	if _, ok := ic.mutation.Currency(); !ok {
+		v := invoice.DefaultCurrency
+		ic.mutation.SetCurrency(v)
+	}
+	if _, ok := ic.mutation.Subtotal(); !ok {
+		v := invoice.DefaultSubtotal
+		ic.mutation.SetSubtotal(v)
+	}
+	if _, ok := ic.mutation.Tax(); !ok {
+		v := invoice.DefaultTax
+		ic.mutation.SetTax(v)
+	}
+	if _, ok := ic.mutation.Total(); !ok {
+		v := invoice.DefaultTotal
+		ic.mutation.SetTotal(v)
+	}
+	if _, ok := ic.mutation.AmountDue(); !ok {
+		v := invoice.DefaultAmountDue
+		ic.mutation.SetAmountDue(v)
+	}
+	if _, ok := ic.mutation.AmountPaid(); !ok {
+		v := invoice.DefaultAmountPaid
+		ic.mutation.SetAmountPaid(v)
+	}
+	if _, ok := ic.mutation.IssuedAt(); !ok {
+		v := invoice.DefaultIssuedAt()
+		ic.mutation.SetIssuedAt(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := invoice.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		v := invoice.DefaultUpdatedAt()
+		ic.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvoiceCreate) check() error {
+	if _, ok := ic.mutation.ProviderInvoiceID(); !ok {
+		return &ValidationError{Name: "provider_invoice_id", err: errors.New(`ent: missing required field "Invoice.provider_invoice_id"`)}
+	}
+	if v, ok := ic.mutation.ProviderInvoiceID(); ok {
+		if err := invoice.ProviderInvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_invoice_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.provider_invoice_id": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "Invoice.provider"`)}
+	}
+	if v, ok := ic.mutation.Provider(); ok {
+		if err := invoice.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Invoice.provider": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invoice.status"`)}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Invoice.currency"`)}
+	}
+	if v, ok := ic.mutation.Currency(); ok {
+		if err := invoice.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Invoice.currency": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "Invoice.subtotal"`)}
+	}
+	if _, ok := ic.mutation.Tax(); !ok {
+		return &ValidationError{Name: "tax", err: errors.New(`ent: missing required field "Invoice.tax"`)}
+	}
+	if _, ok := ic.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Invoice.total"`)}
+	}
+	if _, ok := ic.mutation.AmountDue(); !ok {
+		return &ValidationError{Name: "amount_due", err: errors.New(`ent: missing required field "Invoice.amount_due"`)}
+	}
+	if _, ok := ic.mutation.AmountPaid(); !ok {
+		return &ValidationError{Name: "amount_paid", err: errors.New(`ent: missing required field "Invoice.amount_paid"`)}
+	}
+	if _, ok := ic.mutation.IssuedAt(); !ok {
+		return &ValidationError{Name: "issued_at", err: errors.New(`ent: missing required field "Invoice.issued_at"`)}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invoice.created_at"`)}
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Invoice.updated_at"`)}
+	}
+	if len(ic.mutation.CustomerIDs()) == 0 {
+		return &ValidationError{Name: "customer", err: errors.New(`ent: missing required edge "Invoice.customer"`)}
+	}
+	return nil
+}
+
+func (ic *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ic.conflict
+	if value, ok := ic.mutation.ProviderInvoiceID(); ok {
+		_spec.SetField(invoice.FieldProviderInvoiceID, field.TypeString, value)
+		_node.ProviderInvoiceID = value
+	}
+	if value, ok := ic.mutation.Provider(); ok {
+		_spec.SetField(invoice.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := ic.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(invoice.FieldProviderSubscriptionID, field.TypeString, value)
+		_node.ProviderSubscriptionID = value
+	}
+	if value, ok := ic.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := ic.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeInt64, value)
+		_node.Subtotal = value
+	}
+	if value, ok := ic.mutation.Tax(); ok {
+		_spec.SetField(invoice.FieldTax, field.TypeInt64, value)
+		_node.Tax = value
+	}
+	if value, ok := ic.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeInt64, value)
+		_node.Total = value
+	}
+	if value, ok := ic.mutation.AmountDue(); ok {
+		_spec.SetField(invoice.FieldAmountDue, field.TypeInt64, value)
+		_node.AmountDue = value
+	}
+	if value, ok := ic.mutation.AmountPaid(); ok {
+		_spec.SetField(invoice.FieldAmountPaid, field.TypeInt64, value)
+		_node.AmountPaid = value
+	}
+	if value, ok := ic.mutation.HostedLink(); ok {
+		_spec.SetField(invoice.FieldHostedLink, field.TypeString, value)
+		_node.HostedLink = value
+	}
+	if value, ok := ic.mutation.PdfLink(); ok {
+		_spec.SetField(invoice.FieldPdfLink, field.TypeString, value)
+		_node.PdfLink = value
+	}
+	if value, ok := ic.mutation.PeriodStart(); ok {
+		_spec.SetField(invoice.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := ic.mutation.PeriodEnd(); ok {
+		_spec.SetField(invoice.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := ic.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = value
+	}
+	if value, ok := ic.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+		_node.IssuedAt = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invoice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ic.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentcustomer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_customer_invoices = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.Create().
+//		SetProviderInvoiceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetProviderInvoiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *InvoiceCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertOne {
+	ic.conflict = opts
+	return &InvoiceUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *InvoiceCreate) OnConflictColumns(columns ...string) *InvoiceUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// InvoiceUpsertOne is the builder for "upsert"-ing
+	//  one Invoice node.
+	InvoiceUpsertOne struct {
+		create *InvoiceCreate
+	}
+
+	// InvoiceUpsert is the "OnConflict" setter.
+	InvoiceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProviderInvoiceID sets the "provider_invoice_id" field.
+func (u *InvoiceUpsert) SetProviderInvoiceID(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldProviderInvoiceID, v)
+	return u
+}
+
+// UpdateProviderInvoiceID sets the "provider_invoice_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateProviderInvoiceID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldProviderInvoiceID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *InvoiceUpsert) SetProvider(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateProvider() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldProvider)
+	return u
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *InvoiceUpsert) SetProviderSubscriptionID(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldProviderSubscriptionID, v)
+	return u
+}
+
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateProviderSubscriptionID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldProviderSubscriptionID)
+	return u
+}
+
+// ClearProviderSubscriptionID clears the value of the "provider_subscription_id" field.
+func (u *InvoiceUpsert) ClearProviderSubscriptionID() *InvoiceUpsert {
+	u.SetNull(invoice.FieldProviderSubscriptionID)
+	return u
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsert) SetNumber(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldNumber, v)
+	return u
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateNumber() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldNumber)
+	return u
+}
+
+// ClearNumber clears the value of the "number" field.
+func (u *InvoiceUpsert) ClearNumber() *InvoiceUpsert {
+	u.SetNull(invoice.FieldNumber)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsert) SetStatus(v invoice.Status) *InvoiceUpsert {
+	u.Set(invoice.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateStatus() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldStatus)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsert) SetCurrency(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateCurrency() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldCurrency)
+	return u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsert) SetSubtotal(v int64) *InvoiceUpsert {
+	u.Set(invoice.FieldSubtotal, v)
+	return u
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSubtotal() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSubtotal)
+	return u
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *InvoiceUpsert) AddSubtotal(v int64) *InvoiceUpsert {
+	u.Add(invoice.FieldSubtotal, v)
+	return u
+}
+
+// SetTax sets the "tax" field.
+func (u *InvoiceUpsert) SetTax(v int64) *InvoiceUpsert {
+	u.Set(invoice.FieldTax, v)
+	return u
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTax() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTax)
+	return u
+}
+
+// AddTax adds v to the "tax" field.
+func (u *InvoiceUpsert) AddTax(v int64) *InvoiceUpsert {
+	u.Add(invoice.FieldTax, v)
+	return u
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsert) SetTotal(v int64) *InvoiceUpsert {
+	u.Set(invoice.FieldTotal, v)
+	return u
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTotal() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTotal)
+	return u
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsert) AddTotal(v int64) *InvoiceUpsert {
+	u.Add(invoice.FieldTotal, v)
+	return u
+}
+
+// SetAmountDue sets the "amount_due" field.
+func (u *InvoiceUpsert) SetAmountDue(v int64) *InvoiceUpsert {
+	u.Set(invoice.FieldAmountDue, v)
+	return u
+}
+
+// UpdateAmountDue sets the "amount_due" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateAmountDue() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldAmountDue)
+	return u
+}
+
+// AddAmountDue adds v to the "amount_due" field.
+func (u *InvoiceUpsert) AddAmountDue(v int64) *InvoiceUpsert {
+	u.Add(invoice.FieldAmountDue, v)
+	return u
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (u *InvoiceUpsert) SetAmountPaid(v int64) *InvoiceUpsert {
+	u.Set(invoice.FieldAmountPaid, v)
+	return u
+}
+
+// UpdateAmountPaid sets the "amount_paid" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateAmountPaid() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldAmountPaid)
+	return u
+}
+
+// AddAmountPaid adds v to the "amount_paid" field.
+func (u *InvoiceUpsert) AddAmountPaid(v int64) *InvoiceUpsert {
+	u.Add(invoice.FieldAmountPaid, v)
+	return u
+}
+
+// SetHostedLink sets the "hosted_link" field.
+func (u *InvoiceUpsert) SetHostedLink(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldHostedLink, v)
+	return u
+}
+
+// UpdateHostedLink sets the "hosted_link" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateHostedLink() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldHostedLink)
+	return u
+}
+
+// ClearHostedLink clears the value of the "hosted_link" field.
+func (u *InvoiceUpsert) ClearHostedLink() *InvoiceUpsert {
+	u.SetNull(invoice.FieldHostedLink)
+	return u
+}
+
+// SetPdfLink sets the "pdf_link" field.
+func (u *InvoiceUpsert) SetPdfLink(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldPdfLink, v)
+	return u
+}
+
+// UpdatePdfLink sets the "pdf_link" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePdfLink() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPdfLink)
+	return u
+}
+
+// ClearPdfLink clears the value of the "pdf_link" field.
+func (u *InvoiceUpsert) ClearPdfLink() *InvoiceUpsert {
+	u.SetNull(invoice.FieldPdfLink)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *InvoiceUpsert) SetPeriodStart(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePeriodStart() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPeriodStart)
+	return u
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (u *InvoiceUpsert) ClearPeriodStart() *InvoiceUpsert {
+	u.SetNull(invoice.FieldPeriodStart)
+	return u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *InvoiceUpsert) SetPeriodEnd(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldPeriodEnd, v)
+	return u
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePeriodEnd() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPeriodEnd)
+	return u
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (u *InvoiceUpsert) ClearPeriodEnd() *InvoiceUpsert {
+	u.SetNull(invoice.FieldPeriodEnd)
+	return u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoiceUpsert) SetPaidAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldPaidAt, v)
+	return u
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePaidAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPaidAt)
+	return u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *InvoiceUpsert) ClearPaidAt() *InvoiceUpsert {
+	u.SetNull(invoice.FieldPaidAt)
+	return u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (u *InvoiceUpsert) SetIssuedAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldIssuedAt, v)
+	return u
+}
+
+// UpdateIssuedAt sets the "issued_at" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateIssuedAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldIssuedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceUpsert) SetUpdatedAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateUpdatedAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertOne) UpdateNewValues() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invoice.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceUpsertOne) Ignore() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertOne) DoNothing() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertOne) Update(set func(*InvoiceUpsert)) *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderInvoiceID sets the "provider_invoice_id" field.
+func (u *InvoiceUpsertOne) SetProviderInvoiceID(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetProviderInvoiceID(v)
+	})
+}
+
+// UpdateProviderInvoiceID sets the "provider_invoice_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateProviderInvoiceID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateProviderInvoiceID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *InvoiceUpsertOne) SetProvider(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateProvider() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *InvoiceUpsertOne) SetProviderSubscriptionID(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetProviderSubscriptionID(v)
+	})
+}
+
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateProviderSubscriptionID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateProviderSubscriptionID()
+	})
+}
+
+// ClearProviderSubscriptionID clears the value of the "provider_subscription_id" field.
+func (u *InvoiceUpsertOne) ClearProviderSubscriptionID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearProviderSubscriptionID()
+	})
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsertOne) SetNumber(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetNumber(v)
+	})
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateNumber() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateNumber()
+	})
+}
+
+// ClearNumber clears the value of the "number" field.
+func (u *InvoiceUpsertOne) ClearNumber() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearNumber()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsertOne) SetStatus(v invoice.Status) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateStatus() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsertOne) SetCurrency(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateCurrency() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsertOne) SetSubtotal(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *InvoiceUpsertOne) AddSubtotal(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSubtotal() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetTax sets the "tax" field.
+func (u *InvoiceUpsertOne) SetTax(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTax(v)
+	})
+}
+
+// AddTax adds v to the "tax" field.
+func (u *InvoiceUpsertOne) AddTax(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTax(v)
+	})
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTax() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTax()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsertOne) SetTotal(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsertOne) AddTotal(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTotal() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetAmountDue sets the "amount_due" field.
+func (u *InvoiceUpsertOne) SetAmountDue(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetAmountDue(v)
+	})
+}
+
+// AddAmountDue adds v to the "amount_due" field.
+func (u *InvoiceUpsertOne) AddAmountDue(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddAmountDue(v)
+	})
+}
+
+// UpdateAmountDue sets the "amount_due" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateAmountDue() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateAmountDue()
+	})
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (u *InvoiceUpsertOne) SetAmountPaid(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetAmountPaid(v)
+	})
+}
+
+// AddAmountPaid adds v to the "amount_paid" field.
+func (u *InvoiceUpsertOne) AddAmountPaid(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddAmountPaid(v)
+	})
+}
+
+// UpdateAmountPaid sets the "amount_paid" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateAmountPaid() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateAmountPaid()
+	})
+}
+
+// SetHostedLink sets the "hosted_link" field.
+func (u *InvoiceUpsertOne) SetHostedLink(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetHostedLink(v)
+	})
+}
+
+// UpdateHostedLink sets the "hosted_link" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateHostedLink() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateHostedLink()
+	})
+}
+
+// ClearHostedLink clears the value of the "hosted_link" field.
+func (u *InvoiceUpsertOne) ClearHostedLink() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearHostedLink()
+	})
+}
+
+// SetPdfLink sets the "pdf_link" field.
+func (u *InvoiceUpsertOne) SetPdfLink(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPdfLink(v)
+	})
+}
+
+// UpdatePdfLink sets the "pdf_link" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePdfLink() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePdfLink()
+	})
+}
+
+// ClearPdfLink clears the value of the "pdf_link" field.
+func (u *InvoiceUpsertOne) ClearPdfLink() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPdfLink()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *InvoiceUpsertOne) SetPeriodStart(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePeriodStart() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (u *InvoiceUpsertOne) ClearPeriodStart() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *InvoiceUpsertOne) SetPeriodEnd(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePeriodEnd() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (u *InvoiceUpsertOne) ClearPeriodEnd() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPeriodEnd()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoiceUpsertOne) SetPaidAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePaidAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *InvoiceUpsertOne) ClearPaidAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (u *InvoiceUpsertOne) SetIssuedAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetIssuedAt(v)
+	})
+}
+
+// UpdateIssuedAt sets the "issued_at" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateIssuedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateIssuedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceUpsertOne) SetUpdatedAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateUpdatedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invoice entities in the database.
+func (icb *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invoice, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetProviderInvoiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *InvoiceCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertBulk {
+	icb.conflict = opts
+	return &InvoiceUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *InvoiceCreateBulk) OnConflictColumns(columns ...string) *InvoiceUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertBulk{
+		create: icb,
+	}
+}
+
+// InvoiceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invoice nodes.
+type InvoiceUpsertBulk struct {
+	create *InvoiceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) UpdateNewValues() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invoice.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) Ignore() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertBulk) DoNothing() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertBulk) Update(set func(*InvoiceUpsert)) *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderInvoiceID sets the "provider_invoice_id" field.
+func (u *InvoiceUpsertBulk) SetProviderInvoiceID(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetProviderInvoiceID(v)
+	})
+}
+
+// UpdateProviderInvoiceID sets the "provider_invoice_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateProviderInvoiceID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateProviderInvoiceID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *InvoiceUpsertBulk) SetProvider(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateProvider() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *InvoiceUpsertBulk) SetProviderSubscriptionID(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetProviderSubscriptionID(v)
+	})
+}
+
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateProviderSubscriptionID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateProviderSubscriptionID()
+	})
+}
+
+// ClearProviderSubscriptionID clears the value of the "provider_subscription_id" field.
+func (u *InvoiceUpsertBulk) ClearProviderSubscriptionID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearProviderSubscriptionID()
+	})
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsertBulk) SetNumber(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetNumber(v)
+	})
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateNumber() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateNumber()
+	})
+}
+
+// ClearNumber clears the value of the "number" field.
+func (u *InvoiceUpsertBulk) ClearNumber() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearNumber()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsertBulk) SetStatus(v invoice.Status) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateStatus() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsertBulk) SetCurrency(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateCurrency() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsertBulk) SetSubtotal(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *InvoiceUpsertBulk) AddSubtotal(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSubtotal() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetTax sets the "tax" field.
+func (u *InvoiceUpsertBulk) SetTax(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTax(v)
+	})
+}
+
+// AddTax adds v to the "tax" field.
+func (u *InvoiceUpsertBulk) AddTax(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTax(v)
+	})
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTax() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTax()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsertBulk) SetTotal(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsertBulk) AddTotal(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTotal() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetAmountDue sets the "amount_due" field.
+func (u *InvoiceUpsertBulk) SetAmountDue(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetAmountDue(v)
+	})
+}
+
+// AddAmountDue adds v to the "amount_due" field.
+func (u *InvoiceUpsertBulk) AddAmountDue(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddAmountDue(v)
+	})
+}
+
+// UpdateAmountDue sets the "amount_due" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateAmountDue() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateAmountDue()
+	})
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (u *InvoiceUpsertBulk) SetAmountPaid(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetAmountPaid(v)
+	})
+}
+
+// AddAmountPaid adds v to the "amount_paid" field.
+func (u *InvoiceUpsertBulk) AddAmountPaid(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddAmountPaid(v)
+	})
+}
+
+// UpdateAmountPaid sets the "amount_paid" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateAmountPaid() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateAmountPaid()
+	})
+}
+
+// SetHostedLink sets the "hosted_link" field.
+func (u *InvoiceUpsertBulk) SetHostedLink(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetHostedLink(v)
+	})
+}
+
+// UpdateHostedLink sets the "hosted_link" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateHostedLink() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateHostedLink()
+	})
+}
+
+// ClearHostedLink clears the value of the "hosted_link" field.
+func (u *InvoiceUpsertBulk) ClearHostedLink() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearHostedLink()
+	})
+}
+
+// SetPdfLink sets the "pdf_link" field.
+func (u *InvoiceUpsertBulk) SetPdfLink(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPdfLink(v)
+	})
+}
+
+// UpdatePdfLink sets the "pdf_link" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePdfLink() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePdfLink()
+	})
+}
+
+// ClearPdfLink clears the value of the "pdf_link" field.
+func (u *InvoiceUpsertBulk) ClearPdfLink() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPdfLink()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *InvoiceUpsertBulk) SetPeriodStart(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePeriodStart() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (u *InvoiceUpsertBulk) ClearPeriodStart() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *InvoiceUpsertBulk) SetPeriodEnd(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePeriodEnd() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (u *InvoiceUpsertBulk) ClearPeriodEnd() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPeriodEnd()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoiceUpsertBulk) SetPaidAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePaidAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *InvoiceUpsertBulk) ClearPaidAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (u *InvoiceUpsertBulk) SetIssuedAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetIssuedAt(v)
+	})
+}
+
+// UpdateIssuedAt sets the "issued_at" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateIssuedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateIssuedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceUpsertBulk) SetUpdatedAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateUpdatedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoiceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
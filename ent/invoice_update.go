@@ -0,0 +1,1171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/invoice"
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (iu *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetProviderInvoiceID sets the "provider_invoice_id" field.
+func (iu *InvoiceUpdate) SetProviderInvoiceID(s string) *InvoiceUpdate {
+	iu.mutation.SetProviderInvoiceID(s)
+	return iu
+}
+
+// SetNillableProviderInvoiceID sets the "provider_invoice_id" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableProviderInvoiceID(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetProviderInvoiceID(*s)
+	}
+	return iu
+}
+
+// SetProvider sets the "provider" field.
+func (iu *InvoiceUpdate) SetProvider(s string) *InvoiceUpdate {
+	iu.mutation.SetProvider(s)
+	return iu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableProvider(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetProvider(*s)
+	}
+	return iu
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (iu *InvoiceUpdate) SetProviderSubscriptionID(s string) *InvoiceUpdate {
+	iu.mutation.SetProviderSubscriptionID(s)
+	return iu
+}
+
+// SetNillableProviderSubscriptionID sets the "provider_subscription_id" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableProviderSubscriptionID(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetProviderSubscriptionID(*s)
+	}
+	return iu
+}
+
+// ClearProviderSubscriptionID clears the value of the "provider_subscription_id" field.
+func (iu *InvoiceUpdate) ClearProviderSubscriptionID() *InvoiceUpdate {
+	iu.mutation.ClearProviderSubscriptionID()
+	return iu
+}
+
+// SetNumber sets the "number" field.
+func (iu *InvoiceUpdate) SetNumber(s string) *InvoiceUpdate {
+	iu.mutation.SetNumber(s)
+	return iu
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableNumber(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetNumber(*s)
+	}
+	return iu
+}
+
+// ClearNumber clears the value of the "number" field.
+func (iu *InvoiceUpdate) ClearNumber() *InvoiceUpdate {
+	iu.mutation.ClearNumber()
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InvoiceUpdate) SetStatus(i invoice.Status) *InvoiceUpdate {
+	iu.mutation.SetStatus(i)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableStatus(i *invoice.Status) *InvoiceUpdate {
+	if i != nil {
+		iu.SetStatus(*i)
+	}
+	return iu
+}
+
+// SetCurrency sets the "currency" field.
+func (iu *InvoiceUpdate) SetCurrency(s string) *InvoiceUpdate {
+	iu.mutation.SetCurrency(s)
+	return iu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableCurrency(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetCurrency(*s)
+	}
+	return iu
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iu *InvoiceUpdate) SetSubtotal(i int64) *InvoiceUpdate {
+	iu.mutation.ResetSubtotal()
+	iu.mutation.SetSubtotal(i)
+	return iu
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableSubtotal(i *int64) *InvoiceUpdate {
+	if i != nil {
+		iu.SetSubtotal(*i)
+	}
+	return iu
+}
+
+// AddSubtotal adds i to the "subtotal" field.
+func (iu *InvoiceUpdate) AddSubtotal(i int64) *InvoiceUpdate {
+	iu.mutation.AddSubtotal(i)
+	return iu
+}
+
+// SetTax sets the "tax" field.
+func (iu *InvoiceUpdate) SetTax(i int64) *InvoiceUpdate {
+	iu.mutation.ResetTax()
+	iu.mutation.SetTax(i)
+	return iu
+}
+
+// SetNillableTax sets the "tax" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTax(i *int64) *InvoiceUpdate {
+	if i != nil {
+		iu.SetTax(*i)
+	}
+	return iu
+}
+
+// AddTax adds i to the "tax" field.
+func (iu *InvoiceUpdate) AddTax(i int64) *InvoiceUpdate {
+	iu.mutation.AddTax(i)
+	return iu
+}
+
+// SetTotal sets the "total" field.
+func (iu *InvoiceUpdate) SetTotal(i int64) *InvoiceUpdate {
+	iu.mutation.ResetTotal()
+	iu.mutation.SetTotal(i)
+	return iu
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTotal(i *int64) *InvoiceUpdate {
+	if i != nil {
+		iu.SetTotal(*i)
+	}
+	return iu
+}
+
+// AddTotal adds i to the "total" field.
+func (iu *InvoiceUpdate) AddTotal(i int64) *InvoiceUpdate {
+	iu.mutation.AddTotal(i)
+	return iu
+}
+
+// SetAmountDue sets the "amount_due" field.
+func (iu *InvoiceUpdate) SetAmountDue(i int64) *InvoiceUpdate {
+	iu.mutation.ResetAmountDue()
+	iu.mutation.SetAmountDue(i)
+	return iu
+}
+
+// SetNillableAmountDue sets the "amount_due" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableAmountDue(i *int64) *InvoiceUpdate {
+	if i != nil {
+		iu.SetAmountDue(*i)
+	}
+	return iu
+}
+
+// AddAmountDue adds i to the "amount_due" field.
+func (iu *InvoiceUpdate) AddAmountDue(i int64) *InvoiceUpdate {
+	iu.mutation.AddAmountDue(i)
+	return iu
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (iu *InvoiceUpdate) SetAmountPaid(i int64) *InvoiceUpdate {
+	iu.mutation.ResetAmountPaid()
+	iu.mutation.SetAmountPaid(i)
+	return iu
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableAmountPaid(i *int64) *InvoiceUpdate {
+	if i != nil {
+		iu.SetAmountPaid(*i)
+	}
+	return iu
+}
+
+// AddAmountPaid adds i to the "amount_paid" field.
+func (iu *InvoiceUpdate) AddAmountPaid(i int64) *InvoiceUpdate {
+	iu.mutation.AddAmountPaid(i)
+	return iu
+}
+
+// SetHostedLink sets the "hosted_link" field.
+func (iu *InvoiceUpdate) SetHostedLink(s string) *InvoiceUpdate {
+	iu.mutation.SetHostedLink(s)
+	return iu
+}
+
+// SetNillableHostedLink sets the "hosted_link" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableHostedLink(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetHostedLink(*s)
+	}
+	return iu
+}
+
+// ClearHostedLink clears the value of the "hosted_link" field.
+func (iu *InvoiceUpdate) ClearHostedLink() *InvoiceUpdate {
+	iu.mutation.ClearHostedLink()
+	return iu
+}
+
+// SetPdfLink sets the "pdf_link" field.
+func (iu *InvoiceUpdate) SetPdfLink(s string) *InvoiceUpdate {
+	iu.mutation.SetPdfLink(s)
+	return iu
+}
+
+// SetNillablePdfLink sets the "pdf_link" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePdfLink(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetPdfLink(*s)
+	}
+	return iu
+}
+
+// ClearPdfLink clears the value of the "pdf_link" field.
+func (iu *InvoiceUpdate) ClearPdfLink() *InvoiceUpdate {
+	iu.mutation.ClearPdfLink()
+	return iu
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (iu *InvoiceUpdate) SetPeriodStart(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetPeriodStart(t)
+	return iu
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePeriodStart(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetPeriodStart(*t)
+	}
+	return iu
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (iu *InvoiceUpdate) ClearPeriodStart() *InvoiceUpdate {
+	iu.mutation.ClearPeriodStart()
+	return iu
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (iu *InvoiceUpdate) SetPeriodEnd(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetPeriodEnd(t)
+	return iu
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePeriodEnd(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetPeriodEnd(*t)
+	}
+	return iu
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (iu *InvoiceUpdate) ClearPeriodEnd() *InvoiceUpdate {
+	iu.mutation.ClearPeriodEnd()
+	return iu
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (iu *InvoiceUpdate) SetPaidAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetPaidAt(t)
+	return iu
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePaidAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetPaidAt(*t)
+	}
+	return iu
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (iu *InvoiceUpdate) ClearPaidAt() *InvoiceUpdate {
+	iu.mutation.ClearPaidAt()
+	return iu
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (iu *InvoiceUpdate) SetIssuedAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetIssuedAt(t)
+	return iu
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableIssuedAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetIssuedAt(*t)
+	}
+	return iu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iu *InvoiceUpdate) SetUpdatedAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetUpdatedAt(t)
+	return iu
+}
+
+// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by ID.
+func (iu *InvoiceUpdate) SetCustomerID(id int) *InvoiceUpdate {
+	iu.mutation.SetCustomerID(id)
+	return iu
+}
+
+// SetCustomer sets the "customer" edge to the PaymentCustomer entity.
+func (iu *InvoiceUpdate) SetCustomer(p *PaymentCustomer) *InvoiceUpdate {
+	return iu.SetCustomerID(p.ID)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iu *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return iu.mutation
+}
+
+// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
+func (iu *InvoiceUpdate) ClearCustomer() *InvoiceUpdate {
+	iu.mutation.ClearCustomer()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	iu.defaults()
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iu *InvoiceUpdate) defaults() {
+	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		v := invoice.UpdateDefaultUpdatedAt()
+		iu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvoiceUpdate) check() error {
+	if v, ok := iu.mutation.ProviderInvoiceID(); ok {
+		if err := invoice.ProviderInvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_invoice_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.provider_invoice_id": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Provider(); ok {
+		if err := invoice.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Invoice.provider": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Currency(); ok {
+		if err := invoice.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Invoice.currency": %w`, err)}
+		}
+	}
+	if iu.mutation.CustomerCleared() && len(iu.mutation.CustomerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.customer"`)
+	}
+	return nil
+}
+
+func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.ProviderInvoiceID(); ok {
+		_spec.SetField(invoice.FieldProviderInvoiceID, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Provider(); ok {
+		_spec.SetField(invoice.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(invoice.FieldProviderSubscriptionID, field.TypeString, value)
+	}
+	if iu.mutation.ProviderSubscriptionIDCleared() {
+		_spec.ClearField(invoice.FieldProviderSubscriptionID, field.TypeString)
+	}
+	if value, ok := iu.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if iu.mutation.NumberCleared() {
+		_spec.ClearField(invoice.FieldNumber, field.TypeString)
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedSubtotal(); ok {
+		_spec.AddField(invoice.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.Tax(); ok {
+		_spec.SetField(invoice.FieldTax, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedTax(); ok {
+		_spec.AddField(invoice.FieldTax, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedTotal(); ok {
+		_spec.AddField(invoice.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AmountDue(); ok {
+		_spec.SetField(invoice.FieldAmountDue, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedAmountDue(); ok {
+		_spec.AddField(invoice.FieldAmountDue, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AmountPaid(); ok {
+		_spec.SetField(invoice.FieldAmountPaid, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedAmountPaid(); ok {
+		_spec.AddField(invoice.FieldAmountPaid, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.HostedLink(); ok {
+		_spec.SetField(invoice.FieldHostedLink, field.TypeString, value)
+	}
+	if iu.mutation.HostedLinkCleared() {
+		_spec.ClearField(invoice.FieldHostedLink, field.TypeString)
+	}
+	if value, ok := iu.mutation.PdfLink(); ok {
+		_spec.SetField(invoice.FieldPdfLink, field.TypeString, value)
+	}
+	if iu.mutation.PdfLinkCleared() {
+		_spec.ClearField(invoice.FieldPdfLink, field.TypeString)
+	}
+	if value, ok := iu.mutation.PeriodStart(); ok {
+		_spec.SetField(invoice.FieldPeriodStart, field.TypeTime, value)
+	}
+	if iu.mutation.PeriodStartCleared() {
+		_spec.ClearField(invoice.FieldPeriodStart, field.TypeTime)
+	}
+	if value, ok := iu.mutation.PeriodEnd(); ok {
+		_spec.SetField(invoice.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if iu.mutation.PeriodEndCleared() {
+		_spec.ClearField(invoice.FieldPeriodEnd, field.TypeTime)
+	}
+	if value, ok := iu.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+	}
+	if iu.mutation.PaidAtCleared() {
+		_spec.ClearField(invoice.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iu.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentcustomer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentcustomer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetProviderInvoiceID sets the "provider_invoice_id" field.
+func (iuo *InvoiceUpdateOne) SetProviderInvoiceID(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetProviderInvoiceID(s)
+	return iuo
+}
+
+// SetNillableProviderInvoiceID sets the "provider_invoice_id" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableProviderInvoiceID(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetProviderInvoiceID(*s)
+	}
+	return iuo
+}
+
+// SetProvider sets the "provider" field.
+func (iuo *InvoiceUpdateOne) SetProvider(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetProvider(s)
+	return iuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableProvider(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetProvider(*s)
+	}
+	return iuo
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (iuo *InvoiceUpdateOne) SetProviderSubscriptionID(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetProviderSubscriptionID(s)
+	return iuo
+}
+
+// SetNillableProviderSubscriptionID sets the "provider_subscription_id" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableProviderSubscriptionID(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetProviderSubscriptionID(*s)
+	}
+	return iuo
+}
+
+// ClearProviderSubscriptionID clears the value of the "provider_subscription_id" field.
+func (iuo *InvoiceUpdateOne) ClearProviderSubscriptionID() *InvoiceUpdateOne {
+	iuo.mutation.ClearProviderSubscriptionID()
+	return iuo
+}
+
+// SetNumber sets the "number" field.
+func (iuo *InvoiceUpdateOne) SetNumber(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetNumber(s)
+	return iuo
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableNumber(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetNumber(*s)
+	}
+	return iuo
+}
+
+// ClearNumber clears the value of the "number" field.
+func (iuo *InvoiceUpdateOne) ClearNumber() *InvoiceUpdateOne {
+	iuo.mutation.ClearNumber()
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InvoiceUpdateOne) SetStatus(i invoice.Status) *InvoiceUpdateOne {
+	iuo.mutation.SetStatus(i)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableStatus(i *invoice.Status) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetStatus(*i)
+	}
+	return iuo
+}
+
+// SetCurrency sets the "currency" field.
+func (iuo *InvoiceUpdateOne) SetCurrency(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetCurrency(s)
+	return iuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableCurrency(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetCurrency(*s)
+	}
+	return iuo
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iuo *InvoiceUpdateOne) SetSubtotal(i int64) *InvoiceUpdateOne {
+	iuo.mutation.ResetSubtotal()
+	iuo.mutation.SetSubtotal(i)
+	return iuo
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableSubtotal(i *int64) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetSubtotal(*i)
+	}
+	return iuo
+}
+
+// AddSubtotal adds i to the "subtotal" field.
+func (iuo *InvoiceUpdateOne) AddSubtotal(i int64) *InvoiceUpdateOne {
+	iuo.mutation.AddSubtotal(i)
+	return iuo
+}
+
+// SetTax sets the "tax" field.
+func (iuo *InvoiceUpdateOne) SetTax(i int64) *InvoiceUpdateOne {
+	iuo.mutation.ResetTax()
+	iuo.mutation.SetTax(i)
+	return iuo
+}
+
+// SetNillableTax sets the "tax" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTax(i *int64) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetTax(*i)
+	}
+	return iuo
+}
+
+// AddTax adds i to the "tax" field.
+func (iuo *InvoiceUpdateOne) AddTax(i int64) *InvoiceUpdateOne {
+	iuo.mutation.AddTax(i)
+	return iuo
+}
+
+// SetTotal sets the "total" field.
+func (iuo *InvoiceUpdateOne) SetTotal(i int64) *InvoiceUpdateOne {
+	iuo.mutation.ResetTotal()
+	iuo.mutation.SetTotal(i)
+	return iuo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTotal(i *int64) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetTotal(*i)
+	}
+	return iuo
+}
+
+// AddTotal adds i to the "total" field.
+func (iuo *InvoiceUpdateOne) AddTotal(i int64) *InvoiceUpdateOne {
+	iuo.mutation.AddTotal(i)
+	return iuo
+}
+
+// SetAmountDue sets the "amount_due" field.
+func (iuo *InvoiceUpdateOne) SetAmountDue(i int64) *InvoiceUpdateOne {
+	iuo.mutation.ResetAmountDue()
+	iuo.mutation.SetAmountDue(i)
+	return iuo
+}
+
+// SetNillableAmountDue sets the "amount_due" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableAmountDue(i *int64) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetAmountDue(*i)
+	}
+	return iuo
+}
+
+// AddAmountDue adds i to the "amount_due" field.
+func (iuo *InvoiceUpdateOne) AddAmountDue(i int64) *InvoiceUpdateOne {
+	iuo.mutation.AddAmountDue(i)
+	return iuo
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (iuo *InvoiceUpdateOne) SetAmountPaid(i int64) *InvoiceUpdateOne {
+	iuo.mutation.ResetAmountPaid()
+	iuo.mutation.SetAmountPaid(i)
+	return iuo
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableAmountPaid(i *int64) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetAmountPaid(*i)
+	}
+	return iuo
+}
+
+// AddAmountPaid adds i to the "amount_paid" field.
+func (iuo *InvoiceUpdateOne) AddAmountPaid(i int64) *InvoiceUpdateOne {
+	iuo.mutation.AddAmountPaid(i)
+	return iuo
+}
+
+// SetHostedLink sets the "hosted_link" field.
+func (iuo *InvoiceUpdateOne) SetHostedLink(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetHostedLink(s)
+	return iuo
+}
+
+// SetNillableHostedLink sets the "hosted_link" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableHostedLink(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetHostedLink(*s)
+	}
+	return iuo
+}
+
+// ClearHostedLink clears the value of the "hosted_link" field.
+func (iuo *InvoiceUpdateOne) ClearHostedLink() *InvoiceUpdateOne {
+	iuo.mutation.ClearHostedLink()
+	return iuo
+}
+
+// SetPdfLink sets the "pdf_link" field.
+func (iuo *InvoiceUpdateOne) SetPdfLink(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetPdfLink(s)
+	return iuo
+}
+
+// SetNillablePdfLink sets the "pdf_link" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePdfLink(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetPdfLink(*s)
+	}
+	return iuo
+}
+
+// ClearPdfLink clears the value of the "pdf_link" field.
+func (iuo *InvoiceUpdateOne) ClearPdfLink() *InvoiceUpdateOne {
+	iuo.mutation.ClearPdfLink()
+	return iuo
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (iuo *InvoiceUpdateOne) SetPeriodStart(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetPeriodStart(t)
+	return iuo
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePeriodStart(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetPeriodStart(*t)
+	}
+	return iuo
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (iuo *InvoiceUpdateOne) ClearPeriodStart() *InvoiceUpdateOne {
+	iuo.mutation.ClearPeriodStart()
+	return iuo
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (iuo *InvoiceUpdateOne) SetPeriodEnd(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetPeriodEnd(t)
+	return iuo
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePeriodEnd(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetPeriodEnd(*t)
+	}
+	return iuo
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (iuo *InvoiceUpdateOne) ClearPeriodEnd() *InvoiceUpdateOne {
+	iuo.mutation.ClearPeriodEnd()
+	return iuo
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (iuo *InvoiceUpdateOne) SetPaidAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetPaidAt(t)
+	return iuo
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePaidAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetPaidAt(*t)
+	}
+	return iuo
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (iuo *InvoiceUpdateOne) ClearPaidAt() *InvoiceUpdateOne {
+	iuo.mutation.ClearPaidAt()
+	return iuo
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (iuo *InvoiceUpdateOne) SetIssuedAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetIssuedAt(t)
+	return iuo
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableIssuedAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetIssuedAt(*t)
+	}
+	return iuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iuo *InvoiceUpdateOne) SetUpdatedAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetUpdatedAt(t)
+	return iuo
+}
+
+// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by ID.
+func (iuo *InvoiceUpdateOne) SetCustomerID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetCustomerID(id)
+	return iuo
+}
+
+// SetCustomer sets the "customer" edge to the PaymentCustomer entity.
+func (iuo *InvoiceUpdateOne) SetCustomer(p *PaymentCustomer) *InvoiceUpdateOne {
+	return iuo.SetCustomerID(p.ID)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iuo *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return iuo.mutation
+}
+
+// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
+func (iuo *InvoiceUpdateOne) ClearCustomer() *InvoiceUpdateOne {
+	iuo.mutation.ClearCustomer()
+	return iuo
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (iuo *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (iuo *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	iuo.defaults()
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuo *InvoiceUpdateOne) defaults() {
+	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		v := invoice.UpdateDefaultUpdatedAt()
+		iuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvoiceUpdateOne) check() error {
+	if v, ok := iuo.mutation.ProviderInvoiceID(); ok {
+		if err := invoice.ProviderInvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_invoice_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.provider_invoice_id": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Provider(); ok {
+		if err := invoice.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Invoice.provider": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Currency(); ok {
+		if err := invoice.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Invoice.currency": %w`, err)}
+		}
+	}
+	if iuo.mutation.CustomerCleared() && len(iuo.mutation.CustomerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.customer"`)
+	}
+	return nil
+}
+
+func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.ProviderInvoiceID(); ok {
+		_spec.SetField(invoice.FieldProviderInvoiceID, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Provider(); ok {
+		_spec.SetField(invoice.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(invoice.FieldProviderSubscriptionID, field.TypeString, value)
+	}
+	if iuo.mutation.ProviderSubscriptionIDCleared() {
+		_spec.ClearField(invoice.FieldProviderSubscriptionID, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if iuo.mutation.NumberCleared() {
+		_spec.ClearField(invoice.FieldNumber, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedSubtotal(); ok {
+		_spec.AddField(invoice.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.Tax(); ok {
+		_spec.SetField(invoice.FieldTax, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedTax(); ok {
+		_spec.AddField(invoice.FieldTax, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedTotal(); ok {
+		_spec.AddField(invoice.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AmountDue(); ok {
+		_spec.SetField(invoice.FieldAmountDue, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedAmountDue(); ok {
+		_spec.AddField(invoice.FieldAmountDue, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AmountPaid(); ok {
+		_spec.SetField(invoice.FieldAmountPaid, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedAmountPaid(); ok {
+		_spec.AddField(invoice.FieldAmountPaid, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.HostedLink(); ok {
+		_spec.SetField(invoice.FieldHostedLink, field.TypeString, value)
+	}
+	if iuo.mutation.HostedLinkCleared() {
+		_spec.ClearField(invoice.FieldHostedLink, field.TypeString)
+	}
+	if value, ok := iuo.mutation.PdfLink(); ok {
+		_spec.SetField(invoice.FieldPdfLink, field.TypeString, value)
+	}
+	if iuo.mutation.PdfLinkCleared() {
+		_spec.ClearField(invoice.FieldPdfLink, field.TypeString)
+	}
+	if value, ok := iuo.mutation.PeriodStart(); ok {
+		_spec.SetField(invoice.FieldPeriodStart, field.TypeTime, value)
+	}
+	if iuo.mutation.PeriodStartCleared() {
+		_spec.ClearField(invoice.FieldPeriodStart, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.PeriodEnd(); ok {
+		_spec.SetField(invoice.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if iuo.mutation.PeriodEndCleared() {
+		_spec.ClearField(invoice.FieldPeriodEnd, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+	}
+	if iuo.mutation.PaidAtCleared() {
+		_spec.ClearField(invoice.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentcustomer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentcustomer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invoice{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -28,15 +28,50 @@ type Job struct {
 	MaxAttempts int `json:"max_attempts,omitempty"`
 	// Current status of the job
 	Status job.Status `json:"status,omitempty"`
+	// Jobs with a higher priority are claimed first
+	Priority int `json:"priority,omitempty"`
+	// When the job may next be attempted, pushed back after each failure
+	RunAt time.Time `json:"run_at,omitempty"`
+	// The cron tick that enqueued a recurring job, unique per queue so each tick runs once
+	ScheduledFor *time.Time `json:"scheduled_for,omitempty"`
+	// Deduplication key given when the job was enqueued
+	UniqueKey string `json:"unique_key,omitempty"`
+	// Holds unique_key while the job is pending or processing and is cleared once it finishes, so the unique index only covers active jobs
+	UniqueLock string `json:"unique_lock,omitempty"`
 	// Error message if job failed
 	Error string `json:"error,omitempty"`
 	// When the job was created
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// When the job was processed
-	ProcessedAt  time.Time `json:"processed_at,omitempty"`
+	ProcessedAt time.Time `json:"processed_at,omitempty"`
+	// ID of the worker currently processing the job
+	LockedBy string `json:"locked_by,omitempty"`
+	// When the lease held by the processing worker expires
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JobQuery when eager-loading is set.
+	Edges        JobEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// JobEdges holds the relations/edges for other nodes in the graph.
+type JobEdges struct {
+	// Every attempt made to run the job
+	History []*JobAttempt `json:"history,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// HistoryOrErr returns the History value or an error if the edge
+// was not loaded in eager-loading.
+func (e JobEdges) HistoryOrErr() ([]*JobAttempt, error) {
+	if e.loadedTypes[0] {
+		return e.History, nil
+	}
+	return nil, &NotLoadedError{edge: "history"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Job) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -44,11 +79,11 @@ func (*Job) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case job.FieldPayload:
 			values[i] = new([]byte)
-		case job.FieldID, job.FieldAttempts, job.FieldMaxAttempts:
+		case job.FieldID, job.FieldAttempts, job.FieldMaxAttempts, job.FieldPriority:
 			values[i] = new(sql.NullInt64)
-		case job.FieldQueue, job.FieldStatus, job.FieldError:
+		case job.FieldQueue, job.FieldStatus, job.FieldUniqueKey, job.FieldUniqueLock, job.FieldError, job.FieldLockedBy:
 			values[i] = new(sql.NullString)
-		case job.FieldCreatedAt, job.FieldProcessedAt:
+		case job.FieldRunAt, job.FieldScheduledFor, job.FieldCreatedAt, job.FieldProcessedAt, job.FieldLockedUntil:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -103,6 +138,37 @@ func (j *Job) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				j.Status = job.Status(value.String)
 			}
+		case job.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				j.Priority = int(value.Int64)
+			}
+		case job.FieldRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field run_at", values[i])
+			} else if value.Valid {
+				j.RunAt = value.Time
+			}
+		case job.FieldScheduledFor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_for", values[i])
+			} else if value.Valid {
+				j.ScheduledFor = new(time.Time)
+				*j.ScheduledFor = value.Time
+			}
+		case job.FieldUniqueKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_key", values[i])
+			} else if value.Valid {
+				j.UniqueKey = value.String
+			}
+		case job.FieldUniqueLock:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_lock", values[i])
+			} else if value.Valid {
+				j.UniqueLock = value.String
+			}
 		case job.FieldError:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field error", values[i])
@@ -121,6 +187,19 @@ func (j *Job) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				j.ProcessedAt = value.Time
 			}
+		case job.FieldLockedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_by", values[i])
+			} else if value.Valid {
+				j.LockedBy = value.String
+			}
+		case job.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				j.LockedUntil = new(time.Time)
+				*j.LockedUntil = value.Time
+			}
 		default:
 			j.selectValues.Set(columns[i], values[i])
 		}
@@ -134,6 +213,11 @@ func (j *Job) Value(name string) (ent.Value, error) {
 	return j.selectValues.Get(name)
 }
 
+// QueryHistory queries the "history" edge of the Job entity.
+func (j *Job) QueryHistory() *JobAttemptQuery {
+	return NewJobClient(j.config).QueryHistory(j)
+}
+
 // Update returns a builder for updating this Job.
 // Note that you need to call Job.Unwrap() before calling this method if this Job
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -172,6 +256,23 @@ func (j *Job) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", j.Status))
 	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", j.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("run_at=")
+	builder.WriteString(j.RunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := j.ScheduledFor; v != nil {
+		builder.WriteString("scheduled_for=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unique_key=")
+	builder.WriteString(j.UniqueKey)
+	builder.WriteString(", ")
+	builder.WriteString("unique_lock=")
+	builder.WriteString(j.UniqueLock)
+	builder.WriteString(", ")
 	builder.WriteString("error=")
 	builder.WriteString(j.Error)
 	builder.WriteString(", ")
@@ -180,6 +281,14 @@ func (j *Job) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("processed_at=")
 	builder.WriteString(j.ProcessedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("locked_by=")
+	builder.WriteString(j.LockedBy)
+	builder.WriteString(", ")
+	if v := j.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/occult/pagode/ent/predicate"
 )
 
@@ -69,6 +70,31 @@ func MaxAttempts(v int) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldMaxAttempts, v))
 }
 
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldPriority, v))
+}
+
+// RunAt applies equality check predicate on the "run_at" field. It's identical to RunAtEQ.
+func RunAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRunAt, v))
+}
+
+// ScheduledFor applies equality check predicate on the "scheduled_for" field. It's identical to ScheduledForEQ.
+func ScheduledFor(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// UniqueKey applies equality check predicate on the "unique_key" field. It's identical to UniqueKeyEQ.
+func UniqueKey(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUniqueKey, v))
+}
+
+// UniqueLock applies equality check predicate on the "unique_lock" field. It's identical to UniqueLockEQ.
+func UniqueLock(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUniqueLock, v))
+}
+
 // Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
 func Error(v string) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldError, v))
@@ -84,6 +110,16 @@ func ProcessedAt(v time.Time) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldProcessedAt, v))
 }
 
+// LockedBy applies equality check predicate on the "locked_by" field. It's identical to LockedByEQ.
+func LockedBy(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLockedUntil, v))
+}
+
 // QueueEQ applies the EQ predicate on the "queue" field.
 func QueueEQ(v string) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldQueue, v))
@@ -249,6 +285,286 @@ func StatusNotIn(vs ...Status) predicate.Job {
 	return predicate.Job(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldPriority, v))
+}
+
+// RunAtEQ applies the EQ predicate on the "run_at" field.
+func RunAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldRunAt, v))
+}
+
+// RunAtNEQ applies the NEQ predicate on the "run_at" field.
+func RunAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldRunAt, v))
+}
+
+// RunAtIn applies the In predicate on the "run_at" field.
+func RunAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldRunAt, vs...))
+}
+
+// RunAtNotIn applies the NotIn predicate on the "run_at" field.
+func RunAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldRunAt, vs...))
+}
+
+// RunAtGT applies the GT predicate on the "run_at" field.
+func RunAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldRunAt, v))
+}
+
+// RunAtGTE applies the GTE predicate on the "run_at" field.
+func RunAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldRunAt, v))
+}
+
+// RunAtLT applies the LT predicate on the "run_at" field.
+func RunAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldRunAt, v))
+}
+
+// RunAtLTE applies the LTE predicate on the "run_at" field.
+func RunAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldRunAt, v))
+}
+
+// ScheduledForEQ applies the EQ predicate on the "scheduled_for" field.
+func ScheduledForEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForNEQ applies the NEQ predicate on the "scheduled_for" field.
+func ScheduledForNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForIn applies the In predicate on the "scheduled_for" field.
+func ScheduledForIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForNotIn applies the NotIn predicate on the "scheduled_for" field.
+func ScheduledForNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForGT applies the GT predicate on the "scheduled_for" field.
+func ScheduledForGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldScheduledFor, v))
+}
+
+// ScheduledForGTE applies the GTE predicate on the "scheduled_for" field.
+func ScheduledForGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldScheduledFor, v))
+}
+
+// ScheduledForLT applies the LT predicate on the "scheduled_for" field.
+func ScheduledForLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldScheduledFor, v))
+}
+
+// ScheduledForLTE applies the LTE predicate on the "scheduled_for" field.
+func ScheduledForLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldScheduledFor, v))
+}
+
+// ScheduledForIsNil applies the IsNil predicate on the "scheduled_for" field.
+func ScheduledForIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldScheduledFor))
+}
+
+// ScheduledForNotNil applies the NotNil predicate on the "scheduled_for" field.
+func ScheduledForNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldScheduledFor))
+}
+
+// UniqueKeyEQ applies the EQ predicate on the "unique_key" field.
+func UniqueKeyEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUniqueKey, v))
+}
+
+// UniqueKeyNEQ applies the NEQ predicate on the "unique_key" field.
+func UniqueKeyNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUniqueKey, v))
+}
+
+// UniqueKeyIn applies the In predicate on the "unique_key" field.
+func UniqueKeyIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUniqueKey, vs...))
+}
+
+// UniqueKeyNotIn applies the NotIn predicate on the "unique_key" field.
+func UniqueKeyNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUniqueKey, vs...))
+}
+
+// UniqueKeyGT applies the GT predicate on the "unique_key" field.
+func UniqueKeyGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUniqueKey, v))
+}
+
+// UniqueKeyGTE applies the GTE predicate on the "unique_key" field.
+func UniqueKeyGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUniqueKey, v))
+}
+
+// UniqueKeyLT applies the LT predicate on the "unique_key" field.
+func UniqueKeyLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUniqueKey, v))
+}
+
+// UniqueKeyLTE applies the LTE predicate on the "unique_key" field.
+func UniqueKeyLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUniqueKey, v))
+}
+
+// UniqueKeyContains applies the Contains predicate on the "unique_key" field.
+func UniqueKeyContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldUniqueKey, v))
+}
+
+// UniqueKeyHasPrefix applies the HasPrefix predicate on the "unique_key" field.
+func UniqueKeyHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldUniqueKey, v))
+}
+
+// UniqueKeyHasSuffix applies the HasSuffix predicate on the "unique_key" field.
+func UniqueKeyHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldUniqueKey, v))
+}
+
+// UniqueKeyIsNil applies the IsNil predicate on the "unique_key" field.
+func UniqueKeyIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldUniqueKey))
+}
+
+// UniqueKeyNotNil applies the NotNil predicate on the "unique_key" field.
+func UniqueKeyNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldUniqueKey))
+}
+
+// UniqueKeyEqualFold applies the EqualFold predicate on the "unique_key" field.
+func UniqueKeyEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldUniqueKey, v))
+}
+
+// UniqueKeyContainsFold applies the ContainsFold predicate on the "unique_key" field.
+func UniqueKeyContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldUniqueKey, v))
+}
+
+// UniqueLockEQ applies the EQ predicate on the "unique_lock" field.
+func UniqueLockEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUniqueLock, v))
+}
+
+// UniqueLockNEQ applies the NEQ predicate on the "unique_lock" field.
+func UniqueLockNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUniqueLock, v))
+}
+
+// UniqueLockIn applies the In predicate on the "unique_lock" field.
+func UniqueLockIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUniqueLock, vs...))
+}
+
+// UniqueLockNotIn applies the NotIn predicate on the "unique_lock" field.
+func UniqueLockNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUniqueLock, vs...))
+}
+
+// UniqueLockGT applies the GT predicate on the "unique_lock" field.
+func UniqueLockGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUniqueLock, v))
+}
+
+// UniqueLockGTE applies the GTE predicate on the "unique_lock" field.
+func UniqueLockGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUniqueLock, v))
+}
+
+// UniqueLockLT applies the LT predicate on the "unique_lock" field.
+func UniqueLockLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUniqueLock, v))
+}
+
+// UniqueLockLTE applies the LTE predicate on the "unique_lock" field.
+func UniqueLockLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUniqueLock, v))
+}
+
+// UniqueLockContains applies the Contains predicate on the "unique_lock" field.
+func UniqueLockContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldUniqueLock, v))
+}
+
+// UniqueLockHasPrefix applies the HasPrefix predicate on the "unique_lock" field.
+func UniqueLockHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldUniqueLock, v))
+}
+
+// UniqueLockHasSuffix applies the HasSuffix predicate on the "unique_lock" field.
+func UniqueLockHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldUniqueLock, v))
+}
+
+// UniqueLockIsNil applies the IsNil predicate on the "unique_lock" field.
+func UniqueLockIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldUniqueLock))
+}
+
+// UniqueLockNotNil applies the NotNil predicate on the "unique_lock" field.
+func UniqueLockNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldUniqueLock))
+}
+
+// UniqueLockEqualFold applies the EqualFold predicate on the "unique_lock" field.
+func UniqueLockEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldUniqueLock, v))
+}
+
+// UniqueLockContainsFold applies the ContainsFold predicate on the "unique_lock" field.
+func UniqueLockContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldUniqueLock, v))
+}
+
 // ErrorEQ applies the EQ predicate on the "error" field.
 func ErrorEQ(v string) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldError, v))
@@ -414,6 +730,154 @@ func ProcessedAtNotNil() predicate.Job {
 	return predicate.Job(sql.FieldNotNull(FieldProcessedAt))
 }
 
+// LockedByEQ applies the EQ predicate on the "locked_by" field.
+func LockedByEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedByNEQ applies the NEQ predicate on the "locked_by" field.
+func LockedByNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLockedBy, v))
+}
+
+// LockedByIn applies the In predicate on the "locked_by" field.
+func LockedByIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLockedBy, vs...))
+}
+
+// LockedByNotIn applies the NotIn predicate on the "locked_by" field.
+func LockedByNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLockedBy, vs...))
+}
+
+// LockedByGT applies the GT predicate on the "locked_by" field.
+func LockedByGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLockedBy, v))
+}
+
+// LockedByGTE applies the GTE predicate on the "locked_by" field.
+func LockedByGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLockedBy, v))
+}
+
+// LockedByLT applies the LT predicate on the "locked_by" field.
+func LockedByLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLockedBy, v))
+}
+
+// LockedByLTE applies the LTE predicate on the "locked_by" field.
+func LockedByLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLockedBy, v))
+}
+
+// LockedByContains applies the Contains predicate on the "locked_by" field.
+func LockedByContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldLockedBy, v))
+}
+
+// LockedByHasPrefix applies the HasPrefix predicate on the "locked_by" field.
+func LockedByHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldLockedBy, v))
+}
+
+// LockedByHasSuffix applies the HasSuffix predicate on the "locked_by" field.
+func LockedByHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldLockedBy, v))
+}
+
+// LockedByIsNil applies the IsNil predicate on the "locked_by" field.
+func LockedByIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLockedBy))
+}
+
+// LockedByNotNil applies the NotNil predicate on the "locked_by" field.
+func LockedByNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLockedBy))
+}
+
+// LockedByEqualFold applies the EqualFold predicate on the "locked_by" field.
+func LockedByEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldLockedBy, v))
+}
+
+// LockedByContainsFold applies the ContainsFold predicate on the "locked_by" field.
+func LockedByContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldLockedBy, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// HasHistory applies the HasEdge predicate on the "history" edge.
+func HasHistory() predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HistoryTable, HistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHistoryWith applies the HasEdge predicate on the "history" edge with a given conditions (other predicates).
+func HasHistoryWith(preds ...predicate.JobAttempt) predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := newHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Job) predicate.Job {
 	return predicate.Job(sql.AndPredicates(predicates...))
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
 )
 
 // JobCreate is the builder for creating a Job entity.
@@ -18,6 +20,7 @@ type JobCreate struct {
 	config
 	mutation *JobMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetQueue sets the "queue" field.
@@ -74,6 +77,76 @@ func (jc *JobCreate) SetNillableStatus(j *job.Status) *JobCreate {
 	return jc
 }
 
+// SetPriority sets the "priority" field.
+func (jc *JobCreate) SetPriority(i int) *JobCreate {
+	jc.mutation.SetPriority(i)
+	return jc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (jc *JobCreate) SetNillablePriority(i *int) *JobCreate {
+	if i != nil {
+		jc.SetPriority(*i)
+	}
+	return jc
+}
+
+// SetRunAt sets the "run_at" field.
+func (jc *JobCreate) SetRunAt(t time.Time) *JobCreate {
+	jc.mutation.SetRunAt(t)
+	return jc
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableRunAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetRunAt(*t)
+	}
+	return jc
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (jc *JobCreate) SetScheduledFor(t time.Time) *JobCreate {
+	jc.mutation.SetScheduledFor(t)
+	return jc
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (jc *JobCreate) SetNillableScheduledFor(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetScheduledFor(*t)
+	}
+	return jc
+}
+
+// SetUniqueKey sets the "unique_key" field.
+func (jc *JobCreate) SetUniqueKey(s string) *JobCreate {
+	jc.mutation.SetUniqueKey(s)
+	return jc
+}
+
+// SetNillableUniqueKey sets the "unique_key" field if the given value is not nil.
+func (jc *JobCreate) SetNillableUniqueKey(s *string) *JobCreate {
+	if s != nil {
+		jc.SetUniqueKey(*s)
+	}
+	return jc
+}
+
+// SetUniqueLock sets the "unique_lock" field.
+func (jc *JobCreate) SetUniqueLock(s string) *JobCreate {
+	jc.mutation.SetUniqueLock(s)
+	return jc
+}
+
+// SetNillableUniqueLock sets the "unique_lock" field if the given value is not nil.
+func (jc *JobCreate) SetNillableUniqueLock(s *string) *JobCreate {
+	if s != nil {
+		jc.SetUniqueLock(*s)
+	}
+	return jc
+}
+
 // SetError sets the "error" field.
 func (jc *JobCreate) SetError(s string) *JobCreate {
 	jc.mutation.SetError(s)
@@ -116,6 +189,49 @@ func (jc *JobCreate) SetNillableProcessedAt(t *time.Time) *JobCreate {
 	return jc
 }
 
+// SetLockedBy sets the "locked_by" field.
+func (jc *JobCreate) SetLockedBy(s string) *JobCreate {
+	jc.mutation.SetLockedBy(s)
+	return jc
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLockedBy(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLockedBy(*s)
+	}
+	return jc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (jc *JobCreate) SetLockedUntil(t time.Time) *JobCreate {
+	jc.mutation.SetLockedUntil(t)
+	return jc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLockedUntil(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetLockedUntil(*t)
+	}
+	return jc
+}
+
+// AddHistoryIDs adds the "history" edge to the JobAttempt entity by IDs.
+func (jc *JobCreate) AddHistoryIDs(ids ...int) *JobCreate {
+	jc.mutation.AddHistoryIDs(ids...)
+	return jc
+}
+
+// AddHistory adds the "history" edges to the JobAttempt entity.
+func (jc *JobCreate) AddHistory(j ...*JobAttempt) *JobCreate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return jc.AddHistoryIDs(ids...)
+}
+
 // Mutation returns the JobMutation object of the builder.
 func (jc *JobCreate) Mutation() *JobMutation {
 	return jc.mutation
@@ -163,6 +279,14 @@ func (jc *JobCreate) defaults() {
 		v := job.DefaultStatus
 		jc.mutation.SetStatus(v)
 	}
+	if _, ok := jc.mutation.Priority(); !ok {
+		v := job.DefaultPriority
+		jc.mutation.SetPriority(v)
+	}
+	if _, ok := jc.mutation.RunAt(); !ok {
+		v := job.DefaultRunAt()
+		jc.mutation.SetRunAt(v)
+	}
 	if _, ok := jc.mutation.CreatedAt(); !ok {
 		v := job.DefaultCreatedAt()
 		jc.mutation.SetCreatedAt(v)
@@ -196,6 +320,12 @@ func (jc *JobCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
 		}
 	}
+	if _, ok := jc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Job.priority"`)}
+	}
+	if _, ok := jc.mutation.RunAt(); !ok {
+		return &ValidationError{Name: "run_at", err: errors.New(`ent: missing required field "Job.run_at"`)}
+	}
 	if _, ok := jc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
 	}
@@ -225,6 +355,7 @@ func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		_node = &Job{config: jc.config}
 		_spec = sqlgraph.NewCreateSpec(job.Table, sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = jc.conflict
 	if value, ok := jc.mutation.Queue(); ok {
 		_spec.SetField(job.FieldQueue, field.TypeString, value)
 		_node.Queue = value
@@ -245,6 +376,26 @@ func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := jc.mutation.Priority(); ok {
+		_spec.SetField(job.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := jc.mutation.RunAt(); ok {
+		_spec.SetField(job.FieldRunAt, field.TypeTime, value)
+		_node.RunAt = value
+	}
+	if value, ok := jc.mutation.ScheduledFor(); ok {
+		_spec.SetField(job.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = &value
+	}
+	if value, ok := jc.mutation.UniqueKey(); ok {
+		_spec.SetField(job.FieldUniqueKey, field.TypeString, value)
+		_node.UniqueKey = value
+	}
+	if value, ok := jc.mutation.UniqueLock(); ok {
+		_spec.SetField(job.FieldUniqueLock, field.TypeString, value)
+		_node.UniqueLock = value
+	}
 	if value, ok := jc.mutation.Error(); ok {
 		_spec.SetField(job.FieldError, field.TypeString, value)
 		_node.Error = value
@@ -257,14 +408,588 @@ func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		_spec.SetField(job.FieldProcessedAt, field.TypeTime, value)
 		_node.ProcessedAt = value
 	}
+	if value, ok := jc.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+		_node.LockedBy = value
+	}
+	if value, ok := jc.mutation.LockedUntil(); ok {
+		_spec.SetField(job.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if nodes := jc.mutation.HistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.HistoryTable,
+			Columns: []string{job.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.Create().
+//		SetQueue(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetQueue(v+v).
+//		}).
+//		Exec(ctx)
+func (jc *JobCreate) OnConflict(opts ...sql.ConflictOption) *JobUpsertOne {
+	jc.conflict = opts
+	return &JobUpsertOne{
+		create: jc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jc *JobCreate) OnConflictColumns(columns ...string) *JobUpsertOne {
+	jc.conflict = append(jc.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertOne{
+		create: jc,
+	}
+}
+
+type (
+	// JobUpsertOne is the builder for "upsert"-ing
+	//  one Job node.
+	JobUpsertOne struct {
+		create *JobCreate
+	}
+
+	// JobUpsert is the "OnConflict" setter.
+	JobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetQueue sets the "queue" field.
+func (u *JobUpsert) SetQueue(v string) *JobUpsert {
+	u.Set(job.FieldQueue, v)
+	return u
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *JobUpsert) UpdateQueue() *JobUpsert {
+	u.SetExcluded(job.FieldQueue)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobUpsert) SetPayload(v map[string]interface{}) *JobUpsert {
+	u.Set(job.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobUpsert) UpdatePayload() *JobUpsert {
+	u.SetExcluded(job.FieldPayload)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsert) SetAttempts(v int) *JobUpsert {
+	u.Set(job.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsert) UpdateAttempts() *JobUpsert {
+	u.SetExcluded(job.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsert) AddAttempts(v int) *JobUpsert {
+	u.Add(job.FieldAttempts, v)
+	return u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsert) SetMaxAttempts(v int) *JobUpsert {
+	u.Set(job.FieldMaxAttempts, v)
+	return u
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsert) UpdateMaxAttempts() *JobUpsert {
+	u.SetExcluded(job.FieldMaxAttempts)
+	return u
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsert) AddMaxAttempts(v int) *JobUpsert {
+	u.Add(job.FieldMaxAttempts, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsert) SetStatus(v job.Status) *JobUpsert {
+	u.Set(job.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsert) UpdateStatus() *JobUpsert {
+	u.SetExcluded(job.FieldStatus)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *JobUpsert) SetPriority(v int) *JobUpsert {
+	u.Set(job.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *JobUpsert) UpdatePriority() *JobUpsert {
+	u.SetExcluded(job.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *JobUpsert) AddPriority(v int) *JobUpsert {
+	u.Add(job.FieldPriority, v)
+	return u
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsert) SetRunAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldRunAt, v)
+	return u
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateRunAt() *JobUpsert {
+	u.SetExcluded(job.FieldRunAt)
+	return u
+}
+
+// SetUniqueLock sets the "unique_lock" field.
+func (u *JobUpsert) SetUniqueLock(v string) *JobUpsert {
+	u.Set(job.FieldUniqueLock, v)
+	return u
+}
+
+// UpdateUniqueLock sets the "unique_lock" field to the value that was provided on create.
+func (u *JobUpsert) UpdateUniqueLock() *JobUpsert {
+	u.SetExcluded(job.FieldUniqueLock)
+	return u
+}
+
+// ClearUniqueLock clears the value of the "unique_lock" field.
+func (u *JobUpsert) ClearUniqueLock() *JobUpsert {
+	u.SetNull(job.FieldUniqueLock)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *JobUpsert) SetError(v string) *JobUpsert {
+	u.Set(job.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobUpsert) UpdateError() *JobUpsert {
+	u.SetExcluded(job.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobUpsert) ClearError() *JobUpsert {
+	u.SetNull(job.FieldError)
+	return u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *JobUpsert) SetProcessedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldProcessedAt, v)
+	return u
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateProcessedAt() *JobUpsert {
+	u.SetExcluded(job.FieldProcessedAt)
+	return u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *JobUpsert) ClearProcessedAt() *JobUpsert {
+	u.SetNull(job.FieldProcessedAt)
+	return u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *JobUpsert) SetLockedBy(v string) *JobUpsert {
+	u.Set(job.FieldLockedBy, v)
+	return u
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *JobUpsert) UpdateLockedBy() *JobUpsert {
+	u.SetExcluded(job.FieldLockedBy)
+	return u
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *JobUpsert) ClearLockedBy() *JobUpsert {
+	u.SetNull(job.FieldLockedBy)
+	return u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *JobUpsert) SetLockedUntil(v time.Time) *JobUpsert {
+	u.Set(job.FieldLockedUntil, v)
+	return u
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *JobUpsert) UpdateLockedUntil() *JobUpsert {
+	u.SetExcluded(job.FieldLockedUntil)
+	return u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *JobUpsert) ClearLockedUntil() *JobUpsert {
+	u.SetNull(job.FieldLockedUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertOne) UpdateNewValues() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ScheduledFor(); exists {
+			s.SetIgnore(job.FieldScheduledFor)
+		}
+		if _, exists := u.create.mutation.UniqueKey(); exists {
+			s.SetIgnore(job.FieldUniqueKey)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(job.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobUpsertOne) Ignore() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertOne) DoNothing() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreate.OnConflict
+// documentation for more info.
+func (u *JobUpsertOne) Update(set func(*JobUpsert)) *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetQueue sets the "queue" field.
+func (u *JobUpsertOne) SetQueue(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateQueue() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobUpsertOne) SetPayload(v map[string]interface{}) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdatePayload() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertOne) SetAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertOne) AddAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateAttempts() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsertOne) SetMaxAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetMaxAttempts(v)
+	})
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsertOne) AddMaxAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddMaxAttempts(v)
+	})
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateMaxAttempts() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateMaxAttempts()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsertOne) SetStatus(v job.Status) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateStatus() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *JobUpsertOne) SetPriority(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *JobUpsertOne) AddPriority(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdatePriority() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsertOne) SetRunAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateRunAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetUniqueLock sets the "unique_lock" field.
+func (u *JobUpsertOne) SetUniqueLock(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUniqueLock(v)
+	})
+}
+
+// UpdateUniqueLock sets the "unique_lock" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateUniqueLock() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUniqueLock()
+	})
+}
+
+// ClearUniqueLock clears the value of the "unique_lock" field.
+func (u *JobUpsertOne) ClearUniqueLock() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearUniqueLock()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobUpsertOne) SetError(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobUpsertOne) ClearError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *JobUpsertOne) SetProcessedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetProcessedAt(v)
+	})
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateProcessedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateProcessedAt()
+	})
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *JobUpsertOne) ClearProcessedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearProcessedAt()
+	})
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *JobUpsertOne) SetLockedBy(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLockedBy(v)
+	})
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateLockedBy() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLockedBy()
+	})
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *JobUpsertOne) ClearLockedBy() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLockedBy()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *JobUpsertOne) SetLockedUntil(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateLockedUntil() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *JobUpsertOne) ClearLockedUntil() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // JobCreateBulk is the builder for creating many Job entities in bulk.
 type JobCreateBulk struct {
 	config
 	err      error
 	builders []*JobCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Job entities in the database.
@@ -294,6 +1019,7 @@ func (jcb *JobCreateBulk) Save(ctx context.Context) ([]*Job, error) {
 					_, err = mutators[i+1].Mutate(root, jcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, jcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -347,3 +1073,344 @@ func (jcb *JobCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetQueue(v+v).
+//		}).
+//		Exec(ctx)
+func (jcb *JobCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobUpsertBulk {
+	jcb.conflict = opts
+	return &JobUpsertBulk{
+		create: jcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jcb *JobCreateBulk) OnConflictColumns(columns ...string) *JobUpsertBulk {
+	jcb.conflict = append(jcb.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertBulk{
+		create: jcb,
+	}
+}
+
+// JobUpsertBulk is the builder for "upsert"-ing
+// a bulk of Job nodes.
+type JobUpsertBulk struct {
+	create *JobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertBulk) UpdateNewValues() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ScheduledFor(); exists {
+				s.SetIgnore(job.FieldScheduledFor)
+			}
+			if _, exists := b.mutation.UniqueKey(); exists {
+				s.SetIgnore(job.FieldUniqueKey)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(job.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobUpsertBulk) Ignore() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertBulk) DoNothing() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobUpsertBulk) Update(set func(*JobUpsert)) *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetQueue sets the "queue" field.
+func (u *JobUpsertBulk) SetQueue(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateQueue() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobUpsertBulk) SetPayload(v map[string]interface{}) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdatePayload() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertBulk) SetAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertBulk) AddAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateAttempts() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *JobUpsertBulk) SetMaxAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetMaxAttempts(v)
+	})
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *JobUpsertBulk) AddMaxAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddMaxAttempts(v)
+	})
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateMaxAttempts() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateMaxAttempts()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobUpsertBulk) SetStatus(v job.Status) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateStatus() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *JobUpsertBulk) SetPriority(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *JobUpsertBulk) AddPriority(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdatePriority() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobUpsertBulk) SetRunAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateRunAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetUniqueLock sets the "unique_lock" field.
+func (u *JobUpsertBulk) SetUniqueLock(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUniqueLock(v)
+	})
+}
+
+// UpdateUniqueLock sets the "unique_lock" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateUniqueLock() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUniqueLock()
+	})
+}
+
+// ClearUniqueLock clears the value of the "unique_lock" field.
+func (u *JobUpsertBulk) ClearUniqueLock() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearUniqueLock()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobUpsertBulk) SetError(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobUpsertBulk) ClearError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *JobUpsertBulk) SetProcessedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetProcessedAt(v)
+	})
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateProcessedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateProcessedAt()
+	})
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *JobUpsertBulk) ClearProcessedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearProcessedAt()
+	})
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *JobUpsertBulk) SetLockedBy(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLockedBy(v)
+	})
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateLockedBy() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLockedBy()
+	})
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *JobUpsertBulk) ClearLockedBy() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLockedBy()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *JobUpsertBulk) SetLockedUntil(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateLockedUntil() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *JobUpsertBulk) ClearLockedUntil() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
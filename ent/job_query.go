@@ -4,24 +4,29 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
 	"github.com/occult/pagode/ent/predicate"
 )
 
 // JobQuery is the builder for querying Job entities.
 type JobQuery struct {
 	config
-	ctx        *QueryContext
-	order      []job.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Job
+	ctx         *QueryContext
+	order       []job.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.Job
+	withHistory *JobAttemptQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +63,28 @@ func (jq *JobQuery) Order(o ...job.OrderOption) *JobQuery {
 	return jq
 }
 
+// QueryHistory chains the current query on the "history" edge.
+func (jq *JobQuery) QueryHistory() *JobAttemptQuery {
+	query := (&JobAttemptClient{config: jq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := jq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := jq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, selector),
+			sqlgraph.To(jobattempt.Table, jobattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, job.HistoryTable, job.HistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(jq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Job entity from the query.
 // Returns a *NotFoundError when no Job was found.
 func (jq *JobQuery) First(ctx context.Context) (*Job, error) {
@@ -245,17 +272,29 @@ func (jq *JobQuery) Clone() *JobQuery {
 		return nil
 	}
 	return &JobQuery{
-		config:     jq.config,
-		ctx:        jq.ctx.Clone(),
-		order:      append([]job.OrderOption{}, jq.order...),
-		inters:     append([]Interceptor{}, jq.inters...),
-		predicates: append([]predicate.Job{}, jq.predicates...),
+		config:      jq.config,
+		ctx:         jq.ctx.Clone(),
+		order:       append([]job.OrderOption{}, jq.order...),
+		inters:      append([]Interceptor{}, jq.inters...),
+		predicates:  append([]predicate.Job{}, jq.predicates...),
+		withHistory: jq.withHistory.Clone(),
 		// clone intermediate query.
 		sql:  jq.sql.Clone(),
 		path: jq.path,
 	}
 }
 
+// WithHistory tells the query-builder to eager-load the nodes that are connected to
+// the "history" edge. The optional arguments are used to configure the query builder of the edge.
+func (jq *JobQuery) WithHistory(opts ...func(*JobAttemptQuery)) *JobQuery {
+	query := (&JobAttemptClient{config: jq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	jq.withHistory = query
+	return jq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +371,11 @@ func (jq *JobQuery) prepareQuery(ctx context.Context) error {
 
 func (jq *JobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Job, error) {
 	var (
-		nodes = []*Job{}
-		_spec = jq.querySpec()
+		nodes       = []*Job{}
+		_spec       = jq.querySpec()
+		loadedTypes = [1]bool{
+			jq.withHistory != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Job).scanValues(nil, columns)
@@ -341,8 +383,12 @@ func (jq *JobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Job, err
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Job{config: jq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(jq.modifiers) > 0 {
+		_spec.Modifiers = jq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -352,11 +398,52 @@ func (jq *JobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Job, err
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := jq.withHistory; query != nil {
+		if err := jq.loadHistory(ctx, query, nodes,
+			func(n *Job) { n.Edges.History = []*JobAttempt{} },
+			func(n *Job, e *JobAttempt) { n.Edges.History = append(n.Edges.History, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (jq *JobQuery) loadHistory(ctx context.Context, query *JobAttemptQuery, nodes []*Job, init func(*Job), assign func(*Job, *JobAttempt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Job)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(jobattempt.FieldJobID)
+	}
+	query.Where(predicate.JobAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(job.HistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.JobID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "job_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (jq *JobQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := jq.querySpec()
+	if len(jq.modifiers) > 0 {
+		_spec.Modifiers = jq.modifiers
+	}
 	_spec.Node.Columns = jq.ctx.Fields
 	if len(jq.ctx.Fields) > 0 {
 		_spec.Unique = jq.ctx.Unique != nil && *jq.ctx.Unique
@@ -419,6 +506,9 @@ func (jq *JobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if jq.ctx.Unique != nil && *jq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range jq.modifiers {
+		m(selector)
+	}
 	for _, p := range jq.predicates {
 		p(selector)
 	}
@@ -436,6 +526,32 @@ func (jq *JobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (jq *JobQuery) ForUpdate(opts ...sql.LockOption) *JobQuery {
+	if jq.driver.Dialect() == dialect.Postgres {
+		jq.Unique(false)
+	}
+	jq.modifiers = append(jq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return jq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (jq *JobQuery) ForShare(opts ...sql.LockOption) *JobQuery {
+	if jq.driver.Dialect() == dialect.Postgres {
+		jq.Unique(false)
+	}
+	jq.modifiers = append(jq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return jq
+}
+
 // JobGroupBy is the group-by builder for Job entities.
 type JobGroupBy struct {
 	selector
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
 	"github.com/occult/pagode/ent/predicate"
 )
 
@@ -104,6 +105,61 @@ func (ju *JobUpdate) SetNillableStatus(j *job.Status) *JobUpdate {
 	return ju
 }
 
+// SetPriority sets the "priority" field.
+func (ju *JobUpdate) SetPriority(i int) *JobUpdate {
+	ju.mutation.ResetPriority()
+	ju.mutation.SetPriority(i)
+	return ju
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (ju *JobUpdate) SetNillablePriority(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetPriority(*i)
+	}
+	return ju
+}
+
+// AddPriority adds i to the "priority" field.
+func (ju *JobUpdate) AddPriority(i int) *JobUpdate {
+	ju.mutation.AddPriority(i)
+	return ju
+}
+
+// SetRunAt sets the "run_at" field.
+func (ju *JobUpdate) SetRunAt(t time.Time) *JobUpdate {
+	ju.mutation.SetRunAt(t)
+	return ju
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableRunAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetRunAt(*t)
+	}
+	return ju
+}
+
+// SetUniqueLock sets the "unique_lock" field.
+func (ju *JobUpdate) SetUniqueLock(s string) *JobUpdate {
+	ju.mutation.SetUniqueLock(s)
+	return ju
+}
+
+// SetNillableUniqueLock sets the "unique_lock" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableUniqueLock(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetUniqueLock(*s)
+	}
+	return ju
+}
+
+// ClearUniqueLock clears the value of the "unique_lock" field.
+func (ju *JobUpdate) ClearUniqueLock() *JobUpdate {
+	ju.mutation.ClearUniqueLock()
+	return ju
+}
+
 // SetError sets the "error" field.
 func (ju *JobUpdate) SetError(s string) *JobUpdate {
 	ju.mutation.SetError(s)
@@ -144,11 +200,87 @@ func (ju *JobUpdate) ClearProcessedAt() *JobUpdate {
 	return ju
 }
 
+// SetLockedBy sets the "locked_by" field.
+func (ju *JobUpdate) SetLockedBy(s string) *JobUpdate {
+	ju.mutation.SetLockedBy(s)
+	return ju
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLockedBy(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetLockedBy(*s)
+	}
+	return ju
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (ju *JobUpdate) ClearLockedBy() *JobUpdate {
+	ju.mutation.ClearLockedBy()
+	return ju
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ju *JobUpdate) SetLockedUntil(t time.Time) *JobUpdate {
+	ju.mutation.SetLockedUntil(t)
+	return ju
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLockedUntil(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetLockedUntil(*t)
+	}
+	return ju
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (ju *JobUpdate) ClearLockedUntil() *JobUpdate {
+	ju.mutation.ClearLockedUntil()
+	return ju
+}
+
+// AddHistoryIDs adds the "history" edge to the JobAttempt entity by IDs.
+func (ju *JobUpdate) AddHistoryIDs(ids ...int) *JobUpdate {
+	ju.mutation.AddHistoryIDs(ids...)
+	return ju
+}
+
+// AddHistory adds the "history" edges to the JobAttempt entity.
+func (ju *JobUpdate) AddHistory(j ...*JobAttempt) *JobUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return ju.AddHistoryIDs(ids...)
+}
+
 // Mutation returns the JobMutation object of the builder.
 func (ju *JobUpdate) Mutation() *JobMutation {
 	return ju.mutation
 }
 
+// ClearHistory clears all "history" edges to the JobAttempt entity.
+func (ju *JobUpdate) ClearHistory() *JobUpdate {
+	ju.mutation.ClearHistory()
+	return ju
+}
+
+// RemoveHistoryIDs removes the "history" edge to JobAttempt entities by IDs.
+func (ju *JobUpdate) RemoveHistoryIDs(ids ...int) *JobUpdate {
+	ju.mutation.RemoveHistoryIDs(ids...)
+	return ju
+}
+
+// RemoveHistory removes "history" edges to JobAttempt entities.
+func (ju *JobUpdate) RemoveHistory(j ...*JobAttempt) *JobUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return ju.RemoveHistoryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ju *JobUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ju.sqlSave, ju.mutation, ju.hooks)
@@ -224,6 +356,27 @@ func (ju *JobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ju.mutation.Status(); ok {
 		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := ju.mutation.Priority(); ok {
+		_spec.SetField(job.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedPriority(); ok {
+		_spec.AddField(job.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.RunAt(); ok {
+		_spec.SetField(job.FieldRunAt, field.TypeTime, value)
+	}
+	if ju.mutation.ScheduledForCleared() {
+		_spec.ClearField(job.FieldScheduledFor, field.TypeTime)
+	}
+	if ju.mutation.UniqueKeyCleared() {
+		_spec.ClearField(job.FieldUniqueKey, field.TypeString)
+	}
+	if value, ok := ju.mutation.UniqueLock(); ok {
+		_spec.SetField(job.FieldUniqueLock, field.TypeString, value)
+	}
+	if ju.mutation.UniqueLockCleared() {
+		_spec.ClearField(job.FieldUniqueLock, field.TypeString)
+	}
 	if value, ok := ju.mutation.Error(); ok {
 		_spec.SetField(job.FieldError, field.TypeString, value)
 	}
@@ -236,6 +389,63 @@ func (ju *JobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ju.mutation.ProcessedAtCleared() {
 		_spec.ClearField(job.FieldProcessedAt, field.TypeTime)
 	}
+	if value, ok := ju.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+	}
+	if ju.mutation.LockedByCleared() {
+		_spec.ClearField(job.FieldLockedBy, field.TypeString)
+	}
+	if value, ok := ju.mutation.LockedUntil(); ok {
+		_spec.SetField(job.FieldLockedUntil, field.TypeTime, value)
+	}
+	if ju.mutation.LockedUntilCleared() {
+		_spec.ClearField(job.FieldLockedUntil, field.TypeTime)
+	}
+	if ju.mutation.HistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.HistoryTable,
+			Columns: []string{job.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ju.mutation.RemovedHistoryIDs(); len(nodes) > 0 && !ju.mutation.HistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.HistoryTable,
+			Columns: []string{job.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ju.mutation.HistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.HistoryTable,
+			Columns: []string{job.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ju.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{job.Label}
@@ -332,6 +542,61 @@ func (juo *JobUpdateOne) SetNillableStatus(j *job.Status) *JobUpdateOne {
 	return juo
 }
 
+// SetPriority sets the "priority" field.
+func (juo *JobUpdateOne) SetPriority(i int) *JobUpdateOne {
+	juo.mutation.ResetPriority()
+	juo.mutation.SetPriority(i)
+	return juo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillablePriority(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetPriority(*i)
+	}
+	return juo
+}
+
+// AddPriority adds i to the "priority" field.
+func (juo *JobUpdateOne) AddPriority(i int) *JobUpdateOne {
+	juo.mutation.AddPriority(i)
+	return juo
+}
+
+// SetRunAt sets the "run_at" field.
+func (juo *JobUpdateOne) SetRunAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetRunAt(t)
+	return juo
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableRunAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetRunAt(*t)
+	}
+	return juo
+}
+
+// SetUniqueLock sets the "unique_lock" field.
+func (juo *JobUpdateOne) SetUniqueLock(s string) *JobUpdateOne {
+	juo.mutation.SetUniqueLock(s)
+	return juo
+}
+
+// SetNillableUniqueLock sets the "unique_lock" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableUniqueLock(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetUniqueLock(*s)
+	}
+	return juo
+}
+
+// ClearUniqueLock clears the value of the "unique_lock" field.
+func (juo *JobUpdateOne) ClearUniqueLock() *JobUpdateOne {
+	juo.mutation.ClearUniqueLock()
+	return juo
+}
+
 // SetError sets the "error" field.
 func (juo *JobUpdateOne) SetError(s string) *JobUpdateOne {
 	juo.mutation.SetError(s)
@@ -372,11 +637,87 @@ func (juo *JobUpdateOne) ClearProcessedAt() *JobUpdateOne {
 	return juo
 }
 
+// SetLockedBy sets the "locked_by" field.
+func (juo *JobUpdateOne) SetLockedBy(s string) *JobUpdateOne {
+	juo.mutation.SetLockedBy(s)
+	return juo
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLockedBy(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetLockedBy(*s)
+	}
+	return juo
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (juo *JobUpdateOne) ClearLockedBy() *JobUpdateOne {
+	juo.mutation.ClearLockedBy()
+	return juo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (juo *JobUpdateOne) SetLockedUntil(t time.Time) *JobUpdateOne {
+	juo.mutation.SetLockedUntil(t)
+	return juo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLockedUntil(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetLockedUntil(*t)
+	}
+	return juo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (juo *JobUpdateOne) ClearLockedUntil() *JobUpdateOne {
+	juo.mutation.ClearLockedUntil()
+	return juo
+}
+
+// AddHistoryIDs adds the "history" edge to the JobAttempt entity by IDs.
+func (juo *JobUpdateOne) AddHistoryIDs(ids ...int) *JobUpdateOne {
+	juo.mutation.AddHistoryIDs(ids...)
+	return juo
+}
+
+// AddHistory adds the "history" edges to the JobAttempt entity.
+func (juo *JobUpdateOne) AddHistory(j ...*JobAttempt) *JobUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return juo.AddHistoryIDs(ids...)
+}
+
 // Mutation returns the JobMutation object of the builder.
 func (juo *JobUpdateOne) Mutation() *JobMutation {
 	return juo.mutation
 }
 
+// ClearHistory clears all "history" edges to the JobAttempt entity.
+func (juo *JobUpdateOne) ClearHistory() *JobUpdateOne {
+	juo.mutation.ClearHistory()
+	return juo
+}
+
+// RemoveHistoryIDs removes the "history" edge to JobAttempt entities by IDs.
+func (juo *JobUpdateOne) RemoveHistoryIDs(ids ...int) *JobUpdateOne {
+	juo.mutation.RemoveHistoryIDs(ids...)
+	return juo
+}
+
+// RemoveHistory removes "history" edges to JobAttempt entities.
+func (juo *JobUpdateOne) RemoveHistory(j ...*JobAttempt) *JobUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return juo.RemoveHistoryIDs(ids...)
+}
+
 // Where appends a list predicates to the JobUpdate builder.
 func (juo *JobUpdateOne) Where(ps ...predicate.Job) *JobUpdateOne {
 	juo.mutation.Where(ps...)
@@ -482,6 +823,27 @@ func (juo *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
 	if value, ok := juo.mutation.Status(); ok {
 		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := juo.mutation.Priority(); ok {
+		_spec.SetField(job.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedPriority(); ok {
+		_spec.AddField(job.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.RunAt(); ok {
+		_spec.SetField(job.FieldRunAt, field.TypeTime, value)
+	}
+	if juo.mutation.ScheduledForCleared() {
+		_spec.ClearField(job.FieldScheduledFor, field.TypeTime)
+	}
+	if juo.mutation.UniqueKeyCleared() {
+		_spec.ClearField(job.FieldUniqueKey, field.TypeString)
+	}
+	if value, ok := juo.mutation.UniqueLock(); ok {
+		_spec.SetField(job.FieldUniqueLock, field.TypeString, value)
+	}
+	if juo.mutation.UniqueLockCleared() {
+		_spec.ClearField(job.FieldUniqueLock, field.TypeString)
+	}
 	if value, ok := juo.mutation.Error(); ok {
 		_spec.SetField(job.FieldError, field.TypeString, value)
 	}
@@ -494,6 +856,63 @@ func (juo *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
 	if juo.mutation.ProcessedAtCleared() {
 		_spec.ClearField(job.FieldProcessedAt, field.TypeTime)
 	}
+	if value, ok := juo.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+	}
+	if juo.mutation.LockedByCleared() {
+		_spec.ClearField(job.FieldLockedBy, field.TypeString)
+	}
+	if value, ok := juo.mutation.LockedUntil(); ok {
+		_spec.SetField(job.FieldLockedUntil, field.TypeTime, value)
+	}
+	if juo.mutation.LockedUntilCleared() {
+		_spec.ClearField(job.FieldLockedUntil, field.TypeTime)
+	}
+	if juo.mutation.HistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.HistoryTable,
+			Columns: []string{job.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := juo.mutation.RemovedHistoryIDs(); len(nodes) > 0 && !juo.mutation.HistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.HistoryTable,
+			Columns: []string{job.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := juo.mutation.HistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.HistoryTable,
+			Columns: []string{job.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Job{config: juo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
+)
+
+// JobAttempt is the model entity for the JobAttempt schema.
+type JobAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID int `json:"job_id,omitempty"`
+	// Which attempt of the job this was, starting at 1
+	Attempt int `json:"attempt,omitempty"`
+	// ID of the worker that ran the attempt
+	Worker string `json:"worker,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// How long the handler ran for in milliseconds
+	DurationMs int64 `json:"duration_ms,omitempty"`
+	// Error returned by the handler, empty if the attempt succeeded
+	Error string `json:"error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JobAttemptQuery when eager-loading is set.
+	Edges        JobAttemptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// JobAttemptEdges holds the relations/edges for other nodes in the graph.
+type JobAttemptEdges struct {
+	// Job holds the value of the job edge.
+	Job *Job `json:"job,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// JobOrErr returns the Job value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JobAttemptEdges) JobOrErr() (*Job, error) {
+	if e.Job != nil {
+		return e.Job, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: job.Label}
+	}
+	return nil, &NotLoadedError{edge: "job"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobattempt.FieldID, jobattempt.FieldJobID, jobattempt.FieldAttempt, jobattempt.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case jobattempt.FieldWorker, jobattempt.FieldError:
+			values[i] = new(sql.NullString)
+		case jobattempt.FieldStartedAt, jobattempt.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobAttempt fields.
+func (ja *JobAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ja.ID = int(value.Int64)
+		case jobattempt.FieldJobID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				ja.JobID = int(value.Int64)
+			}
+		case jobattempt.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				ja.Attempt = int(value.Int64)
+			}
+		case jobattempt.FieldWorker:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field worker", values[i])
+			} else if value.Valid {
+				ja.Worker = value.String
+			}
+		case jobattempt.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				ja.StartedAt = value.Time
+			}
+		case jobattempt.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				ja.FinishedAt = value.Time
+			}
+		case jobattempt.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				ja.DurationMs = value.Int64
+			}
+		case jobattempt.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ja.Error = value.String
+			}
+		default:
+			ja.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobAttempt.
+// This includes values selected through modifiers, order, etc.
+func (ja *JobAttempt) Value(name string) (ent.Value, error) {
+	return ja.selectValues.Get(name)
+}
+
+// QueryJob queries the "job" edge of the JobAttempt entity.
+func (ja *JobAttempt) QueryJob() *JobQuery {
+	return NewJobAttemptClient(ja.config).QueryJob(ja)
+}
+
+// Update returns a builder for updating this JobAttempt.
+// Note that you need to call JobAttempt.Unwrap() before calling this method if this JobAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ja *JobAttempt) Update() *JobAttemptUpdateOne {
+	return NewJobAttemptClient(ja.config).UpdateOne(ja)
+}
+
+// Unwrap unwraps the JobAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ja *JobAttempt) Unwrap() *JobAttempt {
+	_tx, ok := ja.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JobAttempt is not a transactional entity")
+	}
+	ja.config.driver = _tx.drv
+	return ja
+}
+
+// String implements the fmt.Stringer.
+func (ja *JobAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ja.ID))
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", ja.JobID))
+	builder.WriteString(", ")
+	builder.WriteString("attempt=")
+	builder.WriteString(fmt.Sprintf("%v", ja.Attempt))
+	builder.WriteString(", ")
+	builder.WriteString("worker=")
+	builder.WriteString(ja.Worker)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(ja.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(ja.FinishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", ja.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ja.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobAttempts is a parsable slice of JobAttempt.
+type JobAttempts []*JobAttempt
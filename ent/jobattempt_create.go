@@ -0,0 +1,832 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
+)
+
+// JobAttemptCreate is the builder for creating a JobAttempt entity.
+type JobAttemptCreate struct {
+	config
+	mutation *JobAttemptMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetJobID sets the "job_id" field.
+func (jac *JobAttemptCreate) SetJobID(i int) *JobAttemptCreate {
+	jac.mutation.SetJobID(i)
+	return jac
+}
+
+// SetAttempt sets the "attempt" field.
+func (jac *JobAttemptCreate) SetAttempt(i int) *JobAttemptCreate {
+	jac.mutation.SetAttempt(i)
+	return jac
+}
+
+// SetWorker sets the "worker" field.
+func (jac *JobAttemptCreate) SetWorker(s string) *JobAttemptCreate {
+	jac.mutation.SetWorker(s)
+	return jac
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jac *JobAttemptCreate) SetStartedAt(t time.Time) *JobAttemptCreate {
+	jac.mutation.SetStartedAt(t)
+	return jac
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jac *JobAttemptCreate) SetFinishedAt(t time.Time) *JobAttemptCreate {
+	jac.mutation.SetFinishedAt(t)
+	return jac
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (jac *JobAttemptCreate) SetDurationMs(i int64) *JobAttemptCreate {
+	jac.mutation.SetDurationMs(i)
+	return jac
+}
+
+// SetError sets the "error" field.
+func (jac *JobAttemptCreate) SetError(s string) *JobAttemptCreate {
+	jac.mutation.SetError(s)
+	return jac
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jac *JobAttemptCreate) SetNillableError(s *string) *JobAttemptCreate {
+	if s != nil {
+		jac.SetError(*s)
+	}
+	return jac
+}
+
+// SetJob sets the "job" edge to the Job entity.
+func (jac *JobAttemptCreate) SetJob(j *Job) *JobAttemptCreate {
+	return jac.SetJobID(j.ID)
+}
+
+// Mutation returns the JobAttemptMutation object of the builder.
+func (jac *JobAttemptCreate) Mutation() *JobAttemptMutation {
+	return jac.mutation
+}
+
+// Save creates the JobAttempt in the database.
+func (jac *JobAttemptCreate) Save(ctx context.Context) (*JobAttempt, error) {
+	return withHooks(ctx, jac.sqlSave, jac.mutation, jac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jac *JobAttemptCreate) SaveX(ctx context.Context) *JobAttempt {
+	v, err := jac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jac *JobAttemptCreate) Exec(ctx context.Context) error {
+	_, err := jac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jac *JobAttemptCreate) ExecX(ctx context.Context) {
+	if err := jac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jac *JobAttemptCreate) check() error {
+	if _, ok := jac.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "JobAttempt.job_id"`)}
+	}
+	if _, ok := jac.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "JobAttempt.attempt"`)}
+	}
+	if _, ok := jac.mutation.Worker(); !ok {
+		return &ValidationError{Name: "worker", err: errors.New(`ent: missing required field "JobAttempt.worker"`)}
+	}
+	if _, ok := jac.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "JobAttempt.started_at"`)}
+	}
+	if _, ok := jac.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`ent: missing required field "JobAttempt.finished_at"`)}
+	}
+	if _, ok := jac.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "JobAttempt.duration_ms"`)}
+	}
+	if len(jac.mutation.JobIDs()) == 0 {
+		return &ValidationError{Name: "job", err: errors.New(`ent: missing required edge "JobAttempt.job"`)}
+	}
+	return nil
+}
+
+func (jac *JobAttemptCreate) sqlSave(ctx context.Context) (*JobAttempt, error) {
+	if err := jac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	jac.mutation.id = &_node.ID
+	jac.mutation.done = true
+	return _node, nil
+}
+
+func (jac *JobAttemptCreate) createSpec() (*JobAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobAttempt{config: jac.config}
+		_spec = sqlgraph.NewCreateSpec(jobattempt.Table, sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = jac.conflict
+	if value, ok := jac.mutation.Attempt(); ok {
+		_spec.SetField(jobattempt.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := jac.mutation.Worker(); ok {
+		_spec.SetField(jobattempt.FieldWorker, field.TypeString, value)
+		_node.Worker = value
+	}
+	if value, ok := jac.mutation.StartedAt(); ok {
+		_spec.SetField(jobattempt.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := jac.mutation.FinishedAt(); ok {
+		_spec.SetField(jobattempt.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := jac.mutation.DurationMs(); ok {
+		_spec.SetField(jobattempt.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := jac.mutation.Error(); ok {
+		_spec.SetField(jobattempt.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if nodes := jac.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobattempt.JobTable,
+			Columns: []string{jobattempt.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JobID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobAttempt.Create().
+//		SetJobID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobAttemptUpsert) {
+//			SetJobID(v+v).
+//		}).
+//		Exec(ctx)
+func (jac *JobAttemptCreate) OnConflict(opts ...sql.ConflictOption) *JobAttemptUpsertOne {
+	jac.conflict = opts
+	return &JobAttemptUpsertOne{
+		create: jac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jac *JobAttemptCreate) OnConflictColumns(columns ...string) *JobAttemptUpsertOne {
+	jac.conflict = append(jac.conflict, sql.ConflictColumns(columns...))
+	return &JobAttemptUpsertOne{
+		create: jac,
+	}
+}
+
+type (
+	// JobAttemptUpsertOne is the builder for "upsert"-ing
+	//  one JobAttempt node.
+	JobAttemptUpsertOne struct {
+		create *JobAttemptCreate
+	}
+
+	// JobAttemptUpsert is the "OnConflict" setter.
+	JobAttemptUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAttempt sets the "attempt" field.
+func (u *JobAttemptUpsert) SetAttempt(v int) *JobAttemptUpsert {
+	u.Set(jobattempt.FieldAttempt, v)
+	return u
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *JobAttemptUpsert) UpdateAttempt() *JobAttemptUpsert {
+	u.SetExcluded(jobattempt.FieldAttempt)
+	return u
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *JobAttemptUpsert) AddAttempt(v int) *JobAttemptUpsert {
+	u.Add(jobattempt.FieldAttempt, v)
+	return u
+}
+
+// SetWorker sets the "worker" field.
+func (u *JobAttemptUpsert) SetWorker(v string) *JobAttemptUpsert {
+	u.Set(jobattempt.FieldWorker, v)
+	return u
+}
+
+// UpdateWorker sets the "worker" field to the value that was provided on create.
+func (u *JobAttemptUpsert) UpdateWorker() *JobAttemptUpsert {
+	u.SetExcluded(jobattempt.FieldWorker)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobAttemptUpsert) SetStartedAt(v time.Time) *JobAttemptUpsert {
+	u.Set(jobattempt.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobAttemptUpsert) UpdateStartedAt() *JobAttemptUpsert {
+	u.SetExcluded(jobattempt.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobAttemptUpsert) SetFinishedAt(v time.Time) *JobAttemptUpsert {
+	u.Set(jobattempt.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobAttemptUpsert) UpdateFinishedAt() *JobAttemptUpsert {
+	u.SetExcluded(jobattempt.FieldFinishedAt)
+	return u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *JobAttemptUpsert) SetDurationMs(v int64) *JobAttemptUpsert {
+	u.Set(jobattempt.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *JobAttemptUpsert) UpdateDurationMs() *JobAttemptUpsert {
+	u.SetExcluded(jobattempt.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *JobAttemptUpsert) AddDurationMs(v int64) *JobAttemptUpsert {
+	u.Add(jobattempt.FieldDurationMs, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *JobAttemptUpsert) SetError(v string) *JobAttemptUpsert {
+	u.Set(jobattempt.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobAttemptUpsert) UpdateError() *JobAttemptUpsert {
+	u.SetExcluded(jobattempt.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobAttemptUpsert) ClearError() *JobAttemptUpsert {
+	u.SetNull(jobattempt.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *JobAttemptUpsertOne) UpdateNewValues() *JobAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.JobID(); exists {
+			s.SetIgnore(jobattempt.FieldJobID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobAttemptUpsertOne) Ignore() *JobAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobAttemptUpsertOne) DoNothing() *JobAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobAttemptCreate.OnConflict
+// documentation for more info.
+func (u *JobAttemptUpsertOne) Update(set func(*JobAttemptUpsert)) *JobAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobAttemptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *JobAttemptUpsertOne) SetAttempt(v int) *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *JobAttemptUpsertOne) AddAttempt(v int) *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *JobAttemptUpsertOne) UpdateAttempt() *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// SetWorker sets the "worker" field.
+func (u *JobAttemptUpsertOne) SetWorker(v string) *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetWorker(v)
+	})
+}
+
+// UpdateWorker sets the "worker" field to the value that was provided on create.
+func (u *JobAttemptUpsertOne) UpdateWorker() *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateWorker()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobAttemptUpsertOne) SetStartedAt(v time.Time) *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobAttemptUpsertOne) UpdateStartedAt() *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobAttemptUpsertOne) SetFinishedAt(v time.Time) *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobAttemptUpsertOne) UpdateFinishedAt() *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *JobAttemptUpsertOne) SetDurationMs(v int64) *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *JobAttemptUpsertOne) AddDurationMs(v int64) *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *JobAttemptUpsertOne) UpdateDurationMs() *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobAttemptUpsertOne) SetError(v string) *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobAttemptUpsertOne) UpdateError() *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobAttemptUpsertOne) ClearError() *JobAttemptUpsertOne {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *JobAttemptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobAttemptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobAttemptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobAttemptUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobAttemptUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobAttemptCreateBulk is the builder for creating many JobAttempt entities in bulk.
+type JobAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*JobAttemptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JobAttempt entities in the database.
+func (jacb *JobAttemptCreateBulk) Save(ctx context.Context) ([]*JobAttempt, error) {
+	if jacb.err != nil {
+		return nil, jacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jacb.builders))
+	nodes := make([]*JobAttempt, len(jacb.builders))
+	mutators := make([]Mutator, len(jacb.builders))
+	for i := range jacb.builders {
+		func(i int, root context.Context) {
+			builder := jacb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jacb *JobAttemptCreateBulk) SaveX(ctx context.Context) []*JobAttempt {
+	v, err := jacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jacb *JobAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := jacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jacb *JobAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := jacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobAttempt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobAttemptUpsert) {
+//			SetJobID(v+v).
+//		}).
+//		Exec(ctx)
+func (jacb *JobAttemptCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobAttemptUpsertBulk {
+	jacb.conflict = opts
+	return &JobAttemptUpsertBulk{
+		create: jacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jacb *JobAttemptCreateBulk) OnConflictColumns(columns ...string) *JobAttemptUpsertBulk {
+	jacb.conflict = append(jacb.conflict, sql.ConflictColumns(columns...))
+	return &JobAttemptUpsertBulk{
+		create: jacb,
+	}
+}
+
+// JobAttemptUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobAttempt nodes.
+type JobAttemptUpsertBulk struct {
+	create *JobAttemptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *JobAttemptUpsertBulk) UpdateNewValues() *JobAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.JobID(); exists {
+				s.SetIgnore(jobattempt.FieldJobID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobAttempt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobAttemptUpsertBulk) Ignore() *JobAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobAttemptUpsertBulk) DoNothing() *JobAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobAttemptCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobAttemptUpsertBulk) Update(set func(*JobAttemptUpsert)) *JobAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobAttemptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *JobAttemptUpsertBulk) SetAttempt(v int) *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *JobAttemptUpsertBulk) AddAttempt(v int) *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *JobAttemptUpsertBulk) UpdateAttempt() *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// SetWorker sets the "worker" field.
+func (u *JobAttemptUpsertBulk) SetWorker(v string) *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetWorker(v)
+	})
+}
+
+// UpdateWorker sets the "worker" field to the value that was provided on create.
+func (u *JobAttemptUpsertBulk) UpdateWorker() *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateWorker()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobAttemptUpsertBulk) SetStartedAt(v time.Time) *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobAttemptUpsertBulk) UpdateStartedAt() *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobAttemptUpsertBulk) SetFinishedAt(v time.Time) *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobAttemptUpsertBulk) UpdateFinishedAt() *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *JobAttemptUpsertBulk) SetDurationMs(v int64) *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *JobAttemptUpsertBulk) AddDurationMs(v int64) *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *JobAttemptUpsertBulk) UpdateDurationMs() *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobAttemptUpsertBulk) SetError(v string) *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobAttemptUpsertBulk) UpdateError() *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobAttemptUpsertBulk) ClearError() *JobAttemptUpsertBulk {
+	return u.Update(func(s *JobAttemptUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *JobAttemptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobAttemptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobAttemptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobAttemptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// JobAttemptQuery is the builder for querying JobAttempt entities.
+type JobAttemptQuery struct {
+	config
+	ctx        *QueryContext
+	order      []jobattempt.OrderOption
+	inters     []Interceptor
+	predicates []predicate.JobAttempt
+	withJob    *JobQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the JobAttemptQuery builder.
+func (jaq *JobAttemptQuery) Where(ps ...predicate.JobAttempt) *JobAttemptQuery {
+	jaq.predicates = append(jaq.predicates, ps...)
+	return jaq
+}
+
+// Limit the number of records to be returned by this query.
+func (jaq *JobAttemptQuery) Limit(limit int) *JobAttemptQuery {
+	jaq.ctx.Limit = &limit
+	return jaq
+}
+
+// Offset to start from.
+func (jaq *JobAttemptQuery) Offset(offset int) *JobAttemptQuery {
+	jaq.ctx.Offset = &offset
+	return jaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (jaq *JobAttemptQuery) Unique(unique bool) *JobAttemptQuery {
+	jaq.ctx.Unique = &unique
+	return jaq
+}
+
+// Order specifies how the records should be ordered.
+func (jaq *JobAttemptQuery) Order(o ...jobattempt.OrderOption) *JobAttemptQuery {
+	jaq.order = append(jaq.order, o...)
+	return jaq
+}
+
+// QueryJob chains the current query on the "job" edge.
+func (jaq *JobAttemptQuery) QueryJob() *JobQuery {
+	query := (&JobClient{config: jaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := jaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := jaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobattempt.Table, jobattempt.FieldID, selector),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, jobattempt.JobTable, jobattempt.JobColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(jaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first JobAttempt entity from the query.
+// Returns a *NotFoundError when no JobAttempt was found.
+func (jaq *JobAttemptQuery) First(ctx context.Context) (*JobAttempt, error) {
+	nodes, err := jaq.Limit(1).All(setContextOp(ctx, jaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{jobattempt.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (jaq *JobAttemptQuery) FirstX(ctx context.Context) *JobAttempt {
+	node, err := jaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first JobAttempt ID from the query.
+// Returns a *NotFoundError when no JobAttempt ID was found.
+func (jaq *JobAttemptQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = jaq.Limit(1).IDs(setContextOp(ctx, jaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{jobattempt.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (jaq *JobAttemptQuery) FirstIDX(ctx context.Context) int {
+	id, err := jaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single JobAttempt entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one JobAttempt entity is found.
+// Returns a *NotFoundError when no JobAttempt entities are found.
+func (jaq *JobAttemptQuery) Only(ctx context.Context) (*JobAttempt, error) {
+	nodes, err := jaq.Limit(2).All(setContextOp(ctx, jaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{jobattempt.Label}
+	default:
+		return nil, &NotSingularError{jobattempt.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (jaq *JobAttemptQuery) OnlyX(ctx context.Context) *JobAttempt {
+	node, err := jaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only JobAttempt ID in the query.
+// Returns a *NotSingularError when more than one JobAttempt ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (jaq *JobAttemptQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = jaq.Limit(2).IDs(setContextOp(ctx, jaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{jobattempt.Label}
+	default:
+		err = &NotSingularError{jobattempt.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (jaq *JobAttemptQuery) OnlyIDX(ctx context.Context) int {
+	id, err := jaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of JobAttempts.
+func (jaq *JobAttemptQuery) All(ctx context.Context) ([]*JobAttempt, error) {
+	ctx = setContextOp(ctx, jaq.ctx, ent.OpQueryAll)
+	if err := jaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*JobAttempt, *JobAttemptQuery]()
+	return withInterceptors[[]*JobAttempt](ctx, jaq, qr, jaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (jaq *JobAttemptQuery) AllX(ctx context.Context) []*JobAttempt {
+	nodes, err := jaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of JobAttempt IDs.
+func (jaq *JobAttemptQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if jaq.ctx.Unique == nil && jaq.path != nil {
+		jaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, jaq.ctx, ent.OpQueryIDs)
+	if err = jaq.Select(jobattempt.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (jaq *JobAttemptQuery) IDsX(ctx context.Context) []int {
+	ids, err := jaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (jaq *JobAttemptQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, jaq.ctx, ent.OpQueryCount)
+	if err := jaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, jaq, querierCount[*JobAttemptQuery](), jaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (jaq *JobAttemptQuery) CountX(ctx context.Context) int {
+	count, err := jaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (jaq *JobAttemptQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, jaq.ctx, ent.OpQueryExist)
+	switch _, err := jaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (jaq *JobAttemptQuery) ExistX(ctx context.Context) bool {
+	exist, err := jaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the JobAttemptQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (jaq *JobAttemptQuery) Clone() *JobAttemptQuery {
+	if jaq == nil {
+		return nil
+	}
+	return &JobAttemptQuery{
+		config:     jaq.config,
+		ctx:        jaq.ctx.Clone(),
+		order:      append([]jobattempt.OrderOption{}, jaq.order...),
+		inters:     append([]Interceptor{}, jaq.inters...),
+		predicates: append([]predicate.JobAttempt{}, jaq.predicates...),
+		withJob:    jaq.withJob.Clone(),
+		// clone intermediate query.
+		sql:  jaq.sql.Clone(),
+		path: jaq.path,
+	}
+}
+
+// WithJob tells the query-builder to eager-load the nodes that are connected to
+// the "job" edge. The optional arguments are used to configure the query builder of the edge.
+func (jaq *JobAttemptQuery) WithJob(opts ...func(*JobQuery)) *JobAttemptQuery {
+	query := (&JobClient{config: jaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	jaq.withJob = query
+	return jaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		JobID int `json:"job_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.JobAttempt.Query().
+//		GroupBy(jobattempt.FieldJobID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (jaq *JobAttemptQuery) GroupBy(field string, fields ...string) *JobAttemptGroupBy {
+	jaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &JobAttemptGroupBy{build: jaq}
+	grbuild.flds = &jaq.ctx.Fields
+	grbuild.label = jobattempt.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		JobID int `json:"job_id,omitempty"`
+//	}
+//
+//	client.JobAttempt.Query().
+//		Select(jobattempt.FieldJobID).
+//		Scan(ctx, &v)
+func (jaq *JobAttemptQuery) Select(fields ...string) *JobAttemptSelect {
+	jaq.ctx.Fields = append(jaq.ctx.Fields, fields...)
+	sbuild := &JobAttemptSelect{JobAttemptQuery: jaq}
+	sbuild.label = jobattempt.Label
+	sbuild.flds, sbuild.scan = &jaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a JobAttemptSelect configured with the given aggregations.
+func (jaq *JobAttemptQuery) Aggregate(fns ...AggregateFunc) *JobAttemptSelect {
+	return jaq.Select().Aggregate(fns...)
+}
+
+func (jaq *JobAttemptQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range jaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, jaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range jaq.ctx.Fields {
+		if !jobattempt.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if jaq.path != nil {
+		prev, err := jaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		jaq.sql = prev
+	}
+	return nil
+}
+
+func (jaq *JobAttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*JobAttempt, error) {
+	var (
+		nodes       = []*JobAttempt{}
+		_spec       = jaq.querySpec()
+		loadedTypes = [1]bool{
+			jaq.withJob != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*JobAttempt).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &JobAttempt{config: jaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(jaq.modifiers) > 0 {
+		_spec.Modifiers = jaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, jaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := jaq.withJob; query != nil {
+		if err := jaq.loadJob(ctx, query, nodes, nil,
+			func(n *JobAttempt, e *Job) { n.Edges.Job = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (jaq *JobAttemptQuery) loadJob(ctx context.Context, query *JobQuery, nodes []*JobAttempt, init func(*JobAttempt), assign func(*JobAttempt, *Job)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*JobAttempt)
+	for i := range nodes {
+		fk := nodes[i].JobID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(job.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "job_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (jaq *JobAttemptQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := jaq.querySpec()
+	if len(jaq.modifiers) > 0 {
+		_spec.Modifiers = jaq.modifiers
+	}
+	_spec.Node.Columns = jaq.ctx.Fields
+	if len(jaq.ctx.Fields) > 0 {
+		_spec.Unique = jaq.ctx.Unique != nil && *jaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, jaq.driver, _spec)
+}
+
+func (jaq *JobAttemptQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(jobattempt.Table, jobattempt.Columns, sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt))
+	_spec.From = jaq.sql
+	if unique := jaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if jaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := jaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobattempt.FieldID)
+		for i := range fields {
+			if fields[i] != jobattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if jaq.withJob != nil {
+			_spec.Node.AddColumnOnce(jobattempt.FieldJobID)
+		}
+	}
+	if ps := jaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := jaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := jaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := jaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (jaq *JobAttemptQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(jaq.driver.Dialect())
+	t1 := builder.Table(jobattempt.Table)
+	columns := jaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = jobattempt.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if jaq.sql != nil {
+		selector = jaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if jaq.ctx.Unique != nil && *jaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range jaq.modifiers {
+		m(selector)
+	}
+	for _, p := range jaq.predicates {
+		p(selector)
+	}
+	for _, p := range jaq.order {
+		p(selector)
+	}
+	if offset := jaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := jaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (jaq *JobAttemptQuery) ForUpdate(opts ...sql.LockOption) *JobAttemptQuery {
+	if jaq.driver.Dialect() == dialect.Postgres {
+		jaq.Unique(false)
+	}
+	jaq.modifiers = append(jaq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return jaq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (jaq *JobAttemptQuery) ForShare(opts ...sql.LockOption) *JobAttemptQuery {
+	if jaq.driver.Dialect() == dialect.Postgres {
+		jaq.Unique(false)
+	}
+	jaq.modifiers = append(jaq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return jaq
+}
+
+// JobAttemptGroupBy is the group-by builder for JobAttempt entities.
+type JobAttemptGroupBy struct {
+	selector
+	build *JobAttemptQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (jagb *JobAttemptGroupBy) Aggregate(fns ...AggregateFunc) *JobAttemptGroupBy {
+	jagb.fns = append(jagb.fns, fns...)
+	return jagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jagb *JobAttemptGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jagb.build.ctx, ent.OpQueryGroupBy)
+	if err := jagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JobAttemptQuery, *JobAttemptGroupBy](ctx, jagb.build, jagb, jagb.build.inters, v)
+}
+
+func (jagb *JobAttemptGroupBy) sqlScan(ctx context.Context, root *JobAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(jagb.fns))
+	for _, fn := range jagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*jagb.flds)+len(jagb.fns))
+		for _, f := range *jagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*jagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// JobAttemptSelect is the builder for selecting fields of JobAttempt entities.
+type JobAttemptSelect struct {
+	*JobAttemptQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (jas *JobAttemptSelect) Aggregate(fns ...AggregateFunc) *JobAttemptSelect {
+	jas.fns = append(jas.fns, fns...)
+	return jas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jas *JobAttemptSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jas.ctx, ent.OpQuerySelect)
+	if err := jas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JobAttemptQuery, *JobAttemptSelect](ctx, jas.JobAttemptQuery, jas, jas.inters, v)
+}
+
+func (jas *JobAttemptSelect) sqlScan(ctx context.Context, root *JobAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(jas.fns))
+	for _, fn := range jas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*jas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
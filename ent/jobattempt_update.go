@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/jobattempt"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// JobAttemptUpdate is the builder for updating JobAttempt entities.
+type JobAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobAttemptMutation
+}
+
+// Where appends a list predicates to the JobAttemptUpdate builder.
+func (jau *JobAttemptUpdate) Where(ps ...predicate.JobAttempt) *JobAttemptUpdate {
+	jau.mutation.Where(ps...)
+	return jau
+}
+
+// SetAttempt sets the "attempt" field.
+func (jau *JobAttemptUpdate) SetAttempt(i int) *JobAttemptUpdate {
+	jau.mutation.ResetAttempt()
+	jau.mutation.SetAttempt(i)
+	return jau
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (jau *JobAttemptUpdate) SetNillableAttempt(i *int) *JobAttemptUpdate {
+	if i != nil {
+		jau.SetAttempt(*i)
+	}
+	return jau
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (jau *JobAttemptUpdate) AddAttempt(i int) *JobAttemptUpdate {
+	jau.mutation.AddAttempt(i)
+	return jau
+}
+
+// SetWorker sets the "worker" field.
+func (jau *JobAttemptUpdate) SetWorker(s string) *JobAttemptUpdate {
+	jau.mutation.SetWorker(s)
+	return jau
+}
+
+// SetNillableWorker sets the "worker" field if the given value is not nil.
+func (jau *JobAttemptUpdate) SetNillableWorker(s *string) *JobAttemptUpdate {
+	if s != nil {
+		jau.SetWorker(*s)
+	}
+	return jau
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jau *JobAttemptUpdate) SetStartedAt(t time.Time) *JobAttemptUpdate {
+	jau.mutation.SetStartedAt(t)
+	return jau
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jau *JobAttemptUpdate) SetNillableStartedAt(t *time.Time) *JobAttemptUpdate {
+	if t != nil {
+		jau.SetStartedAt(*t)
+	}
+	return jau
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jau *JobAttemptUpdate) SetFinishedAt(t time.Time) *JobAttemptUpdate {
+	jau.mutation.SetFinishedAt(t)
+	return jau
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jau *JobAttemptUpdate) SetNillableFinishedAt(t *time.Time) *JobAttemptUpdate {
+	if t != nil {
+		jau.SetFinishedAt(*t)
+	}
+	return jau
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (jau *JobAttemptUpdate) SetDurationMs(i int64) *JobAttemptUpdate {
+	jau.mutation.ResetDurationMs()
+	jau.mutation.SetDurationMs(i)
+	return jau
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (jau *JobAttemptUpdate) SetNillableDurationMs(i *int64) *JobAttemptUpdate {
+	if i != nil {
+		jau.SetDurationMs(*i)
+	}
+	return jau
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (jau *JobAttemptUpdate) AddDurationMs(i int64) *JobAttemptUpdate {
+	jau.mutation.AddDurationMs(i)
+	return jau
+}
+
+// SetError sets the "error" field.
+func (jau *JobAttemptUpdate) SetError(s string) *JobAttemptUpdate {
+	jau.mutation.SetError(s)
+	return jau
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jau *JobAttemptUpdate) SetNillableError(s *string) *JobAttemptUpdate {
+	if s != nil {
+		jau.SetError(*s)
+	}
+	return jau
+}
+
+// ClearError clears the value of the "error" field.
+func (jau *JobAttemptUpdate) ClearError() *JobAttemptUpdate {
+	jau.mutation.ClearError()
+	return jau
+}
+
+// Mutation returns the JobAttemptMutation object of the builder.
+func (jau *JobAttemptUpdate) Mutation() *JobAttemptMutation {
+	return jau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jau *JobAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, jau.sqlSave, jau.mutation, jau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jau *JobAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := jau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jau *JobAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := jau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jau *JobAttemptUpdate) ExecX(ctx context.Context) {
+	if err := jau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jau *JobAttemptUpdate) check() error {
+	if jau.mutation.JobCleared() && len(jau.mutation.JobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JobAttempt.job"`)
+	}
+	return nil
+}
+
+func (jau *JobAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := jau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobattempt.Table, jobattempt.Columns, sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt))
+	if ps := jau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jau.mutation.Attempt(); ok {
+		_spec.SetField(jobattempt.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := jau.mutation.AddedAttempt(); ok {
+		_spec.AddField(jobattempt.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := jau.mutation.Worker(); ok {
+		_spec.SetField(jobattempt.FieldWorker, field.TypeString, value)
+	}
+	if value, ok := jau.mutation.StartedAt(); ok {
+		_spec.SetField(jobattempt.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := jau.mutation.FinishedAt(); ok {
+		_spec.SetField(jobattempt.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := jau.mutation.DurationMs(); ok {
+		_spec.SetField(jobattempt.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := jau.mutation.AddedDurationMs(); ok {
+		_spec.AddField(jobattempt.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := jau.mutation.Error(); ok {
+		_spec.SetField(jobattempt.FieldError, field.TypeString, value)
+	}
+	if jau.mutation.ErrorCleared() {
+		_spec.ClearField(jobattempt.FieldError, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jau.mutation.done = true
+	return n, nil
+}
+
+// JobAttemptUpdateOne is the builder for updating a single JobAttempt entity.
+type JobAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobAttemptMutation
+}
+
+// SetAttempt sets the "attempt" field.
+func (jauo *JobAttemptUpdateOne) SetAttempt(i int) *JobAttemptUpdateOne {
+	jauo.mutation.ResetAttempt()
+	jauo.mutation.SetAttempt(i)
+	return jauo
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (jauo *JobAttemptUpdateOne) SetNillableAttempt(i *int) *JobAttemptUpdateOne {
+	if i != nil {
+		jauo.SetAttempt(*i)
+	}
+	return jauo
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (jauo *JobAttemptUpdateOne) AddAttempt(i int) *JobAttemptUpdateOne {
+	jauo.mutation.AddAttempt(i)
+	return jauo
+}
+
+// SetWorker sets the "worker" field.
+func (jauo *JobAttemptUpdateOne) SetWorker(s string) *JobAttemptUpdateOne {
+	jauo.mutation.SetWorker(s)
+	return jauo
+}
+
+// SetNillableWorker sets the "worker" field if the given value is not nil.
+func (jauo *JobAttemptUpdateOne) SetNillableWorker(s *string) *JobAttemptUpdateOne {
+	if s != nil {
+		jauo.SetWorker(*s)
+	}
+	return jauo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jauo *JobAttemptUpdateOne) SetStartedAt(t time.Time) *JobAttemptUpdateOne {
+	jauo.mutation.SetStartedAt(t)
+	return jauo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jauo *JobAttemptUpdateOne) SetNillableStartedAt(t *time.Time) *JobAttemptUpdateOne {
+	if t != nil {
+		jauo.SetStartedAt(*t)
+	}
+	return jauo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jauo *JobAttemptUpdateOne) SetFinishedAt(t time.Time) *JobAttemptUpdateOne {
+	jauo.mutation.SetFinishedAt(t)
+	return jauo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jauo *JobAttemptUpdateOne) SetNillableFinishedAt(t *time.Time) *JobAttemptUpdateOne {
+	if t != nil {
+		jauo.SetFinishedAt(*t)
+	}
+	return jauo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (jauo *JobAttemptUpdateOne) SetDurationMs(i int64) *JobAttemptUpdateOne {
+	jauo.mutation.ResetDurationMs()
+	jauo.mutation.SetDurationMs(i)
+	return jauo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (jauo *JobAttemptUpdateOne) SetNillableDurationMs(i *int64) *JobAttemptUpdateOne {
+	if i != nil {
+		jauo.SetDurationMs(*i)
+	}
+	return jauo
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (jauo *JobAttemptUpdateOne) AddDurationMs(i int64) *JobAttemptUpdateOne {
+	jauo.mutation.AddDurationMs(i)
+	return jauo
+}
+
+// SetError sets the "error" field.
+func (jauo *JobAttemptUpdateOne) SetError(s string) *JobAttemptUpdateOne {
+	jauo.mutation.SetError(s)
+	return jauo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jauo *JobAttemptUpdateOne) SetNillableError(s *string) *JobAttemptUpdateOne {
+	if s != nil {
+		jauo.SetError(*s)
+	}
+	return jauo
+}
+
+// ClearError clears the value of the "error" field.
+func (jauo *JobAttemptUpdateOne) ClearError() *JobAttemptUpdateOne {
+	jauo.mutation.ClearError()
+	return jauo
+}
+
+// Mutation returns the JobAttemptMutation object of the builder.
+func (jauo *JobAttemptUpdateOne) Mutation() *JobAttemptMutation {
+	return jauo.mutation
+}
+
+// Where appends a list predicates to the JobAttemptUpdate builder.
+func (jauo *JobAttemptUpdateOne) Where(ps ...predicate.JobAttempt) *JobAttemptUpdateOne {
+	jauo.mutation.Where(ps...)
+	return jauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jauo *JobAttemptUpdateOne) Select(field string, fields ...string) *JobAttemptUpdateOne {
+	jauo.fields = append([]string{field}, fields...)
+	return jauo
+}
+
+// Save executes the query and returns the updated JobAttempt entity.
+func (jauo *JobAttemptUpdateOne) Save(ctx context.Context) (*JobAttempt, error) {
+	return withHooks(ctx, jauo.sqlSave, jauo.mutation, jauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jauo *JobAttemptUpdateOne) SaveX(ctx context.Context) *JobAttempt {
+	node, err := jauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jauo *JobAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := jauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jauo *JobAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := jauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jauo *JobAttemptUpdateOne) check() error {
+	if jauo.mutation.JobCleared() && len(jauo.mutation.JobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JobAttempt.job"`)
+	}
+	return nil
+}
+
+func (jauo *JobAttemptUpdateOne) sqlSave(ctx context.Context) (_node *JobAttempt, err error) {
+	if err := jauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobattempt.Table, jobattempt.Columns, sqlgraph.NewFieldSpec(jobattempt.FieldID, field.TypeInt))
+	id, ok := jauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobattempt.FieldID)
+		for _, f := range fields {
+			if !jobattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jauo.mutation.Attempt(); ok {
+		_spec.SetField(jobattempt.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := jauo.mutation.AddedAttempt(); ok {
+		_spec.AddField(jobattempt.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := jauo.mutation.Worker(); ok {
+		_spec.SetField(jobattempt.FieldWorker, field.TypeString, value)
+	}
+	if value, ok := jauo.mutation.StartedAt(); ok {
+		_spec.SetField(jobattempt.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := jauo.mutation.FinishedAt(); ok {
+		_spec.SetField(jobattempt.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := jauo.mutation.DurationMs(); ok {
+		_spec.SetField(jobattempt.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := jauo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(jobattempt.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := jauo.mutation.Error(); ok {
+		_spec.SetField(jobattempt.FieldError, field.TypeString, value)
+	}
+	if jauo.mutation.ErrorCleared() {
+		_spec.ClearField(jobattempt.FieldError, field.TypeString)
+	}
+	_node = &JobAttempt{config: jauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jauo.mutation.done = true
+	return _node, nil
+}
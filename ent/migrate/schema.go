@@ -36,6 +36,38 @@ var (
 			},
 		},
 	}
+	// DiscountsColumns holds the columns for the "discounts" table.
+	DiscountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider_discount_id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString, Default: "stripe"},
+		{Name: "provider_coupon_id", Type: field.TypeString},
+		{Name: "provider_promotion_code_id", Type: field.TypeString, Nullable: true},
+		{Name: "code", Type: field.TypeString, Nullable: true},
+		{Name: "percent_off", Type: field.TypeFloat64, Nullable: true},
+		{Name: "amount_off", Type: field.TypeInt64, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "duration", Type: field.TypeEnum, Enums: []string{"once", "repeating", "forever"}},
+		{Name: "duration_in_months", Type: field.TypeInt, Nullable: true},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "subscription_discounts", Type: field.TypeInt},
+	}
+	// DiscountsTable holds the schema information for the "discounts" table.
+	DiscountsTable = &schema.Table{
+		Name:       "discounts",
+		Columns:    DiscountsColumns,
+		PrimaryKey: []*schema.Column{DiscountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discounts_subscriptions_discounts",
+				Columns:    []*schema.Column{DiscountsColumns[14]},
+				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// FormsColumns holds the columns for the "forms" table.
 	FormsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -44,6 +76,9 @@ var (
 		{Name: "published", Type: field.TypeBool, Default: false},
 		{Name: "slug", Type: field.TypeString},
 		{Name: "display_mode", Type: field.TypeEnum, Enums: []string{"traditional", "conversational"}, Default: "traditional"},
+		{Name: "metadata_storage", Type: field.TypeEnum, Enums: []string{"full", "truncated", "hashed", "none"}, Default: "full"},
+		{Name: "retention_days", Type: field.TypeInt, Default: 0},
+		{Name: "notify_on_response", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeInt},
@@ -56,7 +91,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "forms_users_forms",
-				Columns:    []*schema.Column{FormsColumns[8]},
+				Columns:    []*schema.Column{FormsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -65,7 +100,45 @@ var (
 			{
 				Name:    "form_user_id_slug",
 				Unique:  true,
-				Columns: []*schema.Column{FormsColumns[8], FormsColumns[4]},
+				Columns: []*schema.Column{FormsColumns[11], FormsColumns[4]},
+			},
+		},
+	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider_invoice_id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString, Default: "stripe"},
+		{Name: "provider_subscription_id", Type: field.TypeString, Nullable: true},
+		{Name: "number", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "open", "paid", "uncollectible", "void"}, Default: "draft"},
+		{Name: "currency", Type: field.TypeString, Default: "usd"},
+		{Name: "subtotal", Type: field.TypeInt64, Default: 0},
+		{Name: "tax", Type: field.TypeInt64, Default: 0},
+		{Name: "total", Type: field.TypeInt64, Default: 0},
+		{Name: "amount_due", Type: field.TypeInt64, Default: 0},
+		{Name: "amount_paid", Type: field.TypeInt64, Default: 0},
+		{Name: "hosted_link", Type: field.TypeString, Nullable: true},
+		{Name: "pdf_link", Type: field.TypeString, Nullable: true},
+		{Name: "period_start", Type: field.TypeTime, Nullable: true},
+		{Name: "period_end", Type: field.TypeTime, Nullable: true},
+		{Name: "paid_at", Type: field.TypeTime, Nullable: true},
+		{Name: "issued_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "payment_customer_invoices", Type: field.TypeInt},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_payment_customers_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[20]},
+				RefColumns: []*schema.Column{PaymentCustomersColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
 		},
 	}
@@ -76,10 +149,17 @@ var (
 		{Name: "payload", Type: field.TypeJSON},
 		{Name: "attempts", Type: field.TypeInt, Default: 0},
 		{Name: "max_attempts", Type: field.TypeInt, Default: 3},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed"}, Default: "pending"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed", "cancelled"}, Default: "pending"},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "scheduled_for", Type: field.TypeTime, Nullable: true},
+		{Name: "unique_key", Type: field.TypeString, Nullable: true},
+		{Name: "unique_lock", Type: field.TypeString, Nullable: true},
 		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_by", Type: field.TypeString, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
 	}
 	// JobsTable holds the schema information for the "jobs" table.
 	JobsTable = &schema.Table{
@@ -95,7 +175,59 @@ var (
 			{
 				Name:    "job_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{JobsColumns[7]},
+				Columns: []*schema.Column{JobsColumns[12]},
+			},
+			{
+				Name:    "job_status_locked_until",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[5], JobsColumns[15]},
+			},
+			{
+				Name:    "job_queue_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[1], JobsColumns[5], JobsColumns[7]},
+			},
+			{
+				Name:    "job_queue_scheduled_for",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[1], JobsColumns[8]},
+			},
+			{
+				Name:    "job_queue_unique_lock",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[1], JobsColumns[10]},
+			},
+		},
+	}
+	// JobAttemptsColumns holds the columns for the "job_attempts" table.
+	JobAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "worker", Type: field.TypeString},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime},
+		{Name: "duration_ms", Type: field.TypeInt64},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "job_id", Type: field.TypeInt},
+	}
+	// JobAttemptsTable holds the schema information for the "job_attempts" table.
+	JobAttemptsTable = &schema.Table{
+		Name:       "job_attempts",
+		Columns:    JobAttemptsColumns,
+		PrimaryKey: []*schema.Column{JobAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "job_attempts_jobs_history",
+				Columns:    []*schema.Column{JobAttemptsColumns[7]},
+				RefColumns: []*schema.Column{JobsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobattempt_job_id_attempt",
+				Unique:  false,
+				Columns: []*schema.Column{JobAttemptsColumns[7], JobAttemptsColumns[1]},
 			},
 		},
 	}
@@ -120,6 +252,22 @@ var (
 			},
 		},
 	}
+	// PaymentAccountsColumns holds the columns for the "payment_accounts" table.
+	PaymentAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider_account_id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString, Default: "stripe"},
+		{Name: "charges_enabled", Type: field.TypeBool, Default: false},
+		{Name: "details_submitted", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PaymentAccountsTable holds the schema information for the "payment_accounts" table.
+	PaymentAccountsTable = &schema.Table{
+		Name:       "payment_accounts",
+		Columns:    PaymentAccountsColumns,
+		PrimaryKey: []*schema.Column{PaymentAccountsColumns[0]},
+	}
 	// PaymentCustomersColumns holds the columns for the "payment_customers" table.
 	PaymentCustomersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -144,13 +292,16 @@ var (
 		{Name: "provider", Type: field.TypeString, Default: "stripe"},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"requires_payment_method", "requires_confirmation", "requires_action", "processing", "requires_capture", "canceled", "succeeded"}, Default: "requires_payment_method"},
 		{Name: "amount", Type: field.TypeInt64},
+		{Name: "amount_refunded", Type: field.TypeInt64, Default: 0},
 		{Name: "currency", Type: field.TypeString, Default: "usd"},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "client_secret", Type: field.TypeString, Nullable: true},
+		{Name: "provider_account_id", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "payment_customer_payment_intents", Type: field.TypeInt},
+		{Name: "payment_customer_payment_intents", Type: field.TypeInt, Nullable: true},
+		{Name: "response_payment", Type: field.TypeInt, Unique: true, Nullable: true},
 	}
 	// PaymentIntentsTable holds the schema information for the "payment_intents" table.
 	PaymentIntentsTable = &schema.Table{
@@ -160,9 +311,15 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "payment_intents_payment_customers_payment_intents",
-				Columns:    []*schema.Column{PaymentIntentsColumns[11]},
+				Columns:    []*schema.Column{PaymentIntentsColumns[13]},
 				RefColumns: []*schema.Column{PaymentCustomersColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "payment_intents_responses_payment",
+				Columns:    []*schema.Column{PaymentIntentsColumns[14]},
+				RefColumns: []*schema.Column{ResponsesColumns[0]},
+				OnDelete:   schema.SetNull,
 			},
 		},
 	}
@@ -171,7 +328,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "provider_payment_method_id", Type: field.TypeString, Unique: true},
 		{Name: "provider", Type: field.TypeString, Default: "stripe"},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"card", "bank_account", "wallet"}, Default: "card"},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"card", "bank_account", "sepa_debit", "boleto", "pix", "wallet"}, Default: "card"},
 		{Name: "last_four", Type: field.TypeString, Nullable: true},
 		{Name: "brand", Type: field.TypeString, Nullable: true},
 		{Name: "exp_month", Type: field.TypeInt, Nullable: true},
@@ -196,14 +353,36 @@ var (
 			},
 		},
 	}
+	// ProcessedEventsColumns holds the columns for the "processed_events" table.
+	ProcessedEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString, Default: "stripe"},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ProcessedEventsTable holds the schema information for the "processed_events" table.
+	ProcessedEventsTable = &schema.Table{
+		Name:       "processed_events",
+		Columns:    ProcessedEventsColumns,
+		PrimaryKey: []*schema.Column{ProcessedEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "processedevent_provider_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{ProcessedEventsColumns[1], ProcessedEventsColumns[2]},
+			},
+		},
+	}
 	// QuestionsColumns holds the columns for the "questions" table.
 	QuestionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "short-text", "long-text", "email", "number", "phone", "url", "textarea", "date", "time", "date-range", "file", "signature", "dropdown", "radio", "checkbox", "multi-select", "picture-choice", "yesno", "rating", "opinion-scale", "ranking", "matrix", "statement", "legal", "hidden", "multi-input"}, Default: "text"},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "short-text", "long-text", "email", "number", "phone", "url", "textarea", "date", "time", "date-range", "file", "signature", "dropdown", "radio", "checkbox", "multi-select", "picture-choice", "yesno", "rating", "opinion-scale", "ranking", "matrix", "statement", "legal", "hidden", "multi-input", "payment"}, Default: "text"},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "placeholder", Type: field.TypeString, Nullable: true},
 		{Name: "required", Type: field.TypeBool, Default: false},
+		{Name: "sensitive", Type: field.TypeBool, Default: false},
 		{Name: "order", Type: field.TypeInt, Default: 0},
 		{Name: "options", Type: field.TypeJSON, Nullable: true},
 		{Name: "validation", Type: field.TypeJSON, Nullable: true},
@@ -219,7 +398,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "questions_forms_questions",
-				Columns:    []*schema.Column{QuestionsColumns[11]},
+				Columns:    []*schema.Column{QuestionsColumns[12]},
 				RefColumns: []*schema.Column{FormsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -228,7 +407,44 @@ var (
 			{
 				Name:    "question_order",
 				Unique:  false,
-				Columns: []*schema.Column{QuestionsColumns[6]},
+				Columns: []*schema.Column{QuestionsColumns[7]},
+			},
+		},
+	}
+	// ReportSubscriptionsColumns holds the columns for the "report_subscriptions" table.
+	ReportSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "frequency", Type: field.TypeEnum, Enums: []string{"daily", "weekly", "monthly"}, Default: "weekly"},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "last_period_start", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "form_id", Type: field.TypeInt, Nullable: true},
+	}
+	// ReportSubscriptionsTable holds the schema information for the "report_subscriptions" table.
+	ReportSubscriptionsTable = &schema.Table{
+		Name:       "report_subscriptions",
+		Columns:    ReportSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{ReportSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "report_subscriptions_users_user",
+				Columns:    []*schema.Column{ReportSubscriptionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "report_subscriptions_forms_form",
+				Columns:    []*schema.Column{ReportSubscriptionsColumns[6]},
+				RefColumns: []*schema.Column{FormsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reportsubscription_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReportSubscriptionsColumns[5]},
 			},
 		},
 	}
@@ -239,6 +455,7 @@ var (
 		{Name: "completed", Type: field.TypeBool, Default: true},
 		{Name: "ip_address", Type: field.TypeString, Nullable: true},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"new", "in_progress", "done", "archived"}, Default: "new"},
 		{Name: "form_responses", Type: field.TypeInt},
 		{Name: "user_responses", Type: field.TypeInt, Nullable: true},
 	}
@@ -250,13 +467,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "responses_forms_responses",
-				Columns:    []*schema.Column{ResponsesColumns[5]},
+				Columns:    []*schema.Column{ResponsesColumns[6]},
 				RefColumns: []*schema.Column{FormsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "responses_users_responses",
-				Columns:    []*schema.Column{ResponsesColumns[6]},
+				Columns:    []*schema.Column{ResponsesColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -267,6 +484,103 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{ResponsesColumns[1]},
 			},
+			{
+				Name:    "response_status",
+				Unique:  false,
+				Columns: []*schema.Column{ResponsesColumns[5]},
+			},
+		},
+	}
+	// ResponseActivitiesColumns holds the columns for the "response_activities" table.
+	ResponseActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"answers_updated", "status_changed", "note_added", "tags_updated", "deleted"}},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "response_activities", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ResponseActivitiesTable holds the schema information for the "response_activities" table.
+	ResponseActivitiesTable = &schema.Table{
+		Name:       "response_activities",
+		Columns:    ResponseActivitiesColumns,
+		PrimaryKey: []*schema.Column{ResponseActivitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "response_activities_responses_activities",
+				Columns:    []*schema.Column{ResponseActivitiesColumns[4]},
+				RefColumns: []*schema.Column{ResponsesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "response_activities_users_actor",
+				Columns:    []*schema.Column{ResponseActivitiesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "responseactivity_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ResponseActivitiesColumns[3]},
+			},
+		},
+	}
+	// ResponseNotesColumns holds the columns for the "response_notes" table.
+	ResponseNotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "response_notes", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ResponseNotesTable holds the schema information for the "response_notes" table.
+	ResponseNotesTable = &schema.Table{
+		Name:       "response_notes",
+		Columns:    ResponseNotesColumns,
+		PrimaryKey: []*schema.Column{ResponseNotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "response_notes_responses_notes",
+				Columns:    []*schema.Column{ResponseNotesColumns[3]},
+				RefColumns: []*schema.Column{ResponsesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "response_notes_users_author",
+				Columns:    []*schema.Column{ResponseNotesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ResponseTagsColumns holds the columns for the "response_tags" table.
+	ResponseTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ResponseTagsTable holds the schema information for the "response_tags" table.
+	ResponseTagsTable = &schema.Table{
+		Name:       "response_tags",
+		Columns:    ResponseTagsColumns,
+		PrimaryKey: []*schema.Column{ResponseTagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "response_tags_users_owner",
+				Columns:    []*schema.Column{ResponseTagsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "responsetag_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ResponseTagsColumns[3], ResponseTagsColumns[1]},
+			},
 		},
 	}
 	// SubscriptionsColumns holds the columns for the "subscriptions" table.
@@ -286,6 +600,13 @@ var (
 		{Name: "trial_end", Type: field.TypeTime, Nullable: true},
 		{Name: "canceled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_at_period_end", Type: field.TypeBool, Default: false},
+		{Name: "pending_price_id", Type: field.TypeString, Nullable: true},
+		{Name: "pending_change_at", Type: field.TypeTime, Nullable: true},
+		{Name: "past_due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "dunning_reminders", Type: field.TypeInt, Default: 0},
+		{Name: "trial_reminded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_event_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -299,12 +620,45 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscriptions_payment_customers_subscriptions",
-				Columns:    []*schema.Column{SubscriptionsColumns[18]},
+				Columns:    []*schema.Column{SubscriptionsColumns[25]},
 				RefColumns: []*schema.Column{PaymentCustomersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// UsagesColumns holds the columns for the "usages" table.
+	UsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "responses", Type: field.TypeInt64, Default: 0},
+		{Name: "forms", Type: field.TypeInt64, Default: 0},
+		{Name: "storage_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UsagesTable holds the schema information for the "usages" table.
+	UsagesTable = &schema.Table{
+		Name:       "usages",
+		Columns:    UsagesColumns,
+		PrimaryKey: []*schema.Column{UsagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "usages_users_usage",
+				Columns:    []*schema.Column{UsagesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usage_user_id_period_start",
+				Unique:  true,
+				Columns: []*schema.Column{UsagesColumns[8], UsagesColumns[1]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -322,6 +676,7 @@ var (
 		{Name: "brand_colors_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "processing", "completed", "failed"}},
 		{Name: "logo", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "payment_account_user", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "payment_customer_user", Type: field.TypeInt, Unique: true, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -331,39 +686,96 @@ var (
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "users_payment_customers_user",
+				Symbol:     "users_payment_accounts_user",
 				Columns:    []*schema.Column{UsersColumns[15]},
+				RefColumns: []*schema.Column{PaymentAccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "users_payment_customers_user",
+				Columns:    []*schema.Column{UsersColumns[16]},
 				RefColumns: []*schema.Column{PaymentCustomersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ResponseTagAssignmentsColumns holds the columns for the "response_tag_assignments" table.
+	ResponseTagAssignmentsColumns = []*schema.Column{
+		{Name: "response_id", Type: field.TypeInt},
+		{Name: "response_tag_id", Type: field.TypeInt},
+	}
+	// ResponseTagAssignmentsTable holds the schema information for the "response_tag_assignments" table.
+	ResponseTagAssignmentsTable = &schema.Table{
+		Name:       "response_tag_assignments",
+		Columns:    ResponseTagAssignmentsColumns,
+		PrimaryKey: []*schema.Column{ResponseTagAssignmentsColumns[0], ResponseTagAssignmentsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "response_tag_assignments_response_id",
+				Columns:    []*schema.Column{ResponseTagAssignmentsColumns[0]},
+				RefColumns: []*schema.Column{ResponsesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "response_tag_assignments_response_tag_id",
+				Columns:    []*schema.Column{ResponseTagAssignmentsColumns[1]},
+				RefColumns: []*schema.Column{ResponseTagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AnswersTable,
+		DiscountsTable,
 		FormsTable,
+		InvoicesTable,
 		JobsTable,
+		JobAttemptsTable,
 		PasswordTokensTable,
+		PaymentAccountsTable,
 		PaymentCustomersTable,
 		PaymentIntentsTable,
 		PaymentMethodsTable,
+		ProcessedEventsTable,
 		QuestionsTable,
+		ReportSubscriptionsTable,
 		ResponsesTable,
+		ResponseActivitiesTable,
+		ResponseNotesTable,
+		ResponseTagsTable,
 		SubscriptionsTable,
+		UsagesTable,
 		UsersTable,
+		ResponseTagAssignmentsTable,
 	}
 )
 
 func init() {
 	AnswersTable.ForeignKeys[0].RefTable = QuestionsTable
 	AnswersTable.ForeignKeys[1].RefTable = ResponsesTable
+	DiscountsTable.ForeignKeys[0].RefTable = SubscriptionsTable
 	FormsTable.ForeignKeys[0].RefTable = UsersTable
+	InvoicesTable.ForeignKeys[0].RefTable = PaymentCustomersTable
+	JobAttemptsTable.ForeignKeys[0].RefTable = JobsTable
 	PasswordTokensTable.ForeignKeys[0].RefTable = UsersTable
 	PaymentIntentsTable.ForeignKeys[0].RefTable = PaymentCustomersTable
+	PaymentIntentsTable.ForeignKeys[1].RefTable = ResponsesTable
 	PaymentMethodsTable.ForeignKeys[0].RefTable = PaymentCustomersTable
 	QuestionsTable.ForeignKeys[0].RefTable = FormsTable
+	ReportSubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
+	ReportSubscriptionsTable.ForeignKeys[1].RefTable = FormsTable
 	ResponsesTable.ForeignKeys[0].RefTable = FormsTable
 	ResponsesTable.ForeignKeys[1].RefTable = UsersTable
+	ResponseActivitiesTable.ForeignKeys[0].RefTable = ResponsesTable
+	ResponseActivitiesTable.ForeignKeys[1].RefTable = UsersTable
+	ResponseNotesTable.ForeignKeys[0].RefTable = ResponsesTable
+	ResponseNotesTable.ForeignKeys[1].RefTable = UsersTable
+	ResponseTagsTable.ForeignKeys[0].RefTable = UsersTable
 	SubscriptionsTable.ForeignKeys[0].RefTable = PaymentCustomersTable
-	UsersTable.ForeignKeys[0].RefTable = PaymentCustomersTable
+	UsagesTable.ForeignKeys[0].RefTable = UsersTable
+	UsersTable.ForeignKeys[0].RefTable = PaymentAccountsTable
+	UsersTable.ForeignKeys[1].RefTable = PaymentCustomersTable
+	ResponseTagAssignmentsTable.ForeignKeys[0].RefTable = ResponsesTable
+	ResponseTagAssignmentsTable.ForeignKeys[1].RefTable = ResponseTagsTable
 }
@@ -12,16 +12,26 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/occult/pagode/ent/answer"
+	"github.com/occult/pagode/ent/discount"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
 	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
 	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/processedevent"
 	"github.com/occult/pagode/ent/question"
+	"github.com/occult/pagode/ent/reportsubscription"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
 	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/usage"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -34,17 +44,27 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAnswer          = "Answer"
-	TypeForm            = "Form"
-	TypeJob             = "Job"
-	TypePasswordToken   = "PasswordToken"
-	TypePaymentCustomer = "PaymentCustomer"
-	TypePaymentIntent   = "PaymentIntent"
-	TypePaymentMethod   = "PaymentMethod"
-	TypeQuestion        = "Question"
-	TypeResponse        = "Response"
-	TypeSubscription    = "Subscription"
-	TypeUser            = "User"
+	TypeAnswer             = "Answer"
+	TypeDiscount           = "Discount"
+	TypeForm               = "Form"
+	TypeInvoice            = "Invoice"
+	TypeJob                = "Job"
+	TypeJobAttempt         = "JobAttempt"
+	TypePasswordToken      = "PasswordToken"
+	TypePaymentAccount     = "PaymentAccount"
+	TypePaymentCustomer    = "PaymentCustomer"
+	TypePaymentIntent      = "PaymentIntent"
+	TypePaymentMethod      = "PaymentMethod"
+	TypeProcessedEvent     = "ProcessedEvent"
+	TypeQuestion           = "Question"
+	TypeReportSubscription = "ReportSubscription"
+	TypeResponse           = "Response"
+	TypeResponseActivity   = "ResponseActivity"
+	TypeResponseNote       = "ResponseNote"
+	TypeResponseTag        = "ResponseTag"
+	TypeSubscription       = "Subscription"
+	TypeUsage              = "Usage"
+	TypeUser               = "User"
 )
 
 // AnswerMutation represents an operation that mutates the Answer nodes in the graph.
@@ -553,44 +573,47 @@ func (m *AnswerMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Answer edge %s", name)
 }
 
-// FormMutation represents an operation that mutates the Form nodes in the graph.
-type FormMutation struct {
+// DiscountMutation represents an operation that mutates the Discount nodes in the graph.
+type DiscountMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	title            *string
-	description      *string
-	published        *bool
-	slug             *string
-	display_mode     *form.DisplayMode
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	owner            *int
-	clearedowner     bool
-	questions        map[int]struct{}
-	removedquestions map[int]struct{}
-	clearedquestions bool
-	responses        map[int]struct{}
-	removedresponses map[int]struct{}
-	clearedresponses bool
-	done             bool
-	oldValue         func(context.Context) (*Form, error)
-	predicates       []predicate.Form
+	op                         Op
+	typ                        string
+	id                         *int
+	provider_discount_id       *string
+	provider                   *string
+	provider_coupon_id         *string
+	provider_promotion_code_id *string
+	code                       *string
+	percent_off                *float64
+	addpercent_off             *float64
+	amount_off                 *int64
+	addamount_off              *int64
+	currency                   *string
+	duration                   *discount.Duration
+	duration_in_months         *int
+	addduration_in_months      *int
+	starts_at                  *time.Time
+	ends_at                    *time.Time
+	created_at                 *time.Time
+	clearedFields              map[string]struct{}
+	subscription               *int
+	clearedsubscription        bool
+	done                       bool
+	oldValue                   func(context.Context) (*Discount, error)
+	predicates                 []predicate.Discount
 }
 
-var _ ent.Mutation = (*FormMutation)(nil)
+var _ ent.Mutation = (*DiscountMutation)(nil)
 
-// formOption allows management of the mutation configuration using functional options.
-type formOption func(*FormMutation)
+// discountOption allows management of the mutation configuration using functional options.
+type discountOption func(*DiscountMutation)
 
-// newFormMutation creates new mutation for the Form entity.
-func newFormMutation(c config, op Op, opts ...formOption) *FormMutation {
-	m := &FormMutation{
+// newDiscountMutation creates new mutation for the Discount entity.
+func newDiscountMutation(c config, op Op, opts ...discountOption) *DiscountMutation {
+	m := &DiscountMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeForm,
+		typ:           TypeDiscount,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -599,20 +622,20 @@ func newFormMutation(c config, op Op, opts ...formOption) *FormMutation {
 	return m
 }
 
-// withFormID sets the ID field of the mutation.
-func withFormID(id int) formOption {
-	return func(m *FormMutation) {
+// withDiscountID sets the ID field of the mutation.
+func withDiscountID(id int) discountOption {
+	return func(m *DiscountMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Form
+			value *Discount
 		)
-		m.oldValue = func(ctx context.Context) (*Form, error) {
+		m.oldValue = func(ctx context.Context) (*Discount, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Form.Get(ctx, id)
+					value, err = m.Client().Discount.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -621,10 +644,10 @@ func withFormID(id int) formOption {
 	}
 }
 
-// withForm sets the old Form of the mutation.
-func withForm(node *Form) formOption {
-	return func(m *FormMutation) {
-		m.oldValue = func(context.Context) (*Form, error) {
+// withDiscount sets the old Discount of the mutation.
+func withDiscount(node *Discount) discountOption {
+	return func(m *DiscountMutation) {
+		m.oldValue = func(context.Context) (*Discount, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -633,7 +656,7 @@ func withForm(node *Form) formOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FormMutation) Client() *Client {
+func (m DiscountMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -641,7 +664,7 @@ func (m FormMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FormMutation) Tx() (*Tx, error) {
+func (m DiscountMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -652,7 +675,7 @@ func (m FormMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FormMutation) ID() (id int, exists bool) {
+func (m *DiscountMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -663,7 +686,7 @@ func (m *FormMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FormMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DiscountMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -672,470 +695,682 @@ func (m *FormMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Form.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Discount.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *FormMutation) SetTitle(s string) {
-	m.title = &s
+// SetProviderDiscountID sets the "provider_discount_id" field.
+func (m *DiscountMutation) SetProviderDiscountID(s string) {
+	m.provider_discount_id = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *FormMutation) Title() (r string, exists bool) {
-	v := m.title
+// ProviderDiscountID returns the value of the "provider_discount_id" field in the mutation.
+func (m *DiscountMutation) ProviderDiscountID() (r string, exists bool) {
+	v := m.provider_discount_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Form entity.
-// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// OldProviderDiscountID returns the old "provider_discount_id" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FormMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *DiscountMutation) OldProviderDiscountID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderDiscountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldProviderDiscountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderDiscountID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.ProviderDiscountID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *FormMutation) ResetTitle() {
-	m.title = nil
+// ResetProviderDiscountID resets all changes to the "provider_discount_id" field.
+func (m *DiscountMutation) ResetProviderDiscountID() {
+	m.provider_discount_id = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *FormMutation) SetDescription(s string) {
-	m.description = &s
+// SetProvider sets the "provider" field.
+func (m *DiscountMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *FormMutation) Description() (r string, exists bool) {
-	v := m.description
+// Provider returns the value of the "provider" field in the mutation.
+func (m *DiscountMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Form entity.
-// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FormMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *DiscountMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *FormMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[form.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *FormMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[form.FieldDescription]
-	return ok
+	return oldValue.Provider, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *FormMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, form.FieldDescription)
+// ResetProvider resets all changes to the "provider" field.
+func (m *DiscountMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetPublished sets the "published" field.
-func (m *FormMutation) SetPublished(b bool) {
-	m.published = &b
+// SetProviderCouponID sets the "provider_coupon_id" field.
+func (m *DiscountMutation) SetProviderCouponID(s string) {
+	m.provider_coupon_id = &s
 }
 
-// Published returns the value of the "published" field in the mutation.
-func (m *FormMutation) Published() (r bool, exists bool) {
-	v := m.published
+// ProviderCouponID returns the value of the "provider_coupon_id" field in the mutation.
+func (m *DiscountMutation) ProviderCouponID() (r string, exists bool) {
+	v := m.provider_coupon_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublished returns the old "published" field's value of the Form entity.
-// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// OldProviderCouponID returns the old "provider_coupon_id" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FormMutation) OldPublished(ctx context.Context) (v bool, err error) {
+func (m *DiscountMutation) OldProviderCouponID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderCouponID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublished requires an ID field in the mutation")
+		return v, errors.New("OldProviderCouponID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderCouponID: %w", err)
 	}
-	return oldValue.Published, nil
+	return oldValue.ProviderCouponID, nil
 }
 
-// ResetPublished resets all changes to the "published" field.
-func (m *FormMutation) ResetPublished() {
-	m.published = nil
+// ResetProviderCouponID resets all changes to the "provider_coupon_id" field.
+func (m *DiscountMutation) ResetProviderCouponID() {
+	m.provider_coupon_id = nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *FormMutation) SetSlug(s string) {
-	m.slug = &s
+// SetProviderPromotionCodeID sets the "provider_promotion_code_id" field.
+func (m *DiscountMutation) SetProviderPromotionCodeID(s string) {
+	m.provider_promotion_code_id = &s
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *FormMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// ProviderPromotionCodeID returns the value of the "provider_promotion_code_id" field in the mutation.
+func (m *DiscountMutation) ProviderPromotionCodeID() (r string, exists bool) {
+	v := m.provider_promotion_code_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Form entity.
-// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// OldProviderPromotionCodeID returns the old "provider_promotion_code_id" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FormMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *DiscountMutation) OldProviderPromotionCodeID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderPromotionCodeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldProviderPromotionCodeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderPromotionCodeID: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.ProviderPromotionCodeID, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *FormMutation) ResetSlug() {
-	m.slug = nil
+// ClearProviderPromotionCodeID clears the value of the "provider_promotion_code_id" field.
+func (m *DiscountMutation) ClearProviderPromotionCodeID() {
+	m.provider_promotion_code_id = nil
+	m.clearedFields[discount.FieldProviderPromotionCodeID] = struct{}{}
 }
 
-// SetDisplayMode sets the "display_mode" field.
-func (m *FormMutation) SetDisplayMode(fm form.DisplayMode) {
-	m.display_mode = &fm
+// ProviderPromotionCodeIDCleared returns if the "provider_promotion_code_id" field was cleared in this mutation.
+func (m *DiscountMutation) ProviderPromotionCodeIDCleared() bool {
+	_, ok := m.clearedFields[discount.FieldProviderPromotionCodeID]
+	return ok
 }
 
-// DisplayMode returns the value of the "display_mode" field in the mutation.
-func (m *FormMutation) DisplayMode() (r form.DisplayMode, exists bool) {
-	v := m.display_mode
+// ResetProviderPromotionCodeID resets all changes to the "provider_promotion_code_id" field.
+func (m *DiscountMutation) ResetProviderPromotionCodeID() {
+	m.provider_promotion_code_id = nil
+	delete(m.clearedFields, discount.FieldProviderPromotionCodeID)
+}
+
+// SetCode sets the "code" field.
+func (m *DiscountMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *DiscountMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayMode returns the old "display_mode" field's value of the Form entity.
-// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FormMutation) OldDisplayMode(ctx context.Context) (v form.DisplayMode, err error) {
+func (m *DiscountMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayMode is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayMode requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayMode: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.DisplayMode, nil
+	return oldValue.Code, nil
 }
 
-// ResetDisplayMode resets all changes to the "display_mode" field.
-func (m *FormMutation) ResetDisplayMode() {
-	m.display_mode = nil
+// ClearCode clears the value of the "code" field.
+func (m *DiscountMutation) ClearCode() {
+	m.code = nil
+	m.clearedFields[discount.FieldCode] = struct{}{}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *FormMutation) SetUserID(i int) {
-	m.owner = &i
+// CodeCleared returns if the "code" field was cleared in this mutation.
+func (m *DiscountMutation) CodeCleared() bool {
+	_, ok := m.clearedFields[discount.FieldCode]
+	return ok
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *FormMutation) UserID() (r int, exists bool) {
-	v := m.owner
+// ResetCode resets all changes to the "code" field.
+func (m *DiscountMutation) ResetCode() {
+	m.code = nil
+	delete(m.clearedFields, discount.FieldCode)
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (m *DiscountMutation) SetPercentOff(f float64) {
+	m.percent_off = &f
+	m.addpercent_off = nil
+}
+
+// PercentOff returns the value of the "percent_off" field in the mutation.
+func (m *DiscountMutation) PercentOff() (r float64, exists bool) {
+	v := m.percent_off
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Form entity.
-// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// OldPercentOff returns the old "percent_off" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FormMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *DiscountMutation) OldPercentOff(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPercentOff is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldPercentOff requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPercentOff: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.PercentOff, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *FormMutation) ResetUserID() {
-	m.owner = nil
+// AddPercentOff adds f to the "percent_off" field.
+func (m *DiscountMutation) AddPercentOff(f float64) {
+	if m.addpercent_off != nil {
+		*m.addpercent_off += f
+	} else {
+		m.addpercent_off = &f
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FormMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedPercentOff returns the value that was added to the "percent_off" field in this mutation.
+func (m *DiscountMutation) AddedPercentOff() (r float64, exists bool) {
+	v := m.addpercent_off
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FormMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ClearPercentOff clears the value of the "percent_off" field.
+func (m *DiscountMutation) ClearPercentOff() {
+	m.percent_off = nil
+	m.addpercent_off = nil
+	m.clearedFields[discount.FieldPercentOff] = struct{}{}
+}
+
+// PercentOffCleared returns if the "percent_off" field was cleared in this mutation.
+func (m *DiscountMutation) PercentOffCleared() bool {
+	_, ok := m.clearedFields[discount.FieldPercentOff]
+	return ok
+}
+
+// ResetPercentOff resets all changes to the "percent_off" field.
+func (m *DiscountMutation) ResetPercentOff() {
+	m.percent_off = nil
+	m.addpercent_off = nil
+	delete(m.clearedFields, discount.FieldPercentOff)
+}
+
+// SetAmountOff sets the "amount_off" field.
+func (m *DiscountMutation) SetAmountOff(i int64) {
+	m.amount_off = &i
+	m.addamount_off = nil
+}
+
+// AmountOff returns the value of the "amount_off" field in the mutation.
+func (m *DiscountMutation) AmountOff() (r int64, exists bool) {
+	v := m.amount_off
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Form entity.
-// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// OldAmountOff returns the old "amount_off" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FormMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DiscountMutation) OldAmountOff(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmountOff is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAmountOff requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmountOff: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AmountOff, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FormMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddAmountOff adds i to the "amount_off" field.
+func (m *DiscountMutation) AddAmountOff(i int64) {
+	if m.addamount_off != nil {
+		*m.addamount_off += i
+	} else {
+		m.addamount_off = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FormMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedAmountOff returns the value that was added to the "amount_off" field in this mutation.
+func (m *DiscountMutation) AddedAmountOff() (r int64, exists bool) {
+	v := m.addamount_off
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FormMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ClearAmountOff clears the value of the "amount_off" field.
+func (m *DiscountMutation) ClearAmountOff() {
+	m.amount_off = nil
+	m.addamount_off = nil
+	m.clearedFields[discount.FieldAmountOff] = struct{}{}
+}
+
+// AmountOffCleared returns if the "amount_off" field was cleared in this mutation.
+func (m *DiscountMutation) AmountOffCleared() bool {
+	_, ok := m.clearedFields[discount.FieldAmountOff]
+	return ok
+}
+
+// ResetAmountOff resets all changes to the "amount_off" field.
+func (m *DiscountMutation) ResetAmountOff() {
+	m.amount_off = nil
+	m.addamount_off = nil
+	delete(m.clearedFields, discount.FieldAmountOff)
+}
+
+// SetCurrency sets the "currency" field.
+func (m *DiscountMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *DiscountMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Form entity.
-// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FormMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DiscountMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Currency, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FormMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearCurrency clears the value of the "currency" field.
+func (m *DiscountMutation) ClearCurrency() {
+	m.currency = nil
+	m.clearedFields[discount.FieldCurrency] = struct{}{}
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *FormMutation) SetOwnerID(id int) {
-	m.owner = &id
+// CurrencyCleared returns if the "currency" field was cleared in this mutation.
+func (m *DiscountMutation) CurrencyCleared() bool {
+	_, ok := m.clearedFields[discount.FieldCurrency]
+	return ok
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *FormMutation) ClearOwner() {
-	m.clearedowner = true
-	m.clearedFields[form.FieldUserID] = struct{}{}
+// ResetCurrency resets all changes to the "currency" field.
+func (m *DiscountMutation) ResetCurrency() {
+	m.currency = nil
+	delete(m.clearedFields, discount.FieldCurrency)
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *FormMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SetDuration sets the "duration" field.
+func (m *DiscountMutation) SetDuration(d discount.Duration) {
+	m.duration = &d
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *FormMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// Duration returns the value of the "duration" field in the mutation.
+func (m *DiscountMutation) Duration() (r discount.Duration, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *FormMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldDuration returns the old "duration" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscountMutation) OldDuration(ctx context.Context) (v discount.Duration, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *FormMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetDuration resets all changes to the "duration" field.
+func (m *DiscountMutation) ResetDuration() {
+	m.duration = nil
 }
 
-// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
-func (m *FormMutation) AddQuestionIDs(ids ...int) {
-	if m.questions == nil {
-		m.questions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.questions[ids[i]] = struct{}{}
-	}
+// SetDurationInMonths sets the "duration_in_months" field.
+func (m *DiscountMutation) SetDurationInMonths(i int) {
+	m.duration_in_months = &i
+	m.addduration_in_months = nil
 }
 
-// ClearQuestions clears the "questions" edge to the Question entity.
-func (m *FormMutation) ClearQuestions() {
-	m.clearedquestions = true
+// DurationInMonths returns the value of the "duration_in_months" field in the mutation.
+func (m *DiscountMutation) DurationInMonths() (r int, exists bool) {
+	v := m.duration_in_months
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
-func (m *FormMutation) QuestionsCleared() bool {
-	return m.clearedquestions
+// OldDurationInMonths returns the old "duration_in_months" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscountMutation) OldDurationInMonths(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationInMonths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationInMonths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationInMonths: %w", err)
+	}
+	return oldValue.DurationInMonths, nil
 }
 
-// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
-func (m *FormMutation) RemoveQuestionIDs(ids ...int) {
-	if m.removedquestions == nil {
-		m.removedquestions = make(map[int]struct{})
+// AddDurationInMonths adds i to the "duration_in_months" field.
+func (m *DiscountMutation) AddDurationInMonths(i int) {
+	if m.addduration_in_months != nil {
+		*m.addduration_in_months += i
+	} else {
+		m.addduration_in_months = &i
 	}
-	for i := range ids {
-		delete(m.questions, ids[i])
-		m.removedquestions[ids[i]] = struct{}{}
+}
+
+// AddedDurationInMonths returns the value that was added to the "duration_in_months" field in this mutation.
+func (m *DiscountMutation) AddedDurationInMonths() (r int, exists bool) {
+	v := m.addduration_in_months
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
-func (m *FormMutation) RemovedQuestionsIDs() (ids []int) {
-	for id := range m.removedquestions {
-		ids = append(ids, id)
+// ClearDurationInMonths clears the value of the "duration_in_months" field.
+func (m *DiscountMutation) ClearDurationInMonths() {
+	m.duration_in_months = nil
+	m.addduration_in_months = nil
+	m.clearedFields[discount.FieldDurationInMonths] = struct{}{}
+}
+
+// DurationInMonthsCleared returns if the "duration_in_months" field was cleared in this mutation.
+func (m *DiscountMutation) DurationInMonthsCleared() bool {
+	_, ok := m.clearedFields[discount.FieldDurationInMonths]
+	return ok
+}
+
+// ResetDurationInMonths resets all changes to the "duration_in_months" field.
+func (m *DiscountMutation) ResetDurationInMonths() {
+	m.duration_in_months = nil
+	m.addduration_in_months = nil
+	delete(m.clearedFields, discount.FieldDurationInMonths)
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *DiscountMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *DiscountMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// QuestionsIDs returns the "questions" edge IDs in the mutation.
-func (m *FormMutation) QuestionsIDs() (ids []int) {
-	for id := range m.questions {
-		ids = append(ids, id)
+// OldStartsAt returns the old "starts_at" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscountMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
 }
 
-// ResetQuestions resets all changes to the "questions" edge.
-func (m *FormMutation) ResetQuestions() {
-	m.questions = nil
-	m.clearedquestions = false
-	m.removedquestions = nil
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *DiscountMutation) ResetStartsAt() {
+	m.starts_at = nil
 }
 
-// AddResponseIDs adds the "responses" edge to the Response entity by ids.
-func (m *FormMutation) AddResponseIDs(ids ...int) {
-	if m.responses == nil {
-		m.responses = make(map[int]struct{})
+// SetEndsAt sets the "ends_at" field.
+func (m *DiscountMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *DiscountMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.responses[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscountMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
 	}
+	return oldValue.EndsAt, nil
 }
 
-// ClearResponses clears the "responses" edge to the Response entity.
-func (m *FormMutation) ClearResponses() {
-	m.clearedresponses = true
+// ClearEndsAt clears the value of the "ends_at" field.
+func (m *DiscountMutation) ClearEndsAt() {
+	m.ends_at = nil
+	m.clearedFields[discount.FieldEndsAt] = struct{}{}
 }
 
-// ResponsesCleared reports if the "responses" edge to the Response entity was cleared.
-func (m *FormMutation) ResponsesCleared() bool {
-	return m.clearedresponses
+// EndsAtCleared returns if the "ends_at" field was cleared in this mutation.
+func (m *DiscountMutation) EndsAtCleared() bool {
+	_, ok := m.clearedFields[discount.FieldEndsAt]
+	return ok
 }
 
-// RemoveResponseIDs removes the "responses" edge to the Response entity by IDs.
-func (m *FormMutation) RemoveResponseIDs(ids ...int) {
-	if m.removedresponses == nil {
-		m.removedresponses = make(map[int]struct{})
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *DiscountMutation) ResetEndsAt() {
+	m.ends_at = nil
+	delete(m.clearedFields, discount.FieldEndsAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DiscountMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DiscountMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.responses, ids[i])
-		m.removedresponses[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Discount entity.
+// If the Discount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// RemovedResponses returns the removed IDs of the "responses" edge to the Response entity.
-func (m *FormMutation) RemovedResponsesIDs() (ids []int) {
-	for id := range m.removedresponses {
-		ids = append(ids, id)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DiscountMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSubscriptionID sets the "subscription" edge to the Subscription entity by id.
+func (m *DiscountMutation) SetSubscriptionID(id int) {
+	m.subscription = &id
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (m *DiscountMutation) ClearSubscription() {
+	m.clearedsubscription = true
+}
+
+// SubscriptionCleared reports if the "subscription" edge to the Subscription entity was cleared.
+func (m *DiscountMutation) SubscriptionCleared() bool {
+	return m.clearedsubscription
+}
+
+// SubscriptionID returns the "subscription" edge ID in the mutation.
+func (m *DiscountMutation) SubscriptionID() (id int, exists bool) {
+	if m.subscription != nil {
+		return *m.subscription, true
 	}
 	return
 }
 
-// ResponsesIDs returns the "responses" edge IDs in the mutation.
-func (m *FormMutation) ResponsesIDs() (ids []int) {
-	for id := range m.responses {
-		ids = append(ids, id)
+// SubscriptionIDs returns the "subscription" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubscriptionID instead. It exists only for internal usage by the builders.
+func (m *DiscountMutation) SubscriptionIDs() (ids []int) {
+	if id := m.subscription; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetResponses resets all changes to the "responses" edge.
-func (m *FormMutation) ResetResponses() {
-	m.responses = nil
-	m.clearedresponses = false
-	m.removedresponses = nil
+// ResetSubscription resets all changes to the "subscription" edge.
+func (m *DiscountMutation) ResetSubscription() {
+	m.subscription = nil
+	m.clearedsubscription = false
 }
 
-// Where appends a list predicates to the FormMutation builder.
-func (m *FormMutation) Where(ps ...predicate.Form) {
+// Where appends a list predicates to the DiscountMutation builder.
+func (m *DiscountMutation) Where(ps ...predicate.Discount) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FormMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DiscountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FormMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Form, len(ps))
+func (m *DiscountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Discount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1143,48 +1378,63 @@ func (m *FormMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FormMutation) Op() Op {
+func (m *DiscountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FormMutation) SetOp(op Op) {
+func (m *DiscountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Form).
-func (m *FormMutation) Type() string {
+// Type returns the node type of this mutation (Discount).
+func (m *DiscountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FormMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.title != nil {
-		fields = append(fields, form.FieldTitle)
+func (m *DiscountMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.provider_discount_id != nil {
+		fields = append(fields, discount.FieldProviderDiscountID)
 	}
-	if m.description != nil {
-		fields = append(fields, form.FieldDescription)
+	if m.provider != nil {
+		fields = append(fields, discount.FieldProvider)
 	}
-	if m.published != nil {
-		fields = append(fields, form.FieldPublished)
+	if m.provider_coupon_id != nil {
+		fields = append(fields, discount.FieldProviderCouponID)
 	}
-	if m.slug != nil {
-		fields = append(fields, form.FieldSlug)
+	if m.provider_promotion_code_id != nil {
+		fields = append(fields, discount.FieldProviderPromotionCodeID)
 	}
-	if m.display_mode != nil {
-		fields = append(fields, form.FieldDisplayMode)
+	if m.code != nil {
+		fields = append(fields, discount.FieldCode)
 	}
-	if m.owner != nil {
-		fields = append(fields, form.FieldUserID)
+	if m.percent_off != nil {
+		fields = append(fields, discount.FieldPercentOff)
 	}
-	if m.created_at != nil {
-		fields = append(fields, form.FieldCreatedAt)
+	if m.amount_off != nil {
+		fields = append(fields, discount.FieldAmountOff)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, form.FieldUpdatedAt)
+	if m.currency != nil {
+		fields = append(fields, discount.FieldCurrency)
+	}
+	if m.duration != nil {
+		fields = append(fields, discount.FieldDuration)
+	}
+	if m.duration_in_months != nil {
+		fields = append(fields, discount.FieldDurationInMonths)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, discount.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, discount.FieldEndsAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, discount.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1192,24 +1442,34 @@ func (m *FormMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FormMutation) Field(name string) (ent.Value, bool) {
+func (m *DiscountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case form.FieldTitle:
-		return m.Title()
-	case form.FieldDescription:
-		return m.Description()
-	case form.FieldPublished:
-		return m.Published()
-	case form.FieldSlug:
-		return m.Slug()
-	case form.FieldDisplayMode:
-		return m.DisplayMode()
-	case form.FieldUserID:
-		return m.UserID()
-	case form.FieldCreatedAt:
+	case discount.FieldProviderDiscountID:
+		return m.ProviderDiscountID()
+	case discount.FieldProvider:
+		return m.Provider()
+	case discount.FieldProviderCouponID:
+		return m.ProviderCouponID()
+	case discount.FieldProviderPromotionCodeID:
+		return m.ProviderPromotionCodeID()
+	case discount.FieldCode:
+		return m.Code()
+	case discount.FieldPercentOff:
+		return m.PercentOff()
+	case discount.FieldAmountOff:
+		return m.AmountOff()
+	case discount.FieldCurrency:
+		return m.Currency()
+	case discount.FieldDuration:
+		return m.Duration()
+	case discount.FieldDurationInMonths:
+		return m.DurationInMonths()
+	case discount.FieldStartsAt:
+		return m.StartsAt()
+	case discount.FieldEndsAt:
+		return m.EndsAt()
+	case discount.FieldCreatedAt:
 		return m.CreatedAt()
-	case form.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1217,105 +1477,165 @@ func (m *FormMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FormMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DiscountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case form.FieldTitle:
-		return m.OldTitle(ctx)
-	case form.FieldDescription:
-		return m.OldDescription(ctx)
-	case form.FieldPublished:
-		return m.OldPublished(ctx)
-	case form.FieldSlug:
-		return m.OldSlug(ctx)
-	case form.FieldDisplayMode:
-		return m.OldDisplayMode(ctx)
-	case form.FieldUserID:
-		return m.OldUserID(ctx)
-	case form.FieldCreatedAt:
+	case discount.FieldProviderDiscountID:
+		return m.OldProviderDiscountID(ctx)
+	case discount.FieldProvider:
+		return m.OldProvider(ctx)
+	case discount.FieldProviderCouponID:
+		return m.OldProviderCouponID(ctx)
+	case discount.FieldProviderPromotionCodeID:
+		return m.OldProviderPromotionCodeID(ctx)
+	case discount.FieldCode:
+		return m.OldCode(ctx)
+	case discount.FieldPercentOff:
+		return m.OldPercentOff(ctx)
+	case discount.FieldAmountOff:
+		return m.OldAmountOff(ctx)
+	case discount.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case discount.FieldDuration:
+		return m.OldDuration(ctx)
+	case discount.FieldDurationInMonths:
+		return m.OldDurationInMonths(ctx)
+	case discount.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case discount.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case discount.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case form.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Form field %s", name)
+	return nil, fmt.Errorf("unknown Discount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FormMutation) SetField(name string, value ent.Value) error {
+func (m *DiscountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case form.FieldTitle:
+	case discount.FieldProviderDiscountID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetProviderDiscountID(v)
 		return nil
-	case form.FieldDescription:
+	case discount.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetProvider(v)
 		return nil
-	case form.FieldPublished:
-		v, ok := value.(bool)
+	case discount.FieldProviderCouponID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublished(v)
+		m.SetProviderCouponID(v)
 		return nil
-	case form.FieldSlug:
+	case discount.FieldProviderPromotionCodeID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetProviderPromotionCodeID(v)
 		return nil
-	case form.FieldDisplayMode:
-		v, ok := value.(form.DisplayMode)
+	case discount.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayMode(v)
+		m.SetCode(v)
 		return nil
-	case form.FieldUserID:
+	case discount.FieldPercentOff:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPercentOff(v)
+		return nil
+	case discount.FieldAmountOff:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountOff(v)
+		return nil
+	case discount.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case discount.FieldDuration:
+		v, ok := value.(discount.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case discount.FieldDurationInMonths:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetDurationInMonths(v)
 		return nil
-	case form.FieldCreatedAt:
+	case discount.FieldStartsAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetStartsAt(v)
 		return nil
-	case form.FieldUpdatedAt:
+	case discount.FieldEndsAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetEndsAt(v)
+		return nil
+	case discount.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Form field %s", name)
+	return fmt.Errorf("unknown Discount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FormMutation) AddedFields() []string {
+func (m *DiscountMutation) AddedFields() []string {
 	var fields []string
+	if m.addpercent_off != nil {
+		fields = append(fields, discount.FieldPercentOff)
+	}
+	if m.addamount_off != nil {
+		fields = append(fields, discount.FieldAmountOff)
+	}
+	if m.addduration_in_months != nil {
+		fields = append(fields, discount.FieldDurationInMonths)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FormMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DiscountMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case discount.FieldPercentOff:
+		return m.AddedPercentOff()
+	case discount.FieldAmountOff:
+		return m.AddedAmountOff()
+	case discount.FieldDurationInMonths:
+		return m.AddedDurationInMonths()
 	}
 	return nil, false
 }
@@ -1323,233 +1643,260 @@ func (m *FormMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FormMutation) AddField(name string, value ent.Value) error {
+func (m *DiscountMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case discount.FieldPercentOff:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPercentOff(v)
+		return nil
+	case discount.FieldAmountOff:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountOff(v)
+		return nil
+	case discount.FieldDurationInMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationInMonths(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Form numeric field %s", name)
+	return fmt.Errorf("unknown Discount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FormMutation) ClearedFields() []string {
+func (m *DiscountMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(form.FieldDescription) {
-		fields = append(fields, form.FieldDescription)
+	if m.FieldCleared(discount.FieldProviderPromotionCodeID) {
+		fields = append(fields, discount.FieldProviderPromotionCodeID)
+	}
+	if m.FieldCleared(discount.FieldCode) {
+		fields = append(fields, discount.FieldCode)
+	}
+	if m.FieldCleared(discount.FieldPercentOff) {
+		fields = append(fields, discount.FieldPercentOff)
+	}
+	if m.FieldCleared(discount.FieldAmountOff) {
+		fields = append(fields, discount.FieldAmountOff)
+	}
+	if m.FieldCleared(discount.FieldCurrency) {
+		fields = append(fields, discount.FieldCurrency)
+	}
+	if m.FieldCleared(discount.FieldDurationInMonths) {
+		fields = append(fields, discount.FieldDurationInMonths)
+	}
+	if m.FieldCleared(discount.FieldEndsAt) {
+		fields = append(fields, discount.FieldEndsAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FormMutation) FieldCleared(name string) bool {
+func (m *DiscountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FormMutation) ClearField(name string) error {
+func (m *DiscountMutation) ClearField(name string) error {
 	switch name {
-	case form.FieldDescription:
-		m.ClearDescription()
+	case discount.FieldProviderPromotionCodeID:
+		m.ClearProviderPromotionCodeID()
+		return nil
+	case discount.FieldCode:
+		m.ClearCode()
+		return nil
+	case discount.FieldPercentOff:
+		m.ClearPercentOff()
+		return nil
+	case discount.FieldAmountOff:
+		m.ClearAmountOff()
+		return nil
+	case discount.FieldCurrency:
+		m.ClearCurrency()
+		return nil
+	case discount.FieldDurationInMonths:
+		m.ClearDurationInMonths()
+		return nil
+	case discount.FieldEndsAt:
+		m.ClearEndsAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Form nullable field %s", name)
+	return fmt.Errorf("unknown Discount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FormMutation) ResetField(name string) error {
+func (m *DiscountMutation) ResetField(name string) error {
 	switch name {
-	case form.FieldTitle:
-		m.ResetTitle()
+	case discount.FieldProviderDiscountID:
+		m.ResetProviderDiscountID()
 		return nil
-	case form.FieldDescription:
-		m.ResetDescription()
+	case discount.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case form.FieldPublished:
-		m.ResetPublished()
+	case discount.FieldProviderCouponID:
+		m.ResetProviderCouponID()
 		return nil
-	case form.FieldSlug:
-		m.ResetSlug()
+	case discount.FieldProviderPromotionCodeID:
+		m.ResetProviderPromotionCodeID()
 		return nil
-	case form.FieldDisplayMode:
-		m.ResetDisplayMode()
+	case discount.FieldCode:
+		m.ResetCode()
 		return nil
-	case form.FieldUserID:
-		m.ResetUserID()
+	case discount.FieldPercentOff:
+		m.ResetPercentOff()
 		return nil
-	case form.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case discount.FieldAmountOff:
+		m.ResetAmountOff()
 		return nil
-	case form.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case discount.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case discount.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case discount.FieldDurationInMonths:
+		m.ResetDurationInMonths()
+		return nil
+	case discount.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case discount.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case discount.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Form field %s", name)
+	return fmt.Errorf("unknown Discount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FormMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.owner != nil {
-		edges = append(edges, form.EdgeOwner)
-	}
-	if m.questions != nil {
-		edges = append(edges, form.EdgeQuestions)
-	}
-	if m.responses != nil {
-		edges = append(edges, form.EdgeResponses)
+func (m *DiscountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.subscription != nil {
+		edges = append(edges, discount.EdgeSubscription)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FormMutation) AddedIDs(name string) []ent.Value {
+func (m *DiscountMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case form.EdgeOwner:
-		if id := m.owner; id != nil {
+	case discount.EdgeSubscription:
+		if id := m.subscription; id != nil {
 			return []ent.Value{*id}
 		}
-	case form.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.questions))
-		for id := range m.questions {
-			ids = append(ids, id)
-		}
-		return ids
-	case form.EdgeResponses:
-		ids := make([]ent.Value, 0, len(m.responses))
-		for id := range m.responses {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FormMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedquestions != nil {
-		edges = append(edges, form.EdgeQuestions)
-	}
-	if m.removedresponses != nil {
-		edges = append(edges, form.EdgeResponses)
-	}
+func (m *DiscountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FormMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case form.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquestions))
-		for id := range m.removedquestions {
-			ids = append(ids, id)
-		}
-		return ids
-	case form.EdgeResponses:
-		ids := make([]ent.Value, 0, len(m.removedresponses))
-		for id := range m.removedresponses {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DiscountMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FormMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedowner {
-		edges = append(edges, form.EdgeOwner)
-	}
-	if m.clearedquestions {
-		edges = append(edges, form.EdgeQuestions)
-	}
-	if m.clearedresponses {
-		edges = append(edges, form.EdgeResponses)
+func (m *DiscountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsubscription {
+		edges = append(edges, discount.EdgeSubscription)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FormMutation) EdgeCleared(name string) bool {
+func (m *DiscountMutation) EdgeCleared(name string) bool {
 	switch name {
-	case form.EdgeOwner:
-		return m.clearedowner
-	case form.EdgeQuestions:
-		return m.clearedquestions
-	case form.EdgeResponses:
-		return m.clearedresponses
+	case discount.EdgeSubscription:
+		return m.clearedsubscription
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FormMutation) ClearEdge(name string) error {
+func (m *DiscountMutation) ClearEdge(name string) error {
 	switch name {
-	case form.EdgeOwner:
-		m.ClearOwner()
+	case discount.EdgeSubscription:
+		m.ClearSubscription()
 		return nil
 	}
-	return fmt.Errorf("unknown Form unique edge %s", name)
+	return fmt.Errorf("unknown Discount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FormMutation) ResetEdge(name string) error {
+func (m *DiscountMutation) ResetEdge(name string) error {
 	switch name {
-	case form.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case form.EdgeQuestions:
-		m.ResetQuestions()
-		return nil
-	case form.EdgeResponses:
-		m.ResetResponses()
+	case discount.EdgeSubscription:
+		m.ResetSubscription()
 		return nil
 	}
-	return fmt.Errorf("unknown Form edge %s", name)
+	return fmt.Errorf("unknown Discount edge %s", name)
 }
 
-// JobMutation represents an operation that mutates the Job nodes in the graph.
-type JobMutation struct {
+// FormMutation represents an operation that mutates the Form nodes in the graph.
+type FormMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	queue           *string
-	payload         *map[string]interface{}
-	attempts        *int
-	addattempts     *int
-	max_attempts    *int
-	addmax_attempts *int
-	status          *job.Status
-	error           *string
-	created_at      *time.Time
-	processed_at    *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Job, error)
-	predicates      []predicate.Job
+	op                 Op
+	typ                string
+	id                 *int
+	title              *string
+	description        *string
+	published          *bool
+	slug               *string
+	display_mode       *form.DisplayMode
+	metadata_storage   *form.MetadataStorage
+	retention_days     *int
+	addretention_days  *int
+	notify_on_response *bool
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	owner              *int
+	clearedowner       bool
+	questions          map[int]struct{}
+	removedquestions   map[int]struct{}
+	clearedquestions   bool
+	responses          map[int]struct{}
+	removedresponses   map[int]struct{}
+	clearedresponses   bool
+	done               bool
+	oldValue           func(context.Context) (*Form, error)
+	predicates         []predicate.Form
 }
 
-var _ ent.Mutation = (*JobMutation)(nil)
+var _ ent.Mutation = (*FormMutation)(nil)
 
-// jobOption allows management of the mutation configuration using functional options.
-type jobOption func(*JobMutation)
+// formOption allows management of the mutation configuration using functional options.
+type formOption func(*FormMutation)
 
-// newJobMutation creates new mutation for the Job entity.
-func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
-	m := &JobMutation{
+// newFormMutation creates new mutation for the Form entity.
+func newFormMutation(c config, op Op, opts ...formOption) *FormMutation {
+	m := &FormMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeJob,
+		typ:           TypeForm,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1558,20 +1905,20 @@ func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
 	return m
 }
 
-// withJobID sets the ID field of the mutation.
-func withJobID(id int) jobOption {
-	return func(m *JobMutation) {
+// withFormID sets the ID field of the mutation.
+func withFormID(id int) formOption {
+	return func(m *FormMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Job
+			value *Form
 		)
-		m.oldValue = func(ctx context.Context) (*Job, error) {
+		m.oldValue = func(ctx context.Context) (*Form, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Job.Get(ctx, id)
+					value, err = m.Client().Form.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1580,10 +1927,10 @@ func withJobID(id int) jobOption {
 	}
 }
 
-// withJob sets the old Job of the mutation.
-func withJob(node *Job) jobOption {
-	return func(m *JobMutation) {
-		m.oldValue = func(context.Context) (*Job, error) {
+// withForm sets the old Form of the mutation.
+func withForm(node *Form) formOption {
+	return func(m *FormMutation) {
+		m.oldValue = func(context.Context) (*Form, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1592,7 +1939,7 @@ func withJob(node *Job) jobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JobMutation) Client() *Client {
+func (m FormMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1600,7 +1947,7 @@ func (m JobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m JobMutation) Tx() (*Tx, error) {
+func (m FormMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1611,7 +1958,7 @@ func (m JobMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *JobMutation) ID() (id int, exists bool) {
+func (m *FormMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1622,7 +1969,7 @@ func (m *JobMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *JobMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FormMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1631,288 +1978,376 @@ func (m *JobMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Form.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetQueue sets the "queue" field.
-func (m *JobMutation) SetQueue(s string) {
-	m.queue = &s
+// SetTitle sets the "title" field.
+func (m *FormMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Queue returns the value of the "queue" field in the mutation.
-func (m *JobMutation) Queue() (r string, exists bool) {
-	v := m.queue
+// Title returns the value of the "title" field in the mutation.
+func (m *FormMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQueue returns the old "queue" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldQueue(ctx context.Context) (v string, err error) {
+func (m *FormMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQueue is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQueue requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQueue: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Queue, nil
+	return oldValue.Title, nil
 }
 
-// ResetQueue resets all changes to the "queue" field.
-func (m *JobMutation) ResetQueue() {
-	m.queue = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *FormMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetPayload sets the "payload" field.
-func (m *JobMutation) SetPayload(value map[string]interface{}) {
-	m.payload = &value
+// SetDescription sets the "description" field.
+func (m *FormMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Payload returns the value of the "payload" field in the mutation.
-func (m *JobMutation) Payload() (r map[string]interface{}, exists bool) {
-	v := m.payload
+// Description returns the value of the "description" field in the mutation.
+func (m *FormMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPayload returns the old "payload" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *FormMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayload requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Payload, nil
+	return oldValue.Description, nil
 }
 
-// ResetPayload resets all changes to the "payload" field.
-func (m *JobMutation) ResetPayload() {
-	m.payload = nil
+// ClearDescription clears the value of the "description" field.
+func (m *FormMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[form.FieldDescription] = struct{}{}
 }
 
-// SetAttempts sets the "attempts" field.
-func (m *JobMutation) SetAttempts(i int) {
-	m.attempts = &i
-	m.addattempts = nil
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *FormMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[form.FieldDescription]
+	return ok
 }
 
-// Attempts returns the value of the "attempts" field in the mutation.
-func (m *JobMutation) Attempts() (r int, exists bool) {
-	v := m.attempts
+// ResetDescription resets all changes to the "description" field.
+func (m *FormMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, form.FieldDescription)
+}
+
+// SetPublished sets the "published" field.
+func (m *FormMutation) SetPublished(b bool) {
+	m.published = &b
+}
+
+// Published returns the value of the "published" field in the mutation.
+func (m *FormMutation) Published() (r bool, exists bool) {
+	v := m.published
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAttempts returns the old "attempts" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldPublished returns the old "published" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+func (m *FormMutation) OldPublished(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttempts requires an ID field in the mutation")
+		return v, errors.New("OldPublished requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
 	}
-	return oldValue.Attempts, nil
+	return oldValue.Published, nil
 }
 
-// AddAttempts adds i to the "attempts" field.
-func (m *JobMutation) AddAttempts(i int) {
-	if m.addattempts != nil {
-		*m.addattempts += i
-	} else {
-		m.addattempts = &i
-	}
+// ResetPublished resets all changes to the "published" field.
+func (m *FormMutation) ResetPublished() {
+	m.published = nil
 }
 
-// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
-func (m *JobMutation) AddedAttempts() (r int, exists bool) {
-	v := m.addattempts
+// SetSlug sets the "slug" field.
+func (m *FormMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *FormMutation) Slug() (r string, exists bool) {
+	v := m.slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAttempts resets all changes to the "attempts" field.
-func (m *JobMutation) ResetAttempts() {
-	m.attempts = nil
-	m.addattempts = nil
+// OldSlug returns the old "slug" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FormMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
 }
 
-// SetMaxAttempts sets the "max_attempts" field.
-func (m *JobMutation) SetMaxAttempts(i int) {
-	m.max_attempts = &i
-	m.addmax_attempts = nil
+// ResetSlug resets all changes to the "slug" field.
+func (m *FormMutation) ResetSlug() {
+	m.slug = nil
 }
 
-// MaxAttempts returns the value of the "max_attempts" field in the mutation.
-func (m *JobMutation) MaxAttempts() (r int, exists bool) {
-	v := m.max_attempts
+// SetDisplayMode sets the "display_mode" field.
+func (m *FormMutation) SetDisplayMode(fm form.DisplayMode) {
+	m.display_mode = &fm
+}
+
+// DisplayMode returns the value of the "display_mode" field in the mutation.
+func (m *FormMutation) DisplayMode() (r form.DisplayMode, exists bool) {
+	v := m.display_mode
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxAttempts returns the old "max_attempts" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldDisplayMode returns the old "display_mode" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+func (m *FormMutation) OldDisplayMode(ctx context.Context) (v form.DisplayMode, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisplayMode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+		return v, errors.New("OldDisplayMode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisplayMode: %w", err)
 	}
-	return oldValue.MaxAttempts, nil
+	return oldValue.DisplayMode, nil
 }
 
-// AddMaxAttempts adds i to the "max_attempts" field.
-func (m *JobMutation) AddMaxAttempts(i int) {
-	if m.addmax_attempts != nil {
-		*m.addmax_attempts += i
-	} else {
-		m.addmax_attempts = &i
-	}
+// ResetDisplayMode resets all changes to the "display_mode" field.
+func (m *FormMutation) ResetDisplayMode() {
+	m.display_mode = nil
 }
 
-// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
-func (m *JobMutation) AddedMaxAttempts() (r int, exists bool) {
-	v := m.addmax_attempts
+// SetMetadataStorage sets the "metadata_storage" field.
+func (m *FormMutation) SetMetadataStorage(fs form.MetadataStorage) {
+	m.metadata_storage = &fs
+}
+
+// MetadataStorage returns the value of the "metadata_storage" field in the mutation.
+func (m *FormMutation) MetadataStorage() (r form.MetadataStorage, exists bool) {
+	v := m.metadata_storage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxAttempts resets all changes to the "max_attempts" field.
-func (m *JobMutation) ResetMaxAttempts() {
-	m.max_attempts = nil
-	m.addmax_attempts = nil
+// OldMetadataStorage returns the old "metadata_storage" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FormMutation) OldMetadataStorage(ctx context.Context) (v form.MetadataStorage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadataStorage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadataStorage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadataStorage: %w", err)
+	}
+	return oldValue.MetadataStorage, nil
 }
 
-// SetStatus sets the "status" field.
-func (m *JobMutation) SetStatus(j job.Status) {
-	m.status = &j
+// ResetMetadataStorage resets all changes to the "metadata_storage" field.
+func (m *FormMutation) ResetMetadataStorage() {
+	m.metadata_storage = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *JobMutation) Status() (r job.Status, exists bool) {
-	v := m.status
+// SetRetentionDays sets the "retention_days" field.
+func (m *FormMutation) SetRetentionDays(i int) {
+	m.retention_days = &i
+	m.addretention_days = nil
+}
+
+// RetentionDays returns the value of the "retention_days" field in the mutation.
+func (m *FormMutation) RetentionDays() (r int, exists bool) {
+	v := m.retention_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldRetentionDays returns the old "retention_days" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
+func (m *FormMutation) OldRetentionDays(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRetentionDays is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRetentionDays requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRetentionDays: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.RetentionDays, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *JobMutation) ResetStatus() {
-	m.status = nil
+// AddRetentionDays adds i to the "retention_days" field.
+func (m *FormMutation) AddRetentionDays(i int) {
+	if m.addretention_days != nil {
+		*m.addretention_days += i
+	} else {
+		m.addretention_days = &i
+	}
 }
 
-// SetError sets the "error" field.
-func (m *JobMutation) SetError(s string) {
-	m.error = &s
+// AddedRetentionDays returns the value that was added to the "retention_days" field in this mutation.
+func (m *FormMutation) AddedRetentionDays() (r int, exists bool) {
+	v := m.addretention_days
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Error returns the value of the "error" field in the mutation.
-func (m *JobMutation) Error() (r string, exists bool) {
-	v := m.error
+// ResetRetentionDays resets all changes to the "retention_days" field.
+func (m *FormMutation) ResetRetentionDays() {
+	m.retention_days = nil
+	m.addretention_days = nil
+}
+
+// SetNotifyOnResponse sets the "notify_on_response" field.
+func (m *FormMutation) SetNotifyOnResponse(b bool) {
+	m.notify_on_response = &b
+}
+
+// NotifyOnResponse returns the value of the "notify_on_response" field in the mutation.
+func (m *FormMutation) NotifyOnResponse() (r bool, exists bool) {
+	v := m.notify_on_response
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldError returns the old "error" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldNotifyOnResponse returns the old "notify_on_response" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldError(ctx context.Context) (v string, err error) {
+func (m *FormMutation) OldNotifyOnResponse(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldError is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotifyOnResponse is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldError requires an ID field in the mutation")
+		return v, errors.New("OldNotifyOnResponse requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldError: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotifyOnResponse: %w", err)
 	}
-	return oldValue.Error, nil
+	return oldValue.NotifyOnResponse, nil
 }
 
-// ClearError clears the value of the "error" field.
-func (m *JobMutation) ClearError() {
-	m.error = nil
-	m.clearedFields[job.FieldError] = struct{}{}
+// ResetNotifyOnResponse resets all changes to the "notify_on_response" field.
+func (m *FormMutation) ResetNotifyOnResponse() {
+	m.notify_on_response = nil
 }
 
-// ErrorCleared returns if the "error" field was cleared in this mutation.
-func (m *JobMutation) ErrorCleared() bool {
-	_, ok := m.clearedFields[job.FieldError]
-	return ok
+// SetUserID sets the "user_id" field.
+func (m *FormMutation) SetUserID(i int) {
+	m.owner = &i
 }
 
-// ResetError resets all changes to the "error" field.
-func (m *JobMutation) ResetError() {
-	m.error = nil
-	delete(m.clearedFields, job.FieldError)
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FormMutation) UserID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FormMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FormMutation) ResetUserID() {
+	m.owner = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *JobMutation) SetCreatedAt(t time.Time) {
+func (m *FormMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *FormMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1920,10 +2355,10 @@ func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FormMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1938,68 +2373,203 @@ func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *JobMutation) ResetCreatedAt() {
+func (m *FormMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetProcessedAt sets the "processed_at" field.
-func (m *JobMutation) SetProcessedAt(t time.Time) {
-	m.processed_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FormMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ProcessedAt returns the value of the "processed_at" field in the mutation.
-func (m *JobMutation) ProcessedAt() (r time.Time, exists bool) {
-	v := m.processed_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FormMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessedAt returns the old "processed_at" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Form entity.
+// If the Form object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldProcessedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FormMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.ProcessedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearProcessedAt clears the value of the "processed_at" field.
-func (m *JobMutation) ClearProcessedAt() {
-	m.processed_at = nil
-	m.clearedFields[job.FieldProcessedAt] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FormMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
-func (m *JobMutation) ProcessedAtCleared() bool {
-	_, ok := m.clearedFields[job.FieldProcessedAt]
-	return ok
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *FormMutation) SetOwnerID(id int) {
+	m.owner = &id
 }
 
-// ResetProcessedAt resets all changes to the "processed_at" field.
-func (m *JobMutation) ResetProcessedAt() {
-	m.processed_at = nil
-	delete(m.clearedFields, job.FieldProcessedAt)
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *FormMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[form.FieldUserID] = struct{}{}
 }
 
-// Where appends a list predicates to the JobMutation builder.
-func (m *JobMutation) Where(ps ...predicate.Job) {
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *FormMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *FormMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *FormMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *FormMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
+func (m *FormMutation) AddQuestionIDs(ids ...int) {
+	if m.questions == nil {
+		m.questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestions clears the "questions" edge to the Question entity.
+func (m *FormMutation) ClearQuestions() {
+	m.clearedquestions = true
+}
+
+// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
+func (m *FormMutation) QuestionsCleared() bool {
+	return m.clearedquestions
+}
+
+// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
+func (m *FormMutation) RemoveQuestionIDs(ids ...int) {
+	if m.removedquestions == nil {
+		m.removedquestions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.questions, ids[i])
+		m.removedquestions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
+func (m *FormMutation) RemovedQuestionsIDs() (ids []int) {
+	for id := range m.removedquestions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionsIDs returns the "questions" edge IDs in the mutation.
+func (m *FormMutation) QuestionsIDs() (ids []int) {
+	for id := range m.questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestions resets all changes to the "questions" edge.
+func (m *FormMutation) ResetQuestions() {
+	m.questions = nil
+	m.clearedquestions = false
+	m.removedquestions = nil
+}
+
+// AddResponseIDs adds the "responses" edge to the Response entity by ids.
+func (m *FormMutation) AddResponseIDs(ids ...int) {
+	if m.responses == nil {
+		m.responses = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.responses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearResponses clears the "responses" edge to the Response entity.
+func (m *FormMutation) ClearResponses() {
+	m.clearedresponses = true
+}
+
+// ResponsesCleared reports if the "responses" edge to the Response entity was cleared.
+func (m *FormMutation) ResponsesCleared() bool {
+	return m.clearedresponses
+}
+
+// RemoveResponseIDs removes the "responses" edge to the Response entity by IDs.
+func (m *FormMutation) RemoveResponseIDs(ids ...int) {
+	if m.removedresponses == nil {
+		m.removedresponses = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.responses, ids[i])
+		m.removedresponses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedResponses returns the removed IDs of the "responses" edge to the Response entity.
+func (m *FormMutation) RemovedResponsesIDs() (ids []int) {
+	for id := range m.removedresponses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResponsesIDs returns the "responses" edge IDs in the mutation.
+func (m *FormMutation) ResponsesIDs() (ids []int) {
+	for id := range m.responses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetResponses resets all changes to the "responses" edge.
+func (m *FormMutation) ResetResponses() {
+	m.responses = nil
+	m.clearedresponses = false
+	m.removedresponses = nil
+}
+
+// Where appends a list predicates to the FormMutation builder.
+func (m *FormMutation) Where(ps ...predicate.Form) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FormMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Job, len(ps))
+func (m *FormMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Form, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2007,48 +2577,57 @@ func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JobMutation) Op() Op {
+func (m *FormMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JobMutation) SetOp(op Op) {
+func (m *FormMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Job).
-func (m *JobMutation) Type() string {
+// Type returns the node type of this mutation (Form).
+func (m *FormMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JobMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.queue != nil {
-		fields = append(fields, job.FieldQueue)
+func (m *FormMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.title != nil {
+		fields = append(fields, form.FieldTitle)
 	}
-	if m.payload != nil {
-		fields = append(fields, job.FieldPayload)
+	if m.description != nil {
+		fields = append(fields, form.FieldDescription)
 	}
-	if m.attempts != nil {
-		fields = append(fields, job.FieldAttempts)
+	if m.published != nil {
+		fields = append(fields, form.FieldPublished)
 	}
-	if m.max_attempts != nil {
-		fields = append(fields, job.FieldMaxAttempts)
+	if m.slug != nil {
+		fields = append(fields, form.FieldSlug)
 	}
-	if m.status != nil {
-		fields = append(fields, job.FieldStatus)
+	if m.display_mode != nil {
+		fields = append(fields, form.FieldDisplayMode)
 	}
-	if m.error != nil {
-		fields = append(fields, job.FieldError)
+	if m.metadata_storage != nil {
+		fields = append(fields, form.FieldMetadataStorage)
+	}
+	if m.retention_days != nil {
+		fields = append(fields, form.FieldRetentionDays)
+	}
+	if m.notify_on_response != nil {
+		fields = append(fields, form.FieldNotifyOnResponse)
+	}
+	if m.owner != nil {
+		fields = append(fields, form.FieldUserID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, job.FieldCreatedAt)
+		fields = append(fields, form.FieldCreatedAt)
 	}
-	if m.processed_at != nil {
-		fields = append(fields, job.FieldProcessedAt)
+	if m.updated_at != nil {
+		fields = append(fields, form.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2056,24 +2635,30 @@ func (m *JobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JobMutation) Field(name string) (ent.Value, bool) {
+func (m *FormMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case job.FieldQueue:
-		return m.Queue()
-	case job.FieldPayload:
-		return m.Payload()
-	case job.FieldAttempts:
-		return m.Attempts()
-	case job.FieldMaxAttempts:
-		return m.MaxAttempts()
-	case job.FieldStatus:
-		return m.Status()
-	case job.FieldError:
-		return m.Error()
-	case job.FieldCreatedAt:
+	case form.FieldTitle:
+		return m.Title()
+	case form.FieldDescription:
+		return m.Description()
+	case form.FieldPublished:
+		return m.Published()
+	case form.FieldSlug:
+		return m.Slug()
+	case form.FieldDisplayMode:
+		return m.DisplayMode()
+	case form.FieldMetadataStorage:
+		return m.MetadataStorage()
+	case form.FieldRetentionDays:
+		return m.RetentionDays()
+	case form.FieldNotifyOnResponse:
+		return m.NotifyOnResponse()
+	case form.FieldUserID:
+		return m.UserID()
+	case form.FieldCreatedAt:
 		return m.CreatedAt()
-	case job.FieldProcessedAt:
-		return m.ProcessedAt()
+	case form.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2081,102 +2666,126 @@ func (m *JobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FormMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case job.FieldQueue:
-		return m.OldQueue(ctx)
-	case job.FieldPayload:
-		return m.OldPayload(ctx)
-	case job.FieldAttempts:
-		return m.OldAttempts(ctx)
-	case job.FieldMaxAttempts:
-		return m.OldMaxAttempts(ctx)
-	case job.FieldStatus:
-		return m.OldStatus(ctx)
-	case job.FieldError:
-		return m.OldError(ctx)
-	case job.FieldCreatedAt:
+	case form.FieldTitle:
+		return m.OldTitle(ctx)
+	case form.FieldDescription:
+		return m.OldDescription(ctx)
+	case form.FieldPublished:
+		return m.OldPublished(ctx)
+	case form.FieldSlug:
+		return m.OldSlug(ctx)
+	case form.FieldDisplayMode:
+		return m.OldDisplayMode(ctx)
+	case form.FieldMetadataStorage:
+		return m.OldMetadataStorage(ctx)
+	case form.FieldRetentionDays:
+		return m.OldRetentionDays(ctx)
+	case form.FieldNotifyOnResponse:
+		return m.OldNotifyOnResponse(ctx)
+	case form.FieldUserID:
+		return m.OldUserID(ctx)
+	case form.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case job.FieldProcessedAt:
-		return m.OldProcessedAt(ctx)
+	case form.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Job field %s", name)
+	return nil, fmt.Errorf("unknown Form field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) SetField(name string, value ent.Value) error {
+func (m *FormMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldQueue:
+	case form.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQueue(v)
+		m.SetTitle(v)
 		return nil
-	case job.FieldPayload:
-		v, ok := value.(map[string]interface{})
+	case form.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPayload(v)
+		m.SetDescription(v)
 		return nil
-	case job.FieldAttempts:
-		v, ok := value.(int)
+	case form.FieldPublished:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttempts(v)
+		m.SetPublished(v)
 		return nil
-	case job.FieldMaxAttempts:
+	case form.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case form.FieldDisplayMode:
+		v, ok := value.(form.DisplayMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayMode(v)
+		return nil
+	case form.FieldMetadataStorage:
+		v, ok := value.(form.MetadataStorage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadataStorage(v)
+		return nil
+	case form.FieldRetentionDays:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxAttempts(v)
+		m.SetRetentionDays(v)
 		return nil
-	case job.FieldStatus:
-		v, ok := value.(job.Status)
+	case form.FieldNotifyOnResponse:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetNotifyOnResponse(v)
 		return nil
-	case job.FieldError:
-		v, ok := value.(string)
+	case form.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetError(v)
+		m.SetUserID(v)
 		return nil
-	case job.FieldCreatedAt:
+	case form.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case job.FieldProcessedAt:
+	case form.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown Form field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JobMutation) AddedFields() []string {
+func (m *FormMutation) AddedFields() []string {
 	var fields []string
-	if m.addattempts != nil {
-		fields = append(fields, job.FieldAttempts)
-	}
-	if m.addmax_attempts != nil {
-		fields = append(fields, job.FieldMaxAttempts)
+	if m.addretention_days != nil {
+		fields = append(fields, form.FieldRetentionDays)
 	}
 	return fields
 }
@@ -2184,12 +2793,10 @@ func (m *JobMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FormMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case job.FieldAttempts:
-		return m.AddedAttempts()
-	case job.FieldMaxAttempts:
-		return m.AddedMaxAttempts()
+	case form.FieldRetentionDays:
+		return m.AddedRetentionDays()
 	}
 	return nil, false
 }
@@ -2197,167 +2804,265 @@ func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) AddField(name string, value ent.Value) error {
+func (m *FormMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAttempts(v)
-		return nil
-	case job.FieldMaxAttempts:
+	case form.FieldRetentionDays:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxAttempts(v)
+		m.AddRetentionDays(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Job numeric field %s", name)
+	return fmt.Errorf("unknown Form numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JobMutation) ClearedFields() []string {
+func (m *FormMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(job.FieldError) {
-		fields = append(fields, job.FieldError)
-	}
-	if m.FieldCleared(job.FieldProcessedAt) {
-		fields = append(fields, job.FieldProcessedAt)
+	if m.FieldCleared(form.FieldDescription) {
+		fields = append(fields, form.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JobMutation) FieldCleared(name string) bool {
+func (m *FormMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JobMutation) ClearField(name string) error {
+func (m *FormMutation) ClearField(name string) error {
 	switch name {
-	case job.FieldError:
-		m.ClearError()
-		return nil
-	case job.FieldProcessedAt:
-		m.ClearProcessedAt()
+	case form.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Job nullable field %s", name)
+	return fmt.Errorf("unknown Form nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JobMutation) ResetField(name string) error {
+func (m *FormMutation) ResetField(name string) error {
 	switch name {
-	case job.FieldQueue:
-		m.ResetQueue()
+	case form.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case job.FieldPayload:
-		m.ResetPayload()
+	case form.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case job.FieldAttempts:
-		m.ResetAttempts()
+	case form.FieldPublished:
+		m.ResetPublished()
 		return nil
-	case job.FieldMaxAttempts:
-		m.ResetMaxAttempts()
+	case form.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case job.FieldStatus:
-		m.ResetStatus()
+	case form.FieldDisplayMode:
+		m.ResetDisplayMode()
 		return nil
-	case job.FieldError:
-		m.ResetError()
+	case form.FieldMetadataStorage:
+		m.ResetMetadataStorage()
 		return nil
-	case job.FieldCreatedAt:
+	case form.FieldRetentionDays:
+		m.ResetRetentionDays()
+		return nil
+	case form.FieldNotifyOnResponse:
+		m.ResetNotifyOnResponse()
+		return nil
+	case form.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case form.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case job.FieldProcessedAt:
-		m.ResetProcessedAt()
+	case form.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown Form field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *FormMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.owner != nil {
+		edges = append(edges, form.EdgeOwner)
+	}
+	if m.questions != nil {
+		edges = append(edges, form.EdgeQuestions)
+	}
+	if m.responses != nil {
+		edges = append(edges, form.EdgeResponses)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JobMutation) AddedIDs(name string) []ent.Value {
+func (m *FormMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case form.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case form.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.questions))
+		for id := range m.questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case form.EdgeResponses:
+		ids := make([]ent.Value, 0, len(m.responses))
+		for id := range m.responses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *FormMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedquestions != nil {
+		edges = append(edges, form.EdgeQuestions)
+	}
+	if m.removedresponses != nil {
+		edges = append(edges, form.EdgeResponses)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+func (m *FormMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case form.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquestions))
+		for id := range m.removedquestions {
+			ids = append(ids, id)
+		}
+		return ids
+	case form.EdgeResponses:
+		ids := make([]ent.Value, 0, len(m.removedresponses))
+		for id := range m.removedresponses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *FormMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedowner {
+		edges = append(edges, form.EdgeOwner)
+	}
+	if m.clearedquestions {
+		edges = append(edges, form.EdgeQuestions)
+	}
+	if m.clearedresponses {
+		edges = append(edges, form.EdgeResponses)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JobMutation) EdgeCleared(name string) bool {
+func (m *FormMutation) EdgeCleared(name string) bool {
+	switch name {
+	case form.EdgeOwner:
+		return m.clearedowner
+	case form.EdgeQuestions:
+		return m.clearedquestions
+	case form.EdgeResponses:
+		return m.clearedresponses
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Job unique edge %s", name)
+func (m *FormMutation) ClearEdge(name string) error {
+	switch name {
+	case form.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Form unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Job edge %s", name)
+func (m *FormMutation) ResetEdge(name string) error {
+	switch name {
+	case form.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case form.EdgeQuestions:
+		m.ResetQuestions()
+		return nil
+	case form.EdgeResponses:
+		m.ResetResponses()
+		return nil
+	}
+	return fmt.Errorf("unknown Form edge %s", name)
 }
 
-// PasswordTokenMutation represents an operation that mutates the PasswordToken nodes in the graph.
-type PasswordTokenMutation struct {
+// InvoiceMutation represents an operation that mutates the Invoice nodes in the graph.
+type InvoiceMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	token         *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*PasswordToken, error)
-	predicates    []predicate.PasswordToken
+	op                       Op
+	typ                      string
+	id                       *int
+	provider_invoice_id      *string
+	provider                 *string
+	provider_subscription_id *string
+	number                   *string
+	status                   *invoice.Status
+	currency                 *string
+	subtotal                 *int64
+	addsubtotal              *int64
+	tax                      *int64
+	addtax                   *int64
+	total                    *int64
+	addtotal                 *int64
+	amount_due               *int64
+	addamount_due            *int64
+	amount_paid              *int64
+	addamount_paid           *int64
+	hosted_link              *string
+	pdf_link                 *string
+	period_start             *time.Time
+	period_end               *time.Time
+	paid_at                  *time.Time
+	issued_at                *time.Time
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	customer                 *int
+	clearedcustomer          bool
+	done                     bool
+	oldValue                 func(context.Context) (*Invoice, error)
+	predicates               []predicate.Invoice
 }
 
-var _ ent.Mutation = (*PasswordTokenMutation)(nil)
+var _ ent.Mutation = (*InvoiceMutation)(nil)
 
-// passwordtokenOption allows management of the mutation configuration using functional options.
-type passwordtokenOption func(*PasswordTokenMutation)
+// invoiceOption allows management of the mutation configuration using functional options.
+type invoiceOption func(*InvoiceMutation)
 
-// newPasswordTokenMutation creates new mutation for the PasswordToken entity.
-func newPasswordTokenMutation(c config, op Op, opts ...passwordtokenOption) *PasswordTokenMutation {
-	m := &PasswordTokenMutation{
+// newInvoiceMutation creates new mutation for the Invoice entity.
+func newInvoiceMutation(c config, op Op, opts ...invoiceOption) *InvoiceMutation {
+	m := &InvoiceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePasswordToken,
+		typ:           TypeInvoice,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2366,20 +3071,20 @@ func newPasswordTokenMutation(c config, op Op, opts ...passwordtokenOption) *Pas
 	return m
 }
 
-// withPasswordTokenID sets the ID field of the mutation.
-func withPasswordTokenID(id int) passwordtokenOption {
-	return func(m *PasswordTokenMutation) {
+// withInvoiceID sets the ID field of the mutation.
+func withInvoiceID(id int) invoiceOption {
+	return func(m *InvoiceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PasswordToken
+			value *Invoice
 		)
-		m.oldValue = func(ctx context.Context) (*PasswordToken, error) {
+		m.oldValue = func(ctx context.Context) (*Invoice, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PasswordToken.Get(ctx, id)
+					value, err = m.Client().Invoice.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2388,10 +3093,10 @@ func withPasswordTokenID(id int) passwordtokenOption {
 	}
 }
 
-// withPasswordToken sets the old PasswordToken of the mutation.
-func withPasswordToken(node *PasswordToken) passwordtokenOption {
-	return func(m *PasswordTokenMutation) {
-		m.oldValue = func(context.Context) (*PasswordToken, error) {
+// withInvoice sets the old Invoice of the mutation.
+func withInvoice(node *Invoice) invoiceOption {
+	return func(m *InvoiceMutation) {
+		m.oldValue = func(context.Context) (*Invoice, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2400,7 +3105,7 @@ func withPasswordToken(node *PasswordToken) passwordtokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PasswordTokenMutation) Client() *Client {
+func (m InvoiceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2408,7 +3113,7 @@ func (m PasswordTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PasswordTokenMutation) Tx() (*Tx, error) {
+func (m InvoiceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2419,7 +3124,7 @@ func (m PasswordTokenMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PasswordTokenMutation) ID() (id int, exists bool) {
+func (m *InvoiceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2430,7 +3135,7 @@ func (m *PasswordTokenMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PasswordTokenMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *InvoiceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2439,1005 +3144,935 @@ func (m *PasswordTokenMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PasswordToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Invoice.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetToken sets the "token" field.
-func (m *PasswordTokenMutation) SetToken(s string) {
-	m.token = &s
+// SetProviderInvoiceID sets the "provider_invoice_id" field.
+func (m *InvoiceMutation) SetProviderInvoiceID(s string) {
+	m.provider_invoice_id = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *PasswordTokenMutation) Token() (r string, exists bool) {
-	v := m.token
+// ProviderInvoiceID returns the value of the "provider_invoice_id" field in the mutation.
+func (m *InvoiceMutation) ProviderInvoiceID() (r string, exists bool) {
+	v := m.provider_invoice_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the PasswordToken entity.
-// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// OldProviderInvoiceID returns the old "provider_invoice_id" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *InvoiceMutation) OldProviderInvoiceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderInvoiceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldProviderInvoiceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderInvoiceID: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.ProviderInvoiceID, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *PasswordTokenMutation) ResetToken() {
-	m.token = nil
+// ResetProviderInvoiceID resets all changes to the "provider_invoice_id" field.
+func (m *InvoiceMutation) ResetProviderInvoiceID() {
+	m.provider_invoice_id = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PasswordTokenMutation) SetUserID(i int) {
-	m.user = &i
+// SetProvider sets the "provider" field.
+func (m *InvoiceMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PasswordTokenMutation) UserID() (r int, exists bool) {
-	v := m.user
+// Provider returns the value of the "provider" field in the mutation.
+func (m *InvoiceMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the PasswordToken entity.
-// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *InvoiceMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Provider, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PasswordTokenMutation) ResetUserID() {
-	m.user = nil
+// ResetProvider resets all changes to the "provider" field.
+func (m *InvoiceMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PasswordTokenMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (m *InvoiceMutation) SetProviderSubscriptionID(s string) {
+	m.provider_subscription_id = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PasswordTokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ProviderSubscriptionID returns the value of the "provider_subscription_id" field in the mutation.
+func (m *InvoiceMutation) ProviderSubscriptionID() (r string, exists bool) {
+	v := m.provider_subscription_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PasswordToken entity.
-// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// OldProviderSubscriptionID returns the old "provider_subscription_id" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *InvoiceMutation) OldProviderSubscriptionID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderSubscriptionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProviderSubscriptionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderSubscriptionID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ProviderSubscriptionID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PasswordTokenMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearProviderSubscriptionID clears the value of the "provider_subscription_id" field.
+func (m *InvoiceMutation) ClearProviderSubscriptionID() {
+	m.provider_subscription_id = nil
+	m.clearedFields[invoice.FieldProviderSubscriptionID] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PasswordTokenMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[passwordtoken.FieldUserID] = struct{}{}
+// ProviderSubscriptionIDCleared returns if the "provider_subscription_id" field was cleared in this mutation.
+func (m *InvoiceMutation) ProviderSubscriptionIDCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldProviderSubscriptionID]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PasswordTokenMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetProviderSubscriptionID resets all changes to the "provider_subscription_id" field.
+func (m *InvoiceMutation) ResetProviderSubscriptionID() {
+	m.provider_subscription_id = nil
+	delete(m.clearedFields, invoice.FieldProviderSubscriptionID)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PasswordTokenMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SetNumber sets the "number" field.
+func (m *InvoiceMutation) SetNumber(s string) {
+	m.number = &s
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *InvoiceMutation) Number() (r string, exists bool) {
+	v := m.number
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PasswordTokenMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldNumber returns the old "number" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
 }
 
-// Where appends a list predicates to the PasswordTokenMutation builder.
-func (m *PasswordTokenMutation) Where(ps ...predicate.PasswordToken) {
-	m.predicates = append(m.predicates, ps...)
+// ClearNumber clears the value of the "number" field.
+func (m *InvoiceMutation) ClearNumber() {
+	m.number = nil
+	m.clearedFields[invoice.FieldNumber] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the PasswordTokenMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PasswordTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PasswordToken, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// NumberCleared returns if the "number" field was cleared in this mutation.
+func (m *InvoiceMutation) NumberCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldNumber]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *PasswordTokenMutation) Op() Op {
-	return m.op
+// ResetNumber resets all changes to the "number" field.
+func (m *InvoiceMutation) ResetNumber() {
+	m.number = nil
+	delete(m.clearedFields, invoice.FieldNumber)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PasswordTokenMutation) SetOp(op Op) {
-	m.op = op
+// SetStatus sets the "status" field.
+func (m *InvoiceMutation) SetStatus(i invoice.Status) {
+	m.status = &i
 }
 
-// Type returns the node type of this mutation (PasswordToken).
-func (m *PasswordTokenMutation) Type() string {
-	return m.typ
+// Status returns the value of the "status" field in the mutation.
+func (m *InvoiceMutation) Status() (r invoice.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PasswordTokenMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.token != nil {
-		fields = append(fields, passwordtoken.FieldToken)
+// OldStatus returns the old "status" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldStatus(ctx context.Context) (v invoice.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	if m.user != nil {
-		fields = append(fields, passwordtoken.FieldUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, passwordtoken.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return fields
+	return oldValue.Status, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PasswordTokenMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case passwordtoken.FieldToken:
-		return m.Token()
-	case passwordtoken.FieldUserID:
-		return m.UserID()
-	case passwordtoken.FieldCreatedAt:
-		return m.CreatedAt()
+// ResetStatus resets all changes to the "status" field.
+func (m *InvoiceMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *InvoiceMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *InvoiceMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PasswordTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case passwordtoken.FieldToken:
-		return m.OldToken(ctx)
-	case passwordtoken.FieldUserID:
-		return m.OldUserID(ctx)
-	case passwordtoken.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// OldCurrency returns the old "currency" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown PasswordToken field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PasswordTokenMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case passwordtoken.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
-		return nil
-	case passwordtoken.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case passwordtoken.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// ResetCurrency resets all changes to the "currency" field.
+func (m *InvoiceMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (m *InvoiceMutation) SetSubtotal(i int64) {
+	m.subtotal = &i
+	m.addsubtotal = nil
+}
+
+// Subtotal returns the value of the "subtotal" field in the mutation.
+func (m *InvoiceMutation) Subtotal() (r int64, exists bool) {
+	v := m.subtotal
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown PasswordToken field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PasswordTokenMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// OldSubtotal returns the old "subtotal" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldSubtotal(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtotal: %w", err)
+	}
+	return oldValue.Subtotal, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PasswordTokenMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
+// AddSubtotal adds i to the "subtotal" field.
+func (m *InvoiceMutation) AddSubtotal(i int64) {
+	if m.addsubtotal != nil {
+		*m.addsubtotal += i
+	} else {
+		m.addsubtotal = &i
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PasswordTokenMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AddedSubtotal returns the value that was added to the "subtotal" field in this mutation.
+func (m *InvoiceMutation) AddedSubtotal() (r int64, exists bool) {
+	v := m.addsubtotal
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown PasswordToken numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PasswordTokenMutation) ClearedFields() []string {
-	return nil
+// ResetSubtotal resets all changes to the "subtotal" field.
+func (m *InvoiceMutation) ResetSubtotal() {
+	m.subtotal = nil
+	m.addsubtotal = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PasswordTokenMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetTax sets the "tax" field.
+func (m *InvoiceMutation) SetTax(i int64) {
+	m.tax = &i
+	m.addtax = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PasswordTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PasswordToken nullable field %s", name)
+// Tax returns the value of the "tax" field in the mutation.
+func (m *InvoiceMutation) Tax() (r int64, exists bool) {
+	v := m.tax
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PasswordTokenMutation) ResetField(name string) error {
-	switch name {
-	case passwordtoken.FieldToken:
-		m.ResetToken()
-		return nil
-	case passwordtoken.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case passwordtoken.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// OldTax returns the old "tax" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldTax(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTax is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown PasswordToken field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTax: %w", err)
+	}
+	return oldValue.Tax, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PasswordTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, passwordtoken.EdgeUser)
+// AddTax adds i to the "tax" field.
+func (m *InvoiceMutation) AddTax(i int64) {
+	if m.addtax != nil {
+		*m.addtax += i
+	} else {
+		m.addtax = &i
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PasswordTokenMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case passwordtoken.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// AddedTax returns the value that was added to the "tax" field in this mutation.
+func (m *InvoiceMutation) AddedTax() (r int64, exists bool) {
+	v := m.addtax
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PasswordTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetTax resets all changes to the "tax" field.
+func (m *InvoiceMutation) ResetTax() {
+	m.tax = nil
+	m.addtax = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PasswordTokenMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetTotal sets the "total" field.
+func (m *InvoiceMutation) SetTotal(i int64) {
+	m.total = &i
+	m.addtotal = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PasswordTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, passwordtoken.EdgeUser)
+// Total returns the value of the "total" field in the mutation.
+func (m *InvoiceMutation) Total() (r int64, exists bool) {
+	v := m.total
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PasswordTokenMutation) EdgeCleared(name string) bool {
-	switch name {
-	case passwordtoken.EdgeUser:
-		return m.cleareduser
+// OldTotal returns the old "total" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldTotal(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PasswordTokenMutation) ClearEdge(name string) error {
-	switch name {
-	case passwordtoken.EdgeUser:
-		m.ClearUser()
-		return nil
+// AddTotal adds i to the "total" field.
+func (m *InvoiceMutation) AddTotal(i int64) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
 	}
-	return fmt.Errorf("unknown PasswordToken unique edge %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PasswordTokenMutation) ResetEdge(name string) error {
-	switch name {
-	case passwordtoken.EdgeUser:
-		m.ResetUser()
-		return nil
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *InvoiceMutation) AddedTotal() (r int64, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown PasswordToken edge %s", name)
+	return *v, true
 }
 
-// PaymentCustomerMutation represents an operation that mutates the PaymentCustomer nodes in the graph.
-type PaymentCustomerMutation struct {
-	config
-	op                     Op
-	typ                    string
-	id                     *int
-	provider_customer_id   *string
-	provider               *string
-	email                  *string
-	name                   *string
-	metadata               *map[string]interface{}
-	created_at             *time.Time
-	updated_at             *time.Time
-	clearedFields          map[string]struct{}
-	user                   *int
-	cleareduser            bool
-	payment_intents        map[int]struct{}
-	removedpayment_intents map[int]struct{}
-	clearedpayment_intents bool
-	subscriptions          map[int]struct{}
-	removedsubscriptions   map[int]struct{}
-	clearedsubscriptions   bool
-	payment_methods        map[int]struct{}
-	removedpayment_methods map[int]struct{}
-	clearedpayment_methods bool
-	done                   bool
-	oldValue               func(context.Context) (*PaymentCustomer, error)
-	predicates             []predicate.PaymentCustomer
+// ResetTotal resets all changes to the "total" field.
+func (m *InvoiceMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
 }
 
-var _ ent.Mutation = (*PaymentCustomerMutation)(nil)
-
-// paymentcustomerOption allows management of the mutation configuration using functional options.
-type paymentcustomerOption func(*PaymentCustomerMutation)
-
-// newPaymentCustomerMutation creates new mutation for the PaymentCustomer entity.
-func newPaymentCustomerMutation(c config, op Op, opts ...paymentcustomerOption) *PaymentCustomerMutation {
-	m := &PaymentCustomerMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePaymentCustomer,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetAmountDue sets the "amount_due" field.
+func (m *InvoiceMutation) SetAmountDue(i int64) {
+	m.amount_due = &i
+	m.addamount_due = nil
 }
 
-// withPaymentCustomerID sets the ID field of the mutation.
-func withPaymentCustomerID(id int) paymentcustomerOption {
-	return func(m *PaymentCustomerMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *PaymentCustomer
-		)
-		m.oldValue = func(ctx context.Context) (*PaymentCustomer, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().PaymentCustomer.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AmountDue returns the value of the "amount_due" field in the mutation.
+func (m *InvoiceMutation) AmountDue() (r int64, exists bool) {
+	v := m.amount_due
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withPaymentCustomer sets the old PaymentCustomer of the mutation.
-func withPaymentCustomer(node *PaymentCustomer) paymentcustomerOption {
-	return func(m *PaymentCustomerMutation) {
-		m.oldValue = func(context.Context) (*PaymentCustomer, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldAmountDue returns the old "amount_due" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldAmountDue(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmountDue is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmountDue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmountDue: %w", err)
+	}
+	return oldValue.AmountDue, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PaymentCustomerMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PaymentCustomerMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddAmountDue adds i to the "amount_due" field.
+func (m *InvoiceMutation) AddAmountDue(i int64) {
+	if m.addamount_due != nil {
+		*m.addamount_due += i
+	} else {
+		m.addamount_due = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PaymentCustomerMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedAmountDue returns the value that was added to the "amount_due" field in this mutation.
+func (m *InvoiceMutation) AddedAmountDue() (r int64, exists bool) {
+	v := m.addamount_due
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PaymentCustomerMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PaymentCustomer.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetAmountDue resets all changes to the "amount_due" field.
+func (m *InvoiceMutation) ResetAmountDue() {
+	m.amount_due = nil
+	m.addamount_due = nil
 }
 
-// SetProviderCustomerID sets the "provider_customer_id" field.
-func (m *PaymentCustomerMutation) SetProviderCustomerID(s string) {
-	m.provider_customer_id = &s
+// SetAmountPaid sets the "amount_paid" field.
+func (m *InvoiceMutation) SetAmountPaid(i int64) {
+	m.amount_paid = &i
+	m.addamount_paid = nil
 }
 
-// ProviderCustomerID returns the value of the "provider_customer_id" field in the mutation.
-func (m *PaymentCustomerMutation) ProviderCustomerID() (r string, exists bool) {
-	v := m.provider_customer_id
+// AmountPaid returns the value of the "amount_paid" field in the mutation.
+func (m *InvoiceMutation) AmountPaid() (r int64, exists bool) {
+	v := m.amount_paid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderCustomerID returns the old "provider_customer_id" field's value of the PaymentCustomer entity.
-// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// OldAmountPaid returns the old "amount_paid" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentCustomerMutation) OldProviderCustomerID(ctx context.Context) (v string, err error) {
+func (m *InvoiceMutation) OldAmountPaid(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderCustomerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmountPaid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderCustomerID requires an ID field in the mutation")
+		return v, errors.New("OldAmountPaid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderCustomerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmountPaid: %w", err)
 	}
-	return oldValue.ProviderCustomerID, nil
+	return oldValue.AmountPaid, nil
 }
 
-// ResetProviderCustomerID resets all changes to the "provider_customer_id" field.
-func (m *PaymentCustomerMutation) ResetProviderCustomerID() {
-	m.provider_customer_id = nil
+// AddAmountPaid adds i to the "amount_paid" field.
+func (m *InvoiceMutation) AddAmountPaid(i int64) {
+	if m.addamount_paid != nil {
+		*m.addamount_paid += i
+	} else {
+		m.addamount_paid = &i
+	}
 }
 
-// SetProvider sets the "provider" field.
-func (m *PaymentCustomerMutation) SetProvider(s string) {
-	m.provider = &s
+// AddedAmountPaid returns the value that was added to the "amount_paid" field in this mutation.
+func (m *InvoiceMutation) AddedAmountPaid() (r int64, exists bool) {
+	v := m.addamount_paid
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *PaymentCustomerMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// ResetAmountPaid resets all changes to the "amount_paid" field.
+func (m *InvoiceMutation) ResetAmountPaid() {
+	m.amount_paid = nil
+	m.addamount_paid = nil
+}
+
+// SetHostedLink sets the "hosted_link" field.
+func (m *InvoiceMutation) SetHostedLink(s string) {
+	m.hosted_link = &s
+}
+
+// HostedLink returns the value of the "hosted_link" field in the mutation.
+func (m *InvoiceMutation) HostedLink() (r string, exists bool) {
+	v := m.hosted_link
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the PaymentCustomer entity.
-// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// OldHostedLink returns the old "hosted_link" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentCustomerMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *InvoiceMutation) OldHostedLink(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldHostedLink is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldHostedLink requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldHostedLink: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.HostedLink, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *PaymentCustomerMutation) ResetProvider() {
-	m.provider = nil
+// ClearHostedLink clears the value of the "hosted_link" field.
+func (m *InvoiceMutation) ClearHostedLink() {
+	m.hosted_link = nil
+	m.clearedFields[invoice.FieldHostedLink] = struct{}{}
 }
 
-// SetEmail sets the "email" field.
-func (m *PaymentCustomerMutation) SetEmail(s string) {
-	m.email = &s
+// HostedLinkCleared returns if the "hosted_link" field was cleared in this mutation.
+func (m *InvoiceMutation) HostedLinkCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldHostedLink]
+	return ok
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *PaymentCustomerMutation) Email() (r string, exists bool) {
-	v := m.email
+// ResetHostedLink resets all changes to the "hosted_link" field.
+func (m *InvoiceMutation) ResetHostedLink() {
+	m.hosted_link = nil
+	delete(m.clearedFields, invoice.FieldHostedLink)
+}
+
+// SetPdfLink sets the "pdf_link" field.
+func (m *InvoiceMutation) SetPdfLink(s string) {
+	m.pdf_link = &s
+}
+
+// PdfLink returns the value of the "pdf_link" field in the mutation.
+func (m *InvoiceMutation) PdfLink() (r string, exists bool) {
+	v := m.pdf_link
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the PaymentCustomer entity.
-// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// OldPdfLink returns the old "pdf_link" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentCustomerMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *InvoiceMutation) OldPdfLink(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldPdfLink is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldPdfLink requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldPdfLink: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.PdfLink, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *PaymentCustomerMutation) ResetEmail() {
-	m.email = nil
+// ClearPdfLink clears the value of the "pdf_link" field.
+func (m *InvoiceMutation) ClearPdfLink() {
+	m.pdf_link = nil
+	m.clearedFields[invoice.FieldPdfLink] = struct{}{}
 }
 
-// SetName sets the "name" field.
-func (m *PaymentCustomerMutation) SetName(s string) {
-	m.name = &s
+// PdfLinkCleared returns if the "pdf_link" field was cleared in this mutation.
+func (m *InvoiceMutation) PdfLinkCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldPdfLink]
+	return ok
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PaymentCustomerMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetPdfLink resets all changes to the "pdf_link" field.
+func (m *InvoiceMutation) ResetPdfLink() {
+	m.pdf_link = nil
+	delete(m.clearedFields, invoice.FieldPdfLink)
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (m *InvoiceMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
+}
+
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *InvoiceMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the PaymentCustomer entity.
-// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodStart returns the old "period_start" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentCustomerMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *InvoiceMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.PeriodStart, nil
 }
 
-// ClearName clears the value of the "name" field.
-func (m *PaymentCustomerMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[paymentcustomer.FieldName] = struct{}{}
+// ClearPeriodStart clears the value of the "period_start" field.
+func (m *InvoiceMutation) ClearPeriodStart() {
+	m.period_start = nil
+	m.clearedFields[invoice.FieldPeriodStart] = struct{}{}
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *PaymentCustomerMutation) NameCleared() bool {
-	_, ok := m.clearedFields[paymentcustomer.FieldName]
+// PeriodStartCleared returns if the "period_start" field was cleared in this mutation.
+func (m *InvoiceMutation) PeriodStartCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldPeriodStart]
 	return ok
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PaymentCustomerMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, paymentcustomer.FieldName)
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *InvoiceMutation) ResetPeriodStart() {
+	m.period_start = nil
+	delete(m.clearedFields, invoice.FieldPeriodStart)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *PaymentCustomerMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetPeriodEnd sets the "period_end" field.
+func (m *InvoiceMutation) SetPeriodEnd(t time.Time) {
+	m.period_end = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *PaymentCustomerMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// PeriodEnd returns the value of the "period_end" field in the mutation.
+func (m *InvoiceMutation) PeriodEnd() (r time.Time, exists bool) {
+	v := m.period_end
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the PaymentCustomer entity.
-// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodEnd returns the old "period_end" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentCustomerMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *InvoiceMutation) OldPeriodEnd(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodEnd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldPeriodEnd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodEnd: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.PeriodEnd, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *PaymentCustomerMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[paymentcustomer.FieldMetadata] = struct{}{}
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (m *InvoiceMutation) ClearPeriodEnd() {
+	m.period_end = nil
+	m.clearedFields[invoice.FieldPeriodEnd] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *PaymentCustomerMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[paymentcustomer.FieldMetadata]
+// PeriodEndCleared returns if the "period_end" field was cleared in this mutation.
+func (m *InvoiceMutation) PeriodEndCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldPeriodEnd]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *PaymentCustomerMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, paymentcustomer.FieldMetadata)
+// ResetPeriodEnd resets all changes to the "period_end" field.
+func (m *InvoiceMutation) ResetPeriodEnd() {
+	m.period_end = nil
+	delete(m.clearedFields, invoice.FieldPeriodEnd)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PaymentCustomerMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPaidAt sets the "paid_at" field.
+func (m *InvoiceMutation) SetPaidAt(t time.Time) {
+	m.paid_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PaymentCustomerMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// PaidAt returns the value of the "paid_at" field in the mutation.
+func (m *InvoiceMutation) PaidAt() (r time.Time, exists bool) {
+	v := m.paid_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PaymentCustomer entity.
-// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// OldPaidAt returns the old "paid_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentCustomerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *InvoiceMutation) OldPaidAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPaidAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPaidAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPaidAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.PaidAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PaymentCustomerMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearPaidAt clears the value of the "paid_at" field.
+func (m *InvoiceMutation) ClearPaidAt() {
+	m.paid_at = nil
+	m.clearedFields[invoice.FieldPaidAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PaymentCustomerMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// PaidAtCleared returns if the "paid_at" field was cleared in this mutation.
+func (m *InvoiceMutation) PaidAtCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldPaidAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PaymentCustomerMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetPaidAt resets all changes to the "paid_at" field.
+func (m *InvoiceMutation) ResetPaidAt() {
+	m.paid_at = nil
+	delete(m.clearedFields, invoice.FieldPaidAt)
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (m *InvoiceMutation) SetIssuedAt(t time.Time) {
+	m.issued_at = &t
+}
+
+// IssuedAt returns the value of the "issued_at" field in the mutation.
+func (m *InvoiceMutation) IssuedAt() (r time.Time, exists bool) {
+	v := m.issued_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PaymentCustomer entity.
-// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuedAt returns the old "issued_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentCustomerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *InvoiceMutation) OldIssuedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIssuedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIssuedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PaymentCustomerMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *PaymentCustomerMutation) SetUserID(id int) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *PaymentCustomerMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PaymentCustomerMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *PaymentCustomerMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+		return v, fmt.Errorf("querying old value for OldIssuedAt: %w", err)
 	}
-	return
+	return oldValue.IssuedAt, nil
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PaymentCustomerMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetIssuedAt resets all changes to the "issued_at" field.
+func (m *InvoiceMutation) ResetIssuedAt() {
+	m.issued_at = nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PaymentCustomerMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// SetCreatedAt sets the "created_at" field.
+func (m *InvoiceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AddPaymentIntentIDs adds the "payment_intents" edge to the PaymentIntent entity by ids.
-func (m *PaymentCustomerMutation) AddPaymentIntentIDs(ids ...int) {
-	if m.payment_intents == nil {
-		m.payment_intents = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.payment_intents[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InvoiceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearPaymentIntents clears the "payment_intents" edge to the PaymentIntent entity.
-func (m *PaymentCustomerMutation) ClearPaymentIntents() {
-	m.clearedpayment_intents = true
-}
-
-// PaymentIntentsCleared reports if the "payment_intents" edge to the PaymentIntent entity was cleared.
-func (m *PaymentCustomerMutation) PaymentIntentsCleared() bool {
-	return m.clearedpayment_intents
-}
-
-// RemovePaymentIntentIDs removes the "payment_intents" edge to the PaymentIntent entity by IDs.
-func (m *PaymentCustomerMutation) RemovePaymentIntentIDs(ids ...int) {
-	if m.removedpayment_intents == nil {
-		m.removedpayment_intents = make(map[int]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.payment_intents, ids[i])
-		m.removedpayment_intents[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-}
-
-// RemovedPaymentIntents returns the removed IDs of the "payment_intents" edge to the PaymentIntent entity.
-func (m *PaymentCustomerMutation) RemovedPaymentIntentsIDs() (ids []int) {
-	for id := range m.removedpayment_intents {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return
+	return oldValue.CreatedAt, nil
 }
 
-// PaymentIntentsIDs returns the "payment_intents" edge IDs in the mutation.
-func (m *PaymentCustomerMutation) PaymentIntentsIDs() (ids []int) {
-	for id := range m.payment_intents {
-		ids = append(ids, id)
-	}
-	return
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InvoiceMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ResetPaymentIntents resets all changes to the "payment_intents" edge.
-func (m *PaymentCustomerMutation) ResetPaymentIntents() {
-	m.payment_intents = nil
-	m.clearedpayment_intents = false
-	m.removedpayment_intents = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *InvoiceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by ids.
-func (m *PaymentCustomerMutation) AddSubscriptionIDs(ids ...int) {
-	if m.subscriptions == nil {
-		m.subscriptions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.subscriptions[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *InvoiceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearSubscriptions clears the "subscriptions" edge to the Subscription entity.
-func (m *PaymentCustomerMutation) ClearSubscriptions() {
-	m.clearedsubscriptions = true
-}
-
-// SubscriptionsCleared reports if the "subscriptions" edge to the Subscription entity was cleared.
-func (m *PaymentCustomerMutation) SubscriptionsCleared() bool {
-	return m.clearedsubscriptions
-}
-
-// RemoveSubscriptionIDs removes the "subscriptions" edge to the Subscription entity by IDs.
-func (m *PaymentCustomerMutation) RemoveSubscriptionIDs(ids ...int) {
-	if m.removedsubscriptions == nil {
-		m.removedsubscriptions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.subscriptions, ids[i])
-		m.removedsubscriptions[ids[i]] = struct{}{}
+// OldUpdatedAt returns the old "updated_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedSubscriptions returns the removed IDs of the "subscriptions" edge to the Subscription entity.
-func (m *PaymentCustomerMutation) RemovedSubscriptionsIDs() (ids []int) {
-	for id := range m.removedsubscriptions {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// SubscriptionsIDs returns the "subscriptions" edge IDs in the mutation.
-func (m *PaymentCustomerMutation) SubscriptionsIDs() (ids []int) {
-	for id := range m.subscriptions {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return
-}
-
-// ResetSubscriptions resets all changes to the "subscriptions" edge.
-func (m *PaymentCustomerMutation) ResetSubscriptions() {
-	m.subscriptions = nil
-	m.clearedsubscriptions = false
-	m.removedsubscriptions = nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddPaymentMethodIDs adds the "payment_methods" edge to the PaymentMethod entity by ids.
-func (m *PaymentCustomerMutation) AddPaymentMethodIDs(ids ...int) {
-	if m.payment_methods == nil {
-		m.payment_methods = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.payment_methods[ids[i]] = struct{}{}
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *InvoiceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearPaymentMethods clears the "payment_methods" edge to the PaymentMethod entity.
-func (m *PaymentCustomerMutation) ClearPaymentMethods() {
-	m.clearedpayment_methods = true
+// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by id.
+func (m *InvoiceMutation) SetCustomerID(id int) {
+	m.customer = &id
 }
 
-// PaymentMethodsCleared reports if the "payment_methods" edge to the PaymentMethod entity was cleared.
-func (m *PaymentCustomerMutation) PaymentMethodsCleared() bool {
-	return m.clearedpayment_methods
+// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
+func (m *InvoiceMutation) ClearCustomer() {
+	m.clearedcustomer = true
 }
 
-// RemovePaymentMethodIDs removes the "payment_methods" edge to the PaymentMethod entity by IDs.
-func (m *PaymentCustomerMutation) RemovePaymentMethodIDs(ids ...int) {
-	if m.removedpayment_methods == nil {
-		m.removedpayment_methods = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.payment_methods, ids[i])
-		m.removedpayment_methods[ids[i]] = struct{}{}
-	}
+// CustomerCleared reports if the "customer" edge to the PaymentCustomer entity was cleared.
+func (m *InvoiceMutation) CustomerCleared() bool {
+	return m.clearedcustomer
 }
 
-// RemovedPaymentMethods returns the removed IDs of the "payment_methods" edge to the PaymentMethod entity.
-func (m *PaymentCustomerMutation) RemovedPaymentMethodsIDs() (ids []int) {
-	for id := range m.removedpayment_methods {
-		ids = append(ids, id)
+// CustomerID returns the "customer" edge ID in the mutation.
+func (m *InvoiceMutation) CustomerID() (id int, exists bool) {
+	if m.customer != nil {
+		return *m.customer, true
 	}
 	return
 }
 
-// PaymentMethodsIDs returns the "payment_methods" edge IDs in the mutation.
-func (m *PaymentCustomerMutation) PaymentMethodsIDs() (ids []int) {
-	for id := range m.payment_methods {
-		ids = append(ids, id)
+// CustomerIDs returns the "customer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CustomerID instead. It exists only for internal usage by the builders.
+func (m *InvoiceMutation) CustomerIDs() (ids []int) {
+	if id := m.customer; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPaymentMethods resets all changes to the "payment_methods" edge.
-func (m *PaymentCustomerMutation) ResetPaymentMethods() {
-	m.payment_methods = nil
-	m.clearedpayment_methods = false
-	m.removedpayment_methods = nil
+// ResetCustomer resets all changes to the "customer" edge.
+func (m *InvoiceMutation) ResetCustomer() {
+	m.customer = nil
+	m.clearedcustomer = false
 }
 
-// Where appends a list predicates to the PaymentCustomerMutation builder.
-func (m *PaymentCustomerMutation) Where(ps ...predicate.PaymentCustomer) {
+// Where appends a list predicates to the InvoiceMutation builder.
+func (m *InvoiceMutation) Where(ps ...predicate.Invoice) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PaymentCustomerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the InvoiceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PaymentCustomerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PaymentCustomer, len(ps))
+func (m *InvoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invoice, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3445,45 +4080,81 @@ func (m *PaymentCustomerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PaymentCustomerMutation) Op() Op {
+func (m *InvoiceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PaymentCustomerMutation) SetOp(op Op) {
+func (m *InvoiceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PaymentCustomer).
-func (m *PaymentCustomerMutation) Type() string {
+// Type returns the node type of this mutation (Invoice).
+func (m *InvoiceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PaymentCustomerMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.provider_customer_id != nil {
-		fields = append(fields, paymentcustomer.FieldProviderCustomerID)
+func (m *InvoiceMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.provider_invoice_id != nil {
+		fields = append(fields, invoice.FieldProviderInvoiceID)
 	}
 	if m.provider != nil {
-		fields = append(fields, paymentcustomer.FieldProvider)
+		fields = append(fields, invoice.FieldProvider)
 	}
-	if m.email != nil {
-		fields = append(fields, paymentcustomer.FieldEmail)
+	if m.provider_subscription_id != nil {
+		fields = append(fields, invoice.FieldProviderSubscriptionID)
 	}
-	if m.name != nil {
-		fields = append(fields, paymentcustomer.FieldName)
+	if m.number != nil {
+		fields = append(fields, invoice.FieldNumber)
 	}
-	if m.metadata != nil {
-		fields = append(fields, paymentcustomer.FieldMetadata)
+	if m.status != nil {
+		fields = append(fields, invoice.FieldStatus)
 	}
-	if m.created_at != nil {
-		fields = append(fields, paymentcustomer.FieldCreatedAt)
+	if m.currency != nil {
+		fields = append(fields, invoice.FieldCurrency)
+	}
+	if m.subtotal != nil {
+		fields = append(fields, invoice.FieldSubtotal)
+	}
+	if m.tax != nil {
+		fields = append(fields, invoice.FieldTax)
+	}
+	if m.total != nil {
+		fields = append(fields, invoice.FieldTotal)
+	}
+	if m.amount_due != nil {
+		fields = append(fields, invoice.FieldAmountDue)
+	}
+	if m.amount_paid != nil {
+		fields = append(fields, invoice.FieldAmountPaid)
+	}
+	if m.hosted_link != nil {
+		fields = append(fields, invoice.FieldHostedLink)
+	}
+	if m.pdf_link != nil {
+		fields = append(fields, invoice.FieldPdfLink)
+	}
+	if m.period_start != nil {
+		fields = append(fields, invoice.FieldPeriodStart)
+	}
+	if m.period_end != nil {
+		fields = append(fields, invoice.FieldPeriodEnd)
+	}
+	if m.paid_at != nil {
+		fields = append(fields, invoice.FieldPaidAt)
+	}
+	if m.issued_at != nil {
+		fields = append(fields, invoice.FieldIssuedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, invoice.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, paymentcustomer.FieldUpdatedAt)
+		fields = append(fields, invoice.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3491,21 +4162,45 @@ func (m *PaymentCustomerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PaymentCustomerMutation) Field(name string) (ent.Value, bool) {
+func (m *InvoiceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case paymentcustomer.FieldProviderCustomerID:
-		return m.ProviderCustomerID()
-	case paymentcustomer.FieldProvider:
+	case invoice.FieldProviderInvoiceID:
+		return m.ProviderInvoiceID()
+	case invoice.FieldProvider:
 		return m.Provider()
-	case paymentcustomer.FieldEmail:
-		return m.Email()
-	case paymentcustomer.FieldName:
-		return m.Name()
-	case paymentcustomer.FieldMetadata:
-		return m.Metadata()
-	case paymentcustomer.FieldCreatedAt:
+	case invoice.FieldProviderSubscriptionID:
+		return m.ProviderSubscriptionID()
+	case invoice.FieldNumber:
+		return m.Number()
+	case invoice.FieldStatus:
+		return m.Status()
+	case invoice.FieldCurrency:
+		return m.Currency()
+	case invoice.FieldSubtotal:
+		return m.Subtotal()
+	case invoice.FieldTax:
+		return m.Tax()
+	case invoice.FieldTotal:
+		return m.Total()
+	case invoice.FieldAmountDue:
+		return m.AmountDue()
+	case invoice.FieldAmountPaid:
+		return m.AmountPaid()
+	case invoice.FieldHostedLink:
+		return m.HostedLink()
+	case invoice.FieldPdfLink:
+		return m.PdfLink()
+	case invoice.FieldPeriodStart:
+		return m.PeriodStart()
+	case invoice.FieldPeriodEnd:
+		return m.PeriodEnd()
+	case invoice.FieldPaidAt:
+		return m.PaidAt()
+	case invoice.FieldIssuedAt:
+		return m.IssuedAt()
+	case invoice.FieldCreatedAt:
 		return m.CreatedAt()
-	case paymentcustomer.FieldUpdatedAt:
+	case invoice.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3514,74 +4209,182 @@ func (m *PaymentCustomerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PaymentCustomerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InvoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case paymentcustomer.FieldProviderCustomerID:
-		return m.OldProviderCustomerID(ctx)
-	case paymentcustomer.FieldProvider:
+	case invoice.FieldProviderInvoiceID:
+		return m.OldProviderInvoiceID(ctx)
+	case invoice.FieldProvider:
 		return m.OldProvider(ctx)
-	case paymentcustomer.FieldEmail:
-		return m.OldEmail(ctx)
-	case paymentcustomer.FieldName:
-		return m.OldName(ctx)
-	case paymentcustomer.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case paymentcustomer.FieldCreatedAt:
+	case invoice.FieldProviderSubscriptionID:
+		return m.OldProviderSubscriptionID(ctx)
+	case invoice.FieldNumber:
+		return m.OldNumber(ctx)
+	case invoice.FieldStatus:
+		return m.OldStatus(ctx)
+	case invoice.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case invoice.FieldSubtotal:
+		return m.OldSubtotal(ctx)
+	case invoice.FieldTax:
+		return m.OldTax(ctx)
+	case invoice.FieldTotal:
+		return m.OldTotal(ctx)
+	case invoice.FieldAmountDue:
+		return m.OldAmountDue(ctx)
+	case invoice.FieldAmountPaid:
+		return m.OldAmountPaid(ctx)
+	case invoice.FieldHostedLink:
+		return m.OldHostedLink(ctx)
+	case invoice.FieldPdfLink:
+		return m.OldPdfLink(ctx)
+	case invoice.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case invoice.FieldPeriodEnd:
+		return m.OldPeriodEnd(ctx)
+	case invoice.FieldPaidAt:
+		return m.OldPaidAt(ctx)
+	case invoice.FieldIssuedAt:
+		return m.OldIssuedAt(ctx)
+	case invoice.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case paymentcustomer.FieldUpdatedAt:
+	case invoice.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PaymentCustomer field %s", name)
+	return nil, fmt.Errorf("unknown Invoice field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentCustomerMutation) SetField(name string, value ent.Value) error {
+func (m *InvoiceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case paymentcustomer.FieldProviderCustomerID:
+	case invoice.FieldProviderInvoiceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProviderCustomerID(v)
+		m.SetProviderInvoiceID(v)
 		return nil
-	case paymentcustomer.FieldProvider:
+	case invoice.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProvider(v)
 		return nil
-	case paymentcustomer.FieldEmail:
+	case invoice.FieldProviderSubscriptionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetProviderSubscriptionID(v)
 		return nil
-	case paymentcustomer.FieldName:
+	case invoice.FieldNumber:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetNumber(v)
 		return nil
-	case paymentcustomer.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case invoice.FieldStatus:
+		v, ok := value.(invoice.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetStatus(v)
 		return nil
-	case paymentcustomer.FieldCreatedAt:
+	case invoice.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case invoice.FieldSubtotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtotal(v)
+		return nil
+	case invoice.FieldTax:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTax(v)
+		return nil
+	case invoice.FieldTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case invoice.FieldAmountDue:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountDue(v)
+		return nil
+	case invoice.FieldAmountPaid:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountPaid(v)
+		return nil
+	case invoice.FieldHostedLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostedLink(v)
+		return nil
+	case invoice.FieldPdfLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPdfLink(v)
+		return nil
+	case invoice.FieldPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodStart(v)
+		return nil
+	case invoice.FieldPeriodEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodEnd(v)
+		return nil
+	case invoice.FieldPaidAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaidAt(v)
+		return nil
+	case invoice.FieldIssuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuedAt(v)
+		return nil
+	case invoice.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case paymentcustomer.FieldUpdatedAt:
+	case invoice.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3589,284 +4392,341 @@ func (m *PaymentCustomerMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentCustomer field %s", name)
+	return fmt.Errorf("unknown Invoice field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PaymentCustomerMutation) AddedFields() []string {
-	return nil
+func (m *InvoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addsubtotal != nil {
+		fields = append(fields, invoice.FieldSubtotal)
+	}
+	if m.addtax != nil {
+		fields = append(fields, invoice.FieldTax)
+	}
+	if m.addtotal != nil {
+		fields = append(fields, invoice.FieldTotal)
+	}
+	if m.addamount_due != nil {
+		fields = append(fields, invoice.FieldAmountDue)
+	}
+	if m.addamount_paid != nil {
+		fields = append(fields, invoice.FieldAmountPaid)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PaymentCustomerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InvoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldSubtotal:
+		return m.AddedSubtotal()
+	case invoice.FieldTax:
+		return m.AddedTax()
+	case invoice.FieldTotal:
+		return m.AddedTotal()
+	case invoice.FieldAmountDue:
+		return m.AddedAmountDue()
+	case invoice.FieldAmountPaid:
+		return m.AddedAmountPaid()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentCustomerMutation) AddField(name string, value ent.Value) error {
+func (m *InvoiceMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case invoice.FieldSubtotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubtotal(v)
+		return nil
+	case invoice.FieldTax:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTax(v)
+		return nil
+	case invoice.FieldTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case invoice.FieldAmountDue:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountDue(v)
+		return nil
+	case invoice.FieldAmountPaid:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountPaid(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PaymentCustomer numeric field %s", name)
+	return fmt.Errorf("unknown Invoice numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PaymentCustomerMutation) ClearedFields() []string {
+func (m *InvoiceMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(paymentcustomer.FieldName) {
-		fields = append(fields, paymentcustomer.FieldName)
+	if m.FieldCleared(invoice.FieldProviderSubscriptionID) {
+		fields = append(fields, invoice.FieldProviderSubscriptionID)
 	}
-	if m.FieldCleared(paymentcustomer.FieldMetadata) {
-		fields = append(fields, paymentcustomer.FieldMetadata)
+	if m.FieldCleared(invoice.FieldNumber) {
+		fields = append(fields, invoice.FieldNumber)
+	}
+	if m.FieldCleared(invoice.FieldHostedLink) {
+		fields = append(fields, invoice.FieldHostedLink)
+	}
+	if m.FieldCleared(invoice.FieldPdfLink) {
+		fields = append(fields, invoice.FieldPdfLink)
+	}
+	if m.FieldCleared(invoice.FieldPeriodStart) {
+		fields = append(fields, invoice.FieldPeriodStart)
+	}
+	if m.FieldCleared(invoice.FieldPeriodEnd) {
+		fields = append(fields, invoice.FieldPeriodEnd)
+	}
+	if m.FieldCleared(invoice.FieldPaidAt) {
+		fields = append(fields, invoice.FieldPaidAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PaymentCustomerMutation) FieldCleared(name string) bool {
+func (m *InvoiceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PaymentCustomerMutation) ClearField(name string) error {
+func (m *InvoiceMutation) ClearField(name string) error {
 	switch name {
-	case paymentcustomer.FieldName:
-		m.ClearName()
+	case invoice.FieldProviderSubscriptionID:
+		m.ClearProviderSubscriptionID()
 		return nil
-	case paymentcustomer.FieldMetadata:
-		m.ClearMetadata()
+	case invoice.FieldNumber:
+		m.ClearNumber()
+		return nil
+	case invoice.FieldHostedLink:
+		m.ClearHostedLink()
+		return nil
+	case invoice.FieldPdfLink:
+		m.ClearPdfLink()
+		return nil
+	case invoice.FieldPeriodStart:
+		m.ClearPeriodStart()
+		return nil
+	case invoice.FieldPeriodEnd:
+		m.ClearPeriodEnd()
+		return nil
+	case invoice.FieldPaidAt:
+		m.ClearPaidAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentCustomer nullable field %s", name)
+	return fmt.Errorf("unknown Invoice nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PaymentCustomerMutation) ResetField(name string) error {
+func (m *InvoiceMutation) ResetField(name string) error {
 	switch name {
-	case paymentcustomer.FieldProviderCustomerID:
-		m.ResetProviderCustomerID()
+	case invoice.FieldProviderInvoiceID:
+		m.ResetProviderInvoiceID()
 		return nil
-	case paymentcustomer.FieldProvider:
+	case invoice.FieldProvider:
 		m.ResetProvider()
 		return nil
-	case paymentcustomer.FieldEmail:
-		m.ResetEmail()
+	case invoice.FieldProviderSubscriptionID:
+		m.ResetProviderSubscriptionID()
 		return nil
-	case paymentcustomer.FieldName:
-		m.ResetName()
+	case invoice.FieldNumber:
+		m.ResetNumber()
 		return nil
-	case paymentcustomer.FieldMetadata:
-		m.ResetMetadata()
+	case invoice.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case paymentcustomer.FieldCreatedAt:
+	case invoice.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case invoice.FieldSubtotal:
+		m.ResetSubtotal()
+		return nil
+	case invoice.FieldTax:
+		m.ResetTax()
+		return nil
+	case invoice.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case invoice.FieldAmountDue:
+		m.ResetAmountDue()
+		return nil
+	case invoice.FieldAmountPaid:
+		m.ResetAmountPaid()
+		return nil
+	case invoice.FieldHostedLink:
+		m.ResetHostedLink()
+		return nil
+	case invoice.FieldPdfLink:
+		m.ResetPdfLink()
+		return nil
+	case invoice.FieldPeriodStart:
+		m.ResetPeriodStart()
+		return nil
+	case invoice.FieldPeriodEnd:
+		m.ResetPeriodEnd()
+		return nil
+	case invoice.FieldPaidAt:
+		m.ResetPaidAt()
+		return nil
+	case invoice.FieldIssuedAt:
+		m.ResetIssuedAt()
+		return nil
+	case invoice.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case paymentcustomer.FieldUpdatedAt:
+	case invoice.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentCustomer field %s", name)
+	return fmt.Errorf("unknown Invoice field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PaymentCustomerMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.user != nil {
-		edges = append(edges, paymentcustomer.EdgeUser)
-	}
-	if m.payment_intents != nil {
-		edges = append(edges, paymentcustomer.EdgePaymentIntents)
-	}
-	if m.subscriptions != nil {
-		edges = append(edges, paymentcustomer.EdgeSubscriptions)
-	}
-	if m.payment_methods != nil {
-		edges = append(edges, paymentcustomer.EdgePaymentMethods)
+func (m *InvoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.customer != nil {
+		edges = append(edges, invoice.EdgeCustomer)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PaymentCustomerMutation) AddedIDs(name string) []ent.Value {
+func (m *InvoiceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case paymentcustomer.EdgeUser:
-		if id := m.user; id != nil {
+	case invoice.EdgeCustomer:
+		if id := m.customer; id != nil {
 			return []ent.Value{*id}
 		}
-	case paymentcustomer.EdgePaymentIntents:
-		ids := make([]ent.Value, 0, len(m.payment_intents))
-		for id := range m.payment_intents {
-			ids = append(ids, id)
-		}
-		return ids
-	case paymentcustomer.EdgeSubscriptions:
-		ids := make([]ent.Value, 0, len(m.subscriptions))
-		for id := range m.subscriptions {
-			ids = append(ids, id)
-		}
-		return ids
-	case paymentcustomer.EdgePaymentMethods:
-		ids := make([]ent.Value, 0, len(m.payment_methods))
-		for id := range m.payment_methods {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PaymentCustomerMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedpayment_intents != nil {
-		edges = append(edges, paymentcustomer.EdgePaymentIntents)
-	}
-	if m.removedsubscriptions != nil {
-		edges = append(edges, paymentcustomer.EdgeSubscriptions)
-	}
-	if m.removedpayment_methods != nil {
-		edges = append(edges, paymentcustomer.EdgePaymentMethods)
-	}
+func (m *InvoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PaymentCustomerMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case paymentcustomer.EdgePaymentIntents:
-		ids := make([]ent.Value, 0, len(m.removedpayment_intents))
-		for id := range m.removedpayment_intents {
-			ids = append(ids, id)
-		}
-		return ids
-	case paymentcustomer.EdgeSubscriptions:
-		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
-		for id := range m.removedsubscriptions {
-			ids = append(ids, id)
-		}
-		return ids
-	case paymentcustomer.EdgePaymentMethods:
-		ids := make([]ent.Value, 0, len(m.removedpayment_methods))
-		for id := range m.removedpayment_methods {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *InvoiceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PaymentCustomerMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.cleareduser {
-		edges = append(edges, paymentcustomer.EdgeUser)
-	}
-	if m.clearedpayment_intents {
-		edges = append(edges, paymentcustomer.EdgePaymentIntents)
-	}
-	if m.clearedsubscriptions {
-		edges = append(edges, paymentcustomer.EdgeSubscriptions)
-	}
-	if m.clearedpayment_methods {
-		edges = append(edges, paymentcustomer.EdgePaymentMethods)
+func (m *InvoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcustomer {
+		edges = append(edges, invoice.EdgeCustomer)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PaymentCustomerMutation) EdgeCleared(name string) bool {
+func (m *InvoiceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case paymentcustomer.EdgeUser:
-		return m.cleareduser
-	case paymentcustomer.EdgePaymentIntents:
-		return m.clearedpayment_intents
-	case paymentcustomer.EdgeSubscriptions:
-		return m.clearedsubscriptions
-	case paymentcustomer.EdgePaymentMethods:
-		return m.clearedpayment_methods
+	case invoice.EdgeCustomer:
+		return m.clearedcustomer
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PaymentCustomerMutation) ClearEdge(name string) error {
+func (m *InvoiceMutation) ClearEdge(name string) error {
 	switch name {
-	case paymentcustomer.EdgeUser:
-		m.ClearUser()
+	case invoice.EdgeCustomer:
+		m.ClearCustomer()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentCustomer unique edge %s", name)
+	return fmt.Errorf("unknown Invoice unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PaymentCustomerMutation) ResetEdge(name string) error {
+func (m *InvoiceMutation) ResetEdge(name string) error {
 	switch name {
-	case paymentcustomer.EdgeUser:
-		m.ResetUser()
-		return nil
-	case paymentcustomer.EdgePaymentIntents:
-		m.ResetPaymentIntents()
-		return nil
-	case paymentcustomer.EdgeSubscriptions:
-		m.ResetSubscriptions()
-		return nil
-	case paymentcustomer.EdgePaymentMethods:
-		m.ResetPaymentMethods()
+	case invoice.EdgeCustomer:
+		m.ResetCustomer()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentCustomer edge %s", name)
+	return fmt.Errorf("unknown Invoice edge %s", name)
 }
 
-// PaymentIntentMutation represents an operation that mutates the PaymentIntent nodes in the graph.
-type PaymentIntentMutation struct {
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	provider_payment_intent_id *string
-	provider                   *string
-	status                     *paymentintent.Status
-	amount                     *int64
-	addamount                  *int64
-	currency                   *string
-	description                *string
-	client_secret              *string
-	metadata                   *map[string]interface{}
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	clearedFields              map[string]struct{}
-	customer                   *int
-	clearedcustomer            bool
-	done                       bool
-	oldValue                   func(context.Context) (*PaymentIntent, error)
-	predicates                 []predicate.PaymentIntent
+	op              Op
+	typ             string
+	id              *int
+	queue           *string
+	payload         *map[string]interface{}
+	attempts        *int
+	addattempts     *int
+	max_attempts    *int
+	addmax_attempts *int
+	status          *job.Status
+	priority        *int
+	addpriority     *int
+	run_at          *time.Time
+	scheduled_for   *time.Time
+	unique_key      *string
+	unique_lock     *string
+	error           *string
+	created_at      *time.Time
+	processed_at    *time.Time
+	locked_by       *string
+	locked_until    *time.Time
+	clearedFields   map[string]struct{}
+	history         map[int]struct{}
+	removedhistory  map[int]struct{}
+	clearedhistory  bool
+	done            bool
+	oldValue        func(context.Context) (*Job, error)
+	predicates      []predicate.Job
 }
 
-var _ ent.Mutation = (*PaymentIntentMutation)(nil)
+var _ ent.Mutation = (*JobMutation)(nil)
 
-// paymentintentOption allows management of the mutation configuration using functional options.
-type paymentintentOption func(*PaymentIntentMutation)
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
 
-// newPaymentIntentMutation creates new mutation for the PaymentIntent entity.
-func newPaymentIntentMutation(c config, op Op, opts ...paymentintentOption) *PaymentIntentMutation {
-	m := &PaymentIntentMutation{
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePaymentIntent,
+		typ:           TypeJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3875,20 +4735,20 @@ func newPaymentIntentMutation(c config, op Op, opts ...paymentintentOption) *Pay
 	return m
 }
 
-// withPaymentIntentID sets the ID field of the mutation.
-func withPaymentIntentID(id int) paymentintentOption {
-	return func(m *PaymentIntentMutation) {
+// withJobID sets the ID field of the mutation.
+func withJobID(id int) jobOption {
+	return func(m *JobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PaymentIntent
+			value *Job
 		)
-		m.oldValue = func(ctx context.Context) (*PaymentIntent, error) {
+		m.oldValue = func(ctx context.Context) (*Job, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PaymentIntent.Get(ctx, id)
+					value, err = m.Client().Job.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3897,10 +4757,10 @@ func withPaymentIntentID(id int) paymentintentOption {
 	}
 }
 
-// withPaymentIntent sets the old PaymentIntent of the mutation.
-func withPaymentIntent(node *PaymentIntent) paymentintentOption {
-	return func(m *PaymentIntentMutation) {
-		m.oldValue = func(context.Context) (*PaymentIntent, error) {
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3909,7 +4769,7 @@ func withPaymentIntent(node *PaymentIntent) paymentintentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PaymentIntentMutation) Client() *Client {
+func (m JobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3917,7 +4777,7 @@ func (m PaymentIntentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PaymentIntentMutation) Tx() (*Tx, error) {
+func (m JobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3928,7 +4788,7 @@ func (m PaymentIntentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PaymentIntentMutation) ID() (id int, exists bool) {
+func (m *JobMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3939,7 +4799,7 @@ func (m *PaymentIntentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PaymentIntentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *JobMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3948,479 +4808,766 @@ func (m *PaymentIntentMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PaymentIntent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetProviderPaymentIntentID sets the "provider_payment_intent_id" field.
-func (m *PaymentIntentMutation) SetProviderPaymentIntentID(s string) {
-	m.provider_payment_intent_id = &s
+// SetQueue sets the "queue" field.
+func (m *JobMutation) SetQueue(s string) {
+	m.queue = &s
 }
 
-// ProviderPaymentIntentID returns the value of the "provider_payment_intent_id" field in the mutation.
-func (m *PaymentIntentMutation) ProviderPaymentIntentID() (r string, exists bool) {
-	v := m.provider_payment_intent_id
+// Queue returns the value of the "queue" field in the mutation.
+func (m *JobMutation) Queue() (r string, exists bool) {
+	v := m.queue
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderPaymentIntentID returns the old "provider_payment_intent_id" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldQueue returns the old "queue" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldProviderPaymentIntentID(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldQueue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderPaymentIntentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldQueue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderPaymentIntentID requires an ID field in the mutation")
+		return v, errors.New("OldQueue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderPaymentIntentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldQueue: %w", err)
 	}
-	return oldValue.ProviderPaymentIntentID, nil
+	return oldValue.Queue, nil
 }
 
-// ResetProviderPaymentIntentID resets all changes to the "provider_payment_intent_id" field.
-func (m *PaymentIntentMutation) ResetProviderPaymentIntentID() {
-	m.provider_payment_intent_id = nil
+// ResetQueue resets all changes to the "queue" field.
+func (m *JobMutation) ResetQueue() {
+	m.queue = nil
 }
 
-// SetProvider sets the "provider" field.
-func (m *PaymentIntentMutation) SetProvider(s string) {
-	m.provider = &s
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *PaymentIntentMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.Payload, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *PaymentIntentMutation) ResetProvider() {
-	m.provider = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *PaymentIntentMutation) SetStatus(pa paymentintent.Status) {
-	m.status = &pa
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PaymentIntentMutation) Status() (r paymentintent.Status, exists bool) {
-	v := m.status
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldStatus(ctx context.Context) (v paymentintent.Status, err error) {
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Attempts, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PaymentIntentMutation) ResetStatus() {
-	m.status = nil
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// SetAmount sets the "amount" field.
-func (m *PaymentIntentMutation) SetAmount(i int64) {
-	m.amount = &i
-	m.addamount = nil
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *PaymentIntentMutation) Amount() (r int64, exists bool) {
-	v := m.amount
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *JobMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *JobMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxAttempts returns the old "max_attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldAmount(ctx context.Context) (v int64, err error) {
+func (m *JobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
 	}
-	return oldValue.Amount, nil
+	return oldValue.MaxAttempts, nil
 }
 
-// AddAmount adds i to the "amount" field.
-func (m *PaymentIntentMutation) AddAmount(i int64) {
-	if m.addamount != nil {
-		*m.addamount += i
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *JobMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
 	} else {
-		m.addamount = &i
+		m.addmax_attempts = &i
 	}
 }
 
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *PaymentIntentMutation) AddedAmount() (r int64, exists bool) {
-	v := m.addamount
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *JobMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *PaymentIntentMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *JobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
 }
 
-// SetCurrency sets the "currency" field.
-func (m *PaymentIntentMutation) SetCurrency(s string) {
-	m.currency = &s
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *PaymentIntentMutation) Currency() (r string, exists bool) {
-	v := m.currency
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrency returns the old "currency" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldCurrency(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Currency, nil
+	return oldValue.Status, nil
 }
 
-// ResetCurrency resets all changes to the "currency" field.
-func (m *PaymentIntentMutation) ResetCurrency() {
-	m.currency = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *PaymentIntentMutation) SetDescription(s string) {
-	m.description = &s
+// SetPriority sets the "priority" field.
+func (m *JobMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PaymentIntentMutation) Description() (r string, exists bool) {
-	v := m.description
+// Priority returns the value of the "priority" field in the mutation.
+func (m *JobMutation) Priority() (r int, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldPriority(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Priority, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *PaymentIntentMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[paymentintent.FieldDescription] = struct{}{}
+// AddPriority adds i to the "priority" field.
+func (m *JobMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PaymentIntentMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[paymentintent.FieldDescription]
-	return ok
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *JobMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PaymentIntentMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, paymentintent.FieldDescription)
+// ResetPriority resets all changes to the "priority" field.
+func (m *JobMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
 }
 
-// SetClientSecret sets the "client_secret" field.
-func (m *PaymentIntentMutation) SetClientSecret(s string) {
-	m.client_secret = &s
+// SetRunAt sets the "run_at" field.
+func (m *JobMutation) SetRunAt(t time.Time) {
+	m.run_at = &t
 }
 
-// ClientSecret returns the value of the "client_secret" field in the mutation.
-func (m *PaymentIntentMutation) ClientSecret() (r string, exists bool) {
-	v := m.client_secret
+// RunAt returns the value of the "run_at" field in the mutation.
+func (m *JobMutation) RunAt() (r time.Time, exists bool) {
+	v := m.run_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientSecret returns the old "client_secret" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldRunAt returns the old "run_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldRunAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldRunAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+		return v, errors.New("OldRunAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldRunAt: %w", err)
 	}
-	return oldValue.ClientSecret, nil
-}
-
-// ClearClientSecret clears the value of the "client_secret" field.
-func (m *PaymentIntentMutation) ClearClientSecret() {
-	m.client_secret = nil
-	m.clearedFields[paymentintent.FieldClientSecret] = struct{}{}
-}
-
-// ClientSecretCleared returns if the "client_secret" field was cleared in this mutation.
-func (m *PaymentIntentMutation) ClientSecretCleared() bool {
-	_, ok := m.clearedFields[paymentintent.FieldClientSecret]
-	return ok
+	return oldValue.RunAt, nil
 }
 
-// ResetClientSecret resets all changes to the "client_secret" field.
-func (m *PaymentIntentMutation) ResetClientSecret() {
-	m.client_secret = nil
-	delete(m.clearedFields, paymentintent.FieldClientSecret)
+// ResetRunAt resets all changes to the "run_at" field.
+func (m *JobMutation) ResetRunAt() {
+	m.run_at = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *PaymentIntentMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetScheduledFor sets the "scheduled_for" field.
+func (m *JobMutation) SetScheduledFor(t time.Time) {
+	m.scheduled_for = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *PaymentIntentMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// ScheduledFor returns the value of the "scheduled_for" field in the mutation.
+func (m *JobMutation) ScheduledFor() (r time.Time, exists bool) {
+	v := m.scheduled_for
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduledFor returns the old "scheduled_for" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *JobMutation) OldScheduledFor(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduledFor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldScheduledFor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduledFor: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.ScheduledFor, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *PaymentIntentMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[paymentintent.FieldMetadata] = struct{}{}
+// ClearScheduledFor clears the value of the "scheduled_for" field.
+func (m *JobMutation) ClearScheduledFor() {
+	m.scheduled_for = nil
+	m.clearedFields[job.FieldScheduledFor] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *PaymentIntentMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[paymentintent.FieldMetadata]
+// ScheduledForCleared returns if the "scheduled_for" field was cleared in this mutation.
+func (m *JobMutation) ScheduledForCleared() bool {
+	_, ok := m.clearedFields[job.FieldScheduledFor]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *PaymentIntentMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, paymentintent.FieldMetadata)
+// ResetScheduledFor resets all changes to the "scheduled_for" field.
+func (m *JobMutation) ResetScheduledFor() {
+	m.scheduled_for = nil
+	delete(m.clearedFields, job.FieldScheduledFor)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PaymentIntentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUniqueKey sets the "unique_key" field.
+func (m *JobMutation) SetUniqueKey(s string) {
+	m.unique_key = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PaymentIntentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UniqueKey returns the value of the "unique_key" field in the mutation.
+func (m *JobMutation) UniqueKey() (r string, exists bool) {
+	v := m.unique_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldUniqueKey returns the old "unique_key" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobMutation) OldUniqueKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUniqueKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUniqueKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUniqueKey: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UniqueKey, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PaymentIntentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearUniqueKey clears the value of the "unique_key" field.
+func (m *JobMutation) ClearUniqueKey() {
+	m.unique_key = nil
+	m.clearedFields[job.FieldUniqueKey] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PaymentIntentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// UniqueKeyCleared returns if the "unique_key" field was cleared in this mutation.
+func (m *JobMutation) UniqueKeyCleared() bool {
+	_, ok := m.clearedFields[job.FieldUniqueKey]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PaymentIntentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetUniqueKey resets all changes to the "unique_key" field.
+func (m *JobMutation) ResetUniqueKey() {
+	m.unique_key = nil
+	delete(m.clearedFields, job.FieldUniqueKey)
+}
+
+// SetUniqueLock sets the "unique_lock" field.
+func (m *JobMutation) SetUniqueLock(s string) {
+	m.unique_lock = &s
+}
+
+// UniqueLock returns the value of the "unique_lock" field in the mutation.
+func (m *JobMutation) UniqueLock() (r string, exists bool) {
+	v := m.unique_lock
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PaymentIntent entity.
-// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// OldUniqueLock returns the old "unique_lock" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentIntentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobMutation) OldUniqueLock(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUniqueLock is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUniqueLock requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUniqueLock: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PaymentIntentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.UniqueLock, nil
 }
 
-// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by id.
-func (m *PaymentIntentMutation) SetCustomerID(id int) {
-	m.customer = &id
+// ClearUniqueLock clears the value of the "unique_lock" field.
+func (m *JobMutation) ClearUniqueLock() {
+	m.unique_lock = nil
+	m.clearedFields[job.FieldUniqueLock] = struct{}{}
 }
 
-// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
-func (m *PaymentIntentMutation) ClearCustomer() {
-	m.clearedcustomer = true
+// UniqueLockCleared returns if the "unique_lock" field was cleared in this mutation.
+func (m *JobMutation) UniqueLockCleared() bool {
+	_, ok := m.clearedFields[job.FieldUniqueLock]
+	return ok
 }
 
-// CustomerCleared reports if the "customer" edge to the PaymentCustomer entity was cleared.
-func (m *PaymentIntentMutation) CustomerCleared() bool {
-	return m.clearedcustomer
+// ResetUniqueLock resets all changes to the "unique_lock" field.
+func (m *JobMutation) ResetUniqueLock() {
+	m.unique_lock = nil
+	delete(m.clearedFields, job.FieldUniqueLock)
 }
 
-// CustomerID returns the "customer" edge ID in the mutation.
-func (m *PaymentIntentMutation) CustomerID() (id int, exists bool) {
-	if m.customer != nil {
-		return *m.customer, true
-	}
-	return
+// SetError sets the "error" field.
+func (m *JobMutation) SetError(s string) {
+	m.error = &s
 }
 
-// CustomerIDs returns the "customer" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CustomerID instead. It exists only for internal usage by the builders.
-func (m *PaymentIntentMutation) CustomerIDs() (ids []int) {
-	if id := m.customer; id != nil {
-		ids = append(ids, *id)
+// Error returns the value of the "error" field in the mutation.
+func (m *JobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetCustomer resets all changes to the "customer" edge.
-func (m *PaymentIntentMutation) ResetCustomer() {
-	m.customer = nil
-	m.clearedcustomer = false
+// OldError returns the old "error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// Where appends a list predicates to the PaymentIntentMutation builder.
-func (m *PaymentIntentMutation) Where(ps ...predicate.PaymentIntent) {
+// ClearError clears the value of the "error" field.
+func (m *JobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[job.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, job.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (m *JobMutation) SetProcessedAt(t time.Time) {
+	m.processed_at = &t
+}
+
+// ProcessedAt returns the value of the "processed_at" field in the mutation.
+func (m *JobMutation) ProcessedAt() (r time.Time, exists bool) {
+	v := m.processed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessedAt returns the old "processed_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldProcessedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+	}
+	return oldValue.ProcessedAt, nil
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (m *JobMutation) ClearProcessedAt() {
+	m.processed_at = nil
+	m.clearedFields[job.FieldProcessedAt] = struct{}{}
+}
+
+// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
+func (m *JobMutation) ProcessedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldProcessedAt]
+	return ok
+}
+
+// ResetProcessedAt resets all changes to the "processed_at" field.
+func (m *JobMutation) ResetProcessedAt() {
+	m.processed_at = nil
+	delete(m.clearedFields, job.FieldProcessedAt)
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (m *JobMutation) SetLockedBy(s string) {
+	m.locked_by = &s
+}
+
+// LockedBy returns the value of the "locked_by" field in the mutation.
+func (m *JobMutation) LockedBy() (r string, exists bool) {
+	v := m.locked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedBy returns the old "locked_by" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedBy: %w", err)
+	}
+	return oldValue.LockedBy, nil
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (m *JobMutation) ClearLockedBy() {
+	m.locked_by = nil
+	m.clearedFields[job.FieldLockedBy] = struct{}{}
+}
+
+// LockedByCleared returns if the "locked_by" field was cleared in this mutation.
+func (m *JobMutation) LockedByCleared() bool {
+	_, ok := m.clearedFields[job.FieldLockedBy]
+	return ok
+}
+
+// ResetLockedBy resets all changes to the "locked_by" field.
+func (m *JobMutation) ResetLockedBy() {
+	m.locked_by = nil
+	delete(m.clearedFields, job.FieldLockedBy)
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *JobMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *JobMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *JobMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[job.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *JobMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[job.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *JobMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, job.FieldLockedUntil)
+}
+
+// AddHistoryIDs adds the "history" edge to the JobAttempt entity by ids.
+func (m *JobMutation) AddHistoryIDs(ids ...int) {
+	if m.history == nil {
+		m.history = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistory clears the "history" edge to the JobAttempt entity.
+func (m *JobMutation) ClearHistory() {
+	m.clearedhistory = true
+}
+
+// HistoryCleared reports if the "history" edge to the JobAttempt entity was cleared.
+func (m *JobMutation) HistoryCleared() bool {
+	return m.clearedhistory
+}
+
+// RemoveHistoryIDs removes the "history" edge to the JobAttempt entity by IDs.
+func (m *JobMutation) RemoveHistoryIDs(ids ...int) {
+	if m.removedhistory == nil {
+		m.removedhistory = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.history, ids[i])
+		m.removedhistory[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistory returns the removed IDs of the "history" edge to the JobAttempt entity.
+func (m *JobMutation) RemovedHistoryIDs() (ids []int) {
+	for id := range m.removedhistory {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistoryIDs returns the "history" edge IDs in the mutation.
+func (m *JobMutation) HistoryIDs() (ids []int) {
+	for id := range m.history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistory resets all changes to the "history" edge.
+func (m *JobMutation) ResetHistory() {
+	m.history = nil
+	m.clearedhistory = false
+	m.removedhistory = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PaymentIntentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PaymentIntentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PaymentIntent, len(ps))
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4428,83 +5575,108 @@ func (m *PaymentIntentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PaymentIntentMutation) Op() Op {
+func (m *JobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PaymentIntentMutation) SetOp(op Op) {
+func (m *JobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PaymentIntent).
-func (m *PaymentIntentMutation) Type() string {
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PaymentIntentMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.provider_payment_intent_id != nil {
-		fields = append(fields, paymentintent.FieldProviderPaymentIntentID)
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.queue != nil {
+		fields = append(fields, job.FieldQueue)
 	}
-	if m.provider != nil {
-		fields = append(fields, paymentintent.FieldProvider)
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
 	}
 	if m.status != nil {
-		fields = append(fields, paymentintent.FieldStatus)
+		fields = append(fields, job.FieldStatus)
 	}
-	if m.amount != nil {
-		fields = append(fields, paymentintent.FieldAmount)
+	if m.priority != nil {
+		fields = append(fields, job.FieldPriority)
 	}
-	if m.currency != nil {
-		fields = append(fields, paymentintent.FieldCurrency)
+	if m.run_at != nil {
+		fields = append(fields, job.FieldRunAt)
 	}
-	if m.description != nil {
-		fields = append(fields, paymentintent.FieldDescription)
+	if m.scheduled_for != nil {
+		fields = append(fields, job.FieldScheduledFor)
 	}
-	if m.client_secret != nil {
-		fields = append(fields, paymentintent.FieldClientSecret)
+	if m.unique_key != nil {
+		fields = append(fields, job.FieldUniqueKey)
 	}
-	if m.metadata != nil {
-		fields = append(fields, paymentintent.FieldMetadata)
+	if m.unique_lock != nil {
+		fields = append(fields, job.FieldUniqueLock)
+	}
+	if m.error != nil {
+		fields = append(fields, job.FieldError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, paymentintent.FieldCreatedAt)
+		fields = append(fields, job.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, paymentintent.FieldUpdatedAt)
+	if m.processed_at != nil {
+		fields = append(fields, job.FieldProcessedAt)
 	}
-	return fields
+	if m.locked_by != nil {
+		fields = append(fields, job.FieldLockedBy)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, job.FieldLockedUntil)
+	}
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PaymentIntentMutation) Field(name string) (ent.Value, bool) {
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case paymentintent.FieldProviderPaymentIntentID:
-		return m.ProviderPaymentIntentID()
-	case paymentintent.FieldProvider:
-		return m.Provider()
-	case paymentintent.FieldStatus:
+	case job.FieldQueue:
+		return m.Queue()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case job.FieldStatus:
 		return m.Status()
-	case paymentintent.FieldAmount:
-		return m.Amount()
-	case paymentintent.FieldCurrency:
-		return m.Currency()
-	case paymentintent.FieldDescription:
-		return m.Description()
-	case paymentintent.FieldClientSecret:
-		return m.ClientSecret()
-	case paymentintent.FieldMetadata:
-		return m.Metadata()
-	case paymentintent.FieldCreatedAt:
+	case job.FieldPriority:
+		return m.Priority()
+	case job.FieldRunAt:
+		return m.RunAt()
+	case job.FieldScheduledFor:
+		return m.ScheduledFor()
+	case job.FieldUniqueKey:
+		return m.UniqueKey()
+	case job.FieldUniqueLock:
+		return m.UniqueLock()
+	case job.FieldError:
+		return m.Error()
+	case job.FieldCreatedAt:
 		return m.CreatedAt()
-	case paymentintent.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case job.FieldProcessedAt:
+		return m.ProcessedAt()
+	case job.FieldLockedBy:
+		return m.LockedBy()
+	case job.FieldLockedUntil:
+		return m.LockedUntil()
 	}
 	return nil, false
 }
@@ -4512,117 +5684,168 @@ func (m *PaymentIntentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PaymentIntentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case paymentintent.FieldProviderPaymentIntentID:
-		return m.OldProviderPaymentIntentID(ctx)
-	case paymentintent.FieldProvider:
-		return m.OldProvider(ctx)
-	case paymentintent.FieldStatus:
+	case job.FieldQueue:
+		return m.OldQueue(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case job.FieldStatus:
 		return m.OldStatus(ctx)
-	case paymentintent.FieldAmount:
-		return m.OldAmount(ctx)
-	case paymentintent.FieldCurrency:
-		return m.OldCurrency(ctx)
-	case paymentintent.FieldDescription:
-		return m.OldDescription(ctx)
-	case paymentintent.FieldClientSecret:
-		return m.OldClientSecret(ctx)
-	case paymentintent.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case paymentintent.FieldCreatedAt:
+	case job.FieldPriority:
+		return m.OldPriority(ctx)
+	case job.FieldRunAt:
+		return m.OldRunAt(ctx)
+	case job.FieldScheduledFor:
+		return m.OldScheduledFor(ctx)
+	case job.FieldUniqueKey:
+		return m.OldUniqueKey(ctx)
+	case job.FieldUniqueLock:
+		return m.OldUniqueLock(ctx)
+	case job.FieldError:
+		return m.OldError(ctx)
+	case job.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case paymentintent.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case job.FieldProcessedAt:
+		return m.OldProcessedAt(ctx)
+	case job.FieldLockedBy:
+		return m.OldLockedBy(ctx)
+	case job.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
 	}
-	return nil, fmt.Errorf("unknown PaymentIntent field %s", name)
+	return nil, fmt.Errorf("unknown Job field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentIntentMutation) SetField(name string, value ent.Value) error {
+func (m *JobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case paymentintent.FieldProviderPaymentIntentID:
+	case job.FieldQueue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProviderPaymentIntentID(v)
+		m.SetQueue(v)
 		return nil
-	case paymentintent.FieldProvider:
-		v, ok := value.(string)
+	case job.FieldPayload:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProvider(v)
+		m.SetPayload(v)
 		return nil
-	case paymentintent.FieldStatus:
-		v, ok := value.(paymentintent.Status)
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case paymentintent.FieldAmount:
-		v, ok := value.(int64)
+	case job.FieldPriority:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
+		m.SetPriority(v)
 		return nil
-	case paymentintent.FieldCurrency:
-		v, ok := value.(string)
+	case job.FieldRunAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrency(v)
+		m.SetRunAt(v)
 		return nil
-	case paymentintent.FieldDescription:
+	case job.FieldScheduledFor:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledFor(v)
+		return nil
+	case job.FieldUniqueKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetUniqueKey(v)
 		return nil
-	case paymentintent.FieldClientSecret:
+	case job.FieldUniqueLock:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientSecret(v)
+		m.SetUniqueLock(v)
 		return nil
-	case paymentintent.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case job.FieldError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetError(v)
 		return nil
-	case paymentintent.FieldCreatedAt:
+	case job.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case paymentintent.FieldUpdatedAt:
+	case job.FieldProcessedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetProcessedAt(v)
+		return nil
+	case job.FieldLockedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedBy(v)
+		return nil
+	case job.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentIntent field %s", name)
+	return fmt.Errorf("unknown Job field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PaymentIntentMutation) AddedFields() []string {
+func (m *JobMutation) AddedFields() []string {
 	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, paymentintent.FieldAmount)
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, job.FieldPriority)
 	}
 	return fields
 }
@@ -4630,10 +5853,14 @@ func (m *PaymentIntentMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PaymentIntentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case paymentintent.FieldAmount:
-		return m.AddedAmount()
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	case job.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	case job.FieldPriority:
+		return m.AddedPriority()
 	}
 	return nil, false
 }
@@ -4641,209 +5868,267 @@ func (m *PaymentIntentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentIntentMutation) AddField(name string, value ent.Value) error {
+func (m *JobMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case paymentintent.FieldAmount:
-		v, ok := value.(int64)
+	case job.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAmount(v)
+		m.AddAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	case job.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentIntent numeric field %s", name)
+	return fmt.Errorf("unknown Job numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PaymentIntentMutation) ClearedFields() []string {
+func (m *JobMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(paymentintent.FieldDescription) {
-		fields = append(fields, paymentintent.FieldDescription)
+	if m.FieldCleared(job.FieldScheduledFor) {
+		fields = append(fields, job.FieldScheduledFor)
 	}
-	if m.FieldCleared(paymentintent.FieldClientSecret) {
-		fields = append(fields, paymentintent.FieldClientSecret)
+	if m.FieldCleared(job.FieldUniqueKey) {
+		fields = append(fields, job.FieldUniqueKey)
 	}
-	if m.FieldCleared(paymentintent.FieldMetadata) {
-		fields = append(fields, paymentintent.FieldMetadata)
+	if m.FieldCleared(job.FieldUniqueLock) {
+		fields = append(fields, job.FieldUniqueLock)
+	}
+	if m.FieldCleared(job.FieldError) {
+		fields = append(fields, job.FieldError)
+	}
+	if m.FieldCleared(job.FieldProcessedAt) {
+		fields = append(fields, job.FieldProcessedAt)
+	}
+	if m.FieldCleared(job.FieldLockedBy) {
+		fields = append(fields, job.FieldLockedBy)
+	}
+	if m.FieldCleared(job.FieldLockedUntil) {
+		fields = append(fields, job.FieldLockedUntil)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PaymentIntentMutation) FieldCleared(name string) bool {
+func (m *JobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PaymentIntentMutation) ClearField(name string) error {
+func (m *JobMutation) ClearField(name string) error {
 	switch name {
-	case paymentintent.FieldDescription:
-		m.ClearDescription()
+	case job.FieldScheduledFor:
+		m.ClearScheduledFor()
 		return nil
-	case paymentintent.FieldClientSecret:
-		m.ClearClientSecret()
+	case job.FieldUniqueKey:
+		m.ClearUniqueKey()
 		return nil
-	case paymentintent.FieldMetadata:
-		m.ClearMetadata()
+	case job.FieldUniqueLock:
+		m.ClearUniqueLock()
+		return nil
+	case job.FieldError:
+		m.ClearError()
+		return nil
+	case job.FieldProcessedAt:
+		m.ClearProcessedAt()
+		return nil
+	case job.FieldLockedBy:
+		m.ClearLockedBy()
+		return nil
+	case job.FieldLockedUntil:
+		m.ClearLockedUntil()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentIntent nullable field %s", name)
+	return fmt.Errorf("unknown Job nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PaymentIntentMutation) ResetField(name string) error {
+func (m *JobMutation) ResetField(name string) error {
 	switch name {
-	case paymentintent.FieldProviderPaymentIntentID:
-		m.ResetProviderPaymentIntentID()
+	case job.FieldQueue:
+		m.ResetQueue()
 		return nil
-	case paymentintent.FieldProvider:
-		m.ResetProvider()
+	case job.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case paymentintent.FieldStatus:
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case job.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case paymentintent.FieldAmount:
-		m.ResetAmount()
+	case job.FieldPriority:
+		m.ResetPriority()
 		return nil
-	case paymentintent.FieldCurrency:
-		m.ResetCurrency()
+	case job.FieldRunAt:
+		m.ResetRunAt()
 		return nil
-	case paymentintent.FieldDescription:
-		m.ResetDescription()
+	case job.FieldScheduledFor:
+		m.ResetScheduledFor()
 		return nil
-	case paymentintent.FieldClientSecret:
-		m.ResetClientSecret()
+	case job.FieldUniqueKey:
+		m.ResetUniqueKey()
 		return nil
-	case paymentintent.FieldMetadata:
-		m.ResetMetadata()
+	case job.FieldUniqueLock:
+		m.ResetUniqueLock()
 		return nil
-	case paymentintent.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case job.FieldError:
+		m.ResetError()
 		return nil
-	case paymentintent.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldProcessedAt:
+		m.ResetProcessedAt()
+		return nil
+	case job.FieldLockedBy:
+		m.ResetLockedBy()
+		return nil
+	case job.FieldLockedUntil:
+		m.ResetLockedUntil()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentIntent field %s", name)
+	return fmt.Errorf("unknown Job field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PaymentIntentMutation) AddedEdges() []string {
+func (m *JobMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.customer != nil {
-		edges = append(edges, paymentintent.EdgeCustomer)
+	if m.history != nil {
+		edges = append(edges, job.EdgeHistory)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PaymentIntentMutation) AddedIDs(name string) []ent.Value {
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case paymentintent.EdgeCustomer:
-		if id := m.customer; id != nil {
-			return []ent.Value{*id}
+	case job.EdgeHistory:
+		ids := make([]ent.Value, 0, len(m.history))
+		for id := range m.history {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PaymentIntentMutation) RemovedEdges() []string {
+func (m *JobMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removedhistory != nil {
+		edges = append(edges, job.EdgeHistory)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PaymentIntentMutation) RemovedIDs(name string) []ent.Value {
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case job.EdgeHistory:
+		ids := make([]ent.Value, 0, len(m.removedhistory))
+		for id := range m.removedhistory {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PaymentIntentMutation) ClearedEdges() []string {
+func (m *JobMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedcustomer {
-		edges = append(edges, paymentintent.EdgeCustomer)
+	if m.clearedhistory {
+		edges = append(edges, job.EdgeHistory)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PaymentIntentMutation) EdgeCleared(name string) bool {
+func (m *JobMutation) EdgeCleared(name string) bool {
 	switch name {
-	case paymentintent.EdgeCustomer:
-		return m.clearedcustomer
+	case job.EdgeHistory:
+		return m.clearedhistory
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PaymentIntentMutation) ClearEdge(name string) error {
+func (m *JobMutation) ClearEdge(name string) error {
 	switch name {
-	case paymentintent.EdgeCustomer:
-		m.ClearCustomer()
-		return nil
 	}
-	return fmt.Errorf("unknown PaymentIntent unique edge %s", name)
+	return fmt.Errorf("unknown Job unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PaymentIntentMutation) ResetEdge(name string) error {
+func (m *JobMutation) ResetEdge(name string) error {
 	switch name {
-	case paymentintent.EdgeCustomer:
-		m.ResetCustomer()
+	case job.EdgeHistory:
+		m.ResetHistory()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentIntent edge %s", name)
+	return fmt.Errorf("unknown Job edge %s", name)
 }
 
-// PaymentMethodMutation represents an operation that mutates the PaymentMethod nodes in the graph.
-type PaymentMethodMutation struct {
+// JobAttemptMutation represents an operation that mutates the JobAttempt nodes in the graph.
+type JobAttemptMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	provider_payment_method_id *string
-	provider                   *string
-	_type                      *paymentmethod.Type
-	last_four                  *string
-	brand                      *string
-	exp_month                  *int
-	addexp_month               *int
-	exp_year                   *int
-	addexp_year                *int
-	is_default                 *bool
-	metadata                   *map[string]interface{}
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	clearedFields              map[string]struct{}
-	customer                   *int
-	clearedcustomer            bool
-	done                       bool
-	oldValue                   func(context.Context) (*PaymentMethod, error)
-	predicates                 []predicate.PaymentMethod
+	op             Op
+	typ            string
+	id             *int
+	attempt        *int
+	addattempt     *int
+	worker         *string
+	started_at     *time.Time
+	finished_at    *time.Time
+	duration_ms    *int64
+	addduration_ms *int64
+	error          *string
+	clearedFields  map[string]struct{}
+	job            *int
+	clearedjob     bool
+	done           bool
+	oldValue       func(context.Context) (*JobAttempt, error)
+	predicates     []predicate.JobAttempt
 }
 
-var _ ent.Mutation = (*PaymentMethodMutation)(nil)
+var _ ent.Mutation = (*JobAttemptMutation)(nil)
 
-// paymentmethodOption allows management of the mutation configuration using functional options.
-type paymentmethodOption func(*PaymentMethodMutation)
+// jobattemptOption allows management of the mutation configuration using functional options.
+type jobattemptOption func(*JobAttemptMutation)
 
-// newPaymentMethodMutation creates new mutation for the PaymentMethod entity.
-func newPaymentMethodMutation(c config, op Op, opts ...paymentmethodOption) *PaymentMethodMutation {
-	m := &PaymentMethodMutation{
+// newJobAttemptMutation creates new mutation for the JobAttempt entity.
+func newJobAttemptMutation(c config, op Op, opts ...jobattemptOption) *JobAttemptMutation {
+	m := &JobAttemptMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePaymentMethod,
+		typ:           TypeJobAttempt,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4852,20 +6137,20 @@ func newPaymentMethodMutation(c config, op Op, opts ...paymentmethodOption) *Pay
 	return m
 }
 
-// withPaymentMethodID sets the ID field of the mutation.
-func withPaymentMethodID(id int) paymentmethodOption {
-	return func(m *PaymentMethodMutation) {
+// withJobAttemptID sets the ID field of the mutation.
+func withJobAttemptID(id int) jobattemptOption {
+	return func(m *JobAttemptMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PaymentMethod
+			value *JobAttempt
 		)
-		m.oldValue = func(ctx context.Context) (*PaymentMethod, error) {
+		m.oldValue = func(ctx context.Context) (*JobAttempt, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PaymentMethod.Get(ctx, id)
+					value, err = m.Client().JobAttempt.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4874,10 +6159,10 @@ func withPaymentMethodID(id int) paymentmethodOption {
 	}
 }
 
-// withPaymentMethod sets the old PaymentMethod of the mutation.
-func withPaymentMethod(node *PaymentMethod) paymentmethodOption {
-	return func(m *PaymentMethodMutation) {
-		m.oldValue = func(context.Context) (*PaymentMethod, error) {
+// withJobAttempt sets the old JobAttempt of the mutation.
+func withJobAttempt(node *JobAttempt) jobattemptOption {
+	return func(m *JobAttemptMutation) {
+		m.oldValue = func(context.Context) (*JobAttempt, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4886,7 +6171,7 @@ func withPaymentMethod(node *PaymentMethod) paymentmethodOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PaymentMethodMutation) Client() *Client {
+func (m JobAttemptMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4894,7 +6179,7 @@ func (m PaymentMethodMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PaymentMethodMutation) Tx() (*Tx, error) {
+func (m JobAttemptMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4905,7 +6190,7 @@ func (m PaymentMethodMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PaymentMethodMutation) ID() (id int, exists bool) {
+func (m *JobAttemptMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4916,7 +6201,7 @@ func (m *PaymentMethodMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PaymentMethodMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *JobAttemptMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4925,563 +6210,353 @@ func (m *PaymentMethodMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PaymentMethod.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().JobAttempt.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetProviderPaymentMethodID sets the "provider_payment_method_id" field.
-func (m *PaymentMethodMutation) SetProviderPaymentMethodID(s string) {
-	m.provider_payment_method_id = &s
+// SetJobID sets the "job_id" field.
+func (m *JobAttemptMutation) SetJobID(i int) {
+	m.job = &i
 }
 
-// ProviderPaymentMethodID returns the value of the "provider_payment_method_id" field in the mutation.
-func (m *PaymentMethodMutation) ProviderPaymentMethodID() (r string, exists bool) {
-	v := m.provider_payment_method_id
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *JobAttemptMutation) JobID() (r int, exists bool) {
+	v := m.job
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderPaymentMethodID returns the old "provider_payment_method_id" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// OldJobID returns the old "job_id" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldProviderPaymentMethodID(ctx context.Context) (v string, err error) {
+func (m *JobAttemptMutation) OldJobID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderPaymentMethodID is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderPaymentMethodID requires an ID field in the mutation")
+		return v, errors.New("OldJobID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderPaymentMethodID: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
 	}
-	return oldValue.ProviderPaymentMethodID, nil
+	return oldValue.JobID, nil
 }
 
-// ResetProviderPaymentMethodID resets all changes to the "provider_payment_method_id" field.
-func (m *PaymentMethodMutation) ResetProviderPaymentMethodID() {
-	m.provider_payment_method_id = nil
+// ResetJobID resets all changes to the "job_id" field.
+func (m *JobAttemptMutation) ResetJobID() {
+	m.job = nil
 }
 
-// SetProvider sets the "provider" field.
-func (m *PaymentMethodMutation) SetProvider(s string) {
-	m.provider = &s
+// SetAttempt sets the "attempt" field.
+func (m *JobAttemptMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *PaymentMethodMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *JobAttemptMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempt returns the old "attempt" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *JobAttemptMutation) OldAttempt(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.Attempt, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *PaymentMethodMutation) ResetProvider() {
-	m.provider = nil
+// AddAttempt adds i to the "attempt" field.
+func (m *JobAttemptMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
 }
 
-// SetType sets the "type" field.
-func (m *PaymentMethodMutation) SetType(pa paymentmethod.Type) {
-	m._type = &pa
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *JobAttemptMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *PaymentMethodMutation) GetType() (r paymentmethod.Type, exists bool) {
-	v := m._type
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *JobAttemptMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
+}
+
+// SetWorker sets the "worker" field.
+func (m *JobAttemptMutation) SetWorker(s string) {
+	m.worker = &s
+}
+
+// Worker returns the value of the "worker" field in the mutation.
+func (m *JobAttemptMutation) Worker() (r string, exists bool) {
+	v := m.worker
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// OldWorker returns the old "worker" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldType(ctx context.Context) (v paymentmethod.Type, err error) {
+func (m *JobAttemptMutation) OldWorker(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorker is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldWorker requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorker: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Worker, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *PaymentMethodMutation) ResetType() {
-	m._type = nil
+// ResetWorker resets all changes to the "worker" field.
+func (m *JobAttemptMutation) ResetWorker() {
+	m.worker = nil
 }
 
-// SetLastFour sets the "last_four" field.
-func (m *PaymentMethodMutation) SetLastFour(s string) {
-	m.last_four = &s
+// SetStartedAt sets the "started_at" field.
+func (m *JobAttemptMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// LastFour returns the value of the "last_four" field in the mutation.
-func (m *PaymentMethodMutation) LastFour() (r string, exists bool) {
-	v := m.last_four
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobAttemptMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastFour returns the old "last_four" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldLastFour(ctx context.Context) (v string, err error) {
+func (m *JobAttemptMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastFour is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastFour requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastFour: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.LastFour, nil
-}
-
-// ClearLastFour clears the value of the "last_four" field.
-func (m *PaymentMethodMutation) ClearLastFour() {
-	m.last_four = nil
-	m.clearedFields[paymentmethod.FieldLastFour] = struct{}{}
-}
-
-// LastFourCleared returns if the "last_four" field was cleared in this mutation.
-func (m *PaymentMethodMutation) LastFourCleared() bool {
-	_, ok := m.clearedFields[paymentmethod.FieldLastFour]
-	return ok
+	return oldValue.StartedAt, nil
 }
 
-// ResetLastFour resets all changes to the "last_four" field.
-func (m *PaymentMethodMutation) ResetLastFour() {
-	m.last_four = nil
-	delete(m.clearedFields, paymentmethod.FieldLastFour)
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobAttemptMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetBrand sets the "brand" field.
-func (m *PaymentMethodMutation) SetBrand(s string) {
-	m.brand = &s
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobAttemptMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// Brand returns the value of the "brand" field in the mutation.
-func (m *PaymentMethodMutation) Brand() (r string, exists bool) {
-	v := m.brand
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobAttemptMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBrand returns the old "brand" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldBrand(ctx context.Context) (v string, err error) {
+func (m *JobAttemptMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBrand is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBrand requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBrand: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.Brand, nil
-}
-
-// ClearBrand clears the value of the "brand" field.
-func (m *PaymentMethodMutation) ClearBrand() {
-	m.brand = nil
-	m.clearedFields[paymentmethod.FieldBrand] = struct{}{}
-}
-
-// BrandCleared returns if the "brand" field was cleared in this mutation.
-func (m *PaymentMethodMutation) BrandCleared() bool {
-	_, ok := m.clearedFields[paymentmethod.FieldBrand]
-	return ok
+	return oldValue.FinishedAt, nil
 }
 
-// ResetBrand resets all changes to the "brand" field.
-func (m *PaymentMethodMutation) ResetBrand() {
-	m.brand = nil
-	delete(m.clearedFields, paymentmethod.FieldBrand)
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobAttemptMutation) ResetFinishedAt() {
+	m.finished_at = nil
 }
 
-// SetExpMonth sets the "exp_month" field.
-func (m *PaymentMethodMutation) SetExpMonth(i int) {
-	m.exp_month = &i
-	m.addexp_month = nil
+// SetDurationMs sets the "duration_ms" field.
+func (m *JobAttemptMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// ExpMonth returns the value of the "exp_month" field in the mutation.
-func (m *PaymentMethodMutation) ExpMonth() (r int, exists bool) {
-	v := m.exp_month
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *JobAttemptMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpMonth returns the old "exp_month" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldExpMonth(ctx context.Context) (v int, err error) {
+func (m *JobAttemptMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpMonth is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpMonth requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpMonth: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.ExpMonth, nil
+	return oldValue.DurationMs, nil
 }
 
-// AddExpMonth adds i to the "exp_month" field.
-func (m *PaymentMethodMutation) AddExpMonth(i int) {
-	if m.addexp_month != nil {
-		*m.addexp_month += i
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *JobAttemptMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
 	} else {
-		m.addexp_month = &i
+		m.addduration_ms = &i
 	}
 }
 
-// AddedExpMonth returns the value that was added to the "exp_month" field in this mutation.
-func (m *PaymentMethodMutation) AddedExpMonth() (r int, exists bool) {
-	v := m.addexp_month
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *JobAttemptMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearExpMonth clears the value of the "exp_month" field.
-func (m *PaymentMethodMutation) ClearExpMonth() {
-	m.exp_month = nil
-	m.addexp_month = nil
-	m.clearedFields[paymentmethod.FieldExpMonth] = struct{}{}
-}
-
-// ExpMonthCleared returns if the "exp_month" field was cleared in this mutation.
-func (m *PaymentMethodMutation) ExpMonthCleared() bool {
-	_, ok := m.clearedFields[paymentmethod.FieldExpMonth]
-	return ok
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *JobAttemptMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
 }
 
-// ResetExpMonth resets all changes to the "exp_month" field.
-func (m *PaymentMethodMutation) ResetExpMonth() {
-	m.exp_month = nil
-	m.addexp_month = nil
-	delete(m.clearedFields, paymentmethod.FieldExpMonth)
+// SetError sets the "error" field.
+func (m *JobAttemptMutation) SetError(s string) {
+	m.error = &s
 }
 
-// SetExpYear sets the "exp_year" field.
-func (m *PaymentMethodMutation) SetExpYear(i int) {
-	m.exp_year = &i
-	m.addexp_year = nil
-}
-
-// ExpYear returns the value of the "exp_year" field in the mutation.
-func (m *PaymentMethodMutation) ExpYear() (r int, exists bool) {
-	v := m.exp_year
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldExpYear returns the old "exp_year" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldExpYear(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpYear is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpYear requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpYear: %w", err)
-	}
-	return oldValue.ExpYear, nil
-}
-
-// AddExpYear adds i to the "exp_year" field.
-func (m *PaymentMethodMutation) AddExpYear(i int) {
-	if m.addexp_year != nil {
-		*m.addexp_year += i
-	} else {
-		m.addexp_year = &i
-	}
-}
-
-// AddedExpYear returns the value that was added to the "exp_year" field in this mutation.
-func (m *PaymentMethodMutation) AddedExpYear() (r int, exists bool) {
-	v := m.addexp_year
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearExpYear clears the value of the "exp_year" field.
-func (m *PaymentMethodMutation) ClearExpYear() {
-	m.exp_year = nil
-	m.addexp_year = nil
-	m.clearedFields[paymentmethod.FieldExpYear] = struct{}{}
-}
-
-// ExpYearCleared returns if the "exp_year" field was cleared in this mutation.
-func (m *PaymentMethodMutation) ExpYearCleared() bool {
-	_, ok := m.clearedFields[paymentmethod.FieldExpYear]
-	return ok
-}
-
-// ResetExpYear resets all changes to the "exp_year" field.
-func (m *PaymentMethodMutation) ResetExpYear() {
-	m.exp_year = nil
-	m.addexp_year = nil
-	delete(m.clearedFields, paymentmethod.FieldExpYear)
-}
-
-// SetIsDefault sets the "is_default" field.
-func (m *PaymentMethodMutation) SetIsDefault(b bool) {
-	m.is_default = &b
-}
-
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *PaymentMethodMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsDefault returns the old "is_default" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
-	}
-	return oldValue.IsDefault, nil
-}
-
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *PaymentMethodMutation) ResetIsDefault() {
-	m.is_default = nil
-}
-
-// SetMetadata sets the "metadata" field.
-func (m *PaymentMethodMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
-}
-
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *PaymentMethodMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// Error returns the value of the "error" field in the mutation.
+func (m *JobAttemptMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the JobAttempt entity.
+// If the JobAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *JobAttemptMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.Error, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *PaymentMethodMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[paymentmethod.FieldMetadata] = struct{}{}
+// ClearError clears the value of the "error" field.
+func (m *JobAttemptMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[jobattempt.FieldError] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *PaymentMethodMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[paymentmethod.FieldMetadata]
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobAttemptMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[jobattempt.FieldError]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *PaymentMethodMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, paymentmethod.FieldMetadata)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *PaymentMethodMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PaymentMethodMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PaymentMethodMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PaymentMethodMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PaymentMethodMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the PaymentMethod entity.
-// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMethodMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PaymentMethodMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by id.
-func (m *PaymentMethodMutation) SetCustomerID(id int) {
-	m.customer = &id
-}
-
-// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
-func (m *PaymentMethodMutation) ClearCustomer() {
-	m.clearedcustomer = true
+// ResetError resets all changes to the "error" field.
+func (m *JobAttemptMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, jobattempt.FieldError)
 }
 
-// CustomerCleared reports if the "customer" edge to the PaymentCustomer entity was cleared.
-func (m *PaymentMethodMutation) CustomerCleared() bool {
-	return m.clearedcustomer
+// ClearJob clears the "job" edge to the Job entity.
+func (m *JobAttemptMutation) ClearJob() {
+	m.clearedjob = true
+	m.clearedFields[jobattempt.FieldJobID] = struct{}{}
 }
 
-// CustomerID returns the "customer" edge ID in the mutation.
-func (m *PaymentMethodMutation) CustomerID() (id int, exists bool) {
-	if m.customer != nil {
-		return *m.customer, true
-	}
-	return
+// JobCleared reports if the "job" edge to the Job entity was cleared.
+func (m *JobAttemptMutation) JobCleared() bool {
+	return m.clearedjob
 }
 
-// CustomerIDs returns the "customer" edge IDs in the mutation.
+// JobIDs returns the "job" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CustomerID instead. It exists only for internal usage by the builders.
-func (m *PaymentMethodMutation) CustomerIDs() (ids []int) {
-	if id := m.customer; id != nil {
+// JobID instead. It exists only for internal usage by the builders.
+func (m *JobAttemptMutation) JobIDs() (ids []int) {
+	if id := m.job; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCustomer resets all changes to the "customer" edge.
-func (m *PaymentMethodMutation) ResetCustomer() {
-	m.customer = nil
-	m.clearedcustomer = false
+// ResetJob resets all changes to the "job" edge.
+func (m *JobAttemptMutation) ResetJob() {
+	m.job = nil
+	m.clearedjob = false
 }
 
-// Where appends a list predicates to the PaymentMethodMutation builder.
-func (m *PaymentMethodMutation) Where(ps ...predicate.PaymentMethod) {
+// Where appends a list predicates to the JobAttemptMutation builder.
+func (m *JobAttemptMutation) Where(ps ...predicate.JobAttempt) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PaymentMethodMutation builder. Using this method,
+// WhereP appends storage-level predicates to the JobAttemptMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PaymentMethodMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PaymentMethod, len(ps))
+func (m *JobAttemptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobAttempt, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5489,57 +6564,45 @@ func (m *PaymentMethodMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PaymentMethodMutation) Op() Op {
+func (m *JobAttemptMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PaymentMethodMutation) SetOp(op Op) {
+func (m *JobAttemptMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PaymentMethod).
-func (m *PaymentMethodMutation) Type() string {
+// Type returns the node type of this mutation (JobAttempt).
+func (m *JobAttemptMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PaymentMethodMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.provider_payment_method_id != nil {
-		fields = append(fields, paymentmethod.FieldProviderPaymentMethodID)
+func (m *JobAttemptMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.job != nil {
+		fields = append(fields, jobattempt.FieldJobID)
 	}
-	if m.provider != nil {
-		fields = append(fields, paymentmethod.FieldProvider)
-	}
-	if m._type != nil {
-		fields = append(fields, paymentmethod.FieldType)
-	}
-	if m.last_four != nil {
-		fields = append(fields, paymentmethod.FieldLastFour)
+	if m.attempt != nil {
+		fields = append(fields, jobattempt.FieldAttempt)
 	}
-	if m.brand != nil {
-		fields = append(fields, paymentmethod.FieldBrand)
-	}
-	if m.exp_month != nil {
-		fields = append(fields, paymentmethod.FieldExpMonth)
-	}
-	if m.exp_year != nil {
-		fields = append(fields, paymentmethod.FieldExpYear)
+	if m.worker != nil {
+		fields = append(fields, jobattempt.FieldWorker)
 	}
-	if m.is_default != nil {
-		fields = append(fields, paymentmethod.FieldIsDefault)
+	if m.started_at != nil {
+		fields = append(fields, jobattempt.FieldStartedAt)
 	}
-	if m.metadata != nil {
-		fields = append(fields, paymentmethod.FieldMetadata)
+	if m.finished_at != nil {
+		fields = append(fields, jobattempt.FieldFinishedAt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, paymentmethod.FieldCreatedAt)
+	if m.duration_ms != nil {
+		fields = append(fields, jobattempt.FieldDurationMs)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, paymentmethod.FieldUpdatedAt)
+	if m.error != nil {
+		fields = append(fields, jobattempt.FieldError)
 	}
 	return fields
 }
@@ -5547,30 +6610,22 @@ func (m *PaymentMethodMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PaymentMethodMutation) Field(name string) (ent.Value, bool) {
+func (m *JobAttemptMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case paymentmethod.FieldProviderPaymentMethodID:
-		return m.ProviderPaymentMethodID()
-	case paymentmethod.FieldProvider:
-		return m.Provider()
-	case paymentmethod.FieldType:
-		return m.GetType()
-	case paymentmethod.FieldLastFour:
-		return m.LastFour()
-	case paymentmethod.FieldBrand:
-		return m.Brand()
-	case paymentmethod.FieldExpMonth:
-		return m.ExpMonth()
-	case paymentmethod.FieldExpYear:
-		return m.ExpYear()
-	case paymentmethod.FieldIsDefault:
-		return m.IsDefault()
-	case paymentmethod.FieldMetadata:
-		return m.Metadata()
-	case paymentmethod.FieldCreatedAt:
-		return m.CreatedAt()
-	case paymentmethod.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case jobattempt.FieldJobID:
+		return m.JobID()
+	case jobattempt.FieldAttempt:
+		return m.Attempt()
+	case jobattempt.FieldWorker:
+		return m.Worker()
+	case jobattempt.FieldStartedAt:
+		return m.StartedAt()
+	case jobattempt.FieldFinishedAt:
+		return m.FinishedAt()
+	case jobattempt.FieldDurationMs:
+		return m.DurationMs()
+	case jobattempt.FieldError:
+		return m.Error()
 	}
 	return nil, false
 }
@@ -5578,129 +6633,93 @@ func (m *PaymentMethodMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PaymentMethodMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *JobAttemptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case paymentmethod.FieldProviderPaymentMethodID:
-		return m.OldProviderPaymentMethodID(ctx)
-	case paymentmethod.FieldProvider:
-		return m.OldProvider(ctx)
-	case paymentmethod.FieldType:
-		return m.OldType(ctx)
-	case paymentmethod.FieldLastFour:
-		return m.OldLastFour(ctx)
-	case paymentmethod.FieldBrand:
-		return m.OldBrand(ctx)
-	case paymentmethod.FieldExpMonth:
-		return m.OldExpMonth(ctx)
-	case paymentmethod.FieldExpYear:
-		return m.OldExpYear(ctx)
-	case paymentmethod.FieldIsDefault:
-		return m.OldIsDefault(ctx)
-	case paymentmethod.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case paymentmethod.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case paymentmethod.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case jobattempt.FieldJobID:
+		return m.OldJobID(ctx)
+	case jobattempt.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case jobattempt.FieldWorker:
+		return m.OldWorker(ctx)
+	case jobattempt.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case jobattempt.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case jobattempt.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case jobattempt.FieldError:
+		return m.OldError(ctx)
 	}
-	return nil, fmt.Errorf("unknown PaymentMethod field %s", name)
+	return nil, fmt.Errorf("unknown JobAttempt field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentMethodMutation) SetField(name string, value ent.Value) error {
+func (m *JobAttemptMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case paymentmethod.FieldProviderPaymentMethodID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProviderPaymentMethodID(v)
-		return nil
-	case paymentmethod.FieldProvider:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProvider(v)
-		return nil
-	case paymentmethod.FieldType:
-		v, ok := value.(paymentmethod.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case paymentmethod.FieldLastFour:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastFour(v)
-		return nil
-	case paymentmethod.FieldBrand:
-		v, ok := value.(string)
+	case jobattempt.FieldJobID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBrand(v)
+		m.SetJobID(v)
 		return nil
-	case paymentmethod.FieldExpMonth:
+	case jobattempt.FieldAttempt:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpMonth(v)
+		m.SetAttempt(v)
 		return nil
-	case paymentmethod.FieldExpYear:
-		v, ok := value.(int)
+	case jobattempt.FieldWorker:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpYear(v)
+		m.SetWorker(v)
 		return nil
-	case paymentmethod.FieldIsDefault:
-		v, ok := value.(bool)
+	case jobattempt.FieldStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDefault(v)
+		m.SetStartedAt(v)
 		return nil
-	case paymentmethod.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case jobattempt.FieldFinishedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetFinishedAt(v)
 		return nil
-	case paymentmethod.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case jobattempt.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDurationMs(v)
 		return nil
-	case paymentmethod.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case jobattempt.FieldError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentMethod field %s", name)
+	return fmt.Errorf("unknown JobAttempt field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PaymentMethodMutation) AddedFields() []string {
+func (m *JobAttemptMutation) AddedFields() []string {
 	var fields []string
-	if m.addexp_month != nil {
-		fields = append(fields, paymentmethod.FieldExpMonth)
+	if m.addattempt != nil {
+		fields = append(fields, jobattempt.FieldAttempt)
 	}
-	if m.addexp_year != nil {
-		fields = append(fields, paymentmethod.FieldExpYear)
+	if m.addduration_ms != nil {
+		fields = append(fields, jobattempt.FieldDurationMs)
 	}
 	return fields
 }
@@ -5708,12 +6727,12 @@ func (m *PaymentMethodMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PaymentMethodMutation) AddedField(name string) (ent.Value, bool) {
+func (m *JobAttemptMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case paymentmethod.FieldExpMonth:
-		return m.AddedExpMonth()
-	case paymentmethod.FieldExpYear:
-		return m.AddedExpYear()
+	case jobattempt.FieldAttempt:
+		return m.AddedAttempt()
+	case jobattempt.FieldDurationMs:
+		return m.AddedDurationMs()
 	}
 	return nil, false
 }
@@ -5721,134 +6740,98 @@ func (m *PaymentMethodMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentMethodMutation) AddField(name string, value ent.Value) error {
+func (m *JobAttemptMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case paymentmethod.FieldExpMonth:
+	case jobattempt.FieldAttempt:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpMonth(v)
+		m.AddAttempt(v)
 		return nil
-	case paymentmethod.FieldExpYear:
-		v, ok := value.(int)
+	case jobattempt.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpYear(v)
+		m.AddDurationMs(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentMethod numeric field %s", name)
+	return fmt.Errorf("unknown JobAttempt numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PaymentMethodMutation) ClearedFields() []string {
+func (m *JobAttemptMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(paymentmethod.FieldLastFour) {
-		fields = append(fields, paymentmethod.FieldLastFour)
-	}
-	if m.FieldCleared(paymentmethod.FieldBrand) {
-		fields = append(fields, paymentmethod.FieldBrand)
-	}
-	if m.FieldCleared(paymentmethod.FieldExpMonth) {
-		fields = append(fields, paymentmethod.FieldExpMonth)
-	}
-	if m.FieldCleared(paymentmethod.FieldExpYear) {
-		fields = append(fields, paymentmethod.FieldExpYear)
-	}
-	if m.FieldCleared(paymentmethod.FieldMetadata) {
-		fields = append(fields, paymentmethod.FieldMetadata)
+	if m.FieldCleared(jobattempt.FieldError) {
+		fields = append(fields, jobattempt.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PaymentMethodMutation) FieldCleared(name string) bool {
+func (m *JobAttemptMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PaymentMethodMutation) ClearField(name string) error {
+func (m *JobAttemptMutation) ClearField(name string) error {
 	switch name {
-	case paymentmethod.FieldLastFour:
-		m.ClearLastFour()
-		return nil
-	case paymentmethod.FieldBrand:
-		m.ClearBrand()
-		return nil
-	case paymentmethod.FieldExpMonth:
-		m.ClearExpMonth()
-		return nil
-	case paymentmethod.FieldExpYear:
-		m.ClearExpYear()
-		return nil
-	case paymentmethod.FieldMetadata:
-		m.ClearMetadata()
+	case jobattempt.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentMethod nullable field %s", name)
+	return fmt.Errorf("unknown JobAttempt nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PaymentMethodMutation) ResetField(name string) error {
+func (m *JobAttemptMutation) ResetField(name string) error {
 	switch name {
-	case paymentmethod.FieldProviderPaymentMethodID:
-		m.ResetProviderPaymentMethodID()
-		return nil
-	case paymentmethod.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case paymentmethod.FieldType:
-		m.ResetType()
-		return nil
-	case paymentmethod.FieldLastFour:
-		m.ResetLastFour()
-		return nil
-	case paymentmethod.FieldBrand:
-		m.ResetBrand()
+	case jobattempt.FieldJobID:
+		m.ResetJobID()
 		return nil
-	case paymentmethod.FieldExpMonth:
-		m.ResetExpMonth()
+	case jobattempt.FieldAttempt:
+		m.ResetAttempt()
 		return nil
-	case paymentmethod.FieldExpYear:
-		m.ResetExpYear()
+	case jobattempt.FieldWorker:
+		m.ResetWorker()
 		return nil
-	case paymentmethod.FieldIsDefault:
-		m.ResetIsDefault()
+	case jobattempt.FieldStartedAt:
+		m.ResetStartedAt()
 		return nil
-	case paymentmethod.FieldMetadata:
-		m.ResetMetadata()
+	case jobattempt.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
-	case paymentmethod.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case jobattempt.FieldDurationMs:
+		m.ResetDurationMs()
 		return nil
-	case paymentmethod.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case jobattempt.FieldError:
+		m.ResetError()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentMethod field %s", name)
+	return fmt.Errorf("unknown JobAttempt field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PaymentMethodMutation) AddedEdges() []string {
+func (m *JobAttemptMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.customer != nil {
-		edges = append(edges, paymentmethod.EdgeCustomer)
+	if m.job != nil {
+		edges = append(edges, jobattempt.EdgeJob)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PaymentMethodMutation) AddedIDs(name string) []ent.Value {
+func (m *JobAttemptMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case paymentmethod.EdgeCustomer:
-		if id := m.customer; id != nil {
+	case jobattempt.EdgeJob:
+		if id := m.job; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5856,97 +6839,85 @@ func (m *PaymentMethodMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PaymentMethodMutation) RemovedEdges() []string {
+func (m *JobAttemptMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PaymentMethodMutation) RemovedIDs(name string) []ent.Value {
+func (m *JobAttemptMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PaymentMethodMutation) ClearedEdges() []string {
+func (m *JobAttemptMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedcustomer {
-		edges = append(edges, paymentmethod.EdgeCustomer)
+	if m.clearedjob {
+		edges = append(edges, jobattempt.EdgeJob)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PaymentMethodMutation) EdgeCleared(name string) bool {
+func (m *JobAttemptMutation) EdgeCleared(name string) bool {
 	switch name {
-	case paymentmethod.EdgeCustomer:
-		return m.clearedcustomer
+	case jobattempt.EdgeJob:
+		return m.clearedjob
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PaymentMethodMutation) ClearEdge(name string) error {
+func (m *JobAttemptMutation) ClearEdge(name string) error {
 	switch name {
-	case paymentmethod.EdgeCustomer:
-		m.ClearCustomer()
+	case jobattempt.EdgeJob:
+		m.ClearJob()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentMethod unique edge %s", name)
+	return fmt.Errorf("unknown JobAttempt unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PaymentMethodMutation) ResetEdge(name string) error {
+func (m *JobAttemptMutation) ResetEdge(name string) error {
 	switch name {
-	case paymentmethod.EdgeCustomer:
-		m.ResetCustomer()
+	case jobattempt.EdgeJob:
+		m.ResetJob()
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentMethod edge %s", name)
+	return fmt.Errorf("unknown JobAttempt edge %s", name)
 }
 
-// QuestionMutation represents an operation that mutates the Question nodes in the graph.
-type QuestionMutation struct {
+// PasswordTokenMutation represents an operation that mutates the PasswordToken nodes in the graph.
+type PasswordTokenMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	_type          *question.Type
-	title          *string
-	description    *string
-	placeholder    *string
-	required       *bool
-	_order         *int
-	add_order      *int
-	options        *map[string]interface{}
-	validation     *map[string]interface{}
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	form           *int
-	clearedform    bool
-	answers        map[int]struct{}
-	removedanswers map[int]struct{}
-	clearedanswers bool
-	done           bool
-	oldValue       func(context.Context) (*Question, error)
-	predicates     []predicate.Question
+	op            Op
+	typ           string
+	id            *int
+	token         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PasswordToken, error)
+	predicates    []predicate.PasswordToken
 }
 
-var _ ent.Mutation = (*QuestionMutation)(nil)
+var _ ent.Mutation = (*PasswordTokenMutation)(nil)
 
-// questionOption allows management of the mutation configuration using functional options.
-type questionOption func(*QuestionMutation)
+// passwordtokenOption allows management of the mutation configuration using functional options.
+type passwordtokenOption func(*PasswordTokenMutation)
 
-// newQuestionMutation creates new mutation for the Question entity.
-func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
-	m := &QuestionMutation{
+// newPasswordTokenMutation creates new mutation for the PasswordToken entity.
+func newPasswordTokenMutation(c config, op Op, opts ...passwordtokenOption) *PasswordTokenMutation {
+	m := &PasswordTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuestion,
+		typ:           TypePasswordToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5955,20 +6926,20 @@ func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutat
 	return m
 }
 
-// withQuestionID sets the ID field of the mutation.
-func withQuestionID(id int) questionOption {
-	return func(m *QuestionMutation) {
+// withPasswordTokenID sets the ID field of the mutation.
+func withPasswordTokenID(id int) passwordtokenOption {
+	return func(m *PasswordTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Question
+			value *PasswordToken
 		)
-		m.oldValue = func(ctx context.Context) (*Question, error) {
+		m.oldValue = func(ctx context.Context) (*PasswordToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Question.Get(ctx, id)
+					value, err = m.Client().PasswordToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5977,10 +6948,10 @@ func withQuestionID(id int) questionOption {
 	}
 }
 
-// withQuestion sets the old Question of the mutation.
-func withQuestion(node *Question) questionOption {
-	return func(m *QuestionMutation) {
-		m.oldValue = func(context.Context) (*Question, error) {
+// withPasswordToken sets the old PasswordToken of the mutation.
+func withPasswordToken(node *PasswordToken) passwordtokenOption {
+	return func(m *PasswordTokenMutation) {
+		m.oldValue = func(context.Context) (*PasswordToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5989,7 +6960,7 @@ func withQuestion(node *Question) questionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestionMutation) Client() *Client {
+func (m PasswordTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5997,7 +6968,7 @@ func (m QuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuestionMutation) Tx() (*Tx, error) {
+func (m PasswordTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6008,7 +6979,7 @@ func (m QuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuestionMutation) ID() (id int, exists bool) {
+func (m *PasswordTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6019,7 +6990,7 @@ func (m *QuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PasswordTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6028,379 +6999,658 @@ func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PasswordToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *QuestionMutation) SetType(q question.Type) {
-	m._type = &q
+// SetToken sets the "token" field.
+func (m *PasswordTokenMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *QuestionMutation) GetType() (r question.Type, exists bool) {
-	v := m._type
+// Token returns the value of the "token" field in the mutation.
+func (m *PasswordTokenMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the PasswordToken entity.
+// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldType(ctx context.Context) (v question.Type, err error) {
+func (m *PasswordTokenMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Token, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *QuestionMutation) ResetType() {
-	m._type = nil
+// ResetToken resets all changes to the "token" field.
+func (m *PasswordTokenMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *QuestionMutation) SetTitle(s string) {
-	m.title = &s
+// SetUserID sets the "user_id" field.
+func (m *PasswordTokenMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *QuestionMutation) Title() (r string, exists bool) {
-	v := m.title
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordTokenMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the PasswordToken entity.
+// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *PasswordTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.UserID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *QuestionMutation) ResetTitle() {
-	m.title = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordTokenMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *QuestionMutation) SetDescription(s string) {
-	m.description = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *PasswordTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *QuestionMutation) Description() (r string, exists bool) {
-	v := m.description
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasswordTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PasswordToken entity.
+// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *PasswordTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *QuestionMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[question.FieldDescription] = struct{}{}
+	return oldValue.CreatedAt, nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *QuestionMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[question.FieldDescription]
-	return ok
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasswordTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *QuestionMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, question.FieldDescription)
+// ClearUser clears the "user" edge to the User entity.
+func (m *PasswordTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[passwordtoken.FieldUserID] = struct{}{}
 }
 
-// SetPlaceholder sets the "placeholder" field.
-func (m *QuestionMutation) SetPlaceholder(s string) {
-	m.placeholder = &s
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PasswordTokenMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// Placeholder returns the value of the "placeholder" field in the mutation.
-func (m *QuestionMutation) Placeholder() (r string, exists bool) {
-	v := m.placeholder
-	if v == nil {
-		return
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PasswordTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldPlaceholder returns the old "placeholder" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldPlaceholder(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaceholder is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaceholder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaceholder: %w", err)
-	}
-	return oldValue.Placeholder, nil
+// ResetUser resets all changes to the "user" edge.
+func (m *PasswordTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// ClearPlaceholder clears the value of the "placeholder" field.
-func (m *QuestionMutation) ClearPlaceholder() {
-	m.placeholder = nil
-	m.clearedFields[question.FieldPlaceholder] = struct{}{}
+// Where appends a list predicates to the PasswordTokenMutation builder.
+func (m *PasswordTokenMutation) Where(ps ...predicate.PasswordToken) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// PlaceholderCleared returns if the "placeholder" field was cleared in this mutation.
-func (m *QuestionMutation) PlaceholderCleared() bool {
-	_, ok := m.clearedFields[question.FieldPlaceholder]
-	return ok
+// WhereP appends storage-level predicates to the PasswordTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasswordTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PasswordToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetPlaceholder resets all changes to the "placeholder" field.
-func (m *QuestionMutation) ResetPlaceholder() {
-	m.placeholder = nil
-	delete(m.clearedFields, question.FieldPlaceholder)
+// Op returns the operation name.
+func (m *PasswordTokenMutation) Op() Op {
+	return m.op
 }
 
-// SetRequired sets the "required" field.
-func (m *QuestionMutation) SetRequired(b bool) {
-	m.required = &b
+// SetOp allows setting the mutation operation.
+func (m *PasswordTokenMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Required returns the value of the "required" field in the mutation.
-func (m *QuestionMutation) Required() (r bool, exists bool) {
-	v := m.required
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (PasswordToken).
+func (m *PasswordTokenMutation) Type() string {
+	return m.typ
 }
 
-// OldRequired returns the old "required" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldRequired(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequired is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordTokenMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.token != nil {
+		fields = append(fields, passwordtoken.FieldToken)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequired requires an ID field in the mutation")
+	if m.user != nil {
+		fields = append(fields, passwordtoken.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequired: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, passwordtoken.FieldCreatedAt)
 	}
-	return oldValue.Required, nil
+	return fields
 }
 
-// ResetRequired resets all changes to the "required" field.
-func (m *QuestionMutation) ResetRequired() {
-	m.required = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordtoken.FieldToken:
+		return m.Token()
+	case passwordtoken.FieldUserID:
+		return m.UserID()
+	case passwordtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// SetOrder sets the "order" field.
-func (m *QuestionMutation) SetOrder(i int) {
-	m._order = &i
-	m.add_order = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordtoken.FieldToken:
+		return m.OldToken(ctx)
+	case passwordtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case passwordtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordToken field %s", name)
 }
 
-// Order returns the value of the "order" field in the mutation.
-func (m *QuestionMutation) Order() (r int, exists bool) {
-	v := m._order
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordtoken.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case passwordtoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passwordtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PasswordToken field %s", name)
 }
 
-// OldOrder returns the old "order" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldOrder(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrder is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrder: %w", err)
-	}
-	return oldValue.Order, nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordTokenMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
-// AddOrder adds i to the "order" field.
-func (m *QuestionMutation) AddOrder(i int) {
-	if m.add_order != nil {
-		*m.add_order += i
-	} else {
-		m.add_order = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
 	}
+	return nil, false
 }
 
-// AddedOrder returns the value that was added to the "order" field in this mutation.
-func (m *QuestionMutation) AddedOrder() (r int, exists bool) {
-	v := m.add_order
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown PasswordToken numeric field %s", name)
 }
 
-// ResetOrder resets all changes to the "order" field.
-func (m *QuestionMutation) ResetOrder() {
-	m._order = nil
-	m.add_order = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordTokenMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetOptions sets the "options" field.
-func (m *QuestionMutation) SetOptions(value map[string]interface{}) {
-	m.options = &value
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Options returns the value of the "options" field in the mutation.
-func (m *QuestionMutation) Options() (r map[string]interface{}, exists bool) {
-	v := m.options
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PasswordToken nullable field %s", name)
 }
 
-// OldOptions returns the old "options" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldOptions(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptions requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordTokenMutation) ResetField(name string) error {
+	switch name {
+	case passwordtoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case passwordtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passwordtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return oldValue.Options, nil
+	return fmt.Errorf("unknown PasswordToken field %s", name)
 }
 
-// ClearOptions clears the value of the "options" field.
-func (m *QuestionMutation) ClearOptions() {
-	m.options = nil
-	m.clearedFields[question.FieldOptions] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, passwordtoken.EdgeUser)
+	}
+	return edges
 }
 
-// OptionsCleared returns if the "options" field was cleared in this mutation.
-func (m *QuestionMutation) OptionsCleared() bool {
-	_, ok := m.clearedFields[question.FieldOptions]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case passwordtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// ResetOptions resets all changes to the "options" field.
-func (m *QuestionMutation) ResetOptions() {
-	m.options = nil
-	delete(m.clearedFields, question.FieldOptions)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetValidation sets the "validation" field.
-func (m *QuestionMutation) SetValidation(value map[string]interface{}) {
-	m.validation = &value
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Validation returns the value of the "validation" field in the mutation.
-func (m *QuestionMutation) Validation() (r map[string]interface{}, exists bool) {
-	v := m.validation
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, passwordtoken.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldValidation returns the old "validation" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldValidation(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValidation is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValidation requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValidation: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case passwordtoken.EdgeUser:
+		return m.cleareduser
 	}
-	return oldValue.Validation, nil
+	return false
 }
 
-// ClearValidation clears the value of the "validation" field.
-func (m *QuestionMutation) ClearValidation() {
-	m.validation = nil
-	m.clearedFields[question.FieldValidation] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case passwordtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordToken unique edge %s", name)
 }
 
-// ValidationCleared returns if the "validation" field was cleared in this mutation.
-func (m *QuestionMutation) ValidationCleared() bool {
-	_, ok := m.clearedFields[question.FieldValidation]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case passwordtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordToken edge %s", name)
 }
 
-// ResetValidation resets all changes to the "validation" field.
-func (m *QuestionMutation) ResetValidation() {
-	m.validation = nil
-	delete(m.clearedFields, question.FieldValidation)
+// PaymentAccountMutation represents an operation that mutates the PaymentAccount nodes in the graph.
+type PaymentAccountMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	provider_account_id *string
+	provider            *string
+	charges_enabled     *bool
+	details_submitted   *bool
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*PaymentAccount, error)
+	predicates          []predicate.PaymentAccount
+}
+
+var _ ent.Mutation = (*PaymentAccountMutation)(nil)
+
+// paymentaccountOption allows management of the mutation configuration using functional options.
+type paymentaccountOption func(*PaymentAccountMutation)
+
+// newPaymentAccountMutation creates new mutation for the PaymentAccount entity.
+func newPaymentAccountMutation(c config, op Op, opts ...paymentaccountOption) *PaymentAccountMutation {
+	m := &PaymentAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePaymentAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaymentAccountID sets the ID field of the mutation.
+func withPaymentAccountID(id int) paymentaccountOption {
+	return func(m *PaymentAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PaymentAccount
+		)
+		m.oldValue = func(ctx context.Context) (*PaymentAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PaymentAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPaymentAccount sets the old PaymentAccount of the mutation.
+func withPaymentAccount(node *PaymentAccount) paymentaccountOption {
+	return func(m *PaymentAccountMutation) {
+		m.oldValue = func(context.Context) (*PaymentAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaymentAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaymentAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaymentAccountMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaymentAccountMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PaymentAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (m *PaymentAccountMutation) SetProviderAccountID(s string) {
+	m.provider_account_id = &s
+}
+
+// ProviderAccountID returns the value of the "provider_account_id" field in the mutation.
+func (m *PaymentAccountMutation) ProviderAccountID() (r string, exists bool) {
+	v := m.provider_account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderAccountID returns the old "provider_account_id" field's value of the PaymentAccount entity.
+// If the PaymentAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentAccountMutation) OldProviderAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderAccountID: %w", err)
+	}
+	return oldValue.ProviderAccountID, nil
+}
+
+// ResetProviderAccountID resets all changes to the "provider_account_id" field.
+func (m *PaymentAccountMutation) ResetProviderAccountID() {
+	m.provider_account_id = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *PaymentAccountMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *PaymentAccountMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the PaymentAccount entity.
+// If the PaymentAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentAccountMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *PaymentAccountMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetChargesEnabled sets the "charges_enabled" field.
+func (m *PaymentAccountMutation) SetChargesEnabled(b bool) {
+	m.charges_enabled = &b
+}
+
+// ChargesEnabled returns the value of the "charges_enabled" field in the mutation.
+func (m *PaymentAccountMutation) ChargesEnabled() (r bool, exists bool) {
+	v := m.charges_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargesEnabled returns the old "charges_enabled" field's value of the PaymentAccount entity.
+// If the PaymentAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentAccountMutation) OldChargesEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargesEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargesEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargesEnabled: %w", err)
+	}
+	return oldValue.ChargesEnabled, nil
+}
+
+// ResetChargesEnabled resets all changes to the "charges_enabled" field.
+func (m *PaymentAccountMutation) ResetChargesEnabled() {
+	m.charges_enabled = nil
+}
+
+// SetDetailsSubmitted sets the "details_submitted" field.
+func (m *PaymentAccountMutation) SetDetailsSubmitted(b bool) {
+	m.details_submitted = &b
+}
+
+// DetailsSubmitted returns the value of the "details_submitted" field in the mutation.
+func (m *PaymentAccountMutation) DetailsSubmitted() (r bool, exists bool) {
+	v := m.details_submitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetailsSubmitted returns the old "details_submitted" field's value of the PaymentAccount entity.
+// If the PaymentAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentAccountMutation) OldDetailsSubmitted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetailsSubmitted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetailsSubmitted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetailsSubmitted: %w", err)
+	}
+	return oldValue.DetailsSubmitted, nil
+}
+
+// ResetDetailsSubmitted resets all changes to the "details_submitted" field.
+func (m *PaymentAccountMutation) ResetDetailsSubmitted() {
+	m.details_submitted = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *QuestionMutation) SetCreatedAt(t time.Time) {
+func (m *PaymentAccountMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QuestionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PaymentAccountMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6408,10 +7658,10 @@ func (m *QuestionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PaymentAccount entity.
+// If the PaymentAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PaymentAccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6426,17 +7676,17 @@ func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QuestionMutation) ResetCreatedAt() {
+func (m *PaymentAccountMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *QuestionMutation) SetUpdatedAt(t time.Time) {
+func (m *PaymentAccountMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *QuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *PaymentAccountMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6444,10 +7694,10 @@ func (m *QuestionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the PaymentAccount entity.
+// If the PaymentAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PaymentAccountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6462,167 +7712,101 @@ func (m *QuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *QuestionMutation) ResetUpdatedAt() {
+func (m *PaymentAccountMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetFormID sets the "form" edge to the Form entity by id.
-func (m *QuestionMutation) SetFormID(id int) {
-	m.form = &id
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PaymentAccountMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ClearForm clears the "form" edge to the Form entity.
-func (m *QuestionMutation) ClearForm() {
-	m.clearedform = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *PaymentAccountMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// FormCleared reports if the "form" edge to the Form entity was cleared.
-func (m *QuestionMutation) FormCleared() bool {
-	return m.clearedform
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PaymentAccountMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// FormID returns the "form" edge ID in the mutation.
-func (m *QuestionMutation) FormID() (id int, exists bool) {
-	if m.form != nil {
-		return *m.form, true
+// UserID returns the "user" edge ID in the mutation.
+func (m *PaymentAccountMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// FormIDs returns the "form" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FormID instead. It exists only for internal usage by the builders.
-func (m *QuestionMutation) FormIDs() (ids []int) {
-	if id := m.form; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PaymentAccountMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetForm resets all changes to the "form" edge.
-func (m *QuestionMutation) ResetForm() {
-	m.form = nil
-	m.clearedform = false
+// ResetUser resets all changes to the "user" edge.
+func (m *PaymentAccountMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
-func (m *QuestionMutation) AddAnswerIDs(ids ...int) {
-	if m.answers == nil {
-		m.answers = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.answers[ids[i]] = struct{}{}
+// Where appends a list predicates to the PaymentAccountMutation builder.
+func (m *PaymentAccountMutation) Where(ps ...predicate.PaymentAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaymentAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaymentAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaymentAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-}
-
-// ClearAnswers clears the "answers" edge to the Answer entity.
-func (m *QuestionMutation) ClearAnswers() {
-	m.clearedanswers = true
-}
-
-// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
-func (m *QuestionMutation) AnswersCleared() bool {
-	return m.clearedanswers
-}
-
-// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
-func (m *QuestionMutation) RemoveAnswerIDs(ids ...int) {
-	if m.removedanswers == nil {
-		m.removedanswers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.answers, ids[i])
-		m.removedanswers[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
-func (m *QuestionMutation) RemovedAnswersIDs() (ids []int) {
-	for id := range m.removedanswers {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// AnswersIDs returns the "answers" edge IDs in the mutation.
-func (m *QuestionMutation) AnswersIDs() (ids []int) {
-	for id := range m.answers {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetAnswers resets all changes to the "answers" edge.
-func (m *QuestionMutation) ResetAnswers() {
-	m.answers = nil
-	m.clearedanswers = false
-	m.removedanswers = nil
-}
-
-// Where appends a list predicates to the QuestionMutation builder.
-func (m *QuestionMutation) Where(ps ...predicate.Question) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Question, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *QuestionMutation) Op() Op {
+func (m *PaymentAccountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestionMutation) SetOp(op Op) {
+func (m *PaymentAccountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Question).
-func (m *QuestionMutation) Type() string {
+// Type returns the node type of this mutation (PaymentAccount).
+func (m *PaymentAccountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestionMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m._type != nil {
-		fields = append(fields, question.FieldType)
-	}
-	if m.title != nil {
-		fields = append(fields, question.FieldTitle)
-	}
-	if m.description != nil {
-		fields = append(fields, question.FieldDescription)
-	}
-	if m.placeholder != nil {
-		fields = append(fields, question.FieldPlaceholder)
-	}
-	if m.required != nil {
-		fields = append(fields, question.FieldRequired)
+func (m *PaymentAccountMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.provider_account_id != nil {
+		fields = append(fields, paymentaccount.FieldProviderAccountID)
 	}
-	if m._order != nil {
-		fields = append(fields, question.FieldOrder)
+	if m.provider != nil {
+		fields = append(fields, paymentaccount.FieldProvider)
 	}
-	if m.options != nil {
-		fields = append(fields, question.FieldOptions)
+	if m.charges_enabled != nil {
+		fields = append(fields, paymentaccount.FieldChargesEnabled)
 	}
-	if m.validation != nil {
-		fields = append(fields, question.FieldValidation)
+	if m.details_submitted != nil {
+		fields = append(fields, paymentaccount.FieldDetailsSubmitted)
 	}
 	if m.created_at != nil {
-		fields = append(fields, question.FieldCreatedAt)
+		fields = append(fields, paymentaccount.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, question.FieldUpdatedAt)
+		fields = append(fields, paymentaccount.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6630,27 +7814,19 @@ func (m *QuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *PaymentAccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case question.FieldType:
-		return m.GetType()
-	case question.FieldTitle:
-		return m.Title()
-	case question.FieldDescription:
-		return m.Description()
-	case question.FieldPlaceholder:
-		return m.Placeholder()
-	case question.FieldRequired:
-		return m.Required()
-	case question.FieldOrder:
-		return m.Order()
-	case question.FieldOptions:
-		return m.Options()
-	case question.FieldValidation:
-		return m.Validation()
-	case question.FieldCreatedAt:
+	case paymentaccount.FieldProviderAccountID:
+		return m.ProviderAccountID()
+	case paymentaccount.FieldProvider:
+		return m.Provider()
+	case paymentaccount.FieldChargesEnabled:
+		return m.ChargesEnabled()
+	case paymentaccount.FieldDetailsSubmitted:
+		return m.DetailsSubmitted()
+	case paymentaccount.FieldCreatedAt:
 		return m.CreatedAt()
-	case question.FieldUpdatedAt:
+	case paymentaccount.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -6659,101 +7835,65 @@ func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PaymentAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case question.FieldType:
-		return m.OldType(ctx)
-	case question.FieldTitle:
-		return m.OldTitle(ctx)
-	case question.FieldDescription:
-		return m.OldDescription(ctx)
-	case question.FieldPlaceholder:
-		return m.OldPlaceholder(ctx)
-	case question.FieldRequired:
-		return m.OldRequired(ctx)
-	case question.FieldOrder:
-		return m.OldOrder(ctx)
-	case question.FieldOptions:
-		return m.OldOptions(ctx)
-	case question.FieldValidation:
-		return m.OldValidation(ctx)
-	case question.FieldCreatedAt:
+	case paymentaccount.FieldProviderAccountID:
+		return m.OldProviderAccountID(ctx)
+	case paymentaccount.FieldProvider:
+		return m.OldProvider(ctx)
+	case paymentaccount.FieldChargesEnabled:
+		return m.OldChargesEnabled(ctx)
+	case paymentaccount.FieldDetailsSubmitted:
+		return m.OldDetailsSubmitted(ctx)
+	case paymentaccount.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case question.FieldUpdatedAt:
+	case paymentaccount.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Question field %s", name)
+	return nil, fmt.Errorf("unknown PaymentAccount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+func (m *PaymentAccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case question.FieldType:
-		v, ok := value.(question.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case question.FieldTitle:
+	case paymentaccount.FieldProviderAccountID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
-		return nil
-	case question.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
+		m.SetProviderAccountID(v)
 		return nil
-	case question.FieldPlaceholder:
+	case paymentaccount.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlaceholder(v)
+		m.SetProvider(v)
 		return nil
-	case question.FieldRequired:
+	case paymentaccount.FieldChargesEnabled:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRequired(v)
-		return nil
-	case question.FieldOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrder(v)
-		return nil
-	case question.FieldOptions:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOptions(v)
+		m.SetChargesEnabled(v)
 		return nil
-	case question.FieldValidation:
-		v, ok := value.(map[string]interface{})
+	case paymentaccount.FieldDetailsSubmitted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValidation(v)
+		m.SetDetailsSubmitted(v)
 		return nil
-	case question.FieldCreatedAt:
+	case paymentaccount.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case question.FieldUpdatedAt:
+	case paymentaccount.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -6761,266 +7901,194 @@ func (m *QuestionMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Question field %s", name)
+	return fmt.Errorf("unknown PaymentAccount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestionMutation) AddedFields() []string {
-	var fields []string
-	if m.add_order != nil {
-		fields = append(fields, question.FieldOrder)
-	}
-	return fields
+func (m *PaymentAccountMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case question.FieldOrder:
-		return m.AddedOrder()
-	}
+func (m *PaymentAccountMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+func (m *PaymentAccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case question.FieldOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOrder(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Question numeric field %s", name)
+	return fmt.Errorf("unknown PaymentAccount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(question.FieldDescription) {
-		fields = append(fields, question.FieldDescription)
-	}
-	if m.FieldCleared(question.FieldPlaceholder) {
-		fields = append(fields, question.FieldPlaceholder)
-	}
-	if m.FieldCleared(question.FieldOptions) {
-		fields = append(fields, question.FieldOptions)
-	}
-	if m.FieldCleared(question.FieldValidation) {
-		fields = append(fields, question.FieldValidation)
-	}
-	return fields
+func (m *PaymentAccountMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuestionMutation) FieldCleared(name string) bool {
+func (m *PaymentAccountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestionMutation) ClearField(name string) error {
-	switch name {
-	case question.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case question.FieldPlaceholder:
-		m.ClearPlaceholder()
-		return nil
-	case question.FieldOptions:
-		m.ClearOptions()
-		return nil
-	case question.FieldValidation:
-		m.ClearValidation()
-		return nil
-	}
-	return fmt.Errorf("unknown Question nullable field %s", name)
+func (m *PaymentAccountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PaymentAccount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestionMutation) ResetField(name string) error {
+func (m *PaymentAccountMutation) ResetField(name string) error {
 	switch name {
-	case question.FieldType:
-		m.ResetType()
-		return nil
-	case question.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case question.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case question.FieldPlaceholder:
-		m.ResetPlaceholder()
+	case paymentaccount.FieldProviderAccountID:
+		m.ResetProviderAccountID()
 		return nil
-	case question.FieldRequired:
-		m.ResetRequired()
-		return nil
-	case question.FieldOrder:
-		m.ResetOrder()
+	case paymentaccount.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case question.FieldOptions:
-		m.ResetOptions()
+	case paymentaccount.FieldChargesEnabled:
+		m.ResetChargesEnabled()
 		return nil
-	case question.FieldValidation:
-		m.ResetValidation()
+	case paymentaccount.FieldDetailsSubmitted:
+		m.ResetDetailsSubmitted()
 		return nil
-	case question.FieldCreatedAt:
+	case paymentaccount.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case question.FieldUpdatedAt:
+	case paymentaccount.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Question field %s", name)
+	return fmt.Errorf("unknown PaymentAccount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.form != nil {
-		edges = append(edges, question.EdgeForm)
-	}
-	if m.answers != nil {
-		edges = append(edges, question.EdgeAnswers)
+func (m *PaymentAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, paymentaccount.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+func (m *PaymentAccountMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case question.EdgeForm:
-		if id := m.form; id != nil {
+	case paymentaccount.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case question.EdgeAnswers:
-		ids := make([]ent.Value, 0, len(m.answers))
-		for id := range m.answers {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedanswers != nil {
-		edges = append(edges, question.EdgeAnswers)
-	}
+func (m *PaymentAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case question.EdgeAnswers:
-		ids := make([]ent.Value, 0, len(m.removedanswers))
-		for id := range m.removedanswers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PaymentAccountMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedform {
-		edges = append(edges, question.EdgeForm)
-	}
-	if m.clearedanswers {
-		edges = append(edges, question.EdgeAnswers)
+func (m *PaymentAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, paymentaccount.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuestionMutation) EdgeCleared(name string) bool {
+func (m *PaymentAccountMutation) EdgeCleared(name string) bool {
 	switch name {
-	case question.EdgeForm:
-		return m.clearedform
-	case question.EdgeAnswers:
-		return m.clearedanswers
+	case paymentaccount.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuestionMutation) ClearEdge(name string) error {
+func (m *PaymentAccountMutation) ClearEdge(name string) error {
 	switch name {
-	case question.EdgeForm:
-		m.ClearForm()
+	case paymentaccount.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Question unique edge %s", name)
+	return fmt.Errorf("unknown PaymentAccount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuestionMutation) ResetEdge(name string) error {
+func (m *PaymentAccountMutation) ResetEdge(name string) error {
 	switch name {
-	case question.EdgeForm:
-		m.ResetForm()
-		return nil
-	case question.EdgeAnswers:
-		m.ResetAnswers()
+	case paymentaccount.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Question edge %s", name)
+	return fmt.Errorf("unknown PaymentAccount edge %s", name)
 }
 
-// ResponseMutation represents an operation that mutates the Response nodes in the graph.
-type ResponseMutation struct {
+// PaymentCustomerMutation represents an operation that mutates the PaymentCustomer nodes in the graph.
+type PaymentCustomerMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	submitted_at   *time.Time
-	completed      *bool
-	_IPAddress     *string
-	_UserAgent     *string
-	clearedFields  map[string]struct{}
-	form           *int
-	clearedform    bool
-	user           *int
-	cleareduser    bool
-	answers        map[int]struct{}
-	removedanswers map[int]struct{}
-	clearedanswers bool
-	done           bool
-	oldValue       func(context.Context) (*Response, error)
-	predicates     []predicate.Response
+	op                     Op
+	typ                    string
+	id                     *int
+	provider_customer_id   *string
+	provider               *string
+	email                  *string
+	name                   *string
+	metadata               *map[string]interface{}
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	user                   *int
+	cleareduser            bool
+	payment_intents        map[int]struct{}
+	removedpayment_intents map[int]struct{}
+	clearedpayment_intents bool
+	subscriptions          map[int]struct{}
+	removedsubscriptions   map[int]struct{}
+	clearedsubscriptions   bool
+	payment_methods        map[int]struct{}
+	removedpayment_methods map[int]struct{}
+	clearedpayment_methods bool
+	invoices               map[int]struct{}
+	removedinvoices        map[int]struct{}
+	clearedinvoices        bool
+	done                   bool
+	oldValue               func(context.Context) (*PaymentCustomer, error)
+	predicates             []predicate.PaymentCustomer
 }
 
-var _ ent.Mutation = (*ResponseMutation)(nil)
+var _ ent.Mutation = (*PaymentCustomerMutation)(nil)
 
-// responseOption allows management of the mutation configuration using functional options.
-type responseOption func(*ResponseMutation)
+// paymentcustomerOption allows management of the mutation configuration using functional options.
+type paymentcustomerOption func(*PaymentCustomerMutation)
 
-// newResponseMutation creates new mutation for the Response entity.
-func newResponseMutation(c config, op Op, opts ...responseOption) *ResponseMutation {
-	m := &ResponseMutation{
+// newPaymentCustomerMutation creates new mutation for the PaymentCustomer entity.
+func newPaymentCustomerMutation(c config, op Op, opts ...paymentcustomerOption) *PaymentCustomerMutation {
+	m := &PaymentCustomerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeResponse,
+		typ:           TypePaymentCustomer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7029,20 +8097,20 @@ func newResponseMutation(c config, op Op, opts ...responseOption) *ResponseMutat
 	return m
 }
 
-// withResponseID sets the ID field of the mutation.
-func withResponseID(id int) responseOption {
-	return func(m *ResponseMutation) {
+// withPaymentCustomerID sets the ID field of the mutation.
+func withPaymentCustomerID(id int) paymentcustomerOption {
+	return func(m *PaymentCustomerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Response
+			value *PaymentCustomer
 		)
-		m.oldValue = func(ctx context.Context) (*Response, error) {
+		m.oldValue = func(ctx context.Context) (*PaymentCustomer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Response.Get(ctx, id)
+					value, err = m.Client().PaymentCustomer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7051,10 +8119,10 @@ func withResponseID(id int) responseOption {
 	}
 }
 
-// withResponse sets the old Response of the mutation.
-func withResponse(node *Response) responseOption {
-	return func(m *ResponseMutation) {
-		m.oldValue = func(context.Context) (*Response, error) {
+// withPaymentCustomer sets the old PaymentCustomer of the mutation.
+func withPaymentCustomer(node *PaymentCustomer) paymentcustomerOption {
+	return func(m *PaymentCustomerMutation) {
+		m.oldValue = func(context.Context) (*PaymentCustomer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7063,7 +8131,7 @@ func withResponse(node *Response) responseOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ResponseMutation) Client() *Client {
+func (m PaymentCustomerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7071,7 +8139,7 @@ func (m ResponseMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ResponseMutation) Tx() (*Tx, error) {
+func (m PaymentCustomerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7082,7 +8150,7 @@ func (m ResponseMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ResponseMutation) ID() (id int, exists bool) {
+func (m *PaymentCustomerMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7093,7 +8161,7 @@ func (m *ResponseMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ResponseMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PaymentCustomerMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7102,238 +8170,307 @@ func (m *ResponseMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Response.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PaymentCustomer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSubmittedAt sets the "submitted_at" field.
-func (m *ResponseMutation) SetSubmittedAt(t time.Time) {
-	m.submitted_at = &t
+// SetProviderCustomerID sets the "provider_customer_id" field.
+func (m *PaymentCustomerMutation) SetProviderCustomerID(s string) {
+	m.provider_customer_id = &s
 }
 
-// SubmittedAt returns the value of the "submitted_at" field in the mutation.
-func (m *ResponseMutation) SubmittedAt() (r time.Time, exists bool) {
-	v := m.submitted_at
+// ProviderCustomerID returns the value of the "provider_customer_id" field in the mutation.
+func (m *PaymentCustomerMutation) ProviderCustomerID() (r string, exists bool) {
+	v := m.provider_customer_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubmittedAt returns the old "submitted_at" field's value of the Response entity.
-// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// OldProviderCustomerID returns the old "provider_customer_id" field's value of the PaymentCustomer entity.
+// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResponseMutation) OldSubmittedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PaymentCustomerMutation) OldProviderCustomerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderCustomerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+		return v, errors.New("OldProviderCustomerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderCustomerID: %w", err)
 	}
-	return oldValue.SubmittedAt, nil
+	return oldValue.ProviderCustomerID, nil
 }
 
-// ResetSubmittedAt resets all changes to the "submitted_at" field.
-func (m *ResponseMutation) ResetSubmittedAt() {
-	m.submitted_at = nil
+// ResetProviderCustomerID resets all changes to the "provider_customer_id" field.
+func (m *PaymentCustomerMutation) ResetProviderCustomerID() {
+	m.provider_customer_id = nil
 }
 
-// SetCompleted sets the "completed" field.
-func (m *ResponseMutation) SetCompleted(b bool) {
-	m.completed = &b
+// SetProvider sets the "provider" field.
+func (m *PaymentCustomerMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// Completed returns the value of the "completed" field in the mutation.
-func (m *ResponseMutation) Completed() (r bool, exists bool) {
-	v := m.completed
+// Provider returns the value of the "provider" field in the mutation.
+func (m *PaymentCustomerMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompleted returns the old "completed" field's value of the Response entity.
-// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the PaymentCustomer entity.
+// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResponseMutation) OldCompleted(ctx context.Context) (v bool, err error) {
+func (m *PaymentCustomerMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompleted is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompleted requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompleted: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.Completed, nil
+	return oldValue.Provider, nil
 }
 
-// ResetCompleted resets all changes to the "completed" field.
-func (m *ResponseMutation) ResetCompleted() {
-	m.completed = nil
+// ResetProvider resets all changes to the "provider" field.
+func (m *PaymentCustomerMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetIPAddress sets the "IPAddress" field.
-func (m *ResponseMutation) SetIPAddress(s string) {
-	m._IPAddress = &s
+// SetEmail sets the "email" field.
+func (m *PaymentCustomerMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// IPAddress returns the value of the "IPAddress" field in the mutation.
-func (m *ResponseMutation) IPAddress() (r string, exists bool) {
-	v := m._IPAddress
+// Email returns the value of the "email" field in the mutation.
+func (m *PaymentCustomerMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddress returns the old "IPAddress" field's value of the Response entity.
-// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the PaymentCustomer entity.
+// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResponseMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+func (m *PaymentCustomerMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.IPAddress, nil
+	return oldValue.Email, nil
 }
 
-// ClearIPAddress clears the value of the "IPAddress" field.
-func (m *ResponseMutation) ClearIPAddress() {
-	m._IPAddress = nil
-	m.clearedFields[response.FieldIPAddress] = struct{}{}
+// ResetEmail resets all changes to the "email" field.
+func (m *PaymentCustomerMutation) ResetEmail() {
+	m.email = nil
 }
 
-// IPAddressCleared returns if the "IPAddress" field was cleared in this mutation.
-func (m *ResponseMutation) IPAddressCleared() bool {
-	_, ok := m.clearedFields[response.FieldIPAddress]
-	return ok
+// SetName sets the "name" field.
+func (m *PaymentCustomerMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetIPAddress resets all changes to the "IPAddress" field.
-func (m *ResponseMutation) ResetIPAddress() {
-	m._IPAddress = nil
-	delete(m.clearedFields, response.FieldIPAddress)
+// Name returns the value of the "name" field in the mutation.
+func (m *PaymentCustomerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetUserAgent sets the "UserAgent" field.
-func (m *ResponseMutation) SetUserAgent(s string) {
-	m._UserAgent = &s
+// OldName returns the old "name" field's value of the PaymentCustomer entity.
+// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentCustomerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// UserAgent returns the value of the "UserAgent" field in the mutation.
-func (m *ResponseMutation) UserAgent() (r string, exists bool) {
-	v := m._UserAgent
+// ClearName clears the value of the "name" field.
+func (m *PaymentCustomerMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[paymentcustomer.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *PaymentCustomerMutation) NameCleared() bool {
+	_, ok := m.clearedFields[paymentcustomer.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PaymentCustomerMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, paymentcustomer.FieldName)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *PaymentCustomerMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *PaymentCustomerMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserAgent returns the old "UserAgent" field's value of the Response entity.
-// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the PaymentCustomer entity.
+// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResponseMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+func (m *PaymentCustomerMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.UserAgent, nil
+	return oldValue.Metadata, nil
 }
 
-// ClearUserAgent clears the value of the "UserAgent" field.
-func (m *ResponseMutation) ClearUserAgent() {
-	m._UserAgent = nil
-	m.clearedFields[response.FieldUserAgent] = struct{}{}
+// ClearMetadata clears the value of the "metadata" field.
+func (m *PaymentCustomerMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[paymentcustomer.FieldMetadata] = struct{}{}
 }
 
-// UserAgentCleared returns if the "UserAgent" field was cleared in this mutation.
-func (m *ResponseMutation) UserAgentCleared() bool {
-	_, ok := m.clearedFields[response.FieldUserAgent]
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *PaymentCustomerMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[paymentcustomer.FieldMetadata]
 	return ok
 }
 
-// ResetUserAgent resets all changes to the "UserAgent" field.
-func (m *ResponseMutation) ResetUserAgent() {
-	m._UserAgent = nil
-	delete(m.clearedFields, response.FieldUserAgent)
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *PaymentCustomerMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, paymentcustomer.FieldMetadata)
 }
 
-// SetFormID sets the "form" edge to the Form entity by id.
-func (m *ResponseMutation) SetFormID(id int) {
-	m.form = &id
+// SetCreatedAt sets the "created_at" field.
+func (m *PaymentCustomerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ClearForm clears the "form" edge to the Form entity.
-func (m *ResponseMutation) ClearForm() {
-	m.clearedform = true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PaymentCustomerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FormCleared reports if the "form" edge to the Form entity was cleared.
-func (m *ResponseMutation) FormCleared() bool {
-	return m.clearedform
+// OldCreatedAt returns the old "created_at" field's value of the PaymentCustomer entity.
+// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentCustomerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// FormID returns the "form" edge ID in the mutation.
-func (m *ResponseMutation) FormID() (id int, exists bool) {
-	if m.form != nil {
-		return *m.form, true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PaymentCustomerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PaymentCustomerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PaymentCustomerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// FormIDs returns the "form" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FormID instead. It exists only for internal usage by the builders.
-func (m *ResponseMutation) FormIDs() (ids []int) {
-	if id := m.form; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the PaymentCustomer entity.
+// If the PaymentCustomer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentCustomerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetForm resets all changes to the "form" edge.
-func (m *ResponseMutation) ResetForm() {
-	m.form = nil
-	m.clearedform = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PaymentCustomerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *ResponseMutation) SetUserID(id int) {
+func (m *PaymentCustomerMutation) SetUserID(id int) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *ResponseMutation) ClearUser() {
+func (m *PaymentCustomerMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ResponseMutation) UserCleared() bool {
+func (m *PaymentCustomerMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *ResponseMutation) UserID() (id int, exists bool) {
+func (m *PaymentCustomerMutation) UserID() (id int, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -7343,7 +8480,7 @@ func (m *ResponseMutation) UserID() (id int, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *ResponseMutation) UserIDs() (ids []int) {
+func (m *PaymentCustomerMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -7351,111 +8488,282 @@ func (m *ResponseMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *ResponseMutation) ResetUser() {
+func (m *PaymentCustomerMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
-func (m *ResponseMutation) AddAnswerIDs(ids ...int) {
-	if m.answers == nil {
-		m.answers = make(map[int]struct{})
+// AddPaymentIntentIDs adds the "payment_intents" edge to the PaymentIntent entity by ids.
+func (m *PaymentCustomerMutation) AddPaymentIntentIDs(ids ...int) {
+	if m.payment_intents == nil {
+		m.payment_intents = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.answers[ids[i]] = struct{}{}
+		m.payment_intents[ids[i]] = struct{}{}
 	}
 }
 
-// ClearAnswers clears the "answers" edge to the Answer entity.
-func (m *ResponseMutation) ClearAnswers() {
-	m.clearedanswers = true
+// ClearPaymentIntents clears the "payment_intents" edge to the PaymentIntent entity.
+func (m *PaymentCustomerMutation) ClearPaymentIntents() {
+	m.clearedpayment_intents = true
 }
 
-// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
-func (m *ResponseMutation) AnswersCleared() bool {
-	return m.clearedanswers
+// PaymentIntentsCleared reports if the "payment_intents" edge to the PaymentIntent entity was cleared.
+func (m *PaymentCustomerMutation) PaymentIntentsCleared() bool {
+	return m.clearedpayment_intents
 }
 
-// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
-func (m *ResponseMutation) RemoveAnswerIDs(ids ...int) {
-	if m.removedanswers == nil {
-		m.removedanswers = make(map[int]struct{})
+// RemovePaymentIntentIDs removes the "payment_intents" edge to the PaymentIntent entity by IDs.
+func (m *PaymentCustomerMutation) RemovePaymentIntentIDs(ids ...int) {
+	if m.removedpayment_intents == nil {
+		m.removedpayment_intents = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.answers, ids[i])
-		m.removedanswers[ids[i]] = struct{}{}
+		delete(m.payment_intents, ids[i])
+		m.removedpayment_intents[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
-func (m *ResponseMutation) RemovedAnswersIDs() (ids []int) {
-	for id := range m.removedanswers {
+// RemovedPaymentIntents returns the removed IDs of the "payment_intents" edge to the PaymentIntent entity.
+func (m *PaymentCustomerMutation) RemovedPaymentIntentsIDs() (ids []int) {
+	for id := range m.removedpayment_intents {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// AnswersIDs returns the "answers" edge IDs in the mutation.
-func (m *ResponseMutation) AnswersIDs() (ids []int) {
-	for id := range m.answers {
+// PaymentIntentsIDs returns the "payment_intents" edge IDs in the mutation.
+func (m *PaymentCustomerMutation) PaymentIntentsIDs() (ids []int) {
+	for id := range m.payment_intents {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAnswers resets all changes to the "answers" edge.
-func (m *ResponseMutation) ResetAnswers() {
-	m.answers = nil
-	m.clearedanswers = false
-	m.removedanswers = nil
-}
-
-// Where appends a list predicates to the ResponseMutation builder.
-func (m *ResponseMutation) Where(ps ...predicate.Response) {
-	m.predicates = append(m.predicates, ps...)
+// ResetPaymentIntents resets all changes to the "payment_intents" edge.
+func (m *PaymentCustomerMutation) ResetPaymentIntents() {
+	m.payment_intents = nil
+	m.clearedpayment_intents = false
+	m.removedpayment_intents = nil
 }
 
-// WhereP appends storage-level predicates to the ResponseMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ResponseMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Response, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by ids.
+func (m *PaymentCustomerMutation) AddSubscriptionIDs(ids ...int) {
+	if m.subscriptions == nil {
+		m.subscriptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.subscriptions[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *ResponseMutation) Op() Op {
-	return m.op
+// ClearSubscriptions clears the "subscriptions" edge to the Subscription entity.
+func (m *PaymentCustomerMutation) ClearSubscriptions() {
+	m.clearedsubscriptions = true
+}
+
+// SubscriptionsCleared reports if the "subscriptions" edge to the Subscription entity was cleared.
+func (m *PaymentCustomerMutation) SubscriptionsCleared() bool {
+	return m.clearedsubscriptions
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to the Subscription entity by IDs.
+func (m *PaymentCustomerMutation) RemoveSubscriptionIDs(ids ...int) {
+	if m.removedsubscriptions == nil {
+		m.removedsubscriptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.subscriptions, ids[i])
+		m.removedsubscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubscriptions returns the removed IDs of the "subscriptions" edge to the Subscription entity.
+func (m *PaymentCustomerMutation) RemovedSubscriptionsIDs() (ids []int) {
+	for id := range m.removedsubscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubscriptionsIDs returns the "subscriptions" edge IDs in the mutation.
+func (m *PaymentCustomerMutation) SubscriptionsIDs() (ids []int) {
+	for id := range m.subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSubscriptions resets all changes to the "subscriptions" edge.
+func (m *PaymentCustomerMutation) ResetSubscriptions() {
+	m.subscriptions = nil
+	m.clearedsubscriptions = false
+	m.removedsubscriptions = nil
+}
+
+// AddPaymentMethodIDs adds the "payment_methods" edge to the PaymentMethod entity by ids.
+func (m *PaymentCustomerMutation) AddPaymentMethodIDs(ids ...int) {
+	if m.payment_methods == nil {
+		m.payment_methods = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.payment_methods[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPaymentMethods clears the "payment_methods" edge to the PaymentMethod entity.
+func (m *PaymentCustomerMutation) ClearPaymentMethods() {
+	m.clearedpayment_methods = true
+}
+
+// PaymentMethodsCleared reports if the "payment_methods" edge to the PaymentMethod entity was cleared.
+func (m *PaymentCustomerMutation) PaymentMethodsCleared() bool {
+	return m.clearedpayment_methods
+}
+
+// RemovePaymentMethodIDs removes the "payment_methods" edge to the PaymentMethod entity by IDs.
+func (m *PaymentCustomerMutation) RemovePaymentMethodIDs(ids ...int) {
+	if m.removedpayment_methods == nil {
+		m.removedpayment_methods = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.payment_methods, ids[i])
+		m.removedpayment_methods[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPaymentMethods returns the removed IDs of the "payment_methods" edge to the PaymentMethod entity.
+func (m *PaymentCustomerMutation) RemovedPaymentMethodsIDs() (ids []int) {
+	for id := range m.removedpayment_methods {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PaymentMethodsIDs returns the "payment_methods" edge IDs in the mutation.
+func (m *PaymentCustomerMutation) PaymentMethodsIDs() (ids []int) {
+	for id := range m.payment_methods {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPaymentMethods resets all changes to the "payment_methods" edge.
+func (m *PaymentCustomerMutation) ResetPaymentMethods() {
+	m.payment_methods = nil
+	m.clearedpayment_methods = false
+	m.removedpayment_methods = nil
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by ids.
+func (m *PaymentCustomerMutation) AddInvoiceIDs(ids ...int) {
+	if m.invoices == nil {
+		m.invoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invoices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvoices clears the "invoices" edge to the Invoice entity.
+func (m *PaymentCustomerMutation) ClearInvoices() {
+	m.clearedinvoices = true
+}
+
+// InvoicesCleared reports if the "invoices" edge to the Invoice entity was cleared.
+func (m *PaymentCustomerMutation) InvoicesCleared() bool {
+	return m.clearedinvoices
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to the Invoice entity by IDs.
+func (m *PaymentCustomerMutation) RemoveInvoiceIDs(ids ...int) {
+	if m.removedinvoices == nil {
+		m.removedinvoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.invoices, ids[i])
+		m.removedinvoices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvoices returns the removed IDs of the "invoices" edge to the Invoice entity.
+func (m *PaymentCustomerMutation) RemovedInvoicesIDs() (ids []int) {
+	for id := range m.removedinvoices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvoicesIDs returns the "invoices" edge IDs in the mutation.
+func (m *PaymentCustomerMutation) InvoicesIDs() (ids []int) {
+	for id := range m.invoices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvoices resets all changes to the "invoices" edge.
+func (m *PaymentCustomerMutation) ResetInvoices() {
+	m.invoices = nil
+	m.clearedinvoices = false
+	m.removedinvoices = nil
+}
+
+// Where appends a list predicates to the PaymentCustomerMutation builder.
+func (m *PaymentCustomerMutation) Where(ps ...predicate.PaymentCustomer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaymentCustomerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaymentCustomerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaymentCustomer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaymentCustomerMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ResponseMutation) SetOp(op Op) {
+func (m *PaymentCustomerMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Response).
-func (m *ResponseMutation) Type() string {
+// Type returns the node type of this mutation (PaymentCustomer).
+func (m *PaymentCustomerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ResponseMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.submitted_at != nil {
-		fields = append(fields, response.FieldSubmittedAt)
+func (m *PaymentCustomerMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.provider_customer_id != nil {
+		fields = append(fields, paymentcustomer.FieldProviderCustomerID)
 	}
-	if m.completed != nil {
-		fields = append(fields, response.FieldCompleted)
+	if m.provider != nil {
+		fields = append(fields, paymentcustomer.FieldProvider)
 	}
-	if m._IPAddress != nil {
-		fields = append(fields, response.FieldIPAddress)
+	if m.email != nil {
+		fields = append(fields, paymentcustomer.FieldEmail)
 	}
-	if m._UserAgent != nil {
-		fields = append(fields, response.FieldUserAgent)
+	if m.name != nil {
+		fields = append(fields, paymentcustomer.FieldName)
+	}
+	if m.metadata != nil {
+		fields = append(fields, paymentcustomer.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, paymentcustomer.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, paymentcustomer.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7463,16 +8771,22 @@ func (m *ResponseMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ResponseMutation) Field(name string) (ent.Value, bool) {
+func (m *PaymentCustomerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case response.FieldSubmittedAt:
-		return m.SubmittedAt()
-	case response.FieldCompleted:
-		return m.Completed()
-	case response.FieldIPAddress:
-		return m.IPAddress()
-	case response.FieldUserAgent:
-		return m.UserAgent()
+	case paymentcustomer.FieldProviderCustomerID:
+		return m.ProviderCustomerID()
+	case paymentcustomer.FieldProvider:
+		return m.Provider()
+	case paymentcustomer.FieldEmail:
+		return m.Email()
+	case paymentcustomer.FieldName:
+		return m.Name()
+	case paymentcustomer.FieldMetadata:
+		return m.Metadata()
+	case paymentcustomer.FieldCreatedAt:
+		return m.CreatedAt()
+	case paymentcustomer.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -7480,163 +8794,219 @@ func (m *ResponseMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ResponseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PaymentCustomerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case response.FieldSubmittedAt:
-		return m.OldSubmittedAt(ctx)
-	case response.FieldCompleted:
-		return m.OldCompleted(ctx)
-	case response.FieldIPAddress:
-		return m.OldIPAddress(ctx)
-	case response.FieldUserAgent:
-		return m.OldUserAgent(ctx)
+	case paymentcustomer.FieldProviderCustomerID:
+		return m.OldProviderCustomerID(ctx)
+	case paymentcustomer.FieldProvider:
+		return m.OldProvider(ctx)
+	case paymentcustomer.FieldEmail:
+		return m.OldEmail(ctx)
+	case paymentcustomer.FieldName:
+		return m.OldName(ctx)
+	case paymentcustomer.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case paymentcustomer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case paymentcustomer.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Response field %s", name)
+	return nil, fmt.Errorf("unknown PaymentCustomer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ResponseMutation) SetField(name string, value ent.Value) error {
+func (m *PaymentCustomerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case response.FieldSubmittedAt:
-		v, ok := value.(time.Time)
+	case paymentcustomer.FieldProviderCustomerID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubmittedAt(v)
+		m.SetProviderCustomerID(v)
 		return nil
-	case response.FieldCompleted:
-		v, ok := value.(bool)
+	case paymentcustomer.FieldProvider:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompleted(v)
+		m.SetProvider(v)
 		return nil
-	case response.FieldIPAddress:
+	case paymentcustomer.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIPAddress(v)
+		m.SetEmail(v)
 		return nil
-	case response.FieldUserAgent:
+	case paymentcustomer.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserAgent(v)
+		m.SetName(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Response field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ResponseMutation) AddedFields() []string {
-	return nil
-}
-
+	case paymentcustomer.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case paymentcustomer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case paymentcustomer.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentCustomer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaymentCustomerMutation) AddedFields() []string {
+	return nil
+}
+
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ResponseMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PaymentCustomerMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ResponseMutation) AddField(name string, value ent.Value) error {
+func (m *PaymentCustomerMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Response numeric field %s", name)
+	return fmt.Errorf("unknown PaymentCustomer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ResponseMutation) ClearedFields() []string {
+func (m *PaymentCustomerMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(response.FieldIPAddress) {
-		fields = append(fields, response.FieldIPAddress)
+	if m.FieldCleared(paymentcustomer.FieldName) {
+		fields = append(fields, paymentcustomer.FieldName)
 	}
-	if m.FieldCleared(response.FieldUserAgent) {
-		fields = append(fields, response.FieldUserAgent)
+	if m.FieldCleared(paymentcustomer.FieldMetadata) {
+		fields = append(fields, paymentcustomer.FieldMetadata)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ResponseMutation) FieldCleared(name string) bool {
+func (m *PaymentCustomerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ResponseMutation) ClearField(name string) error {
+func (m *PaymentCustomerMutation) ClearField(name string) error {
 	switch name {
-	case response.FieldIPAddress:
-		m.ClearIPAddress()
+	case paymentcustomer.FieldName:
+		m.ClearName()
 		return nil
-	case response.FieldUserAgent:
-		m.ClearUserAgent()
+	case paymentcustomer.FieldMetadata:
+		m.ClearMetadata()
 		return nil
 	}
-	return fmt.Errorf("unknown Response nullable field %s", name)
+	return fmt.Errorf("unknown PaymentCustomer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ResponseMutation) ResetField(name string) error {
+func (m *PaymentCustomerMutation) ResetField(name string) error {
 	switch name {
-	case response.FieldSubmittedAt:
-		m.ResetSubmittedAt()
+	case paymentcustomer.FieldProviderCustomerID:
+		m.ResetProviderCustomerID()
 		return nil
-	case response.FieldCompleted:
-		m.ResetCompleted()
+	case paymentcustomer.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case response.FieldIPAddress:
-		m.ResetIPAddress()
+	case paymentcustomer.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case response.FieldUserAgent:
-		m.ResetUserAgent()
+	case paymentcustomer.FieldName:
+		m.ResetName()
+		return nil
+	case paymentcustomer.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case paymentcustomer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case paymentcustomer.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Response field %s", name)
+	return fmt.Errorf("unknown PaymentCustomer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ResponseMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.form != nil {
-		edges = append(edges, response.EdgeForm)
-	}
+func (m *PaymentCustomerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
 	if m.user != nil {
-		edges = append(edges, response.EdgeUser)
+		edges = append(edges, paymentcustomer.EdgeUser)
 	}
-	if m.answers != nil {
-		edges = append(edges, response.EdgeAnswers)
+	if m.payment_intents != nil {
+		edges = append(edges, paymentcustomer.EdgePaymentIntents)
+	}
+	if m.subscriptions != nil {
+		edges = append(edges, paymentcustomer.EdgeSubscriptions)
+	}
+	if m.payment_methods != nil {
+		edges = append(edges, paymentcustomer.EdgePaymentMethods)
+	}
+	if m.invoices != nil {
+		edges = append(edges, paymentcustomer.EdgeInvoices)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ResponseMutation) AddedIDs(name string) []ent.Value {
+func (m *PaymentCustomerMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case response.EdgeForm:
-		if id := m.form; id != nil {
-			return []ent.Value{*id}
-		}
-	case response.EdgeUser:
+	case paymentcustomer.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case response.EdgeAnswers:
-		ids := make([]ent.Value, 0, len(m.answers))
-		for id := range m.answers {
+	case paymentcustomer.EdgePaymentIntents:
+		ids := make([]ent.Value, 0, len(m.payment_intents))
+		for id := range m.payment_intents {
+			ids = append(ids, id)
+		}
+		return ids
+	case paymentcustomer.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.subscriptions))
+		for id := range m.subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case paymentcustomer.EdgePaymentMethods:
+		ids := make([]ent.Value, 0, len(m.payment_methods))
+		for id := range m.payment_methods {
+			ids = append(ids, id)
+		}
+		return ids
+	case paymentcustomer.EdgeInvoices:
+		ids := make([]ent.Value, 0, len(m.invoices))
+		for id := range m.invoices {
 			ids = append(ids, id)
 		}
 		return ids
@@ -7645,21 +9015,48 @@ func (m *ResponseMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ResponseMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedanswers != nil {
-		edges = append(edges, response.EdgeAnswers)
+func (m *PaymentCustomerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedpayment_intents != nil {
+		edges = append(edges, paymentcustomer.EdgePaymentIntents)
+	}
+	if m.removedsubscriptions != nil {
+		edges = append(edges, paymentcustomer.EdgeSubscriptions)
+	}
+	if m.removedpayment_methods != nil {
+		edges = append(edges, paymentcustomer.EdgePaymentMethods)
+	}
+	if m.removedinvoices != nil {
+		edges = append(edges, paymentcustomer.EdgeInvoices)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ResponseMutation) RemovedIDs(name string) []ent.Value {
+func (m *PaymentCustomerMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case response.EdgeAnswers:
-		ids := make([]ent.Value, 0, len(m.removedanswers))
-		for id := range m.removedanswers {
+	case paymentcustomer.EdgePaymentIntents:
+		ids := make([]ent.Value, 0, len(m.removedpayment_intents))
+		for id := range m.removedpayment_intents {
+			ids = append(ids, id)
+		}
+		return ids
+	case paymentcustomer.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
+		for id := range m.removedsubscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case paymentcustomer.EdgePaymentMethods:
+		ids := make([]ent.Value, 0, len(m.removedpayment_methods))
+		for id := range m.removedpayment_methods {
+			ids = append(ids, id)
+		}
+		return ids
+	case paymentcustomer.EdgeInvoices:
+		ids := make([]ent.Value, 0, len(m.removedinvoices))
+		for id := range m.removedinvoices {
 			ids = append(ids, id)
 		}
 		return ids
@@ -7668,109 +9065,119 @@ func (m *ResponseMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ResponseMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedform {
-		edges = append(edges, response.EdgeForm)
-	}
+func (m *PaymentCustomerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
 	if m.cleareduser {
-		edges = append(edges, response.EdgeUser)
+		edges = append(edges, paymentcustomer.EdgeUser)
 	}
-	if m.clearedanswers {
-		edges = append(edges, response.EdgeAnswers)
+	if m.clearedpayment_intents {
+		edges = append(edges, paymentcustomer.EdgePaymentIntents)
+	}
+	if m.clearedsubscriptions {
+		edges = append(edges, paymentcustomer.EdgeSubscriptions)
+	}
+	if m.clearedpayment_methods {
+		edges = append(edges, paymentcustomer.EdgePaymentMethods)
+	}
+	if m.clearedinvoices {
+		edges = append(edges, paymentcustomer.EdgeInvoices)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ResponseMutation) EdgeCleared(name string) bool {
+func (m *PaymentCustomerMutation) EdgeCleared(name string) bool {
 	switch name {
-	case response.EdgeForm:
-		return m.clearedform
-	case response.EdgeUser:
+	case paymentcustomer.EdgeUser:
 		return m.cleareduser
-	case response.EdgeAnswers:
-		return m.clearedanswers
+	case paymentcustomer.EdgePaymentIntents:
+		return m.clearedpayment_intents
+	case paymentcustomer.EdgeSubscriptions:
+		return m.clearedsubscriptions
+	case paymentcustomer.EdgePaymentMethods:
+		return m.clearedpayment_methods
+	case paymentcustomer.EdgeInvoices:
+		return m.clearedinvoices
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ResponseMutation) ClearEdge(name string) error {
+func (m *PaymentCustomerMutation) ClearEdge(name string) error {
 	switch name {
-	case response.EdgeForm:
-		m.ClearForm()
-		return nil
-	case response.EdgeUser:
+	case paymentcustomer.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Response unique edge %s", name)
+	return fmt.Errorf("unknown PaymentCustomer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ResponseMutation) ResetEdge(name string) error {
+func (m *PaymentCustomerMutation) ResetEdge(name string) error {
 	switch name {
-	case response.EdgeForm:
-		m.ResetForm()
-		return nil
-	case response.EdgeUser:
+	case paymentcustomer.EdgeUser:
 		m.ResetUser()
 		return nil
-	case response.EdgeAnswers:
-		m.ResetAnswers()
+	case paymentcustomer.EdgePaymentIntents:
+		m.ResetPaymentIntents()
+		return nil
+	case paymentcustomer.EdgeSubscriptions:
+		m.ResetSubscriptions()
+		return nil
+	case paymentcustomer.EdgePaymentMethods:
+		m.ResetPaymentMethods()
+		return nil
+	case paymentcustomer.EdgeInvoices:
+		m.ResetInvoices()
 		return nil
 	}
-	return fmt.Errorf("unknown Response edge %s", name)
+	return fmt.Errorf("unknown PaymentCustomer edge %s", name)
 }
 
-// SubscriptionMutation represents an operation that mutates the Subscription nodes in the graph.
-type SubscriptionMutation struct {
+// PaymentIntentMutation represents an operation that mutates the PaymentIntent nodes in the graph.
+type PaymentIntentMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *int
-	provider_subscription_id *string
-	provider                 *string
-	status                   *subscription.Status
-	price_id                 *string
-	amount                   *int64
-	addamount                *int64
-	currency                 *string
-	interval                 *subscription.Interval
-	interval_count           *int
-	addinterval_count        *int
-	current_period_start     *time.Time
-	current_period_end       *time.Time
-	trial_start              *time.Time
-	trial_end                *time.Time
-	canceled_at              *time.Time
-	ended_at                 *time.Time
-	metadata                 *map[string]interface{}
-	created_at               *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	customer                 *int
-	clearedcustomer          bool
-	done                     bool
-	oldValue                 func(context.Context) (*Subscription, error)
-	predicates               []predicate.Subscription
+	op                         Op
+	typ                        string
+	id                         *int
+	provider_payment_intent_id *string
+	provider                   *string
+	status                     *paymentintent.Status
+	amount                     *int64
+	addamount                  *int64
+	amount_refunded            *int64
+	addamount_refunded         *int64
+	currency                   *string
+	description                *string
+	client_secret              *string
+	provider_account_id        *string
+	metadata                   *map[string]interface{}
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	customer                   *int
+	clearedcustomer            bool
+	response                   *int
+	clearedresponse            bool
+	done                       bool
+	oldValue                   func(context.Context) (*PaymentIntent, error)
+	predicates                 []predicate.PaymentIntent
 }
 
-var _ ent.Mutation = (*SubscriptionMutation)(nil)
+var _ ent.Mutation = (*PaymentIntentMutation)(nil)
 
-// subscriptionOption allows management of the mutation configuration using functional options.
-type subscriptionOption func(*SubscriptionMutation)
+// paymentintentOption allows management of the mutation configuration using functional options.
+type paymentintentOption func(*PaymentIntentMutation)
 
-// newSubscriptionMutation creates new mutation for the Subscription entity.
-func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
-	m := &SubscriptionMutation{
+// newPaymentIntentMutation creates new mutation for the PaymentIntent entity.
+func newPaymentIntentMutation(c config, op Op, opts ...paymentintentOption) *PaymentIntentMutation {
+	m := &PaymentIntentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSubscription,
+		typ:           TypePaymentIntent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7779,20 +9186,20 @@ func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *Subsc
 	return m
 }
 
-// withSubscriptionID sets the ID field of the mutation.
-func withSubscriptionID(id int) subscriptionOption {
-	return func(m *SubscriptionMutation) {
+// withPaymentIntentID sets the ID field of the mutation.
+func withPaymentIntentID(id int) paymentintentOption {
+	return func(m *PaymentIntentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Subscription
+			value *PaymentIntent
 		)
-		m.oldValue = func(ctx context.Context) (*Subscription, error) {
+		m.oldValue = func(ctx context.Context) (*PaymentIntent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Subscription.Get(ctx, id)
+					value, err = m.Client().PaymentIntent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7801,10 +9208,10 @@ func withSubscriptionID(id int) subscriptionOption {
 	}
 }
 
-// withSubscription sets the old Subscription of the mutation.
-func withSubscription(node *Subscription) subscriptionOption {
-	return func(m *SubscriptionMutation) {
-		m.oldValue = func(context.Context) (*Subscription, error) {
+// withPaymentIntent sets the old PaymentIntent of the mutation.
+func withPaymentIntent(node *PaymentIntent) paymentintentOption {
+	return func(m *PaymentIntentMutation) {
+		m.oldValue = func(context.Context) (*PaymentIntent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7813,7 +9220,7 @@ func withSubscription(node *Subscription) subscriptionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubscriptionMutation) Client() *Client {
+func (m PaymentIntentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7821,7 +9228,7 @@ func (m SubscriptionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SubscriptionMutation) Tx() (*Tx, error) {
+func (m PaymentIntentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7832,7 +9239,7 @@ func (m SubscriptionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SubscriptionMutation) ID() (id int, exists bool) {
+func (m *PaymentIntentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7843,7 +9250,7 @@ func (m *SubscriptionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PaymentIntentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7852,55 +9259,55 @@ func (m *SubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Subscription.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PaymentIntent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetProviderSubscriptionID sets the "provider_subscription_id" field.
-func (m *SubscriptionMutation) SetProviderSubscriptionID(s string) {
-	m.provider_subscription_id = &s
+// SetProviderPaymentIntentID sets the "provider_payment_intent_id" field.
+func (m *PaymentIntentMutation) SetProviderPaymentIntentID(s string) {
+	m.provider_payment_intent_id = &s
 }
 
-// ProviderSubscriptionID returns the value of the "provider_subscription_id" field in the mutation.
-func (m *SubscriptionMutation) ProviderSubscriptionID() (r string, exists bool) {
-	v := m.provider_subscription_id
+// ProviderPaymentIntentID returns the value of the "provider_payment_intent_id" field in the mutation.
+func (m *PaymentIntentMutation) ProviderPaymentIntentID() (r string, exists bool) {
+	v := m.provider_payment_intent_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderSubscriptionID returns the old "provider_subscription_id" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldProviderPaymentIntentID returns the old "provider_payment_intent_id" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldProviderSubscriptionID(ctx context.Context) (v string, err error) {
+func (m *PaymentIntentMutation) OldProviderPaymentIntentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderSubscriptionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderPaymentIntentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderSubscriptionID requires an ID field in the mutation")
+		return v, errors.New("OldProviderPaymentIntentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderSubscriptionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderPaymentIntentID: %w", err)
 	}
-	return oldValue.ProviderSubscriptionID, nil
+	return oldValue.ProviderPaymentIntentID, nil
 }
 
-// ResetProviderSubscriptionID resets all changes to the "provider_subscription_id" field.
-func (m *SubscriptionMutation) ResetProviderSubscriptionID() {
-	m.provider_subscription_id = nil
+// ResetProviderPaymentIntentID resets all changes to the "provider_payment_intent_id" field.
+func (m *PaymentIntentMutation) ResetProviderPaymentIntentID() {
+	m.provider_payment_intent_id = nil
 }
 
 // SetProvider sets the "provider" field.
-func (m *SubscriptionMutation) SetProvider(s string) {
+func (m *PaymentIntentMutation) SetProvider(s string) {
 	m.provider = &s
 }
 
 // Provider returns the value of the "provider" field in the mutation.
-func (m *SubscriptionMutation) Provider() (r string, exists bool) {
+func (m *PaymentIntentMutation) Provider() (r string, exists bool) {
 	v := m.provider
 	if v == nil {
 		return
@@ -7908,10 +9315,10 @@ func (m *SubscriptionMutation) Provider() (r string, exists bool) {
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *PaymentIntentMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
@@ -7926,17 +9333,17 @@ func (m *SubscriptionMutation) OldProvider(ctx context.Context) (v string, err e
 }
 
 // ResetProvider resets all changes to the "provider" field.
-func (m *SubscriptionMutation) ResetProvider() {
+func (m *PaymentIntentMutation) ResetProvider() {
 	m.provider = nil
 }
 
 // SetStatus sets the "status" field.
-func (m *SubscriptionMutation) SetStatus(s subscription.Status) {
-	m.status = &s
+func (m *PaymentIntentMutation) SetStatus(pa paymentintent.Status) {
+	m.status = &pa
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *SubscriptionMutation) Status() (r subscription.Status, exists bool) {
+func (m *PaymentIntentMutation) Status() (r paymentintent.Status, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -7944,10 +9351,10 @@ func (m *SubscriptionMutation) Status() (r subscription.Status, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldStatus(ctx context.Context) (v subscription.Status, err error) {
+func (m *PaymentIntentMutation) OldStatus(ctx context.Context) (v paymentintent.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -7962,109 +9369,129 @@ func (m *SubscriptionMutation) OldStatus(ctx context.Context) (v subscription.St
 }
 
 // ResetStatus resets all changes to the "status" field.
-func (m *SubscriptionMutation) ResetStatus() {
+func (m *PaymentIntentMutation) ResetStatus() {
 	m.status = nil
 }
 
-// SetPriceID sets the "price_id" field.
-func (m *SubscriptionMutation) SetPriceID(s string) {
-	m.price_id = &s
+// SetAmount sets the "amount" field.
+func (m *PaymentIntentMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
 }
 
-// PriceID returns the value of the "price_id" field in the mutation.
-func (m *SubscriptionMutation) PriceID() (r string, exists bool) {
-	v := m.price_id
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PaymentIntentMutation) Amount() (r int64, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriceID returns the old "price_id" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldPriceID(ctx context.Context) (v string, err error) {
+func (m *PaymentIntentMutation) OldAmount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriceID requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.PriceID, nil
+	return oldValue.Amount, nil
 }
 
-// ResetPriceID resets all changes to the "price_id" field.
-func (m *SubscriptionMutation) ResetPriceID() {
-	m.price_id = nil
+// AddAmount adds i to the "amount" field.
+func (m *PaymentIntentMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
 }
 
-// SetAmount sets the "amount" field.
-func (m *SubscriptionMutation) SetAmount(i int64) {
-	m.amount = &i
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *PaymentIntentMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *PaymentIntentMutation) ResetAmount() {
+	m.amount = nil
 	m.addamount = nil
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *SubscriptionMutation) Amount() (r int64, exists bool) {
-	v := m.amount
+// SetAmountRefunded sets the "amount_refunded" field.
+func (m *PaymentIntentMutation) SetAmountRefunded(i int64) {
+	m.amount_refunded = &i
+	m.addamount_refunded = nil
+}
+
+// AmountRefunded returns the value of the "amount_refunded" field in the mutation.
+func (m *PaymentIntentMutation) AmountRefunded() (r int64, exists bool) {
+	v := m.amount_refunded
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldAmountRefunded returns the old "amount_refunded" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldAmount(ctx context.Context) (v int64, err error) {
+func (m *PaymentIntentMutation) OldAmountRefunded(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmountRefunded is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldAmountRefunded requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmountRefunded: %w", err)
 	}
-	return oldValue.Amount, nil
+	return oldValue.AmountRefunded, nil
 }
 
-// AddAmount adds i to the "amount" field.
-func (m *SubscriptionMutation) AddAmount(i int64) {
-	if m.addamount != nil {
-		*m.addamount += i
+// AddAmountRefunded adds i to the "amount_refunded" field.
+func (m *PaymentIntentMutation) AddAmountRefunded(i int64) {
+	if m.addamount_refunded != nil {
+		*m.addamount_refunded += i
 	} else {
-		m.addamount = &i
+		m.addamount_refunded = &i
 	}
 }
 
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *SubscriptionMutation) AddedAmount() (r int64, exists bool) {
-	v := m.addamount
+// AddedAmountRefunded returns the value that was added to the "amount_refunded" field in this mutation.
+func (m *PaymentIntentMutation) AddedAmountRefunded() (r int64, exists bool) {
+	v := m.addamount_refunded
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *SubscriptionMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
+// ResetAmountRefunded resets all changes to the "amount_refunded" field.
+func (m *PaymentIntentMutation) ResetAmountRefunded() {
+	m.amount_refunded = nil
+	m.addamount_refunded = nil
 }
 
 // SetCurrency sets the "currency" field.
-func (m *SubscriptionMutation) SetCurrency(s string) {
+func (m *PaymentIntentMutation) SetCurrency(s string) {
 	m.currency = &s
 }
 
 // Currency returns the value of the "currency" field in the mutation.
-func (m *SubscriptionMutation) Currency() (r string, exists bool) {
+func (m *PaymentIntentMutation) Currency() (r string, exists bool) {
 	v := m.currency
 	if v == nil {
 		return
@@ -8072,10 +9499,10 @@ func (m *SubscriptionMutation) Currency() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCurrency returns the old "currency" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCurrency(ctx context.Context) (v string, err error) {
+func (m *PaymentIntentMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
@@ -8090,452 +9517,9634 @@ func (m *SubscriptionMutation) OldCurrency(ctx context.Context) (v string, err e
 }
 
 // ResetCurrency resets all changes to the "currency" field.
-func (m *SubscriptionMutation) ResetCurrency() {
+func (m *PaymentIntentMutation) ResetCurrency() {
 	m.currency = nil
 }
 
-// SetInterval sets the "interval" field.
-func (m *SubscriptionMutation) SetInterval(s subscription.Interval) {
-	m.interval = &s
+// SetDescription sets the "description" field.
+func (m *PaymentIntentMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Interval returns the value of the "interval" field in the mutation.
-func (m *SubscriptionMutation) Interval() (r subscription.Interval, exists bool) {
-	v := m.interval
+// Description returns the value of the "description" field in the mutation.
+func (m *PaymentIntentMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInterval returns the old "interval" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldInterval(ctx context.Context) (v subscription.Interval, err error) {
+func (m *PaymentIntentMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInterval is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInterval requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInterval: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Interval, nil
-}
-
-// ResetInterval resets all changes to the "interval" field.
-func (m *SubscriptionMutation) ResetInterval() {
-	m.interval = nil
+	return oldValue.Description, nil
 }
 
-// SetIntervalCount sets the "interval_count" field.
-func (m *SubscriptionMutation) SetIntervalCount(i int) {
-	m.interval_count = &i
-	m.addinterval_count = nil
+// ClearDescription clears the value of the "description" field.
+func (m *PaymentIntentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[paymentintent.FieldDescription] = struct{}{}
 }
 
-// IntervalCount returns the value of the "interval_count" field in the mutation.
-func (m *SubscriptionMutation) IntervalCount() (r int, exists bool) {
-	v := m.interval_count
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PaymentIntentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[paymentintent.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PaymentIntentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, paymentintent.FieldDescription)
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (m *PaymentIntentMutation) SetClientSecret(s string) {
+	m.client_secret = &s
+}
+
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *PaymentIntentMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIntervalCount returns the old "interval_count" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldClientSecret returns the old "client_secret" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldIntervalCount(ctx context.Context) (v int, err error) {
+func (m *PaymentIntentMutation) OldClientSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntervalCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntervalCount requires an ID field in the mutation")
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntervalCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
 	}
-	return oldValue.IntervalCount, nil
+	return oldValue.ClientSecret, nil
 }
 
-// AddIntervalCount adds i to the "interval_count" field.
-func (m *SubscriptionMutation) AddIntervalCount(i int) {
-	if m.addinterval_count != nil {
-		*m.addinterval_count += i
-	} else {
-		m.addinterval_count = &i
+// ClearClientSecret clears the value of the "client_secret" field.
+func (m *PaymentIntentMutation) ClearClientSecret() {
+	m.client_secret = nil
+	m.clearedFields[paymentintent.FieldClientSecret] = struct{}{}
+}
+
+// ClientSecretCleared returns if the "client_secret" field was cleared in this mutation.
+func (m *PaymentIntentMutation) ClientSecretCleared() bool {
+	_, ok := m.clearedFields[paymentintent.FieldClientSecret]
+	return ok
+}
+
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *PaymentIntentMutation) ResetClientSecret() {
+	m.client_secret = nil
+	delete(m.clearedFields, paymentintent.FieldClientSecret)
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (m *PaymentIntentMutation) SetProviderAccountID(s string) {
+	m.provider_account_id = &s
+}
+
+// ProviderAccountID returns the value of the "provider_account_id" field in the mutation.
+func (m *PaymentIntentMutation) ProviderAccountID() (r string, exists bool) {
+	v := m.provider_account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderAccountID returns the old "provider_account_id" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentIntentMutation) OldProviderAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderAccountID: %w", err)
+	}
+	return oldValue.ProviderAccountID, nil
+}
+
+// ClearProviderAccountID clears the value of the "provider_account_id" field.
+func (m *PaymentIntentMutation) ClearProviderAccountID() {
+	m.provider_account_id = nil
+	m.clearedFields[paymentintent.FieldProviderAccountID] = struct{}{}
+}
+
+// ProviderAccountIDCleared returns if the "provider_account_id" field was cleared in this mutation.
+func (m *PaymentIntentMutation) ProviderAccountIDCleared() bool {
+	_, ok := m.clearedFields[paymentintent.FieldProviderAccountID]
+	return ok
+}
+
+// ResetProviderAccountID resets all changes to the "provider_account_id" field.
+func (m *PaymentIntentMutation) ResetProviderAccountID() {
+	m.provider_account_id = nil
+	delete(m.clearedFields, paymentintent.FieldProviderAccountID)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *PaymentIntentMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *PaymentIntentMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentIntentMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *PaymentIntentMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[paymentintent.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *PaymentIntentMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[paymentintent.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *PaymentIntentMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, paymentintent.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PaymentIntentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PaymentIntentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentIntentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PaymentIntentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PaymentIntentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PaymentIntentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PaymentIntent entity.
+// If the PaymentIntent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentIntentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PaymentIntentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by id.
+func (m *PaymentIntentMutation) SetCustomerID(id int) {
+	m.customer = &id
+}
+
+// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
+func (m *PaymentIntentMutation) ClearCustomer() {
+	m.clearedcustomer = true
+}
+
+// CustomerCleared reports if the "customer" edge to the PaymentCustomer entity was cleared.
+func (m *PaymentIntentMutation) CustomerCleared() bool {
+	return m.clearedcustomer
+}
+
+// CustomerID returns the "customer" edge ID in the mutation.
+func (m *PaymentIntentMutation) CustomerID() (id int, exists bool) {
+	if m.customer != nil {
+		return *m.customer, true
+	}
+	return
+}
+
+// CustomerIDs returns the "customer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CustomerID instead. It exists only for internal usage by the builders.
+func (m *PaymentIntentMutation) CustomerIDs() (ids []int) {
+	if id := m.customer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCustomer resets all changes to the "customer" edge.
+func (m *PaymentIntentMutation) ResetCustomer() {
+	m.customer = nil
+	m.clearedcustomer = false
+}
+
+// SetResponseID sets the "response" edge to the Response entity by id.
+func (m *PaymentIntentMutation) SetResponseID(id int) {
+	m.response = &id
+}
+
+// ClearResponse clears the "response" edge to the Response entity.
+func (m *PaymentIntentMutation) ClearResponse() {
+	m.clearedresponse = true
+}
+
+// ResponseCleared reports if the "response" edge to the Response entity was cleared.
+func (m *PaymentIntentMutation) ResponseCleared() bool {
+	return m.clearedresponse
+}
+
+// ResponseID returns the "response" edge ID in the mutation.
+func (m *PaymentIntentMutation) ResponseID() (id int, exists bool) {
+	if m.response != nil {
+		return *m.response, true
+	}
+	return
+}
+
+// ResponseIDs returns the "response" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResponseID instead. It exists only for internal usage by the builders.
+func (m *PaymentIntentMutation) ResponseIDs() (ids []int) {
+	if id := m.response; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResponse resets all changes to the "response" edge.
+func (m *PaymentIntentMutation) ResetResponse() {
+	m.response = nil
+	m.clearedresponse = false
+}
+
+// Where appends a list predicates to the PaymentIntentMutation builder.
+func (m *PaymentIntentMutation) Where(ps ...predicate.PaymentIntent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaymentIntentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaymentIntentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaymentIntent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaymentIntentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PaymentIntentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PaymentIntent).
+func (m *PaymentIntentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaymentIntentMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.provider_payment_intent_id != nil {
+		fields = append(fields, paymentintent.FieldProviderPaymentIntentID)
+	}
+	if m.provider != nil {
+		fields = append(fields, paymentintent.FieldProvider)
+	}
+	if m.status != nil {
+		fields = append(fields, paymentintent.FieldStatus)
+	}
+	if m.amount != nil {
+		fields = append(fields, paymentintent.FieldAmount)
+	}
+	if m.amount_refunded != nil {
+		fields = append(fields, paymentintent.FieldAmountRefunded)
+	}
+	if m.currency != nil {
+		fields = append(fields, paymentintent.FieldCurrency)
+	}
+	if m.description != nil {
+		fields = append(fields, paymentintent.FieldDescription)
+	}
+	if m.client_secret != nil {
+		fields = append(fields, paymentintent.FieldClientSecret)
+	}
+	if m.provider_account_id != nil {
+		fields = append(fields, paymentintent.FieldProviderAccountID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, paymentintent.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, paymentintent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, paymentintent.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaymentIntentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case paymentintent.FieldProviderPaymentIntentID:
+		return m.ProviderPaymentIntentID()
+	case paymentintent.FieldProvider:
+		return m.Provider()
+	case paymentintent.FieldStatus:
+		return m.Status()
+	case paymentintent.FieldAmount:
+		return m.Amount()
+	case paymentintent.FieldAmountRefunded:
+		return m.AmountRefunded()
+	case paymentintent.FieldCurrency:
+		return m.Currency()
+	case paymentintent.FieldDescription:
+		return m.Description()
+	case paymentintent.FieldClientSecret:
+		return m.ClientSecret()
+	case paymentintent.FieldProviderAccountID:
+		return m.ProviderAccountID()
+	case paymentintent.FieldMetadata:
+		return m.Metadata()
+	case paymentintent.FieldCreatedAt:
+		return m.CreatedAt()
+	case paymentintent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaymentIntentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case paymentintent.FieldProviderPaymentIntentID:
+		return m.OldProviderPaymentIntentID(ctx)
+	case paymentintent.FieldProvider:
+		return m.OldProvider(ctx)
+	case paymentintent.FieldStatus:
+		return m.OldStatus(ctx)
+	case paymentintent.FieldAmount:
+		return m.OldAmount(ctx)
+	case paymentintent.FieldAmountRefunded:
+		return m.OldAmountRefunded(ctx)
+	case paymentintent.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case paymentintent.FieldDescription:
+		return m.OldDescription(ctx)
+	case paymentintent.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case paymentintent.FieldProviderAccountID:
+		return m.OldProviderAccountID(ctx)
+	case paymentintent.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case paymentintent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case paymentintent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PaymentIntent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentIntentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case paymentintent.FieldProviderPaymentIntentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderPaymentIntentID(v)
+		return nil
+	case paymentintent.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case paymentintent.FieldStatus:
+		v, ok := value.(paymentintent.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case paymentintent.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case paymentintent.FieldAmountRefunded:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountRefunded(v)
+		return nil
+	case paymentintent.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case paymentintent.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case paymentintent.FieldClientSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSecret(v)
+		return nil
+	case paymentintent.FieldProviderAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderAccountID(v)
+		return nil
+	case paymentintent.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case paymentintent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case paymentintent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentIntent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaymentIntentMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, paymentintent.FieldAmount)
+	}
+	if m.addamount_refunded != nil {
+		fields = append(fields, paymentintent.FieldAmountRefunded)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaymentIntentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case paymentintent.FieldAmount:
+		return m.AddedAmount()
+	case paymentintent.FieldAmountRefunded:
+		return m.AddedAmountRefunded()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentIntentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case paymentintent.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case paymentintent.FieldAmountRefunded:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountRefunded(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentIntent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaymentIntentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(paymentintent.FieldDescription) {
+		fields = append(fields, paymentintent.FieldDescription)
+	}
+	if m.FieldCleared(paymentintent.FieldClientSecret) {
+		fields = append(fields, paymentintent.FieldClientSecret)
+	}
+	if m.FieldCleared(paymentintent.FieldProviderAccountID) {
+		fields = append(fields, paymentintent.FieldProviderAccountID)
+	}
+	if m.FieldCleared(paymentintent.FieldMetadata) {
+		fields = append(fields, paymentintent.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaymentIntentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaymentIntentMutation) ClearField(name string) error {
+	switch name {
+	case paymentintent.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case paymentintent.FieldClientSecret:
+		m.ClearClientSecret()
+		return nil
+	case paymentintent.FieldProviderAccountID:
+		m.ClearProviderAccountID()
+		return nil
+	case paymentintent.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentIntent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaymentIntentMutation) ResetField(name string) error {
+	switch name {
+	case paymentintent.FieldProviderPaymentIntentID:
+		m.ResetProviderPaymentIntentID()
+		return nil
+	case paymentintent.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case paymentintent.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case paymentintent.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case paymentintent.FieldAmountRefunded:
+		m.ResetAmountRefunded()
+		return nil
+	case paymentintent.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case paymentintent.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case paymentintent.FieldClientSecret:
+		m.ResetClientSecret()
+		return nil
+	case paymentintent.FieldProviderAccountID:
+		m.ResetProviderAccountID()
+		return nil
+	case paymentintent.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case paymentintent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case paymentintent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentIntent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaymentIntentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.customer != nil {
+		edges = append(edges, paymentintent.EdgeCustomer)
+	}
+	if m.response != nil {
+		edges = append(edges, paymentintent.EdgeResponse)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaymentIntentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case paymentintent.EdgeCustomer:
+		if id := m.customer; id != nil {
+			return []ent.Value{*id}
+		}
+	case paymentintent.EdgeResponse:
+		if id := m.response; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaymentIntentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaymentIntentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaymentIntentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcustomer {
+		edges = append(edges, paymentintent.EdgeCustomer)
+	}
+	if m.clearedresponse {
+		edges = append(edges, paymentintent.EdgeResponse)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaymentIntentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case paymentintent.EdgeCustomer:
+		return m.clearedcustomer
+	case paymentintent.EdgeResponse:
+		return m.clearedresponse
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaymentIntentMutation) ClearEdge(name string) error {
+	switch name {
+	case paymentintent.EdgeCustomer:
+		m.ClearCustomer()
+		return nil
+	case paymentintent.EdgeResponse:
+		m.ClearResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentIntent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaymentIntentMutation) ResetEdge(name string) error {
+	switch name {
+	case paymentintent.EdgeCustomer:
+		m.ResetCustomer()
+		return nil
+	case paymentintent.EdgeResponse:
+		m.ResetResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentIntent edge %s", name)
+}
+
+// PaymentMethodMutation represents an operation that mutates the PaymentMethod nodes in the graph.
+type PaymentMethodMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	provider_payment_method_id *string
+	provider                   *string
+	_type                      *paymentmethod.Type
+	last_four                  *string
+	brand                      *string
+	exp_month                  *int
+	addexp_month               *int
+	exp_year                   *int
+	addexp_year                *int
+	is_default                 *bool
+	metadata                   *map[string]interface{}
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	customer                   *int
+	clearedcustomer            bool
+	done                       bool
+	oldValue                   func(context.Context) (*PaymentMethod, error)
+	predicates                 []predicate.PaymentMethod
+}
+
+var _ ent.Mutation = (*PaymentMethodMutation)(nil)
+
+// paymentmethodOption allows management of the mutation configuration using functional options.
+type paymentmethodOption func(*PaymentMethodMutation)
+
+// newPaymentMethodMutation creates new mutation for the PaymentMethod entity.
+func newPaymentMethodMutation(c config, op Op, opts ...paymentmethodOption) *PaymentMethodMutation {
+	m := &PaymentMethodMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePaymentMethod,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaymentMethodID sets the ID field of the mutation.
+func withPaymentMethodID(id int) paymentmethodOption {
+	return func(m *PaymentMethodMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PaymentMethod
+		)
+		m.oldValue = func(ctx context.Context) (*PaymentMethod, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PaymentMethod.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPaymentMethod sets the old PaymentMethod of the mutation.
+func withPaymentMethod(node *PaymentMethod) paymentmethodOption {
+	return func(m *PaymentMethodMutation) {
+		m.oldValue = func(context.Context) (*PaymentMethod, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaymentMethodMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaymentMethodMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaymentMethodMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaymentMethodMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PaymentMethod.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProviderPaymentMethodID sets the "provider_payment_method_id" field.
+func (m *PaymentMethodMutation) SetProviderPaymentMethodID(s string) {
+	m.provider_payment_method_id = &s
+}
+
+// ProviderPaymentMethodID returns the value of the "provider_payment_method_id" field in the mutation.
+func (m *PaymentMethodMutation) ProviderPaymentMethodID() (r string, exists bool) {
+	v := m.provider_payment_method_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderPaymentMethodID returns the old "provider_payment_method_id" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldProviderPaymentMethodID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderPaymentMethodID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderPaymentMethodID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderPaymentMethodID: %w", err)
+	}
+	return oldValue.ProviderPaymentMethodID, nil
+}
+
+// ResetProviderPaymentMethodID resets all changes to the "provider_payment_method_id" field.
+func (m *PaymentMethodMutation) ResetProviderPaymentMethodID() {
+	m.provider_payment_method_id = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *PaymentMethodMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *PaymentMethodMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *PaymentMethodMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetType sets the "type" field.
+func (m *PaymentMethodMutation) SetType(pa paymentmethod.Type) {
+	m._type = &pa
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *PaymentMethodMutation) GetType() (r paymentmethod.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldType(ctx context.Context) (v paymentmethod.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *PaymentMethodMutation) ResetType() {
+	m._type = nil
+}
+
+// SetLastFour sets the "last_four" field.
+func (m *PaymentMethodMutation) SetLastFour(s string) {
+	m.last_four = &s
+}
+
+// LastFour returns the value of the "last_four" field in the mutation.
+func (m *PaymentMethodMutation) LastFour() (r string, exists bool) {
+	v := m.last_four
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFour returns the old "last_four" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldLastFour(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFour is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFour requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFour: %w", err)
+	}
+	return oldValue.LastFour, nil
+}
+
+// ClearLastFour clears the value of the "last_four" field.
+func (m *PaymentMethodMutation) ClearLastFour() {
+	m.last_four = nil
+	m.clearedFields[paymentmethod.FieldLastFour] = struct{}{}
+}
+
+// LastFourCleared returns if the "last_four" field was cleared in this mutation.
+func (m *PaymentMethodMutation) LastFourCleared() bool {
+	_, ok := m.clearedFields[paymentmethod.FieldLastFour]
+	return ok
+}
+
+// ResetLastFour resets all changes to the "last_four" field.
+func (m *PaymentMethodMutation) ResetLastFour() {
+	m.last_four = nil
+	delete(m.clearedFields, paymentmethod.FieldLastFour)
+}
+
+// SetBrand sets the "brand" field.
+func (m *PaymentMethodMutation) SetBrand(s string) {
+	m.brand = &s
+}
+
+// Brand returns the value of the "brand" field in the mutation.
+func (m *PaymentMethodMutation) Brand() (r string, exists bool) {
+	v := m.brand
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrand returns the old "brand" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldBrand(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrand is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrand requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrand: %w", err)
+	}
+	return oldValue.Brand, nil
+}
+
+// ClearBrand clears the value of the "brand" field.
+func (m *PaymentMethodMutation) ClearBrand() {
+	m.brand = nil
+	m.clearedFields[paymentmethod.FieldBrand] = struct{}{}
+}
+
+// BrandCleared returns if the "brand" field was cleared in this mutation.
+func (m *PaymentMethodMutation) BrandCleared() bool {
+	_, ok := m.clearedFields[paymentmethod.FieldBrand]
+	return ok
+}
+
+// ResetBrand resets all changes to the "brand" field.
+func (m *PaymentMethodMutation) ResetBrand() {
+	m.brand = nil
+	delete(m.clearedFields, paymentmethod.FieldBrand)
+}
+
+// SetExpMonth sets the "exp_month" field.
+func (m *PaymentMethodMutation) SetExpMonth(i int) {
+	m.exp_month = &i
+	m.addexp_month = nil
+}
+
+// ExpMonth returns the value of the "exp_month" field in the mutation.
+func (m *PaymentMethodMutation) ExpMonth() (r int, exists bool) {
+	v := m.exp_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpMonth returns the old "exp_month" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldExpMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpMonth: %w", err)
+	}
+	return oldValue.ExpMonth, nil
+}
+
+// AddExpMonth adds i to the "exp_month" field.
+func (m *PaymentMethodMutation) AddExpMonth(i int) {
+	if m.addexp_month != nil {
+		*m.addexp_month += i
+	} else {
+		m.addexp_month = &i
+	}
+}
+
+// AddedExpMonth returns the value that was added to the "exp_month" field in this mutation.
+func (m *PaymentMethodMutation) AddedExpMonth() (r int, exists bool) {
+	v := m.addexp_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExpMonth clears the value of the "exp_month" field.
+func (m *PaymentMethodMutation) ClearExpMonth() {
+	m.exp_month = nil
+	m.addexp_month = nil
+	m.clearedFields[paymentmethod.FieldExpMonth] = struct{}{}
+}
+
+// ExpMonthCleared returns if the "exp_month" field was cleared in this mutation.
+func (m *PaymentMethodMutation) ExpMonthCleared() bool {
+	_, ok := m.clearedFields[paymentmethod.FieldExpMonth]
+	return ok
+}
+
+// ResetExpMonth resets all changes to the "exp_month" field.
+func (m *PaymentMethodMutation) ResetExpMonth() {
+	m.exp_month = nil
+	m.addexp_month = nil
+	delete(m.clearedFields, paymentmethod.FieldExpMonth)
+}
+
+// SetExpYear sets the "exp_year" field.
+func (m *PaymentMethodMutation) SetExpYear(i int) {
+	m.exp_year = &i
+	m.addexp_year = nil
+}
+
+// ExpYear returns the value of the "exp_year" field in the mutation.
+func (m *PaymentMethodMutation) ExpYear() (r int, exists bool) {
+	v := m.exp_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpYear returns the old "exp_year" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldExpYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpYear: %w", err)
+	}
+	return oldValue.ExpYear, nil
+}
+
+// AddExpYear adds i to the "exp_year" field.
+func (m *PaymentMethodMutation) AddExpYear(i int) {
+	if m.addexp_year != nil {
+		*m.addexp_year += i
+	} else {
+		m.addexp_year = &i
+	}
+}
+
+// AddedExpYear returns the value that was added to the "exp_year" field in this mutation.
+func (m *PaymentMethodMutation) AddedExpYear() (r int, exists bool) {
+	v := m.addexp_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExpYear clears the value of the "exp_year" field.
+func (m *PaymentMethodMutation) ClearExpYear() {
+	m.exp_year = nil
+	m.addexp_year = nil
+	m.clearedFields[paymentmethod.FieldExpYear] = struct{}{}
+}
+
+// ExpYearCleared returns if the "exp_year" field was cleared in this mutation.
+func (m *PaymentMethodMutation) ExpYearCleared() bool {
+	_, ok := m.clearedFields[paymentmethod.FieldExpYear]
+	return ok
+}
+
+// ResetExpYear resets all changes to the "exp_year" field.
+func (m *PaymentMethodMutation) ResetExpYear() {
+	m.exp_year = nil
+	m.addexp_year = nil
+	delete(m.clearedFields, paymentmethod.FieldExpYear)
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *PaymentMethodMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *PaymentMethodMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *PaymentMethodMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *PaymentMethodMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *PaymentMethodMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *PaymentMethodMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[paymentmethod.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *PaymentMethodMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[paymentmethod.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *PaymentMethodMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, paymentmethod.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PaymentMethodMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PaymentMethodMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PaymentMethodMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PaymentMethodMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PaymentMethodMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PaymentMethod entity.
+// If the PaymentMethod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMethodMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PaymentMethodMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by id.
+func (m *PaymentMethodMutation) SetCustomerID(id int) {
+	m.customer = &id
+}
+
+// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
+func (m *PaymentMethodMutation) ClearCustomer() {
+	m.clearedcustomer = true
+}
+
+// CustomerCleared reports if the "customer" edge to the PaymentCustomer entity was cleared.
+func (m *PaymentMethodMutation) CustomerCleared() bool {
+	return m.clearedcustomer
+}
+
+// CustomerID returns the "customer" edge ID in the mutation.
+func (m *PaymentMethodMutation) CustomerID() (id int, exists bool) {
+	if m.customer != nil {
+		return *m.customer, true
+	}
+	return
+}
+
+// CustomerIDs returns the "customer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CustomerID instead. It exists only for internal usage by the builders.
+func (m *PaymentMethodMutation) CustomerIDs() (ids []int) {
+	if id := m.customer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCustomer resets all changes to the "customer" edge.
+func (m *PaymentMethodMutation) ResetCustomer() {
+	m.customer = nil
+	m.clearedcustomer = false
+}
+
+// Where appends a list predicates to the PaymentMethodMutation builder.
+func (m *PaymentMethodMutation) Where(ps ...predicate.PaymentMethod) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaymentMethodMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaymentMethodMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaymentMethod, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaymentMethodMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PaymentMethodMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PaymentMethod).
+func (m *PaymentMethodMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaymentMethodMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.provider_payment_method_id != nil {
+		fields = append(fields, paymentmethod.FieldProviderPaymentMethodID)
+	}
+	if m.provider != nil {
+		fields = append(fields, paymentmethod.FieldProvider)
+	}
+	if m._type != nil {
+		fields = append(fields, paymentmethod.FieldType)
+	}
+	if m.last_four != nil {
+		fields = append(fields, paymentmethod.FieldLastFour)
+	}
+	if m.brand != nil {
+		fields = append(fields, paymentmethod.FieldBrand)
+	}
+	if m.exp_month != nil {
+		fields = append(fields, paymentmethod.FieldExpMonth)
+	}
+	if m.exp_year != nil {
+		fields = append(fields, paymentmethod.FieldExpYear)
+	}
+	if m.is_default != nil {
+		fields = append(fields, paymentmethod.FieldIsDefault)
+	}
+	if m.metadata != nil {
+		fields = append(fields, paymentmethod.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, paymentmethod.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, paymentmethod.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaymentMethodMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case paymentmethod.FieldProviderPaymentMethodID:
+		return m.ProviderPaymentMethodID()
+	case paymentmethod.FieldProvider:
+		return m.Provider()
+	case paymentmethod.FieldType:
+		return m.GetType()
+	case paymentmethod.FieldLastFour:
+		return m.LastFour()
+	case paymentmethod.FieldBrand:
+		return m.Brand()
+	case paymentmethod.FieldExpMonth:
+		return m.ExpMonth()
+	case paymentmethod.FieldExpYear:
+		return m.ExpYear()
+	case paymentmethod.FieldIsDefault:
+		return m.IsDefault()
+	case paymentmethod.FieldMetadata:
+		return m.Metadata()
+	case paymentmethod.FieldCreatedAt:
+		return m.CreatedAt()
+	case paymentmethod.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaymentMethodMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case paymentmethod.FieldProviderPaymentMethodID:
+		return m.OldProviderPaymentMethodID(ctx)
+	case paymentmethod.FieldProvider:
+		return m.OldProvider(ctx)
+	case paymentmethod.FieldType:
+		return m.OldType(ctx)
+	case paymentmethod.FieldLastFour:
+		return m.OldLastFour(ctx)
+	case paymentmethod.FieldBrand:
+		return m.OldBrand(ctx)
+	case paymentmethod.FieldExpMonth:
+		return m.OldExpMonth(ctx)
+	case paymentmethod.FieldExpYear:
+		return m.OldExpYear(ctx)
+	case paymentmethod.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case paymentmethod.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case paymentmethod.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case paymentmethod.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PaymentMethod field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentMethodMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case paymentmethod.FieldProviderPaymentMethodID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderPaymentMethodID(v)
+		return nil
+	case paymentmethod.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case paymentmethod.FieldType:
+		v, ok := value.(paymentmethod.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case paymentmethod.FieldLastFour:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFour(v)
+		return nil
+	case paymentmethod.FieldBrand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrand(v)
+		return nil
+	case paymentmethod.FieldExpMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpMonth(v)
+		return nil
+	case paymentmethod.FieldExpYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpYear(v)
+		return nil
+	case paymentmethod.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case paymentmethod.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case paymentmethod.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case paymentmethod.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentMethod field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaymentMethodMutation) AddedFields() []string {
+	var fields []string
+	if m.addexp_month != nil {
+		fields = append(fields, paymentmethod.FieldExpMonth)
+	}
+	if m.addexp_year != nil {
+		fields = append(fields, paymentmethod.FieldExpYear)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaymentMethodMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case paymentmethod.FieldExpMonth:
+		return m.AddedExpMonth()
+	case paymentmethod.FieldExpYear:
+		return m.AddedExpYear()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentMethodMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case paymentmethod.FieldExpMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpMonth(v)
+		return nil
+	case paymentmethod.FieldExpYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpYear(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentMethod numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaymentMethodMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(paymentmethod.FieldLastFour) {
+		fields = append(fields, paymentmethod.FieldLastFour)
+	}
+	if m.FieldCleared(paymentmethod.FieldBrand) {
+		fields = append(fields, paymentmethod.FieldBrand)
+	}
+	if m.FieldCleared(paymentmethod.FieldExpMonth) {
+		fields = append(fields, paymentmethod.FieldExpMonth)
+	}
+	if m.FieldCleared(paymentmethod.FieldExpYear) {
+		fields = append(fields, paymentmethod.FieldExpYear)
+	}
+	if m.FieldCleared(paymentmethod.FieldMetadata) {
+		fields = append(fields, paymentmethod.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaymentMethodMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaymentMethodMutation) ClearField(name string) error {
+	switch name {
+	case paymentmethod.FieldLastFour:
+		m.ClearLastFour()
+		return nil
+	case paymentmethod.FieldBrand:
+		m.ClearBrand()
+		return nil
+	case paymentmethod.FieldExpMonth:
+		m.ClearExpMonth()
+		return nil
+	case paymentmethod.FieldExpYear:
+		m.ClearExpYear()
+		return nil
+	case paymentmethod.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentMethod nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaymentMethodMutation) ResetField(name string) error {
+	switch name {
+	case paymentmethod.FieldProviderPaymentMethodID:
+		m.ResetProviderPaymentMethodID()
+		return nil
+	case paymentmethod.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case paymentmethod.FieldType:
+		m.ResetType()
+		return nil
+	case paymentmethod.FieldLastFour:
+		m.ResetLastFour()
+		return nil
+	case paymentmethod.FieldBrand:
+		m.ResetBrand()
+		return nil
+	case paymentmethod.FieldExpMonth:
+		m.ResetExpMonth()
+		return nil
+	case paymentmethod.FieldExpYear:
+		m.ResetExpYear()
+		return nil
+	case paymentmethod.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case paymentmethod.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case paymentmethod.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case paymentmethod.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentMethod field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaymentMethodMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.customer != nil {
+		edges = append(edges, paymentmethod.EdgeCustomer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaymentMethodMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case paymentmethod.EdgeCustomer:
+		if id := m.customer; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaymentMethodMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaymentMethodMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaymentMethodMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcustomer {
+		edges = append(edges, paymentmethod.EdgeCustomer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaymentMethodMutation) EdgeCleared(name string) bool {
+	switch name {
+	case paymentmethod.EdgeCustomer:
+		return m.clearedcustomer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaymentMethodMutation) ClearEdge(name string) error {
+	switch name {
+	case paymentmethod.EdgeCustomer:
+		m.ClearCustomer()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentMethod unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaymentMethodMutation) ResetEdge(name string) error {
+	switch name {
+	case paymentmethod.EdgeCustomer:
+		m.ResetCustomer()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentMethod edge %s", name)
+}
+
+// ProcessedEventMutation represents an operation that mutates the ProcessedEvent nodes in the graph.
+type ProcessedEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	provider      *string
+	event_id      *string
+	_type         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ProcessedEvent, error)
+	predicates    []predicate.ProcessedEvent
+}
+
+var _ ent.Mutation = (*ProcessedEventMutation)(nil)
+
+// processedeventOption allows management of the mutation configuration using functional options.
+type processedeventOption func(*ProcessedEventMutation)
+
+// newProcessedEventMutation creates new mutation for the ProcessedEvent entity.
+func newProcessedEventMutation(c config, op Op, opts ...processedeventOption) *ProcessedEventMutation {
+	m := &ProcessedEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProcessedEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProcessedEventID sets the ID field of the mutation.
+func withProcessedEventID(id int) processedeventOption {
+	return func(m *ProcessedEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProcessedEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ProcessedEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProcessedEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProcessedEvent sets the old ProcessedEvent of the mutation.
+func withProcessedEvent(node *ProcessedEvent) processedeventOption {
+	return func(m *ProcessedEventMutation) {
+		m.oldValue = func(context.Context) (*ProcessedEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProcessedEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProcessedEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProcessedEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProcessedEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProcessedEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvider sets the "provider" field.
+func (m *ProcessedEventMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *ProcessedEventMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the ProcessedEvent entity.
+// If the ProcessedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessedEventMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *ProcessedEventMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *ProcessedEventMutation) SetEventID(s string) {
+	m.event_id = &s
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *ProcessedEventMutation) EventID() (r string, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the ProcessedEvent entity.
+// If the ProcessedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessedEventMutation) OldEventID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *ProcessedEventMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *ProcessedEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProcessedEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ProcessedEvent entity.
+// If the ProcessedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessedEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProcessedEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProcessedEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProcessedEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProcessedEvent entity.
+// If the ProcessedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessedEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProcessedEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the ProcessedEventMutation builder.
+func (m *ProcessedEventMutation) Where(ps ...predicate.ProcessedEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProcessedEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProcessedEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProcessedEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProcessedEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProcessedEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProcessedEvent).
+func (m *ProcessedEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProcessedEventMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.provider != nil {
+		fields = append(fields, processedevent.FieldProvider)
+	}
+	if m.event_id != nil {
+		fields = append(fields, processedevent.FieldEventID)
+	}
+	if m._type != nil {
+		fields = append(fields, processedevent.FieldType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, processedevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProcessedEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case processedevent.FieldProvider:
+		return m.Provider()
+	case processedevent.FieldEventID:
+		return m.EventID()
+	case processedevent.FieldType:
+		return m.GetType()
+	case processedevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProcessedEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case processedevent.FieldProvider:
+		return m.OldProvider(ctx)
+	case processedevent.FieldEventID:
+		return m.OldEventID(ctx)
+	case processedevent.FieldType:
+		return m.OldType(ctx)
+	case processedevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProcessedEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessedEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case processedevent.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case processedevent.FieldEventID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case processedevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case processedevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessedEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProcessedEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProcessedEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessedEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProcessedEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProcessedEventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProcessedEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProcessedEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProcessedEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProcessedEventMutation) ResetField(name string) error {
+	switch name {
+	case processedevent.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case processedevent.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case processedevent.FieldType:
+		m.ResetType()
+		return nil
+	case processedevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessedEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProcessedEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProcessedEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProcessedEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProcessedEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProcessedEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProcessedEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProcessedEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProcessedEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProcessedEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProcessedEvent edge %s", name)
+}
+
+// QuestionMutation represents an operation that mutates the Question nodes in the graph.
+type QuestionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	_type          *question.Type
+	title          *string
+	description    *string
+	placeholder    *string
+	required       *bool
+	sensitive      *bool
+	_order         *int
+	add_order      *int
+	options        *map[string]interface{}
+	validation     *map[string]interface{}
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	form           *int
+	clearedform    bool
+	answers        map[int]struct{}
+	removedanswers map[int]struct{}
+	clearedanswers bool
+	done           bool
+	oldValue       func(context.Context) (*Question, error)
+	predicates     []predicate.Question
+}
+
+var _ ent.Mutation = (*QuestionMutation)(nil)
+
+// questionOption allows management of the mutation configuration using functional options.
+type questionOption func(*QuestionMutation)
+
+// newQuestionMutation creates new mutation for the Question entity.
+func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
+	m := &QuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionID sets the ID field of the mutation.
+func withQuestionID(id int) questionOption {
+	return func(m *QuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Question
+		)
+		m.oldValue = func(ctx context.Context) (*Question, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Question.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestion sets the old Question of the mutation.
+func withQuestion(node *Question) questionOption {
+	return func(m *QuestionMutation) {
+		m.oldValue = func(context.Context) (*Question, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *QuestionMutation) SetType(q question.Type) {
+	m._type = &q
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *QuestionMutation) GetType() (r question.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldType(ctx context.Context) (v question.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *QuestionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *QuestionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *QuestionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *QuestionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *QuestionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *QuestionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *QuestionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[question.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *QuestionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[question.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *QuestionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, question.FieldDescription)
+}
+
+// SetPlaceholder sets the "placeholder" field.
+func (m *QuestionMutation) SetPlaceholder(s string) {
+	m.placeholder = &s
+}
+
+// Placeholder returns the value of the "placeholder" field in the mutation.
+func (m *QuestionMutation) Placeholder() (r string, exists bool) {
+	v := m.placeholder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlaceholder returns the old "placeholder" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldPlaceholder(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlaceholder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlaceholder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlaceholder: %w", err)
+	}
+	return oldValue.Placeholder, nil
+}
+
+// ClearPlaceholder clears the value of the "placeholder" field.
+func (m *QuestionMutation) ClearPlaceholder() {
+	m.placeholder = nil
+	m.clearedFields[question.FieldPlaceholder] = struct{}{}
+}
+
+// PlaceholderCleared returns if the "placeholder" field was cleared in this mutation.
+func (m *QuestionMutation) PlaceholderCleared() bool {
+	_, ok := m.clearedFields[question.FieldPlaceholder]
+	return ok
+}
+
+// ResetPlaceholder resets all changes to the "placeholder" field.
+func (m *QuestionMutation) ResetPlaceholder() {
+	m.placeholder = nil
+	delete(m.clearedFields, question.FieldPlaceholder)
+}
+
+// SetRequired sets the "required" field.
+func (m *QuestionMutation) SetRequired(b bool) {
+	m.required = &b
+}
+
+// Required returns the value of the "required" field in the mutation.
+func (m *QuestionMutation) Required() (r bool, exists bool) {
+	v := m.required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequired returns the old "required" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequired: %w", err)
+	}
+	return oldValue.Required, nil
+}
+
+// ResetRequired resets all changes to the "required" field.
+func (m *QuestionMutation) ResetRequired() {
+	m.required = nil
+}
+
+// SetSensitive sets the "sensitive" field.
+func (m *QuestionMutation) SetSensitive(b bool) {
+	m.sensitive = &b
+}
+
+// Sensitive returns the value of the "sensitive" field in the mutation.
+func (m *QuestionMutation) Sensitive() (r bool, exists bool) {
+	v := m.sensitive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSensitive returns the old "sensitive" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldSensitive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSensitive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSensitive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSensitive: %w", err)
+	}
+	return oldValue.Sensitive, nil
+}
+
+// ResetSensitive resets all changes to the "sensitive" field.
+func (m *QuestionMutation) ResetSensitive() {
+	m.sensitive = nil
+}
+
+// SetOrder sets the "order" field.
+func (m *QuestionMutation) SetOrder(i int) {
+	m._order = &i
+	m.add_order = nil
+}
+
+// Order returns the value of the "order" field in the mutation.
+func (m *QuestionMutation) Order() (r int, exists bool) {
+	v := m._order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrder returns the old "order" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrder: %w", err)
+	}
+	return oldValue.Order, nil
+}
+
+// AddOrder adds i to the "order" field.
+func (m *QuestionMutation) AddOrder(i int) {
+	if m.add_order != nil {
+		*m.add_order += i
+	} else {
+		m.add_order = &i
+	}
+}
+
+// AddedOrder returns the value that was added to the "order" field in this mutation.
+func (m *QuestionMutation) AddedOrder() (r int, exists bool) {
+	v := m.add_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOrder resets all changes to the "order" field.
+func (m *QuestionMutation) ResetOrder() {
+	m._order = nil
+	m.add_order = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *QuestionMutation) SetOptions(value map[string]interface{}) {
+	m.options = &value
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *QuestionMutation) Options() (r map[string]interface{}, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldOptions(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *QuestionMutation) ClearOptions() {
+	m.options = nil
+	m.clearedFields[question.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *QuestionMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[question.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *QuestionMutation) ResetOptions() {
+	m.options = nil
+	delete(m.clearedFields, question.FieldOptions)
+}
+
+// SetValidation sets the "validation" field.
+func (m *QuestionMutation) SetValidation(value map[string]interface{}) {
+	m.validation = &value
+}
+
+// Validation returns the value of the "validation" field in the mutation.
+func (m *QuestionMutation) Validation() (r map[string]interface{}, exists bool) {
+	v := m.validation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidation returns the old "validation" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldValidation(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidation: %w", err)
+	}
+	return oldValue.Validation, nil
+}
+
+// ClearValidation clears the value of the "validation" field.
+func (m *QuestionMutation) ClearValidation() {
+	m.validation = nil
+	m.clearedFields[question.FieldValidation] = struct{}{}
+}
+
+// ValidationCleared returns if the "validation" field was cleared in this mutation.
+func (m *QuestionMutation) ValidationCleared() bool {
+	_, ok := m.clearedFields[question.FieldValidation]
+	return ok
+}
+
+// ResetValidation resets all changes to the "validation" field.
+func (m *QuestionMutation) ResetValidation() {
+	m.validation = nil
+	delete(m.clearedFields, question.FieldValidation)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuestionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuestionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetFormID sets the "form" edge to the Form entity by id.
+func (m *QuestionMutation) SetFormID(id int) {
+	m.form = &id
+}
+
+// ClearForm clears the "form" edge to the Form entity.
+func (m *QuestionMutation) ClearForm() {
+	m.clearedform = true
+}
+
+// FormCleared reports if the "form" edge to the Form entity was cleared.
+func (m *QuestionMutation) FormCleared() bool {
+	return m.clearedform
+}
+
+// FormID returns the "form" edge ID in the mutation.
+func (m *QuestionMutation) FormID() (id int, exists bool) {
+	if m.form != nil {
+		return *m.form, true
+	}
+	return
+}
+
+// FormIDs returns the "form" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FormID instead. It exists only for internal usage by the builders.
+func (m *QuestionMutation) FormIDs() (ids []int) {
+	if id := m.form; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetForm resets all changes to the "form" edge.
+func (m *QuestionMutation) ResetForm() {
+	m.form = nil
+	m.clearedform = false
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *QuestionMutation) AddAnswerIDs(ids ...int) {
+	if m.answers == nil {
+		m.answers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *QuestionMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *QuestionMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *QuestionMutation) RemoveAnswerIDs(ids ...int) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *QuestionMutation) RemovedAnswersIDs() (ids []int) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *QuestionMutation) AnswersIDs() (ids []int) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *QuestionMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// Where appends a list predicates to the QuestionMutation builder.
+func (m *QuestionMutation) Where(ps ...predicate.Question) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Question, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Question).
+func (m *QuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m._type != nil {
+		fields = append(fields, question.FieldType)
+	}
+	if m.title != nil {
+		fields = append(fields, question.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, question.FieldDescription)
+	}
+	if m.placeholder != nil {
+		fields = append(fields, question.FieldPlaceholder)
+	}
+	if m.required != nil {
+		fields = append(fields, question.FieldRequired)
+	}
+	if m.sensitive != nil {
+		fields = append(fields, question.FieldSensitive)
+	}
+	if m._order != nil {
+		fields = append(fields, question.FieldOrder)
+	}
+	if m.options != nil {
+		fields = append(fields, question.FieldOptions)
+	}
+	if m.validation != nil {
+		fields = append(fields, question.FieldValidation)
+	}
+	if m.created_at != nil {
+		fields = append(fields, question.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, question.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldType:
+		return m.GetType()
+	case question.FieldTitle:
+		return m.Title()
+	case question.FieldDescription:
+		return m.Description()
+	case question.FieldPlaceholder:
+		return m.Placeholder()
+	case question.FieldRequired:
+		return m.Required()
+	case question.FieldSensitive:
+		return m.Sensitive()
+	case question.FieldOrder:
+		return m.Order()
+	case question.FieldOptions:
+		return m.Options()
+	case question.FieldValidation:
+		return m.Validation()
+	case question.FieldCreatedAt:
+		return m.CreatedAt()
+	case question.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case question.FieldType:
+		return m.OldType(ctx)
+	case question.FieldTitle:
+		return m.OldTitle(ctx)
+	case question.FieldDescription:
+		return m.OldDescription(ctx)
+	case question.FieldPlaceholder:
+		return m.OldPlaceholder(ctx)
+	case question.FieldRequired:
+		return m.OldRequired(ctx)
+	case question.FieldSensitive:
+		return m.OldSensitive(ctx)
+	case question.FieldOrder:
+		return m.OldOrder(ctx)
+	case question.FieldOptions:
+		return m.OldOptions(ctx)
+	case question.FieldValidation:
+		return m.OldValidation(ctx)
+	case question.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case question.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Question field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldType:
+		v, ok := value.(question.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case question.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case question.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case question.FieldPlaceholder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlaceholder(v)
+		return nil
+	case question.FieldRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequired(v)
+		return nil
+	case question.FieldSensitive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSensitive(v)
+		return nil
+	case question.FieldOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrder(v)
+		return nil
+	case question.FieldOptions:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case question.FieldValidation:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidation(v)
+		return nil
+	case question.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case question.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.add_order != nil {
+		fields = append(fields, question.FieldOrder)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldOrder:
+		return m.AddedOrder()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(question.FieldDescription) {
+		fields = append(fields, question.FieldDescription)
+	}
+	if m.FieldCleared(question.FieldPlaceholder) {
+		fields = append(fields, question.FieldPlaceholder)
+	}
+	if m.FieldCleared(question.FieldOptions) {
+		fields = append(fields, question.FieldOptions)
+	}
+	if m.FieldCleared(question.FieldValidation) {
+		fields = append(fields, question.FieldValidation)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionMutation) ClearField(name string) error {
+	switch name {
+	case question.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case question.FieldPlaceholder:
+		m.ClearPlaceholder()
+		return nil
+	case question.FieldOptions:
+		m.ClearOptions()
+		return nil
+	case question.FieldValidation:
+		m.ClearValidation()
+		return nil
+	}
+	return fmt.Errorf("unknown Question nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionMutation) ResetField(name string) error {
+	switch name {
+	case question.FieldType:
+		m.ResetType()
+		return nil
+	case question.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case question.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case question.FieldPlaceholder:
+		m.ResetPlaceholder()
+		return nil
+	case question.FieldRequired:
+		m.ResetRequired()
+		return nil
+	case question.FieldSensitive:
+		m.ResetSensitive()
+		return nil
+	case question.FieldOrder:
+		m.ResetOrder()
+		return nil
+	case question.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case question.FieldValidation:
+		m.ResetValidation()
+		return nil
+	case question.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case question.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.form != nil {
+		edges = append(edges, question.EdgeForm)
+	}
+	if m.answers != nil {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeForm:
+		if id := m.form; id != nil {
+			return []ent.Value{*id}
+		}
+	case question.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedanswers != nil {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedform {
+		edges = append(edges, question.EdgeForm)
+	}
+	if m.clearedanswers {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case question.EdgeForm:
+		return m.clearedform
+	case question.EdgeAnswers:
+		return m.clearedanswers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case question.EdgeForm:
+		m.ClearForm()
+		return nil
+	}
+	return fmt.Errorf("unknown Question unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case question.EdgeForm:
+		m.ResetForm()
+		return nil
+	case question.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	}
+	return fmt.Errorf("unknown Question edge %s", name)
+}
+
+// ReportSubscriptionMutation represents an operation that mutates the ReportSubscription nodes in the graph.
+type ReportSubscriptionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	frequency         *reportsubscription.Frequency
+	timezone          *string
+	last_period_start *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	form              *int
+	clearedform       bool
+	done              bool
+	oldValue          func(context.Context) (*ReportSubscription, error)
+	predicates        []predicate.ReportSubscription
+}
+
+var _ ent.Mutation = (*ReportSubscriptionMutation)(nil)
+
+// reportsubscriptionOption allows management of the mutation configuration using functional options.
+type reportsubscriptionOption func(*ReportSubscriptionMutation)
+
+// newReportSubscriptionMutation creates new mutation for the ReportSubscription entity.
+func newReportSubscriptionMutation(c config, op Op, opts ...reportsubscriptionOption) *ReportSubscriptionMutation {
+	m := &ReportSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReportSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReportSubscriptionID sets the ID field of the mutation.
+func withReportSubscriptionID(id int) reportsubscriptionOption {
+	return func(m *ReportSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReportSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*ReportSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReportSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReportSubscription sets the old ReportSubscription of the mutation.
+func withReportSubscription(node *ReportSubscription) reportsubscriptionOption {
+	return func(m *ReportSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*ReportSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReportSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReportSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReportSubscriptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReportSubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReportSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFrequency sets the "frequency" field.
+func (m *ReportSubscriptionMutation) SetFrequency(r reportsubscription.Frequency) {
+	m.frequency = &r
+}
+
+// Frequency returns the value of the "frequency" field in the mutation.
+func (m *ReportSubscriptionMutation) Frequency() (r reportsubscription.Frequency, exists bool) {
+	v := m.frequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrequency returns the old "frequency" field's value of the ReportSubscription entity.
+// If the ReportSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportSubscriptionMutation) OldFrequency(ctx context.Context) (v reportsubscription.Frequency, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrequency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrequency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrequency: %w", err)
+	}
+	return oldValue.Frequency, nil
+}
+
+// ResetFrequency resets all changes to the "frequency" field.
+func (m *ReportSubscriptionMutation) ResetFrequency() {
+	m.frequency = nil
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *ReportSubscriptionMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *ReportSubscriptionMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimezone returns the old "timezone" field's value of the ReportSubscription entity.
+// If the ReportSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportSubscriptionMutation) OldTimezone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+	}
+	return oldValue.Timezone, nil
+}
+
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *ReportSubscriptionMutation) ResetTimezone() {
+	m.timezone = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReportSubscriptionMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReportSubscriptionMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ReportSubscription entity.
+// If the ReportSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportSubscriptionMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReportSubscriptionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetFormID sets the "form_id" field.
+func (m *ReportSubscriptionMutation) SetFormID(i int) {
+	m.form = &i
+}
+
+// FormID returns the value of the "form_id" field in the mutation.
+func (m *ReportSubscriptionMutation) FormID() (r int, exists bool) {
+	v := m.form
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormID returns the old "form_id" field's value of the ReportSubscription entity.
+// If the ReportSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportSubscriptionMutation) OldFormID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormID: %w", err)
+	}
+	return oldValue.FormID, nil
+}
+
+// ClearFormID clears the value of the "form_id" field.
+func (m *ReportSubscriptionMutation) ClearFormID() {
+	m.form = nil
+	m.clearedFields[reportsubscription.FieldFormID] = struct{}{}
+}
+
+// FormIDCleared returns if the "form_id" field was cleared in this mutation.
+func (m *ReportSubscriptionMutation) FormIDCleared() bool {
+	_, ok := m.clearedFields[reportsubscription.FieldFormID]
+	return ok
+}
+
+// ResetFormID resets all changes to the "form_id" field.
+func (m *ReportSubscriptionMutation) ResetFormID() {
+	m.form = nil
+	delete(m.clearedFields, reportsubscription.FieldFormID)
+}
+
+// SetLastPeriodStart sets the "last_period_start" field.
+func (m *ReportSubscriptionMutation) SetLastPeriodStart(t time.Time) {
+	m.last_period_start = &t
+}
+
+// LastPeriodStart returns the value of the "last_period_start" field in the mutation.
+func (m *ReportSubscriptionMutation) LastPeriodStart() (r time.Time, exists bool) {
+	v := m.last_period_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastPeriodStart returns the old "last_period_start" field's value of the ReportSubscription entity.
+// If the ReportSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportSubscriptionMutation) OldLastPeriodStart(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastPeriodStart: %w", err)
+	}
+	return oldValue.LastPeriodStart, nil
+}
+
+// ClearLastPeriodStart clears the value of the "last_period_start" field.
+func (m *ReportSubscriptionMutation) ClearLastPeriodStart() {
+	m.last_period_start = nil
+	m.clearedFields[reportsubscription.FieldLastPeriodStart] = struct{}{}
+}
+
+// LastPeriodStartCleared returns if the "last_period_start" field was cleared in this mutation.
+func (m *ReportSubscriptionMutation) LastPeriodStartCleared() bool {
+	_, ok := m.clearedFields[reportsubscription.FieldLastPeriodStart]
+	return ok
+}
+
+// ResetLastPeriodStart resets all changes to the "last_period_start" field.
+func (m *ReportSubscriptionMutation) ResetLastPeriodStart() {
+	m.last_period_start = nil
+	delete(m.clearedFields, reportsubscription.FieldLastPeriodStart)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReportSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReportSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReportSubscription entity.
+// If the ReportSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReportSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ReportSubscriptionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[reportsubscription.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ReportSubscriptionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ReportSubscriptionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ReportSubscriptionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearForm clears the "form" edge to the Form entity.
+func (m *ReportSubscriptionMutation) ClearForm() {
+	m.clearedform = true
+	m.clearedFields[reportsubscription.FieldFormID] = struct{}{}
+}
+
+// FormCleared reports if the "form" edge to the Form entity was cleared.
+func (m *ReportSubscriptionMutation) FormCleared() bool {
+	return m.FormIDCleared() || m.clearedform
+}
+
+// FormIDs returns the "form" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FormID instead. It exists only for internal usage by the builders.
+func (m *ReportSubscriptionMutation) FormIDs() (ids []int) {
+	if id := m.form; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetForm resets all changes to the "form" edge.
+func (m *ReportSubscriptionMutation) ResetForm() {
+	m.form = nil
+	m.clearedform = false
+}
+
+// Where appends a list predicates to the ReportSubscriptionMutation builder.
+func (m *ReportSubscriptionMutation) Where(ps ...predicate.ReportSubscription) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReportSubscriptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReportSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReportSubscription, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReportSubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReportSubscriptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReportSubscription).
+func (m *ReportSubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReportSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.frequency != nil {
+		fields = append(fields, reportsubscription.FieldFrequency)
+	}
+	if m.timezone != nil {
+		fields = append(fields, reportsubscription.FieldTimezone)
+	}
+	if m.user != nil {
+		fields = append(fields, reportsubscription.FieldUserID)
+	}
+	if m.form != nil {
+		fields = append(fields, reportsubscription.FieldFormID)
+	}
+	if m.last_period_start != nil {
+		fields = append(fields, reportsubscription.FieldLastPeriodStart)
+	}
+	if m.created_at != nil {
+		fields = append(fields, reportsubscription.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReportSubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reportsubscription.FieldFrequency:
+		return m.Frequency()
+	case reportsubscription.FieldTimezone:
+		return m.Timezone()
+	case reportsubscription.FieldUserID:
+		return m.UserID()
+	case reportsubscription.FieldFormID:
+		return m.FormID()
+	case reportsubscription.FieldLastPeriodStart:
+		return m.LastPeriodStart()
+	case reportsubscription.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReportSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reportsubscription.FieldFrequency:
+		return m.OldFrequency(ctx)
+	case reportsubscription.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case reportsubscription.FieldUserID:
+		return m.OldUserID(ctx)
+	case reportsubscription.FieldFormID:
+		return m.OldFormID(ctx)
+	case reportsubscription.FieldLastPeriodStart:
+		return m.OldLastPeriodStart(ctx)
+	case reportsubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReportSubscription field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportSubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reportsubscription.FieldFrequency:
+		v, ok := value.(reportsubscription.Frequency)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrequency(v)
+		return nil
+	case reportsubscription.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case reportsubscription.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case reportsubscription.FieldFormID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormID(v)
+		return nil
+	case reportsubscription.FieldLastPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastPeriodStart(v)
+		return nil
+	case reportsubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReportSubscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReportSubscriptionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReportSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportSubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ReportSubscription numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReportSubscriptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reportsubscription.FieldFormID) {
+		fields = append(fields, reportsubscription.FieldFormID)
+	}
+	if m.FieldCleared(reportsubscription.FieldLastPeriodStart) {
+		fields = append(fields, reportsubscription.FieldLastPeriodStart)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReportSubscriptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReportSubscriptionMutation) ClearField(name string) error {
+	switch name {
+	case reportsubscription.FieldFormID:
+		m.ClearFormID()
+		return nil
+	case reportsubscription.FieldLastPeriodStart:
+		m.ClearLastPeriodStart()
+		return nil
+	}
+	return fmt.Errorf("unknown ReportSubscription nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReportSubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case reportsubscription.FieldFrequency:
+		m.ResetFrequency()
+		return nil
+	case reportsubscription.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case reportsubscription.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case reportsubscription.FieldFormID:
+		m.ResetFormID()
+		return nil
+	case reportsubscription.FieldLastPeriodStart:
+		m.ResetLastPeriodStart()
+		return nil
+	case reportsubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReportSubscription field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReportSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, reportsubscription.EdgeUser)
+	}
+	if m.form != nil {
+		edges = append(edges, reportsubscription.EdgeForm)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReportSubscriptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reportsubscription.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case reportsubscription.EdgeForm:
+		if id := m.form; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReportSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReportSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReportSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, reportsubscription.EdgeUser)
+	}
+	if m.clearedform {
+		edges = append(edges, reportsubscription.EdgeForm)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReportSubscriptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reportsubscription.EdgeUser:
+		return m.cleareduser
+	case reportsubscription.EdgeForm:
+		return m.clearedform
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReportSubscriptionMutation) ClearEdge(name string) error {
+	switch name {
+	case reportsubscription.EdgeUser:
+		m.ClearUser()
+		return nil
+	case reportsubscription.EdgeForm:
+		m.ClearForm()
+		return nil
+	}
+	return fmt.Errorf("unknown ReportSubscription unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReportSubscriptionMutation) ResetEdge(name string) error {
+	switch name {
+	case reportsubscription.EdgeUser:
+		m.ResetUser()
+		return nil
+	case reportsubscription.EdgeForm:
+		m.ResetForm()
+		return nil
+	}
+	return fmt.Errorf("unknown ReportSubscription edge %s", name)
+}
+
+// ResponseMutation represents an operation that mutates the Response nodes in the graph.
+type ResponseMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	submitted_at      *time.Time
+	completed         *bool
+	_IPAddress        *string
+	_UserAgent        *string
+	status            *response.Status
+	clearedFields     map[string]struct{}
+	form              *int
+	clearedform       bool
+	user              *int
+	cleareduser       bool
+	answers           map[int]struct{}
+	removedanswers    map[int]struct{}
+	clearedanswers    bool
+	notes             map[int]struct{}
+	removednotes      map[int]struct{}
+	clearednotes      bool
+	tags              map[int]struct{}
+	removedtags       map[int]struct{}
+	clearedtags       bool
+	activities        map[int]struct{}
+	removedactivities map[int]struct{}
+	clearedactivities bool
+	payment           *int
+	clearedpayment    bool
+	done              bool
+	oldValue          func(context.Context) (*Response, error)
+	predicates        []predicate.Response
+}
+
+var _ ent.Mutation = (*ResponseMutation)(nil)
+
+// responseOption allows management of the mutation configuration using functional options.
+type responseOption func(*ResponseMutation)
+
+// newResponseMutation creates new mutation for the Response entity.
+func newResponseMutation(c config, op Op, opts ...responseOption) *ResponseMutation {
+	m := &ResponseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResponse,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResponseID sets the ID field of the mutation.
+func withResponseID(id int) responseOption {
+	return func(m *ResponseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Response
+		)
+		m.oldValue = func(ctx context.Context) (*Response, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Response.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResponse sets the old Response of the mutation.
+func withResponse(node *Response) responseOption {
+	return func(m *ResponseMutation) {
+		m.oldValue = func(context.Context) (*Response, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResponseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResponseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResponseMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResponseMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Response.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *ResponseMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *ResponseMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the Response entity.
+// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseMutation) OldSubmittedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *ResponseMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+}
+
+// SetCompleted sets the "completed" field.
+func (m *ResponseMutation) SetCompleted(b bool) {
+	m.completed = &b
+}
+
+// Completed returns the value of the "completed" field in the mutation.
+func (m *ResponseMutation) Completed() (r bool, exists bool) {
+	v := m.completed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompleted returns the old "completed" field's value of the Response entity.
+// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseMutation) OldCompleted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompleted: %w", err)
+	}
+	return oldValue.Completed, nil
+}
+
+// ResetCompleted resets all changes to the "completed" field.
+func (m *ResponseMutation) ResetCompleted() {
+	m.completed = nil
+}
+
+// SetIPAddress sets the "IPAddress" field.
+func (m *ResponseMutation) SetIPAddress(s string) {
+	m._IPAddress = &s
+}
+
+// IPAddress returns the value of the "IPAddress" field in the mutation.
+func (m *ResponseMutation) IPAddress() (r string, exists bool) {
+	v := m._IPAddress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "IPAddress" field's value of the Response entity.
+// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "IPAddress" field.
+func (m *ResponseMutation) ClearIPAddress() {
+	m._IPAddress = nil
+	m.clearedFields[response.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "IPAddress" field was cleared in this mutation.
+func (m *ResponseMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[response.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "IPAddress" field.
+func (m *ResponseMutation) ResetIPAddress() {
+	m._IPAddress = nil
+	delete(m.clearedFields, response.FieldIPAddress)
+}
+
+// SetUserAgent sets the "UserAgent" field.
+func (m *ResponseMutation) SetUserAgent(s string) {
+	m._UserAgent = &s
+}
+
+// UserAgent returns the value of the "UserAgent" field in the mutation.
+func (m *ResponseMutation) UserAgent() (r string, exists bool) {
+	v := m._UserAgent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "UserAgent" field's value of the Response entity.
+// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "UserAgent" field.
+func (m *ResponseMutation) ClearUserAgent() {
+	m._UserAgent = nil
+	m.clearedFields[response.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "UserAgent" field was cleared in this mutation.
+func (m *ResponseMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[response.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "UserAgent" field.
+func (m *ResponseMutation) ResetUserAgent() {
+	m._UserAgent = nil
+	delete(m.clearedFields, response.FieldUserAgent)
+}
+
+// SetStatus sets the "status" field.
+func (m *ResponseMutation) SetStatus(r response.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ResponseMutation) Status() (r response.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Response entity.
+// If the Response object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseMutation) OldStatus(ctx context.Context) (v response.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ResponseMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFormID sets the "form" edge to the Form entity by id.
+func (m *ResponseMutation) SetFormID(id int) {
+	m.form = &id
+}
+
+// ClearForm clears the "form" edge to the Form entity.
+func (m *ResponseMutation) ClearForm() {
+	m.clearedform = true
+}
+
+// FormCleared reports if the "form" edge to the Form entity was cleared.
+func (m *ResponseMutation) FormCleared() bool {
+	return m.clearedform
+}
+
+// FormID returns the "form" edge ID in the mutation.
+func (m *ResponseMutation) FormID() (id int, exists bool) {
+	if m.form != nil {
+		return *m.form, true
+	}
+	return
+}
+
+// FormIDs returns the "form" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FormID instead. It exists only for internal usage by the builders.
+func (m *ResponseMutation) FormIDs() (ids []int) {
+	if id := m.form; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetForm resets all changes to the "form" edge.
+func (m *ResponseMutation) ResetForm() {
+	m.form = nil
+	m.clearedform = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ResponseMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ResponseMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ResponseMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ResponseMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ResponseMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ResponseMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *ResponseMutation) AddAnswerIDs(ids ...int) {
+	if m.answers == nil {
+		m.answers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *ResponseMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *ResponseMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *ResponseMutation) RemoveAnswerIDs(ids ...int) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *ResponseMutation) RemovedAnswersIDs() (ids []int) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *ResponseMutation) AnswersIDs() (ids []int) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *ResponseMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// AddNoteIDs adds the "notes" edge to the ResponseNote entity by ids.
+func (m *ResponseMutation) AddNoteIDs(ids ...int) {
+	if m.notes == nil {
+		m.notes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotes clears the "notes" edge to the ResponseNote entity.
+func (m *ResponseMutation) ClearNotes() {
+	m.clearednotes = true
+}
+
+// NotesCleared reports if the "notes" edge to the ResponseNote entity was cleared.
+func (m *ResponseMutation) NotesCleared() bool {
+	return m.clearednotes
+}
+
+// RemoveNoteIDs removes the "notes" edge to the ResponseNote entity by IDs.
+func (m *ResponseMutation) RemoveNoteIDs(ids ...int) {
+	if m.removednotes == nil {
+		m.removednotes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notes, ids[i])
+		m.removednotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotes returns the removed IDs of the "notes" edge to the ResponseNote entity.
+func (m *ResponseMutation) RemovedNotesIDs() (ids []int) {
+	for id := range m.removednotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotesIDs returns the "notes" edge IDs in the mutation.
+func (m *ResponseMutation) NotesIDs() (ids []int) {
+	for id := range m.notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotes resets all changes to the "notes" edge.
+func (m *ResponseMutation) ResetNotes() {
+	m.notes = nil
+	m.clearednotes = false
+	m.removednotes = nil
+}
+
+// AddTagIDs adds the "tags" edge to the ResponseTag entity by ids.
+func (m *ResponseMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the ResponseTag entity.
+func (m *ResponseMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the ResponseTag entity was cleared.
+func (m *ResponseMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the ResponseTag entity by IDs.
+func (m *ResponseMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the ResponseTag entity.
+func (m *ResponseMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *ResponseMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *ResponseMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddActivityIDs adds the "activities" edge to the ResponseActivity entity by ids.
+func (m *ResponseMutation) AddActivityIDs(ids ...int) {
+	if m.activities == nil {
+		m.activities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.activities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActivities clears the "activities" edge to the ResponseActivity entity.
+func (m *ResponseMutation) ClearActivities() {
+	m.clearedactivities = true
+}
+
+// ActivitiesCleared reports if the "activities" edge to the ResponseActivity entity was cleared.
+func (m *ResponseMutation) ActivitiesCleared() bool {
+	return m.clearedactivities
+}
+
+// RemoveActivityIDs removes the "activities" edge to the ResponseActivity entity by IDs.
+func (m *ResponseMutation) RemoveActivityIDs(ids ...int) {
+	if m.removedactivities == nil {
+		m.removedactivities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.activities, ids[i])
+		m.removedactivities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActivities returns the removed IDs of the "activities" edge to the ResponseActivity entity.
+func (m *ResponseMutation) RemovedActivitiesIDs() (ids []int) {
+	for id := range m.removedactivities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActivitiesIDs returns the "activities" edge IDs in the mutation.
+func (m *ResponseMutation) ActivitiesIDs() (ids []int) {
+	for id := range m.activities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActivities resets all changes to the "activities" edge.
+func (m *ResponseMutation) ResetActivities() {
+	m.activities = nil
+	m.clearedactivities = false
+	m.removedactivities = nil
+}
+
+// SetPaymentID sets the "payment" edge to the PaymentIntent entity by id.
+func (m *ResponseMutation) SetPaymentID(id int) {
+	m.payment = &id
+}
+
+// ClearPayment clears the "payment" edge to the PaymentIntent entity.
+func (m *ResponseMutation) ClearPayment() {
+	m.clearedpayment = true
+}
+
+// PaymentCleared reports if the "payment" edge to the PaymentIntent entity was cleared.
+func (m *ResponseMutation) PaymentCleared() bool {
+	return m.clearedpayment
+}
+
+// PaymentID returns the "payment" edge ID in the mutation.
+func (m *ResponseMutation) PaymentID() (id int, exists bool) {
+	if m.payment != nil {
+		return *m.payment, true
+	}
+	return
+}
+
+// PaymentIDs returns the "payment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PaymentID instead. It exists only for internal usage by the builders.
+func (m *ResponseMutation) PaymentIDs() (ids []int) {
+	if id := m.payment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPayment resets all changes to the "payment" edge.
+func (m *ResponseMutation) ResetPayment() {
+	m.payment = nil
+	m.clearedpayment = false
+}
+
+// Where appends a list predicates to the ResponseMutation builder.
+func (m *ResponseMutation) Where(ps ...predicate.Response) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResponseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResponseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Response, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResponseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResponseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Response).
+func (m *ResponseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResponseMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.submitted_at != nil {
+		fields = append(fields, response.FieldSubmittedAt)
+	}
+	if m.completed != nil {
+		fields = append(fields, response.FieldCompleted)
+	}
+	if m._IPAddress != nil {
+		fields = append(fields, response.FieldIPAddress)
+	}
+	if m._UserAgent != nil {
+		fields = append(fields, response.FieldUserAgent)
+	}
+	if m.status != nil {
+		fields = append(fields, response.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResponseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case response.FieldSubmittedAt:
+		return m.SubmittedAt()
+	case response.FieldCompleted:
+		return m.Completed()
+	case response.FieldIPAddress:
+		return m.IPAddress()
+	case response.FieldUserAgent:
+		return m.UserAgent()
+	case response.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResponseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case response.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	case response.FieldCompleted:
+		return m.OldCompleted(ctx)
+	case response.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case response.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case response.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown Response field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case response.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
+		return nil
+	case response.FieldCompleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompleted(v)
+		return nil
+	case response.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case response.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case response.FieldStatus:
+		v, ok := value.(response.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Response field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResponseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResponseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Response numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResponseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(response.FieldIPAddress) {
+		fields = append(fields, response.FieldIPAddress)
+	}
+	if m.FieldCleared(response.FieldUserAgent) {
+		fields = append(fields, response.FieldUserAgent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResponseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResponseMutation) ClearField(name string) error {
+	switch name {
+	case response.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case response.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown Response nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResponseMutation) ResetField(name string) error {
+	switch name {
+	case response.FieldSubmittedAt:
+		m.ResetSubmittedAt()
+		return nil
+	case response.FieldCompleted:
+		m.ResetCompleted()
+		return nil
+	case response.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case response.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case response.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Response field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResponseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.form != nil {
+		edges = append(edges, response.EdgeForm)
+	}
+	if m.user != nil {
+		edges = append(edges, response.EdgeUser)
+	}
+	if m.answers != nil {
+		edges = append(edges, response.EdgeAnswers)
+	}
+	if m.notes != nil {
+		edges = append(edges, response.EdgeNotes)
+	}
+	if m.tags != nil {
+		edges = append(edges, response.EdgeTags)
+	}
+	if m.activities != nil {
+		edges = append(edges, response.EdgeActivities)
+	}
+	if m.payment != nil {
+		edges = append(edges, response.EdgePayment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResponseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case response.EdgeForm:
+		if id := m.form; id != nil {
+			return []ent.Value{*id}
+		}
+	case response.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case response.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case response.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.notes))
+		for id := range m.notes {
+			ids = append(ids, id)
+		}
+		return ids
+	case response.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case response.EdgeActivities:
+		ids := make([]ent.Value, 0, len(m.activities))
+		for id := range m.activities {
+			ids = append(ids, id)
+		}
+		return ids
+	case response.EdgePayment:
+		if id := m.payment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResponseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedanswers != nil {
+		edges = append(edges, response.EdgeAnswers)
+	}
+	if m.removednotes != nil {
+		edges = append(edges, response.EdgeNotes)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, response.EdgeTags)
+	}
+	if m.removedactivities != nil {
+		edges = append(edges, response.EdgeActivities)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResponseMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case response.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	case response.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.removednotes))
+		for id := range m.removednotes {
+			ids = append(ids, id)
+		}
+		return ids
+	case response.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case response.EdgeActivities:
+		ids := make([]ent.Value, 0, len(m.removedactivities))
+		for id := range m.removedactivities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResponseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedform {
+		edges = append(edges, response.EdgeForm)
+	}
+	if m.cleareduser {
+		edges = append(edges, response.EdgeUser)
+	}
+	if m.clearedanswers {
+		edges = append(edges, response.EdgeAnswers)
+	}
+	if m.clearednotes {
+		edges = append(edges, response.EdgeNotes)
+	}
+	if m.clearedtags {
+		edges = append(edges, response.EdgeTags)
+	}
+	if m.clearedactivities {
+		edges = append(edges, response.EdgeActivities)
+	}
+	if m.clearedpayment {
+		edges = append(edges, response.EdgePayment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResponseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case response.EdgeForm:
+		return m.clearedform
+	case response.EdgeUser:
+		return m.cleareduser
+	case response.EdgeAnswers:
+		return m.clearedanswers
+	case response.EdgeNotes:
+		return m.clearednotes
+	case response.EdgeTags:
+		return m.clearedtags
+	case response.EdgeActivities:
+		return m.clearedactivities
+	case response.EdgePayment:
+		return m.clearedpayment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResponseMutation) ClearEdge(name string) error {
+	switch name {
+	case response.EdgeForm:
+		m.ClearForm()
+		return nil
+	case response.EdgeUser:
+		m.ClearUser()
+		return nil
+	case response.EdgePayment:
+		m.ClearPayment()
+		return nil
+	}
+	return fmt.Errorf("unknown Response unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResponseMutation) ResetEdge(name string) error {
+	switch name {
+	case response.EdgeForm:
+		m.ResetForm()
+		return nil
+	case response.EdgeUser:
+		m.ResetUser()
+		return nil
+	case response.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	case response.EdgeNotes:
+		m.ResetNotes()
+		return nil
+	case response.EdgeTags:
+		m.ResetTags()
+		return nil
+	case response.EdgeActivities:
+		m.ResetActivities()
+		return nil
+	case response.EdgePayment:
+		m.ResetPayment()
+		return nil
+	}
+	return fmt.Errorf("unknown Response edge %s", name)
+}
+
+// ResponseActivityMutation represents an operation that mutates the ResponseActivity nodes in the graph.
+type ResponseActivityMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	action          *responseactivity.Action
+	changes         *map[string]interface{}
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	response        *int
+	clearedresponse bool
+	actor           *int
+	clearedactor    bool
+	done            bool
+	oldValue        func(context.Context) (*ResponseActivity, error)
+	predicates      []predicate.ResponseActivity
+}
+
+var _ ent.Mutation = (*ResponseActivityMutation)(nil)
+
+// responseactivityOption allows management of the mutation configuration using functional options.
+type responseactivityOption func(*ResponseActivityMutation)
+
+// newResponseActivityMutation creates new mutation for the ResponseActivity entity.
+func newResponseActivityMutation(c config, op Op, opts ...responseactivityOption) *ResponseActivityMutation {
+	m := &ResponseActivityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResponseActivity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResponseActivityID sets the ID field of the mutation.
+func withResponseActivityID(id int) responseactivityOption {
+	return func(m *ResponseActivityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResponseActivity
+		)
+		m.oldValue = func(ctx context.Context) (*ResponseActivity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResponseActivity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResponseActivity sets the old ResponseActivity of the mutation.
+func withResponseActivity(node *ResponseActivity) responseactivityOption {
+	return func(m *ResponseActivityMutation) {
+		m.oldValue = func(context.Context) (*ResponseActivity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResponseActivityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResponseActivityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResponseActivityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResponseActivityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResponseActivity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAction sets the "action" field.
+func (m *ResponseActivityMutation) SetAction(r responseactivity.Action) {
+	m.action = &r
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ResponseActivityMutation) Action() (r responseactivity.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ResponseActivity entity.
+// If the ResponseActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseActivityMutation) OldAction(ctx context.Context) (v responseactivity.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ResponseActivityMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *ResponseActivityMutation) SetChanges(value map[string]interface{}) {
+	m.changes = &value
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *ResponseActivityMutation) Changes() (r map[string]interface{}, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the ResponseActivity entity.
+// If the ResponseActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseActivityMutation) OldChanges(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *ResponseActivityMutation) ClearChanges() {
+	m.changes = nil
+	m.clearedFields[responseactivity.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *ResponseActivityMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[responseactivity.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *ResponseActivityMutation) ResetChanges() {
+	m.changes = nil
+	delete(m.clearedFields, responseactivity.FieldChanges)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ResponseActivityMutation) SetUserID(i int) {
+	m.actor = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ResponseActivityMutation) UserID() (r int, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ResponseActivity entity.
+// If the ResponseActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseActivityMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ResponseActivityMutation) ResetUserID() {
+	m.actor = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResponseActivityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResponseActivityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResponseActivity entity.
+// If the ResponseActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseActivityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResponseActivityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetResponseID sets the "response" edge to the Response entity by id.
+func (m *ResponseActivityMutation) SetResponseID(id int) {
+	m.response = &id
+}
+
+// ClearResponse clears the "response" edge to the Response entity.
+func (m *ResponseActivityMutation) ClearResponse() {
+	m.clearedresponse = true
+}
+
+// ResponseCleared reports if the "response" edge to the Response entity was cleared.
+func (m *ResponseActivityMutation) ResponseCleared() bool {
+	return m.clearedresponse
+}
+
+// ResponseID returns the "response" edge ID in the mutation.
+func (m *ResponseActivityMutation) ResponseID() (id int, exists bool) {
+	if m.response != nil {
+		return *m.response, true
+	}
+	return
+}
+
+// ResponseIDs returns the "response" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResponseID instead. It exists only for internal usage by the builders.
+func (m *ResponseActivityMutation) ResponseIDs() (ids []int) {
+	if id := m.response; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResponse resets all changes to the "response" edge.
+func (m *ResponseActivityMutation) ResetResponse() {
+	m.response = nil
+	m.clearedresponse = false
+}
+
+// SetActorID sets the "actor" edge to the User entity by id.
+func (m *ResponseActivityMutation) SetActorID(id int) {
+	m.actor = &id
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (m *ResponseActivityMutation) ClearActor() {
+	m.clearedactor = true
+	m.clearedFields[responseactivity.FieldUserID] = struct{}{}
+}
+
+// ActorCleared reports if the "actor" edge to the User entity was cleared.
+func (m *ResponseActivityMutation) ActorCleared() bool {
+	return m.clearedactor
+}
+
+// ActorID returns the "actor" edge ID in the mutation.
+func (m *ResponseActivityMutation) ActorID() (id int, exists bool) {
+	if m.actor != nil {
+		return *m.actor, true
+	}
+	return
+}
+
+// ActorIDs returns the "actor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActorID instead. It exists only for internal usage by the builders.
+func (m *ResponseActivityMutation) ActorIDs() (ids []int) {
+	if id := m.actor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetActor resets all changes to the "actor" edge.
+func (m *ResponseActivityMutation) ResetActor() {
+	m.actor = nil
+	m.clearedactor = false
+}
+
+// Where appends a list predicates to the ResponseActivityMutation builder.
+func (m *ResponseActivityMutation) Where(ps ...predicate.ResponseActivity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResponseActivityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResponseActivityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResponseActivity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResponseActivityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResponseActivityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ResponseActivity).
+func (m *ResponseActivityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResponseActivityMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.action != nil {
+		fields = append(fields, responseactivity.FieldAction)
+	}
+	if m.changes != nil {
+		fields = append(fields, responseactivity.FieldChanges)
+	}
+	if m.actor != nil {
+		fields = append(fields, responseactivity.FieldUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, responseactivity.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResponseActivityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case responseactivity.FieldAction:
+		return m.Action()
+	case responseactivity.FieldChanges:
+		return m.Changes()
+	case responseactivity.FieldUserID:
+		return m.UserID()
+	case responseactivity.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResponseActivityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case responseactivity.FieldAction:
+		return m.OldAction(ctx)
+	case responseactivity.FieldChanges:
+		return m.OldChanges(ctx)
+	case responseactivity.FieldUserID:
+		return m.OldUserID(ctx)
+	case responseactivity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResponseActivity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseActivityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case responseactivity.FieldAction:
+		v, ok := value.(responseactivity.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case responseactivity.FieldChanges:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case responseactivity.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case responseactivity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseActivity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResponseActivityMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResponseActivityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseActivityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ResponseActivity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResponseActivityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(responseactivity.FieldChanges) {
+		fields = append(fields, responseactivity.FieldChanges)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResponseActivityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResponseActivityMutation) ClearField(name string) error {
+	switch name {
+	case responseactivity.FieldChanges:
+		m.ClearChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseActivity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResponseActivityMutation) ResetField(name string) error {
+	switch name {
+	case responseactivity.FieldAction:
+		m.ResetAction()
+		return nil
+	case responseactivity.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case responseactivity.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case responseactivity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseActivity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResponseActivityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.response != nil {
+		edges = append(edges, responseactivity.EdgeResponse)
+	}
+	if m.actor != nil {
+		edges = append(edges, responseactivity.EdgeActor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResponseActivityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case responseactivity.EdgeResponse:
+		if id := m.response; id != nil {
+			return []ent.Value{*id}
+		}
+	case responseactivity.EdgeActor:
+		if id := m.actor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResponseActivityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResponseActivityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResponseActivityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedresponse {
+		edges = append(edges, responseactivity.EdgeResponse)
+	}
+	if m.clearedactor {
+		edges = append(edges, responseactivity.EdgeActor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResponseActivityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case responseactivity.EdgeResponse:
+		return m.clearedresponse
+	case responseactivity.EdgeActor:
+		return m.clearedactor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResponseActivityMutation) ClearEdge(name string) error {
+	switch name {
+	case responseactivity.EdgeResponse:
+		m.ClearResponse()
+		return nil
+	case responseactivity.EdgeActor:
+		m.ClearActor()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseActivity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResponseActivityMutation) ResetEdge(name string) error {
+	switch name {
+	case responseactivity.EdgeResponse:
+		m.ResetResponse()
+		return nil
+	case responseactivity.EdgeActor:
+		m.ResetActor()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseActivity edge %s", name)
+}
+
+// ResponseNoteMutation represents an operation that mutates the ResponseNote nodes in the graph.
+type ResponseNoteMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	body            *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	response        *int
+	clearedresponse bool
+	author          *int
+	clearedauthor   bool
+	done            bool
+	oldValue        func(context.Context) (*ResponseNote, error)
+	predicates      []predicate.ResponseNote
+}
+
+var _ ent.Mutation = (*ResponseNoteMutation)(nil)
+
+// responsenoteOption allows management of the mutation configuration using functional options.
+type responsenoteOption func(*ResponseNoteMutation)
+
+// newResponseNoteMutation creates new mutation for the ResponseNote entity.
+func newResponseNoteMutation(c config, op Op, opts ...responsenoteOption) *ResponseNoteMutation {
+	m := &ResponseNoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResponseNote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResponseNoteID sets the ID field of the mutation.
+func withResponseNoteID(id int) responsenoteOption {
+	return func(m *ResponseNoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResponseNote
+		)
+		m.oldValue = func(ctx context.Context) (*ResponseNote, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResponseNote.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResponseNote sets the old ResponseNote of the mutation.
+func withResponseNote(node *ResponseNote) responsenoteOption {
+	return func(m *ResponseNoteMutation) {
+		m.oldValue = func(context.Context) (*ResponseNote, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResponseNoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResponseNoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResponseNoteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResponseNoteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResponseNote.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBody sets the "body" field.
+func (m *ResponseNoteMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *ResponseNoteMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the ResponseNote entity.
+// If the ResponseNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseNoteMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *ResponseNoteMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ResponseNoteMutation) SetUserID(i int) {
+	m.author = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ResponseNoteMutation) UserID() (r int, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ResponseNote entity.
+// If the ResponseNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseNoteMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ResponseNoteMutation) ResetUserID() {
+	m.author = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResponseNoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResponseNoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResponseNote entity.
+// If the ResponseNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseNoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResponseNoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetResponseID sets the "response" edge to the Response entity by id.
+func (m *ResponseNoteMutation) SetResponseID(id int) {
+	m.response = &id
+}
+
+// ClearResponse clears the "response" edge to the Response entity.
+func (m *ResponseNoteMutation) ClearResponse() {
+	m.clearedresponse = true
+}
+
+// ResponseCleared reports if the "response" edge to the Response entity was cleared.
+func (m *ResponseNoteMutation) ResponseCleared() bool {
+	return m.clearedresponse
+}
+
+// ResponseID returns the "response" edge ID in the mutation.
+func (m *ResponseNoteMutation) ResponseID() (id int, exists bool) {
+	if m.response != nil {
+		return *m.response, true
+	}
+	return
+}
+
+// ResponseIDs returns the "response" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResponseID instead. It exists only for internal usage by the builders.
+func (m *ResponseNoteMutation) ResponseIDs() (ids []int) {
+	if id := m.response; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResponse resets all changes to the "response" edge.
+func (m *ResponseNoteMutation) ResetResponse() {
+	m.response = nil
+	m.clearedresponse = false
+}
+
+// SetAuthorID sets the "author" edge to the User entity by id.
+func (m *ResponseNoteMutation) SetAuthorID(id int) {
+	m.author = &id
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (m *ResponseNoteMutation) ClearAuthor() {
+	m.clearedauthor = true
+	m.clearedFields[responsenote.FieldUserID] = struct{}{}
+}
+
+// AuthorCleared reports if the "author" edge to the User entity was cleared.
+func (m *ResponseNoteMutation) AuthorCleared() bool {
+	return m.clearedauthor
+}
+
+// AuthorID returns the "author" edge ID in the mutation.
+func (m *ResponseNoteMutation) AuthorID() (id int, exists bool) {
+	if m.author != nil {
+		return *m.author, true
+	}
+	return
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *ResponseNoteMutation) AuthorIDs() (ids []int) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *ResponseNoteMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// Where appends a list predicates to the ResponseNoteMutation builder.
+func (m *ResponseNoteMutation) Where(ps ...predicate.ResponseNote) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResponseNoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResponseNoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResponseNote, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResponseNoteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResponseNoteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ResponseNote).
+func (m *ResponseNoteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResponseNoteMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.body != nil {
+		fields = append(fields, responsenote.FieldBody)
+	}
+	if m.author != nil {
+		fields = append(fields, responsenote.FieldUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, responsenote.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResponseNoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case responsenote.FieldBody:
+		return m.Body()
+	case responsenote.FieldUserID:
+		return m.UserID()
+	case responsenote.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResponseNoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case responsenote.FieldBody:
+		return m.OldBody(ctx)
+	case responsenote.FieldUserID:
+		return m.OldUserID(ctx)
+	case responsenote.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResponseNote field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseNoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case responsenote.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case responsenote.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case responsenote.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseNote field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResponseNoteMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResponseNoteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseNoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ResponseNote numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResponseNoteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResponseNoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResponseNoteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ResponseNote nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResponseNoteMutation) ResetField(name string) error {
+	switch name {
+	case responsenote.FieldBody:
+		m.ResetBody()
+		return nil
+	case responsenote.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case responsenote.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseNote field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResponseNoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.response != nil {
+		edges = append(edges, responsenote.EdgeResponse)
+	}
+	if m.author != nil {
+		edges = append(edges, responsenote.EdgeAuthor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResponseNoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case responsenote.EdgeResponse:
+		if id := m.response; id != nil {
+			return []ent.Value{*id}
+		}
+	case responsenote.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResponseNoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResponseNoteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResponseNoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedresponse {
+		edges = append(edges, responsenote.EdgeResponse)
+	}
+	if m.clearedauthor {
+		edges = append(edges, responsenote.EdgeAuthor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResponseNoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case responsenote.EdgeResponse:
+		return m.clearedresponse
+	case responsenote.EdgeAuthor:
+		return m.clearedauthor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResponseNoteMutation) ClearEdge(name string) error {
+	switch name {
+	case responsenote.EdgeResponse:
+		m.ClearResponse()
+		return nil
+	case responsenote.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseNote unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResponseNoteMutation) ResetEdge(name string) error {
+	switch name {
+	case responsenote.EdgeResponse:
+		m.ResetResponse()
+		return nil
+	case responsenote.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseNote edge %s", name)
+}
+
+// ResponseTagMutation represents an operation that mutates the ResponseTag nodes in the graph.
+type ResponseTagMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	owner            *int
+	clearedowner     bool
+	responses        map[int]struct{}
+	removedresponses map[int]struct{}
+	clearedresponses bool
+	done             bool
+	oldValue         func(context.Context) (*ResponseTag, error)
+	predicates       []predicate.ResponseTag
+}
+
+var _ ent.Mutation = (*ResponseTagMutation)(nil)
+
+// responsetagOption allows management of the mutation configuration using functional options.
+type responsetagOption func(*ResponseTagMutation)
+
+// newResponseTagMutation creates new mutation for the ResponseTag entity.
+func newResponseTagMutation(c config, op Op, opts ...responsetagOption) *ResponseTagMutation {
+	m := &ResponseTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResponseTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResponseTagID sets the ID field of the mutation.
+func withResponseTagID(id int) responsetagOption {
+	return func(m *ResponseTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResponseTag
+		)
+		m.oldValue = func(ctx context.Context) (*ResponseTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResponseTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResponseTag sets the old ResponseTag of the mutation.
+func withResponseTag(node *ResponseTag) responsetagOption {
+	return func(m *ResponseTagMutation) {
+		m.oldValue = func(context.Context) (*ResponseTag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResponseTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResponseTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResponseTagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResponseTagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResponseTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ResponseTagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ResponseTagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ResponseTag entity.
+// If the ResponseTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseTagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ResponseTagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ResponseTagMutation) SetUserID(i int) {
+	m.owner = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ResponseTagMutation) UserID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ResponseTag entity.
+// If the ResponseTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseTagMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ResponseTagMutation) ResetUserID() {
+	m.owner = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResponseTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResponseTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResponseTag entity.
+// If the ResponseTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResponseTagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ResponseTagMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ResponseTagMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[responsetag.FieldUserID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ResponseTagMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ResponseTagMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ResponseTagMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ResponseTagMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddResponseIDs adds the "responses" edge to the Response entity by ids.
+func (m *ResponseTagMutation) AddResponseIDs(ids ...int) {
+	if m.responses == nil {
+		m.responses = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.responses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearResponses clears the "responses" edge to the Response entity.
+func (m *ResponseTagMutation) ClearResponses() {
+	m.clearedresponses = true
+}
+
+// ResponsesCleared reports if the "responses" edge to the Response entity was cleared.
+func (m *ResponseTagMutation) ResponsesCleared() bool {
+	return m.clearedresponses
+}
+
+// RemoveResponseIDs removes the "responses" edge to the Response entity by IDs.
+func (m *ResponseTagMutation) RemoveResponseIDs(ids ...int) {
+	if m.removedresponses == nil {
+		m.removedresponses = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.responses, ids[i])
+		m.removedresponses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedResponses returns the removed IDs of the "responses" edge to the Response entity.
+func (m *ResponseTagMutation) RemovedResponsesIDs() (ids []int) {
+	for id := range m.removedresponses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResponsesIDs returns the "responses" edge IDs in the mutation.
+func (m *ResponseTagMutation) ResponsesIDs() (ids []int) {
+	for id := range m.responses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetResponses resets all changes to the "responses" edge.
+func (m *ResponseTagMutation) ResetResponses() {
+	m.responses = nil
+	m.clearedresponses = false
+	m.removedresponses = nil
+}
+
+// Where appends a list predicates to the ResponseTagMutation builder.
+func (m *ResponseTagMutation) Where(ps ...predicate.ResponseTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResponseTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResponseTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResponseTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResponseTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResponseTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ResponseTag).
+func (m *ResponseTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResponseTagMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, responsetag.FieldName)
+	}
+	if m.owner != nil {
+		fields = append(fields, responsetag.FieldUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, responsetag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResponseTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case responsetag.FieldName:
+		return m.Name()
+	case responsetag.FieldUserID:
+		return m.UserID()
+	case responsetag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResponseTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case responsetag.FieldName:
+		return m.OldName(ctx)
+	case responsetag.FieldUserID:
+		return m.OldUserID(ctx)
+	case responsetag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResponseTag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case responsetag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case responsetag.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case responsetag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResponseTagMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResponseTagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ResponseTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResponseTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResponseTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResponseTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ResponseTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResponseTagMutation) ResetField(name string) error {
+	switch name {
+	case responsetag.FieldName:
+		m.ResetName()
+		return nil
+	case responsetag.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case responsetag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResponseTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, responsetag.EdgeOwner)
+	}
+	if m.responses != nil {
+		edges = append(edges, responsetag.EdgeResponses)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResponseTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case responsetag.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case responsetag.EdgeResponses:
+		ids := make([]ent.Value, 0, len(m.responses))
+		for id := range m.responses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResponseTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedresponses != nil {
+		edges = append(edges, responsetag.EdgeResponses)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResponseTagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case responsetag.EdgeResponses:
+		ids := make([]ent.Value, 0, len(m.removedresponses))
+		for id := range m.removedresponses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResponseTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, responsetag.EdgeOwner)
+	}
+	if m.clearedresponses {
+		edges = append(edges, responsetag.EdgeResponses)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResponseTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case responsetag.EdgeOwner:
+		return m.clearedowner
+	case responsetag.EdgeResponses:
+		return m.clearedresponses
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResponseTagMutation) ClearEdge(name string) error {
+	switch name {
+	case responsetag.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResponseTagMutation) ResetEdge(name string) error {
+	switch name {
+	case responsetag.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case responsetag.EdgeResponses:
+		m.ResetResponses()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseTag edge %s", name)
+}
+
+// SubscriptionMutation represents an operation that mutates the Subscription nodes in the graph.
+type SubscriptionMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	provider_subscription_id *string
+	provider                 *string
+	status                   *subscription.Status
+	price_id                 *string
+	amount                   *int64
+	addamount                *int64
+	currency                 *string
+	interval                 *subscription.Interval
+	interval_count           *int
+	addinterval_count        *int
+	current_period_start     *time.Time
+	current_period_end       *time.Time
+	trial_start              *time.Time
+	trial_end                *time.Time
+	canceled_at              *time.Time
+	ended_at                 *time.Time
+	cancel_at_period_end     *bool
+	pending_price_id         *string
+	pending_change_at        *time.Time
+	past_due_at              *time.Time
+	dunning_reminders        *int
+	adddunning_reminders     *int
+	trial_reminded_at        *time.Time
+	last_event_at            *time.Time
+	metadata                 *map[string]interface{}
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	customer                 *int
+	clearedcustomer          bool
+	discounts                map[int]struct{}
+	removeddiscounts         map[int]struct{}
+	cleareddiscounts         bool
+	done                     bool
+	oldValue                 func(context.Context) (*Subscription, error)
+	predicates               []predicate.Subscription
+}
+
+var _ ent.Mutation = (*SubscriptionMutation)(nil)
+
+// subscriptionOption allows management of the mutation configuration using functional options.
+type subscriptionOption func(*SubscriptionMutation)
+
+// newSubscriptionMutation creates new mutation for the Subscription entity.
+func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
+	m := &SubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSubscriptionID sets the ID field of the mutation.
+func withSubscriptionID(id int) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Subscription
+		)
+		m.oldValue = func(ctx context.Context) (*Subscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Subscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSubscription sets the old Subscription of the mutation.
+func withSubscription(node *Subscription) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		m.oldValue = func(context.Context) (*Subscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SubscriptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Subscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (m *SubscriptionMutation) SetProviderSubscriptionID(s string) {
+	m.provider_subscription_id = &s
+}
+
+// ProviderSubscriptionID returns the value of the "provider_subscription_id" field in the mutation.
+func (m *SubscriptionMutation) ProviderSubscriptionID() (r string, exists bool) {
+	v := m.provider_subscription_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderSubscriptionID returns the old "provider_subscription_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldProviderSubscriptionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderSubscriptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderSubscriptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderSubscriptionID: %w", err)
+	}
+	return oldValue.ProviderSubscriptionID, nil
+}
+
+// ResetProviderSubscriptionID resets all changes to the "provider_subscription_id" field.
+func (m *SubscriptionMutation) ResetProviderSubscriptionID() {
+	m.provider_subscription_id = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *SubscriptionMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *SubscriptionMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *SubscriptionMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SubscriptionMutation) SetStatus(s subscription.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SubscriptionMutation) Status() (r subscription.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldStatus(ctx context.Context) (v subscription.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SubscriptionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPriceID sets the "price_id" field.
+func (m *SubscriptionMutation) SetPriceID(s string) {
+	m.price_id = &s
+}
+
+// PriceID returns the value of the "price_id" field in the mutation.
+func (m *SubscriptionMutation) PriceID() (r string, exists bool) {
+	v := m.price_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceID returns the old "price_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldPriceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceID: %w", err)
+	}
+	return oldValue.PriceID, nil
+}
+
+// ResetPriceID resets all changes to the "price_id" field.
+func (m *SubscriptionMutation) ResetPriceID() {
+	m.price_id = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *SubscriptionMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *SubscriptionMutation) Amount() (r int64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldAmount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *SubscriptionMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *SubscriptionMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *SubscriptionMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *SubscriptionMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *SubscriptionMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *SubscriptionMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetInterval sets the "interval" field.
+func (m *SubscriptionMutation) SetInterval(s subscription.Interval) {
+	m.interval = &s
+}
+
+// Interval returns the value of the "interval" field in the mutation.
+func (m *SubscriptionMutation) Interval() (r subscription.Interval, exists bool) {
+	v := m.interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterval returns the old "interval" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldInterval(ctx context.Context) (v subscription.Interval, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterval: %w", err)
+	}
+	return oldValue.Interval, nil
+}
+
+// ResetInterval resets all changes to the "interval" field.
+func (m *SubscriptionMutation) ResetInterval() {
+	m.interval = nil
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (m *SubscriptionMutation) SetIntervalCount(i int) {
+	m.interval_count = &i
+	m.addinterval_count = nil
+}
+
+// IntervalCount returns the value of the "interval_count" field in the mutation.
+func (m *SubscriptionMutation) IntervalCount() (r int, exists bool) {
+	v := m.interval_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntervalCount returns the old "interval_count" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldIntervalCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntervalCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntervalCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntervalCount: %w", err)
+	}
+	return oldValue.IntervalCount, nil
+}
+
+// AddIntervalCount adds i to the "interval_count" field.
+func (m *SubscriptionMutation) AddIntervalCount(i int) {
+	if m.addinterval_count != nil {
+		*m.addinterval_count += i
+	} else {
+		m.addinterval_count = &i
+	}
+}
+
+// AddedIntervalCount returns the value that was added to the "interval_count" field in this mutation.
+func (m *SubscriptionMutation) AddedIntervalCount() (r int, exists bool) {
+	v := m.addinterval_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIntervalCount resets all changes to the "interval_count" field.
+func (m *SubscriptionMutation) ResetIntervalCount() {
+	m.interval_count = nil
+	m.addinterval_count = nil
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (m *SubscriptionMutation) SetCurrentPeriodStart(t time.Time) {
+	m.current_period_start = &t
+}
+
+// CurrentPeriodStart returns the value of the "current_period_start" field in the mutation.
+func (m *SubscriptionMutation) CurrentPeriodStart() (r time.Time, exists bool) {
+	v := m.current_period_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentPeriodStart returns the old "current_period_start" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCurrentPeriodStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentPeriodStart: %w", err)
+	}
+	return oldValue.CurrentPeriodStart, nil
+}
+
+// ClearCurrentPeriodStart clears the value of the "current_period_start" field.
+func (m *SubscriptionMutation) ClearCurrentPeriodStart() {
+	m.current_period_start = nil
+	m.clearedFields[subscription.FieldCurrentPeriodStart] = struct{}{}
+}
+
+// CurrentPeriodStartCleared returns if the "current_period_start" field was cleared in this mutation.
+func (m *SubscriptionMutation) CurrentPeriodStartCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldCurrentPeriodStart]
+	return ok
+}
+
+// ResetCurrentPeriodStart resets all changes to the "current_period_start" field.
+func (m *SubscriptionMutation) ResetCurrentPeriodStart() {
+	m.current_period_start = nil
+	delete(m.clearedFields, subscription.FieldCurrentPeriodStart)
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (m *SubscriptionMutation) SetCurrentPeriodEnd(t time.Time) {
+	m.current_period_end = &t
+}
+
+// CurrentPeriodEnd returns the value of the "current_period_end" field in the mutation.
+func (m *SubscriptionMutation) CurrentPeriodEnd() (r time.Time, exists bool) {
+	v := m.current_period_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentPeriodEnd returns the old "current_period_end" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCurrentPeriodEnd(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentPeriodEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentPeriodEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentPeriodEnd: %w", err)
+	}
+	return oldValue.CurrentPeriodEnd, nil
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (m *SubscriptionMutation) ClearCurrentPeriodEnd() {
+	m.current_period_end = nil
+	m.clearedFields[subscription.FieldCurrentPeriodEnd] = struct{}{}
+}
+
+// CurrentPeriodEndCleared returns if the "current_period_end" field was cleared in this mutation.
+func (m *SubscriptionMutation) CurrentPeriodEndCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldCurrentPeriodEnd]
+	return ok
+}
+
+// ResetCurrentPeriodEnd resets all changes to the "current_period_end" field.
+func (m *SubscriptionMutation) ResetCurrentPeriodEnd() {
+	m.current_period_end = nil
+	delete(m.clearedFields, subscription.FieldCurrentPeriodEnd)
+}
+
+// SetTrialStart sets the "trial_start" field.
+func (m *SubscriptionMutation) SetTrialStart(t time.Time) {
+	m.trial_start = &t
+}
+
+// TrialStart returns the value of the "trial_start" field in the mutation.
+func (m *SubscriptionMutation) TrialStart() (r time.Time, exists bool) {
+	v := m.trial_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialStart returns the old "trial_start" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldTrialStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialStart: %w", err)
+	}
+	return oldValue.TrialStart, nil
+}
+
+// ClearTrialStart clears the value of the "trial_start" field.
+func (m *SubscriptionMutation) ClearTrialStart() {
+	m.trial_start = nil
+	m.clearedFields[subscription.FieldTrialStart] = struct{}{}
+}
+
+// TrialStartCleared returns if the "trial_start" field was cleared in this mutation.
+func (m *SubscriptionMutation) TrialStartCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldTrialStart]
+	return ok
+}
+
+// ResetTrialStart resets all changes to the "trial_start" field.
+func (m *SubscriptionMutation) ResetTrialStart() {
+	m.trial_start = nil
+	delete(m.clearedFields, subscription.FieldTrialStart)
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (m *SubscriptionMutation) SetTrialEnd(t time.Time) {
+	m.trial_end = &t
+}
+
+// TrialEnd returns the value of the "trial_end" field in the mutation.
+func (m *SubscriptionMutation) TrialEnd() (r time.Time, exists bool) {
+	v := m.trial_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialEnd returns the old "trial_end" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldTrialEnd(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialEnd: %w", err)
+	}
+	return oldValue.TrialEnd, nil
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (m *SubscriptionMutation) ClearTrialEnd() {
+	m.trial_end = nil
+	m.clearedFields[subscription.FieldTrialEnd] = struct{}{}
+}
+
+// TrialEndCleared returns if the "trial_end" field was cleared in this mutation.
+func (m *SubscriptionMutation) TrialEndCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldTrialEnd]
+	return ok
+}
+
+// ResetTrialEnd resets all changes to the "trial_end" field.
+func (m *SubscriptionMutation) ResetTrialEnd() {
+	m.trial_end = nil
+	delete(m.clearedFields, subscription.FieldTrialEnd)
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (m *SubscriptionMutation) SetCanceledAt(t time.Time) {
+	m.canceled_at = &t
+}
+
+// CanceledAt returns the value of the "canceled_at" field in the mutation.
+func (m *SubscriptionMutation) CanceledAt() (r time.Time, exists bool) {
+	v := m.canceled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanceledAt returns the old "canceled_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCanceledAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanceledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanceledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanceledAt: %w", err)
+	}
+	return oldValue.CanceledAt, nil
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (m *SubscriptionMutation) ClearCanceledAt() {
+	m.canceled_at = nil
+	m.clearedFields[subscription.FieldCanceledAt] = struct{}{}
+}
+
+// CanceledAtCleared returns if the "canceled_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) CanceledAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldCanceledAt]
+	return ok
+}
+
+// ResetCanceledAt resets all changes to the "canceled_at" field.
+func (m *SubscriptionMutation) ResetCanceledAt() {
+	m.canceled_at = nil
+	delete(m.clearedFields, subscription.FieldCanceledAt)
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *SubscriptionMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *SubscriptionMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldEndedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *SubscriptionMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[subscription.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *SubscriptionMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, subscription.FieldEndedAt)
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (m *SubscriptionMutation) SetCancelAtPeriodEnd(b bool) {
+	m.cancel_at_period_end = &b
+}
+
+// CancelAtPeriodEnd returns the value of the "cancel_at_period_end" field in the mutation.
+func (m *SubscriptionMutation) CancelAtPeriodEnd() (r bool, exists bool) {
+	v := m.cancel_at_period_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelAtPeriodEnd returns the old "cancel_at_period_end" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCancelAtPeriodEnd(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelAtPeriodEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelAtPeriodEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelAtPeriodEnd: %w", err)
+	}
+	return oldValue.CancelAtPeriodEnd, nil
+}
+
+// ResetCancelAtPeriodEnd resets all changes to the "cancel_at_period_end" field.
+func (m *SubscriptionMutation) ResetCancelAtPeriodEnd() {
+	m.cancel_at_period_end = nil
+}
+
+// SetPendingPriceID sets the "pending_price_id" field.
+func (m *SubscriptionMutation) SetPendingPriceID(s string) {
+	m.pending_price_id = &s
+}
+
+// PendingPriceID returns the value of the "pending_price_id" field in the mutation.
+func (m *SubscriptionMutation) PendingPriceID() (r string, exists bool) {
+	v := m.pending_price_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingPriceID returns the old "pending_price_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldPendingPriceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingPriceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingPriceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingPriceID: %w", err)
+	}
+	return oldValue.PendingPriceID, nil
+}
+
+// ClearPendingPriceID clears the value of the "pending_price_id" field.
+func (m *SubscriptionMutation) ClearPendingPriceID() {
+	m.pending_price_id = nil
+	m.clearedFields[subscription.FieldPendingPriceID] = struct{}{}
+}
+
+// PendingPriceIDCleared returns if the "pending_price_id" field was cleared in this mutation.
+func (m *SubscriptionMutation) PendingPriceIDCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldPendingPriceID]
+	return ok
+}
+
+// ResetPendingPriceID resets all changes to the "pending_price_id" field.
+func (m *SubscriptionMutation) ResetPendingPriceID() {
+	m.pending_price_id = nil
+	delete(m.clearedFields, subscription.FieldPendingPriceID)
+}
+
+// SetPendingChangeAt sets the "pending_change_at" field.
+func (m *SubscriptionMutation) SetPendingChangeAt(t time.Time) {
+	m.pending_change_at = &t
+}
+
+// PendingChangeAt returns the value of the "pending_change_at" field in the mutation.
+func (m *SubscriptionMutation) PendingChangeAt() (r time.Time, exists bool) {
+	v := m.pending_change_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingChangeAt returns the old "pending_change_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldPendingChangeAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingChangeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingChangeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingChangeAt: %w", err)
+	}
+	return oldValue.PendingChangeAt, nil
+}
+
+// ClearPendingChangeAt clears the value of the "pending_change_at" field.
+func (m *SubscriptionMutation) ClearPendingChangeAt() {
+	m.pending_change_at = nil
+	m.clearedFields[subscription.FieldPendingChangeAt] = struct{}{}
+}
+
+// PendingChangeAtCleared returns if the "pending_change_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) PendingChangeAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldPendingChangeAt]
+	return ok
+}
+
+// ResetPendingChangeAt resets all changes to the "pending_change_at" field.
+func (m *SubscriptionMutation) ResetPendingChangeAt() {
+	m.pending_change_at = nil
+	delete(m.clearedFields, subscription.FieldPendingChangeAt)
+}
+
+// SetPastDueAt sets the "past_due_at" field.
+func (m *SubscriptionMutation) SetPastDueAt(t time.Time) {
+	m.past_due_at = &t
+}
+
+// PastDueAt returns the value of the "past_due_at" field in the mutation.
+func (m *SubscriptionMutation) PastDueAt() (r time.Time, exists bool) {
+	v := m.past_due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPastDueAt returns the old "past_due_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldPastDueAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPastDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPastDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPastDueAt: %w", err)
+	}
+	return oldValue.PastDueAt, nil
+}
+
+// ClearPastDueAt clears the value of the "past_due_at" field.
+func (m *SubscriptionMutation) ClearPastDueAt() {
+	m.past_due_at = nil
+	m.clearedFields[subscription.FieldPastDueAt] = struct{}{}
+}
+
+// PastDueAtCleared returns if the "past_due_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) PastDueAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldPastDueAt]
+	return ok
+}
+
+// ResetPastDueAt resets all changes to the "past_due_at" field.
+func (m *SubscriptionMutation) ResetPastDueAt() {
+	m.past_due_at = nil
+	delete(m.clearedFields, subscription.FieldPastDueAt)
+}
+
+// SetDunningReminders sets the "dunning_reminders" field.
+func (m *SubscriptionMutation) SetDunningReminders(i int) {
+	m.dunning_reminders = &i
+	m.adddunning_reminders = nil
+}
+
+// DunningReminders returns the value of the "dunning_reminders" field in the mutation.
+func (m *SubscriptionMutation) DunningReminders() (r int, exists bool) {
+	v := m.dunning_reminders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDunningReminders returns the old "dunning_reminders" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldDunningReminders(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDunningReminders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDunningReminders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDunningReminders: %w", err)
+	}
+	return oldValue.DunningReminders, nil
+}
+
+// AddDunningReminders adds i to the "dunning_reminders" field.
+func (m *SubscriptionMutation) AddDunningReminders(i int) {
+	if m.adddunning_reminders != nil {
+		*m.adddunning_reminders += i
+	} else {
+		m.adddunning_reminders = &i
+	}
+}
+
+// AddedDunningReminders returns the value that was added to the "dunning_reminders" field in this mutation.
+func (m *SubscriptionMutation) AddedDunningReminders() (r int, exists bool) {
+	v := m.adddunning_reminders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDunningReminders resets all changes to the "dunning_reminders" field.
+func (m *SubscriptionMutation) ResetDunningReminders() {
+	m.dunning_reminders = nil
+	m.adddunning_reminders = nil
+}
+
+// SetTrialRemindedAt sets the "trial_reminded_at" field.
+func (m *SubscriptionMutation) SetTrialRemindedAt(t time.Time) {
+	m.trial_reminded_at = &t
+}
+
+// TrialRemindedAt returns the value of the "trial_reminded_at" field in the mutation.
+func (m *SubscriptionMutation) TrialRemindedAt() (r time.Time, exists bool) {
+	v := m.trial_reminded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialRemindedAt returns the old "trial_reminded_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldTrialRemindedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialRemindedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialRemindedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialRemindedAt: %w", err)
+	}
+	return oldValue.TrialRemindedAt, nil
+}
+
+// ClearTrialRemindedAt clears the value of the "trial_reminded_at" field.
+func (m *SubscriptionMutation) ClearTrialRemindedAt() {
+	m.trial_reminded_at = nil
+	m.clearedFields[subscription.FieldTrialRemindedAt] = struct{}{}
+}
+
+// TrialRemindedAtCleared returns if the "trial_reminded_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) TrialRemindedAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldTrialRemindedAt]
+	return ok
+}
+
+// ResetTrialRemindedAt resets all changes to the "trial_reminded_at" field.
+func (m *SubscriptionMutation) ResetTrialRemindedAt() {
+	m.trial_reminded_at = nil
+	delete(m.clearedFields, subscription.FieldTrialRemindedAt)
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (m *SubscriptionMutation) SetLastEventAt(t time.Time) {
+	m.last_event_at = &t
+}
+
+// LastEventAt returns the value of the "last_event_at" field in the mutation.
+func (m *SubscriptionMutation) LastEventAt() (r time.Time, exists bool) {
+	v := m.last_event_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastEventAt returns the old "last_event_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldLastEventAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastEventAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastEventAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastEventAt: %w", err)
+	}
+	return oldValue.LastEventAt, nil
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (m *SubscriptionMutation) ClearLastEventAt() {
+	m.last_event_at = nil
+	m.clearedFields[subscription.FieldLastEventAt] = struct{}{}
+}
+
+// LastEventAtCleared returns if the "last_event_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) LastEventAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldLastEventAt]
+	return ok
+}
+
+// ResetLastEventAt resets all changes to the "last_event_at" field.
+func (m *SubscriptionMutation) ResetLastEventAt() {
+	m.last_event_at = nil
+	delete(m.clearedFields, subscription.FieldLastEventAt)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SubscriptionMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SubscriptionMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *SubscriptionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[subscription.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *SubscriptionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SubscriptionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, subscription.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SubscriptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SubscriptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by id.
+func (m *SubscriptionMutation) SetCustomerID(id int) {
+	m.customer = &id
+}
+
+// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
+func (m *SubscriptionMutation) ClearCustomer() {
+	m.clearedcustomer = true
+}
+
+// CustomerCleared reports if the "customer" edge to the PaymentCustomer entity was cleared.
+func (m *SubscriptionMutation) CustomerCleared() bool {
+	return m.clearedcustomer
+}
+
+// CustomerID returns the "customer" edge ID in the mutation.
+func (m *SubscriptionMutation) CustomerID() (id int, exists bool) {
+	if m.customer != nil {
+		return *m.customer, true
+	}
+	return
+}
+
+// CustomerIDs returns the "customer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CustomerID instead. It exists only for internal usage by the builders.
+func (m *SubscriptionMutation) CustomerIDs() (ids []int) {
+	if id := m.customer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCustomer resets all changes to the "customer" edge.
+func (m *SubscriptionMutation) ResetCustomer() {
+	m.customer = nil
+	m.clearedcustomer = false
+}
+
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by ids.
+func (m *SubscriptionMutation) AddDiscountIDs(ids ...int) {
+	if m.discounts == nil {
+		m.discounts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.discounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDiscounts clears the "discounts" edge to the Discount entity.
+func (m *SubscriptionMutation) ClearDiscounts() {
+	m.cleareddiscounts = true
+}
+
+// DiscountsCleared reports if the "discounts" edge to the Discount entity was cleared.
+func (m *SubscriptionMutation) DiscountsCleared() bool {
+	return m.cleareddiscounts
+}
+
+// RemoveDiscountIDs removes the "discounts" edge to the Discount entity by IDs.
+func (m *SubscriptionMutation) RemoveDiscountIDs(ids ...int) {
+	if m.removeddiscounts == nil {
+		m.removeddiscounts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.discounts, ids[i])
+		m.removeddiscounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDiscounts returns the removed IDs of the "discounts" edge to the Discount entity.
+func (m *SubscriptionMutation) RemovedDiscountsIDs() (ids []int) {
+	for id := range m.removeddiscounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DiscountsIDs returns the "discounts" edge IDs in the mutation.
+func (m *SubscriptionMutation) DiscountsIDs() (ids []int) {
+	for id := range m.discounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDiscounts resets all changes to the "discounts" edge.
+func (m *SubscriptionMutation) ResetDiscounts() {
+	m.discounts = nil
+	m.cleareddiscounts = false
+	m.removeddiscounts = nil
+}
+
+// Where appends a list predicates to the SubscriptionMutation builder.
+func (m *SubscriptionMutation) Where(ps ...predicate.Subscription) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SubscriptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Subscription, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SubscriptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Subscription).
+func (m *SubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.provider_subscription_id != nil {
+		fields = append(fields, subscription.FieldProviderSubscriptionID)
+	}
+	if m.provider != nil {
+		fields = append(fields, subscription.FieldProvider)
+	}
+	if m.status != nil {
+		fields = append(fields, subscription.FieldStatus)
+	}
+	if m.price_id != nil {
+		fields = append(fields, subscription.FieldPriceID)
+	}
+	if m.amount != nil {
+		fields = append(fields, subscription.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, subscription.FieldCurrency)
+	}
+	if m.interval != nil {
+		fields = append(fields, subscription.FieldInterval)
+	}
+	if m.interval_count != nil {
+		fields = append(fields, subscription.FieldIntervalCount)
+	}
+	if m.current_period_start != nil {
+		fields = append(fields, subscription.FieldCurrentPeriodStart)
+	}
+	if m.current_period_end != nil {
+		fields = append(fields, subscription.FieldCurrentPeriodEnd)
+	}
+	if m.trial_start != nil {
+		fields = append(fields, subscription.FieldTrialStart)
+	}
+	if m.trial_end != nil {
+		fields = append(fields, subscription.FieldTrialEnd)
+	}
+	if m.canceled_at != nil {
+		fields = append(fields, subscription.FieldCanceledAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, subscription.FieldEndedAt)
+	}
+	if m.cancel_at_period_end != nil {
+		fields = append(fields, subscription.FieldCancelAtPeriodEnd)
+	}
+	if m.pending_price_id != nil {
+		fields = append(fields, subscription.FieldPendingPriceID)
+	}
+	if m.pending_change_at != nil {
+		fields = append(fields, subscription.FieldPendingChangeAt)
+	}
+	if m.past_due_at != nil {
+		fields = append(fields, subscription.FieldPastDueAt)
+	}
+	if m.dunning_reminders != nil {
+		fields = append(fields, subscription.FieldDunningReminders)
+	}
+	if m.trial_reminded_at != nil {
+		fields = append(fields, subscription.FieldTrialRemindedAt)
+	}
+	if m.last_event_at != nil {
+		fields = append(fields, subscription.FieldLastEventAt)
+	}
+	if m.metadata != nil {
+		fields = append(fields, subscription.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, subscription.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, subscription.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case subscription.FieldProviderSubscriptionID:
+		return m.ProviderSubscriptionID()
+	case subscription.FieldProvider:
+		return m.Provider()
+	case subscription.FieldStatus:
+		return m.Status()
+	case subscription.FieldPriceID:
+		return m.PriceID()
+	case subscription.FieldAmount:
+		return m.Amount()
+	case subscription.FieldCurrency:
+		return m.Currency()
+	case subscription.FieldInterval:
+		return m.Interval()
+	case subscription.FieldIntervalCount:
+		return m.IntervalCount()
+	case subscription.FieldCurrentPeriodStart:
+		return m.CurrentPeriodStart()
+	case subscription.FieldCurrentPeriodEnd:
+		return m.CurrentPeriodEnd()
+	case subscription.FieldTrialStart:
+		return m.TrialStart()
+	case subscription.FieldTrialEnd:
+		return m.TrialEnd()
+	case subscription.FieldCanceledAt:
+		return m.CanceledAt()
+	case subscription.FieldEndedAt:
+		return m.EndedAt()
+	case subscription.FieldCancelAtPeriodEnd:
+		return m.CancelAtPeriodEnd()
+	case subscription.FieldPendingPriceID:
+		return m.PendingPriceID()
+	case subscription.FieldPendingChangeAt:
+		return m.PendingChangeAt()
+	case subscription.FieldPastDueAt:
+		return m.PastDueAt()
+	case subscription.FieldDunningReminders:
+		return m.DunningReminders()
+	case subscription.FieldTrialRemindedAt:
+		return m.TrialRemindedAt()
+	case subscription.FieldLastEventAt:
+		return m.LastEventAt()
+	case subscription.FieldMetadata:
+		return m.Metadata()
+	case subscription.FieldCreatedAt:
+		return m.CreatedAt()
+	case subscription.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case subscription.FieldProviderSubscriptionID:
+		return m.OldProviderSubscriptionID(ctx)
+	case subscription.FieldProvider:
+		return m.OldProvider(ctx)
+	case subscription.FieldStatus:
+		return m.OldStatus(ctx)
+	case subscription.FieldPriceID:
+		return m.OldPriceID(ctx)
+	case subscription.FieldAmount:
+		return m.OldAmount(ctx)
+	case subscription.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case subscription.FieldInterval:
+		return m.OldInterval(ctx)
+	case subscription.FieldIntervalCount:
+		return m.OldIntervalCount(ctx)
+	case subscription.FieldCurrentPeriodStart:
+		return m.OldCurrentPeriodStart(ctx)
+	case subscription.FieldCurrentPeriodEnd:
+		return m.OldCurrentPeriodEnd(ctx)
+	case subscription.FieldTrialStart:
+		return m.OldTrialStart(ctx)
+	case subscription.FieldTrialEnd:
+		return m.OldTrialEnd(ctx)
+	case subscription.FieldCanceledAt:
+		return m.OldCanceledAt(ctx)
+	case subscription.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case subscription.FieldCancelAtPeriodEnd:
+		return m.OldCancelAtPeriodEnd(ctx)
+	case subscription.FieldPendingPriceID:
+		return m.OldPendingPriceID(ctx)
+	case subscription.FieldPendingChangeAt:
+		return m.OldPendingChangeAt(ctx)
+	case subscription.FieldPastDueAt:
+		return m.OldPastDueAt(ctx)
+	case subscription.FieldDunningReminders:
+		return m.OldDunningReminders(ctx)
+	case subscription.FieldTrialRemindedAt:
+		return m.OldTrialRemindedAt(ctx)
+	case subscription.FieldLastEventAt:
+		return m.OldLastEventAt(ctx)
+	case subscription.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case subscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case subscription.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Subscription field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case subscription.FieldProviderSubscriptionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderSubscriptionID(v)
+		return nil
+	case subscription.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case subscription.FieldStatus:
+		v, ok := value.(subscription.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case subscription.FieldPriceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceID(v)
+		return nil
+	case subscription.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case subscription.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case subscription.FieldInterval:
+		v, ok := value.(subscription.Interval)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterval(v)
+		return nil
+	case subscription.FieldIntervalCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntervalCount(v)
+		return nil
+	case subscription.FieldCurrentPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentPeriodStart(v)
+		return nil
+	case subscription.FieldCurrentPeriodEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentPeriodEnd(v)
+		return nil
+	case subscription.FieldTrialStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrialStart(v)
+		return nil
+	case subscription.FieldTrialEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrialEnd(v)
+		return nil
+	case subscription.FieldCanceledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanceledAt(v)
+		return nil
+	case subscription.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case subscription.FieldCancelAtPeriodEnd:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelAtPeriodEnd(v)
+		return nil
+	case subscription.FieldPendingPriceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingPriceID(v)
+		return nil
+	case subscription.FieldPendingChangeAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingChangeAt(v)
+		return nil
+	case subscription.FieldPastDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPastDueAt(v)
+		return nil
+	case subscription.FieldDunningReminders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDunningReminders(v)
+		return nil
+	case subscription.FieldTrialRemindedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrialRemindedAt(v)
+		return nil
+	case subscription.FieldLastEventAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastEventAt(v)
+		return nil
+	case subscription.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case subscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case subscription.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SubscriptionMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, subscription.FieldAmount)
+	}
+	if m.addinterval_count != nil {
+		fields = append(fields, subscription.FieldIntervalCount)
+	}
+	if m.adddunning_reminders != nil {
+		fields = append(fields, subscription.FieldDunningReminders)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case subscription.FieldAmount:
+		return m.AddedAmount()
+	case subscription.FieldIntervalCount:
+		return m.AddedIntervalCount()
+	case subscription.FieldDunningReminders:
+		return m.AddedDunningReminders()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case subscription.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case subscription.FieldIntervalCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntervalCount(v)
+		return nil
+	case subscription.FieldDunningReminders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDunningReminders(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SubscriptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(subscription.FieldCurrentPeriodStart) {
+		fields = append(fields, subscription.FieldCurrentPeriodStart)
+	}
+	if m.FieldCleared(subscription.FieldCurrentPeriodEnd) {
+		fields = append(fields, subscription.FieldCurrentPeriodEnd)
+	}
+	if m.FieldCleared(subscription.FieldTrialStart) {
+		fields = append(fields, subscription.FieldTrialStart)
+	}
+	if m.FieldCleared(subscription.FieldTrialEnd) {
+		fields = append(fields, subscription.FieldTrialEnd)
+	}
+	if m.FieldCleared(subscription.FieldCanceledAt) {
+		fields = append(fields, subscription.FieldCanceledAt)
+	}
+	if m.FieldCleared(subscription.FieldEndedAt) {
+		fields = append(fields, subscription.FieldEndedAt)
+	}
+	if m.FieldCleared(subscription.FieldPendingPriceID) {
+		fields = append(fields, subscription.FieldPendingPriceID)
+	}
+	if m.FieldCleared(subscription.FieldPendingChangeAt) {
+		fields = append(fields, subscription.FieldPendingChangeAt)
+	}
+	if m.FieldCleared(subscription.FieldPastDueAt) {
+		fields = append(fields, subscription.FieldPastDueAt)
+	}
+	if m.FieldCleared(subscription.FieldTrialRemindedAt) {
+		fields = append(fields, subscription.FieldTrialRemindedAt)
+	}
+	if m.FieldCleared(subscription.FieldLastEventAt) {
+		fields = append(fields, subscription.FieldLastEventAt)
+	}
+	if m.FieldCleared(subscription.FieldMetadata) {
+		fields = append(fields, subscription.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SubscriptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SubscriptionMutation) ClearField(name string) error {
+	switch name {
+	case subscription.FieldCurrentPeriodStart:
+		m.ClearCurrentPeriodStart()
+		return nil
+	case subscription.FieldCurrentPeriodEnd:
+		m.ClearCurrentPeriodEnd()
+		return nil
+	case subscription.FieldTrialStart:
+		m.ClearTrialStart()
+		return nil
+	case subscription.FieldTrialEnd:
+		m.ClearTrialEnd()
+		return nil
+	case subscription.FieldCanceledAt:
+		m.ClearCanceledAt()
+		return nil
+	case subscription.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	case subscription.FieldPendingPriceID:
+		m.ClearPendingPriceID()
+		return nil
+	case subscription.FieldPendingChangeAt:
+		m.ClearPendingChangeAt()
+		return nil
+	case subscription.FieldPastDueAt:
+		m.ClearPastDueAt()
+		return nil
+	case subscription.FieldTrialRemindedAt:
+		m.ClearTrialRemindedAt()
+		return nil
+	case subscription.FieldLastEventAt:
+		m.ClearLastEventAt()
+		return nil
+	case subscription.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case subscription.FieldProviderSubscriptionID:
+		m.ResetProviderSubscriptionID()
+		return nil
+	case subscription.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case subscription.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case subscription.FieldPriceID:
+		m.ResetPriceID()
+		return nil
+	case subscription.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case subscription.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case subscription.FieldInterval:
+		m.ResetInterval()
+		return nil
+	case subscription.FieldIntervalCount:
+		m.ResetIntervalCount()
+		return nil
+	case subscription.FieldCurrentPeriodStart:
+		m.ResetCurrentPeriodStart()
+		return nil
+	case subscription.FieldCurrentPeriodEnd:
+		m.ResetCurrentPeriodEnd()
+		return nil
+	case subscription.FieldTrialStart:
+		m.ResetTrialStart()
+		return nil
+	case subscription.FieldTrialEnd:
+		m.ResetTrialEnd()
+		return nil
+	case subscription.FieldCanceledAt:
+		m.ResetCanceledAt()
+		return nil
+	case subscription.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case subscription.FieldCancelAtPeriodEnd:
+		m.ResetCancelAtPeriodEnd()
+		return nil
+	case subscription.FieldPendingPriceID:
+		m.ResetPendingPriceID()
+		return nil
+	case subscription.FieldPendingChangeAt:
+		m.ResetPendingChangeAt()
+		return nil
+	case subscription.FieldPastDueAt:
+		m.ResetPastDueAt()
+		return nil
+	case subscription.FieldDunningReminders:
+		m.ResetDunningReminders()
+		return nil
+	case subscription.FieldTrialRemindedAt:
+		m.ResetTrialRemindedAt()
+		return nil
+	case subscription.FieldLastEventAt:
+		m.ResetLastEventAt()
+		return nil
+	case subscription.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case subscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case subscription.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.customer != nil {
+		edges = append(edges, subscription.EdgeCustomer)
+	}
+	if m.discounts != nil {
+		edges = append(edges, subscription.EdgeDiscounts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case subscription.EdgeCustomer:
+		if id := m.customer; id != nil {
+			return []ent.Value{*id}
+		}
+	case subscription.EdgeDiscounts:
+		ids := make([]ent.Value, 0, len(m.discounts))
+		for id := range m.discounts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddiscounts != nil {
+		edges = append(edges, subscription.EdgeDiscounts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case subscription.EdgeDiscounts:
+		ids := make([]ent.Value, 0, len(m.removeddiscounts))
+		for id := range m.removeddiscounts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcustomer {
+		edges = append(edges, subscription.EdgeCustomer)
+	}
+	if m.cleareddiscounts {
+		edges = append(edges, subscription.EdgeDiscounts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SubscriptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case subscription.EdgeCustomer:
+		return m.clearedcustomer
+	case subscription.EdgeDiscounts:
+		return m.cleareddiscounts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SubscriptionMutation) ClearEdge(name string) error {
+	switch name {
+	case subscription.EdgeCustomer:
+		m.ClearCustomer()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SubscriptionMutation) ResetEdge(name string) error {
+	switch name {
+	case subscription.EdgeCustomer:
+		m.ResetCustomer()
+		return nil
+	case subscription.EdgeDiscounts:
+		m.ResetDiscounts()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription edge %s", name)
+}
+
+// UsageMutation represents an operation that mutates the Usage nodes in the graph.
+type UsageMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	period_start     *time.Time
+	period_end       *time.Time
+	responses        *int64
+	addresponses     *int64
+	forms            *int64
+	addforms         *int64
+	storage_bytes    *int64
+	addstorage_bytes *int64
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *int
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*Usage, error)
+	predicates       []predicate.Usage
+}
+
+var _ ent.Mutation = (*UsageMutation)(nil)
+
+// usageOption allows management of the mutation configuration using functional options.
+type usageOption func(*UsageMutation)
+
+// newUsageMutation creates new mutation for the Usage entity.
+func newUsageMutation(c config, op Op, opts ...usageOption) *UsageMutation {
+	m := &UsageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUsage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUsageID sets the ID field of the mutation.
+func withUsageID(id int) usageOption {
+	return func(m *UsageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Usage
+		)
+		m.oldValue = func(ctx context.Context) (*Usage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Usage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedIntervalCount returns the value that was added to the "interval_count" field in this mutation.
-func (m *SubscriptionMutation) AddedIntervalCount() (r int, exists bool) {
-	v := m.addinterval_count
-	if v == nil {
-		return
+// withUsage sets the old Usage of the mutation.
+func withUsage(node *Usage) usageOption {
+	return func(m *UsageMutation) {
+		m.oldValue = func(context.Context) (*Usage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ResetIntervalCount resets all changes to the "interval_count" field.
-func (m *SubscriptionMutation) ResetIntervalCount() {
-	m.interval_count = nil
-	m.addinterval_count = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UsageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetCurrentPeriodStart sets the "current_period_start" field.
-func (m *SubscriptionMutation) SetCurrentPeriodStart(t time.Time) {
-	m.current_period_start = &t
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UsageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// CurrentPeriodStart returns the value of the "current_period_start" field in the mutation.
-func (m *SubscriptionMutation) CurrentPeriodStart() (r time.Time, exists bool) {
-	v := m.current_period_start
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UsageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldCurrentPeriodStart returns the old "current_period_start" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCurrentPeriodStart(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPeriodStart is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPeriodStart requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPeriodStart: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UsageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Usage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.CurrentPeriodStart, nil
-}
-
-// ClearCurrentPeriodStart clears the value of the "current_period_start" field.
-func (m *SubscriptionMutation) ClearCurrentPeriodStart() {
-	m.current_period_start = nil
-	m.clearedFields[subscription.FieldCurrentPeriodStart] = struct{}{}
-}
-
-// CurrentPeriodStartCleared returns if the "current_period_start" field was cleared in this mutation.
-func (m *SubscriptionMutation) CurrentPeriodStartCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldCurrentPeriodStart]
-	return ok
 }
 
-// ResetCurrentPeriodStart resets all changes to the "current_period_start" field.
-func (m *SubscriptionMutation) ResetCurrentPeriodStart() {
-	m.current_period_start = nil
-	delete(m.clearedFields, subscription.FieldCurrentPeriodStart)
-}
-
-// SetCurrentPeriodEnd sets the "current_period_end" field.
-func (m *SubscriptionMutation) SetCurrentPeriodEnd(t time.Time) {
-	m.current_period_end = &t
+// SetUserID sets the "user_id" field.
+func (m *UsageMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// CurrentPeriodEnd returns the value of the "current_period_end" field in the mutation.
-func (m *SubscriptionMutation) CurrentPeriodEnd() (r time.Time, exists bool) {
-	v := m.current_period_end
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsageMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrentPeriodEnd returns the old "current_period_end" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCurrentPeriodEnd(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPeriodEnd is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPeriodEnd requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPeriodEnd: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.CurrentPeriodEnd, nil
-}
-
-// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
-func (m *SubscriptionMutation) ClearCurrentPeriodEnd() {
-	m.current_period_end = nil
-	m.clearedFields[subscription.FieldCurrentPeriodEnd] = struct{}{}
-}
-
-// CurrentPeriodEndCleared returns if the "current_period_end" field was cleared in this mutation.
-func (m *SubscriptionMutation) CurrentPeriodEndCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldCurrentPeriodEnd]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetCurrentPeriodEnd resets all changes to the "current_period_end" field.
-func (m *SubscriptionMutation) ResetCurrentPeriodEnd() {
-	m.current_period_end = nil
-	delete(m.clearedFields, subscription.FieldCurrentPeriodEnd)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsageMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetTrialStart sets the "trial_start" field.
-func (m *SubscriptionMutation) SetTrialStart(t time.Time) {
-	m.trial_start = &t
+// SetPeriodStart sets the "period_start" field.
+func (m *UsageMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
 }
 
-// TrialStart returns the value of the "trial_start" field in the mutation.
-func (m *SubscriptionMutation) TrialStart() (r time.Time, exists bool) {
-	v := m.trial_start
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *UsageMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrialStart returns the old "trial_start" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodStart returns the old "period_start" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldTrialStart(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrialStart is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrialStart requires an ID field in the mutation")
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrialStart: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
 	}
-	return oldValue.TrialStart, nil
-}
-
-// ClearTrialStart clears the value of the "trial_start" field.
-func (m *SubscriptionMutation) ClearTrialStart() {
-	m.trial_start = nil
-	m.clearedFields[subscription.FieldTrialStart] = struct{}{}
+	return oldValue.PeriodStart, nil
 }
 
-// TrialStartCleared returns if the "trial_start" field was cleared in this mutation.
-func (m *SubscriptionMutation) TrialStartCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldTrialStart]
-	return ok
-}
-
-// ResetTrialStart resets all changes to the "trial_start" field.
-func (m *SubscriptionMutation) ResetTrialStart() {
-	m.trial_start = nil
-	delete(m.clearedFields, subscription.FieldTrialStart)
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *UsageMutation) ResetPeriodStart() {
+	m.period_start = nil
 }
 
-// SetTrialEnd sets the "trial_end" field.
-func (m *SubscriptionMutation) SetTrialEnd(t time.Time) {
-	m.trial_end = &t
+// SetPeriodEnd sets the "period_end" field.
+func (m *UsageMutation) SetPeriodEnd(t time.Time) {
+	m.period_end = &t
 }
 
-// TrialEnd returns the value of the "trial_end" field in the mutation.
-func (m *SubscriptionMutation) TrialEnd() (r time.Time, exists bool) {
-	v := m.trial_end
+// PeriodEnd returns the value of the "period_end" field in the mutation.
+func (m *UsageMutation) PeriodEnd() (r time.Time, exists bool) {
+	v := m.period_end
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrialEnd returns the old "trial_end" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodEnd returns the old "period_end" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldTrialEnd(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldPeriodEnd(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrialEnd is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodEnd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrialEnd requires an ID field in the mutation")
+		return v, errors.New("OldPeriodEnd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrialEnd: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodEnd: %w", err)
 	}
-	return oldValue.TrialEnd, nil
-}
-
-// ClearTrialEnd clears the value of the "trial_end" field.
-func (m *SubscriptionMutation) ClearTrialEnd() {
-	m.trial_end = nil
-	m.clearedFields[subscription.FieldTrialEnd] = struct{}{}
-}
-
-// TrialEndCleared returns if the "trial_end" field was cleared in this mutation.
-func (m *SubscriptionMutation) TrialEndCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldTrialEnd]
-	return ok
+	return oldValue.PeriodEnd, nil
 }
 
-// ResetTrialEnd resets all changes to the "trial_end" field.
-func (m *SubscriptionMutation) ResetTrialEnd() {
-	m.trial_end = nil
-	delete(m.clearedFields, subscription.FieldTrialEnd)
+// ResetPeriodEnd resets all changes to the "period_end" field.
+func (m *UsageMutation) ResetPeriodEnd() {
+	m.period_end = nil
 }
 
-// SetCanceledAt sets the "canceled_at" field.
-func (m *SubscriptionMutation) SetCanceledAt(t time.Time) {
-	m.canceled_at = &t
+// SetResponses sets the "responses" field.
+func (m *UsageMutation) SetResponses(i int64) {
+	m.responses = &i
+	m.addresponses = nil
 }
 
-// CanceledAt returns the value of the "canceled_at" field in the mutation.
-func (m *SubscriptionMutation) CanceledAt() (r time.Time, exists bool) {
-	v := m.canceled_at
+// Responses returns the value of the "responses" field in the mutation.
+func (m *UsageMutation) Responses() (r int64, exists bool) {
+	v := m.responses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCanceledAt returns the old "canceled_at" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldResponses returns the old "responses" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCanceledAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldResponses(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCanceledAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponses is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCanceledAt requires an ID field in the mutation")
+		return v, errors.New("OldResponses requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCanceledAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponses: %w", err)
 	}
-	return oldValue.CanceledAt, nil
+	return oldValue.Responses, nil
 }
 
-// ClearCanceledAt clears the value of the "canceled_at" field.
-func (m *SubscriptionMutation) ClearCanceledAt() {
-	m.canceled_at = nil
-	m.clearedFields[subscription.FieldCanceledAt] = struct{}{}
+// AddResponses adds i to the "responses" field.
+func (m *UsageMutation) AddResponses(i int64) {
+	if m.addresponses != nil {
+		*m.addresponses += i
+	} else {
+		m.addresponses = &i
+	}
 }
 
-// CanceledAtCleared returns if the "canceled_at" field was cleared in this mutation.
-func (m *SubscriptionMutation) CanceledAtCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldCanceledAt]
-	return ok
+// AddedResponses returns the value that was added to the "responses" field in this mutation.
+func (m *UsageMutation) AddedResponses() (r int64, exists bool) {
+	v := m.addresponses
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCanceledAt resets all changes to the "canceled_at" field.
-func (m *SubscriptionMutation) ResetCanceledAt() {
-	m.canceled_at = nil
-	delete(m.clearedFields, subscription.FieldCanceledAt)
+// ResetResponses resets all changes to the "responses" field.
+func (m *UsageMutation) ResetResponses() {
+	m.responses = nil
+	m.addresponses = nil
 }
 
-// SetEndedAt sets the "ended_at" field.
-func (m *SubscriptionMutation) SetEndedAt(t time.Time) {
-	m.ended_at = &t
+// SetForms sets the "forms" field.
+func (m *UsageMutation) SetForms(i int64) {
+	m.forms = &i
+	m.addforms = nil
 }
 
-// EndedAt returns the value of the "ended_at" field in the mutation.
-func (m *SubscriptionMutation) EndedAt() (r time.Time, exists bool) {
-	v := m.ended_at
+// Forms returns the value of the "forms" field in the mutation.
+func (m *UsageMutation) Forms() (r int64, exists bool) {
+	v := m.forms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndedAt returns the old "ended_at" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldForms returns the old "forms" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldEndedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldForms(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldForms is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+		return v, errors.New("OldForms requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldForms: %w", err)
 	}
-	return oldValue.EndedAt, nil
+	return oldValue.Forms, nil
 }
 
-// ClearEndedAt clears the value of the "ended_at" field.
-func (m *SubscriptionMutation) ClearEndedAt() {
-	m.ended_at = nil
-	m.clearedFields[subscription.FieldEndedAt] = struct{}{}
+// AddForms adds i to the "forms" field.
+func (m *UsageMutation) AddForms(i int64) {
+	if m.addforms != nil {
+		*m.addforms += i
+	} else {
+		m.addforms = &i
+	}
 }
 
-// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
-func (m *SubscriptionMutation) EndedAtCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldEndedAt]
-	return ok
+// AddedForms returns the value that was added to the "forms" field in this mutation.
+func (m *UsageMutation) AddedForms() (r int64, exists bool) {
+	v := m.addforms
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEndedAt resets all changes to the "ended_at" field.
-func (m *SubscriptionMutation) ResetEndedAt() {
-	m.ended_at = nil
-	delete(m.clearedFields, subscription.FieldEndedAt)
+// ResetForms resets all changes to the "forms" field.
+func (m *UsageMutation) ResetForms() {
+	m.forms = nil
+	m.addforms = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SubscriptionMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetStorageBytes sets the "storage_bytes" field.
+func (m *UsageMutation) SetStorageBytes(i int64) {
+	m.storage_bytes = &i
+	m.addstorage_bytes = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SubscriptionMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// StorageBytes returns the value of the "storage_bytes" field in the mutation.
+func (m *UsageMutation) StorageBytes() (r int64, exists bool) {
+	v := m.storage_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldStorageBytes returns the old "storage_bytes" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *UsageMutation) OldStorageBytes(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldStorageBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldStorageBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldStorageBytes: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.StorageBytes, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *SubscriptionMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[subscription.FieldMetadata] = struct{}{}
+// AddStorageBytes adds i to the "storage_bytes" field.
+func (m *UsageMutation) AddStorageBytes(i int64) {
+	if m.addstorage_bytes != nil {
+		*m.addstorage_bytes += i
+	} else {
+		m.addstorage_bytes = &i
+	}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *SubscriptionMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldMetadata]
-	return ok
+// AddedStorageBytes returns the value that was added to the "storage_bytes" field in this mutation.
+func (m *UsageMutation) AddedStorageBytes() (r int64, exists bool) {
+	v := m.addstorage_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SubscriptionMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, subscription.FieldMetadata)
+// ResetStorageBytes resets all changes to the "storage_bytes" field.
+func (m *UsageMutation) ResetStorageBytes() {
+	m.storage_bytes = nil
+	m.addstorage_bytes = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SubscriptionMutation) SetCreatedAt(t time.Time) {
+func (m *UsageMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UsageMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8543,10 +19152,10 @@ func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8561,17 +19170,17 @@ func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SubscriptionMutation) ResetCreatedAt() {
+func (m *UsageMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SubscriptionMutation) SetUpdatedAt(t time.Time) {
+func (m *UsageMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UsageMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8579,10 +19188,10 @@ func (m *SubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8597,58 +19206,46 @@ func (m *SubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SubscriptionMutation) ResetUpdatedAt() {
+func (m *UsageMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetCustomerID sets the "customer" edge to the PaymentCustomer entity by id.
-func (m *SubscriptionMutation) SetCustomerID(id int) {
-	m.customer = &id
-}
-
-// ClearCustomer clears the "customer" edge to the PaymentCustomer entity.
-func (m *SubscriptionMutation) ClearCustomer() {
-	m.clearedcustomer = true
-}
-
-// CustomerCleared reports if the "customer" edge to the PaymentCustomer entity was cleared.
-func (m *SubscriptionMutation) CustomerCleared() bool {
-	return m.clearedcustomer
+// ClearUser clears the "user" edge to the User entity.
+func (m *UsageMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[usage.FieldUserID] = struct{}{}
 }
 
-// CustomerID returns the "customer" edge ID in the mutation.
-func (m *SubscriptionMutation) CustomerID() (id int, exists bool) {
-	if m.customer != nil {
-		return *m.customer, true
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UsageMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// CustomerIDs returns the "customer" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CustomerID instead. It exists only for internal usage by the builders.
-func (m *SubscriptionMutation) CustomerIDs() (ids []int) {
-	if id := m.customer; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UsageMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCustomer resets all changes to the "customer" edge.
-func (m *SubscriptionMutation) ResetCustomer() {
-	m.customer = nil
-	m.clearedcustomer = false
+// ResetUser resets all changes to the "user" edge.
+func (m *UsageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the SubscriptionMutation builder.
-func (m *SubscriptionMutation) Where(ps ...predicate.Subscription) {
+// Where appends a list predicates to the UsageMutation builder.
+func (m *UsageMutation) Where(ps ...predicate.Usage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SubscriptionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Subscription, len(ps))
+func (m *UsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Usage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8656,75 +19253,48 @@ func (m *SubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SubscriptionMutation) Op() Op {
+func (m *UsageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SubscriptionMutation) SetOp(op Op) {
+func (m *UsageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Subscription).
-func (m *SubscriptionMutation) Type() string {
+// Type returns the node type of this mutation (Usage).
+func (m *UsageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubscriptionMutation) Fields() []string {
-	fields := make([]string, 0, 17)
-	if m.provider_subscription_id != nil {
-		fields = append(fields, subscription.FieldProviderSubscriptionID)
-	}
-	if m.provider != nil {
-		fields = append(fields, subscription.FieldProvider)
-	}
-	if m.status != nil {
-		fields = append(fields, subscription.FieldStatus)
-	}
-	if m.price_id != nil {
-		fields = append(fields, subscription.FieldPriceID)
-	}
-	if m.amount != nil {
-		fields = append(fields, subscription.FieldAmount)
-	}
-	if m.currency != nil {
-		fields = append(fields, subscription.FieldCurrency)
-	}
-	if m.interval != nil {
-		fields = append(fields, subscription.FieldInterval)
-	}
-	if m.interval_count != nil {
-		fields = append(fields, subscription.FieldIntervalCount)
-	}
-	if m.current_period_start != nil {
-		fields = append(fields, subscription.FieldCurrentPeriodStart)
-	}
-	if m.current_period_end != nil {
-		fields = append(fields, subscription.FieldCurrentPeriodEnd)
+func (m *UsageMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user != nil {
+		fields = append(fields, usage.FieldUserID)
 	}
-	if m.trial_start != nil {
-		fields = append(fields, subscription.FieldTrialStart)
+	if m.period_start != nil {
+		fields = append(fields, usage.FieldPeriodStart)
 	}
-	if m.trial_end != nil {
-		fields = append(fields, subscription.FieldTrialEnd)
+	if m.period_end != nil {
+		fields = append(fields, usage.FieldPeriodEnd)
 	}
-	if m.canceled_at != nil {
-		fields = append(fields, subscription.FieldCanceledAt)
+	if m.responses != nil {
+		fields = append(fields, usage.FieldResponses)
 	}
-	if m.ended_at != nil {
-		fields = append(fields, subscription.FieldEndedAt)
+	if m.forms != nil {
+		fields = append(fields, usage.FieldForms)
 	}
-	if m.metadata != nil {
-		fields = append(fields, subscription.FieldMetadata)
+	if m.storage_bytes != nil {
+		fields = append(fields, usage.FieldStorageBytes)
 	}
 	if m.created_at != nil {
-		fields = append(fields, subscription.FieldCreatedAt)
+		fields = append(fields, usage.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, subscription.FieldUpdatedAt)
+		fields = append(fields, usage.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -8732,41 +19302,23 @@ func (m *SubscriptionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
+func (m *UsageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case subscription.FieldProviderSubscriptionID:
-		return m.ProviderSubscriptionID()
-	case subscription.FieldProvider:
-		return m.Provider()
-	case subscription.FieldStatus:
-		return m.Status()
-	case subscription.FieldPriceID:
-		return m.PriceID()
-	case subscription.FieldAmount:
-		return m.Amount()
-	case subscription.FieldCurrency:
-		return m.Currency()
-	case subscription.FieldInterval:
-		return m.Interval()
-	case subscription.FieldIntervalCount:
-		return m.IntervalCount()
-	case subscription.FieldCurrentPeriodStart:
-		return m.CurrentPeriodStart()
-	case subscription.FieldCurrentPeriodEnd:
-		return m.CurrentPeriodEnd()
-	case subscription.FieldTrialStart:
-		return m.TrialStart()
-	case subscription.FieldTrialEnd:
-		return m.TrialEnd()
-	case subscription.FieldCanceledAt:
-		return m.CanceledAt()
-	case subscription.FieldEndedAt:
-		return m.EndedAt()
-	case subscription.FieldMetadata:
-		return m.Metadata()
-	case subscription.FieldCreatedAt:
+	case usage.FieldUserID:
+		return m.UserID()
+	case usage.FieldPeriodStart:
+		return m.PeriodStart()
+	case usage.FieldPeriodEnd:
+		return m.PeriodEnd()
+	case usage.FieldResponses:
+		return m.Responses()
+	case usage.FieldForms:
+		return m.Forms()
+	case usage.FieldStorageBytes:
+		return m.StorageBytes()
+	case usage.FieldCreatedAt:
 		return m.CreatedAt()
-	case subscription.FieldUpdatedAt:
+	case usage.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -8774,165 +19326,84 @@ func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case subscription.FieldProviderSubscriptionID:
-		return m.OldProviderSubscriptionID(ctx)
-	case subscription.FieldProvider:
-		return m.OldProvider(ctx)
-	case subscription.FieldStatus:
-		return m.OldStatus(ctx)
-	case subscription.FieldPriceID:
-		return m.OldPriceID(ctx)
-	case subscription.FieldAmount:
-		return m.OldAmount(ctx)
-	case subscription.FieldCurrency:
-		return m.OldCurrency(ctx)
-	case subscription.FieldInterval:
-		return m.OldInterval(ctx)
-	case subscription.FieldIntervalCount:
-		return m.OldIntervalCount(ctx)
-	case subscription.FieldCurrentPeriodStart:
-		return m.OldCurrentPeriodStart(ctx)
-	case subscription.FieldCurrentPeriodEnd:
-		return m.OldCurrentPeriodEnd(ctx)
-	case subscription.FieldTrialStart:
-		return m.OldTrialStart(ctx)
-	case subscription.FieldTrialEnd:
-		return m.OldTrialEnd(ctx)
-	case subscription.FieldCanceledAt:
-		return m.OldCanceledAt(ctx)
-	case subscription.FieldEndedAt:
-		return m.OldEndedAt(ctx)
-	case subscription.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case subscription.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case subscription.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Subscription field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case subscription.FieldProviderSubscriptionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProviderSubscriptionID(v)
-		return nil
-	case subscription.FieldProvider:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProvider(v)
-		return nil
-	case subscription.FieldStatus:
-		v, ok := value.(subscription.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case subscription.FieldPriceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriceID(v)
-		return nil
-	case subscription.FieldAmount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAmount(v)
-		return nil
-	case subscription.FieldCurrency:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrency(v)
-		return nil
-	case subscription.FieldInterval:
-		v, ok := value.(subscription.Interval)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInterval(v)
-		return nil
-	case subscription.FieldIntervalCount:
+// database failed.
+func (m *UsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case usage.FieldUserID:
+		return m.OldUserID(ctx)
+	case usage.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case usage.FieldPeriodEnd:
+		return m.OldPeriodEnd(ctx)
+	case usage.FieldResponses:
+		return m.OldResponses(ctx)
+	case usage.FieldForms:
+		return m.OldForms(ctx)
+	case usage.FieldStorageBytes:
+		return m.OldStorageBytes(ctx)
+	case usage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case usage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Usage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UsageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case usage.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIntervalCount(v)
-		return nil
-	case subscription.FieldCurrentPeriodStart:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrentPeriodStart(v)
-		return nil
-	case subscription.FieldCurrentPeriodEnd:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrentPeriodEnd(v)
+		m.SetUserID(v)
 		return nil
-	case subscription.FieldTrialStart:
+	case usage.FieldPeriodStart:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrialStart(v)
+		m.SetPeriodStart(v)
 		return nil
-	case subscription.FieldTrialEnd:
+	case usage.FieldPeriodEnd:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrialEnd(v)
+		m.SetPeriodEnd(v)
 		return nil
-	case subscription.FieldCanceledAt:
-		v, ok := value.(time.Time)
+	case usage.FieldResponses:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCanceledAt(v)
+		m.SetResponses(v)
 		return nil
-	case subscription.FieldEndedAt:
-		v, ok := value.(time.Time)
+	case usage.FieldForms:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndedAt(v)
+		m.SetForms(v)
 		return nil
-	case subscription.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case usage.FieldStorageBytes:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetStorageBytes(v)
 		return nil
-	case subscription.FieldCreatedAt:
+	case usage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case subscription.FieldUpdatedAt:
+	case usage.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -8940,18 +19411,21 @@ func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription field %s", name)
+	return fmt.Errorf("unknown Usage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubscriptionMutation) AddedFields() []string {
+func (m *UsageMutation) AddedFields() []string {
 	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, subscription.FieldAmount)
+	if m.addresponses != nil {
+		fields = append(fields, usage.FieldResponses)
 	}
-	if m.addinterval_count != nil {
-		fields = append(fields, subscription.FieldIntervalCount)
+	if m.addforms != nil {
+		fields = append(fields, usage.FieldForms)
+	}
+	if m.addstorage_bytes != nil {
+		fields = append(fields, usage.FieldStorageBytes)
 	}
 	return fields
 }
@@ -8959,12 +19433,14 @@ func (m *SubscriptionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case subscription.FieldAmount:
-		return m.AddedAmount()
-	case subscription.FieldIntervalCount:
-		return m.AddedIntervalCount()
+	case usage.FieldResponses:
+		return m.AddedResponses()
+	case usage.FieldForms:
+		return m.AddedForms()
+	case usage.FieldStorageBytes:
+		return m.AddedStorageBytes()
 	}
 	return nil, false
 }
@@ -8972,164 +19448,99 @@ func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
+func (m *UsageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case subscription.FieldAmount:
+	case usage.FieldResponses:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAmount(v)
+		m.AddResponses(v)
 		return nil
-	case subscription.FieldIntervalCount:
-		v, ok := value.(int)
+	case usage.FieldForms:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddIntervalCount(v)
+		m.AddForms(v)
+		return nil
+	case usage.FieldStorageBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageBytes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription numeric field %s", name)
+	return fmt.Errorf("unknown Usage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubscriptionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(subscription.FieldCurrentPeriodStart) {
-		fields = append(fields, subscription.FieldCurrentPeriodStart)
-	}
-	if m.FieldCleared(subscription.FieldCurrentPeriodEnd) {
-		fields = append(fields, subscription.FieldCurrentPeriodEnd)
-	}
-	if m.FieldCleared(subscription.FieldTrialStart) {
-		fields = append(fields, subscription.FieldTrialStart)
-	}
-	if m.FieldCleared(subscription.FieldTrialEnd) {
-		fields = append(fields, subscription.FieldTrialEnd)
-	}
-	if m.FieldCleared(subscription.FieldCanceledAt) {
-		fields = append(fields, subscription.FieldCanceledAt)
-	}
-	if m.FieldCleared(subscription.FieldEndedAt) {
-		fields = append(fields, subscription.FieldEndedAt)
-	}
-	if m.FieldCleared(subscription.FieldMetadata) {
-		fields = append(fields, subscription.FieldMetadata)
-	}
-	return fields
+func (m *UsageMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubscriptionMutation) FieldCleared(name string) bool {
+func (m *UsageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubscriptionMutation) ClearField(name string) error {
-	switch name {
-	case subscription.FieldCurrentPeriodStart:
-		m.ClearCurrentPeriodStart()
-		return nil
-	case subscription.FieldCurrentPeriodEnd:
-		m.ClearCurrentPeriodEnd()
-		return nil
-	case subscription.FieldTrialStart:
-		m.ClearTrialStart()
-		return nil
-	case subscription.FieldTrialEnd:
-		m.ClearTrialEnd()
-		return nil
-	case subscription.FieldCanceledAt:
-		m.ClearCanceledAt()
-		return nil
-	case subscription.FieldEndedAt:
-		m.ClearEndedAt()
-		return nil
-	case subscription.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	}
-	return fmt.Errorf("unknown Subscription nullable field %s", name)
+func (m *UsageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Usage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubscriptionMutation) ResetField(name string) error {
+func (m *UsageMutation) ResetField(name string) error {
 	switch name {
-	case subscription.FieldProviderSubscriptionID:
-		m.ResetProviderSubscriptionID()
-		return nil
-	case subscription.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case subscription.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case subscription.FieldPriceID:
-		m.ResetPriceID()
-		return nil
-	case subscription.FieldAmount:
-		m.ResetAmount()
-		return nil
-	case subscription.FieldCurrency:
-		m.ResetCurrency()
-		return nil
-	case subscription.FieldInterval:
-		m.ResetInterval()
-		return nil
-	case subscription.FieldIntervalCount:
-		m.ResetIntervalCount()
-		return nil
-	case subscription.FieldCurrentPeriodStart:
-		m.ResetCurrentPeriodStart()
-		return nil
-	case subscription.FieldCurrentPeriodEnd:
-		m.ResetCurrentPeriodEnd()
+	case usage.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case subscription.FieldTrialStart:
-		m.ResetTrialStart()
+	case usage.FieldPeriodStart:
+		m.ResetPeriodStart()
 		return nil
-	case subscription.FieldTrialEnd:
-		m.ResetTrialEnd()
+	case usage.FieldPeriodEnd:
+		m.ResetPeriodEnd()
 		return nil
-	case subscription.FieldCanceledAt:
-		m.ResetCanceledAt()
+	case usage.FieldResponses:
+		m.ResetResponses()
 		return nil
-	case subscription.FieldEndedAt:
-		m.ResetEndedAt()
+	case usage.FieldForms:
+		m.ResetForms()
 		return nil
-	case subscription.FieldMetadata:
-		m.ResetMetadata()
+	case usage.FieldStorageBytes:
+		m.ResetStorageBytes()
 		return nil
-	case subscription.FieldCreatedAt:
+	case usage.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case subscription.FieldUpdatedAt:
+	case usage.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription field %s", name)
+	return fmt.Errorf("unknown Usage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubscriptionMutation) AddedEdges() []string {
+func (m *UsageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.customer != nil {
-		edges = append(edges, subscription.EdgeCustomer)
+	if m.user != nil {
+		edges = append(edges, usage.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
+func (m *UsageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case subscription.EdgeCustomer:
-		if id := m.customer; id != nil {
+	case usage.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -9137,56 +19548,56 @@ func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubscriptionMutation) RemovedEdges() []string {
+func (m *UsageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
+func (m *UsageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubscriptionMutation) ClearedEdges() []string {
+func (m *UsageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedcustomer {
-		edges = append(edges, subscription.EdgeCustomer)
+	if m.cleareduser {
+		edges = append(edges, usage.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubscriptionMutation) EdgeCleared(name string) bool {
+func (m *UsageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case subscription.EdgeCustomer:
-		return m.clearedcustomer
+	case usage.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubscriptionMutation) ClearEdge(name string) error {
+func (m *UsageMutation) ClearEdge(name string) error {
 	switch name {
-	case subscription.EdgeCustomer:
-		m.ClearCustomer()
+	case usage.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription unique edge %s", name)
+	return fmt.Errorf("unknown Usage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubscriptionMutation) ResetEdge(name string) error {
+func (m *UsageMutation) ResetEdge(name string) error {
 	switch name {
-	case subscription.EdgeCustomer:
-		m.ResetCustomer()
+	case usage.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription edge %s", name)
+	return fmt.Errorf("unknown Usage edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -9215,12 +19626,17 @@ type UserMutation struct {
 	clearedowner            bool
 	payment_customer        *int
 	clearedpayment_customer bool
+	payment_account         *int
+	clearedpayment_account  bool
 	forms                   map[int]struct{}
 	removedforms            map[int]struct{}
 	clearedforms            bool
 	responses               map[int]struct{}
 	removedresponses        map[int]struct{}
 	clearedresponses        bool
+	usage                   map[int]struct{}
+	removedusage            map[int]struct{}
+	clearedusage            bool
 	done                    bool
 	oldValue                func(context.Context) (*User, error)
 	predicates              []predicate.User
@@ -10025,6 +20441,45 @@ func (m *UserMutation) ResetPaymentCustomer() {
 	m.clearedpayment_customer = false
 }
 
+// SetPaymentAccountID sets the "payment_account" edge to the PaymentAccount entity by id.
+func (m *UserMutation) SetPaymentAccountID(id int) {
+	m.payment_account = &id
+}
+
+// ClearPaymentAccount clears the "payment_account" edge to the PaymentAccount entity.
+func (m *UserMutation) ClearPaymentAccount() {
+	m.clearedpayment_account = true
+}
+
+// PaymentAccountCleared reports if the "payment_account" edge to the PaymentAccount entity was cleared.
+func (m *UserMutation) PaymentAccountCleared() bool {
+	return m.clearedpayment_account
+}
+
+// PaymentAccountID returns the "payment_account" edge ID in the mutation.
+func (m *UserMutation) PaymentAccountID() (id int, exists bool) {
+	if m.payment_account != nil {
+		return *m.payment_account, true
+	}
+	return
+}
+
+// PaymentAccountIDs returns the "payment_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PaymentAccountID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) PaymentAccountIDs() (ids []int) {
+	if id := m.payment_account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPaymentAccount resets all changes to the "payment_account" edge.
+func (m *UserMutation) ResetPaymentAccount() {
+	m.payment_account = nil
+	m.clearedpayment_account = false
+}
+
 // AddFormIDs adds the "forms" edge to the Form entity by ids.
 func (m *UserMutation) AddFormIDs(ids ...int) {
 	if m.forms == nil {
@@ -10133,6 +20588,60 @@ func (m *UserMutation) ResetResponses() {
 	m.removedresponses = nil
 }
 
+// AddUsageIDs adds the "usage" edge to the Usage entity by ids.
+func (m *UserMutation) AddUsageIDs(ids ...int) {
+	if m.usage == nil {
+		m.usage = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.usage[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsage clears the "usage" edge to the Usage entity.
+func (m *UserMutation) ClearUsage() {
+	m.clearedusage = true
+}
+
+// UsageCleared reports if the "usage" edge to the Usage entity was cleared.
+func (m *UserMutation) UsageCleared() bool {
+	return m.clearedusage
+}
+
+// RemoveUsageIDs removes the "usage" edge to the Usage entity by IDs.
+func (m *UserMutation) RemoveUsageIDs(ids ...int) {
+	if m.removedusage == nil {
+		m.removedusage = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.usage, ids[i])
+		m.removedusage[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsage returns the removed IDs of the "usage" edge to the Usage entity.
+func (m *UserMutation) RemovedUsageIDs() (ids []int) {
+	for id := range m.removedusage {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsageIDs returns the "usage" edge IDs in the mutation.
+func (m *UserMutation) UsageIDs() (ids []int) {
+	for id := range m.usage {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsage resets all changes to the "usage" edge.
+func (m *UserMutation) ResetUsage() {
+	m.usage = nil
+	m.clearedusage = false
+	m.removedusage = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -10538,19 +21047,25 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 6)
 	if m.owner != nil {
 		edges = append(edges, user.EdgeOwner)
 	}
 	if m.payment_customer != nil {
 		edges = append(edges, user.EdgePaymentCustomer)
 	}
+	if m.payment_account != nil {
+		edges = append(edges, user.EdgePaymentAccount)
+	}
 	if m.forms != nil {
 		edges = append(edges, user.EdgeForms)
 	}
 	if m.responses != nil {
 		edges = append(edges, user.EdgeResponses)
 	}
+	if m.usage != nil {
+		edges = append(edges, user.EdgeUsage)
+	}
 	return edges
 }
 
@@ -10568,6 +21083,10 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 		if id := m.payment_customer; id != nil {
 			return []ent.Value{*id}
 		}
+	case user.EdgePaymentAccount:
+		if id := m.payment_account; id != nil {
+			return []ent.Value{*id}
+		}
 	case user.EdgeForms:
 		ids := make([]ent.Value, 0, len(m.forms))
 		for id := range m.forms {
@@ -10580,13 +21099,19 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeUsage:
+		ids := make([]ent.Value, 0, len(m.usage))
+		for id := range m.usage {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 6)
 	if m.removedowner != nil {
 		edges = append(edges, user.EdgeOwner)
 	}
@@ -10596,6 +21121,9 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedresponses != nil {
 		edges = append(edges, user.EdgeResponses)
 	}
+	if m.removedusage != nil {
+		edges = append(edges, user.EdgeUsage)
+	}
 	return edges
 }
 
@@ -10621,25 +21149,37 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeUsage:
+		ids := make([]ent.Value, 0, len(m.removedusage))
+		for id := range m.removedusage {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 6)
 	if m.clearedowner {
 		edges = append(edges, user.EdgeOwner)
 	}
 	if m.clearedpayment_customer {
 		edges = append(edges, user.EdgePaymentCustomer)
 	}
+	if m.clearedpayment_account {
+		edges = append(edges, user.EdgePaymentAccount)
+	}
 	if m.clearedforms {
 		edges = append(edges, user.EdgeForms)
 	}
 	if m.clearedresponses {
 		edges = append(edges, user.EdgeResponses)
 	}
+	if m.clearedusage {
+		edges = append(edges, user.EdgeUsage)
+	}
 	return edges
 }
 
@@ -10651,10 +21191,14 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedowner
 	case user.EdgePaymentCustomer:
 		return m.clearedpayment_customer
+	case user.EdgePaymentAccount:
+		return m.clearedpayment_account
 	case user.EdgeForms:
 		return m.clearedforms
 	case user.EdgeResponses:
 		return m.clearedresponses
+	case user.EdgeUsage:
+		return m.clearedusage
 	}
 	return false
 }
@@ -10666,6 +21210,9 @@ func (m *UserMutation) ClearEdge(name string) error {
 	case user.EdgePaymentCustomer:
 		m.ClearPaymentCustomer()
 		return nil
+	case user.EdgePaymentAccount:
+		m.ClearPaymentAccount()
+		return nil
 	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
@@ -10680,12 +21227,18 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgePaymentCustomer:
 		m.ResetPaymentCustomer()
 		return nil
+	case user.EdgePaymentAccount:
+		m.ResetPaymentAccount()
+		return nil
 	case user.EdgeForms:
 		m.ResetForms()
 		return nil
 	case user.EdgeResponses:
 		m.ResetResponses()
 		return nil
+	case user.EdgeUsage:
+		m.ResetUsage()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
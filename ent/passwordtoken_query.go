@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -24,6 +25,7 @@ type PasswordTokenQuery struct {
 	inters     []Interceptor
 	predicates []predicate.PasswordToken
 	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -383,6 +385,9 @@ func (ptq *PasswordTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -433,6 +438,9 @@ func (ptq *PasswordTokenQuery) loadUser(ctx context.Context, query *UserQuery, n
 
 func (ptq *PasswordTokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ptq.querySpec()
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
 	_spec.Node.Columns = ptq.ctx.Fields
 	if len(ptq.ctx.Fields) > 0 {
 		_spec.Unique = ptq.ctx.Unique != nil && *ptq.ctx.Unique
@@ -498,6 +506,9 @@ func (ptq *PasswordTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ptq.ctx.Unique != nil && *ptq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ptq.modifiers {
+		m(selector)
+	}
 	for _, p := range ptq.predicates {
 		p(selector)
 	}
@@ -515,6 +526,32 @@ func (ptq *PasswordTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ptq *PasswordTokenQuery) ForUpdate(opts ...sql.LockOption) *PasswordTokenQuery {
+	if ptq.driver.Dialect() == dialect.Postgres {
+		ptq.Unique(false)
+	}
+	ptq.modifiers = append(ptq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ptq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ptq *PasswordTokenQuery) ForShare(opts ...sql.LockOption) *PasswordTokenQuery {
+	if ptq.driver.Dialect() == dialect.Postgres {
+		ptq.Unique(false)
+	}
+	ptq.modifiers = append(ptq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ptq
+}
+
 // PasswordTokenGroupBy is the group-by builder for PasswordToken entities.
 type PasswordTokenGroupBy struct {
 	selector
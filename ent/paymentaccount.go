@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/paymentaccount"
+	"github.com/occult/pagode/ent/user"
+)
+
+// PaymentAccount is the model entity for the PaymentAccount schema.
+type PaymentAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// External payment provider connected account ID (e.g., Stripe account ID)
+	ProviderAccountID string `json:"provider_account_id,omitempty"`
+	// Payment provider name
+	Provider string `json:"provider,omitempty"`
+	// Whether the account can receive payments
+	ChargesEnabled bool `json:"charges_enabled,omitempty"`
+	// Whether the owner finished onboarding with the provider
+	DetailsSubmitted bool `json:"details_submitted,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentAccountQuery when eager-loading is set.
+	Edges        PaymentAccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentAccountEdges holds the relations/edges for other nodes in the graph.
+type PaymentAccountEdges struct {
+	// User receiving payments made to their forms
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentAccountEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentaccount.FieldChargesEnabled, paymentaccount.FieldDetailsSubmitted:
+			values[i] = new(sql.NullBool)
+		case paymentaccount.FieldID:
+			values[i] = new(sql.NullInt64)
+		case paymentaccount.FieldProviderAccountID, paymentaccount.FieldProvider:
+			values[i] = new(sql.NullString)
+		case paymentaccount.FieldCreatedAt, paymentaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentAccount fields.
+func (pa *PaymentAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentaccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pa.ID = int(value.Int64)
+		case paymentaccount.FieldProviderAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_account_id", values[i])
+			} else if value.Valid {
+				pa.ProviderAccountID = value.String
+			}
+		case paymentaccount.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				pa.Provider = value.String
+			}
+		case paymentaccount.FieldChargesEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field charges_enabled", values[i])
+			} else if value.Valid {
+				pa.ChargesEnabled = value.Bool
+			}
+		case paymentaccount.FieldDetailsSubmitted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field details_submitted", values[i])
+			} else if value.Valid {
+				pa.DetailsSubmitted = value.Bool
+			}
+		case paymentaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Time
+			}
+		case paymentaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pa.UpdatedAt = value.Time
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentAccount.
+// This includes values selected through modifiers, order, etc.
+func (pa *PaymentAccount) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PaymentAccount entity.
+func (pa *PaymentAccount) QueryUser() *UserQuery {
+	return NewPaymentAccountClient(pa.config).QueryUser(pa)
+}
+
+// Update returns a builder for updating this PaymentAccount.
+// Note that you need to call PaymentAccount.Unwrap() before calling this method if this PaymentAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *PaymentAccount) Update() *PaymentAccountUpdateOne {
+	return NewPaymentAccountClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the PaymentAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *PaymentAccount) Unwrap() *PaymentAccount {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentAccount is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *PaymentAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("provider_account_id=")
+	builder.WriteString(pa.ProviderAccountID)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(pa.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("charges_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", pa.ChargesEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("details_submitted=")
+	builder.WriteString(fmt.Sprintf("%v", pa.DetailsSubmitted))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentAccounts is a parsable slice of PaymentAccount.
+type PaymentAccounts []*PaymentAccount
@@ -0,0 +1,132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the paymentaccount type in the database.
+	Label = "payment_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProviderAccountID holds the string denoting the provider_account_id field in the database.
+	FieldProviderAccountID = "provider_account_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldChargesEnabled holds the string denoting the charges_enabled field in the database.
+	FieldChargesEnabled = "charges_enabled"
+	// FieldDetailsSubmitted holds the string denoting the details_submitted field in the database.
+	FieldDetailsSubmitted = "details_submitted"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the paymentaccount in the database.
+	Table = "payment_accounts"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "users"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "payment_account_user"
+)
+
+// Columns holds all SQL columns for paymentaccount fields.
+var Columns = []string{
+	FieldID,
+	FieldProviderAccountID,
+	FieldProvider,
+	FieldChargesEnabled,
+	FieldDetailsSubmitted,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderAccountIDValidator is a validator for the "provider_account_id" field. It is called by the builders before save.
+	ProviderAccountIDValidator func(string) error
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// DefaultChargesEnabled holds the default value on creation for the "charges_enabled" field.
+	DefaultChargesEnabled bool
+	// DefaultDetailsSubmitted holds the default value on creation for the "details_submitted" field.
+	DefaultDetailsSubmitted bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PaymentAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProviderAccountID orders the results by the provider_account_id field.
+func ByProviderAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderAccountID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByChargesEnabled orders the results by the charges_enabled field.
+func ByChargesEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargesEnabled, opts...).ToFunc()
+}
+
+// ByDetailsSubmitted orders the results by the details_submitted field.
+func ByDetailsSubmitted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetailsSubmitted, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLTE(FieldID, id))
+}
+
+// ProviderAccountID applies equality check predicate on the "provider_account_id" field. It's identical to ProviderAccountIDEQ.
+func ProviderAccountID(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldProviderAccountID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldProvider, v))
+}
+
+// ChargesEnabled applies equality check predicate on the "charges_enabled" field. It's identical to ChargesEnabledEQ.
+func ChargesEnabled(v bool) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldChargesEnabled, v))
+}
+
+// DetailsSubmitted applies equality check predicate on the "details_submitted" field. It's identical to DetailsSubmittedEQ.
+func DetailsSubmitted(v bool) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldDetailsSubmitted, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProviderAccountIDEQ applies the EQ predicate on the "provider_account_id" field.
+func ProviderAccountIDEQ(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDNEQ applies the NEQ predicate on the "provider_account_id" field.
+func ProviderAccountIDNEQ(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNEQ(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDIn applies the In predicate on the "provider_account_id" field.
+func ProviderAccountIDIn(vs ...string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldIn(FieldProviderAccountID, vs...))
+}
+
+// ProviderAccountIDNotIn applies the NotIn predicate on the "provider_account_id" field.
+func ProviderAccountIDNotIn(vs ...string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNotIn(FieldProviderAccountID, vs...))
+}
+
+// ProviderAccountIDGT applies the GT predicate on the "provider_account_id" field.
+func ProviderAccountIDGT(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGT(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDGTE applies the GTE predicate on the "provider_account_id" field.
+func ProviderAccountIDGTE(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGTE(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDLT applies the LT predicate on the "provider_account_id" field.
+func ProviderAccountIDLT(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLT(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDLTE applies the LTE predicate on the "provider_account_id" field.
+func ProviderAccountIDLTE(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLTE(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDContains applies the Contains predicate on the "provider_account_id" field.
+func ProviderAccountIDContains(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldContains(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDHasPrefix applies the HasPrefix predicate on the "provider_account_id" field.
+func ProviderAccountIDHasPrefix(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldHasPrefix(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDHasSuffix applies the HasSuffix predicate on the "provider_account_id" field.
+func ProviderAccountIDHasSuffix(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldHasSuffix(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDEqualFold applies the EqualFold predicate on the "provider_account_id" field.
+func ProviderAccountIDEqualFold(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEqualFold(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDContainsFold applies the ContainsFold predicate on the "provider_account_id" field.
+func ProviderAccountIDContainsFold(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldContainsFold(FieldProviderAccountID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ChargesEnabledEQ applies the EQ predicate on the "charges_enabled" field.
+func ChargesEnabledEQ(v bool) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldChargesEnabled, v))
+}
+
+// ChargesEnabledNEQ applies the NEQ predicate on the "charges_enabled" field.
+func ChargesEnabledNEQ(v bool) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNEQ(FieldChargesEnabled, v))
+}
+
+// DetailsSubmittedEQ applies the EQ predicate on the "details_submitted" field.
+func DetailsSubmittedEQ(v bool) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldDetailsSubmitted, v))
+}
+
+// DetailsSubmittedNEQ applies the NEQ predicate on the "details_submitted" field.
+func DetailsSubmittedNEQ(v bool) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNEQ(FieldDetailsSubmitted, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PaymentAccount {
+	return predicate.PaymentAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PaymentAccount {
+	return predicate.PaymentAccount(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentAccount) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentAccount) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentAccount) predicate.PaymentAccount {
+	return predicate.PaymentAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,799 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/paymentaccount"
+	"github.com/occult/pagode/ent/user"
+)
+
+// PaymentAccountCreate is the builder for creating a PaymentAccount entity.
+type PaymentAccountCreate struct {
+	config
+	mutation *PaymentAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (pac *PaymentAccountCreate) SetProviderAccountID(s string) *PaymentAccountCreate {
+	pac.mutation.SetProviderAccountID(s)
+	return pac
+}
+
+// SetProvider sets the "provider" field.
+func (pac *PaymentAccountCreate) SetProvider(s string) *PaymentAccountCreate {
+	pac.mutation.SetProvider(s)
+	return pac
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pac *PaymentAccountCreate) SetNillableProvider(s *string) *PaymentAccountCreate {
+	if s != nil {
+		pac.SetProvider(*s)
+	}
+	return pac
+}
+
+// SetChargesEnabled sets the "charges_enabled" field.
+func (pac *PaymentAccountCreate) SetChargesEnabled(b bool) *PaymentAccountCreate {
+	pac.mutation.SetChargesEnabled(b)
+	return pac
+}
+
+// SetNillableChargesEnabled sets the "charges_enabled" field if the given value is not nil.
+func (pac *PaymentAccountCreate) SetNillableChargesEnabled(b *bool) *PaymentAccountCreate {
+	if b != nil {
+		pac.SetChargesEnabled(*b)
+	}
+	return pac
+}
+
+// SetDetailsSubmitted sets the "details_submitted" field.
+func (pac *PaymentAccountCreate) SetDetailsSubmitted(b bool) *PaymentAccountCreate {
+	pac.mutation.SetDetailsSubmitted(b)
+	return pac
+}
+
+// SetNillableDetailsSubmitted sets the "details_submitted" field if the given value is not nil.
+func (pac *PaymentAccountCreate) SetNillableDetailsSubmitted(b *bool) *PaymentAccountCreate {
+	if b != nil {
+		pac.SetDetailsSubmitted(*b)
+	}
+	return pac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pac *PaymentAccountCreate) SetCreatedAt(t time.Time) *PaymentAccountCreate {
+	pac.mutation.SetCreatedAt(t)
+	return pac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pac *PaymentAccountCreate) SetNillableCreatedAt(t *time.Time) *PaymentAccountCreate {
+	if t != nil {
+		pac.SetCreatedAt(*t)
+	}
+	return pac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pac *PaymentAccountCreate) SetUpdatedAt(t time.Time) *PaymentAccountCreate {
+	pac.mutation.SetUpdatedAt(t)
+	return pac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pac *PaymentAccountCreate) SetNillableUpdatedAt(t *time.Time) *PaymentAccountCreate {
+	if t != nil {
+		pac.SetUpdatedAt(*t)
+	}
+	return pac
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pac *PaymentAccountCreate) SetUserID(id int) *PaymentAccountCreate {
+	pac.mutation.SetUserID(id)
+	return pac
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pac *PaymentAccountCreate) SetUser(u *User) *PaymentAccountCreate {
+	return pac.SetUserID(u.ID)
+}
+
+// Mutation returns the PaymentAccountMutation object of the builder.
+func (pac *PaymentAccountCreate) Mutation() *PaymentAccountMutation {
+	return pac.mutation
+}
+
+// Save creates the PaymentAccount in the database.
+func (pac *PaymentAccountCreate) Save(ctx context.Context) (*PaymentAccount, error) {
+	pac.defaults()
+	return withHooks(ctx, pac.sqlSave, pac.mutation, pac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pac *PaymentAccountCreate) SaveX(ctx context.Context) *PaymentAccount {
+	v, err := pac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pac *PaymentAccountCreate) Exec(ctx context.Context) error {
+	_, err := pac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pac *PaymentAccountCreate) ExecX(ctx context.Context) {
+	if err := pac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pac *PaymentAccountCreate) defaults() {
+	if _, ok := pac.mutation.Provider(); !ok {
+		v := paymentaccount.DefaultProvider
+		pac.mutation.SetProvider(v)
+	}
+	if _, ok := pac.mutation.ChargesEnabled(); !ok {
+		v := paymentaccount.DefaultChargesEnabled
+		pac.mutation.SetChargesEnabled(v)
+	}
+	if _, ok := pac.mutation.DetailsSubmitted(); !ok {
+		v := paymentaccount.DefaultDetailsSubmitted
+		pac.mutation.SetDetailsSubmitted(v)
+	}
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		v := paymentaccount.DefaultCreatedAt()
+		pac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pac.mutation.UpdatedAt(); !ok {
+		v := paymentaccount.DefaultUpdatedAt()
+		pac.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pac *PaymentAccountCreate) check() error {
+	if _, ok := pac.mutation.ProviderAccountID(); !ok {
+		return &ValidationError{Name: "provider_account_id", err: errors.New(`ent: missing required field "PaymentAccount.provider_account_id"`)}
+	}
+	if v, ok := pac.mutation.ProviderAccountID(); ok {
+		if err := paymentaccount.ProviderAccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_account_id", err: fmt.Errorf(`ent: validator failed for field "PaymentAccount.provider_account_id": %w`, err)}
+		}
+	}
+	if _, ok := pac.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "PaymentAccount.provider"`)}
+	}
+	if v, ok := pac.mutation.Provider(); ok {
+		if err := paymentaccount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentAccount.provider": %w`, err)}
+		}
+	}
+	if _, ok := pac.mutation.ChargesEnabled(); !ok {
+		return &ValidationError{Name: "charges_enabled", err: errors.New(`ent: missing required field "PaymentAccount.charges_enabled"`)}
+	}
+	if _, ok := pac.mutation.DetailsSubmitted(); !ok {
+		return &ValidationError{Name: "details_submitted", err: errors.New(`ent: missing required field "PaymentAccount.details_submitted"`)}
+	}
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentAccount.created_at"`)}
+	}
+	if _, ok := pac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentAccount.updated_at"`)}
+	}
+	if len(pac.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PaymentAccount.user"`)}
+	}
+	return nil
+}
+
+func (pac *PaymentAccountCreate) sqlSave(ctx context.Context) (*PaymentAccount, error) {
+	if err := pac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pac.mutation.id = &_node.ID
+	pac.mutation.done = true
+	return _node, nil
+}
+
+func (pac *PaymentAccountCreate) createSpec() (*PaymentAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentAccount{config: pac.config}
+		_spec = sqlgraph.NewCreateSpec(paymentaccount.Table, sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pac.conflict
+	if value, ok := pac.mutation.ProviderAccountID(); ok {
+		_spec.SetField(paymentaccount.FieldProviderAccountID, field.TypeString, value)
+		_node.ProviderAccountID = value
+	}
+	if value, ok := pac.mutation.Provider(); ok {
+		_spec.SetField(paymentaccount.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := pac.mutation.ChargesEnabled(); ok {
+		_spec.SetField(paymentaccount.FieldChargesEnabled, field.TypeBool, value)
+		_node.ChargesEnabled = value
+	}
+	if value, ok := pac.mutation.DetailsSubmitted(); ok {
+		_spec.SetField(paymentaccount.FieldDetailsSubmitted, field.TypeBool, value)
+		_node.DetailsSubmitted = value
+	}
+	if value, ok := pac.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pac.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentaccount.UserTable,
+			Columns: []string{paymentaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentAccount.Create().
+//		SetProviderAccountID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentAccountUpsert) {
+//			SetProviderAccountID(v+v).
+//		}).
+//		Exec(ctx)
+func (pac *PaymentAccountCreate) OnConflict(opts ...sql.ConflictOption) *PaymentAccountUpsertOne {
+	pac.conflict = opts
+	return &PaymentAccountUpsertOne{
+		create: pac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pac *PaymentAccountCreate) OnConflictColumns(columns ...string) *PaymentAccountUpsertOne {
+	pac.conflict = append(pac.conflict, sql.ConflictColumns(columns...))
+	return &PaymentAccountUpsertOne{
+		create: pac,
+	}
+}
+
+type (
+	// PaymentAccountUpsertOne is the builder for "upsert"-ing
+	//  one PaymentAccount node.
+	PaymentAccountUpsertOne struct {
+		create *PaymentAccountCreate
+	}
+
+	// PaymentAccountUpsert is the "OnConflict" setter.
+	PaymentAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *PaymentAccountUpsert) SetProviderAccountID(v string) *PaymentAccountUpsert {
+	u.Set(paymentaccount.FieldProviderAccountID, v)
+	return u
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *PaymentAccountUpsert) UpdateProviderAccountID() *PaymentAccountUpsert {
+	u.SetExcluded(paymentaccount.FieldProviderAccountID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentAccountUpsert) SetProvider(v string) *PaymentAccountUpsert {
+	u.Set(paymentaccount.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentAccountUpsert) UpdateProvider() *PaymentAccountUpsert {
+	u.SetExcluded(paymentaccount.FieldProvider)
+	return u
+}
+
+// SetChargesEnabled sets the "charges_enabled" field.
+func (u *PaymentAccountUpsert) SetChargesEnabled(v bool) *PaymentAccountUpsert {
+	u.Set(paymentaccount.FieldChargesEnabled, v)
+	return u
+}
+
+// UpdateChargesEnabled sets the "charges_enabled" field to the value that was provided on create.
+func (u *PaymentAccountUpsert) UpdateChargesEnabled() *PaymentAccountUpsert {
+	u.SetExcluded(paymentaccount.FieldChargesEnabled)
+	return u
+}
+
+// SetDetailsSubmitted sets the "details_submitted" field.
+func (u *PaymentAccountUpsert) SetDetailsSubmitted(v bool) *PaymentAccountUpsert {
+	u.Set(paymentaccount.FieldDetailsSubmitted, v)
+	return u
+}
+
+// UpdateDetailsSubmitted sets the "details_submitted" field to the value that was provided on create.
+func (u *PaymentAccountUpsert) UpdateDetailsSubmitted() *PaymentAccountUpsert {
+	u.SetExcluded(paymentaccount.FieldDetailsSubmitted)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentAccountUpsert) SetUpdatedAt(v time.Time) *PaymentAccountUpsert {
+	u.Set(paymentaccount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentAccountUpsert) UpdateUpdatedAt() *PaymentAccountUpsert {
+	u.SetExcluded(paymentaccount.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PaymentAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentAccountUpsertOne) UpdateNewValues() *PaymentAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentaccount.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentAccountUpsertOne) Ignore() *PaymentAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentAccountUpsertOne) DoNothing() *PaymentAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentAccountCreate.OnConflict
+// documentation for more info.
+func (u *PaymentAccountUpsertOne) Update(set func(*PaymentAccountUpsert)) *PaymentAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *PaymentAccountUpsertOne) SetProviderAccountID(v string) *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetProviderAccountID(v)
+	})
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *PaymentAccountUpsertOne) UpdateProviderAccountID() *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateProviderAccountID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentAccountUpsertOne) SetProvider(v string) *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentAccountUpsertOne) UpdateProvider() *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetChargesEnabled sets the "charges_enabled" field.
+func (u *PaymentAccountUpsertOne) SetChargesEnabled(v bool) *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetChargesEnabled(v)
+	})
+}
+
+// UpdateChargesEnabled sets the "charges_enabled" field to the value that was provided on create.
+func (u *PaymentAccountUpsertOne) UpdateChargesEnabled() *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateChargesEnabled()
+	})
+}
+
+// SetDetailsSubmitted sets the "details_submitted" field.
+func (u *PaymentAccountUpsertOne) SetDetailsSubmitted(v bool) *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetDetailsSubmitted(v)
+	})
+}
+
+// UpdateDetailsSubmitted sets the "details_submitted" field to the value that was provided on create.
+func (u *PaymentAccountUpsertOne) UpdateDetailsSubmitted() *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateDetailsSubmitted()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentAccountUpsertOne) SetUpdatedAt(v time.Time) *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentAccountUpsertOne) UpdateUpdatedAt() *PaymentAccountUpsertOne {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentAccountUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentAccountUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentAccountCreateBulk is the builder for creating many PaymentAccount entities in bulk.
+type PaymentAccountCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentAccount entities in the database.
+func (pacb *PaymentAccountCreateBulk) Save(ctx context.Context) ([]*PaymentAccount, error) {
+	if pacb.err != nil {
+		return nil, pacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pacb.builders))
+	nodes := make([]*PaymentAccount, len(pacb.builders))
+	mutators := make([]Mutator, len(pacb.builders))
+	for i := range pacb.builders {
+		func(i int, root context.Context) {
+			builder := pacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pacb *PaymentAccountCreateBulk) SaveX(ctx context.Context) []*PaymentAccount {
+	v, err := pacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pacb *PaymentAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := pacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pacb *PaymentAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := pacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentAccountUpsert) {
+//			SetProviderAccountID(v+v).
+//		}).
+//		Exec(ctx)
+func (pacb *PaymentAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentAccountUpsertBulk {
+	pacb.conflict = opts
+	return &PaymentAccountUpsertBulk{
+		create: pacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pacb *PaymentAccountCreateBulk) OnConflictColumns(columns ...string) *PaymentAccountUpsertBulk {
+	pacb.conflict = append(pacb.conflict, sql.ConflictColumns(columns...))
+	return &PaymentAccountUpsertBulk{
+		create: pacb,
+	}
+}
+
+// PaymentAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentAccount nodes.
+type PaymentAccountUpsertBulk struct {
+	create *PaymentAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentAccountUpsertBulk) UpdateNewValues() *PaymentAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentaccount.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentAccountUpsertBulk) Ignore() *PaymentAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentAccountUpsertBulk) DoNothing() *PaymentAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentAccountUpsertBulk) Update(set func(*PaymentAccountUpsert)) *PaymentAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *PaymentAccountUpsertBulk) SetProviderAccountID(v string) *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetProviderAccountID(v)
+	})
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *PaymentAccountUpsertBulk) UpdateProviderAccountID() *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateProviderAccountID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentAccountUpsertBulk) SetProvider(v string) *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentAccountUpsertBulk) UpdateProvider() *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetChargesEnabled sets the "charges_enabled" field.
+func (u *PaymentAccountUpsertBulk) SetChargesEnabled(v bool) *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetChargesEnabled(v)
+	})
+}
+
+// UpdateChargesEnabled sets the "charges_enabled" field to the value that was provided on create.
+func (u *PaymentAccountUpsertBulk) UpdateChargesEnabled() *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateChargesEnabled()
+	})
+}
+
+// SetDetailsSubmitted sets the "details_submitted" field.
+func (u *PaymentAccountUpsertBulk) SetDetailsSubmitted(v bool) *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetDetailsSubmitted(v)
+	})
+}
+
+// UpdateDetailsSubmitted sets the "details_submitted" field to the value that was provided on create.
+func (u *PaymentAccountUpsertBulk) UpdateDetailsSubmitted() *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateDetailsSubmitted()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentAccountUpsertBulk) SetUpdatedAt(v time.Time) *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentAccountUpsertBulk) UpdateUpdatedAt() *PaymentAccountUpsertBulk {
+	return u.Update(func(s *PaymentAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
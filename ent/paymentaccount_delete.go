@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/paymentaccount"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// PaymentAccountDelete is the builder for deleting a PaymentAccount entity.
+type PaymentAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *PaymentAccountMutation
+}
+
+// Where appends a list predicates to the PaymentAccountDelete builder.
+func (pad *PaymentAccountDelete) Where(ps ...predicate.PaymentAccount) *PaymentAccountDelete {
+	pad.mutation.Where(ps...)
+	return pad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pad *PaymentAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pad.sqlExec, pad.mutation, pad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pad *PaymentAccountDelete) ExecX(ctx context.Context) int {
+	n, err := pad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pad *PaymentAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(paymentaccount.Table, sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt))
+	if ps := pad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pad.mutation.done = true
+	return affected, err
+}
+
+// PaymentAccountDeleteOne is the builder for deleting a single PaymentAccount entity.
+type PaymentAccountDeleteOne struct {
+	pad *PaymentAccountDelete
+}
+
+// Where appends a list predicates to the PaymentAccountDelete builder.
+func (pado *PaymentAccountDeleteOne) Where(ps ...predicate.PaymentAccount) *PaymentAccountDeleteOne {
+	pado.pad.mutation.Where(ps...)
+	return pado
+}
+
+// Exec executes the deletion query.
+func (pado *PaymentAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := pado.pad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{paymentaccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pado *PaymentAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := pado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/paymentaccount"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/user"
+)
+
+// PaymentAccountQuery is the builder for querying PaymentAccount entities.
+type PaymentAccountQuery struct {
+	config
+	ctx        *QueryContext
+	order      []paymentaccount.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PaymentAccount
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PaymentAccountQuery builder.
+func (paq *PaymentAccountQuery) Where(ps ...predicate.PaymentAccount) *PaymentAccountQuery {
+	paq.predicates = append(paq.predicates, ps...)
+	return paq
+}
+
+// Limit the number of records to be returned by this query.
+func (paq *PaymentAccountQuery) Limit(limit int) *PaymentAccountQuery {
+	paq.ctx.Limit = &limit
+	return paq
+}
+
+// Offset to start from.
+func (paq *PaymentAccountQuery) Offset(offset int) *PaymentAccountQuery {
+	paq.ctx.Offset = &offset
+	return paq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (paq *PaymentAccountQuery) Unique(unique bool) *PaymentAccountQuery {
+	paq.ctx.Unique = &unique
+	return paq
+}
+
+// Order specifies how the records should be ordered.
+func (paq *PaymentAccountQuery) Order(o ...paymentaccount.OrderOption) *PaymentAccountQuery {
+	paq.order = append(paq.order, o...)
+	return paq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (paq *PaymentAccountQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: paq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := paq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := paq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentaccount.Table, paymentaccount.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentaccount.UserTable, paymentaccount.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(paq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PaymentAccount entity from the query.
+// Returns a *NotFoundError when no PaymentAccount was found.
+func (paq *PaymentAccountQuery) First(ctx context.Context) (*PaymentAccount, error) {
+	nodes, err := paq.Limit(1).All(setContextOp(ctx, paq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{paymentaccount.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (paq *PaymentAccountQuery) FirstX(ctx context.Context) *PaymentAccount {
+	node, err := paq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PaymentAccount ID from the query.
+// Returns a *NotFoundError when no PaymentAccount ID was found.
+func (paq *PaymentAccountQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = paq.Limit(1).IDs(setContextOp(ctx, paq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{paymentaccount.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (paq *PaymentAccountQuery) FirstIDX(ctx context.Context) int {
+	id, err := paq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PaymentAccount entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PaymentAccount entity is found.
+// Returns a *NotFoundError when no PaymentAccount entities are found.
+func (paq *PaymentAccountQuery) Only(ctx context.Context) (*PaymentAccount, error) {
+	nodes, err := paq.Limit(2).All(setContextOp(ctx, paq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{paymentaccount.Label}
+	default:
+		return nil, &NotSingularError{paymentaccount.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (paq *PaymentAccountQuery) OnlyX(ctx context.Context) *PaymentAccount {
+	node, err := paq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PaymentAccount ID in the query.
+// Returns a *NotSingularError when more than one PaymentAccount ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (paq *PaymentAccountQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = paq.Limit(2).IDs(setContextOp(ctx, paq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{paymentaccount.Label}
+	default:
+		err = &NotSingularError{paymentaccount.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (paq *PaymentAccountQuery) OnlyIDX(ctx context.Context) int {
+	id, err := paq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PaymentAccounts.
+func (paq *PaymentAccountQuery) All(ctx context.Context) ([]*PaymentAccount, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryAll)
+	if err := paq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PaymentAccount, *PaymentAccountQuery]()
+	return withInterceptors[[]*PaymentAccount](ctx, paq, qr, paq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (paq *PaymentAccountQuery) AllX(ctx context.Context) []*PaymentAccount {
+	nodes, err := paq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PaymentAccount IDs.
+func (paq *PaymentAccountQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if paq.ctx.Unique == nil && paq.path != nil {
+		paq.Unique(true)
+	}
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryIDs)
+	if err = paq.Select(paymentaccount.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (paq *PaymentAccountQuery) IDsX(ctx context.Context) []int {
+	ids, err := paq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (paq *PaymentAccountQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryCount)
+	if err := paq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, paq, querierCount[*PaymentAccountQuery](), paq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (paq *PaymentAccountQuery) CountX(ctx context.Context) int {
+	count, err := paq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (paq *PaymentAccountQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryExist)
+	switch _, err := paq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (paq *PaymentAccountQuery) ExistX(ctx context.Context) bool {
+	exist, err := paq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PaymentAccountQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (paq *PaymentAccountQuery) Clone() *PaymentAccountQuery {
+	if paq == nil {
+		return nil
+	}
+	return &PaymentAccountQuery{
+		config:     paq.config,
+		ctx:        paq.ctx.Clone(),
+		order:      append([]paymentaccount.OrderOption{}, paq.order...),
+		inters:     append([]Interceptor{}, paq.inters...),
+		predicates: append([]predicate.PaymentAccount{}, paq.predicates...),
+		withUser:   paq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  paq.sql.Clone(),
+		path: paq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (paq *PaymentAccountQuery) WithUser(opts ...func(*UserQuery)) *PaymentAccountQuery {
+	query := (&UserClient{config: paq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	paq.withUser = query
+	return paq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProviderAccountID string `json:"provider_account_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PaymentAccount.Query().
+//		GroupBy(paymentaccount.FieldProviderAccountID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (paq *PaymentAccountQuery) GroupBy(field string, fields ...string) *PaymentAccountGroupBy {
+	paq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PaymentAccountGroupBy{build: paq}
+	grbuild.flds = &paq.ctx.Fields
+	grbuild.label = paymentaccount.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProviderAccountID string `json:"provider_account_id,omitempty"`
+//	}
+//
+//	client.PaymentAccount.Query().
+//		Select(paymentaccount.FieldProviderAccountID).
+//		Scan(ctx, &v)
+func (paq *PaymentAccountQuery) Select(fields ...string) *PaymentAccountSelect {
+	paq.ctx.Fields = append(paq.ctx.Fields, fields...)
+	sbuild := &PaymentAccountSelect{PaymentAccountQuery: paq}
+	sbuild.label = paymentaccount.Label
+	sbuild.flds, sbuild.scan = &paq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PaymentAccountSelect configured with the given aggregations.
+func (paq *PaymentAccountQuery) Aggregate(fns ...AggregateFunc) *PaymentAccountSelect {
+	return paq.Select().Aggregate(fns...)
+}
+
+func (paq *PaymentAccountQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range paq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, paq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range paq.ctx.Fields {
+		if !paymentaccount.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if paq.path != nil {
+		prev, err := paq.path(ctx)
+		if err != nil {
+			return err
+		}
+		paq.sql = prev
+	}
+	return nil
+}
+
+func (paq *PaymentAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PaymentAccount, error) {
+	var (
+		nodes       = []*PaymentAccount{}
+		_spec       = paq.querySpec()
+		loadedTypes = [1]bool{
+			paq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PaymentAccount).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PaymentAccount{config: paq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(paq.modifiers) > 0 {
+		_spec.Modifiers = paq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, paq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := paq.withUser; query != nil {
+		if err := paq.loadUser(ctx, query, nodes, nil,
+			func(n *PaymentAccount, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (paq *PaymentAccountQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*PaymentAccount, init func(*PaymentAccount), assign func(*PaymentAccount, *User)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PaymentAccount)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.User(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentaccount.UserColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_account_user
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_account_user" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_account_user" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (paq *PaymentAccountQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := paq.querySpec()
+	if len(paq.modifiers) > 0 {
+		_spec.Modifiers = paq.modifiers
+	}
+	_spec.Node.Columns = paq.ctx.Fields
+	if len(paq.ctx.Fields) > 0 {
+		_spec.Unique = paq.ctx.Unique != nil && *paq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, paq.driver, _spec)
+}
+
+func (paq *PaymentAccountQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(paymentaccount.Table, paymentaccount.Columns, sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt))
+	_spec.From = paq.sql
+	if unique := paq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if paq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := paq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentaccount.FieldID)
+		for i := range fields {
+			if fields[i] != paymentaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := paq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := paq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := paq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := paq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (paq *PaymentAccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(paq.driver.Dialect())
+	t1 := builder.Table(paymentaccount.Table)
+	columns := paq.ctx.Fields
+	if len(columns) == 0 {
+		columns = paymentaccount.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if paq.sql != nil {
+		selector = paq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if paq.ctx.Unique != nil && *paq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range paq.modifiers {
+		m(selector)
+	}
+	for _, p := range paq.predicates {
+		p(selector)
+	}
+	for _, p := range paq.order {
+		p(selector)
+	}
+	if offset := paq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := paq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (paq *PaymentAccountQuery) ForUpdate(opts ...sql.LockOption) *PaymentAccountQuery {
+	if paq.driver.Dialect() == dialect.Postgres {
+		paq.Unique(false)
+	}
+	paq.modifiers = append(paq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return paq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (paq *PaymentAccountQuery) ForShare(opts ...sql.LockOption) *PaymentAccountQuery {
+	if paq.driver.Dialect() == dialect.Postgres {
+		paq.Unique(false)
+	}
+	paq.modifiers = append(paq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return paq
+}
+
+// PaymentAccountGroupBy is the group-by builder for PaymentAccount entities.
+type PaymentAccountGroupBy struct {
+	selector
+	build *PaymentAccountQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pagb *PaymentAccountGroupBy) Aggregate(fns ...AggregateFunc) *PaymentAccountGroupBy {
+	pagb.fns = append(pagb.fns, fns...)
+	return pagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pagb *PaymentAccountGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pagb.build.ctx, ent.OpQueryGroupBy)
+	if err := pagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentAccountQuery, *PaymentAccountGroupBy](ctx, pagb.build, pagb, pagb.build.inters, v)
+}
+
+func (pagb *PaymentAccountGroupBy) sqlScan(ctx context.Context, root *PaymentAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pagb.fns))
+	for _, fn := range pagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pagb.flds)+len(pagb.fns))
+		for _, f := range *pagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PaymentAccountSelect is the builder for selecting fields of PaymentAccount entities.
+type PaymentAccountSelect struct {
+	*PaymentAccountQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pas *PaymentAccountSelect) Aggregate(fns ...AggregateFunc) *PaymentAccountSelect {
+	pas.fns = append(pas.fns, fns...)
+	return pas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pas *PaymentAccountSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pas.ctx, ent.OpQuerySelect)
+	if err := pas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentAccountQuery, *PaymentAccountSelect](ctx, pas.PaymentAccountQuery, pas, pas.inters, v)
+}
+
+func (pas *PaymentAccountSelect) sqlScan(ctx context.Context, root *PaymentAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pas.fns))
+	for _, fn := range pas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
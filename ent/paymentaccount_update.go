@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/paymentaccount"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/user"
+)
+
+// PaymentAccountUpdate is the builder for updating PaymentAccount entities.
+type PaymentAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentAccountMutation
+}
+
+// Where appends a list predicates to the PaymentAccountUpdate builder.
+func (pau *PaymentAccountUpdate) Where(ps ...predicate.PaymentAccount) *PaymentAccountUpdate {
+	pau.mutation.Where(ps...)
+	return pau
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (pau *PaymentAccountUpdate) SetProviderAccountID(s string) *PaymentAccountUpdate {
+	pau.mutation.SetProviderAccountID(s)
+	return pau
+}
+
+// SetNillableProviderAccountID sets the "provider_account_id" field if the given value is not nil.
+func (pau *PaymentAccountUpdate) SetNillableProviderAccountID(s *string) *PaymentAccountUpdate {
+	if s != nil {
+		pau.SetProviderAccountID(*s)
+	}
+	return pau
+}
+
+// SetProvider sets the "provider" field.
+func (pau *PaymentAccountUpdate) SetProvider(s string) *PaymentAccountUpdate {
+	pau.mutation.SetProvider(s)
+	return pau
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pau *PaymentAccountUpdate) SetNillableProvider(s *string) *PaymentAccountUpdate {
+	if s != nil {
+		pau.SetProvider(*s)
+	}
+	return pau
+}
+
+// SetChargesEnabled sets the "charges_enabled" field.
+func (pau *PaymentAccountUpdate) SetChargesEnabled(b bool) *PaymentAccountUpdate {
+	pau.mutation.SetChargesEnabled(b)
+	return pau
+}
+
+// SetNillableChargesEnabled sets the "charges_enabled" field if the given value is not nil.
+func (pau *PaymentAccountUpdate) SetNillableChargesEnabled(b *bool) *PaymentAccountUpdate {
+	if b != nil {
+		pau.SetChargesEnabled(*b)
+	}
+	return pau
+}
+
+// SetDetailsSubmitted sets the "details_submitted" field.
+func (pau *PaymentAccountUpdate) SetDetailsSubmitted(b bool) *PaymentAccountUpdate {
+	pau.mutation.SetDetailsSubmitted(b)
+	return pau
+}
+
+// SetNillableDetailsSubmitted sets the "details_submitted" field if the given value is not nil.
+func (pau *PaymentAccountUpdate) SetNillableDetailsSubmitted(b *bool) *PaymentAccountUpdate {
+	if b != nil {
+		pau.SetDetailsSubmitted(*b)
+	}
+	return pau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pau *PaymentAccountUpdate) SetUpdatedAt(t time.Time) *PaymentAccountUpdate {
+	pau.mutation.SetUpdatedAt(t)
+	return pau
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pau *PaymentAccountUpdate) SetUserID(id int) *PaymentAccountUpdate {
+	pau.mutation.SetUserID(id)
+	return pau
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pau *PaymentAccountUpdate) SetUser(u *User) *PaymentAccountUpdate {
+	return pau.SetUserID(u.ID)
+}
+
+// Mutation returns the PaymentAccountMutation object of the builder.
+func (pau *PaymentAccountUpdate) Mutation() *PaymentAccountMutation {
+	return pau.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pau *PaymentAccountUpdate) ClearUser() *PaymentAccountUpdate {
+	pau.mutation.ClearUser()
+	return pau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pau *PaymentAccountUpdate) Save(ctx context.Context) (int, error) {
+	pau.defaults()
+	return withHooks(ctx, pau.sqlSave, pau.mutation, pau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pau *PaymentAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := pau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pau *PaymentAccountUpdate) Exec(ctx context.Context) error {
+	_, err := pau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pau *PaymentAccountUpdate) ExecX(ctx context.Context) {
+	if err := pau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pau *PaymentAccountUpdate) defaults() {
+	if _, ok := pau.mutation.UpdatedAt(); !ok {
+		v := paymentaccount.UpdateDefaultUpdatedAt()
+		pau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pau *PaymentAccountUpdate) check() error {
+	if v, ok := pau.mutation.ProviderAccountID(); ok {
+		if err := paymentaccount.ProviderAccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_account_id", err: fmt.Errorf(`ent: validator failed for field "PaymentAccount.provider_account_id": %w`, err)}
+		}
+	}
+	if v, ok := pau.mutation.Provider(); ok {
+		if err := paymentaccount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentAccount.provider": %w`, err)}
+		}
+	}
+	if pau.mutation.UserCleared() && len(pau.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentAccount.user"`)
+	}
+	return nil
+}
+
+func (pau *PaymentAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentaccount.Table, paymentaccount.Columns, sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt))
+	if ps := pau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pau.mutation.ProviderAccountID(); ok {
+		_spec.SetField(paymentaccount.FieldProviderAccountID, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.Provider(); ok {
+		_spec.SetField(paymentaccount.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.ChargesEnabled(); ok {
+		_spec.SetField(paymentaccount.FieldChargesEnabled, field.TypeBool, value)
+	}
+	if value, ok := pau.mutation.DetailsSubmitted(); ok {
+		_spec.SetField(paymentaccount.FieldDetailsSubmitted, field.TypeBool, value)
+	}
+	if value, ok := pau.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pau.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentaccount.UserTable,
+			Columns: []string{paymentaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pau.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentaccount.UserTable,
+			Columns: []string{paymentaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pau.mutation.done = true
+	return n, nil
+}
+
+// PaymentAccountUpdateOne is the builder for updating a single PaymentAccount entity.
+type PaymentAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentAccountMutation
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (pauo *PaymentAccountUpdateOne) SetProviderAccountID(s string) *PaymentAccountUpdateOne {
+	pauo.mutation.SetProviderAccountID(s)
+	return pauo
+}
+
+// SetNillableProviderAccountID sets the "provider_account_id" field if the given value is not nil.
+func (pauo *PaymentAccountUpdateOne) SetNillableProviderAccountID(s *string) *PaymentAccountUpdateOne {
+	if s != nil {
+		pauo.SetProviderAccountID(*s)
+	}
+	return pauo
+}
+
+// SetProvider sets the "provider" field.
+func (pauo *PaymentAccountUpdateOne) SetProvider(s string) *PaymentAccountUpdateOne {
+	pauo.mutation.SetProvider(s)
+	return pauo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pauo *PaymentAccountUpdateOne) SetNillableProvider(s *string) *PaymentAccountUpdateOne {
+	if s != nil {
+		pauo.SetProvider(*s)
+	}
+	return pauo
+}
+
+// SetChargesEnabled sets the "charges_enabled" field.
+func (pauo *PaymentAccountUpdateOne) SetChargesEnabled(b bool) *PaymentAccountUpdateOne {
+	pauo.mutation.SetChargesEnabled(b)
+	return pauo
+}
+
+// SetNillableChargesEnabled sets the "charges_enabled" field if the given value is not nil.
+func (pauo *PaymentAccountUpdateOne) SetNillableChargesEnabled(b *bool) *PaymentAccountUpdateOne {
+	if b != nil {
+		pauo.SetChargesEnabled(*b)
+	}
+	return pauo
+}
+
+// SetDetailsSubmitted sets the "details_submitted" field.
+func (pauo *PaymentAccountUpdateOne) SetDetailsSubmitted(b bool) *PaymentAccountUpdateOne {
+	pauo.mutation.SetDetailsSubmitted(b)
+	return pauo
+}
+
+// SetNillableDetailsSubmitted sets the "details_submitted" field if the given value is not nil.
+func (pauo *PaymentAccountUpdateOne) SetNillableDetailsSubmitted(b *bool) *PaymentAccountUpdateOne {
+	if b != nil {
+		pauo.SetDetailsSubmitted(*b)
+	}
+	return pauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pauo *PaymentAccountUpdateOne) SetUpdatedAt(t time.Time) *PaymentAccountUpdateOne {
+	pauo.mutation.SetUpdatedAt(t)
+	return pauo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pauo *PaymentAccountUpdateOne) SetUserID(id int) *PaymentAccountUpdateOne {
+	pauo.mutation.SetUserID(id)
+	return pauo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pauo *PaymentAccountUpdateOne) SetUser(u *User) *PaymentAccountUpdateOne {
+	return pauo.SetUserID(u.ID)
+}
+
+// Mutation returns the PaymentAccountMutation object of the builder.
+func (pauo *PaymentAccountUpdateOne) Mutation() *PaymentAccountMutation {
+	return pauo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pauo *PaymentAccountUpdateOne) ClearUser() *PaymentAccountUpdateOne {
+	pauo.mutation.ClearUser()
+	return pauo
+}
+
+// Where appends a list predicates to the PaymentAccountUpdate builder.
+func (pauo *PaymentAccountUpdateOne) Where(ps ...predicate.PaymentAccount) *PaymentAccountUpdateOne {
+	pauo.mutation.Where(ps...)
+	return pauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pauo *PaymentAccountUpdateOne) Select(field string, fields ...string) *PaymentAccountUpdateOne {
+	pauo.fields = append([]string{field}, fields...)
+	return pauo
+}
+
+// Save executes the query and returns the updated PaymentAccount entity.
+func (pauo *PaymentAccountUpdateOne) Save(ctx context.Context) (*PaymentAccount, error) {
+	pauo.defaults()
+	return withHooks(ctx, pauo.sqlSave, pauo.mutation, pauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pauo *PaymentAccountUpdateOne) SaveX(ctx context.Context) *PaymentAccount {
+	node, err := pauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pauo *PaymentAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := pauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pauo *PaymentAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := pauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pauo *PaymentAccountUpdateOne) defaults() {
+	if _, ok := pauo.mutation.UpdatedAt(); !ok {
+		v := paymentaccount.UpdateDefaultUpdatedAt()
+		pauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pauo *PaymentAccountUpdateOne) check() error {
+	if v, ok := pauo.mutation.ProviderAccountID(); ok {
+		if err := paymentaccount.ProviderAccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_account_id", err: fmt.Errorf(`ent: validator failed for field "PaymentAccount.provider_account_id": %w`, err)}
+		}
+	}
+	if v, ok := pauo.mutation.Provider(); ok {
+		if err := paymentaccount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentAccount.provider": %w`, err)}
+		}
+	}
+	if pauo.mutation.UserCleared() && len(pauo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentAccount.user"`)
+	}
+	return nil
+}
+
+func (pauo *PaymentAccountUpdateOne) sqlSave(ctx context.Context) (_node *PaymentAccount, err error) {
+	if err := pauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentaccount.Table, paymentaccount.Columns, sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt))
+	id, ok := pauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentaccount.FieldID)
+		for _, f := range fields {
+			if !paymentaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pauo.mutation.ProviderAccountID(); ok {
+		_spec.SetField(paymentaccount.FieldProviderAccountID, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.Provider(); ok {
+		_spec.SetField(paymentaccount.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.ChargesEnabled(); ok {
+		_spec.SetField(paymentaccount.FieldChargesEnabled, field.TypeBool, value)
+	}
+	if value, ok := pauo.mutation.DetailsSubmitted(); ok {
+		_spec.SetField(paymentaccount.FieldDetailsSubmitted, field.TypeBool, value)
+	}
+	if value, ok := pauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pauo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentaccount.UserTable,
+			Columns: []string{paymentaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pauo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentaccount.UserTable,
+			Columns: []string{paymentaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentAccount{config: pauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pauo.mutation.done = true
+	return _node, nil
+}
@@ -49,9 +49,11 @@ type PaymentCustomerEdges struct {
 	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
 	// Payment methods for this customer
 	PaymentMethods []*PaymentMethod `json:"payment_methods,omitempty"`
+	// Invoices issued to this customer
+	Invoices []*Invoice `json:"invoices,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -92,6 +94,15 @@ func (e PaymentCustomerEdges) PaymentMethodsOrErr() ([]*PaymentMethod, error) {
 	return nil, &NotLoadedError{edge: "payment_methods"}
 }
 
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentCustomerEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[4] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PaymentCustomer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -203,6 +214,11 @@ func (pc *PaymentCustomer) QueryPaymentMethods() *PaymentMethodQuery {
 	return NewPaymentCustomerClient(pc.config).QueryPaymentMethods(pc)
 }
 
+// QueryInvoices queries the "invoices" edge of the PaymentCustomer entity.
+func (pc *PaymentCustomer) QueryInvoices() *InvoiceQuery {
+	return NewPaymentCustomerClient(pc.config).QueryInvoices(pc)
+}
+
 // Update returns a builder for updating this PaymentCustomer.
 // Note that you need to call PaymentCustomer.Unwrap() before calling this method if this PaymentCustomer
 // was returned from a transaction, and the transaction was committed or rolled back.
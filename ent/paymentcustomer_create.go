@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
@@ -22,6 +24,7 @@ type PaymentCustomerCreate struct {
 	config
 	mutation *PaymentCustomerMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProviderCustomerID sets the "provider_customer_id" field.
@@ -154,6 +157,21 @@ func (pcc *PaymentCustomerCreate) AddPaymentMethods(p ...*PaymentMethod) *Paymen
 	return pcc.AddPaymentMethodIDs(ids...)
 }
 
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (pcc *PaymentCustomerCreate) AddInvoiceIDs(ids ...int) *PaymentCustomerCreate {
+	pcc.mutation.AddInvoiceIDs(ids...)
+	return pcc
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (pcc *PaymentCustomerCreate) AddInvoices(i ...*Invoice) *PaymentCustomerCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pcc.AddInvoiceIDs(ids...)
+}
+
 // Mutation returns the PaymentCustomerMutation object of the builder.
 func (pcc *PaymentCustomerCreate) Mutation() *PaymentCustomerMutation {
 	return pcc.mutation
@@ -264,6 +282,7 @@ func (pcc *PaymentCustomerCreate) createSpec() (*PaymentCustomer, *sqlgraph.Crea
 		_node = &PaymentCustomer{config: pcc.config}
 		_spec = sqlgraph.NewCreateSpec(paymentcustomer.Table, sqlgraph.NewFieldSpec(paymentcustomer.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = pcc.conflict
 	if value, ok := pcc.mutation.ProviderCustomerID(); ok {
 		_spec.SetField(paymentcustomer.FieldProviderCustomerID, field.TypeString, value)
 		_node.ProviderCustomerID = value
@@ -356,14 +375,340 @@ func (pcc *PaymentCustomerCreate) createSpec() (*PaymentCustomer, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pcc.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentcustomer.InvoicesTable,
+			Columns: []string{paymentcustomer.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentCustomer.Create().
+//		SetProviderCustomerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentCustomerUpsert) {
+//			SetProviderCustomerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pcc *PaymentCustomerCreate) OnConflict(opts ...sql.ConflictOption) *PaymentCustomerUpsertOne {
+	pcc.conflict = opts
+	return &PaymentCustomerUpsertOne{
+		create: pcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentCustomer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcc *PaymentCustomerCreate) OnConflictColumns(columns ...string) *PaymentCustomerUpsertOne {
+	pcc.conflict = append(pcc.conflict, sql.ConflictColumns(columns...))
+	return &PaymentCustomerUpsertOne{
+		create: pcc,
+	}
+}
+
+type (
+	// PaymentCustomerUpsertOne is the builder for "upsert"-ing
+	//  one PaymentCustomer node.
+	PaymentCustomerUpsertOne struct {
+		create *PaymentCustomerCreate
+	}
+
+	// PaymentCustomerUpsert is the "OnConflict" setter.
+	PaymentCustomerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProviderCustomerID sets the "provider_customer_id" field.
+func (u *PaymentCustomerUpsert) SetProviderCustomerID(v string) *PaymentCustomerUpsert {
+	u.Set(paymentcustomer.FieldProviderCustomerID, v)
+	return u
+}
+
+// UpdateProviderCustomerID sets the "provider_customer_id" field to the value that was provided on create.
+func (u *PaymentCustomerUpsert) UpdateProviderCustomerID() *PaymentCustomerUpsert {
+	u.SetExcluded(paymentcustomer.FieldProviderCustomerID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentCustomerUpsert) SetProvider(v string) *PaymentCustomerUpsert {
+	u.Set(paymentcustomer.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentCustomerUpsert) UpdateProvider() *PaymentCustomerUpsert {
+	u.SetExcluded(paymentcustomer.FieldProvider)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *PaymentCustomerUpsert) SetEmail(v string) *PaymentCustomerUpsert {
+	u.Set(paymentcustomer.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PaymentCustomerUpsert) UpdateEmail() *PaymentCustomerUpsert {
+	u.SetExcluded(paymentcustomer.FieldEmail)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PaymentCustomerUpsert) SetName(v string) *PaymentCustomerUpsert {
+	u.Set(paymentcustomer.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PaymentCustomerUpsert) UpdateName() *PaymentCustomerUpsert {
+	u.SetExcluded(paymentcustomer.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PaymentCustomerUpsert) ClearName() *PaymentCustomerUpsert {
+	u.SetNull(paymentcustomer.FieldName)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentCustomerUpsert) SetMetadata(v map[string]interface{}) *PaymentCustomerUpsert {
+	u.Set(paymentcustomer.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentCustomerUpsert) UpdateMetadata() *PaymentCustomerUpsert {
+	u.SetExcluded(paymentcustomer.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentCustomerUpsert) ClearMetadata() *PaymentCustomerUpsert {
+	u.SetNull(paymentcustomer.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentCustomerUpsert) SetUpdatedAt(v time.Time) *PaymentCustomerUpsert {
+	u.Set(paymentcustomer.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentCustomerUpsert) UpdateUpdatedAt() *PaymentCustomerUpsert {
+	u.SetExcluded(paymentcustomer.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PaymentCustomer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentCustomerUpsertOne) UpdateNewValues() *PaymentCustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentcustomer.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentCustomer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentCustomerUpsertOne) Ignore() *PaymentCustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentCustomerUpsertOne) DoNothing() *PaymentCustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentCustomerCreate.OnConflict
+// documentation for more info.
+func (u *PaymentCustomerUpsertOne) Update(set func(*PaymentCustomerUpsert)) *PaymentCustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentCustomerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderCustomerID sets the "provider_customer_id" field.
+func (u *PaymentCustomerUpsertOne) SetProviderCustomerID(v string) *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetProviderCustomerID(v)
+	})
+}
+
+// UpdateProviderCustomerID sets the "provider_customer_id" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertOne) UpdateProviderCustomerID() *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateProviderCustomerID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentCustomerUpsertOne) SetProvider(v string) *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertOne) UpdateProvider() *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *PaymentCustomerUpsertOne) SetEmail(v string) *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertOne) UpdateEmail() *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PaymentCustomerUpsertOne) SetName(v string) *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertOne) UpdateName() *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PaymentCustomerUpsertOne) ClearName() *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentCustomerUpsertOne) SetMetadata(v map[string]interface{}) *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertOne) UpdateMetadata() *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentCustomerUpsertOne) ClearMetadata() *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentCustomerUpsertOne) SetUpdatedAt(v time.Time) *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertOne) UpdateUpdatedAt() *PaymentCustomerUpsertOne {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentCustomerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentCustomerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentCustomerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentCustomerUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentCustomerUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PaymentCustomerCreateBulk is the builder for creating many PaymentCustomer entities in bulk.
 type PaymentCustomerCreateBulk struct {
 	config
 	err      error
 	builders []*PaymentCustomerCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PaymentCustomer entities in the database.
@@ -393,6 +738,7 @@ func (pccb *PaymentCustomerCreateBulk) Save(ctx context.Context) ([]*PaymentCust
 					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -446,3 +792,212 @@ func (pccb *PaymentCustomerCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentCustomer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentCustomerUpsert) {
+//			SetProviderCustomerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pccb *PaymentCustomerCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentCustomerUpsertBulk {
+	pccb.conflict = opts
+	return &PaymentCustomerUpsertBulk{
+		create: pccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentCustomer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pccb *PaymentCustomerCreateBulk) OnConflictColumns(columns ...string) *PaymentCustomerUpsertBulk {
+	pccb.conflict = append(pccb.conflict, sql.ConflictColumns(columns...))
+	return &PaymentCustomerUpsertBulk{
+		create: pccb,
+	}
+}
+
+// PaymentCustomerUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentCustomer nodes.
+type PaymentCustomerUpsertBulk struct {
+	create *PaymentCustomerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentCustomer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentCustomerUpsertBulk) UpdateNewValues() *PaymentCustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentcustomer.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentCustomer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentCustomerUpsertBulk) Ignore() *PaymentCustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentCustomerUpsertBulk) DoNothing() *PaymentCustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentCustomerCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentCustomerUpsertBulk) Update(set func(*PaymentCustomerUpsert)) *PaymentCustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentCustomerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderCustomerID sets the "provider_customer_id" field.
+func (u *PaymentCustomerUpsertBulk) SetProviderCustomerID(v string) *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetProviderCustomerID(v)
+	})
+}
+
+// UpdateProviderCustomerID sets the "provider_customer_id" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertBulk) UpdateProviderCustomerID() *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateProviderCustomerID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentCustomerUpsertBulk) SetProvider(v string) *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertBulk) UpdateProvider() *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *PaymentCustomerUpsertBulk) SetEmail(v string) *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertBulk) UpdateEmail() *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PaymentCustomerUpsertBulk) SetName(v string) *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertBulk) UpdateName() *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PaymentCustomerUpsertBulk) ClearName() *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentCustomerUpsertBulk) SetMetadata(v map[string]interface{}) *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertBulk) UpdateMetadata() *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentCustomerUpsertBulk) ClearMetadata() *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentCustomerUpsertBulk) SetUpdatedAt(v time.Time) *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentCustomerUpsertBulk) UpdateUpdatedAt() *PaymentCustomerUpsertBulk {
+	return u.Update(func(s *PaymentCustomerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentCustomerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentCustomerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentCustomerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentCustomerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
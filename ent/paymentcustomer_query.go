@@ -9,9 +9,11 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
@@ -31,6 +33,8 @@ type PaymentCustomerQuery struct {
 	withPaymentIntents *PaymentIntentQuery
 	withSubscriptions  *SubscriptionQuery
 	withPaymentMethods *PaymentMethodQuery
+	withInvoices       *InvoiceQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +159,28 @@ func (pcq *PaymentCustomerQuery) QueryPaymentMethods() *PaymentMethodQuery {
 	return query
 }
 
+// QueryInvoices chains the current query on the "invoices" edge.
+func (pcq *PaymentCustomerQuery) QueryInvoices() *InvoiceQuery {
+	query := (&InvoiceClient{config: pcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentcustomer.Table, paymentcustomer.FieldID, selector),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentcustomer.InvoicesTable, paymentcustomer.InvoicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PaymentCustomer entity from the query.
 // Returns a *NotFoundError when no PaymentCustomer was found.
 func (pcq *PaymentCustomerQuery) First(ctx context.Context) (*PaymentCustomer, error) {
@@ -351,6 +377,7 @@ func (pcq *PaymentCustomerQuery) Clone() *PaymentCustomerQuery {
 		withPaymentIntents: pcq.withPaymentIntents.Clone(),
 		withSubscriptions:  pcq.withSubscriptions.Clone(),
 		withPaymentMethods: pcq.withPaymentMethods.Clone(),
+		withInvoices:       pcq.withInvoices.Clone(),
 		// clone intermediate query.
 		sql:  pcq.sql.Clone(),
 		path: pcq.path,
@@ -401,6 +428,17 @@ func (pcq *PaymentCustomerQuery) WithPaymentMethods(opts ...func(*PaymentMethodQ
 	return pcq
 }
 
+// WithInvoices tells the query-builder to eager-load the nodes that are connected to
+// the "invoices" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PaymentCustomerQuery) WithInvoices(opts ...func(*InvoiceQuery)) *PaymentCustomerQuery {
+	query := (&InvoiceClient{config: pcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withInvoices = query
+	return pcq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -479,11 +517,12 @@ func (pcq *PaymentCustomerQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*PaymentCustomer{}
 		_spec       = pcq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			pcq.withUser != nil,
 			pcq.withPaymentIntents != nil,
 			pcq.withSubscriptions != nil,
 			pcq.withPaymentMethods != nil,
+			pcq.withInvoices != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -495,6 +534,9 @@ func (pcq *PaymentCustomerQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -531,6 +573,13 @@ func (pcq *PaymentCustomerQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := pcq.withInvoices; query != nil {
+		if err := pcq.loadInvoices(ctx, query, nodes,
+			func(n *PaymentCustomer) { n.Edges.Invoices = []*Invoice{} },
+			func(n *PaymentCustomer, e *Invoice) { n.Edges.Invoices = append(n.Edges.Invoices, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -655,9 +704,43 @@ func (pcq *PaymentCustomerQuery) loadPaymentMethods(ctx context.Context, query *
 	}
 	return nil
 }
+func (pcq *PaymentCustomerQuery) loadInvoices(ctx context.Context, query *InvoiceQuery, nodes []*PaymentCustomer, init func(*PaymentCustomer), assign func(*PaymentCustomer, *Invoice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PaymentCustomer)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentcustomer.InvoicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_customer_invoices
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_customer_invoices" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_customer_invoices" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pcq *PaymentCustomerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pcq.querySpec()
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
 	_spec.Node.Columns = pcq.ctx.Fields
 	if len(pcq.ctx.Fields) > 0 {
 		_spec.Unique = pcq.ctx.Unique != nil && *pcq.ctx.Unique
@@ -720,6 +803,9 @@ func (pcq *PaymentCustomerQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pcq.ctx.Unique != nil && *pcq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pcq.modifiers {
+		m(selector)
+	}
 	for _, p := range pcq.predicates {
 		p(selector)
 	}
@@ -737,6 +823,32 @@ func (pcq *PaymentCustomerQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pcq *PaymentCustomerQuery) ForUpdate(opts ...sql.LockOption) *PaymentCustomerQuery {
+	if pcq.driver.Dialect() == dialect.Postgres {
+		pcq.Unique(false)
+	}
+	pcq.modifiers = append(pcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pcq *PaymentCustomerQuery) ForShare(opts ...sql.LockOption) *PaymentCustomerQuery {
+	if pcq.driver.Dialect() == dialect.Postgres {
+		pcq.Unique(false)
+	}
+	pcq.modifiers = append(pcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pcq
+}
+
 // PaymentCustomerGroupBy is the group-by builder for PaymentCustomer entities.
 type PaymentCustomerGroupBy struct {
 	selector
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
@@ -168,6 +169,21 @@ func (pcu *PaymentCustomerUpdate) AddPaymentMethods(p ...*PaymentMethod) *Paymen
 	return pcu.AddPaymentMethodIDs(ids...)
 }
 
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (pcu *PaymentCustomerUpdate) AddInvoiceIDs(ids ...int) *PaymentCustomerUpdate {
+	pcu.mutation.AddInvoiceIDs(ids...)
+	return pcu
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (pcu *PaymentCustomerUpdate) AddInvoices(i ...*Invoice) *PaymentCustomerUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pcu.AddInvoiceIDs(ids...)
+}
+
 // Mutation returns the PaymentCustomerMutation object of the builder.
 func (pcu *PaymentCustomerUpdate) Mutation() *PaymentCustomerMutation {
 	return pcu.mutation
@@ -242,6 +258,27 @@ func (pcu *PaymentCustomerUpdate) RemovePaymentMethods(p ...*PaymentMethod) *Pay
 	return pcu.RemovePaymentMethodIDs(ids...)
 }
 
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (pcu *PaymentCustomerUpdate) ClearInvoices() *PaymentCustomerUpdate {
+	pcu.mutation.ClearInvoices()
+	return pcu
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (pcu *PaymentCustomerUpdate) RemoveInvoiceIDs(ids ...int) *PaymentCustomerUpdate {
+	pcu.mutation.RemoveInvoiceIDs(ids...)
+	return pcu
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (pcu *PaymentCustomerUpdate) RemoveInvoices(i ...*Invoice) *PaymentCustomerUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pcu.RemoveInvoiceIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pcu *PaymentCustomerUpdate) Save(ctx context.Context) (int, error) {
 	pcu.defaults()
@@ -501,6 +538,51 @@ func (pcu *PaymentCustomerUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pcu.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentcustomer.InvoicesTable,
+			Columns: []string{paymentcustomer.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !pcu.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentcustomer.InvoicesTable,
+			Columns: []string{paymentcustomer.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentcustomer.InvoicesTable,
+			Columns: []string{paymentcustomer.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{paymentcustomer.Label}
@@ -657,6 +739,21 @@ func (pcuo *PaymentCustomerUpdateOne) AddPaymentMethods(p ...*PaymentMethod) *Pa
 	return pcuo.AddPaymentMethodIDs(ids...)
 }
 
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (pcuo *PaymentCustomerUpdateOne) AddInvoiceIDs(ids ...int) *PaymentCustomerUpdateOne {
+	pcuo.mutation.AddInvoiceIDs(ids...)
+	return pcuo
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (pcuo *PaymentCustomerUpdateOne) AddInvoices(i ...*Invoice) *PaymentCustomerUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pcuo.AddInvoiceIDs(ids...)
+}
+
 // Mutation returns the PaymentCustomerMutation object of the builder.
 func (pcuo *PaymentCustomerUpdateOne) Mutation() *PaymentCustomerMutation {
 	return pcuo.mutation
@@ -731,6 +828,27 @@ func (pcuo *PaymentCustomerUpdateOne) RemovePaymentMethods(p ...*PaymentMethod)
 	return pcuo.RemovePaymentMethodIDs(ids...)
 }
 
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (pcuo *PaymentCustomerUpdateOne) ClearInvoices() *PaymentCustomerUpdateOne {
+	pcuo.mutation.ClearInvoices()
+	return pcuo
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (pcuo *PaymentCustomerUpdateOne) RemoveInvoiceIDs(ids ...int) *PaymentCustomerUpdateOne {
+	pcuo.mutation.RemoveInvoiceIDs(ids...)
+	return pcuo
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (pcuo *PaymentCustomerUpdateOne) RemoveInvoices(i ...*Invoice) *PaymentCustomerUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pcuo.RemoveInvoiceIDs(ids...)
+}
+
 // Where appends a list predicates to the PaymentCustomerUpdate builder.
 func (pcuo *PaymentCustomerUpdateOne) Where(ps ...predicate.PaymentCustomer) *PaymentCustomerUpdateOne {
 	pcuo.mutation.Where(ps...)
@@ -1020,6 +1138,51 @@ func (pcuo *PaymentCustomerUpdateOne) sqlSave(ctx context.Context) (_node *Payme
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pcuo.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentcustomer.InvoicesTable,
+			Columns: []string{paymentcustomer.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !pcuo.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentcustomer.InvoicesTable,
+			Columns: []string{paymentcustomer.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentcustomer.InvoicesTable,
+			Columns: []string{paymentcustomer.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PaymentCustomer{config: pcuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/response"
 )
 
 // PaymentIntent is the model entity for the PaymentIntent schema.
@@ -27,12 +28,16 @@ type PaymentIntent struct {
 	Status paymentintent.Status `json:"status,omitempty"`
 	// Amount in smallest currency unit (e.g., cents)
 	Amount int64 `json:"amount,omitempty"`
+	// Amount refunded in smallest currency unit
+	AmountRefunded int64 `json:"amount_refunded,omitempty"`
 	// Three-letter ISO currency code
 	Currency string `json:"currency,omitempty"`
 	// Description of the payment
 	Description string `json:"description,omitempty"`
 	// Client secret for frontend payment processing
 	ClientSecret string `json:"-"`
+	// Connected account receiving the payment, for payments made to forms
+	ProviderAccountID string `json:"provider_account_id,omitempty"`
 	// Additional payment data
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -43,16 +48,19 @@ type PaymentIntent struct {
 	// The values are being populated by the PaymentIntentQuery when eager-loading is set.
 	Edges                            PaymentIntentEdges `json:"edges"`
 	payment_customer_payment_intents *int
+	response_payment                 *int
 	selectValues                     sql.SelectValues
 }
 
 // PaymentIntentEdges holds the relations/edges for other nodes in the graph.
 type PaymentIntentEdges struct {
-	// Payment customer who owns this payment intent
+	// Payment customer who owns this payment intent, unless it was made by a form respondent
 	Customer *PaymentCustomer `json:"customer,omitempty"`
+	// Form response the payment is for
+	Response *Response `json:"response,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // CustomerOrErr returns the Customer value or an error if the edge
@@ -66,6 +74,17 @@ func (e PaymentIntentEdges) CustomerOrErr() (*PaymentCustomer, error) {
 	return nil, &NotLoadedError{edge: "customer"}
 }
 
+// ResponseOrErr returns the Response value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentIntentEdges) ResponseOrErr() (*Response, error) {
+	if e.Response != nil {
+		return e.Response, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: response.Label}
+	}
+	return nil, &NotLoadedError{edge: "response"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PaymentIntent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -73,14 +92,16 @@ func (*PaymentIntent) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case paymentintent.FieldMetadata:
 			values[i] = new([]byte)
-		case paymentintent.FieldID, paymentintent.FieldAmount:
+		case paymentintent.FieldID, paymentintent.FieldAmount, paymentintent.FieldAmountRefunded:
 			values[i] = new(sql.NullInt64)
-		case paymentintent.FieldProviderPaymentIntentID, paymentintent.FieldProvider, paymentintent.FieldStatus, paymentintent.FieldCurrency, paymentintent.FieldDescription, paymentintent.FieldClientSecret:
+		case paymentintent.FieldProviderPaymentIntentID, paymentintent.FieldProvider, paymentintent.FieldStatus, paymentintent.FieldCurrency, paymentintent.FieldDescription, paymentintent.FieldClientSecret, paymentintent.FieldProviderAccountID:
 			values[i] = new(sql.NullString)
 		case paymentintent.FieldCreatedAt, paymentintent.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case paymentintent.ForeignKeys[0]: // payment_customer_payment_intents
 			values[i] = new(sql.NullInt64)
+		case paymentintent.ForeignKeys[1]: // response_payment
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -126,6 +147,12 @@ func (pi *PaymentIntent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pi.Amount = value.Int64
 			}
+		case paymentintent.FieldAmountRefunded:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_refunded", values[i])
+			} else if value.Valid {
+				pi.AmountRefunded = value.Int64
+			}
 		case paymentintent.FieldCurrency:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field currency", values[i])
@@ -144,6 +171,12 @@ func (pi *PaymentIntent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pi.ClientSecret = value.String
 			}
+		case paymentintent.FieldProviderAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_account_id", values[i])
+			} else if value.Valid {
+				pi.ProviderAccountID = value.String
+			}
 		case paymentintent.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -171,6 +204,13 @@ func (pi *PaymentIntent) assignValues(columns []string, values []any) error {
 				pi.payment_customer_payment_intents = new(int)
 				*pi.payment_customer_payment_intents = int(value.Int64)
 			}
+		case paymentintent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field response_payment", value)
+			} else if value.Valid {
+				pi.response_payment = new(int)
+				*pi.response_payment = int(value.Int64)
+			}
 		default:
 			pi.selectValues.Set(columns[i], values[i])
 		}
@@ -189,6 +229,11 @@ func (pi *PaymentIntent) QueryCustomer() *PaymentCustomerQuery {
 	return NewPaymentIntentClient(pi.config).QueryCustomer(pi)
 }
 
+// QueryResponse queries the "response" edge of the PaymentIntent entity.
+func (pi *PaymentIntent) QueryResponse() *ResponseQuery {
+	return NewPaymentIntentClient(pi.config).QueryResponse(pi)
+}
+
 // Update returns a builder for updating this PaymentIntent.
 // Note that you need to call PaymentIntent.Unwrap() before calling this method if this PaymentIntent
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -224,6 +269,9 @@ func (pi *PaymentIntent) String() string {
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", pi.Amount))
 	builder.WriteString(", ")
+	builder.WriteString("amount_refunded=")
+	builder.WriteString(fmt.Sprintf("%v", pi.AmountRefunded))
+	builder.WriteString(", ")
 	builder.WriteString("currency=")
 	builder.WriteString(pi.Currency)
 	builder.WriteString(", ")
@@ -232,6 +280,9 @@ func (pi *PaymentIntent) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("client_secret=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("provider_account_id=")
+	builder.WriteString(pi.ProviderAccountID)
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", pi.Metadata))
 	builder.WriteString(", ")
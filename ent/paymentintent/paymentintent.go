@@ -23,12 +23,16 @@ const (
 	FieldStatus = "status"
 	// FieldAmount holds the string denoting the amount field in the database.
 	FieldAmount = "amount"
+	// FieldAmountRefunded holds the string denoting the amount_refunded field in the database.
+	FieldAmountRefunded = "amount_refunded"
 	// FieldCurrency holds the string denoting the currency field in the database.
 	FieldCurrency = "currency"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
 	// FieldClientSecret holds the string denoting the client_secret field in the database.
 	FieldClientSecret = "client_secret"
+	// FieldProviderAccountID holds the string denoting the provider_account_id field in the database.
+	FieldProviderAccountID = "provider_account_id"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -37,6 +41,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeCustomer holds the string denoting the customer edge name in mutations.
 	EdgeCustomer = "customer"
+	// EdgeResponse holds the string denoting the response edge name in mutations.
+	EdgeResponse = "response"
 	// Table holds the table name of the paymentintent in the database.
 	Table = "payment_intents"
 	// CustomerTable is the table that holds the customer relation/edge.
@@ -46,6 +52,13 @@ const (
 	CustomerInverseTable = "payment_customers"
 	// CustomerColumn is the table column denoting the customer relation/edge.
 	CustomerColumn = "payment_customer_payment_intents"
+	// ResponseTable is the table that holds the response relation/edge.
+	ResponseTable = "payment_intents"
+	// ResponseInverseTable is the table name for the Response entity.
+	// It exists in this package in order to avoid circular dependency with the "response" package.
+	ResponseInverseTable = "responses"
+	// ResponseColumn is the table column denoting the response relation/edge.
+	ResponseColumn = "response_payment"
 )
 
 // Columns holds all SQL columns for paymentintent fields.
@@ -55,9 +68,11 @@ var Columns = []string{
 	FieldProvider,
 	FieldStatus,
 	FieldAmount,
+	FieldAmountRefunded,
 	FieldCurrency,
 	FieldDescription,
 	FieldClientSecret,
+	FieldProviderAccountID,
 	FieldMetadata,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -67,6 +82,7 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"payment_customer_payment_intents",
+	"response_payment",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -93,6 +109,10 @@ var (
 	ProviderValidator func(string) error
 	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
 	AmountValidator func(int64) error
+	// DefaultAmountRefunded holds the default value on creation for the "amount_refunded" field.
+	DefaultAmountRefunded int64
+	// AmountRefundedValidator is a validator for the "amount_refunded" field. It is called by the builders before save.
+	AmountRefundedValidator func(int64) error
 	// DefaultCurrency holds the default value on creation for the "currency" field.
 	DefaultCurrency string
 	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
@@ -164,6 +184,11 @@ func ByAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmount, opts...).ToFunc()
 }
 
+// ByAmountRefunded orders the results by the amount_refunded field.
+func ByAmountRefunded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountRefunded, opts...).ToFunc()
+}
+
 // ByCurrency orders the results by the currency field.
 func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
@@ -179,6 +204,11 @@ func ByClientSecret(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClientSecret, opts...).ToFunc()
 }
 
+// ByProviderAccountID orders the results by the provider_account_id field.
+func ByProviderAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderAccountID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -195,6 +225,13 @@ func ByCustomerField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCustomerStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByResponseField orders the results by response field.
+func ByResponseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResponseStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newCustomerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -202,3 +239,10 @@ func newCustomerStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
 	)
 }
+func newResponseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResponseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, ResponseTable, ResponseColumn),
+	)
+}
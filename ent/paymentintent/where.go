@@ -70,6 +70,11 @@ func Amount(v int64) predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.FieldEQ(FieldAmount, v))
 }
 
+// AmountRefunded applies equality check predicate on the "amount_refunded" field. It's identical to AmountRefundedEQ.
+func AmountRefunded(v int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldEQ(FieldAmountRefunded, v))
+}
+
 // Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
 func Currency(v string) predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.FieldEQ(FieldCurrency, v))
@@ -85,6 +90,11 @@ func ClientSecret(v string) predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.FieldEQ(FieldClientSecret, v))
 }
 
+// ProviderAccountID applies equality check predicate on the "provider_account_id" field. It's identical to ProviderAccountIDEQ.
+func ProviderAccountID(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldEQ(FieldProviderAccountID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.FieldEQ(FieldCreatedAt, v))
@@ -285,6 +295,46 @@ func AmountLTE(v int64) predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.FieldLTE(FieldAmount, v))
 }
 
+// AmountRefundedEQ applies the EQ predicate on the "amount_refunded" field.
+func AmountRefundedEQ(v int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldEQ(FieldAmountRefunded, v))
+}
+
+// AmountRefundedNEQ applies the NEQ predicate on the "amount_refunded" field.
+func AmountRefundedNEQ(v int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldNEQ(FieldAmountRefunded, v))
+}
+
+// AmountRefundedIn applies the In predicate on the "amount_refunded" field.
+func AmountRefundedIn(vs ...int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldIn(FieldAmountRefunded, vs...))
+}
+
+// AmountRefundedNotIn applies the NotIn predicate on the "amount_refunded" field.
+func AmountRefundedNotIn(vs ...int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldNotIn(FieldAmountRefunded, vs...))
+}
+
+// AmountRefundedGT applies the GT predicate on the "amount_refunded" field.
+func AmountRefundedGT(v int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldGT(FieldAmountRefunded, v))
+}
+
+// AmountRefundedGTE applies the GTE predicate on the "amount_refunded" field.
+func AmountRefundedGTE(v int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldGTE(FieldAmountRefunded, v))
+}
+
+// AmountRefundedLT applies the LT predicate on the "amount_refunded" field.
+func AmountRefundedLT(v int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldLT(FieldAmountRefunded, v))
+}
+
+// AmountRefundedLTE applies the LTE predicate on the "amount_refunded" field.
+func AmountRefundedLTE(v int64) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldLTE(FieldAmountRefunded, v))
+}
+
 // CurrencyEQ applies the EQ predicate on the "currency" field.
 func CurrencyEQ(v string) predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.FieldEQ(FieldCurrency, v))
@@ -500,6 +550,81 @@ func ClientSecretContainsFold(v string) predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.FieldContainsFold(FieldClientSecret, v))
 }
 
+// ProviderAccountIDEQ applies the EQ predicate on the "provider_account_id" field.
+func ProviderAccountIDEQ(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldEQ(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDNEQ applies the NEQ predicate on the "provider_account_id" field.
+func ProviderAccountIDNEQ(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldNEQ(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDIn applies the In predicate on the "provider_account_id" field.
+func ProviderAccountIDIn(vs ...string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldIn(FieldProviderAccountID, vs...))
+}
+
+// ProviderAccountIDNotIn applies the NotIn predicate on the "provider_account_id" field.
+func ProviderAccountIDNotIn(vs ...string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldNotIn(FieldProviderAccountID, vs...))
+}
+
+// ProviderAccountIDGT applies the GT predicate on the "provider_account_id" field.
+func ProviderAccountIDGT(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldGT(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDGTE applies the GTE predicate on the "provider_account_id" field.
+func ProviderAccountIDGTE(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldGTE(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDLT applies the LT predicate on the "provider_account_id" field.
+func ProviderAccountIDLT(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldLT(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDLTE applies the LTE predicate on the "provider_account_id" field.
+func ProviderAccountIDLTE(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldLTE(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDContains applies the Contains predicate on the "provider_account_id" field.
+func ProviderAccountIDContains(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldContains(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDHasPrefix applies the HasPrefix predicate on the "provider_account_id" field.
+func ProviderAccountIDHasPrefix(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldHasPrefix(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDHasSuffix applies the HasSuffix predicate on the "provider_account_id" field.
+func ProviderAccountIDHasSuffix(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldHasSuffix(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDIsNil applies the IsNil predicate on the "provider_account_id" field.
+func ProviderAccountIDIsNil() predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldIsNull(FieldProviderAccountID))
+}
+
+// ProviderAccountIDNotNil applies the NotNil predicate on the "provider_account_id" field.
+func ProviderAccountIDNotNil() predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldNotNull(FieldProviderAccountID))
+}
+
+// ProviderAccountIDEqualFold applies the EqualFold predicate on the "provider_account_id" field.
+func ProviderAccountIDEqualFold(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldEqualFold(FieldProviderAccountID, v))
+}
+
+// ProviderAccountIDContainsFold applies the ContainsFold predicate on the "provider_account_id" field.
+func ProviderAccountIDContainsFold(v string) predicate.PaymentIntent {
+	return predicate.PaymentIntent(sql.FieldContainsFold(FieldProviderAccountID, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.FieldIsNull(FieldMetadata))
@@ -613,6 +738,29 @@ func HasCustomerWith(preds ...predicate.PaymentCustomer) predicate.PaymentIntent
 	})
 }
 
+// HasResponse applies the HasEdge predicate on the "response" edge.
+func HasResponse() predicate.PaymentIntent {
+	return predicate.PaymentIntent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ResponseTable, ResponseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResponseWith applies the HasEdge predicate on the "response" edge with a given conditions (other predicates).
+func HasResponseWith(preds ...predicate.Response) predicate.PaymentIntent {
+	return predicate.PaymentIntent(func(s *sql.Selector) {
+		step := newResponseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PaymentIntent) predicate.PaymentIntent {
 	return predicate.PaymentIntent(sql.AndPredicates(predicates...))
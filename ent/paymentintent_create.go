@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/response"
 )
 
 // PaymentIntentCreate is the builder for creating a PaymentIntent entity.
@@ -19,6 +21,7 @@ type PaymentIntentCreate struct {
 	config
 	mutation *PaymentIntentMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProviderPaymentIntentID sets the "provider_payment_intent_id" field.
@@ -61,6 +64,20 @@ func (pic *PaymentIntentCreate) SetAmount(i int64) *PaymentIntentCreate {
 	return pic
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (pic *PaymentIntentCreate) SetAmountRefunded(i int64) *PaymentIntentCreate {
+	pic.mutation.SetAmountRefunded(i)
+	return pic
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (pic *PaymentIntentCreate) SetNillableAmountRefunded(i *int64) *PaymentIntentCreate {
+	if i != nil {
+		pic.SetAmountRefunded(*i)
+	}
+	return pic
+}
+
 // SetCurrency sets the "currency" field.
 func (pic *PaymentIntentCreate) SetCurrency(s string) *PaymentIntentCreate {
 	pic.mutation.SetCurrency(s)
@@ -103,6 +120,20 @@ func (pic *PaymentIntentCreate) SetNillableClientSecret(s *string) *PaymentInten
 	return pic
 }
 
+// SetProviderAccountID sets the "provider_account_id" field.
+func (pic *PaymentIntentCreate) SetProviderAccountID(s string) *PaymentIntentCreate {
+	pic.mutation.SetProviderAccountID(s)
+	return pic
+}
+
+// SetNillableProviderAccountID sets the "provider_account_id" field if the given value is not nil.
+func (pic *PaymentIntentCreate) SetNillableProviderAccountID(s *string) *PaymentIntentCreate {
+	if s != nil {
+		pic.SetProviderAccountID(*s)
+	}
+	return pic
+}
+
 // SetMetadata sets the "metadata" field.
 func (pic *PaymentIntentCreate) SetMetadata(m map[string]interface{}) *PaymentIntentCreate {
 	pic.mutation.SetMetadata(m)
@@ -143,11 +174,38 @@ func (pic *PaymentIntentCreate) SetCustomerID(id int) *PaymentIntentCreate {
 	return pic
 }
 
+// SetNillableCustomerID sets the "customer" edge to the PaymentCustomer entity by ID if the given value is not nil.
+func (pic *PaymentIntentCreate) SetNillableCustomerID(id *int) *PaymentIntentCreate {
+	if id != nil {
+		pic = pic.SetCustomerID(*id)
+	}
+	return pic
+}
+
 // SetCustomer sets the "customer" edge to the PaymentCustomer entity.
 func (pic *PaymentIntentCreate) SetCustomer(p *PaymentCustomer) *PaymentIntentCreate {
 	return pic.SetCustomerID(p.ID)
 }
 
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (pic *PaymentIntentCreate) SetResponseID(id int) *PaymentIntentCreate {
+	pic.mutation.SetResponseID(id)
+	return pic
+}
+
+// SetNillableResponseID sets the "response" edge to the Response entity by ID if the given value is not nil.
+func (pic *PaymentIntentCreate) SetNillableResponseID(id *int) *PaymentIntentCreate {
+	if id != nil {
+		pic = pic.SetResponseID(*id)
+	}
+	return pic
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (pic *PaymentIntentCreate) SetResponse(r *Response) *PaymentIntentCreate {
+	return pic.SetResponseID(r.ID)
+}
+
 // Mutation returns the PaymentIntentMutation object of the builder.
 func (pic *PaymentIntentCreate) Mutation() *PaymentIntentMutation {
 	return pic.mutation
@@ -191,6 +249,10 @@ func (pic *PaymentIntentCreate) defaults() {
 		v := paymentintent.DefaultStatus
 		pic.mutation.SetStatus(v)
 	}
+	if _, ok := pic.mutation.AmountRefunded(); !ok {
+		v := paymentintent.DefaultAmountRefunded
+		pic.mutation.SetAmountRefunded(v)
+	}
 	if _, ok := pic.mutation.Currency(); !ok {
 		v := paymentintent.DefaultCurrency
 		pic.mutation.SetCurrency(v)
@@ -239,6 +301,14 @@ func (pic *PaymentIntentCreate) check() error {
 			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "PaymentIntent.amount": %w`, err)}
 		}
 	}
+	if _, ok := pic.mutation.AmountRefunded(); !ok {
+		return &ValidationError{Name: "amount_refunded", err: errors.New(`ent: missing required field "PaymentIntent.amount_refunded"`)}
+	}
+	if v, ok := pic.mutation.AmountRefunded(); ok {
+		if err := paymentintent.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`ent: validator failed for field "PaymentIntent.amount_refunded": %w`, err)}
+		}
+	}
 	if _, ok := pic.mutation.Currency(); !ok {
 		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PaymentIntent.currency"`)}
 	}
@@ -253,9 +323,6 @@ func (pic *PaymentIntentCreate) check() error {
 	if _, ok := pic.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentIntent.updated_at"`)}
 	}
-	if len(pic.mutation.CustomerIDs()) == 0 {
-		return &ValidationError{Name: "customer", err: errors.New(`ent: missing required edge "PaymentIntent.customer"`)}
-	}
 	return nil
 }
 
@@ -282,6 +349,7 @@ func (pic *PaymentIntentCreate) createSpec() (*PaymentIntent, *sqlgraph.CreateSp
 		_node = &PaymentIntent{config: pic.config}
 		_spec = sqlgraph.NewCreateSpec(paymentintent.Table, sqlgraph.NewFieldSpec(paymentintent.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = pic.conflict
 	if value, ok := pic.mutation.ProviderPaymentIntentID(); ok {
 		_spec.SetField(paymentintent.FieldProviderPaymentIntentID, field.TypeString, value)
 		_node.ProviderPaymentIntentID = value
@@ -298,6 +366,10 @@ func (pic *PaymentIntentCreate) createSpec() (*PaymentIntent, *sqlgraph.CreateSp
 		_spec.SetField(paymentintent.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
+	if value, ok := pic.mutation.AmountRefunded(); ok {
+		_spec.SetField(paymentintent.FieldAmountRefunded, field.TypeInt64, value)
+		_node.AmountRefunded = value
+	}
 	if value, ok := pic.mutation.Currency(); ok {
 		_spec.SetField(paymentintent.FieldCurrency, field.TypeString, value)
 		_node.Currency = value
@@ -310,6 +382,10 @@ func (pic *PaymentIntentCreate) createSpec() (*PaymentIntent, *sqlgraph.CreateSp
 		_spec.SetField(paymentintent.FieldClientSecret, field.TypeString, value)
 		_node.ClientSecret = value
 	}
+	if value, ok := pic.mutation.ProviderAccountID(); ok {
+		_spec.SetField(paymentintent.FieldProviderAccountID, field.TypeString, value)
+		_node.ProviderAccountID = value
+	}
 	if value, ok := pic.mutation.Metadata(); ok {
 		_spec.SetField(paymentintent.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -339,14 +415,523 @@ func (pic *PaymentIntentCreate) createSpec() (*PaymentIntent, *sqlgraph.CreateSp
 		_node.payment_customer_payment_intents = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pic.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   paymentintent.ResponseTable,
+			Columns: []string{paymentintent.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.response_payment = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentIntent.Create().
+//		SetProviderPaymentIntentID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentIntentUpsert) {
+//			SetProviderPaymentIntentID(v+v).
+//		}).
+//		Exec(ctx)
+func (pic *PaymentIntentCreate) OnConflict(opts ...sql.ConflictOption) *PaymentIntentUpsertOne {
+	pic.conflict = opts
+	return &PaymentIntentUpsertOne{
+		create: pic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentIntent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pic *PaymentIntentCreate) OnConflictColumns(columns ...string) *PaymentIntentUpsertOne {
+	pic.conflict = append(pic.conflict, sql.ConflictColumns(columns...))
+	return &PaymentIntentUpsertOne{
+		create: pic,
+	}
+}
+
+type (
+	// PaymentIntentUpsertOne is the builder for "upsert"-ing
+	//  one PaymentIntent node.
+	PaymentIntentUpsertOne struct {
+		create *PaymentIntentCreate
+	}
+
+	// PaymentIntentUpsert is the "OnConflict" setter.
+	PaymentIntentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProviderPaymentIntentID sets the "provider_payment_intent_id" field.
+func (u *PaymentIntentUpsert) SetProviderPaymentIntentID(v string) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldProviderPaymentIntentID, v)
+	return u
+}
+
+// UpdateProviderPaymentIntentID sets the "provider_payment_intent_id" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateProviderPaymentIntentID() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldProviderPaymentIntentID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentIntentUpsert) SetProvider(v string) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateProvider() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldProvider)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentIntentUpsert) SetStatus(v paymentintent.Status) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateStatus() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldStatus)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentIntentUpsert) SetAmount(v int64) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateAmount() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentIntentUpsert) AddAmount(v int64) *PaymentIntentUpsert {
+	u.Add(paymentintent.FieldAmount, v)
+	return u
+}
+
+// SetAmountRefunded sets the "amount_refunded" field.
+func (u *PaymentIntentUpsert) SetAmountRefunded(v int64) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldAmountRefunded, v)
+	return u
+}
+
+// UpdateAmountRefunded sets the "amount_refunded" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateAmountRefunded() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldAmountRefunded)
+	return u
+}
+
+// AddAmountRefunded adds v to the "amount_refunded" field.
+func (u *PaymentIntentUpsert) AddAmountRefunded(v int64) *PaymentIntentUpsert {
+	u.Add(paymentintent.FieldAmountRefunded, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentIntentUpsert) SetCurrency(v string) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateCurrency() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldCurrency)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PaymentIntentUpsert) SetDescription(v string) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateDescription() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PaymentIntentUpsert) ClearDescription() *PaymentIntentUpsert {
+	u.SetNull(paymentintent.FieldDescription)
+	return u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (u *PaymentIntentUpsert) SetClientSecret(v string) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldClientSecret, v)
+	return u
+}
+
+// UpdateClientSecret sets the "client_secret" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateClientSecret() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldClientSecret)
+	return u
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (u *PaymentIntentUpsert) ClearClientSecret() *PaymentIntentUpsert {
+	u.SetNull(paymentintent.FieldClientSecret)
+	return u
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *PaymentIntentUpsert) SetProviderAccountID(v string) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldProviderAccountID, v)
+	return u
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateProviderAccountID() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldProviderAccountID)
+	return u
+}
+
+// ClearProviderAccountID clears the value of the "provider_account_id" field.
+func (u *PaymentIntentUpsert) ClearProviderAccountID() *PaymentIntentUpsert {
+	u.SetNull(paymentintent.FieldProviderAccountID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentIntentUpsert) SetMetadata(v map[string]interface{}) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateMetadata() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentIntentUpsert) ClearMetadata() *PaymentIntentUpsert {
+	u.SetNull(paymentintent.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentIntentUpsert) SetUpdatedAt(v time.Time) *PaymentIntentUpsert {
+	u.Set(paymentintent.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentIntentUpsert) UpdateUpdatedAt() *PaymentIntentUpsert {
+	u.SetExcluded(paymentintent.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PaymentIntent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentIntentUpsertOne) UpdateNewValues() *PaymentIntentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentintent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentIntent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentIntentUpsertOne) Ignore() *PaymentIntentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentIntentUpsertOne) DoNothing() *PaymentIntentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentIntentCreate.OnConflict
+// documentation for more info.
+func (u *PaymentIntentUpsertOne) Update(set func(*PaymentIntentUpsert)) *PaymentIntentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentIntentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderPaymentIntentID sets the "provider_payment_intent_id" field.
+func (u *PaymentIntentUpsertOne) SetProviderPaymentIntentID(v string) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetProviderPaymentIntentID(v)
+	})
+}
+
+// UpdateProviderPaymentIntentID sets the "provider_payment_intent_id" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateProviderPaymentIntentID() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateProviderPaymentIntentID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentIntentUpsertOne) SetProvider(v string) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateProvider() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentIntentUpsertOne) SetStatus(v paymentintent.Status) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateStatus() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentIntentUpsertOne) SetAmount(v int64) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentIntentUpsertOne) AddAmount(v int64) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateAmount() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetAmountRefunded sets the "amount_refunded" field.
+func (u *PaymentIntentUpsertOne) SetAmountRefunded(v int64) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetAmountRefunded(v)
+	})
+}
+
+// AddAmountRefunded adds v to the "amount_refunded" field.
+func (u *PaymentIntentUpsertOne) AddAmountRefunded(v int64) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.AddAmountRefunded(v)
+	})
+}
+
+// UpdateAmountRefunded sets the "amount_refunded" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateAmountRefunded() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateAmountRefunded()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentIntentUpsertOne) SetCurrency(v string) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateCurrency() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PaymentIntentUpsertOne) SetDescription(v string) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateDescription() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PaymentIntentUpsertOne) ClearDescription() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (u *PaymentIntentUpsertOne) SetClientSecret(v string) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetClientSecret(v)
+	})
+}
+
+// UpdateClientSecret sets the "client_secret" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateClientSecret() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateClientSecret()
+	})
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (u *PaymentIntentUpsertOne) ClearClientSecret() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.ClearClientSecret()
+	})
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *PaymentIntentUpsertOne) SetProviderAccountID(v string) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetProviderAccountID(v)
+	})
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateProviderAccountID() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateProviderAccountID()
+	})
+}
+
+// ClearProviderAccountID clears the value of the "provider_account_id" field.
+func (u *PaymentIntentUpsertOne) ClearProviderAccountID() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.ClearProviderAccountID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentIntentUpsertOne) SetMetadata(v map[string]interface{}) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateMetadata() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentIntentUpsertOne) ClearMetadata() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentIntentUpsertOne) SetUpdatedAt(v time.Time) *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentIntentUpsertOne) UpdateUpdatedAt() *PaymentIntentUpsertOne {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentIntentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentIntentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentIntentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentIntentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentIntentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PaymentIntentCreateBulk is the builder for creating many PaymentIntent entities in bulk.
 type PaymentIntentCreateBulk struct {
 	config
 	err      error
 	builders []*PaymentIntentCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PaymentIntent entities in the database.
@@ -376,6 +961,7 @@ func (picb *PaymentIntentCreateBulk) Save(ctx context.Context) ([]*PaymentIntent
 					_, err = mutators[i+1].Mutate(root, picb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = picb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, picb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -429,3 +1015,310 @@ func (picb *PaymentIntentCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentIntent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentIntentUpsert) {
+//			SetProviderPaymentIntentID(v+v).
+//		}).
+//		Exec(ctx)
+func (picb *PaymentIntentCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentIntentUpsertBulk {
+	picb.conflict = opts
+	return &PaymentIntentUpsertBulk{
+		create: picb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentIntent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (picb *PaymentIntentCreateBulk) OnConflictColumns(columns ...string) *PaymentIntentUpsertBulk {
+	picb.conflict = append(picb.conflict, sql.ConflictColumns(columns...))
+	return &PaymentIntentUpsertBulk{
+		create: picb,
+	}
+}
+
+// PaymentIntentUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentIntent nodes.
+type PaymentIntentUpsertBulk struct {
+	create *PaymentIntentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentIntent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentIntentUpsertBulk) UpdateNewValues() *PaymentIntentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentintent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentIntent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentIntentUpsertBulk) Ignore() *PaymentIntentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentIntentUpsertBulk) DoNothing() *PaymentIntentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentIntentCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentIntentUpsertBulk) Update(set func(*PaymentIntentUpsert)) *PaymentIntentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentIntentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderPaymentIntentID sets the "provider_payment_intent_id" field.
+func (u *PaymentIntentUpsertBulk) SetProviderPaymentIntentID(v string) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetProviderPaymentIntentID(v)
+	})
+}
+
+// UpdateProviderPaymentIntentID sets the "provider_payment_intent_id" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateProviderPaymentIntentID() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateProviderPaymentIntentID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentIntentUpsertBulk) SetProvider(v string) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateProvider() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentIntentUpsertBulk) SetStatus(v paymentintent.Status) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateStatus() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentIntentUpsertBulk) SetAmount(v int64) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentIntentUpsertBulk) AddAmount(v int64) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateAmount() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetAmountRefunded sets the "amount_refunded" field.
+func (u *PaymentIntentUpsertBulk) SetAmountRefunded(v int64) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetAmountRefunded(v)
+	})
+}
+
+// AddAmountRefunded adds v to the "amount_refunded" field.
+func (u *PaymentIntentUpsertBulk) AddAmountRefunded(v int64) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.AddAmountRefunded(v)
+	})
+}
+
+// UpdateAmountRefunded sets the "amount_refunded" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateAmountRefunded() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateAmountRefunded()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentIntentUpsertBulk) SetCurrency(v string) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateCurrency() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PaymentIntentUpsertBulk) SetDescription(v string) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateDescription() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PaymentIntentUpsertBulk) ClearDescription() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (u *PaymentIntentUpsertBulk) SetClientSecret(v string) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetClientSecret(v)
+	})
+}
+
+// UpdateClientSecret sets the "client_secret" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateClientSecret() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateClientSecret()
+	})
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (u *PaymentIntentUpsertBulk) ClearClientSecret() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.ClearClientSecret()
+	})
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *PaymentIntentUpsertBulk) SetProviderAccountID(v string) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetProviderAccountID(v)
+	})
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateProviderAccountID() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateProviderAccountID()
+	})
+}
+
+// ClearProviderAccountID clears the value of the "provider_account_id" field.
+func (u *PaymentIntentUpsertBulk) ClearProviderAccountID() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.ClearProviderAccountID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentIntentUpsertBulk) SetMetadata(v map[string]interface{}) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateMetadata() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentIntentUpsertBulk) ClearMetadata() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentIntentUpsertBulk) SetUpdatedAt(v time.Time) *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentIntentUpsertBulk) UpdateUpdatedAt() *PaymentIntentUpsertBulk {
+	return u.Update(func(s *PaymentIntentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentIntentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentIntentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentIntentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentIntentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -8,12 +8,14 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/response"
 )
 
 // PaymentIntentQuery is the builder for querying PaymentIntent entities.
@@ -24,7 +26,9 @@ type PaymentIntentQuery struct {
 	inters       []Interceptor
 	predicates   []predicate.PaymentIntent
 	withCustomer *PaymentCustomerQuery
+	withResponse *ResponseQuery
 	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +87,28 @@ func (piq *PaymentIntentQuery) QueryCustomer() *PaymentCustomerQuery {
 	return query
 }
 
+// QueryResponse chains the current query on the "response" edge.
+func (piq *PaymentIntentQuery) QueryResponse() *ResponseQuery {
+	query := (&ResponseClient{config: piq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := piq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := piq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentintent.Table, paymentintent.FieldID, selector),
+			sqlgraph.To(response.Table, response.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, paymentintent.ResponseTable, paymentintent.ResponseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(piq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PaymentIntent entity from the query.
 // Returns a *NotFoundError when no PaymentIntent was found.
 func (piq *PaymentIntentQuery) First(ctx context.Context) (*PaymentIntent, error) {
@@ -276,6 +302,7 @@ func (piq *PaymentIntentQuery) Clone() *PaymentIntentQuery {
 		inters:       append([]Interceptor{}, piq.inters...),
 		predicates:   append([]predicate.PaymentIntent{}, piq.predicates...),
 		withCustomer: piq.withCustomer.Clone(),
+		withResponse: piq.withResponse.Clone(),
 		// clone intermediate query.
 		sql:  piq.sql.Clone(),
 		path: piq.path,
@@ -293,6 +320,17 @@ func (piq *PaymentIntentQuery) WithCustomer(opts ...func(*PaymentCustomerQuery))
 	return piq
 }
 
+// WithResponse tells the query-builder to eager-load the nodes that are connected to
+// the "response" edge. The optional arguments are used to configure the query builder of the edge.
+func (piq *PaymentIntentQuery) WithResponse(opts ...func(*ResponseQuery)) *PaymentIntentQuery {
+	query := (&ResponseClient{config: piq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	piq.withResponse = query
+	return piq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,11 +410,12 @@ func (piq *PaymentIntentQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		nodes       = []*PaymentIntent{}
 		withFKs     = piq.withFKs
 		_spec       = piq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			piq.withCustomer != nil,
+			piq.withResponse != nil,
 		}
 	)
-	if piq.withCustomer != nil {
+	if piq.withCustomer != nil || piq.withResponse != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -391,6 +430,9 @@ func (piq *PaymentIntentQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(piq.modifiers) > 0 {
+		_spec.Modifiers = piq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -406,6 +448,12 @@ func (piq *PaymentIntentQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := piq.withResponse; query != nil {
+		if err := piq.loadResponse(ctx, query, nodes, nil,
+			func(n *PaymentIntent, e *Response) { n.Edges.Response = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -441,9 +489,44 @@ func (piq *PaymentIntentQuery) loadCustomer(ctx context.Context, query *PaymentC
 	}
 	return nil
 }
+func (piq *PaymentIntentQuery) loadResponse(ctx context.Context, query *ResponseQuery, nodes []*PaymentIntent, init func(*PaymentIntent), assign func(*PaymentIntent, *Response)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PaymentIntent)
+	for i := range nodes {
+		if nodes[i].response_payment == nil {
+			continue
+		}
+		fk := *nodes[i].response_payment
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(response.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "response_payment" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (piq *PaymentIntentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := piq.querySpec()
+	if len(piq.modifiers) > 0 {
+		_spec.Modifiers = piq.modifiers
+	}
 	_spec.Node.Columns = piq.ctx.Fields
 	if len(piq.ctx.Fields) > 0 {
 		_spec.Unique = piq.ctx.Unique != nil && *piq.ctx.Unique
@@ -506,6 +589,9 @@ func (piq *PaymentIntentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if piq.ctx.Unique != nil && *piq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range piq.modifiers {
+		m(selector)
+	}
 	for _, p := range piq.predicates {
 		p(selector)
 	}
@@ -523,6 +609,32 @@ func (piq *PaymentIntentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (piq *PaymentIntentQuery) ForUpdate(opts ...sql.LockOption) *PaymentIntentQuery {
+	if piq.driver.Dialect() == dialect.Postgres {
+		piq.Unique(false)
+	}
+	piq.modifiers = append(piq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return piq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (piq *PaymentIntentQuery) ForShare(opts ...sql.LockOption) *PaymentIntentQuery {
+	if piq.driver.Dialect() == dialect.Postgres {
+		piq.Unique(false)
+	}
+	piq.modifiers = append(piq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return piq
+}
+
 // PaymentIntentGroupBy is the group-by builder for PaymentIntent entities.
 type PaymentIntentGroupBy struct {
 	selector
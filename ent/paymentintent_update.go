@@ -14,6 +14,7 @@ import (
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/response"
 )
 
 // PaymentIntentUpdate is the builder for updating PaymentIntent entities.
@@ -92,6 +93,27 @@ func (piu *PaymentIntentUpdate) AddAmount(i int64) *PaymentIntentUpdate {
 	return piu
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (piu *PaymentIntentUpdate) SetAmountRefunded(i int64) *PaymentIntentUpdate {
+	piu.mutation.ResetAmountRefunded()
+	piu.mutation.SetAmountRefunded(i)
+	return piu
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (piu *PaymentIntentUpdate) SetNillableAmountRefunded(i *int64) *PaymentIntentUpdate {
+	if i != nil {
+		piu.SetAmountRefunded(*i)
+	}
+	return piu
+}
+
+// AddAmountRefunded adds i to the "amount_refunded" field.
+func (piu *PaymentIntentUpdate) AddAmountRefunded(i int64) *PaymentIntentUpdate {
+	piu.mutation.AddAmountRefunded(i)
+	return piu
+}
+
 // SetCurrency sets the "currency" field.
 func (piu *PaymentIntentUpdate) SetCurrency(s string) *PaymentIntentUpdate {
 	piu.mutation.SetCurrency(s)
@@ -146,6 +168,26 @@ func (piu *PaymentIntentUpdate) ClearClientSecret() *PaymentIntentUpdate {
 	return piu
 }
 
+// SetProviderAccountID sets the "provider_account_id" field.
+func (piu *PaymentIntentUpdate) SetProviderAccountID(s string) *PaymentIntentUpdate {
+	piu.mutation.SetProviderAccountID(s)
+	return piu
+}
+
+// SetNillableProviderAccountID sets the "provider_account_id" field if the given value is not nil.
+func (piu *PaymentIntentUpdate) SetNillableProviderAccountID(s *string) *PaymentIntentUpdate {
+	if s != nil {
+		piu.SetProviderAccountID(*s)
+	}
+	return piu
+}
+
+// ClearProviderAccountID clears the value of the "provider_account_id" field.
+func (piu *PaymentIntentUpdate) ClearProviderAccountID() *PaymentIntentUpdate {
+	piu.mutation.ClearProviderAccountID()
+	return piu
+}
+
 // SetMetadata sets the "metadata" field.
 func (piu *PaymentIntentUpdate) SetMetadata(m map[string]interface{}) *PaymentIntentUpdate {
 	piu.mutation.SetMetadata(m)
@@ -170,11 +212,38 @@ func (piu *PaymentIntentUpdate) SetCustomerID(id int) *PaymentIntentUpdate {
 	return piu
 }
 
+// SetNillableCustomerID sets the "customer" edge to the PaymentCustomer entity by ID if the given value is not nil.
+func (piu *PaymentIntentUpdate) SetNillableCustomerID(id *int) *PaymentIntentUpdate {
+	if id != nil {
+		piu = piu.SetCustomerID(*id)
+	}
+	return piu
+}
+
 // SetCustomer sets the "customer" edge to the PaymentCustomer entity.
 func (piu *PaymentIntentUpdate) SetCustomer(p *PaymentCustomer) *PaymentIntentUpdate {
 	return piu.SetCustomerID(p.ID)
 }
 
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (piu *PaymentIntentUpdate) SetResponseID(id int) *PaymentIntentUpdate {
+	piu.mutation.SetResponseID(id)
+	return piu
+}
+
+// SetNillableResponseID sets the "response" edge to the Response entity by ID if the given value is not nil.
+func (piu *PaymentIntentUpdate) SetNillableResponseID(id *int) *PaymentIntentUpdate {
+	if id != nil {
+		piu = piu.SetResponseID(*id)
+	}
+	return piu
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (piu *PaymentIntentUpdate) SetResponse(r *Response) *PaymentIntentUpdate {
+	return piu.SetResponseID(r.ID)
+}
+
 // Mutation returns the PaymentIntentMutation object of the builder.
 func (piu *PaymentIntentUpdate) Mutation() *PaymentIntentMutation {
 	return piu.mutation
@@ -186,6 +255,12 @@ func (piu *PaymentIntentUpdate) ClearCustomer() *PaymentIntentUpdate {
 	return piu
 }
 
+// ClearResponse clears the "response" edge to the Response entity.
+func (piu *PaymentIntentUpdate) ClearResponse() *PaymentIntentUpdate {
+	piu.mutation.ClearResponse()
+	return piu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (piu *PaymentIntentUpdate) Save(ctx context.Context) (int, error) {
 	piu.defaults()
@@ -244,14 +319,16 @@ func (piu *PaymentIntentUpdate) check() error {
 			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "PaymentIntent.amount": %w`, err)}
 		}
 	}
+	if v, ok := piu.mutation.AmountRefunded(); ok {
+		if err := paymentintent.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`ent: validator failed for field "PaymentIntent.amount_refunded": %w`, err)}
+		}
+	}
 	if v, ok := piu.mutation.Currency(); ok {
 		if err := paymentintent.CurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PaymentIntent.currency": %w`, err)}
 		}
 	}
-	if piu.mutation.CustomerCleared() && len(piu.mutation.CustomerIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "PaymentIntent.customer"`)
-	}
 	return nil
 }
 
@@ -282,6 +359,12 @@ func (piu *PaymentIntentUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := piu.mutation.AddedAmount(); ok {
 		_spec.AddField(paymentintent.FieldAmount, field.TypeInt64, value)
 	}
+	if value, ok := piu.mutation.AmountRefunded(); ok {
+		_spec.SetField(paymentintent.FieldAmountRefunded, field.TypeInt64, value)
+	}
+	if value, ok := piu.mutation.AddedAmountRefunded(); ok {
+		_spec.AddField(paymentintent.FieldAmountRefunded, field.TypeInt64, value)
+	}
 	if value, ok := piu.mutation.Currency(); ok {
 		_spec.SetField(paymentintent.FieldCurrency, field.TypeString, value)
 	}
@@ -297,6 +380,12 @@ func (piu *PaymentIntentUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if piu.mutation.ClientSecretCleared() {
 		_spec.ClearField(paymentintent.FieldClientSecret, field.TypeString)
 	}
+	if value, ok := piu.mutation.ProviderAccountID(); ok {
+		_spec.SetField(paymentintent.FieldProviderAccountID, field.TypeString, value)
+	}
+	if piu.mutation.ProviderAccountIDCleared() {
+		_spec.ClearField(paymentintent.FieldProviderAccountID, field.TypeString)
+	}
 	if value, ok := piu.mutation.Metadata(); ok {
 		_spec.SetField(paymentintent.FieldMetadata, field.TypeJSON, value)
 	}
@@ -335,6 +424,35 @@ func (piu *PaymentIntentUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if piu.mutation.ResponseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   paymentintent.ResponseTable,
+			Columns: []string{paymentintent.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := piu.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   paymentintent.ResponseTable,
+			Columns: []string{paymentintent.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, piu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{paymentintent.Label}
@@ -418,6 +536,27 @@ func (piuo *PaymentIntentUpdateOne) AddAmount(i int64) *PaymentIntentUpdateOne {
 	return piuo
 }
 
+// SetAmountRefunded sets the "amount_refunded" field.
+func (piuo *PaymentIntentUpdateOne) SetAmountRefunded(i int64) *PaymentIntentUpdateOne {
+	piuo.mutation.ResetAmountRefunded()
+	piuo.mutation.SetAmountRefunded(i)
+	return piuo
+}
+
+// SetNillableAmountRefunded sets the "amount_refunded" field if the given value is not nil.
+func (piuo *PaymentIntentUpdateOne) SetNillableAmountRefunded(i *int64) *PaymentIntentUpdateOne {
+	if i != nil {
+		piuo.SetAmountRefunded(*i)
+	}
+	return piuo
+}
+
+// AddAmountRefunded adds i to the "amount_refunded" field.
+func (piuo *PaymentIntentUpdateOne) AddAmountRefunded(i int64) *PaymentIntentUpdateOne {
+	piuo.mutation.AddAmountRefunded(i)
+	return piuo
+}
+
 // SetCurrency sets the "currency" field.
 func (piuo *PaymentIntentUpdateOne) SetCurrency(s string) *PaymentIntentUpdateOne {
 	piuo.mutation.SetCurrency(s)
@@ -472,6 +611,26 @@ func (piuo *PaymentIntentUpdateOne) ClearClientSecret() *PaymentIntentUpdateOne
 	return piuo
 }
 
+// SetProviderAccountID sets the "provider_account_id" field.
+func (piuo *PaymentIntentUpdateOne) SetProviderAccountID(s string) *PaymentIntentUpdateOne {
+	piuo.mutation.SetProviderAccountID(s)
+	return piuo
+}
+
+// SetNillableProviderAccountID sets the "provider_account_id" field if the given value is not nil.
+func (piuo *PaymentIntentUpdateOne) SetNillableProviderAccountID(s *string) *PaymentIntentUpdateOne {
+	if s != nil {
+		piuo.SetProviderAccountID(*s)
+	}
+	return piuo
+}
+
+// ClearProviderAccountID clears the value of the "provider_account_id" field.
+func (piuo *PaymentIntentUpdateOne) ClearProviderAccountID() *PaymentIntentUpdateOne {
+	piuo.mutation.ClearProviderAccountID()
+	return piuo
+}
+
 // SetMetadata sets the "metadata" field.
 func (piuo *PaymentIntentUpdateOne) SetMetadata(m map[string]interface{}) *PaymentIntentUpdateOne {
 	piuo.mutation.SetMetadata(m)
@@ -496,11 +655,38 @@ func (piuo *PaymentIntentUpdateOne) SetCustomerID(id int) *PaymentIntentUpdateOn
 	return piuo
 }
 
+// SetNillableCustomerID sets the "customer" edge to the PaymentCustomer entity by ID if the given value is not nil.
+func (piuo *PaymentIntentUpdateOne) SetNillableCustomerID(id *int) *PaymentIntentUpdateOne {
+	if id != nil {
+		piuo = piuo.SetCustomerID(*id)
+	}
+	return piuo
+}
+
 // SetCustomer sets the "customer" edge to the PaymentCustomer entity.
 func (piuo *PaymentIntentUpdateOne) SetCustomer(p *PaymentCustomer) *PaymentIntentUpdateOne {
 	return piuo.SetCustomerID(p.ID)
 }
 
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (piuo *PaymentIntentUpdateOne) SetResponseID(id int) *PaymentIntentUpdateOne {
+	piuo.mutation.SetResponseID(id)
+	return piuo
+}
+
+// SetNillableResponseID sets the "response" edge to the Response entity by ID if the given value is not nil.
+func (piuo *PaymentIntentUpdateOne) SetNillableResponseID(id *int) *PaymentIntentUpdateOne {
+	if id != nil {
+		piuo = piuo.SetResponseID(*id)
+	}
+	return piuo
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (piuo *PaymentIntentUpdateOne) SetResponse(r *Response) *PaymentIntentUpdateOne {
+	return piuo.SetResponseID(r.ID)
+}
+
 // Mutation returns the PaymentIntentMutation object of the builder.
 func (piuo *PaymentIntentUpdateOne) Mutation() *PaymentIntentMutation {
 	return piuo.mutation
@@ -512,6 +698,12 @@ func (piuo *PaymentIntentUpdateOne) ClearCustomer() *PaymentIntentUpdateOne {
 	return piuo
 }
 
+// ClearResponse clears the "response" edge to the Response entity.
+func (piuo *PaymentIntentUpdateOne) ClearResponse() *PaymentIntentUpdateOne {
+	piuo.mutation.ClearResponse()
+	return piuo
+}
+
 // Where appends a list predicates to the PaymentIntentUpdate builder.
 func (piuo *PaymentIntentUpdateOne) Where(ps ...predicate.PaymentIntent) *PaymentIntentUpdateOne {
 	piuo.mutation.Where(ps...)
@@ -583,14 +775,16 @@ func (piuo *PaymentIntentUpdateOne) check() error {
 			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "PaymentIntent.amount": %w`, err)}
 		}
 	}
+	if v, ok := piuo.mutation.AmountRefunded(); ok {
+		if err := paymentintent.AmountRefundedValidator(v); err != nil {
+			return &ValidationError{Name: "amount_refunded", err: fmt.Errorf(`ent: validator failed for field "PaymentIntent.amount_refunded": %w`, err)}
+		}
+	}
 	if v, ok := piuo.mutation.Currency(); ok {
 		if err := paymentintent.CurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PaymentIntent.currency": %w`, err)}
 		}
 	}
-	if piuo.mutation.CustomerCleared() && len(piuo.mutation.CustomerIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "PaymentIntent.customer"`)
-	}
 	return nil
 }
 
@@ -638,6 +832,12 @@ func (piuo *PaymentIntentUpdateOne) sqlSave(ctx context.Context) (_node *Payment
 	if value, ok := piuo.mutation.AddedAmount(); ok {
 		_spec.AddField(paymentintent.FieldAmount, field.TypeInt64, value)
 	}
+	if value, ok := piuo.mutation.AmountRefunded(); ok {
+		_spec.SetField(paymentintent.FieldAmountRefunded, field.TypeInt64, value)
+	}
+	if value, ok := piuo.mutation.AddedAmountRefunded(); ok {
+		_spec.AddField(paymentintent.FieldAmountRefunded, field.TypeInt64, value)
+	}
 	if value, ok := piuo.mutation.Currency(); ok {
 		_spec.SetField(paymentintent.FieldCurrency, field.TypeString, value)
 	}
@@ -653,6 +853,12 @@ func (piuo *PaymentIntentUpdateOne) sqlSave(ctx context.Context) (_node *Payment
 	if piuo.mutation.ClientSecretCleared() {
 		_spec.ClearField(paymentintent.FieldClientSecret, field.TypeString)
 	}
+	if value, ok := piuo.mutation.ProviderAccountID(); ok {
+		_spec.SetField(paymentintent.FieldProviderAccountID, field.TypeString, value)
+	}
+	if piuo.mutation.ProviderAccountIDCleared() {
+		_spec.ClearField(paymentintent.FieldProviderAccountID, field.TypeString)
+	}
 	if value, ok := piuo.mutation.Metadata(); ok {
 		_spec.SetField(paymentintent.FieldMetadata, field.TypeJSON, value)
 	}
@@ -691,6 +897,35 @@ func (piuo *PaymentIntentUpdateOne) sqlSave(ctx context.Context) (_node *Payment
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if piuo.mutation.ResponseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   paymentintent.ResponseTable,
+			Columns: []string{paymentintent.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := piuo.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   paymentintent.ResponseTable,
+			Columns: []string{paymentintent.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PaymentIntent{config: piuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
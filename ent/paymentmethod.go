@@ -25,7 +25,7 @@ type PaymentMethod struct {
 	Provider string `json:"provider,omitempty"`
 	// Payment method type
 	Type paymentmethod.Type `json:"type,omitempty"`
-	// Last four digits of card/account
+	// Last four digits of card/account, or of the IBAN for SEPA debits and the tax ID for boletos
 	LastFour string `json:"last_four,omitempty"`
 	// Card brand (visa, mastercard, etc.)
 	Brand string `json:"brand,omitempty"`
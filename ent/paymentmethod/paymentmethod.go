@@ -116,6 +116,9 @@ const DefaultType = TypeCard
 const (
 	TypeCard        Type = "card"
 	TypeBankAccount Type = "bank_account"
+	TypeSepaDebit   Type = "sepa_debit"
+	TypeBoleto      Type = "boleto"
+	TypePix         Type = "pix"
 	TypeWallet      Type = "wallet"
 )
 
@@ -126,7 +129,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeCard, TypeBankAccount, TypeWallet:
+	case TypeCard, TypeBankAccount, TypeSepaDebit, TypeBoleto, TypePix, TypeWallet:
 		return nil
 	default:
 		return fmt.Errorf("paymentmethod: invalid enum value for type field: %q", _type)
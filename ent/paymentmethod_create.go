@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/paymentcustomer"
@@ -19,6 +20,7 @@ type PaymentMethodCreate struct {
 	config
 	mutation *PaymentMethodMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProviderPaymentMethodID sets the "provider_payment_method_id" field.
@@ -296,6 +298,7 @@ func (pmc *PaymentMethodCreate) createSpec() (*PaymentMethod, *sqlgraph.CreateSp
 		_node = &PaymentMethod{config: pmc.config}
 		_spec = sqlgraph.NewCreateSpec(paymentmethod.Table, sqlgraph.NewFieldSpec(paymentmethod.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = pmc.conflict
 	if value, ok := pmc.mutation.ProviderPaymentMethodID(); ok {
 		_spec.SetField(paymentmethod.FieldProviderPaymentMethodID, field.TypeString, value)
 		_node.ProviderPaymentMethodID = value
@@ -360,11 +363,490 @@ func (pmc *PaymentMethodCreate) createSpec() (*PaymentMethod, *sqlgraph.CreateSp
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentMethod.Create().
+//		SetProviderPaymentMethodID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentMethodUpsert) {
+//			SetProviderPaymentMethodID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmc *PaymentMethodCreate) OnConflict(opts ...sql.ConflictOption) *PaymentMethodUpsertOne {
+	pmc.conflict = opts
+	return &PaymentMethodUpsertOne{
+		create: pmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentMethod.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmc *PaymentMethodCreate) OnConflictColumns(columns ...string) *PaymentMethodUpsertOne {
+	pmc.conflict = append(pmc.conflict, sql.ConflictColumns(columns...))
+	return &PaymentMethodUpsertOne{
+		create: pmc,
+	}
+}
+
+type (
+	// PaymentMethodUpsertOne is the builder for "upsert"-ing
+	//  one PaymentMethod node.
+	PaymentMethodUpsertOne struct {
+		create *PaymentMethodCreate
+	}
+
+	// PaymentMethodUpsert is the "OnConflict" setter.
+	PaymentMethodUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProviderPaymentMethodID sets the "provider_payment_method_id" field.
+func (u *PaymentMethodUpsert) SetProviderPaymentMethodID(v string) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldProviderPaymentMethodID, v)
+	return u
+}
+
+// UpdateProviderPaymentMethodID sets the "provider_payment_method_id" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateProviderPaymentMethodID() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldProviderPaymentMethodID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentMethodUpsert) SetProvider(v string) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateProvider() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldProvider)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *PaymentMethodUpsert) SetType(v paymentmethod.Type) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateType() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldType)
+	return u
+}
+
+// SetLastFour sets the "last_four" field.
+func (u *PaymentMethodUpsert) SetLastFour(v string) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldLastFour, v)
+	return u
+}
+
+// UpdateLastFour sets the "last_four" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateLastFour() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldLastFour)
+	return u
+}
+
+// ClearLastFour clears the value of the "last_four" field.
+func (u *PaymentMethodUpsert) ClearLastFour() *PaymentMethodUpsert {
+	u.SetNull(paymentmethod.FieldLastFour)
+	return u
+}
+
+// SetBrand sets the "brand" field.
+func (u *PaymentMethodUpsert) SetBrand(v string) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldBrand, v)
+	return u
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateBrand() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldBrand)
+	return u
+}
+
+// ClearBrand clears the value of the "brand" field.
+func (u *PaymentMethodUpsert) ClearBrand() *PaymentMethodUpsert {
+	u.SetNull(paymentmethod.FieldBrand)
+	return u
+}
+
+// SetExpMonth sets the "exp_month" field.
+func (u *PaymentMethodUpsert) SetExpMonth(v int) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldExpMonth, v)
+	return u
+}
+
+// UpdateExpMonth sets the "exp_month" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateExpMonth() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldExpMonth)
+	return u
+}
+
+// AddExpMonth adds v to the "exp_month" field.
+func (u *PaymentMethodUpsert) AddExpMonth(v int) *PaymentMethodUpsert {
+	u.Add(paymentmethod.FieldExpMonth, v)
+	return u
+}
+
+// ClearExpMonth clears the value of the "exp_month" field.
+func (u *PaymentMethodUpsert) ClearExpMonth() *PaymentMethodUpsert {
+	u.SetNull(paymentmethod.FieldExpMonth)
+	return u
+}
+
+// SetExpYear sets the "exp_year" field.
+func (u *PaymentMethodUpsert) SetExpYear(v int) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldExpYear, v)
+	return u
+}
+
+// UpdateExpYear sets the "exp_year" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateExpYear() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldExpYear)
+	return u
+}
+
+// AddExpYear adds v to the "exp_year" field.
+func (u *PaymentMethodUpsert) AddExpYear(v int) *PaymentMethodUpsert {
+	u.Add(paymentmethod.FieldExpYear, v)
+	return u
+}
+
+// ClearExpYear clears the value of the "exp_year" field.
+func (u *PaymentMethodUpsert) ClearExpYear() *PaymentMethodUpsert {
+	u.SetNull(paymentmethod.FieldExpYear)
+	return u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PaymentMethodUpsert) SetIsDefault(v bool) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldIsDefault, v)
+	return u
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateIsDefault() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldIsDefault)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentMethodUpsert) SetMetadata(v map[string]interface{}) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateMetadata() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentMethodUpsert) ClearMetadata() *PaymentMethodUpsert {
+	u.SetNull(paymentmethod.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentMethodUpsert) SetUpdatedAt(v time.Time) *PaymentMethodUpsert {
+	u.Set(paymentmethod.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentMethodUpsert) UpdateUpdatedAt() *PaymentMethodUpsert {
+	u.SetExcluded(paymentmethod.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PaymentMethod.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentMethodUpsertOne) UpdateNewValues() *PaymentMethodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentmethod.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentMethod.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentMethodUpsertOne) Ignore() *PaymentMethodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentMethodUpsertOne) DoNothing() *PaymentMethodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentMethodCreate.OnConflict
+// documentation for more info.
+func (u *PaymentMethodUpsertOne) Update(set func(*PaymentMethodUpsert)) *PaymentMethodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentMethodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderPaymentMethodID sets the "provider_payment_method_id" field.
+func (u *PaymentMethodUpsertOne) SetProviderPaymentMethodID(v string) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetProviderPaymentMethodID(v)
+	})
+}
+
+// UpdateProviderPaymentMethodID sets the "provider_payment_method_id" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateProviderPaymentMethodID() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateProviderPaymentMethodID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentMethodUpsertOne) SetProvider(v string) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateProvider() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PaymentMethodUpsertOne) SetType(v paymentmethod.Type) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateType() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetLastFour sets the "last_four" field.
+func (u *PaymentMethodUpsertOne) SetLastFour(v string) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetLastFour(v)
+	})
+}
+
+// UpdateLastFour sets the "last_four" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateLastFour() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateLastFour()
+	})
+}
+
+// ClearLastFour clears the value of the "last_four" field.
+func (u *PaymentMethodUpsertOne) ClearLastFour() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearLastFour()
+	})
+}
+
+// SetBrand sets the "brand" field.
+func (u *PaymentMethodUpsertOne) SetBrand(v string) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetBrand(v)
+	})
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateBrand() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateBrand()
+	})
+}
+
+// ClearBrand clears the value of the "brand" field.
+func (u *PaymentMethodUpsertOne) ClearBrand() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearBrand()
+	})
+}
+
+// SetExpMonth sets the "exp_month" field.
+func (u *PaymentMethodUpsertOne) SetExpMonth(v int) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetExpMonth(v)
+	})
+}
+
+// AddExpMonth adds v to the "exp_month" field.
+func (u *PaymentMethodUpsertOne) AddExpMonth(v int) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.AddExpMonth(v)
+	})
+}
+
+// UpdateExpMonth sets the "exp_month" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateExpMonth() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateExpMonth()
+	})
+}
+
+// ClearExpMonth clears the value of the "exp_month" field.
+func (u *PaymentMethodUpsertOne) ClearExpMonth() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearExpMonth()
+	})
+}
+
+// SetExpYear sets the "exp_year" field.
+func (u *PaymentMethodUpsertOne) SetExpYear(v int) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetExpYear(v)
+	})
+}
+
+// AddExpYear adds v to the "exp_year" field.
+func (u *PaymentMethodUpsertOne) AddExpYear(v int) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.AddExpYear(v)
+	})
+}
+
+// UpdateExpYear sets the "exp_year" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateExpYear() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateExpYear()
+	})
+}
+
+// ClearExpYear clears the value of the "exp_year" field.
+func (u *PaymentMethodUpsertOne) ClearExpYear() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearExpYear()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PaymentMethodUpsertOne) SetIsDefault(v bool) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateIsDefault() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentMethodUpsertOne) SetMetadata(v map[string]interface{}) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateMetadata() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentMethodUpsertOne) ClearMetadata() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentMethodUpsertOne) SetUpdatedAt(v time.Time) *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentMethodUpsertOne) UpdateUpdatedAt() *PaymentMethodUpsertOne {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentMethodUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentMethodCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentMethodUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentMethodUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentMethodUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PaymentMethodCreateBulk is the builder for creating many PaymentMethod entities in bulk.
 type PaymentMethodCreateBulk struct {
 	config
 	err      error
 	builders []*PaymentMethodCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PaymentMethod entities in the database.
@@ -394,6 +876,7 @@ func (pmcb *PaymentMethodCreateBulk) Save(ctx context.Context) ([]*PaymentMethod
 					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pmcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -447,3 +930,303 @@ func (pmcb *PaymentMethodCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentMethod.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentMethodUpsert) {
+//			SetProviderPaymentMethodID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmcb *PaymentMethodCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentMethodUpsertBulk {
+	pmcb.conflict = opts
+	return &PaymentMethodUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentMethod.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmcb *PaymentMethodCreateBulk) OnConflictColumns(columns ...string) *PaymentMethodUpsertBulk {
+	pmcb.conflict = append(pmcb.conflict, sql.ConflictColumns(columns...))
+	return &PaymentMethodUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// PaymentMethodUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentMethod nodes.
+type PaymentMethodUpsertBulk struct {
+	create *PaymentMethodCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentMethod.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentMethodUpsertBulk) UpdateNewValues() *PaymentMethodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentmethod.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentMethod.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentMethodUpsertBulk) Ignore() *PaymentMethodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentMethodUpsertBulk) DoNothing() *PaymentMethodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentMethodCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentMethodUpsertBulk) Update(set func(*PaymentMethodUpsert)) *PaymentMethodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentMethodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderPaymentMethodID sets the "provider_payment_method_id" field.
+func (u *PaymentMethodUpsertBulk) SetProviderPaymentMethodID(v string) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetProviderPaymentMethodID(v)
+	})
+}
+
+// UpdateProviderPaymentMethodID sets the "provider_payment_method_id" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateProviderPaymentMethodID() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateProviderPaymentMethodID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentMethodUpsertBulk) SetProvider(v string) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateProvider() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PaymentMethodUpsertBulk) SetType(v paymentmethod.Type) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateType() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetLastFour sets the "last_four" field.
+func (u *PaymentMethodUpsertBulk) SetLastFour(v string) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetLastFour(v)
+	})
+}
+
+// UpdateLastFour sets the "last_four" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateLastFour() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateLastFour()
+	})
+}
+
+// ClearLastFour clears the value of the "last_four" field.
+func (u *PaymentMethodUpsertBulk) ClearLastFour() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearLastFour()
+	})
+}
+
+// SetBrand sets the "brand" field.
+func (u *PaymentMethodUpsertBulk) SetBrand(v string) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetBrand(v)
+	})
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateBrand() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateBrand()
+	})
+}
+
+// ClearBrand clears the value of the "brand" field.
+func (u *PaymentMethodUpsertBulk) ClearBrand() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearBrand()
+	})
+}
+
+// SetExpMonth sets the "exp_month" field.
+func (u *PaymentMethodUpsertBulk) SetExpMonth(v int) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetExpMonth(v)
+	})
+}
+
+// AddExpMonth adds v to the "exp_month" field.
+func (u *PaymentMethodUpsertBulk) AddExpMonth(v int) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.AddExpMonth(v)
+	})
+}
+
+// UpdateExpMonth sets the "exp_month" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateExpMonth() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateExpMonth()
+	})
+}
+
+// ClearExpMonth clears the value of the "exp_month" field.
+func (u *PaymentMethodUpsertBulk) ClearExpMonth() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearExpMonth()
+	})
+}
+
+// SetExpYear sets the "exp_year" field.
+func (u *PaymentMethodUpsertBulk) SetExpYear(v int) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetExpYear(v)
+	})
+}
+
+// AddExpYear adds v to the "exp_year" field.
+func (u *PaymentMethodUpsertBulk) AddExpYear(v int) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.AddExpYear(v)
+	})
+}
+
+// UpdateExpYear sets the "exp_year" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateExpYear() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateExpYear()
+	})
+}
+
+// ClearExpYear clears the value of the "exp_year" field.
+func (u *PaymentMethodUpsertBulk) ClearExpYear() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearExpYear()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PaymentMethodUpsertBulk) SetIsDefault(v bool) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateIsDefault() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentMethodUpsertBulk) SetMetadata(v map[string]interface{}) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateMetadata() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PaymentMethodUpsertBulk) ClearMetadata() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentMethodUpsertBulk) SetUpdatedAt(v time.Time) *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentMethodUpsertBulk) UpdateUpdatedAt() *PaymentMethodUpsertBulk {
+	return u.Update(func(s *PaymentMethodUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentMethodUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentMethodCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentMethodCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentMethodUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
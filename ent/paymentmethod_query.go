@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type PaymentMethodQuery struct {
 	predicates   []predicate.PaymentMethod
 	withCustomer *PaymentCustomerQuery
 	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -391,6 +393,9 @@ func (pmq *PaymentMethodQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -444,6 +449,9 @@ func (pmq *PaymentMethodQuery) loadCustomer(ctx context.Context, query *PaymentC
 
 func (pmq *PaymentMethodQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pmq.querySpec()
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
 	_spec.Node.Columns = pmq.ctx.Fields
 	if len(pmq.ctx.Fields) > 0 {
 		_spec.Unique = pmq.ctx.Unique != nil && *pmq.ctx.Unique
@@ -506,6 +514,9 @@ func (pmq *PaymentMethodQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pmq.ctx.Unique != nil && *pmq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pmq.modifiers {
+		m(selector)
+	}
 	for _, p := range pmq.predicates {
 		p(selector)
 	}
@@ -523,6 +534,32 @@ func (pmq *PaymentMethodQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pmq *PaymentMethodQuery) ForUpdate(opts ...sql.LockOption) *PaymentMethodQuery {
+	if pmq.driver.Dialect() == dialect.Postgres {
+		pmq.Unique(false)
+	}
+	pmq.modifiers = append(pmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pmq *PaymentMethodQuery) ForShare(opts ...sql.LockOption) *PaymentMethodQuery {
+	if pmq.driver.Dialect() == dialect.Postgres {
+		pmq.Unique(false)
+	}
+	pmq.modifiers = append(pmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pmq
+}
+
 // PaymentMethodGroupBy is the group-by builder for PaymentMethod entities.
 type PaymentMethodGroupBy struct {
 	selector
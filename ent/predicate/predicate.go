@@ -9,15 +9,27 @@ import (
 // Answer is the predicate function for answer builders.
 type Answer func(*sql.Selector)
 
+// Discount is the predicate function for discount builders.
+type Discount func(*sql.Selector)
+
 // Form is the predicate function for form builders.
 type Form func(*sql.Selector)
 
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
 // Job is the predicate function for job builders.
 type Job func(*sql.Selector)
 
+// JobAttempt is the predicate function for jobattempt builders.
+type JobAttempt func(*sql.Selector)
+
 // PasswordToken is the predicate function for passwordtoken builders.
 type PasswordToken func(*sql.Selector)
 
+// PaymentAccount is the predicate function for paymentaccount builders.
+type PaymentAccount func(*sql.Selector)
+
 // PaymentCustomer is the predicate function for paymentcustomer builders.
 type PaymentCustomer func(*sql.Selector)
 
@@ -27,14 +39,32 @@ type PaymentIntent func(*sql.Selector)
 // PaymentMethod is the predicate function for paymentmethod builders.
 type PaymentMethod func(*sql.Selector)
 
+// ProcessedEvent is the predicate function for processedevent builders.
+type ProcessedEvent func(*sql.Selector)
+
 // Question is the predicate function for question builders.
 type Question func(*sql.Selector)
 
+// ReportSubscription is the predicate function for reportsubscription builders.
+type ReportSubscription func(*sql.Selector)
+
 // Response is the predicate function for response builders.
 type Response func(*sql.Selector)
 
+// ResponseActivity is the predicate function for responseactivity builders.
+type ResponseActivity func(*sql.Selector)
+
+// ResponseNote is the predicate function for responsenote builders.
+type ResponseNote func(*sql.Selector)
+
+// ResponseTag is the predicate function for responsetag builders.
+type ResponseTag func(*sql.Selector)
+
 // Subscription is the predicate function for subscription builders.
 type Subscription func(*sql.Selector)
 
+// Usage is the predicate function for usage builders.
+type Usage func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
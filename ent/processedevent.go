@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/processedevent"
+)
+
+// ProcessedEvent is the model entity for the ProcessedEvent schema.
+type ProcessedEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Payment provider name
+	Provider string `json:"provider,omitempty"`
+	// External payment provider event ID
+	EventID string `json:"event_id,omitempty"`
+	// Event type, such as customer.subscription.updated
+	Type string `json:"type,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProcessedEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case processedevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case processedevent.FieldProvider, processedevent.FieldEventID, processedevent.FieldType:
+			values[i] = new(sql.NullString)
+		case processedevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProcessedEvent fields.
+func (pe *ProcessedEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case processedevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pe.ID = int(value.Int64)
+		case processedevent.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				pe.Provider = value.String
+			}
+		case processedevent.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				pe.EventID = value.String
+			}
+		case processedevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				pe.Type = value.String
+			}
+		case processedevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pe.CreatedAt = value.Time
+			}
+		default:
+			pe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProcessedEvent.
+// This includes values selected through modifiers, order, etc.
+func (pe *ProcessedEvent) Value(name string) (ent.Value, error) {
+	return pe.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProcessedEvent.
+// Note that you need to call ProcessedEvent.Unwrap() before calling this method if this ProcessedEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pe *ProcessedEvent) Update() *ProcessedEventUpdateOne {
+	return NewProcessedEventClient(pe.config).UpdateOne(pe)
+}
+
+// Unwrap unwraps the ProcessedEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pe *ProcessedEvent) Unwrap() *ProcessedEvent {
+	_tx, ok := pe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProcessedEvent is not a transactional entity")
+	}
+	pe.config.driver = _tx.drv
+	return pe
+}
+
+// String implements the fmt.Stringer.
+func (pe *ProcessedEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProcessedEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pe.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(pe.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(pe.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(pe.Type)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pe.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProcessedEvents is a parsable slice of ProcessedEvent.
+type ProcessedEvents []*ProcessedEvent
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/processedevent"
+)
+
+// ProcessedEventCreate is the builder for creating a ProcessedEvent entity.
+type ProcessedEventCreate struct {
+	config
+	mutation *ProcessedEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProvider sets the "provider" field.
+func (pec *ProcessedEventCreate) SetProvider(s string) *ProcessedEventCreate {
+	pec.mutation.SetProvider(s)
+	return pec
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pec *ProcessedEventCreate) SetNillableProvider(s *string) *ProcessedEventCreate {
+	if s != nil {
+		pec.SetProvider(*s)
+	}
+	return pec
+}
+
+// SetEventID sets the "event_id" field.
+func (pec *ProcessedEventCreate) SetEventID(s string) *ProcessedEventCreate {
+	pec.mutation.SetEventID(s)
+	return pec
+}
+
+// SetType sets the "type" field.
+func (pec *ProcessedEventCreate) SetType(s string) *ProcessedEventCreate {
+	pec.mutation.SetType(s)
+	return pec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pec *ProcessedEventCreate) SetCreatedAt(t time.Time) *ProcessedEventCreate {
+	pec.mutation.SetCreatedAt(t)
+	return pec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pec *ProcessedEventCreate) SetNillableCreatedAt(t *time.Time) *ProcessedEventCreate {
+	if t != nil {
+		pec.SetCreatedAt(*t)
+	}
+	return pec
+}
+
+// Mutation returns the ProcessedEventMutation object of the builder.
+func (pec *ProcessedEventCreate) Mutation() *ProcessedEventMutation {
+	return pec.mutation
+}
+
+// Save creates the ProcessedEvent in the database.
+func (pec *ProcessedEventCreate) Save(ctx context.Context) (*ProcessedEvent, error) {
+	pec.defaults()
+	return withHooks(ctx, pec.sqlSave, pec.mutation, pec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pec *ProcessedEventCreate) SaveX(ctx context.Context) *ProcessedEvent {
+	v, err := pec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pec *ProcessedEventCreate) Exec(ctx context.Context) error {
+	_, err := pec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pec *ProcessedEventCreate) ExecX(ctx context.Context) {
+	if err := pec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pec *ProcessedEventCreate) defaults() {
+	if _, ok := pec.mutation.Provider(); !ok {
+		v := processedevent.DefaultProvider
+		pec.mutation.SetProvider(v)
+	}
+	if _, ok := pec.mutation.CreatedAt(); !ok {
+		v := processedevent.DefaultCreatedAt()
+		pec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pec *ProcessedEventCreate) check() error {
+	if _, ok := pec.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "ProcessedEvent.provider"`)}
+	}
+	if v, ok := pec.mutation.Provider(); ok {
+		if err := processedevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ProcessedEvent.provider": %w`, err)}
+		}
+	}
+	if _, ok := pec.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "ProcessedEvent.event_id"`)}
+	}
+	if v, ok := pec.mutation.EventID(); ok {
+		if err := processedevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "ProcessedEvent.event_id": %w`, err)}
+		}
+	}
+	if _, ok := pec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ProcessedEvent.type"`)}
+	}
+	if v, ok := pec.mutation.GetType(); ok {
+		if err := processedevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ProcessedEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := pec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProcessedEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (pec *ProcessedEventCreate) sqlSave(ctx context.Context) (*ProcessedEvent, error) {
+	if err := pec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pec.mutation.id = &_node.ID
+	pec.mutation.done = true
+	return _node, nil
+}
+
+func (pec *ProcessedEventCreate) createSpec() (*ProcessedEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProcessedEvent{config: pec.config}
+		_spec = sqlgraph.NewCreateSpec(processedevent.Table, sqlgraph.NewFieldSpec(processedevent.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pec.conflict
+	if value, ok := pec.mutation.Provider(); ok {
+		_spec.SetField(processedevent.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := pec.mutation.EventID(); ok {
+		_spec.SetField(processedevent.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := pec.mutation.GetType(); ok {
+		_spec.SetField(processedevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := pec.mutation.CreatedAt(); ok {
+		_spec.SetField(processedevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProcessedEvent.Create().
+//		SetProvider(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProcessedEventUpsert) {
+//			SetProvider(v+v).
+//		}).
+//		Exec(ctx)
+func (pec *ProcessedEventCreate) OnConflict(opts ...sql.ConflictOption) *ProcessedEventUpsertOne {
+	pec.conflict = opts
+	return &ProcessedEventUpsertOne{
+		create: pec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pec *ProcessedEventCreate) OnConflictColumns(columns ...string) *ProcessedEventUpsertOne {
+	pec.conflict = append(pec.conflict, sql.ConflictColumns(columns...))
+	return &ProcessedEventUpsertOne{
+		create: pec,
+	}
+}
+
+type (
+	// ProcessedEventUpsertOne is the builder for "upsert"-ing
+	//  one ProcessedEvent node.
+	ProcessedEventUpsertOne struct {
+		create *ProcessedEventCreate
+	}
+
+	// ProcessedEventUpsert is the "OnConflict" setter.
+	ProcessedEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProvider sets the "provider" field.
+func (u *ProcessedEventUpsert) SetProvider(v string) *ProcessedEventUpsert {
+	u.Set(processedevent.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ProcessedEventUpsert) UpdateProvider() *ProcessedEventUpsert {
+	u.SetExcluded(processedevent.FieldProvider)
+	return u
+}
+
+// SetEventID sets the "event_id" field.
+func (u *ProcessedEventUpsert) SetEventID(v string) *ProcessedEventUpsert {
+	u.Set(processedevent.FieldEventID, v)
+	return u
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *ProcessedEventUpsert) UpdateEventID() *ProcessedEventUpsert {
+	u.SetExcluded(processedevent.FieldEventID)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *ProcessedEventUpsert) SetType(v string) *ProcessedEventUpsert {
+	u.Set(processedevent.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ProcessedEventUpsert) UpdateType() *ProcessedEventUpsert {
+	u.SetExcluded(processedevent.FieldType)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProcessedEventUpsertOne) UpdateNewValues() *ProcessedEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(processedevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProcessedEventUpsertOne) Ignore() *ProcessedEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProcessedEventUpsertOne) DoNothing() *ProcessedEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProcessedEventCreate.OnConflict
+// documentation for more info.
+func (u *ProcessedEventUpsertOne) Update(set func(*ProcessedEventUpsert)) *ProcessedEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProcessedEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *ProcessedEventUpsertOne) SetProvider(v string) *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ProcessedEventUpsertOne) UpdateProvider() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetEventID sets the "event_id" field.
+func (u *ProcessedEventUpsertOne) SetEventID(v string) *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *ProcessedEventUpsertOne) UpdateEventID() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *ProcessedEventUpsertOne) SetType(v string) *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ProcessedEventUpsertOne) UpdateType() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// Exec executes the query.
+func (u *ProcessedEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProcessedEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProcessedEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProcessedEventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProcessedEventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProcessedEventCreateBulk is the builder for creating many ProcessedEvent entities in bulk.
+type ProcessedEventCreateBulk struct {
+	config
+	err      error
+	builders []*ProcessedEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProcessedEvent entities in the database.
+func (pecb *ProcessedEventCreateBulk) Save(ctx context.Context) ([]*ProcessedEvent, error) {
+	if pecb.err != nil {
+		return nil, pecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pecb.builders))
+	nodes := make([]*ProcessedEvent, len(pecb.builders))
+	mutators := make([]Mutator, len(pecb.builders))
+	for i := range pecb.builders {
+		func(i int, root context.Context) {
+			builder := pecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProcessedEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pecb *ProcessedEventCreateBulk) SaveX(ctx context.Context) []*ProcessedEvent {
+	v, err := pecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pecb *ProcessedEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := pecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pecb *ProcessedEventCreateBulk) ExecX(ctx context.Context) {
+	if err := pecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProcessedEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProcessedEventUpsert) {
+//			SetProvider(v+v).
+//		}).
+//		Exec(ctx)
+func (pecb *ProcessedEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProcessedEventUpsertBulk {
+	pecb.conflict = opts
+	return &ProcessedEventUpsertBulk{
+		create: pecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pecb *ProcessedEventCreateBulk) OnConflictColumns(columns ...string) *ProcessedEventUpsertBulk {
+	pecb.conflict = append(pecb.conflict, sql.ConflictColumns(columns...))
+	return &ProcessedEventUpsertBulk{
+		create: pecb,
+	}
+}
+
+// ProcessedEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProcessedEvent nodes.
+type ProcessedEventUpsertBulk struct {
+	create *ProcessedEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProcessedEventUpsertBulk) UpdateNewValues() *ProcessedEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(processedevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProcessedEventUpsertBulk) Ignore() *ProcessedEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProcessedEventUpsertBulk) DoNothing() *ProcessedEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProcessedEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProcessedEventUpsertBulk) Update(set func(*ProcessedEventUpsert)) *ProcessedEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProcessedEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *ProcessedEventUpsertBulk) SetProvider(v string) *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ProcessedEventUpsertBulk) UpdateProvider() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetEventID sets the "event_id" field.
+func (u *ProcessedEventUpsertBulk) SetEventID(v string) *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *ProcessedEventUpsertBulk) UpdateEventID() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *ProcessedEventUpsertBulk) SetType(v string) *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ProcessedEventUpsertBulk) UpdateType() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// Exec executes the query.
+func (u *ProcessedEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProcessedEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProcessedEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProcessedEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -29,6 +29,8 @@ type Question struct {
 	Placeholder string `json:"placeholder,omitempty"`
 	// Required holds the value of the "required" field.
 	Required bool `json:"required,omitempty"`
+	// Answers are encrypted at rest and only decrypted for the form owner
+	Sensitive bool `json:"sensitive,omitempty"`
 	// Order holds the value of the "order" field.
 	Order int `json:"order,omitempty"`
 	// Options holds the value of the "options" field.
@@ -84,7 +86,7 @@ func (*Question) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case question.FieldOptions, question.FieldValidation:
 			values[i] = new([]byte)
-		case question.FieldRequired:
+		case question.FieldRequired, question.FieldSensitive:
 			values[i] = new(sql.NullBool)
 		case question.FieldID, question.FieldOrder:
 			values[i] = new(sql.NullInt64)
@@ -145,6 +147,12 @@ func (q *Question) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				q.Required = value.Bool
 			}
+		case question.FieldSensitive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sensitive", values[i])
+			} else if value.Valid {
+				q.Sensitive = value.Bool
+			}
 		case question.FieldOrder:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field order", values[i])
@@ -247,6 +255,9 @@ func (q *Question) String() string {
 	builder.WriteString("required=")
 	builder.WriteString(fmt.Sprintf("%v", q.Required))
 	builder.WriteString(", ")
+	builder.WriteString("sensitive=")
+	builder.WriteString(fmt.Sprintf("%v", q.Sensitive))
+	builder.WriteString(", ")
 	builder.WriteString("order=")
 	builder.WriteString(fmt.Sprintf("%v", q.Order))
 	builder.WriteString(", ")
@@ -25,6 +25,8 @@ const (
 	FieldPlaceholder = "placeholder"
 	// FieldRequired holds the string denoting the required field in the database.
 	FieldRequired = "required"
+	// FieldSensitive holds the string denoting the sensitive field in the database.
+	FieldSensitive = "sensitive"
 	// FieldOrder holds the string denoting the order field in the database.
 	FieldOrder = "order"
 	// FieldOptions holds the string denoting the options field in the database.
@@ -65,6 +67,7 @@ var Columns = []string{
 	FieldDescription,
 	FieldPlaceholder,
 	FieldRequired,
+	FieldSensitive,
 	FieldOrder,
 	FieldOptions,
 	FieldValidation,
@@ -98,6 +101,8 @@ var (
 	TitleValidator func(string) error
 	// DefaultRequired holds the default value on creation for the "required" field.
 	DefaultRequired bool
+	// DefaultSensitive holds the default value on creation for the "sensitive" field.
+	DefaultSensitive bool
 	// DefaultOrder holds the default value on creation for the "order" field.
 	DefaultOrder int
 	// OrderValidator is a validator for the "order" field. It is called by the builders before save.
@@ -145,6 +150,7 @@ const (
 	TypeLegal         Type = "legal"
 	TypeHidden        Type = "hidden"
 	TypeMultiInput    Type = "multi-input"
+	TypePayment       Type = "payment"
 )
 
 func (_type Type) String() string {
@@ -154,7 +160,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeText, TypeShortText, TypeLongText, TypeEmail, TypeNumber, TypePhone, TypeURL, TypeTextarea, TypeDate, TypeTime, TypeDateRange, TypeFile, TypeSignature, TypeDropdown, TypeRadio, TypeCheckbox, TypeMultiSelect, TypePictureChoice, TypeYesno, TypeRating, TypeOpinionScale, TypeRanking, TypeMatrix, TypeStatement, TypeLegal, TypeHidden, TypeMultiInput:
+	case TypeText, TypeShortText, TypeLongText, TypeEmail, TypeNumber, TypePhone, TypeURL, TypeTextarea, TypeDate, TypeTime, TypeDateRange, TypeFile, TypeSignature, TypeDropdown, TypeRadio, TypeCheckbox, TypeMultiSelect, TypePictureChoice, TypeYesno, TypeRating, TypeOpinionScale, TypeRanking, TypeMatrix, TypeStatement, TypeLegal, TypeHidden, TypeMultiInput, TypePayment:
 		return nil
 	default:
 		return fmt.Errorf("question: invalid enum value for type field: %q", _type)
@@ -194,6 +200,11 @@ func ByRequired(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRequired, opts...).ToFunc()
 }
 
+// BySensitive orders the results by the sensitive field.
+func BySensitive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSensitive, opts...).ToFunc()
+}
+
 // ByOrder orders the results by the order field.
 func ByOrder(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrder, opts...).ToFunc()
@@ -75,6 +75,11 @@ func Required(v bool) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldRequired, v))
 }
 
+// Sensitive applies equality check predicate on the "sensitive" field. It's identical to SensitiveEQ.
+func Sensitive(v bool) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldSensitive, v))
+}
+
 // Order applies equality check predicate on the "order" field. It's identical to OrderEQ.
 func Order(v int) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldOrder, v))
@@ -335,6 +340,16 @@ func RequiredNEQ(v bool) predicate.Question {
 	return predicate.Question(sql.FieldNEQ(FieldRequired, v))
 }
 
+// SensitiveEQ applies the EQ predicate on the "sensitive" field.
+func SensitiveEQ(v bool) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldSensitive, v))
+}
+
+// SensitiveNEQ applies the NEQ predicate on the "sensitive" field.
+func SensitiveNEQ(v bool) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldSensitive, v))
+}
+
 // OrderEQ applies the EQ predicate on the "order" field.
 func OrderEQ(v int) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldOrder, v))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/answer"
@@ -20,6 +21,7 @@ type QuestionCreate struct {
 	config
 	mutation *QuestionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetType sets the "type" field.
@@ -84,6 +86,20 @@ func (qc *QuestionCreate) SetNillableRequired(b *bool) *QuestionCreate {
 	return qc
 }
 
+// SetSensitive sets the "sensitive" field.
+func (qc *QuestionCreate) SetSensitive(b bool) *QuestionCreate {
+	qc.mutation.SetSensitive(b)
+	return qc
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableSensitive(b *bool) *QuestionCreate {
+	if b != nil {
+		qc.SetSensitive(*b)
+	}
+	return qc
+}
+
 // SetOrder sets the "order" field.
 func (qc *QuestionCreate) SetOrder(i int) *QuestionCreate {
 	qc.mutation.SetOrder(i)
@@ -207,6 +223,10 @@ func (qc *QuestionCreate) defaults() {
 		v := question.DefaultRequired
 		qc.mutation.SetRequired(v)
 	}
+	if _, ok := qc.mutation.Sensitive(); !ok {
+		v := question.DefaultSensitive
+		qc.mutation.SetSensitive(v)
+	}
 	if _, ok := qc.mutation.Order(); !ok {
 		v := question.DefaultOrder
 		qc.mutation.SetOrder(v)
@@ -242,6 +262,9 @@ func (qc *QuestionCreate) check() error {
 	if _, ok := qc.mutation.Required(); !ok {
 		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "Question.required"`)}
 	}
+	if _, ok := qc.mutation.Sensitive(); !ok {
+		return &ValidationError{Name: "sensitive", err: errors.New(`ent: missing required field "Question.sensitive"`)}
+	}
 	if _, ok := qc.mutation.Order(); !ok {
 		return &ValidationError{Name: "order", err: errors.New(`ent: missing required field "Question.order"`)}
 	}
@@ -285,6 +308,7 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_node = &Question{config: qc.config}
 		_spec = sqlgraph.NewCreateSpec(question.Table, sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = qc.conflict
 	if value, ok := qc.mutation.GetType(); ok {
 		_spec.SetField(question.FieldType, field.TypeEnum, value)
 		_node.Type = value
@@ -305,6 +329,10 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_spec.SetField(question.FieldRequired, field.TypeBool, value)
 		_node.Required = value
 	}
+	if value, ok := qc.mutation.Sensitive(); ok {
+		_spec.SetField(question.FieldSensitive, field.TypeBool, value)
+		_node.Sensitive = value
+	}
 	if value, ok := qc.mutation.Order(); ok {
 		_spec.SetField(question.FieldOrder, field.TypeInt, value)
 		_node.Order = value
@@ -361,11 +389,464 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Question.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (qc *QuestionCreate) OnConflict(opts ...sql.ConflictOption) *QuestionUpsertOne {
+	qc.conflict = opts
+	return &QuestionUpsertOne{
+		create: qc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qc *QuestionCreate) OnConflictColumns(columns ...string) *QuestionUpsertOne {
+	qc.conflict = append(qc.conflict, sql.ConflictColumns(columns...))
+	return &QuestionUpsertOne{
+		create: qc,
+	}
+}
+
+type (
+	// QuestionUpsertOne is the builder for "upsert"-ing
+	//  one Question node.
+	QuestionUpsertOne struct {
+		create *QuestionCreate
+	}
+
+	// QuestionUpsert is the "OnConflict" setter.
+	QuestionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetType sets the "type" field.
+func (u *QuestionUpsert) SetType(v question.Type) *QuestionUpsert {
+	u.Set(question.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateType() *QuestionUpsert {
+	u.SetExcluded(question.FieldType)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionUpsert) SetTitle(v string) *QuestionUpsert {
+	u.Set(question.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateTitle() *QuestionUpsert {
+	u.SetExcluded(question.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionUpsert) SetDescription(v string) *QuestionUpsert {
+	u.Set(question.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateDescription() *QuestionUpsert {
+	u.SetExcluded(question.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionUpsert) ClearDescription() *QuestionUpsert {
+	u.SetNull(question.FieldDescription)
+	return u
+}
+
+// SetPlaceholder sets the "placeholder" field.
+func (u *QuestionUpsert) SetPlaceholder(v string) *QuestionUpsert {
+	u.Set(question.FieldPlaceholder, v)
+	return u
+}
+
+// UpdatePlaceholder sets the "placeholder" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdatePlaceholder() *QuestionUpsert {
+	u.SetExcluded(question.FieldPlaceholder)
+	return u
+}
+
+// ClearPlaceholder clears the value of the "placeholder" field.
+func (u *QuestionUpsert) ClearPlaceholder() *QuestionUpsert {
+	u.SetNull(question.FieldPlaceholder)
+	return u
+}
+
+// SetRequired sets the "required" field.
+func (u *QuestionUpsert) SetRequired(v bool) *QuestionUpsert {
+	u.Set(question.FieldRequired, v)
+	return u
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateRequired() *QuestionUpsert {
+	u.SetExcluded(question.FieldRequired)
+	return u
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *QuestionUpsert) SetSensitive(v bool) *QuestionUpsert {
+	u.Set(question.FieldSensitive, v)
+	return u
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateSensitive() *QuestionUpsert {
+	u.SetExcluded(question.FieldSensitive)
+	return u
+}
+
+// SetOrder sets the "order" field.
+func (u *QuestionUpsert) SetOrder(v int) *QuestionUpsert {
+	u.Set(question.FieldOrder, v)
+	return u
+}
+
+// UpdateOrder sets the "order" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateOrder() *QuestionUpsert {
+	u.SetExcluded(question.FieldOrder)
+	return u
+}
+
+// AddOrder adds v to the "order" field.
+func (u *QuestionUpsert) AddOrder(v int) *QuestionUpsert {
+	u.Add(question.FieldOrder, v)
+	return u
+}
+
+// SetOptions sets the "options" field.
+func (u *QuestionUpsert) SetOptions(v map[string]interface{}) *QuestionUpsert {
+	u.Set(question.FieldOptions, v)
+	return u
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateOptions() *QuestionUpsert {
+	u.SetExcluded(question.FieldOptions)
+	return u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (u *QuestionUpsert) ClearOptions() *QuestionUpsert {
+	u.SetNull(question.FieldOptions)
+	return u
+}
+
+// SetValidation sets the "validation" field.
+func (u *QuestionUpsert) SetValidation(v map[string]interface{}) *QuestionUpsert {
+	u.Set(question.FieldValidation, v)
+	return u
+}
+
+// UpdateValidation sets the "validation" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateValidation() *QuestionUpsert {
+	u.SetExcluded(question.FieldValidation)
+	return u
+}
+
+// ClearValidation clears the value of the "validation" field.
+func (u *QuestionUpsert) ClearValidation() *QuestionUpsert {
+	u.SetNull(question.FieldValidation)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionUpsert) SetUpdatedAt(v time.Time) *QuestionUpsert {
+	u.Set(question.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateUpdatedAt() *QuestionUpsert {
+	u.SetExcluded(question.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuestionUpsertOne) UpdateNewValues() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(question.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionUpsertOne) Ignore() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionUpsertOne) DoNothing() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionCreate.OnConflict
+// documentation for more info.
+func (u *QuestionUpsertOne) Update(set func(*QuestionUpsert)) *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *QuestionUpsertOne) SetType(v question.Type) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateType() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionUpsertOne) SetTitle(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateTitle() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionUpsertOne) SetDescription(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateDescription() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionUpsertOne) ClearDescription() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPlaceholder sets the "placeholder" field.
+func (u *QuestionUpsertOne) SetPlaceholder(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetPlaceholder(v)
+	})
+}
+
+// UpdatePlaceholder sets the "placeholder" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdatePlaceholder() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdatePlaceholder()
+	})
+}
+
+// ClearPlaceholder clears the value of the "placeholder" field.
+func (u *QuestionUpsertOne) ClearPlaceholder() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearPlaceholder()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *QuestionUpsertOne) SetRequired(v bool) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateRequired() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *QuestionUpsertOne) SetSensitive(v bool) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetSensitive(v)
+	})
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateSensitive() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateSensitive()
+	})
+}
+
+// SetOrder sets the "order" field.
+func (u *QuestionUpsertOne) SetOrder(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetOrder(v)
+	})
+}
+
+// AddOrder adds v to the "order" field.
+func (u *QuestionUpsertOne) AddOrder(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddOrder(v)
+	})
+}
+
+// UpdateOrder sets the "order" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateOrder() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateOrder()
+	})
+}
+
+// SetOptions sets the "options" field.
+func (u *QuestionUpsertOne) SetOptions(v map[string]interface{}) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateOptions() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// ClearOptions clears the value of the "options" field.
+func (u *QuestionUpsertOne) ClearOptions() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearOptions()
+	})
+}
+
+// SetValidation sets the "validation" field.
+func (u *QuestionUpsertOne) SetValidation(v map[string]interface{}) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetValidation(v)
+	})
+}
+
+// UpdateValidation sets the "validation" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateValidation() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateValidation()
+	})
+}
+
+// ClearValidation clears the value of the "validation" field.
+func (u *QuestionUpsertOne) ClearValidation() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearValidation()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionUpsertOne) SetUpdatedAt(v time.Time) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateUpdatedAt() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // QuestionCreateBulk is the builder for creating many Question entities in bulk.
 type QuestionCreateBulk struct {
 	config
 	err      error
 	builders []*QuestionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Question entities in the database.
@@ -395,6 +876,7 @@ func (qcb *QuestionCreateBulk) Save(ctx context.Context) ([]*Question, error) {
 					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -448,3 +930,289 @@ func (qcb *QuestionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Question.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (qcb *QuestionCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionUpsertBulk {
+	qcb.conflict = opts
+	return &QuestionUpsertBulk{
+		create: qcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qcb *QuestionCreateBulk) OnConflictColumns(columns ...string) *QuestionUpsertBulk {
+	qcb.conflict = append(qcb.conflict, sql.ConflictColumns(columns...))
+	return &QuestionUpsertBulk{
+		create: qcb,
+	}
+}
+
+// QuestionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Question nodes.
+type QuestionUpsertBulk struct {
+	create *QuestionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuestionUpsertBulk) UpdateNewValues() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(question.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionUpsertBulk) Ignore() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionUpsertBulk) DoNothing() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionUpsertBulk) Update(set func(*QuestionUpsert)) *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *QuestionUpsertBulk) SetType(v question.Type) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateType() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionUpsertBulk) SetTitle(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateTitle() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionUpsertBulk) SetDescription(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateDescription() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionUpsertBulk) ClearDescription() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPlaceholder sets the "placeholder" field.
+func (u *QuestionUpsertBulk) SetPlaceholder(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetPlaceholder(v)
+	})
+}
+
+// UpdatePlaceholder sets the "placeholder" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdatePlaceholder() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdatePlaceholder()
+	})
+}
+
+// ClearPlaceholder clears the value of the "placeholder" field.
+func (u *QuestionUpsertBulk) ClearPlaceholder() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearPlaceholder()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *QuestionUpsertBulk) SetRequired(v bool) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateRequired() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// SetSensitive sets the "sensitive" field.
+func (u *QuestionUpsertBulk) SetSensitive(v bool) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetSensitive(v)
+	})
+}
+
+// UpdateSensitive sets the "sensitive" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateSensitive() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateSensitive()
+	})
+}
+
+// SetOrder sets the "order" field.
+func (u *QuestionUpsertBulk) SetOrder(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetOrder(v)
+	})
+}
+
+// AddOrder adds v to the "order" field.
+func (u *QuestionUpsertBulk) AddOrder(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddOrder(v)
+	})
+}
+
+// UpdateOrder sets the "order" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateOrder() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateOrder()
+	})
+}
+
+// SetOptions sets the "options" field.
+func (u *QuestionUpsertBulk) SetOptions(v map[string]interface{}) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateOptions() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// ClearOptions clears the value of the "options" field.
+func (u *QuestionUpsertBulk) ClearOptions() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearOptions()
+	})
+}
+
+// SetValidation sets the "validation" field.
+func (u *QuestionUpsertBulk) SetValidation(v map[string]interface{}) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetValidation(v)
+	})
+}
+
+// UpdateValidation sets the "validation" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateValidation() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateValidation()
+	})
+}
+
+// ClearValidation clears the value of the "validation" field.
+func (u *QuestionUpsertBulk) ClearValidation() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearValidation()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionUpsertBulk) SetUpdatedAt(v time.Time) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateUpdatedAt() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -28,6 +29,7 @@ type QuestionQuery struct {
 	withForm    *FormQuery
 	withAnswers *AnswerQuery
 	withFKs     bool
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -429,6 +431,9 @@ func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(qq.modifiers) > 0 {
+		_spec.Modifiers = qq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -520,6 +525,9 @@ func (qq *QuestionQuery) loadAnswers(ctx context.Context, query *AnswerQuery, no
 
 func (qq *QuestionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := qq.querySpec()
+	if len(qq.modifiers) > 0 {
+		_spec.Modifiers = qq.modifiers
+	}
 	_spec.Node.Columns = qq.ctx.Fields
 	if len(qq.ctx.Fields) > 0 {
 		_spec.Unique = qq.ctx.Unique != nil && *qq.ctx.Unique
@@ -582,6 +590,9 @@ func (qq *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if qq.ctx.Unique != nil && *qq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range qq.modifiers {
+		m(selector)
+	}
 	for _, p := range qq.predicates {
 		p(selector)
 	}
@@ -599,6 +610,32 @@ func (qq *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qq *QuestionQuery) ForUpdate(opts ...sql.LockOption) *QuestionQuery {
+	if qq.driver.Dialect() == dialect.Postgres {
+		qq.Unique(false)
+	}
+	qq.modifiers = append(qq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qq *QuestionQuery) ForShare(opts ...sql.LockOption) *QuestionQuery {
+	if qq.driver.Dialect() == dialect.Postgres {
+		qq.Unique(false)
+	}
+	qq.modifiers = append(qq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qq
+}
+
 // QuestionGroupBy is the group-by builder for Question entities.
 type QuestionGroupBy struct {
 	selector
@@ -112,6 +112,20 @@ func (qu *QuestionUpdate) SetNillableRequired(b *bool) *QuestionUpdate {
 	return qu
 }
 
+// SetSensitive sets the "sensitive" field.
+func (qu *QuestionUpdate) SetSensitive(b bool) *QuestionUpdate {
+	qu.mutation.SetSensitive(b)
+	return qu
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableSensitive(b *bool) *QuestionUpdate {
+	if b != nil {
+		qu.SetSensitive(*b)
+	}
+	return qu
+}
+
 // SetOrder sets the "order" field.
 func (qu *QuestionUpdate) SetOrder(i int) *QuestionUpdate {
 	qu.mutation.ResetOrder()
@@ -313,6 +327,9 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := qu.mutation.Required(); ok {
 		_spec.SetField(question.FieldRequired, field.TypeBool, value)
 	}
+	if value, ok := qu.mutation.Sensitive(); ok {
+		_spec.SetField(question.FieldSensitive, field.TypeBool, value)
+	}
 	if value, ok := qu.mutation.Order(); ok {
 		_spec.SetField(question.FieldOrder, field.TypeInt, value)
 	}
@@ -510,6 +527,20 @@ func (quo *QuestionUpdateOne) SetNillableRequired(b *bool) *QuestionUpdateOne {
 	return quo
 }
 
+// SetSensitive sets the "sensitive" field.
+func (quo *QuestionUpdateOne) SetSensitive(b bool) *QuestionUpdateOne {
+	quo.mutation.SetSensitive(b)
+	return quo
+}
+
+// SetNillableSensitive sets the "sensitive" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableSensitive(b *bool) *QuestionUpdateOne {
+	if b != nil {
+		quo.SetSensitive(*b)
+	}
+	return quo
+}
+
 // SetOrder sets the "order" field.
 func (quo *QuestionUpdateOne) SetOrder(i int) *QuestionUpdateOne {
 	quo.mutation.ResetOrder()
@@ -741,6 +772,9 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 	if value, ok := quo.mutation.Required(); ok {
 		_spec.SetField(question.FieldRequired, field.TypeBool, value)
 	}
+	if value, ok := quo.mutation.Sensitive(); ok {
+		_spec.SetField(question.FieldSensitive, field.TypeBool, value)
+	}
 	if value, ok := quo.mutation.Order(); ok {
 		_spec.SetField(question.FieldOrder, field.TypeInt, value)
 	}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ReportSubscription is the model entity for the ReportSubscription schema.
+type ReportSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// How often the report is sent
+	Frequency reportsubscription.Frequency `json:"frequency,omitempty"`
+	// IANA timezone used to determine report periods
+	Timezone string `json:"timezone,omitempty"`
+	// User who receives the report
+	UserID int `json:"user_id,omitempty"`
+	// Form covered by the report, all of the user's forms if empty
+	FormID *int `json:"form_id,omitempty"`
+	// Start of the most recent period a report was sent for
+	LastPeriodStart *time.Time `json:"last_period_start,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReportSubscriptionQuery when eager-loading is set.
+	Edges        ReportSubscriptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReportSubscriptionEdges holds the relations/edges for other nodes in the graph.
+type ReportSubscriptionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Form holds the value of the form edge.
+	Form *Form `json:"form,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportSubscriptionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// FormOrErr returns the Form value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportSubscriptionEdges) FormOrErr() (*Form, error) {
+	if e.Form != nil {
+		return e.Form, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: form.Label}
+	}
+	return nil, &NotLoadedError{edge: "form"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReportSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reportsubscription.FieldID, reportsubscription.FieldUserID, reportsubscription.FieldFormID:
+			values[i] = new(sql.NullInt64)
+		case reportsubscription.FieldFrequency, reportsubscription.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case reportsubscription.FieldLastPeriodStart, reportsubscription.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReportSubscription fields.
+func (rs *ReportSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reportsubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rs.ID = int(value.Int64)
+		case reportsubscription.FieldFrequency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field frequency", values[i])
+			} else if value.Valid {
+				rs.Frequency = reportsubscription.Frequency(value.String)
+			}
+		case reportsubscription.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				rs.Timezone = value.String
+			}
+		case reportsubscription.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rs.UserID = int(value.Int64)
+			}
+		case reportsubscription.FieldFormID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field form_id", values[i])
+			} else if value.Valid {
+				rs.FormID = new(int)
+				*rs.FormID = int(value.Int64)
+			}
+		case reportsubscription.FieldLastPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_period_start", values[i])
+			} else if value.Valid {
+				rs.LastPeriodStart = new(time.Time)
+				*rs.LastPeriodStart = value.Time
+			}
+		case reportsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rs.CreatedAt = value.Time
+			}
+		default:
+			rs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReportSubscription.
+// This includes values selected through modifiers, order, etc.
+func (rs *ReportSubscription) Value(name string) (ent.Value, error) {
+	return rs.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ReportSubscription entity.
+func (rs *ReportSubscription) QueryUser() *UserQuery {
+	return NewReportSubscriptionClient(rs.config).QueryUser(rs)
+}
+
+// QueryForm queries the "form" edge of the ReportSubscription entity.
+func (rs *ReportSubscription) QueryForm() *FormQuery {
+	return NewReportSubscriptionClient(rs.config).QueryForm(rs)
+}
+
+// Update returns a builder for updating this ReportSubscription.
+// Note that you need to call ReportSubscription.Unwrap() before calling this method if this ReportSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rs *ReportSubscription) Update() *ReportSubscriptionUpdateOne {
+	return NewReportSubscriptionClient(rs.config).UpdateOne(rs)
+}
+
+// Unwrap unwraps the ReportSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rs *ReportSubscription) Unwrap() *ReportSubscription {
+	_tx, ok := rs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReportSubscription is not a transactional entity")
+	}
+	rs.config.driver = _tx.drv
+	return rs
+}
+
+// String implements the fmt.Stringer.
+func (rs *ReportSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReportSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rs.ID))
+	builder.WriteString("frequency=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Frequency))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(rs.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rs.UserID))
+	builder.WriteString(", ")
+	if v := rs.FormID; v != nil {
+		builder.WriteString("form_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := rs.LastPeriodStart; v != nil {
+		builder.WriteString("last_period_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rs.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReportSubscriptions is a parsable slice of ReportSubscription.
+type ReportSubscriptions []*ReportSubscription
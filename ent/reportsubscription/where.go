@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reportsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldTimezone, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// FormID applies equality check predicate on the "form_id" field. It's identical to FormIDEQ.
+func FormID(v int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldFormID, v))
+}
+
+// LastPeriodStart applies equality check predicate on the "last_period_start" field. It's identical to LastPeriodStartEQ.
+func LastPeriodStart(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldLastPeriodStart, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FrequencyEQ applies the EQ predicate on the "frequency" field.
+func FrequencyEQ(v Frequency) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldFrequency, v))
+}
+
+// FrequencyNEQ applies the NEQ predicate on the "frequency" field.
+func FrequencyNEQ(v Frequency) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNEQ(FieldFrequency, v))
+}
+
+// FrequencyIn applies the In predicate on the "frequency" field.
+func FrequencyIn(vs ...Frequency) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIn(FieldFrequency, vs...))
+}
+
+// FrequencyNotIn applies the NotIn predicate on the "frequency" field.
+func FrequencyNotIn(vs ...Frequency) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotIn(FieldFrequency, vs...))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// FormIDEQ applies the EQ predicate on the "form_id" field.
+func FormIDEQ(v int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldFormID, v))
+}
+
+// FormIDNEQ applies the NEQ predicate on the "form_id" field.
+func FormIDNEQ(v int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNEQ(FieldFormID, v))
+}
+
+// FormIDIn applies the In predicate on the "form_id" field.
+func FormIDIn(vs ...int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIn(FieldFormID, vs...))
+}
+
+// FormIDNotIn applies the NotIn predicate on the "form_id" field.
+func FormIDNotIn(vs ...int) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotIn(FieldFormID, vs...))
+}
+
+// FormIDIsNil applies the IsNil predicate on the "form_id" field.
+func FormIDIsNil() predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIsNull(FieldFormID))
+}
+
+// FormIDNotNil applies the NotNil predicate on the "form_id" field.
+func FormIDNotNil() predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotNull(FieldFormID))
+}
+
+// LastPeriodStartEQ applies the EQ predicate on the "last_period_start" field.
+func LastPeriodStartEQ(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldLastPeriodStart, v))
+}
+
+// LastPeriodStartNEQ applies the NEQ predicate on the "last_period_start" field.
+func LastPeriodStartNEQ(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNEQ(FieldLastPeriodStart, v))
+}
+
+// LastPeriodStartIn applies the In predicate on the "last_period_start" field.
+func LastPeriodStartIn(vs ...time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIn(FieldLastPeriodStart, vs...))
+}
+
+// LastPeriodStartNotIn applies the NotIn predicate on the "last_period_start" field.
+func LastPeriodStartNotIn(vs ...time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotIn(FieldLastPeriodStart, vs...))
+}
+
+// LastPeriodStartGT applies the GT predicate on the "last_period_start" field.
+func LastPeriodStartGT(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldGT(FieldLastPeriodStart, v))
+}
+
+// LastPeriodStartGTE applies the GTE predicate on the "last_period_start" field.
+func LastPeriodStartGTE(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldGTE(FieldLastPeriodStart, v))
+}
+
+// LastPeriodStartLT applies the LT predicate on the "last_period_start" field.
+func LastPeriodStartLT(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldLT(FieldLastPeriodStart, v))
+}
+
+// LastPeriodStartLTE applies the LTE predicate on the "last_period_start" field.
+func LastPeriodStartLTE(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldLTE(FieldLastPeriodStart, v))
+}
+
+// LastPeriodStartIsNil applies the IsNil predicate on the "last_period_start" field.
+func LastPeriodStartIsNil() predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIsNull(FieldLastPeriodStart))
+}
+
+// LastPeriodStartNotNil applies the NotNil predicate on the "last_period_start" field.
+func LastPeriodStartNotNil() predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotNull(FieldLastPeriodStart))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ReportSubscription {
+	return predicate.ReportSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ReportSubscription {
+	return predicate.ReportSubscription(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasForm applies the HasEdge predicate on the "form" edge.
+func HasForm() predicate.ReportSubscription {
+	return predicate.ReportSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, FormTable, FormColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFormWith applies the HasEdge predicate on the "form" edge with a given conditions (other predicates).
+func HasFormWith(preds ...predicate.Form) predicate.ReportSubscription {
+	return predicate.ReportSubscription(func(s *sql.Selector) {
+		step := newFormStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReportSubscription) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReportSubscription) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReportSubscription) predicate.ReportSubscription {
+	return predicate.ReportSubscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ReportSubscriptionCreate is the builder for creating a ReportSubscription entity.
+type ReportSubscriptionCreate struct {
+	config
+	mutation *ReportSubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetFrequency sets the "frequency" field.
+func (rsc *ReportSubscriptionCreate) SetFrequency(r reportsubscription.Frequency) *ReportSubscriptionCreate {
+	rsc.mutation.SetFrequency(r)
+	return rsc
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (rsc *ReportSubscriptionCreate) SetNillableFrequency(r *reportsubscription.Frequency) *ReportSubscriptionCreate {
+	if r != nil {
+		rsc.SetFrequency(*r)
+	}
+	return rsc
+}
+
+// SetTimezone sets the "timezone" field.
+func (rsc *ReportSubscriptionCreate) SetTimezone(s string) *ReportSubscriptionCreate {
+	rsc.mutation.SetTimezone(s)
+	return rsc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (rsc *ReportSubscriptionCreate) SetNillableTimezone(s *string) *ReportSubscriptionCreate {
+	if s != nil {
+		rsc.SetTimezone(*s)
+	}
+	return rsc
+}
+
+// SetUserID sets the "user_id" field.
+func (rsc *ReportSubscriptionCreate) SetUserID(i int) *ReportSubscriptionCreate {
+	rsc.mutation.SetUserID(i)
+	return rsc
+}
+
+// SetFormID sets the "form_id" field.
+func (rsc *ReportSubscriptionCreate) SetFormID(i int) *ReportSubscriptionCreate {
+	rsc.mutation.SetFormID(i)
+	return rsc
+}
+
+// SetNillableFormID sets the "form_id" field if the given value is not nil.
+func (rsc *ReportSubscriptionCreate) SetNillableFormID(i *int) *ReportSubscriptionCreate {
+	if i != nil {
+		rsc.SetFormID(*i)
+	}
+	return rsc
+}
+
+// SetLastPeriodStart sets the "last_period_start" field.
+func (rsc *ReportSubscriptionCreate) SetLastPeriodStart(t time.Time) *ReportSubscriptionCreate {
+	rsc.mutation.SetLastPeriodStart(t)
+	return rsc
+}
+
+// SetNillableLastPeriodStart sets the "last_period_start" field if the given value is not nil.
+func (rsc *ReportSubscriptionCreate) SetNillableLastPeriodStart(t *time.Time) *ReportSubscriptionCreate {
+	if t != nil {
+		rsc.SetLastPeriodStart(*t)
+	}
+	return rsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rsc *ReportSubscriptionCreate) SetCreatedAt(t time.Time) *ReportSubscriptionCreate {
+	rsc.mutation.SetCreatedAt(t)
+	return rsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rsc *ReportSubscriptionCreate) SetNillableCreatedAt(t *time.Time) *ReportSubscriptionCreate {
+	if t != nil {
+		rsc.SetCreatedAt(*t)
+	}
+	return rsc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rsc *ReportSubscriptionCreate) SetUser(u *User) *ReportSubscriptionCreate {
+	return rsc.SetUserID(u.ID)
+}
+
+// SetForm sets the "form" edge to the Form entity.
+func (rsc *ReportSubscriptionCreate) SetForm(f *Form) *ReportSubscriptionCreate {
+	return rsc.SetFormID(f.ID)
+}
+
+// Mutation returns the ReportSubscriptionMutation object of the builder.
+func (rsc *ReportSubscriptionCreate) Mutation() *ReportSubscriptionMutation {
+	return rsc.mutation
+}
+
+// Save creates the ReportSubscription in the database.
+func (rsc *ReportSubscriptionCreate) Save(ctx context.Context) (*ReportSubscription, error) {
+	rsc.defaults()
+	return withHooks(ctx, rsc.sqlSave, rsc.mutation, rsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rsc *ReportSubscriptionCreate) SaveX(ctx context.Context) *ReportSubscription {
+	v, err := rsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rsc *ReportSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := rsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsc *ReportSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := rsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsc *ReportSubscriptionCreate) defaults() {
+	if _, ok := rsc.mutation.Frequency(); !ok {
+		v := reportsubscription.DefaultFrequency
+		rsc.mutation.SetFrequency(v)
+	}
+	if _, ok := rsc.mutation.Timezone(); !ok {
+		v := reportsubscription.DefaultTimezone
+		rsc.mutation.SetTimezone(v)
+	}
+	if _, ok := rsc.mutation.CreatedAt(); !ok {
+		v := reportsubscription.DefaultCreatedAt()
+		rsc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsc *ReportSubscriptionCreate) check() error {
+	if _, ok := rsc.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "ReportSubscription.frequency"`)}
+	}
+	if v, ok := rsc.mutation.Frequency(); ok {
+		if err := reportsubscription.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "ReportSubscription.frequency": %w`, err)}
+		}
+	}
+	if _, ok := rsc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "ReportSubscription.timezone"`)}
+	}
+	if _, ok := rsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ReportSubscription.user_id"`)}
+	}
+	if _, ok := rsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReportSubscription.created_at"`)}
+	}
+	if len(rsc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ReportSubscription.user"`)}
+	}
+	return nil
+}
+
+func (rsc *ReportSubscriptionCreate) sqlSave(ctx context.Context) (*ReportSubscription, error) {
+	if err := rsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rsc.mutation.id = &_node.ID
+	rsc.mutation.done = true
+	return _node, nil
+}
+
+func (rsc *ReportSubscriptionCreate) createSpec() (*ReportSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReportSubscription{config: rsc.config}
+		_spec = sqlgraph.NewCreateSpec(reportsubscription.Table, sqlgraph.NewFieldSpec(reportsubscription.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rsc.conflict
+	if value, ok := rsc.mutation.Frequency(); ok {
+		_spec.SetField(reportsubscription.FieldFrequency, field.TypeEnum, value)
+		_node.Frequency = value
+	}
+	if value, ok := rsc.mutation.Timezone(); ok {
+		_spec.SetField(reportsubscription.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := rsc.mutation.LastPeriodStart(); ok {
+		_spec.SetField(reportsubscription.FieldLastPeriodStart, field.TypeTime, value)
+		_node.LastPeriodStart = &value
+	}
+	if value, ok := rsc.mutation.CreatedAt(); ok {
+		_spec.SetField(reportsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rsc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.UserTable,
+			Columns: []string{reportsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.FormIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.FormTable,
+			Columns: []string{reportsubscription.FormColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(form.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FormID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReportSubscription.Create().
+//		SetFrequency(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportSubscriptionUpsert) {
+//			SetFrequency(v+v).
+//		}).
+//		Exec(ctx)
+func (rsc *ReportSubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *ReportSubscriptionUpsertOne {
+	rsc.conflict = opts
+	return &ReportSubscriptionUpsertOne{
+		create: rsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReportSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rsc *ReportSubscriptionCreate) OnConflictColumns(columns ...string) *ReportSubscriptionUpsertOne {
+	rsc.conflict = append(rsc.conflict, sql.ConflictColumns(columns...))
+	return &ReportSubscriptionUpsertOne{
+		create: rsc,
+	}
+}
+
+type (
+	// ReportSubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one ReportSubscription node.
+	ReportSubscriptionUpsertOne struct {
+		create *ReportSubscriptionCreate
+	}
+
+	// ReportSubscriptionUpsert is the "OnConflict" setter.
+	ReportSubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetFrequency sets the "frequency" field.
+func (u *ReportSubscriptionUpsert) SetFrequency(v reportsubscription.Frequency) *ReportSubscriptionUpsert {
+	u.Set(reportsubscription.FieldFrequency, v)
+	return u
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsert) UpdateFrequency() *ReportSubscriptionUpsert {
+	u.SetExcluded(reportsubscription.FieldFrequency)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ReportSubscriptionUpsert) SetTimezone(v string) *ReportSubscriptionUpsert {
+	u.Set(reportsubscription.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsert) UpdateTimezone() *ReportSubscriptionUpsert {
+	u.SetExcluded(reportsubscription.FieldTimezone)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportSubscriptionUpsert) SetUserID(v int) *ReportSubscriptionUpsert {
+	u.Set(reportsubscription.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsert) UpdateUserID() *ReportSubscriptionUpsert {
+	u.SetExcluded(reportsubscription.FieldUserID)
+	return u
+}
+
+// SetFormID sets the "form_id" field.
+func (u *ReportSubscriptionUpsert) SetFormID(v int) *ReportSubscriptionUpsert {
+	u.Set(reportsubscription.FieldFormID, v)
+	return u
+}
+
+// UpdateFormID sets the "form_id" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsert) UpdateFormID() *ReportSubscriptionUpsert {
+	u.SetExcluded(reportsubscription.FieldFormID)
+	return u
+}
+
+// ClearFormID clears the value of the "form_id" field.
+func (u *ReportSubscriptionUpsert) ClearFormID() *ReportSubscriptionUpsert {
+	u.SetNull(reportsubscription.FieldFormID)
+	return u
+}
+
+// SetLastPeriodStart sets the "last_period_start" field.
+func (u *ReportSubscriptionUpsert) SetLastPeriodStart(v time.Time) *ReportSubscriptionUpsert {
+	u.Set(reportsubscription.FieldLastPeriodStart, v)
+	return u
+}
+
+// UpdateLastPeriodStart sets the "last_period_start" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsert) UpdateLastPeriodStart() *ReportSubscriptionUpsert {
+	u.SetExcluded(reportsubscription.FieldLastPeriodStart)
+	return u
+}
+
+// ClearLastPeriodStart clears the value of the "last_period_start" field.
+func (u *ReportSubscriptionUpsert) ClearLastPeriodStart() *ReportSubscriptionUpsert {
+	u.SetNull(reportsubscription.FieldLastPeriodStart)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ReportSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReportSubscriptionUpsertOne) UpdateNewValues() *ReportSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reportsubscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReportSubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReportSubscriptionUpsertOne) Ignore() *ReportSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportSubscriptionUpsertOne) DoNothing() *ReportSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportSubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *ReportSubscriptionUpsertOne) Update(set func(*ReportSubscriptionUpsert)) *ReportSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *ReportSubscriptionUpsertOne) SetFrequency(v reportsubscription.Frequency) *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertOne) UpdateFrequency() *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ReportSubscriptionUpsertOne) SetTimezone(v string) *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertOne) UpdateTimezone() *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportSubscriptionUpsertOne) SetUserID(v int) *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertOne) UpdateUserID() *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetFormID sets the "form_id" field.
+func (u *ReportSubscriptionUpsertOne) SetFormID(v int) *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetFormID(v)
+	})
+}
+
+// UpdateFormID sets the "form_id" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertOne) UpdateFormID() *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateFormID()
+	})
+}
+
+// ClearFormID clears the value of the "form_id" field.
+func (u *ReportSubscriptionUpsertOne) ClearFormID() *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.ClearFormID()
+	})
+}
+
+// SetLastPeriodStart sets the "last_period_start" field.
+func (u *ReportSubscriptionUpsertOne) SetLastPeriodStart(v time.Time) *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetLastPeriodStart(v)
+	})
+}
+
+// UpdateLastPeriodStart sets the "last_period_start" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertOne) UpdateLastPeriodStart() *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateLastPeriodStart()
+	})
+}
+
+// ClearLastPeriodStart clears the value of the "last_period_start" field.
+func (u *ReportSubscriptionUpsertOne) ClearLastPeriodStart() *ReportSubscriptionUpsertOne {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.ClearLastPeriodStart()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportSubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportSubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportSubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReportSubscriptionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReportSubscriptionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReportSubscriptionCreateBulk is the builder for creating many ReportSubscription entities in bulk.
+type ReportSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*ReportSubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReportSubscription entities in the database.
+func (rscb *ReportSubscriptionCreateBulk) Save(ctx context.Context) ([]*ReportSubscription, error) {
+	if rscb.err != nil {
+		return nil, rscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rscb.builders))
+	nodes := make([]*ReportSubscription, len(rscb.builders))
+	mutators := make([]Mutator, len(rscb.builders))
+	for i := range rscb.builders {
+		func(i int, root context.Context) {
+			builder := rscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rscb *ReportSubscriptionCreateBulk) SaveX(ctx context.Context) []*ReportSubscription {
+	v, err := rscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rscb *ReportSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rscb *ReportSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := rscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReportSubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportSubscriptionUpsert) {
+//			SetFrequency(v+v).
+//		}).
+//		Exec(ctx)
+func (rscb *ReportSubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReportSubscriptionUpsertBulk {
+	rscb.conflict = opts
+	return &ReportSubscriptionUpsertBulk{
+		create: rscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReportSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rscb *ReportSubscriptionCreateBulk) OnConflictColumns(columns ...string) *ReportSubscriptionUpsertBulk {
+	rscb.conflict = append(rscb.conflict, sql.ConflictColumns(columns...))
+	return &ReportSubscriptionUpsertBulk{
+		create: rscb,
+	}
+}
+
+// ReportSubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReportSubscription nodes.
+type ReportSubscriptionUpsertBulk struct {
+	create *ReportSubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReportSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReportSubscriptionUpsertBulk) UpdateNewValues() *ReportSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reportsubscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReportSubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReportSubscriptionUpsertBulk) Ignore() *ReportSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportSubscriptionUpsertBulk) DoNothing() *ReportSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportSubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReportSubscriptionUpsertBulk) Update(set func(*ReportSubscriptionUpsert)) *ReportSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *ReportSubscriptionUpsertBulk) SetFrequency(v reportsubscription.Frequency) *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertBulk) UpdateFrequency() *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ReportSubscriptionUpsertBulk) SetTimezone(v string) *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertBulk) UpdateTimezone() *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportSubscriptionUpsertBulk) SetUserID(v int) *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertBulk) UpdateUserID() *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetFormID sets the "form_id" field.
+func (u *ReportSubscriptionUpsertBulk) SetFormID(v int) *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetFormID(v)
+	})
+}
+
+// UpdateFormID sets the "form_id" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertBulk) UpdateFormID() *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateFormID()
+	})
+}
+
+// ClearFormID clears the value of the "form_id" field.
+func (u *ReportSubscriptionUpsertBulk) ClearFormID() *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.ClearFormID()
+	})
+}
+
+// SetLastPeriodStart sets the "last_period_start" field.
+func (u *ReportSubscriptionUpsertBulk) SetLastPeriodStart(v time.Time) *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.SetLastPeriodStart(v)
+	})
+}
+
+// UpdateLastPeriodStart sets the "last_period_start" field to the value that was provided on create.
+func (u *ReportSubscriptionUpsertBulk) UpdateLastPeriodStart() *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.UpdateLastPeriodStart()
+	})
+}
+
+// ClearLastPeriodStart clears the value of the "last_period_start" field.
+func (u *ReportSubscriptionUpsertBulk) ClearLastPeriodStart() *ReportSubscriptionUpsertBulk {
+	return u.Update(func(s *ReportSubscriptionUpsert) {
+		s.ClearLastPeriodStart()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportSubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReportSubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportSubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportSubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/reportsubscription"
+)
+
+// ReportSubscriptionDelete is the builder for deleting a ReportSubscription entity.
+type ReportSubscriptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReportSubscriptionMutation
+}
+
+// Where appends a list predicates to the ReportSubscriptionDelete builder.
+func (rsd *ReportSubscriptionDelete) Where(ps ...predicate.ReportSubscription) *ReportSubscriptionDelete {
+	rsd.mutation.Where(ps...)
+	return rsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rsd *ReportSubscriptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsd.sqlExec, rsd.mutation, rsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsd *ReportSubscriptionDelete) ExecX(ctx context.Context) int {
+	n, err := rsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rsd *ReportSubscriptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(reportsubscription.Table, sqlgraph.NewFieldSpec(reportsubscription.FieldID, field.TypeInt))
+	if ps := rsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rsd.mutation.done = true
+	return affected, err
+}
+
+// ReportSubscriptionDeleteOne is the builder for deleting a single ReportSubscription entity.
+type ReportSubscriptionDeleteOne struct {
+	rsd *ReportSubscriptionDelete
+}
+
+// Where appends a list predicates to the ReportSubscriptionDelete builder.
+func (rsdo *ReportSubscriptionDeleteOne) Where(ps ...predicate.ReportSubscription) *ReportSubscriptionDeleteOne {
+	rsdo.rsd.mutation.Where(ps...)
+	return rsdo
+}
+
+// Exec executes the deletion query.
+func (rsdo *ReportSubscriptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := rsdo.rsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reportsubscription.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsdo *ReportSubscriptionDeleteOne) ExecX(ctx context.Context) {
+	if err := rsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
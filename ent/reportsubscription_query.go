@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ReportSubscriptionQuery is the builder for querying ReportSubscription entities.
+type ReportSubscriptionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []reportsubscription.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ReportSubscription
+	withUser   *UserQuery
+	withForm   *FormQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReportSubscriptionQuery builder.
+func (rsq *ReportSubscriptionQuery) Where(ps ...predicate.ReportSubscription) *ReportSubscriptionQuery {
+	rsq.predicates = append(rsq.predicates, ps...)
+	return rsq
+}
+
+// Limit the number of records to be returned by this query.
+func (rsq *ReportSubscriptionQuery) Limit(limit int) *ReportSubscriptionQuery {
+	rsq.ctx.Limit = &limit
+	return rsq
+}
+
+// Offset to start from.
+func (rsq *ReportSubscriptionQuery) Offset(offset int) *ReportSubscriptionQuery {
+	rsq.ctx.Offset = &offset
+	return rsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rsq *ReportSubscriptionQuery) Unique(unique bool) *ReportSubscriptionQuery {
+	rsq.ctx.Unique = &unique
+	return rsq
+}
+
+// Order specifies how the records should be ordered.
+func (rsq *ReportSubscriptionQuery) Order(o ...reportsubscription.OrderOption) *ReportSubscriptionQuery {
+	rsq.order = append(rsq.order, o...)
+	return rsq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (rsq *ReportSubscriptionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: rsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportsubscription.Table, reportsubscription.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, reportsubscription.UserTable, reportsubscription.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryForm chains the current query on the "form" edge.
+func (rsq *ReportSubscriptionQuery) QueryForm() *FormQuery {
+	query := (&FormClient{config: rsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportsubscription.Table, reportsubscription.FieldID, selector),
+			sqlgraph.To(form.Table, form.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, reportsubscription.FormTable, reportsubscription.FormColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReportSubscription entity from the query.
+// Returns a *NotFoundError when no ReportSubscription was found.
+func (rsq *ReportSubscriptionQuery) First(ctx context.Context) (*ReportSubscription, error) {
+	nodes, err := rsq.Limit(1).All(setContextOp(ctx, rsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reportsubscription.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rsq *ReportSubscriptionQuery) FirstX(ctx context.Context) *ReportSubscription {
+	node, err := rsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReportSubscription ID from the query.
+// Returns a *NotFoundError when no ReportSubscription ID was found.
+func (rsq *ReportSubscriptionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rsq.Limit(1).IDs(setContextOp(ctx, rsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reportsubscription.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rsq *ReportSubscriptionQuery) FirstIDX(ctx context.Context) int {
+	id, err := rsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReportSubscription entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReportSubscription entity is found.
+// Returns a *NotFoundError when no ReportSubscription entities are found.
+func (rsq *ReportSubscriptionQuery) Only(ctx context.Context) (*ReportSubscription, error) {
+	nodes, err := rsq.Limit(2).All(setContextOp(ctx, rsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reportsubscription.Label}
+	default:
+		return nil, &NotSingularError{reportsubscription.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rsq *ReportSubscriptionQuery) OnlyX(ctx context.Context) *ReportSubscription {
+	node, err := rsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReportSubscription ID in the query.
+// Returns a *NotSingularError when more than one ReportSubscription ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rsq *ReportSubscriptionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rsq.Limit(2).IDs(setContextOp(ctx, rsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reportsubscription.Label}
+	default:
+		err = &NotSingularError{reportsubscription.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rsq *ReportSubscriptionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReportSubscriptions.
+func (rsq *ReportSubscriptionQuery) All(ctx context.Context) ([]*ReportSubscription, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryAll)
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReportSubscription, *ReportSubscriptionQuery]()
+	return withInterceptors[[]*ReportSubscription](ctx, rsq, qr, rsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rsq *ReportSubscriptionQuery) AllX(ctx context.Context) []*ReportSubscription {
+	nodes, err := rsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReportSubscription IDs.
+func (rsq *ReportSubscriptionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rsq.ctx.Unique == nil && rsq.path != nil {
+		rsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryIDs)
+	if err = rsq.Select(reportsubscription.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rsq *ReportSubscriptionQuery) IDsX(ctx context.Context) []int {
+	ids, err := rsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rsq *ReportSubscriptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryCount)
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rsq, querierCount[*ReportSubscriptionQuery](), rsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rsq *ReportSubscriptionQuery) CountX(ctx context.Context) int {
+	count, err := rsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rsq *ReportSubscriptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryExist)
+	switch _, err := rsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rsq *ReportSubscriptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := rsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReportSubscriptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rsq *ReportSubscriptionQuery) Clone() *ReportSubscriptionQuery {
+	if rsq == nil {
+		return nil
+	}
+	return &ReportSubscriptionQuery{
+		config:     rsq.config,
+		ctx:        rsq.ctx.Clone(),
+		order:      append([]reportsubscription.OrderOption{}, rsq.order...),
+		inters:     append([]Interceptor{}, rsq.inters...),
+		predicates: append([]predicate.ReportSubscription{}, rsq.predicates...),
+		withUser:   rsq.withUser.Clone(),
+		withForm:   rsq.withForm.Clone(),
+		// clone intermediate query.
+		sql:  rsq.sql.Clone(),
+		path: rsq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (rsq *ReportSubscriptionQuery) WithUser(opts ...func(*UserQuery)) *ReportSubscriptionQuery {
+	query := (&UserClient{config: rsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withUser = query
+	return rsq
+}
+
+// WithForm tells the query-builder to eager-load the nodes that are connected to
+// the "form" edge. The optional arguments are used to configure the query builder of the edge.
+func (rsq *ReportSubscriptionQuery) WithForm(opts ...func(*FormQuery)) *ReportSubscriptionQuery {
+	query := (&FormClient{config: rsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withForm = query
+	return rsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Frequency reportsubscription.Frequency `json:"frequency,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReportSubscription.Query().
+//		GroupBy(reportsubscription.FieldFrequency).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rsq *ReportSubscriptionQuery) GroupBy(field string, fields ...string) *ReportSubscriptionGroupBy {
+	rsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReportSubscriptionGroupBy{build: rsq}
+	grbuild.flds = &rsq.ctx.Fields
+	grbuild.label = reportsubscription.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Frequency reportsubscription.Frequency `json:"frequency,omitempty"`
+//	}
+//
+//	client.ReportSubscription.Query().
+//		Select(reportsubscription.FieldFrequency).
+//		Scan(ctx, &v)
+func (rsq *ReportSubscriptionQuery) Select(fields ...string) *ReportSubscriptionSelect {
+	rsq.ctx.Fields = append(rsq.ctx.Fields, fields...)
+	sbuild := &ReportSubscriptionSelect{ReportSubscriptionQuery: rsq}
+	sbuild.label = reportsubscription.Label
+	sbuild.flds, sbuild.scan = &rsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReportSubscriptionSelect configured with the given aggregations.
+func (rsq *ReportSubscriptionQuery) Aggregate(fns ...AggregateFunc) *ReportSubscriptionSelect {
+	return rsq.Select().Aggregate(fns...)
+}
+
+func (rsq *ReportSubscriptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rsq.ctx.Fields {
+		if !reportsubscription.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rsq.path != nil {
+		prev, err := rsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rsq.sql = prev
+	}
+	return nil
+}
+
+func (rsq *ReportSubscriptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReportSubscription, error) {
+	var (
+		nodes       = []*ReportSubscription{}
+		_spec       = rsq.querySpec()
+		loadedTypes = [2]bool{
+			rsq.withUser != nil,
+			rsq.withForm != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReportSubscription).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReportSubscription{config: rsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rsq.modifiers) > 0 {
+		_spec.Modifiers = rsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rsq.withUser; query != nil {
+		if err := rsq.loadUser(ctx, query, nodes, nil,
+			func(n *ReportSubscription, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rsq.withForm; query != nil {
+		if err := rsq.loadForm(ctx, query, nodes, nil,
+			func(n *ReportSubscription, e *Form) { n.Edges.Form = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rsq *ReportSubscriptionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ReportSubscription, init func(*ReportSubscription), assign func(*ReportSubscription, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ReportSubscription)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rsq *ReportSubscriptionQuery) loadForm(ctx context.Context, query *FormQuery, nodes []*ReportSubscription, init func(*ReportSubscription), assign func(*ReportSubscription, *Form)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ReportSubscription)
+	for i := range nodes {
+		if nodes[i].FormID == nil {
+			continue
+		}
+		fk := *nodes[i].FormID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(form.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "form_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rsq *ReportSubscriptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rsq.querySpec()
+	if len(rsq.modifiers) > 0 {
+		_spec.Modifiers = rsq.modifiers
+	}
+	_spec.Node.Columns = rsq.ctx.Fields
+	if len(rsq.ctx.Fields) > 0 {
+		_spec.Unique = rsq.ctx.Unique != nil && *rsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rsq.driver, _spec)
+}
+
+func (rsq *ReportSubscriptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(reportsubscription.Table, reportsubscription.Columns, sqlgraph.NewFieldSpec(reportsubscription.FieldID, field.TypeInt))
+	_spec.From = rsq.sql
+	if unique := rsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reportsubscription.FieldID)
+		for i := range fields {
+			if fields[i] != reportsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rsq.withUser != nil {
+			_spec.Node.AddColumnOnce(reportsubscription.FieldUserID)
+		}
+		if rsq.withForm != nil {
+			_spec.Node.AddColumnOnce(reportsubscription.FieldFormID)
+		}
+	}
+	if ps := rsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rsq *ReportSubscriptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rsq.driver.Dialect())
+	t1 := builder.Table(reportsubscription.Table)
+	columns := rsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = reportsubscription.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rsq.sql != nil {
+		selector = rsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rsq.ctx.Unique != nil && *rsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rsq.modifiers {
+		m(selector)
+	}
+	for _, p := range rsq.predicates {
+		p(selector)
+	}
+	for _, p := range rsq.order {
+		p(selector)
+	}
+	if offset := rsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rsq *ReportSubscriptionQuery) ForUpdate(opts ...sql.LockOption) *ReportSubscriptionQuery {
+	if rsq.driver.Dialect() == dialect.Postgres {
+		rsq.Unique(false)
+	}
+	rsq.modifiers = append(rsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rsq *ReportSubscriptionQuery) ForShare(opts ...sql.LockOption) *ReportSubscriptionQuery {
+	if rsq.driver.Dialect() == dialect.Postgres {
+		rsq.Unique(false)
+	}
+	rsq.modifiers = append(rsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rsq
+}
+
+// ReportSubscriptionGroupBy is the group-by builder for ReportSubscription entities.
+type ReportSubscriptionGroupBy struct {
+	selector
+	build *ReportSubscriptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rsgb *ReportSubscriptionGroupBy) Aggregate(fns ...AggregateFunc) *ReportSubscriptionGroupBy {
+	rsgb.fns = append(rsgb.fns, fns...)
+	return rsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rsgb *ReportSubscriptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReportSubscriptionQuery, *ReportSubscriptionGroupBy](ctx, rsgb.build, rsgb, rsgb.build.inters, v)
+}
+
+func (rsgb *ReportSubscriptionGroupBy) sqlScan(ctx context.Context, root *ReportSubscriptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rsgb.fns))
+	for _, fn := range rsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rsgb.flds)+len(rsgb.fns))
+		for _, f := range *rsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReportSubscriptionSelect is the builder for selecting fields of ReportSubscription entities.
+type ReportSubscriptionSelect struct {
+	*ReportSubscriptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rss *ReportSubscriptionSelect) Aggregate(fns ...AggregateFunc) *ReportSubscriptionSelect {
+	rss.fns = append(rss.fns, fns...)
+	return rss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rss *ReportSubscriptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rss.ctx, ent.OpQuerySelect)
+	if err := rss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReportSubscriptionQuery, *ReportSubscriptionSelect](ctx, rss.ReportSubscriptionQuery, rss, rss.inters, v)
+}
+
+func (rss *ReportSubscriptionSelect) sqlScan(ctx context.Context, root *ReportSubscriptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rss.fns))
+	for _, fn := range rss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ReportSubscriptionUpdate is the builder for updating ReportSubscription entities.
+type ReportSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReportSubscriptionMutation
+}
+
+// Where appends a list predicates to the ReportSubscriptionUpdate builder.
+func (rsu *ReportSubscriptionUpdate) Where(ps ...predicate.ReportSubscription) *ReportSubscriptionUpdate {
+	rsu.mutation.Where(ps...)
+	return rsu
+}
+
+// SetFrequency sets the "frequency" field.
+func (rsu *ReportSubscriptionUpdate) SetFrequency(r reportsubscription.Frequency) *ReportSubscriptionUpdate {
+	rsu.mutation.SetFrequency(r)
+	return rsu
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (rsu *ReportSubscriptionUpdate) SetNillableFrequency(r *reportsubscription.Frequency) *ReportSubscriptionUpdate {
+	if r != nil {
+		rsu.SetFrequency(*r)
+	}
+	return rsu
+}
+
+// SetTimezone sets the "timezone" field.
+func (rsu *ReportSubscriptionUpdate) SetTimezone(s string) *ReportSubscriptionUpdate {
+	rsu.mutation.SetTimezone(s)
+	return rsu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (rsu *ReportSubscriptionUpdate) SetNillableTimezone(s *string) *ReportSubscriptionUpdate {
+	if s != nil {
+		rsu.SetTimezone(*s)
+	}
+	return rsu
+}
+
+// SetUserID sets the "user_id" field.
+func (rsu *ReportSubscriptionUpdate) SetUserID(i int) *ReportSubscriptionUpdate {
+	rsu.mutation.SetUserID(i)
+	return rsu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rsu *ReportSubscriptionUpdate) SetNillableUserID(i *int) *ReportSubscriptionUpdate {
+	if i != nil {
+		rsu.SetUserID(*i)
+	}
+	return rsu
+}
+
+// SetFormID sets the "form_id" field.
+func (rsu *ReportSubscriptionUpdate) SetFormID(i int) *ReportSubscriptionUpdate {
+	rsu.mutation.SetFormID(i)
+	return rsu
+}
+
+// SetNillableFormID sets the "form_id" field if the given value is not nil.
+func (rsu *ReportSubscriptionUpdate) SetNillableFormID(i *int) *ReportSubscriptionUpdate {
+	if i != nil {
+		rsu.SetFormID(*i)
+	}
+	return rsu
+}
+
+// ClearFormID clears the value of the "form_id" field.
+func (rsu *ReportSubscriptionUpdate) ClearFormID() *ReportSubscriptionUpdate {
+	rsu.mutation.ClearFormID()
+	return rsu
+}
+
+// SetLastPeriodStart sets the "last_period_start" field.
+func (rsu *ReportSubscriptionUpdate) SetLastPeriodStart(t time.Time) *ReportSubscriptionUpdate {
+	rsu.mutation.SetLastPeriodStart(t)
+	return rsu
+}
+
+// SetNillableLastPeriodStart sets the "last_period_start" field if the given value is not nil.
+func (rsu *ReportSubscriptionUpdate) SetNillableLastPeriodStart(t *time.Time) *ReportSubscriptionUpdate {
+	if t != nil {
+		rsu.SetLastPeriodStart(*t)
+	}
+	return rsu
+}
+
+// ClearLastPeriodStart clears the value of the "last_period_start" field.
+func (rsu *ReportSubscriptionUpdate) ClearLastPeriodStart() *ReportSubscriptionUpdate {
+	rsu.mutation.ClearLastPeriodStart()
+	return rsu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rsu *ReportSubscriptionUpdate) SetUser(u *User) *ReportSubscriptionUpdate {
+	return rsu.SetUserID(u.ID)
+}
+
+// SetForm sets the "form" edge to the Form entity.
+func (rsu *ReportSubscriptionUpdate) SetForm(f *Form) *ReportSubscriptionUpdate {
+	return rsu.SetFormID(f.ID)
+}
+
+// Mutation returns the ReportSubscriptionMutation object of the builder.
+func (rsu *ReportSubscriptionUpdate) Mutation() *ReportSubscriptionMutation {
+	return rsu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rsu *ReportSubscriptionUpdate) ClearUser() *ReportSubscriptionUpdate {
+	rsu.mutation.ClearUser()
+	return rsu
+}
+
+// ClearForm clears the "form" edge to the Form entity.
+func (rsu *ReportSubscriptionUpdate) ClearForm() *ReportSubscriptionUpdate {
+	rsu.mutation.ClearForm()
+	return rsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rsu *ReportSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsu.sqlSave, rsu.mutation, rsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsu *ReportSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := rsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rsu *ReportSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := rsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsu *ReportSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := rsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsu *ReportSubscriptionUpdate) check() error {
+	if v, ok := rsu.mutation.Frequency(); ok {
+		if err := reportsubscription.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "ReportSubscription.frequency": %w`, err)}
+		}
+	}
+	if rsu.mutation.UserCleared() && len(rsu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReportSubscription.user"`)
+	}
+	return nil
+}
+
+func (rsu *ReportSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reportsubscription.Table, reportsubscription.Columns, sqlgraph.NewFieldSpec(reportsubscription.FieldID, field.TypeInt))
+	if ps := rsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsu.mutation.Frequency(); ok {
+		_spec.SetField(reportsubscription.FieldFrequency, field.TypeEnum, value)
+	}
+	if value, ok := rsu.mutation.Timezone(); ok {
+		_spec.SetField(reportsubscription.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := rsu.mutation.LastPeriodStart(); ok {
+		_spec.SetField(reportsubscription.FieldLastPeriodStart, field.TypeTime, value)
+	}
+	if rsu.mutation.LastPeriodStartCleared() {
+		_spec.ClearField(reportsubscription.FieldLastPeriodStart, field.TypeTime)
+	}
+	if rsu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.UserTable,
+			Columns: []string{reportsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.UserTable,
+			Columns: []string{reportsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsu.mutation.FormCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.FormTable,
+			Columns: []string{reportsubscription.FormColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(form.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.FormIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.FormTable,
+			Columns: []string{reportsubscription.FormColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(form.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reportsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rsu.mutation.done = true
+	return n, nil
+}
+
+// ReportSubscriptionUpdateOne is the builder for updating a single ReportSubscription entity.
+type ReportSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReportSubscriptionMutation
+}
+
+// SetFrequency sets the "frequency" field.
+func (rsuo *ReportSubscriptionUpdateOne) SetFrequency(r reportsubscription.Frequency) *ReportSubscriptionUpdateOne {
+	rsuo.mutation.SetFrequency(r)
+	return rsuo
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (rsuo *ReportSubscriptionUpdateOne) SetNillableFrequency(r *reportsubscription.Frequency) *ReportSubscriptionUpdateOne {
+	if r != nil {
+		rsuo.SetFrequency(*r)
+	}
+	return rsuo
+}
+
+// SetTimezone sets the "timezone" field.
+func (rsuo *ReportSubscriptionUpdateOne) SetTimezone(s string) *ReportSubscriptionUpdateOne {
+	rsuo.mutation.SetTimezone(s)
+	return rsuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (rsuo *ReportSubscriptionUpdateOne) SetNillableTimezone(s *string) *ReportSubscriptionUpdateOne {
+	if s != nil {
+		rsuo.SetTimezone(*s)
+	}
+	return rsuo
+}
+
+// SetUserID sets the "user_id" field.
+func (rsuo *ReportSubscriptionUpdateOne) SetUserID(i int) *ReportSubscriptionUpdateOne {
+	rsuo.mutation.SetUserID(i)
+	return rsuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rsuo *ReportSubscriptionUpdateOne) SetNillableUserID(i *int) *ReportSubscriptionUpdateOne {
+	if i != nil {
+		rsuo.SetUserID(*i)
+	}
+	return rsuo
+}
+
+// SetFormID sets the "form_id" field.
+func (rsuo *ReportSubscriptionUpdateOne) SetFormID(i int) *ReportSubscriptionUpdateOne {
+	rsuo.mutation.SetFormID(i)
+	return rsuo
+}
+
+// SetNillableFormID sets the "form_id" field if the given value is not nil.
+func (rsuo *ReportSubscriptionUpdateOne) SetNillableFormID(i *int) *ReportSubscriptionUpdateOne {
+	if i != nil {
+		rsuo.SetFormID(*i)
+	}
+	return rsuo
+}
+
+// ClearFormID clears the value of the "form_id" field.
+func (rsuo *ReportSubscriptionUpdateOne) ClearFormID() *ReportSubscriptionUpdateOne {
+	rsuo.mutation.ClearFormID()
+	return rsuo
+}
+
+// SetLastPeriodStart sets the "last_period_start" field.
+func (rsuo *ReportSubscriptionUpdateOne) SetLastPeriodStart(t time.Time) *ReportSubscriptionUpdateOne {
+	rsuo.mutation.SetLastPeriodStart(t)
+	return rsuo
+}
+
+// SetNillableLastPeriodStart sets the "last_period_start" field if the given value is not nil.
+func (rsuo *ReportSubscriptionUpdateOne) SetNillableLastPeriodStart(t *time.Time) *ReportSubscriptionUpdateOne {
+	if t != nil {
+		rsuo.SetLastPeriodStart(*t)
+	}
+	return rsuo
+}
+
+// ClearLastPeriodStart clears the value of the "last_period_start" field.
+func (rsuo *ReportSubscriptionUpdateOne) ClearLastPeriodStart() *ReportSubscriptionUpdateOne {
+	rsuo.mutation.ClearLastPeriodStart()
+	return rsuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rsuo *ReportSubscriptionUpdateOne) SetUser(u *User) *ReportSubscriptionUpdateOne {
+	return rsuo.SetUserID(u.ID)
+}
+
+// SetForm sets the "form" edge to the Form entity.
+func (rsuo *ReportSubscriptionUpdateOne) SetForm(f *Form) *ReportSubscriptionUpdateOne {
+	return rsuo.SetFormID(f.ID)
+}
+
+// Mutation returns the ReportSubscriptionMutation object of the builder.
+func (rsuo *ReportSubscriptionUpdateOne) Mutation() *ReportSubscriptionMutation {
+	return rsuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rsuo *ReportSubscriptionUpdateOne) ClearUser() *ReportSubscriptionUpdateOne {
+	rsuo.mutation.ClearUser()
+	return rsuo
+}
+
+// ClearForm clears the "form" edge to the Form entity.
+func (rsuo *ReportSubscriptionUpdateOne) ClearForm() *ReportSubscriptionUpdateOne {
+	rsuo.mutation.ClearForm()
+	return rsuo
+}
+
+// Where appends a list predicates to the ReportSubscriptionUpdate builder.
+func (rsuo *ReportSubscriptionUpdateOne) Where(ps ...predicate.ReportSubscription) *ReportSubscriptionUpdateOne {
+	rsuo.mutation.Where(ps...)
+	return rsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rsuo *ReportSubscriptionUpdateOne) Select(field string, fields ...string) *ReportSubscriptionUpdateOne {
+	rsuo.fields = append([]string{field}, fields...)
+	return rsuo
+}
+
+// Save executes the query and returns the updated ReportSubscription entity.
+func (rsuo *ReportSubscriptionUpdateOne) Save(ctx context.Context) (*ReportSubscription, error) {
+	return withHooks(ctx, rsuo.sqlSave, rsuo.mutation, rsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsuo *ReportSubscriptionUpdateOne) SaveX(ctx context.Context) *ReportSubscription {
+	node, err := rsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rsuo *ReportSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsuo *ReportSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := rsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsuo *ReportSubscriptionUpdateOne) check() error {
+	if v, ok := rsuo.mutation.Frequency(); ok {
+		if err := reportsubscription.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "ReportSubscription.frequency": %w`, err)}
+		}
+	}
+	if rsuo.mutation.UserCleared() && len(rsuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReportSubscription.user"`)
+	}
+	return nil
+}
+
+func (rsuo *ReportSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *ReportSubscription, err error) {
+	if err := rsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reportsubscription.Table, reportsubscription.Columns, sqlgraph.NewFieldSpec(reportsubscription.FieldID, field.TypeInt))
+	id, ok := rsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReportSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reportsubscription.FieldID)
+		for _, f := range fields {
+			if !reportsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reportsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsuo.mutation.Frequency(); ok {
+		_spec.SetField(reportsubscription.FieldFrequency, field.TypeEnum, value)
+	}
+	if value, ok := rsuo.mutation.Timezone(); ok {
+		_spec.SetField(reportsubscription.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := rsuo.mutation.LastPeriodStart(); ok {
+		_spec.SetField(reportsubscription.FieldLastPeriodStart, field.TypeTime, value)
+	}
+	if rsuo.mutation.LastPeriodStartCleared() {
+		_spec.ClearField(reportsubscription.FieldLastPeriodStart, field.TypeTime)
+	}
+	if rsuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.UserTable,
+			Columns: []string{reportsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.UserTable,
+			Columns: []string{reportsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsuo.mutation.FormCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.FormTable,
+			Columns: []string{reportsubscription.FormColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(form.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.FormIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reportsubscription.FormTable,
+			Columns: []string{reportsubscription.FormColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(form.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReportSubscription{config: rsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reportsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rsuo.mutation.done = true
+	return _node, nil
+}
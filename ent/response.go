@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/response"
 	"github.com/occult/pagode/ent/user"
 )
@@ -27,6 +28,8 @@ type Response struct {
 	IPAddress string `json:"ip_address"`
 	// UserAgent holds the value of the "UserAgent" field.
 	UserAgent string `json:"user_agent"`
+	// Status holds the value of the "status" field.
+	Status response.Status `json:"status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ResponseQuery when eager-loading is set.
 	Edges          ResponseEdges `json:"edges"`
@@ -43,9 +46,17 @@ type ResponseEdges struct {
 	User *User `json:"user,omitempty"`
 	// Answers holds the value of the answers edge.
 	Answers []*Answer `json:"answers,omitempty"`
+	// Notes holds the value of the notes edge.
+	Notes []*ResponseNote `json:"notes,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*ResponseTag `json:"tags,omitempty"`
+	// Activities holds the value of the activities edge.
+	Activities []*ResponseActivity `json:"activities,omitempty"`
+	// Payment holds the value of the payment edge.
+	Payment *PaymentIntent `json:"payment,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [7]bool
 }
 
 // FormOrErr returns the Form value or an error if the edge
@@ -79,6 +90,44 @@ func (e ResponseEdges) AnswersOrErr() ([]*Answer, error) {
 	return nil, &NotLoadedError{edge: "answers"}
 }
 
+// NotesOrErr returns the Notes value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResponseEdges) NotesOrErr() ([]*ResponseNote, error) {
+	if e.loadedTypes[3] {
+		return e.Notes, nil
+	}
+	return nil, &NotLoadedError{edge: "notes"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResponseEdges) TagsOrErr() ([]*ResponseTag, error) {
+	if e.loadedTypes[4] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// ActivitiesOrErr returns the Activities value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResponseEdges) ActivitiesOrErr() ([]*ResponseActivity, error) {
+	if e.loadedTypes[5] {
+		return e.Activities, nil
+	}
+	return nil, &NotLoadedError{edge: "activities"}
+}
+
+// PaymentOrErr returns the Payment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResponseEdges) PaymentOrErr() (*PaymentIntent, error) {
+	if e.Payment != nil {
+		return e.Payment, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: paymentintent.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Response) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -88,7 +137,7 @@ func (*Response) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case response.FieldID:
 			values[i] = new(sql.NullInt64)
-		case response.FieldIPAddress, response.FieldUserAgent:
+		case response.FieldIPAddress, response.FieldUserAgent, response.FieldStatus:
 			values[i] = new(sql.NullString)
 		case response.FieldSubmittedAt:
 			values[i] = new(sql.NullTime)
@@ -141,6 +190,12 @@ func (r *Response) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.UserAgent = value.String
 			}
+		case response.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = response.Status(value.String)
+			}
 		case response.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field form_responses", value)
@@ -183,6 +238,26 @@ func (r *Response) QueryAnswers() *AnswerQuery {
 	return NewResponseClient(r.config).QueryAnswers(r)
 }
 
+// QueryNotes queries the "notes" edge of the Response entity.
+func (r *Response) QueryNotes() *ResponseNoteQuery {
+	return NewResponseClient(r.config).QueryNotes(r)
+}
+
+// QueryTags queries the "tags" edge of the Response entity.
+func (r *Response) QueryTags() *ResponseTagQuery {
+	return NewResponseClient(r.config).QueryTags(r)
+}
+
+// QueryActivities queries the "activities" edge of the Response entity.
+func (r *Response) QueryActivities() *ResponseActivityQuery {
+	return NewResponseClient(r.config).QueryActivities(r)
+}
+
+// QueryPayment queries the "payment" edge of the Response entity.
+func (r *Response) QueryPayment() *PaymentIntentQuery {
+	return NewResponseClient(r.config).QueryPayment(r)
+}
+
 // Update returns a builder for updating this Response.
 // Note that you need to call Response.Unwrap() before calling this method if this Response
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -217,6 +292,9 @@ func (r *Response) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("UserAgent=")
 	builder.WriteString(r.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -8,11 +8,16 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/answer"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -21,6 +26,7 @@ type ResponseCreate struct {
 	config
 	mutation *ResponseMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetSubmittedAt sets the "submitted_at" field.
@@ -79,6 +85,20 @@ func (rc *ResponseCreate) SetNillableUserAgent(s *string) *ResponseCreate {
 	return rc
 }
 
+// SetStatus sets the "status" field.
+func (rc *ResponseCreate) SetStatus(r response.Status) *ResponseCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ResponseCreate) SetNillableStatus(r *response.Status) *ResponseCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
 // SetFormID sets the "form" edge to the Form entity by ID.
 func (rc *ResponseCreate) SetFormID(id int) *ResponseCreate {
 	rc.mutation.SetFormID(id)
@@ -124,6 +144,70 @@ func (rc *ResponseCreate) AddAnswers(a ...*Answer) *ResponseCreate {
 	return rc.AddAnswerIDs(ids...)
 }
 
+// AddNoteIDs adds the "notes" edge to the ResponseNote entity by IDs.
+func (rc *ResponseCreate) AddNoteIDs(ids ...int) *ResponseCreate {
+	rc.mutation.AddNoteIDs(ids...)
+	return rc
+}
+
+// AddNotes adds the "notes" edges to the ResponseNote entity.
+func (rc *ResponseCreate) AddNotes(r ...*ResponseNote) *ResponseCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddNoteIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the ResponseTag entity by IDs.
+func (rc *ResponseCreate) AddTagIDs(ids ...int) *ResponseCreate {
+	rc.mutation.AddTagIDs(ids...)
+	return rc
+}
+
+// AddTags adds the "tags" edges to the ResponseTag entity.
+func (rc *ResponseCreate) AddTags(r ...*ResponseTag) *ResponseCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddTagIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the ResponseActivity entity by IDs.
+func (rc *ResponseCreate) AddActivityIDs(ids ...int) *ResponseCreate {
+	rc.mutation.AddActivityIDs(ids...)
+	return rc
+}
+
+// AddActivities adds the "activities" edges to the ResponseActivity entity.
+func (rc *ResponseCreate) AddActivities(r ...*ResponseActivity) *ResponseCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddActivityIDs(ids...)
+}
+
+// SetPaymentID sets the "payment" edge to the PaymentIntent entity by ID.
+func (rc *ResponseCreate) SetPaymentID(id int) *ResponseCreate {
+	rc.mutation.SetPaymentID(id)
+	return rc
+}
+
+// SetNillablePaymentID sets the "payment" edge to the PaymentIntent entity by ID if the given value is not nil.
+func (rc *ResponseCreate) SetNillablePaymentID(id *int) *ResponseCreate {
+	if id != nil {
+		rc = rc.SetPaymentID(*id)
+	}
+	return rc
+}
+
+// SetPayment sets the "payment" edge to the PaymentIntent entity.
+func (rc *ResponseCreate) SetPayment(p *PaymentIntent) *ResponseCreate {
+	return rc.SetPaymentID(p.ID)
+}
+
 // Mutation returns the ResponseMutation object of the builder.
 func (rc *ResponseCreate) Mutation() *ResponseMutation {
 	return rc.mutation
@@ -167,6 +251,10 @@ func (rc *ResponseCreate) defaults() {
 		v := response.DefaultCompleted
 		rc.mutation.SetCompleted(v)
 	}
+	if _, ok := rc.mutation.Status(); !ok {
+		v := response.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -177,6 +265,14 @@ func (rc *ResponseCreate) check() error {
 	if _, ok := rc.mutation.Completed(); !ok {
 		return &ValidationError{Name: "completed", err: errors.New(`ent: missing required field "Response.completed"`)}
 	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Response.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := response.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Response.status": %w`, err)}
+		}
+	}
 	if len(rc.mutation.FormIDs()) == 0 {
 		return &ValidationError{Name: "form", err: errors.New(`ent: missing required edge "Response.form"`)}
 	}
@@ -206,6 +302,7 @@ func (rc *ResponseCreate) createSpec() (*Response, *sqlgraph.CreateSpec) {
 		_node = &Response{config: rc.config}
 		_spec = sqlgraph.NewCreateSpec(response.Table, sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = rc.conflict
 	if value, ok := rc.mutation.SubmittedAt(); ok {
 		_spec.SetField(response.FieldSubmittedAt, field.TypeTime, value)
 		_node.SubmittedAt = value
@@ -222,6 +319,10 @@ func (rc *ResponseCreate) createSpec() (*Response, *sqlgraph.CreateSpec) {
 		_spec.SetField(response.FieldUserAgent, field.TypeString, value)
 		_node.UserAgent = value
 	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(response.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
 	if nodes := rc.mutation.FormIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -272,14 +373,336 @@ func (rc *ResponseCreate) createSpec() (*Response, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.NotesTable,
+			Columns: []string{response.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   response.TagsTable,
+			Columns: response.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.ActivitiesTable,
+			Columns: []string{response.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   response.PaymentTable,
+			Columns: []string{response.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentintent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Response.Create().
+//		SetSubmittedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseUpsert) {
+//			SetSubmittedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ResponseCreate) OnConflict(opts ...sql.ConflictOption) *ResponseUpsertOne {
+	rc.conflict = opts
+	return &ResponseUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Response.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ResponseCreate) OnConflictColumns(columns ...string) *ResponseUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ResponseUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ResponseUpsertOne is the builder for "upsert"-ing
+	//  one Response node.
+	ResponseUpsertOne struct {
+		create *ResponseCreate
+	}
+
+	// ResponseUpsert is the "OnConflict" setter.
+	ResponseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCompleted sets the "completed" field.
+func (u *ResponseUpsert) SetCompleted(v bool) *ResponseUpsert {
+	u.Set(response.FieldCompleted, v)
+	return u
+}
+
+// UpdateCompleted sets the "completed" field to the value that was provided on create.
+func (u *ResponseUpsert) UpdateCompleted() *ResponseUpsert {
+	u.SetExcluded(response.FieldCompleted)
+	return u
+}
+
+// SetIPAddress sets the "IPAddress" field.
+func (u *ResponseUpsert) SetIPAddress(v string) *ResponseUpsert {
+	u.Set(response.FieldIPAddress, v)
+	return u
+}
+
+// UpdateIPAddress sets the "IPAddress" field to the value that was provided on create.
+func (u *ResponseUpsert) UpdateIPAddress() *ResponseUpsert {
+	u.SetExcluded(response.FieldIPAddress)
+	return u
+}
+
+// ClearIPAddress clears the value of the "IPAddress" field.
+func (u *ResponseUpsert) ClearIPAddress() *ResponseUpsert {
+	u.SetNull(response.FieldIPAddress)
+	return u
+}
+
+// SetUserAgent sets the "UserAgent" field.
+func (u *ResponseUpsert) SetUserAgent(v string) *ResponseUpsert {
+	u.Set(response.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "UserAgent" field to the value that was provided on create.
+func (u *ResponseUpsert) UpdateUserAgent() *ResponseUpsert {
+	u.SetExcluded(response.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "UserAgent" field.
+func (u *ResponseUpsert) ClearUserAgent() *ResponseUpsert {
+	u.SetNull(response.FieldUserAgent)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ResponseUpsert) SetStatus(v response.Status) *ResponseUpsert {
+	u.Set(response.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ResponseUpsert) UpdateStatus() *ResponseUpsert {
+	u.SetExcluded(response.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Response.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseUpsertOne) UpdateNewValues() *ResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.SubmittedAt(); exists {
+			s.SetIgnore(response.FieldSubmittedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Response.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResponseUpsertOne) Ignore() *ResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseUpsertOne) DoNothing() *ResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseCreate.OnConflict
+// documentation for more info.
+func (u *ResponseUpsertOne) Update(set func(*ResponseUpsert)) *ResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCompleted sets the "completed" field.
+func (u *ResponseUpsertOne) SetCompleted(v bool) *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.SetCompleted(v)
+	})
+}
+
+// UpdateCompleted sets the "completed" field to the value that was provided on create.
+func (u *ResponseUpsertOne) UpdateCompleted() *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.UpdateCompleted()
+	})
+}
+
+// SetIPAddress sets the "IPAddress" field.
+func (u *ResponseUpsertOne) SetIPAddress(v string) *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "IPAddress" field to the value that was provided on create.
+func (u *ResponseUpsertOne) UpdateIPAddress() *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "IPAddress" field.
+func (u *ResponseUpsertOne) ClearIPAddress() *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// SetUserAgent sets the "UserAgent" field.
+func (u *ResponseUpsertOne) SetUserAgent(v string) *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "UserAgent" field to the value that was provided on create.
+func (u *ResponseUpsertOne) UpdateUserAgent() *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "UserAgent" field.
+func (u *ResponseUpsertOne) ClearUserAgent() *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ResponseUpsertOne) SetStatus(v response.Status) *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ResponseUpsertOne) UpdateStatus() *ResponseUpsertOne {
+	return u.Update(func(s *ResponseUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResponseUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResponseUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ResponseCreateBulk is the builder for creating many Response entities in bulk.
 type ResponseCreateBulk struct {
 	config
 	err      error
 	builders []*ResponseCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Response entities in the database.
@@ -309,6 +732,7 @@ func (rcb *ResponseCreateBulk) Save(ctx context.Context) ([]*Response, error) {
 					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -362,3 +786,184 @@ func (rcb *ResponseCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Response.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseUpsert) {
+//			SetSubmittedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ResponseCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResponseUpsertBulk {
+	rcb.conflict = opts
+	return &ResponseUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Response.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ResponseCreateBulk) OnConflictColumns(columns ...string) *ResponseUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ResponseUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ResponseUpsertBulk is the builder for "upsert"-ing
+// a bulk of Response nodes.
+type ResponseUpsertBulk struct {
+	create *ResponseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Response.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseUpsertBulk) UpdateNewValues() *ResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.SubmittedAt(); exists {
+				s.SetIgnore(response.FieldSubmittedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Response.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResponseUpsertBulk) Ignore() *ResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseUpsertBulk) DoNothing() *ResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResponseUpsertBulk) Update(set func(*ResponseUpsert)) *ResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCompleted sets the "completed" field.
+func (u *ResponseUpsertBulk) SetCompleted(v bool) *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.SetCompleted(v)
+	})
+}
+
+// UpdateCompleted sets the "completed" field to the value that was provided on create.
+func (u *ResponseUpsertBulk) UpdateCompleted() *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.UpdateCompleted()
+	})
+}
+
+// SetIPAddress sets the "IPAddress" field.
+func (u *ResponseUpsertBulk) SetIPAddress(v string) *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "IPAddress" field to the value that was provided on create.
+func (u *ResponseUpsertBulk) UpdateIPAddress() *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "IPAddress" field.
+func (u *ResponseUpsertBulk) ClearIPAddress() *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// SetUserAgent sets the "UserAgent" field.
+func (u *ResponseUpsertBulk) SetUserAgent(v string) *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "UserAgent" field to the value that was provided on create.
+func (u *ResponseUpsertBulk) UpdateUserAgent() *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "UserAgent" field.
+func (u *ResponseUpsertBulk) ClearUserAgent() *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ResponseUpsertBulk) SetStatus(v response.Status) *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ResponseUpsertBulk) UpdateStatus() *ResponseUpsertBulk {
+	return u.Update(func(s *ResponseUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ResponseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
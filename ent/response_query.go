@@ -9,27 +9,37 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/answer"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/predicate"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
 	"github.com/occult/pagode/ent/user"
 )
 
 // ResponseQuery is the builder for querying Response entities.
 type ResponseQuery struct {
 	config
-	ctx         *QueryContext
-	order       []response.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Response
-	withForm    *FormQuery
-	withUser    *UserQuery
-	withAnswers *AnswerQuery
-	withFKs     bool
+	ctx            *QueryContext
+	order          []response.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.Response
+	withForm       *FormQuery
+	withUser       *UserQuery
+	withAnswers    *AnswerQuery
+	withNotes      *ResponseNoteQuery
+	withTags       *ResponseTagQuery
+	withActivities *ResponseActivityQuery
+	withPayment    *PaymentIntentQuery
+	withFKs        bool
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +142,94 @@ func (rq *ResponseQuery) QueryAnswers() *AnswerQuery {
 	return query
 }
 
+// QueryNotes chains the current query on the "notes" edge.
+func (rq *ResponseQuery) QueryNotes() *ResponseNoteQuery {
+	query := (&ResponseNoteClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, selector),
+			sqlgraph.To(responsenote.Table, responsenote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, response.NotesTable, response.NotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (rq *ResponseQuery) QueryTags() *ResponseTagQuery {
+	query := (&ResponseTagClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, selector),
+			sqlgraph.To(responsetag.Table, responsetag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, response.TagsTable, response.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActivities chains the current query on the "activities" edge.
+func (rq *ResponseQuery) QueryActivities() *ResponseActivityQuery {
+	query := (&ResponseActivityClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, selector),
+			sqlgraph.To(responseactivity.Table, responseactivity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, response.ActivitiesTable, response.ActivitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPayment chains the current query on the "payment" edge.
+func (rq *ResponseQuery) QueryPayment() *PaymentIntentQuery {
+	query := (&PaymentIntentClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(response.Table, response.FieldID, selector),
+			sqlgraph.To(paymentintent.Table, paymentintent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, response.PaymentTable, response.PaymentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Response entity from the query.
 // Returns a *NotFoundError when no Response was found.
 func (rq *ResponseQuery) First(ctx context.Context) (*Response, error) {
@@ -319,14 +417,18 @@ func (rq *ResponseQuery) Clone() *ResponseQuery {
 		return nil
 	}
 	return &ResponseQuery{
-		config:      rq.config,
-		ctx:         rq.ctx.Clone(),
-		order:       append([]response.OrderOption{}, rq.order...),
-		inters:      append([]Interceptor{}, rq.inters...),
-		predicates:  append([]predicate.Response{}, rq.predicates...),
-		withForm:    rq.withForm.Clone(),
-		withUser:    rq.withUser.Clone(),
-		withAnswers: rq.withAnswers.Clone(),
+		config:         rq.config,
+		ctx:            rq.ctx.Clone(),
+		order:          append([]response.OrderOption{}, rq.order...),
+		inters:         append([]Interceptor{}, rq.inters...),
+		predicates:     append([]predicate.Response{}, rq.predicates...),
+		withForm:       rq.withForm.Clone(),
+		withUser:       rq.withUser.Clone(),
+		withAnswers:    rq.withAnswers.Clone(),
+		withNotes:      rq.withNotes.Clone(),
+		withTags:       rq.withTags.Clone(),
+		withActivities: rq.withActivities.Clone(),
+		withPayment:    rq.withPayment.Clone(),
 		// clone intermediate query.
 		sql:  rq.sql.Clone(),
 		path: rq.path,
@@ -366,6 +468,50 @@ func (rq *ResponseQuery) WithAnswers(opts ...func(*AnswerQuery)) *ResponseQuery
 	return rq
 }
 
+// WithNotes tells the query-builder to eager-load the nodes that are connected to
+// the "notes" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResponseQuery) WithNotes(opts ...func(*ResponseNoteQuery)) *ResponseQuery {
+	query := (&ResponseNoteClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withNotes = query
+	return rq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResponseQuery) WithTags(opts ...func(*ResponseTagQuery)) *ResponseQuery {
+	query := (&ResponseTagClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withTags = query
+	return rq
+}
+
+// WithActivities tells the query-builder to eager-load the nodes that are connected to
+// the "activities" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResponseQuery) WithActivities(opts ...func(*ResponseActivityQuery)) *ResponseQuery {
+	query := (&ResponseActivityClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withActivities = query
+	return rq
+}
+
+// WithPayment tells the query-builder to eager-load the nodes that are connected to
+// the "payment" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResponseQuery) WithPayment(opts ...func(*PaymentIntentQuery)) *ResponseQuery {
+	query := (&PaymentIntentClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withPayment = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -445,10 +591,14 @@ func (rq *ResponseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Res
 		nodes       = []*Response{}
 		withFKs     = rq.withFKs
 		_spec       = rq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [7]bool{
 			rq.withForm != nil,
 			rq.withUser != nil,
 			rq.withAnswers != nil,
+			rq.withNotes != nil,
+			rq.withTags != nil,
+			rq.withActivities != nil,
+			rq.withPayment != nil,
 		}
 	)
 	if rq.withForm != nil || rq.withUser != nil {
@@ -466,6 +616,9 @@ func (rq *ResponseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Res
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -494,6 +647,33 @@ func (rq *ResponseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Res
 			return nil, err
 		}
 	}
+	if query := rq.withNotes; query != nil {
+		if err := rq.loadNotes(ctx, query, nodes,
+			func(n *Response) { n.Edges.Notes = []*ResponseNote{} },
+			func(n *Response, e *ResponseNote) { n.Edges.Notes = append(n.Edges.Notes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withTags; query != nil {
+		if err := rq.loadTags(ctx, query, nodes,
+			func(n *Response) { n.Edges.Tags = []*ResponseTag{} },
+			func(n *Response, e *ResponseTag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withActivities; query != nil {
+		if err := rq.loadActivities(ctx, query, nodes,
+			func(n *Response) { n.Edges.Activities = []*ResponseActivity{} },
+			func(n *Response, e *ResponseActivity) { n.Edges.Activities = append(n.Edges.Activities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withPayment; query != nil {
+		if err := rq.loadPayment(ctx, query, nodes, nil,
+			func(n *Response, e *PaymentIntent) { n.Edges.Payment = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -592,9 +772,163 @@ func (rq *ResponseQuery) loadAnswers(ctx context.Context, query *AnswerQuery, no
 	}
 	return nil
 }
+func (rq *ResponseQuery) loadNotes(ctx context.Context, query *ResponseNoteQuery, nodes []*Response, init func(*Response), assign func(*Response, *ResponseNote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Response)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ResponseNote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(response.NotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.response_notes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "response_notes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "response_notes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *ResponseQuery) loadTags(ctx context.Context, query *ResponseTagQuery, nodes []*Response, init func(*Response), assign func(*Response, *ResponseTag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Response)
+	nids := make(map[int]map[*Response]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(response.TagsTable)
+		s.Join(joinT).On(s.C(responsetag.FieldID), joinT.C(response.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(response.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(response.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Response]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*ResponseTag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (rq *ResponseQuery) loadActivities(ctx context.Context, query *ResponseActivityQuery, nodes []*Response, init func(*Response), assign func(*Response, *ResponseActivity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Response)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ResponseActivity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(response.ActivitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.response_activities
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "response_activities" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "response_activities" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *ResponseQuery) loadPayment(ctx context.Context, query *PaymentIntentQuery, nodes []*Response, init func(*Response), assign func(*Response, *PaymentIntent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Response)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.PaymentIntent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(response.PaymentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.response_payment
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "response_payment" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "response_payment" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (rq *ResponseQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
 	_spec.Node.Columns = rq.ctx.Fields
 	if len(rq.ctx.Fields) > 0 {
 		_spec.Unique = rq.ctx.Unique != nil && *rq.ctx.Unique
@@ -657,6 +991,9 @@ func (rq *ResponseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if rq.ctx.Unique != nil && *rq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range rq.modifiers {
+		m(selector)
+	}
 	for _, p := range rq.predicates {
 		p(selector)
 	}
@@ -674,6 +1011,32 @@ func (rq *ResponseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rq *ResponseQuery) ForUpdate(opts ...sql.LockOption) *ResponseQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rq *ResponseQuery) ForShare(opts ...sql.LockOption) *ResponseQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rq
+}
+
 // ResponseGroupBy is the group-by builder for Response entities.
 type ResponseGroupBy struct {
 	selector
@@ -12,8 +12,12 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/answer"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/predicate"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -84,6 +88,20 @@ func (ru *ResponseUpdate) ClearUserAgent() *ResponseUpdate {
 	return ru
 }
 
+// SetStatus sets the "status" field.
+func (ru *ResponseUpdate) SetStatus(r response.Status) *ResponseUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ResponseUpdate) SetNillableStatus(r *response.Status) *ResponseUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
 // SetFormID sets the "form" edge to the Form entity by ID.
 func (ru *ResponseUpdate) SetFormID(id int) *ResponseUpdate {
 	ru.mutation.SetFormID(id)
@@ -129,6 +147,70 @@ func (ru *ResponseUpdate) AddAnswers(a ...*Answer) *ResponseUpdate {
 	return ru.AddAnswerIDs(ids...)
 }
 
+// AddNoteIDs adds the "notes" edge to the ResponseNote entity by IDs.
+func (ru *ResponseUpdate) AddNoteIDs(ids ...int) *ResponseUpdate {
+	ru.mutation.AddNoteIDs(ids...)
+	return ru
+}
+
+// AddNotes adds the "notes" edges to the ResponseNote entity.
+func (ru *ResponseUpdate) AddNotes(r ...*ResponseNote) *ResponseUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddNoteIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the ResponseTag entity by IDs.
+func (ru *ResponseUpdate) AddTagIDs(ids ...int) *ResponseUpdate {
+	ru.mutation.AddTagIDs(ids...)
+	return ru
+}
+
+// AddTags adds the "tags" edges to the ResponseTag entity.
+func (ru *ResponseUpdate) AddTags(r ...*ResponseTag) *ResponseUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddTagIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the ResponseActivity entity by IDs.
+func (ru *ResponseUpdate) AddActivityIDs(ids ...int) *ResponseUpdate {
+	ru.mutation.AddActivityIDs(ids...)
+	return ru
+}
+
+// AddActivities adds the "activities" edges to the ResponseActivity entity.
+func (ru *ResponseUpdate) AddActivities(r ...*ResponseActivity) *ResponseUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddActivityIDs(ids...)
+}
+
+// SetPaymentID sets the "payment" edge to the PaymentIntent entity by ID.
+func (ru *ResponseUpdate) SetPaymentID(id int) *ResponseUpdate {
+	ru.mutation.SetPaymentID(id)
+	return ru
+}
+
+// SetNillablePaymentID sets the "payment" edge to the PaymentIntent entity by ID if the given value is not nil.
+func (ru *ResponseUpdate) SetNillablePaymentID(id *int) *ResponseUpdate {
+	if id != nil {
+		ru = ru.SetPaymentID(*id)
+	}
+	return ru
+}
+
+// SetPayment sets the "payment" edge to the PaymentIntent entity.
+func (ru *ResponseUpdate) SetPayment(p *PaymentIntent) *ResponseUpdate {
+	return ru.SetPaymentID(p.ID)
+}
+
 // Mutation returns the ResponseMutation object of the builder.
 func (ru *ResponseUpdate) Mutation() *ResponseMutation {
 	return ru.mutation
@@ -167,6 +249,75 @@ func (ru *ResponseUpdate) RemoveAnswers(a ...*Answer) *ResponseUpdate {
 	return ru.RemoveAnswerIDs(ids...)
 }
 
+// ClearNotes clears all "notes" edges to the ResponseNote entity.
+func (ru *ResponseUpdate) ClearNotes() *ResponseUpdate {
+	ru.mutation.ClearNotes()
+	return ru
+}
+
+// RemoveNoteIDs removes the "notes" edge to ResponseNote entities by IDs.
+func (ru *ResponseUpdate) RemoveNoteIDs(ids ...int) *ResponseUpdate {
+	ru.mutation.RemoveNoteIDs(ids...)
+	return ru
+}
+
+// RemoveNotes removes "notes" edges to ResponseNote entities.
+func (ru *ResponseUpdate) RemoveNotes(r ...*ResponseNote) *ResponseUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveNoteIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the ResponseTag entity.
+func (ru *ResponseUpdate) ClearTags() *ResponseUpdate {
+	ru.mutation.ClearTags()
+	return ru
+}
+
+// RemoveTagIDs removes the "tags" edge to ResponseTag entities by IDs.
+func (ru *ResponseUpdate) RemoveTagIDs(ids ...int) *ResponseUpdate {
+	ru.mutation.RemoveTagIDs(ids...)
+	return ru
+}
+
+// RemoveTags removes "tags" edges to ResponseTag entities.
+func (ru *ResponseUpdate) RemoveTags(r ...*ResponseTag) *ResponseUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveTagIDs(ids...)
+}
+
+// ClearActivities clears all "activities" edges to the ResponseActivity entity.
+func (ru *ResponseUpdate) ClearActivities() *ResponseUpdate {
+	ru.mutation.ClearActivities()
+	return ru
+}
+
+// RemoveActivityIDs removes the "activities" edge to ResponseActivity entities by IDs.
+func (ru *ResponseUpdate) RemoveActivityIDs(ids ...int) *ResponseUpdate {
+	ru.mutation.RemoveActivityIDs(ids...)
+	return ru
+}
+
+// RemoveActivities removes "activities" edges to ResponseActivity entities.
+func (ru *ResponseUpdate) RemoveActivities(r ...*ResponseActivity) *ResponseUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveActivityIDs(ids...)
+}
+
+// ClearPayment clears the "payment" edge to the PaymentIntent entity.
+func (ru *ResponseUpdate) ClearPayment() *ResponseUpdate {
+	ru.mutation.ClearPayment()
+	return ru
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *ResponseUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
@@ -196,6 +347,11 @@ func (ru *ResponseUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ru *ResponseUpdate) check() error {
+	if v, ok := ru.mutation.Status(); ok {
+		if err := response.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Response.status": %w`, err)}
+		}
+	}
 	if ru.mutation.FormCleared() && len(ru.mutation.FormIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Response.form"`)
 	}
@@ -229,6 +385,9 @@ func (ru *ResponseUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ru.mutation.UserAgentCleared() {
 		_spec.ClearField(response.FieldUserAgent, field.TypeString)
 	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(response.FieldStatus, field.TypeEnum, value)
+	}
 	if ru.mutation.FormCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -332,6 +491,170 @@ func (ru *ResponseUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.NotesTable,
+			Columns: []string{response.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedNotesIDs(); len(nodes) > 0 && !ru.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.NotesTable,
+			Columns: []string{response.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.NotesTable,
+			Columns: []string{response.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   response.TagsTable,
+			Columns: response.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedTagsIDs(); len(nodes) > 0 && !ru.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   response.TagsTable,
+			Columns: response.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   response.TagsTable,
+			Columns: response.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.ActivitiesTable,
+			Columns: []string{response.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !ru.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.ActivitiesTable,
+			Columns: []string{response.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.ActivitiesTable,
+			Columns: []string{response.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   response.PaymentTable,
+			Columns: []string{response.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentintent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   response.PaymentTable,
+			Columns: []string{response.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentintent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{response.Label}
@@ -406,6 +729,20 @@ func (ruo *ResponseUpdateOne) ClearUserAgent() *ResponseUpdateOne {
 	return ruo
 }
 
+// SetStatus sets the "status" field.
+func (ruo *ResponseUpdateOne) SetStatus(r response.Status) *ResponseUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ResponseUpdateOne) SetNillableStatus(r *response.Status) *ResponseUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
 // SetFormID sets the "form" edge to the Form entity by ID.
 func (ruo *ResponseUpdateOne) SetFormID(id int) *ResponseUpdateOne {
 	ruo.mutation.SetFormID(id)
@@ -451,6 +788,70 @@ func (ruo *ResponseUpdateOne) AddAnswers(a ...*Answer) *ResponseUpdateOne {
 	return ruo.AddAnswerIDs(ids...)
 }
 
+// AddNoteIDs adds the "notes" edge to the ResponseNote entity by IDs.
+func (ruo *ResponseUpdateOne) AddNoteIDs(ids ...int) *ResponseUpdateOne {
+	ruo.mutation.AddNoteIDs(ids...)
+	return ruo
+}
+
+// AddNotes adds the "notes" edges to the ResponseNote entity.
+func (ruo *ResponseUpdateOne) AddNotes(r ...*ResponseNote) *ResponseUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddNoteIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the ResponseTag entity by IDs.
+func (ruo *ResponseUpdateOne) AddTagIDs(ids ...int) *ResponseUpdateOne {
+	ruo.mutation.AddTagIDs(ids...)
+	return ruo
+}
+
+// AddTags adds the "tags" edges to the ResponseTag entity.
+func (ruo *ResponseUpdateOne) AddTags(r ...*ResponseTag) *ResponseUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddTagIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the ResponseActivity entity by IDs.
+func (ruo *ResponseUpdateOne) AddActivityIDs(ids ...int) *ResponseUpdateOne {
+	ruo.mutation.AddActivityIDs(ids...)
+	return ruo
+}
+
+// AddActivities adds the "activities" edges to the ResponseActivity entity.
+func (ruo *ResponseUpdateOne) AddActivities(r ...*ResponseActivity) *ResponseUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddActivityIDs(ids...)
+}
+
+// SetPaymentID sets the "payment" edge to the PaymentIntent entity by ID.
+func (ruo *ResponseUpdateOne) SetPaymentID(id int) *ResponseUpdateOne {
+	ruo.mutation.SetPaymentID(id)
+	return ruo
+}
+
+// SetNillablePaymentID sets the "payment" edge to the PaymentIntent entity by ID if the given value is not nil.
+func (ruo *ResponseUpdateOne) SetNillablePaymentID(id *int) *ResponseUpdateOne {
+	if id != nil {
+		ruo = ruo.SetPaymentID(*id)
+	}
+	return ruo
+}
+
+// SetPayment sets the "payment" edge to the PaymentIntent entity.
+func (ruo *ResponseUpdateOne) SetPayment(p *PaymentIntent) *ResponseUpdateOne {
+	return ruo.SetPaymentID(p.ID)
+}
+
 // Mutation returns the ResponseMutation object of the builder.
 func (ruo *ResponseUpdateOne) Mutation() *ResponseMutation {
 	return ruo.mutation
@@ -489,6 +890,75 @@ func (ruo *ResponseUpdateOne) RemoveAnswers(a ...*Answer) *ResponseUpdateOne {
 	return ruo.RemoveAnswerIDs(ids...)
 }
 
+// ClearNotes clears all "notes" edges to the ResponseNote entity.
+func (ruo *ResponseUpdateOne) ClearNotes() *ResponseUpdateOne {
+	ruo.mutation.ClearNotes()
+	return ruo
+}
+
+// RemoveNoteIDs removes the "notes" edge to ResponseNote entities by IDs.
+func (ruo *ResponseUpdateOne) RemoveNoteIDs(ids ...int) *ResponseUpdateOne {
+	ruo.mutation.RemoveNoteIDs(ids...)
+	return ruo
+}
+
+// RemoveNotes removes "notes" edges to ResponseNote entities.
+func (ruo *ResponseUpdateOne) RemoveNotes(r ...*ResponseNote) *ResponseUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveNoteIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the ResponseTag entity.
+func (ruo *ResponseUpdateOne) ClearTags() *ResponseUpdateOne {
+	ruo.mutation.ClearTags()
+	return ruo
+}
+
+// RemoveTagIDs removes the "tags" edge to ResponseTag entities by IDs.
+func (ruo *ResponseUpdateOne) RemoveTagIDs(ids ...int) *ResponseUpdateOne {
+	ruo.mutation.RemoveTagIDs(ids...)
+	return ruo
+}
+
+// RemoveTags removes "tags" edges to ResponseTag entities.
+func (ruo *ResponseUpdateOne) RemoveTags(r ...*ResponseTag) *ResponseUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveTagIDs(ids...)
+}
+
+// ClearActivities clears all "activities" edges to the ResponseActivity entity.
+func (ruo *ResponseUpdateOne) ClearActivities() *ResponseUpdateOne {
+	ruo.mutation.ClearActivities()
+	return ruo
+}
+
+// RemoveActivityIDs removes the "activities" edge to ResponseActivity entities by IDs.
+func (ruo *ResponseUpdateOne) RemoveActivityIDs(ids ...int) *ResponseUpdateOne {
+	ruo.mutation.RemoveActivityIDs(ids...)
+	return ruo
+}
+
+// RemoveActivities removes "activities" edges to ResponseActivity entities.
+func (ruo *ResponseUpdateOne) RemoveActivities(r ...*ResponseActivity) *ResponseUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveActivityIDs(ids...)
+}
+
+// ClearPayment clears the "payment" edge to the PaymentIntent entity.
+func (ruo *ResponseUpdateOne) ClearPayment() *ResponseUpdateOne {
+	ruo.mutation.ClearPayment()
+	return ruo
+}
+
 // Where appends a list predicates to the ResponseUpdate builder.
 func (ruo *ResponseUpdateOne) Where(ps ...predicate.Response) *ResponseUpdateOne {
 	ruo.mutation.Where(ps...)
@@ -531,6 +1001,11 @@ func (ruo *ResponseUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ruo *ResponseUpdateOne) check() error {
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := response.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Response.status": %w`, err)}
+		}
+	}
 	if ruo.mutation.FormCleared() && len(ruo.mutation.FormIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Response.form"`)
 	}
@@ -581,6 +1056,9 @@ func (ruo *ResponseUpdateOne) sqlSave(ctx context.Context) (_node *Response, err
 	if ruo.mutation.UserAgentCleared() {
 		_spec.ClearField(response.FieldUserAgent, field.TypeString)
 	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(response.FieldStatus, field.TypeEnum, value)
+	}
 	if ruo.mutation.FormCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -684,6 +1162,170 @@ func (ruo *ResponseUpdateOne) sqlSave(ctx context.Context) (_node *Response, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.NotesTable,
+			Columns: []string{response.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedNotesIDs(); len(nodes) > 0 && !ruo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.NotesTable,
+			Columns: []string{response.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.NotesTable,
+			Columns: []string{response.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   response.TagsTable,
+			Columns: response.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !ruo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   response.TagsTable,
+			Columns: response.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   response.TagsTable,
+			Columns: response.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.ActivitiesTable,
+			Columns: []string{response.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !ruo.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.ActivitiesTable,
+			Columns: []string{response.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   response.ActivitiesTable,
+			Columns: []string{response.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   response.PaymentTable,
+			Columns: []string{response.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentintent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   response.PaymentTable,
+			Columns: []string{response.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentintent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Response{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseActivity is the model entity for the ResponseActivity schema.
+type ResponseActivity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Type of change made to the response
+	Action responseactivity.Action `json:"action,omitempty"`
+	// Details of the change, such as the previous and new values
+	Changes map[string]interface{} `json:"changes,omitempty"`
+	// User who made the change
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResponseActivityQuery when eager-loading is set.
+	Edges               ResponseActivityEdges `json:"edges"`
+	response_activities *int
+	selectValues        sql.SelectValues
+}
+
+// ResponseActivityEdges holds the relations/edges for other nodes in the graph.
+type ResponseActivityEdges struct {
+	// Response holds the value of the response edge.
+	Response *Response `json:"response,omitempty"`
+	// Actor holds the value of the actor edge.
+	Actor *User `json:"actor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ResponseOrErr returns the Response value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResponseActivityEdges) ResponseOrErr() (*Response, error) {
+	if e.Response != nil {
+		return e.Response, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: response.Label}
+	}
+	return nil, &NotLoadedError{edge: "response"}
+}
+
+// ActorOrErr returns the Actor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResponseActivityEdges) ActorOrErr() (*User, error) {
+	if e.Actor != nil {
+		return e.Actor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "actor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResponseActivity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case responseactivity.FieldChanges:
+			values[i] = new([]byte)
+		case responseactivity.FieldID, responseactivity.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case responseactivity.FieldAction:
+			values[i] = new(sql.NullString)
+		case responseactivity.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case responseactivity.ForeignKeys[0]: // response_activities
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResponseActivity fields.
+func (ra *ResponseActivity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case responseactivity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ra.ID = int(value.Int64)
+		case responseactivity.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ra.Action = responseactivity.Action(value.String)
+			}
+		case responseactivity.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ra.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case responseactivity.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ra.UserID = int(value.Int64)
+			}
+		case responseactivity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ra.CreatedAt = value.Time
+			}
+		case responseactivity.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field response_activities", value)
+			} else if value.Valid {
+				ra.response_activities = new(int)
+				*ra.response_activities = int(value.Int64)
+			}
+		default:
+			ra.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResponseActivity.
+// This includes values selected through modifiers, order, etc.
+func (ra *ResponseActivity) Value(name string) (ent.Value, error) {
+	return ra.selectValues.Get(name)
+}
+
+// QueryResponse queries the "response" edge of the ResponseActivity entity.
+func (ra *ResponseActivity) QueryResponse() *ResponseQuery {
+	return NewResponseActivityClient(ra.config).QueryResponse(ra)
+}
+
+// QueryActor queries the "actor" edge of the ResponseActivity entity.
+func (ra *ResponseActivity) QueryActor() *UserQuery {
+	return NewResponseActivityClient(ra.config).QueryActor(ra)
+}
+
+// Update returns a builder for updating this ResponseActivity.
+// Note that you need to call ResponseActivity.Unwrap() before calling this method if this ResponseActivity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ra *ResponseActivity) Update() *ResponseActivityUpdateOne {
+	return NewResponseActivityClient(ra.config).UpdateOne(ra)
+}
+
+// Unwrap unwraps the ResponseActivity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ra *ResponseActivity) Unwrap() *ResponseActivity {
+	_tx, ok := ra.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResponseActivity is not a transactional entity")
+	}
+	ra.config.driver = _tx.drv
+	return ra
+}
+
+// String implements the fmt.Stringer.
+func (ra *ResponseActivity) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResponseActivity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ra.ID))
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", ra.Action))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", ra.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ra.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResponseActivities is a parsable slice of ResponseActivity.
+type ResponseActivities []*ResponseActivity
@@ -0,0 +1,691 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseActivityCreate is the builder for creating a ResponseActivity entity.
+type ResponseActivityCreate struct {
+	config
+	mutation *ResponseActivityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAction sets the "action" field.
+func (rac *ResponseActivityCreate) SetAction(r responseactivity.Action) *ResponseActivityCreate {
+	rac.mutation.SetAction(r)
+	return rac
+}
+
+// SetChanges sets the "changes" field.
+func (rac *ResponseActivityCreate) SetChanges(m map[string]interface{}) *ResponseActivityCreate {
+	rac.mutation.SetChanges(m)
+	return rac
+}
+
+// SetUserID sets the "user_id" field.
+func (rac *ResponseActivityCreate) SetUserID(i int) *ResponseActivityCreate {
+	rac.mutation.SetUserID(i)
+	return rac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rac *ResponseActivityCreate) SetCreatedAt(t time.Time) *ResponseActivityCreate {
+	rac.mutation.SetCreatedAt(t)
+	return rac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rac *ResponseActivityCreate) SetNillableCreatedAt(t *time.Time) *ResponseActivityCreate {
+	if t != nil {
+		rac.SetCreatedAt(*t)
+	}
+	return rac
+}
+
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (rac *ResponseActivityCreate) SetResponseID(id int) *ResponseActivityCreate {
+	rac.mutation.SetResponseID(id)
+	return rac
+}
+
+// SetNillableResponseID sets the "response" edge to the Response entity by ID if the given value is not nil.
+func (rac *ResponseActivityCreate) SetNillableResponseID(id *int) *ResponseActivityCreate {
+	if id != nil {
+		rac = rac.SetResponseID(*id)
+	}
+	return rac
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (rac *ResponseActivityCreate) SetResponse(r *Response) *ResponseActivityCreate {
+	return rac.SetResponseID(r.ID)
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (rac *ResponseActivityCreate) SetActorID(id int) *ResponseActivityCreate {
+	rac.mutation.SetActorID(id)
+	return rac
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (rac *ResponseActivityCreate) SetActor(u *User) *ResponseActivityCreate {
+	return rac.SetActorID(u.ID)
+}
+
+// Mutation returns the ResponseActivityMutation object of the builder.
+func (rac *ResponseActivityCreate) Mutation() *ResponseActivityMutation {
+	return rac.mutation
+}
+
+// Save creates the ResponseActivity in the database.
+func (rac *ResponseActivityCreate) Save(ctx context.Context) (*ResponseActivity, error) {
+	rac.defaults()
+	return withHooks(ctx, rac.sqlSave, rac.mutation, rac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rac *ResponseActivityCreate) SaveX(ctx context.Context) *ResponseActivity {
+	v, err := rac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rac *ResponseActivityCreate) Exec(ctx context.Context) error {
+	_, err := rac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rac *ResponseActivityCreate) ExecX(ctx context.Context) {
+	if err := rac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rac *ResponseActivityCreate) defaults() {
+	if _, ok := rac.mutation.CreatedAt(); !ok {
+		v := responseactivity.DefaultCreatedAt()
+		rac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rac *ResponseActivityCreate) check() error {
+	if _, ok := rac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ResponseActivity.action"`)}
+	}
+	if v, ok := rac.mutation.Action(); ok {
+		if err := responseactivity.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ResponseActivity.action": %w`, err)}
+		}
+	}
+	if _, ok := rac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ResponseActivity.user_id"`)}
+	}
+	if _, ok := rac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResponseActivity.created_at"`)}
+	}
+	if len(rac.mutation.ActorIDs()) == 0 {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required edge "ResponseActivity.actor"`)}
+	}
+	return nil
+}
+
+func (rac *ResponseActivityCreate) sqlSave(ctx context.Context) (*ResponseActivity, error) {
+	if err := rac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rac.mutation.id = &_node.ID
+	rac.mutation.done = true
+	return _node, nil
+}
+
+func (rac *ResponseActivityCreate) createSpec() (*ResponseActivity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResponseActivity{config: rac.config}
+		_spec = sqlgraph.NewCreateSpec(responseactivity.Table, sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rac.conflict
+	if value, ok := rac.mutation.Action(); ok {
+		_spec.SetField(responseactivity.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := rac.mutation.Changes(); ok {
+		_spec.SetField(responseactivity.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := rac.mutation.CreatedAt(); ok {
+		_spec.SetField(responseactivity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rac.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responseactivity.ResponseTable,
+			Columns: []string{responseactivity.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.response_activities = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rac.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responseactivity.ActorTable,
+			Columns: []string{responseactivity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseActivity.Create().
+//		SetAction(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseActivityUpsert) {
+//			SetAction(v+v).
+//		}).
+//		Exec(ctx)
+func (rac *ResponseActivityCreate) OnConflict(opts ...sql.ConflictOption) *ResponseActivityUpsertOne {
+	rac.conflict = opts
+	return &ResponseActivityUpsertOne{
+		create: rac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseActivity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rac *ResponseActivityCreate) OnConflictColumns(columns ...string) *ResponseActivityUpsertOne {
+	rac.conflict = append(rac.conflict, sql.ConflictColumns(columns...))
+	return &ResponseActivityUpsertOne{
+		create: rac,
+	}
+}
+
+type (
+	// ResponseActivityUpsertOne is the builder for "upsert"-ing
+	//  one ResponseActivity node.
+	ResponseActivityUpsertOne struct {
+		create *ResponseActivityCreate
+	}
+
+	// ResponseActivityUpsert is the "OnConflict" setter.
+	ResponseActivityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAction sets the "action" field.
+func (u *ResponseActivityUpsert) SetAction(v responseactivity.Action) *ResponseActivityUpsert {
+	u.Set(responseactivity.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ResponseActivityUpsert) UpdateAction() *ResponseActivityUpsert {
+	u.SetExcluded(responseactivity.FieldAction)
+	return u
+}
+
+// SetChanges sets the "changes" field.
+func (u *ResponseActivityUpsert) SetChanges(v map[string]interface{}) *ResponseActivityUpsert {
+	u.Set(responseactivity.FieldChanges, v)
+	return u
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ResponseActivityUpsert) UpdateChanges() *ResponseActivityUpsert {
+	u.SetExcluded(responseactivity.FieldChanges)
+	return u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *ResponseActivityUpsert) ClearChanges() *ResponseActivityUpsert {
+	u.SetNull(responseactivity.FieldChanges)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseActivityUpsert) SetUserID(v int) *ResponseActivityUpsert {
+	u.Set(responseactivity.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseActivityUpsert) UpdateUserID() *ResponseActivityUpsert {
+	u.SetExcluded(responseactivity.FieldUserID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ResponseActivity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseActivityUpsertOne) UpdateNewValues() *ResponseActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(responseactivity.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseActivity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResponseActivityUpsertOne) Ignore() *ResponseActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseActivityUpsertOne) DoNothing() *ResponseActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseActivityCreate.OnConflict
+// documentation for more info.
+func (u *ResponseActivityUpsertOne) Update(set func(*ResponseActivityUpsert)) *ResponseActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *ResponseActivityUpsertOne) SetAction(v responseactivity.Action) *ResponseActivityUpsertOne {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ResponseActivityUpsertOne) UpdateAction() *ResponseActivityUpsertOne {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *ResponseActivityUpsertOne) SetChanges(v map[string]interface{}) *ResponseActivityUpsertOne {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ResponseActivityUpsertOne) UpdateChanges() *ResponseActivityUpsertOne {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *ResponseActivityUpsertOne) ClearChanges() *ResponseActivityUpsertOne {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.ClearChanges()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseActivityUpsertOne) SetUserID(v int) *ResponseActivityUpsertOne {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseActivityUpsertOne) UpdateUserID() *ResponseActivityUpsertOne {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseActivityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseActivityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseActivityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResponseActivityUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResponseActivityUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResponseActivityCreateBulk is the builder for creating many ResponseActivity entities in bulk.
+type ResponseActivityCreateBulk struct {
+	config
+	err      error
+	builders []*ResponseActivityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ResponseActivity entities in the database.
+func (racb *ResponseActivityCreateBulk) Save(ctx context.Context) ([]*ResponseActivity, error) {
+	if racb.err != nil {
+		return nil, racb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(racb.builders))
+	nodes := make([]*ResponseActivity, len(racb.builders))
+	mutators := make([]Mutator, len(racb.builders))
+	for i := range racb.builders {
+		func(i int, root context.Context) {
+			builder := racb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResponseActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, racb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = racb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, racb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, racb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (racb *ResponseActivityCreateBulk) SaveX(ctx context.Context) []*ResponseActivity {
+	v, err := racb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (racb *ResponseActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := racb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (racb *ResponseActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := racb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseActivity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseActivityUpsert) {
+//			SetAction(v+v).
+//		}).
+//		Exec(ctx)
+func (racb *ResponseActivityCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResponseActivityUpsertBulk {
+	racb.conflict = opts
+	return &ResponseActivityUpsertBulk{
+		create: racb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseActivity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (racb *ResponseActivityCreateBulk) OnConflictColumns(columns ...string) *ResponseActivityUpsertBulk {
+	racb.conflict = append(racb.conflict, sql.ConflictColumns(columns...))
+	return &ResponseActivityUpsertBulk{
+		create: racb,
+	}
+}
+
+// ResponseActivityUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResponseActivity nodes.
+type ResponseActivityUpsertBulk struct {
+	create *ResponseActivityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResponseActivity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseActivityUpsertBulk) UpdateNewValues() *ResponseActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(responseactivity.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseActivity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResponseActivityUpsertBulk) Ignore() *ResponseActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseActivityUpsertBulk) DoNothing() *ResponseActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseActivityCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResponseActivityUpsertBulk) Update(set func(*ResponseActivityUpsert)) *ResponseActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *ResponseActivityUpsertBulk) SetAction(v responseactivity.Action) *ResponseActivityUpsertBulk {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ResponseActivityUpsertBulk) UpdateAction() *ResponseActivityUpsertBulk {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *ResponseActivityUpsertBulk) SetChanges(v map[string]interface{}) *ResponseActivityUpsertBulk {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ResponseActivityUpsertBulk) UpdateChanges() *ResponseActivityUpsertBulk {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *ResponseActivityUpsertBulk) ClearChanges() *ResponseActivityUpsertBulk {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.ClearChanges()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseActivityUpsertBulk) SetUserID(v int) *ResponseActivityUpsertBulk {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseActivityUpsertBulk) UpdateUserID() *ResponseActivityUpsertBulk {
+	return u.Update(func(s *ResponseActivityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseActivityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ResponseActivityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseActivityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseActivityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/responseactivity"
+)
+
+// ResponseActivityDelete is the builder for deleting a ResponseActivity entity.
+type ResponseActivityDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResponseActivityMutation
+}
+
+// Where appends a list predicates to the ResponseActivityDelete builder.
+func (rad *ResponseActivityDelete) Where(ps ...predicate.ResponseActivity) *ResponseActivityDelete {
+	rad.mutation.Where(ps...)
+	return rad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rad *ResponseActivityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rad.sqlExec, rad.mutation, rad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rad *ResponseActivityDelete) ExecX(ctx context.Context) int {
+	n, err := rad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rad *ResponseActivityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(responseactivity.Table, sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt))
+	if ps := rad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rad.mutation.done = true
+	return affected, err
+}
+
+// ResponseActivityDeleteOne is the builder for deleting a single ResponseActivity entity.
+type ResponseActivityDeleteOne struct {
+	rad *ResponseActivityDelete
+}
+
+// Where appends a list predicates to the ResponseActivityDelete builder.
+func (rado *ResponseActivityDeleteOne) Where(ps ...predicate.ResponseActivity) *ResponseActivityDeleteOne {
+	rado.rad.mutation.Where(ps...)
+	return rado
+}
+
+// Exec executes the deletion query.
+func (rado *ResponseActivityDeleteOne) Exec(ctx context.Context) error {
+	n, err := rado.rad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{responseactivity.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rado *ResponseActivityDeleteOne) ExecX(ctx context.Context) {
+	if err := rado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseActivityQuery is the builder for querying ResponseActivity entities.
+type ResponseActivityQuery struct {
+	config
+	ctx          *QueryContext
+	order        []responseactivity.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ResponseActivity
+	withResponse *ResponseQuery
+	withActor    *UserQuery
+	withFKs      bool
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResponseActivityQuery builder.
+func (raq *ResponseActivityQuery) Where(ps ...predicate.ResponseActivity) *ResponseActivityQuery {
+	raq.predicates = append(raq.predicates, ps...)
+	return raq
+}
+
+// Limit the number of records to be returned by this query.
+func (raq *ResponseActivityQuery) Limit(limit int) *ResponseActivityQuery {
+	raq.ctx.Limit = &limit
+	return raq
+}
+
+// Offset to start from.
+func (raq *ResponseActivityQuery) Offset(offset int) *ResponseActivityQuery {
+	raq.ctx.Offset = &offset
+	return raq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (raq *ResponseActivityQuery) Unique(unique bool) *ResponseActivityQuery {
+	raq.ctx.Unique = &unique
+	return raq
+}
+
+// Order specifies how the records should be ordered.
+func (raq *ResponseActivityQuery) Order(o ...responseactivity.OrderOption) *ResponseActivityQuery {
+	raq.order = append(raq.order, o...)
+	return raq
+}
+
+// QueryResponse chains the current query on the "response" edge.
+func (raq *ResponseActivityQuery) QueryResponse() *ResponseQuery {
+	query := (&ResponseClient{config: raq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(responseactivity.Table, responseactivity.FieldID, selector),
+			sqlgraph.To(response.Table, response.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, responseactivity.ResponseTable, responseactivity.ResponseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActor chains the current query on the "actor" edge.
+func (raq *ResponseActivityQuery) QueryActor() *UserQuery {
+	query := (&UserClient{config: raq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(responseactivity.Table, responseactivity.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, responseactivity.ActorTable, responseactivity.ActorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResponseActivity entity from the query.
+// Returns a *NotFoundError when no ResponseActivity was found.
+func (raq *ResponseActivityQuery) First(ctx context.Context) (*ResponseActivity, error) {
+	nodes, err := raq.Limit(1).All(setContextOp(ctx, raq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{responseactivity.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (raq *ResponseActivityQuery) FirstX(ctx context.Context) *ResponseActivity {
+	node, err := raq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResponseActivity ID from the query.
+// Returns a *NotFoundError when no ResponseActivity ID was found.
+func (raq *ResponseActivityQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = raq.Limit(1).IDs(setContextOp(ctx, raq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{responseactivity.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (raq *ResponseActivityQuery) FirstIDX(ctx context.Context) int {
+	id, err := raq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResponseActivity entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResponseActivity entity is found.
+// Returns a *NotFoundError when no ResponseActivity entities are found.
+func (raq *ResponseActivityQuery) Only(ctx context.Context) (*ResponseActivity, error) {
+	nodes, err := raq.Limit(2).All(setContextOp(ctx, raq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{responseactivity.Label}
+	default:
+		return nil, &NotSingularError{responseactivity.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (raq *ResponseActivityQuery) OnlyX(ctx context.Context) *ResponseActivity {
+	node, err := raq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResponseActivity ID in the query.
+// Returns a *NotSingularError when more than one ResponseActivity ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (raq *ResponseActivityQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = raq.Limit(2).IDs(setContextOp(ctx, raq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{responseactivity.Label}
+	default:
+		err = &NotSingularError{responseactivity.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (raq *ResponseActivityQuery) OnlyIDX(ctx context.Context) int {
+	id, err := raq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResponseActivities.
+func (raq *ResponseActivityQuery) All(ctx context.Context) ([]*ResponseActivity, error) {
+	ctx = setContextOp(ctx, raq.ctx, ent.OpQueryAll)
+	if err := raq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResponseActivity, *ResponseActivityQuery]()
+	return withInterceptors[[]*ResponseActivity](ctx, raq, qr, raq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (raq *ResponseActivityQuery) AllX(ctx context.Context) []*ResponseActivity {
+	nodes, err := raq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResponseActivity IDs.
+func (raq *ResponseActivityQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if raq.ctx.Unique == nil && raq.path != nil {
+		raq.Unique(true)
+	}
+	ctx = setContextOp(ctx, raq.ctx, ent.OpQueryIDs)
+	if err = raq.Select(responseactivity.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (raq *ResponseActivityQuery) IDsX(ctx context.Context) []int {
+	ids, err := raq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (raq *ResponseActivityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, raq.ctx, ent.OpQueryCount)
+	if err := raq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, raq, querierCount[*ResponseActivityQuery](), raq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (raq *ResponseActivityQuery) CountX(ctx context.Context) int {
+	count, err := raq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (raq *ResponseActivityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, raq.ctx, ent.OpQueryExist)
+	switch _, err := raq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (raq *ResponseActivityQuery) ExistX(ctx context.Context) bool {
+	exist, err := raq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResponseActivityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (raq *ResponseActivityQuery) Clone() *ResponseActivityQuery {
+	if raq == nil {
+		return nil
+	}
+	return &ResponseActivityQuery{
+		config:       raq.config,
+		ctx:          raq.ctx.Clone(),
+		order:        append([]responseactivity.OrderOption{}, raq.order...),
+		inters:       append([]Interceptor{}, raq.inters...),
+		predicates:   append([]predicate.ResponseActivity{}, raq.predicates...),
+		withResponse: raq.withResponse.Clone(),
+		withActor:    raq.withActor.Clone(),
+		// clone intermediate query.
+		sql:  raq.sql.Clone(),
+		path: raq.path,
+	}
+}
+
+// WithResponse tells the query-builder to eager-load the nodes that are connected to
+// the "response" edge. The optional arguments are used to configure the query builder of the edge.
+func (raq *ResponseActivityQuery) WithResponse(opts ...func(*ResponseQuery)) *ResponseActivityQuery {
+	query := (&ResponseClient{config: raq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raq.withResponse = query
+	return raq
+}
+
+// WithActor tells the query-builder to eager-load the nodes that are connected to
+// the "actor" edge. The optional arguments are used to configure the query builder of the edge.
+func (raq *ResponseActivityQuery) WithActor(opts ...func(*UserQuery)) *ResponseActivityQuery {
+	query := (&UserClient{config: raq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raq.withActor = query
+	return raq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Action responseactivity.Action `json:"action,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResponseActivity.Query().
+//		GroupBy(responseactivity.FieldAction).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (raq *ResponseActivityQuery) GroupBy(field string, fields ...string) *ResponseActivityGroupBy {
+	raq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResponseActivityGroupBy{build: raq}
+	grbuild.flds = &raq.ctx.Fields
+	grbuild.label = responseactivity.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Action responseactivity.Action `json:"action,omitempty"`
+//	}
+//
+//	client.ResponseActivity.Query().
+//		Select(responseactivity.FieldAction).
+//		Scan(ctx, &v)
+func (raq *ResponseActivityQuery) Select(fields ...string) *ResponseActivitySelect {
+	raq.ctx.Fields = append(raq.ctx.Fields, fields...)
+	sbuild := &ResponseActivitySelect{ResponseActivityQuery: raq}
+	sbuild.label = responseactivity.Label
+	sbuild.flds, sbuild.scan = &raq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResponseActivitySelect configured with the given aggregations.
+func (raq *ResponseActivityQuery) Aggregate(fns ...AggregateFunc) *ResponseActivitySelect {
+	return raq.Select().Aggregate(fns...)
+}
+
+func (raq *ResponseActivityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range raq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, raq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range raq.ctx.Fields {
+		if !responseactivity.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if raq.path != nil {
+		prev, err := raq.path(ctx)
+		if err != nil {
+			return err
+		}
+		raq.sql = prev
+	}
+	return nil
+}
+
+func (raq *ResponseActivityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResponseActivity, error) {
+	var (
+		nodes       = []*ResponseActivity{}
+		withFKs     = raq.withFKs
+		_spec       = raq.querySpec()
+		loadedTypes = [2]bool{
+			raq.withResponse != nil,
+			raq.withActor != nil,
+		}
+	)
+	if raq.withResponse != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, responseactivity.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResponseActivity).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResponseActivity{config: raq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(raq.modifiers) > 0 {
+		_spec.Modifiers = raq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, raq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := raq.withResponse; query != nil {
+		if err := raq.loadResponse(ctx, query, nodes, nil,
+			func(n *ResponseActivity, e *Response) { n.Edges.Response = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := raq.withActor; query != nil {
+		if err := raq.loadActor(ctx, query, nodes, nil,
+			func(n *ResponseActivity, e *User) { n.Edges.Actor = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (raq *ResponseActivityQuery) loadResponse(ctx context.Context, query *ResponseQuery, nodes []*ResponseActivity, init func(*ResponseActivity), assign func(*ResponseActivity, *Response)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ResponseActivity)
+	for i := range nodes {
+		if nodes[i].response_activities == nil {
+			continue
+		}
+		fk := *nodes[i].response_activities
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(response.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "response_activities" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (raq *ResponseActivityQuery) loadActor(ctx context.Context, query *UserQuery, nodes []*ResponseActivity, init func(*ResponseActivity), assign func(*ResponseActivity, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ResponseActivity)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (raq *ResponseActivityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := raq.querySpec()
+	if len(raq.modifiers) > 0 {
+		_spec.Modifiers = raq.modifiers
+	}
+	_spec.Node.Columns = raq.ctx.Fields
+	if len(raq.ctx.Fields) > 0 {
+		_spec.Unique = raq.ctx.Unique != nil && *raq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, raq.driver, _spec)
+}
+
+func (raq *ResponseActivityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(responseactivity.Table, responseactivity.Columns, sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt))
+	_spec.From = raq.sql
+	if unique := raq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if raq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := raq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responseactivity.FieldID)
+		for i := range fields {
+			if fields[i] != responseactivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if raq.withActor != nil {
+			_spec.Node.AddColumnOnce(responseactivity.FieldUserID)
+		}
+	}
+	if ps := raq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := raq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := raq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := raq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (raq *ResponseActivityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(raq.driver.Dialect())
+	t1 := builder.Table(responseactivity.Table)
+	columns := raq.ctx.Fields
+	if len(columns) == 0 {
+		columns = responseactivity.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if raq.sql != nil {
+		selector = raq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if raq.ctx.Unique != nil && *raq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range raq.modifiers {
+		m(selector)
+	}
+	for _, p := range raq.predicates {
+		p(selector)
+	}
+	for _, p := range raq.order {
+		p(selector)
+	}
+	if offset := raq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := raq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (raq *ResponseActivityQuery) ForUpdate(opts ...sql.LockOption) *ResponseActivityQuery {
+	if raq.driver.Dialect() == dialect.Postgres {
+		raq.Unique(false)
+	}
+	raq.modifiers = append(raq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return raq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (raq *ResponseActivityQuery) ForShare(opts ...sql.LockOption) *ResponseActivityQuery {
+	if raq.driver.Dialect() == dialect.Postgres {
+		raq.Unique(false)
+	}
+	raq.modifiers = append(raq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return raq
+}
+
+// ResponseActivityGroupBy is the group-by builder for ResponseActivity entities.
+type ResponseActivityGroupBy struct {
+	selector
+	build *ResponseActivityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ragb *ResponseActivityGroupBy) Aggregate(fns ...AggregateFunc) *ResponseActivityGroupBy {
+	ragb.fns = append(ragb.fns, fns...)
+	return ragb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ragb *ResponseActivityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ragb.build.ctx, ent.OpQueryGroupBy)
+	if err := ragb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResponseActivityQuery, *ResponseActivityGroupBy](ctx, ragb.build, ragb, ragb.build.inters, v)
+}
+
+func (ragb *ResponseActivityGroupBy) sqlScan(ctx context.Context, root *ResponseActivityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ragb.fns))
+	for _, fn := range ragb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ragb.flds)+len(ragb.fns))
+		for _, f := range *ragb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ragb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ragb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResponseActivitySelect is the builder for selecting fields of ResponseActivity entities.
+type ResponseActivitySelect struct {
+	*ResponseActivityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ras *ResponseActivitySelect) Aggregate(fns ...AggregateFunc) *ResponseActivitySelect {
+	ras.fns = append(ras.fns, fns...)
+	return ras
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ras *ResponseActivitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ras.ctx, ent.OpQuerySelect)
+	if err := ras.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResponseActivityQuery, *ResponseActivitySelect](ctx, ras.ResponseActivityQuery, ras, ras.inters, v)
+}
+
+func (ras *ResponseActivitySelect) sqlScan(ctx context.Context, root *ResponseActivityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ras.fns))
+	for _, fn := range ras.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ras.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ras.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
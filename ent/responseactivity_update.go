@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseActivityUpdate is the builder for updating ResponseActivity entities.
+type ResponseActivityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResponseActivityMutation
+}
+
+// Where appends a list predicates to the ResponseActivityUpdate builder.
+func (rau *ResponseActivityUpdate) Where(ps ...predicate.ResponseActivity) *ResponseActivityUpdate {
+	rau.mutation.Where(ps...)
+	return rau
+}
+
+// SetAction sets the "action" field.
+func (rau *ResponseActivityUpdate) SetAction(r responseactivity.Action) *ResponseActivityUpdate {
+	rau.mutation.SetAction(r)
+	return rau
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (rau *ResponseActivityUpdate) SetNillableAction(r *responseactivity.Action) *ResponseActivityUpdate {
+	if r != nil {
+		rau.SetAction(*r)
+	}
+	return rau
+}
+
+// SetChanges sets the "changes" field.
+func (rau *ResponseActivityUpdate) SetChanges(m map[string]interface{}) *ResponseActivityUpdate {
+	rau.mutation.SetChanges(m)
+	return rau
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (rau *ResponseActivityUpdate) ClearChanges() *ResponseActivityUpdate {
+	rau.mutation.ClearChanges()
+	return rau
+}
+
+// SetUserID sets the "user_id" field.
+func (rau *ResponseActivityUpdate) SetUserID(i int) *ResponseActivityUpdate {
+	rau.mutation.SetUserID(i)
+	return rau
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rau *ResponseActivityUpdate) SetNillableUserID(i *int) *ResponseActivityUpdate {
+	if i != nil {
+		rau.SetUserID(*i)
+	}
+	return rau
+}
+
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (rau *ResponseActivityUpdate) SetResponseID(id int) *ResponseActivityUpdate {
+	rau.mutation.SetResponseID(id)
+	return rau
+}
+
+// SetNillableResponseID sets the "response" edge to the Response entity by ID if the given value is not nil.
+func (rau *ResponseActivityUpdate) SetNillableResponseID(id *int) *ResponseActivityUpdate {
+	if id != nil {
+		rau = rau.SetResponseID(*id)
+	}
+	return rau
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (rau *ResponseActivityUpdate) SetResponse(r *Response) *ResponseActivityUpdate {
+	return rau.SetResponseID(r.ID)
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (rau *ResponseActivityUpdate) SetActorID(id int) *ResponseActivityUpdate {
+	rau.mutation.SetActorID(id)
+	return rau
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (rau *ResponseActivityUpdate) SetActor(u *User) *ResponseActivityUpdate {
+	return rau.SetActorID(u.ID)
+}
+
+// Mutation returns the ResponseActivityMutation object of the builder.
+func (rau *ResponseActivityUpdate) Mutation() *ResponseActivityMutation {
+	return rau.mutation
+}
+
+// ClearResponse clears the "response" edge to the Response entity.
+func (rau *ResponseActivityUpdate) ClearResponse() *ResponseActivityUpdate {
+	rau.mutation.ClearResponse()
+	return rau
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (rau *ResponseActivityUpdate) ClearActor() *ResponseActivityUpdate {
+	rau.mutation.ClearActor()
+	return rau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rau *ResponseActivityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rau.sqlSave, rau.mutation, rau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rau *ResponseActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := rau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rau *ResponseActivityUpdate) Exec(ctx context.Context) error {
+	_, err := rau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rau *ResponseActivityUpdate) ExecX(ctx context.Context) {
+	if err := rau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rau *ResponseActivityUpdate) check() error {
+	if v, ok := rau.mutation.Action(); ok {
+		if err := responseactivity.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ResponseActivity.action": %w`, err)}
+		}
+	}
+	if rau.mutation.ActorCleared() && len(rau.mutation.ActorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResponseActivity.actor"`)
+	}
+	return nil
+}
+
+func (rau *ResponseActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(responseactivity.Table, responseactivity.Columns, sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt))
+	if ps := rau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rau.mutation.Action(); ok {
+		_spec.SetField(responseactivity.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := rau.mutation.Changes(); ok {
+		_spec.SetField(responseactivity.FieldChanges, field.TypeJSON, value)
+	}
+	if rau.mutation.ChangesCleared() {
+		_spec.ClearField(responseactivity.FieldChanges, field.TypeJSON)
+	}
+	if rau.mutation.ResponseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responseactivity.ResponseTable,
+			Columns: []string{responseactivity.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rau.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responseactivity.ResponseTable,
+			Columns: []string{responseactivity.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rau.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responseactivity.ActorTable,
+			Columns: []string{responseactivity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rau.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responseactivity.ActorTable,
+			Columns: []string{responseactivity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responseactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rau.mutation.done = true
+	return n, nil
+}
+
+// ResponseActivityUpdateOne is the builder for updating a single ResponseActivity entity.
+type ResponseActivityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResponseActivityMutation
+}
+
+// SetAction sets the "action" field.
+func (rauo *ResponseActivityUpdateOne) SetAction(r responseactivity.Action) *ResponseActivityUpdateOne {
+	rauo.mutation.SetAction(r)
+	return rauo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (rauo *ResponseActivityUpdateOne) SetNillableAction(r *responseactivity.Action) *ResponseActivityUpdateOne {
+	if r != nil {
+		rauo.SetAction(*r)
+	}
+	return rauo
+}
+
+// SetChanges sets the "changes" field.
+func (rauo *ResponseActivityUpdateOne) SetChanges(m map[string]interface{}) *ResponseActivityUpdateOne {
+	rauo.mutation.SetChanges(m)
+	return rauo
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (rauo *ResponseActivityUpdateOne) ClearChanges() *ResponseActivityUpdateOne {
+	rauo.mutation.ClearChanges()
+	return rauo
+}
+
+// SetUserID sets the "user_id" field.
+func (rauo *ResponseActivityUpdateOne) SetUserID(i int) *ResponseActivityUpdateOne {
+	rauo.mutation.SetUserID(i)
+	return rauo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rauo *ResponseActivityUpdateOne) SetNillableUserID(i *int) *ResponseActivityUpdateOne {
+	if i != nil {
+		rauo.SetUserID(*i)
+	}
+	return rauo
+}
+
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (rauo *ResponseActivityUpdateOne) SetResponseID(id int) *ResponseActivityUpdateOne {
+	rauo.mutation.SetResponseID(id)
+	return rauo
+}
+
+// SetNillableResponseID sets the "response" edge to the Response entity by ID if the given value is not nil.
+func (rauo *ResponseActivityUpdateOne) SetNillableResponseID(id *int) *ResponseActivityUpdateOne {
+	if id != nil {
+		rauo = rauo.SetResponseID(*id)
+	}
+	return rauo
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (rauo *ResponseActivityUpdateOne) SetResponse(r *Response) *ResponseActivityUpdateOne {
+	return rauo.SetResponseID(r.ID)
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (rauo *ResponseActivityUpdateOne) SetActorID(id int) *ResponseActivityUpdateOne {
+	rauo.mutation.SetActorID(id)
+	return rauo
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (rauo *ResponseActivityUpdateOne) SetActor(u *User) *ResponseActivityUpdateOne {
+	return rauo.SetActorID(u.ID)
+}
+
+// Mutation returns the ResponseActivityMutation object of the builder.
+func (rauo *ResponseActivityUpdateOne) Mutation() *ResponseActivityMutation {
+	return rauo.mutation
+}
+
+// ClearResponse clears the "response" edge to the Response entity.
+func (rauo *ResponseActivityUpdateOne) ClearResponse() *ResponseActivityUpdateOne {
+	rauo.mutation.ClearResponse()
+	return rauo
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (rauo *ResponseActivityUpdateOne) ClearActor() *ResponseActivityUpdateOne {
+	rauo.mutation.ClearActor()
+	return rauo
+}
+
+// Where appends a list predicates to the ResponseActivityUpdate builder.
+func (rauo *ResponseActivityUpdateOne) Where(ps ...predicate.ResponseActivity) *ResponseActivityUpdateOne {
+	rauo.mutation.Where(ps...)
+	return rauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rauo *ResponseActivityUpdateOne) Select(field string, fields ...string) *ResponseActivityUpdateOne {
+	rauo.fields = append([]string{field}, fields...)
+	return rauo
+}
+
+// Save executes the query and returns the updated ResponseActivity entity.
+func (rauo *ResponseActivityUpdateOne) Save(ctx context.Context) (*ResponseActivity, error) {
+	return withHooks(ctx, rauo.sqlSave, rauo.mutation, rauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rauo *ResponseActivityUpdateOne) SaveX(ctx context.Context) *ResponseActivity {
+	node, err := rauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rauo *ResponseActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := rauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rauo *ResponseActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := rauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rauo *ResponseActivityUpdateOne) check() error {
+	if v, ok := rauo.mutation.Action(); ok {
+		if err := responseactivity.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ResponseActivity.action": %w`, err)}
+		}
+	}
+	if rauo.mutation.ActorCleared() && len(rauo.mutation.ActorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResponseActivity.actor"`)
+	}
+	return nil
+}
+
+func (rauo *ResponseActivityUpdateOne) sqlSave(ctx context.Context) (_node *ResponseActivity, err error) {
+	if err := rauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(responseactivity.Table, responseactivity.Columns, sqlgraph.NewFieldSpec(responseactivity.FieldID, field.TypeInt))
+	id, ok := rauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResponseActivity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responseactivity.FieldID)
+		for _, f := range fields {
+			if !responseactivity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != responseactivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rauo.mutation.Action(); ok {
+		_spec.SetField(responseactivity.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := rauo.mutation.Changes(); ok {
+		_spec.SetField(responseactivity.FieldChanges, field.TypeJSON, value)
+	}
+	if rauo.mutation.ChangesCleared() {
+		_spec.ClearField(responseactivity.FieldChanges, field.TypeJSON)
+	}
+	if rauo.mutation.ResponseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responseactivity.ResponseTable,
+			Columns: []string{responseactivity.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rauo.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responseactivity.ResponseTable,
+			Columns: []string{responseactivity.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rauo.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responseactivity.ActorTable,
+			Columns: []string{responseactivity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rauo.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responseactivity.ActorTable,
+			Columns: []string{responseactivity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ResponseActivity{config: rauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responseactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseNote is the model entity for the ResponseNote schema.
+type ResponseNote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Internal note visible only to the form owner
+	Body string `json:"body,omitempty"`
+	// User who wrote the note
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResponseNoteQuery when eager-loading is set.
+	Edges          ResponseNoteEdges `json:"edges"`
+	response_notes *int
+	selectValues   sql.SelectValues
+}
+
+// ResponseNoteEdges holds the relations/edges for other nodes in the graph.
+type ResponseNoteEdges struct {
+	// Response holds the value of the response edge.
+	Response *Response `json:"response,omitempty"`
+	// Author holds the value of the author edge.
+	Author *User `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ResponseOrErr returns the Response value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResponseNoteEdges) ResponseOrErr() (*Response, error) {
+	if e.Response != nil {
+		return e.Response, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: response.Label}
+	}
+	return nil, &NotLoadedError{edge: "response"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResponseNoteEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResponseNote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case responsenote.FieldID, responsenote.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case responsenote.FieldBody:
+			values[i] = new(sql.NullString)
+		case responsenote.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case responsenote.ForeignKeys[0]: // response_notes
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResponseNote fields.
+func (rn *ResponseNote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case responsenote.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rn.ID = int(value.Int64)
+		case responsenote.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				rn.Body = value.String
+			}
+		case responsenote.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rn.UserID = int(value.Int64)
+			}
+		case responsenote.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rn.CreatedAt = value.Time
+			}
+		case responsenote.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field response_notes", value)
+			} else if value.Valid {
+				rn.response_notes = new(int)
+				*rn.response_notes = int(value.Int64)
+			}
+		default:
+			rn.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResponseNote.
+// This includes values selected through modifiers, order, etc.
+func (rn *ResponseNote) Value(name string) (ent.Value, error) {
+	return rn.selectValues.Get(name)
+}
+
+// QueryResponse queries the "response" edge of the ResponseNote entity.
+func (rn *ResponseNote) QueryResponse() *ResponseQuery {
+	return NewResponseNoteClient(rn.config).QueryResponse(rn)
+}
+
+// QueryAuthor queries the "author" edge of the ResponseNote entity.
+func (rn *ResponseNote) QueryAuthor() *UserQuery {
+	return NewResponseNoteClient(rn.config).QueryAuthor(rn)
+}
+
+// Update returns a builder for updating this ResponseNote.
+// Note that you need to call ResponseNote.Unwrap() before calling this method if this ResponseNote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rn *ResponseNote) Update() *ResponseNoteUpdateOne {
+	return NewResponseNoteClient(rn.config).UpdateOne(rn)
+}
+
+// Unwrap unwraps the ResponseNote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rn *ResponseNote) Unwrap() *ResponseNote {
+	_tx, ok := rn.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResponseNote is not a transactional entity")
+	}
+	rn.config.driver = _tx.drv
+	return rn
+}
+
+// String implements the fmt.Stringer.
+func (rn *ResponseNote) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResponseNote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rn.ID))
+	builder.WriteString("body=")
+	builder.WriteString(rn.Body)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rn.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rn.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResponseNotes is a parsable slice of ResponseNote.
+type ResponseNotes []*ResponseNote
@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseNoteCreate is the builder for creating a ResponseNote entity.
+type ResponseNoteCreate struct {
+	config
+	mutation *ResponseNoteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBody sets the "body" field.
+func (rnc *ResponseNoteCreate) SetBody(s string) *ResponseNoteCreate {
+	rnc.mutation.SetBody(s)
+	return rnc
+}
+
+// SetUserID sets the "user_id" field.
+func (rnc *ResponseNoteCreate) SetUserID(i int) *ResponseNoteCreate {
+	rnc.mutation.SetUserID(i)
+	return rnc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rnc *ResponseNoteCreate) SetCreatedAt(t time.Time) *ResponseNoteCreate {
+	rnc.mutation.SetCreatedAt(t)
+	return rnc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rnc *ResponseNoteCreate) SetNillableCreatedAt(t *time.Time) *ResponseNoteCreate {
+	if t != nil {
+		rnc.SetCreatedAt(*t)
+	}
+	return rnc
+}
+
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (rnc *ResponseNoteCreate) SetResponseID(id int) *ResponseNoteCreate {
+	rnc.mutation.SetResponseID(id)
+	return rnc
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (rnc *ResponseNoteCreate) SetResponse(r *Response) *ResponseNoteCreate {
+	return rnc.SetResponseID(r.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (rnc *ResponseNoteCreate) SetAuthorID(id int) *ResponseNoteCreate {
+	rnc.mutation.SetAuthorID(id)
+	return rnc
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (rnc *ResponseNoteCreate) SetAuthor(u *User) *ResponseNoteCreate {
+	return rnc.SetAuthorID(u.ID)
+}
+
+// Mutation returns the ResponseNoteMutation object of the builder.
+func (rnc *ResponseNoteCreate) Mutation() *ResponseNoteMutation {
+	return rnc.mutation
+}
+
+// Save creates the ResponseNote in the database.
+func (rnc *ResponseNoteCreate) Save(ctx context.Context) (*ResponseNote, error) {
+	rnc.defaults()
+	return withHooks(ctx, rnc.sqlSave, rnc.mutation, rnc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rnc *ResponseNoteCreate) SaveX(ctx context.Context) *ResponseNote {
+	v, err := rnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rnc *ResponseNoteCreate) Exec(ctx context.Context) error {
+	_, err := rnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rnc *ResponseNoteCreate) ExecX(ctx context.Context) {
+	if err := rnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rnc *ResponseNoteCreate) defaults() {
+	if _, ok := rnc.mutation.CreatedAt(); !ok {
+		v := responsenote.DefaultCreatedAt()
+		rnc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rnc *ResponseNoteCreate) check() error {
+	if _, ok := rnc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "ResponseNote.body"`)}
+	}
+	if v, ok := rnc.mutation.Body(); ok {
+		if err := responsenote.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ResponseNote.body": %w`, err)}
+		}
+	}
+	if _, ok := rnc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ResponseNote.user_id"`)}
+	}
+	if _, ok := rnc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResponseNote.created_at"`)}
+	}
+	if len(rnc.mutation.ResponseIDs()) == 0 {
+		return &ValidationError{Name: "response", err: errors.New(`ent: missing required edge "ResponseNote.response"`)}
+	}
+	if len(rnc.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "ResponseNote.author"`)}
+	}
+	return nil
+}
+
+func (rnc *ResponseNoteCreate) sqlSave(ctx context.Context) (*ResponseNote, error) {
+	if err := rnc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rnc.mutation.id = &_node.ID
+	rnc.mutation.done = true
+	return _node, nil
+}
+
+func (rnc *ResponseNoteCreate) createSpec() (*ResponseNote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResponseNote{config: rnc.config}
+		_spec = sqlgraph.NewCreateSpec(responsenote.Table, sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rnc.conflict
+	if value, ok := rnc.mutation.Body(); ok {
+		_spec.SetField(responsenote.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := rnc.mutation.CreatedAt(); ok {
+		_spec.SetField(responsenote.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rnc.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responsenote.ResponseTable,
+			Columns: []string{responsenote.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.response_notes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rnc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsenote.AuthorTable,
+			Columns: []string{responsenote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseNote.Create().
+//		SetBody(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseNoteUpsert) {
+//			SetBody(v+v).
+//		}).
+//		Exec(ctx)
+func (rnc *ResponseNoteCreate) OnConflict(opts ...sql.ConflictOption) *ResponseNoteUpsertOne {
+	rnc.conflict = opts
+	return &ResponseNoteUpsertOne{
+		create: rnc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseNote.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rnc *ResponseNoteCreate) OnConflictColumns(columns ...string) *ResponseNoteUpsertOne {
+	rnc.conflict = append(rnc.conflict, sql.ConflictColumns(columns...))
+	return &ResponseNoteUpsertOne{
+		create: rnc,
+	}
+}
+
+type (
+	// ResponseNoteUpsertOne is the builder for "upsert"-ing
+	//  one ResponseNote node.
+	ResponseNoteUpsertOne struct {
+		create *ResponseNoteCreate
+	}
+
+	// ResponseNoteUpsert is the "OnConflict" setter.
+	ResponseNoteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBody sets the "body" field.
+func (u *ResponseNoteUpsert) SetBody(v string) *ResponseNoteUpsert {
+	u.Set(responsenote.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ResponseNoteUpsert) UpdateBody() *ResponseNoteUpsert {
+	u.SetExcluded(responsenote.FieldBody)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseNoteUpsert) SetUserID(v int) *ResponseNoteUpsert {
+	u.Set(responsenote.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseNoteUpsert) UpdateUserID() *ResponseNoteUpsert {
+	u.SetExcluded(responsenote.FieldUserID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ResponseNote.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseNoteUpsertOne) UpdateNewValues() *ResponseNoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(responsenote.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseNote.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResponseNoteUpsertOne) Ignore() *ResponseNoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseNoteUpsertOne) DoNothing() *ResponseNoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseNoteCreate.OnConflict
+// documentation for more info.
+func (u *ResponseNoteUpsertOne) Update(set func(*ResponseNoteUpsert)) *ResponseNoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseNoteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *ResponseNoteUpsertOne) SetBody(v string) *ResponseNoteUpsertOne {
+	return u.Update(func(s *ResponseNoteUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ResponseNoteUpsertOne) UpdateBody() *ResponseNoteUpsertOne {
+	return u.Update(func(s *ResponseNoteUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseNoteUpsertOne) SetUserID(v int) *ResponseNoteUpsertOne {
+	return u.Update(func(s *ResponseNoteUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseNoteUpsertOne) UpdateUserID() *ResponseNoteUpsertOne {
+	return u.Update(func(s *ResponseNoteUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseNoteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseNoteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseNoteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResponseNoteUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResponseNoteUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResponseNoteCreateBulk is the builder for creating many ResponseNote entities in bulk.
+type ResponseNoteCreateBulk struct {
+	config
+	err      error
+	builders []*ResponseNoteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ResponseNote entities in the database.
+func (rncb *ResponseNoteCreateBulk) Save(ctx context.Context) ([]*ResponseNote, error) {
+	if rncb.err != nil {
+		return nil, rncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rncb.builders))
+	nodes := make([]*ResponseNote, len(rncb.builders))
+	mutators := make([]Mutator, len(rncb.builders))
+	for i := range rncb.builders {
+		func(i int, root context.Context) {
+			builder := rncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResponseNoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rncb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rncb *ResponseNoteCreateBulk) SaveX(ctx context.Context) []*ResponseNote {
+	v, err := rncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rncb *ResponseNoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := rncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rncb *ResponseNoteCreateBulk) ExecX(ctx context.Context) {
+	if err := rncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseNote.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseNoteUpsert) {
+//			SetBody(v+v).
+//		}).
+//		Exec(ctx)
+func (rncb *ResponseNoteCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResponseNoteUpsertBulk {
+	rncb.conflict = opts
+	return &ResponseNoteUpsertBulk{
+		create: rncb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseNote.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rncb *ResponseNoteCreateBulk) OnConflictColumns(columns ...string) *ResponseNoteUpsertBulk {
+	rncb.conflict = append(rncb.conflict, sql.ConflictColumns(columns...))
+	return &ResponseNoteUpsertBulk{
+		create: rncb,
+	}
+}
+
+// ResponseNoteUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResponseNote nodes.
+type ResponseNoteUpsertBulk struct {
+	create *ResponseNoteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResponseNote.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseNoteUpsertBulk) UpdateNewValues() *ResponseNoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(responsenote.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseNote.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResponseNoteUpsertBulk) Ignore() *ResponseNoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseNoteUpsertBulk) DoNothing() *ResponseNoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseNoteCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResponseNoteUpsertBulk) Update(set func(*ResponseNoteUpsert)) *ResponseNoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseNoteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *ResponseNoteUpsertBulk) SetBody(v string) *ResponseNoteUpsertBulk {
+	return u.Update(func(s *ResponseNoteUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ResponseNoteUpsertBulk) UpdateBody() *ResponseNoteUpsertBulk {
+	return u.Update(func(s *ResponseNoteUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseNoteUpsertBulk) SetUserID(v int) *ResponseNoteUpsertBulk {
+	return u.Update(func(s *ResponseNoteUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseNoteUpsertBulk) UpdateUserID() *ResponseNoteUpsertBulk {
+	return u.Update(func(s *ResponseNoteUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseNoteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ResponseNoteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseNoteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseNoteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
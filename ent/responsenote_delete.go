@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/responsenote"
+)
+
+// ResponseNoteDelete is the builder for deleting a ResponseNote entity.
+type ResponseNoteDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResponseNoteMutation
+}
+
+// Where appends a list predicates to the ResponseNoteDelete builder.
+func (rnd *ResponseNoteDelete) Where(ps ...predicate.ResponseNote) *ResponseNoteDelete {
+	rnd.mutation.Where(ps...)
+	return rnd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rnd *ResponseNoteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rnd.sqlExec, rnd.mutation, rnd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rnd *ResponseNoteDelete) ExecX(ctx context.Context) int {
+	n, err := rnd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rnd *ResponseNoteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(responsenote.Table, sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt))
+	if ps := rnd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rnd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rnd.mutation.done = true
+	return affected, err
+}
+
+// ResponseNoteDeleteOne is the builder for deleting a single ResponseNote entity.
+type ResponseNoteDeleteOne struct {
+	rnd *ResponseNoteDelete
+}
+
+// Where appends a list predicates to the ResponseNoteDelete builder.
+func (rndo *ResponseNoteDeleteOne) Where(ps ...predicate.ResponseNote) *ResponseNoteDeleteOne {
+	rndo.rnd.mutation.Where(ps...)
+	return rndo
+}
+
+// Exec executes the deletion query.
+func (rndo *ResponseNoteDeleteOne) Exec(ctx context.Context) error {
+	n, err := rndo.rnd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{responsenote.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rndo *ResponseNoteDeleteOne) ExecX(ctx context.Context) {
+	if err := rndo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
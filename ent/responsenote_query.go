@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseNoteQuery is the builder for querying ResponseNote entities.
+type ResponseNoteQuery struct {
+	config
+	ctx          *QueryContext
+	order        []responsenote.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ResponseNote
+	withResponse *ResponseQuery
+	withAuthor   *UserQuery
+	withFKs      bool
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResponseNoteQuery builder.
+func (rnq *ResponseNoteQuery) Where(ps ...predicate.ResponseNote) *ResponseNoteQuery {
+	rnq.predicates = append(rnq.predicates, ps...)
+	return rnq
+}
+
+// Limit the number of records to be returned by this query.
+func (rnq *ResponseNoteQuery) Limit(limit int) *ResponseNoteQuery {
+	rnq.ctx.Limit = &limit
+	return rnq
+}
+
+// Offset to start from.
+func (rnq *ResponseNoteQuery) Offset(offset int) *ResponseNoteQuery {
+	rnq.ctx.Offset = &offset
+	return rnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rnq *ResponseNoteQuery) Unique(unique bool) *ResponseNoteQuery {
+	rnq.ctx.Unique = &unique
+	return rnq
+}
+
+// Order specifies how the records should be ordered.
+func (rnq *ResponseNoteQuery) Order(o ...responsenote.OrderOption) *ResponseNoteQuery {
+	rnq.order = append(rnq.order, o...)
+	return rnq
+}
+
+// QueryResponse chains the current query on the "response" edge.
+func (rnq *ResponseNoteQuery) QueryResponse() *ResponseQuery {
+	query := (&ResponseClient{config: rnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(responsenote.Table, responsenote.FieldID, selector),
+			sqlgraph.To(response.Table, response.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, responsenote.ResponseTable, responsenote.ResponseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuthor chains the current query on the "author" edge.
+func (rnq *ResponseNoteQuery) QueryAuthor() *UserQuery {
+	query := (&UserClient{config: rnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(responsenote.Table, responsenote.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, responsenote.AuthorTable, responsenote.AuthorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResponseNote entity from the query.
+// Returns a *NotFoundError when no ResponseNote was found.
+func (rnq *ResponseNoteQuery) First(ctx context.Context) (*ResponseNote, error) {
+	nodes, err := rnq.Limit(1).All(setContextOp(ctx, rnq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{responsenote.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rnq *ResponseNoteQuery) FirstX(ctx context.Context) *ResponseNote {
+	node, err := rnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResponseNote ID from the query.
+// Returns a *NotFoundError when no ResponseNote ID was found.
+func (rnq *ResponseNoteQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rnq.Limit(1).IDs(setContextOp(ctx, rnq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{responsenote.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rnq *ResponseNoteQuery) FirstIDX(ctx context.Context) int {
+	id, err := rnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResponseNote entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResponseNote entity is found.
+// Returns a *NotFoundError when no ResponseNote entities are found.
+func (rnq *ResponseNoteQuery) Only(ctx context.Context) (*ResponseNote, error) {
+	nodes, err := rnq.Limit(2).All(setContextOp(ctx, rnq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{responsenote.Label}
+	default:
+		return nil, &NotSingularError{responsenote.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rnq *ResponseNoteQuery) OnlyX(ctx context.Context) *ResponseNote {
+	node, err := rnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResponseNote ID in the query.
+// Returns a *NotSingularError when more than one ResponseNote ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rnq *ResponseNoteQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rnq.Limit(2).IDs(setContextOp(ctx, rnq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{responsenote.Label}
+	default:
+		err = &NotSingularError{responsenote.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rnq *ResponseNoteQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResponseNotes.
+func (rnq *ResponseNoteQuery) All(ctx context.Context) ([]*ResponseNote, error) {
+	ctx = setContextOp(ctx, rnq.ctx, ent.OpQueryAll)
+	if err := rnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResponseNote, *ResponseNoteQuery]()
+	return withInterceptors[[]*ResponseNote](ctx, rnq, qr, rnq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rnq *ResponseNoteQuery) AllX(ctx context.Context) []*ResponseNote {
+	nodes, err := rnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResponseNote IDs.
+func (rnq *ResponseNoteQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rnq.ctx.Unique == nil && rnq.path != nil {
+		rnq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rnq.ctx, ent.OpQueryIDs)
+	if err = rnq.Select(responsenote.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rnq *ResponseNoteQuery) IDsX(ctx context.Context) []int {
+	ids, err := rnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rnq *ResponseNoteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rnq.ctx, ent.OpQueryCount)
+	if err := rnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rnq, querierCount[*ResponseNoteQuery](), rnq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rnq *ResponseNoteQuery) CountX(ctx context.Context) int {
+	count, err := rnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rnq *ResponseNoteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rnq.ctx, ent.OpQueryExist)
+	switch _, err := rnq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rnq *ResponseNoteQuery) ExistX(ctx context.Context) bool {
+	exist, err := rnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResponseNoteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rnq *ResponseNoteQuery) Clone() *ResponseNoteQuery {
+	if rnq == nil {
+		return nil
+	}
+	return &ResponseNoteQuery{
+		config:       rnq.config,
+		ctx:          rnq.ctx.Clone(),
+		order:        append([]responsenote.OrderOption{}, rnq.order...),
+		inters:       append([]Interceptor{}, rnq.inters...),
+		predicates:   append([]predicate.ResponseNote{}, rnq.predicates...),
+		withResponse: rnq.withResponse.Clone(),
+		withAuthor:   rnq.withAuthor.Clone(),
+		// clone intermediate query.
+		sql:  rnq.sql.Clone(),
+		path: rnq.path,
+	}
+}
+
+// WithResponse tells the query-builder to eager-load the nodes that are connected to
+// the "response" edge. The optional arguments are used to configure the query builder of the edge.
+func (rnq *ResponseNoteQuery) WithResponse(opts ...func(*ResponseQuery)) *ResponseNoteQuery {
+	query := (&ResponseClient{config: rnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rnq.withResponse = query
+	return rnq
+}
+
+// WithAuthor tells the query-builder to eager-load the nodes that are connected to
+// the "author" edge. The optional arguments are used to configure the query builder of the edge.
+func (rnq *ResponseNoteQuery) WithAuthor(opts ...func(*UserQuery)) *ResponseNoteQuery {
+	query := (&UserClient{config: rnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rnq.withAuthor = query
+	return rnq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Body string `json:"body,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResponseNote.Query().
+//		GroupBy(responsenote.FieldBody).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rnq *ResponseNoteQuery) GroupBy(field string, fields ...string) *ResponseNoteGroupBy {
+	rnq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResponseNoteGroupBy{build: rnq}
+	grbuild.flds = &rnq.ctx.Fields
+	grbuild.label = responsenote.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Body string `json:"body,omitempty"`
+//	}
+//
+//	client.ResponseNote.Query().
+//		Select(responsenote.FieldBody).
+//		Scan(ctx, &v)
+func (rnq *ResponseNoteQuery) Select(fields ...string) *ResponseNoteSelect {
+	rnq.ctx.Fields = append(rnq.ctx.Fields, fields...)
+	sbuild := &ResponseNoteSelect{ResponseNoteQuery: rnq}
+	sbuild.label = responsenote.Label
+	sbuild.flds, sbuild.scan = &rnq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResponseNoteSelect configured with the given aggregations.
+func (rnq *ResponseNoteQuery) Aggregate(fns ...AggregateFunc) *ResponseNoteSelect {
+	return rnq.Select().Aggregate(fns...)
+}
+
+func (rnq *ResponseNoteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rnq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rnq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rnq.ctx.Fields {
+		if !responsenote.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rnq.path != nil {
+		prev, err := rnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rnq.sql = prev
+	}
+	return nil
+}
+
+func (rnq *ResponseNoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResponseNote, error) {
+	var (
+		nodes       = []*ResponseNote{}
+		withFKs     = rnq.withFKs
+		_spec       = rnq.querySpec()
+		loadedTypes = [2]bool{
+			rnq.withResponse != nil,
+			rnq.withAuthor != nil,
+		}
+	)
+	if rnq.withResponse != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, responsenote.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResponseNote).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResponseNote{config: rnq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rnq.modifiers) > 0 {
+		_spec.Modifiers = rnq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rnq.withResponse; query != nil {
+		if err := rnq.loadResponse(ctx, query, nodes, nil,
+			func(n *ResponseNote, e *Response) { n.Edges.Response = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rnq.withAuthor; query != nil {
+		if err := rnq.loadAuthor(ctx, query, nodes, nil,
+			func(n *ResponseNote, e *User) { n.Edges.Author = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rnq *ResponseNoteQuery) loadResponse(ctx context.Context, query *ResponseQuery, nodes []*ResponseNote, init func(*ResponseNote), assign func(*ResponseNote, *Response)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ResponseNote)
+	for i := range nodes {
+		if nodes[i].response_notes == nil {
+			continue
+		}
+		fk := *nodes[i].response_notes
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(response.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "response_notes" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rnq *ResponseNoteQuery) loadAuthor(ctx context.Context, query *UserQuery, nodes []*ResponseNote, init func(*ResponseNote), assign func(*ResponseNote, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ResponseNote)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rnq *ResponseNoteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rnq.querySpec()
+	if len(rnq.modifiers) > 0 {
+		_spec.Modifiers = rnq.modifiers
+	}
+	_spec.Node.Columns = rnq.ctx.Fields
+	if len(rnq.ctx.Fields) > 0 {
+		_spec.Unique = rnq.ctx.Unique != nil && *rnq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rnq.driver, _spec)
+}
+
+func (rnq *ResponseNoteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(responsenote.Table, responsenote.Columns, sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt))
+	_spec.From = rnq.sql
+	if unique := rnq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rnq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rnq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responsenote.FieldID)
+		for i := range fields {
+			if fields[i] != responsenote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rnq.withAuthor != nil {
+			_spec.Node.AddColumnOnce(responsenote.FieldUserID)
+		}
+	}
+	if ps := rnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rnq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rnq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rnq *ResponseNoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rnq.driver.Dialect())
+	t1 := builder.Table(responsenote.Table)
+	columns := rnq.ctx.Fields
+	if len(columns) == 0 {
+		columns = responsenote.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rnq.sql != nil {
+		selector = rnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rnq.ctx.Unique != nil && *rnq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rnq.modifiers {
+		m(selector)
+	}
+	for _, p := range rnq.predicates {
+		p(selector)
+	}
+	for _, p := range rnq.order {
+		p(selector)
+	}
+	if offset := rnq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rnq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rnq *ResponseNoteQuery) ForUpdate(opts ...sql.LockOption) *ResponseNoteQuery {
+	if rnq.driver.Dialect() == dialect.Postgres {
+		rnq.Unique(false)
+	}
+	rnq.modifiers = append(rnq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rnq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rnq *ResponseNoteQuery) ForShare(opts ...sql.LockOption) *ResponseNoteQuery {
+	if rnq.driver.Dialect() == dialect.Postgres {
+		rnq.Unique(false)
+	}
+	rnq.modifiers = append(rnq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rnq
+}
+
+// ResponseNoteGroupBy is the group-by builder for ResponseNote entities.
+type ResponseNoteGroupBy struct {
+	selector
+	build *ResponseNoteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rngb *ResponseNoteGroupBy) Aggregate(fns ...AggregateFunc) *ResponseNoteGroupBy {
+	rngb.fns = append(rngb.fns, fns...)
+	return rngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rngb *ResponseNoteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rngb.build.ctx, ent.OpQueryGroupBy)
+	if err := rngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResponseNoteQuery, *ResponseNoteGroupBy](ctx, rngb.build, rngb, rngb.build.inters, v)
+}
+
+func (rngb *ResponseNoteGroupBy) sqlScan(ctx context.Context, root *ResponseNoteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rngb.fns))
+	for _, fn := range rngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rngb.flds)+len(rngb.fns))
+		for _, f := range *rngb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rngb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rngb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResponseNoteSelect is the builder for selecting fields of ResponseNote entities.
+type ResponseNoteSelect struct {
+	*ResponseNoteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rns *ResponseNoteSelect) Aggregate(fns ...AggregateFunc) *ResponseNoteSelect {
+	rns.fns = append(rns.fns, fns...)
+	return rns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rns *ResponseNoteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rns.ctx, ent.OpQuerySelect)
+	if err := rns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResponseNoteQuery, *ResponseNoteSelect](ctx, rns.ResponseNoteQuery, rns, rns.inters, v)
+}
+
+func (rns *ResponseNoteSelect) sqlScan(ctx context.Context, root *ResponseNoteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rns.fns))
+	for _, fn := range rns.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
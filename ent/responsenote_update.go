@@ -0,0 +1,461 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseNoteUpdate is the builder for updating ResponseNote entities.
+type ResponseNoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResponseNoteMutation
+}
+
+// Where appends a list predicates to the ResponseNoteUpdate builder.
+func (rnu *ResponseNoteUpdate) Where(ps ...predicate.ResponseNote) *ResponseNoteUpdate {
+	rnu.mutation.Where(ps...)
+	return rnu
+}
+
+// SetBody sets the "body" field.
+func (rnu *ResponseNoteUpdate) SetBody(s string) *ResponseNoteUpdate {
+	rnu.mutation.SetBody(s)
+	return rnu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (rnu *ResponseNoteUpdate) SetNillableBody(s *string) *ResponseNoteUpdate {
+	if s != nil {
+		rnu.SetBody(*s)
+	}
+	return rnu
+}
+
+// SetUserID sets the "user_id" field.
+func (rnu *ResponseNoteUpdate) SetUserID(i int) *ResponseNoteUpdate {
+	rnu.mutation.SetUserID(i)
+	return rnu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rnu *ResponseNoteUpdate) SetNillableUserID(i *int) *ResponseNoteUpdate {
+	if i != nil {
+		rnu.SetUserID(*i)
+	}
+	return rnu
+}
+
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (rnu *ResponseNoteUpdate) SetResponseID(id int) *ResponseNoteUpdate {
+	rnu.mutation.SetResponseID(id)
+	return rnu
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (rnu *ResponseNoteUpdate) SetResponse(r *Response) *ResponseNoteUpdate {
+	return rnu.SetResponseID(r.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (rnu *ResponseNoteUpdate) SetAuthorID(id int) *ResponseNoteUpdate {
+	rnu.mutation.SetAuthorID(id)
+	return rnu
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (rnu *ResponseNoteUpdate) SetAuthor(u *User) *ResponseNoteUpdate {
+	return rnu.SetAuthorID(u.ID)
+}
+
+// Mutation returns the ResponseNoteMutation object of the builder.
+func (rnu *ResponseNoteUpdate) Mutation() *ResponseNoteMutation {
+	return rnu.mutation
+}
+
+// ClearResponse clears the "response" edge to the Response entity.
+func (rnu *ResponseNoteUpdate) ClearResponse() *ResponseNoteUpdate {
+	rnu.mutation.ClearResponse()
+	return rnu
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (rnu *ResponseNoteUpdate) ClearAuthor() *ResponseNoteUpdate {
+	rnu.mutation.ClearAuthor()
+	return rnu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rnu *ResponseNoteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rnu.sqlSave, rnu.mutation, rnu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rnu *ResponseNoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := rnu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rnu *ResponseNoteUpdate) Exec(ctx context.Context) error {
+	_, err := rnu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rnu *ResponseNoteUpdate) ExecX(ctx context.Context) {
+	if err := rnu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rnu *ResponseNoteUpdate) check() error {
+	if v, ok := rnu.mutation.Body(); ok {
+		if err := responsenote.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ResponseNote.body": %w`, err)}
+		}
+	}
+	if rnu.mutation.ResponseCleared() && len(rnu.mutation.ResponseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResponseNote.response"`)
+	}
+	if rnu.mutation.AuthorCleared() && len(rnu.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResponseNote.author"`)
+	}
+	return nil
+}
+
+func (rnu *ResponseNoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rnu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(responsenote.Table, responsenote.Columns, sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt))
+	if ps := rnu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rnu.mutation.Body(); ok {
+		_spec.SetField(responsenote.FieldBody, field.TypeString, value)
+	}
+	if rnu.mutation.ResponseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responsenote.ResponseTable,
+			Columns: []string{responsenote.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rnu.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responsenote.ResponseTable,
+			Columns: []string{responsenote.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rnu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsenote.AuthorTable,
+			Columns: []string{responsenote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rnu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsenote.AuthorTable,
+			Columns: []string{responsenote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rnu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsenote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rnu.mutation.done = true
+	return n, nil
+}
+
+// ResponseNoteUpdateOne is the builder for updating a single ResponseNote entity.
+type ResponseNoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResponseNoteMutation
+}
+
+// SetBody sets the "body" field.
+func (rnuo *ResponseNoteUpdateOne) SetBody(s string) *ResponseNoteUpdateOne {
+	rnuo.mutation.SetBody(s)
+	return rnuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (rnuo *ResponseNoteUpdateOne) SetNillableBody(s *string) *ResponseNoteUpdateOne {
+	if s != nil {
+		rnuo.SetBody(*s)
+	}
+	return rnuo
+}
+
+// SetUserID sets the "user_id" field.
+func (rnuo *ResponseNoteUpdateOne) SetUserID(i int) *ResponseNoteUpdateOne {
+	rnuo.mutation.SetUserID(i)
+	return rnuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rnuo *ResponseNoteUpdateOne) SetNillableUserID(i *int) *ResponseNoteUpdateOne {
+	if i != nil {
+		rnuo.SetUserID(*i)
+	}
+	return rnuo
+}
+
+// SetResponseID sets the "response" edge to the Response entity by ID.
+func (rnuo *ResponseNoteUpdateOne) SetResponseID(id int) *ResponseNoteUpdateOne {
+	rnuo.mutation.SetResponseID(id)
+	return rnuo
+}
+
+// SetResponse sets the "response" edge to the Response entity.
+func (rnuo *ResponseNoteUpdateOne) SetResponse(r *Response) *ResponseNoteUpdateOne {
+	return rnuo.SetResponseID(r.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (rnuo *ResponseNoteUpdateOne) SetAuthorID(id int) *ResponseNoteUpdateOne {
+	rnuo.mutation.SetAuthorID(id)
+	return rnuo
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (rnuo *ResponseNoteUpdateOne) SetAuthor(u *User) *ResponseNoteUpdateOne {
+	return rnuo.SetAuthorID(u.ID)
+}
+
+// Mutation returns the ResponseNoteMutation object of the builder.
+func (rnuo *ResponseNoteUpdateOne) Mutation() *ResponseNoteMutation {
+	return rnuo.mutation
+}
+
+// ClearResponse clears the "response" edge to the Response entity.
+func (rnuo *ResponseNoteUpdateOne) ClearResponse() *ResponseNoteUpdateOne {
+	rnuo.mutation.ClearResponse()
+	return rnuo
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (rnuo *ResponseNoteUpdateOne) ClearAuthor() *ResponseNoteUpdateOne {
+	rnuo.mutation.ClearAuthor()
+	return rnuo
+}
+
+// Where appends a list predicates to the ResponseNoteUpdate builder.
+func (rnuo *ResponseNoteUpdateOne) Where(ps ...predicate.ResponseNote) *ResponseNoteUpdateOne {
+	rnuo.mutation.Where(ps...)
+	return rnuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rnuo *ResponseNoteUpdateOne) Select(field string, fields ...string) *ResponseNoteUpdateOne {
+	rnuo.fields = append([]string{field}, fields...)
+	return rnuo
+}
+
+// Save executes the query and returns the updated ResponseNote entity.
+func (rnuo *ResponseNoteUpdateOne) Save(ctx context.Context) (*ResponseNote, error) {
+	return withHooks(ctx, rnuo.sqlSave, rnuo.mutation, rnuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rnuo *ResponseNoteUpdateOne) SaveX(ctx context.Context) *ResponseNote {
+	node, err := rnuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rnuo *ResponseNoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := rnuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rnuo *ResponseNoteUpdateOne) ExecX(ctx context.Context) {
+	if err := rnuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rnuo *ResponseNoteUpdateOne) check() error {
+	if v, ok := rnuo.mutation.Body(); ok {
+		if err := responsenote.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ResponseNote.body": %w`, err)}
+		}
+	}
+	if rnuo.mutation.ResponseCleared() && len(rnuo.mutation.ResponseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResponseNote.response"`)
+	}
+	if rnuo.mutation.AuthorCleared() && len(rnuo.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResponseNote.author"`)
+	}
+	return nil
+}
+
+func (rnuo *ResponseNoteUpdateOne) sqlSave(ctx context.Context) (_node *ResponseNote, err error) {
+	if err := rnuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(responsenote.Table, responsenote.Columns, sqlgraph.NewFieldSpec(responsenote.FieldID, field.TypeInt))
+	id, ok := rnuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResponseNote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rnuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responsenote.FieldID)
+		for _, f := range fields {
+			if !responsenote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != responsenote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rnuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rnuo.mutation.Body(); ok {
+		_spec.SetField(responsenote.FieldBody, field.TypeString, value)
+	}
+	if rnuo.mutation.ResponseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responsenote.ResponseTable,
+			Columns: []string{responsenote.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rnuo.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   responsenote.ResponseTable,
+			Columns: []string{responsenote.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rnuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsenote.AuthorTable,
+			Columns: []string{responsenote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rnuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsenote.AuthorTable,
+			Columns: []string{responsenote.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ResponseNote{config: rnuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rnuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsenote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rnuo.mutation.done = true
+	return _node, nil
+}
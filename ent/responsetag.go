@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/responsetag"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseTag is the model entity for the ResponseTag schema.
+type ResponseTag struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Tag label defined by the form owner
+	Name string `json:"name,omitempty"`
+	// Form owner who defined the tag
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResponseTagQuery when eager-loading is set.
+	Edges        ResponseTagEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ResponseTagEdges holds the relations/edges for other nodes in the graph.
+type ResponseTagEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Responses holds the value of the responses edge.
+	Responses []*Response `json:"responses,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResponseTagEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// ResponsesOrErr returns the Responses value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResponseTagEdges) ResponsesOrErr() ([]*Response, error) {
+	if e.loadedTypes[1] {
+		return e.Responses, nil
+	}
+	return nil, &NotLoadedError{edge: "responses"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResponseTag) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case responsetag.FieldID, responsetag.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case responsetag.FieldName:
+			values[i] = new(sql.NullString)
+		case responsetag.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResponseTag fields.
+func (rt *ResponseTag) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case responsetag.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rt.ID = int(value.Int64)
+		case responsetag.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				rt.Name = value.String
+			}
+		case responsetag.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rt.UserID = int(value.Int64)
+			}
+		case responsetag.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rt.CreatedAt = value.Time
+			}
+		default:
+			rt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResponseTag.
+// This includes values selected through modifiers, order, etc.
+func (rt *ResponseTag) Value(name string) (ent.Value, error) {
+	return rt.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the ResponseTag entity.
+func (rt *ResponseTag) QueryOwner() *UserQuery {
+	return NewResponseTagClient(rt.config).QueryOwner(rt)
+}
+
+// QueryResponses queries the "responses" edge of the ResponseTag entity.
+func (rt *ResponseTag) QueryResponses() *ResponseQuery {
+	return NewResponseTagClient(rt.config).QueryResponses(rt)
+}
+
+// Update returns a builder for updating this ResponseTag.
+// Note that you need to call ResponseTag.Unwrap() before calling this method if this ResponseTag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *ResponseTag) Update() *ResponseTagUpdateOne {
+	return NewResponseTagClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the ResponseTag entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *ResponseTag) Unwrap() *ResponseTag {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResponseTag is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *ResponseTag) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResponseTag(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("name=")
+	builder.WriteString(rt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rt.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResponseTags is a parsable slice of ResponseTag.
+type ResponseTags []*ResponseTag
@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responsetag"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseTagCreate is the builder for creating a ResponseTag entity.
+type ResponseTagCreate struct {
+	config
+	mutation *ResponseTagMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (rtc *ResponseTagCreate) SetName(s string) *ResponseTagCreate {
+	rtc.mutation.SetName(s)
+	return rtc
+}
+
+// SetUserID sets the "user_id" field.
+func (rtc *ResponseTagCreate) SetUserID(i int) *ResponseTagCreate {
+	rtc.mutation.SetUserID(i)
+	return rtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *ResponseTagCreate) SetCreatedAt(t time.Time) *ResponseTagCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *ResponseTagCreate) SetNillableCreatedAt(t *time.Time) *ResponseTagCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (rtc *ResponseTagCreate) SetOwnerID(id int) *ResponseTagCreate {
+	rtc.mutation.SetOwnerID(id)
+	return rtc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (rtc *ResponseTagCreate) SetOwner(u *User) *ResponseTagCreate {
+	return rtc.SetOwnerID(u.ID)
+}
+
+// AddResponseIDs adds the "responses" edge to the Response entity by IDs.
+func (rtc *ResponseTagCreate) AddResponseIDs(ids ...int) *ResponseTagCreate {
+	rtc.mutation.AddResponseIDs(ids...)
+	return rtc
+}
+
+// AddResponses adds the "responses" edges to the Response entity.
+func (rtc *ResponseTagCreate) AddResponses(r ...*Response) *ResponseTagCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rtc.AddResponseIDs(ids...)
+}
+
+// Mutation returns the ResponseTagMutation object of the builder.
+func (rtc *ResponseTagCreate) Mutation() *ResponseTagMutation {
+	return rtc.mutation
+}
+
+// Save creates the ResponseTag in the database.
+func (rtc *ResponseTagCreate) Save(ctx context.Context) (*ResponseTag, error) {
+	rtc.defaults()
+	return withHooks(ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *ResponseTagCreate) SaveX(ctx context.Context) *ResponseTag {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *ResponseTagCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *ResponseTagCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *ResponseTagCreate) defaults() {
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		v := responsetag.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *ResponseTagCreate) check() error {
+	if _, ok := rtc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ResponseTag.name"`)}
+	}
+	if v, ok := rtc.mutation.Name(); ok {
+		if err := responsetag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ResponseTag.name": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ResponseTag.user_id"`)}
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResponseTag.created_at"`)}
+	}
+	if len(rtc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "ResponseTag.owner"`)}
+	}
+	return nil
+}
+
+func (rtc *ResponseTagCreate) sqlSave(ctx context.Context) (*ResponseTag, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *ResponseTagCreate) createSpec() (*ResponseTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResponseTag{config: rtc.config}
+		_spec = sqlgraph.NewCreateSpec(responsetag.Table, sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rtc.conflict
+	if value, ok := rtc.mutation.Name(); ok {
+		_spec.SetField(responsetag.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(responsetag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rtc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsetag.OwnerTable,
+			Columns: []string{responsetag.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rtc.mutation.ResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   responsetag.ResponsesTable,
+			Columns: responsetag.ResponsesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseTag.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseTagUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rtc *ResponseTagCreate) OnConflict(opts ...sql.ConflictOption) *ResponseTagUpsertOne {
+	rtc.conflict = opts
+	return &ResponseTagUpsertOne{
+		create: rtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rtc *ResponseTagCreate) OnConflictColumns(columns ...string) *ResponseTagUpsertOne {
+	rtc.conflict = append(rtc.conflict, sql.ConflictColumns(columns...))
+	return &ResponseTagUpsertOne{
+		create: rtc,
+	}
+}
+
+type (
+	// ResponseTagUpsertOne is the builder for "upsert"-ing
+	//  one ResponseTag node.
+	ResponseTagUpsertOne struct {
+		create *ResponseTagCreate
+	}
+
+	// ResponseTagUpsert is the "OnConflict" setter.
+	ResponseTagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *ResponseTagUpsert) SetName(v string) *ResponseTagUpsert {
+	u.Set(responsetag.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResponseTagUpsert) UpdateName() *ResponseTagUpsert {
+	u.SetExcluded(responsetag.FieldName)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseTagUpsert) SetUserID(v int) *ResponseTagUpsert {
+	u.Set(responsetag.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseTagUpsert) UpdateUserID() *ResponseTagUpsert {
+	u.SetExcluded(responsetag.FieldUserID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ResponseTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseTagUpsertOne) UpdateNewValues() *ResponseTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(responsetag.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseTag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResponseTagUpsertOne) Ignore() *ResponseTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseTagUpsertOne) DoNothing() *ResponseTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseTagCreate.OnConflict
+// documentation for more info.
+func (u *ResponseTagUpsertOne) Update(set func(*ResponseTagUpsert)) *ResponseTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ResponseTagUpsertOne) SetName(v string) *ResponseTagUpsertOne {
+	return u.Update(func(s *ResponseTagUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResponseTagUpsertOne) UpdateName() *ResponseTagUpsertOne {
+	return u.Update(func(s *ResponseTagUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseTagUpsertOne) SetUserID(v int) *ResponseTagUpsertOne {
+	return u.Update(func(s *ResponseTagUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseTagUpsertOne) UpdateUserID() *ResponseTagUpsertOne {
+	return u.Update(func(s *ResponseTagUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseTagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseTagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseTagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResponseTagUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResponseTagUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResponseTagCreateBulk is the builder for creating many ResponseTag entities in bulk.
+type ResponseTagCreateBulk struct {
+	config
+	err      error
+	builders []*ResponseTagCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ResponseTag entities in the database.
+func (rtcb *ResponseTagCreateBulk) Save(ctx context.Context) ([]*ResponseTag, error) {
+	if rtcb.err != nil {
+		return nil, rtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*ResponseTag, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResponseTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *ResponseTagCreateBulk) SaveX(ctx context.Context) []*ResponseTag {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *ResponseTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *ResponseTagCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseTag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseTagUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rtcb *ResponseTagCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResponseTagUpsertBulk {
+	rtcb.conflict = opts
+	return &ResponseTagUpsertBulk{
+		create: rtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rtcb *ResponseTagCreateBulk) OnConflictColumns(columns ...string) *ResponseTagUpsertBulk {
+	rtcb.conflict = append(rtcb.conflict, sql.ConflictColumns(columns...))
+	return &ResponseTagUpsertBulk{
+		create: rtcb,
+	}
+}
+
+// ResponseTagUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResponseTag nodes.
+type ResponseTagUpsertBulk struct {
+	create *ResponseTagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResponseTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseTagUpsertBulk) UpdateNewValues() *ResponseTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(responsetag.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseTag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResponseTagUpsertBulk) Ignore() *ResponseTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseTagUpsertBulk) DoNothing() *ResponseTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseTagCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResponseTagUpsertBulk) Update(set func(*ResponseTagUpsert)) *ResponseTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ResponseTagUpsertBulk) SetName(v string) *ResponseTagUpsertBulk {
+	return u.Update(func(s *ResponseTagUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResponseTagUpsertBulk) UpdateName() *ResponseTagUpsertBulk {
+	return u.Update(func(s *ResponseTagUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ResponseTagUpsertBulk) SetUserID(v int) *ResponseTagUpsertBulk {
+	return u.Update(func(s *ResponseTagUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ResponseTagUpsertBulk) UpdateUserID() *ResponseTagUpsertBulk {
+	return u.Update(func(s *ResponseTagUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ResponseTagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseTagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseTagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responsetag"
+	"github.com/occult/pagode/ent/user"
+)
+
+// ResponseTagUpdate is the builder for updating ResponseTag entities.
+type ResponseTagUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResponseTagMutation
+}
+
+// Where appends a list predicates to the ResponseTagUpdate builder.
+func (rtu *ResponseTagUpdate) Where(ps ...predicate.ResponseTag) *ResponseTagUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetName sets the "name" field.
+func (rtu *ResponseTagUpdate) SetName(s string) *ResponseTagUpdate {
+	rtu.mutation.SetName(s)
+	return rtu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rtu *ResponseTagUpdate) SetNillableName(s *string) *ResponseTagUpdate {
+	if s != nil {
+		rtu.SetName(*s)
+	}
+	return rtu
+}
+
+// SetUserID sets the "user_id" field.
+func (rtu *ResponseTagUpdate) SetUserID(i int) *ResponseTagUpdate {
+	rtu.mutation.SetUserID(i)
+	return rtu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rtu *ResponseTagUpdate) SetNillableUserID(i *int) *ResponseTagUpdate {
+	if i != nil {
+		rtu.SetUserID(*i)
+	}
+	return rtu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (rtu *ResponseTagUpdate) SetOwnerID(id int) *ResponseTagUpdate {
+	rtu.mutation.SetOwnerID(id)
+	return rtu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (rtu *ResponseTagUpdate) SetOwner(u *User) *ResponseTagUpdate {
+	return rtu.SetOwnerID(u.ID)
+}
+
+// AddResponseIDs adds the "responses" edge to the Response entity by IDs.
+func (rtu *ResponseTagUpdate) AddResponseIDs(ids ...int) *ResponseTagUpdate {
+	rtu.mutation.AddResponseIDs(ids...)
+	return rtu
+}
+
+// AddResponses adds the "responses" edges to the Response entity.
+func (rtu *ResponseTagUpdate) AddResponses(r ...*Response) *ResponseTagUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rtu.AddResponseIDs(ids...)
+}
+
+// Mutation returns the ResponseTagMutation object of the builder.
+func (rtu *ResponseTagUpdate) Mutation() *ResponseTagMutation {
+	return rtu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (rtu *ResponseTagUpdate) ClearOwner() *ResponseTagUpdate {
+	rtu.mutation.ClearOwner()
+	return rtu
+}
+
+// ClearResponses clears all "responses" edges to the Response entity.
+func (rtu *ResponseTagUpdate) ClearResponses() *ResponseTagUpdate {
+	rtu.mutation.ClearResponses()
+	return rtu
+}
+
+// RemoveResponseIDs removes the "responses" edge to Response entities by IDs.
+func (rtu *ResponseTagUpdate) RemoveResponseIDs(ids ...int) *ResponseTagUpdate {
+	rtu.mutation.RemoveResponseIDs(ids...)
+	return rtu
+}
+
+// RemoveResponses removes "responses" edges to Response entities.
+func (rtu *ResponseTagUpdate) RemoveResponses(r ...*Response) *ResponseTagUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rtu.RemoveResponseIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *ResponseTagUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *ResponseTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *ResponseTagUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *ResponseTagUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *ResponseTagUpdate) check() error {
+	if v, ok := rtu.mutation.Name(); ok {
+		if err := responsetag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ResponseTag.name": %w`, err)}
+		}
+	}
+	if rtu.mutation.OwnerCleared() && len(rtu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResponseTag.owner"`)
+	}
+	return nil
+}
+
+func (rtu *ResponseTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(responsetag.Table, responsetag.Columns, sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.Name(); ok {
+		_spec.SetField(responsetag.FieldName, field.TypeString, value)
+	}
+	if rtu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsetag.OwnerTable,
+			Columns: []string{responsetag.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsetag.OwnerTable,
+			Columns: []string{responsetag.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rtu.mutation.ResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   responsetag.ResponsesTable,
+			Columns: responsetag.ResponsesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtu.mutation.RemovedResponsesIDs(); len(nodes) > 0 && !rtu.mutation.ResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   responsetag.ResponsesTable,
+			Columns: responsetag.ResponsesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtu.mutation.ResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   responsetag.ResponsesTable,
+			Columns: responsetag.ResponsesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsetag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// ResponseTagUpdateOne is the builder for updating a single ResponseTag entity.
+type ResponseTagUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResponseTagMutation
+}
+
+// SetName sets the "name" field.
+func (rtuo *ResponseTagUpdateOne) SetName(s string) *ResponseTagUpdateOne {
+	rtuo.mutation.SetName(s)
+	return rtuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rtuo *ResponseTagUpdateOne) SetNillableName(s *string) *ResponseTagUpdateOne {
+	if s != nil {
+		rtuo.SetName(*s)
+	}
+	return rtuo
+}
+
+// SetUserID sets the "user_id" field.
+func (rtuo *ResponseTagUpdateOne) SetUserID(i int) *ResponseTagUpdateOne {
+	rtuo.mutation.SetUserID(i)
+	return rtuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rtuo *ResponseTagUpdateOne) SetNillableUserID(i *int) *ResponseTagUpdateOne {
+	if i != nil {
+		rtuo.SetUserID(*i)
+	}
+	return rtuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (rtuo *ResponseTagUpdateOne) SetOwnerID(id int) *ResponseTagUpdateOne {
+	rtuo.mutation.SetOwnerID(id)
+	return rtuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (rtuo *ResponseTagUpdateOne) SetOwner(u *User) *ResponseTagUpdateOne {
+	return rtuo.SetOwnerID(u.ID)
+}
+
+// AddResponseIDs adds the "responses" edge to the Response entity by IDs.
+func (rtuo *ResponseTagUpdateOne) AddResponseIDs(ids ...int) *ResponseTagUpdateOne {
+	rtuo.mutation.AddResponseIDs(ids...)
+	return rtuo
+}
+
+// AddResponses adds the "responses" edges to the Response entity.
+func (rtuo *ResponseTagUpdateOne) AddResponses(r ...*Response) *ResponseTagUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rtuo.AddResponseIDs(ids...)
+}
+
+// Mutation returns the ResponseTagMutation object of the builder.
+func (rtuo *ResponseTagUpdateOne) Mutation() *ResponseTagMutation {
+	return rtuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (rtuo *ResponseTagUpdateOne) ClearOwner() *ResponseTagUpdateOne {
+	rtuo.mutation.ClearOwner()
+	return rtuo
+}
+
+// ClearResponses clears all "responses" edges to the Response entity.
+func (rtuo *ResponseTagUpdateOne) ClearResponses() *ResponseTagUpdateOne {
+	rtuo.mutation.ClearResponses()
+	return rtuo
+}
+
+// RemoveResponseIDs removes the "responses" edge to Response entities by IDs.
+func (rtuo *ResponseTagUpdateOne) RemoveResponseIDs(ids ...int) *ResponseTagUpdateOne {
+	rtuo.mutation.RemoveResponseIDs(ids...)
+	return rtuo
+}
+
+// RemoveResponses removes "responses" edges to Response entities.
+func (rtuo *ResponseTagUpdateOne) RemoveResponses(r ...*Response) *ResponseTagUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rtuo.RemoveResponseIDs(ids...)
+}
+
+// Where appends a list predicates to the ResponseTagUpdate builder.
+func (rtuo *ResponseTagUpdateOne) Where(ps ...predicate.ResponseTag) *ResponseTagUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *ResponseTagUpdateOne) Select(field string, fields ...string) *ResponseTagUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated ResponseTag entity.
+func (rtuo *ResponseTagUpdateOne) Save(ctx context.Context) (*ResponseTag, error) {
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *ResponseTagUpdateOne) SaveX(ctx context.Context) *ResponseTag {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *ResponseTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *ResponseTagUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *ResponseTagUpdateOne) check() error {
+	if v, ok := rtuo.mutation.Name(); ok {
+		if err := responsetag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ResponseTag.name": %w`, err)}
+		}
+	}
+	if rtuo.mutation.OwnerCleared() && len(rtuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResponseTag.owner"`)
+	}
+	return nil
+}
+
+func (rtuo *ResponseTagUpdateOne) sqlSave(ctx context.Context) (_node *ResponseTag, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(responsetag.Table, responsetag.Columns, sqlgraph.NewFieldSpec(responsetag.FieldID, field.TypeInt))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResponseTag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responsetag.FieldID)
+		for _, f := range fields {
+			if !responsetag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != responsetag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.Name(); ok {
+		_spec.SetField(responsetag.FieldName, field.TypeString, value)
+	}
+	if rtuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsetag.OwnerTable,
+			Columns: []string{responsetag.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   responsetag.OwnerTable,
+			Columns: []string{responsetag.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rtuo.mutation.ResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   responsetag.ResponsesTable,
+			Columns: responsetag.ResponsesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtuo.mutation.RemovedResponsesIDs(); len(nodes) > 0 && !rtuo.mutation.ResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   responsetag.ResponsesTable,
+			Columns: responsetag.ResponsesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtuo.mutation.ResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   responsetag.ResponsesTable,
+			Columns: responsetag.ResponsesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(response.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ResponseTag{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsetag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
@@ -6,16 +6,25 @@ import (
 	"time"
 
 	"github.com/occult/pagode/ent/answer"
+	"github.com/occult/pagode/ent/discount"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/invoice"
 	"github.com/occult/pagode/ent/job"
 	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/processedevent"
 	"github.com/occult/pagode/ent/question"
+	"github.com/occult/pagode/ent/reportsubscription"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
 	"github.com/occult/pagode/ent/schema"
 	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/usage"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -33,6 +42,30 @@ func init() {
 	answerDescCreatedAt := answerFields[1].Descriptor()
 	// answer.DefaultCreatedAt holds the default value on creation for the created_at field.
 	answer.DefaultCreatedAt = answerDescCreatedAt.Default.(func() time.Time)
+	discountFields := schema.Discount{}.Fields()
+	_ = discountFields
+	// discountDescProviderDiscountID is the schema descriptor for provider_discount_id field.
+	discountDescProviderDiscountID := discountFields[0].Descriptor()
+	// discount.ProviderDiscountIDValidator is a validator for the "provider_discount_id" field. It is called by the builders before save.
+	discount.ProviderDiscountIDValidator = discountDescProviderDiscountID.Validators[0].(func(string) error)
+	// discountDescProvider is the schema descriptor for provider field.
+	discountDescProvider := discountFields[1].Descriptor()
+	// discount.DefaultProvider holds the default value on creation for the provider field.
+	discount.DefaultProvider = discountDescProvider.Default.(string)
+	// discount.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	discount.ProviderValidator = discountDescProvider.Validators[0].(func(string) error)
+	// discountDescProviderCouponID is the schema descriptor for provider_coupon_id field.
+	discountDescProviderCouponID := discountFields[2].Descriptor()
+	// discount.ProviderCouponIDValidator is a validator for the "provider_coupon_id" field. It is called by the builders before save.
+	discount.ProviderCouponIDValidator = discountDescProviderCouponID.Validators[0].(func(string) error)
+	// discountDescStartsAt is the schema descriptor for starts_at field.
+	discountDescStartsAt := discountFields[10].Descriptor()
+	// discount.DefaultStartsAt holds the default value on creation for the starts_at field.
+	discount.DefaultStartsAt = discountDescStartsAt.Default.(func() time.Time)
+	// discountDescCreatedAt is the schema descriptor for created_at field.
+	discountDescCreatedAt := discountFields[12].Descriptor()
+	// discount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	discount.DefaultCreatedAt = discountDescCreatedAt.Default.(func() time.Time)
 	formFields := schema.Form{}.Fields()
 	_ = formFields
 	// formDescTitle is the schema descriptor for title field.
@@ -47,16 +80,78 @@ func init() {
 	formDescSlug := formFields[3].Descriptor()
 	// form.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	form.SlugValidator = formDescSlug.Validators[0].(func(string) error)
+	// formDescRetentionDays is the schema descriptor for retention_days field.
+	formDescRetentionDays := formFields[6].Descriptor()
+	// form.DefaultRetentionDays holds the default value on creation for the retention_days field.
+	form.DefaultRetentionDays = formDescRetentionDays.Default.(int)
+	// form.RetentionDaysValidator is a validator for the "retention_days" field. It is called by the builders before save.
+	form.RetentionDaysValidator = formDescRetentionDays.Validators[0].(func(int) error)
+	// formDescNotifyOnResponse is the schema descriptor for notify_on_response field.
+	formDescNotifyOnResponse := formFields[7].Descriptor()
+	// form.DefaultNotifyOnResponse holds the default value on creation for the notify_on_response field.
+	form.DefaultNotifyOnResponse = formDescNotifyOnResponse.Default.(bool)
 	// formDescCreatedAt is the schema descriptor for created_at field.
-	formDescCreatedAt := formFields[6].Descriptor()
+	formDescCreatedAt := formFields[9].Descriptor()
 	// form.DefaultCreatedAt holds the default value on creation for the created_at field.
 	form.DefaultCreatedAt = formDescCreatedAt.Default.(func() time.Time)
 	// formDescUpdatedAt is the schema descriptor for updated_at field.
-	formDescUpdatedAt := formFields[7].Descriptor()
+	formDescUpdatedAt := formFields[10].Descriptor()
 	// form.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	form.DefaultUpdatedAt = formDescUpdatedAt.Default.(func() time.Time)
 	// form.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	form.UpdateDefaultUpdatedAt = formDescUpdatedAt.UpdateDefault.(func() time.Time)
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescProviderInvoiceID is the schema descriptor for provider_invoice_id field.
+	invoiceDescProviderInvoiceID := invoiceFields[0].Descriptor()
+	// invoice.ProviderInvoiceIDValidator is a validator for the "provider_invoice_id" field. It is called by the builders before save.
+	invoice.ProviderInvoiceIDValidator = invoiceDescProviderInvoiceID.Validators[0].(func(string) error)
+	// invoiceDescProvider is the schema descriptor for provider field.
+	invoiceDescProvider := invoiceFields[1].Descriptor()
+	// invoice.DefaultProvider holds the default value on creation for the provider field.
+	invoice.DefaultProvider = invoiceDescProvider.Default.(string)
+	// invoice.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	invoice.ProviderValidator = invoiceDescProvider.Validators[0].(func(string) error)
+	// invoiceDescCurrency is the schema descriptor for currency field.
+	invoiceDescCurrency := invoiceFields[5].Descriptor()
+	// invoice.DefaultCurrency holds the default value on creation for the currency field.
+	invoice.DefaultCurrency = invoiceDescCurrency.Default.(string)
+	// invoice.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	invoice.CurrencyValidator = invoiceDescCurrency.Validators[0].(func(string) error)
+	// invoiceDescSubtotal is the schema descriptor for subtotal field.
+	invoiceDescSubtotal := invoiceFields[6].Descriptor()
+	// invoice.DefaultSubtotal holds the default value on creation for the subtotal field.
+	invoice.DefaultSubtotal = invoiceDescSubtotal.Default.(int64)
+	// invoiceDescTax is the schema descriptor for tax field.
+	invoiceDescTax := invoiceFields[7].Descriptor()
+	// invoice.DefaultTax holds the default value on creation for the tax field.
+	invoice.DefaultTax = invoiceDescTax.Default.(int64)
+	// invoiceDescTotal is the schema descriptor for total field.
+	invoiceDescTotal := invoiceFields[8].Descriptor()
+	// invoice.DefaultTotal holds the default value on creation for the total field.
+	invoice.DefaultTotal = invoiceDescTotal.Default.(int64)
+	// invoiceDescAmountDue is the schema descriptor for amount_due field.
+	invoiceDescAmountDue := invoiceFields[9].Descriptor()
+	// invoice.DefaultAmountDue holds the default value on creation for the amount_due field.
+	invoice.DefaultAmountDue = invoiceDescAmountDue.Default.(int64)
+	// invoiceDescAmountPaid is the schema descriptor for amount_paid field.
+	invoiceDescAmountPaid := invoiceFields[10].Descriptor()
+	// invoice.DefaultAmountPaid holds the default value on creation for the amount_paid field.
+	invoice.DefaultAmountPaid = invoiceDescAmountPaid.Default.(int64)
+	// invoiceDescIssuedAt is the schema descriptor for issued_at field.
+	invoiceDescIssuedAt := invoiceFields[16].Descriptor()
+	// invoice.DefaultIssuedAt holds the default value on creation for the issued_at field.
+	invoice.DefaultIssuedAt = invoiceDescIssuedAt.Default.(func() time.Time)
+	// invoiceDescCreatedAt is the schema descriptor for created_at field.
+	invoiceDescCreatedAt := invoiceFields[17].Descriptor()
+	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
+	// invoiceDescUpdatedAt is the schema descriptor for updated_at field.
+	invoiceDescUpdatedAt := invoiceFields[18].Descriptor()
+	// invoice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invoice.DefaultUpdatedAt = invoiceDescUpdatedAt.Default.(func() time.Time)
+	// invoice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invoice.UpdateDefaultUpdatedAt = invoiceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	jobFields := schema.Job{}.Fields()
 	_ = jobFields
 	// jobDescQueue is the schema descriptor for queue field.
@@ -71,8 +166,16 @@ func init() {
 	jobDescMaxAttempts := jobFields[3].Descriptor()
 	// job.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
 	job.DefaultMaxAttempts = jobDescMaxAttempts.Default.(int)
+	// jobDescPriority is the schema descriptor for priority field.
+	jobDescPriority := jobFields[5].Descriptor()
+	// job.DefaultPriority holds the default value on creation for the priority field.
+	job.DefaultPriority = jobDescPriority.Default.(int)
+	// jobDescRunAt is the schema descriptor for run_at field.
+	jobDescRunAt := jobFields[6].Descriptor()
+	// job.DefaultRunAt holds the default value on creation for the run_at field.
+	job.DefaultRunAt = jobDescRunAt.Default.(func() time.Time)
 	// jobDescCreatedAt is the schema descriptor for created_at field.
-	jobDescCreatedAt := jobFields[6].Descriptor()
+	jobDescCreatedAt := jobFields[11].Descriptor()
 	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
 	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
 	passwordtokenHooks := schema.PasswordToken{}.Hooks()
@@ -87,6 +190,36 @@ func init() {
 	passwordtokenDescCreatedAt := passwordtokenFields[2].Descriptor()
 	// passwordtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	passwordtoken.DefaultCreatedAt = passwordtokenDescCreatedAt.Default.(func() time.Time)
+	paymentaccountFields := schema.PaymentAccount{}.Fields()
+	_ = paymentaccountFields
+	// paymentaccountDescProviderAccountID is the schema descriptor for provider_account_id field.
+	paymentaccountDescProviderAccountID := paymentaccountFields[0].Descriptor()
+	// paymentaccount.ProviderAccountIDValidator is a validator for the "provider_account_id" field. It is called by the builders before save.
+	paymentaccount.ProviderAccountIDValidator = paymentaccountDescProviderAccountID.Validators[0].(func(string) error)
+	// paymentaccountDescProvider is the schema descriptor for provider field.
+	paymentaccountDescProvider := paymentaccountFields[1].Descriptor()
+	// paymentaccount.DefaultProvider holds the default value on creation for the provider field.
+	paymentaccount.DefaultProvider = paymentaccountDescProvider.Default.(string)
+	// paymentaccount.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	paymentaccount.ProviderValidator = paymentaccountDescProvider.Validators[0].(func(string) error)
+	// paymentaccountDescChargesEnabled is the schema descriptor for charges_enabled field.
+	paymentaccountDescChargesEnabled := paymentaccountFields[2].Descriptor()
+	// paymentaccount.DefaultChargesEnabled holds the default value on creation for the charges_enabled field.
+	paymentaccount.DefaultChargesEnabled = paymentaccountDescChargesEnabled.Default.(bool)
+	// paymentaccountDescDetailsSubmitted is the schema descriptor for details_submitted field.
+	paymentaccountDescDetailsSubmitted := paymentaccountFields[3].Descriptor()
+	// paymentaccount.DefaultDetailsSubmitted holds the default value on creation for the details_submitted field.
+	paymentaccount.DefaultDetailsSubmitted = paymentaccountDescDetailsSubmitted.Default.(bool)
+	// paymentaccountDescCreatedAt is the schema descriptor for created_at field.
+	paymentaccountDescCreatedAt := paymentaccountFields[4].Descriptor()
+	// paymentaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentaccount.DefaultCreatedAt = paymentaccountDescCreatedAt.Default.(func() time.Time)
+	// paymentaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentaccountDescUpdatedAt := paymentaccountFields[5].Descriptor()
+	// paymentaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentaccount.DefaultUpdatedAt = paymentaccountDescUpdatedAt.Default.(func() time.Time)
+	// paymentaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentaccount.UpdateDefaultUpdatedAt = paymentaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
 	paymentcustomerFields := schema.PaymentCustomer{}.Fields()
 	_ = paymentcustomerFields
 	// paymentcustomerDescProviderCustomerID is the schema descriptor for provider_customer_id field.
@@ -129,18 +262,24 @@ func init() {
 	paymentintentDescAmount := paymentintentFields[3].Descriptor()
 	// paymentintent.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
 	paymentintent.AmountValidator = paymentintentDescAmount.Validators[0].(func(int64) error)
+	// paymentintentDescAmountRefunded is the schema descriptor for amount_refunded field.
+	paymentintentDescAmountRefunded := paymentintentFields[4].Descriptor()
+	// paymentintent.DefaultAmountRefunded holds the default value on creation for the amount_refunded field.
+	paymentintent.DefaultAmountRefunded = paymentintentDescAmountRefunded.Default.(int64)
+	// paymentintent.AmountRefundedValidator is a validator for the "amount_refunded" field. It is called by the builders before save.
+	paymentintent.AmountRefundedValidator = paymentintentDescAmountRefunded.Validators[0].(func(int64) error)
 	// paymentintentDescCurrency is the schema descriptor for currency field.
-	paymentintentDescCurrency := paymentintentFields[4].Descriptor()
+	paymentintentDescCurrency := paymentintentFields[5].Descriptor()
 	// paymentintent.DefaultCurrency holds the default value on creation for the currency field.
 	paymentintent.DefaultCurrency = paymentintentDescCurrency.Default.(string)
 	// paymentintent.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
 	paymentintent.CurrencyValidator = paymentintentDescCurrency.Validators[0].(func(string) error)
 	// paymentintentDescCreatedAt is the schema descriptor for created_at field.
-	paymentintentDescCreatedAt := paymentintentFields[8].Descriptor()
+	paymentintentDescCreatedAt := paymentintentFields[10].Descriptor()
 	// paymentintent.DefaultCreatedAt holds the default value on creation for the created_at field.
 	paymentintent.DefaultCreatedAt = paymentintentDescCreatedAt.Default.(func() time.Time)
 	// paymentintentDescUpdatedAt is the schema descriptor for updated_at field.
-	paymentintentDescUpdatedAt := paymentintentFields[9].Descriptor()
+	paymentintentDescUpdatedAt := paymentintentFields[11].Descriptor()
 	// paymentintent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	paymentintent.DefaultUpdatedAt = paymentintentDescUpdatedAt.Default.(func() time.Time)
 	// paymentintent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -189,6 +328,26 @@ func init() {
 	paymentmethod.DefaultUpdatedAt = paymentmethodDescUpdatedAt.Default.(func() time.Time)
 	// paymentmethod.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	paymentmethod.UpdateDefaultUpdatedAt = paymentmethodDescUpdatedAt.UpdateDefault.(func() time.Time)
+	processedeventFields := schema.ProcessedEvent{}.Fields()
+	_ = processedeventFields
+	// processedeventDescProvider is the schema descriptor for provider field.
+	processedeventDescProvider := processedeventFields[0].Descriptor()
+	// processedevent.DefaultProvider holds the default value on creation for the provider field.
+	processedevent.DefaultProvider = processedeventDescProvider.Default.(string)
+	// processedevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	processedevent.ProviderValidator = processedeventDescProvider.Validators[0].(func(string) error)
+	// processedeventDescEventID is the schema descriptor for event_id field.
+	processedeventDescEventID := processedeventFields[1].Descriptor()
+	// processedevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	processedevent.EventIDValidator = processedeventDescEventID.Validators[0].(func(string) error)
+	// processedeventDescType is the schema descriptor for type field.
+	processedeventDescType := processedeventFields[2].Descriptor()
+	// processedevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	processedevent.TypeValidator = processedeventDescType.Validators[0].(func(string) error)
+	// processedeventDescCreatedAt is the schema descriptor for created_at field.
+	processedeventDescCreatedAt := processedeventFields[3].Descriptor()
+	// processedevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	processedevent.DefaultCreatedAt = processedeventDescCreatedAt.Default.(func() time.Time)
 	questionFields := schema.Question{}.Fields()
 	_ = questionFields
 	// questionDescTitle is the schema descriptor for title field.
@@ -199,22 +358,36 @@ func init() {
 	questionDescRequired := questionFields[4].Descriptor()
 	// question.DefaultRequired holds the default value on creation for the required field.
 	question.DefaultRequired = questionDescRequired.Default.(bool)
+	// questionDescSensitive is the schema descriptor for sensitive field.
+	questionDescSensitive := questionFields[5].Descriptor()
+	// question.DefaultSensitive holds the default value on creation for the sensitive field.
+	question.DefaultSensitive = questionDescSensitive.Default.(bool)
 	// questionDescOrder is the schema descriptor for order field.
-	questionDescOrder := questionFields[5].Descriptor()
+	questionDescOrder := questionFields[6].Descriptor()
 	// question.DefaultOrder holds the default value on creation for the order field.
 	question.DefaultOrder = questionDescOrder.Default.(int)
 	// question.OrderValidator is a validator for the "order" field. It is called by the builders before save.
 	question.OrderValidator = questionDescOrder.Validators[0].(func(int) error)
 	// questionDescCreatedAt is the schema descriptor for created_at field.
-	questionDescCreatedAt := questionFields[8].Descriptor()
+	questionDescCreatedAt := questionFields[9].Descriptor()
 	// question.DefaultCreatedAt holds the default value on creation for the created_at field.
 	question.DefaultCreatedAt = questionDescCreatedAt.Default.(func() time.Time)
 	// questionDescUpdatedAt is the schema descriptor for updated_at field.
-	questionDescUpdatedAt := questionFields[9].Descriptor()
+	questionDescUpdatedAt := questionFields[10].Descriptor()
 	// question.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	question.DefaultUpdatedAt = questionDescUpdatedAt.Default.(func() time.Time)
 	// question.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	question.UpdateDefaultUpdatedAt = questionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	reportsubscriptionFields := schema.ReportSubscription{}.Fields()
+	_ = reportsubscriptionFields
+	// reportsubscriptionDescTimezone is the schema descriptor for timezone field.
+	reportsubscriptionDescTimezone := reportsubscriptionFields[1].Descriptor()
+	// reportsubscription.DefaultTimezone holds the default value on creation for the timezone field.
+	reportsubscription.DefaultTimezone = reportsubscriptionDescTimezone.Default.(string)
+	// reportsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	reportsubscriptionDescCreatedAt := reportsubscriptionFields[5].Descriptor()
+	// reportsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reportsubscription.DefaultCreatedAt = reportsubscriptionDescCreatedAt.Default.(func() time.Time)
 	responseFields := schema.Response{}.Fields()
 	_ = responseFields
 	// responseDescSubmittedAt is the schema descriptor for submitted_at field.
@@ -225,6 +398,46 @@ func init() {
 	responseDescCompleted := responseFields[1].Descriptor()
 	// response.DefaultCompleted holds the default value on creation for the completed field.
 	response.DefaultCompleted = responseDescCompleted.Default.(bool)
+	responseactivityFields := schema.ResponseActivity{}.Fields()
+	_ = responseactivityFields
+	// responseactivityDescCreatedAt is the schema descriptor for created_at field.
+	responseactivityDescCreatedAt := responseactivityFields[3].Descriptor()
+	// responseactivity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	responseactivity.DefaultCreatedAt = responseactivityDescCreatedAt.Default.(func() time.Time)
+	responsenoteFields := schema.ResponseNote{}.Fields()
+	_ = responsenoteFields
+	// responsenoteDescBody is the schema descriptor for body field.
+	responsenoteDescBody := responsenoteFields[0].Descriptor()
+	// responsenote.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	responsenote.BodyValidator = responsenoteDescBody.Validators[0].(func(string) error)
+	// responsenoteDescCreatedAt is the schema descriptor for created_at field.
+	responsenoteDescCreatedAt := responsenoteFields[2].Descriptor()
+	// responsenote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	responsenote.DefaultCreatedAt = responsenoteDescCreatedAt.Default.(func() time.Time)
+	responsetagFields := schema.ResponseTag{}.Fields()
+	_ = responsetagFields
+	// responsetagDescName is the schema descriptor for name field.
+	responsetagDescName := responsetagFields[0].Descriptor()
+	// responsetag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	responsetag.NameValidator = func() func(string) error {
+		validators := responsetagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// responsetagDescCreatedAt is the schema descriptor for created_at field.
+	responsetagDescCreatedAt := responsetagFields[2].Descriptor()
+	// responsetag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	responsetag.DefaultCreatedAt = responsetagDescCreatedAt.Default.(func() time.Time)
 	subscriptionFields := schema.Subscription{}.Fields()
 	_ = subscriptionFields
 	// subscriptionDescProviderSubscriptionID is the schema descriptor for provider_subscription_id field.
@@ -257,16 +470,50 @@ func init() {
 	subscription.DefaultIntervalCount = subscriptionDescIntervalCount.Default.(int)
 	// subscription.IntervalCountValidator is a validator for the "interval_count" field. It is called by the builders before save.
 	subscription.IntervalCountValidator = subscriptionDescIntervalCount.Validators[0].(func(int) error)
+	// subscriptionDescCancelAtPeriodEnd is the schema descriptor for cancel_at_period_end field.
+	subscriptionDescCancelAtPeriodEnd := subscriptionFields[14].Descriptor()
+	// subscription.DefaultCancelAtPeriodEnd holds the default value on creation for the cancel_at_period_end field.
+	subscription.DefaultCancelAtPeriodEnd = subscriptionDescCancelAtPeriodEnd.Default.(bool)
+	// subscriptionDescDunningReminders is the schema descriptor for dunning_reminders field.
+	subscriptionDescDunningReminders := subscriptionFields[18].Descriptor()
+	// subscription.DefaultDunningReminders holds the default value on creation for the dunning_reminders field.
+	subscription.DefaultDunningReminders = subscriptionDescDunningReminders.Default.(int)
+	// subscription.DunningRemindersValidator is a validator for the "dunning_reminders" field. It is called by the builders before save.
+	subscription.DunningRemindersValidator = subscriptionDescDunningReminders.Validators[0].(func(int) error)
 	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
-	subscriptionDescCreatedAt := subscriptionFields[15].Descriptor()
+	subscriptionDescCreatedAt := subscriptionFields[22].Descriptor()
 	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
 	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
 	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
-	subscriptionDescUpdatedAt := subscriptionFields[16].Descriptor()
+	subscriptionDescUpdatedAt := subscriptionFields[23].Descriptor()
 	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
 	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	subscription.UpdateDefaultUpdatedAt = subscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	usageFields := schema.Usage{}.Fields()
+	_ = usageFields
+	// usageDescResponses is the schema descriptor for responses field.
+	usageDescResponses := usageFields[3].Descriptor()
+	// usage.DefaultResponses holds the default value on creation for the responses field.
+	usage.DefaultResponses = usageDescResponses.Default.(int64)
+	// usageDescForms is the schema descriptor for forms field.
+	usageDescForms := usageFields[4].Descriptor()
+	// usage.DefaultForms holds the default value on creation for the forms field.
+	usage.DefaultForms = usageDescForms.Default.(int64)
+	// usageDescStorageBytes is the schema descriptor for storage_bytes field.
+	usageDescStorageBytes := usageFields[5].Descriptor()
+	// usage.DefaultStorageBytes holds the default value on creation for the storage_bytes field.
+	usage.DefaultStorageBytes = usageDescStorageBytes.Default.(int64)
+	// usageDescCreatedAt is the schema descriptor for created_at field.
+	usageDescCreatedAt := usageFields[6].Descriptor()
+	// usage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usage.DefaultCreatedAt = usageDescCreatedAt.Default.(func() time.Time)
+	// usageDescUpdatedAt is the schema descriptor for updated_at field.
+	usageDescUpdatedAt := usageFields[7].Descriptor()
+	// usage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usage.DefaultUpdatedAt = usageDescUpdatedAt.Default.(func() time.Time)
+	// usage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usage.UpdateDefaultUpdatedAt = usageDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
 	userFields := schema.User{}.Fields()
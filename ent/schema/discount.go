@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Discount holds the schema definition for the Discount entity.
+// Discounts record the coupons the payment provider applied to subscriptions, such as through promotion codes
+// redeemed at checkout.
+type Discount struct {
+	ent.Schema
+}
+
+// Fields of the Discount.
+func (Discount) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider_discount_id").
+			NotEmpty().
+			Unique().
+			Comment("External payment provider discount ID"),
+		field.String("provider").
+			NotEmpty().
+			Default("stripe").
+			Comment("Payment provider name"),
+		field.String("provider_coupon_id").
+			NotEmpty().
+			Comment("External payment provider ID of the coupon the discount applies"),
+		field.String("provider_promotion_code_id").
+			Optional().
+			Comment("External payment provider ID of the promotion code the coupon was redeemed with"),
+		field.String("code").
+			Optional().
+			Comment("Promotion code entered by the customer"),
+		field.Float("percent_off").
+			Optional().
+			Comment("Percentage taken off, if the coupon takes a percentage off"),
+		field.Int64("amount_off").
+			Optional().
+			Comment("Amount taken off in smallest currency unit, if the coupon takes an amount off"),
+		field.String("currency").
+			Optional().
+			Comment("Three-letter ISO currency code of the amount taken off"),
+		field.Enum("duration").
+			Values("once", "repeating", "forever").
+			Comment("How long the discount applies for"),
+		field.Int("duration_in_months").
+			Optional().
+			Comment("Number of months a repeating discount applies for"),
+		field.Time("starts_at").
+			Default(time.Now).
+			Comment("When the discount started to apply"),
+		field.Time("ends_at").
+			Optional().
+			Comment("When the discount stops applying, unset for discounts which apply once or forever"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Discount.
+func (Discount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", Subscription.Type).
+			Ref("discounts").
+			Unique().
+			Required().
+			Comment("Subscription the discount applies to"),
+	}
+}
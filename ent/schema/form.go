@@ -26,6 +26,17 @@ func (Form) Fields() []ent.Field {
 		field.Enum("display_mode").
 			Values("traditional", "conversational").
 			Default("traditional"),
+		field.Enum("metadata_storage").
+			Values("full", "truncated", "hashed", "none").
+			Default("full").
+			Comment("How respondent IP addresses and user agents are stored"),
+		field.Int("retention_days").
+			Default(0).
+			NonNegative().
+			Comment("Responses older than this many days are purged, zero keeps them forever"),
+		field.Bool("notify_on_response").
+			Default(false).
+			Comment("Email the owner whenever a response is submitted"),
 		field.Int("user_id"),
 		field.Time("created_at").
 			Default(time.Now).
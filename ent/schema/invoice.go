@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+// Invoices are mirrored from the payment provider so billing history can be listed without calling it.
+type Invoice struct {
+	ent.Schema
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider_invoice_id").
+			NotEmpty().
+			Unique().
+			Comment("External payment provider invoice ID"),
+		field.String("provider").
+			NotEmpty().
+			Default("stripe").
+			Comment("Payment provider name"),
+		field.String("provider_subscription_id").
+			Optional().
+			Comment("External payment provider ID of the subscription the invoice was created for"),
+		field.String("number").
+			Optional().
+			Comment("Invoice number shown to the customer, assigned once the invoice is finalized"),
+		field.Enum("status").
+			Values("draft", "open", "paid", "uncollectible", "void").
+			Default("draft").
+			Comment("Invoice status from provider"),
+		field.String("currency").
+			NotEmpty().
+			Default("usd").
+			Comment("Three-letter ISO currency code"),
+		field.Int64("subtotal").
+			Default(0).
+			Comment("Total before tax in smallest currency unit"),
+		field.Int64("tax").
+			Default(0).
+			Comment("Tax in smallest currency unit"),
+		field.Int64("total").
+			Default(0).
+			Comment("Total after tax in smallest currency unit"),
+		field.Int64("amount_due").
+			Default(0).
+			Comment("Amount due in smallest currency unit"),
+		field.Int64("amount_paid").
+			Default(0).
+			Comment("Amount paid in smallest currency unit"),
+		field.String("hosted_link").
+			Optional().
+			Comment("URL of the invoice page hosted by the provider"),
+		field.String("pdf_link").
+			Optional().
+			Comment("URL of the invoice PDF"),
+		field.Time("period_start").
+			Optional().
+			Comment("Start of the period the invoice is for"),
+		field.Time("period_end").
+			Optional().
+			Comment("End of the period the invoice is for"),
+		field.Time("paid_at").
+			Optional().
+			Comment("When the invoice was paid"),
+		field.Time("issued_at").
+			Default(time.Now).
+			Comment("When the invoice was created by the provider"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("customer", PaymentCustomer.Type).
+			Ref("invoices").
+			Unique().
+			Required().
+			Comment("Payment customer the invoice was issued to"),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -28,9 +30,27 @@ func (Job) Fields() []ent.Field {
 			Default(3).
 			Comment("Maximum number of retry attempts"),
 		field.Enum("status").
-			Values("pending", "processing", "completed", "failed").
+			Values("pending", "processing", "completed", "failed", "cancelled").
 			Default("pending").
 			Comment("Current status of the job"),
+		field.Int("priority").
+			Default(0).
+			Comment("Jobs with a higher priority are claimed first"),
+		field.Time("run_at").
+			Default(time.Now).
+			Comment("When the job may next be attempted, pushed back after each failure"),
+		field.Time("scheduled_for").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The cron tick that enqueued a recurring job, unique per queue so each tick runs once"),
+		field.String("unique_key").
+			Optional().
+			Immutable().
+			Comment("Deduplication key given when the job was enqueued"),
+		field.String("unique_lock").
+			Optional().
+			Comment("Holds unique_key while the job is pending or processing and is cleared once it finishes, so the unique index only covers active jobs"),
 		field.Text("error").
 			Optional().
 			Comment("Error message if job failed"),
@@ -41,12 +61,23 @@ func (Job) Fields() []ent.Field {
 		field.Time("processed_at").
 			Optional().
 			Comment("When the job was processed"),
+		field.String("locked_by").
+			Optional().
+			Comment("ID of the worker currently processing the job"),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("When the lease held by the processing worker expires"),
 	}
 }
 
 // Edges of the Job.
 func (Job) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("history", JobAttempt.Type).
+			Comment("Every attempt made to run the job").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
 }
 
 // Indexes of the Job.
@@ -54,5 +85,11 @@ func (Job) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("queue", "status"),
 		index.Fields("created_at"),
+		index.Fields("status", "locked_until"),
+		index.Fields("queue", "status", "run_at"),
+		index.Fields("queue", "scheduled_for").
+			Unique(),
+		index.Fields("queue", "unique_lock").
+			Unique(),
 	}
 }
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JobAttempt holds the schema definition for the JobAttempt entity.
+// Each row records a single run of a job by a worker.
+type JobAttempt struct {
+	ent.Schema
+}
+
+// Fields of the JobAttempt.
+func (JobAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("job_id").
+			Immutable(),
+		field.Int("attempt").
+			Comment("Which attempt of the job this was, starting at 1"),
+		field.String("worker").
+			Comment("ID of the worker that ran the attempt"),
+		field.Time("started_at"),
+		field.Time("finished_at"),
+		field.Int64("duration_ms").
+			Comment("How long the handler ran for in milliseconds"),
+		field.Text("error").
+			Optional().
+			Comment("Error returned by the handler, empty if the attempt succeeded"),
+	}
+}
+
+// Edges of the JobAttempt.
+func (JobAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("job", Job.Type).
+			Ref("history").
+			Field("job_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the JobAttempt.
+func (JobAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id", "attempt"),
+	}
+}
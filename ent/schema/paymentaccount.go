@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PaymentAccount holds the schema definition for the PaymentAccount entity.
+type PaymentAccount struct {
+	ent.Schema
+}
+
+// Fields of the PaymentAccount.
+func (PaymentAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider_account_id").
+			NotEmpty().
+			Unique().
+			Comment("External payment provider connected account ID (e.g., Stripe account ID)"),
+		field.String("provider").
+			NotEmpty().
+			Default("stripe").
+			Comment("Payment provider name"),
+		field.Bool("charges_enabled").
+			Default(false).
+			Comment("Whether the account can receive payments"),
+		field.Bool("details_submitted").
+			Default(false).
+			Comment("Whether the owner finished onboarding with the provider"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the PaymentAccount.
+func (PaymentAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Comment("User receiving payments made to their forms"),
+	}
+}
@@ -54,5 +54,7 @@ func (PaymentCustomer) Edges() []ent.Edge {
 			Comment("Subscriptions for this customer"),
 		edge.To("payment_methods", PaymentMethod.Type).
 			Comment("Payment methods for this customer"),
+		edge.To("invoices", Invoice.Type).
+			Comment("Invoices issued to this customer"),
 	}
 }
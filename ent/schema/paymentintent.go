@@ -32,6 +32,10 @@ func (PaymentIntent) Fields() []ent.Field {
 		field.Int64("amount").
 			Min(0).
 			Comment("Amount in smallest currency unit (e.g., cents)"),
+		field.Int64("amount_refunded").
+			Default(0).
+			Min(0).
+			Comment("Amount refunded in smallest currency unit"),
 		field.String("currency").
 			NotEmpty().
 			Default("usd").
@@ -43,6 +47,9 @@ func (PaymentIntent) Fields() []ent.Field {
 			Optional().
 			Sensitive().
 			Comment("Client secret for frontend payment processing"),
+		field.String("provider_account_id").
+			Optional().
+			Comment("Connected account receiving the payment, for payments made to forms"),
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("Additional payment data"),
@@ -61,7 +68,10 @@ func (PaymentIntent) Edges() []ent.Edge {
 		edge.From("customer", PaymentCustomer.Type).
 			Ref("payment_intents").
 			Unique().
-			Required().
-			Comment("Payment customer who owns this payment intent"),
+			Comment("Payment customer who owns this payment intent, unless it was made by a form respondent"),
+		edge.From("response", Response.Type).
+			Ref("payment").
+			Unique().
+			Comment("Form response the payment is for"),
 	}
 }
@@ -25,12 +25,12 @@ func (PaymentMethod) Fields() []ent.Field {
 			Default("stripe").
 			Comment("Payment provider name"),
 		field.Enum("type").
-			Values("card", "bank_account", "wallet").
+			Values("card", "bank_account", "sepa_debit", "boleto", "pix", "wallet").
 			Default("card").
 			Comment("Payment method type"),
 		field.String("last_four").
 			Optional().
-			Comment("Last four digits of card/account"),
+			Comment("Last four digits of card/account, or of the IBAN for SEPA debits and the tax ID for boletos"),
 		field.String("brand").
 			Optional().
 			Comment("Card brand (visa, mastercard, etc.)"),
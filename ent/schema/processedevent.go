@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProcessedEvent holds the schema definition for the ProcessedEvent entity.
+// Each row records a payment provider webhook event which has been applied, so redelivered events are skipped.
+type ProcessedEvent struct {
+	ent.Schema
+}
+
+// Fields of the ProcessedEvent.
+func (ProcessedEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			NotEmpty().
+			Default("stripe").
+			Comment("Payment provider name"),
+		field.String("event_id").
+			NotEmpty().
+			Comment("External payment provider event ID"),
+		field.String("type").
+			NotEmpty().
+			Comment("Event type, such as customer.subscription.updated"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ProcessedEvent.
+func (ProcessedEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "event_id").
+			Unique(),
+	}
+}
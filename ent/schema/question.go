@@ -27,6 +27,7 @@ func (Question) Fields() []ent.Field {
 				"yesno", "rating", "opinion-scale", "ranking", "matrix",
 				"statement", "legal", "hidden",
 				"multi-input",
+				"payment",
 			).
 			Default("text"),
 		field.String("title").
@@ -37,6 +38,9 @@ func (Question) Fields() []ent.Field {
 			Optional(),
 		field.Bool("required").
 			Default(false),
+		field.Bool("sensitive").
+			Default(false).
+			Comment("Answers are encrypted at rest and only decrypted for the form owner"),
 		field.Int("order").
 			Default(0).
 			NonNegative(),
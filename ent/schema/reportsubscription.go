@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReportSubscription holds the schema definition for the ReportSubscription entity.
+// A subscription without a form covers all forms owned by the user.
+type ReportSubscription struct {
+	ent.Schema
+}
+
+// Fields of the ReportSubscription.
+func (ReportSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("frequency").
+			Values("daily", "weekly", "monthly").
+			Default("weekly").
+			Comment("How often the report is sent"),
+		field.String("timezone").
+			Default("UTC").
+			Comment("IANA timezone used to determine report periods"),
+		field.Int("user_id").
+			Comment("User who receives the report"),
+		field.Int("form_id").
+			Optional().
+			Nillable().
+			Comment("Form covered by the report, all of the user's forms if empty"),
+		field.Time("last_period_start").
+			Optional().
+			Nillable().
+			Comment("Start of the most recent period a report was sent for"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ReportSubscription.
+func (ReportSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("form", Form.Type).
+			Field("form_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the ReportSubscription.
+func (ReportSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
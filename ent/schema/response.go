@@ -29,6 +29,9 @@ func (Response) Fields() []ent.Field {
 			Optional().
 			StorageKey("user_agent").
 			StructTag(`json:"user_agent"`),
+		field.Enum("status").
+			Values("new", "in_progress", "done", "archived").
+			Default("new"),
 	}
 }
 
@@ -43,11 +46,20 @@ func (Response) Edges() []ent.Edge {
 			Unique(),
 		edge.To("answers", Answer.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("notes", ResponseNote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", ResponseTag.Type).
+			StorageKey(edge.Table("response_tag_assignments")),
+		edge.To("activities", ResponseActivity.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("payment", PaymentIntent.Type).
+			Unique(),
 	}
 }
 
 func (Response) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("submitted_at"),
+		index.Fields("status"),
 	}
 }
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ResponseActivity holds the schema definition for the ResponseActivity entity.
+// Each row records a single change made to a response by its form owner.
+// Rows recording that a response was deleted are kept without their response.
+type ResponseActivity struct {
+	ent.Schema
+}
+
+// Fields of the ResponseActivity.
+func (ResponseActivity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("action").
+			Values("answers_updated", "status_changed", "note_added", "tags_updated", "deleted").
+			Comment("Type of change made to the response"),
+		field.JSON("changes", map[string]interface{}{}).
+			Optional().
+			Comment("Details of the change, such as the previous and new values"),
+		field.Int("user_id").
+			Comment("User who made the change"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ResponseActivity.
+func (ResponseActivity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("response", Response.Type).
+			Ref("activities").
+			Unique(),
+		edge.To("actor", User.Type).
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ResponseActivity.
+func (ResponseActivity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
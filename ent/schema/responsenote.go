@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ResponseNote holds the schema definition for the ResponseNote entity.
+type ResponseNote struct {
+	ent.Schema
+}
+
+// Fields of the ResponseNote.
+func (ResponseNote) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("body").
+			NotEmpty().
+			Comment("Internal note visible only to the form owner"),
+		field.Int("user_id").
+			Comment("User who wrote the note"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ResponseNote.
+func (ResponseNote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("response", Response.Type).
+			Ref("notes").
+			Unique().
+			Required(),
+		edge.To("author", User.Type).
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ResponseTag holds the schema definition for the ResponseTag entity.
+type ResponseTag struct {
+	ent.Schema
+}
+
+// Fields of the ResponseTag.
+func (ResponseTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(50).
+			Comment("Tag label defined by the form owner"),
+		field.Int("user_id").
+			Comment("Form owner who defined the tag"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ResponseTag.
+func (ResponseTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("owner", User.Type).
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.From("responses", Response.Type).
+			Ref("tags"),
+	}
+}
+
+// Indexes of the ResponseTag.
+func (ResponseTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").
+			Unique(),
+	}
+}
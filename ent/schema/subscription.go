@@ -64,6 +64,28 @@ func (Subscription) Fields() []ent.Field {
 		field.Time("ended_at").
 			Optional().
 			Comment("When subscription ended"),
+		field.Bool("cancel_at_period_end").
+			Default(false).
+			Comment("Whether the subscription ends at the end of the current period"),
+		field.String("pending_price_id").
+			Optional().
+			Comment("Price the subscription switches to at the end of the current period"),
+		field.Time("pending_change_at").
+			Optional().
+			Comment("When the pending price takes effect"),
+		field.Time("past_due_at").
+			Optional().
+			Comment("When payments of the subscription started failing"),
+		field.Int("dunning_reminders").
+			Default(0).
+			Min(0).
+			Comment("Number of payment reminders sent since payments started failing"),
+		field.Time("trial_reminded_at").
+			Optional().
+			Comment("When the user was reminded that the trial ends"),
+		field.Time("last_event_at").
+			Optional().
+			Comment("When the last webhook event applied to the subscription was created, so older events delivered late are skipped"),
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("Additional subscription data"),
@@ -84,5 +106,7 @@ func (Subscription) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Comment("Payment customer who owns this subscription"),
+		edge.To("discounts", Discount.Type).
+			Comment("Discounts applied to this subscription"),
 	}
 }
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Usage holds the schema definition for the Usage entity.
+// Each row counts what a user used during one of their usage periods, which follow their billing period.
+type Usage struct {
+	ent.Schema
+}
+
+// Fields of the Usage.
+func (Usage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Time("period_start"),
+		field.Time("period_end"),
+		field.Int64("responses").
+			Default(0).
+			Comment("Responses received by the user's forms"),
+		field.Int64("forms").
+			Default(0).
+			Comment("Forms created"),
+		field.Int64("storage_bytes").
+			Default(0).
+			Comment("Bytes of answers and files stored"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Usage.
+func (Usage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("usage").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the Usage.
+func (Usage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "period_start").
+			Unique(),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -75,8 +76,13 @@ func (User) Edges() []ent.Edge {
 		edge.From("payment_customer", PaymentCustomer.Type).
 			Ref("user").
 			Unique(),
+		edge.From("payment_account", PaymentAccount.Type).
+			Ref("user").
+			Unique(),
 		edge.To("forms", Form.Type),
 		edge.To("responses", Response.Type),
+		edge.To("usage", Usage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
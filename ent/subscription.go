@@ -47,6 +47,20 @@ type Subscription struct {
 	CanceledAt time.Time `json:"canceled_at,omitempty"`
 	// When subscription ended
 	EndedAt time.Time `json:"ended_at,omitempty"`
+	// Whether the subscription ends at the end of the current period
+	CancelAtPeriodEnd bool `json:"cancel_at_period_end,omitempty"`
+	// Price the subscription switches to at the end of the current period
+	PendingPriceID string `json:"pending_price_id,omitempty"`
+	// When the pending price takes effect
+	PendingChangeAt time.Time `json:"pending_change_at,omitempty"`
+	// When payments of the subscription started failing
+	PastDueAt time.Time `json:"past_due_at,omitempty"`
+	// Number of payment reminders sent since payments started failing
+	DunningReminders int `json:"dunning_reminders,omitempty"`
+	// When the user was reminded that the trial ends
+	TrialRemindedAt time.Time `json:"trial_reminded_at,omitempty"`
+	// When the last webhook event applied to the subscription was created, so older events delivered late are skipped
+	LastEventAt time.Time `json:"last_event_at,omitempty"`
 	// Additional subscription data
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -64,9 +78,11 @@ type Subscription struct {
 type SubscriptionEdges struct {
 	// Payment customer who owns this subscription
 	Customer *PaymentCustomer `json:"customer,omitempty"`
+	// Discounts applied to this subscription
+	Discounts []*Discount `json:"discounts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // CustomerOrErr returns the Customer value or an error if the edge
@@ -80,6 +96,15 @@ func (e SubscriptionEdges) CustomerOrErr() (*PaymentCustomer, error) {
 	return nil, &NotLoadedError{edge: "customer"}
 }
 
+// DiscountsOrErr returns the Discounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e SubscriptionEdges) DiscountsOrErr() ([]*Discount, error) {
+	if e.loadedTypes[1] {
+		return e.Discounts, nil
+	}
+	return nil, &NotLoadedError{edge: "discounts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Subscription) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -87,11 +112,13 @@ func (*Subscription) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case subscription.FieldMetadata:
 			values[i] = new([]byte)
-		case subscription.FieldID, subscription.FieldAmount, subscription.FieldIntervalCount:
+		case subscription.FieldCancelAtPeriodEnd:
+			values[i] = new(sql.NullBool)
+		case subscription.FieldID, subscription.FieldAmount, subscription.FieldIntervalCount, subscription.FieldDunningReminders:
 			values[i] = new(sql.NullInt64)
-		case subscription.FieldProviderSubscriptionID, subscription.FieldProvider, subscription.FieldStatus, subscription.FieldPriceID, subscription.FieldCurrency, subscription.FieldInterval:
+		case subscription.FieldProviderSubscriptionID, subscription.FieldProvider, subscription.FieldStatus, subscription.FieldPriceID, subscription.FieldCurrency, subscription.FieldInterval, subscription.FieldPendingPriceID:
 			values[i] = new(sql.NullString)
-		case subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldTrialStart, subscription.FieldTrialEnd, subscription.FieldCanceledAt, subscription.FieldEndedAt, subscription.FieldCreatedAt, subscription.FieldUpdatedAt:
+		case subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldTrialStart, subscription.FieldTrialEnd, subscription.FieldCanceledAt, subscription.FieldEndedAt, subscription.FieldPendingChangeAt, subscription.FieldPastDueAt, subscription.FieldTrialRemindedAt, subscription.FieldLastEventAt, subscription.FieldCreatedAt, subscription.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case subscription.ForeignKeys[0]: // payment_customer_subscriptions
 			values[i] = new(sql.NullInt64)
@@ -200,6 +227,48 @@ func (s *Subscription) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.EndedAt = value.Time
 			}
+		case subscription.FieldCancelAtPeriodEnd:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_at_period_end", values[i])
+			} else if value.Valid {
+				s.CancelAtPeriodEnd = value.Bool
+			}
+		case subscription.FieldPendingPriceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_price_id", values[i])
+			} else if value.Valid {
+				s.PendingPriceID = value.String
+			}
+		case subscription.FieldPendingChangeAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_change_at", values[i])
+			} else if value.Valid {
+				s.PendingChangeAt = value.Time
+			}
+		case subscription.FieldPastDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field past_due_at", values[i])
+			} else if value.Valid {
+				s.PastDueAt = value.Time
+			}
+		case subscription.FieldDunningReminders:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dunning_reminders", values[i])
+			} else if value.Valid {
+				s.DunningReminders = int(value.Int64)
+			}
+		case subscription.FieldTrialRemindedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_reminded_at", values[i])
+			} else if value.Valid {
+				s.TrialRemindedAt = value.Time
+			}
+		case subscription.FieldLastEventAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_event_at", values[i])
+			} else if value.Valid {
+				s.LastEventAt = value.Time
+			}
 		case subscription.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -245,6 +314,11 @@ func (s *Subscription) QueryCustomer() *PaymentCustomerQuery {
 	return NewSubscriptionClient(s.config).QueryCustomer(s)
 }
 
+// QueryDiscounts queries the "discounts" edge of the Subscription entity.
+func (s *Subscription) QueryDiscounts() *DiscountQuery {
+	return NewSubscriptionClient(s.config).QueryDiscounts(s)
+}
+
 // Update returns a builder for updating this Subscription.
 // Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -310,6 +384,27 @@ func (s *Subscription) String() string {
 	builder.WriteString("ended_at=")
 	builder.WriteString(s.EndedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("cancel_at_period_end=")
+	builder.WriteString(fmt.Sprintf("%v", s.CancelAtPeriodEnd))
+	builder.WriteString(", ")
+	builder.WriteString("pending_price_id=")
+	builder.WriteString(s.PendingPriceID)
+	builder.WriteString(", ")
+	builder.WriteString("pending_change_at=")
+	builder.WriteString(s.PendingChangeAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("past_due_at=")
+	builder.WriteString(s.PastDueAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("dunning_reminders=")
+	builder.WriteString(fmt.Sprintf("%v", s.DunningReminders))
+	builder.WriteString(", ")
+	builder.WriteString("trial_reminded_at=")
+	builder.WriteString(s.TrialRemindedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_event_at=")
+	builder.WriteString(s.LastEventAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", s.Metadata))
 	builder.WriteString(", ")
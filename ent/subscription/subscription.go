@@ -43,6 +43,20 @@ const (
 	FieldCanceledAt = "canceled_at"
 	// FieldEndedAt holds the string denoting the ended_at field in the database.
 	FieldEndedAt = "ended_at"
+	// FieldCancelAtPeriodEnd holds the string denoting the cancel_at_period_end field in the database.
+	FieldCancelAtPeriodEnd = "cancel_at_period_end"
+	// FieldPendingPriceID holds the string denoting the pending_price_id field in the database.
+	FieldPendingPriceID = "pending_price_id"
+	// FieldPendingChangeAt holds the string denoting the pending_change_at field in the database.
+	FieldPendingChangeAt = "pending_change_at"
+	// FieldPastDueAt holds the string denoting the past_due_at field in the database.
+	FieldPastDueAt = "past_due_at"
+	// FieldDunningReminders holds the string denoting the dunning_reminders field in the database.
+	FieldDunningReminders = "dunning_reminders"
+	// FieldTrialRemindedAt holds the string denoting the trial_reminded_at field in the database.
+	FieldTrialRemindedAt = "trial_reminded_at"
+	// FieldLastEventAt holds the string denoting the last_event_at field in the database.
+	FieldLastEventAt = "last_event_at"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -51,6 +65,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeCustomer holds the string denoting the customer edge name in mutations.
 	EdgeCustomer = "customer"
+	// EdgeDiscounts holds the string denoting the discounts edge name in mutations.
+	EdgeDiscounts = "discounts"
 	// Table holds the table name of the subscription in the database.
 	Table = "subscriptions"
 	// CustomerTable is the table that holds the customer relation/edge.
@@ -60,6 +76,13 @@ const (
 	CustomerInverseTable = "payment_customers"
 	// CustomerColumn is the table column denoting the customer relation/edge.
 	CustomerColumn = "payment_customer_subscriptions"
+	// DiscountsTable is the table that holds the discounts relation/edge.
+	DiscountsTable = "discounts"
+	// DiscountsInverseTable is the table name for the Discount entity.
+	// It exists in this package in order to avoid circular dependency with the "discount" package.
+	DiscountsInverseTable = "discounts"
+	// DiscountsColumn is the table column denoting the discounts relation/edge.
+	DiscountsColumn = "subscription_discounts"
 )
 
 // Columns holds all SQL columns for subscription fields.
@@ -79,6 +102,13 @@ var Columns = []string{
 	FieldTrialEnd,
 	FieldCanceledAt,
 	FieldEndedAt,
+	FieldCancelAtPeriodEnd,
+	FieldPendingPriceID,
+	FieldPendingChangeAt,
+	FieldPastDueAt,
+	FieldDunningReminders,
+	FieldTrialRemindedAt,
+	FieldLastEventAt,
 	FieldMetadata,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -124,6 +154,12 @@ var (
 	DefaultIntervalCount int
 	// IntervalCountValidator is a validator for the "interval_count" field. It is called by the builders before save.
 	IntervalCountValidator func(int) error
+	// DefaultCancelAtPeriodEnd holds the default value on creation for the "cancel_at_period_end" field.
+	DefaultCancelAtPeriodEnd bool
+	// DefaultDunningReminders holds the default value on creation for the "dunning_reminders" field.
+	DefaultDunningReminders int
+	// DunningRemindersValidator is a validator for the "dunning_reminders" field. It is called by the builders before save.
+	DunningRemindersValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -267,6 +303,41 @@ func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
 }
 
+// ByCancelAtPeriodEnd orders the results by the cancel_at_period_end field.
+func ByCancelAtPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelAtPeriodEnd, opts...).ToFunc()
+}
+
+// ByPendingPriceID orders the results by the pending_price_id field.
+func ByPendingPriceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingPriceID, opts...).ToFunc()
+}
+
+// ByPendingChangeAt orders the results by the pending_change_at field.
+func ByPendingChangeAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingChangeAt, opts...).ToFunc()
+}
+
+// ByPastDueAt orders the results by the past_due_at field.
+func ByPastDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPastDueAt, opts...).ToFunc()
+}
+
+// ByDunningReminders orders the results by the dunning_reminders field.
+func ByDunningReminders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDunningReminders, opts...).ToFunc()
+}
+
+// ByTrialRemindedAt orders the results by the trial_reminded_at field.
+func ByTrialRemindedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialRemindedAt, opts...).ToFunc()
+}
+
+// ByLastEventAt orders the results by the last_event_at field.
+func ByLastEventAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastEventAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -283,6 +354,20 @@ func ByCustomerField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCustomerStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByDiscountsCount orders the results by discounts count.
+func ByDiscountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDiscountsStep(), opts...)
+	}
+}
+
+// ByDiscounts orders the results by discounts terms.
+func ByDiscounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDiscountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCustomerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -290,3 +375,10 @@ func newCustomerStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
 	)
 }
+func newDiscountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DiscountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DiscountsTable, DiscountsColumn),
+	)
+}
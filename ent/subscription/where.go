@@ -115,6 +115,41 @@ func EndedAt(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldEndedAt, v))
 }
 
+// CancelAtPeriodEnd applies equality check predicate on the "cancel_at_period_end" field. It's identical to CancelAtPeriodEndEQ.
+func CancelAtPeriodEnd(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// PendingPriceID applies equality check predicate on the "pending_price_id" field. It's identical to PendingPriceIDEQ.
+func PendingPriceID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPendingPriceID, v))
+}
+
+// PendingChangeAt applies equality check predicate on the "pending_change_at" field. It's identical to PendingChangeAtEQ.
+func PendingChangeAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPendingChangeAt, v))
+}
+
+// PastDueAt applies equality check predicate on the "past_due_at" field. It's identical to PastDueAtEQ.
+func PastDueAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPastDueAt, v))
+}
+
+// DunningReminders applies equality check predicate on the "dunning_reminders" field. It's identical to DunningRemindersEQ.
+func DunningReminders(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldDunningReminders, v))
+}
+
+// TrialRemindedAt applies equality check predicate on the "trial_reminded_at" field. It's identical to TrialRemindedAtEQ.
+func TrialRemindedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialRemindedAt, v))
+}
+
+// LastEventAt applies equality check predicate on the "last_event_at" field. It's identical to LastEventAtEQ.
+func LastEventAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldLastEventAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
@@ -805,6 +840,331 @@ func EndedAtNotNil() predicate.Subscription {
 	return predicate.Subscription(sql.FieldNotNull(FieldEndedAt))
 }
 
+// CancelAtPeriodEndEQ applies the EQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelAtPeriodEndNEQ applies the NEQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// PendingPriceIDEQ applies the EQ predicate on the "pending_price_id" field.
+func PendingPriceIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDNEQ applies the NEQ predicate on the "pending_price_id" field.
+func PendingPriceIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDIn applies the In predicate on the "pending_price_id" field.
+func PendingPriceIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPendingPriceID, vs...))
+}
+
+// PendingPriceIDNotIn applies the NotIn predicate on the "pending_price_id" field.
+func PendingPriceIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPendingPriceID, vs...))
+}
+
+// PendingPriceIDGT applies the GT predicate on the "pending_price_id" field.
+func PendingPriceIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDGTE applies the GTE predicate on the "pending_price_id" field.
+func PendingPriceIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDLT applies the LT predicate on the "pending_price_id" field.
+func PendingPriceIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDLTE applies the LTE predicate on the "pending_price_id" field.
+func PendingPriceIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDContains applies the Contains predicate on the "pending_price_id" field.
+func PendingPriceIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDHasPrefix applies the HasPrefix predicate on the "pending_price_id" field.
+func PendingPriceIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDHasSuffix applies the HasSuffix predicate on the "pending_price_id" field.
+func PendingPriceIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDIsNil applies the IsNil predicate on the "pending_price_id" field.
+func PendingPriceIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldPendingPriceID))
+}
+
+// PendingPriceIDNotNil applies the NotNil predicate on the "pending_price_id" field.
+func PendingPriceIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldPendingPriceID))
+}
+
+// PendingPriceIDEqualFold applies the EqualFold predicate on the "pending_price_id" field.
+func PendingPriceIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldPendingPriceID, v))
+}
+
+// PendingPriceIDContainsFold applies the ContainsFold predicate on the "pending_price_id" field.
+func PendingPriceIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldPendingPriceID, v))
+}
+
+// PendingChangeAtEQ applies the EQ predicate on the "pending_change_at" field.
+func PendingChangeAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPendingChangeAt, v))
+}
+
+// PendingChangeAtNEQ applies the NEQ predicate on the "pending_change_at" field.
+func PendingChangeAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPendingChangeAt, v))
+}
+
+// PendingChangeAtIn applies the In predicate on the "pending_change_at" field.
+func PendingChangeAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPendingChangeAt, vs...))
+}
+
+// PendingChangeAtNotIn applies the NotIn predicate on the "pending_change_at" field.
+func PendingChangeAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPendingChangeAt, vs...))
+}
+
+// PendingChangeAtGT applies the GT predicate on the "pending_change_at" field.
+func PendingChangeAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPendingChangeAt, v))
+}
+
+// PendingChangeAtGTE applies the GTE predicate on the "pending_change_at" field.
+func PendingChangeAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPendingChangeAt, v))
+}
+
+// PendingChangeAtLT applies the LT predicate on the "pending_change_at" field.
+func PendingChangeAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPendingChangeAt, v))
+}
+
+// PendingChangeAtLTE applies the LTE predicate on the "pending_change_at" field.
+func PendingChangeAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPendingChangeAt, v))
+}
+
+// PendingChangeAtIsNil applies the IsNil predicate on the "pending_change_at" field.
+func PendingChangeAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldPendingChangeAt))
+}
+
+// PendingChangeAtNotNil applies the NotNil predicate on the "pending_change_at" field.
+func PendingChangeAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldPendingChangeAt))
+}
+
+// PastDueAtEQ applies the EQ predicate on the "past_due_at" field.
+func PastDueAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPastDueAt, v))
+}
+
+// PastDueAtNEQ applies the NEQ predicate on the "past_due_at" field.
+func PastDueAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPastDueAt, v))
+}
+
+// PastDueAtIn applies the In predicate on the "past_due_at" field.
+func PastDueAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPastDueAt, vs...))
+}
+
+// PastDueAtNotIn applies the NotIn predicate on the "past_due_at" field.
+func PastDueAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPastDueAt, vs...))
+}
+
+// PastDueAtGT applies the GT predicate on the "past_due_at" field.
+func PastDueAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPastDueAt, v))
+}
+
+// PastDueAtGTE applies the GTE predicate on the "past_due_at" field.
+func PastDueAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPastDueAt, v))
+}
+
+// PastDueAtLT applies the LT predicate on the "past_due_at" field.
+func PastDueAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPastDueAt, v))
+}
+
+// PastDueAtLTE applies the LTE predicate on the "past_due_at" field.
+func PastDueAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPastDueAt, v))
+}
+
+// PastDueAtIsNil applies the IsNil predicate on the "past_due_at" field.
+func PastDueAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldPastDueAt))
+}
+
+// PastDueAtNotNil applies the NotNil predicate on the "past_due_at" field.
+func PastDueAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldPastDueAt))
+}
+
+// DunningRemindersEQ applies the EQ predicate on the "dunning_reminders" field.
+func DunningRemindersEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldDunningReminders, v))
+}
+
+// DunningRemindersNEQ applies the NEQ predicate on the "dunning_reminders" field.
+func DunningRemindersNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldDunningReminders, v))
+}
+
+// DunningRemindersIn applies the In predicate on the "dunning_reminders" field.
+func DunningRemindersIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldDunningReminders, vs...))
+}
+
+// DunningRemindersNotIn applies the NotIn predicate on the "dunning_reminders" field.
+func DunningRemindersNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldDunningReminders, vs...))
+}
+
+// DunningRemindersGT applies the GT predicate on the "dunning_reminders" field.
+func DunningRemindersGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldDunningReminders, v))
+}
+
+// DunningRemindersGTE applies the GTE predicate on the "dunning_reminders" field.
+func DunningRemindersGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldDunningReminders, v))
+}
+
+// DunningRemindersLT applies the LT predicate on the "dunning_reminders" field.
+func DunningRemindersLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldDunningReminders, v))
+}
+
+// DunningRemindersLTE applies the LTE predicate on the "dunning_reminders" field.
+func DunningRemindersLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldDunningReminders, v))
+}
+
+// TrialRemindedAtEQ applies the EQ predicate on the "trial_reminded_at" field.
+func TrialRemindedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialRemindedAt, v))
+}
+
+// TrialRemindedAtNEQ applies the NEQ predicate on the "trial_reminded_at" field.
+func TrialRemindedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTrialRemindedAt, v))
+}
+
+// TrialRemindedAtIn applies the In predicate on the "trial_reminded_at" field.
+func TrialRemindedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldTrialRemindedAt, vs...))
+}
+
+// TrialRemindedAtNotIn applies the NotIn predicate on the "trial_reminded_at" field.
+func TrialRemindedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldTrialRemindedAt, vs...))
+}
+
+// TrialRemindedAtGT applies the GT predicate on the "trial_reminded_at" field.
+func TrialRemindedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldTrialRemindedAt, v))
+}
+
+// TrialRemindedAtGTE applies the GTE predicate on the "trial_reminded_at" field.
+func TrialRemindedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldTrialRemindedAt, v))
+}
+
+// TrialRemindedAtLT applies the LT predicate on the "trial_reminded_at" field.
+func TrialRemindedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldTrialRemindedAt, v))
+}
+
+// TrialRemindedAtLTE applies the LTE predicate on the "trial_reminded_at" field.
+func TrialRemindedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldTrialRemindedAt, v))
+}
+
+// TrialRemindedAtIsNil applies the IsNil predicate on the "trial_reminded_at" field.
+func TrialRemindedAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldTrialRemindedAt))
+}
+
+// TrialRemindedAtNotNil applies the NotNil predicate on the "trial_reminded_at" field.
+func TrialRemindedAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldTrialRemindedAt))
+}
+
+// LastEventAtEQ applies the EQ predicate on the "last_event_at" field.
+func LastEventAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldLastEventAt, v))
+}
+
+// LastEventAtNEQ applies the NEQ predicate on the "last_event_at" field.
+func LastEventAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldLastEventAt, v))
+}
+
+// LastEventAtIn applies the In predicate on the "last_event_at" field.
+func LastEventAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldLastEventAt, vs...))
+}
+
+// LastEventAtNotIn applies the NotIn predicate on the "last_event_at" field.
+func LastEventAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldLastEventAt, vs...))
+}
+
+// LastEventAtGT applies the GT predicate on the "last_event_at" field.
+func LastEventAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldLastEventAt, v))
+}
+
+// LastEventAtGTE applies the GTE predicate on the "last_event_at" field.
+func LastEventAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldLastEventAt, v))
+}
+
+// LastEventAtLT applies the LT predicate on the "last_event_at" field.
+func LastEventAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldLastEventAt, v))
+}
+
+// LastEventAtLTE applies the LTE predicate on the "last_event_at" field.
+func LastEventAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldLastEventAt, v))
+}
+
+// LastEventAtIsNil applies the IsNil predicate on the "last_event_at" field.
+func LastEventAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldLastEventAt))
+}
+
+// LastEventAtNotNil applies the NotNil predicate on the "last_event_at" field.
+func LastEventAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldLastEventAt))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Subscription {
 	return predicate.Subscription(sql.FieldIsNull(FieldMetadata))
@@ -918,6 +1278,29 @@ func HasCustomerWith(preds ...predicate.PaymentCustomer) predicate.Subscription
 	})
 }
 
+// HasDiscounts applies the HasEdge predicate on the "discounts" edge.
+func HasDiscounts() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DiscountsTable, DiscountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiscountsWith applies the HasEdge predicate on the "discounts" edge with a given conditions (other predicates).
+func HasDiscountsWith(preds ...predicate.Discount) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newDiscountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Subscription) predicate.Subscription {
 	return predicate.Subscription(sql.AndPredicates(predicates...))
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/discount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/subscription"
 )
@@ -19,6 +21,7 @@ type SubscriptionCreate struct {
 	config
 	mutation *SubscriptionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProviderSubscriptionID sets the "provider_subscription_id" field.
@@ -185,6 +188,104 @@ func (sc *SubscriptionCreate) SetNillableEndedAt(t *time.Time) *SubscriptionCrea
 	return sc
 }
 
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (sc *SubscriptionCreate) SetCancelAtPeriodEnd(b bool) *SubscriptionCreate {
+	sc.mutation.SetCancelAtPeriodEnd(b)
+	return sc
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetCancelAtPeriodEnd(*b)
+	}
+	return sc
+}
+
+// SetPendingPriceID sets the "pending_price_id" field.
+func (sc *SubscriptionCreate) SetPendingPriceID(s string) *SubscriptionCreate {
+	sc.mutation.SetPendingPriceID(s)
+	return sc
+}
+
+// SetNillablePendingPriceID sets the "pending_price_id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillablePendingPriceID(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetPendingPriceID(*s)
+	}
+	return sc
+}
+
+// SetPendingChangeAt sets the "pending_change_at" field.
+func (sc *SubscriptionCreate) SetPendingChangeAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetPendingChangeAt(t)
+	return sc
+}
+
+// SetNillablePendingChangeAt sets the "pending_change_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillablePendingChangeAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetPendingChangeAt(*t)
+	}
+	return sc
+}
+
+// SetPastDueAt sets the "past_due_at" field.
+func (sc *SubscriptionCreate) SetPastDueAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetPastDueAt(t)
+	return sc
+}
+
+// SetNillablePastDueAt sets the "past_due_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillablePastDueAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetPastDueAt(*t)
+	}
+	return sc
+}
+
+// SetDunningReminders sets the "dunning_reminders" field.
+func (sc *SubscriptionCreate) SetDunningReminders(i int) *SubscriptionCreate {
+	sc.mutation.SetDunningReminders(i)
+	return sc
+}
+
+// SetNillableDunningReminders sets the "dunning_reminders" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableDunningReminders(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetDunningReminders(*i)
+	}
+	return sc
+}
+
+// SetTrialRemindedAt sets the "trial_reminded_at" field.
+func (sc *SubscriptionCreate) SetTrialRemindedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetTrialRemindedAt(t)
+	return sc
+}
+
+// SetNillableTrialRemindedAt sets the "trial_reminded_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableTrialRemindedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetTrialRemindedAt(*t)
+	}
+	return sc
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (sc *SubscriptionCreate) SetLastEventAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetLastEventAt(t)
+	return sc
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableLastEventAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetLastEventAt(*t)
+	}
+	return sc
+}
+
 // SetMetadata sets the "metadata" field.
 func (sc *SubscriptionCreate) SetMetadata(m map[string]interface{}) *SubscriptionCreate {
 	sc.mutation.SetMetadata(m)
@@ -230,6 +331,21 @@ func (sc *SubscriptionCreate) SetCustomer(p *PaymentCustomer) *SubscriptionCreat
 	return sc.SetCustomerID(p.ID)
 }
 
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (sc *SubscriptionCreate) AddDiscountIDs(ids ...int) *SubscriptionCreate {
+	sc.mutation.AddDiscountIDs(ids...)
+	return sc
+}
+
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (sc *SubscriptionCreate) AddDiscounts(d ...*Discount) *SubscriptionCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return sc.AddDiscountIDs(ids...)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (sc *SubscriptionCreate) Mutation() *SubscriptionMutation {
 	return sc.mutation
@@ -281,6 +397,14 @@ func (sc *SubscriptionCreate) defaults() {
 		v := subscription.DefaultIntervalCount
 		sc.mutation.SetIntervalCount(v)
 	}
+	if _, ok := sc.mutation.CancelAtPeriodEnd(); !ok {
+		v := subscription.DefaultCancelAtPeriodEnd
+		sc.mutation.SetCancelAtPeriodEnd(v)
+	}
+	if _, ok := sc.mutation.DunningReminders(); !ok {
+		v := subscription.DefaultDunningReminders
+		sc.mutation.SetDunningReminders(v)
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		v := subscription.DefaultCreatedAt()
 		sc.mutation.SetCreatedAt(v)
@@ -357,6 +481,17 @@ func (sc *SubscriptionCreate) check() error {
 			return &ValidationError{Name: "interval_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval_count": %w`, err)}
 		}
 	}
+	if _, ok := sc.mutation.CancelAtPeriodEnd(); !ok {
+		return &ValidationError{Name: "cancel_at_period_end", err: errors.New(`ent: missing required field "Subscription.cancel_at_period_end"`)}
+	}
+	if _, ok := sc.mutation.DunningReminders(); !ok {
+		return &ValidationError{Name: "dunning_reminders", err: errors.New(`ent: missing required field "Subscription.dunning_reminders"`)}
+	}
+	if v, ok := sc.mutation.DunningReminders(); ok {
+		if err := subscription.DunningRemindersValidator(v); err != nil {
+			return &ValidationError{Name: "dunning_reminders", err: fmt.Errorf(`ent: validator failed for field "Subscription.dunning_reminders": %w`, err)}
+		}
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Subscription.created_at"`)}
 	}
@@ -392,6 +527,7 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_node = &Subscription{config: sc.config}
 		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = sc.conflict
 	if value, ok := sc.mutation.ProviderSubscriptionID(); ok {
 		_spec.SetField(subscription.FieldProviderSubscriptionID, field.TypeString, value)
 		_node.ProviderSubscriptionID = value
@@ -448,6 +584,34 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
 		_node.EndedAt = value
 	}
+	if value, ok := sc.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+		_node.CancelAtPeriodEnd = value
+	}
+	if value, ok := sc.mutation.PendingPriceID(); ok {
+		_spec.SetField(subscription.FieldPendingPriceID, field.TypeString, value)
+		_node.PendingPriceID = value
+	}
+	if value, ok := sc.mutation.PendingChangeAt(); ok {
+		_spec.SetField(subscription.FieldPendingChangeAt, field.TypeTime, value)
+		_node.PendingChangeAt = value
+	}
+	if value, ok := sc.mutation.PastDueAt(); ok {
+		_spec.SetField(subscription.FieldPastDueAt, field.TypeTime, value)
+		_node.PastDueAt = value
+	}
+	if value, ok := sc.mutation.DunningReminders(); ok {
+		_spec.SetField(subscription.FieldDunningReminders, field.TypeInt, value)
+		_node.DunningReminders = value
+	}
+	if value, ok := sc.mutation.TrialRemindedAt(); ok {
+		_spec.SetField(subscription.FieldTrialRemindedAt, field.TypeTime, value)
+		_node.TrialRemindedAt = value
+	}
+	if value, ok := sc.mutation.LastEventAt(); ok {
+		_spec.SetField(subscription.FieldLastEventAt, field.TypeTime, value)
+		_node.LastEventAt = value
+	}
 	if value, ok := sc.mutation.Metadata(); ok {
 		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -477,93 +641,1569 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_node.payment_customer_subscriptions = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.DiscountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.DiscountsTable,
+			Columns: []string{subscription.DiscountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
-type SubscriptionCreateBulk struct {
-	config
-	err      error
-	builders []*SubscriptionCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Subscription.Create().
+//		SetProviderSubscriptionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionUpsert) {
+//			SetProviderSubscriptionID(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *SubscriptionUpsertOne {
+	sc.conflict = opts
+	return &SubscriptionUpsertOne{
+		create: sc,
+	}
 }
 
-// Save creates the Subscription entities in the database.
-func (scb *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
-	if scb.err != nil {
-		return nil, scb.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SubscriptionCreate) OnConflictColumns(columns ...string) *SubscriptionUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionUpsertOne{
+		create: sc,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
-	nodes := make([]*Subscription, len(scb.builders))
-	mutators := make([]Mutator, len(scb.builders))
-	for i := range scb.builders {
-		func(i int, root context.Context) {
-			builder := scb.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*SubscriptionMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// SubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one Subscription node.
+	SubscriptionUpsertOne struct {
+		create *SubscriptionCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// SubscriptionUpsert is the "OnConflict" setter.
+	SubscriptionUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *SubscriptionUpsert) SetProviderSubscriptionID(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldProviderSubscriptionID, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (scb *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
-	v, err := scb.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateProviderSubscriptionID() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldProviderSubscriptionID)
+	return u
 }
 
-// Exec executes the query.
-func (scb *SubscriptionCreateBulk) Exec(ctx context.Context) error {
-	_, err := scb.Save(ctx)
-	return err
+// SetProvider sets the "provider" field.
+func (u *SubscriptionUpsert) SetProvider(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldProvider, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (scb *SubscriptionCreateBulk) ExecX(ctx context.Context) {
-	if err := scb.Exec(ctx); err != nil {
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateProvider() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldProvider)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsert) SetStatus(v subscription.Status) *SubscriptionUpsert {
+	u.Set(subscription.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateStatus() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldStatus)
+	return u
+}
+
+// SetPriceID sets the "price_id" field.
+func (u *SubscriptionUpsert) SetPriceID(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldPriceID, v)
+	return u
+}
+
+// UpdatePriceID sets the "price_id" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePriceID() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPriceID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionUpsert) SetAmount(v int64) *SubscriptionUpsert {
+	u.Set(subscription.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateAmount() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionUpsert) AddAmount(v int64) *SubscriptionUpsert {
+	u.Add(subscription.FieldAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionUpsert) SetCurrency(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCurrency() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCurrency)
+	return u
+}
+
+// SetInterval sets the "interval" field.
+func (u *SubscriptionUpsert) SetInterval(v subscription.Interval) *SubscriptionUpsert {
+	u.Set(subscription.FieldInterval, v)
+	return u
+}
+
+// UpdateInterval sets the "interval" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateInterval() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldInterval)
+	return u
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (u *SubscriptionUpsert) SetIntervalCount(v int) *SubscriptionUpsert {
+	u.Set(subscription.FieldIntervalCount, v)
+	return u
+}
+
+// UpdateIntervalCount sets the "interval_count" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateIntervalCount() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldIntervalCount)
+	return u
+}
+
+// AddIntervalCount adds v to the "interval_count" field.
+func (u *SubscriptionUpsert) AddIntervalCount(v int) *SubscriptionUpsert {
+	u.Add(subscription.FieldIntervalCount, v)
+	return u
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (u *SubscriptionUpsert) SetCurrentPeriodStart(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCurrentPeriodStart, v)
+	return u
+}
+
+// UpdateCurrentPeriodStart sets the "current_period_start" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCurrentPeriodStart() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCurrentPeriodStart)
+	return u
+}
+
+// ClearCurrentPeriodStart clears the value of the "current_period_start" field.
+func (u *SubscriptionUpsert) ClearCurrentPeriodStart() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldCurrentPeriodStart)
+	return u
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionUpsert) SetCurrentPeriodEnd(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCurrentPeriodEnd, v)
+	return u
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCurrentPeriodEnd() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCurrentPeriodEnd)
+	return u
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (u *SubscriptionUpsert) ClearCurrentPeriodEnd() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldCurrentPeriodEnd)
+	return u
+}
+
+// SetTrialStart sets the "trial_start" field.
+func (u *SubscriptionUpsert) SetTrialStart(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldTrialStart, v)
+	return u
+}
+
+// UpdateTrialStart sets the "trial_start" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateTrialStart() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldTrialStart)
+	return u
+}
+
+// ClearTrialStart clears the value of the "trial_start" field.
+func (u *SubscriptionUpsert) ClearTrialStart() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldTrialStart)
+	return u
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (u *SubscriptionUpsert) SetTrialEnd(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldTrialEnd, v)
+	return u
+}
+
+// UpdateTrialEnd sets the "trial_end" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateTrialEnd() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldTrialEnd)
+	return u
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (u *SubscriptionUpsert) ClearTrialEnd() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldTrialEnd)
+	return u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (u *SubscriptionUpsert) SetCanceledAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCanceledAt, v)
+	return u
+}
+
+// UpdateCanceledAt sets the "canceled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCanceledAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCanceledAt)
+	return u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (u *SubscriptionUpsert) ClearCanceledAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldCanceledAt)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsert) SetEndedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateEndedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldEndedAt)
+	return u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsert) ClearEndedAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldEndedAt)
+	return u
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsert) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsert {
+	u.Set(subscription.FieldCancelAtPeriodEnd, v)
+	return u
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCancelAtPeriodEnd() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCancelAtPeriodEnd)
+	return u
+}
+
+// SetPendingPriceID sets the "pending_price_id" field.
+func (u *SubscriptionUpsert) SetPendingPriceID(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldPendingPriceID, v)
+	return u
+}
+
+// UpdatePendingPriceID sets the "pending_price_id" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePendingPriceID() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPendingPriceID)
+	return u
+}
+
+// ClearPendingPriceID clears the value of the "pending_price_id" field.
+func (u *SubscriptionUpsert) ClearPendingPriceID() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldPendingPriceID)
+	return u
+}
+
+// SetPendingChangeAt sets the "pending_change_at" field.
+func (u *SubscriptionUpsert) SetPendingChangeAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldPendingChangeAt, v)
+	return u
+}
+
+// UpdatePendingChangeAt sets the "pending_change_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePendingChangeAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPendingChangeAt)
+	return u
+}
+
+// ClearPendingChangeAt clears the value of the "pending_change_at" field.
+func (u *SubscriptionUpsert) ClearPendingChangeAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldPendingChangeAt)
+	return u
+}
+
+// SetPastDueAt sets the "past_due_at" field.
+func (u *SubscriptionUpsert) SetPastDueAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldPastDueAt, v)
+	return u
+}
+
+// UpdatePastDueAt sets the "past_due_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePastDueAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPastDueAt)
+	return u
+}
+
+// ClearPastDueAt clears the value of the "past_due_at" field.
+func (u *SubscriptionUpsert) ClearPastDueAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldPastDueAt)
+	return u
+}
+
+// SetDunningReminders sets the "dunning_reminders" field.
+func (u *SubscriptionUpsert) SetDunningReminders(v int) *SubscriptionUpsert {
+	u.Set(subscription.FieldDunningReminders, v)
+	return u
+}
+
+// UpdateDunningReminders sets the "dunning_reminders" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateDunningReminders() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldDunningReminders)
+	return u
+}
+
+// AddDunningReminders adds v to the "dunning_reminders" field.
+func (u *SubscriptionUpsert) AddDunningReminders(v int) *SubscriptionUpsert {
+	u.Add(subscription.FieldDunningReminders, v)
+	return u
+}
+
+// SetTrialRemindedAt sets the "trial_reminded_at" field.
+func (u *SubscriptionUpsert) SetTrialRemindedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldTrialRemindedAt, v)
+	return u
+}
+
+// UpdateTrialRemindedAt sets the "trial_reminded_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateTrialRemindedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldTrialRemindedAt)
+	return u
+}
+
+// ClearTrialRemindedAt clears the value of the "trial_reminded_at" field.
+func (u *SubscriptionUpsert) ClearTrialRemindedAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldTrialRemindedAt)
+	return u
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (u *SubscriptionUpsert) SetLastEventAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldLastEventAt, v)
+	return u
+}
+
+// UpdateLastEventAt sets the "last_event_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateLastEventAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldLastEventAt)
+	return u
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (u *SubscriptionUpsert) ClearLastEventAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldLastEventAt)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionUpsert) SetMetadata(v map[string]interface{}) *SubscriptionUpsert {
+	u.Set(subscription.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateMetadata() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SubscriptionUpsert) ClearMetadata() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsert) SetUpdatedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateUpdatedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionUpsertOne) UpdateNewValues() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(subscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SubscriptionUpsertOne) Ignore() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionUpsertOne) DoNothing() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *SubscriptionUpsertOne) Update(set func(*SubscriptionUpsert)) *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *SubscriptionUpsertOne) SetProviderSubscriptionID(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetProviderSubscriptionID(v)
+	})
+}
+
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateProviderSubscriptionID() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateProviderSubscriptionID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *SubscriptionUpsertOne) SetProvider(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateProvider() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsertOne) SetStatus(v subscription.Status) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateStatus() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriceID sets the "price_id" field.
+func (u *SubscriptionUpsertOne) SetPriceID(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPriceID(v)
+	})
+}
+
+// UpdatePriceID sets the "price_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePriceID() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePriceID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionUpsertOne) SetAmount(v int64) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionUpsertOne) AddAmount(v int64) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateAmount() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionUpsertOne) SetCurrency(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCurrency() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetInterval sets the "interval" field.
+func (u *SubscriptionUpsertOne) SetInterval(v subscription.Interval) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetInterval(v)
+	})
+}
+
+// UpdateInterval sets the "interval" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateInterval() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateInterval()
+	})
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (u *SubscriptionUpsertOne) SetIntervalCount(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetIntervalCount(v)
+	})
+}
+
+// AddIntervalCount adds v to the "interval_count" field.
+func (u *SubscriptionUpsertOne) AddIntervalCount(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddIntervalCount(v)
+	})
+}
+
+// UpdateIntervalCount sets the "interval_count" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateIntervalCount() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateIntervalCount()
+	})
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (u *SubscriptionUpsertOne) SetCurrentPeriodStart(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentPeriodStart(v)
+	})
+}
+
+// UpdateCurrentPeriodStart sets the "current_period_start" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCurrentPeriodStart() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentPeriodStart()
+	})
+}
+
+// ClearCurrentPeriodStart clears the value of the "current_period_start" field.
+func (u *SubscriptionUpsertOne) ClearCurrentPeriodStart() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCurrentPeriodStart()
+	})
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionUpsertOne) SetCurrentPeriodEnd(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentPeriodEnd(v)
+	})
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCurrentPeriodEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentPeriodEnd()
+	})
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (u *SubscriptionUpsertOne) ClearCurrentPeriodEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCurrentPeriodEnd()
+	})
+}
+
+// SetTrialStart sets the "trial_start" field.
+func (u *SubscriptionUpsertOne) SetTrialStart(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTrialStart(v)
+	})
+}
+
+// UpdateTrialStart sets the "trial_start" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateTrialStart() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTrialStart()
+	})
+}
+
+// ClearTrialStart clears the value of the "trial_start" field.
+func (u *SubscriptionUpsertOne) ClearTrialStart() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearTrialStart()
+	})
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (u *SubscriptionUpsertOne) SetTrialEnd(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTrialEnd(v)
+	})
+}
+
+// UpdateTrialEnd sets the "trial_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateTrialEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTrialEnd()
+	})
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (u *SubscriptionUpsertOne) ClearTrialEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearTrialEnd()
+	})
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (u *SubscriptionUpsertOne) SetCanceledAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCanceledAt(v)
+	})
+}
+
+// UpdateCanceledAt sets the "canceled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCanceledAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCanceledAt()
+	})
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (u *SubscriptionUpsertOne) ClearCanceledAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCanceledAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsertOne) SetEndedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateEndedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsertOne) ClearEndedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsertOne) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelAtPeriodEnd(v)
+	})
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCancelAtPeriodEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelAtPeriodEnd()
+	})
+}
+
+// SetPendingPriceID sets the "pending_price_id" field.
+func (u *SubscriptionUpsertOne) SetPendingPriceID(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPendingPriceID(v)
+	})
+}
+
+// UpdatePendingPriceID sets the "pending_price_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePendingPriceID() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePendingPriceID()
+	})
+}
+
+// ClearPendingPriceID clears the value of the "pending_price_id" field.
+func (u *SubscriptionUpsertOne) ClearPendingPriceID() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPendingPriceID()
+	})
+}
+
+// SetPendingChangeAt sets the "pending_change_at" field.
+func (u *SubscriptionUpsertOne) SetPendingChangeAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPendingChangeAt(v)
+	})
+}
+
+// UpdatePendingChangeAt sets the "pending_change_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePendingChangeAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePendingChangeAt()
+	})
+}
+
+// ClearPendingChangeAt clears the value of the "pending_change_at" field.
+func (u *SubscriptionUpsertOne) ClearPendingChangeAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPendingChangeAt()
+	})
+}
+
+// SetPastDueAt sets the "past_due_at" field.
+func (u *SubscriptionUpsertOne) SetPastDueAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPastDueAt(v)
+	})
+}
+
+// UpdatePastDueAt sets the "past_due_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePastDueAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePastDueAt()
+	})
+}
+
+// ClearPastDueAt clears the value of the "past_due_at" field.
+func (u *SubscriptionUpsertOne) ClearPastDueAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPastDueAt()
+	})
+}
+
+// SetDunningReminders sets the "dunning_reminders" field.
+func (u *SubscriptionUpsertOne) SetDunningReminders(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetDunningReminders(v)
+	})
+}
+
+// AddDunningReminders adds v to the "dunning_reminders" field.
+func (u *SubscriptionUpsertOne) AddDunningReminders(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddDunningReminders(v)
+	})
+}
+
+// UpdateDunningReminders sets the "dunning_reminders" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateDunningReminders() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateDunningReminders()
+	})
+}
+
+// SetTrialRemindedAt sets the "trial_reminded_at" field.
+func (u *SubscriptionUpsertOne) SetTrialRemindedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTrialRemindedAt(v)
+	})
+}
+
+// UpdateTrialRemindedAt sets the "trial_reminded_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateTrialRemindedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTrialRemindedAt()
+	})
+}
+
+// ClearTrialRemindedAt clears the value of the "trial_reminded_at" field.
+func (u *SubscriptionUpsertOne) ClearTrialRemindedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearTrialRemindedAt()
+	})
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (u *SubscriptionUpsertOne) SetLastEventAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetLastEventAt(v)
+	})
+}
+
+// UpdateLastEventAt sets the "last_event_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateLastEventAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateLastEventAt()
+	})
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (u *SubscriptionUpsertOne) ClearLastEventAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearLastEventAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionUpsertOne) SetMetadata(v map[string]interface{}) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateMetadata() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SubscriptionUpsertOne) ClearMetadata() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsertOne) SetUpdatedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateUpdatedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SubscriptionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SubscriptionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
+type SubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Subscription entities in the database.
+func (scb *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Subscription, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Subscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionUpsert) {
+//			SetProviderSubscriptionID(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *SubscriptionUpsertBulk {
+	scb.conflict = opts
+	return &SubscriptionUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SubscriptionCreateBulk) OnConflictColumns(columns ...string) *SubscriptionUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionUpsertBulk{
+		create: scb,
+	}
+}
+
+// SubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Subscription nodes.
+type SubscriptionUpsertBulk struct {
+	create *SubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionUpsertBulk) UpdateNewValues() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(subscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SubscriptionUpsertBulk) Ignore() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionUpsertBulk) DoNothing() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *SubscriptionUpsertBulk) Update(set func(*SubscriptionUpsert)) *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *SubscriptionUpsertBulk) SetProviderSubscriptionID(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetProviderSubscriptionID(v)
+	})
+}
+
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateProviderSubscriptionID() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateProviderSubscriptionID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *SubscriptionUpsertBulk) SetProvider(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateProvider() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsertBulk) SetStatus(v subscription.Status) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateStatus() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriceID sets the "price_id" field.
+func (u *SubscriptionUpsertBulk) SetPriceID(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPriceID(v)
+	})
+}
+
+// UpdatePriceID sets the "price_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePriceID() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePriceID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionUpsertBulk) SetAmount(v int64) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionUpsertBulk) AddAmount(v int64) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateAmount() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionUpsertBulk) SetCurrency(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCurrency() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetInterval sets the "interval" field.
+func (u *SubscriptionUpsertBulk) SetInterval(v subscription.Interval) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetInterval(v)
+	})
+}
+
+// UpdateInterval sets the "interval" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateInterval() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateInterval()
+	})
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (u *SubscriptionUpsertBulk) SetIntervalCount(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetIntervalCount(v)
+	})
+}
+
+// AddIntervalCount adds v to the "interval_count" field.
+func (u *SubscriptionUpsertBulk) AddIntervalCount(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddIntervalCount(v)
+	})
+}
+
+// UpdateIntervalCount sets the "interval_count" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateIntervalCount() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateIntervalCount()
+	})
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (u *SubscriptionUpsertBulk) SetCurrentPeriodStart(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentPeriodStart(v)
+	})
+}
+
+// UpdateCurrentPeriodStart sets the "current_period_start" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCurrentPeriodStart() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentPeriodStart()
+	})
+}
+
+// ClearCurrentPeriodStart clears the value of the "current_period_start" field.
+func (u *SubscriptionUpsertBulk) ClearCurrentPeriodStart() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCurrentPeriodStart()
+	})
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionUpsertBulk) SetCurrentPeriodEnd(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentPeriodEnd(v)
+	})
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCurrentPeriodEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentPeriodEnd()
+	})
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (u *SubscriptionUpsertBulk) ClearCurrentPeriodEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCurrentPeriodEnd()
+	})
+}
+
+// SetTrialStart sets the "trial_start" field.
+func (u *SubscriptionUpsertBulk) SetTrialStart(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTrialStart(v)
+	})
+}
+
+// UpdateTrialStart sets the "trial_start" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateTrialStart() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTrialStart()
+	})
+}
+
+// ClearTrialStart clears the value of the "trial_start" field.
+func (u *SubscriptionUpsertBulk) ClearTrialStart() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearTrialStart()
+	})
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (u *SubscriptionUpsertBulk) SetTrialEnd(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTrialEnd(v)
+	})
+}
+
+// UpdateTrialEnd sets the "trial_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateTrialEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTrialEnd()
+	})
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (u *SubscriptionUpsertBulk) ClearTrialEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearTrialEnd()
+	})
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (u *SubscriptionUpsertBulk) SetCanceledAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCanceledAt(v)
+	})
+}
+
+// UpdateCanceledAt sets the "canceled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCanceledAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCanceledAt()
+	})
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (u *SubscriptionUpsertBulk) ClearCanceledAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCanceledAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsertBulk) SetEndedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateEndedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsertBulk) ClearEndedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsertBulk) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelAtPeriodEnd(v)
+	})
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCancelAtPeriodEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelAtPeriodEnd()
+	})
+}
+
+// SetPendingPriceID sets the "pending_price_id" field.
+func (u *SubscriptionUpsertBulk) SetPendingPriceID(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPendingPriceID(v)
+	})
+}
+
+// UpdatePendingPriceID sets the "pending_price_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePendingPriceID() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePendingPriceID()
+	})
+}
+
+// ClearPendingPriceID clears the value of the "pending_price_id" field.
+func (u *SubscriptionUpsertBulk) ClearPendingPriceID() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPendingPriceID()
+	})
+}
+
+// SetPendingChangeAt sets the "pending_change_at" field.
+func (u *SubscriptionUpsertBulk) SetPendingChangeAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPendingChangeAt(v)
+	})
+}
+
+// UpdatePendingChangeAt sets the "pending_change_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePendingChangeAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePendingChangeAt()
+	})
+}
+
+// ClearPendingChangeAt clears the value of the "pending_change_at" field.
+func (u *SubscriptionUpsertBulk) ClearPendingChangeAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPendingChangeAt()
+	})
+}
+
+// SetPastDueAt sets the "past_due_at" field.
+func (u *SubscriptionUpsertBulk) SetPastDueAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPastDueAt(v)
+	})
+}
+
+// UpdatePastDueAt sets the "past_due_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePastDueAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePastDueAt()
+	})
+}
+
+// ClearPastDueAt clears the value of the "past_due_at" field.
+func (u *SubscriptionUpsertBulk) ClearPastDueAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPastDueAt()
+	})
+}
+
+// SetDunningReminders sets the "dunning_reminders" field.
+func (u *SubscriptionUpsertBulk) SetDunningReminders(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetDunningReminders(v)
+	})
+}
+
+// AddDunningReminders adds v to the "dunning_reminders" field.
+func (u *SubscriptionUpsertBulk) AddDunningReminders(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddDunningReminders(v)
+	})
+}
+
+// UpdateDunningReminders sets the "dunning_reminders" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateDunningReminders() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateDunningReminders()
+	})
+}
+
+// SetTrialRemindedAt sets the "trial_reminded_at" field.
+func (u *SubscriptionUpsertBulk) SetTrialRemindedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTrialRemindedAt(v)
+	})
+}
+
+// UpdateTrialRemindedAt sets the "trial_reminded_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateTrialRemindedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTrialRemindedAt()
+	})
+}
+
+// ClearTrialRemindedAt clears the value of the "trial_reminded_at" field.
+func (u *SubscriptionUpsertBulk) ClearTrialRemindedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearTrialRemindedAt()
+	})
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (u *SubscriptionUpsertBulk) SetLastEventAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetLastEventAt(v)
+	})
+}
+
+// UpdateLastEventAt sets the "last_event_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateLastEventAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateLastEventAt()
+	})
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (u *SubscriptionUpsertBulk) ClearLastEventAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearLastEventAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionUpsertBulk) SetMetadata(v map[string]interface{}) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateMetadata() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *SubscriptionUpsertBulk) ClearMetadata() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateUpdatedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/discount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/predicate"
 	"github.com/occult/pagode/ent/subscription"
@@ -275,6 +276,141 @@ func (su *SubscriptionUpdate) ClearEndedAt() *SubscriptionUpdate {
 	return su
 }
 
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (su *SubscriptionUpdate) SetCancelAtPeriodEnd(b bool) *SubscriptionUpdate {
+	su.mutation.SetCancelAtPeriodEnd(b)
+	return su
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetCancelAtPeriodEnd(*b)
+	}
+	return su
+}
+
+// SetPendingPriceID sets the "pending_price_id" field.
+func (su *SubscriptionUpdate) SetPendingPriceID(s string) *SubscriptionUpdate {
+	su.mutation.SetPendingPriceID(s)
+	return su
+}
+
+// SetNillablePendingPriceID sets the "pending_price_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePendingPriceID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetPendingPriceID(*s)
+	}
+	return su
+}
+
+// ClearPendingPriceID clears the value of the "pending_price_id" field.
+func (su *SubscriptionUpdate) ClearPendingPriceID() *SubscriptionUpdate {
+	su.mutation.ClearPendingPriceID()
+	return su
+}
+
+// SetPendingChangeAt sets the "pending_change_at" field.
+func (su *SubscriptionUpdate) SetPendingChangeAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetPendingChangeAt(t)
+	return su
+}
+
+// SetNillablePendingChangeAt sets the "pending_change_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePendingChangeAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetPendingChangeAt(*t)
+	}
+	return su
+}
+
+// ClearPendingChangeAt clears the value of the "pending_change_at" field.
+func (su *SubscriptionUpdate) ClearPendingChangeAt() *SubscriptionUpdate {
+	su.mutation.ClearPendingChangeAt()
+	return su
+}
+
+// SetPastDueAt sets the "past_due_at" field.
+func (su *SubscriptionUpdate) SetPastDueAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetPastDueAt(t)
+	return su
+}
+
+// SetNillablePastDueAt sets the "past_due_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePastDueAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetPastDueAt(*t)
+	}
+	return su
+}
+
+// ClearPastDueAt clears the value of the "past_due_at" field.
+func (su *SubscriptionUpdate) ClearPastDueAt() *SubscriptionUpdate {
+	su.mutation.ClearPastDueAt()
+	return su
+}
+
+// SetDunningReminders sets the "dunning_reminders" field.
+func (su *SubscriptionUpdate) SetDunningReminders(i int) *SubscriptionUpdate {
+	su.mutation.ResetDunningReminders()
+	su.mutation.SetDunningReminders(i)
+	return su
+}
+
+// SetNillableDunningReminders sets the "dunning_reminders" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableDunningReminders(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetDunningReminders(*i)
+	}
+	return su
+}
+
+// AddDunningReminders adds i to the "dunning_reminders" field.
+func (su *SubscriptionUpdate) AddDunningReminders(i int) *SubscriptionUpdate {
+	su.mutation.AddDunningReminders(i)
+	return su
+}
+
+// SetTrialRemindedAt sets the "trial_reminded_at" field.
+func (su *SubscriptionUpdate) SetTrialRemindedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetTrialRemindedAt(t)
+	return su
+}
+
+// SetNillableTrialRemindedAt sets the "trial_reminded_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTrialRemindedAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetTrialRemindedAt(*t)
+	}
+	return su
+}
+
+// ClearTrialRemindedAt clears the value of the "trial_reminded_at" field.
+func (su *SubscriptionUpdate) ClearTrialRemindedAt() *SubscriptionUpdate {
+	su.mutation.ClearTrialRemindedAt()
+	return su
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (su *SubscriptionUpdate) SetLastEventAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetLastEventAt(t)
+	return su
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableLastEventAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetLastEventAt(*t)
+	}
+	return su
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (su *SubscriptionUpdate) ClearLastEventAt() *SubscriptionUpdate {
+	su.mutation.ClearLastEventAt()
+	return su
+}
+
 // SetMetadata sets the "metadata" field.
 func (su *SubscriptionUpdate) SetMetadata(m map[string]interface{}) *SubscriptionUpdate {
 	su.mutation.SetMetadata(m)
@@ -304,6 +440,21 @@ func (su *SubscriptionUpdate) SetCustomer(p *PaymentCustomer) *SubscriptionUpdat
 	return su.SetCustomerID(p.ID)
 }
 
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (su *SubscriptionUpdate) AddDiscountIDs(ids ...int) *SubscriptionUpdate {
+	su.mutation.AddDiscountIDs(ids...)
+	return su
+}
+
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (su *SubscriptionUpdate) AddDiscounts(d ...*Discount) *SubscriptionUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return su.AddDiscountIDs(ids...)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (su *SubscriptionUpdate) Mutation() *SubscriptionMutation {
 	return su.mutation
@@ -315,6 +466,27 @@ func (su *SubscriptionUpdate) ClearCustomer() *SubscriptionUpdate {
 	return su
 }
 
+// ClearDiscounts clears all "discounts" edges to the Discount entity.
+func (su *SubscriptionUpdate) ClearDiscounts() *SubscriptionUpdate {
+	su.mutation.ClearDiscounts()
+	return su
+}
+
+// RemoveDiscountIDs removes the "discounts" edge to Discount entities by IDs.
+func (su *SubscriptionUpdate) RemoveDiscountIDs(ids ...int) *SubscriptionUpdate {
+	su.mutation.RemoveDiscountIDs(ids...)
+	return su
+}
+
+// RemoveDiscounts removes "discounts" edges to Discount entities.
+func (su *SubscriptionUpdate) RemoveDiscounts(d ...*Discount) *SubscriptionUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return su.RemoveDiscountIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
 	su.defaults()
@@ -393,6 +565,11 @@ func (su *SubscriptionUpdate) check() error {
 			return &ValidationError{Name: "interval_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval_count": %w`, err)}
 		}
 	}
+	if v, ok := su.mutation.DunningReminders(); ok {
+		if err := subscription.DunningRemindersValidator(v); err != nil {
+			return &ValidationError{Name: "dunning_reminders", err: fmt.Errorf(`ent: validator failed for field "Subscription.dunning_reminders": %w`, err)}
+		}
+	}
 	if su.mutation.CustomerCleared() && len(su.mutation.CustomerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Subscription.customer"`)
 	}
@@ -477,6 +654,45 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.EndedAtCleared() {
 		_spec.ClearField(subscription.FieldEndedAt, field.TypeTime)
 	}
+	if value, ok := su.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.PendingPriceID(); ok {
+		_spec.SetField(subscription.FieldPendingPriceID, field.TypeString, value)
+	}
+	if su.mutation.PendingPriceIDCleared() {
+		_spec.ClearField(subscription.FieldPendingPriceID, field.TypeString)
+	}
+	if value, ok := su.mutation.PendingChangeAt(); ok {
+		_spec.SetField(subscription.FieldPendingChangeAt, field.TypeTime, value)
+	}
+	if su.mutation.PendingChangeAtCleared() {
+		_spec.ClearField(subscription.FieldPendingChangeAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.PastDueAt(); ok {
+		_spec.SetField(subscription.FieldPastDueAt, field.TypeTime, value)
+	}
+	if su.mutation.PastDueAtCleared() {
+		_spec.ClearField(subscription.FieldPastDueAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.DunningReminders(); ok {
+		_spec.SetField(subscription.FieldDunningReminders, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDunningReminders(); ok {
+		_spec.AddField(subscription.FieldDunningReminders, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.TrialRemindedAt(); ok {
+		_spec.SetField(subscription.FieldTrialRemindedAt, field.TypeTime, value)
+	}
+	if su.mutation.TrialRemindedAtCleared() {
+		_spec.ClearField(subscription.FieldTrialRemindedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.LastEventAt(); ok {
+		_spec.SetField(subscription.FieldLastEventAt, field.TypeTime, value)
+	}
+	if su.mutation.LastEventAtCleared() {
+		_spec.ClearField(subscription.FieldLastEventAt, field.TypeTime)
+	}
 	if value, ok := su.mutation.Metadata(); ok {
 		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
 	}
@@ -515,6 +731,51 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.DiscountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.DiscountsTable,
+			Columns: []string{subscription.DiscountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedDiscountsIDs(); len(nodes) > 0 && !su.mutation.DiscountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.DiscountsTable,
+			Columns: []string{subscription.DiscountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.DiscountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.DiscountsTable,
+			Columns: []string{subscription.DiscountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{subscription.Label}
@@ -781,6 +1042,141 @@ func (suo *SubscriptionUpdateOne) ClearEndedAt() *SubscriptionUpdateOne {
 	return suo
 }
 
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (suo *SubscriptionUpdateOne) SetCancelAtPeriodEnd(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetCancelAtPeriodEnd(b)
+	return suo
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetCancelAtPeriodEnd(*b)
+	}
+	return suo
+}
+
+// SetPendingPriceID sets the "pending_price_id" field.
+func (suo *SubscriptionUpdateOne) SetPendingPriceID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetPendingPriceID(s)
+	return suo
+}
+
+// SetNillablePendingPriceID sets the "pending_price_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePendingPriceID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetPendingPriceID(*s)
+	}
+	return suo
+}
+
+// ClearPendingPriceID clears the value of the "pending_price_id" field.
+func (suo *SubscriptionUpdateOne) ClearPendingPriceID() *SubscriptionUpdateOne {
+	suo.mutation.ClearPendingPriceID()
+	return suo
+}
+
+// SetPendingChangeAt sets the "pending_change_at" field.
+func (suo *SubscriptionUpdateOne) SetPendingChangeAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetPendingChangeAt(t)
+	return suo
+}
+
+// SetNillablePendingChangeAt sets the "pending_change_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePendingChangeAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetPendingChangeAt(*t)
+	}
+	return suo
+}
+
+// ClearPendingChangeAt clears the value of the "pending_change_at" field.
+func (suo *SubscriptionUpdateOne) ClearPendingChangeAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearPendingChangeAt()
+	return suo
+}
+
+// SetPastDueAt sets the "past_due_at" field.
+func (suo *SubscriptionUpdateOne) SetPastDueAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetPastDueAt(t)
+	return suo
+}
+
+// SetNillablePastDueAt sets the "past_due_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePastDueAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetPastDueAt(*t)
+	}
+	return suo
+}
+
+// ClearPastDueAt clears the value of the "past_due_at" field.
+func (suo *SubscriptionUpdateOne) ClearPastDueAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearPastDueAt()
+	return suo
+}
+
+// SetDunningReminders sets the "dunning_reminders" field.
+func (suo *SubscriptionUpdateOne) SetDunningReminders(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetDunningReminders()
+	suo.mutation.SetDunningReminders(i)
+	return suo
+}
+
+// SetNillableDunningReminders sets the "dunning_reminders" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableDunningReminders(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetDunningReminders(*i)
+	}
+	return suo
+}
+
+// AddDunningReminders adds i to the "dunning_reminders" field.
+func (suo *SubscriptionUpdateOne) AddDunningReminders(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddDunningReminders(i)
+	return suo
+}
+
+// SetTrialRemindedAt sets the "trial_reminded_at" field.
+func (suo *SubscriptionUpdateOne) SetTrialRemindedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetTrialRemindedAt(t)
+	return suo
+}
+
+// SetNillableTrialRemindedAt sets the "trial_reminded_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTrialRemindedAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetTrialRemindedAt(*t)
+	}
+	return suo
+}
+
+// ClearTrialRemindedAt clears the value of the "trial_reminded_at" field.
+func (suo *SubscriptionUpdateOne) ClearTrialRemindedAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearTrialRemindedAt()
+	return suo
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (suo *SubscriptionUpdateOne) SetLastEventAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetLastEventAt(t)
+	return suo
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableLastEventAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetLastEventAt(*t)
+	}
+	return suo
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (suo *SubscriptionUpdateOne) ClearLastEventAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearLastEventAt()
+	return suo
+}
+
 // SetMetadata sets the "metadata" field.
 func (suo *SubscriptionUpdateOne) SetMetadata(m map[string]interface{}) *SubscriptionUpdateOne {
 	suo.mutation.SetMetadata(m)
@@ -810,6 +1206,21 @@ func (suo *SubscriptionUpdateOne) SetCustomer(p *PaymentCustomer) *SubscriptionU
 	return suo.SetCustomerID(p.ID)
 }
 
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (suo *SubscriptionUpdateOne) AddDiscountIDs(ids ...int) *SubscriptionUpdateOne {
+	suo.mutation.AddDiscountIDs(ids...)
+	return suo
+}
+
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (suo *SubscriptionUpdateOne) AddDiscounts(d ...*Discount) *SubscriptionUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return suo.AddDiscountIDs(ids...)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (suo *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
 	return suo.mutation
@@ -821,6 +1232,27 @@ func (suo *SubscriptionUpdateOne) ClearCustomer() *SubscriptionUpdateOne {
 	return suo
 }
 
+// ClearDiscounts clears all "discounts" edges to the Discount entity.
+func (suo *SubscriptionUpdateOne) ClearDiscounts() *SubscriptionUpdateOne {
+	suo.mutation.ClearDiscounts()
+	return suo
+}
+
+// RemoveDiscountIDs removes the "discounts" edge to Discount entities by IDs.
+func (suo *SubscriptionUpdateOne) RemoveDiscountIDs(ids ...int) *SubscriptionUpdateOne {
+	suo.mutation.RemoveDiscountIDs(ids...)
+	return suo
+}
+
+// RemoveDiscounts removes "discounts" edges to Discount entities.
+func (suo *SubscriptionUpdateOne) RemoveDiscounts(d ...*Discount) *SubscriptionUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return suo.RemoveDiscountIDs(ids...)
+}
+
 // Where appends a list predicates to the SubscriptionUpdate builder.
 func (suo *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
 	suo.mutation.Where(ps...)
@@ -912,6 +1344,11 @@ func (suo *SubscriptionUpdateOne) check() error {
 			return &ValidationError{Name: "interval_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval_count": %w`, err)}
 		}
 	}
+	if v, ok := suo.mutation.DunningReminders(); ok {
+		if err := subscription.DunningRemindersValidator(v); err != nil {
+			return &ValidationError{Name: "dunning_reminders", err: fmt.Errorf(`ent: validator failed for field "Subscription.dunning_reminders": %w`, err)}
+		}
+	}
 	if suo.mutation.CustomerCleared() && len(suo.mutation.CustomerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Subscription.customer"`)
 	}
@@ -1013,6 +1450,45 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 	if suo.mutation.EndedAtCleared() {
 		_spec.ClearField(subscription.FieldEndedAt, field.TypeTime)
 	}
+	if value, ok := suo.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.PendingPriceID(); ok {
+		_spec.SetField(subscription.FieldPendingPriceID, field.TypeString, value)
+	}
+	if suo.mutation.PendingPriceIDCleared() {
+		_spec.ClearField(subscription.FieldPendingPriceID, field.TypeString)
+	}
+	if value, ok := suo.mutation.PendingChangeAt(); ok {
+		_spec.SetField(subscription.FieldPendingChangeAt, field.TypeTime, value)
+	}
+	if suo.mutation.PendingChangeAtCleared() {
+		_spec.ClearField(subscription.FieldPendingChangeAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.PastDueAt(); ok {
+		_spec.SetField(subscription.FieldPastDueAt, field.TypeTime, value)
+	}
+	if suo.mutation.PastDueAtCleared() {
+		_spec.ClearField(subscription.FieldPastDueAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.DunningReminders(); ok {
+		_spec.SetField(subscription.FieldDunningReminders, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDunningReminders(); ok {
+		_spec.AddField(subscription.FieldDunningReminders, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.TrialRemindedAt(); ok {
+		_spec.SetField(subscription.FieldTrialRemindedAt, field.TypeTime, value)
+	}
+	if suo.mutation.TrialRemindedAtCleared() {
+		_spec.ClearField(subscription.FieldTrialRemindedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.LastEventAt(); ok {
+		_spec.SetField(subscription.FieldLastEventAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastEventAtCleared() {
+		_spec.ClearField(subscription.FieldLastEventAt, field.TypeTime)
+	}
 	if value, ok := suo.mutation.Metadata(); ok {
 		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
 	}
@@ -1051,6 +1527,51 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.DiscountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.DiscountsTable,
+			Columns: []string{subscription.DiscountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedDiscountsIDs(); len(nodes) > 0 && !suo.mutation.DiscountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.DiscountsTable,
+			Columns: []string{subscription.DiscountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.DiscountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.DiscountsTable,
+			Columns: []string{subscription.DiscountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Subscription{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -14,24 +14,44 @@ type Tx struct {
 	config
 	// Answer is the client for interacting with the Answer builders.
 	Answer *AnswerClient
+	// Discount is the client for interacting with the Discount builders.
+	Discount *DiscountClient
 	// Form is the client for interacting with the Form builders.
 	Form *FormClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
 	// Job is the client for interacting with the Job builders.
 	Job *JobClient
+	// JobAttempt is the client for interacting with the JobAttempt builders.
+	JobAttempt *JobAttemptClient
 	// PasswordToken is the client for interacting with the PasswordToken builders.
 	PasswordToken *PasswordTokenClient
+	// PaymentAccount is the client for interacting with the PaymentAccount builders.
+	PaymentAccount *PaymentAccountClient
 	// PaymentCustomer is the client for interacting with the PaymentCustomer builders.
 	PaymentCustomer *PaymentCustomerClient
 	// PaymentIntent is the client for interacting with the PaymentIntent builders.
 	PaymentIntent *PaymentIntentClient
 	// PaymentMethod is the client for interacting with the PaymentMethod builders.
 	PaymentMethod *PaymentMethodClient
+	// ProcessedEvent is the client for interacting with the ProcessedEvent builders.
+	ProcessedEvent *ProcessedEventClient
 	// Question is the client for interacting with the Question builders.
 	Question *QuestionClient
+	// ReportSubscription is the client for interacting with the ReportSubscription builders.
+	ReportSubscription *ReportSubscriptionClient
 	// Response is the client for interacting with the Response builders.
 	Response *ResponseClient
+	// ResponseActivity is the client for interacting with the ResponseActivity builders.
+	ResponseActivity *ResponseActivityClient
+	// ResponseNote is the client for interacting with the ResponseNote builders.
+	ResponseNote *ResponseNoteClient
+	// ResponseTag is the client for interacting with the ResponseTag builders.
+	ResponseTag *ResponseTagClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
+	// Usage is the client for interacting with the Usage builders.
+	Usage *UsageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -166,15 +186,25 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Answer = NewAnswerClient(tx.config)
+	tx.Discount = NewDiscountClient(tx.config)
 	tx.Form = NewFormClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
 	tx.Job = NewJobClient(tx.config)
+	tx.JobAttempt = NewJobAttemptClient(tx.config)
 	tx.PasswordToken = NewPasswordTokenClient(tx.config)
+	tx.PaymentAccount = NewPaymentAccountClient(tx.config)
 	tx.PaymentCustomer = NewPaymentCustomerClient(tx.config)
 	tx.PaymentIntent = NewPaymentIntentClient(tx.config)
 	tx.PaymentMethod = NewPaymentMethodClient(tx.config)
+	tx.ProcessedEvent = NewProcessedEventClient(tx.config)
 	tx.Question = NewQuestionClient(tx.config)
+	tx.ReportSubscription = NewReportSubscriptionClient(tx.config)
 	tx.Response = NewResponseClient(tx.config)
+	tx.ResponseActivity = NewResponseActivityClient(tx.config)
+	tx.ResponseNote = NewResponseNoteClient(tx.config)
+	tx.ResponseTag = NewResponseTagClient(tx.config)
 	tx.Subscription = NewSubscriptionClient(tx.config)
+	tx.Usage = NewUsageClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
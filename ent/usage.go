@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/usage"
+	"github.com/occult/pagode/ent/user"
+)
+
+// Usage is the model entity for the Usage schema.
+type Usage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// PeriodEnd holds the value of the "period_end" field.
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// Responses received by the user's forms
+	Responses int64 `json:"responses,omitempty"`
+	// Forms created
+	Forms int64 `json:"forms,omitempty"`
+	// Bytes of answers and files stored
+	StorageBytes int64 `json:"storage_bytes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UsageQuery when eager-loading is set.
+	Edges        UsageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UsageEdges holds the relations/edges for other nodes in the graph.
+type UsageEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UsageEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Usage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usage.FieldID, usage.FieldUserID, usage.FieldResponses, usage.FieldForms, usage.FieldStorageBytes:
+			values[i] = new(sql.NullInt64)
+		case usage.FieldPeriodStart, usage.FieldPeriodEnd, usage.FieldCreatedAt, usage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Usage fields.
+func (u *Usage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case usage.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				u.UserID = int(value.Int64)
+			}
+		case usage.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				u.PeriodStart = value.Time
+			}
+		case usage.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				u.PeriodEnd = value.Time
+			}
+		case usage.FieldResponses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field responses", values[i])
+			} else if value.Valid {
+				u.Responses = value.Int64
+			}
+		case usage.FieldForms:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field forms", values[i])
+			} else if value.Valid {
+				u.Forms = value.Int64
+			}
+		case usage.FieldStorageBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_bytes", values[i])
+			} else if value.Valid {
+				u.StorageBytes = value.Int64
+			}
+		case usage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				u.CreatedAt = value.Time
+			}
+		case usage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				u.UpdatedAt = value.Time
+			}
+		default:
+			u.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Usage.
+// This includes values selected through modifiers, order, etc.
+func (u *Usage) Value(name string) (ent.Value, error) {
+	return u.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Usage entity.
+func (u *Usage) QueryUser() *UserQuery {
+	return NewUsageClient(u.config).QueryUser(u)
+}
+
+// Update returns a builder for updating this Usage.
+// Note that you need to call Usage.Unwrap() before calling this method if this Usage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (u *Usage) Update() *UsageUpdateOne {
+	return NewUsageClient(u.config).UpdateOne(u)
+}
+
+// Unwrap unwraps the Usage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (u *Usage) Unwrap() *Usage {
+	_tx, ok := u.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Usage is not a transactional entity")
+	}
+	u.config.driver = _tx.drv
+	return u
+}
+
+// String implements the fmt.Stringer.
+func (u *Usage) String() string {
+	var builder strings.Builder
+	builder.WriteString("Usage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", u.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", u.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(u.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(u.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("responses=")
+	builder.WriteString(fmt.Sprintf("%v", u.Responses))
+	builder.WriteString(", ")
+	builder.WriteString("forms=")
+	builder.WriteString(fmt.Sprintf("%v", u.Forms))
+	builder.WriteString(", ")
+	builder.WriteString("storage_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", u.StorageBytes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(u.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Usages is a parsable slice of Usage.
+type Usages []*Usage
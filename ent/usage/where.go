@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUserID, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// Responses applies equality check predicate on the "responses" field. It's identical to ResponsesEQ.
+func Responses(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldResponses, v))
+}
+
+// Forms applies equality check predicate on the "forms" field. It's identical to FormsEQ.
+func Forms(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldForms, v))
+}
+
+// StorageBytes applies equality check predicate on the "storage_bytes" field. It's identical to StorageBytesEQ.
+func StorageBytes(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldStorageBytes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// ResponsesEQ applies the EQ predicate on the "responses" field.
+func ResponsesEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldResponses, v))
+}
+
+// ResponsesNEQ applies the NEQ predicate on the "responses" field.
+func ResponsesNEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldResponses, v))
+}
+
+// ResponsesIn applies the In predicate on the "responses" field.
+func ResponsesIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldResponses, vs...))
+}
+
+// ResponsesNotIn applies the NotIn predicate on the "responses" field.
+func ResponsesNotIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldResponses, vs...))
+}
+
+// ResponsesGT applies the GT predicate on the "responses" field.
+func ResponsesGT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldResponses, v))
+}
+
+// ResponsesGTE applies the GTE predicate on the "responses" field.
+func ResponsesGTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldResponses, v))
+}
+
+// ResponsesLT applies the LT predicate on the "responses" field.
+func ResponsesLT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldResponses, v))
+}
+
+// ResponsesLTE applies the LTE predicate on the "responses" field.
+func ResponsesLTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldResponses, v))
+}
+
+// FormsEQ applies the EQ predicate on the "forms" field.
+func FormsEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldForms, v))
+}
+
+// FormsNEQ applies the NEQ predicate on the "forms" field.
+func FormsNEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldForms, v))
+}
+
+// FormsIn applies the In predicate on the "forms" field.
+func FormsIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldForms, vs...))
+}
+
+// FormsNotIn applies the NotIn predicate on the "forms" field.
+func FormsNotIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldForms, vs...))
+}
+
+// FormsGT applies the GT predicate on the "forms" field.
+func FormsGT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldForms, v))
+}
+
+// FormsGTE applies the GTE predicate on the "forms" field.
+func FormsGTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldForms, v))
+}
+
+// FormsLT applies the LT predicate on the "forms" field.
+func FormsLT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldForms, v))
+}
+
+// FormsLTE applies the LTE predicate on the "forms" field.
+func FormsLTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldForms, v))
+}
+
+// StorageBytesEQ applies the EQ predicate on the "storage_bytes" field.
+func StorageBytesEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldStorageBytes, v))
+}
+
+// StorageBytesNEQ applies the NEQ predicate on the "storage_bytes" field.
+func StorageBytesNEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldStorageBytes, v))
+}
+
+// StorageBytesIn applies the In predicate on the "storage_bytes" field.
+func StorageBytesIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldStorageBytes, vs...))
+}
+
+// StorageBytesNotIn applies the NotIn predicate on the "storage_bytes" field.
+func StorageBytesNotIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldStorageBytes, vs...))
+}
+
+// StorageBytesGT applies the GT predicate on the "storage_bytes" field.
+func StorageBytesGT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldStorageBytes, v))
+}
+
+// StorageBytesGTE applies the GTE predicate on the "storage_bytes" field.
+func StorageBytesGTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldStorageBytes, v))
+}
+
+// StorageBytesLT applies the LT predicate on the "storage_bytes" field.
+func StorageBytesLT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldStorageBytes, v))
+}
+
+// StorageBytesLTE applies the LTE predicate on the "storage_bytes" field.
+func StorageBytesLTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldStorageBytes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Usage {
+	return predicate.Usage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Usage {
+	return predicate.Usage(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.NotPredicates(p))
+}
@@ -0,0 +1,946 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/usage"
+	"github.com/occult/pagode/ent/user"
+)
+
+// UsageCreate is the builder for creating a Usage entity.
+type UsageCreate struct {
+	config
+	mutation *UsageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (uc *UsageCreate) SetUserID(i int) *UsageCreate {
+	uc.mutation.SetUserID(i)
+	return uc
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (uc *UsageCreate) SetPeriodStart(t time.Time) *UsageCreate {
+	uc.mutation.SetPeriodStart(t)
+	return uc
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (uc *UsageCreate) SetPeriodEnd(t time.Time) *UsageCreate {
+	uc.mutation.SetPeriodEnd(t)
+	return uc
+}
+
+// SetResponses sets the "responses" field.
+func (uc *UsageCreate) SetResponses(i int64) *UsageCreate {
+	uc.mutation.SetResponses(i)
+	return uc
+}
+
+// SetNillableResponses sets the "responses" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableResponses(i *int64) *UsageCreate {
+	if i != nil {
+		uc.SetResponses(*i)
+	}
+	return uc
+}
+
+// SetForms sets the "forms" field.
+func (uc *UsageCreate) SetForms(i int64) *UsageCreate {
+	uc.mutation.SetForms(i)
+	return uc
+}
+
+// SetNillableForms sets the "forms" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableForms(i *int64) *UsageCreate {
+	if i != nil {
+		uc.SetForms(*i)
+	}
+	return uc
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (uc *UsageCreate) SetStorageBytes(i int64) *UsageCreate {
+	uc.mutation.SetStorageBytes(i)
+	return uc
+}
+
+// SetNillableStorageBytes sets the "storage_bytes" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableStorageBytes(i *int64) *UsageCreate {
+	if i != nil {
+		uc.SetStorageBytes(*i)
+	}
+	return uc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uc *UsageCreate) SetCreatedAt(t time.Time) *UsageCreate {
+	uc.mutation.SetCreatedAt(t)
+	return uc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableCreatedAt(t *time.Time) *UsageCreate {
+	if t != nil {
+		uc.SetCreatedAt(*t)
+	}
+	return uc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uc *UsageCreate) SetUpdatedAt(t time.Time) *UsageCreate {
+	uc.mutation.SetUpdatedAt(t)
+	return uc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableUpdatedAt(t *time.Time) *UsageCreate {
+	if t != nil {
+		uc.SetUpdatedAt(*t)
+	}
+	return uc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uc *UsageCreate) SetUser(u *User) *UsageCreate {
+	return uc.SetUserID(u.ID)
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uc *UsageCreate) Mutation() *UsageMutation {
+	return uc.mutation
+}
+
+// Save creates the Usage in the database.
+func (uc *UsageCreate) Save(ctx context.Context) (*Usage, error) {
+	uc.defaults()
+	return withHooks(ctx, uc.sqlSave, uc.mutation, uc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uc *UsageCreate) SaveX(ctx context.Context) *Usage {
+	v, err := uc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uc *UsageCreate) Exec(ctx context.Context) error {
+	_, err := uc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uc *UsageCreate) ExecX(ctx context.Context) {
+	if err := uc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uc *UsageCreate) defaults() {
+	if _, ok := uc.mutation.Responses(); !ok {
+		v := usage.DefaultResponses
+		uc.mutation.SetResponses(v)
+	}
+	if _, ok := uc.mutation.Forms(); !ok {
+		v := usage.DefaultForms
+		uc.mutation.SetForms(v)
+	}
+	if _, ok := uc.mutation.StorageBytes(); !ok {
+		v := usage.DefaultStorageBytes
+		uc.mutation.SetStorageBytes(v)
+	}
+	if _, ok := uc.mutation.CreatedAt(); !ok {
+		v := usage.DefaultCreatedAt()
+		uc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		v := usage.DefaultUpdatedAt()
+		uc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uc *UsageCreate) check() error {
+	if _, ok := uc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Usage.user_id"`)}
+	}
+	if _, ok := uc.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "Usage.period_start"`)}
+	}
+	if _, ok := uc.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "Usage.period_end"`)}
+	}
+	if _, ok := uc.mutation.Responses(); !ok {
+		return &ValidationError{Name: "responses", err: errors.New(`ent: missing required field "Usage.responses"`)}
+	}
+	if _, ok := uc.mutation.Forms(); !ok {
+		return &ValidationError{Name: "forms", err: errors.New(`ent: missing required field "Usage.forms"`)}
+	}
+	if _, ok := uc.mutation.StorageBytes(); !ok {
+		return &ValidationError{Name: "storage_bytes", err: errors.New(`ent: missing required field "Usage.storage_bytes"`)}
+	}
+	if _, ok := uc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Usage.created_at"`)}
+	}
+	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Usage.updated_at"`)}
+	}
+	if len(uc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Usage.user"`)}
+	}
+	return nil
+}
+
+func (uc *UsageCreate) sqlSave(ctx context.Context) (*Usage, error) {
+	if err := uc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	uc.mutation.id = &_node.ID
+	uc.mutation.done = true
+	return _node, nil
+}
+
+func (uc *UsageCreate) createSpec() (*Usage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Usage{config: uc.config}
+		_spec = sqlgraph.NewCreateSpec(usage.Table, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = uc.conflict
+	if value, ok := uc.mutation.PeriodStart(); ok {
+		_spec.SetField(usage.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := uc.mutation.PeriodEnd(); ok {
+		_spec.SetField(usage.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := uc.mutation.Responses(); ok {
+		_spec.SetField(usage.FieldResponses, field.TypeInt64, value)
+		_node.Responses = value
+	}
+	if value, ok := uc.mutation.Forms(); ok {
+		_spec.SetField(usage.FieldForms, field.TypeInt64, value)
+		_node.Forms = value
+	}
+	if value, ok := uc.mutation.StorageBytes(); ok {
+		_spec.SetField(usage.FieldStorageBytes, field.TypeInt64, value)
+		_node.StorageBytes = value
+	}
+	if value, ok := uc.mutation.CreatedAt(); ok {
+		_spec.SetField(usage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uc.mutation.UpdatedAt(); ok {
+		_spec.SetField(usage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := uc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usage.UserTable,
+			Columns: []string{usage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Usage.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (uc *UsageCreate) OnConflict(opts ...sql.ConflictOption) *UsageUpsertOne {
+	uc.conflict = opts
+	return &UsageUpsertOne{
+		create: uc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uc *UsageCreate) OnConflictColumns(columns ...string) *UsageUpsertOne {
+	uc.conflict = append(uc.conflict, sql.ConflictColumns(columns...))
+	return &UsageUpsertOne{
+		create: uc,
+	}
+}
+
+type (
+	// UsageUpsertOne is the builder for "upsert"-ing
+	//  one Usage node.
+	UsageUpsertOne struct {
+		create *UsageCreate
+	}
+
+	// UsageUpsert is the "OnConflict" setter.
+	UsageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *UsageUpsert) SetUserID(v int) *UsageUpsert {
+	u.Set(usage.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateUserID() *UsageUpsert {
+	u.SetExcluded(usage.FieldUserID)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *UsageUpsert) SetPeriodStart(v time.Time) *UsageUpsert {
+	u.Set(usage.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *UsageUpsert) UpdatePeriodStart() *UsageUpsert {
+	u.SetExcluded(usage.FieldPeriodStart)
+	return u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *UsageUpsert) SetPeriodEnd(v time.Time) *UsageUpsert {
+	u.Set(usage.FieldPeriodEnd, v)
+	return u
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *UsageUpsert) UpdatePeriodEnd() *UsageUpsert {
+	u.SetExcluded(usage.FieldPeriodEnd)
+	return u
+}
+
+// SetResponses sets the "responses" field.
+func (u *UsageUpsert) SetResponses(v int64) *UsageUpsert {
+	u.Set(usage.FieldResponses, v)
+	return u
+}
+
+// UpdateResponses sets the "responses" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateResponses() *UsageUpsert {
+	u.SetExcluded(usage.FieldResponses)
+	return u
+}
+
+// AddResponses adds v to the "responses" field.
+func (u *UsageUpsert) AddResponses(v int64) *UsageUpsert {
+	u.Add(usage.FieldResponses, v)
+	return u
+}
+
+// SetForms sets the "forms" field.
+func (u *UsageUpsert) SetForms(v int64) *UsageUpsert {
+	u.Set(usage.FieldForms, v)
+	return u
+}
+
+// UpdateForms sets the "forms" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateForms() *UsageUpsert {
+	u.SetExcluded(usage.FieldForms)
+	return u
+}
+
+// AddForms adds v to the "forms" field.
+func (u *UsageUpsert) AddForms(v int64) *UsageUpsert {
+	u.Add(usage.FieldForms, v)
+	return u
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (u *UsageUpsert) SetStorageBytes(v int64) *UsageUpsert {
+	u.Set(usage.FieldStorageBytes, v)
+	return u
+}
+
+// UpdateStorageBytes sets the "storage_bytes" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateStorageBytes() *UsageUpsert {
+	u.SetExcluded(usage.FieldStorageBytes)
+	return u
+}
+
+// AddStorageBytes adds v to the "storage_bytes" field.
+func (u *UsageUpsert) AddStorageBytes(v int64) *UsageUpsert {
+	u.Add(usage.FieldStorageBytes, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsageUpsert) SetUpdatedAt(v time.Time) *UsageUpsert {
+	u.Set(usage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateUpdatedAt() *UsageUpsert {
+	u.SetExcluded(usage.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageUpsertOne) UpdateNewValues() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UsageUpsertOne) Ignore() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageUpsertOne) DoNothing() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageCreate.OnConflict
+// documentation for more info.
+func (u *UsageUpsertOne) Update(set func(*UsageUpsert)) *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UsageUpsertOne) SetUserID(v int) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateUserID() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *UsageUpsertOne) SetPeriodStart(v time.Time) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdatePeriodStart() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *UsageUpsertOne) SetPeriodEnd(v time.Time) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdatePeriodEnd() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetResponses sets the "responses" field.
+func (u *UsageUpsertOne) SetResponses(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetResponses(v)
+	})
+}
+
+// AddResponses adds v to the "responses" field.
+func (u *UsageUpsertOne) AddResponses(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddResponses(v)
+	})
+}
+
+// UpdateResponses sets the "responses" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateResponses() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateResponses()
+	})
+}
+
+// SetForms sets the "forms" field.
+func (u *UsageUpsertOne) SetForms(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetForms(v)
+	})
+}
+
+// AddForms adds v to the "forms" field.
+func (u *UsageUpsertOne) AddForms(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddForms(v)
+	})
+}
+
+// UpdateForms sets the "forms" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateForms() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateForms()
+	})
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (u *UsageUpsertOne) SetStorageBytes(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetStorageBytes(v)
+	})
+}
+
+// AddStorageBytes adds v to the "storage_bytes" field.
+func (u *UsageUpsertOne) AddStorageBytes(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddStorageBytes(v)
+	})
+}
+
+// UpdateStorageBytes sets the "storage_bytes" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateStorageBytes() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateStorageBytes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsageUpsertOne) SetUpdatedAt(v time.Time) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateUpdatedAt() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UsageUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UsageUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UsageCreateBulk is the builder for creating many Usage entities in bulk.
+type UsageCreateBulk struct {
+	config
+	err      error
+	builders []*UsageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Usage entities in the database.
+func (ucb *UsageCreateBulk) Save(ctx context.Context) ([]*Usage, error) {
+	if ucb.err != nil {
+		return nil, ucb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
+	nodes := make([]*Usage, len(ucb.builders))
+	mutators := make([]Mutator, len(ucb.builders))
+	for i := range ucb.builders {
+		func(i int, root context.Context) {
+			builder := ucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ucb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucb *UsageCreateBulk) SaveX(ctx context.Context) []*Usage {
+	v, err := ucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucb *UsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := ucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucb *UsageCreateBulk) ExecX(ctx context.Context) {
+	if err := ucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Usage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ucb *UsageCreateBulk) OnConflict(opts ...sql.ConflictOption) *UsageUpsertBulk {
+	ucb.conflict = opts
+	return &UsageUpsertBulk{
+		create: ucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ucb *UsageCreateBulk) OnConflictColumns(columns ...string) *UsageUpsertBulk {
+	ucb.conflict = append(ucb.conflict, sql.ConflictColumns(columns...))
+	return &UsageUpsertBulk{
+		create: ucb,
+	}
+}
+
+// UsageUpsertBulk is the builder for "upsert"-ing
+// a bulk of Usage nodes.
+type UsageUpsertBulk struct {
+	create *UsageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageUpsertBulk) UpdateNewValues() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UsageUpsertBulk) Ignore() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageUpsertBulk) DoNothing() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageCreateBulk.OnConflict
+// documentation for more info.
+func (u *UsageUpsertBulk) Update(set func(*UsageUpsert)) *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UsageUpsertBulk) SetUserID(v int) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateUserID() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *UsageUpsertBulk) SetPeriodStart(v time.Time) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdatePeriodStart() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *UsageUpsertBulk) SetPeriodEnd(v time.Time) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdatePeriodEnd() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetResponses sets the "responses" field.
+func (u *UsageUpsertBulk) SetResponses(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetResponses(v)
+	})
+}
+
+// AddResponses adds v to the "responses" field.
+func (u *UsageUpsertBulk) AddResponses(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddResponses(v)
+	})
+}
+
+// UpdateResponses sets the "responses" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateResponses() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateResponses()
+	})
+}
+
+// SetForms sets the "forms" field.
+func (u *UsageUpsertBulk) SetForms(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetForms(v)
+	})
+}
+
+// AddForms adds v to the "forms" field.
+func (u *UsageUpsertBulk) AddForms(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddForms(v)
+	})
+}
+
+// UpdateForms sets the "forms" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateForms() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateForms()
+	})
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (u *UsageUpsertBulk) SetStorageBytes(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetStorageBytes(v)
+	})
+}
+
+// AddStorageBytes adds v to the "storage_bytes" field.
+func (u *UsageUpsertBulk) AddStorageBytes(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddStorageBytes(v)
+	})
+}
+
+// UpdateStorageBytes sets the "storage_bytes" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateStorageBytes() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateStorageBytes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsageUpsertBulk) SetUpdatedAt(v time.Time) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateUpdatedAt() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UsageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,573 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/usage"
+	"github.com/occult/pagode/ent/user"
+)
+
+// UsageUpdate is the builder for updating Usage entities.
+type UsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageMutation
+}
+
+// Where appends a list predicates to the UsageUpdate builder.
+func (uu *UsageUpdate) Where(ps ...predicate.Usage) *UsageUpdate {
+	uu.mutation.Where(ps...)
+	return uu
+}
+
+// SetUserID sets the "user_id" field.
+func (uu *UsageUpdate) SetUserID(i int) *UsageUpdate {
+	uu.mutation.SetUserID(i)
+	return uu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uu *UsageUpdate) SetNillableUserID(i *int) *UsageUpdate {
+	if i != nil {
+		uu.SetUserID(*i)
+	}
+	return uu
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (uu *UsageUpdate) SetPeriodStart(t time.Time) *UsageUpdate {
+	uu.mutation.SetPeriodStart(t)
+	return uu
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (uu *UsageUpdate) SetNillablePeriodStart(t *time.Time) *UsageUpdate {
+	if t != nil {
+		uu.SetPeriodStart(*t)
+	}
+	return uu
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (uu *UsageUpdate) SetPeriodEnd(t time.Time) *UsageUpdate {
+	uu.mutation.SetPeriodEnd(t)
+	return uu
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (uu *UsageUpdate) SetNillablePeriodEnd(t *time.Time) *UsageUpdate {
+	if t != nil {
+		uu.SetPeriodEnd(*t)
+	}
+	return uu
+}
+
+// SetResponses sets the "responses" field.
+func (uu *UsageUpdate) SetResponses(i int64) *UsageUpdate {
+	uu.mutation.ResetResponses()
+	uu.mutation.SetResponses(i)
+	return uu
+}
+
+// SetNillableResponses sets the "responses" field if the given value is not nil.
+func (uu *UsageUpdate) SetNillableResponses(i *int64) *UsageUpdate {
+	if i != nil {
+		uu.SetResponses(*i)
+	}
+	return uu
+}
+
+// AddResponses adds i to the "responses" field.
+func (uu *UsageUpdate) AddResponses(i int64) *UsageUpdate {
+	uu.mutation.AddResponses(i)
+	return uu
+}
+
+// SetForms sets the "forms" field.
+func (uu *UsageUpdate) SetForms(i int64) *UsageUpdate {
+	uu.mutation.ResetForms()
+	uu.mutation.SetForms(i)
+	return uu
+}
+
+// SetNillableForms sets the "forms" field if the given value is not nil.
+func (uu *UsageUpdate) SetNillableForms(i *int64) *UsageUpdate {
+	if i != nil {
+		uu.SetForms(*i)
+	}
+	return uu
+}
+
+// AddForms adds i to the "forms" field.
+func (uu *UsageUpdate) AddForms(i int64) *UsageUpdate {
+	uu.mutation.AddForms(i)
+	return uu
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (uu *UsageUpdate) SetStorageBytes(i int64) *UsageUpdate {
+	uu.mutation.ResetStorageBytes()
+	uu.mutation.SetStorageBytes(i)
+	return uu
+}
+
+// SetNillableStorageBytes sets the "storage_bytes" field if the given value is not nil.
+func (uu *UsageUpdate) SetNillableStorageBytes(i *int64) *UsageUpdate {
+	if i != nil {
+		uu.SetStorageBytes(*i)
+	}
+	return uu
+}
+
+// AddStorageBytes adds i to the "storage_bytes" field.
+func (uu *UsageUpdate) AddStorageBytes(i int64) *UsageUpdate {
+	uu.mutation.AddStorageBytes(i)
+	return uu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uu *UsageUpdate) SetUpdatedAt(t time.Time) *UsageUpdate {
+	uu.mutation.SetUpdatedAt(t)
+	return uu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uu *UsageUpdate) SetUser(u *User) *UsageUpdate {
+	return uu.SetUserID(u.ID)
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uu *UsageUpdate) Mutation() *UsageMutation {
+	return uu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (uu *UsageUpdate) ClearUser() *UsageUpdate {
+	uu.mutation.ClearUser()
+	return uu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UsageUpdate) Save(ctx context.Context) (int, error) {
+	uu.defaults()
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UsageUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UsageUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uu *UsageUpdate) defaults() {
+	if _, ok := uu.mutation.UpdatedAt(); !ok {
+		v := usage.UpdateDefaultUpdatedAt()
+		uu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uu *UsageUpdate) check() error {
+	if uu.mutation.UserCleared() && len(uu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Usage.user"`)
+	}
+	return nil
+}
+
+func (uu *UsageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usage.Table, usage.Columns, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	if ps := uu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uu.mutation.PeriodStart(); ok {
+		_spec.SetField(usage.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := uu.mutation.PeriodEnd(); ok {
+		_spec.SetField(usage.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := uu.mutation.Responses(); ok {
+		_spec.SetField(usage.FieldResponses, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedResponses(); ok {
+		_spec.AddField(usage.FieldResponses, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.Forms(); ok {
+		_spec.SetField(usage.FieldForms, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedForms(); ok {
+		_spec.AddField(usage.FieldForms, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.StorageBytes(); ok {
+		_spec.SetField(usage.FieldStorageBytes, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedStorageBytes(); ok {
+		_spec.AddField(usage.FieldStorageBytes, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.UpdatedAt(); ok {
+		_spec.SetField(usage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if uu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usage.UserTable,
+			Columns: []string{usage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usage.UserTable,
+			Columns: []string{usage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UsageUpdateOne is the builder for updating a single Usage entity.
+type UsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (uuo *UsageUpdateOne) SetUserID(i int) *UsageUpdateOne {
+	uuo.mutation.SetUserID(i)
+	return uuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uuo *UsageUpdateOne) SetNillableUserID(i *int) *UsageUpdateOne {
+	if i != nil {
+		uuo.SetUserID(*i)
+	}
+	return uuo
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (uuo *UsageUpdateOne) SetPeriodStart(t time.Time) *UsageUpdateOne {
+	uuo.mutation.SetPeriodStart(t)
+	return uuo
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (uuo *UsageUpdateOne) SetNillablePeriodStart(t *time.Time) *UsageUpdateOne {
+	if t != nil {
+		uuo.SetPeriodStart(*t)
+	}
+	return uuo
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (uuo *UsageUpdateOne) SetPeriodEnd(t time.Time) *UsageUpdateOne {
+	uuo.mutation.SetPeriodEnd(t)
+	return uuo
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (uuo *UsageUpdateOne) SetNillablePeriodEnd(t *time.Time) *UsageUpdateOne {
+	if t != nil {
+		uuo.SetPeriodEnd(*t)
+	}
+	return uuo
+}
+
+// SetResponses sets the "responses" field.
+func (uuo *UsageUpdateOne) SetResponses(i int64) *UsageUpdateOne {
+	uuo.mutation.ResetResponses()
+	uuo.mutation.SetResponses(i)
+	return uuo
+}
+
+// SetNillableResponses sets the "responses" field if the given value is not nil.
+func (uuo *UsageUpdateOne) SetNillableResponses(i *int64) *UsageUpdateOne {
+	if i != nil {
+		uuo.SetResponses(*i)
+	}
+	return uuo
+}
+
+// AddResponses adds i to the "responses" field.
+func (uuo *UsageUpdateOne) AddResponses(i int64) *UsageUpdateOne {
+	uuo.mutation.AddResponses(i)
+	return uuo
+}
+
+// SetForms sets the "forms" field.
+func (uuo *UsageUpdateOne) SetForms(i int64) *UsageUpdateOne {
+	uuo.mutation.ResetForms()
+	uuo.mutation.SetForms(i)
+	return uuo
+}
+
+// SetNillableForms sets the "forms" field if the given value is not nil.
+func (uuo *UsageUpdateOne) SetNillableForms(i *int64) *UsageUpdateOne {
+	if i != nil {
+		uuo.SetForms(*i)
+	}
+	return uuo
+}
+
+// AddForms adds i to the "forms" field.
+func (uuo *UsageUpdateOne) AddForms(i int64) *UsageUpdateOne {
+	uuo.mutation.AddForms(i)
+	return uuo
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (uuo *UsageUpdateOne) SetStorageBytes(i int64) *UsageUpdateOne {
+	uuo.mutation.ResetStorageBytes()
+	uuo.mutation.SetStorageBytes(i)
+	return uuo
+}
+
+// SetNillableStorageBytes sets the "storage_bytes" field if the given value is not nil.
+func (uuo *UsageUpdateOne) SetNillableStorageBytes(i *int64) *UsageUpdateOne {
+	if i != nil {
+		uuo.SetStorageBytes(*i)
+	}
+	return uuo
+}
+
+// AddStorageBytes adds i to the "storage_bytes" field.
+func (uuo *UsageUpdateOne) AddStorageBytes(i int64) *UsageUpdateOne {
+	uuo.mutation.AddStorageBytes(i)
+	return uuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UsageUpdateOne) SetUpdatedAt(t time.Time) *UsageUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uuo *UsageUpdateOne) SetUser(u *User) *UsageUpdateOne {
+	return uuo.SetUserID(u.ID)
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uuo *UsageUpdateOne) Mutation() *UsageMutation {
+	return uuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (uuo *UsageUpdateOne) ClearUser() *UsageUpdateOne {
+	uuo.mutation.ClearUser()
+	return uuo
+}
+
+// Where appends a list predicates to the UsageUpdate builder.
+func (uuo *UsageUpdateOne) Where(ps ...predicate.Usage) *UsageUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UsageUpdateOne) Select(field string, fields ...string) *UsageUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated Usage entity.
+func (uuo *UsageUpdateOne) Save(ctx context.Context) (*Usage, error) {
+	uuo.defaults()
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UsageUpdateOne) SaveX(ctx context.Context) *Usage {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UsageUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UsageUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		v := usage.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UsageUpdateOne) check() error {
+	if uuo.mutation.UserCleared() && len(uuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Usage.user"`)
+	}
+	return nil
+}
+
+func (uuo *UsageUpdateOne) sqlSave(ctx context.Context) (_node *Usage, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usage.Table, usage.Columns, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Usage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usage.FieldID)
+		for _, f := range fields {
+			if !usage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.PeriodStart(); ok {
+		_spec.SetField(usage.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.PeriodEnd(); ok {
+		_spec.SetField(usage.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.Responses(); ok {
+		_spec.SetField(usage.FieldResponses, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedResponses(); ok {
+		_spec.AddField(usage.FieldResponses, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.Forms(); ok {
+		_spec.SetField(usage.FieldForms, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedForms(); ok {
+		_spec.AddField(usage.FieldForms, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.StorageBytes(); ok {
+		_spec.SetField(usage.FieldStorageBytes, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedStorageBytes(); ok {
+		_spec.AddField(usage.FieldStorageBytes, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(usage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usage.UserTable,
+			Columns: []string{usage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usage.UserTable,
+			Columns: []string{usage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Usage{config: uuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uuo.mutation.done = true
+	return _node, nil
+}
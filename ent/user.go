@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/user"
 )
@@ -49,6 +50,7 @@ type User struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges                 UserEdges `json:"edges"`
+	payment_account_user  *int
 	payment_customer_user *int
 	selectValues          sql.SelectValues
 }
@@ -59,13 +61,17 @@ type UserEdges struct {
 	Owner []*PasswordToken `json:"owner,omitempty"`
 	// PaymentCustomer holds the value of the payment_customer edge.
 	PaymentCustomer *PaymentCustomer `json:"payment_customer,omitempty"`
+	// PaymentAccount holds the value of the payment_account edge.
+	PaymentAccount *PaymentAccount `json:"payment_account,omitempty"`
 	// Forms holds the value of the forms edge.
 	Forms []*Form `json:"forms,omitempty"`
 	// Responses holds the value of the responses edge.
 	Responses []*Response `json:"responses,omitempty"`
+	// Usage holds the value of the usage edge.
+	Usage []*Usage `json:"usage,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -88,10 +94,21 @@ func (e UserEdges) PaymentCustomerOrErr() (*PaymentCustomer, error) {
 	return nil, &NotLoadedError{edge: "payment_customer"}
 }
 
+// PaymentAccountOrErr returns the PaymentAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) PaymentAccountOrErr() (*PaymentAccount, error) {
+	if e.PaymentAccount != nil {
+		return e.PaymentAccount, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: paymentaccount.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_account"}
+}
+
 // FormsOrErr returns the Forms value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) FormsOrErr() ([]*Form, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Forms, nil
 	}
 	return nil, &NotLoadedError{edge: "forms"}
@@ -100,12 +117,21 @@ func (e UserEdges) FormsOrErr() ([]*Form, error) {
 // ResponsesOrErr returns the Responses value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ResponsesOrErr() ([]*Response, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Responses, nil
 	}
 	return nil, &NotLoadedError{edge: "responses"}
 }
 
+// UsageOrErr returns the Usage value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UsageOrErr() ([]*Usage, error) {
+	if e.loadedTypes[5] {
+		return e.Usage, nil
+	}
+	return nil, &NotLoadedError{edge: "usage"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -119,7 +145,9 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
-		case user.ForeignKeys[0]: // payment_customer_user
+		case user.ForeignKeys[0]: // payment_account_user
+			values[i] = new(sql.NullInt64)
+		case user.ForeignKeys[1]: // payment_customer_user
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -227,6 +255,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.CreatedAt = value.Time
 			}
 		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field payment_account_user", value)
+			} else if value.Valid {
+				u.payment_account_user = new(int)
+				*u.payment_account_user = int(value.Int64)
+			}
+		case user.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field payment_customer_user", value)
 			} else if value.Valid {
@@ -256,6 +291,11 @@ func (u *User) QueryPaymentCustomer() *PaymentCustomerQuery {
 	return NewUserClient(u.config).QueryPaymentCustomer(u)
 }
 
+// QueryPaymentAccount queries the "payment_account" edge of the User entity.
+func (u *User) QueryPaymentAccount() *PaymentAccountQuery {
+	return NewUserClient(u.config).QueryPaymentAccount(u)
+}
+
 // QueryForms queries the "forms" edge of the User entity.
 func (u *User) QueryForms() *FormQuery {
 	return NewUserClient(u.config).QueryForms(u)
@@ -266,6 +306,11 @@ func (u *User) QueryResponses() *ResponseQuery {
 	return NewUserClient(u.config).QueryResponses(u)
 }
 
+// QueryUsage queries the "usage" edge of the User entity.
+func (u *User) QueryUsage() *UsageQuery {
+	return NewUserClient(u.config).QueryUsage(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
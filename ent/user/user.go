@@ -48,10 +48,14 @@ const (
 	EdgeOwner = "owner"
 	// EdgePaymentCustomer holds the string denoting the payment_customer edge name in mutations.
 	EdgePaymentCustomer = "payment_customer"
+	// EdgePaymentAccount holds the string denoting the payment_account edge name in mutations.
+	EdgePaymentAccount = "payment_account"
 	// EdgeForms holds the string denoting the forms edge name in mutations.
 	EdgeForms = "forms"
 	// EdgeResponses holds the string denoting the responses edge name in mutations.
 	EdgeResponses = "responses"
+	// EdgeUsage holds the string denoting the usage edge name in mutations.
+	EdgeUsage = "usage"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -68,6 +72,13 @@ const (
 	PaymentCustomerInverseTable = "payment_customers"
 	// PaymentCustomerColumn is the table column denoting the payment_customer relation/edge.
 	PaymentCustomerColumn = "payment_customer_user"
+	// PaymentAccountTable is the table that holds the payment_account relation/edge.
+	PaymentAccountTable = "users"
+	// PaymentAccountInverseTable is the table name for the PaymentAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentaccount" package.
+	PaymentAccountInverseTable = "payment_accounts"
+	// PaymentAccountColumn is the table column denoting the payment_account relation/edge.
+	PaymentAccountColumn = "payment_account_user"
 	// FormsTable is the table that holds the forms relation/edge.
 	FormsTable = "forms"
 	// FormsInverseTable is the table name for the Form entity.
@@ -82,6 +93,13 @@ const (
 	ResponsesInverseTable = "responses"
 	// ResponsesColumn is the table column denoting the responses relation/edge.
 	ResponsesColumn = "user_responses"
+	// UsageTable is the table that holds the usage relation/edge.
+	UsageTable = "usages"
+	// UsageInverseTable is the table name for the Usage entity.
+	// It exists in this package in order to avoid circular dependency with the "usage" package.
+	UsageInverseTable = "usages"
+	// UsageColumn is the table column denoting the usage relation/edge.
+	UsageColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -106,6 +124,7 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "users"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"payment_account_user",
 	"payment_customer_user",
 }
 
@@ -269,6 +288,13 @@ func ByPaymentCustomerField(field string, opts ...sql.OrderTermOption) OrderOpti
 	}
 }
 
+// ByPaymentAccountField orders the results by payment_account field.
+func ByPaymentAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByFormsCount orders the results by forms count.
 func ByFormsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -296,6 +322,20 @@ func ByResponses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newResponsesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByUsageCount orders the results by usage count.
+func ByUsageCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsageStep(), opts...)
+	}
+}
+
+// ByUsage orders the results by usage terms.
+func ByUsage(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsageStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -310,6 +350,13 @@ func newPaymentCustomerStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, PaymentCustomerTable, PaymentCustomerColumn),
 	)
 }
+func newPaymentAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentAccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, PaymentAccountTable, PaymentAccountColumn),
+	)
+}
 func newFormsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -324,3 +371,10 @@ func newResponsesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ResponsesTable, ResponsesColumn),
 	)
 }
+func newUsageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsageTable, UsageColumn),
+	)
+}
@@ -976,6 +976,29 @@ func HasPaymentCustomerWith(preds ...predicate.PaymentCustomer) predicate.User {
 	})
 }
 
+// HasPaymentAccount applies the HasEdge predicate on the "payment_account" edge.
+func HasPaymentAccount() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, PaymentAccountTable, PaymentAccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentAccountWith applies the HasEdge predicate on the "payment_account" edge with a given conditions (other predicates).
+func HasPaymentAccountWith(preds ...predicate.PaymentAccount) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPaymentAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasForms applies the HasEdge predicate on the "forms" edge.
 func HasForms() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1022,6 +1045,29 @@ func HasResponsesWith(preds ...predicate.Response) predicate.User {
 	})
 }
 
+// HasUsage applies the HasEdge predicate on the "usage" edge.
+func HasUsage() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsageTable, UsageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsageWith applies the HasEdge predicate on the "usage" edge with a given conditions (other predicates).
+func HasUsageWith(preds ...predicate.Usage) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newUsageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -8,12 +8,15 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/form"
 	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/usage"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -22,6 +25,7 @@ type UserCreate struct {
 	config
 	mutation *UserMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -230,6 +234,25 @@ func (uc *UserCreate) SetPaymentCustomer(p *PaymentCustomer) *UserCreate {
 	return uc.SetPaymentCustomerID(p.ID)
 }
 
+// SetPaymentAccountID sets the "payment_account" edge to the PaymentAccount entity by ID.
+func (uc *UserCreate) SetPaymentAccountID(id int) *UserCreate {
+	uc.mutation.SetPaymentAccountID(id)
+	return uc
+}
+
+// SetNillablePaymentAccountID sets the "payment_account" edge to the PaymentAccount entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillablePaymentAccountID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetPaymentAccountID(*id)
+	}
+	return uc
+}
+
+// SetPaymentAccount sets the "payment_account" edge to the PaymentAccount entity.
+func (uc *UserCreate) SetPaymentAccount(p *PaymentAccount) *UserCreate {
+	return uc.SetPaymentAccountID(p.ID)
+}
+
 // AddFormIDs adds the "forms" edge to the Form entity by IDs.
 func (uc *UserCreate) AddFormIDs(ids ...int) *UserCreate {
 	uc.mutation.AddFormIDs(ids...)
@@ -260,6 +283,21 @@ func (uc *UserCreate) AddResponses(r ...*Response) *UserCreate {
 	return uc.AddResponseIDs(ids...)
 }
 
+// AddUsageIDs adds the "usage" edge to the Usage entity by IDs.
+func (uc *UserCreate) AddUsageIDs(ids ...int) *UserCreate {
+	uc.mutation.AddUsageIDs(ids...)
+	return uc
+}
+
+// AddUsage adds the "usage" edges to the Usage entity.
+func (uc *UserCreate) AddUsage(u ...*Usage) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddUsageIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -381,6 +419,7 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node = &User{config: uc.config}
 		_spec = sqlgraph.NewCreateSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = uc.conflict
 	if value, ok := uc.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -470,6 +509,23 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node.payment_customer_user = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.PaymentAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.PaymentAccountTable,
+			Columns: []string{user.PaymentAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_account_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.FormsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -502,14 +558,600 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.UsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageTable,
+			Columns: []string{user.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (uc *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
+	uc.conflict = opts
+	return &UserUpsertOne{
+		create: uc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uc *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
+	uc.conflict = append(uc.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertOne{
+		create: uc,
+	}
+}
+
+type (
+	// UserUpsertOne is the builder for "upsert"-ing
+	//  one User node.
+	UserUpsertOne struct {
+		create *UserCreate
+	}
+
+	// UserUpsert is the "OnConflict" setter.
+	UserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *UserUpsert) SetName(v string) *UserUpsert {
+	u.Set(user.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserUpsert) UpdateName() *UserUpsert {
+	u.SetExcluded(user.FieldName)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *UserUpsert) SetEmail(v string) *UserUpsert {
+	u.Set(user.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserUpsert) UpdateEmail() *UserUpsert {
+	u.SetExcluded(user.FieldEmail)
+	return u
+}
+
+// SetPassword sets the "password" field.
+func (u *UserUpsert) SetPassword(v string) *UserUpsert {
+	u.Set(user.FieldPassword, v)
+	return u
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePassword() *UserUpsert {
+	u.SetExcluded(user.FieldPassword)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *UserUpsert) SetUsername(v string) *UserUpsert {
+	u.Set(user.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUsername() *UserUpsert {
+	u.SetExcluded(user.FieldUsername)
+	return u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *UserUpsert) ClearUsername() *UserUpsert {
+	u.SetNull(user.FieldUsername)
+	return u
+}
+
+// SetCompanyName sets the "company_name" field.
+func (u *UserUpsert) SetCompanyName(v string) *UserUpsert {
+	u.Set(user.FieldCompanyName, v)
+	return u
+}
+
+// UpdateCompanyName sets the "company_name" field to the value that was provided on create.
+func (u *UserUpsert) UpdateCompanyName() *UserUpsert {
+	u.SetExcluded(user.FieldCompanyName)
+	return u
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (u *UserUpsert) ClearCompanyName() *UserUpsert {
+	u.SetNull(user.FieldCompanyName)
+	return u
+}
+
+// SetVerified sets the "verified" field.
+func (u *UserUpsert) SetVerified(v bool) *UserUpsert {
+	u.Set(user.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *UserUpsert) UpdateVerified() *UserUpsert {
+	u.SetExcluded(user.FieldVerified)
+	return u
+}
+
+// SetAdmin sets the "admin" field.
+func (u *UserUpsert) SetAdmin(v bool) *UserUpsert {
+	u.Set(user.FieldAdmin, v)
+	return u
+}
+
+// UpdateAdmin sets the "admin" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAdmin() *UserUpsert {
+	u.SetExcluded(user.FieldAdmin)
+	return u
+}
+
+// SetWebsite sets the "website" field.
+func (u *UserUpsert) SetWebsite(v string) *UserUpsert {
+	u.Set(user.FieldWebsite, v)
+	return u
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *UserUpsert) UpdateWebsite() *UserUpsert {
+	u.SetExcluded(user.FieldWebsite)
+	return u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *UserUpsert) ClearWebsite() *UserUpsert {
+	u.SetNull(user.FieldWebsite)
+	return u
+}
+
+// SetBrandButtonColor sets the "brand_button_color" field.
+func (u *UserUpsert) SetBrandButtonColor(v string) *UserUpsert {
+	u.Set(user.FieldBrandButtonColor, v)
+	return u
+}
+
+// UpdateBrandButtonColor sets the "brand_button_color" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBrandButtonColor() *UserUpsert {
+	u.SetExcluded(user.FieldBrandButtonColor)
+	return u
+}
+
+// ClearBrandButtonColor clears the value of the "brand_button_color" field.
+func (u *UserUpsert) ClearBrandButtonColor() *UserUpsert {
+	u.SetNull(user.FieldBrandButtonColor)
+	return u
+}
+
+// SetBrandBackgroundColor sets the "brand_background_color" field.
+func (u *UserUpsert) SetBrandBackgroundColor(v string) *UserUpsert {
+	u.Set(user.FieldBrandBackgroundColor, v)
+	return u
+}
+
+// UpdateBrandBackgroundColor sets the "brand_background_color" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBrandBackgroundColor() *UserUpsert {
+	u.SetExcluded(user.FieldBrandBackgroundColor)
+	return u
+}
+
+// ClearBrandBackgroundColor clears the value of the "brand_background_color" field.
+func (u *UserUpsert) ClearBrandBackgroundColor() *UserUpsert {
+	u.SetNull(user.FieldBrandBackgroundColor)
+	return u
+}
+
+// SetBrandTextColor sets the "brand_text_color" field.
+func (u *UserUpsert) SetBrandTextColor(v string) *UserUpsert {
+	u.Set(user.FieldBrandTextColor, v)
+	return u
+}
+
+// UpdateBrandTextColor sets the "brand_text_color" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBrandTextColor() *UserUpsert {
+	u.SetExcluded(user.FieldBrandTextColor)
+	return u
+}
+
+// ClearBrandTextColor clears the value of the "brand_text_color" field.
+func (u *UserUpsert) ClearBrandTextColor() *UserUpsert {
+	u.SetNull(user.FieldBrandTextColor)
+	return u
+}
+
+// SetBrandColorsStatus sets the "brand_colors_status" field.
+func (u *UserUpsert) SetBrandColorsStatus(v user.BrandColorsStatus) *UserUpsert {
+	u.Set(user.FieldBrandColorsStatus, v)
+	return u
+}
+
+// UpdateBrandColorsStatus sets the "brand_colors_status" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBrandColorsStatus() *UserUpsert {
+	u.SetExcluded(user.FieldBrandColorsStatus)
+	return u
+}
+
+// ClearBrandColorsStatus clears the value of the "brand_colors_status" field.
+func (u *UserUpsert) ClearBrandColorsStatus() *UserUpsert {
+	u.SetNull(user.FieldBrandColorsStatus)
+	return u
+}
+
+// SetLogo sets the "logo" field.
+func (u *UserUpsert) SetLogo(v string) *UserUpsert {
+	u.Set(user.FieldLogo, v)
+	return u
+}
+
+// UpdateLogo sets the "logo" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLogo() *UserUpsert {
+	u.SetExcluded(user.FieldLogo)
+	return u
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (u *UserUpsert) ClearLogo() *UserUpsert {
+	u.SetNull(user.FieldLogo)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(user.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserUpsertOne) Ignore() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
+// documentation for more info.
+func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *UserUpsertOne) SetName(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateName() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *UserUpsertOne) SetEmail(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateEmail() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPassword sets the "password" field.
+func (u *UserUpsertOne) SetPassword(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePassword() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *UserUpsertOne) SetUsername(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUsername() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *UserUpsertOne) ClearUsername() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearUsername()
+	})
+}
+
+// SetCompanyName sets the "company_name" field.
+func (u *UserUpsertOne) SetCompanyName(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCompanyName(v)
+	})
+}
+
+// UpdateCompanyName sets the "company_name" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateCompanyName() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCompanyName()
+	})
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (u *UserUpsertOne) ClearCompanyName() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearCompanyName()
+	})
+}
+
+// SetVerified sets the "verified" field.
+func (u *UserUpsertOne) SetVerified(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateVerified() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// SetAdmin sets the "admin" field.
+func (u *UserUpsertOne) SetAdmin(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAdmin(v)
+	})
+}
+
+// UpdateAdmin sets the "admin" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAdmin() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAdmin()
+	})
+}
+
+// SetWebsite sets the "website" field.
+func (u *UserUpsertOne) SetWebsite(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetWebsite(v)
+	})
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateWebsite() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateWebsite()
+	})
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *UserUpsertOne) ClearWebsite() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearWebsite()
+	})
+}
+
+// SetBrandButtonColor sets the "brand_button_color" field.
+func (u *UserUpsertOne) SetBrandButtonColor(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBrandButtonColor(v)
+	})
+}
+
+// UpdateBrandButtonColor sets the "brand_button_color" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBrandButtonColor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBrandButtonColor()
+	})
+}
+
+// ClearBrandButtonColor clears the value of the "brand_button_color" field.
+func (u *UserUpsertOne) ClearBrandButtonColor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBrandButtonColor()
+	})
+}
+
+// SetBrandBackgroundColor sets the "brand_background_color" field.
+func (u *UserUpsertOne) SetBrandBackgroundColor(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBrandBackgroundColor(v)
+	})
+}
+
+// UpdateBrandBackgroundColor sets the "brand_background_color" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBrandBackgroundColor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBrandBackgroundColor()
+	})
+}
+
+// ClearBrandBackgroundColor clears the value of the "brand_background_color" field.
+func (u *UserUpsertOne) ClearBrandBackgroundColor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBrandBackgroundColor()
+	})
+}
+
+// SetBrandTextColor sets the "brand_text_color" field.
+func (u *UserUpsertOne) SetBrandTextColor(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBrandTextColor(v)
+	})
+}
+
+// UpdateBrandTextColor sets the "brand_text_color" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBrandTextColor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBrandTextColor()
+	})
+}
+
+// ClearBrandTextColor clears the value of the "brand_text_color" field.
+func (u *UserUpsertOne) ClearBrandTextColor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBrandTextColor()
+	})
+}
+
+// SetBrandColorsStatus sets the "brand_colors_status" field.
+func (u *UserUpsertOne) SetBrandColorsStatus(v user.BrandColorsStatus) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBrandColorsStatus(v)
+	})
+}
+
+// UpdateBrandColorsStatus sets the "brand_colors_status" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBrandColorsStatus() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBrandColorsStatus()
+	})
+}
+
+// ClearBrandColorsStatus clears the value of the "brand_colors_status" field.
+func (u *UserUpsertOne) ClearBrandColorsStatus() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBrandColorsStatus()
+	})
+}
+
+// SetLogo sets the "logo" field.
+func (u *UserUpsertOne) SetLogo(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLogo(v)
+	})
+}
+
+// UpdateLogo sets the "logo" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLogo() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLogo()
+	})
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (u *UserUpsertOne) ClearLogo() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLogo()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
 	err      error
 	builders []*UserCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
@@ -539,6 +1181,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ucb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -592,3 +1235,352 @@ func (ucb *UserCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (ucb *UserCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserUpsertBulk {
+	ucb.conflict = opts
+	return &UserUpsertBulk{
+		create: ucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ucb *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk {
+	ucb.conflict = append(ucb.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertBulk{
+		create: ucb,
+	}
+}
+
+// UserUpsertBulk is the builder for "upsert"-ing
+// a bulk of User nodes.
+type UserUpsertBulk struct {
+	create *UserCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(user.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserUpsertBulk) Ignore() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertBulk) DoNothing() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *UserUpsertBulk) SetName(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateName() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *UserUpsertBulk) SetEmail(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateEmail() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPassword sets the "password" field.
+func (u *UserUpsertBulk) SetPassword(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePassword() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *UserUpsertBulk) SetUsername(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUsername() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *UserUpsertBulk) ClearUsername() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearUsername()
+	})
+}
+
+// SetCompanyName sets the "company_name" field.
+func (u *UserUpsertBulk) SetCompanyName(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCompanyName(v)
+	})
+}
+
+// UpdateCompanyName sets the "company_name" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateCompanyName() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCompanyName()
+	})
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (u *UserUpsertBulk) ClearCompanyName() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearCompanyName()
+	})
+}
+
+// SetVerified sets the "verified" field.
+func (u *UserUpsertBulk) SetVerified(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateVerified() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// SetAdmin sets the "admin" field.
+func (u *UserUpsertBulk) SetAdmin(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAdmin(v)
+	})
+}
+
+// UpdateAdmin sets the "admin" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAdmin() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAdmin()
+	})
+}
+
+// SetWebsite sets the "website" field.
+func (u *UserUpsertBulk) SetWebsite(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetWebsite(v)
+	})
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateWebsite() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateWebsite()
+	})
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *UserUpsertBulk) ClearWebsite() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearWebsite()
+	})
+}
+
+// SetBrandButtonColor sets the "brand_button_color" field.
+func (u *UserUpsertBulk) SetBrandButtonColor(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBrandButtonColor(v)
+	})
+}
+
+// UpdateBrandButtonColor sets the "brand_button_color" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBrandButtonColor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBrandButtonColor()
+	})
+}
+
+// ClearBrandButtonColor clears the value of the "brand_button_color" field.
+func (u *UserUpsertBulk) ClearBrandButtonColor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBrandButtonColor()
+	})
+}
+
+// SetBrandBackgroundColor sets the "brand_background_color" field.
+func (u *UserUpsertBulk) SetBrandBackgroundColor(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBrandBackgroundColor(v)
+	})
+}
+
+// UpdateBrandBackgroundColor sets the "brand_background_color" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBrandBackgroundColor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBrandBackgroundColor()
+	})
+}
+
+// ClearBrandBackgroundColor clears the value of the "brand_background_color" field.
+func (u *UserUpsertBulk) ClearBrandBackgroundColor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBrandBackgroundColor()
+	})
+}
+
+// SetBrandTextColor sets the "brand_text_color" field.
+func (u *UserUpsertBulk) SetBrandTextColor(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBrandTextColor(v)
+	})
+}
+
+// UpdateBrandTextColor sets the "brand_text_color" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBrandTextColor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBrandTextColor()
+	})
+}
+
+// ClearBrandTextColor clears the value of the "brand_text_color" field.
+func (u *UserUpsertBulk) ClearBrandTextColor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBrandTextColor()
+	})
+}
+
+// SetBrandColorsStatus sets the "brand_colors_status" field.
+func (u *UserUpsertBulk) SetBrandColorsStatus(v user.BrandColorsStatus) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBrandColorsStatus(v)
+	})
+}
+
+// UpdateBrandColorsStatus sets the "brand_colors_status" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBrandColorsStatus() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBrandColorsStatus()
+	})
+}
+
+// ClearBrandColorsStatus clears the value of the "brand_colors_status" field.
+func (u *UserUpsertBulk) ClearBrandColorsStatus() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBrandColorsStatus()
+	})
+}
+
+// SetLogo sets the "logo" field.
+func (u *UserUpsertBulk) SetLogo(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLogo(v)
+	})
+}
+
+// UpdateLogo sets the "logo" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLogo() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLogo()
+	})
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (u *UserUpsertBulk) ClearLogo() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLogo()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,14 +9,17 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/form"
 	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/predicate"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/usage"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -29,9 +32,12 @@ type UserQuery struct {
 	predicates          []predicate.User
 	withOwner           *PasswordTokenQuery
 	withPaymentCustomer *PaymentCustomerQuery
+	withPaymentAccount  *PaymentAccountQuery
 	withForms           *FormQuery
 	withResponses       *ResponseQuery
+	withUsage           *UsageQuery
 	withFKs             bool
+	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -112,6 +118,28 @@ func (uq *UserQuery) QueryPaymentCustomer() *PaymentCustomerQuery {
 	return query
 }
 
+// QueryPaymentAccount chains the current query on the "payment_account" edge.
+func (uq *UserQuery) QueryPaymentAccount() *PaymentAccountQuery {
+	query := (&PaymentAccountClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(paymentaccount.Table, paymentaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, user.PaymentAccountTable, user.PaymentAccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryForms chains the current query on the "forms" edge.
 func (uq *UserQuery) QueryForms() *FormQuery {
 	query := (&FormClient{config: uq.config}).Query()
@@ -156,6 +184,28 @@ func (uq *UserQuery) QueryResponses() *ResponseQuery {
 	return query
 }
 
+// QueryUsage chains the current query on the "usage" edge.
+func (uq *UserQuery) QueryUsage() *UsageQuery {
+	query := (&UsageClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(usage.Table, usage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsageTable, user.UsageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -350,8 +400,10 @@ func (uq *UserQuery) Clone() *UserQuery {
 		predicates:          append([]predicate.User{}, uq.predicates...),
 		withOwner:           uq.withOwner.Clone(),
 		withPaymentCustomer: uq.withPaymentCustomer.Clone(),
+		withPaymentAccount:  uq.withPaymentAccount.Clone(),
 		withForms:           uq.withForms.Clone(),
 		withResponses:       uq.withResponses.Clone(),
+		withUsage:           uq.withUsage.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -380,6 +432,17 @@ func (uq *UserQuery) WithPaymentCustomer(opts ...func(*PaymentCustomerQuery)) *U
 	return uq
 }
 
+// WithPaymentAccount tells the query-builder to eager-load the nodes that are connected to
+// the "payment_account" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithPaymentAccount(opts ...func(*PaymentAccountQuery)) *UserQuery {
+	query := (&PaymentAccountClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withPaymentAccount = query
+	return uq
+}
+
 // WithForms tells the query-builder to eager-load the nodes that are connected to
 // the "forms" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithForms(opts ...func(*FormQuery)) *UserQuery {
@@ -402,6 +465,17 @@ func (uq *UserQuery) WithResponses(opts ...func(*ResponseQuery)) *UserQuery {
 	return uq
 }
 
+// WithUsage tells the query-builder to eager-load the nodes that are connected to
+// the "usage" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUsage(opts ...func(*UsageQuery)) *UserQuery {
+	query := (&UsageClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUsage = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -481,14 +555,16 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		nodes       = []*User{}
 		withFKs     = uq.withFKs
 		_spec       = uq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			uq.withOwner != nil,
 			uq.withPaymentCustomer != nil,
+			uq.withPaymentAccount != nil,
 			uq.withForms != nil,
 			uq.withResponses != nil,
+			uq.withUsage != nil,
 		}
 	)
-	if uq.withPaymentCustomer != nil {
+	if uq.withPaymentCustomer != nil || uq.withPaymentAccount != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -503,6 +579,9 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -525,6 +604,12 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withPaymentAccount; query != nil {
+		if err := uq.loadPaymentAccount(ctx, query, nodes, nil,
+			func(n *User, e *PaymentAccount) { n.Edges.PaymentAccount = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withForms; query != nil {
 		if err := uq.loadForms(ctx, query, nodes,
 			func(n *User) { n.Edges.Forms = []*Form{} },
@@ -539,6 +624,13 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withUsage; query != nil {
+		if err := uq.loadUsage(ctx, query, nodes,
+			func(n *User) { n.Edges.Usage = []*Usage{} },
+			func(n *User, e *Usage) { n.Edges.Usage = append(n.Edges.Usage, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -604,6 +696,38 @@ func (uq *UserQuery) loadPaymentCustomer(ctx context.Context, query *PaymentCust
 	}
 	return nil
 }
+func (uq *UserQuery) loadPaymentAccount(ctx context.Context, query *PaymentAccountQuery, nodes []*User, init func(*User), assign func(*User, *PaymentAccount)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*User)
+	for i := range nodes {
+		if nodes[i].payment_account_user == nil {
+			continue
+		}
+		fk := *nodes[i].payment_account_user
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(paymentaccount.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_account_user" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (uq *UserQuery) loadForms(ctx context.Context, query *FormQuery, nodes []*User, init func(*User), assign func(*User, *Form)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
@@ -665,9 +789,42 @@ func (uq *UserQuery) loadResponses(ctx context.Context, query *ResponseQuery, no
 	}
 	return nil
 }
+func (uq *UserQuery) loadUsage(ctx context.Context, query *UsageQuery, nodes []*User, init func(*User), assign func(*User, *Usage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(usage.FieldUserID)
+	}
+	query.Where(predicate.Usage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.UsageColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -730,6 +887,9 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.ctx.Unique != nil && *uq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -747,6 +907,32 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uq *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uq *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -12,9 +12,11 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/occult/pagode/ent/form"
 	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/paymentaccount"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/predicate"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/usage"
 	"github.com/occult/pagode/ent/user"
 )
 
@@ -295,6 +297,25 @@ func (uu *UserUpdate) SetPaymentCustomer(p *PaymentCustomer) *UserUpdate {
 	return uu.SetPaymentCustomerID(p.ID)
 }
 
+// SetPaymentAccountID sets the "payment_account" edge to the PaymentAccount entity by ID.
+func (uu *UserUpdate) SetPaymentAccountID(id int) *UserUpdate {
+	uu.mutation.SetPaymentAccountID(id)
+	return uu
+}
+
+// SetNillablePaymentAccountID sets the "payment_account" edge to the PaymentAccount entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillablePaymentAccountID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetPaymentAccountID(*id)
+	}
+	return uu
+}
+
+// SetPaymentAccount sets the "payment_account" edge to the PaymentAccount entity.
+func (uu *UserUpdate) SetPaymentAccount(p *PaymentAccount) *UserUpdate {
+	return uu.SetPaymentAccountID(p.ID)
+}
+
 // AddFormIDs adds the "forms" edge to the Form entity by IDs.
 func (uu *UserUpdate) AddFormIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddFormIDs(ids...)
@@ -325,6 +346,21 @@ func (uu *UserUpdate) AddResponses(r ...*Response) *UserUpdate {
 	return uu.AddResponseIDs(ids...)
 }
 
+// AddUsageIDs adds the "usage" edge to the Usage entity by IDs.
+func (uu *UserUpdate) AddUsageIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddUsageIDs(ids...)
+	return uu
+}
+
+// AddUsage adds the "usage" edges to the Usage entity.
+func (uu *UserUpdate) AddUsage(u ...*Usage) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddUsageIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -357,6 +393,12 @@ func (uu *UserUpdate) ClearPaymentCustomer() *UserUpdate {
 	return uu
 }
 
+// ClearPaymentAccount clears the "payment_account" edge to the PaymentAccount entity.
+func (uu *UserUpdate) ClearPaymentAccount() *UserUpdate {
+	uu.mutation.ClearPaymentAccount()
+	return uu
+}
+
 // ClearForms clears all "forms" edges to the Form entity.
 func (uu *UserUpdate) ClearForms() *UserUpdate {
 	uu.mutation.ClearForms()
@@ -399,6 +441,27 @@ func (uu *UserUpdate) RemoveResponses(r ...*Response) *UserUpdate {
 	return uu.RemoveResponseIDs(ids...)
 }
 
+// ClearUsage clears all "usage" edges to the Usage entity.
+func (uu *UserUpdate) ClearUsage() *UserUpdate {
+	uu.mutation.ClearUsage()
+	return uu
+}
+
+// RemoveUsageIDs removes the "usage" edge to Usage entities by IDs.
+func (uu *UserUpdate) RemoveUsageIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveUsageIDs(ids...)
+	return uu
+}
+
+// RemoveUsage removes "usage" edges to Usage entities.
+func (uu *UserUpdate) RemoveUsage(u ...*Usage) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveUsageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
@@ -600,6 +663,35 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.PaymentAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.PaymentAccountTable,
+			Columns: []string{user.PaymentAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PaymentAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.PaymentAccountTable,
+			Columns: []string{user.PaymentAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uu.mutation.FormsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -690,6 +782,51 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.UsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageTable,
+			Columns: []string{user.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUsageIDs(); len(nodes) > 0 && !uu.mutation.UsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageTable,
+			Columns: []string{user.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageTable,
+			Columns: []string{user.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -974,6 +1111,25 @@ func (uuo *UserUpdateOne) SetPaymentCustomer(p *PaymentCustomer) *UserUpdateOne
 	return uuo.SetPaymentCustomerID(p.ID)
 }
 
+// SetPaymentAccountID sets the "payment_account" edge to the PaymentAccount entity by ID.
+func (uuo *UserUpdateOne) SetPaymentAccountID(id int) *UserUpdateOne {
+	uuo.mutation.SetPaymentAccountID(id)
+	return uuo
+}
+
+// SetNillablePaymentAccountID sets the "payment_account" edge to the PaymentAccount entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePaymentAccountID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetPaymentAccountID(*id)
+	}
+	return uuo
+}
+
+// SetPaymentAccount sets the "payment_account" edge to the PaymentAccount entity.
+func (uuo *UserUpdateOne) SetPaymentAccount(p *PaymentAccount) *UserUpdateOne {
+	return uuo.SetPaymentAccountID(p.ID)
+}
+
 // AddFormIDs adds the "forms" edge to the Form entity by IDs.
 func (uuo *UserUpdateOne) AddFormIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddFormIDs(ids...)
@@ -1004,6 +1160,21 @@ func (uuo *UserUpdateOne) AddResponses(r ...*Response) *UserUpdateOne {
 	return uuo.AddResponseIDs(ids...)
 }
 
+// AddUsageIDs adds the "usage" edge to the Usage entity by IDs.
+func (uuo *UserUpdateOne) AddUsageIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddUsageIDs(ids...)
+	return uuo
+}
+
+// AddUsage adds the "usage" edges to the Usage entity.
+func (uuo *UserUpdateOne) AddUsage(u ...*Usage) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddUsageIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -1036,6 +1207,12 @@ func (uuo *UserUpdateOne) ClearPaymentCustomer() *UserUpdateOne {
 	return uuo
 }
 
+// ClearPaymentAccount clears the "payment_account" edge to the PaymentAccount entity.
+func (uuo *UserUpdateOne) ClearPaymentAccount() *UserUpdateOne {
+	uuo.mutation.ClearPaymentAccount()
+	return uuo
+}
+
 // ClearForms clears all "forms" edges to the Form entity.
 func (uuo *UserUpdateOne) ClearForms() *UserUpdateOne {
 	uuo.mutation.ClearForms()
@@ -1078,6 +1255,27 @@ func (uuo *UserUpdateOne) RemoveResponses(r ...*Response) *UserUpdateOne {
 	return uuo.RemoveResponseIDs(ids...)
 }
 
+// ClearUsage clears all "usage" edges to the Usage entity.
+func (uuo *UserUpdateOne) ClearUsage() *UserUpdateOne {
+	uuo.mutation.ClearUsage()
+	return uuo
+}
+
+// RemoveUsageIDs removes the "usage" edge to Usage entities by IDs.
+func (uuo *UserUpdateOne) RemoveUsageIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveUsageIDs(ids...)
+	return uuo
+}
+
+// RemoveUsage removes "usage" edges to Usage entities.
+func (uuo *UserUpdateOne) RemoveUsage(u ...*Usage) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveUsageIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -1309,6 +1507,35 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.PaymentAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.PaymentAccountTable,
+			Columns: []string{user.PaymentAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PaymentAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.PaymentAccountTable,
+			Columns: []string{user.PaymentAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.FormsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1399,6 +1626,51 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.UsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageTable,
+			Columns: []string{user.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUsageIDs(); len(nodes) > 0 && !uuo.mutation.UsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageTable,
+			Columns: []string{user.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageTable,
+			Columns: []string{user.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,366 @@
+package answers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/question"
+)
+
+type (
+	// MatrixAnswer stores the column chosen for each row of a matrix question, keyed by row label.
+	MatrixAnswer map[string]string
+
+	// RankingAnswer stores the configured items of a ranking question ordered from first to last.
+	RankingAnswer []string
+
+	// MultiInputAnswer stores the value entered for each sub-input, keyed by sub-input ID.
+	MultiInputAnswer map[string]string
+
+	// Matrix describes the rows and columns configured on a matrix question.
+	Matrix struct {
+		Rows    []string
+		Columns []string
+	}
+
+	// SubInput describes a single sub-input configured on a multi-input question.
+	SubInput struct {
+		ID          string `json:"id"`
+		Type        string `json:"type"`
+		Label       string `json:"label"`
+		Placeholder string `json:"placeholder,omitempty"`
+		Required    bool   `json:"required"`
+	}
+)
+
+// IsComposite returns true if answers to the given question type are stored as structured JSON.
+func IsComposite(t question.Type) bool {
+	switch t {
+	case question.TypeMatrix, question.TypeRanking, question.TypeMultiInput:
+		return true
+	}
+	return false
+}
+
+// MatrixOf returns the matrix configuration stored in the question options.
+func MatrixOf(q *ent.Question) Matrix {
+	return Matrix{
+		Rows:    stringSlice(q.Options["rows"]),
+		Columns: stringSlice(q.Options["columns"]),
+	}
+}
+
+// ItemsOf returns the items configured on a selection or ranking question.
+func ItemsOf(q *ent.Question) []string {
+	return stringSlice(q.Options["items"])
+}
+
+// SubInputsOf returns the sub-inputs configured on a multi-input question.
+func SubInputsOf(q *ent.Question) []SubInput {
+	raw, ok := q.Options["subInputs"]
+	if !ok {
+		return nil
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+
+	var subInputs []SubInput
+	if err := json.Unmarshal(b, &subInputs); err != nil {
+		return nil
+	}
+	return subInputs
+}
+
+// Normalize validates a raw submitted value against the question configuration and returns the string
+// that should be stored in Answer.value.
+// An empty string is returned if the respondent left the question unanswered.
+func Normalize(q *ent.Question, raw any) (string, error) {
+	switch q.Type {
+	case question.TypeMatrix:
+		return normalizeMatrix(q, raw)
+	case question.TypeRanking:
+		return normalizeRanking(q, raw)
+	case question.TypeMultiInput:
+		return normalizeMultiInput(q, raw)
+	}
+
+	switch v := raw.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []any:
+		if len(v) == 0 {
+			return "", nil
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
+
+// Columns returns the export column headers for a question.
+// Composite questions produce one column per row, item or sub-input.
+func Columns(q *ent.Question) []string {
+	var labels []string
+
+	switch q.Type {
+	case question.TypeMatrix:
+		labels = MatrixOf(q).Rows
+	case question.TypeRanking:
+		labels = ItemsOf(q)
+	case question.TypeMultiInput:
+		for _, s := range SubInputsOf(q) {
+			labels = append(labels, s.Label)
+		}
+	}
+
+	if len(labels) == 0 {
+		return []string{q.Title}
+	}
+
+	cols := make([]string, 0, len(labels))
+	for _, l := range labels {
+		cols = append(cols, fmt.Sprintf("%s - %s", q.Title, l))
+	}
+	return cols
+}
+
+// Cells splits a stored answer value into one cell per export column of the question.
+// Values that cannot be decoded are placed in the first cell so no data is lost.
+func Cells(q *ent.Question, value string) []string {
+	cols := Columns(q)
+	cells := make([]string, len(cols))
+
+	if value == "" {
+		return cells
+	}
+
+	if !IsComposite(q.Type) || (len(cols) == 1 && cols[0] == q.Title) {
+		cells[0] = value
+		return cells
+	}
+
+	switch q.Type {
+	case question.TypeMatrix:
+		var a MatrixAnswer
+		if err := json.Unmarshal([]byte(value), &a); err != nil {
+			cells[0] = value
+			return cells
+		}
+		for i, row := range MatrixOf(q).Rows {
+			cells[i] = a[row]
+		}
+
+	case question.TypeRanking:
+		var a RankingAnswer
+		if err := json.Unmarshal([]byte(value), &a); err != nil {
+			cells[0] = value
+			return cells
+		}
+		rank := make(map[string]int, len(a))
+		for i, item := range a {
+			rank[item] = i + 1
+		}
+		for i, item := range ItemsOf(q) {
+			if r, ok := rank[item]; ok {
+				cells[i] = strconv.Itoa(r)
+			}
+		}
+
+	case question.TypeMultiInput:
+		var a MultiInputAnswer
+		if err := json.Unmarshal([]byte(value), &a); err != nil {
+			cells[0] = value
+			return cells
+		}
+		for i, s := range SubInputsOf(q) {
+			cells[i] = a[s.ID]
+		}
+	}
+
+	return cells
+}
+
+func normalizeMatrix(q *ent.Question, raw any) (string, error) {
+	values, ok := raw.(map[string]any)
+	if !ok && raw != nil {
+		return "", fmt.Errorf("answer to '%s' must be an object of row selections", q.Title)
+	}
+
+	m := MatrixOf(q)
+	rows := toSet(m.Rows)
+	columns := toSet(m.Columns)
+
+	a := make(MatrixAnswer, len(values))
+	for row, v := range values {
+		col := scalarString(v)
+		if col == "" {
+			continue
+		}
+		if len(rows) > 0 && !rows[row] {
+			return "", fmt.Errorf("'%s' is not a row of '%s'", row, q.Title)
+		}
+		if len(columns) > 0 && !columns[col] {
+			return "", fmt.Errorf("'%s' is not a valid choice for '%s'", col, row)
+		}
+		a[row] = col
+	}
+
+	if q.Required {
+		for _, row := range m.Rows {
+			if _, ok := a[row]; !ok {
+				return "", fmt.Errorf("row '%s' of '%s' must be answered", row, q.Title)
+			}
+		}
+	}
+
+	if len(a) == 0 {
+		return "", nil
+	}
+	return marshal(a)
+}
+
+func normalizeRanking(q *ent.Question, raw any) (string, error) {
+	values, ok := raw.([]any)
+	if !ok && raw != nil {
+		return "", fmt.Errorf("answer to '%s' must be a list of ranked items", q.Title)
+	}
+
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	items := ItemsOf(q)
+	remaining := toSet(items)
+
+	a := make(RankingAnswer, 0, len(values))
+	for _, v := range values {
+		item := scalarString(v)
+		if !remaining[item] {
+			return "", fmt.Errorf("ranking for '%s' must list each item exactly once", q.Title)
+		}
+		delete(remaining, item)
+		a = append(a, item)
+	}
+
+	if len(remaining) > 0 {
+		return "", fmt.Errorf("ranking for '%s' must include all %d items", q.Title, len(items))
+	}
+
+	return marshal(a)
+}
+
+func normalizeMultiInput(q *ent.Question, raw any) (string, error) {
+	values, ok := raw.(map[string]any)
+	if !ok && raw != nil {
+		return "", fmt.Errorf("answer to '%s' must be an object of sub-input values", q.Title)
+	}
+
+	subInputs := SubInputsOf(q)
+	known := make(map[string]SubInput, len(subInputs))
+	for _, s := range subInputs {
+		known[s.ID] = s
+	}
+
+	a := make(MultiInputAnswer, len(values))
+	for id, v := range values {
+		val := strings.TrimSpace(scalarString(v))
+		if val == "" {
+			continue
+		}
+		s, ok := known[id]
+		if !ok {
+			return "", fmt.Errorf("'%s' is not a field of '%s'", id, q.Title)
+		}
+		if err := validateSubInput(s, val); err != nil {
+			return "", err
+		}
+		a[id] = val
+	}
+
+	// Required sub-inputs apply whenever the question is required or partially answered.
+	if q.Required || len(a) > 0 {
+		for _, s := range subInputs {
+			if _, ok := a[s.ID]; s.Required && !ok {
+				return "", fmt.Errorf("'%s' of '%s' is required", s.Label, q.Title)
+			}
+		}
+	}
+
+	if len(a) == 0 {
+		return "", nil
+	}
+	return marshal(a)
+}
+
+func validateSubInput(s SubInput, val string) error {
+	var err error
+
+	switch s.Type {
+	case "email":
+		_, err = mail.ParseAddress(val)
+	case "number":
+		_, err = strconv.ParseFloat(val, 64)
+	case "url":
+		_, err = url.ParseRequestURI(val)
+	}
+
+	if err != nil {
+		return fmt.Errorf("'%s' must be a valid %s", s.Label, s.Type)
+	}
+	return nil
+}
+
+func marshal(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func scalarString(v any) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	default:
+		return fmt.Sprintf("%v", s)
+	}
+}
+
+func stringSlice(v any) []string {
+	switch s := v.(type) {
+	case []string:
+		return s
+	case []any:
+		out := make([]string, 0, len(s))
+		for _, item := range s {
+			out = append(out, scalarString(item))
+		}
+		return out
+	}
+	return nil
+}
+
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, i := range items {
+		set[i] = true
+	}
+	return set
+}
@@ -0,0 +1,203 @@
+package answers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/question"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func matrixQuestion(required bool) *ent.Question {
+	return &ent.Question{
+		Type:     question.TypeMatrix,
+		Title:    "Service",
+		Required: required,
+		Options: map[string]interface{}{
+			"rows":    []interface{}{"Speed", "Quality"},
+			"columns": []interface{}{"Bad", "Good"},
+		},
+	}
+}
+
+func rankingQuestion() *ent.Question {
+	return &ent.Question{
+		Type:  question.TypeRanking,
+		Title: "Priorities",
+		Options: map[string]interface{}{
+			"items": []interface{}{"Price", "Support", "Features"},
+		},
+	}
+}
+
+func multiInputQuestion(required bool) *ent.Question {
+	return &ent.Question{
+		Type:     question.TypeMultiInput,
+		Title:    "Contact",
+		Required: required,
+		Options: map[string]interface{}{
+			"subInputs": []interface{}{
+				map[string]interface{}{"id": "name", "type": "text", "label": "Name", "required": true},
+				map[string]interface{}{"id": "email", "type": "email", "label": "Email", "required": false},
+			},
+		},
+	}
+}
+
+func TestNormalize_Scalar(t *testing.T) {
+	q := &ent.Question{Type: question.TypeText, Title: "Name"}
+
+	v, err := Normalize(q, "Alice")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", v)
+
+	v, err = Normalize(q, []interface{}{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, `["a","b"]`, v)
+
+	v, err = Normalize(q, nil)
+	require.NoError(t, err)
+	assert.Empty(t, v)
+}
+
+func TestNormalize_Matrix(t *testing.T) {
+	v, err := Normalize(matrixQuestion(true), map[string]interface{}{"Speed": "Good", "Quality": "Bad"})
+	require.NoError(t, err)
+
+	var a MatrixAnswer
+	require.NoError(t, json.Unmarshal([]byte(v), &a))
+	assert.Equal(t, MatrixAnswer{"Speed": "Good", "Quality": "Bad"}, a)
+
+	_, err = Normalize(matrixQuestion(true), map[string]interface{}{"Speed": "Good"})
+	assert.ErrorContains(t, err, "row 'Quality'")
+
+	v, err = Normalize(matrixQuestion(false), map[string]interface{}{"Speed": "Good"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, v)
+
+	_, err = Normalize(matrixQuestion(false), map[string]interface{}{"Speed": "Great"})
+	assert.ErrorContains(t, err, "not a valid choice")
+
+	_, err = Normalize(matrixQuestion(false), map[string]interface{}{"Price": "Good"})
+	assert.ErrorContains(t, err, "not a row")
+
+	_, err = Normalize(matrixQuestion(false), "Good")
+	assert.Error(t, err)
+}
+
+func TestNormalize_Ranking(t *testing.T) {
+	v, err := Normalize(rankingQuestion(), []interface{}{"Support", "Price", "Features"})
+	require.NoError(t, err)
+	assert.Equal(t, `["Support","Price","Features"]`, v)
+
+	_, err = Normalize(rankingQuestion(), []interface{}{"Support", "Price"})
+	assert.ErrorContains(t, err, "must include all 3 items")
+
+	_, err = Normalize(rankingQuestion(), []interface{}{"Support", "Support", "Price"})
+	assert.ErrorContains(t, err, "exactly once")
+
+	_, err = Normalize(rankingQuestion(), []interface{}{"Support", "Price", "Other"})
+	assert.Error(t, err)
+
+	v, err = Normalize(rankingQuestion(), []interface{}{})
+	require.NoError(t, err)
+	assert.Empty(t, v)
+}
+
+func TestNormalize_MultiInput(t *testing.T) {
+	v, err := Normalize(multiInputQuestion(true), map[string]interface{}{"name": "Alice", "email": "alice@example.com"})
+	require.NoError(t, err)
+
+	var a MultiInputAnswer
+	require.NoError(t, json.Unmarshal([]byte(v), &a))
+	assert.Equal(t, "Alice", a["name"])
+
+	_, err = Normalize(multiInputQuestion(true), map[string]interface{}{"email": "alice@example.com"})
+	assert.ErrorContains(t, err, "'Name' of 'Contact' is required")
+
+	_, err = Normalize(multiInputQuestion(false), map[string]interface{}{"email": "alice@example.com"})
+	assert.ErrorContains(t, err, "is required")
+
+	v, err = Normalize(multiInputQuestion(false), map[string]interface{}{"name": "", "email": ""})
+	require.NoError(t, err)
+	assert.Empty(t, v)
+
+	_, err = Normalize(multiInputQuestion(false), map[string]interface{}{"name": "Alice", "email": "nope"})
+	assert.ErrorContains(t, err, "valid email")
+
+	_, err = Normalize(multiInputQuestion(false), map[string]interface{}{"name": "Alice", "age": "30"})
+	assert.ErrorContains(t, err, "not a field")
+}
+
+func TestColumnsAndCells(t *testing.T) {
+	m := matrixQuestion(false)
+	assert.Equal(t, []string{"Service - Speed", "Service - Quality"}, Columns(m))
+	assert.Equal(t, []string{"", "Good"}, Cells(m, `{"Quality":"Good"}`))
+
+	r := rankingQuestion()
+	assert.Equal(t, []string{"Priorities - Price", "Priorities - Support", "Priorities - Features"}, Columns(r))
+	assert.Equal(t, []string{"2", "1", "3"}, Cells(r, `["Support","Price","Features"]`))
+
+	mi := multiInputQuestion(false)
+	assert.Equal(t, []string{"Contact - Name", "Contact - Email"}, Columns(mi))
+	assert.Equal(t, []string{"Alice", ""}, Cells(mi, `{"name":"Alice"}`))
+
+	text := &ent.Question{Type: question.TypeText, Title: "Name"}
+	assert.Equal(t, []string{"Name"}, Columns(text))
+	assert.Equal(t, []string{"Alice"}, Cells(text, "Alice"))
+
+	assert.Equal(t, []string{"legacy", "", ""}, Cells(r, "legacy"))
+	assert.Equal(t, []string{"", ""}, Cells(m, ""))
+}
+
+func paymentQuestion(options map[string]interface{}) *ent.Question {
+	return &ent.Question{Type: question.TypePayment, Title: "Tickets", Options: options}
+}
+
+func TestPayment(t *testing.T) {
+	fixed := PaymentOf(paymentQuestion(map[string]interface{}{"amount": float64(2500)}), "USD")
+	assert.Equal(t, PaymentFixed, fixed.Mode)
+	assert.Equal(t, "usd", fixed.Currency)
+	total, err := fixed.Total(nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2500, total)
+
+	quantity := PaymentOf(paymentQuestion(map[string]interface{}{
+		"mode": "quantity", "amount": float64(1000), "currency": "eur", "maxQuantity": float64(4),
+	}), "usd")
+	assert.Equal(t, "eur", quantity.Currency)
+	total, err = quantity.Total("3")
+	require.NoError(t, err)
+	assert.EqualValues(t, 3000, total)
+	total, err = quantity.Total("")
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	_, err = quantity.Total("5")
+	assert.ErrorContains(t, err, "at most 4")
+	_, err = quantity.Total("1.5")
+	assert.ErrorContains(t, err, "whole number")
+
+	// Totals are capped before they can overflow.
+	unlimited := PaymentOf(paymentQuestion(map[string]interface{}{"mode": "quantity", "amount": float64(2500)}), "usd")
+	_, err = unlimited.Total("9223372036854775807")
+	assert.ErrorContains(t, err, "at most 999999.99 USD")
+
+	custom := PaymentOf(paymentQuestion(map[string]interface{}{"mode": "custom", "minimum": float64(500)}), "usd")
+	total, err = custom.Total("12.50")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1250, total)
+	_, err = custom.Total("4.99")
+	assert.ErrorContains(t, err, "at least 5.00 USD")
+	_, err = custom.Total("-1")
+	assert.ErrorContains(t, err, "positive")
+	_, err = custom.Total("1000000")
+	assert.ErrorContains(t, err, "at most")
+
+	// Amounts of zero-decimal currencies are entered in whole units.
+	yen := PaymentOf(paymentQuestion(map[string]interface{}{"mode": "custom", "currency": "JPY"}), "usd")
+	total, err = yen.Total("1200")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1200, total)
+}
@@ -0,0 +1,118 @@
+package answers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/currency"
+)
+
+// Modes of payment questions.
+const (
+	// PaymentFixed charges the configured amount.
+	PaymentFixed = "fixed"
+
+	// PaymentQuantity charges the configured amount for each unit the respondent orders.
+	PaymentQuantity = "quantity"
+
+	// PaymentCustom charges the amount the respondent enters, which must be at least the configured minimum.
+	PaymentCustom = "custom"
+)
+
+// MaxPaymentTotal is the largest amount, in the smallest currency unit, a response can be charged. It is the
+// payment provider's limit for most currencies and keeps totals far from overflowing.
+const MaxPaymentTotal int64 = 99_999_999
+
+// Payment describes the amount charged by a payment question. Amounts are in the smallest currency unit.
+type Payment struct {
+	Title       string
+	Mode        string
+	Amount      int64
+	Currency    string
+	Minimum     int64
+	MaxQuantity int64
+}
+
+// PaymentOf returns the payment configured on a payment question. The default currency is used if the question
+// doesn't set one.
+func PaymentOf(q *ent.Question, defaultCurrency string) Payment {
+	p := Payment{
+		Title:       q.Title,
+		Mode:        scalarString(q.Options["mode"]),
+		Amount:      int64Of(q.Options["amount"]),
+		Currency:    strings.ToLower(scalarString(q.Options["currency"])),
+		Minimum:     int64Of(q.Options["minimum"]),
+		MaxQuantity: int64Of(q.Options["maxQuantity"]),
+	}
+
+	switch p.Mode {
+	case PaymentQuantity, PaymentCustom:
+	default:
+		p.Mode = PaymentFixed
+	}
+	if p.Currency == "" {
+		p.Currency = strings.ToLower(defaultCurrency)
+	}
+	return p
+}
+
+// Total validates a raw submitted value against the payment and returns the amount to charge.
+// Zero is returned if the respondent ordered nothing or left the amount empty.
+func (p Payment) Total(raw any) (int64, error) {
+	switch p.Mode {
+	case PaymentQuantity:
+		v := strings.TrimSpace(scalarString(raw))
+		if v == "" {
+			return 0, nil
+		}
+		quantity, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || quantity < 0 {
+			return 0, fmt.Errorf("quantity for '%s' must be a whole number", p.Title)
+		}
+		if p.MaxQuantity > 0 && quantity > p.MaxQuantity {
+			return 0, fmt.Errorf("quantity for '%s' must be at most %d", p.Title, p.MaxQuantity)
+		}
+		// Checked before multiplying so large quantities can't overflow.
+		if p.Amount > 0 && quantity > MaxPaymentTotal/p.Amount {
+			return 0, fmt.Errorf("total for '%s' must be at most %s", p.Title, currency.Format(MaxPaymentTotal, p.Currency))
+		}
+		return quantity * p.Amount, nil
+
+	case PaymentCustom:
+		v := strings.TrimSpace(scalarString(raw))
+		if v == "" {
+			return 0, nil
+		}
+		amount, err := currency.Parse(v, p.Currency)
+		if err != nil || amount <= 0 {
+			return 0, fmt.Errorf("amount for '%s' must be a positive number", p.Title)
+		}
+		if amount < p.Minimum {
+			return 0, fmt.Errorf("amount for '%s' must be at least %s", p.Title, currency.Format(p.Minimum, p.Currency))
+		}
+		if amount > MaxPaymentTotal {
+			return 0, fmt.Errorf("amount for '%s' must be at most %s", p.Title, currency.Format(MaxPaymentTotal, p.Currency))
+		}
+		return amount, nil
+
+	default:
+		return p.Amount, nil
+	}
+}
+
+func int64Of(v any) int64 {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	}
+	return 0
+}
@@ -0,0 +1,80 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]bool{
+		"* * * * *":       true,
+		"*/15 * * * *":    true,
+		"0 9-17 * * 1-5":  true,
+		"0,30 0 1,15 * *": true,
+		"0 0 * * 7":       true,
+		"@daily":          true,
+		"@hourly":         true,
+		"":                false,
+		"* * * *":         false,
+		"60 * * * *":      false,
+		"* 24 * * *":      false,
+		"* * 0 * *":       false,
+		"* * * 13 *":      false,
+		"*/0 * * * *":     false,
+		"5-1 * * * *":     false,
+		"a * * * *":       false,
+		"@every 5m":       false,
+	}
+
+	for expr, valid := range tests {
+		t.Run(expr, func(t *testing.T) {
+			_, err := Parse(expr)
+			if valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2024, 1, 10, 10, 17, 30, 0, time.UTC)
+
+	tests := map[string]time.Time{
+		"* * * * *":    time.Date(2024, 1, 10, 10, 18, 0, 0, time.UTC),
+		"*/15 * * * *": time.Date(2024, 1, 10, 10, 30, 0, 0, time.UTC),
+		"@hourly":      time.Date(2024, 1, 10, 11, 0, 0, 0, time.UTC),
+		"@daily":       time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+		"30 2 * * *":   time.Date(2024, 1, 11, 2, 30, 0, 0, time.UTC),
+		"0 9 * * 1":    time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+		"0 0 * * 7":    time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC),
+		"@monthly":     time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 29 2 *":   time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		"0 0 13 * 5":   time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC),
+		"15 10 * * *":  time.Date(2024, 1, 11, 10, 15, 0, 0, time.UTC),
+		"0 0 1 1 *":    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 30 2 *":   {},
+	}
+
+	for expr, expected := range tests {
+		t.Run(expr, func(t *testing.T) {
+			s, err := Parse(expr)
+			require.NoError(t, err)
+			assert.Equal(t, expected, s.Next(now))
+		})
+	}
+}
+
+func TestScheduleNext_Location(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	s := MustParse("0 9 * * *")
+	next := s.Next(time.Date(2024, 1, 10, 12, 0, 0, 0, loc))
+	assert.Equal(t, time.Date(2024, 1, 11, 9, 0, 0, 0, loc), next)
+}
@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// zeroDecimal are the currencies which have no minor unit, such as JPY, whose amounts are in whole units.
+var zeroDecimal = map[string]bool{
+	"bif": true, "clp": true, "djf": true, "gnf": true, "jpy": true, "kmf": true, "krw": true, "mga": true,
+	"pyg": true, "rwf": true, "ugx": true, "vnd": true, "vuv": true, "xaf": true, "xof": true, "xpf": true,
+}
+
+// threeDecimal are the currencies whose minor unit is a thousandth, such as KWD.
+var threeDecimal = map[string]bool{
+	"bhd": true, "jod": true, "kwd": true, "omr": true, "tnd": true,
+}
+
+// Decimals returns how many decimals an amount of a currency has in its major unit, which is how many times
+// the smallest unit amounts are stored in has to be divided by 10.
+func Decimals(currency string) int {
+	c := strings.ToLower(currency)
+	switch {
+	case zeroDecimal[c]:
+		return 0
+	case threeDecimal[c]:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// Valid returns whether a currency is a three-letter ISO 4217 code.
+func Valid(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, r := range currency {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse parses an amount entered in the major unit of a currency, such as "12.50", into its smallest unit.
+func Parse(v, currency string) (int64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid amount: %s", v)
+	}
+	return int64(math.Round(f * math.Pow10(Decimals(currency)))), nil
+}
+
+// Format formats an amount in the smallest unit of a currency along with the currency, such as "12.50 USD"
+// or "1200 JPY".
+func Format(amount int64, currency string) string {
+	d := Decimals(currency)
+	s := strconv.FormatFloat(float64(amount)/math.Pow10(d), 'f', d, 64)
+	if currency == "" {
+		return s
+	}
+	return s + " " + strings.ToUpper(currency)
+}
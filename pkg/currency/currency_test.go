@@ -0,0 +1,60 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimals(t *testing.T) {
+	assert.Equal(t, 2, Decimals("usd"))
+	assert.Equal(t, 2, Decimals("EUR"))
+	assert.Equal(t, 2, Decimals("brl"))
+	assert.Equal(t, 0, Decimals("JPY"))
+	assert.Equal(t, 0, Decimals("clp"))
+	assert.Equal(t, 3, Decimals("kwd"))
+}
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid("usd"))
+	assert.True(t, Valid("BRL"))
+	assert.False(t, Valid(""))
+	assert.False(t, Valid("euro"))
+	assert.False(t, Valid("u$d"))
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		currency string
+		expected int64
+	}{
+		"two decimals":   {value: "12.50", currency: "eur", expected: 1250},
+		"whole amount":   {value: "12", currency: "brl", expected: 1200},
+		"zero decimal":   {value: "1200", currency: "jpy", expected: 1200},
+		"three decimals": {value: "1.5", currency: "kwd", expected: 1500},
+		"rounded":        {value: "0.005", currency: "usd", expected: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			amount, err := Parse(test.value, test.currency)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, amount)
+		})
+	}
+
+	_, err := Parse("abc", "usd")
+	assert.Error(t, err)
+	_, err = Parse("NaN", "usd")
+	assert.Error(t, err)
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "12.50 USD", Format(1250, "usd"))
+	assert.Equal(t, "29.00 BRL", Format(2900, "brl"))
+	assert.Equal(t, "1200 JPY", Format(1200, "jpy"))
+	assert.Equal(t, "1.500 KWD", Format(1500, "kwd"))
+	assert.Equal(t, "0.05", Format(5, ""))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/occult/pagode/ent/admin"
 	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/form"
+	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/msg"
 	"github.com/occult/pagode/pkg/pager"
 	"github.com/occult/pagode/pkg/redirect"
@@ -30,6 +31,7 @@ type Admin struct {
 	graph    *gen.Graph
 	admin    *admin.Handler
 	backlite *ui.Handler
+	jobs     *services.JobWorker
 	Inertia  *inertia.Inertia
 }
 
@@ -44,6 +46,7 @@ func (h *Admin) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.graph = c.Graph
 	h.orm = c.ORM
+	h.jobs = c.Jobs
 	h.admin = admin.NewHandler(h.orm, admin.HandlerConfig{
 		ItemsPerPage: 25,
 		PageQueryKey: pager.QueryKey,
@@ -92,6 +95,16 @@ func (h *Admin) Routes(g *echo.Group) {
 	tasks.GET("/upcoming", h.Backlite(h.backlite.Upcoming))
 	tasks.GET("/task/:id", h.Backlite(h.backlite.Task))
 	tasks.GET("/completed/:id", h.Backlite(h.backlite.TaskCompleted))
+
+	jobs := g.Group("/admin/jobs", middleware.RequireAuthentication, middleware.RequireAdmin)
+	jobs.GET("", h.Jobs).Name = routenames.AdminJobs
+	jobs.GET("/schedules", h.JobSchedules).Name = routenames.AdminJobSchedules
+	jobs.POST("/purge", h.JobsPurge).Name = routenames.AdminJobsPurge
+	jobs.GET("/dead", h.JobsDead).Name = routenames.AdminJobsDead
+	jobs.POST("/dead/retry", h.JobsDeadRetry).Name = routenames.AdminJobsDeadRetry
+	jobs.GET("/:id", h.JobShow).Name = routenames.AdminJobShow
+	jobs.POST("/:id/retry", h.JobRetry).Name = routenames.AdminJobRetry
+	jobs.POST("/:id/cancel", h.JobCancel).Name = routenames.AdminJobCancel
 }
 
 func (h *Admin) Page(ctx echo.Context) error {
@@ -0,0 +1,330 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
+	"github.com/occult/pagode/pkg/msg"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// jobStatsWindow is the period used for the recent throughput and failure counts of each queue.
+const jobStatsWindow = 24 * time.Hour
+
+// Jobs lists jobs filtered by queue and status along with per-queue stats.
+func (h *Admin) Jobs(ctx echo.Context) error {
+	page, err := strconv.Atoi(ctx.QueryParam("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	limit := 25
+	offset := (page - 1) * limit
+
+	queue := ctx.QueryParam("queue")
+	status := ctx.QueryParam("status")
+
+	query := h.orm.Job.Query()
+	if queue != "" {
+		query.Where(job.Queue(queue))
+	}
+	if status != "" {
+		if err := job.StatusValidator(job.Status(status)); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err)
+		}
+		query.Where(job.StatusEQ(job.Status(status)))
+	}
+
+	total, err := query.Clone().Count(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	jobs, err := query.
+		Order(ent.Desc(job.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	stats, err := h.jobs.QueueStats(ctx.Request().Context(), time.Now().Add(-jobStatsWindow))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	err = h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Admin/Jobs/Index",
+		inertia.Props{
+			"jobs":  jobs,
+			"stats": stats,
+			"filters": map[string]string{
+				"queue":  queue,
+				"status": status,
+			},
+			"pagination": map[string]any{
+				"total":      total,
+				"page":       page,
+				"perPage":    limit,
+				"totalPages": (total + limit - 1) / limit,
+			},
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
+// JobShow shows a single job including its payload, error and every attempt made to run it.
+func (h *Admin) JobShow(ctx echo.Context) error {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid job ID")
+	}
+
+	j, err := h.orm.Job.Get(ctx.Request().Context(), id)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound)
+	case err != nil:
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	attempts, err := j.QueryHistory().
+		Order(ent.Asc(jobattempt.FieldID)).
+		All(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	err = h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Admin/Jobs/Show",
+		inertia.Props{
+			"job":      j,
+			"attempts": attempts,
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
+// JobsDead lists the jobs which used up their attempts or failed permanently, optionally filtered by queue.
+func (h *Admin) JobsDead(ctx echo.Context) error {
+	page, err := strconv.Atoi(ctx.QueryParam("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	limit := 25
+	offset := (page - 1) * limit
+
+	queue := ctx.QueryParam("queue")
+
+	query := h.orm.Job.Query().
+		Where(job.StatusEQ(job.StatusFailed))
+	if queue != "" {
+		query.Where(job.Queue(queue))
+	}
+
+	total, err := query.Clone().Count(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	jobs, err := query.
+		Order(ent.Desc(job.FieldProcessedAt), ent.Desc(job.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	stats, err := h.jobs.QueueStats(ctx.Request().Context(), time.Now().Add(-jobStatsWindow))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Only list the queues which have dead jobs.
+	failing := make([]services.QueueStats, 0, len(stats))
+	for _, s := range stats {
+		if s.Failed > 0 {
+			failing = append(failing, s)
+		}
+	}
+
+	err = h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Admin/Jobs/Dead",
+		inertia.Props{
+			"jobs":  jobs,
+			"stats": failing,
+			"queue": queue,
+			"pagination": map[string]any{
+				"total":      total,
+				"page":       page,
+				"perPage":    limit,
+				"totalPages": (total + limit - 1) / limit,
+			},
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
+// JobsDeadRetry returns every failed job of the submitted queue to it.
+func (h *Admin) JobsDeadRetry(ctx echo.Context) error {
+	w := ctx.Response().Writer
+	r := ctx.Request()
+
+	queue := ctx.FormValue("queue")
+	uri := ctx.Echo().Reverse(routenames.AdminJobsDead)
+	if queue == "" {
+		msg.Danger(ctx, "Please select a queue to retry.")
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	retried, err := h.jobs.RetryFailed(r.Context(), queue)
+	if err != nil {
+		msg.Danger(ctx, "Failed to retry jobs: "+err.Error())
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	msg.Success(ctx, "Queued "+strconv.Itoa(retried)+" failed jobs for retry.")
+	h.Inertia.Redirect(w, r, uri)
+	return nil
+}
+
+// JobRetry returns a failed or cancelled job to its queue.
+func (h *Admin) JobRetry(ctx echo.Context) error {
+	return h.jobAction(ctx, h.jobs.Retry, "Job queued for retry.", "Only failed or cancelled jobs can be retried.")
+}
+
+// JobCancel cancels a pending job.
+func (h *Admin) JobCancel(ctx echo.Context) error {
+	return h.jobAction(ctx, h.jobs.Cancel, "Job cancelled.", "Only pending jobs can be cancelled.")
+}
+
+// jobAction applies an action to the job in the route and redirects back to it.
+func (h *Admin) jobAction(ctx echo.Context, action func(ctx context.Context, id int) error, success, invalid string) error {
+	w := ctx.Response().Writer
+	r := ctx.Request()
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid job ID")
+	}
+
+	err = action(r.Context(), id)
+	switch {
+	case err == nil:
+		msg.Success(ctx, success)
+	case errors.Is(err, services.ErrJobStatus):
+		msg.Warning(ctx, invalid)
+	case errors.Is(err, services.ErrDuplicateJob):
+		msg.Warning(ctx, "Another job with the same unique key is already queued.")
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound)
+	default:
+		msg.Danger(ctx, "Failed to update job: "+err.Error())
+	}
+
+	h.Inertia.Redirect(w, r, ctx.Echo().Reverse(routenames.AdminJobShow, id))
+	return nil
+}
+
+// JobsPurge deletes completed jobs older than the submitted number of days.
+func (h *Admin) JobsPurge(ctx echo.Context) error {
+	w := ctx.Response().Writer
+	r := ctx.Request()
+	uri := ctx.Echo().Reverse(routenames.AdminJobs)
+
+	days, err := strconv.Atoi(ctx.FormValue("days"))
+	if err != nil || days < 0 {
+		msg.Danger(ctx, "Please enter a valid number of days.")
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	deleted, err := h.jobs.PurgeCompleted(r.Context(), time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		msg.Danger(ctx, "Failed to purge jobs: "+err.Error())
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	msg.Success(ctx, "Purged "+strconv.Itoa(deleted)+" completed jobs.")
+	h.Inertia.Redirect(w, r, uri)
+	return nil
+}
+
+// JobSchedules lists the recurring jobs along with the most recent job each one enqueued.
+func (h *Admin) JobSchedules(ctx echo.Context) error {
+	type scheduleRow struct {
+		services.ScheduleInfo
+		LastRun *ent.Job `json:"lastRun"`
+	}
+
+	schedules := h.jobs.Schedules()
+	rows := make([]scheduleRow, 0, len(schedules))
+	for _, s := range schedules {
+		last, err := h.orm.Job.Query().
+			Where(
+				job.Queue(s.Queue),
+				job.ScheduledForNotNil(),
+			).
+			Order(ent.Desc(job.FieldScheduledFor)).
+			First(ctx.Request().Context())
+		if err != nil && !ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusInternalServerError, err)
+		}
+
+		rows = append(rows, scheduleRow{
+			ScheduleInfo: s,
+			LastRun:      last,
+		})
+	}
+
+	err := h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Admin/Jobs/Schedules",
+		inertia.Props{
+			"schedules": rows,
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
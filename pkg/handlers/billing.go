@@ -1,9 +1,16 @@
 package handlers
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
 	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/subscription"
 	"github.com/occult/pagode/pkg/form"
 	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/msg"
@@ -13,9 +20,10 @@ import (
 )
 
 type Billing struct {
-	Inertia *inertia.Inertia
-	Payment *services.PaymentClient
-	Auth    *services.AuthClient
+	Inertia      *inertia.Inertia
+	Payment      *services.PaymentClient
+	Auth         *services.AuthClient
+	Entitlements *services.EntitlementsClient
 }
 
 func init() {
@@ -26,6 +34,7 @@ func (h *Billing) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.Payment = c.Payment
 	h.Auth = c.Auth
+	h.Entitlements = c.Entitlements
 	return nil
 }
 
@@ -35,9 +44,22 @@ func (h *Billing) Routes(g *echo.Group) {
 	
 	authGroup.GET("/billing", h.Page).Name = routenames.Billing
 	authGroup.POST("/billing/cancel", h.CancelSubscription).Name = routenames.BillingCancel
+	authGroup.GET("/billing/preview", h.Preview).Name = routenames.BillingPreview
+	authGroup.POST("/billing/change", h.ChangePlan).Name = routenames.BillingChange
+	authGroup.POST("/billing/change/cancel", h.CancelChange).Name = routenames.BillingChangeCancel
+	authGroup.POST("/billing/reactivate", h.Reactivate).Name = routenames.BillingReactivate
+	authGroup.GET("/billing/invoices/:invoice", h.Invoice).Name = routenames.BillingInvoice
+	authGroup.POST("/billing/invoices/sync", h.SyncInvoices).Name = routenames.BillingInvoicesSync
+	authGroup.POST("/billing/payouts", h.SetupPayouts).Name = routenames.BillingPayouts
+	authGroup.GET("/billing/payouts/return", h.PayoutsReturn).Name = routenames.BillingPayoutsReturn
 }
 
 func (h *Billing) Page(ctx echo.Context) error {
+	return h.render(ctx, nil)
+}
+
+// render renders the billing page with an optional preview of a plan change.
+func (h *Billing) render(ctx echo.Context, changePreview map[string]interface{}) error {
 	// Get current user
 	user, err := h.Auth.GetAuthenticatedUser(ctx)
 	if err != nil {
@@ -76,6 +98,94 @@ func (h *Billing) Page(ctx echo.Context) error {
 			"currency":            sub.Currency,
 			"interval":            string(sub.Interval),
 			"metadata":            sub.Metadata,
+			"priceId":             sub.PriceID,
+			"cancelAtPeriodEnd":   sub.CancelAtPeriodEnd,
+			"pendingPriceId":      sub.PendingPriceID,
+			"pendingChangeAt":     nil,
+		}
+		if sub.PendingPriceID != "" {
+			subscriptionData[i]["pendingChangeAt"] = sub.PendingChangeAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		subscriptionData[i]["trialEnd"] = nil
+		if sub.Status == subscription.StatusTrialing && !sub.TrialEnd.IsZero() {
+			subscriptionData[i]["trialEnd"] = sub.TrialEnd.Format("2006-01-02T15:04:05Z07:00")
+		}
+
+		// Show the discount applied through a promotion code
+		subscriptionData[i]["discount"] = nil
+		d, err := h.Payment.GetActiveDiscount(ctx.Request().Context(), sub, time.Now())
+		if err != nil {
+			return err
+		}
+		if d != nil {
+			discountData := map[string]interface{}{
+				"code":             d.Code,
+				"percentOff":       d.PercentOff,
+				"amountOff":        d.AmountOff,
+				"currency":         d.Currency,
+				"duration":         string(d.Duration),
+				"durationInMonths": d.DurationInMonths,
+				"endsAt":           nil,
+			}
+			if !d.EndsAt.IsZero() {
+				discountData["endsAt"] = d.EndsAt.Format("2006-01-02T15:04:05Z07:00")
+			}
+			subscriptionData[i]["discount"] = discountData
+		}
+	}
+
+	// List every price of the paid plans that subscriptions can be switched to
+	prices := []map[string]interface{}{}
+	for _, plan := range h.Entitlements.Plans() {
+		for _, price := range plan.Prices {
+			prices = append(prices, map[string]interface{}{
+				"planId":   plan.ID,
+				"planName": plan.Name,
+				"priceId":  price.ID,
+				"amount":   price.Amount,
+				"currency": price.Currency,
+				"interval": price.Interval,
+			})
+		}
+	}
+
+	// Get invoices
+	invoices, err := h.Payment.GetCustomerInvoices(ctx, paymentCustomer)
+	if err != nil {
+		// If no invoices found, continue with empty slice
+		invoices = []*ent.Invoice{}
+	}
+
+	// Convert invoices to the format expected by React
+	invoiceData := make([]map[string]interface{}, len(invoices))
+	for i, inv := range invoices {
+		invoiceData[i] = map[string]interface{}{
+			"id":           inv.ProviderInvoiceID,
+			"number":       inv.Number,
+			"status":       string(inv.Status),
+			"currency":     inv.Currency,
+			"subtotal":     inv.Subtotal,
+			"tax":          inv.Tax,
+			"total":        inv.Total,
+			"amountPaid":   inv.AmountPaid,
+			"periodStart":  inv.PeriodStart.Format("2006-01-02T15:04:05Z07:00"),
+			"periodEnd":    inv.PeriodEnd.Format("2006-01-02T15:04:05Z07:00"),
+			"issuedAt":     inv.IssuedAt.Format("2006-01-02T15:04:05Z07:00"),
+			"downloadable": inv.PdfLink != "" || inv.HostedLink != "",
+		}
+	}
+
+	// Get the account payments made to the user's forms are paid out to
+	paymentAccount, err := h.Payment.GetPaymentAccount(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	var paymentAccountData map[string]interface{}
+	if paymentAccount != nil {
+		paymentAccountData = map[string]interface{}{
+			"chargesEnabled":   paymentAccount.ChargesEnabled,
+			"detailsSubmitted": paymentAccount.DetailsSubmitted,
 		}
 	}
 
@@ -101,6 +211,10 @@ func (h *Billing) Page(ctx echo.Context) error {
 			"title":          "Billing & Subscription",
 			"subscriptions":  subscriptionData,
 			"paymentMethods": paymentMethodData,
+			"prices":         prices,
+			"invoices":       invoiceData,
+			"paymentAccount": paymentAccountData,
+			"changePreview":  changePreview,
 			"user":           user,
 			"form":           form.Get[CancelSubscriptionForm](ctx),
 		},
@@ -116,6 +230,8 @@ func (h *Billing) Page(ctx echo.Context) error {
 type CancelSubscriptionForm struct {
 	form.Submission
 	SubscriptionID string `form:"subscriptionId" validate:"required"`
+	// AtPeriodEnd keeps the subscription until the end of its current period instead of cancelling it right away
+	AtPeriodEnd bool `form:"atPeriodEnd"`
 }
 
 func (h *Billing) CancelSubscription(ctx echo.Context) error {
@@ -143,6 +259,21 @@ func (h *Billing) CancelSubscription(ctx echo.Context) error {
 		return fail(err, "Unable to get payment customer", h.Inertia, ctx)
 	}
 
+	if input.AtPeriodEnd {
+		sub, err := h.Payment.GetCustomerSubscription(ctx, paymentCustomer, input.SubscriptionID)
+		if err != nil {
+			return fail(err, "Unable to get subscription", h.Inertia, ctx)
+		}
+
+		sub, err = h.Payment.CancelAtPeriodEnd(ctx, sub)
+		if err != nil {
+			return fail(err, "Unable to cancel subscription", h.Inertia, ctx)
+		}
+
+		msg.Success(ctx, fmt.Sprintf("Your subscription will end on %s.", sub.CurrentPeriodEnd.Format("January 2, 2006")))
+		return h.Page(ctx)
+	}
+
 	// Cancel the subscription
 	err = h.Payment.CancelSubscription(ctx, paymentCustomer, input.SubscriptionID)
 	if err != nil {
@@ -154,4 +285,295 @@ func (h *Billing) CancelSubscription(ctx echo.Context) error {
 
 	// Return to billing page 
 	return h.Page(ctx)
-}
\ No newline at end of file
+}
+
+type ChangePlanForm struct {
+	form.Submission
+	SubscriptionID string `form:"subscriptionId" validate:"required"`
+	PriceID        string `form:"priceId" validate:"required"`
+	// ProrationDate is the Unix time the confirmed preview was prorated at
+	ProrationDate int64 `form:"prorationDate"`
+}
+
+// Preview renders the billing page with what switching a subscription to another price would cost.
+// Upgrades are charged the prorated difference right away, downgrades take effect at the end of the period.
+func (h *Billing) Preview(ctx echo.Context) error {
+	sub, price, err := h.changeTarget(ctx, ctx.QueryParam("subscriptionId"), ctx.QueryParam("priceId"))
+	if err != nil {
+		return fail(err, "Unable to preview plan change", h.Inertia, ctx)
+	}
+
+	upgrade := h.Entitlements.IsUpgrade(sub.PriceID, price.ID)
+	preview := map[string]interface{}{
+		"subscriptionId": sub.ProviderSubscriptionID,
+		"priceId":        price.ID,
+		"amount":         price.Amount,
+		"currency":       price.Currency,
+		"interval":       price.Interval,
+		"upgrade":        upgrade,
+		"amountDue":      int64(0),
+		"prorationDate":  nil,
+		"effectiveAt":    sub.CurrentPeriodEnd.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if upgrade {
+		proration, err := h.Payment.PreviewPriceChange(ctx, sub, price.ID)
+		if err != nil {
+			return fail(err, "Unable to preview plan change", h.Inertia, ctx)
+		}
+		preview["amountDue"] = proration.AmountDue
+		preview["currency"] = proration.Currency
+		preview["prorationDate"] = proration.ProrationDate.Unix()
+		preview["effectiveAt"] = proration.ProrationDate.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return h.render(ctx, preview)
+}
+
+// ChangePlan switches a subscription to another price. Upgrades are applied and charged right away,
+// downgrades are scheduled for the end of the current period.
+func (h *Billing) ChangePlan(ctx echo.Context) error {
+	var input ChangePlanForm
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	sub, price, err := h.changeTarget(ctx, input.SubscriptionID, input.PriceID)
+	if err != nil {
+		return fail(err, "Unable to change plan", h.Inertia, ctx)
+	}
+	plan, _, _ := h.Entitlements.PlanForPrice(price.ID)
+
+	if h.Entitlements.IsUpgrade(sub.PriceID, price.ID) {
+		var prorationDate time.Time
+		if input.ProrationDate > 0 {
+			prorationDate = time.Unix(input.ProrationDate, 0)
+		}
+
+		_, err = h.Payment.ChangePrice(ctx, sub, price.ID, prorationDate)
+		if err != nil {
+			return fail(err, "Unable to change plan", h.Inertia, ctx)
+		}
+
+		msg.Success(ctx, fmt.Sprintf("You are now on the %s plan!", plan.Name))
+		return h.Page(ctx)
+	}
+
+	sub, err = h.Payment.SchedulePriceChange(ctx, sub, price.ID)
+	if err != nil {
+		return fail(err, "Unable to change plan", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, fmt.Sprintf("You will switch to the %s plan on %s.", plan.Name, sub.PendingChangeAt.Format("January 2, 2006")))
+	return h.Page(ctx)
+}
+
+// CancelChange drops the plan change scheduled for a subscription.
+func (h *Billing) CancelChange(ctx echo.Context) error {
+	var input CancelSubscriptionForm
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	sub, err := h.customerSubscription(ctx, input.SubscriptionID)
+	if err != nil {
+		return fail(err, "Unable to get subscription", h.Inertia, ctx)
+	}
+
+	_, err = h.Payment.CancelScheduledChange(ctx, sub)
+	if err != nil {
+		return fail(err, "Unable to cancel plan change", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Your scheduled plan change was cancelled.")
+	return h.Page(ctx)
+}
+
+// Reactivate keeps a subscription which was set to cancel at the end of its period.
+func (h *Billing) Reactivate(ctx echo.Context) error {
+	var input CancelSubscriptionForm
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	sub, err := h.customerSubscription(ctx, input.SubscriptionID)
+	if err != nil {
+		return fail(err, "Unable to get subscription", h.Inertia, ctx)
+	}
+
+	_, err = h.Payment.Reactivate(ctx, sub)
+	if err != nil {
+		return fail(err, "Unable to reactivate subscription", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Your subscription was reactivated.")
+	return h.Page(ctx)
+}
+
+// Invoice redirects to the PDF of an invoice of the authenticated user, or to the page hosted by the provider
+// when there is no PDF.
+func (h *Billing) Invoice(ctx echo.Context) error {
+	user, err := h.Auth.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	paymentCustomer, err := h.Payment.CreateOrGetCustomer(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	inv, err := h.Payment.GetCustomerInvoice(ctx, paymentCustomer, ctx.Param("invoice"))
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "Invoice not found")
+	case err != nil:
+		return err
+	}
+
+	link := inv.PdfLink
+	if link == "" {
+		link = inv.HostedLink
+	}
+	if link == "" {
+		return echo.NewHTTPError(http.StatusNotFound, "This invoice has no document")
+	}
+
+	h.Inertia.Location(ctx.Response().Writer, ctx.Request(), link)
+	return nil
+}
+
+// SyncInvoices refreshes the invoices of the authenticated user from the provider.
+func (h *Billing) SyncInvoices(ctx echo.Context) error {
+	user, err := h.Auth.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return fail(err, "Unable to get authenticated user", h.Inertia, ctx)
+	}
+
+	paymentCustomer, err := h.Payment.CreateOrGetCustomer(ctx, user)
+	if err != nil {
+		return fail(err, "Unable to get payment customer", h.Inertia, ctx)
+	}
+
+	if err := h.Payment.SyncInvoices(ctx, paymentCustomer); err != nil {
+		return fail(err, "Unable to refresh invoices", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Your billing history is up to date.")
+	return h.Page(ctx)
+}
+
+// SetupPayouts sends the user to the provider's onboarding of the account payments made to their forms are
+// paid out to, creating the account first if needed.
+func (h *Billing) SetupPayouts(ctx echo.Context) error {
+	user, err := h.Auth.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return fail(err, "Unable to get authenticated user", h.Inertia, ctx)
+	}
+
+	host := h.Payment.GetConfig().App.Host
+	link, err := h.Payment.OnboardPaymentAccount(
+		ctx,
+		user,
+		host+ctx.Echo().Reverse(routenames.Billing),
+		host+ctx.Echo().Reverse(routenames.BillingPayoutsReturn),
+	)
+	if err != nil {
+		return fail(err, "Unable to set up payouts", h.Inertia, ctx)
+	}
+
+	h.Inertia.Location(ctx.Response().Writer, ctx.Request(), link)
+	return nil
+}
+
+// PayoutsReturn is where users return to from the provider's onboarding.
+func (h *Billing) PayoutsReturn(ctx echo.Context) error {
+	user, err := h.Auth.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return fail(err, "Unable to get authenticated user", h.Inertia, ctx)
+	}
+
+	paymentAccount, err := h.Payment.GetPaymentAccount(ctx, user)
+	if err != nil {
+		return fail(err, "Unable to get payment account", h.Inertia, ctx)
+	}
+	if paymentAccount == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Payouts are not set up")
+	}
+
+	paymentAccount, err = h.Payment.RefreshPaymentAccount(ctx, paymentAccount)
+	if err != nil {
+		return fail(err, "Unable to refresh payment account", h.Inertia, ctx)
+	}
+
+	if paymentAccount.ChargesEnabled {
+		msg.Success(ctx, "Payouts are set up. Your forms can now collect payments.")
+	} else {
+		msg.Warning(ctx, "Your payout setup isn't complete yet. Finish it to collect payments in your forms.")
+	}
+
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Billing))
+	return nil
+}
+
+// customerSubscription returns a subscription of the authenticated user.
+func (h *Billing) customerSubscription(ctx echo.Context, subscriptionID string) (*ent.Subscription, error) {
+	user, err := h.Auth.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentCustomer, err := h.Payment.CreateOrGetCustomer(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Payment.GetCustomerSubscription(ctx, paymentCustomer, subscriptionID)
+}
+
+// changeTarget returns a subscription of the authenticated user along with the catalog price it would be
+// switched to. Only active subscriptions which are not set to cancel can change plans, to prices billed in the
+// same currency.
+func (h *Billing) changeTarget(ctx echo.Context, subscriptionID, priceID string) (*ent.Subscription, config.PriceConfig, error) {
+	sub, err := h.customerSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, config.PriceConfig{}, err
+	}
+
+	_, price, ok := h.Entitlements.PlanForPrice(priceID)
+	switch {
+	case !ok:
+		return nil, config.PriceConfig{}, fmt.Errorf("price %q is not in the plan catalog", priceID)
+	case price.ID == sub.PriceID:
+		return nil, config.PriceConfig{}, fmt.Errorf("subscription is already on price %q", priceID)
+	case sub.Status != subscription.StatusActive && sub.Status != subscription.StatusTrialing:
+		return nil, config.PriceConfig{}, fmt.Errorf("subscription is %s", sub.Status)
+	case sub.CancelAtPeriodEnd:
+		return nil, config.PriceConfig{}, fmt.Errorf("subscription is set to cancel at the end of its period")
+	case !strings.EqualFold(price.Currency, sub.Currency):
+		return nil, config.PriceConfig{}, fmt.Errorf("subscription is billed in %s", strings.ToUpper(sub.Currency))
+	}
+
+	return sub, price, nil
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBilling__PageAndCancel(t *testing.T) {
+	u := createTestUser(t)
+	h := new(Billing)
+	require.NoError(t, h.Init(c))
+
+	ctx, _ := inertiaContext(t, u, http.MethodGet, "/billing", nil)
+	customer, err := c.Payment.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	_, err = c.Payment.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_mastercard", true)
+	require.NoError(t, err)
+	sub, err := c.Payment.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &services.CreateSubscriptionParams{})
+	require.NoError(t, err)
+
+	// Renewals are synced through webhook events.
+	fake := c.Payment.Provider().(*services.FakeProvider)
+	_, err = fake.Renew(sub.ProviderSubscriptionID)
+	require.NoError(t, err)
+	for _, event := range fake.Events() {
+		_, err = c.Payment.ProcessWebhookEvent(t.Context(), event)
+		require.NoError(t, err)
+	}
+
+	ctx, rec := inertiaContext(t, u, http.MethodGet, "/billing", nil)
+	require.NoError(t, h.Page(ctx))
+	props := inertiaProps(t, rec)
+
+	require.Len(t, props["subscriptions"], 1)
+	renewed := props["subscriptions"].([]any)[0].(map[string]any)
+	assert.Equal(t, "active", renewed["status"])
+	assert.Equal(t, sub.CurrentPeriodEnd.UTC().Format("2006-01-02"), renewed["currentPeriodStart"].(string)[:10])
+
+	require.Len(t, props["paymentMethods"], 1)
+	method := props["paymentMethods"].([]any)[0].(map[string]any)
+	assert.Equal(t, "mastercard", method["brand"])
+	assert.Equal(t, "4444", method["lastFour"])
+	assert.Equal(t, true, method["isDefault"])
+
+	// Invoices of the first period and the renewal, newest first.
+	require.Len(t, props["invoices"], 2)
+	invoice := props["invoices"].([]any)[0].(map[string]any)
+	assert.Equal(t, "paid", invoice["status"])
+	assert.EqualValues(t, sub.Amount, invoice["total"])
+	assert.Equal(t, false, invoice["downloadable"])
+
+	// Fake invoices have no documents to download.
+	ctx, _ = inertiaContext(t, u, http.MethodGet, "/billing/invoices/"+invoice["id"].(string), nil)
+	ctx.SetParamNames("invoice")
+	ctx.SetParamValues(invoice["id"].(string))
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, h.Invoice(ctx), &httpErr)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+
+	ctx, _ = inertiaContext(t, u, http.MethodPost, "/billing/cancel", url.Values{
+		"subscriptionId": {sub.ProviderSubscriptionID},
+	})
+	require.NoError(t, h.CancelSubscription(ctx))
+
+	sub, err = c.ORM.Subscription.Get(t.Context(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusCanceled, sub.Status)
+}
+
+func TestBilling__CancelAtPeriodEndAndReactivate(t *testing.T) {
+	u := createTestUser(t)
+	h := new(Billing)
+	require.NoError(t, h.Init(c))
+
+	ctx, _ := inertiaContext(t, u, http.MethodGet, "/billing", nil)
+	customer, err := c.Payment.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	_, err = c.Payment.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_visa", true)
+	require.NoError(t, err)
+	sub, err := c.Payment.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &services.CreateSubscriptionParams{})
+	require.NoError(t, err)
+
+	ctx, _ = inertiaContext(t, u, http.MethodPost, "/billing/cancel", url.Values{
+		"subscriptionId": {sub.ProviderSubscriptionID},
+		"atPeriodEnd":    {"true"},
+	})
+	require.NoError(t, h.CancelSubscription(ctx))
+
+	ctx, rec := inertiaContext(t, u, http.MethodGet, "/billing", nil)
+	require.NoError(t, h.Page(ctx))
+	props := inertiaProps(t, rec)
+	canceling := props["subscriptions"].([]any)[0].(map[string]any)
+	assert.Equal(t, "active", canceling["status"])
+	assert.Equal(t, true, canceling["cancelAtPeriodEnd"])
+
+	// Plans can't be changed while the subscription is set to cancel.
+	ctx, rec = inertiaContext(t, u, http.MethodGet, "/billing/preview?subscriptionId="+sub.ProviderSubscriptionID+"&priceId=price_your_stripe_price_id_here", nil)
+	require.NoError(t, h.Preview(ctx))
+	assert.Equal(t, http.StatusFound, rec.Code)
+
+	ctx, _ = inertiaContext(t, u, http.MethodPost, "/billing/reactivate", url.Values{
+		"subscriptionId": {sub.ProviderSubscriptionID},
+	})
+	require.NoError(t, h.Reactivate(ctx))
+
+	sub, err = c.ORM.Subscription.Get(t.Context(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusActive, sub.Status)
+	assert.False(t, sub.CancelAtPeriodEnd)
+}
+
+func TestBilling__Payouts(t *testing.T) {
+	u := createTestUser(t)
+	h := new(Billing)
+	require.NoError(t, h.Init(c))
+
+	ctx, rec := inertiaContext(t, u, http.MethodGet, "/billing", nil)
+	require.NoError(t, h.Page(ctx))
+	assert.Nil(t, inertiaProps(t, rec)["paymentAccount"])
+
+	// The fake provider onboards the account right away and sends the user back.
+	ctx, rec = inertiaContext(t, u, http.MethodPost, "/billing/payouts", nil)
+	require.NoError(t, h.SetupPayouts(ctx))
+	assert.Contains(t, rec.Header().Get("X-Inertia-Location"), "/billing/payouts/return")
+
+	ctx, _ = inertiaContext(t, u, http.MethodGet, "/billing/payouts/return", nil)
+	require.NoError(t, h.PayoutsReturn(ctx))
+
+	ctx, rec = inertiaContext(t, u, http.MethodGet, "/billing", nil)
+	require.NoError(t, h.Page(ctx))
+	account := inertiaProps(t, rec)["paymentAccount"].(map[string]any)
+	assert.Equal(t, true, account["chargesEnabled"])
+	assert.Equal(t, true, account["detailsSubmitted"])
+}
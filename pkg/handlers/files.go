@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/msg"
 	"github.com/occult/pagode/pkg/routenames"
@@ -16,6 +19,8 @@ import (
 
 type Files struct {
 	files   afero.Fs
+	orm     *ent.Client
+	usage   *services.UsageClient
 	Inertia *inertia.Inertia
 }
 
@@ -25,6 +30,8 @@ func init() {
 
 func (h *Files) Init(c *services.Container) error {
 	h.files = c.Files
+	h.orm = c.ORM
+	h.usage = c.Usage
 	h.Inertia = c.Inertia
 	return nil
 }
@@ -69,6 +76,16 @@ func (h *Files) Submit(ctx echo.Context) error {
 		return h.UploadFilePage(ctx)
 	}
 
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	allowed, _, err := h.usage.Allows(ctx.Request().Context(), user, services.MetricStorage, file.Size)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		msg.Danger(ctx, "This file does not fit in the storage included in your plan.")
+		return h.UploadFilePage(ctx)
+	}
+
 	src, err := file.Open()
 	if err != nil {
 		return err
@@ -85,6 +102,26 @@ func (h *Files) Submit(ctx echo.Context) error {
 		return err
 	}
 
+	status, _, err := h.usage.Consume(ctx.Request().Context(), h.orm, user, services.MetricStorage, file.Size)
+	if errors.Is(err, services.ErrUsageLimit) {
+		// Other files were uploaded since the limit was checked.
+		dst.Close()
+		if err := h.files.Remove(file.Filename); err != nil {
+			return err
+		}
+		msg.Danger(ctx, "This file does not fit in the storage included in your plan.")
+		return h.UploadFilePage(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	switch status.Threshold {
+	case services.ThresholdLimit:
+		msg.Warning(ctx, "You reached the storage limit of your plan.")
+	case services.ThresholdWarning:
+		msg.Warning(ctx, "You are close to the storage limit of your plan.")
+	}
+
 	msg.Success(ctx, fmt.Sprintf("%s was uploaded successfully.", file.Filename))
 
 	h.Inertia.Redirect(
@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	goctx "context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/mail"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,21 +16,34 @@ import (
 	"github.com/occult/pagode/config"
 	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/question"
 	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsenote"
+	"github.com/occult/pagode/ent/responsetag"
+	"github.com/occult/pagode/pkg/answers"
 	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/currency"
 	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/msg"
+	"github.com/occult/pagode/pkg/privacy"
+	"github.com/occult/pagode/pkg/reports"
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tasks"
 
 	inertia "github.com/romsar/gonertia/v2"
 )
 
 type Forms struct {
-	config  *config.Config
-	orm     *ent.Client
-	Inertia *inertia.Inertia
+	config     *config.Config
+	orm        *ent.Client
+	encryption *services.EncryptionClient
+	jobs       *services.JobWorker
+	usage      *services.UsageClient
+	payment    *services.PaymentClient
+	Inertia    *inertia.Inertia
 }
 
 func init() {
@@ -37,6 +53,10 @@ func init() {
 func (h *Forms) Init(c *services.Container) error {
 	h.config = c.Config
 	h.orm = c.ORM
+	h.encryption = c.Encryption
+	h.jobs = c.Jobs
+	h.usage = c.Usage
+	h.payment = c.Payment
 	h.Inertia = c.Inertia
 	return nil
 }
@@ -46,11 +66,16 @@ func (h *Forms) Routes(g *echo.Group) {
 	g.GET("/:identifier/:slug", h.View).Name = routenames.FormsView
 	g.POST("/:identifier/:slug", h.Submit).Name = routenames.FormsSubmit
 	g.GET("/:identifier/:slug/thank-you", h.ThankYou).Name = routenames.FormsThankYou
+	g.GET("/:identifier/:slug/payments/:payment", h.Payment).Name = routenames.FormsPayment
+	g.POST("/:identifier/:slug/payments/:payment", h.Pay).Name = routenames.FormsPay
 
 	// Authenticated routes
 	formsGroup := g.Group("/forms", middleware.RequireAuthentication)
 	formsGroup.GET("", h.Index).Name = routenames.Forms
 	formsGroup.GET("/create", h.Create).Name = routenames.FormsCreate
+	formsGroup.GET("/respondents", h.Respondents).Name = routenames.FormsRespondents
+	formsGroup.GET("/respondents/export", h.RespondentsExport).Name = routenames.FormsRespondentsExport
+	formsGroup.POST("/respondents/erase", h.RespondentsErase).Name = routenames.FormsRespondentsErase
 	formsGroup.POST("", h.Store).Name = routenames.FormsStore
 	formsGroup.GET("/:id/edit", h.Edit).Name = routenames.FormsEdit
 	formsGroup.POST("/:id", h.Update).Name = routenames.FormsUpdate
@@ -59,6 +84,12 @@ func (h *Forms) Routes(g *echo.Group) {
 	formsGroup.GET("/:id/responses", h.Responses).Name = routenames.FormsResponses
 	formsGroup.GET("/:id/responses/:responseId", h.ResponseShow).Name = routenames.FormsResponsesShow
 	formsGroup.GET("/:id/responses/export", h.ResponsesExport).Name = routenames.FormsResponsesExport
+	formsGroup.POST("/:id/responses/bulk", h.ResponsesBulk).Name = routenames.FormsResponsesBulk
+	formsGroup.POST("/:id/responses/:responseId", h.ResponseUpdate).Name = routenames.FormsResponsesUpdate
+	formsGroup.POST("/:id/responses/:responseId/status", h.ResponseStatus).Name = routenames.FormsResponsesStatus
+	formsGroup.POST("/:id/responses/:responseId/notes", h.ResponseNote).Name = routenames.FormsResponsesNotes
+	formsGroup.POST("/:id/responses/:responseId/tags", h.ResponseTags).Name = routenames.FormsResponsesTags
+	formsGroup.DELETE("/:id/responses/:responseId", h.ResponseDelete).Name = routenames.FormsResponsesDelete
 }
 
 func (h *Forms) Index(ctx echo.Context) error {
@@ -122,9 +153,24 @@ func (h *Forms) Store(ctx echo.Context) error {
 		return nil
 	}
 
+	allowed, status, err := h.usage.Allows(ctx.Request().Context(), user, services.MetricForms, 1)
+	if err != nil {
+		return fail(err, "failed to check usage", h.Inertia, ctx)
+	}
+	if !allowed {
+		msg.Danger(ctx, fmt.Sprintf("Your plan includes up to %d forms. Upgrade your plan to create more.", status.Limit))
+		h.Inertia.Redirect(w, r, uriCreate)
+		return nil
+	}
+
 	slug := generateSlug(title)
 
-	formCreate := h.orm.Form.
+	tx, err := h.orm.Tx(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to start transaction", h.Inertia, ctx)
+	}
+
+	formCreate := tx.Form.
 		Create().
 		SetTitle(title).
 		SetSlug(slug).
@@ -146,15 +192,41 @@ func (h *Forms) Store(ctx echo.Context) error {
 				}
 			}
 			if err != nil {
+				tx.Rollback()
 				msg.Danger(ctx, "A form with this title already exists. Please try a different title.")
 				h.Inertia.Redirect(w, r, r.URL.Path)
 				return nil
 			}
 		} else {
+			tx.Rollback()
 			return fail(err, "failed to create form", h.Inertia, ctx)
 		}
 	}
 
+	status, crossed, err := h.usage.Consume(ctx.Request().Context(), tx.Client(), user, services.MetricForms, 1)
+	if errors.Is(err, services.ErrUsageLimit) {
+		// Another form was created since the limit was checked.
+		tx.Rollback()
+		msg.Danger(ctx, fmt.Sprintf("Your plan includes up to %d forms. Upgrade your plan to create more.", status.Limit))
+		h.Inertia.Redirect(w, r, uriCreate)
+		return nil
+	}
+	if err != nil {
+		tx.Rollback()
+		return fail(err, "failed to record usage", h.Inertia, ctx)
+	}
+	if err := h.notifyUsage(ctx.Request().Context(), tx, user.ID, status, crossed); err != nil {
+		tx.Rollback()
+		return fail(err, "failed to enqueue usage notification", h.Inertia, ctx)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fail(err, "failed to commit transaction", h.Inertia, ctx)
+	}
+
+	if status.Threshold != services.ThresholdNone {
+		msg.Warning(ctx, fmt.Sprintf("You are using %d of the %d forms included in your plan.", status.Used, status.Limit))
+	}
 	msg.Success(ctx, "Form created successfully!")
 	h.Inertia.Redirect(w, r, fmt.Sprintf("/forms/%d/edit", createdForm.ID))
 	return nil
@@ -305,6 +377,25 @@ func (h *Forms) Update(ctx echo.Context) error {
 		update.SetDisplayMode(form.DisplayMode(displayMode))
 	}
 
+	metadataStorage := ctx.FormValue("metadata_storage")
+	if metadataStorage != "" {
+		update.SetMetadataStorage(form.MetadataStorage(metadataStorage))
+	}
+
+	notifyStr := ctx.FormValue("notify_on_response")
+	if notifyStr != "" {
+		update.SetNotifyOnResponse(notifyStr == "1" || notifyStr == "true")
+	}
+
+	retentionDays := ctx.FormValue("retention_days")
+	if retentionDays != "" {
+		days, err := strconv.Atoi(retentionDays)
+		if err != nil {
+			return fail(err, "invalid retention period", h.Inertia, ctx)
+		}
+		update.SetRetentionDays(days)
+	}
+
 	_, err = update.Save(ctx.Request().Context())
 	if err != nil {
 		return fail(err, "failed to update form settings", h.Inertia, ctx)
@@ -339,12 +430,14 @@ func (h *Forms) Update(ctx echo.Context) error {
 		qDescription, _ := q["description"].(string)
 		qPlaceholder, _ := q["placeholder"].(string)
 		qRequired, _ := q["required"].(bool)
+		qSensitive, _ := q["sensitive"].(bool)
 		qOrder, _ := q["order"].(float64)
 
 		create := tx.Question.Create().
 			SetType(question.Type(qType)).
 			SetTitle(qTitle).
 			SetRequired(qRequired).
+			SetSensitive(qSensitive).
 			SetOrder(int(qOrder)).
 			SetFormID(formID)
 
@@ -479,35 +572,83 @@ func (h *Forms) Submit(ctx echo.Context) error {
 		})
 	}
 
-	var answers map[string]interface{}
-	if err := json.Unmarshal([]byte(answersJSON), &answers); err != nil {
+	var submitted map[string]interface{}
+	if err := json.Unmarshal([]byte(answersJSON), &submitted); err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{
 			"error": "Invalid answers format",
 		})
 	}
 
+	allowed, _, err := h.usage.Allows(ctx.Request().Context(), foundUser, services.MetricResponses, 1)
+	if err != nil {
+		return fail(err, "failed to check usage", h.Inertia, ctx)
+	}
+	if !allowed {
+		return ctx.JSON(http.StatusForbidden, map[string]string{
+			"error": "This form is not accepting responses right now",
+		})
+	}
+
+	paid, total, chargedCurrency, err := paymentAnswers(formData.Edges.Questions, submitted, h.config.Payment.Stripe.Currency)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	var account *ent.PaymentAccount
+	if total > 0 {
+		account, err = h.payment.GetPaymentAccount(ctx, foundUser)
+		if err != nil {
+			return fail(err, "failed to load payment account", h.Inertia, ctx)
+		}
+		if account == nil || !account.ChargesEnabled {
+			return ctx.JSON(http.StatusForbidden, map[string]string{
+				"error": "This form is not accepting payments right now",
+			})
+		}
+	}
+
 	tx, err := h.orm.Tx(ctx.Request().Context())
 	if err != nil {
 		return fail(err, "failed to start transaction", h.Inertia, ctx)
 	}
 
-	ipAddress := ctx.RealIP()
-	userAgent := ctx.Request().UserAgent()
+	ipAddress, userAgent := privacy.RespondentMetadata(
+		formData.MetadataStorage,
+		ctx.RealIP(),
+		ctx.Request().UserAgent(),
+		h.config.App.EncryptionKey,
+	)
 
 	response, err := tx.Response.Create().
 		SetFormID(formData.ID).
 		SetIPAddress(ipAddress).
 		SetUserAgent(userAgent).
-		SetCompleted(true).
+		// Responses to paid forms are completed once the payment succeeds.
+		SetCompleted(total == 0).
 		Save(ctx.Request().Context())
 	if err != nil {
 		tx.Rollback()
 		return fail(err, "failed to create response", h.Inertia, ctx)
 	}
 
+	var stored int64
 	for _, q := range formData.Edges.Questions {
-		answerValue, ok := answers[fmt.Sprintf("%d", q.ID)]
-		if !ok || answerValue == nil {
+		var answerStr string
+		if q.Type == question.TypePayment {
+			answerStr = paid[q.ID]
+		} else {
+			answerStr, err = answers.Normalize(q, submitted[fmt.Sprintf("%d", q.ID)])
+			if err != nil {
+				tx.Rollback()
+				return ctx.JSON(http.StatusBadRequest, map[string]string{
+					"error": err.Error(),
+				})
+			}
+		}
+
+		if answerStr == "" {
 			if q.Required {
 				tx.Rollback()
 				return ctx.JSON(http.StatusBadRequest, map[string]string{
@@ -517,15 +658,12 @@ func (h *Forms) Submit(ctx echo.Context) error {
 			continue
 		}
 
-		var answerStr string
-		switch v := answerValue.(type) {
-		case string:
-			answerStr = v
-		case []interface{}:
-			bytes, _ := json.Marshal(v)
-			answerStr = string(bytes)
-		default:
-			answerStr = fmt.Sprintf("%v", v)
+		if q.Sensitive {
+			answerStr, err = h.encryption.Encrypt(answerStr)
+			if err != nil {
+				tx.Rollback()
+				return fail(err, "failed to encrypt answer", h.Inertia, ctx)
+			}
 		}
 
 		_, err = tx.Answer.Create().
@@ -537,17 +675,220 @@ func (h *Forms) Submit(ctx echo.Context) error {
 			tx.Rollback()
 			return fail(err, "failed to save answer", h.Inertia, ctx)
 		}
+		stored += int64(len(answerStr))
+	}
+
+	// Recorded within the transaction so usage always matches the responses which were saved.
+	// The response limit is enforced by recording it, as other responses may have been received since it was checked.
+	// Paid responses are recorded and notified about once they are paid, see PaymentClient.completeResponse.
+	usage := map[services.Metric]int64{
+		services.MetricResponses: 1,
+		services.MetricStorage:   stored,
+	}
+	if total > 0 {
+		usage = nil
+	}
+	for metric, amount := range usage {
+		record := h.usage.Record
+		if metric == services.MetricResponses {
+			record = h.usage.Consume
+		}
+		status, crossed, err := record(ctx.Request().Context(), tx.Client(), foundUser, metric, amount)
+		if errors.Is(err, services.ErrUsageLimit) {
+			tx.Rollback()
+			return ctx.JSON(http.StatusForbidden, map[string]string{
+				"error": "This form is not accepting responses right now",
+			})
+		}
+		if err != nil {
+			tx.Rollback()
+			return fail(err, "failed to record usage", h.Inertia, ctx)
+		}
+		if err := h.notifyUsage(ctx.Request().Context(), tx, foundUser.ID, status, crossed); err != nil {
+			tx.Rollback()
+			return fail(err, "failed to enqueue usage notification", h.Inertia, ctx)
+		}
+	}
+
+	// Enqueued within the transaction so the owner is only notified about responses which were saved.
+	if formData.NotifyOnResponse && total == 0 {
+		err = h.jobs.EnqueueTxJSON(ctx.Request().Context(), tx, "notify_new_response", tasks.NotifyNewResponsePayload{
+			ResponseID: response.ID,
+		})
+		if err != nil {
+			tx.Rollback()
+			return fail(err, "failed to enqueue response notification", h.Inertia, ctx)
+		}
+	}
+
+	// Created last so the respondent isn't asked to pay for a response which failed to be saved.
+	var payment *ent.PaymentIntent
+	if total > 0 {
+		payment, err = h.payment.CreateResponsePayment(ctx.Request().Context(), tx.Client(), account, response, total, chargedCurrency, formData.Title)
+		if err != nil {
+			tx.Rollback()
+			return fail(err, "failed to create payment", h.Inertia, ctx)
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
+		// The payment intent can't be paid without its response, so it isn't left open with the provider.
+		if payment != nil {
+			if cerr := h.payment.CancelResponsePayment(ctx.Request().Context(), payment); cerr != nil {
+				err = fmt.Errorf("%w: canceling payment: %v", err, cerr)
+			}
+		}
 		return fail(err, "failed to commit transaction", h.Inertia, ctx)
 	}
 
-	ctx.Response().Header().Set("Location", fmt.Sprintf("/%s/%s/thank-you", identifier, slug))
+	location := fmt.Sprintf("/%s/%s/thank-you", identifier, slug)
+	if payment != nil {
+		location = fmt.Sprintf("/%s/%s/payments/%s", identifier, slug, payment.ProviderPaymentIntentID)
+	}
+
+	ctx.Response().Header().Set("Location", location)
 	ctx.Response().WriteHeader(http.StatusSeeOther)
 	return nil
 }
 
+// paymentAnswers computes the amounts charged by the payment questions of a form for the submitted answers.
+// The answers to store are returned keyed by question ID, along with the total to charge and its currency.
+func paymentAnswers(questions []*ent.Question, submitted map[string]interface{}, defaultCurrency string) (map[int]string, int64, string, error) {
+	paid := make(map[int]string)
+	var total int64
+	var chargedCurrency string
+
+	for _, q := range questions {
+		if q.Type != question.TypePayment {
+			continue
+		}
+
+		p := answers.PaymentOf(q, defaultCurrency)
+		amount, err := p.Total(submitted[fmt.Sprintf("%d", q.ID)])
+		if err != nil {
+			return nil, 0, "", err
+		}
+		if amount == 0 {
+			continue
+		}
+
+		if chargedCurrency != "" && p.Currency != chargedCurrency {
+			return nil, 0, "", fmt.Errorf("payment for '%s' must be in %s", q.Title, strings.ToUpper(chargedCurrency))
+		}
+		chargedCurrency = p.Currency
+		if amount > answers.MaxPaymentTotal-total {
+			return nil, 0, "", fmt.Errorf("the total must be at most %s", currency.Format(answers.MaxPaymentTotal, p.Currency))
+		}
+		total += amount
+		paid[q.ID] = currency.Format(amount, p.Currency)
+	}
+
+	return paid, total, chargedCurrency, nil
+}
+
+// Payment renders the page where respondents pay for their response to a paid form.
+func (h *Forms) Payment(ctx echo.Context) error {
+	formData, payment, err := h.loadResponsePayment(ctx)
+	if err != nil {
+		return err
+	}
+
+	if payment.Status == paymentintent.StatusSucceeded {
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), fmt.Sprintf("/%s/%s/thank-you", ctx.Param("identifier"), formData.Slug))
+		return nil
+	}
+
+	return h.renderPayment(ctx, formData, payment)
+}
+
+// Pay charges the payment of a response to a paid form, which completes the response once it succeeds.
+func (h *Forms) Pay(ctx echo.Context) error {
+	formData, payment, err := h.loadResponsePayment(ctx)
+	if err != nil {
+		return err
+	}
+
+	paymentMethodID := ctx.FormValue("paymentMethodId")
+	if paymentMethodID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Payment method is required")
+	}
+
+	if payment.Status != paymentintent.StatusSucceeded {
+		payment, err = h.payment.ConfirmPaymentIntent(ctx, payment, paymentMethodID)
+		if err != nil {
+			msg.Danger(ctx, fmt.Sprintf("Payment failed: %v", err))
+			h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Request().URL.Path)
+			return nil
+		}
+	}
+
+	if payment.Status != paymentintent.StatusSucceeded {
+		msg.Warning(ctx, "Your payment is pending. Your response will be submitted as soon as it completes.")
+		return h.renderPayment(ctx, formData, payment)
+	}
+
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), fmt.Sprintf("/%s/%s/thank-you", ctx.Param("identifier"), formData.Slug))
+	return nil
+}
+
+// loadResponsePayment loads the payment in the path along with the form it was made to, which must match the
+// form in the path.
+func (h *Forms) loadResponsePayment(ctx echo.Context) (*ent.Form, *ent.PaymentIntent, error) {
+	payment, err := h.orm.PaymentIntent.Query().
+		Where(paymentintent.ProviderPaymentIntentID(ctx.Param("payment"))).
+		WithResponse(func(q *ent.ResponseQuery) {
+			q.WithForm(func(q *ent.FormQuery) {
+				q.WithOwner()
+			})
+		}).
+		Only(ctx.Request().Context())
+	switch {
+	case ent.IsNotFound(err):
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, "Payment not found")
+	case err != nil:
+		return nil, nil, fail(err, "failed to load payment", h.Inertia, ctx)
+	case payment.Edges.Response == nil:
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, "Payment not found")
+	}
+
+	formData := payment.Edges.Response.Edges.Form
+	if formData.Slug != ctx.Param("slug") || getUserIdentifier(formData.Edges.Owner) != ctx.Param("identifier") {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, "Payment not found")
+	}
+
+	return formData, payment, nil
+}
+
+func (h *Forms) renderPayment(ctx echo.Context, formData *ent.Form, payment *ent.PaymentIntent) error {
+	err := h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Forms/Payment",
+		inertia.Props{
+			"formTitle": formData.Title,
+			"payment": map[string]interface{}{
+				"id":       payment.ProviderPaymentIntentID,
+				"status":   payment.Status,
+				"amount":   payment.Amount,
+				"currency": payment.Currency,
+			},
+			"stripePublishableKey": h.config.Payment.Stripe.PublishableKey,
+			"paymentProvider":      h.config.Payment.Provider,
+			"brandColors": map[string]string{
+				"button":     formData.Edges.Owner.BrandButtonColor,
+				"background": formData.Edges.Owner.BrandBackgroundColor,
+				"text":       formData.Edges.Owner.BrandTextColor,
+			},
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
 func (h *Forms) ThankYou(ctx echo.Context) error {
 	identifier := ctx.Param("identifier")
 	slug := ctx.Param("slug")
@@ -689,17 +1030,54 @@ func (h *Forms) Responses(ctx echo.Context) error {
 		return nil
 	}
 
-	responses, err := h.orm.Response.Query().
-		Where(response.HasFormWith(form.ID(formID))).
+	status := ctx.QueryParam("status")
+	tag := ctx.QueryParam("tag")
+
+	query := h.orm.Response.Query().
+		Where(response.HasFormWith(form.ID(formID)))
+
+	switch {
+	case status == "all":
+	case status != "":
+		if err := response.StatusValidator(response.Status(status)); err != nil {
+			return fail(err, "invalid status filter", h.Inertia, ctx)
+		}
+		query = query.Where(response.StatusEQ(response.Status(status)))
+	default:
+		// Archived responses are hidden unless explicitly requested.
+		query = query.Where(response.StatusNEQ(response.StatusArchived))
+	}
+
+	if tag != "" {
+		query = query.Where(response.HasTagsWith(responsetag.Name(tag)))
+	}
+
+	responses, err := query.
 		WithAnswers(func(q *ent.AnswerQuery) {
 			q.WithQuestion()
 		}).
+		WithTags().
+		WithPayment().
 		Order(ent.Desc("submitted_at")).
 		All(ctx.Request().Context())
 	if err != nil {
 		return fail(err, "failed to fetch responses", h.Inertia, ctx)
 	}
 
+	for _, r := range responses {
+		if err := h.encryption.DecryptAnswers(r.Edges.Answers); err != nil {
+			return fail(err, "failed to decrypt answers", h.Inertia, ctx)
+		}
+	}
+
+	tags, err := h.orm.ResponseTag.Query().
+		Where(responsetag.UserID(user.ID)).
+		Order(ent.Asc(responsetag.FieldName)).
+		All(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to fetch tags", h.Inertia, ctx)
+	}
+
 	totalResponses := len(responses)
 	completedResponses := 0
 	for _, r := range responses {
@@ -723,6 +1101,11 @@ func (h *Forms) Responses(ctx echo.Context) error {
 			"totalResponses": totalResponses,
 			"completionRate": completionRate,
 			"userIdentifier": getUserIdentifier(user),
+			"tags":           tags,
+			"filters": map[string]string{
+				"status": status,
+				"tag":    tag,
+			},
 		},
 	)
 	if err != nil {
@@ -768,11 +1151,31 @@ func (h *Forms) ResponseShow(ctx echo.Context) error {
 		WithAnswers(func(q *ent.AnswerQuery) {
 			q.WithQuestion()
 		}).
+		WithTags().
+		WithPayment().
+		WithNotes(func(q *ent.ResponseNoteQuery) {
+			q.WithAuthor().Order(ent.Desc(responsenote.FieldCreatedAt))
+		}).
+		WithActivities(func(q *ent.ResponseActivityQuery) {
+			q.WithActor().Order(ent.Desc(responseactivity.FieldCreatedAt))
+		}).
 		Only(ctx.Request().Context())
 	if err != nil {
 		return fail(err, "failed to fetch response", h.Inertia, ctx)
 	}
 
+	if err := h.encryption.DecryptAnswers(responseData.Edges.Answers); err != nil {
+		return fail(err, "failed to decrypt answers", h.Inertia, ctx)
+	}
+
+	tags, err := h.orm.ResponseTag.Query().
+		Where(responsetag.UserID(user.ID)).
+		Order(ent.Asc(responsetag.FieldName)).
+		All(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to fetch tags", h.Inertia, ctx)
+	}
+
 	err = h.Inertia.Render(
 		ctx.Response().Writer,
 		ctx.Request(),
@@ -780,6 +1183,13 @@ func (h *Forms) ResponseShow(ctx echo.Context) error {
 		inertia.Props{
 			"form":     formData,
 			"response": responseData,
+			"tags":     tags,
+			"statuses": []response.Status{
+				response.StatusNew,
+				response.StatusInProgress,
+				response.StatusDone,
+				response.StatusArchived,
+			},
 		},
 	)
 	if err != nil {
@@ -825,38 +1235,348 @@ func (h *Forms) ResponsesExport(ctx echo.Context) error {
 		return fail(err, "failed to fetch responses", h.Inertia, ctx)
 	}
 
-	csv := "Submitted At,IP Address,User Agent,Completed"
-	for _, q := range formData.Edges.Questions {
-		csv += fmt.Sprintf(",\"%s\"", strings.ReplaceAll(q.Title, "\"", "\"\""))
+	for _, resp := range responses {
+		if err := h.encryption.DecryptAnswers(resp.Edges.Answers); err != nil {
+			return fail(err, "failed to decrypt answers", h.Inertia, ctx)
+		}
 	}
-	csv += "\n"
 
-	for _, resp := range responses {
-		row := fmt.Sprintf("%s,\"%s\",\"%s\",%t",
-			resp.SubmittedAt.Format("2006-01-02 15:04:05"),
-			resp.IPAddress,
-			resp.UserAgent,
-			resp.Completed,
+	csv, err := reports.ResponsesCSV(formData.Edges.Questions, responses)
+	if err != nil {
+		return fail(err, "failed to export responses", h.Inertia, ctx)
+	}
+
+	ctx.Response().Header().Set("Content-Type", "text/csv")
+	ctx.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s-responses.csv\"", formData.Slug))
+	ctx.Response().WriteHeader(http.StatusOK)
+	ctx.Response().Write(csv)
+
+	return nil
+}
+
+// loadOwnedResponse loads the form and response identified by the route parameters.
+// A nil response is returned if the form does not belong to the user, in which case the
+// request has already been redirected.
+func (h *Forms) loadOwnedResponse(ctx echo.Context, user *ent.User) (*ent.Form, *ent.Response, error) {
+	formID, err := parseID(ctx.Param("id"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	respID, err := parseID(ctx.Param("responseId"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	formData, err := h.orm.Form.Query().
+		Where(form.ID(formID)).
+		WithOwner().
+		WithQuestions().
+		Only(ctx.Request().Context())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if formData.Edges.Owner.ID != user.ID {
+		msg.Danger(ctx, "Unauthorized access")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Forms))
+		return nil, nil, nil
+	}
+
+	responseData, err := h.orm.Response.Query().
+		Where(response.ID(respID), response.HasFormWith(form.ID(formID))).
+		Only(ctx.Request().Context())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return formData, responseData, nil
+}
+
+func (h *Forms) redirectToResponse(ctx echo.Context, formID, respID int) error {
+	h.Inertia.Redirect(
+		ctx.Response().Writer,
+		ctx.Request(),
+		ctx.Echo().Reverse(routenames.FormsResponsesShow, formID, respID),
+	)
+	return nil
+}
+
+func (h *Forms) ResponseUpdate(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	formData, responseData, err := h.loadOwnedResponse(ctx, user)
+	if err != nil {
+		return fail(err, "failed to fetch response", h.Inertia, ctx)
+	}
+	if responseData == nil {
+		return nil
+	}
+
+	var submitted map[string]interface{}
+	if err := json.Unmarshal([]byte(ctx.FormValue("answers")), &submitted); err != nil {
+		return fail(err, "invalid answers format", h.Inertia, ctx)
+	}
+
+	err = withTx(ctx.Request().Context(), h.orm, func(tx *ent.Tx) error {
+		return updateResponseAnswers(
+			ctx.Request().Context(),
+			tx,
+			h.encryption,
+			user,
+			responseData,
+			formData.Edges.Questions,
+			submitted,
 		)
+	})
+	if err != nil {
+		return fail(err, "failed to update answers", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Response updated successfully")
+	return h.redirectToResponse(ctx, formData.ID, responseData.ID)
+}
+
+func (h *Forms) ResponseStatus(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	formData, responseData, err := h.loadOwnedResponse(ctx, user)
+	if err != nil {
+		return fail(err, "failed to fetch response", h.Inertia, ctx)
+	}
+	if responseData == nil {
+		return nil
+	}
+
+	status := response.Status(ctx.FormValue("status"))
+
+	err = withTx(ctx.Request().Context(), h.orm, func(tx *ent.Tx) error {
+		return setResponseStatus(ctx.Request().Context(), tx, user, responseData, status)
+	})
+	if err != nil {
+		return fail(err, "failed to update status", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Status updated successfully")
+	return h.redirectToResponse(ctx, formData.ID, responseData.ID)
+}
+
+func (h *Forms) ResponseNote(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	formData, responseData, err := h.loadOwnedResponse(ctx, user)
+	if err != nil {
+		return fail(err, "failed to fetch response", h.Inertia, ctx)
+	}
+	if responseData == nil {
+		return nil
+	}
+
+	err = withTx(ctx.Request().Context(), h.orm, func(tx *ent.Tx) error {
+		return addResponseNote(ctx.Request().Context(), tx, user, responseData, ctx.FormValue("body"))
+	})
+	if err != nil {
+		return fail(err, "failed to add note", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Note added successfully")
+	return h.redirectToResponse(ctx, formData.ID, responseData.ID)
+}
+
+func (h *Forms) ResponseTags(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	formData, responseData, err := h.loadOwnedResponse(ctx, user)
+	if err != nil {
+		return fail(err, "failed to fetch response", h.Inertia, ctx)
+	}
+	if responseData == nil {
+		return nil
+	}
+
+	names := parseTagNames(ctx.FormValue("tags"))
+
+	err = withTx(ctx.Request().Context(), h.orm, func(tx *ent.Tx) error {
+		return setResponseTags(ctx.Request().Context(), tx, user, responseData, names, false)
+	})
+	if err != nil {
+		return fail(err, "failed to update tags", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Tags updated successfully")
+	return h.redirectToResponse(ctx, formData.ID, responseData.ID)
+}
 
-		answerMap := make(map[int]string)
-		for _, answer := range resp.Edges.Answers {
-			answerMap[answer.Edges.Question.ID] = answer.Value
+func (h *Forms) ResponsesBulk(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	id := ctx.Param("id")
+
+	formID, err := parseID(id)
+	if err != nil {
+		return fail(err, "invalid form ID", h.Inertia, ctx)
+	}
+
+	formData, err := h.orm.Form.Query().
+		Where(form.ID(formID)).
+		WithOwner().
+		Only(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to fetch form", h.Inertia, ctx)
+	}
+
+	if formData.Edges.Owner.ID != user.ID {
+		msg.Danger(ctx, "Unauthorized access")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Forms))
+		return nil
+	}
+
+	ids, err := parseIDs(ctx.FormValue("ids"))
+	if err != nil {
+		return fail(err, "invalid response IDs", h.Inertia, ctx)
+	}
+	if len(ids) == 0 {
+		msg.Warning(ctx, "No responses selected")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	}
+
+	action := ctx.FormValue("action")
+	var count int
+	err = withTx(ctx.Request().Context(), h.orm, func(tx *ent.Tx) error {
+		count, err = applyBulkResponseAction(
+			ctx.Request().Context(),
+			tx,
+			user,
+			formData.ID,
+			action,
+			ids,
+			parseTagNames(ctx.FormValue("tags")),
+		)
+		return err
+	})
+	if err != nil {
+		return fail(err, "failed to apply bulk action", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, fmt.Sprintf("Updated %d responses", count))
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.FormsResponses, formData.ID))
+	return nil
+}
+
+func (h *Forms) ResponseDelete(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	formData, responseData, err := h.loadOwnedResponse(ctx, user)
+	if err != nil {
+		return fail(err, "failed to fetch response", h.Inertia, ctx)
+	}
+	if responseData == nil {
+		return nil
+	}
+
+	err = withTx(ctx.Request().Context(), h.orm, func(tx *ent.Tx) error {
+		return deleteResponses(ctx.Request().Context(), tx, user, formData.ID, []*ent.Response{responseData})
+	})
+	if err != nil {
+		return fail(err, "failed to delete response", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Response deleted successfully")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.FormsResponses, formData.ID))
+	return nil
+}
+
+func (h *Forms) Respondents(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	email := strings.TrimSpace(ctx.QueryParam("email"))
+
+	responses := make([]*ent.Response, 0)
+	if email != "" {
+		var err error
+		responses, err = findResponsesByEmail(ctx.Request().Context(), h.orm, user, email)
+		if err != nil {
+			return fail(err, "failed to search responses", h.Inertia, ctx)
+		}
+		for _, r := range responses {
+			if err := h.encryption.DecryptAnswers(r.Edges.Answers); err != nil {
+				return fail(err, "failed to decrypt answers", h.Inertia, ctx)
+			}
 		}
+	}
+
+	err := h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Forms/Respondents",
+		inertia.Props{
+			"email":     email,
+			"responses": responses,
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *Forms) RespondentsExport(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	email := strings.TrimSpace(ctx.QueryParam("email"))
+
+	if email == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "email is required")
+	}
+
+	responses, err := findResponsesByEmail(ctx.Request().Context(), h.orm, user, email)
+	if err != nil {
+		return fail(err, "failed to search responses", h.Inertia, ctx)
+	}
 
-		for _, q := range formData.Edges.Questions {
-			value := answerMap[q.ID]
-			value = strings.ReplaceAll(value, "\"", "\"\"")
-			row += fmt.Sprintf(",\"%s\"", value)
+	for _, r := range responses {
+		if err := h.encryption.DecryptAnswers(r.Edges.Answers); err != nil {
+			return fail(err, "failed to decrypt answers", h.Inertia, ctx)
 		}
+	}
+
+	ctx.Response().Header().Set("Content-Disposition", "attachment; filename=\"respondent-data.json\"")
+	return ctx.JSON(http.StatusOK, respondentExport(email, responses))
+}
+
+func (h *Forms) RespondentsErase(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	email := strings.TrimSpace(ctx.FormValue("email"))
 
-		csv += row + "\n"
+	// Erasing can't be undone, so only a single, complete address is accepted.
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return fail(fmt.Errorf("%q is not an email address", email), "invalid request", h.Inertia, ctx)
 	}
 
-	ctx.Response().Header().Set("Content-Type", "text/csv")
-	ctx.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s-responses.csv\"", formData.Slug))
-	ctx.Response().WriteHeader(http.StatusOK)
-	ctx.Response().Write([]byte(csv))
+	var count int
+	err := withTx(ctx.Request().Context(), h.orm, func(tx *ent.Tx) error {
+		var err error
+		count, err = eraseResponsesByEmail(ctx.Request().Context(), tx, user, email)
+		return err
+	})
+	if err != nil {
+		return fail(err, "failed to erase responses", h.Inertia, ctx)
+	}
 
+	msg.Success(ctx, fmt.Sprintf("Deleted %d responses from %s", count, email))
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.FormsRespondents))
 	return nil
 }
+
+// notifyUsage enqueues an email to a user whose usage just crossed a threshold of their plan's limits.
+func (h *Forms) notifyUsage(ctx goctx.Context, tx *ent.Tx, userID int, status services.UsageStatus, crossed services.UsageThreshold) error {
+	if crossed == services.ThresholdNone {
+		return nil
+	}
+
+	return h.jobs.EnqueueTxJSON(ctx, tx, "notify_usage", tasks.NotifyUsagePayload{
+		UserID:    userID,
+		Metric:    string(status.Metric),
+		Threshold: crossed.String(),
+	})
+}
@@ -0,0 +1,538 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/answer"
+	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/question"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/responseactivity"
+	"github.com/occult/pagode/ent/responsetag"
+	"github.com/occult/pagode/pkg/answers"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// Bulk actions that can be applied to a selection of responses.
+const (
+	bulkActionTag     = "tag"
+	bulkActionArchive = "archive"
+	bulkActionDelete  = "delete"
+)
+
+// withTx runs fn inside a transaction, rolling back if it returns an error.
+func withTx(ctx context.Context, orm *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := orm.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// recordResponseActivity stores an audit entry for a change made to a response.
+func recordResponseActivity(
+	ctx context.Context,
+	tx *ent.Tx,
+	actor *ent.User,
+	responseID int,
+	action responseactivity.Action,
+	changes map[string]interface{},
+) error {
+	return tx.ResponseActivity.Create().
+		SetResponseID(responseID).
+		SetActorID(actor.ID).
+		SetAction(action).
+		SetChanges(changes).
+		Exec(ctx)
+}
+
+// deleteResponses deletes responses of a form, recording who deleted them. The activity entries are kept after
+// the responses are gone, so they hold the IDs of the response and its form.
+func deleteResponses(ctx context.Context, tx *ent.Tx, actor *ent.User, formID int, responses []*ent.Response) error {
+	ids := make([]int, 0, len(responses))
+	for _, r := range responses {
+		err := recordResponseActivity(ctx, tx, actor, r.ID, responseactivity.ActionDeleted, map[string]interface{}{
+			"response_id": r.ID,
+			"form_id":     formID,
+		})
+		if err != nil {
+			return err
+		}
+		ids = append(ids, r.ID)
+	}
+
+	_, err := tx.Response.Delete().Where(response.IDIn(ids...)).Exec(ctx)
+	return err
+}
+
+// updateResponseAnswers applies edited answer values, keyed by question ID, to a response.
+// Values are validated the same way as on submission and only changed answers are recorded.
+// Answers to sensitive questions are stored encrypted and their values are left out of the activity log.
+func updateResponseAnswers(
+	ctx context.Context,
+	tx *ent.Tx,
+	enc *services.EncryptionClient,
+	actor *ent.User,
+	resp *ent.Response,
+	questions []*ent.Question,
+	submitted map[string]interface{},
+) error {
+	existing, err := tx.Answer.Query().
+		Where(answer.HasResponseWith(response.ID(resp.ID))).
+		WithQuestion().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[int]*ent.Answer, len(existing))
+	for _, a := range existing {
+		if a.Edges.Question != nil {
+			current[a.Edges.Question.ID] = a
+		}
+	}
+
+	changes := make(map[string]interface{})
+	for _, q := range questions {
+		// Payment answers record what was charged, so they can't be edited.
+		raw, ok := submitted[fmt.Sprintf("%d", q.ID)]
+		if !ok || q.Type == question.TypePayment {
+			continue
+		}
+
+		value, err := answers.Normalize(q, raw)
+		if err != nil {
+			return err
+		}
+
+		prev := ""
+		if a, ok := current[q.ID]; ok {
+			if prev, err = enc.DecryptAnswer(a); err != nil {
+				return err
+			}
+		}
+		if prev == value {
+			continue
+		}
+
+		if value == "" && q.Required {
+			return fmt.Errorf("required question '%s' not answered", q.Title)
+		}
+
+		stored := value
+		if q.Sensitive && value != "" {
+			if stored, err = enc.Encrypt(value); err != nil {
+				return err
+			}
+		}
+
+		switch a, ok := current[q.ID]; {
+		case value == "":
+			err = tx.Answer.DeleteOne(a).Exec(ctx)
+		case ok:
+			err = tx.Answer.UpdateOne(a).SetValue(stored).Exec(ctx)
+		default:
+			err = tx.Answer.Create().
+				SetResponseID(resp.ID).
+				SetQuestionID(q.ID).
+				SetValue(stored).
+				Exec(ctx)
+		}
+		if err != nil {
+			return err
+		}
+
+		if q.Sensitive {
+			changes[q.Title] = map[string]interface{}{"sensitive": true}
+		} else {
+			changes[q.Title] = map[string]interface{}{"from": prev, "to": value}
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return recordResponseActivity(ctx, tx, actor, resp.ID, responseactivity.ActionAnswersUpdated, changes)
+}
+
+// setResponseStatus moves a response to a new workflow status.
+func setResponseStatus(ctx context.Context, tx *ent.Tx, actor *ent.User, resp *ent.Response, status response.Status) error {
+	if err := response.StatusValidator(status); err != nil {
+		return err
+	}
+
+	if resp.Status == status {
+		return nil
+	}
+
+	if err := tx.Response.UpdateOneID(resp.ID).SetStatus(status).Exec(ctx); err != nil {
+		return err
+	}
+
+	return recordResponseActivity(ctx, tx, actor, resp.ID, responseactivity.ActionStatusChanged, map[string]interface{}{
+		"from": resp.Status.String(),
+		"to":   status.String(),
+	})
+}
+
+// addResponseNote attaches an internal note to a response.
+func addResponseNote(ctx context.Context, tx *ent.Tx, actor *ent.User, resp *ent.Response, body string) error {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return errors.New("note cannot be empty")
+	}
+
+	note, err := tx.ResponseNote.Create().
+		SetResponseID(resp.ID).
+		SetAuthorID(actor.ID).
+		SetBody(body).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return recordResponseActivity(ctx, tx, actor, resp.ID, responseactivity.ActionNoteAdded, map[string]interface{}{
+		"note_id": note.ID,
+	})
+}
+
+// setResponseTags replaces the tags of a response, creating any of the owner's tags that do not exist yet.
+// When add is true the given tags are added to the existing ones instead.
+func setResponseTags(ctx context.Context, tx *ent.Tx, actor *ent.User, resp *ent.Response, names []string, add bool) error {
+	current, err := tx.Response.QueryTags(resp).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	tags, err := findOrCreateResponseTags(ctx, tx, actor, names)
+	if err != nil {
+		return err
+	}
+
+	from := tagNames(current)
+	to := tagNames(tags)
+	if add {
+		to = tagNames(append(current, tags...))
+	}
+	if strings.Join(from, ",") == strings.Join(to, ",") {
+		return nil
+	}
+
+	upd := tx.Response.UpdateOneID(resp.ID)
+	if add {
+		has := make(map[int]bool, len(current))
+		for _, t := range current {
+			has[t.ID] = true
+		}
+		for _, t := range tags {
+			if !has[t.ID] {
+				upd.AddTags(t)
+			}
+		}
+	} else {
+		upd.ClearTags().AddTags(tags...)
+	}
+	if err := upd.Exec(ctx); err != nil {
+		return err
+	}
+
+	return recordResponseActivity(ctx, tx, actor, resp.ID, responseactivity.ActionTagsUpdated, map[string]interface{}{
+		"from": from,
+		"to":   to,
+	})
+}
+
+// findOrCreateResponseTags returns the owner's tags matching the given names, creating missing ones.
+func findOrCreateResponseTags(ctx context.Context, tx *ent.Tx, owner *ent.User, names []string) ([]*ent.ResponseTag, error) {
+	names = parseTagNames(strings.Join(names, ","))
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tags, err := tx.ResponseTag.Query().
+		Where(responsetag.UserID(owner.ID), responsetag.NameIn(names...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		found[t.Name] = true
+	}
+
+	for _, name := range names {
+		if found[name] {
+			continue
+		}
+		t, err := tx.ResponseTag.Create().
+			SetName(name).
+			SetOwnerID(owner.ID).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+
+	return tags, nil
+}
+
+// applyBulkResponseAction applies a bulk action to the given responses of a form.
+// IDs that do not belong to the form are ignored. The number of affected responses is returned.
+func applyBulkResponseAction(
+	ctx context.Context,
+	tx *ent.Tx,
+	actor *ent.User,
+	formID int,
+	action string,
+	ids []int,
+	tags []string,
+) (int, error) {
+	responses, err := tx.Response.Query().
+		Where(response.IDIn(ids...), response.HasFormWith(form.ID(formID))).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	switch action {
+	case bulkActionTag:
+		if len(parseTagNames(strings.Join(tags, ","))) == 0 {
+			return 0, errors.New("at least one tag is required")
+		}
+		for _, r := range responses {
+			if err := setResponseTags(ctx, tx, actor, r, tags, true); err != nil {
+				return 0, err
+			}
+		}
+
+	case bulkActionArchive:
+		for _, r := range responses {
+			if err := setResponseStatus(ctx, tx, actor, r, response.StatusArchived); err != nil {
+				return 0, err
+			}
+		}
+
+	case bulkActionDelete:
+		if err := deleteResponses(ctx, tx, actor, formID, responses); err != nil {
+			return 0, err
+		}
+
+	default:
+		return 0, fmt.Errorf("unknown bulk action '%s'", action)
+	}
+
+	return len(responses), nil
+}
+
+// respondentAnswersMatching returns a predicate matching answers that may contain the given email address,
+// either as a plain value or as a value inside a structured answer. Structured answers only match partially,
+// so the matches are narrowed down with answerMatchesEmail.
+func respondentAnswersMatching(email string) predicate.Answer {
+	return answer.Or(
+		answer.ValueEqualFold(email),
+		answer.ValueContainsFold(fmt.Sprintf("%q", email)),
+	)
+}
+
+// answerMatchesEmail returns whether an answer is the given email address, or a structured answer holding it
+// as one of its values.
+func answerMatchesEmail(a *ent.Answer, email string) bool {
+	if strings.EqualFold(a.Value, email) {
+		return true
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(a.Value), &value); err != nil {
+		return false
+	}
+
+	var matches func(v interface{}) bool
+	matches = func(v interface{}) bool {
+		switch v := v.(type) {
+		case string:
+			return strings.EqualFold(v, email)
+		case []interface{}:
+			for _, item := range v {
+				if matches(item) {
+					return true
+				}
+			}
+		case map[string]interface{}:
+			for _, item := range v {
+				if matches(item) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return matches(value)
+}
+
+// responsesMatchingEmail returns the responses which have an answer matching the given email address.
+func responsesMatchingEmail(responses []*ent.Response, email string) []*ent.Response {
+	matched := make([]*ent.Response, 0, len(responses))
+	for _, r := range responses {
+		for _, a := range r.Edges.Answers {
+			if answerMatchesEmail(a, email) {
+				matched = append(matched, r)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// findResponsesByEmail returns all responses to the owner's forms that contain the given email address.
+// Answers to sensitive questions are encrypted at rest and cannot be matched.
+func findResponsesByEmail(ctx context.Context, orm *ent.Client, owner *ent.User, email string) ([]*ent.Response, error) {
+	responses, err := orm.Response.Query().
+		Where(
+			response.HasFormWith(form.UserID(owner.ID)),
+			response.HasAnswersWith(respondentAnswersMatching(email)),
+		).
+		WithForm().
+		WithAnswers(func(q *ent.AnswerQuery) {
+			q.WithQuestion()
+		}).
+		Order(ent.Desc(response.FieldSubmittedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return responsesMatchingEmail(responses, email), nil
+}
+
+// eraseResponsesByEmail deletes all responses to the owner's forms that contain the given email address.
+func eraseResponsesByEmail(ctx context.Context, tx *ent.Tx, owner *ent.User, email string) (int, error) {
+	responses, err := tx.Response.Query().
+		Where(
+			response.HasFormWith(form.UserID(owner.ID)),
+			response.HasAnswersWith(respondentAnswersMatching(email)),
+		).
+		WithForm().
+		WithAnswers(func(q *ent.AnswerQuery) {
+			q.Where(respondentAnswersMatching(email))
+		}).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Responses are deleted per form so the activity log records which form each was deleted from.
+	var formIDs []int
+	byForm := make(map[int][]*ent.Response)
+	matching := responsesMatchingEmail(responses, email)
+	for _, r := range matching {
+		formID := r.Edges.Form.ID
+		if _, ok := byForm[formID]; !ok {
+			formIDs = append(formIDs, formID)
+		}
+		byForm[formID] = append(byForm[formID], r)
+	}
+
+	for _, formID := range formIDs {
+		if err := deleteResponses(ctx, tx, owner, formID, byForm[formID]); err != nil {
+			return 0, err
+		}
+	}
+	return len(matching), nil
+}
+
+// respondentExport builds a portable copy of the responses held about a respondent.
+func respondentExport(email string, responses []*ent.Response) map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(responses))
+	for _, r := range responses {
+		answerList := make([]map[string]string, 0, len(r.Edges.Answers))
+		for _, a := range r.Edges.Answers {
+			title := ""
+			if a.Edges.Question != nil {
+				title = a.Edges.Question.Title
+			}
+			answerList = append(answerList, map[string]string{
+				"question": title,
+				"value":    a.Value,
+			})
+		}
+
+		formTitle := ""
+		if r.Edges.Form != nil {
+			formTitle = r.Edges.Form.Title
+		}
+
+		items = append(items, map[string]interface{}{
+			"form":         formTitle,
+			"submitted_at": r.SubmittedAt,
+			"ip_address":   r.IPAddress,
+			"user_agent":   r.UserAgent,
+			"answers":      answerList,
+		})
+	}
+
+	return map[string]interface{}{
+		"email":     email,
+		"responses": items,
+	}
+}
+
+// parseTagNames splits a comma-separated list of tag names, trimming and de-duplicating them.
+func parseTagNames(s string) []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+	for _, n := range strings.Split(s, ",") {
+		n = strings.TrimSpace(n)
+		if n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		names = append(names, n)
+	}
+	return names
+}
+
+// parseIDs parses a comma-separated list of IDs.
+func parseIDs(s string) ([]int, error) {
+	ids := make([]int, 0)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := parseID(part)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func tagNames(tags []*ent.ResponseTag) []string {
+	seen := make(map[string]bool, len(tags))
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if seen[t.Name] {
+			continue
+		}
+		seen[t.Name] = true
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	return names
+}
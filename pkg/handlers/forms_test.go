@@ -3,14 +3,22 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/labstack/echo/v4"
 	"github.com/occult/pagode/ent"
 	entForm "github.com/occult/pagode/ent/form"
 	entQuestion "github.com/occult/pagode/ent/question"
 	entResponse "github.com/occult/pagode/ent/response"
+	entResponseActivity "github.com/occult/pagode/ent/responseactivity"
+	entResponseTag "github.com/occult/pagode/ent/responsetag"
 	entUser "github.com/occult/pagode/ent/user"
+	appContext "github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -775,3 +783,566 @@ func TestQuestions__OptionsForSelectionFields(t *testing.T) {
 	}
 }
 
+
+func TestForms__ResponseStatus_RecordsActivity(t *testing.T) {
+	user := createTestUser(t)
+	formData := createTestForm(t, user, "Workflow Test", "Testing response status")
+
+	response, err := c.ORM.Response.Create().
+		SetFormID(formData.ID).
+		SetCompleted(true).
+		Save(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, entResponse.StatusNew, response.Status)
+
+	err = withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+		return setResponseStatus(context.Background(), tx, user, response, entResponse.StatusInProgress)
+	})
+	require.NoError(t, err)
+
+	err = withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+		return setResponseStatus(context.Background(), tx, user, response, "invalid")
+	})
+	assert.Error(t, err)
+
+	saved, err := c.ORM.Response.Query().
+		Where(entResponse.IDEQ(response.ID)).
+		WithActivities(func(q *ent.ResponseActivityQuery) {
+			q.WithActor()
+		}).
+		Only(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, entResponse.StatusInProgress, saved.Status)
+	require.Len(t, saved.Edges.Activities, 1)
+
+	activity := saved.Edges.Activities[0]
+	assert.Equal(t, entResponseActivity.ActionStatusChanged, activity.Action)
+	assert.Equal(t, "new", activity.Changes["from"])
+	assert.Equal(t, "in_progress", activity.Changes["to"])
+	assert.Equal(t, user.ID, activity.Edges.Actor.ID)
+}
+
+func TestForms__ResponseUpdate_EditAnswers(t *testing.T) {
+	user := createTestUser(t)
+	formData := createTestForm(t, user, "Edit Test", "Testing answer editing")
+
+	name, err := c.ORM.Question.Create().
+		SetType("text").
+		SetTitle("Name").
+		SetRequired(true).
+		SetOrder(0).
+		SetFormID(formData.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	comment, err := c.ORM.Question.Create().
+		SetType("textarea").
+		SetTitle("Comment").
+		SetRequired(false).
+		SetOrder(1).
+		SetFormID(formData.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	response, err := c.ORM.Response.Create().
+		SetFormID(formData.ID).
+		SetCompleted(true).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	_, err = c.ORM.Answer.Create().
+		SetResponseID(response.ID).
+		SetQuestionID(name.ID).
+		SetValue("Alice").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	questions := []*ent.Question{name, comment}
+	update := func(submitted map[string]interface{}) error {
+		return withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+			return updateResponseAnswers(context.Background(), tx, c.Encryption, user, response, questions, submitted)
+		})
+	}
+
+	require.NoError(t, update(map[string]interface{}{
+		fmt.Sprintf("%d", name.ID):    "Alicia",
+		fmt.Sprintf("%d", comment.ID): "Great",
+	}))
+
+	err = update(map[string]interface{}{fmt.Sprintf("%d", name.ID): ""})
+	assert.ErrorContains(t, err, "required question 'Name'")
+
+	saved, err := c.ORM.Response.Query().
+		Where(entResponse.IDEQ(response.ID)).
+		WithAnswers(func(q *ent.AnswerQuery) {
+			q.WithQuestion()
+		}).
+		WithActivities().
+		Only(context.Background())
+	require.NoError(t, err)
+
+	answerMap := make(map[int]string)
+	for _, a := range saved.Edges.Answers {
+		answerMap[a.Edges.Question.ID] = a.Value
+	}
+	assert.Equal(t, "Alicia", answerMap[name.ID])
+	assert.Equal(t, "Great", answerMap[comment.ID])
+
+	require.Len(t, saved.Edges.Activities, 1)
+	assert.Equal(t, entResponseActivity.ActionAnswersUpdated, saved.Edges.Activities[0].Action)
+	assert.Contains(t, saved.Edges.Activities[0].Changes, "Name")
+	assert.Contains(t, saved.Edges.Activities[0].Changes, "Comment")
+}
+
+func TestForms__ResponseNotesAndTags(t *testing.T) {
+	user := createTestUser(t)
+	formData := createTestForm(t, user, "Notes Test", "Testing notes and tags")
+
+	response, err := c.ORM.Response.Create().
+		SetFormID(formData.ID).
+		SetCompleted(true).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	err = withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+		if err := addResponseNote(context.Background(), tx, user, response, "  Follow up by email  "); err != nil {
+			return err
+		}
+		return setResponseTags(context.Background(), tx, user, response, parseTagNames("lead, vip, lead"), false)
+	})
+	require.NoError(t, err)
+
+	err = withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+		return addResponseNote(context.Background(), tx, user, response, " ")
+	})
+	assert.Error(t, err)
+
+	err = withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+		return setResponseTags(context.Background(), tx, user, response, []string{"vip"}, false)
+	})
+	require.NoError(t, err)
+
+	saved, err := c.ORM.Response.Query().
+		Where(entResponse.IDEQ(response.ID)).
+		WithNotes().
+		WithTags().
+		WithActivities().
+		Only(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, saved.Edges.Notes, 1)
+	assert.Equal(t, "Follow up by email", saved.Edges.Notes[0].Body)
+	require.Len(t, saved.Edges.Tags, 1)
+	assert.Equal(t, "vip", saved.Edges.Tags[0].Name)
+	assert.Len(t, saved.Edges.Activities, 3)
+
+	ownerTags, err := c.ORM.ResponseTag.Query().
+		Where(entResponseTag.UserID(user.ID)).
+		All(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, ownerTags, 2)
+}
+
+func TestForms__ResponsesBulk(t *testing.T) {
+	user := createTestUser(t)
+	formData := createTestForm(t, user, "Bulk Test", "Testing bulk actions")
+	otherForm := createTestForm(t, user, "Other Form", "Responses outside the bulk form")
+
+	var ids []int
+	for i := 0; i < 3; i++ {
+		r, err := c.ORM.Response.Create().
+			SetFormID(formData.ID).
+			SetCompleted(true).
+			Save(context.Background())
+		require.NoError(t, err)
+		ids = append(ids, r.ID)
+	}
+
+	other, err := c.ORM.Response.Create().
+		SetFormID(otherForm.ID).
+		SetCompleted(true).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	bulk := func(action string, ids []int, tags []string) (int, error) {
+		var count int
+		err := withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+			var err error
+			count, err = applyBulkResponseAction(context.Background(), tx, user, formData.ID, action, ids, tags)
+			return err
+		})
+		return count, err
+	}
+
+	count, err := bulk(bulkActionTag, append(ids, other.ID), []string{"reviewed"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	count, err = bulk(bulkActionTag, ids, []string{"reviewed"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	tagged, err := c.ORM.Response.Query().
+		Where(entResponse.HasTagsWith(entResponseTag.Name("reviewed"))).
+		Where(entResponse.IDIn(append(ids, other.ID)...)).
+		Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, tagged)
+
+	_, err = bulk(bulkActionTag, ids, nil)
+	assert.Error(t, err)
+
+	_, err = bulk("unknown", ids, nil)
+	assert.Error(t, err)
+
+	count, err = bulk(bulkActionArchive, ids[:2], nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	archived, err := c.ORM.Response.Query().
+		Where(entResponse.IDIn(ids...), entResponse.StatusEQ(entResponse.StatusArchived)).
+		Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, archived)
+
+	count, err = bulk(bulkActionDelete, []int{ids[2], other.ID}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	remaining, err := c.ORM.Response.Query().
+		Where(entResponse.IDIn(append(ids, other.ID)...)).
+		Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, remaining)
+
+	// Deletions are recorded, and their entry outlives the response.
+	deleted, err := c.ORM.ResponseActivity.Query().
+		Where(
+			entResponseActivity.ActionEQ(entResponseActivity.ActionDeleted),
+			entResponseActivity.UserID(user.ID),
+		).
+		All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.EqualValues(t, ids[2], deleted[0].Changes["response_id"])
+	assert.EqualValues(t, formData.ID, deleted[0].Changes["form_id"])
+}
+
+func TestParseIDs(t *testing.T) {
+	ids, err := parseIDs("1, 2,,3")
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+
+	_, err = parseIDs("1,abc")
+	assert.Error(t, err)
+}
+
+func TestForms__Respondents_FindAndErase(t *testing.T) {
+	user := createTestUser(t)
+	otherUser := createTestUser(t)
+	formData := createTestForm(t, user, "Signup", "Testing respondent search")
+	otherForm := createTestForm(t, otherUser, "Signup", "Another owner's form")
+
+	emailQ, err := c.ORM.Question.Create().
+		SetType("email").
+		SetTitle("Email").
+		SetOrder(0).
+		SetFormID(formData.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	contactQ, err := c.ORM.Question.Create().
+		SetType("multi-input").
+		SetTitle("Contact").
+		SetOrder(1).
+		SetFormID(formData.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	otherQ, err := c.ORM.Question.Create().
+		SetType("email").
+		SetTitle("Email").
+		SetOrder(0).
+		SetFormID(otherForm.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	createResponse := func(formID, questionID int, value string) *ent.Response {
+		r, err := c.ORM.Response.Create().
+			SetFormID(formID).
+			SetCompleted(true).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		_, err = c.ORM.Answer.Create().
+			SetResponseID(r.ID).
+			SetQuestionID(questionID).
+			SetValue(value).
+			Save(context.Background())
+		require.NoError(t, err)
+		return r
+	}
+
+	plain := createResponse(formData.ID, emailQ.ID, "Jane@Example.com")
+	structured := createResponse(formData.ID, contactQ.ID, `{"email":"jane@example.com","name":"Jane"}`)
+	unrelated := createResponse(formData.ID, emailQ.ID, "notjane@example.com")
+	foreign := createResponse(otherForm.ID, otherQ.ID, "jane@example.com")
+	// Only whole values match, not other parts of a structured answer which contain the address.
+	partial := createResponse(formData.ID, contactQ.ID, `{"jane@example.com":"referred by"}`)
+
+	found, err := findResponsesByEmail(context.Background(), c.ORM, user, "jane@example.com")
+	require.NoError(t, err)
+
+	var ids []int
+	for _, r := range found {
+		ids = append(ids, r.ID)
+		assert.NotNil(t, r.Edges.Form)
+	}
+	assert.ElementsMatch(t, []int{plain.ID, structured.ID}, ids)
+
+	export := respondentExport("jane@example.com", found)
+	assert.Len(t, export["responses"], 2)
+
+	var count int
+	err = withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+		var err error
+		count, err = eraseResponsesByEmail(context.Background(), tx, user, "jane@example.com")
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	remaining, err := c.ORM.Response.Query().
+		Where(entResponse.IDIn(plain.ID, structured.ID, unrelated.ID, foreign.ID, partial.ID)).
+		IDs(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{unrelated.ID, foreign.ID, partial.ID}, remaining)
+
+	// Erasures are recorded like other deletions.
+	deleted, err := c.ORM.ResponseActivity.Query().
+		Where(
+			entResponseActivity.ActionEQ(entResponseActivity.ActionDeleted),
+			entResponseActivity.UserID(user.ID),
+		).
+		All(context.Background())
+	require.NoError(t, err)
+	ids = nil
+	for _, a := range deleted {
+		ids = append(ids, int(a.Changes["response_id"].(float64)))
+		assert.EqualValues(t, formData.ID, a.Changes["form_id"])
+	}
+	assert.ElementsMatch(t, []int{plain.ID, structured.ID}, ids)
+
+	// Erasing requires a single, complete address.
+	h := new(Forms)
+	require.NoError(t, h.Init(c))
+	for _, email := range []string{"example.com", "Jane <notjane@example.com>", "notjane@example.com, jane@example.com"} {
+		ctx, _ := inertiaContext(t, user, http.MethodPost, "/respondents/erase", url.Values{"email": {email}})
+		ctx.Set(appContext.AuthenticatedUserKey, user)
+		require.NoError(t, h.RespondentsErase(ctx))
+	}
+	assert.True(t, c.ORM.Response.Query().Where(entResponse.ID(unrelated.ID)).ExistX(context.Background()))
+}
+
+func TestForms__ResponseUpdate_SensitiveAnswersEncrypted(t *testing.T) {
+	user := createTestUser(t)
+	formData := createTestForm(t, user, "Health Form", "Testing sensitive answers")
+
+	q, err := c.ORM.Question.Create().
+		SetType("text").
+		SetTitle("Diagnosis").
+		SetSensitive(true).
+		SetOrder(0).
+		SetFormID(formData.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	response, err := c.ORM.Response.Create().
+		SetFormID(formData.ID).
+		SetCompleted(true).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	err = withTx(context.Background(), c.ORM, func(tx *ent.Tx) error {
+		return updateResponseAnswers(context.Background(), tx, c.Encryption, user, response, []*ent.Question{q}, map[string]interface{}{
+			fmt.Sprintf("%d", q.ID): "Asthma",
+		})
+	})
+	require.NoError(t, err)
+
+	saved, err := c.ORM.Response.Query().
+		Where(entResponse.IDEQ(response.ID)).
+		WithAnswers().
+		WithActivities().
+		Only(context.Background())
+	require.NoError(t, err)
+	require.Len(t, saved.Edges.Answers, 1)
+
+	stored := saved.Edges.Answers[0].Value
+	assert.True(t, c.Encryption.IsEncrypted(stored))
+	assert.NotContains(t, stored, "Asthma")
+
+	require.NoError(t, c.Encryption.DecryptAnswers(saved.Edges.Answers))
+	assert.Equal(t, "Asthma", saved.Edges.Answers[0].Value)
+
+	require.Len(t, saved.Edges.Activities, 1)
+	assert.Equal(t, map[string]interface{}{"sensitive": true}, saved.Edges.Activities[0].Changes["Diagnosis"])
+}
+
+func TestForms__Responses_AnswerLooksEncrypted(t *testing.T) {
+	user := createTestUser(t)
+	formData := createTestForm(t, user, "Feedback", "Testing answers which look encrypted")
+
+	_, err := c.ORM.Form.UpdateOne(formData).
+		SetPublished(true).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	q, err := c.ORM.Question.Create().
+		SetType("text").
+		SetTitle("Comment").
+		SetOrder(0).
+		SetFormID(formData.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	h := new(Forms)
+	require.NoError(t, h.Init(c))
+	identifier := getUserIdentifier(user)
+
+	answersJSON := fmt.Sprintf(`{"%d":"enc:v1:x"}`, q.ID)
+	ctx, _ := inertiaContext(t, user, http.MethodPost, "/"+identifier+"/"+formData.Slug, url.Values{"answers": {answersJSON}})
+	ctx.SetParamNames("identifier", "slug")
+	ctx.SetParamValues(identifier, formData.Slug)
+	require.NoError(t, h.Submit(ctx))
+
+	// Answers to questions which are not sensitive are shown as they were typed.
+	ctx, rec := inertiaContext(t, user, http.MethodGet, fmt.Sprintf("/forms/%d/responses", formData.ID), nil)
+	ctx.Set(appContext.AuthenticatedUserKey, user)
+	ctx.SetParamNames("id")
+	ctx.SetParamValues(fmt.Sprintf("%d", formData.ID))
+	require.NoError(t, h.Responses(ctx))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	responses := inertiaProps(t, rec)["responses"].([]any)
+	require.Len(t, responses, 1)
+	answers := responses[0].(map[string]any)["edges"].(map[string]any)["answers"].([]any)
+	require.Len(t, answers, 1)
+	assert.Equal(t, "enc:v1:x", answers[0].(map[string]any)["value"])
+}
+
+func TestForms__Submit_PaidForm(t *testing.T) {
+	user := createTestUser(t)
+	formData := createTestForm(t, user, "Workshop", "Paid registration")
+
+	_, err := c.ORM.Form.UpdateOne(formData).
+		SetPublished(true).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	name, err := c.ORM.Question.Create().
+		SetType("text").
+		SetTitle("Name").
+		SetOrder(0).
+		SetFormID(formData.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	tickets, err := c.ORM.Question.Create().
+		SetType("payment").
+		SetTitle("Tickets").
+		SetRequired(true).
+		SetOrder(1).
+		SetOptions(map[string]interface{}{"mode": "quantity", "amount": 2500, "currency": "usd"}).
+		SetFormID(formData.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	h := new(Forms)
+	require.NoError(t, h.Init(c))
+	identifier := getUserIdentifier(user)
+
+	submit := func() *httptest.ResponseRecorder {
+		answersJSON := fmt.Sprintf(`{"%d":"Alice","%d":"2"}`, name.ID, tickets.ID)
+		ctx, rec := inertiaContext(t, user, http.MethodPost, "/"+identifier+"/"+formData.Slug, url.Values{"answers": {answersJSON}})
+		ctx.SetParamNames("identifier", "slug")
+		ctx.SetParamValues(identifier, formData.Slug)
+		require.NoError(t, h.Submit(ctx))
+		return rec
+	}
+
+	// Payments can't be collected until the owner set up their payment account.
+	rec := submit()
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	ctx, _ := inertiaContext(t, user, http.MethodPost, "/billing/payouts", nil)
+	_, err = c.Payment.OnboardPaymentAccount(ctx, user, "/billing", "/billing/payouts/return")
+	require.NoError(t, err)
+	account, err := c.Payment.GetPaymentAccount(ctx, user)
+	require.NoError(t, err)
+	_, err = c.Payment.RefreshPaymentAccount(ctx, account)
+	require.NoError(t, err)
+
+	rec = submit()
+	require.Equal(t, http.StatusSeeOther, rec.Code)
+	location := rec.Header().Get("Location")
+	assert.Contains(t, location, "/payments/")
+
+	response, err := c.ORM.Response.Query().
+		Where(entResponse.HasFormWith(entForm.ID(formData.ID))).
+		WithAnswers(func(q *ent.AnswerQuery) {
+			q.WithQuestion()
+		}).
+		WithPayment().
+		Only(context.Background())
+	require.NoError(t, err)
+	assert.False(t, response.Completed)
+	require.NotNil(t, response.Edges.Payment)
+	assert.EqualValues(t, 5000, response.Edges.Payment.Amount)
+	assert.Equal(t, account.ProviderAccountID, response.Edges.Payment.ProviderAccountID)
+
+	answerMap := make(map[int]string)
+	for _, a := range response.Edges.Answers {
+		answerMap[a.Edges.Question.ID] = a.Value
+	}
+	assert.Equal(t, "50.00 USD", answerMap[tickets.ID])
+
+	paymentIntentID := response.Edges.Payment.ProviderPaymentIntentID
+	pay := func(paymentMethodID string) *httptest.ResponseRecorder {
+		ctx, rec := inertiaContext(t, user, http.MethodPost, location, url.Values{"paymentMethodId": {paymentMethodID}})
+		ctx.SetParamNames("identifier", "slug", "payment")
+		ctx.SetParamValues(identifier, formData.Slug, paymentIntentID)
+		require.NoError(t, h.Pay(ctx))
+		return rec
+	}
+
+	// The response is completed, and counted against the owner's usage, once the payment succeeds.
+	usage := func() int64 {
+		status, err := c.Usage.Status(context.Background(), user, services.MetricResponses)
+		require.NoError(t, err)
+		return status.Used
+	}
+	used := usage()
+
+	pay("pm_card_chargeDeclined")
+	response, err = c.ORM.Response.Get(context.Background(), response.ID)
+	require.NoError(t, err)
+	assert.False(t, response.Completed)
+	assert.Equal(t, used, usage())
+
+	rec = pay("pm_card_visa")
+	assert.Contains(t, rec.Header().Get("Location"), "/thank-you")
+	response, err = c.ORM.Response.Get(context.Background(), response.ID)
+	require.NoError(t, err)
+	assert.True(t, response.Completed)
+	assert.Equal(t, used+1, usage())
+
+	// Payments are only found through the form they were made to.
+	ctx, _ = inertiaContext(t, user, http.MethodGet, location, nil)
+	ctx.SetParamNames("identifier", "slug", "payment")
+	ctx.SetParamValues(identifier, "other-form", paymentIntentID)
+	err = h.Payment(ctx)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -15,9 +17,10 @@ import (
 )
 
 type Plans struct {
-	Inertia *inertia.Inertia
-	Payment *services.PaymentClient
-	Auth    *services.AuthClient
+	Inertia      *inertia.Inertia
+	Payment      *services.PaymentClient
+	Auth         *services.AuthClient
+	Entitlements *services.EntitlementsClient
 }
 
 func init() {
@@ -28,6 +31,7 @@ func (h *Plans) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.Payment = c.Payment
 	h.Auth = c.Auth
+	h.Entitlements = c.Entitlements
 	return nil
 }
 
@@ -54,7 +58,7 @@ func (h *Plans) Page(ctx echo.Context) error {
 		subscriptions, err := h.Payment.GetCustomerSubscriptions(ctx, paymentCustomer)
 		if err == nil {
 			for _, sub := range subscriptions {
-				if sub.Status == subscription.StatusActive {
+				if sub.Status == subscription.StatusActive || sub.Status == subscription.StatusTrialing {
 					hasActiveSubscription = true
 					break
 				}
@@ -62,33 +66,48 @@ func (h *Plans) Page(ctx echo.Context) error {
 		}
 	}
 
+	currentPlan, err := h.Entitlements.PlanFor(ctx.Request().Context(), user)
+	if err != nil {
+		return err
+	}
+
+	// List every price of the paid plans
+	plans := []map[string]interface{}{}
+	for _, plan := range h.Entitlements.Plans() {
+		var trialDays int
+		if paymentCustomer != nil {
+			if trialDays, err = h.Payment.TrialDays(ctx.Request().Context(), paymentCustomer, plan); err != nil {
+				return err
+			}
+		}
+
+		for _, price := range plan.Prices {
+			plans = append(plans, map[string]interface{}{
+				"id":          plan.ID,
+				"name":        plan.Name,
+				"description": plan.Description,
+				"price":       price.Amount,
+				"currency":    price.Currency,
+				"interval":    price.Interval,
+				"features":    plan.Highlights,
+				"priceId":     price.ID,
+				"trialDays":   trialDays,
+			})
+		}
+	}
+
 	err = h.Inertia.Render(
 		ctx.Response().Writer,
 		ctx.Request(),
 		"Plans",
 		inertia.Props{
-			"title":                "Choose Your Plan",
+			"title":                 "Choose Your Plan",
 			"hasActiveSubscription": hasActiveSubscription,
-			"form":                 form.Get[SubscribeForm](ctx),
-			"stripePublishableKey": h.Payment.GetConfig().Payment.Stripe.PublishableKey,
-			"plans": []map[string]interface{}{
-				{
-					"id":          "premium",
-					"name":        "Premium Plan",
-					"description": "Unlock all premium features",
-					"price":       2900, // $29.00 in cents
-					"currency":    "usd",
-					"interval":    "month",
-					"features": []string{
-						"Advanced Analytics",
-						"Priority Support", 
-						"Custom Integrations",
-						"Unlimited Projects",
-						"API Access",
-					},
-					"priceId": "price_your_stripe_price_id_here", // Stripe Price ID
-				},
-			},
+			"currentPlan":           currentPlan.ID,
+			"form":                  form.Get[SubscribeForm](ctx),
+			"stripePublishableKey":  h.Payment.GetConfig().Payment.Stripe.PublishableKey,
+			"paymentProvider":       h.Payment.GetConfig().Payment.Provider,
+			"plans":                 plans,
 		},
 	)
 	if err != nil {
@@ -102,7 +121,9 @@ func (h *Plans) Page(ctx echo.Context) error {
 type SubscribeForm struct {
 	form.Submission
 	PlanId          string `form:"planId" validate:"required"`
+	PriceId         string `form:"priceId"`
 	PaymentMethodId string `form:"paymentMethodId" validate:"required"`
+	PromotionCode   string `form:"promotionCode"`
 }
 
 func (h *Plans) Subscribe(ctx echo.Context) error {
@@ -124,25 +145,56 @@ func (h *Plans) Subscribe(ctx echo.Context) error {
 		return fail(err, "Unable to get authenticated user", h.Inertia, ctx)
 	}
 
+	// Look up the price in the plan catalog, defaulting to the plan's first price
+	plan, ok := h.Entitlements.Plan(input.PlanId)
+	if !ok || len(plan.Prices) == 0 {
+		return fail(fmt.Errorf("plan %q cannot be subscribed to", input.PlanId), "Unknown plan", h.Inertia, ctx)
+	}
+	price := plan.Prices[0]
+	for _, p := range plan.Prices {
+		if p.ID == input.PriceId {
+			price = p
+		}
+	}
+
 	// Create or get payment customer
 	paymentCustomer, err := h.Payment.CreateOrGetCustomer(ctx, user)
 	if err != nil {
 		return fail(err, "Unable to create payment customer", h.Inertia, ctx)
 	}
 
+	// Check the promotion code with the provider before anything is charged
+	var promotionCodeID string
+	if strings.TrimSpace(input.PromotionCode) != "" {
+		promo, err := h.Payment.ValidatePromotionCode(ctx.Request().Context(), input.PromotionCode, price)
+		switch {
+		case errors.Is(err, services.ErrInvalidPromotionCode):
+			msg.Danger(ctx, "This promotion code is invalid or has expired.")
+			h.Inertia.Back(ctx.Response(), ctx.Request())
+			return nil
+		case err != nil:
+			return fail(err, "Unable to check promotion code", h.Inertia, ctx)
+		}
+		promotionCodeID = promo.ID
+	}
+
+	trialDays, err := h.Payment.TrialDays(ctx.Request().Context(), paymentCustomer, plan)
+	if err != nil {
+		return fail(err, "Unable to check trial eligibility", h.Inertia, ctx)
+	}
+
 	// Attach the payment method to the customer
 	_, err = h.Payment.AttachPaymentMethodToCustomer(ctx, paymentCustomer, input.PaymentMethodId, true)
 	if err != nil {
 		return fail(err, "Unable to attach payment method", h.Inertia, ctx)
 	}
 
-	// Create the subscription with real Stripe Price ID
-	priceId := "price_your_stripe_price_id_here" // Stripe Price ID
-	
-	_, err = h.Payment.CreateSubscription(ctx, paymentCustomer, priceId, &services.CreateSubscriptionParams{
+	_, err = h.Payment.CreateSubscription(ctx, paymentCustomer, price.ID, &services.CreateSubscriptionParams{
 		PaymentMethodID: input.PaymentMethodId,
+		TrialPeriodDays: trialDays,
+		PromotionCodeID: promotionCodeID,
 		Metadata: map[string]interface{}{
-			"plan_id": input.PlanId,
+			"plan_id": plan.ID,
 			"user_id": fmt.Sprintf("%d", user.ID),
 		},
 	})
@@ -151,7 +203,11 @@ func (h *Plans) Subscribe(ctx echo.Context) error {
 	}
 
 	// Add success message
-	msg.Success(ctx, "Successfully subscribed to the premium plan!")
+	if trialDays > 0 {
+		msg.Success(ctx, fmt.Sprintf("Your %d-day free trial of the %s plan has started!", trialDays, plan.Name))
+	} else {
+		msg.Success(ctx, fmt.Sprintf("Successfully subscribed to the %s plan!", plan.Name))
+	}
 
 	// Return to plans page 
 	return h.Page(ctx)
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlans__Page(t *testing.T) {
+	u := createTestUser(t)
+	h := new(Plans)
+	require.NoError(t, h.Init(c))
+
+	ctx, rec := inertiaContext(t, u, http.MethodGet, "/plans", nil)
+	require.NoError(t, h.Page(ctx))
+
+	props := inertiaProps(t, rec)
+	assert.Equal(t, "free", props["currentPlan"])
+	assert.Equal(t, "fake", props["paymentProvider"])
+	assert.Equal(t, false, props["hasActiveSubscription"])
+	require.Len(t, props["plans"], 1)
+	assert.Equal(t, "price_your_stripe_price_id_here", props["plans"].([]any)[0].(map[string]any)["priceId"])
+}
+
+func TestPlans__Subscribe(t *testing.T) {
+	subscribe := func(paymentMethodID string) (*ent.User, *ent.Subscription) {
+		t.Helper()
+		u := createTestUser(t)
+		h := new(Plans)
+		require.NoError(t, h.Init(c))
+
+		ctx, _ := inertiaContext(t, u, http.MethodPost, "/plans/subscribe", url.Values{
+			"planId":          {"pro"},
+			"priceId":         {"price_your_stripe_price_id_here"},
+			"paymentMethodId": {paymentMethodID},
+		})
+		require.NoError(t, h.Subscribe(ctx))
+
+		sub, err := c.ORM.Subscription.Query().
+			Where(subscription.HasCustomerWith(paymentcustomer.HasUserWith(user.ID(u.ID)))).
+			Only(ctx.Request().Context())
+		require.NoError(t, err)
+		assert.Equal(t, "fake", sub.Provider)
+		assert.Equal(t, int64(2900), sub.Amount)
+		return u, sub
+	}
+
+	// A successful charge activates the plan.
+	u, sub := subscribe("pm_card_visa")
+	assert.Equal(t, subscription.StatusActive, sub.Status)
+	plan, err := c.Entitlements.PlanFor(t.Context(), u)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", plan.ID)
+
+	// A declined charge leaves the subscription incomplete and the user on the free plan.
+	u, sub = subscribe("pm_card_chargeDeclined")
+	assert.Equal(t, subscription.StatusIncomplete, sub.Status)
+	plan, err = c.Entitlements.PlanFor(t.Context(), u)
+	require.NoError(t, err)
+	assert.Equal(t, "free", plan.ID)
+}
+
+func TestPlans__SubscribeWithPromotionCode(t *testing.T) {
+	subscribe := func(code string) *ent.Subscription {
+		t.Helper()
+		u := createTestUser(t)
+		h := new(Plans)
+		require.NoError(t, h.Init(c))
+
+		ctx, _ := inertiaContext(t, u, http.MethodPost, "/plans/subscribe", url.Values{
+			"planId":          {"pro"},
+			"paymentMethodId": {"pm_card_visa"},
+			"promotionCode":   {code},
+		})
+		require.NoError(t, h.Subscribe(ctx))
+
+		sub, err := c.ORM.Subscription.Query().
+			Where(subscription.HasCustomerWith(paymentcustomer.HasUserWith(user.ID(u.ID)))).
+			WithDiscounts().
+			Only(ctx.Request().Context())
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		require.NoError(t, err)
+		return sub
+	}
+
+	// Valid codes are applied to the subscription.
+	sub := subscribe("launch20")
+	require.NotNil(t, sub)
+	assert.Equal(t, subscription.StatusActive, sub.Status)
+	require.Len(t, sub.Edges.Discounts, 1)
+	assert.Equal(t, "LAUNCH20", sub.Edges.Discounts[0].Code)
+	assert.Equal(t, 20.0, sub.Edges.Discounts[0].PercentOff)
+
+	// Nothing is subscribed to when the code is invalid.
+	assert.Nil(t, subscribe("NOPE"))
+}
+
+func TestPlans__SubscribeWithTrial(t *testing.T) {
+	cfg := *c.Config
+	cfg.Payment.Plans = slices.Clone(cfg.Payment.Plans)
+	for i, plan := range cfg.Payment.Plans {
+		if plan.ID == "pro" {
+			cfg.Payment.Plans[i].TrialDays = 7
+		}
+	}
+
+	u := createTestUser(t)
+	h := new(Plans)
+	require.NoError(t, h.Init(c))
+	var err error
+	h.Entitlements, err = services.NewEntitlementsClient(&cfg, c.ORM)
+	require.NoError(t, err)
+
+	ctx, rec := inertiaContext(t, u, http.MethodGet, "/plans", nil)
+	require.NoError(t, h.Page(ctx))
+	props := inertiaProps(t, rec)
+	assert.Equal(t, float64(7), props["plans"].([]any)[0].(map[string]any)["trialDays"])
+
+	ctx, _ = inertiaContext(t, u, http.MethodPost, "/plans/subscribe", url.Values{
+		"planId":          {"pro"},
+		"paymentMethodId": {"pm_card_visa"},
+	})
+	require.NoError(t, h.Subscribe(ctx))
+
+	sub, err := c.ORM.Subscription.Query().
+		Where(subscription.HasCustomerWith(paymentcustomer.HasUserWith(user.ID(u.ID)))).
+		Only(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusTrialing, sub.Status)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 7), sub.TrialEnd, time.Minute)
+
+	// The plan's features are available during the trial.
+	plan, err := h.Entitlements.PlanFor(t.Context(), u)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", plan.ID)
+}
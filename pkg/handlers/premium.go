@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"github.com/labstack/echo/v4"
-	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/services"
@@ -10,9 +9,9 @@ import (
 )
 
 type Premium struct {
-	Inertia *inertia.Inertia
-	Auth    *services.AuthClient
-	ORM     *ent.Client
+	Inertia      *inertia.Inertia
+	Auth         *services.AuthClient
+	Entitlements *services.EntitlementsClient
 }
 
 func init() {
@@ -22,14 +21,14 @@ func init() {
 func (h *Premium) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.Auth = c.Auth
-	h.ORM = c.ORM
+	h.Entitlements = c.Entitlements
 	return nil
 }
 
 func (h *Premium) Routes(g *echo.Group) {
 	authGroup := g.Group("")
 	authGroup.Use(middleware.RequireAuthentication)
-	authGroup.Use(middleware.RequirePaidUser(h.ORM))
+	authGroup.Use(middleware.RequireFeature(h.Entitlements, services.FeaturePremium))
 	
 	authGroup.GET("/premium", h.Page).Name = routenames.Premium
 }
@@ -5,17 +5,21 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/pkg/form"
 	"github.com/occult/pagode/pkg/middleware"
+	"github.com/occult/pagode/pkg/msg"
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/services"
 	inertia "github.com/romsar/gonertia/v2"
 )
 
 type Products struct {
-	Inertia *inertia.Inertia
-	Payment *services.PaymentClient
-	Auth    *services.AuthClient
+	Inertia      *inertia.Inertia
+	Payment      *services.PaymentClient
+	Auth         *services.AuthClient
+	Entitlements *services.EntitlementsClient
 }
 
 func init() {
@@ -26,6 +30,7 @@ func (h *Products) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.Payment = c.Payment
 	h.Auth = c.Auth
+	h.Entitlements = c.Entitlements
 	return nil
 }
 
@@ -38,12 +43,9 @@ func (h *Products) Routes(g *echo.Group) {
 }
 
 func (h *Products) Page(ctx echo.Context) error {
-	product := map[string]interface{}{
-		"id":          "prod_your_stripe_product_id_here",
-		"name":        "Premium Product",
-		"description": "Access to premium features and content",
-		"price":       2999, // $29.99 in cents
-		"currency":    "usd",
+	products := h.Entitlements.Products()
+	if len(products) == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "No products are available")
 	}
 
 	return h.Inertia.Render(
@@ -52,17 +54,28 @@ func (h *Products) Page(ctx echo.Context) error {
 		"Products",
 		inertia.Props{
 			"title":               "Products",
-			"product":             product,
+			"product":             productProps(products[0]),
 			"stripePublishableKey": h.Payment.GetConfig().Payment.Stripe.PublishableKey,
+			"paymentProvider":      h.Payment.GetConfig().Payment.Provider,
 		},
 	)
 }
 
+// productProps converts a product of the catalog to the props the products page expects.
+func productProps(p config.ProductConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          p.ID,
+		"name":        p.Name,
+		"description": p.Description,
+		"price":       p.Amount,
+		"currency":    p.Currency,
+	}
+}
+
 type ProductPurchaseForm struct {
 	form.Submission
 	ProductID       string `form:"productId" validate:"required"`
 	PaymentMethodID string `form:"paymentMethodId" validate:"required"`
-	Amount          int    `form:"amount" validate:"required,min=1"`
 }
 
 func (h *Products) Purchase(ctx echo.Context) error {
@@ -76,6 +89,12 @@ func (h *Products) Purchase(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnauthorized, "User not authenticated")
 	}
 
+	// The price is taken from the catalog rather than the request
+	product, ok := h.Entitlements.Product(form.ProductID)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "Unknown product")
+	}
+
 	// Get or create Stripe customer
 	customer, err := h.Payment.CreateOrGetCustomer(ctx, user)
 	if err != nil {
@@ -92,23 +111,27 @@ func (h *Products) Purchase(ctx echo.Context) error {
 	paymentIntent, err := h.Payment.CreateOneTimePayment(
 		ctx,
 		customer,
-		int64(form.Amount),
-		"usd",
-		fmt.Sprintf("Purchase of product %s", form.ProductID),
+		product.Amount,
+		product.Currency,
+		fmt.Sprintf("Purchase of product %s", product.ID),
+		map[string]interface{}{
+			services.ProductMetadataKey: product.ID,
+		},
 	)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Payment failed: %v", err))
 	}
 
-	// If payment succeeded, you could store purchase record in database here
-	// For now, we'll just redirect with success message
+	// Charge the payment method
+	paymentIntent, err = h.Payment.ConfirmPaymentIntent(ctx, paymentIntent, form.PaymentMethodID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Payment failed: %v", err))
+	}
 
-	product := map[string]interface{}{
-		"id":          "prod_your_stripe_product_id_here",
-		"name":        "Premium Product",
-		"description": "Access to premium features and content",
-		"price":       2999, // $29.99 in cents
-		"currency":    "usd",
+	// The purchase is granted once the payment succeeds, which may only happen after the customer authenticates it
+	succeeded := paymentIntent.Status == paymentintent.StatusSucceeded
+	if !succeeded {
+		msg.Warning(ctx, "Your payment is pending. You'll get access as soon as it completes.")
 	}
 
 	return h.Inertia.Render(
@@ -117,9 +140,10 @@ func (h *Products) Purchase(ctx echo.Context) error {
 		"Products",
 		inertia.Props{
 			"title":               "Products",
-			"product":             product,
+			"product":             productProps(product),
 			"stripePublishableKey": h.Payment.GetConfig().Payment.Stripe.PublishableKey,
-			"success":             true,
+			"paymentProvider":      h.Payment.GetConfig().Payment.Provider,
+			"success":             succeeded,
 			"paymentIntentId":     paymentIntent.ProviderPaymentIntentID,
 		},
 	)
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProducts__Purchase(t *testing.T) {
+	h := new(Products)
+	require.NoError(t, h.Init(c))
+
+	purchase := func(paymentMethodID string) (map[string]any, error) {
+		t.Helper()
+		u := createTestUser(t)
+		ctx, rec := inertiaContext(t, u, http.MethodPost, "/products/purchase", url.Values{
+			"productId":       {"lifetime"},
+			"paymentMethodId": {paymentMethodID},
+		})
+		if err := h.Purchase(ctx); err != nil {
+			return nil, err
+		}
+
+		props := inertiaProps(t, rec)
+		pi, err := c.ORM.PaymentIntent.Query().
+			Where(paymentintent.HasCustomerWith(paymentcustomer.HasUserWith(user.ID(u.ID)))).
+			Only(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, pi.ProviderPaymentIntentID, props["paymentIntentId"])
+		assert.Equal(t, int64(2999), pi.Amount)
+		props["status"] = string(pi.Status)
+		return props, nil
+	}
+
+	props, err := purchase("pm_card_visa")
+	require.NoError(t, err)
+	assert.Equal(t, true, props["success"])
+	assert.Equal(t, "succeeded", props["status"])
+
+	// Cards which must be authenticated leave the payment pending.
+	props, err = purchase("pm_card_authenticationRequired")
+	require.NoError(t, err)
+	assert.Equal(t, false, props["success"])
+	assert.Equal(t, "requires_action", props["status"])
+
+	_, err = purchase("pm_card_chargeDeclined")
+	tests.AssertHTTPErrorCode(t, err, http.StatusBadRequest)
+	assert.ErrorContains(t, err, services.ErrFakePaymentDeclined.Error())
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/occult/pagode/pkg/msg"
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tasks"
 	inertia "github.com/romsar/gonertia/v2"
 )
 
@@ -292,9 +293,13 @@ func (h *Profile) ExtractBrandColors(ctx echo.Context) error {
 		return nil
 	}
 
-	err = h.jobs.Enqueue(ctx.Request().Context(), "extract_brand_colors", map[string]interface{}{
-		"user_id": usr.ID,
-	})
+	// The job reads the website when it runs, so a pending extraction already picks up the new URL.
+	err = h.jobs.EnqueueJSON(
+		ctx.Request().Context(),
+		"extract_brand_colors",
+		tasks.ExtractBrandColorsPayload{UserID: usr.ID},
+		services.WithUniqueKey(fmt.Sprintf("user:%d", usr.ID), services.ConflictIgnore),
+	)
 	if err != nil {
 		msg.Danger(ctx, "Failed to enqueue brand color extraction.")
 		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	entform "github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/form"
+	"github.com/occult/pagode/pkg/middleware"
+	"github.com/occult/pagode/pkg/msg"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+type Reports struct {
+	orm     *ent.Client
+	Inertia *inertia.Inertia
+}
+
+func init() {
+	Register(new(Reports))
+}
+
+func (h *Reports) Init(c *services.Container) error {
+	h.orm = c.ORM
+	h.Inertia = c.Inertia
+	return nil
+}
+
+func (h *Reports) Routes(g *echo.Group) {
+	reportsGroup := g.Group("/reports", middleware.RequireAuthentication)
+	reportsGroup.GET("", h.Index).Name = routenames.Reports
+	reportsGroup.POST("", h.Store).Name = routenames.ReportsStore
+	reportsGroup.DELETE("/:id", h.Delete).Name = routenames.ReportsDelete
+}
+
+type ReportSubscriptionForm struct {
+	form.Submission
+	Frequency string `form:"frequency" validate:"required,oneof=daily weekly monthly"`
+	Timezone  string `form:"timezone" validate:"required,timezone"`
+	FormID    int    `form:"formId"`
+}
+
+func (h *Reports) Index(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	subscriptions, err := h.orm.ReportSubscription.Query().
+		Where(reportsubscription.UserID(user.ID)).
+		WithForm().
+		Order(ent.Desc(reportsubscription.FieldCreatedAt)).
+		All(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to fetch report subscriptions", h.Inertia, ctx)
+	}
+
+	forms, err := h.orm.Form.Query().
+		Where(entform.UserID(user.ID)).
+		Order(ent.Asc(entform.FieldTitle)).
+		All(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to fetch forms", h.Inertia, ctx)
+	}
+
+	err = h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Reports/Index",
+		inertia.Props{
+			"subscriptions": subscriptions,
+			"forms":         forms,
+			"form":          form.Get[ReportSubscriptionForm](ctx),
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *Reports) Store(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	var input ReportSubscriptionForm
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Index(ctx)
+	default:
+		return err
+	}
+
+	create := h.orm.ReportSubscription.Create().
+		SetUserID(user.ID).
+		SetFrequency(reportsubscription.Frequency(input.Frequency)).
+		SetTimezone(input.Timezone)
+
+	if input.FormID != 0 {
+		exists, err := h.orm.Form.Query().
+			Where(entform.ID(input.FormID), entform.UserID(user.ID)).
+			Exist(ctx.Request().Context())
+		if err != nil {
+			return fail(err, "failed to fetch form", h.Inertia, ctx)
+		}
+		if !exists {
+			return fail(errors.New("form not found"), "invalid form", h.Inertia, ctx)
+		}
+		create.SetFormID(input.FormID)
+	}
+
+	if err := create.Exec(ctx.Request().Context()); err != nil {
+		return fail(err, "failed to create report subscription", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Report subscription created")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Reports))
+	return nil
+}
+
+func (h *Reports) Delete(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	id, err := parseID(ctx.Param("id"))
+	if err != nil {
+		return fail(err, "invalid report subscription ID", h.Inertia, ctx)
+	}
+
+	_, err = h.orm.ReportSubscription.Delete().
+		Where(
+			reportsubscription.ID(id),
+			reportsubscription.UserID(user.ID),
+		).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to delete report subscription", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Report subscription deleted")
+	ctx.Response().Header().Set("Location", ctx.Echo().Reverse(routenames.Reports))
+	ctx.Response().WriteHeader(http.StatusSeeOther)
+	return nil
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/sessions"
 	"github.com/labstack/echo/v4"
@@ -56,8 +57,12 @@ func BuildRouter(c *services.Container) error {
 			CookieHTTPOnly: false,                 // must be false so JS (Axios) can read it
 			CookieSameSite: http.SameSiteStrictMode,
 			ContextKey:     context.CSRFKey,
+			Skipper: func(ctx echo.Context) bool {
+				// Webhooks are verified by their signature instead.
+				return strings.HasPrefix(ctx.Path(), "/webhooks/")
+			},
 		}),
-		middleware.InertiaProps(), // leave this as the last one
+		middleware.InertiaProps(c.Payment), // leave this as the last one
 	)
 
 	// Error handler.
@@ -1,15 +1,20 @@
 package handlers
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/cookiejar"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/labstack/echo/v4"
 	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +31,11 @@ func TestMain(m *testing.M) {
 	// Set the environment to test
 	config.SwitchEnvironment(config.EnvTest)
 
+	// Use the in-memory payment provider so billing can be tested offline
+	if err := os.Setenv("PAGODA_PAYMENT_PROVIDER", "fake"); err != nil {
+		panic(err)
+	}
+
 	// Start a new container
 	c = services.NewContainer()
 
@@ -136,3 +146,26 @@ func (h *httpResponse) toDoc() *goquery.Document {
 	assert.NoError(h.t, err)
 	return doc
 }
+
+// inertiaContext creates a context for an Inertia request made by a logged in user, so handlers can be
+// called directly and respond with their props as JSON.
+func inertiaContext(t *testing.T, u *ent.User, method, target string, body url.Values) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body.Encode()))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	req.Header.Set("X-Inertia", "true")
+	rec := httptest.NewRecorder()
+	ctx := c.Web.NewContext(req, rec)
+
+	tests.InitSession(ctx)
+	require.NoError(t, c.Auth.Login(ctx, u.ID))
+	return ctx, rec
+}
+
+// inertiaProps decodes the props of the page an Inertia request responded with.
+func inertiaProps(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	var page struct {
+		Props map[string]any `json:"props"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	return page.Props
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/middleware"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+type Usage struct {
+	Inertia *inertia.Inertia
+	Usage   *services.UsageClient
+}
+
+func init() {
+	Register(new(Usage))
+}
+
+func (h *Usage) Init(c *services.Container) error {
+	h.Inertia = c.Inertia
+	h.Usage = c.Usage
+	return nil
+}
+
+func (h *Usage) Routes(g *echo.Group) {
+	authGroup := g.Group("")
+	authGroup.Use(middleware.RequireAuthentication)
+
+	authGroup.GET("/usage", h.Page).Name = routenames.Usage
+}
+
+// Page shows the authenticated user's usage during their current usage period against the limits of their plan.
+func (h *Usage) Page(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	report, err := h.Usage.Report(ctx.Request().Context(), user)
+	if err != nil {
+		return fail(err, "failed to load usage", h.Inertia, ctx)
+	}
+
+	err = h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Usage",
+		inertia.Props{
+			"title": "Usage",
+			"plan": map[string]string{
+				"id":   report.Plan.ID,
+				"name": report.Plan.Name,
+			},
+			"usage": report,
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// maxWebhookBodySize limits how much of a webhook request body is read.
+const maxWebhookBodySize = 1 << 20
+
+type Webhooks struct {
+	Payment *services.PaymentClient
+}
+
+func init() {
+	Register(new(Webhooks))
+}
+
+func (h *Webhooks) Init(c *services.Container) error {
+	h.Payment = c.Payment
+	return nil
+}
+
+func (h *Webhooks) Routes(g *echo.Group) {
+	g.POST("/webhooks/stripe", h.Stripe).Name = routenames.WebhooksStripe
+}
+
+// Stripe applies a Stripe webhook event to the database.
+// Errors respond with a 5xx status so Stripe retries the delivery later.
+func (h *Webhooks) Stripe(ctx echo.Context) error {
+	payload, err := io.ReadAll(http.MaxBytesReader(ctx.Response(), ctx.Request().Body, maxWebhookBodySize))
+	if err != nil {
+		return ctx.NoContent(http.StatusRequestEntityTooLarge)
+	}
+
+	event, err := h.Payment.ParseWebhook(payload, ctx.Request().Header.Get("Stripe-Signature"))
+	switch {
+	case errors.Is(err, services.ErrInvalidWebhookSignature):
+		log.Ctx(ctx).Warn("rejected webhook with an invalid signature", "error", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	case err != nil:
+		log.Ctx(ctx).Error("failed to parse webhook", "error", err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	processed, err := h.Payment.ProcessWebhookEvent(ctx.Request().Context(), event)
+	if err != nil {
+		log.Ctx(ctx).Error("failed to process webhook", "id", event.ID, "type", event.Type, "error", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if !processed {
+		log.Ctx(ctx).Info("skipped webhook which was already processed", "id", event.ID, "type", event.Type)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/occult/pagode/ent/processedevent"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooks__Stripe(t *testing.T) {
+	fake := c.Payment.Provider().(*services.FakeProvider)
+	payload, signature, err := fake.SignWebhook(&services.PaymentEvent{
+		ID:   "evt_1RHandlerTest01",
+		Type: "invoice.created",
+	})
+	require.NoError(t, err)
+
+	post := func(signature string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+c.Web.Reverse(routenames.WebhooksStripe), bytes.NewReader(payload))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Stripe-Signature", signature)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp
+	}
+
+	// Webhooks are not sent with a CSRF token so they must be verified by their signature.
+	resp := post("bogus")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = post(signature)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Redelivered events are acknowledged without being processed again.
+	resp = post(signature)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	count, err := c.ORM.ProcessedEvent.Query().
+		Where(processedevent.EventID("evt_1RHandlerTest01")).
+		Count(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -7,7 +7,10 @@ import (
 	"testing"
 
 	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
 	"github.com/occult/pagode/pkg/tests"
 
 	"github.com/stretchr/testify/require"
@@ -144,3 +147,48 @@ func TestRequireAdmin(t *testing.T) {
 	err = tests.ExecuteMiddleware(ctx, RequireAdmin)
 	assert.Nil(t, err)
 }
+
+func TestRequireFeature(t *testing.T) {
+	ctx, rec := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+	mw := RequireFeature(c.Entitlements, services.FeaturePremium)
+
+	// Not logged in
+	err := tests.ExecuteMiddleware(ctx, mw)
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+
+	// Login as a user on the free plan
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	err = c.Auth.Login(ctx, u.ID)
+	require.NoError(t, err)
+	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth))
+
+	// Redirected to upgrade
+	err = tests.ExecuteMiddleware(ctx, mw)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Equal(t, c.Web.Reverse(routenames.Plans), rec.Header().Get("Location"))
+
+	// Buy a product granting the feature
+	customer, err := c.ORM.PaymentCustomer.Create().
+		SetProviderCustomerID("cus_require_feature").
+		SetEmail(u.Email).
+		SetUser(u).
+		Save(goctx.Background())
+	require.NoError(t, err)
+	err = c.ORM.PaymentIntent.Create().
+		SetProviderPaymentIntentID("pi_require_feature").
+		SetStatus(paymentintent.StatusSucceeded).
+		SetAmount(2999).
+		SetMetadata(map[string]interface{}{services.ProductMetadataKey: "lifetime"}).
+		SetCustomer(customer).
+		Exec(goctx.Background())
+	require.NoError(t, err)
+
+	ctx, rec = tests.NewContext(c.Web, "/")
+	ctx.Set(context.AuthenticatedUserKey, u)
+	err = tests.ExecuteMiddleware(ctx, mw)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
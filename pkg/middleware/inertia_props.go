@@ -1,13 +1,20 @@
 package middleware
 
 import (
+	"time"
+
 	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/log"
 	"github.com/occult/pagode/pkg/msg"
+	"github.com/occult/pagode/pkg/services"
 	"github.com/romsar/gonertia/v2"
 )
 
-func InertiaProps() echo.MiddlewareFunc {
+// InertiaProps sets the props shared by every page, which include a banner for users whose subscription
+// payments fail. The banner is only loaded when a page is rendered, not for redirects or other responses.
+func InertiaProps(payment *services.PaymentClient) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
 			// Get authenticated user
@@ -27,6 +34,29 @@ func InertiaProps() echo.MiddlewareFunc {
 				}
 			}
 
+			// Warn users whose subscription payments fail
+			dunning := func() any {
+				u, ok := user.(*ent.User)
+				if !ok {
+					return nil
+				}
+
+				sub, err := payment.GetPastDueSubscription(ctx.Request().Context(), u)
+				switch {
+				case err != nil:
+					log.Ctx(ctx).Error("failed to load past due subscription", "error", err)
+					return nil
+				case sub == nil:
+					return nil
+				}
+				return map[string]any{
+					"status":      string(sub.Status),
+					"amount":      sub.Amount,
+					"currency":    sub.Currency,
+					"graceEndsAt": payment.GracePeriodEnd(sub).Format(time.RFC3339),
+				}
+			}
+
 			// Set Inertia props
 			newCtx := gonertia.SetProps(ctx.Request().Context(), map[string]any{
 				"flash": flash,
@@ -34,6 +64,7 @@ func InertiaProps() echo.MiddlewareFunc {
 					"user": user,
 				},
 				"sidebarOpen": false,
+				"dunning":     dunning,
 			})
 
 			// Replace request context
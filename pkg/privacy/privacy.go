@@ -0,0 +1,63 @@
+package privacy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strings"
+
+	"github.com/occult/pagode/ent/form"
+)
+
+// RespondentMetadata returns the IP address and user agent that should be stored for a response
+// according to the form's metadata storage mode.
+// Hashed values are keyed with the given secret so they cannot be reversed with a lookup table.
+func RespondentMetadata(mode form.MetadataStorage, ip, userAgent, secret string) (string, string) {
+	switch mode {
+	case form.MetadataStorageNone:
+		return "", ""
+	case form.MetadataStorageTruncated:
+		return TruncateIP(ip), TruncateUserAgent(userAgent)
+	case form.MetadataStorageHashed:
+		return Hash(ip, secret), Hash(userAgent, secret)
+	default:
+		return ip, userAgent
+	}
+}
+
+// TruncateIP removes the host portion of an IP address, keeping the /24 network for IPv4
+// and the /48 network for IPv6.
+func TruncateIP(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return parsed.Mask(net.CIDRMask(48, 128)).String()
+}
+
+// TruncateUserAgent keeps the leading product token and platform of a user agent,
+// dropping browser and version details.
+func TruncateUserAgent(userAgent string) string {
+	if i := strings.Index(userAgent, ")"); i >= 0 {
+		return userAgent[:i+1]
+	}
+	if i := strings.Index(userAgent, " "); i >= 0 {
+		return userAgent[:i]
+	}
+	return userAgent
+}
+
+// Hash returns a hex encoded HMAC-SHA256 of the value, or an empty string if the value is empty.
+func Hash(value, secret string) string {
+	if value == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
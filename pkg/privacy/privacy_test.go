@@ -0,0 +1,42 @@
+package privacy
+
+import (
+	"testing"
+
+	"github.com/occult/pagode/ent/form"
+	"github.com/stretchr/testify/assert"
+)
+
+const ua = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 Chrome/120.0 Safari/537.36"
+
+func TestRespondentMetadata(t *testing.T) {
+	ip, agent := RespondentMetadata(form.MetadataStorageFull, "192.168.1.25", ua, "secret")
+	assert.Equal(t, "192.168.1.25", ip)
+	assert.Equal(t, ua, agent)
+
+	ip, agent = RespondentMetadata(form.MetadataStorageNone, "192.168.1.25", ua, "secret")
+	assert.Empty(t, ip)
+	assert.Empty(t, agent)
+
+	ip, agent = RespondentMetadata(form.MetadataStorageTruncated, "192.168.1.25", ua, "secret")
+	assert.Equal(t, "192.168.1.0", ip)
+	assert.Equal(t, "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)", agent)
+
+	ip, agent = RespondentMetadata(form.MetadataStorageHashed, "192.168.1.25", ua, "secret")
+	assert.Len(t, ip, 64)
+	assert.Len(t, agent, 64)
+	assert.Equal(t, ip, Hash("192.168.1.25", "secret"))
+	assert.NotEqual(t, ip, Hash("192.168.1.25", "other"))
+}
+
+func TestTruncateIP(t *testing.T) {
+	assert.Equal(t, "10.1.2.0", TruncateIP("10.1.2.3"))
+	assert.Equal(t, "2001:db8:85a3::", TruncateIP("2001:db8:85a3:8d3:1319:8a2e:370:7348"))
+	assert.Empty(t, TruncateIP("not-an-ip"))
+}
+
+func TestTruncateUserAgent(t *testing.T) {
+	assert.Equal(t, "curl/8.0", TruncateUserAgent("curl/8.0"))
+	assert.Equal(t, "Go-http-client/1.1", TruncateUserAgent("Go-http-client/1.1 extra"))
+	assert.Empty(t, Hash("", "secret"))
+}
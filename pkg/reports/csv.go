@@ -0,0 +1,52 @@
+package reports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/answers"
+)
+
+// ResponsesCSV renders responses as a CSV export with one or more columns per question.
+// Answers must already be decrypted and loaded with their question.
+func ResponsesCSV(questions []*ent.Question, responses []*ent.Response) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	w := csv.NewWriter(buf)
+
+	header := []string{"Submitted At", "IP Address", "User Agent", "Completed"}
+	for _, q := range questions {
+		header = append(header, answers.Columns(q)...)
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, resp := range responses {
+		row := []string{
+			resp.SubmittedAt.Format("2006-01-02 15:04:05"),
+			resp.IPAddress,
+			resp.UserAgent,
+			strconv.FormatBool(resp.Completed),
+		}
+
+		answerMap := make(map[int]string)
+		for _, a := range resp.Edges.Answers {
+			if a.Edges.Question != nil {
+				answerMap[a.Edges.Question.ID] = a.Value
+			}
+		}
+
+		for _, q := range questions {
+			row = append(row, answers.Cells(q, answerMap[q.ID])...)
+		}
+
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
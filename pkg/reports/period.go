@@ -0,0 +1,64 @@
+package reports
+
+import (
+	"time"
+
+	// Embed the timezone database so report periods work on hosts without one.
+	_ "time/tzdata"
+
+	"github.com/occult/pagode/ent/reportsubscription"
+)
+
+// Period is a half-open time range covered by a report.
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains returns true if t falls within the period.
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// LastCompleted returns the most recent full period before now in the given location.
+// Weeks start on Monday.
+func LastCompleted(freq reportsubscription.Frequency, now time.Time, loc *time.Location) Period {
+	now = now.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	var end time.Time
+	switch freq {
+	case reportsubscription.FrequencyDaily:
+		end = today
+	case reportsubscription.FrequencyMonthly:
+		end = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		offset := (int(today.Weekday()) + 6) % 7
+		end = today.AddDate(0, 0, -offset)
+	}
+
+	return Period{
+		Start: step(freq, end, -1),
+		End:   end,
+	}
+}
+
+// Previous returns the period immediately before p.
+func (p Period) Previous(freq reportsubscription.Frequency) Period {
+	return Period{
+		Start: step(freq, p.Start, -1),
+		End:   p.Start,
+	}
+}
+
+// step moves t by n periods of the given frequency, keeping wall clock times across DST changes.
+func step(freq reportsubscription.Frequency, t time.Time, n int) time.Time {
+	switch freq {
+	case reportsubscription.FrequencyDaily:
+		return t.AddDate(0, 0, n)
+	case reportsubscription.FrequencyMonthly:
+		return t.AddDate(0, n, 0)
+	default:
+		return t.AddDate(0, 0, 7*n)
+	}
+}
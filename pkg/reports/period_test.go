@@ -0,0 +1,66 @@
+package reports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastCompleted(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Wednesday, 2024-03-13 01:30 in New York, three days after the switch to daylight saving time.
+	now := time.Date(2024, 3, 13, 5, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		freq  reportsubscription.Frequency
+		start time.Time
+		end   time.Time
+	}{
+		"daily": {
+			freq:  reportsubscription.FrequencyDaily,
+			start: time.Date(2024, 3, 12, 0, 0, 0, 0, loc),
+			end:   time.Date(2024, 3, 13, 0, 0, 0, 0, loc),
+		},
+		"weekly across dst": {
+			freq:  reportsubscription.FrequencyWeekly,
+			start: time.Date(2024, 3, 4, 0, 0, 0, 0, loc),
+			end:   time.Date(2024, 3, 11, 0, 0, 0, 0, loc),
+		},
+		"monthly": {
+			freq:  reportsubscription.FrequencyMonthly,
+			start: time.Date(2024, 2, 1, 0, 0, 0, 0, loc),
+			end:   time.Date(2024, 3, 1, 0, 0, 0, 0, loc),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := LastCompleted(tc.freq, now, loc)
+			assert.True(t, tc.start.Equal(p.Start), "start %s", p.Start)
+			assert.True(t, tc.end.Equal(p.End), "end %s", p.End)
+		})
+	}
+
+	// The week containing the DST change is an hour shorter but still starts at local midnight.
+	p := LastCompleted(reportsubscription.FrequencyWeekly, now, loc)
+	assert.Equal(t, 7*24*time.Hour-time.Hour, p.End.Sub(p.Start))
+}
+
+func TestPeriodPrevious(t *testing.T) {
+	p := Period{
+		Start: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	prev := p.Previous(reportsubscription.FrequencyMonthly)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), prev.Start)
+	assert.Equal(t, p.Start, prev.End)
+
+	assert.True(t, p.Contains(p.Start))
+	assert.False(t, p.Contains(p.End))
+}
@@ -0,0 +1,230 @@
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/question"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// topAnswersLimit is the number of most common answers listed per question.
+const topAnswersLimit = 3
+
+type (
+	// Report summarises the responses received by one or more forms during a period.
+	Report struct {
+		Period   Period
+		Previous Period
+		Forms    []FormSummary
+	}
+
+	// FormSummary summarises the responses received by a single form.
+	FormSummary struct {
+		Form       *ent.Form
+		Responses  int
+		Previous   int
+		TopAnswers []QuestionSummary
+
+		// CSV contains the export of the responses received during the period.
+		CSV []byte
+	}
+
+	// QuestionSummary lists the most common answers to a choice question.
+	QuestionSummary struct {
+		Question string
+		Answers  []AnswerCount
+	}
+
+	// AnswerCount is the number of times an answer was given.
+	AnswerCount struct {
+		Value string
+		Count int
+	}
+)
+
+// Build generates the report for a subscription covering the given period.
+// Reports are emailed, so answers to sensitive questions are left out of them entirely.
+func Build(
+	ctx context.Context,
+	orm *ent.Client,
+	enc *services.EncryptionClient,
+	sub *ent.ReportSubscription,
+	period Period,
+) (*Report, error) {
+	query := orm.Form.Query().
+		Where(form.UserID(sub.UserID)).
+		WithQuestions(func(q *ent.QuestionQuery) {
+			q.Order(ent.Asc(question.FieldOrder))
+		}).
+		Order(ent.Asc(form.FieldTitle))
+	if sub.FormID != nil {
+		query = query.Where(form.ID(*sub.FormID))
+	}
+
+	forms, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Report{
+		Period:   period,
+		Previous: period.Previous(sub.Frequency),
+		Forms:    make([]FormSummary, 0, len(forms)),
+	}
+
+	for _, f := range forms {
+		responses, err := orm.Response.Query().
+			Where(
+				response.HasFormWith(form.ID(f.ID)),
+				response.SubmittedAtGTE(period.Start),
+				response.SubmittedAtLT(period.End),
+			).
+			WithAnswers(func(q *ent.AnswerQuery) {
+				q.WithQuestion()
+			}).
+			Order(ent.Asc(response.FieldSubmittedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		previous, err := orm.Response.Query().
+			Where(
+				response.HasFormWith(form.ID(f.ID)),
+				response.SubmittedAtGTE(r.Previous.Start),
+				response.SubmittedAtLT(r.Previous.End),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resp := range responses {
+			resp.Edges.Answers = withoutSensitive(resp.Edges.Answers)
+			if err := enc.DecryptAnswers(resp.Edges.Answers); err != nil {
+				return nil, err
+			}
+		}
+
+		questions := make([]*ent.Question, 0, len(f.Edges.Questions))
+		for _, q := range f.Edges.Questions {
+			if !q.Sensitive {
+				questions = append(questions, q)
+			}
+		}
+
+		summary := FormSummary{
+			Form:       f,
+			Responses:  len(responses),
+			Previous:   previous,
+			TopAnswers: topAnswers(questions, responses),
+		}
+
+		if len(responses) > 0 {
+			if summary.CSV, err = ResponsesCSV(questions, responses); err != nil {
+				return nil, err
+			}
+		}
+
+		r.Forms = append(r.Forms, summary)
+	}
+
+	return r, nil
+}
+
+// TotalResponses returns the number of responses received across all forms during the period.
+func (r *Report) TotalResponses() int {
+	total := 0
+	for _, f := range r.Forms {
+		total += f.Responses
+	}
+	return total
+}
+
+// Trend returns the percentage change in responses versus the previous period.
+// False is returned if there were no responses in the previous period.
+func (s FormSummary) Trend() (float64, bool) {
+	if s.Previous == 0 {
+		return 0, false
+	}
+	return float64(s.Responses-s.Previous) / float64(s.Previous) * 100, true
+}
+
+// withoutSensitive returns the answers which are not to sensitive questions.
+func withoutSensitive(answers []*ent.Answer) []*ent.Answer {
+	kept := make([]*ent.Answer, 0, len(answers))
+	for _, a := range answers {
+		if a.Edges.Question != nil && !a.Edges.Question.Sensitive {
+			kept = append(kept, a)
+		}
+	}
+	return kept
+}
+
+// isChoice returns true if answers to the question type come from a fixed set of values.
+func isChoice(t question.Type) bool {
+	switch t {
+	case question.TypeDropdown, question.TypeRadio, question.TypeCheckbox, question.TypeMultiSelect,
+		question.TypePictureChoice, question.TypeYesno, question.TypeRating, question.TypeOpinionScale:
+		return true
+	}
+	return false
+}
+
+// topAnswers counts the most common answers to each choice question. Sensitive questions are left out.
+func topAnswers(questions []*ent.Question, responses []*ent.Response) []QuestionSummary {
+	summaries := make([]QuestionSummary, 0)
+
+	for _, q := range questions {
+		if q.Sensitive || !isChoice(q.Type) {
+			continue
+		}
+
+		counts := make(map[string]int)
+		for _, resp := range responses {
+			for _, a := range resp.Edges.Answers {
+				if a.Edges.Question == nil || a.Edges.Question.ID != q.ID {
+					continue
+				}
+
+				var values []string
+				if err := json.Unmarshal([]byte(a.Value), &values); err != nil {
+					values = []string{a.Value}
+				}
+				for _, v := range values {
+					counts[v]++
+				}
+			}
+		}
+
+		if len(counts) == 0 {
+			continue
+		}
+
+		list := make([]AnswerCount, 0, len(counts))
+		for v, c := range counts {
+			list = append(list, AnswerCount{Value: v, Count: c})
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Count == list[j].Count {
+				return list[i].Value < list[j].Value
+			}
+			return list[i].Count > list[j].Count
+		})
+		if len(list) > topAnswersLimit {
+			list = list[:topAnswersLimit]
+		}
+
+		summaries = append(summaries, QuestionSummary{
+			Question: q.Title,
+			Answers:  list,
+		})
+	}
+
+	return summaries
+}
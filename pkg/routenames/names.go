@@ -33,6 +33,14 @@ const (
 	Files                 = "files"
 	FilesSubmit           = "files.submit"
 	AdminTasks            = "admin:tasks"
+	AdminJobs             = "admin:jobs"
+	AdminJobShow          = "admin:jobs.show"
+	AdminJobRetry         = "admin:jobs.retry"
+	AdminJobCancel        = "admin:jobs.cancel"
+	AdminJobsPurge        = "admin:jobs.purge"
+	AdminJobSchedules     = "admin:job_schedules"
+	AdminJobsDead         = "admin:jobs.dead"
+	AdminJobsDeadRetry    = "admin:jobs.dead.retry"
 	ProfileEdit           = "profile.edit"
 	ProfileUpdate         = "profile.update"
 	ProfileDestroy        = "profile.destroy"
@@ -47,6 +55,16 @@ const (
 	Premium               = "premium"
 	Billing               = "billing"
 	BillingCancel         = "billing.cancel"
+	BillingPreview        = "billing.preview"
+	BillingChange         = "billing.change"
+	BillingChangeCancel   = "billing.change.cancel"
+	BillingReactivate     = "billing.reactivate"
+	BillingInvoice        = "billing.invoice"
+	BillingInvoicesSync   = "billing.invoices.sync"
+	BillingPayouts        = "billing.payouts"
+	BillingPayoutsReturn  = "billing.payouts.return"
+	WebhooksStripe        = "webhooks.stripe"
+	Usage                 = "usage"
 	Forms                 = "forms"
 	FormsCreate           = "forms.create"
 	FormsStore            = "forms.store"
@@ -57,9 +75,23 @@ const (
 	FormsView             = "forms.view"
 	FormsSubmit           = "forms.submit"
 	FormsThankYou         = "forms.thank_you"
+	FormsPayment          = "forms.payment"
+	FormsPay              = "forms.pay"
 	FormsResponses        = "forms.responses"
 	FormsResponsesShow    = "forms.responses.show"
 	FormsResponsesExport  = "forms.responses.export"
+	FormsResponsesBulk    = "forms.responses.bulk"
+	FormsResponsesUpdate  = "forms.responses.update"
+	FormsResponsesStatus  = "forms.responses.status"
+	FormsResponsesNotes   = "forms.responses.notes"
+	FormsResponsesTags    = "forms.responses.tags"
+	FormsResponsesDelete  = "forms.responses.delete"
+	FormsRespondents      = "forms.respondents"
+	FormsRespondentsExport = "forms.respondents.export"
+	FormsRespondentsErase = "forms.respondents.erase"
+	Reports               = "reports"
+	ReportsStore          = "reports.store"
+	ReportsDelete         = "reports.delete"
 )
 
 func AdminEntityList(entityTypeName string) string {
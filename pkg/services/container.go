@@ -64,6 +64,9 @@ type Container struct {
 	// Auth stores an authentication client.
 	Auth *AuthClient
 
+	// Encryption stores a client for encrypting sensitive data at rest.
+	Encryption *EncryptionClient
+
 	// Tasks stores the task client.
 	Tasks *backlite.Client
 
@@ -73,6 +76,12 @@ type Container struct {
 	// Payment stores the payment client.
 	Payment *PaymentClient
 
+	// Entitlements stores the client resolving the plan users are on and what it allows.
+	Entitlements *EntitlementsClient
+
+	// Usage stores the client metering usage against plan limits.
+	Usage *UsageClient
+
 	// Inertia for React
 	Inertia *inertia.Inertia
 }
@@ -88,9 +97,12 @@ func NewContainer() *Container {
 	c.initFiles()
 	c.initORM()
 	c.initAuth()
+	c.initEncryption()
 	c.initMail()
 	c.initTasks()
 	c.initJobs()
+	c.initEntitlements()
+	c.initUsage()
 	c.initPayment()
 	c.initInertia()
 	return c
@@ -259,6 +271,15 @@ func (c *Container) initAuth() {
 	c.Auth = NewAuthClient(c.Config, c.ORM)
 }
 
+// initEncryption initializes the encryption client.
+func (c *Container) initEncryption() {
+	var err error
+	c.Encryption, err = NewEncryptionClient(c.Config)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create encryption client: %v", err))
+	}
+}
+
 // initMail initialize the mail client.
 func (c *Container) initMail() {
 	var err error
@@ -289,9 +310,16 @@ func (c *Container) initTasks() {
 }
 
 // initJobs initializes the job worker.
+// The worker is not started so that commands which only need to enqueue jobs do not run them; see cmd/web and cmd/worker.
 func (c *Container) initJobs() {
-	c.Jobs = NewJobWorker(c.ORM)
-	c.Jobs.Start()
+	c.Jobs = NewJobWorker(c.ORM, c.Config, c.Validator)
+
+	if c.Config.Tasks.Alerts.Email {
+		c.Jobs.AddAlertHook(NewQueueAlertHook(c.Jobs, "notify_job_alert"))
+	}
+	if url := c.Config.Tasks.Alerts.Webhook; url != "" {
+		c.Jobs.AddAlertHook(NewWebhookAlertHook(url))
+	}
 }
 
 // initPayment initializes the payment client.
@@ -301,11 +329,28 @@ func (c *Container) initPayment() {
 	switch c.Config.Payment.Provider {
 	case "stripe":
 		provider = NewStripeProvider(c.Config)
+	case "fake":
+		provider = NewFakeProvider(c.Config)
 	default:
 		panic(fmt.Sprintf("unsupported payment provider: %s", c.Config.Payment.Provider))
 	}
 
 	c.Payment = NewPaymentClient(c.Config, c.ORM, provider)
+	c.Payment.usage = c.Usage
+	c.Payment.jobs = c.Jobs
+}
+
+// initEntitlements initializes the entitlements client.
+func (c *Container) initEntitlements() {
+	var err error
+	if c.Entitlements, err = NewEntitlementsClient(c.Config, c.ORM); err != nil {
+		panic(fmt.Sprintf("failed to load plan catalog: %v", err))
+	}
+}
+
+// initUsage initializes the usage client.
+func (c *Container) initUsage() {
+	c.Usage = NewUsageClient(c.Config, c.ORM, c.Entitlements)
 }
 
 func ProjectRoot() string {
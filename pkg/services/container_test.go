@@ -16,6 +16,11 @@ func TestNewContainer(t *testing.T) {
 	assert.NotNil(t, c.ORM)
 	assert.NotNil(t, c.Mail)
 	assert.NotNil(t, c.Auth)
+	assert.NotNil(t, c.Jobs)
+	assert.NotNil(t, c.Entitlements)
+	assert.NotNil(t, c.Usage)
+	// The job worker is only started by the commands which run jobs.
+	assert.False(t, c.Jobs.Health().Healthy)
 	// Tasks disabled for MySQL - see container.go:239-253
 	// assert.NotNil(t, c.Tasks)
 }
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/answer"
+	"github.com/occult/pagode/ent/question"
+)
+
+// encryptedPrefix marks values that were encrypted by the EncryptionClient.
+const encryptedPrefix = "enc:v1:"
+
+// ErrUnknownKey is returned when a value was encrypted with a key that is no longer configured.
+var ErrUnknownKey = errors.New("value was encrypted with an unknown key")
+
+type (
+	// EncryptionClient encrypts sensitive values at rest using envelope encryption.
+	// Each value is encrypted with a random data key which is then wrapped by a key-encryption key derived
+	// from the configuration. Only the key-encryption keys need rotating since data keys are never reused.
+	EncryptionClient struct {
+		// current is the ID of the key used for new values.
+		current string
+
+		// keys contains every configured key-encryption key, keyed by ID.
+		keys map[string][]byte
+	}
+)
+
+// NewEncryptionClient creates a new EncryptionClient from the App.AnswerKeys configuration, falling
+// back to a key derived from App.EncryptionKey.
+func NewEncryptionClient(cfg *config.Config) (*EncryptionClient, error) {
+	secrets := cfg.App.AnswerKeys
+	if len(secrets) == 0 {
+		secrets = []string{cfg.App.EncryptionKey}
+	}
+
+	c := &EncryptionClient{
+		keys: make(map[string][]byte, len(secrets)),
+	}
+
+	for i, secret := range secrets {
+		if secret == "" {
+			return nil, errors.New("encryption keys cannot be empty")
+		}
+
+		id, key := deriveKey(secret)
+		if i == 0 {
+			c.current = id
+		}
+		c.keys[id] = key
+	}
+
+	return c, nil
+}
+
+// deriveKey derives a 256-bit key-encryption key from a secret along with a short, non-secret ID for it.
+func deriveKey(secret string) (string, []byte) {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("pagode:answers:kek"))
+	key := mac.Sum(nil)
+
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4]), key
+}
+
+// IsEncrypted returns true if the value was produced by Encrypt.
+func (c *EncryptionClient) IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+// IsCurrent returns true if the value is encrypted with the current key.
+func (c *EncryptionClient) IsCurrent(value string) bool {
+	id, _, _, err := c.parse(value)
+	return err == nil && id == c.current
+}
+
+// Encrypt encrypts a value with a new data key wrapped by the current key-encryption key.
+func (c *EncryptionClient) Encrypt(value string) (string, error) {
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return "", err
+	}
+
+	wrapped, err := seal(c.keys[c.current], dek)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := seal(dek, []byte(value))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%s:%s:%s",
+		encryptedPrefix,
+		c.current,
+		base64.RawStdEncoding.EncodeToString(wrapped),
+		base64.RawStdEncoding.EncodeToString(data),
+	), nil
+}
+
+// Decrypt decrypts a value produced by Encrypt. Values that are not encrypted are returned as-is.
+func (c *EncryptionClient) Decrypt(value string) (string, error) {
+	if !c.IsEncrypted(value) {
+		return value, nil
+	}
+
+	id, wrapped, data, err := c.parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	kek, ok := c.keys[id]
+	if !ok {
+		return "", ErrUnknownKey
+	}
+
+	dek, err := open(kek, wrapped)
+	if err != nil {
+		return "", fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+
+	plain, err := open(dek, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value: %w", err)
+	}
+
+	return string(plain), nil
+}
+
+// Reencrypt returns the value encrypted with the current key, decrypting it first if needed.
+// The second return value is false if the value was already encrypted with the current key.
+func (c *EncryptionClient) Reencrypt(value string) (string, bool, error) {
+	if c.IsCurrent(value) {
+		return value, false, nil
+	}
+
+	plain, err := c.Decrypt(value)
+	if err != nil {
+		return "", false, err
+	}
+
+	enc, err := c.Encrypt(plain)
+	if err != nil {
+		return "", false, err
+	}
+	return enc, true, nil
+}
+
+// DecryptAnswer returns the plain value of an answer, which must be loaded with its question.
+// Answers to sensitive questions must decrypt. Answers to other questions are only decrypted if they were
+// encrypted while the question was sensitive, so a value a respondent typed which merely looks encrypted is
+// returned as-is.
+func (c *EncryptionClient) DecryptAnswer(a *ent.Answer) (string, error) {
+	value, err := c.Decrypt(a.Value)
+	if err != nil && a.Edges.Question != nil && !a.Edges.Question.Sensitive {
+		return a.Value, nil
+	}
+	return value, err
+}
+
+// DecryptAnswers decrypts the values of the given answers in place so they can be shown to the form owner.
+// The answers must be loaded with their question.
+func (c *EncryptionClient) DecryptAnswers(answers []*ent.Answer) error {
+	for _, a := range answers {
+		value, err := c.DecryptAnswer(a)
+		if err != nil {
+			return err
+		}
+		a.Value = value
+	}
+	return nil
+}
+
+// ReencryptAnswers encrypts every answer to a sensitive question with the current key.
+// This should be run after adding a new key so older keys can be removed, and after flagging existing
+// questions as sensitive. The number of updated answers is returned.
+func (c *EncryptionClient) ReencryptAnswers(ctx context.Context, orm *ent.Client) (int, error) {
+	const batchSize = 500
+
+	updated := 0
+	lastID := 0
+	for {
+		answers, err := orm.Answer.Query().
+			Where(
+				answer.IDGT(lastID),
+				answer.HasQuestionWith(question.Sensitive(true)),
+			).
+			Order(ent.Asc(answer.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return updated, err
+		}
+
+		for _, a := range answers {
+			value, changed, err := c.Reencrypt(a.Value)
+			if err != nil {
+				return updated, fmt.Errorf("answer %d: %w", a.ID, err)
+			}
+			if !changed {
+				continue
+			}
+
+			if err := orm.Answer.UpdateOne(a).SetValue(value).Exec(ctx); err != nil {
+				return updated, err
+			}
+			updated++
+		}
+
+		if len(answers) < batchSize {
+			return updated, nil
+		}
+		lastID = answers[len(answers)-1].ID
+	}
+}
+
+func (c *EncryptionClient) parse(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, encryptedPrefix), ":")
+	if !c.IsEncrypted(value) || len(parts) != 3 {
+		return "", nil, nil, errors.New("value is not encrypted")
+	}
+
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	data, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return parts[0], wrapped, data, nil
+}
+
+// seal encrypts plaintext with AES-256-GCM, prefixing the random nonce to the ciphertext.
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open decrypts a value produced by seal.
+func open(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce, data := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, data, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEncryptionClient(t *testing.T, keys ...string) *EncryptionClient {
+	cfg := &config.Config{}
+	cfg.App.EncryptionKey = "app-key"
+	cfg.App.AnswerKeys = keys
+
+	enc, err := NewEncryptionClient(cfg)
+	require.NoError(t, err)
+	return enc
+}
+
+func TestEncryptionClient__EncryptDecrypt(t *testing.T) {
+	enc := newTestEncryptionClient(t)
+
+	value, err := enc.Encrypt("123-45-6789")
+	require.NoError(t, err)
+	assert.True(t, enc.IsEncrypted(value))
+	assert.True(t, enc.IsCurrent(value))
+	assert.NotContains(t, value, "123-45-6789")
+
+	again, err := enc.Encrypt("123-45-6789")
+	require.NoError(t, err)
+	assert.NotEqual(t, value, again)
+
+	plain, err := enc.Decrypt(value)
+	require.NoError(t, err)
+	assert.Equal(t, "123-45-6789", plain)
+
+	plain, err = enc.Decrypt("not encrypted")
+	require.NoError(t, err)
+	assert.Equal(t, "not encrypted", plain)
+
+	_, err = enc.Decrypt(value[:len(value)-4] + "AAAA")
+	assert.Error(t, err)
+}
+
+func TestEncryptionClient__Rotation(t *testing.T) {
+	old := newTestEncryptionClient(t, "old-key")
+	value, err := old.Encrypt("secret")
+	require.NoError(t, err)
+
+	rotated := newTestEncryptionClient(t, "new-key", "old-key")
+	assert.False(t, rotated.IsCurrent(value))
+
+	plain, err := rotated.Decrypt(value)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", plain)
+
+	updated, changed, err := rotated.Reencrypt(value)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, rotated.IsCurrent(updated))
+
+	_, changed, err = rotated.Reencrypt(updated)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, err = newTestEncryptionClient(t, "new-key").Decrypt(value)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+
+	_, err = NewEncryptionClient(&config.Config{})
+	assert.Error(t, err)
+}
+
+func TestEncryptionClient__DecryptAnswers(t *testing.T) {
+	enc := newTestEncryptionClient(t)
+	value, err := enc.Encrypt("secret")
+	require.NoError(t, err)
+
+	sensitive := &ent.Question{Sensitive: true}
+	plain := &ent.Question{}
+	answer := func(q *ent.Question, value string) *ent.Answer {
+		return &ent.Answer{Value: value, Edges: ent.AnswerEdges{Question: q}}
+	}
+
+	answers := []*ent.Answer{
+		answer(sensitive, value),
+		answer(plain, value),
+		answer(plain, "enc:v1:x"),
+	}
+	require.NoError(t, enc.DecryptAnswers(answers))
+	assert.Equal(t, "secret", answers[0].Value)
+	assert.Equal(t, "secret", answers[1].Value)
+	assert.Equal(t, "enc:v1:x", answers[2].Value)
+
+	// Answers to sensitive questions must decrypt.
+	assert.Error(t, enc.DecryptAnswers([]*ent.Answer{answer(sensitive, "enc:v1:x")}))
+}
+
+func TestEncryptionClient__ReencryptAnswers(t *testing.T) {
+	ctx := context.Background()
+
+	f, err := c.ORM.Form.Create().
+		SetTitle("Health").
+		SetSlug(fmt.Sprintf("health-%d", time.Now().UnixNano())).
+		SetOwner(usr).
+		Save(ctx)
+	require.NoError(t, err)
+
+	sensitive, err := c.ORM.Question.Create().
+		SetTitle("ID number").
+		SetSensitive(true).
+		SetFormID(f.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	plain, err := c.ORM.Question.Create().
+		SetTitle("Name").
+		SetFormID(f.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	r, err := c.ORM.Response.Create().
+		SetFormID(f.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	old := newTestEncryptionClient(t, "old-key")
+	oldValue, err := old.Encrypt("A123")
+	require.NoError(t, err)
+
+	encrypted, err := c.ORM.Answer.Create().
+		SetResponseID(r.ID).
+		SetQuestionID(sensitive.ID).
+		SetValue(oldValue).
+		Save(ctx)
+	require.NoError(t, err)
+
+	unencrypted, err := c.ORM.Answer.Create().
+		SetResponseID(r.ID).
+		SetQuestionID(plain.ID).
+		SetValue("Alice").
+		Save(ctx)
+	require.NoError(t, err)
+
+	rotated := newTestEncryptionClient(t, "new-key", "old-key")
+	count, err := rotated.ReencryptAnswers(ctx, c.ORM)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, count, 1)
+
+	a, err := c.ORM.Answer.Get(ctx, encrypted.ID)
+	require.NoError(t, err)
+	assert.True(t, rotated.IsCurrent(a.Value))
+
+	value, err := newTestEncryptionClient(t, "new-key").Decrypt(a.Value)
+	require.NoError(t, err)
+	assert.Equal(t, "A123", value)
+
+	a, err = c.ORM.Answer.Get(ctx, unencrypted.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", a.Value)
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/currency"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Feature is something a plan can unlock.
+type Feature string
+
+const (
+	// FeaturePremium grants access to the premium area.
+	FeaturePremium Feature = "premium"
+
+	// FeatureWebhooks allows forms to send their responses to webhooks.
+	FeatureWebhooks Feature = "webhooks"
+
+	// FeatureRemoveBranding removes our branding from published forms.
+	FeatureRemoveBranding Feature = "remove_branding"
+)
+
+// ProductMetadataKey is the payment intent metadata key holding the ID of the product which was bought.
+const ProductMetadataKey = "product_id"
+
+// legacyPurchaseDescription prefixes the description of payments for products which were bought before the
+// product was recorded in their metadata.
+const legacyPurchaseDescription = "Purchase of product "
+
+// EntitlementsClient resolves which plan a user is on from the plan catalog and their payments, and what
+// the plan allows them to do.
+type EntitlementsClient struct {
+	config *config.Config
+	orm    *ent.Client
+}
+
+// NewEntitlementsClient creates a new EntitlementsClient.
+// An error is returned if the catalog refers to a plan which does not exist, or to a currency which is not an
+// ISO 4217 code.
+func NewEntitlementsClient(cfg *config.Config, orm *ent.Client) (*EntitlementsClient, error) {
+	e := &EntitlementsClient{
+		config: cfg,
+		orm:    orm,
+	}
+
+	if _, ok := e.Plan(cfg.Payment.FreePlan); !ok {
+		return nil, fmt.Errorf("free plan %q is not in the plan catalog", cfg.Payment.FreePlan)
+	}
+	for _, p := range cfg.Payment.Plans {
+		for _, price := range p.Prices {
+			if !currency.Valid(price.Currency) {
+				return nil, fmt.Errorf("price %q of plan %q has invalid currency %q", price.ID, p.ID, price.Currency)
+			}
+		}
+	}
+	for _, p := range cfg.Payment.Products {
+		if _, ok := e.Plan(p.Plan); !ok {
+			return nil, fmt.Errorf("product %q grants plan %q which is not in the plan catalog", p.ID, p.Plan)
+		}
+		if !currency.Valid(p.Currency) {
+			return nil, fmt.Errorf("product %q has invalid currency %q", p.ID, p.Currency)
+		}
+	}
+	if id := cfg.Payment.LegacyProduct; id != "" {
+		if _, ok := e.Product(id); !ok {
+			return nil, fmt.Errorf("legacy product %q is not a product", id)
+		}
+	}
+
+	return e, nil
+}
+
+// Plans returns the plan catalog, ordered from the lowest to the highest plan.
+func (e *EntitlementsClient) Plans() []config.PlanConfig {
+	return e.config.Payment.Plans
+}
+
+// Plan returns the plan with the given ID.
+func (e *EntitlementsClient) Plan(id string) (config.PlanConfig, bool) {
+	for _, p := range e.config.Payment.Plans {
+		if p.ID == id {
+			return p, true
+		}
+	}
+	return config.PlanConfig{}, false
+}
+
+// FreePlan returns the plan users are on when they have not paid for another one.
+func (e *EntitlementsClient) FreePlan() config.PlanConfig {
+	p, _ := e.Plan(e.config.Payment.FreePlan)
+	return p
+}
+
+// PlanForPrice returns the plan which can be subscribed to with the given provider price, and the price.
+func (e *EntitlementsClient) PlanForPrice(priceID string) (config.PlanConfig, config.PriceConfig, bool) {
+	for _, p := range e.config.Payment.Plans {
+		for _, price := range p.Prices {
+			if price.ID == priceID {
+				return p, price, true
+			}
+		}
+	}
+	return config.PlanConfig{}, config.PriceConfig{}, false
+}
+
+// intervalRank orders billing intervals from the shortest to the longest.
+var intervalRank = map[string]int{
+	"day":   0,
+	"week":  1,
+	"month": 2,
+	"year":  3,
+}
+
+// IsUpgrade returns whether switching from one price to another is an upgrade. Moving to a higher plan is an
+// upgrade, as is moving to a longer billing interval of the same plan. Prices which are not in the catalog
+// are never upgrades.
+func (e *EntitlementsClient) IsUpgrade(fromPriceID, toPriceID string) bool {
+	rank := make(map[string]int, len(e.config.Payment.Plans))
+	for i, p := range e.config.Payment.Plans {
+		rank[p.ID] = i
+	}
+
+	fromPlan, fromPrice, ok := e.PlanForPrice(fromPriceID)
+	if !ok {
+		return false
+	}
+	toPlan, toPrice, ok := e.PlanForPrice(toPriceID)
+	if !ok {
+		return false
+	}
+
+	if fromPlan.ID != toPlan.ID {
+		return rank[toPlan.ID] > rank[fromPlan.ID]
+	}
+	return intervalRank[toPrice.Interval] > intervalRank[fromPrice.Interval]
+}
+
+// Products returns the products which can be bought with a one-time payment.
+func (e *EntitlementsClient) Products() []config.ProductConfig {
+	return e.config.Payment.Products
+}
+
+// Product returns the product with the given ID.
+func (e *EntitlementsClient) Product(id string) (config.ProductConfig, bool) {
+	for _, p := range e.config.Payment.Products {
+		if p.ID == id {
+			return p, true
+		}
+	}
+	return config.ProductConfig{}, false
+}
+
+// PlanFor returns the plan a user is on. This is the highest plan granted by either an active or trialing
+// subscription, a subscription whose payments fail but which is still within its grace period, or a product
+// bought with a payment which has not been fully refunded, otherwise the free plan. Products bought before
+// they were recorded with their payment are taken to be the legacy product.
+func (e *EntitlementsClient) PlanFor(ctx context.Context, u *ent.User) (config.PlanConfig, error) {
+	rank := make(map[string]int, len(e.config.Payment.Plans))
+	for i, p := range e.config.Payment.Plans {
+		rank[p.ID] = i
+	}
+
+	current := e.FreePlan()
+	grant := func(id string) {
+		if p, ok := e.Plan(id); ok && rank[p.ID] > rank[current.ID] {
+			current = p
+		}
+	}
+
+	subs, err := e.orm.Subscription.Query().
+		Where(
+			subscription.HasCustomerWith(paymentcustomer.HasUserWith(user.ID(u.ID))),
+			subscription.Or(
+				subscription.StatusIn(subscription.StatusActive, subscription.StatusTrialing),
+				subscription.And(
+					subscription.StatusIn(subscription.StatusPastDue, subscription.StatusUnpaid),
+					subscription.PastDueAtGT(time.Now().Add(-e.config.Payment.Dunning.GracePeriod)),
+				),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return current, err
+	}
+	for _, sub := range subs {
+		if p, _, ok := e.PlanForPrice(sub.PriceID); ok {
+			grant(p.ID)
+		}
+	}
+
+	payments, err := e.orm.PaymentIntent.Query().
+		Where(
+			paymentintent.HasCustomerWith(paymentcustomer.HasUserWith(user.ID(u.ID))),
+			paymentintent.StatusEQ(paymentintent.StatusSucceeded),
+			predicate.PaymentIntent(sql.FieldsLT(paymentintent.FieldAmountRefunded, paymentintent.FieldAmount)),
+		).
+		All(ctx)
+	if err != nil {
+		return current, err
+	}
+	for _, pi := range payments {
+		id, ok := pi.Metadata[ProductMetadataKey].(string)
+		if !ok && strings.HasPrefix(pi.Description, legacyPurchaseDescription) {
+			id = e.config.Payment.LegacyProduct
+		}
+		if product, ok := e.Product(id); ok {
+			grant(product.Plan)
+		}
+	}
+
+	return current, nil
+}
+
+// Allows returns whether the plan a user is on unlocks a feature.
+func (e *EntitlementsClient) Allows(ctx context.Context, u *ent.User, feature Feature) (bool, error) {
+	p, err := e.PlanFor(ctx, u)
+	if err != nil {
+		return false, err
+	}
+	return PlanAllows(p, feature), nil
+}
+
+// PlanAllows returns whether a plan unlocks a feature.
+func PlanAllows(p config.PlanConfig, feature Feature) bool {
+	for _, f := range p.Features {
+		if Feature(f) == feature {
+			return true
+		}
+	}
+	return false
+}
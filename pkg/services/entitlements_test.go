@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEntitlementsClient(t *testing.T) {
+	cfg := *c.Config
+	cfg.Payment.FreePlan = "missing"
+	_, err := NewEntitlementsClient(&cfg, c.ORM)
+	assert.Error(t, err)
+
+	cfg = *c.Config
+	cfg.Payment.Products = []config.ProductConfig{{ID: "bogus", Plan: "missing"}}
+	_, err = NewEntitlementsClient(&cfg, c.ORM)
+	assert.Error(t, err)
+
+	cfg = *c.Config
+	cfg.Payment.Products = []config.ProductConfig{{ID: "bogus", Plan: "pro", Currency: "euro"}}
+	_, err = NewEntitlementsClient(&cfg, c.ORM)
+	assert.Error(t, err)
+
+	cfg = *c.Config
+	cfg.Payment.LegacyProduct = "missing"
+	_, err = NewEntitlementsClient(&cfg, c.ORM)
+	assert.Error(t, err)
+
+	// Prices can be in any currency, so plans can be sold in several.
+	cfg = *testCatalog()
+	cfg.Payment.Plans[len(cfg.Payment.Plans)-1].Prices[0].Currency = "brl"
+	_, err = NewEntitlementsClient(&cfg, c.ORM)
+	assert.NoError(t, err)
+	cfg.Payment.Plans[len(cfg.Payment.Plans)-1].Prices[0].Currency = ""
+	_, err = NewEntitlementsClient(&cfg, c.ORM)
+	assert.Error(t, err)
+}
+
+func TestEntitlementsClient_Catalog(t *testing.T) {
+	free := c.Entitlements.FreePlan()
+	assert.Equal(t, c.Config.Payment.FreePlan, free.ID)
+	assert.Empty(t, free.Prices)
+
+	plan, price, ok := c.Entitlements.PlanForPrice("price_your_stripe_price_id_here")
+	require.True(t, ok)
+	assert.Equal(t, "pro", plan.ID)
+	assert.Equal(t, int64(2900), price.Amount)
+
+	_, _, ok = c.Entitlements.PlanForPrice("price_missing")
+	assert.False(t, ok)
+
+	product, ok := c.Entitlements.Product("lifetime")
+	require.True(t, ok)
+	assert.Equal(t, "pro", product.Plan)
+}
+
+func TestEntitlementsClient_PlanFor(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := c.ORM.PaymentCustomer.Create().
+		SetProviderCustomerID("cus_entitlements").
+		SetEmail(u.Email).
+		SetUser(u).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	assertPlan := func(id string, premium bool) {
+		t.Helper()
+		plan, err := c.Entitlements.PlanFor(context.Background(), u)
+		require.NoError(t, err)
+		assert.Equal(t, id, plan.ID)
+
+		allowed, err := c.Entitlements.Allows(context.Background(), u, FeaturePremium)
+		require.NoError(t, err)
+		assert.Equal(t, premium, allowed)
+	}
+
+	// Without payments users are on the free plan.
+	assertPlan("free", false)
+
+	// A purchased product grants its plan until it is fully refunded.
+	pi, err := c.ORM.PaymentIntent.Create().
+		SetProviderPaymentIntentID("pi_entitlements").
+		SetStatus(paymentintent.StatusSucceeded).
+		SetAmount(2999).
+		SetMetadata(map[string]interface{}{ProductMetadataKey: "lifetime"}).
+		SetCustomer(customer).
+		Save(context.Background())
+	require.NoError(t, err)
+	assertPlan("pro", true)
+
+	err = pi.Update().SetAmountRefunded(pi.Amount).Exec(context.Background())
+	require.NoError(t, err)
+	assertPlan("free", false)
+
+	// Payments which aren't for a product, such as subscription invoices, grant nothing by themselves.
+	err = pi.Update().SetAmountRefunded(0).SetMetadata(nil).Exec(context.Background())
+	require.NoError(t, err)
+	assertPlan("free", false)
+
+	// Products bought before they were recorded with their payment grant the plan of the legacy product.
+	err = pi.Update().SetDescription("Purchase of product prod_your_stripe_product_id_here").Exec(context.Background())
+	require.NoError(t, err)
+	assertPlan("pro", true)
+
+	err = pi.Update().SetAmountRefunded(pi.Amount).Exec(context.Background())
+	require.NoError(t, err)
+	assertPlan("free", false)
+
+	err = pi.Update().SetAmountRefunded(0).SetDescription("").Exec(context.Background())
+	require.NoError(t, err)
+
+	// Subscriptions grant the plan of their price while they're active or trialing.
+	sub := createSubscription(t, customer, "price_your_stripe_price_id_here", subscription.StatusTrialing)
+	assertPlan("pro", true)
+
+	err = sub.Update().SetStatus(subscription.StatusPastDue).Exec(context.Background())
+	require.NoError(t, err)
+	assertPlan("free", false)
+
+	// Subscriptions whose payments fail keep their plan until the grace period ends.
+	err = sub.Update().SetPastDueAt(time.Now().Add(-time.Hour)).Exec(context.Background())
+	require.NoError(t, err)
+	assertPlan("pro", true)
+
+	err = sub.Update().SetPastDueAt(time.Now().Add(-c.Config.Payment.Dunning.GracePeriod)).Exec(context.Background())
+	require.NoError(t, err)
+	assertPlan("free", false)
+
+	// Dunning runs over every past-due subscription, so this one mustn't be left for other tests.
+	err = sub.Update().SetStatus(subscription.StatusCanceled).ClearPastDueAt().Exec(context.Background())
+	require.NoError(t, err)
+
+	// Prices which aren't in the catalog grant nothing.
+	createSubscription(t, customer, "price_unknown", subscription.StatusActive)
+	assertPlan("free", false)
+}
+
+func TestPlanAllows(t *testing.T) {
+	plan := config.PlanConfig{Features: []string{string(FeatureWebhooks)}}
+	assert.True(t, PlanAllows(plan, FeatureWebhooks))
+	assert.False(t, PlanAllows(plan, FeatureRemoveBranding))
+}
+
+func createSubscription(t *testing.T, customer *ent.PaymentCustomer, priceID string, status subscription.Status) *ent.Subscription {
+	sub, err := c.ORM.Subscription.Create().
+		SetProviderSubscriptionID("sub_" + priceID + "_" + string(status)).
+		SetStatus(status).
+		SetPriceID(priceID).
+		SetAmount(2900).
+		SetInterval(subscription.IntervalMonth).
+		SetCustomer(customer).
+		Save(context.Background())
+	require.NoError(t, err)
+	return sub
+}
@@ -2,147 +2,1370 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	mrand "math/rand/v2"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/config"
 	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/pkg/cron"
 	"github.com/occult/pagode/pkg/log"
 )
 
+const (
+	// defaultJobLease is used when no lease duration is configured.
+	defaultJobLease = 5 * time.Minute
+
+	// defaultPollInterval is used when no poll interval is configured.
+	defaultPollInterval = 5 * time.Second
+)
+
 type JobHandler func(ctx context.Context, payload map[string]interface{}) error
 
+// ErrInvalidPayload is returned by typed handlers when a payload cannot be decoded or fails validation.
+var ErrInvalidPayload = errors.New("invalid job payload")
+
+// permanentError marks an error that retrying will not fix.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent wraps an error returned by a handler so the job fails immediately instead of being retried.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// IsPermanent returns true if the error was wrapped with Permanent.
+func IsPermanent(err error) bool {
+	var p permanentError
+	return errors.As(err, &p)
+}
+
+// TypedHandler adapts a handler that accepts a typed payload into a JobHandler.
+// The payload is decoded from JSON into T and, for structs, validated using their validate tags.
+// Payloads that cannot be decoded or are invalid fail permanently with ErrInvalidPayload.
+func TypedHandler[T any](v *Validator, handler func(ctx context.Context, payload T) error) JobHandler {
+	return func(ctx context.Context, payload map[string]interface{}) error {
+		var typed T
+
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return Permanent(fmt.Errorf("%w: %v", ErrInvalidPayload, err))
+		}
+		if err := json.Unmarshal(b, &typed); err != nil {
+			return Permanent(fmt.Errorf("%w: %v", ErrInvalidPayload, err))
+		}
+
+		if reflect.Indirect(reflect.ValueOf(typed)).Kind() == reflect.Struct {
+			if err := v.Validate(typed); err != nil {
+				return Permanent(fmt.Errorf("%w: %v", ErrInvalidPayload, err))
+			}
+		}
+
+		return handler(ctx, typed)
+	}
+}
+
+// Register adds a handler with a typed payload for a queue. See TypedHandler.
+func Register[T any](w *JobWorker, queue string, handler func(ctx context.Context, payload T) error) {
+	w.Register(queue, TypedHandler(w.validator, handler))
+}
+
+// ErrDuplicateJob is returned when enqueueing a job whose unique key is held by another pending or processing
+// job of the same queue and the conflict cannot be resolved by the job's ConflictStrategy.
+var ErrDuplicateJob = errors.New("a job with this unique key is already queued")
+
+// ConflictStrategy controls what happens when a job is enqueued with a unique key that another pending or
+// processing job of the same queue already holds.
+type ConflictStrategy int
+
+const (
+	// ConflictIgnore keeps the existing job and discards the new one without an error.
+	ConflictIgnore ConflictStrategy = iota
+
+	// ConflictReplace updates the existing job with the new payload and options if it has not started yet.
+	// ErrDuplicateJob is returned if it is already running.
+	ConflictReplace
+
+	// ConflictError keeps the existing job and returns ErrDuplicateJob.
+	ConflictError
+)
+
+// enqueueOptions contains the job being created and how it is enqueued.
+type enqueueOptions struct {
+	create   *ent.JobCreate
+	conflict ConflictStrategy
+}
+
+// EnqueueOption customizes a job when it is enqueued.
+type EnqueueOption func(*enqueueOptions)
+
+// WithPriority sets the priority of a job. Jobs with a higher priority are claimed first.
+func WithPriority(priority int) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.create.SetPriority(priority)
+	}
+}
+
+// WithUniqueKey prevents the job from being enqueued while another job of the same queue with the same key
+// is pending or processing. The strategy decides what happens when one is. Keys can be reused once the
+// job completes, fails or is cancelled.
+func WithUniqueKey(key string, strategy ConflictStrategy) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.create.
+			SetUniqueKey(key).
+			SetUniqueLock(key)
+		o.conflict = strategy
+	}
+}
+
+// withRunAt delays a job until the given time.
+func withRunAt(at time.Time) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.create.SetRunAt(at)
+	}
+}
+
+// ScheduleInfo describes a recurring job registered with Schedule.
+type ScheduleInfo struct {
+	Queue string    `json:"queue"`
+	Spec  string    `json:"spec"`
+	Next  time.Time `json:"next"`
+}
+
+// schedule is a recurring job along with the next tick it is due.
+type schedule struct {
+	queue string
+	spec  cron.Schedule
+	next  time.Time
+}
+
+// RetryPolicy controls how failed jobs of a queue are retried.
+type RetryPolicy struct {
+	// MaxAttempts is how many times a job is attempted before it is marked as failed.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry, which doubles with each further attempt.
+	Backoff time.Duration
+
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is used for queues without a retry policy of their own.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     10 * time.Second,
+	MaxBackoff:  time.Hour,
+}
+
+// Delay returns how long to wait before retrying a job that failed on the given attempt.
+// Half of the delay is random so jobs which failed together, such as during an outage, spread their retries out.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	half := d / 2
+	return half + mrand.N(d-half+1)
+}
+
 type JobWorker struct {
-	orm      *ent.Client
-	handlers map[string]JobHandler
-	ticker   *time.Ticker
-	stop     chan bool
+	orm       *ent.Client
+	validator *Validator
+	handlers  map[string]JobHandler
+	ticker    *time.Ticker
+	stop      chan bool
+
+	// id uniquely identifies this worker so it only ever updates jobs it holds the lease for.
+	id string
+
+	// dialect is the database dialect, used to pick the claiming strategy.
+	dialect string
+
+	// lease is how long a claimed job is reserved before other workers may reclaim it.
+	// The lease is extended while the handler is still running.
+	lease time.Duration
+
+	// pollInterval is how often the job table is checked for work enqueued by other processes.
+	pollInterval time.Duration
+
+	// concurrency is the number of jobs of each queue that may run at once.
+	concurrency        map[string]int
+	defaultConcurrency int
+
+	// shutdownTimeout is how long Stop waits for running jobs before cancelling them.
+	shutdownTimeout time.Duration
+
+	// retry contains the retry policy of each queue that does not use DefaultRetryPolicy.
+	retry map[string]RetryPolicy
+
+	// schedules contains the recurring jobs.
+	schedules []*schedule
+
+	// mu guards handlers, retry, schedules, wake, started, the health fields, alertHooks and lastAlert.
+	mu sync.Mutex
+
+	// wake signals a queue's pool that there may be jobs to claim.
+	wake map[string]chan struct{}
+
+	// started is true once Start has been called; queues registered afterward start immediately.
+	started bool
+
+	// startedAt, lastPoll and stopped are reported by Health, guarded by mu.
+	startedAt time.Time
+	lastPoll  time.Time
+	stopped   bool
+
+	// ctx is passed to every handler and is cancelled if jobs outlive the shutdown timeout.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// running tracks jobs that are currently executing.
+	running sync.WaitGroup
+
+	// alerting tracks alert hooks that are currently being notified.
+	alerting sync.WaitGroup
+
+	// dbMu serializes this worker's database access on SQLite, which only allows a single writer.
+	dbMu sync.Mutex
+
+	// alertPolicy controls when the alert hooks are notified about failing queues.
+	alertPolicy AlertPolicy
+
+	// alertHooks are notified when a queue's failure rate crosses the alert threshold.
+	alertHooks []AlertHook
+
+	// lastAlert is when each queue last triggered an alert, guarded by mu.
+	lastAlert map[string]time.Time
 }
 
-func NewJobWorker(orm *ent.Client) *JobWorker {
+func NewJobWorker(orm *ent.Client, cfg *config.Config, validator *Validator) *JobWorker {
+	lease := cfg.Tasks.ReleaseAfter
+	if lease <= 0 {
+		lease = defaultJobLease
+	}
+
+	pollInterval := cfg.Tasks.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
 	return &JobWorker{
-		orm:      orm,
-		handlers: make(map[string]JobHandler),
-		stop:     make(chan bool),
+		orm:                orm,
+		validator:          validator,
+		handlers:           make(map[string]JobHandler),
+		stop:               make(chan bool),
+		id:                 newWorkerID(),
+		dialect:            cfg.Database.Driver,
+		lease:              lease,
+		pollInterval:       pollInterval,
+		concurrency:        cfg.Tasks.Queues,
+		defaultConcurrency: max(cfg.Tasks.Goroutines, 1),
+		shutdownTimeout:    cfg.Tasks.ShutdownTimeout,
+		retry:              make(map[string]RetryPolicy),
+		wake:               make(map[string]chan struct{}),
+		ctx:                ctx,
+		cancel:             cancel,
+		alertPolicy: AlertPolicy{
+			FailureRate: cfg.Tasks.Alerts.FailureRate,
+			MinJobs:     cfg.Tasks.Alerts.MinJobs,
+			Window:      cfg.Tasks.Alerts.Window,
+			Cooldown:    cfg.Tasks.Alerts.Cooldown,
+		},
+		lastAlert: make(map[string]time.Time),
 	}
 }
 
+// newWorkerID generates an ID for a worker which is unique across hosts and processes.
+func newWorkerID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(b))
+}
+
+// Register adds a handler for a queue. If the worker is already running, the queue's pool starts immediately.
 func (w *JobWorker) Register(queue string, handler JobHandler) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, exists := w.handlers[queue]
 	w.handlers[queue] = handler
+	if exists {
+		return
+	}
+
+	w.wake[queue] = make(chan struct{}, 1)
+	if w.started {
+		go w.runQueue(queue)
+	}
+}
+
+// SetRetryPolicy sets how failed jobs of the given queue are retried.
+// The policy's MaxAttempts applies to jobs enqueued afterward by this worker.
+func (w *JobWorker) SetRetryPolicy(queue string, policy RetryPolicy) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.retry[queue] = policy
+}
+
+// RetryPolicy returns the retry policy of the given queue.
+func (w *JobWorker) RetryPolicy(queue string) RetryPolicy {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if policy, ok := w.retry[queue]; ok {
+		return policy
+	}
+	return DefaultRetryPolicy
 }
 
+// Concurrency returns how many jobs of the given queue may run at once.
+func (w *JobWorker) Concurrency(queue string) int {
+	if n, ok := w.concurrency[queue]; ok && n > 0 {
+		return n
+	}
+	return w.defaultConcurrency
+}
+
+// Start starts a pool of goroutines for each registered queue, the scheduler for recurring jobs, and a
+// ticker which polls for jobs enqueued by other processes and fails jobs whose lease expired on their
+// final attempt.
 func (w *JobWorker) Start() {
-	w.ticker = time.NewTicker(5 * time.Second)
-	
+	w.mu.Lock()
+	w.started = true
+	w.startedAt = time.Now()
+	w.lastPoll = w.startedAt
+	for queue := range w.handlers {
+		go w.runQueue(queue)
+	}
+	w.mu.Unlock()
+
+	go w.runSchedules()
+
+	w.ticker = time.NewTicker(w.pollInterval)
+
 	go func() {
 		for {
 			select {
 			case <-w.ticker.C:
-				w.processJobs()
+				if err := w.failExpiredJobs(w.ctx); err != nil {
+					log.Default().Error("Failed to fail expired jobs", "error", err)
+				} else {
+					w.mu.Lock()
+					w.lastPoll = time.Now()
+					w.mu.Unlock()
+				}
+				w.notifyAll()
 			case <-w.stop:
 				return
 			}
 		}
 	}()
-	
-	log.Default().Info("Job worker started")
+
+	log.Default().Info("Job worker started", "worker_id", w.id, "poll_interval", w.pollInterval)
 }
 
+// Stop stops claiming new jobs and waits up to the shutdown timeout for running jobs to finish.
+// Jobs still running after that have their contexts cancelled; any that do not return are reclaimed by
+// another worker once their lease expires.
 func (w *JobWorker) Stop() {
+	w.mu.Lock()
+	w.stopped = true
+	w.mu.Unlock()
+
 	if w.ticker != nil {
 		w.ticker.Stop()
 	}
-	w.stop <- true
+	close(w.stop)
+
+	done := make(chan struct{})
+	go func() {
+		w.running.Wait()
+		w.alerting.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(w.shutdownTimeout):
+		log.Default().Warn("Cancelling jobs that did not finish before the shutdown timeout")
+	}
+
+	w.cancel()
 	log.Default().Info("Job worker stopped")
 }
 
-func (w *JobWorker) processJobs() {
-	ctx := context.Background()
-	
-	jobs, err := w.orm.Job.Query().
-		Where(
-			job.StatusEQ(job.StatusPending),
-		).
-		Order(ent.Asc(job.FieldCreatedAt)).
-		Limit(10).
-		All(ctx)
-	
+// JobWorkerHealth describes the state of a worker for health checks.
+type JobWorkerHealth struct {
+	WorkerID  string    `json:"workerId"`
+	Healthy   bool      `json:"healthy"`
+	StartedAt time.Time `json:"startedAt"`
+	LastPoll  time.Time `json:"lastPoll"`
+	Queues    []string  `json:"queues"`
+}
+
+// Health reports whether the worker is running and can reach the database. A worker is unhealthy if it has not
+// been started, has been stopped, or has not polled the job table successfully for three poll intervals.
+func (w *JobWorker) Health() JobWorkerHealth {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	queues := make([]string, 0, len(w.handlers))
+	for queue := range w.handlers {
+		queues = append(queues, queue)
+	}
+	sort.Strings(queues)
+
+	return JobWorkerHealth{
+		WorkerID:  w.id,
+		Healthy:   w.started && !w.stopped && time.Since(w.lastPoll) < 3*w.pollInterval,
+		StartedAt: w.startedAt,
+		LastPoll:  w.lastPoll,
+		Queues:    queues,
+	}
+}
+
+// Schedule enqueues a job with an empty payload on the given queue according to a cron expression,
+// such as "*/15 * * * *" or "@daily", evaluated in UTC. Every instance may register the same schedules
+// since each tick is only enqueued once across all of them.
+func (w *JobWorker) Schedule(queue, spec string) error {
+	sched, err := cron.Parse(spec)
 	if err != nil {
-		log.Default().Error("Failed to fetch jobs", "error", err)
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.schedules = append(w.schedules, &schedule{
+		queue: queue,
+		spec:  sched,
+		next:  sched.Next(time.Now().UTC()),
+	})
+	return nil
+}
+
+// Schedules returns every registered recurring job.
+func (w *JobWorker) Schedules() []ScheduleInfo {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	info := make([]ScheduleInfo, 0, len(w.schedules))
+	for _, s := range w.schedules {
+		info = append(info, ScheduleInfo{
+			Queue: s.queue,
+			Spec:  s.spec.String(),
+			Next:  s.next,
+		})
+	}
+	return info
+}
+
+// runSchedules enqueues recurring jobs as their ticks come due until the worker is stopped.
+// Ticks missed while no instance was running are skipped rather than caught up.
+func (w *JobWorker) runSchedules() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.enqueueDueSchedules(time.Now().UTC())
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// enqueueDueSchedules enqueues a job for every schedule whose next tick is at or before now.
+func (w *JobWorker) enqueueDueSchedules(now time.Time) {
+	type tick struct {
+		queue string
+		at    time.Time
+	}
+
+	var due []tick
+	w.mu.Lock()
+	for _, s := range w.schedules {
+		if !s.next.IsZero() && !now.Before(s.next) {
+			due = append(due, tick{queue: s.queue, at: s.next})
+			s.next = s.spec.Next(now)
+		}
+	}
+	w.mu.Unlock()
+
+	for _, t := range due {
+		if err := w.enqueueTick(w.ctx, t.queue, t.at); err != nil {
+			log.Default().Error("Failed to enqueue scheduled job", "queue", t.queue, "tick", t.at, "error", err)
+		}
+	}
+}
+
+// enqueueTick enqueues the job for a single tick of a schedule. The unique (queue, scheduled_for) index
+// rejects the tick if another instance already enqueued it, which is not treated as an error.
+func (w *JobWorker) enqueueTick(ctx context.Context, queue string, at time.Time) error {
+	create := w.orm.Job.Create().
+		SetQueue(queue).
+		SetPayload(map[string]interface{}{}).
+		SetScheduledFor(at).
+		SetRunAt(at)
+
+	if policy := w.RetryPolicy(queue); policy.MaxAttempts > 0 {
+		create.SetMaxAttempts(policy.MaxAttempts)
+	}
+
+	err := create.Exec(ctx)
+	switch {
+	case ent.IsConstraintError(err):
+		log.Default().Debug("Scheduled job already enqueued", "queue", queue, "tick", at)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	w.notify(queue)
+	return nil
+}
+
+// notify wakes the pool for a queue so it claims any available jobs without waiting for the next poll.
+func (w *JobWorker) notify(queue string) {
+	w.mu.Lock()
+	ch, ok := w.wake[queue]
+	w.mu.Unlock()
+
+	if !ok {
 		return
 	}
-	
-	for _, j := range jobs {
-		w.processJob(ctx, j)
+
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// notifyAll wakes the pool of every queue.
+func (w *JobWorker) notifyAll() {
+	w.mu.Lock()
+	queues := make([]string, 0, len(w.wake))
+	for queue := range w.wake {
+		queues = append(queues, queue)
+	}
+	w.mu.Unlock()
+
+	for _, queue := range queues {
+		w.notify(queue)
 	}
 }
 
+// runQueue processes jobs for a queue whenever it is woken until the worker is stopped.
+func (w *JobWorker) runQueue(queue string) {
+	w.mu.Lock()
+	handler, wake := w.handlers[queue], w.wake[queue]
+	w.mu.Unlock()
+
+	slots := make(chan struct{}, w.Concurrency(queue))
+
+	for {
+		w.drain(queue, handler, slots)
+
+		select {
+		case <-wake:
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// drain claims and starts jobs for a queue while it has free slots, returning once there are no jobs
+// left to claim. Each slot is released when its job finishes.
+func (w *JobWorker) drain(queue string, handler JobHandler, slots chan struct{}) {
+	for {
+		select {
+		case slots <- struct{}{}:
+		case <-w.stop:
+			return
+		}
+
+		jobs, err := w.claimJobs(w.ctx, queue, 1)
+		if err != nil {
+			log.Default().Error("Failed to claim jobs", "queue", queue, "error", err)
+		}
+		if len(jobs) == 0 {
+			<-slots
+			return
+		}
+
+		w.running.Add(1)
+		go func(j *ent.Job) {
+			defer w.running.Done()
+			defer func() { <-slots }()
+			w.run(w.ctx, j, handler)
+		}(jobs[0])
+	}
+}
+
+// processJobs claims and runs every available job of every registered queue, honoring each queue's
+// concurrency, and returns once they have all finished.
+func (w *JobWorker) processJobs() {
+	if err := w.failExpiredJobs(w.ctx); err != nil {
+		log.Default().Error("Failed to fail expired jobs", "error", err)
+	}
+
+	w.mu.Lock()
+	handlers := make(map[string]JobHandler, len(w.handlers))
+	for queue, handler := range w.handlers {
+		handlers[queue] = handler
+	}
+	w.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for queue, handler := range handlers {
+		wg.Add(1)
+		go func(queue string, handler JobHandler) {
+			defer wg.Done()
+			w.drain(queue, handler, make(chan struct{}, w.Concurrency(queue)))
+		}(queue, handler)
+	}
+	wg.Wait()
+	w.running.Wait()
+}
+
+// processJob claims and runs a single job. Nothing happens if the job was claimed by another worker.
 func (w *JobWorker) processJob(ctx context.Context, j *ent.Job) {
+	w.mu.Lock()
 	handler, exists := w.handlers[j.Queue]
+	w.mu.Unlock()
+
 	if !exists {
 		log.Default().Warn("No handler registered for queue", "queue", j.Queue, "job_id", j.ID)
 		return
 	}
-	
-	newAttempts := j.Attempts + 1
-	
-	update := j.Update()
-	update.SetStatus(job.StatusProcessing)
-	update.SetAttempts(newAttempts)
-	
-	if _, err := update.Save(ctx); err != nil {
-		log.Default().Error("Failed to update job status", "job_id", j.ID, "error", err)
+
+	claimed, err := w.claimJob(ctx, j)
+	if err != nil {
+		log.Default().Error("Failed to claim job", "job_id", j.ID, "error", err)
+		return
+	}
+	if !claimed {
 		return
 	}
-	
+
+	w.run(ctx, j, handler)
+}
+
+// run executes the handler for a job this worker has claimed and stores the outcome.
+func (w *JobWorker) run(ctx context.Context, j *ent.Job, handler JobHandler) {
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	go w.heartbeat(heartbeatCtx, j.ID)
+
+	started := time.Now()
 	err := handler(ctx, j.Payload)
-	
-	finalUpdate := j.Update()
-	finalUpdate.SetProcessedAt(time.Now())
-	
+	finished := time.Now()
+	stopHeartbeat()
+
+	attempt := w.orm.JobAttempt.Create().
+		SetJobID(j.ID).
+		SetAttempt(j.Attempts).
+		SetWorker(w.id).
+		SetStartedAt(started).
+		SetFinishedAt(finished).
+		SetDurationMs(finished.Sub(started).Milliseconds())
+
+	// Only store the outcome while still holding the lease, otherwise another worker has reclaimed the job.
+	finalUpdate := w.orm.Job.Update().
+		Where(
+			job.ID(j.ID),
+			job.LockedBy(w.id),
+		).
+		SetProcessedAt(time.Now()).
+		ClearLockedBy().
+		ClearLockedUntil()
+
+	failed := false
 	if err != nil {
 		log.Default().Error("Job failed", "job_id", j.ID, "queue", j.Queue, "error", err)
-		
-		if newAttempts >= j.MaxAttempts {
+
+		attempt.SetError(err.Error())
+		finalUpdate.SetError(err.Error())
+		if j.Attempts >= j.MaxAttempts || IsPermanent(err) {
+			failed = true
 			finalUpdate.SetStatus(job.StatusFailed)
-			finalUpdate.SetError(err.Error())
+			finalUpdate.ClearUniqueLock()
 		} else {
 			finalUpdate.SetStatus(job.StatusPending)
+			finalUpdate.SetRunAt(time.Now().Add(w.RetryPolicy(j.Queue).Delay(j.Attempts)))
 		}
 	} else {
 		finalUpdate.SetStatus(job.StatusCompleted)
+		finalUpdate.ClearUniqueLock()
 		log.Default().Info("Job completed", "job_id", j.ID, "queue", j.Queue)
 	}
-	
-	if _, err := finalUpdate.Save(ctx); err != nil {
+
+	// The outcome is stored even if the job was cancelled during shutdown so it can be retried.
+	// The attempt is recorded even if the lease was lost since the handler still ran.
+	ctx = context.WithoutCancel(ctx)
+	unlock := w.exclusive()
+	if err := attempt.Exec(ctx); err != nil {
+		log.Default().Error("Failed to record job attempt", "job_id", j.ID, "error", err)
+	}
+	n, err := finalUpdate.Save(ctx)
+	unlock()
+	switch {
+	case err != nil:
 		log.Default().Error("Failed to update job final status", "job_id", j.ID, "error", err)
+	case n == 0:
+		log.Default().Warn("Job lease was lost before it finished", "job_id", j.ID, "queue", j.Queue)
+	case failed:
+		w.checkFailureRate(ctx, j)
 	}
 }
 
-func (w *JobWorker) Enqueue(ctx context.Context, queue string, payload map[string]interface{}) error {
-	_, err := w.orm.Job.Create().
-		SetQueue(queue).
-		SetPayload(payload).
+// heartbeat extends the lease of a running job until the context is cancelled.
+func (w *JobWorker) heartbeat(ctx context.Context, id int) {
+	ticker := time.NewTicker(w.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			unlock := w.exclusive()
+			err := w.orm.Job.Update().
+				Where(
+					job.ID(id),
+					job.LockedBy(w.id),
+				).
+				SetLockedUntil(time.Now().Add(w.lease)).
+				Exec(ctx)
+			unlock()
+			if err != nil && ctx.Err() == nil {
+				log.Default().Error("Failed to extend job lease", "job_id", id, "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// claimable matches jobs that are due to run along with jobs whose worker stopped renewing
+// its lease, such as after a crash. Jobs that have used up their attempts are excluded.
+func claimable(now time.Time) predicate.Job {
+	return job.And(
+		job.Or(
+			job.And(
+				job.StatusEQ(job.StatusPending),
+				job.RunAtLTE(now),
+			),
+			job.And(
+				job.StatusEQ(job.StatusProcessing),
+				job.LockedUntilLT(now),
+			),
+		),
+		predicate.Job(func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(job.FieldAttempts), s.C(job.FieldMaxAttempts)))
+		}),
+	)
+}
+
+// claimJobs claims up to limit jobs from the given queue.
+// MySQL locks the rows with SKIP LOCKED so concurrent workers claim disjoint batches. Other databases,
+// such as SQLite which serializes writes, claim each job with a conditional update instead.
+func (w *JobWorker) claimJobs(ctx context.Context, queue string, limit int) ([]*ent.Job, error) {
+	if w.dialect == dialect.MySQL {
+		return w.claimJobsLocked(ctx, queue, limit)
+	}
+
+	unlock := w.exclusive()
+	candidates, err := w.orm.Job.Query().
+		Where(
+			job.QueueEQ(queue),
+			claimable(time.Now()),
+		).
+		Order(
+			ent.Desc(job.FieldPriority),
+			ent.Asc(job.FieldRunAt),
+			ent.Asc(job.FieldID),
+		).
+		Limit(limit).
+		All(ctx)
+	unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*ent.Job, 0, len(candidates))
+	for _, j := range candidates {
+		claimed, err := w.claimJob(ctx, j)
+		if err != nil {
+			return jobs, err
+		}
+		if claimed {
+			jobs = append(jobs, j)
+		}
+	}
+
+	return jobs, nil
+}
+
+// claimJobsLocked claims jobs using SELECT ... FOR UPDATE SKIP LOCKED within a transaction.
+func (w *JobWorker) claimJobsLocked(ctx context.Context, queue string, limit int) ([]*ent.Job, error) {
+	tx, err := w.orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	jobs, err := tx.Job.Query().
+		Where(
+			job.QueueEQ(queue),
+			claimable(now),
+		).
+		Order(
+			ent.Desc(job.FieldPriority),
+			ent.Asc(job.FieldRunAt),
+			ent.Asc(job.FieldID),
+		).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(jobs) == 0 {
+		return nil, tx.Commit()
+	}
+
+	ids := make([]int, len(jobs))
+	for i, j := range jobs {
+		ids[i] = j.ID
+	}
+
+	lockedUntil := now.Add(w.lease)
+	err = tx.Job.Update().
+		Where(job.IDIn(ids...)).
+		SetStatus(job.StatusProcessing).
+		AddAttempts(1).
+		SetLockedBy(w.id).
+		SetLockedUntil(lockedUntil).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	for _, j := range jobs {
+		j.Status = job.StatusProcessing
+		j.Attempts++
+		j.LockedBy = w.id
+		j.LockedUntil = &lockedUntil
+	}
+
+	return jobs, nil
+}
+
+// claimJob atomically marks a single job as processing by this worker.
+// The update only applies if the job is still claimable and has not been attempted since it was loaded,
+// so exactly one worker wins when several race for the same job. The job is updated in place when claimed.
+func (w *JobWorker) claimJob(ctx context.Context, j *ent.Job) (bool, error) {
+	defer w.exclusive()()
+
+	now := time.Now()
+	lockedUntil := now.Add(w.lease)
+
+	n, err := w.orm.Job.Update().
+		Where(
+			job.ID(j.ID),
+			job.Attempts(j.Attempts),
+			claimable(now),
+		).
+		SetStatus(job.StatusProcessing).
+		SetAttempts(j.Attempts + 1).
+		SetLockedBy(w.id).
+		SetLockedUntil(lockedUntil).
 		Save(ctx)
-	
+	if err != nil || n == 0 {
+		return false, err
+	}
+
+	j.Status = job.StatusProcessing
+	j.Attempts++
+	j.LockedBy = w.id
+	j.LockedUntil = &lockedUntil
+	return true, nil
+}
+
+// failExpiredJobs fails jobs whose lease expired on their final attempt since they can no longer be reclaimed.
+func (w *JobWorker) failExpiredJobs(ctx context.Context) error {
+	defer w.exclusive()()
+
+	return w.orm.Job.Update().
+		Where(
+			job.StatusEQ(job.StatusProcessing),
+			job.LockedUntilLT(time.Now()),
+			predicate.Job(func(s *sql.Selector) {
+				s.Where(sql.ColumnsGTE(s.C(job.FieldAttempts), s.C(job.FieldMaxAttempts)))
+			}),
+		).
+		SetStatus(job.StatusFailed).
+		SetError("job lease expired before it finished").
+		SetProcessedAt(time.Now()).
+		ClearUniqueLock().
+		ClearLockedBy().
+		ClearLockedUntil().
+		Exec(ctx)
+}
+
+// exclusive locks the worker's database access when the database only allows a single writer
+// and returns a function which releases it. MySQL relies on row locks instead.
+func (w *JobWorker) exclusive() func() {
+	if w.dialect == dialect.MySQL {
+		return func() {}
+	}
+
+	w.dbMu.Lock()
+	return w.dbMu.Unlock
+}
+
+// rollback rolls back a transaction and returns the error that caused it.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
+
+// Enqueue adds a job to a queue. Workers in this process are woken to run it immediately if it is due.
+func (w *JobWorker) Enqueue(ctx context.Context, queue string, payload map[string]interface{}, opts ...EnqueueOption) error {
+	unlock := w.exclusive()
+	due, err := w.enqueue(ctx, w.orm, queue, payload, opts...)
+	unlock()
 	if err != nil {
-		return fmt.Errorf("failed to enqueue job: %w", err)
+		return err
+	}
+
+	if due {
+		w.notify(queue)
 	}
-	
 	return nil
 }
 
-func (w *JobWorker) EnqueueJSON(ctx context.Context, queue string, data interface{}) error {
+// EnqueueTx adds a job to a queue as part of a transaction, so the job only becomes visible to workers if the
+// transaction commits and is never lost if it does. Use it for jobs that follow up on a write made in the same
+// transaction. Workers in this process are woken once the transaction commits.
+func (w *JobWorker) EnqueueTx(ctx context.Context, tx *ent.Tx, queue string, payload map[string]interface{}, opts ...EnqueueOption) error {
+	// The transaction already holds the database's write lock on SQLite, so the worker's lock is not taken.
+	due, err := w.enqueue(ctx, tx.Client(), queue, payload, opts...)
+	if err != nil || !due {
+		return err
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			w.notify(queue)
+			return nil
+		})
+	})
+	return nil
+}
+
+// enqueue creates a job using the given client and returns whether it is due to run now.
+func (w *JobWorker) enqueue(ctx context.Context, client *ent.Client, queue string, payload map[string]interface{}, opts ...EnqueueOption) (bool, error) {
+	o := enqueueOptions{
+		create: client.Job.Create().
+			SetQueue(queue).
+			SetPayload(payload),
+	}
+
+	if policy := w.RetryPolicy(queue); policy.MaxAttempts > 0 {
+		o.create.SetMaxAttempts(policy.MaxAttempts)
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	err := o.create.Exec(ctx)
+	if key, ok := o.create.Mutation().UniqueKey(); ok && ent.IsConstraintError(err) {
+		err = w.resolveConflict(ctx, client, queue, key, o)
+	}
+
+	switch {
+	case errors.Is(err, errJobIgnored):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	runAt, ok := o.create.Mutation().RunAt()
+	return !ok || !runAt.After(time.Now()), nil
+}
+
+// errJobIgnored is returned by resolveConflict when the new job was discarded in favor of the existing one.
+var errJobIgnored = errors.New("job ignored")
+
+// resolveConflict applies the conflict strategy after a job could not be created because another active job
+// of the queue holds its unique key.
+func (w *JobWorker) resolveConflict(ctx context.Context, client *ent.Client, queue, key string, o enqueueOptions) error {
+	switch o.conflict {
+	case ConflictIgnore:
+		log.Default().Debug("Ignoring duplicate job", "queue", queue, "unique_key", key)
+		return errJobIgnored
+
+	case ConflictReplace:
+		m := o.create.Mutation()
+		update := client.Job.Update().
+			Where(
+				job.Queue(queue),
+				job.UniqueLock(key),
+				job.StatusEQ(job.StatusPending),
+			).
+			SetAttempts(0).
+			ClearError()
+
+		if payload, ok := m.Payload(); ok {
+			update.SetPayload(payload)
+		}
+		if priority, ok := m.Priority(); ok {
+			update.SetPriority(priority)
+		} else {
+			update.SetPriority(0)
+		}
+		if runAt, ok := m.RunAt(); ok {
+			update.SetRunAt(runAt)
+		} else {
+			update.SetRunAt(time.Now())
+		}
+		if maxAttempts, ok := m.MaxAttempts(); ok {
+			update.SetMaxAttempts(maxAttempts)
+		}
+
+		n, err := update.Save(ctx)
+		if err != nil || n > 0 {
+			return err
+		}
+
+		// The existing job is running, or finished after the insert failed in which case the key is free again.
+		err = o.create.Exec(ctx)
+		if ent.IsConstraintError(err) {
+			return ErrDuplicateJob
+		}
+		return err
+
+	default:
+		return ErrDuplicateJob
+	}
+}
+
+// EnqueueAt adds a job to a queue which will not run before the given time.
+func (w *JobWorker) EnqueueAt(ctx context.Context, queue string, payload map[string]interface{}, at time.Time, opts ...EnqueueOption) error {
+	return w.Enqueue(ctx, queue, payload, append(opts, withRunAt(at))...)
+}
+
+// EnqueueIn adds a job to a queue which will not run until the given delay has passed.
+func (w *JobWorker) EnqueueIn(ctx context.Context, queue string, payload map[string]interface{}, delay time.Duration, opts ...EnqueueOption) error {
+	return w.EnqueueAt(ctx, queue, payload, time.Now().Add(delay), opts...)
+}
+
+func (w *JobWorker) EnqueueJSON(ctx context.Context, queue string, data interface{}, opts ...EnqueueOption) error {
+	payload, err := jsonPayload(data)
+	if err != nil {
+		return err
+	}
+
+	return w.Enqueue(ctx, queue, payload, opts...)
+}
+
+// EnqueueTxJSON is like EnqueueTx but takes a payload which is converted to a map via JSON, such as the typed
+// payload of a handler added with Register.
+func (w *JobWorker) EnqueueTxJSON(ctx context.Context, tx *ent.Tx, queue string, data interface{}, opts ...EnqueueOption) error {
+	payload, err := jsonPayload(data)
+	if err != nil {
+		return err
+	}
+
+	return w.EnqueueTx(ctx, tx, queue, payload, opts...)
+}
+
+// jsonPayload converts data into a job payload by round-tripping it through JSON.
+func jsonPayload(data interface{}) (map[string]interface{}, error) {
 	payloadBytes, err := json.Marshal(data)
 	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
 	}
-	
+
 	var payload map[string]interface{}
 	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
-		return fmt.Errorf("failed to unmarshal payload: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	return payload, nil
+}
+
+// ErrJobStatus is returned when a job cannot be retried or cancelled because of its current status.
+var ErrJobStatus = errors.New("job status does not allow this action")
+
+// QueueStats contains job counts for a single queue.
+type QueueStats struct {
+	Queue string `json:"queue"`
+
+	// Counts of every job in the queue by their current status.
+	Pending    int `json:"pending"`
+	Processing int `json:"processing"`
+	Completed  int `json:"completed"`
+	Failed     int `json:"failed"`
+	Cancelled  int `json:"cancelled"`
+
+	// RecentCompleted and RecentFailed count jobs which finished within the stats window.
+	RecentCompleted int `json:"recentCompleted"`
+	RecentFailed    int `json:"recentFailed"`
+}
+
+// FailureRate returns the share of jobs that finished within the stats window which failed.
+func (s QueueStats) FailureRate() float64 {
+	total := s.RecentCompleted + s.RecentFailed
+	if total == 0 {
+		return 0
+	}
+	return float64(s.RecentFailed) / float64(total)
+}
+
+// Retry returns a failed or cancelled job to its queue with a fresh set of attempts.
+// ErrDuplicateJob is returned if the job has a unique key which another active job now holds.
+func (w *JobWorker) Retry(ctx context.Context, id int) error {
+	defer w.exclusive()()
+
+	j, err := w.orm.Job.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	update := w.orm.Job.Update().
+		Where(
+			job.ID(id),
+			job.StatusIn(job.StatusFailed, job.StatusCancelled),
+		).
+		SetStatus(job.StatusPending).
+		SetAttempts(0).
+		SetRunAt(time.Now()).
+		ClearError().
+		ClearProcessedAt()
+
+	if j.UniqueKey != "" {
+		update.SetUniqueLock(j.UniqueKey)
+	}
+
+	n, err := update.Save(ctx)
+	switch {
+	case ent.IsConstraintError(err):
+		return ErrDuplicateJob
+	case err != nil:
+		return err
+	case n == 0:
+		return ErrJobStatus
+	}
+
+	w.notify(j.Queue)
+	return nil
+}
+
+// RetryFailed returns every failed job of a queue to it with a fresh set of attempts and returns how many were retried.
+// Jobs whose unique key is held by another active job are skipped.
+func (w *JobWorker) RetryFailed(ctx context.Context, queue string) (int, error) {
+	unlock := w.exclusive()
+	n, err := w.orm.Job.Update().
+		Where(
+			job.Queue(queue),
+			job.StatusEQ(job.StatusFailed),
+			job.UniqueKeyIsNil(),
+		).
+		SetStatus(job.StatusPending).
+		SetAttempts(0).
+		SetRunAt(time.Now()).
+		ClearError().
+		ClearProcessedAt().
+		Save(ctx)
+	if err != nil {
+		unlock()
+		return 0, err
+	}
+
+	// Jobs with a unique key are retried one at a time since each one may conflict.
+	ids, err := w.orm.Job.Query().
+		Where(
+			job.Queue(queue),
+			job.StatusEQ(job.StatusFailed),
+			job.UniqueKeyNotNil(),
+		).
+		IDs(ctx)
+	unlock()
+	if err != nil {
+		return n, err
+	}
+
+	for _, id := range ids {
+		switch err := w.Retry(ctx, id); {
+		case err == nil:
+			n++
+		case errors.Is(err, ErrDuplicateJob), errors.Is(err, ErrJobStatus):
+		default:
+			return n, err
+		}
+	}
+
+	if n > 0 {
+		w.notify(queue)
+	}
+	return n, nil
+}
+
+// Cancel prevents a pending job from running. Jobs which are already running cannot be cancelled.
+func (w *JobWorker) Cancel(ctx context.Context, id int) error {
+	defer w.exclusive()()
+
+	n, err := w.orm.Job.Update().
+		Where(
+			job.ID(id),
+			job.StatusEQ(job.StatusPending),
+		).
+		SetStatus(job.StatusCancelled).
+		SetProcessedAt(time.Now()).
+		ClearUniqueLock().
+		Save(ctx)
+	if err != nil {
+		return err
 	}
-	
-	return w.Enqueue(ctx, queue, payload)
+	if n == 0 {
+		return ErrJobStatus
+	}
+	return nil
+}
+
+// PurgeCompleted deletes completed jobs which finished before the given time and returns how many were deleted.
+func (w *JobWorker) PurgeCompleted(ctx context.Context, before time.Time) (int, error) {
+	defer w.exclusive()()
+
+	return w.orm.Job.Delete().
+		Where(
+			job.StatusEQ(job.StatusCompleted),
+			job.ProcessedAtLT(before),
+		).
+		Exec(ctx)
+}
+
+// QueueStats returns job counts for every queue, sorted by queue name.
+// Recent counts include jobs which finished at or after since.
+func (w *JobWorker) QueueStats(ctx context.Context, since time.Time) ([]QueueStats, error) {
+	type count struct {
+		Queue  string     `json:"queue"`
+		Status job.Status `json:"status"`
+		Count  int        `json:"count"`
+	}
+
+	var totals, recent []count
+	err := w.orm.Job.Query().
+		GroupBy(job.FieldQueue, job.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.orm.Job.Query().
+		Where(
+			job.StatusIn(job.StatusCompleted, job.StatusFailed),
+			job.ProcessedAtGTE(since),
+		).
+		GroupBy(job.FieldQueue, job.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &recent)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]*QueueStats)
+	get := func(queue string) *QueueStats {
+		if _, ok := stats[queue]; !ok {
+			stats[queue] = &QueueStats{Queue: queue}
+		}
+		return stats[queue]
+	}
+
+	for _, c := range totals {
+		s := get(c.Queue)
+		switch c.Status {
+		case job.StatusPending:
+			s.Pending = c.Count
+		case job.StatusProcessing:
+			s.Processing = c.Count
+		case job.StatusCompleted:
+			s.Completed = c.Count
+		case job.StatusFailed:
+			s.Failed = c.Count
+		case job.StatusCancelled:
+			s.Cancelled = c.Count
+		}
+	}
+
+	for _, c := range recent {
+		s := get(c.Queue)
+		if c.Status == job.StatusCompleted {
+			s.RecentCompleted = c.Count
+		} else {
+			s.RecentFailed = c.Count
+		}
+	}
+
+	out := make([]QueueStats, 0, len(stats))
+	for _, s := range stats {
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Queue < out[j].Queue
+	})
+
+	return out, nil
 }
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/pkg/log"
+)
+
+// AlertPolicy controls when a queue's failures trigger an alert.
+type AlertPolicy struct {
+	// FailureRate is the share of jobs finished within the window that must have failed, from 0 to 1.
+	// Alerts are disabled when it is zero.
+	FailureRate float64
+
+	// MinJobs is how many jobs must have finished within the window before a queue can alert,
+	// so a single failure in a quiet queue does not count as a 100% failure rate.
+	MinJobs int
+
+	// Window is how far back finished jobs are counted.
+	Window time.Duration
+
+	// Cooldown is the minimum time between two alerts for the same queue.
+	Cooldown time.Duration
+}
+
+// JobAlert describes a queue whose failure rate crossed the alert threshold.
+type JobAlert struct {
+	Queue       string    `json:"queue"`
+	FailureRate float64   `json:"failureRate"`
+	Failed      int       `json:"failed"`
+	Completed   int       `json:"completed"`
+	Since       time.Time `json:"since"`
+	JobID       int       `json:"jobId"`
+	Error       string    `json:"error"`
+}
+
+// AlertHook is notified when a queue's failure rate crosses the alert threshold.
+type AlertHook interface {
+	Alert(ctx context.Context, alert JobAlert) error
+}
+
+// AlertHookFunc adapts a function into an AlertHook.
+type AlertHookFunc func(ctx context.Context, alert JobAlert) error
+
+// Alert calls the function.
+func (f AlertHookFunc) Alert(ctx context.Context, alert JobAlert) error {
+	return f(ctx, alert)
+}
+
+// AddAlertHook adds a hook which is notified when a queue's failure rate crosses the alert threshold.
+func (w *JobWorker) AddAlertHook(hook AlertHook) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.alertHooks = append(w.alertHooks, hook)
+}
+
+// SetAlertPolicy replaces the policy loaded from the configuration.
+func (w *JobWorker) SetAlertPolicy(policy AlertPolicy) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.alertPolicy = policy
+}
+
+// checkFailureRate notifies the alert hooks if the failure of the given job pushed its queue's failure
+// rate over the threshold, unless the queue already alerted within the cooldown.
+func (w *JobWorker) checkFailureRate(ctx context.Context, j *ent.Job) {
+	w.mu.Lock()
+	policy := w.alertPolicy
+	hooks := w.alertHooks
+	last := w.lastAlert[j.Queue]
+	w.mu.Unlock()
+
+	now := time.Now()
+	if policy.FailureRate <= 0 || len(hooks) == 0 || (!last.IsZero() && now.Sub(last) < policy.Cooldown) {
+		return
+	}
+
+	since := now.Add(-policy.Window)
+	unlock := w.exclusive()
+	failed, err := w.countFinished(ctx, j.Queue, job.StatusFailed, since)
+	if err != nil {
+		unlock()
+		log.Default().Error("Failed to count failed jobs", "queue", j.Queue, "error", err)
+		return
+	}
+	completed, err := w.countFinished(ctx, j.Queue, job.StatusCompleted, since)
+	unlock()
+	if err != nil {
+		log.Default().Error("Failed to count completed jobs", "queue", j.Queue, "error", err)
+		return
+	}
+
+	stats := QueueStats{Queue: j.Queue, RecentFailed: failed, RecentCompleted: completed}
+	if failed+completed < policy.MinJobs || stats.FailureRate() < policy.FailureRate {
+		return
+	}
+
+	// Check the cooldown again since another job of the queue may have alerted in the meantime.
+	w.mu.Lock()
+	if last := w.lastAlert[j.Queue]; !last.IsZero() && now.Sub(last) < policy.Cooldown {
+		w.mu.Unlock()
+		return
+	}
+	w.lastAlert[j.Queue] = now
+	w.mu.Unlock()
+
+	alert := JobAlert{
+		Queue:       j.Queue,
+		FailureRate: stats.FailureRate(),
+		Failed:      failed,
+		Completed:   completed,
+		Since:       since,
+		JobID:       j.ID,
+	}
+	if latest, err := w.orm.Job.Get(ctx, j.ID); err == nil {
+		alert.Error = latest.Error
+	}
+
+	log.Default().Warn("Job failure rate crossed the alert threshold",
+		"queue", alert.Queue,
+		"failure_rate", alert.FailureRate,
+		"failed", alert.Failed,
+		"completed", alert.Completed,
+	)
+
+	// Hooks may be slow, such as when posting to a webhook, so they are notified without holding up the worker.
+	w.alerting.Add(1)
+	go func() {
+		defer w.alerting.Done()
+		for _, hook := range hooks {
+			if err := hook.Alert(w.ctx, alert); err != nil {
+				log.Default().Error("Job alert hook failed", "queue", alert.Queue, "error", err)
+			}
+		}
+	}()
+}
+
+// countFinished counts jobs of a queue with the given status which finished at or after since.
+func (w *JobWorker) countFinished(ctx context.Context, queue string, status job.Status, since time.Time) (int, error) {
+	return w.orm.Job.Query().
+		Where(
+			job.Queue(queue),
+			job.StatusEQ(status),
+			job.ProcessedAtGTE(since),
+		).
+		Count(ctx)
+}
+
+// QueueAlertHook enqueues job alerts to a queue, so they are delivered by the queue's handler with retries,
+// such as the notify_job_alert job which emails them to every admin user.
+type QueueAlertHook struct {
+	jobs  *JobWorker
+	queue string
+}
+
+// NewQueueAlertHook creates a new QueueAlertHook.
+func NewQueueAlertHook(jobs *JobWorker, queue string) *QueueAlertHook {
+	return &QueueAlertHook{
+		jobs:  jobs,
+		queue: queue,
+	}
+}
+
+// Alert enqueues the alert, which is the payload of the job.
+func (h *QueueAlertHook) Alert(ctx context.Context, alert JobAlert) error {
+	return h.jobs.EnqueueJSON(ctx, h.queue, alert)
+}
+
+// WebhookAlertHook posts job alerts as JSON to a URL.
+type WebhookAlertHook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookAlertHook creates a new WebhookAlertHook.
+func NewWebhookAlertHook(url string) *WebhookAlertHook {
+	return &WebhookAlertHook{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Alert posts the alert to the webhook URL. Responses other than 2xx are returned as errors.
+func (h *WebhookAlertHook) Alert(ctx context.Context, alert JobAlert) error {
+	b, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
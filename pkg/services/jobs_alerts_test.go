@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobWorker__Alerts(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("alert_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	worker.SetRetryPolicy("alert_queue", RetryPolicy{MaxAttempts: 1})
+	worker.SetAlertPolicy(AlertPolicy{
+		FailureRate: 0.5,
+		MinJobs:     4,
+		Window:      time.Hour,
+		Cooldown:    time.Hour,
+	})
+
+	var alerts []JobAlert
+	worker.AddAlertHook(AlertHookFunc(func(ctx context.Context, alert JobAlert) error {
+		alerts = append(alerts, alert)
+		return nil
+	}))
+
+	worker.Register("alert_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		if payload["fail"] == true {
+			return errors.New("upstream unavailable")
+		}
+		return nil
+	})
+
+	run := func(fail bool) {
+		require.NoError(t, worker.Enqueue(ctx, "alert_queue", map[string]interface{}{"fail": fail}))
+		worker.processJobs()
+		worker.alerting.Wait()
+	}
+
+	// Too few jobs have finished to alert even though every one failed.
+	run(true)
+	run(true)
+	assert.Empty(t, alerts)
+
+	// 1 of 3 is below the threshold.
+	run(false)
+	require.Empty(t, alerts)
+
+	// 3 of 4 crosses it.
+	run(true)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "alert_queue", alerts[0].Queue)
+	assert.Equal(t, 3, alerts[0].Failed)
+	assert.Equal(t, 1, alerts[0].Completed)
+	assert.InDelta(t, 0.75, alerts[0].FailureRate, 0.001)
+	assert.Equal(t, "upstream unavailable", alerts[0].Error)
+	assert.NotZero(t, alerts[0].JobID)
+
+	// Further failures within the cooldown do not alert again.
+	run(true)
+	assert.Len(t, alerts, 1)
+}
+
+func TestJobWorker__Alerts_Disabled(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("quiet_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	worker.SetRetryPolicy("quiet_queue", RetryPolicy{MaxAttempts: 1})
+	worker.SetAlertPolicy(AlertPolicy{})
+
+	called := false
+	worker.AddAlertHook(AlertHookFunc(func(ctx context.Context, alert JobAlert) error {
+		called = true
+		return nil
+	}))
+	worker.Register("quiet_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		return errors.New("failed")
+	})
+
+	require.NoError(t, worker.Enqueue(ctx, "quiet_queue", map[string]interface{}{}))
+	worker.processJobs()
+	worker.alerting.Wait()
+	assert.False(t, called)
+}
+
+func TestWebhookAlertHook(t *testing.T) {
+	var received JobAlert
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	hook := NewWebhookAlertHook(srv.URL)
+	alert := JobAlert{
+		Queue:       "webhook_queue",
+		FailureRate: 0.5,
+		Failed:      5,
+		Completed:   5,
+		JobID:       10,
+		Error:       "boom",
+	}
+
+	require.NoError(t, hook.Alert(context.Background(), alert))
+	assert.Equal(t, alert, received)
+
+	status = http.StatusInternalServerError
+	assert.Error(t, hook.Alert(context.Background(), alert))
+}
+
+func TestQueueAlertHook(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("alerts_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	hook := NewQueueAlertHook(worker, "alerts_queue")
+	err := hook.Alert(ctx, JobAlert{
+		Queue:       "mail_queue",
+		FailureRate: 1,
+		Failed:      10,
+		Since:       time.Now().Add(-time.Hour),
+		Error:       "boom",
+	})
+	require.NoError(t, err)
+
+	j, err := c.ORM.Job.Query().Where(job.QueueEQ("alerts_queue")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "mail_queue", j.Payload["queue"])
+	assert.Equal(t, "boom", j.Payload["error"])
+}
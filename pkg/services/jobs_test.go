@@ -2,17 +2,23 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/jobattempt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestJobWorker__Enqueue(t *testing.T) {
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 	ctx := context.Background()
 
 	// Clean up jobs table before test
@@ -38,7 +44,7 @@ func TestJobWorker__Enqueue(t *testing.T) {
 }
 
 func TestJobWorker__EnqueueJSON(t *testing.T) {
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 	ctx := context.Background()
 
 	// Clean up jobs table before test
@@ -67,7 +73,7 @@ func TestJobWorker__EnqueueJSON(t *testing.T) {
 }
 
 func TestJobWorker__Register(t *testing.T) {
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 
 	handlerCalled := false
 	worker.Register("test_handler", func(ctx context.Context, payload map[string]interface{}) error {
@@ -86,7 +92,7 @@ func TestJobWorker__Register(t *testing.T) {
 func TestJobWorker__ProcessJob_Success(t *testing.T) {
 	// Clean up jobs table before test
 	c.ORM.Job.Delete().ExecX(context.Background())
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 	ctx := context.Background()
 
 	processed := false
@@ -126,7 +132,7 @@ func TestJobWorker__ProcessJob_Success(t *testing.T) {
 func TestJobWorker__ProcessJob_Failure(t *testing.T) {
 	// Clean up jobs table before test
 	c.ORM.Job.Delete().ExecX(context.Background())
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 	ctx := context.Background()
 
 	worker.Register("fail_queue", func(ctx context.Context, payload map[string]interface{}) error {
@@ -151,7 +157,7 @@ func TestJobWorker__ProcessJob_Failure(t *testing.T) {
 }
 
 func TestJobWorker__ProcessJob_MaxAttemptsReached(t *testing.T) {
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 	ctx := context.Background()
 
 	worker.Register("max_fail_queue", func(ctx context.Context, payload map[string]interface{}) error {
@@ -176,7 +182,7 @@ func TestJobWorker__ProcessJob_MaxAttemptsReached(t *testing.T) {
 }
 
 func TestJobWorker__ProcessJob_NoHandler(t *testing.T) {
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 	ctx := context.Background()
 
 	createdJob, err := c.ORM.Job.Create().
@@ -196,7 +202,7 @@ func TestJobWorker__ProcessJob_NoHandler(t *testing.T) {
 func TestJobWorker__ProcessJobs(t *testing.T) {
 	// Clean up jobs table before test
 	c.ORM.Job.Delete().ExecX(context.Background())
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 	ctx := context.Background()
 
 	processedCount := 0
@@ -227,7 +233,7 @@ func TestJobWorker__ProcessJobs(t *testing.T) {
 }
 
 func TestJobWorker__StartStop(t *testing.T) {
-	worker := NewJobWorker(c.ORM)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
 
 	assert.Nil(t, worker.ticker)
 
@@ -239,3 +245,965 @@ func TestJobWorker__StartStop(t *testing.T) {
 	worker.Stop()
 	time.Sleep(100 * time.Millisecond)
 }
+
+func TestJobWorker__Schedule(t *testing.T) {
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+
+	assert.Error(t, worker.Schedule("scheduled_queue", "not a cron expression"))
+	require.NoError(t, worker.Schedule("scheduled_queue", "*/5 * * * *"))
+
+	schedules := worker.Schedules()
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "scheduled_queue", schedules[0].Queue)
+	assert.Equal(t, "*/5 * * * *", schedules[0].Spec)
+	assert.True(t, schedules[0].Next.After(time.Now()))
+	assert.Zero(t, schedules[0].Next.Minute()%5)
+}
+
+func TestJobWorker__Schedule_OncePerTick(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("cron_queue")).ExecX(ctx)
+
+	// Two instances share the same schedule.
+	first := NewJobWorker(c.ORM, c.Config, c.Validator)
+	second := NewJobWorker(c.ORM, c.Config, c.Validator)
+	require.NoError(t, first.Schedule("cron_queue", "@hourly"))
+	require.NoError(t, second.Schedule("cron_queue", "@hourly"))
+
+	tick := time.Now().UTC().Truncate(time.Hour)
+	first.schedules[0].next = tick
+	second.schedules[0].next = tick
+
+	// Nothing is due before the tick.
+	first.enqueueDueSchedules(tick.Add(-time.Second))
+
+	now := tick.Add(time.Second)
+	first.enqueueDueSchedules(now)
+	second.enqueueDueSchedules(now)
+	first.enqueueDueSchedules(now)
+
+	jobs, err := c.ORM.Job.Query().
+		Where(job.QueueEQ("cron_queue")).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.NotNil(t, jobs[0].ScheduledFor)
+	assert.True(t, tick.Equal(*jobs[0].ScheduledFor))
+
+	assert.Equal(t, tick.Add(time.Hour), first.schedules[0].next)
+	assert.Equal(t, tick.Add(time.Hour), second.schedules[0].next)
+}
+
+func TestJobWorker__ClaimJob_OnlyOnce(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("claim_queue")).ExecX(ctx)
+
+	first := NewJobWorker(c.ORM, c.Config, c.Validator)
+	second := NewJobWorker(c.ORM, c.Config, c.Validator)
+	require.NotEqual(t, first.id, second.id)
+
+	created, err := c.ORM.Job.Create().
+		SetQueue("claim_queue").
+		SetPayload(map[string]interface{}{}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Both workers loaded the same pending row but only one may claim it.
+	stale := *created
+	claimed, err := first.claimJob(ctx, created)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = second.claimJob(ctx, &stale)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	updated, err := c.ORM.Job.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusProcessing, updated.Status)
+	assert.Equal(t, first.id, updated.LockedBy)
+	assert.Equal(t, 1, updated.Attempts)
+	require.NotNil(t, updated.LockedUntil)
+	assert.True(t, updated.LockedUntil.After(time.Now()))
+}
+
+func TestJobWorker__ProcessJobs_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("concurrent_queue")).ExecX(ctx)
+
+	var mu sync.Mutex
+	runs := make(map[float64]int)
+	handler := func(ctx context.Context, payload map[string]interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		runs[payload["index"].(float64)]++
+		return nil
+	}
+
+	workers := make([]*JobWorker, 4)
+	for i := range workers {
+		workers[i] = NewJobWorker(c.ORM, c.Config, c.Validator)
+		workers[i].Register("concurrent_queue", handler)
+	}
+
+	for i := 0; i < 20; i++ {
+		require.NoError(t, workers[0].Enqueue(ctx, "concurrent_queue", map[string]interface{}{
+			"index": i,
+		}))
+	}
+
+	var wg sync.WaitGroup
+	for _, w := range workers {
+		wg.Add(1)
+		go func(w *JobWorker) {
+			defer wg.Done()
+			for i := 0; i < 3; i++ {
+				w.processJobs()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	assert.Len(t, runs, 20)
+	for index, count := range runs {
+		assert.Equal(t, 1, count, "job %v ran %d times", index, count)
+	}
+}
+
+func TestJobWorker__ProcessJobs_ReclaimsExpiredLease(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("reclaim_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	processed := 0
+	worker.Register("reclaim_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		processed++
+		return nil
+	})
+
+	// A job abandoned by a crashed worker.
+	expired, err := c.ORM.Job.Create().
+		SetQueue("reclaim_queue").
+		SetPayload(map[string]interface{}{}).
+		SetStatus(job.StatusProcessing).
+		SetAttempts(1).
+		SetLockedBy("crashed").
+		SetLockedUntil(time.Now().Add(-time.Minute)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A job still being processed by another worker.
+	active, err := c.ORM.Job.Create().
+		SetQueue("reclaim_queue").
+		SetPayload(map[string]interface{}{}).
+		SetStatus(job.StatusProcessing).
+		SetAttempts(1).
+		SetLockedBy("other").
+		SetLockedUntil(time.Now().Add(time.Minute)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A job abandoned on its final attempt.
+	exhausted, err := c.ORM.Job.Create().
+		SetQueue("reclaim_queue").
+		SetPayload(map[string]interface{}{}).
+		SetStatus(job.StatusProcessing).
+		SetAttempts(3).
+		SetMaxAttempts(3).
+		SetLockedBy("crashed").
+		SetLockedUntil(time.Now().Add(-time.Minute)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	worker.processJobs()
+	assert.Equal(t, 1, processed)
+
+	expired, err = c.ORM.Job.Get(ctx, expired.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusCompleted, expired.Status)
+	assert.Equal(t, 2, expired.Attempts)
+	assert.Empty(t, expired.LockedBy)
+	assert.Nil(t, expired.LockedUntil)
+
+	active, err = c.ORM.Job.Get(ctx, active.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusProcessing, active.Status)
+	assert.Equal(t, "other", active.LockedBy)
+
+	exhausted, err = c.ORM.Job.Get(ctx, exhausted.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusFailed, exhausted.Status)
+	assert.Contains(t, exhausted.Error, "lease expired")
+}
+
+func TestJobWorker__Run_LostLease(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("lost_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	worker.Register("lost_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		return nil
+	})
+
+	created, err := c.ORM.Job.Create().
+		SetQueue("lost_queue").
+		SetPayload(map[string]interface{}{}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	claimed, err := worker.claimJob(ctx, created)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	// Simulate the job being reclaimed by another worker after the lease expired.
+	c.ORM.Job.UpdateOneID(created.ID).SetLockedBy("other").ExecX(ctx)
+
+	worker.run(ctx, created, worker.handlers["lost_queue"])
+
+	updated, err := c.ORM.Job.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusProcessing, updated.Status)
+	assert.Equal(t, "other", updated.LockedBy)
+}
+
+func newTestJobWorker(tasks func(cfg *config.TasksConfig)) *JobWorker {
+	cfg := *c.Config
+	tasks(&cfg.Tasks)
+	return NewJobWorker(c.ORM, &cfg, c.Validator)
+}
+
+func TestJobWorker__Concurrency(t *testing.T) {
+	worker := newTestJobWorker(func(cfg *config.TasksConfig) {
+		cfg.Goroutines = 2
+		cfg.Queues = map[string]int{"wide_queue": 5}
+	})
+
+	assert.Equal(t, 5, worker.Concurrency("wide_queue"))
+	assert.Equal(t, 2, worker.Concurrency("other_queue"))
+}
+
+func TestJobWorker__ProcessJobs_PerQueueConcurrency(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueIn("pool_queue", "fast_queue")).ExecX(ctx)
+
+	worker := newTestJobWorker(func(cfg *config.TasksConfig) {
+		cfg.Goroutines = 1
+		cfg.Queues = map[string]int{"pool_queue": 3}
+	})
+
+	var running, peak, fast atomic.Int32
+	worker.Register("pool_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	})
+	worker.Register("fast_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		fast.Add(1)
+		return nil
+	})
+
+	for i := 0; i < 6; i++ {
+		require.NoError(t, worker.Enqueue(ctx, "pool_queue", map[string]interface{}{}))
+	}
+	require.NoError(t, worker.Enqueue(ctx, "fast_queue", map[string]interface{}{}))
+
+	start := time.Now()
+	worker.processJobs()
+
+	assert.Equal(t, int32(3), peak.Load())
+	assert.Equal(t, int32(1), fast.Load())
+	assert.Less(t, time.Since(start), 6*50*time.Millisecond)
+
+	completed, err := c.ORM.Job.Query().
+		Where(
+			job.QueueEQ("pool_queue"),
+			job.StatusEQ(job.StatusCompleted),
+		).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 6, completed)
+}
+
+func TestJobWorker__Enqueue_WakesWorker(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("wake_queue")).ExecX(ctx)
+
+	worker := newTestJobWorker(func(cfg *config.TasksConfig) {
+		cfg.PollInterval = time.Hour
+	})
+	worker.Start()
+	defer worker.Stop()
+
+	processed := make(chan struct{})
+	worker.Register("wake_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		close(processed)
+		return nil
+	})
+
+	require.NoError(t, worker.Enqueue(ctx, "wake_queue", map[string]interface{}{}))
+
+	select {
+	case <-processed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("job was not processed after being enqueued")
+	}
+}
+
+func TestJobWorker__Stop_WaitsForRunningJobs(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("graceful_queue")).ExecX(ctx)
+
+	worker := newTestJobWorker(func(cfg *config.TasksConfig) {
+		cfg.PollInterval = time.Hour
+		cfg.ShutdownTimeout = 5 * time.Second
+	})
+
+	started := make(chan struct{})
+	worker.Register("graceful_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		close(started)
+		time.Sleep(100 * time.Millisecond)
+		return ctx.Err()
+	})
+	worker.Start()
+
+	require.NoError(t, worker.Enqueue(ctx, "graceful_queue", map[string]interface{}{}))
+	<-started
+	worker.Stop()
+
+	j, err := c.ORM.Job.Query().Where(job.QueueEQ("graceful_queue")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusCompleted, j.Status)
+}
+
+func TestJobWorker__Stop_CancelsAfterTimeout(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("cancel_queue")).ExecX(ctx)
+
+	worker := newTestJobWorker(func(cfg *config.TasksConfig) {
+		cfg.PollInterval = time.Hour
+		cfg.ShutdownTimeout = 50 * time.Millisecond
+	})
+
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	worker.Register("cancel_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+		return ctx.Err()
+	})
+	worker.Start()
+
+	require.NoError(t, worker.Enqueue(ctx, "cancel_queue", map[string]interface{}{}))
+	<-started
+
+	begin := time.Now()
+	worker.Stop()
+	assert.Less(t, time.Since(begin), time.Second)
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("job context was not cancelled")
+	}
+
+	// The cancelled job is returned to the queue to be retried.
+	require.Eventually(t, func() bool {
+		j, err := c.ORM.Job.Query().Where(job.QueueEQ("cancel_queue")).Only(ctx)
+		return err == nil && j.Status == job.StatusPending
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestRetryPolicy__Delay(t *testing.T) {
+	policy := RetryPolicy{
+		Backoff:    time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: time.Second},
+		{attempt: 2, max: 2 * time.Second},
+		{attempt: 3, max: 4 * time.Second},
+		{attempt: 4, max: 8 * time.Second},
+		{attempt: 5, max: 10 * time.Second},
+		{attempt: 50, max: 10 * time.Second},
+	}
+
+	for _, tc := range tests {
+		for i := 0; i < 20; i++ {
+			d := policy.Delay(tc.attempt)
+			assert.GreaterOrEqual(t, d, tc.max/2, "attempt %d", tc.attempt)
+			assert.LessOrEqual(t, d, tc.max, "attempt %d", tc.attempt)
+		}
+	}
+
+	assert.Zero(t, RetryPolicy{}.Delay(1))
+}
+
+func TestJobWorker__ProcessJob_RetryBackoff(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("backoff_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	worker.SetRetryPolicy("backoff_queue", RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     time.Minute,
+		MaxBackoff:  time.Hour,
+	})
+	calls := 0
+	worker.Register("backoff_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		calls++
+		return fmt.Errorf("rate limited")
+	})
+
+	require.NoError(t, worker.Enqueue(ctx, "backoff_queue", map[string]interface{}{}))
+
+	worker.processJobs()
+	assert.Equal(t, 1, calls)
+
+	j, err := c.ORM.Job.Query().Where(job.QueueEQ("backoff_queue")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusPending, j.Status)
+	assert.Equal(t, 5, j.MaxAttempts)
+	assert.Equal(t, "rate limited", j.Error)
+	assert.True(t, j.RunAt.After(time.Now().Add(29*time.Second)))
+	assert.True(t, j.RunAt.Before(time.Now().Add(61*time.Second)))
+
+	// The job is not retried before its next attempt time.
+	worker.processJobs()
+	assert.Equal(t, 1, calls)
+}
+
+func TestJobWorker__EnqueueIn(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("delayed_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	calls := 0
+	worker.Register("delayed_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		calls++
+		return nil
+	})
+
+	require.NoError(t, worker.EnqueueIn(ctx, "delayed_queue", map[string]interface{}{}, time.Hour))
+	worker.processJobs()
+	assert.Equal(t, 0, calls)
+
+	require.NoError(t, worker.EnqueueAt(ctx, "delayed_queue", map[string]interface{}{}, time.Now().Add(-time.Second)))
+	worker.processJobs()
+	assert.Equal(t, 1, calls)
+}
+
+func TestJobWorker__ProcessJobs_Priority(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("priority_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	var order []string
+	worker.Register("priority_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		order = append(order, payload["name"].(string))
+		return nil
+	})
+
+	require.NoError(t, worker.Enqueue(ctx, "priority_queue", map[string]interface{}{"name": "low"}, WithPriority(-1)))
+	require.NoError(t, worker.Enqueue(ctx, "priority_queue", map[string]interface{}{"name": "normal"}))
+	require.NoError(t, worker.Enqueue(ctx, "priority_queue", map[string]interface{}{"name": "high"}, WithPriority(10)))
+
+	worker.processJobs()
+	assert.Equal(t, []string{"high", "normal", "low"}, order)
+}
+
+func TestJobWorker__RetryCancel(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("manage_queue")).ExecX(ctx)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+
+	failed := c.ORM.Job.Create().
+		SetQueue("manage_queue").
+		SetPayload(map[string]interface{}{}).
+		SetStatus(job.StatusFailed).
+		SetAttempts(3).
+		SetError("boom").
+		SetProcessedAt(time.Now()).
+		SaveX(ctx)
+
+	pending := c.ORM.Job.Create().
+		SetQueue("manage_queue").
+		SetPayload(map[string]interface{}{}).
+		SaveX(ctx)
+
+	// Only failed or cancelled jobs can be retried.
+	assert.ErrorIs(t, worker.Retry(ctx, pending.ID), ErrJobStatus)
+	require.NoError(t, worker.Retry(ctx, failed.ID))
+
+	failed = c.ORM.Job.GetX(ctx, failed.ID)
+	assert.Equal(t, job.StatusPending, failed.Status)
+	assert.Zero(t, failed.Attempts)
+	assert.Empty(t, failed.Error)
+
+	// Only pending jobs can be cancelled.
+	require.NoError(t, worker.Cancel(ctx, pending.ID))
+	assert.ErrorIs(t, worker.Cancel(ctx, pending.ID), ErrJobStatus)
+
+	pending = c.ORM.Job.GetX(ctx, pending.ID)
+	assert.Equal(t, job.StatusCancelled, pending.Status)
+
+	// Cancelled jobs are never claimed.
+	calls := 0
+	worker.Register("manage_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		calls++
+		return nil
+	})
+	worker.processJobs()
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, job.StatusCancelled, c.ORM.Job.GetX(ctx, pending.ID).Status)
+
+	assert.True(t, ent.IsNotFound(worker.Retry(ctx, 0)))
+}
+
+func TestJobWorker__PurgeCompleted(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("purge_queue")).ExecX(ctx)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+
+	create := func(status job.Status, processedAt time.Time) int {
+		return c.ORM.Job.Create().
+			SetQueue("purge_queue").
+			SetPayload(map[string]interface{}{}).
+			SetStatus(status).
+			SetProcessedAt(processedAt).
+			SaveX(ctx).ID
+	}
+
+	old := create(job.StatusCompleted, time.Now().AddDate(0, 0, -10))
+	recent := create(job.StatusCompleted, time.Now().AddDate(0, 0, -1))
+	oldFailed := create(job.StatusFailed, time.Now().AddDate(0, 0, -10))
+
+	deleted, err := worker.PurgeCompleted(ctx, time.Now().AddDate(0, 0, -7))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	ids := c.ORM.Job.Query().Where(job.QueueEQ("purge_queue")).IDsX(ctx)
+	assert.NotContains(t, ids, old)
+	assert.ElementsMatch(t, []int{recent, oldFailed}, ids)
+}
+
+func TestJobWorker__QueueStats(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().ExecX(ctx)
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+
+	create := func(queue string, status job.Status, processedAt time.Time) {
+		q := c.ORM.Job.Create().
+			SetQueue(queue).
+			SetPayload(map[string]interface{}{}).
+			SetStatus(status)
+		if !processedAt.IsZero() {
+			q.SetProcessedAt(processedAt)
+		}
+		q.SaveX(ctx)
+	}
+
+	now := time.Now()
+	create("b_queue", job.StatusPending, time.Time{})
+	create("b_queue", job.StatusPending, time.Time{})
+	create("b_queue", job.StatusCompleted, now.Add(-time.Hour))
+	create("b_queue", job.StatusCompleted, now.Add(-time.Hour))
+	create("b_queue", job.StatusCompleted, now.Add(-time.Hour))
+	create("b_queue", job.StatusFailed, now.Add(-time.Hour))
+	create("b_queue", job.StatusCompleted, now.AddDate(0, 0, -3))
+	create("a_queue", job.StatusProcessing, time.Time{})
+
+	stats, err := worker.QueueStats(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	assert.Equal(t, QueueStats{Queue: "a_queue", Processing: 1}, stats[0])
+	assert.Equal(t, QueueStats{
+		Queue:           "b_queue",
+		Pending:         2,
+		Completed:       4,
+		Failed:          1,
+		RecentCompleted: 3,
+		RecentFailed:    1,
+	}, stats[1])
+	assert.Equal(t, 0.25, stats[1].FailureRate())
+	assert.Zero(t, stats[0].FailureRate())
+}
+
+func TestJobWorker__Register_Typed(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("typed_queue")).ExecX(ctx)
+
+	type payload struct {
+		Email string `json:"email" validate:"required,email"`
+		Count int    `json:"count"`
+	}
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	var received []payload
+	Register(worker, "typed_queue", func(ctx context.Context, p payload) error {
+		received = append(received, p)
+		return nil
+	})
+
+	require.NoError(t, worker.EnqueueJSON(ctx, "typed_queue", payload{Email: "a@example.com", Count: 2}))
+	worker.processJobs()
+	assert.Equal(t, []payload{{Email: "a@example.com", Count: 2}}, received)
+
+	// Payloads that fail to decode or validate fail on the first attempt.
+	malformed := []map[string]interface{}{
+		{"email": "a@example.com", "count": "two"},
+		{"email": "not an email"},
+	}
+	for _, p := range malformed {
+		require.NoError(t, worker.Enqueue(ctx, "typed_queue", p))
+	}
+	worker.processJobs()
+	assert.Len(t, received, 1)
+
+	failed, err := c.ORM.Job.Query().
+		Where(
+			job.QueueEQ("typed_queue"),
+			job.StatusEQ(job.StatusFailed),
+		).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, failed, 2)
+	for _, j := range failed {
+		assert.Equal(t, 1, j.Attempts)
+		assert.Contains(t, j.Error, ErrInvalidPayload.Error())
+	}
+}
+
+func TestJobWorker__ProcessJob_Permanent(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("permanent_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	worker.Register("permanent_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		return Permanent(fmt.Errorf("record was deleted"))
+	})
+
+	require.NoError(t, worker.Enqueue(ctx, "permanent_queue", map[string]interface{}{}))
+	worker.processJobs()
+
+	j, err := c.ORM.Job.Query().Where(job.QueueEQ("permanent_queue")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusFailed, j.Status)
+	assert.Equal(t, 1, j.Attempts)
+	assert.Equal(t, "record was deleted", j.Error)
+	assert.Nil(t, Permanent(nil))
+}
+
+func TestJobWorker__ProcessJob_RecordsAttempts(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("history_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	worker.SetRetryPolicy("history_queue", RetryPolicy{MaxAttempts: 3})
+
+	calls := 0
+	worker.Register("history_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		calls++
+		if calls < 3 {
+			return fmt.Errorf("failure %d", calls)
+		}
+		return nil
+	})
+
+	require.NoError(t, worker.Enqueue(ctx, "history_queue", map[string]interface{}{}))
+	for range 3 {
+		worker.processJobs()
+	}
+
+	j, err := c.ORM.Job.Query().Where(job.QueueEQ("history_queue")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, job.StatusCompleted, j.Status)
+
+	attempts, err := j.QueryHistory().Order(ent.Asc(jobattempt.FieldID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, attempts, 3)
+	for i, a := range attempts {
+		assert.Equal(t, i+1, a.Attempt)
+		assert.Equal(t, worker.id, a.Worker)
+		assert.False(t, a.FinishedAt.Before(a.StartedAt))
+		assert.GreaterOrEqual(t, a.DurationMs, int64(0))
+	}
+	assert.Equal(t, "failure 1", attempts[0].Error)
+	assert.Equal(t, "failure 2", attempts[1].Error)
+	assert.Empty(t, attempts[2].Error)
+
+	// Purging the job removes its history.
+	_, err = worker.PurgeCompleted(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	n, err := c.ORM.JobAttempt.Query().Where(jobattempt.JobID(j.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestJobWorker__RetryFailed(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueIn("dead_queue", "other_dead_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	create := func(queue string, status job.Status) *ent.Job {
+		return c.ORM.Job.Create().
+			SetQueue(queue).
+			SetPayload(map[string]interface{}{}).
+			SetStatus(status).
+			SetAttempts(3).
+			SetError("boom").
+			SetProcessedAt(time.Now()).
+			SaveX(ctx)
+	}
+
+	failed := create("dead_queue", job.StatusFailed)
+	completed := create("dead_queue", job.StatusCompleted)
+	other := create("other_dead_queue", job.StatusFailed)
+
+	n, err := worker.RetryFailed(ctx, "dead_queue")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	failed = c.ORM.Job.GetX(ctx, failed.ID)
+	assert.Equal(t, job.StatusPending, failed.Status)
+	assert.Zero(t, failed.Attempts)
+	assert.Empty(t, failed.Error)
+
+	assert.Equal(t, job.StatusCompleted, c.ORM.Job.GetX(ctx, completed.ID).Status)
+	assert.Equal(t, job.StatusFailed, c.ORM.Job.GetX(ctx, other.ID).Status)
+}
+
+func TestJobWorker__Enqueue_UniqueKey(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("unique_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	active := func() []*ent.Job {
+		return c.ORM.Job.Query().
+			Where(
+				job.QueueEQ("unique_queue"),
+				job.StatusIn(job.StatusPending, job.StatusProcessing),
+			).
+			AllX(ctx)
+	}
+
+	enqueue := func(n int, strategy ConflictStrategy, opts ...EnqueueOption) error {
+		return worker.Enqueue(ctx, "unique_queue", map[string]interface{}{"n": n},
+			append(opts, WithUniqueKey("user:1", strategy))...)
+	}
+
+	require.NoError(t, enqueue(1, ConflictIgnore))
+
+	// Ignore keeps the existing job.
+	require.NoError(t, enqueue(2, ConflictIgnore))
+	jobs := active()
+	require.Len(t, jobs, 1)
+	assert.Equal(t, float64(1), jobs[0].Payload["n"])
+	assert.Equal(t, "user:1", jobs[0].UniqueKey)
+
+	// Error keeps the existing job and returns an error.
+	err := enqueue(3, ConflictError)
+	assert.ErrorIs(t, err, ErrDuplicateJob)
+	assert.Len(t, active(), 1)
+
+	// Replace updates the pending job.
+	require.NoError(t, enqueue(4, ConflictReplace, WithPriority(5)))
+	jobs = active()
+	require.Len(t, jobs, 1)
+	assert.Equal(t, float64(4), jobs[0].Payload["n"])
+	assert.Equal(t, 5, jobs[0].Priority)
+
+	// Keys are scoped to their queue.
+	c.ORM.Job.Delete().Where(job.QueueEQ("other_unique_queue")).ExecX(ctx)
+	require.NoError(t, worker.Enqueue(ctx, "other_unique_queue", nil, WithUniqueKey("user:1", ConflictError)))
+
+	// A running job cannot be replaced.
+	_, err = c.ORM.Job.UpdateOne(jobs[0]).SetStatus(job.StatusProcessing).Save(ctx)
+	require.NoError(t, err)
+	err = enqueue(5, ConflictReplace)
+	assert.ErrorIs(t, err, ErrDuplicateJob)
+
+	// The key is released once the job finishes.
+	_, err = c.ORM.Job.UpdateOne(jobs[0]).SetStatus(job.StatusCompleted).ClearUniqueLock().Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, enqueue(6, ConflictError))
+	jobs = active()
+	require.Len(t, jobs, 1)
+	assert.Equal(t, float64(6), jobs[0].Payload["n"])
+
+	// Cancelling releases the key, and retrying the cancelled job conflicts with the newer one.
+	require.NoError(t, worker.Cancel(ctx, jobs[0].ID))
+	require.NoError(t, enqueue(7, ConflictError))
+	assert.ErrorIs(t, worker.Retry(ctx, jobs[0].ID), ErrDuplicateJob)
+	assert.Equal(t, job.StatusCancelled, c.ORM.Job.GetX(ctx, jobs[0].ID).Status)
+}
+
+func TestJobWorker__ProcessJob_ReleasesUniqueKey(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("release_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	worker.SetRetryPolicy("release_queue", RetryPolicy{MaxAttempts: 2, Backoff: time.Hour})
+
+	fail := true
+	worker.Register("release_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	key := WithUniqueKey("digest", ConflictError)
+	require.NoError(t, worker.Enqueue(ctx, "release_queue", nil, key))
+
+	// The key is held while the job waits to be retried.
+	worker.processJobs()
+	assert.ErrorIs(t, worker.Enqueue(ctx, "release_queue", nil, key), ErrDuplicateJob)
+
+	// It is released once the job has failed for good.
+	c.ORM.Job.Update().Where(job.QueueEQ("release_queue")).SetRunAt(time.Now()).ExecX(ctx)
+	worker.processJobs()
+	require.NoError(t, worker.Enqueue(ctx, "release_queue", nil, key))
+
+	// And once a job completes.
+	fail = false
+	worker.processJobs()
+	require.NoError(t, worker.Enqueue(ctx, "release_queue", nil, key))
+
+	// Failed jobs whose key is held by an active job are skipped when retrying.
+	n, err := worker.RetryFailed(ctx, "release_queue")
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestJobWorker__Enqueue_UniqueKey_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+
+	strategies := map[string]ConflictStrategy{
+		"ignore":  ConflictIgnore,
+		"replace": ConflictReplace,
+		"error":   ConflictError,
+	}
+
+	for name, strategy := range strategies {
+		t.Run(name, func(t *testing.T) {
+			queue := "concurrent_unique_" + name
+			c.ORM.Job.Delete().Where(job.QueueEQ(queue)).ExecX(ctx)
+
+			const n = 20
+			var wg sync.WaitGroup
+			var duplicates atomic.Int32
+			for i := range n {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					err := worker.Enqueue(ctx, queue, map[string]interface{}{"n": i}, WithUniqueKey("key", strategy))
+					switch {
+					case errors.Is(err, ErrDuplicateJob):
+						duplicates.Add(1)
+					case err != nil:
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			count, err := c.ORM.Job.Query().Where(job.QueueEQ(queue)).Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			if strategy == ConflictError {
+				assert.Equal(t, int32(n-1), duplicates.Load())
+			} else {
+				assert.Zero(t, duplicates.Load())
+			}
+		})
+	}
+}
+
+func TestJobWorker__EnqueueTx(t *testing.T) {
+	ctx := context.Background()
+	c.ORM.Job.Delete().Where(job.QueueEQ("tx_queue")).ExecX(ctx)
+
+	worker := NewJobWorker(c.ORM, c.Config, c.Validator)
+	worker.Register("tx_queue", func(ctx context.Context, payload map[string]interface{}) error {
+		return nil
+	})
+	count := func() int {
+		return c.ORM.Job.Query().Where(job.QueueEQ("tx_queue")).CountX(ctx)
+	}
+	woken := func() bool {
+		select {
+		case <-worker.wake["tx_queue"]:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// Rolled back jobs are never enqueued.
+	tx, err := c.ORM.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, worker.EnqueueTx(ctx, tx, "tx_queue", map[string]interface{}{"n": 1}))
+	require.NoError(t, tx.Rollback())
+	assert.Zero(t, count())
+	assert.False(t, woken())
+
+	// Committed jobs are enqueued and the worker is woken only after the commit.
+	tx, err = c.ORM.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, worker.EnqueueTxJSON(ctx, tx, "tx_queue", struct {
+		N int `json:"n"`
+	}{N: 2}))
+	assert.False(t, woken())
+	require.NoError(t, tx.Commit())
+	assert.Equal(t, 1, count())
+	assert.True(t, woken())
+
+	// Unique keys are enforced against jobs outside of the transaction.
+	require.NoError(t, worker.Enqueue(ctx, "tx_queue", nil, WithUniqueKey("key", ConflictError)))
+	tx, err = c.ORM.Tx(ctx)
+	require.NoError(t, err)
+	err = worker.EnqueueTx(ctx, tx, "tx_queue", nil, WithUniqueKey("key", ConflictError))
+	assert.ErrorIs(t, err, ErrDuplicateJob)
+	require.NoError(t, tx.Rollback())
+	assert.Equal(t, 2, count())
+}
+
+func TestJobWorker__Health(t *testing.T) {
+	worker := newTestJobWorker(func(cfg *config.TasksConfig) {
+		cfg.PollInterval = 50 * time.Millisecond
+	})
+	worker.Register("health_b", func(ctx context.Context, payload map[string]interface{}) error { return nil })
+	worker.Register("health_a", func(ctx context.Context, payload map[string]interface{}) error { return nil })
+
+	health := worker.Health()
+	assert.False(t, health.Healthy)
+	assert.Equal(t, worker.id, health.WorkerID)
+	assert.Equal(t, []string{"health_a", "health_b"}, health.Queues)
+
+	worker.Start()
+	assert.True(t, worker.Health().Healthy)
+
+	// The poll loop keeps the worker healthy beyond three poll intervals.
+	time.Sleep(200 * time.Millisecond)
+	health = worker.Health()
+	assert.True(t, health.Healthy)
+	assert.True(t, health.LastPoll.After(health.StartedAt))
+
+	worker.Stop()
+	assert.False(t, worker.Health().Healthy)
+}
@@ -26,12 +26,13 @@ type (
 
 	// mail represents an email to be sent.
 	mail struct {
-		client    *MailClient
-		from      string
-		to        string
-		subject   string
-		body      string
-		component gomponents.Node
+		client      *MailClient
+		from        string
+		to          string
+		subject     string
+		body        string
+		component   gomponents.Node
+		attachments []*resend.Attachment
 	}
 )
 
@@ -57,6 +58,7 @@ func (m *MailClient) skipSend() bool {
 }
 
 // send attempts to send the email.
+// The context is only used for logging and may be nil when sending outside of a request, such as from a job.
 func (m *MailClient) send(email *mail, ctx echo.Context) error {
 	switch {
 	case email.to == "":
@@ -77,9 +79,14 @@ func (m *MailClient) send(email *mail, ctx echo.Context) error {
 		email.body = buf.String()
 	}
 
+	logger := log.Default()
+	if ctx != nil {
+		logger = log.Ctx(ctx)
+	}
+
 	// Check if mail sending should be skipped.
 	if m.skipSend() {
-		log.Ctx(ctx).Debug("skipping email delivery",
+		logger.Debug("skipping email delivery",
 			"to", email.to,
 		)
 		return nil
@@ -87,17 +94,18 @@ func (m *MailClient) send(email *mail, ctx echo.Context) error {
 
 	// Build Resend request.
 	params := &resend.SendEmailRequest{
-		From:    email.from,
-		To:      []string{email.to},
-		Subject: email.subject,
-		Html:    email.body,
+		From:        email.from,
+		To:          []string{email.to},
+		Subject:     email.subject,
+		Html:        email.body,
+		Attachments: email.attachments,
 	}
 
 	if _, err := m.sender.Emails.Send(params); err != nil {
 		return fmt.Errorf("resend: %w", err)
 	}
 
-	log.Ctx(ctx).Info("email sent", "to", email.to, "subject", email.subject)
+	logger.Info("email sent", "to", email.to, "subject", email.subject)
 	return nil
 }
 
@@ -132,6 +140,15 @@ func (m *mail) Component(component gomponents.Node) *mail {
 	return m
 }
 
+// Attach adds a file attachment to the email.
+func (m *mail) Attach(filename string, content []byte) *mail {
+	m.attachments = append(m.attachments, &resend.Attachment{
+		Filename: filename,
+		Content:  content,
+	})
+	return m
+}
+
 // Send attempts to send the email.
 func (m *mail) Send(ctx echo.Context) error {
 	return m.client.send(m, ctx)
@@ -32,6 +32,7 @@ type PaymentProvider interface {
 	GetSubscription(ctx context.Context, subscriptionID string) (*SubscriptionResult, error)
 	UpdateSubscription(ctx context.Context, subscriptionID string, params *UpdateSubscriptionParams) (*SubscriptionResult, error)
 	CancelSubscription(ctx context.Context, subscriptionID string) (*SubscriptionResult, error)
+	PreviewSubscriptionChange(ctx context.Context, subscriptionID, priceID string) (*ProrationPreviewResult, error)
 
 	// Payment method operations (secure - no raw card data)
 	GetPaymentMethod(ctx context.Context, paymentMethodID string) (*PaymentMethodResult, error)
@@ -40,9 +41,24 @@ type PaymentProvider interface {
 	ListPaymentMethods(ctx context.Context, customerID string) ([]*PaymentMethodResult, error)
 	SetDefaultPaymentMethod(ctx context.Context, customerID, paymentMethodID string) (*PaymentMethodResult, error)
 
+	// Promotion code operations
+	GetPromotionCode(ctx context.Context, code string) (*PromotionCodeResult, error)
+
 	// Refund operations
 	CreateRefund(ctx context.Context, params *CreateRefundParams) (*RefundResult, error)
 	GetRefund(ctx context.Context, refundID string) (*RefundResult, error)
+
+	// Invoice operations
+	ListInvoices(ctx context.Context, customerID string) ([]*InvoiceResult, error)
+	GetInvoice(ctx context.Context, invoiceID string) (*InvoiceResult, error)
+
+	// Connected account operations (accounts of form owners receiving payments from respondents)
+	CreateConnectedAccount(ctx context.Context, params *CreateConnectedAccountParams) (*ConnectedAccountResult, error)
+	GetConnectedAccount(ctx context.Context, accountID string) (*ConnectedAccountResult, error)
+	CreateAccountOnboardingLink(ctx context.Context, accountID, refreshURL, returnURL string) (string, error)
+
+	// Webhook operations
+	ConstructWebhookEvent(payload []byte, signature string) (*PaymentEvent, error)
 }
 
 // PaymentClient wraps the payment provider and provides high-level operations
@@ -50,6 +66,11 @@ type PaymentClient struct {
 	config   *config.Config
 	orm      *ent.Client
 	provider PaymentProvider
+
+	// usage and jobs record the usage of paid form responses and notify their owner once the payment succeeded.
+	// Neither is required, so clients without them only complete the responses.
+	usage *UsageClient
+	jobs  *JobWorker
 }
 
 // NewPaymentClient creates a new payment client
@@ -66,6 +87,11 @@ func (c *PaymentClient) GetConfig() *config.Config {
 	return c.config
 }
 
+// Provider returns the payment provider
+func (c *PaymentClient) Provider() PaymentProvider {
+	return c.provider
+}
+
 // CreateCustomerParams contains parameters for creating a customer
 type CreateCustomerParams struct {
 	Email    string                 `json:"email"`
@@ -80,13 +106,15 @@ type UpdateCustomerParams struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
-// CreatePaymentIntentParams contains parameters for creating a payment intent
+// CreatePaymentIntentParams contains parameters for creating a payment intent.
+// Payments to a connected account are made on its behalf and transferred to it, and need no customer.
 type CreatePaymentIntentParams struct {
-	Amount      int64                  `json:"amount"`
-	Currency    string                 `json:"currency"`
-	CustomerID  string                 `json:"customer_id"`
-	Description string                 `json:"description,omitempty"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Amount             int64                  `json:"amount"`
+	Currency           string                 `json:"currency"`
+	CustomerID         string                 `json:"customer_id,omitempty"`
+	ConnectedAccountID string                 `json:"connected_account_id,omitempty"`
+	Description        string                 `json:"description,omitempty"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // CreateSubscriptionParams contains parameters for creating a subscription
@@ -95,6 +123,7 @@ type CreateSubscriptionParams struct {
 	PriceID         string                 `json:"price_id"`
 	PaymentMethodID string                 `json:"payment_method_id,omitempty"`
 	TrialPeriodDays int                    `json:"trial_period_days,omitempty"`
+	PromotionCodeID string                 `json:"promotion_code_id,omitempty"`
 	Metadata        map[string]interface{} `json:"metadata,omitempty"`
 }
 
@@ -103,6 +132,12 @@ type UpdateSubscriptionParams struct {
 	PriceID         string                 `json:"price_id,omitempty"`
 	PaymentMethodID string                 `json:"payment_method_id,omitempty"`
 	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	// ProrationBehavior is how a price change is prorated, see ProrationAlwaysInvoice and ProrationNone
+	ProrationBehavior string `json:"proration_behavior,omitempty"`
+	// ProrationDate is when the proration is calculated from, which should match a preview that was shown
+	ProrationDate *time.Time `json:"proration_date,omitempty"`
+	// CancelAtPeriodEnd schedules or unschedules the end of the subscription when it is set
+	CancelAtPeriodEnd *bool `json:"cancel_at_period_end,omitempty"`
 }
 
 // AttachPaymentMethodParams contains parameters for attaching a payment method
@@ -113,6 +148,12 @@ type AttachPaymentMethodParams struct {
 	Metadata        map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// CreateConnectedAccountParams contains parameters for creating a connected account
+type CreateConnectedAccountParams struct {
+	Email    string                 `json:"email"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
 // CreateRefundParams contains parameters for creating a refund
 type CreateRefundParams struct {
 	PaymentIntentID string                 `json:"payment_intent_id"`
@@ -132,15 +173,26 @@ type CustomerResult struct {
 
 // PaymentIntentResult represents a payment intent response from the provider
 type PaymentIntentResult struct {
-	ID           string                 `json:"id"`
-	Status       string                 `json:"status"`
-	Amount       int64                  `json:"amount"`
-	Currency     string                 `json:"currency"`
-	CustomerID   string                 `json:"customer_id"`
-	Description  string                 `json:"description,omitempty"`
-	ClientSecret string                 `json:"client_secret,omitempty"`
-	Metadata     map[string]interface{} `json:"metadata,omitempty"`
-	Created      time.Time              `json:"created"`
+	ID                 string                 `json:"id"`
+	Status             string                 `json:"status"`
+	Amount             int64                  `json:"amount"`
+	Currency           string                 `json:"currency"`
+	CustomerID         string                 `json:"customer_id"`
+	ConnectedAccountID string                 `json:"connected_account_id,omitempty"`
+	Description        string                 `json:"description,omitempty"`
+	ClientSecret       string                 `json:"client_secret,omitempty"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+	Created            time.Time              `json:"created"`
+}
+
+// ConnectedAccountResult represents a connected account response from the provider
+type ConnectedAccountResult struct {
+	ID               string                 `json:"id"`
+	Email            string                 `json:"email"`
+	ChargesEnabled   bool                   `json:"charges_enabled"`
+	DetailsSubmitted bool                   `json:"details_submitted"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+	Created          time.Time              `json:"created"`
 }
 
 // SubscriptionResult represents a subscription response from the provider
@@ -159,8 +211,54 @@ type SubscriptionResult struct {
 	TrialEnd             *time.Time             `json:"trial_end,omitempty"`
 	CanceledAt           *time.Time             `json:"canceled_at,omitempty"`
 	EndedAt              *time.Time             `json:"ended_at,omitempty"`
-	Metadata             map[string]interface{} `json:"metadata,omitempty"`
-	Created              time.Time              `json:"created"`
+	CancelAtPeriodEnd    bool                   `json:"cancel_at_period_end"`
+	// Discount is the discount applied when the subscription was created, if any
+	Discount *DiscountResult        `json:"discount,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Created  time.Time              `json:"created"`
+}
+
+// CouponResult represents a coupon of the provider, which takes either a percentage or an amount off
+type CouponResult struct {
+	ID               string  `json:"id"`
+	Name             string  `json:"name,omitempty"`
+	PercentOff       float64 `json:"percent_off,omitempty"`
+	AmountOff        int64   `json:"amount_off,omitempty"`
+	Currency         string  `json:"currency,omitempty"`
+	Duration         string  `json:"duration"`
+	DurationInMonths int     `json:"duration_in_months,omitempty"`
+	Valid            bool    `json:"valid"`
+}
+
+// PromotionCodeResult represents a customer-facing code which redeems a coupon
+type PromotionCodeResult struct {
+	ID             string       `json:"id"`
+	Code           string       `json:"code"`
+	Active         bool         `json:"active"`
+	Coupon         CouponResult `json:"coupon"`
+	ExpiresAt      *time.Time   `json:"expires_at,omitempty"`
+	MaxRedemptions int64        `json:"max_redemptions,omitempty"`
+	TimesRedeemed  int64        `json:"times_redeemed"`
+	// MinimumAmount is the minimum amount of the first payment the code can be redeemed on
+	MinimumAmount         int64  `json:"minimum_amount,omitempty"`
+	MinimumAmountCurrency string `json:"minimum_amount_currency,omitempty"`
+}
+
+// DiscountResult represents a coupon applied to a subscription
+type DiscountResult struct {
+	ID              string       `json:"id"`
+	PromotionCodeID string       `json:"promotion_code_id,omitempty"`
+	Code            string       `json:"code,omitempty"`
+	Coupon          CouponResult `json:"coupon"`
+	Start           time.Time    `json:"start"`
+	End             *time.Time   `json:"end,omitempty"`
+}
+
+// ProrationPreviewResult represents what changing the price of a subscription would cost right away
+type ProrationPreviewResult struct {
+	AmountDue     int64     `json:"amount_due"`
+	Currency      string    `json:"currency"`
+	ProrationDate time.Time `json:"proration_date"`
 }
 
 // PaymentMethodResult represents a payment method response from the provider
@@ -188,6 +286,27 @@ type RefundResult struct {
 	Created         time.Time              `json:"created"`
 }
 
+// InvoiceResult represents an invoice response from the provider
+type InvoiceResult struct {
+	ID             string     `json:"id"`
+	CustomerID     string     `json:"customer_id"`
+	SubscriptionID string     `json:"subscription_id,omitempty"`
+	Number         string     `json:"number,omitempty"`
+	Status         string     `json:"status"`
+	Currency       string     `json:"currency"`
+	Subtotal       int64      `json:"subtotal"`
+	Tax            int64      `json:"tax"`
+	Total          int64      `json:"total"`
+	AmountDue      int64      `json:"amount_due"`
+	AmountPaid     int64      `json:"amount_paid"`
+	HostedURL      string     `json:"hosted_url,omitempty"`
+	PDFURL         string     `json:"pdf_url,omitempty"`
+	PeriodStart    time.Time  `json:"period_start"`
+	PeriodEnd      time.Time  `json:"period_end"`
+	PaidAt         *time.Time `json:"paid_at,omitempty"`
+	Created        time.Time  `json:"created"`
+}
+
 // High-level methods for the PaymentClient
 
 // CreateOrGetCustomer creates or retrieves an existing payment customer for a user
@@ -216,7 +335,7 @@ func (c *PaymentClient) CreateOrGetCustomer(ctx echo.Context, u *ent.User) (*ent
 	// Save customer to database
 	customer, err := c.orm.PaymentCustomer.Create().
 		SetProviderCustomerID(providerCustomer.ID).
-		SetProvider("stripe").
+		SetProvider(c.config.Payment.Provider).
 		SetEmail(providerCustomer.Email).
 		SetName(providerCustomer.Name).
 		SetMetadata(providerCustomer.Metadata).
@@ -227,13 +346,14 @@ func (c *PaymentClient) CreateOrGetCustomer(ctx echo.Context, u *ent.User) (*ent
 }
 
 // CreateOneTimePayment creates a payment intent for a one-time payment
-func (c *PaymentClient) CreateOneTimePayment(ctx echo.Context, customer *ent.PaymentCustomer, amount int64, currency, description string) (*ent.PaymentIntent, error) {
+func (c *PaymentClient) CreateOneTimePayment(ctx echo.Context, customer *ent.PaymentCustomer, amount int64, currency, description string, metadata map[string]interface{}) (*ent.PaymentIntent, error) {
 	// Create payment intent with provider
 	providerPaymentIntent, err := c.provider.CreatePaymentIntent(ctx.Request().Context(), &CreatePaymentIntentParams{
 		Amount:      amount,
 		Currency:    currency,
 		CustomerID:  customer.ProviderCustomerID,
 		Description: description,
+		Metadata:    metadata,
 	})
 	if err != nil {
 		return nil, err
@@ -242,7 +362,7 @@ func (c *PaymentClient) CreateOneTimePayment(ctx echo.Context, customer *ent.Pay
 	// Save payment intent to database
 	paymentIntent, err := c.orm.PaymentIntent.Create().
 		SetProviderPaymentIntentID(providerPaymentIntent.ID).
-		SetProvider("stripe").
+		SetProvider(c.config.Payment.Provider).
 		SetStatus(paymentintent.Status(providerPaymentIntent.Status)).
 		SetAmount(providerPaymentIntent.Amount).
 		SetCurrency(providerPaymentIntent.Currency).
@@ -263,6 +383,7 @@ func (c *PaymentClient) CreateSubscription(ctx echo.Context, customer *ent.Payme
 		PriceID:         priceID,
 		PaymentMethodID: params.PaymentMethodID,
 		TrialPeriodDays: params.TrialPeriodDays,
+		PromotionCodeID: params.PromotionCodeID,
 		Metadata:        params.Metadata,
 	})
 	if err != nil {
@@ -272,7 +393,7 @@ func (c *PaymentClient) CreateSubscription(ctx echo.Context, customer *ent.Payme
 	// Save subscription to database
 	subscriptionBuilder := c.orm.Subscription.Create().
 		SetProviderSubscriptionID(providerSubscription.ID).
-		SetProvider("stripe").
+		SetProvider(c.config.Payment.Provider).
 		SetStatus(subscription.Status(providerSubscription.Status)).
 		SetPriceID(providerSubscription.PriceID).
 		SetAmount(providerSubscription.Amount).
@@ -292,7 +413,18 @@ func (c *PaymentClient) CreateSubscription(ctx echo.Context, customer *ent.Payme
 	}
 
 	subscription, err := subscriptionBuilder.Save(ctx.Request().Context())
-	return subscription, err
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep a record of the discount the provider applied
+	if providerSubscription.Discount != nil {
+		if err := c.recordDiscount(ctx.Request().Context(), subscription, providerSubscription.Discount); err != nil {
+			return nil, err
+		}
+	}
+
+	return subscription, nil
 }
 
 // RefundPayment creates a refund for a payment intent
@@ -321,20 +453,22 @@ func (c *PaymentClient) AttachPaymentMethodToCustomer(ctx echo.Context, customer
 	}
 
 	// Save payment method to database (only display data)
-	paymentMethod, err := c.orm.PaymentMethod.Create().
+	create := c.orm.PaymentMethod.Create().
 		SetProviderPaymentMethodID(providerPaymentMethod.ID).
-		SetProvider("stripe").
-		SetType(paymentmethod.Type(providerPaymentMethod.Type)).
+		SetProvider(c.config.Payment.Provider).
+		SetType(paymentMethodType(providerPaymentMethod.Type)).
 		SetLastFour(providerPaymentMethod.LastFour).
 		SetBrand(providerPaymentMethod.Brand).
-		SetExpMonth(providerPaymentMethod.ExpMonth).
-		SetExpYear(providerPaymentMethod.ExpYear).
 		SetIsDefault(setAsDefault).
 		SetMetadata(providerPaymentMethod.Metadata).
-		SetCustomer(customer).
-		Save(ctx.Request().Context())
+		SetCustomer(customer)
+
+	// Only cards expire
+	if providerPaymentMethod.ExpMonth > 0 {
+		create.SetExpMonth(providerPaymentMethod.ExpMonth).SetExpYear(providerPaymentMethod.ExpYear)
+	}
 
-	return paymentMethod, err
+	return create.Save(ctx.Request().Context())
 }
 
 // GetCustomerPaymentMethods retrieves all payment methods for a customer
@@ -376,15 +510,20 @@ func (c *PaymentClient) GetCustomerSubscriptions(ctx echo.Context, customer *ent
 		All(ctx.Request().Context())
 }
 
-// CancelSubscription cancels a subscription
-func (c *PaymentClient) CancelSubscription(ctx echo.Context, customer *ent.PaymentCustomer, subscriptionID string) error {
-	// Get the subscription from database
-	sub, err := c.orm.Subscription.Query().
+// GetCustomerSubscription retrieves a subscription of a customer by its provider ID
+func (c *PaymentClient) GetCustomerSubscription(ctx echo.Context, customer *ent.PaymentCustomer, subscriptionID string) (*ent.Subscription, error) {
+	return c.orm.Subscription.Query().
 		Where(
 			subscription.ProviderSubscriptionID(subscriptionID),
 			subscription.HasCustomerWith(paymentcustomer.ID(customer.ID)),
 		).
 		Only(ctx.Request().Context())
+}
+
+// CancelSubscription cancels a subscription
+func (c *PaymentClient) CancelSubscription(ctx echo.Context, customer *ent.PaymentCustomer, subscriptionID string) error {
+	// Get the subscription from database
+	sub, err := c.GetCustomerSubscription(ctx, customer, subscriptionID)
 	if err != nil {
 		return err
 	}
@@ -412,18 +551,30 @@ func (c *PaymentClient) GetCustomerPaymentIntents(ctx echo.Context, customer *en
 		All(ctx.Request().Context())
 }
 
-// ConfirmPaymentIntent confirms a payment intent with a payment method
-func (c *PaymentClient) ConfirmPaymentIntent(ctx echo.Context, paymentIntent *ent.PaymentIntent, paymentMethodID string) error {
+// ConfirmPaymentIntent confirms a payment intent with a payment method and returns it with its new status.
+// The form response the payment is for is completed once it succeeded.
+func (c *PaymentClient) ConfirmPaymentIntent(ctx echo.Context, paymentIntent *ent.PaymentIntent, paymentMethodID string) (*ent.PaymentIntent, error) {
 	// Confirm payment intent with provider
 	providerPaymentIntent, err := c.provider.ConfirmPaymentIntent(ctx.Request().Context(), paymentIntent.ProviderPaymentIntentID, paymentMethodID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	tx, err := c.orm.Tx(ctx.Request().Context())
+	if err != nil {
+		return nil, err
 	}
 
 	// Update payment intent status in database
-	_, err = c.orm.PaymentIntent.UpdateOne(paymentIntent).
+	paymentIntent, err = tx.PaymentIntent.UpdateOne(paymentIntent).
 		SetStatus(paymentintent.Status(providerPaymentIntent.Status)).
 		Save(ctx.Request().Context())
-	
-	return err
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := c.completeResponse(ctx.Request().Context(), tx, paymentIntent); err != nil {
+		return nil, rollback(tx, err)
+	}
+	return paymentIntent, tx.Commit()
 }
\ No newline at end of file
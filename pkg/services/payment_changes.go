@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/subscription"
+)
+
+// How a provider prorates a subscription when its price changes.
+const (
+	// ProrationAlwaysInvoice charges the prorated difference right away.
+	ProrationAlwaysInvoice = "always_invoice"
+
+	// ProrationNone changes the price without charging or crediting anything.
+	ProrationNone = "none"
+)
+
+// PreviewPriceChange previews the prorated amount due when a subscription changes to another price right away.
+func (c *PaymentClient) PreviewPriceChange(ctx echo.Context, sub *ent.Subscription, priceID string) (*ProrationPreviewResult, error) {
+	return c.provider.PreviewSubscriptionChange(ctx.Request().Context(), sub.ProviderSubscriptionID, priceID)
+}
+
+// ChangePrice changes a subscription to another price right away, charging the prorated difference as of the
+// proration date of a preview. A zero proration date prorates as of now. Any scheduled change is dropped.
+func (c *PaymentClient) ChangePrice(ctx echo.Context, sub *ent.Subscription, priceID string, prorationDate time.Time) (*ent.Subscription, error) {
+	params := &UpdateSubscriptionParams{
+		PriceID:           priceID,
+		ProrationBehavior: ProrationAlwaysInvoice,
+	}
+	if !prorationDate.IsZero() {
+		params.ProrationDate = &prorationDate
+	}
+	return c.changePrice(ctx.Request().Context(), sub, params)
+}
+
+// SchedulePriceChange schedules a subscription to change to another price at the end of its current period,
+// without charging anything until then.
+func (c *PaymentClient) SchedulePriceChange(ctx echo.Context, sub *ent.Subscription, priceID string) (*ent.Subscription, error) {
+	if sub.CancelAtPeriodEnd {
+		return nil, fmt.Errorf("subscription %s is set to cancel at the end of its period", sub.ProviderSubscriptionID)
+	}
+
+	return c.orm.Subscription.UpdateOne(sub).
+		SetPendingPriceID(priceID).
+		SetPendingChangeAt(sub.CurrentPeriodEnd).
+		Save(ctx.Request().Context())
+}
+
+// CancelScheduledChange drops the change scheduled for a subscription, if any.
+func (c *PaymentClient) CancelScheduledChange(ctx echo.Context, sub *ent.Subscription) (*ent.Subscription, error) {
+	return c.orm.Subscription.UpdateOne(sub).
+		ClearPendingPriceID().
+		ClearPendingChangeAt().
+		Save(ctx.Request().Context())
+}
+
+// CancelAtPeriodEnd cancels a subscription at the end of its current period. Any scheduled change is dropped.
+func (c *PaymentClient) CancelAtPeriodEnd(ctx echo.Context, sub *ent.Subscription) (*ent.Subscription, error) {
+	return c.setCancelAtPeriodEnd(ctx.Request().Context(), sub, true)
+}
+
+// Reactivate keeps a subscription which was set to cancel at the end of its period.
+func (c *PaymentClient) Reactivate(ctx echo.Context, sub *ent.Subscription) (*ent.Subscription, error) {
+	return c.setCancelAtPeriodEnd(ctx.Request().Context(), sub, false)
+}
+
+// ApplyScheduledChanges applies the price changes scheduled to take effect by the given time.
+// The number of subscriptions which were changed is returned along with any errors.
+func (c *PaymentClient) ApplyScheduledChanges(ctx context.Context, now time.Time) (int, error) {
+	subs, err := c.orm.Subscription.Query().
+		Where(
+			subscription.PendingPriceIDNEQ(""),
+			subscription.PendingChangeAtLTE(now),
+			subscription.StatusIn(subscription.StatusActive, subscription.StatusTrialing, subscription.StatusPastDue),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var changed int
+	var errs []error
+	for _, sub := range subs {
+		_, err := c.changePrice(ctx, sub, &UpdateSubscriptionParams{
+			PriceID:           sub.PendingPriceID,
+			ProrationBehavior: ProrationNone,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %s: %w", sub.ProviderSubscriptionID, err))
+			continue
+		}
+		changed++
+	}
+
+	return changed, errors.Join(errs...)
+}
+
+// changePrice changes a subscription to another price with the provider and syncs the result.
+func (c *PaymentClient) changePrice(ctx context.Context, sub *ent.Subscription, params *UpdateSubscriptionParams) (*ent.Subscription, error) {
+	s, err := c.provider.UpdateSubscription(ctx, sub.ProviderSubscriptionID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.syncChange(sub, s).
+		ClearPendingPriceID().
+		ClearPendingChangeAt().
+		Save(ctx)
+}
+
+// setCancelAtPeriodEnd sets whether a subscription cancels at the end of its period with the provider and syncs
+// the result.
+func (c *PaymentClient) setCancelAtPeriodEnd(ctx context.Context, sub *ent.Subscription, cancel bool) (*ent.Subscription, error) {
+	s, err := c.provider.UpdateSubscription(ctx, sub.ProviderSubscriptionID, &UpdateSubscriptionParams{
+		CancelAtPeriodEnd: &cancel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	update := c.syncChange(sub, s)
+	if cancel {
+		update.
+			ClearPendingPriceID().
+			ClearPendingChangeAt()
+	}
+	return update.Save(ctx)
+}
+
+// syncChange returns an update of a subscription's row to match the subscription returned by the provider.
+func (c *PaymentClient) syncChange(sub *ent.Subscription, s *SubscriptionResult) *ent.SubscriptionUpdateOne {
+	return trackDunning(c.orm.Subscription.UpdateOne(sub), sub, subscription.Status(s.Status)).
+		SetStatus(subscription.Status(s.Status)).
+		SetPriceID(s.PriceID).
+		SetAmount(s.Amount).
+		SetCurrency(s.Currency).
+		SetInterval(subscription.Interval(s.Interval)).
+		SetCurrentPeriodStart(s.CurrentPeriodStart).
+		SetCurrentPeriodEnd(s.CurrentPeriodEnd).
+		SetCancelAtPeriodEnd(s.CancelAtPeriodEnd)
+}
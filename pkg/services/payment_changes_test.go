@@ -0,0 +1,125 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCatalog returns a copy of the config with a yearly price for the pro plan and a higher business plan.
+func testCatalog() *config.Config {
+	cfg := *c.Config
+	cfg.Payment.Plans = nil
+	for _, p := range c.Config.Payment.Plans {
+		if p.ID == "pro" {
+			p.Prices = append(p.Prices[:1:1], config.PriceConfig{
+				ID:       "price_pro_yearly",
+				Amount:   29000,
+				Currency: "usd",
+				Interval: "year",
+			})
+		}
+		cfg.Payment.Plans = append(cfg.Payment.Plans, p)
+	}
+	cfg.Payment.Plans = append(cfg.Payment.Plans, config.PlanConfig{
+		ID:   "business",
+		Name: "Business",
+		Prices: []config.PriceConfig{{
+			ID:       "price_business_monthly",
+			Amount:   4900,
+			Currency: "usd",
+			Interval: "month",
+		}},
+	})
+	return &cfg
+}
+
+func TestEntitlementsClient_IsUpgrade(t *testing.T) {
+	e, err := NewEntitlementsClient(testCatalog(), c.ORM)
+	require.NoError(t, err)
+
+	assert.True(t, e.IsUpgrade("price_your_stripe_price_id_here", "price_business_monthly"))
+	assert.True(t, e.IsUpgrade("price_your_stripe_price_id_here", "price_pro_yearly"))
+	assert.False(t, e.IsUpgrade("price_business_monthly", "price_pro_yearly"))
+	assert.False(t, e.IsUpgrade("price_pro_yearly", "price_your_stripe_price_id_here"))
+	assert.False(t, e.IsUpgrade("price_your_stripe_price_id_here", "price_missing"))
+}
+
+func TestPaymentClient_SubscriptionChanges(t *testing.T) {
+	cfg := testCatalog()
+	fake := NewFakeProvider(cfg)
+	client := NewPaymentClient(cfg, c.ORM, fake)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := client.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	_, err = client.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_visa", true)
+	require.NoError(t, err)
+	sub, err := client.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &CreateSubscriptionParams{})
+	require.NoError(t, err)
+
+	// Upgrades are charged the prorated difference right away.
+	preview, err := client.PreviewPriceChange(ctx, sub, "price_business_monthly")
+	require.NoError(t, err)
+	assert.InDelta(t, 2000, preview.AmountDue, 5)
+	assert.Equal(t, "usd", preview.Currency)
+
+	sub, err = client.ChangePrice(ctx, sub, "price_business_monthly", preview.ProrationDate)
+	require.NoError(t, err)
+	assert.Equal(t, "price_business_monthly", sub.PriceID)
+	assert.Equal(t, int64(4900), sub.Amount)
+
+	// Downgrades wait for the end of the period.
+	sub, err = client.SchedulePriceChange(ctx, sub, "price_your_stripe_price_id_here")
+	require.NoError(t, err)
+	assert.Equal(t, "price_business_monthly", sub.PriceID)
+	assert.Equal(t, "price_your_stripe_price_id_here", sub.PendingPriceID)
+	assert.WithinDuration(t, sub.CurrentPeriodEnd, sub.PendingChangeAt, time.Second)
+
+	changed, err := client.ApplyScheduledChanges(t.Context(), time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, changed)
+
+	changed, err = client.ApplyScheduledChanges(t.Context(), sub.CurrentPeriodEnd)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	sub, err = c.ORM.Subscription.Get(t.Context(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "price_your_stripe_price_id_here", sub.PriceID)
+	assert.Empty(t, sub.PendingPriceID)
+	assert.True(t, sub.PendingChangeAt.IsZero())
+
+	// Cancelling at the end of the period drops scheduled changes and can be undone.
+	sub, err = client.SchedulePriceChange(ctx, sub, "price_pro_yearly")
+	require.NoError(t, err)
+	sub, err = client.CancelAtPeriodEnd(ctx, sub)
+	require.NoError(t, err)
+	assert.True(t, sub.CancelAtPeriodEnd)
+	assert.Empty(t, sub.PendingPriceID)
+	_, err = client.SchedulePriceChange(ctx, sub, "price_pro_yearly")
+	assert.Error(t, err)
+
+	sub, err = client.Reactivate(ctx, sub)
+	require.NoError(t, err)
+	assert.False(t, sub.CancelAtPeriodEnd)
+	assert.Equal(t, subscription.StatusActive, sub.Status)
+
+	// The subscription ends instead of renewing once the period is over.
+	_, err = client.CancelAtPeriodEnd(ctx, sub)
+	require.NoError(t, err)
+	_, err = fake.Renew(sub.ProviderSubscriptionID)
+	require.NoError(t, err)
+	for _, event := range fake.Events() {
+		_, err = client.ProcessWebhookEvent(t.Context(), event)
+		require.NoError(t, err)
+	}
+	sub, err = c.ORM.Subscription.Get(t.Context(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusCanceled, sub.Status)
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/user"
+)
+
+// DunningStage is a step taken to chase a subscription whose payments fail.
+type DunningStage string
+
+const (
+	// DunningReminder asks the user to update their payment method before the grace period ends.
+	DunningReminder DunningStage = "reminder"
+
+	// DunningDowngrade tells the user their subscription was canceled because the grace period ended.
+	DunningDowngrade DunningStage = "downgrade"
+)
+
+// dunningStatuses are the statuses of subscriptions whose payments fail.
+var dunningStatuses = []subscription.Status{subscription.StatusPastDue, subscription.StatusUnpaid}
+
+// DunningNotifier is called for every step taken by ProcessDunning, within the transaction recording the step.
+type DunningNotifier func(ctx context.Context, tx *ent.Tx, sub *ent.Subscription, stage DunningStage) error
+
+// GracePeriodEnd returns when a subscription whose payments fail is canceled.
+func (c *PaymentClient) GracePeriodEnd(sub *ent.Subscription) time.Time {
+	return sub.PastDueAt.Add(c.config.Payment.Dunning.GracePeriod)
+}
+
+// GetPastDueSubscription returns the subscription of a user whose payments fail, or nil if there isn't one.
+func (c *PaymentClient) GetPastDueSubscription(ctx context.Context, u *ent.User) (*ent.Subscription, error) {
+	sub, err := c.orm.Subscription.Query().
+		Where(
+			subscription.HasCustomerWith(paymentcustomer.HasUserWith(user.ID(u.ID))),
+			subscription.StatusIn(dunningStatuses...),
+			subscription.PastDueAtNotNil(),
+		).
+		Order(ent.Asc(subscription.FieldPastDueAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return sub, err
+}
+
+// ProcessDunning takes the dunning steps which are due at the given time and returns how many were taken.
+// Reminders which became due since the last run are sent as one, and subscriptions whose grace period ended
+// are canceled with the provider. Subscriptions which fail are retried on the next run.
+func (c *PaymentClient) ProcessDunning(ctx context.Context, now time.Time, notify DunningNotifier) (int, error) {
+	subs, err := c.orm.Subscription.Query().
+		Where(
+			subscription.StatusIn(dunningStatuses...),
+			subscription.PastDueAtLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var taken int
+	var errs []error
+	for _, sub := range subs {
+		sent := true
+		var err error
+		if !now.Before(c.GracePeriodEnd(sub)) {
+			err = c.downgrade(ctx, sub, notify)
+		} else if due := c.dueReminders(sub, now); due > sub.DunningReminders {
+			sent, err = c.remind(ctx, sub, due, notify)
+		} else {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %s: %w", sub.ProviderSubscriptionID, err))
+			continue
+		}
+		if sent {
+			taken++
+		}
+	}
+
+	return taken, errors.Join(errs...)
+}
+
+// dueReminders returns how many reminders are due for a subscription at the given time.
+func (c *PaymentClient) dueReminders(sub *ent.Subscription, now time.Time) int {
+	var due int
+	for _, after := range c.config.Payment.Dunning.Reminders {
+		if sub.PastDueAt.Add(after).After(now) {
+			break
+		}
+		due++
+	}
+	return due
+}
+
+// remind records that the due reminders were sent and notifies the user. Nothing is sent if another run
+// recorded them first.
+func (c *PaymentClient) remind(ctx context.Context, sub *ent.Subscription, due int, notify DunningNotifier) (bool, error) {
+	tx, err := c.orm.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := tx.Subscription.Update().
+		Where(
+			subscription.ID(sub.ID),
+			subscription.DunningReminders(sub.DunningReminders),
+		).
+		SetDunningReminders(due).
+		Save(ctx)
+	if err != nil {
+		return false, rollback(tx, err)
+	}
+	if updated == 0 {
+		return false, tx.Rollback()
+	}
+
+	if err := notify(ctx, tx, sub, DunningReminder); err != nil {
+		return false, rollback(tx, err)
+	}
+	return true, tx.Commit()
+}
+
+// downgrade cancels a subscription whose grace period ended with the provider, which moves the user to the
+// free plan, and notifies the user. Subscriptions which a previous run canceled with the provider but failed
+// to record are only synced, since the provider refuses to cancel them again.
+func (c *PaymentClient) downgrade(ctx context.Context, sub *ent.Subscription, notify DunningNotifier) error {
+	s, err := c.provider.GetSubscription(ctx, sub.ProviderSubscriptionID)
+	if err != nil {
+		return err
+	}
+	if s.Status != string(subscription.StatusCanceled) {
+		s, err = c.provider.CancelSubscription(ctx, sub.ProviderSubscriptionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := c.orm.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := c.syncSubscription(ctx, tx.Client(), s, time.Time{}); err != nil {
+		return rollback(tx, err)
+	}
+	if err := notify(ctx, tx, sub, DunningDowngrade); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// trackDunning starts the dunning of a subscription when its payments start failing, and ends it once they
+// succeed again.
+func trackDunning(update *ent.SubscriptionUpdateOne, sub *ent.Subscription, status subscription.Status) *ent.SubscriptionUpdateOne {
+	switch status {
+	case subscription.StatusPastDue, subscription.StatusUnpaid:
+		if sub.PastDueAt.IsZero() {
+			update.SetPastDueAt(time.Now())
+		}
+	case subscription.StatusActive, subscription.StatusTrialing:
+		update.
+			ClearPastDueAt().
+			SetDunningReminders(0)
+	}
+	return update
+}
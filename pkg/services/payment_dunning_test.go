@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentClient_Dunning(t *testing.T) {
+	fake := NewFakeProvider(c.Config)
+	client := NewPaymentClient(c.Config, c.ORM, fake)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := client.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	visa, err := client.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_visa", true)
+	require.NoError(t, err)
+	declined, err := client.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_chargeDeclined", false)
+	require.NoError(t, err)
+	sub, err := client.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &CreateSubscriptionParams{})
+	require.NoError(t, err)
+
+	renew := func(paymentMethodID string) *ent.Subscription {
+		_, err := fake.UpdateSubscription(t.Context(), sub.ProviderSubscriptionID, &UpdateSubscriptionParams{
+			PaymentMethodID: paymentMethodID,
+		})
+		require.NoError(t, err)
+		_, err = fake.Renew(sub.ProviderSubscriptionID)
+		require.NoError(t, err)
+		for _, event := range fake.Events() {
+			_, err = client.ProcessWebhookEvent(t.Context(), event)
+			require.NoError(t, err)
+		}
+		renewed, err := c.ORM.Subscription.Get(t.Context(), sub.ID)
+		require.NoError(t, err)
+		return renewed
+	}
+
+	var stages []DunningStage
+	notify := func(ctx context.Context, tx *ent.Tx, sub *ent.Subscription, stage DunningStage) error {
+		stages = append(stages, stage)
+		return nil
+	}
+
+	// Dunning starts with the first failed renewal.
+	sub = renew(visa.ProviderPaymentMethodID)
+	assert.True(t, sub.PastDueAt.IsZero())
+	sub = renew(declined.ProviderPaymentMethodID)
+	assert.Equal(t, subscription.StatusPastDue, sub.Status)
+	assert.WithinDuration(t, time.Now(), sub.PastDueAt, time.Minute)
+
+	past, err := client.GetPastDueSubscription(t.Context(), u)
+	require.NoError(t, err)
+	require.NotNil(t, past)
+	assert.Equal(t, sub.ID, past.ID)
+
+	taken, err := client.ProcessDunning(t.Context(), sub.PastDueAt, notify)
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+	taken, err = client.ProcessDunning(t.Context(), sub.PastDueAt, notify)
+	require.NoError(t, err)
+	assert.Zero(t, taken)
+
+	// A successful payment ends it.
+	sub = renew(visa.ProviderPaymentMethodID)
+	assert.True(t, sub.PastDueAt.IsZero())
+	assert.Zero(t, sub.DunningReminders)
+	past, err = client.GetPastDueSubscription(t.Context(), u)
+	require.NoError(t, err)
+	assert.Nil(t, past)
+
+	// Missed reminders are sent as one.
+	sub = renew(declined.ProviderPaymentMethodID)
+	reminders := c.Config.Payment.Dunning.Reminders
+	taken, err = client.ProcessDunning(t.Context(), sub.PastDueAt.Add(reminders[len(reminders)-1]), notify)
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+	sub, err = c.ORM.Subscription.Get(t.Context(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, len(reminders), sub.DunningReminders)
+
+	// Reminders which another run recorded first are neither sent nor counted.
+	stale := *sub
+	stale.DunningReminders = 0
+	sent, err := client.remind(t.Context(), &stale, len(reminders), notify)
+	require.NoError(t, err)
+	assert.False(t, sent)
+
+	// The subscription is canceled once the grace period ends.
+	taken, err = client.ProcessDunning(t.Context(), client.GracePeriodEnd(sub), notify)
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+	sub, err = c.ORM.Subscription.Get(t.Context(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusCanceled, sub.Status)
+
+	assert.Equal(t, []DunningStage{DunningReminder, DunningReminder, DunningDowngrade}, stages)
+
+	// Subscriptions canceled with the provider by a run which failed to record it are downgraded by the next.
+	err = sub.Update().SetStatus(subscription.StatusPastDue).Exec(t.Context())
+	require.NoError(t, err)
+	taken, err = client.ProcessDunning(t.Context(), client.GracePeriodEnd(sub), notify)
+	require.NoError(t, err)
+	assert.Equal(t, 1, taken)
+	sub, err = c.ORM.Subscription.Get(t.Context(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusCanceled, sub.Status)
+}
@@ -0,0 +1,1176 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/occult/pagode/config"
+)
+
+// Outcomes of charges made with the fake provider.
+const (
+	FakeOutcomeSucceeded      = "succeeded"
+	FakeOutcomeDeclined       = "declined"
+	FakeOutcomeRequiresAction = "requires_action"
+)
+
+// ErrFakePaymentDeclined is returned by the fake provider when a charge is declined.
+var ErrFakePaymentDeclined = errors.New("your card was declined")
+
+// fakeCard is a test card of the fake provider.
+type fakeCard struct {
+	// kind is the type of the payment method, which defaults to a card.
+	kind     string
+	brand    string
+	lastFour string
+	outcome  string
+}
+
+// fakeCards are payment method IDs which always have the same outcome, named after Stripe's test payment methods.
+// Like those, attaching one to a customer attaches a new payment method cloned from it, and the customer's clone
+// can be referred to by the test card's ID. Any other ID starting with "pm_" is a Visa card charged with the
+// configured outcome. Boletos and Pix require action since customers pay them outside of the checkout, which
+// Authenticate stands for.
+var fakeCards = map[string]fakeCard{
+	"pm_card_visa":                   {brand: "visa", lastFour: "4242", outcome: FakeOutcomeSucceeded},
+	"pm_card_mastercard":             {brand: "mastercard", lastFour: "4444", outcome: FakeOutcomeSucceeded},
+	"pm_card_chargeDeclined":         {brand: "visa", lastFour: "0002", outcome: FakeOutcomeDeclined},
+	"pm_card_authenticationRequired": {brand: "visa", lastFour: "3184", outcome: FakeOutcomeRequiresAction},
+	"pm_sepa_debit":                  {kind: "sepa_debit", brand: "37040044", lastFour: "3000", outcome: FakeOutcomeSucceeded},
+	"pm_boleto":                      {kind: "boleto", lastFour: "0001", outcome: FakeOutcomeRequiresAction},
+	"pm_pix":                         {kind: "pix", outcome: FakeOutcomeRequiresAction},
+}
+
+// FakeProvider is an in-memory implementation of PaymentProvider for local development and tests.
+// Charges succeed, are declined or require authentication depending on the payment method, subscriptions are
+// priced from the plan catalog and only renew when Renew is called, and subscription charges are recorded as
+// invoices, discounted by the configured promotion codes they were created with. Connected accounts can accept
+// charges once their onboarding link was created. Every change is recorded as an event which can be retrieved with Events, signed with SignWebhook and
+// delivered to the webhook endpoint.
+type FakeProvider struct {
+	config *config.Config
+
+	// instance makes IDs unique across providers, since their objects end up in the same database.
+	instance string
+
+	mu             sync.Mutex
+	seq            int
+	customers      map[string]*CustomerResult
+	paymentIntents map[string]*fakePaymentIntent
+	subscriptions  map[string]*SubscriptionResult
+	paymentMethods map[string]*PaymentMethodResult
+	refunds        map[string]*RefundResult
+	accounts       map[string]*ConnectedAccountResult
+	promotionCodes map[string]*PromotionCodeResult
+	invoices       []*InvoiceResult
+	events         []*PaymentEvent
+
+	// defaults maps customers and subscriptions to the ID of the payment method they are charged with.
+	defaults map[string]string
+
+	// clones maps payment methods cloned from a test card to the test card's ID.
+	clones map[string]string
+}
+
+// fakePaymentIntent is a payment intent along with how much of it was refunded.
+type fakePaymentIntent struct {
+	PaymentIntentResult
+	chargeID       string
+	amountRefunded int64
+}
+
+// NewFakeProvider creates a new FakeProvider.
+func NewFakeProvider(cfg *config.Config) *FakeProvider {
+	return &FakeProvider{
+		config:         cfg,
+		instance:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		customers:      make(map[string]*CustomerResult),
+		defaults:       make(map[string]string),
+		clones:         make(map[string]string),
+		paymentIntents: make(map[string]*fakePaymentIntent),
+		subscriptions:  make(map[string]*SubscriptionResult),
+		paymentMethods: make(map[string]*PaymentMethodResult),
+		refunds:        make(map[string]*RefundResult),
+		accounts:       make(map[string]*ConnectedAccountResult),
+		promotionCodes: make(map[string]*PromotionCodeResult),
+	}
+}
+
+// CreateCustomer creates a new customer.
+func (f *FakeProvider) CreateCustomer(ctx context.Context, params *CreateCustomerParams) (*CustomerResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cus := &CustomerResult{
+		ID:       f.id("cus"),
+		Email:    params.Email,
+		Name:     params.Name,
+		Metadata: mergeMetadata(nil, params.Metadata),
+		Created:  time.Now(),
+	}
+	f.customers[cus.ID] = cus
+
+	c := *cus
+	return &c, nil
+}
+
+// GetCustomer retrieves a customer.
+func (f *FakeProvider) GetCustomer(ctx context.Context, customerID string) (*CustomerResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cus, err := f.customer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	c := *cus
+	return &c, nil
+}
+
+// UpdateCustomer updates a customer. Metadata is merged into the existing metadata.
+func (f *FakeProvider) UpdateCustomer(ctx context.Context, customerID string, params *UpdateCustomerParams) (*CustomerResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cus, err := f.customer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Email != "" {
+		cus.Email = params.Email
+	}
+	if params.Name != "" {
+		cus.Name = params.Name
+	}
+	cus.Metadata = mergeMetadata(cus.Metadata, params.Metadata)
+
+	c := *cus
+	return &c, nil
+}
+
+// CreatePaymentIntent creates a payment intent which waits for a payment method. Payment intents for a
+// connected account require the account to accept charges.
+func (f *FakeProvider) CreatePaymentIntent(ctx context.Context, params *CreatePaymentIntentParams) (*PaymentIntentResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if params.CustomerID != "" {
+		if _, err := f.customer(params.CustomerID); err != nil {
+			return nil, err
+		}
+	}
+	if params.ConnectedAccountID != "" {
+		acct, err := f.account(params.ConnectedAccountID)
+		if err != nil {
+			return nil, err
+		}
+		if !acct.ChargesEnabled {
+			return nil, fmt.Errorf("account %s cannot accept charges", acct.ID)
+		}
+	}
+	if params.Amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	id := f.id("pi")
+	pi := &fakePaymentIntent{
+		PaymentIntentResult: PaymentIntentResult{
+			ID:                 id,
+			Status:             "requires_payment_method",
+			Amount:             params.Amount,
+			Currency:           strings.ToLower(params.Currency),
+			CustomerID:         params.CustomerID,
+			ConnectedAccountID: params.ConnectedAccountID,
+			Description:        params.Description,
+			ClientSecret:       id + "_secret_fake",
+			Metadata:           mergeMetadata(nil, params.Metadata),
+			Created:            time.Now(),
+		},
+	}
+	f.paymentIntents[id] = pi
+	f.emit("payment_intent.created", pi)
+
+	return pi.result(), nil
+}
+
+// ConfirmPaymentIntent charges a payment intent with a payment method. ErrFakePaymentDeclined is returned if
+// the charge is declined, and the payment intent requires action if the card must be authenticated first.
+func (f *FakeProvider) ConfirmPaymentIntent(ctx context.Context, paymentIntentID string, paymentMethodID string) (*PaymentIntentResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pi, err := f.paymentIntent(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+	if pi.Status != "requires_payment_method" && pi.Status != "requires_confirmation" {
+		return nil, fmt.Errorf("payment intent %s cannot be confirmed while its status is %s", pi.ID, pi.Status)
+	}
+
+	pm, err := f.paymentMethod(paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch f.outcome(pm.ID) {
+	case FakeOutcomeDeclined:
+		pi.Status = "requires_payment_method"
+		f.emit("payment_intent.payment_failed", pi)
+		return nil, ErrFakePaymentDeclined
+	case FakeOutcomeRequiresAction:
+		pi.Status = "requires_action"
+		f.emit("payment_intent.requires_action", pi)
+	default:
+		f.succeed(pi)
+	}
+
+	return pi.result(), nil
+}
+
+// Authenticate completes or fails the authentication, such as 3D Secure, of a payment intent which requires
+// action, as if the customer did so in their browser.
+func (f *FakeProvider) Authenticate(paymentIntentID string, approve bool) (*PaymentIntentResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pi, err := f.paymentIntent(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+	if pi.Status != "requires_action" {
+		return nil, fmt.Errorf("payment intent %s does not require action", pi.ID)
+	}
+
+	if approve {
+		f.succeed(pi)
+	} else {
+		pi.Status = "requires_payment_method"
+		f.emit("payment_intent.payment_failed", pi)
+	}
+
+	return pi.result(), nil
+}
+
+// GetPaymentIntent retrieves a payment intent.
+func (f *FakeProvider) GetPaymentIntent(ctx context.Context, paymentIntentID string) (*PaymentIntentResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pi, err := f.paymentIntent(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+	return pi.result(), nil
+}
+
+// CancelPaymentIntent cancels a payment intent which has not succeeded.
+func (f *FakeProvider) CancelPaymentIntent(ctx context.Context, paymentIntentID string) (*PaymentIntentResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pi, err := f.paymentIntent(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+	if pi.Status == "succeeded" || pi.Status == "canceled" {
+		return nil, fmt.Errorf("payment intent %s cannot be canceled while its status is %s", pi.ID, pi.Status)
+	}
+
+	pi.Status = "canceled"
+	f.emit("payment_intent.canceled", pi)
+	return pi.result(), nil
+}
+
+// CreateSubscription subscribes a customer to a price of the plan catalog. The first period is charged right
+// away unless there is a trial; the subscription is incomplete if the charge does not succeed.
+func (f *FakeProvider) CreateSubscription(ctx context.Context, params *CreateSubscriptionParams) (*SubscriptionResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.customer(params.CustomerID); err != nil {
+		return nil, err
+	}
+
+	price, err := f.price(params.PriceID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sub := &SubscriptionResult{
+		ID:                 f.id("sub"),
+		CustomerID:         params.CustomerID,
+		PriceID:            price.ID,
+		Amount:             price.Amount,
+		Currency:           price.Currency,
+		Interval:           price.Interval,
+		IntervalCount:      1,
+		CurrentPeriodStart: now,
+		Metadata:           mergeMetadata(nil, params.Metadata),
+		Created:            now,
+	}
+
+	paymentMethodID := params.PaymentMethodID
+	if paymentMethodID == "" {
+		paymentMethodID = f.defaults[params.CustomerID]
+	}
+	if paymentMethodID != "" {
+		pm, err := f.attached(params.CustomerID, paymentMethodID)
+		if err != nil {
+			return nil, err
+		}
+		paymentMethodID = pm.ID
+		f.defaults[sub.ID] = pm.ID
+	}
+
+	if params.PromotionCodeID != "" {
+		promo, err := f.promotionCode(params.PromotionCodeID)
+		if err != nil {
+			return nil, err
+		}
+		sub.Discount = &DiscountResult{
+			ID:              f.id("di"),
+			PromotionCodeID: promo.ID,
+			Code:            promo.Code,
+			Coupon:          promo.Coupon,
+			Start:           now,
+		}
+		if promo.Coupon.Duration == "repeating" {
+			end := now.AddDate(0, promo.Coupon.DurationInMonths, 0)
+			sub.Discount.End = &end
+		}
+		promo.TimesRedeemed++
+	}
+
+	var inv *InvoiceResult
+	if params.TrialPeriodDays > 0 {
+		trialEnd := now.AddDate(0, 0, params.TrialPeriodDays)
+		sub.Status = "trialing"
+		sub.TrialStart = &now
+		sub.TrialEnd = &trialEnd
+		sub.CurrentPeriodEnd = trialEnd
+	} else {
+		sub.CurrentPeriodEnd = addInterval(now, sub.Interval, sub.IntervalCount)
+		inv = f.invoice(sub, f.discounted(sub, sub.Amount, now), sub.Currency, sub.CurrentPeriodStart, sub.CurrentPeriodEnd, f.charge(paymentMethodID))
+		if inv.Status == "paid" {
+			sub.Status = "active"
+		} else {
+			sub.Status = "incomplete"
+		}
+	}
+
+	f.subscriptions[sub.ID] = sub
+	f.emit("customer.subscription.created", sub)
+	if inv != nil {
+		f.emitInvoice(inv)
+	}
+
+	s := *sub
+	return &s, nil
+}
+
+// Renew simulates the end of a subscription's current period. The next period is charged to the subscription's
+// payment method and the subscription becomes past due if the charge does not succeed. Subscriptions set to cancel
+// at the end of the period are canceled instead.
+func (f *FakeProvider) Renew(subscriptionID string) (*SubscriptionResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sub, err := f.subscription(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sub.Status {
+	case "active", "trialing", "past_due", "unpaid":
+	default:
+		return nil, fmt.Errorf("subscription %s cannot renew while its status is %s", sub.ID, sub.Status)
+	}
+
+	if sub.CancelAtPeriodEnd {
+		endedAt := sub.CurrentPeriodEnd
+		sub.Status = "canceled"
+		sub.CanceledAt = &endedAt
+		sub.EndedAt = &endedAt
+		f.emit("customer.subscription.deleted", sub)
+
+		s := *sub
+		return &s, nil
+	}
+
+	sub.CurrentPeriodStart = sub.CurrentPeriodEnd
+	sub.CurrentPeriodEnd = addInterval(sub.CurrentPeriodStart, sub.Interval, sub.IntervalCount)
+
+	paymentMethodID := f.defaults[sub.ID]
+	if paymentMethodID == "" {
+		paymentMethodID = f.defaults[sub.CustomerID]
+	}
+	amount := f.discounted(sub, sub.Amount, sub.CurrentPeriodStart)
+	inv := f.invoice(sub, amount, sub.Currency, sub.CurrentPeriodStart, sub.CurrentPeriodEnd, f.charge(paymentMethodID))
+	if inv.Status == "paid" {
+		sub.Status = "active"
+	} else {
+		sub.Status = "past_due"
+	}
+
+	f.emit("customer.subscription.updated", sub)
+	f.emitInvoice(inv)
+
+	s := *sub
+	return &s, nil
+}
+
+// GetSubscription retrieves a subscription.
+func (f *FakeProvider) GetSubscription(ctx context.Context, subscriptionID string) (*SubscriptionResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sub, err := f.subscription(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	s := *sub
+	return &s, nil
+}
+
+// UpdateSubscription changes the price or payment method of a subscription. Metadata is merged into the
+// existing metadata. When the price changes with ProrationAlwaysInvoice the prorated amount is charged right away
+// and the subscription is left unchanged if the charge does not succeed.
+func (f *FakeProvider) UpdateSubscription(ctx context.Context, subscriptionID string, params *UpdateSubscriptionParams) (*SubscriptionResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sub, err := f.subscription(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.PaymentMethodID != "" {
+		pm, err := f.attached(sub.CustomerID, params.PaymentMethodID)
+		if err != nil {
+			return nil, err
+		}
+		f.defaults[sub.ID] = pm.ID
+	}
+
+	var proration *InvoiceResult
+
+	if params.PriceID != "" && params.PriceID != sub.PriceID {
+		price, err := f.price(params.PriceID)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		if params.ProrationDate != nil {
+			now = *params.ProrationDate
+		}
+		amountDue, periodEnd := f.prorate(sub, price, now)
+		if params.ProrationBehavior == ProrationAlwaysInvoice && amountDue > 0 {
+			paymentMethodID := f.defaults[sub.ID]
+			if paymentMethodID == "" {
+				paymentMethodID = f.defaults[sub.CustomerID]
+			}
+			if !f.charge(paymentMethodID) {
+				return nil, ErrFakePaymentDeclined
+			}
+			proration = f.invoice(sub, amountDue, price.Currency, now, periodEnd, true)
+		}
+
+		if !periodEnd.Equal(sub.CurrentPeriodEnd) {
+			sub.CurrentPeriodStart = now
+			sub.CurrentPeriodEnd = periodEnd
+		}
+		sub.PriceID = price.ID
+		sub.Amount = price.Amount
+		sub.Currency = price.Currency
+		sub.Interval = price.Interval
+	}
+
+	if params.CancelAtPeriodEnd != nil {
+		sub.CancelAtPeriodEnd = *params.CancelAtPeriodEnd
+	}
+	sub.Metadata = mergeMetadata(sub.Metadata, params.Metadata)
+
+	f.emit("customer.subscription.updated", sub)
+	if proration != nil {
+		f.emitInvoice(proration)
+	}
+
+	s := *sub
+	return &s, nil
+}
+
+// PreviewSubscriptionChange previews the prorated amount due when a subscription changes to another price.
+func (f *FakeProvider) PreviewSubscriptionChange(ctx context.Context, subscriptionID, priceID string) (*ProrationPreviewResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sub, err := f.subscription(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	price, err := f.price(priceID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	amountDue, _ := f.prorate(sub, price, now)
+	return &ProrationPreviewResult{
+		AmountDue:     amountDue,
+		Currency:      price.Currency,
+		ProrationDate: now,
+	}, nil
+}
+
+// CancelSubscription cancels a subscription immediately.
+func (f *FakeProvider) CancelSubscription(ctx context.Context, subscriptionID string) (*SubscriptionResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sub, err := f.subscription(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if sub.Status == "canceled" {
+		return nil, fmt.Errorf("subscription %s is already canceled", sub.ID)
+	}
+
+	now := time.Now()
+	sub.Status = "canceled"
+	sub.CanceledAt = &now
+	sub.EndedAt = &now
+	f.emit("customer.subscription.deleted", sub)
+
+	s := *sub
+	return &s, nil
+}
+
+// GetPaymentMethod retrieves a payment method.
+func (f *FakeProvider) GetPaymentMethod(ctx context.Context, paymentMethodID string) (*PaymentMethodResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pm, err := f.paymentMethod(paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := *pm
+	return &p, nil
+}
+
+// AttachPaymentMethod attaches a payment method to a customer.
+func (f *FakeProvider) AttachPaymentMethod(ctx context.Context, paymentMethodID, customerID string) (*PaymentMethodResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.customer(customerID); err != nil {
+		return nil, err
+	}
+
+	pm, err := f.paymentMethod(paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := fakeCards[pm.ID]; ok {
+		clone := *pm
+		clone.ID = f.id("pm")
+		clone.Created = time.Now()
+		f.paymentMethods[clone.ID] = &clone
+		f.clones[clone.ID] = pm.ID
+		pm = &clone
+	}
+	if pm.CustomerID != "" && pm.CustomerID != customerID {
+		return nil, fmt.Errorf("payment method %s is attached to another customer", pm.ID)
+	}
+
+	pm.CustomerID = customerID
+	f.emit("payment_method.attached", pm)
+
+	p := *pm
+	return &p, nil
+}
+
+// DetachPaymentMethod detaches a payment method from its customer.
+func (f *FakeProvider) DetachPaymentMethod(ctx context.Context, paymentMethodID string) (*PaymentMethodResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pm, err := f.paymentMethod(paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	if pm.CustomerID == "" {
+		return nil, fmt.Errorf("payment method %s is not attached to a customer", pm.ID)
+	}
+
+	if f.defaults[pm.CustomerID] == pm.ID {
+		delete(f.defaults, pm.CustomerID)
+	}
+	pm.CustomerID = ""
+	f.emit("payment_method.detached", pm)
+
+	p := *pm
+	return &p, nil
+}
+
+// ListPaymentMethods lists the payment methods attached to a customer.
+func (f *FakeProvider) ListPaymentMethods(ctx context.Context, customerID string) ([]*PaymentMethodResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.customer(customerID); err != nil {
+		return nil, err
+	}
+
+	var list []*PaymentMethodResult
+	for _, pm := range f.paymentMethods {
+		if pm.CustomerID == customerID {
+			p := *pm
+			list = append(list, &p)
+		}
+	}
+	return list, nil
+}
+
+// SetDefaultPaymentMethod sets the payment method a customer is charged with by default.
+func (f *FakeProvider) SetDefaultPaymentMethod(ctx context.Context, customerID, paymentMethodID string) (*PaymentMethodResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pm, err := f.attached(customerID, paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	f.defaults[customerID] = pm.ID
+
+	p := *pm
+	return &p, nil
+}
+
+// GetPromotionCode looks up one of the configured promotion codes. Like Stripe's, codes are case-insensitive.
+func (f *FakeProvider) GetPromotionCode(ctx context.Context, code string) (*PromotionCodeResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, pc := range f.config.Payment.Fake.PromotionCodes {
+		if !strings.EqualFold(pc.Code, code) {
+			continue
+		}
+
+		key := strings.ToUpper(pc.Code)
+		promo, ok := f.promotionCodes[key]
+		if !ok {
+			promo = &PromotionCodeResult{
+				ID:     f.id("promo"),
+				Code:   pc.Code,
+				Active: true,
+				Coupon: CouponResult{
+					ID:               f.id("coupon"),
+					PercentOff:       pc.PercentOff,
+					AmountOff:        pc.AmountOff,
+					Currency:         strings.ToLower(pc.Currency),
+					Duration:         pc.Duration,
+					DurationInMonths: pc.DurationInMonths,
+					Valid:            true,
+				},
+			}
+			f.promotionCodes[key] = promo
+		}
+
+		p := *promo
+		return &p, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s does not exist", ErrInvalidPromotionCode, code)
+}
+
+// CreateRefund refunds a payment intent which succeeded, in full if no amount is given.
+func (f *FakeProvider) CreateRefund(ctx context.Context, params *CreateRefundParams) (*RefundResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pi, err := f.paymentIntent(params.PaymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+	if pi.Status != "succeeded" {
+		return nil, fmt.Errorf("payment intent %s cannot be refunded while its status is %s", pi.ID, pi.Status)
+	}
+
+	remaining := pi.Amount - pi.amountRefunded
+	amount := params.Amount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount <= 0 || amount > remaining {
+		return nil, fmt.Errorf("refund of %d exceeds the %d which can be refunded", amount, remaining)
+	}
+
+	refund := &RefundResult{
+		ID:              f.id("re"),
+		PaymentIntentID: pi.ID,
+		Amount:          amount,
+		Currency:        pi.Currency,
+		Status:          "succeeded",
+		Reason:          params.Reason,
+		Metadata:        mergeMetadata(nil, params.Metadata),
+		Created:         time.Now(),
+	}
+	f.refunds[refund.ID] = refund
+
+	pi.amountRefunded += amount
+	f.emit("charge.refunded", &ChargeResult{
+		ID:              pi.chargeID,
+		PaymentIntentID: pi.ID,
+		Amount:          pi.Amount,
+		AmountRefunded:  pi.amountRefunded,
+		Currency:        pi.Currency,
+		Refunded:        pi.amountRefunded == pi.Amount,
+	})
+
+	r := *refund
+	return &r, nil
+}
+
+// GetRefund retrieves a refund.
+func (f *FakeProvider) GetRefund(ctx context.Context, refundID string) (*RefundResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	refund, ok := f.refunds[refundID]
+	if !ok {
+		return nil, fmt.Errorf("no such refund: %s", refundID)
+	}
+
+	r := *refund
+	return &r, nil
+}
+
+// ListInvoices lists the invoices of a customer, newest first.
+func (f *FakeProvider) ListInvoices(ctx context.Context, customerID string) ([]*InvoiceResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.customer(customerID); err != nil {
+		return nil, err
+	}
+
+	var results []*InvoiceResult
+	for i := len(f.invoices) - 1; i >= 0; i-- {
+		if f.invoices[i].CustomerID == customerID {
+			inv := *f.invoices[i]
+			results = append(results, &inv)
+		}
+	}
+	return results, nil
+}
+
+// GetInvoice retrieves an invoice.
+func (f *FakeProvider) GetInvoice(ctx context.Context, invoiceID string) (*InvoiceResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, inv := range f.invoices {
+		if inv.ID == invoiceID {
+			i := *inv
+			return &i, nil
+		}
+	}
+	return nil, fmt.Errorf("no such invoice: %s", invoiceID)
+}
+
+// CreateConnectedAccount creates a connected account which cannot accept charges until it is onboarded.
+func (f *FakeProvider) CreateConnectedAccount(ctx context.Context, params *CreateConnectedAccountParams) (*ConnectedAccountResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	acct := &ConnectedAccountResult{
+		ID:       f.id("acct"),
+		Email:    params.Email,
+		Metadata: mergeMetadata(nil, params.Metadata),
+		Created:  time.Now(),
+	}
+	f.accounts[acct.ID] = acct
+
+	a := *acct
+	return &a, nil
+}
+
+// GetConnectedAccount retrieves a connected account.
+func (f *FakeProvider) GetConnectedAccount(ctx context.Context, accountID string) (*ConnectedAccountResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	acct, err := f.account(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	a := *acct
+	return &a, nil
+}
+
+// CreateAccountOnboardingLink onboards a connected account right away, as if its owner completed the hosted
+// onboarding, and returns the return URL.
+func (f *FakeProvider) CreateAccountOnboardingLink(ctx context.Context, accountID, refreshURL, returnURL string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	acct, err := f.account(accountID)
+	if err != nil {
+		return "", err
+	}
+
+	if !acct.ChargesEnabled {
+		acct.ChargesEnabled = true
+		acct.DetailsSubmitted = true
+		f.emit("account.updated", acct)
+	}
+
+	return returnURL, nil
+}
+
+// Events returns the events which happened since the last call, oldest first.
+func (f *FakeProvider) Events() []*PaymentEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	events := f.events
+	f.events = nil
+	return events
+}
+
+// SignWebhook encodes an event as a webhook payload and signs it with the configured webhook secret.
+func (f *FakeProvider) SignWebhook(event *PaymentEvent) ([]byte, string, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, f.sign(payload), nil
+}
+
+// ConstructWebhookEvent verifies that a webhook payload was signed by SignWebhook and decodes the event.
+func (f *FakeProvider) ConstructWebhookEvent(payload []byte, signature string) (*PaymentEvent, error) {
+	if !hmac.Equal([]byte(signature), []byte(f.sign(payload))) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	var event PaymentEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// sign returns the hex encoded HMAC-SHA256 of a payload keyed with the webhook secret.
+func (f *FakeProvider) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(f.config.Payment.Fake.WebhookSecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// id generates a new ID with the given prefix.
+func (f *FakeProvider) id(prefix string) string {
+	f.seq++
+	return fmt.Sprintf("%s_fake%s_%d", prefix, f.instance, f.seq)
+}
+
+// emit records an event for the given object.
+func (f *FakeProvider) emit(eventType string, object any) {
+	event := &PaymentEvent{
+		ID:      f.id("evt"),
+		Type:    eventType,
+		Created: time.Now(),
+	}
+
+	switch o := object.(type) {
+	case *SubscriptionResult:
+		s := *o
+		event.Subscription = &s
+	case *fakePaymentIntent:
+		event.PaymentIntent = o.result()
+	case *PaymentMethodResult:
+		p := *o
+		event.PaymentMethod = &p
+	case *ChargeResult:
+		event.Charge = o
+	case *InvoiceResult:
+		i := *o
+		event.Invoice = &i
+	case *ConnectedAccountResult:
+		a := *o
+		event.Account = &a
+	}
+
+	f.events = append(f.events, event)
+}
+
+// outcome returns the outcome of charging a payment method.
+func (f *FakeProvider) outcome(paymentMethodID string) string {
+	if card, ok := fakeCards[paymentMethodID]; ok {
+		return card.outcome
+	}
+	if card, ok := fakeCards[f.clones[paymentMethodID]]; ok {
+		return card.outcome
+	}
+	if f.config.Payment.Fake.Outcome == "" {
+		return FakeOutcomeSucceeded
+	}
+	return f.config.Payment.Fake.Outcome
+}
+
+// charge returns whether charging a payment method off-session succeeds. Charges which require authentication
+// fail since the customer isn't there to authenticate.
+func (f *FakeProvider) charge(paymentMethodID string) bool {
+	return paymentMethodID != "" && f.outcome(paymentMethodID) == FakeOutcomeSucceeded
+}
+
+// invoice records an invoice billing a subscription for a period, which is paid if the charge succeeded.
+// Its event is emitted separately with emitInvoice so it follows the subscription's own event.
+func (f *FakeProvider) invoice(sub *SubscriptionResult, amount int64, currency string, periodStart, periodEnd time.Time, paid bool) *InvoiceResult {
+	now := time.Now()
+	inv := &InvoiceResult{
+		ID:             f.id("in"),
+		CustomerID:     sub.CustomerID,
+		SubscriptionID: sub.ID,
+		Number:         fmt.Sprintf("FAKE-%04d", len(f.invoices)+1),
+		Status:         "open",
+		Currency:       currency,
+		Subtotal:       amount,
+		Total:          amount,
+		AmountDue:      amount,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		Created:        now,
+	}
+	if paid {
+		inv.Status = "paid"
+		inv.AmountPaid = amount
+		inv.PaidAt = &now
+	}
+
+	f.invoices = append(f.invoices, inv)
+	return inv
+}
+
+// emitInvoice records the event for an invoice which was paid or failed to be paid.
+func (f *FakeProvider) emitInvoice(inv *InvoiceResult) {
+	if inv.Status == "paid" {
+		f.emit("invoice.paid", inv)
+	} else {
+		f.emit("invoice.payment_failed", inv)
+	}
+}
+
+// discounted applies the discount of a subscription to an amount charged at the given time. Discounts which
+// apply once only apply to the first invoice of the subscription.
+func (f *FakeProvider) discounted(sub *SubscriptionResult, amount int64, at time.Time) int64 {
+	d := sub.Discount
+	if d == nil || at.Before(d.Start) || (d.End != nil && !at.Before(*d.End)) {
+		return amount
+	}
+	if d.Coupon.Duration == "once" {
+		for _, inv := range f.invoices {
+			if inv.SubscriptionID == sub.ID {
+				return amount
+			}
+		}
+	}
+
+	if d.Coupon.PercentOff > 0 {
+		amount -= int64(math.Round(float64(amount) * d.Coupon.PercentOff / 100))
+	}
+	return max(amount-d.Coupon.AmountOff, 0)
+}
+
+// succeed marks a payment intent as succeeded.
+func (f *FakeProvider) succeed(pi *fakePaymentIntent) {
+	pi.Status = "succeeded"
+	pi.chargeID = f.id("ch")
+	f.emit("payment_intent.succeeded", pi)
+}
+
+// customer loads a customer.
+func (f *FakeProvider) customer(id string) (*CustomerResult, error) {
+	cus, ok := f.customers[id]
+	if !ok {
+		return nil, fmt.Errorf("no such customer: %s", id)
+	}
+	return cus, nil
+}
+
+// account loads a connected account.
+func (f *FakeProvider) account(id string) (*ConnectedAccountResult, error) {
+	acct, ok := f.accounts[id]
+	if !ok {
+		return nil, fmt.Errorf("no such account: %s", id)
+	}
+	return acct, nil
+}
+
+// promotionCode loads a promotion code which was looked up before.
+func (f *FakeProvider) promotionCode(id string) (*PromotionCodeResult, error) {
+	for _, promo := range f.promotionCodes {
+		if promo.ID == id {
+			return promo, nil
+		}
+	}
+	return nil, fmt.Errorf("no such promotion code: %s", id)
+}
+
+// paymentIntent loads a payment intent.
+func (f *FakeProvider) paymentIntent(id string) (*fakePaymentIntent, error) {
+	pi, ok := f.paymentIntents[id]
+	if !ok {
+		return nil, fmt.Errorf("no such payment intent: %s", id)
+	}
+	return pi, nil
+}
+
+// subscription loads a subscription.
+func (f *FakeProvider) subscription(id string) (*SubscriptionResult, error) {
+	sub, ok := f.subscriptions[id]
+	if !ok {
+		return nil, fmt.Errorf("no such subscription: %s", id)
+	}
+	return sub, nil
+}
+
+// paymentMethod loads a payment method, creating it the first time a payment method ID is used, like payment
+// methods created in the browser before they reach the server.
+func (f *FakeProvider) paymentMethod(id string) (*PaymentMethodResult, error) {
+	if pm, ok := f.paymentMethods[id]; ok {
+		return pm, nil
+	}
+	if !strings.HasPrefix(id, "pm_") {
+		return nil, fmt.Errorf("no such payment method: %s", id)
+	}
+
+	card, ok := fakeCards[id]
+	if !ok {
+		card = fakeCard{brand: "visa", lastFour: "4242"}
+	}
+
+	pm := &PaymentMethodResult{
+		ID:       id,
+		Type:     card.kind,
+		LastFour: card.lastFour,
+		Brand:    card.brand,
+		Created:  time.Now(),
+	}
+	if pm.Type == "" {
+		pm.Type = "card"
+		pm.ExpMonth = 12
+		pm.ExpYear = time.Now().Year() + 5
+	}
+	f.paymentMethods[id] = pm
+	return pm, nil
+}
+
+// attached loads a payment method attached to a customer, which may be referred to by the ID of the test card
+// it was cloned from.
+func (f *FakeProvider) attached(customerID, paymentMethodID string) (*PaymentMethodResult, error) {
+	if _, ok := fakeCards[paymentMethodID]; ok {
+		for id, card := range f.clones {
+			if pm := f.paymentMethods[id]; card == paymentMethodID && pm.CustomerID == customerID {
+				return pm, nil
+			}
+		}
+	}
+
+	pm, ok := f.paymentMethods[paymentMethodID]
+	if !ok || pm.CustomerID != customerID {
+		return nil, fmt.Errorf("payment method %s is not attached to customer %s", paymentMethodID, customerID)
+	}
+	return pm, nil
+}
+
+// price looks up a price of the plan catalog.
+func (f *FakeProvider) price(id string) (config.PriceConfig, error) {
+	for _, plan := range f.config.Payment.Plans {
+		for _, price := range plan.Prices {
+			if price.ID == id {
+				return price, nil
+			}
+		}
+	}
+	return config.PriceConfig{}, fmt.Errorf("no such price: %s", id)
+}
+
+// prorate returns the amount due when a subscription changes to a price at the given time, along with the end of
+// the subscription's period after the change. The unused time of the current price is credited against the
+// remaining time of the new one. When the billing interval changes a new period starts right away and the new
+// price is due in full. Nothing is due while the subscription is trialing.
+func (f *FakeProvider) prorate(sub *SubscriptionResult, price config.PriceConfig, now time.Time) (int64, time.Time) {
+	if sub.Status == "trialing" {
+		return 0, sub.CurrentPeriodEnd
+	}
+
+	total := int64(sub.CurrentPeriodEnd.Sub(sub.CurrentPeriodStart) / time.Second)
+	remaining := int64(sub.CurrentPeriodEnd.Sub(now) / time.Second)
+	if total <= 0 || remaining < 0 {
+		remaining = 0
+	}
+
+	var credit int64
+	if total > 0 {
+		credit = sub.Amount * remaining / total
+	}
+	if price.Interval != sub.Interval || total <= 0 {
+		return max(0, price.Amount-credit), addInterval(now, price.Interval, 1)
+	}
+	return max(0, price.Amount*remaining/total-credit), sub.CurrentPeriodEnd
+}
+
+// result returns a copy of the payment intent.
+func (pi *fakePaymentIntent) result() *PaymentIntentResult {
+	r := pi.PaymentIntentResult
+	return &r
+}
+
+// addInterval adds billing intervals to a time.
+func addInterval(t time.Time, interval string, count int) time.Time {
+	switch interval {
+	case "day":
+		return t.AddDate(0, 0, count)
+	case "week":
+		return t.AddDate(0, 0, 7*count)
+	case "year":
+		return t.AddDate(count, 0, 0)
+	default:
+		return t.AddDate(0, count, 0)
+	}
+}
+
+// mergeMetadata returns a copy of the metadata with the updates applied.
+func mergeMetadata(metadata, updates map[string]interface{}) map[string]interface{} {
+	if metadata == nil && updates == nil {
+		return nil
+	}
+
+	merged := make(map[string]interface{}, len(metadata)+len(updates))
+	for k, v := range metadata {
+		merged[k] = v
+	}
+	for k, v := range updates {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeProvider_PaymentIntents(t *testing.T) {
+	fake := NewFakeProvider(c.Config)
+	ctx := context.Background()
+
+	cus, err := fake.CreateCustomer(ctx, &CreateCustomerParams{Email: "fake@localhost"})
+	require.NoError(t, err)
+
+	confirm := func(paymentMethodID string) (*PaymentIntentResult, error) {
+		pi, err := fake.CreatePaymentIntent(ctx, &CreatePaymentIntentParams{
+			Amount:     1500,
+			Currency:   "USD",
+			CustomerID: cus.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "requires_payment_method", pi.Status)
+		assert.Equal(t, "usd", pi.Currency)
+		return fake.ConfirmPaymentIntent(ctx, pi.ID, paymentMethodID)
+	}
+
+	pi, err := confirm("pm_card_visa")
+	require.NoError(t, err)
+	assert.Equal(t, "succeeded", pi.Status)
+
+	_, err = confirm("pm_card_chargeDeclined")
+	assert.ErrorIs(t, err, ErrFakePaymentDeclined)
+
+	// Authentication can be approved or failed by the customer.
+	pi, err = confirm("pm_card_authenticationRequired")
+	require.NoError(t, err)
+	assert.Equal(t, "requires_action", pi.Status)
+	pi, err = fake.Authenticate(pi.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, "succeeded", pi.Status)
+
+	pi, err = confirm("pm_card_authenticationRequired")
+	require.NoError(t, err)
+	pi, err = fake.Authenticate(pi.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, "requires_payment_method", pi.Status)
+
+	// Other payment methods are charged with the configured outcome.
+	cfg := *c.Config
+	cfg.Payment.Fake.Outcome = FakeOutcomeDeclined
+	fake.config = &cfg
+	_, err = confirm("pm_anything")
+	assert.ErrorIs(t, err, ErrFakePaymentDeclined)
+}
+
+func TestFakeProvider_Subscriptions(t *testing.T) {
+	fake := NewFakeProvider(c.Config)
+	ctx := context.Background()
+
+	cus, err := fake.CreateCustomer(ctx, &CreateCustomerParams{Email: "fake@localhost"})
+	require.NoError(t, err)
+
+	// Test cards are cloned when they are attached.
+	pm, err := fake.AttachPaymentMethod(ctx, "pm_card_visa", cus.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, "pm_card_visa", pm.ID)
+	assert.Equal(t, "4242", pm.LastFour)
+	_, err = fake.SetDefaultPaymentMethod(ctx, cus.ID, "pm_card_visa")
+	require.NoError(t, err)
+
+	_, err = fake.CreateSubscription(ctx, &CreateSubscriptionParams{CustomerID: cus.ID, PriceID: "price_missing"})
+	assert.Error(t, err)
+
+	sub, err := fake.CreateSubscription(ctx, &CreateSubscriptionParams{
+		CustomerID:      cus.ID,
+		PriceID:         "price_your_stripe_price_id_here",
+		TrialPeriodDays: 14,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "trialing", sub.Status)
+	assert.Equal(t, int64(2900), sub.Amount)
+	assert.Equal(t, sub.TrialEnd.Sub(*sub.TrialStart).Hours(), float64(14*24))
+
+	// Renewals charge the default payment method for the next period.
+	renewed, err := fake.Renew(sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "active", renewed.Status)
+	assert.Equal(t, sub.CurrentPeriodEnd, renewed.CurrentPeriodStart)
+	assert.Equal(t, sub.CurrentPeriodEnd.AddDate(0, 1, 0), renewed.CurrentPeriodEnd)
+
+	declined, err := fake.AttachPaymentMethod(ctx, "pm_card_chargeDeclined", cus.ID)
+	require.NoError(t, err)
+	_, err = fake.UpdateSubscription(ctx, sub.ID, &UpdateSubscriptionParams{PaymentMethodID: declined.ID})
+	require.NoError(t, err)
+	renewed, err = fake.Renew(sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "past_due", renewed.Status)
+
+	// Every charge is invoiced.
+	invoices, err := fake.ListInvoices(ctx, cus.ID)
+	require.NoError(t, err)
+	require.Len(t, invoices, 2)
+	assert.Equal(t, "open", invoices[0].Status)
+	assert.Equal(t, "paid", invoices[1].Status)
+	assert.Equal(t, int64(2900), invoices[1].AmountPaid)
+	assert.Equal(t, sub.ID, invoices[1].SubscriptionID)
+	assert.Equal(t, sub.CurrentPeriodEnd, invoices[1].PeriodStart)
+
+	canceled, err := fake.CancelSubscription(ctx, sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "canceled", canceled.Status)
+	assert.NotNil(t, canceled.EndedAt)
+	_, err = fake.Renew(sub.ID)
+	assert.Error(t, err)
+
+	var types []string
+	for _, event := range fake.Events() {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []string{
+		"payment_method.attached",
+		"customer.subscription.created",
+		"customer.subscription.updated",
+		"invoice.paid",
+		"payment_method.attached",
+		"customer.subscription.updated",
+		"customer.subscription.updated",
+		"invoice.payment_failed",
+		"customer.subscription.deleted",
+	}, types)
+	assert.Empty(t, fake.Events())
+}
+
+func TestFakeProvider_Refunds(t *testing.T) {
+	fake := NewFakeProvider(c.Config)
+	ctx := context.Background()
+
+	cus, err := fake.CreateCustomer(ctx, &CreateCustomerParams{Email: "fake@localhost"})
+	require.NoError(t, err)
+	pi, err := fake.CreatePaymentIntent(ctx, &CreatePaymentIntentParams{Amount: 1000, Currency: "usd", CustomerID: cus.ID})
+	require.NoError(t, err)
+
+	_, err = fake.CreateRefund(ctx, &CreateRefundParams{PaymentIntentID: pi.ID})
+	assert.Error(t, err, "payments which did not succeed cannot be refunded")
+
+	_, err = fake.ConfirmPaymentIntent(ctx, pi.ID, "pm_card_visa")
+	require.NoError(t, err)
+
+	refund, err := fake.CreateRefund(ctx, &CreateRefundParams{PaymentIntentID: pi.ID, Amount: 400})
+	require.NoError(t, err)
+	assert.Equal(t, int64(400), refund.Amount)
+
+	_, err = fake.CreateRefund(ctx, &CreateRefundParams{PaymentIntentID: pi.ID, Amount: 700})
+	assert.Error(t, err)
+
+	refund, err = fake.CreateRefund(ctx, &CreateRefundParams{PaymentIntentID: pi.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(600), refund.Amount)
+
+	got, err := fake.GetRefund(ctx, refund.ID)
+	require.NoError(t, err)
+	assert.Equal(t, refund, got)
+}
+
+func TestFakeProvider_Webhooks(t *testing.T) {
+	fake := NewFakeProvider(c.Config)
+	client := NewPaymentClient(c.Config, c.ORM, fake)
+	ctx := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	cus, err := fake.CreateCustomer(ctx, &CreateCustomerParams{Email: u.Email})
+	require.NoError(t, err)
+	customer, err := c.ORM.PaymentCustomer.Create().
+		SetProviderCustomerID(cus.ID).
+		SetEmail(cus.Email).
+		SetUser(u).
+		Save(ctx)
+	require.NoError(t, err)
+
+	pi, err := fake.CreatePaymentIntent(ctx, &CreatePaymentIntentParams{Amount: 2500, Currency: "usd", CustomerID: cus.ID})
+	require.NoError(t, err)
+	_, err = fake.ConfirmPaymentIntent(ctx, pi.ID, "pm_card_visa")
+	require.NoError(t, err)
+	_, err = fake.CreateRefund(ctx, &CreateRefundParams{PaymentIntentID: pi.ID, Amount: 1000})
+	require.NoError(t, err)
+	_, err = fake.AttachPaymentMethod(ctx, "pm_card_mastercard", cus.ID)
+	require.NoError(t, err)
+	_, err = fake.SetDefaultPaymentMethod(ctx, cus.ID, "pm_card_mastercard")
+	require.NoError(t, err)
+	sub, err := fake.CreateSubscription(ctx, &CreateSubscriptionParams{CustomerID: cus.ID, PriceID: "price_your_stripe_price_id_here"})
+	require.NoError(t, err)
+
+	// Every event is delivered through a signed webhook.
+	for _, event := range fake.Events() {
+		payload, signature, err := fake.SignWebhook(event)
+		require.NoError(t, err)
+		parsed, err := client.ParseWebhook(payload, signature)
+		require.NoError(t, err)
+		processed, err := client.ProcessWebhookEvent(ctx, parsed)
+		require.NoError(t, err)
+		assert.True(t, processed)
+	}
+
+	synced, err := customer.QueryPaymentIntents().
+		Where(paymentintent.ProviderPaymentIntentID(pi.ID)).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, paymentintent.StatusSucceeded, synced.Status)
+	assert.Equal(t, int64(1000), synced.AmountRefunded)
+
+	syncedSub, err := customer.QuerySubscriptions().
+		Where(subscription.ProviderSubscriptionID(sub.ID)).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusActive, syncedSub.Status)
+
+	count, err := customer.QueryPaymentMethods().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	payload, _, err := fake.SignWebhook(&PaymentEvent{ID: "evt_fake_invalid"})
+	require.NoError(t, err)
+	_, err = client.ParseWebhook(payload, "bogus")
+	assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+}
+
+func TestFakeProvider_ConnectedAccounts(t *testing.T) {
+	fake := NewFakeProvider(c.Config)
+	ctx := context.Background()
+
+	acct, err := fake.CreateConnectedAccount(ctx, &CreateConnectedAccountParams{Email: "owner@localhost"})
+	require.NoError(t, err)
+	assert.False(t, acct.ChargesEnabled)
+
+	params := &CreatePaymentIntentParams{
+		Amount:             1500,
+		Currency:           "usd",
+		ConnectedAccountID: acct.ID,
+	}
+	_, err = fake.CreatePaymentIntent(ctx, params)
+	assert.ErrorContains(t, err, "cannot accept charges")
+
+	link, err := fake.CreateAccountOnboardingLink(ctx, acct.ID, "/refresh", "/return")
+	require.NoError(t, err)
+	assert.Equal(t, "/return", link)
+
+	acct, err = fake.GetConnectedAccount(ctx, acct.ID)
+	require.NoError(t, err)
+	assert.True(t, acct.ChargesEnabled)
+
+	// Payments to connected accounts don't need a customer.
+	pi, err := fake.CreatePaymentIntent(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, acct.ID, pi.ConnectedAccountID)
+	assert.Empty(t, pi.CustomerID)
+
+	events := fake.Events()
+	require.NotEmpty(t, events)
+	assert.Equal(t, "account.updated", events[0].Type)
+	assert.Equal(t, acct.ID, events[0].Account.ID)
+}
+
+func TestFakeProvider_PaymentMethodTypes(t *testing.T) {
+	fake := NewFakeProvider(c.Config)
+	ctx := context.Background()
+
+	cus, err := fake.CreateCustomer(ctx, &CreateCustomerParams{Email: "fake@localhost"})
+	require.NoError(t, err)
+
+	sepa, err := fake.AttachPaymentMethod(ctx, "pm_sepa_debit", cus.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "sepa_debit", sepa.Type)
+	assert.Equal(t, "3000", sepa.LastFour)
+	assert.Zero(t, sepa.ExpMonth)
+
+	// Boletos are paid outside of the checkout, so their payments wait for the customer.
+	pi, err := fake.CreatePaymentIntent(ctx, &CreatePaymentIntentParams{
+		Amount:     5000,
+		Currency:   "brl",
+		CustomerID: cus.ID,
+	})
+	require.NoError(t, err)
+	pi, err = fake.ConfirmPaymentIntent(ctx, pi.ID, "pm_boleto")
+	require.NoError(t, err)
+	assert.Equal(t, "requires_action", pi.Status)
+	assert.Equal(t, "brl", pi.Currency)
+	pi, err = fake.Authenticate(pi.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, "succeeded", pi.Status)
+}
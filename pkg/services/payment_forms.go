@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentaccount"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/ent/user"
+)
+
+// GetPaymentAccount retrieves the connected account a user receives form payments with, returning nil if the
+// user hasn't set one up
+func (c *PaymentClient) GetPaymentAccount(ctx echo.Context, u *ent.User) (*ent.PaymentAccount, error) {
+	account, err := c.orm.PaymentAccount.Query().
+		Where(paymentaccount.HasUserWith(user.ID(u.ID))).
+		Only(ctx.Request().Context())
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return account, err
+}
+
+// OnboardPaymentAccount creates a connected account for a user if they don't have one yet and returns a link to
+// the provider's onboarding, which sends the user to the return URL when done or to the refresh URL if the link expired
+func (c *PaymentClient) OnboardPaymentAccount(ctx echo.Context, u *ent.User, refreshURL, returnURL string) (string, error) {
+	account, err := c.GetPaymentAccount(ctx, u)
+	if err != nil {
+		return "", err
+	}
+
+	if account == nil {
+		providerAccount, err := c.provider.CreateConnectedAccount(ctx.Request().Context(), &CreateConnectedAccountParams{
+			Email: u.Email,
+			Metadata: map[string]interface{}{
+				"user_id": strconv.Itoa(u.ID),
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		account, err = c.orm.PaymentAccount.Create().
+			SetProviderAccountID(providerAccount.ID).
+			SetProvider(c.config.Payment.Provider).
+			SetChargesEnabled(providerAccount.ChargesEnabled).
+			SetDetailsSubmitted(providerAccount.DetailsSubmitted).
+			SetUser(u).
+			Save(ctx.Request().Context())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return c.provider.CreateAccountOnboardingLink(ctx.Request().Context(), account.ProviderAccountID, refreshURL, returnURL)
+}
+
+// RefreshPaymentAccount updates a connected account from the provider, such as when its owner returns from onboarding
+func (c *PaymentClient) RefreshPaymentAccount(ctx echo.Context, account *ent.PaymentAccount) (*ent.PaymentAccount, error) {
+	providerAccount, err := c.provider.GetConnectedAccount(ctx.Request().Context(), account.ProviderAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.orm.PaymentAccount.UpdateOne(account).
+		SetChargesEnabled(providerAccount.ChargesEnabled).
+		SetDetailsSubmitted(providerAccount.DetailsSubmitted).
+		Save(ctx.Request().Context())
+}
+
+// CreateResponsePayment creates a payment intent for a form response which is paid to the form owner's connected
+// account. The respondent isn't a customer, so the payment intent only belongs to the response.
+func (c *PaymentClient) CreateResponsePayment(ctx context.Context, client *ent.Client, account *ent.PaymentAccount, resp *ent.Response, amount int64, currency, description string) (*ent.PaymentIntent, error) {
+	providerPaymentIntent, err := c.provider.CreatePaymentIntent(ctx, &CreatePaymentIntentParams{
+		Amount:             amount,
+		Currency:           currency,
+		ConnectedAccountID: account.ProviderAccountID,
+		Description:        description,
+		Metadata: map[string]interface{}{
+			"response_id": strconv.Itoa(resp.ID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.PaymentIntent.Create().
+		SetProviderPaymentIntentID(providerPaymentIntent.ID).
+		SetProvider(c.config.Payment.Provider).
+		SetStatus(paymentintent.Status(providerPaymentIntent.Status)).
+		SetAmount(providerPaymentIntent.Amount).
+		SetCurrency(providerPaymentIntent.Currency).
+		SetDescription(providerPaymentIntent.Description).
+		SetClientSecret(providerPaymentIntent.ClientSecret).
+		SetProviderAccountID(providerPaymentIntent.ConnectedAccountID).
+		SetMetadata(providerPaymentIntent.Metadata).
+		SetResponse(resp).
+		Save(ctx)
+}
+
+// syncPaymentAccount updates the connected account to match the provider.
+// Accounts which are unknown to this application are ignored.
+func (c *PaymentClient) syncPaymentAccount(ctx context.Context, client *ent.Client, a *ConnectedAccountResult) error {
+	return client.PaymentAccount.Update().
+		Where(paymentaccount.ProviderAccountID(a.ID)).
+		SetChargesEnabled(a.ChargesEnabled).
+		SetDetailsSubmitted(a.DetailsSubmitted).
+		Exec(ctx)
+}
+
+// CancelResponsePayment cancels the payment intent of a form response with the provider, such as when the
+// response failed to be saved after the payment intent was created for it.
+func (c *PaymentClient) CancelResponsePayment(ctx context.Context, pi *ent.PaymentIntent) error {
+	_, err := c.provider.CancelPaymentIntent(ctx, pi.ProviderPaymentIntentID)
+	return err
+}
+
+// completeResponse marks the form response a payment intent is for as completed once the payment succeeded.
+// Only then is the response counted against the form owner's usage and are they notified of it, as the
+// respondent may never pay. Responses which were already completed are left as they are.
+func (c *PaymentClient) completeResponse(ctx context.Context, tx *ent.Tx, pi *ent.PaymentIntent) error {
+	if pi.Status != paymentintent.StatusSucceeded {
+		return nil
+	}
+
+	completed, err := tx.Response.Update().
+		Where(
+			response.HasPaymentWith(paymentintent.ID(pi.ID)),
+			response.Completed(false),
+		).
+		SetCompleted(true).
+		Save(ctx)
+	if err != nil || completed == 0 {
+		return err
+	}
+
+	resp, err := tx.Response.Query().
+		Where(response.HasPaymentWith(paymentintent.ID(pi.ID))).
+		WithAnswers().
+		WithForm(func(q *ent.FormQuery) {
+			q.WithOwner()
+		}).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	f := resp.Edges.Form
+
+	if c.usage != nil {
+		var stored int64
+		for _, a := range resp.Edges.Answers {
+			stored += int64(len(a.Value))
+		}
+
+		// The payment was taken, so the response is recorded even if it goes over the limit.
+		for metric, amount := range map[Metric]int64{
+			MetricResponses: 1,
+			MetricStorage:   stored,
+		} {
+			status, crossed, err := c.usage.Record(ctx, tx.Client(), f.Edges.Owner, metric, amount)
+			if err != nil {
+				return err
+			}
+			if crossed == ThresholdNone || c.jobs == nil {
+				continue
+			}
+			err = c.jobs.EnqueueTx(ctx, tx, "notify_usage", map[string]interface{}{
+				"user_id":   f.Edges.Owner.ID,
+				"metric":    string(status.Metric),
+				"threshold": crossed.String(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if f.NotifyOnResponse && c.jobs != nil {
+		return c.jobs.EnqueueTx(ctx, tx, "notify_new_response", map[string]interface{}{
+			"response_id": resp.ID,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentClient_ResponsePayments(t *testing.T) {
+	fake := NewFakeProvider(c.Config)
+	client := NewPaymentClient(c.Config, c.ORM, fake)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	account, err := client.GetPaymentAccount(ctx, u)
+	require.NoError(t, err)
+	assert.Nil(t, account)
+
+	// Onboarding creates the connected account, which accepts charges once the owner finished.
+	link, err := client.OnboardPaymentAccount(ctx, u, "/billing", "/billing/payouts/return")
+	require.NoError(t, err)
+	assert.Equal(t, "/billing/payouts/return", link)
+
+	account, err = client.GetPaymentAccount(ctx, u)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	assert.False(t, account.ChargesEnabled)
+
+	for _, event := range fake.Events() {
+		_, err = client.ProcessWebhookEvent(t.Context(), event)
+		require.NoError(t, err)
+	}
+	account, err = client.GetPaymentAccount(ctx, u)
+	require.NoError(t, err)
+	assert.True(t, account.ChargesEnabled)
+	assert.True(t, account.DetailsSubmitted)
+
+	account, err = client.RefreshPaymentAccount(ctx, account)
+	require.NoError(t, err)
+	assert.True(t, account.ChargesEnabled)
+
+	f, err := c.ORM.Form.Create().
+		SetTitle("Workshop").
+		SetSlug(fmt.Sprintf("workshop-%d", time.Now().UnixNano())).
+		SetOwner(u).
+		Save(t.Context())
+	require.NoError(t, err)
+	resp, err := c.ORM.Response.Create().
+		SetForm(f).
+		SetCompleted(false).
+		Save(t.Context())
+	require.NoError(t, err)
+
+	pi, err := client.CreateResponsePayment(t.Context(), c.ORM, account, resp, 2500, "usd", "Workshop")
+	require.NoError(t, err)
+	assert.Equal(t, account.ProviderAccountID, pi.ProviderAccountID)
+	assert.Equal(t, "usd", pi.Currency)
+	exists, err := pi.QueryCustomer().Exist(t.Context())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// The response is only completed once the payment succeeds.
+	_, err = client.ConfirmPaymentIntent(ctx, pi, "pm_card_chargeDeclined")
+	assert.ErrorIs(t, err, ErrFakePaymentDeclined)
+	resp, err = c.ORM.Response.Get(t.Context(), resp.ID)
+	require.NoError(t, err)
+	assert.False(t, resp.Completed)
+
+	pi, err = client.ConfirmPaymentIntent(ctx, pi, "pm_card_authenticationRequired")
+	require.NoError(t, err)
+	assert.Equal(t, paymentintent.StatusRequiresAction, pi.Status)
+	resp, err = c.ORM.Response.Get(t.Context(), resp.ID)
+	require.NoError(t, err)
+	assert.False(t, resp.Completed)
+
+	// Payments which succeed after authentication complete the response through their webhook.
+	_, err = fake.Authenticate(pi.ProviderPaymentIntentID, true)
+	require.NoError(t, err)
+	for _, event := range fake.Events() {
+		_, err = client.ProcessWebhookEvent(t.Context(), event)
+		require.NoError(t, err)
+	}
+	resp, err = c.ORM.Response.Get(t.Context(), resp.ID)
+	require.NoError(t, err)
+	assert.True(t, resp.Completed)
+
+	payment, err := resp.QueryPayment().Only(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, paymentintent.StatusSucceeded, payment.Status)
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/invoice"
+	"github.com/occult/pagode/ent/paymentcustomer"
+)
+
+// GetCustomerInvoices retrieves the invoices of a customer, newest first
+func (c *PaymentClient) GetCustomerInvoices(ctx echo.Context, customer *ent.PaymentCustomer) ([]*ent.Invoice, error) {
+	return c.orm.Invoice.Query().
+		Where(invoice.HasCustomerWith(paymentcustomer.ID(customer.ID))).
+		Order(ent.Desc(invoice.FieldIssuedAt), ent.Desc(invoice.FieldID)).
+		All(ctx.Request().Context())
+}
+
+// GetCustomerInvoice retrieves an invoice of a customer by its provider ID. The invoice is refreshed from the
+// provider first since links to its documents may expire.
+func (c *PaymentClient) GetCustomerInvoice(ctx echo.Context, customer *ent.PaymentCustomer, invoiceID string) (*ent.Invoice, error) {
+	inv, err := c.orm.Invoice.Query().
+		Where(
+			invoice.ProviderInvoiceID(invoiceID),
+			invoice.HasCustomerWith(paymentcustomer.ID(customer.ID)),
+		).
+		Only(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := c.provider.GetInvoice(ctx.Request().Context(), invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.syncInvoice(ctx.Request().Context(), c.orm, i); err != nil {
+		return nil, err
+	}
+
+	return c.orm.Invoice.Get(ctx.Request().Context(), inv.ID)
+}
+
+// SyncInvoices mirrors every invoice of a customer from the provider, for invoices which were issued before
+// webhooks were set up or whose events were missed.
+func (c *PaymentClient) SyncInvoices(ctx echo.Context, customer *ent.PaymentCustomer) error {
+	invoices, err := c.provider.ListInvoices(ctx.Request().Context(), customer.ProviderCustomerID)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range invoices {
+		if err := c.syncInvoice(ctx.Request().Context(), c.orm, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncInvoice creates or updates the invoice to match the provider.
+func (c *PaymentClient) syncInvoice(ctx context.Context, client *ent.Client, i *InvoiceResult) error {
+	status := invoice.Status(i.Status)
+	if invoice.StatusValidator(status) != nil {
+		status = invoice.DefaultStatus
+	}
+
+	existing, err := client.Invoice.Query().
+		Where(invoice.ProviderInvoiceID(i.ID)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		return client.Invoice.UpdateOne(existing).
+			SetProviderSubscriptionID(i.SubscriptionID).
+			SetNumber(i.Number).
+			SetStatus(status).
+			SetCurrency(i.Currency).
+			SetSubtotal(i.Subtotal).
+			SetTax(i.Tax).
+			SetTotal(i.Total).
+			SetAmountDue(i.AmountDue).
+			SetAmountPaid(i.AmountPaid).
+			SetHostedLink(i.HostedURL).
+			SetPdfLink(i.PDFURL).
+			SetPeriodStart(i.PeriodStart).
+			SetPeriodEnd(i.PeriodEnd).
+			SetNillablePaidAt(i.PaidAt).
+			Exec(ctx)
+
+	case !ent.IsNotFound(err):
+		return err
+	}
+
+	customer, err := c.findCustomer(ctx, client, i.CustomerID)
+	if err != nil || customer == nil {
+		return err
+	}
+
+	return client.Invoice.Create().
+		SetProviderInvoiceID(i.ID).
+		SetProvider(c.config.Payment.Provider).
+		SetProviderSubscriptionID(i.SubscriptionID).
+		SetNumber(i.Number).
+		SetStatus(status).
+		SetCurrency(i.Currency).
+		SetSubtotal(i.Subtotal).
+		SetTax(i.Tax).
+		SetTotal(i.Total).
+		SetAmountDue(i.AmountDue).
+		SetAmountPaid(i.AmountPaid).
+		SetHostedLink(i.HostedURL).
+		SetPdfLink(i.PDFURL).
+		SetPeriodStart(i.PeriodStart).
+		SetPeriodEnd(i.PeriodEnd).
+		SetNillablePaidAt(i.PaidAt).
+		SetIssuedAt(i.Created).
+		SetCustomer(customer).
+		Exec(ctx)
+}
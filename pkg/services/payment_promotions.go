@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/discount"
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/currency"
+)
+
+// ErrInvalidPromotionCode is returned when a promotion code does not exist or cannot be redeemed.
+var ErrInvalidPromotionCode = errors.New("invalid promotion code")
+
+// ValidatePromotionCode looks up a promotion code with the provider and checks that it can be redeemed when
+// subscribing to a price. Errors wrap ErrInvalidPromotionCode when the code cannot be redeemed.
+func (c *PaymentClient) ValidatePromotionCode(ctx context.Context, code string, price config.PriceConfig) (*PromotionCodeResult, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, fmt.Errorf("%w: no code was entered", ErrInvalidPromotionCode)
+	}
+
+	promo, err := c.provider.GetPromotionCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !promo.Active || !promo.Coupon.Valid:
+		return nil, fmt.Errorf("%w: %s is no longer active", ErrInvalidPromotionCode, code)
+	case promo.ExpiresAt != nil && !promo.ExpiresAt.After(time.Now()):
+		return nil, fmt.Errorf("%w: %s expired", ErrInvalidPromotionCode, code)
+	case promo.MaxRedemptions > 0 && promo.TimesRedeemed >= promo.MaxRedemptions:
+		return nil, fmt.Errorf("%w: %s was fully redeemed", ErrInvalidPromotionCode, code)
+	case promo.Coupon.AmountOff > 0 && !strings.EqualFold(promo.Coupon.Currency, price.Currency):
+		return nil, fmt.Errorf("%w: %s only applies to prices in %s", ErrInvalidPromotionCode, code, strings.ToUpper(promo.Coupon.Currency))
+	case promo.MinimumAmount > 0 && strings.EqualFold(promo.MinimumAmountCurrency, price.Currency) && price.Amount < promo.MinimumAmount:
+		return nil, fmt.Errorf("%w: %s requires a minimum of %s", ErrInvalidPromotionCode, code, currency.Format(promo.MinimumAmount, price.Currency))
+	}
+
+	return promo, nil
+}
+
+// TrialDays returns how many days of free trial a customer gets when subscribing to a plan. Customers who
+// already had a trial don't get another one.
+func (c *PaymentClient) TrialDays(ctx context.Context, customer *ent.PaymentCustomer, plan config.PlanConfig) (int, error) {
+	if plan.TrialDays <= 0 {
+		return 0, nil
+	}
+
+	trialed, err := c.orm.Subscription.Query().
+		Where(
+			subscription.HasCustomerWith(paymentcustomer.ID(customer.ID)),
+			subscription.TrialStartNotNil(),
+		).
+		Exist(ctx)
+	if err != nil || trialed {
+		return 0, err
+	}
+	return plan.TrialDays, nil
+}
+
+// GetActiveDiscount returns the discount which applies to a subscription at the given time, or nil if there
+// isn't one.
+func (c *PaymentClient) GetActiveDiscount(ctx context.Context, sub *ent.Subscription, now time.Time) (*ent.Discount, error) {
+	d, err := sub.QueryDiscounts().
+		Where(
+			discount.StartsAtLTE(now),
+			discount.Or(discount.EndsAtIsNil(), discount.EndsAtGT(now)),
+		).
+		Order(ent.Desc(discount.FieldStartsAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return d, err
+}
+
+// recordDiscount keeps a record of a discount the provider applied to a subscription.
+func (c *PaymentClient) recordDiscount(ctx context.Context, sub *ent.Subscription, d *DiscountResult) error {
+	create := c.orm.Discount.Create().
+		SetProviderDiscountID(d.ID).
+		SetProvider(c.config.Payment.Provider).
+		SetProviderCouponID(d.Coupon.ID).
+		SetProviderPromotionCodeID(d.PromotionCodeID).
+		SetCode(d.Code).
+		SetDuration(discount.Duration(d.Coupon.Duration)).
+		SetStartsAt(d.Start).
+		SetNillableEndsAt(d.End).
+		SetSubscription(sub)
+
+	if d.Coupon.PercentOff > 0 {
+		create.SetPercentOff(d.Coupon.PercentOff)
+	}
+	if d.Coupon.AmountOff > 0 {
+		create.SetAmountOff(d.Coupon.AmountOff).SetCurrency(d.Coupon.Currency)
+	}
+	if d.Coupon.DurationInMonths > 0 {
+		create.SetDurationInMonths(d.Coupon.DurationInMonths)
+	}
+
+	return create.Exec(ctx)
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/discount"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPromotions returns a copy of the config with a fixed amount promotion code and a trial on the pro plan.
+func testPromotions() *config.Config {
+	cfg := *testCatalog()
+	cfg.Payment.Fake.PromotionCodes = append(slices.Clone(cfg.Payment.Fake.PromotionCodes), config.FakePromotionCodeConfig{
+		Code:      "WELCOME5",
+		AmountOff: 500,
+		Currency:  "usd",
+		Duration:  "once",
+	})
+	for i, plan := range cfg.Payment.Plans {
+		if plan.ID == "pro" {
+			cfg.Payment.Plans[i].TrialDays = 14
+		}
+	}
+	return &cfg
+}
+
+func TestPaymentClient_ValidatePromotionCode(t *testing.T) {
+	cfg := testPromotions()
+	client := NewPaymentClient(cfg, c.ORM, NewFakeProvider(cfg))
+	usd := config.PriceConfig{ID: "price_usd", Amount: 2900, Currency: "usd"}
+	eur := config.PriceConfig{ID: "price_eur", Amount: 2900, Currency: "eur"}
+
+	promo, err := client.ValidatePromotionCode(t.Context(), " launch20 ", usd)
+	require.NoError(t, err)
+	assert.Equal(t, "LAUNCH20", promo.Code)
+	assert.Equal(t, 20.0, promo.Coupon.PercentOff)
+	assert.Equal(t, "repeating", promo.Coupon.Duration)
+
+	// Percentages apply to any currency, fixed amounts only to their own.
+	_, err = client.ValidatePromotionCode(t.Context(), "LAUNCH20", eur)
+	assert.NoError(t, err)
+	_, err = client.ValidatePromotionCode(t.Context(), "WELCOME5", usd)
+	assert.NoError(t, err)
+	_, err = client.ValidatePromotionCode(t.Context(), "WELCOME5", eur)
+	assert.ErrorIs(t, err, ErrInvalidPromotionCode)
+
+	_, err = client.ValidatePromotionCode(t.Context(), "MISSING", usd)
+	assert.ErrorIs(t, err, ErrInvalidPromotionCode)
+	_, err = client.ValidatePromotionCode(t.Context(), "  ", usd)
+	assert.ErrorIs(t, err, ErrInvalidPromotionCode)
+}
+
+func TestPaymentClient_SubscribeWithPromotionCode(t *testing.T) {
+	cfg := testPromotions()
+	fake := NewFakeProvider(cfg)
+	client := NewPaymentClient(cfg, c.ORM, fake)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := client.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	_, err = client.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_visa", true)
+	require.NoError(t, err)
+
+	promo, err := client.ValidatePromotionCode(t.Context(), "LAUNCH20", cfg.Payment.Plans[1].Prices[0])
+	require.NoError(t, err)
+	sub, err := client.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &CreateSubscriptionParams{
+		PromotionCodeID: promo.ID,
+	})
+	require.NoError(t, err)
+
+	// The applied discount is recorded with the subscription.
+	d, err := client.GetActiveDiscount(t.Context(), sub, time.Now())
+	require.NoError(t, err)
+	require.NotNil(t, d)
+	assert.Equal(t, "LAUNCH20", d.Code)
+	assert.Equal(t, promo.ID, d.ProviderPromotionCodeID)
+	assert.Equal(t, 20.0, d.PercentOff)
+	assert.Equal(t, discount.DurationRepeating, d.Duration)
+	assert.Equal(t, 3, d.DurationInMonths)
+	assert.WithinDuration(t, time.Now().AddDate(0, 3, 0), d.EndsAt, time.Minute)
+
+	d, err = client.GetActiveDiscount(t.Context(), sub, time.Now().AddDate(0, 4, 0))
+	require.NoError(t, err)
+	assert.Nil(t, d)
+
+	// Invoices are discounted until the discount ends.
+	invoices, err := fake.ListInvoices(t.Context(), customer.ProviderCustomerID)
+	require.NoError(t, err)
+	require.Len(t, invoices, 1)
+	assert.Equal(t, int64(2320), invoices[0].Total)
+
+	for range 4 {
+		_, err = fake.Renew(sub.ProviderSubscriptionID)
+		require.NoError(t, err)
+	}
+	invoices, err = fake.ListInvoices(t.Context(), customer.ProviderCustomerID)
+	require.NoError(t, err)
+	require.Len(t, invoices, 5)
+	assert.Equal(t, int64(2900), invoices[0].Total)
+	assert.Equal(t, int64(2320), invoices[2].Total)
+
+	promo, err = fake.GetPromotionCode(t.Context(), "LAUNCH20")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), promo.TimesRedeemed)
+}
+
+func TestPaymentClient_TrialDays(t *testing.T) {
+	cfg := testPromotions()
+	client := NewPaymentClient(cfg, c.ORM, NewFakeProvider(cfg))
+	pro, business := cfg.Payment.Plans[1], cfg.Payment.Plans[2]
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := client.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	_, err = client.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_visa", true)
+	require.NoError(t, err)
+
+	days, err := client.TrialDays(t.Context(), customer, business)
+	require.NoError(t, err)
+	assert.Zero(t, days)
+	days, err = client.TrialDays(t.Context(), customer, pro)
+	require.NoError(t, err)
+	assert.Equal(t, 14, days)
+
+	sub, err := client.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &CreateSubscriptionParams{
+		TrialPeriodDays: days,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "trialing", string(sub.Status))
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 14), sub.TrialEnd, time.Minute)
+
+	// Customers only get one trial.
+	days, err = client.TrialDays(t.Context(), customer, pro)
+	require.NoError(t, err)
+	assert.Zero(t, days)
+}
@@ -2,15 +2,23 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/occult/pagode/config"
 	"github.com/stripe/stripe-go/v82"
+	"github.com/stripe/stripe-go/v82/account"
+	"github.com/stripe/stripe-go/v82/accountlink"
 	"github.com/stripe/stripe-go/v82/customer"
+	"github.com/stripe/stripe-go/v82/invoice"
 	"github.com/stripe/stripe-go/v82/paymentintent"
 	"github.com/stripe/stripe-go/v82/paymentmethod"
+	"github.com/stripe/stripe-go/v82/promotioncode"
 	"github.com/stripe/stripe-go/v82/refund"
 	"github.com/stripe/stripe-go/v82/subscription"
+	"github.com/stripe/stripe-go/v82/webhook"
 )
 
 // StripeProvider implements the PaymentProvider interface for Stripe
@@ -115,7 +123,19 @@ func (s *StripeProvider) CreatePaymentIntent(ctx context.Context, params *Create
 	stripeParams := &stripe.PaymentIntentParams{
 		Amount:   stripe.Int64(params.Amount),
 		Currency: stripe.String(params.Currency),
-		Customer: stripe.String(params.CustomerID),
+	}
+
+	if params.CustomerID != "" {
+		stripeParams.Customer = stripe.String(params.CustomerID)
+	}
+
+	// Payments to a connected account are destination charges: they are made on the platform on behalf of the
+	// account, which settles them, and transferred to it once they succeed.
+	if params.ConnectedAccountID != "" {
+		stripeParams.OnBehalfOf = stripe.String(params.ConnectedAccountID)
+		stripeParams.TransferData = &stripe.PaymentIntentTransferDataParams{
+			Destination: stripe.String(params.ConnectedAccountID),
+		}
 	}
 
 	if params.Description != "" {
@@ -136,17 +156,7 @@ func (s *StripeProvider) CreatePaymentIntent(ctx context.Context, params *Create
 		return nil, err
 	}
 
-	return &PaymentIntentResult{
-		ID:           pi.ID,
-		Status:       string(pi.Status),
-		Amount:       pi.Amount,
-		Currency:     string(pi.Currency),
-		CustomerID:   pi.Customer.ID,
-		Description:  pi.Description,
-		ClientSecret: pi.ClientSecret,
-		Metadata:     convertStripeMetadata(pi.Metadata),
-		Created:      time.Unix(pi.Created, 0),
-	}, nil
+	return convertStripePaymentIntent(pi), nil
 }
 
 // ConfirmPaymentIntent confirms a payment intent in Stripe
@@ -159,17 +169,7 @@ func (s *StripeProvider) ConfirmPaymentIntent(ctx context.Context, paymentIntent
 		return nil, err
 	}
 
-	return &PaymentIntentResult{
-		ID:           pi.ID,
-		Status:       string(pi.Status),
-		Amount:       pi.Amount,
-		Currency:     string(pi.Currency),
-		CustomerID:   pi.Customer.ID,
-		Description:  pi.Description,
-		ClientSecret: pi.ClientSecret,
-		Metadata:     convertStripeMetadata(pi.Metadata),
-		Created:      time.Unix(pi.Created, 0),
-	}, nil
+	return convertStripePaymentIntent(pi), nil
 }
 
 // GetPaymentIntent retrieves a payment intent from Stripe
@@ -179,17 +179,7 @@ func (s *StripeProvider) GetPaymentIntent(ctx context.Context, paymentIntentID s
 		return nil, err
 	}
 
-	return &PaymentIntentResult{
-		ID:           pi.ID,
-		Status:       string(pi.Status),
-		Amount:       pi.Amount,
-		Currency:     string(pi.Currency),
-		CustomerID:   pi.Customer.ID,
-		Description:  pi.Description,
-		ClientSecret: pi.ClientSecret,
-		Metadata:     convertStripeMetadata(pi.Metadata),
-		Created:      time.Unix(pi.Created, 0),
-	}, nil
+	return convertStripePaymentIntent(pi), nil
 }
 
 // CancelPaymentIntent cancels a payment intent in Stripe
@@ -199,17 +189,7 @@ func (s *StripeProvider) CancelPaymentIntent(ctx context.Context, paymentIntentI
 		return nil, err
 	}
 
-	return &PaymentIntentResult{
-		ID:           pi.ID,
-		Status:       string(pi.Status),
-		Amount:       pi.Amount,
-		Currency:     string(pi.Currency),
-		CustomerID:   pi.Customer.ID,
-		Description:  pi.Description,
-		ClientSecret: pi.ClientSecret,
-		Metadata:     convertStripeMetadata(pi.Metadata),
-		Created:      time.Unix(pi.Created, 0),
-	}, nil
+	return convertStripePaymentIntent(pi), nil
 }
 
 // CreateSubscription creates a new sub in Stripe
@@ -231,6 +211,15 @@ func (s *StripeProvider) CreateSubscription(ctx context.Context, params *CreateS
 		stripeParams.TrialPeriodDays = stripe.Int64(int64(params.TrialPeriodDays))
 	}
 
+	// The applied discount is expanded so it can be recorded along with the subscription
+	if params.PromotionCodeID != "" {
+		stripeParams.Discounts = []*stripe.SubscriptionDiscountParams{
+			{PromotionCode: stripe.String(params.PromotionCodeID)},
+		}
+		stripeParams.AddExpand("discounts")
+		stripeParams.AddExpand("discounts.promotion_code")
+	}
+
 	if params.Metadata != nil {
 		stripeParams.Metadata = make(map[string]string)
 		for k, v := range params.Metadata {
@@ -245,49 +234,7 @@ func (s *StripeProvider) CreateSubscription(ctx context.Context, params *CreateS
 		return nil, err
 	}
 
-	result := &SubscriptionResult{
-		ID:         sub.ID,
-		Status:     string(sub.Status),
-		CustomerID: sub.Customer.ID,
-		Metadata:   convertStripeMetadata(sub.Metadata),
-		Created:    time.Unix(sub.Created, 0),
-	}
-
-	// Get pricing information and period information from the first item
-	if len(sub.Items.Data) > 0 {
-		item := sub.Items.Data[0]
-		result.PriceID = item.Price.ID
-		result.Amount = item.Price.UnitAmount
-		result.Currency = string(item.Price.Currency)
-		result.Interval = string(item.Price.Recurring.Interval)
-		result.IntervalCount = int(item.Price.Recurring.IntervalCount)
-
-		// Period information is now at the subscription item level
-		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
-		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
-	}
-
-	if sub.TrialStart != 0 {
-		trialStart := time.Unix(sub.TrialStart, 0)
-		result.TrialStart = &trialStart
-	}
-
-	if sub.TrialEnd != 0 {
-		trialEnd := time.Unix(sub.TrialEnd, 0)
-		result.TrialEnd = &trialEnd
-	}
-
-	if sub.CanceledAt != 0 {
-		canceledAt := time.Unix(sub.CanceledAt, 0)
-		result.CanceledAt = &canceledAt
-	}
-
-	if sub.EndedAt != 0 {
-		endedAt := time.Unix(sub.EndedAt, 0)
-		result.EndedAt = &endedAt
-	}
-
-	return result, nil
+	return convertStripeSubscription(sub), nil
 }
 
 // GetSubscription retrieves a sub from Stripe
@@ -297,49 +244,7 @@ func (s *StripeProvider) GetSubscription(ctx context.Context, subscriptionID str
 		return nil, err
 	}
 
-	result := &SubscriptionResult{
-		ID:         sub.ID,
-		Status:     string(sub.Status),
-		CustomerID: sub.Customer.ID,
-		Metadata:   convertStripeMetadata(sub.Metadata),
-		Created:    time.Unix(sub.Created, 0),
-	}
-
-	// Get pricing information and period information from the first item
-	if len(sub.Items.Data) > 0 {
-		item := sub.Items.Data[0]
-		result.PriceID = item.Price.ID
-		result.Amount = item.Price.UnitAmount
-		result.Currency = string(item.Price.Currency)
-		result.Interval = string(item.Price.Recurring.Interval)
-		result.IntervalCount = int(item.Price.Recurring.IntervalCount)
-
-		// Period information is now at the subscription item level
-		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
-		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
-	}
-
-	if sub.TrialStart != 0 {
-		trialStart := time.Unix(sub.TrialStart, 0)
-		result.TrialStart = &trialStart
-	}
-
-	if sub.TrialEnd != 0 {
-		trialEnd := time.Unix(sub.TrialEnd, 0)
-		result.TrialEnd = &trialEnd
-	}
-
-	if sub.CanceledAt != 0 {
-		canceledAt := time.Unix(sub.CanceledAt, 0)
-		result.CanceledAt = &canceledAt
-	}
-
-	if sub.EndedAt != 0 {
-		endedAt := time.Unix(sub.EndedAt, 0)
-		result.EndedAt = &endedAt
-	}
-
-	return result, nil
+	return convertStripeSubscription(sub), nil
 }
 
 // UpdateSubscription updates a sub in Stripe
@@ -367,6 +272,22 @@ func (s *StripeProvider) UpdateSubscription(ctx context.Context, subscriptionID
 		stripeParams.DefaultPaymentMethod = stripe.String(params.PaymentMethodID)
 	}
 
+	if params.ProrationBehavior != "" {
+		stripeParams.ProrationBehavior = stripe.String(params.ProrationBehavior)
+		// Prorations which are invoiced right away must be paid for the change to go through
+		if params.ProrationBehavior == ProrationAlwaysInvoice {
+			stripeParams.PaymentBehavior = stripe.String("error_if_incomplete")
+		}
+	}
+
+	if params.ProrationDate != nil {
+		stripeParams.ProrationDate = stripe.Int64(params.ProrationDate.Unix())
+	}
+
+	if params.CancelAtPeriodEnd != nil {
+		stripeParams.CancelAtPeriodEnd = params.CancelAtPeriodEnd
+	}
+
 	if params.Metadata != nil {
 		stripeParams.Metadata = make(map[string]string)
 		for k, v := range params.Metadata {
@@ -381,29 +302,7 @@ func (s *StripeProvider) UpdateSubscription(ctx context.Context, subscriptionID
 		return nil, err
 	}
 
-	result := &SubscriptionResult{
-		ID:         sub.ID,
-		Status:     string(sub.Status),
-		CustomerID: sub.Customer.ID,
-		Metadata:   convertStripeMetadata(sub.Metadata),
-		Created:    time.Unix(sub.Created, 0),
-	}
-
-	// Get pricing information and period information from the first item
-	if len(sub.Items.Data) > 0 {
-		item := sub.Items.Data[0]
-		result.PriceID = item.Price.ID
-		result.Amount = item.Price.UnitAmount
-		result.Currency = string(item.Price.Currency)
-		result.Interval = string(item.Price.Recurring.Interval)
-		result.IntervalCount = int(item.Price.Recurring.IntervalCount)
-
-		// Period information is now at the subscription item level
-		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
-		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
-	}
-
-	return result, nil
+	return convertStripeSubscription(sub), nil
 }
 
 // CancelSubscription cancels a sub in Stripe
@@ -413,39 +312,45 @@ func (s *StripeProvider) CancelSubscription(ctx context.Context, subscriptionID
 		return nil, err
 	}
 
-	result := &SubscriptionResult{
-		ID:         sub.ID,
-		Status:     string(sub.Status),
-		CustomerID: sub.Customer.ID,
-		Metadata:   convertStripeMetadata(sub.Metadata),
-		Created:    time.Unix(sub.Created, 0),
-	}
-
-	// Get pricing information and period information from the first item
-	if len(sub.Items.Data) > 0 {
-		item := sub.Items.Data[0]
-		result.PriceID = item.Price.ID
-		result.Amount = item.Price.UnitAmount
-		result.Currency = string(item.Price.Currency)
-		result.Interval = string(item.Price.Recurring.Interval)
-		result.IntervalCount = int(item.Price.Recurring.IntervalCount)
+	return convertStripeSubscription(sub), nil
+}
 
-		// Period information is now at the subscription item level
-		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
-		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
+// PreviewSubscriptionChange previews the invoice Stripe would create right away for switching a sub to
+// another price
+func (s *StripeProvider) PreviewSubscriptionChange(ctx context.Context, subscriptionID, priceID string) (*ProrationPreviewResult, error) {
+	currentSub, err := subscription.Get(subscriptionID, nil)
+	if err != nil {
+		return nil, err
 	}
-
-	if sub.CanceledAt != 0 {
-		canceledAt := time.Unix(sub.CanceledAt, 0)
-		result.CanceledAt = &canceledAt
+	if len(currentSub.Items.Data) == 0 {
+		return nil, fmt.Errorf("subscription %s has no items", subscriptionID)
 	}
 
-	if sub.EndedAt != 0 {
-		endedAt := time.Unix(sub.EndedAt, 0)
-		result.EndedAt = &endedAt
+	// The same proration date must be used when the change is made for the amount to match
+	prorationDate := time.Now()
+	preview, err := invoice.CreatePreview(&stripe.InvoiceCreatePreviewParams{
+		Customer:     stripe.String(currentSub.Customer.ID),
+		Subscription: stripe.String(subscriptionID),
+		SubscriptionDetails: &stripe.InvoiceCreatePreviewSubscriptionDetailsParams{
+			Items: []*stripe.InvoiceCreatePreviewSubscriptionDetailsItemParams{
+				{
+					ID:    stripe.String(currentSub.Items.Data[0].ID),
+					Price: stripe.String(priceID),
+				},
+			},
+			ProrationBehavior: stripe.String(ProrationAlwaysInvoice),
+			ProrationDate:     stripe.Int64(prorationDate.Unix()),
+		},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	return &ProrationPreviewResult{
+		AmountDue:     preview.AmountDue,
+		Currency:      string(preview.Currency),
+		ProrationDate: prorationDate,
+	}, nil
 }
 
 // GetPaymentMethod retrieves a payment method from Stripe
@@ -466,12 +371,7 @@ func (s *StripeProvider) GetPaymentMethod(ctx context.Context, paymentMethodID s
 		result.CustomerID = pm.Customer.ID
 	}
 
-	if pm.Card != nil {
-		result.LastFour = pm.Card.Last4
-		result.Brand = string(pm.Card.Brand)
-		result.ExpMonth = int(pm.Card.ExpMonth)
-		result.ExpYear = int(pm.Card.ExpYear)
-	}
+	applyStripePaymentMethodDetails(result, pm)
 
 	return result, nil
 }
@@ -493,12 +393,7 @@ func (s *StripeProvider) AttachPaymentMethod(ctx context.Context, paymentMethodI
 		Created:    time.Unix(pm.Created, 0),
 	}
 
-	if pm.Card != nil {
-		result.LastFour = pm.Card.Last4
-		result.Brand = string(pm.Card.Brand)
-		result.ExpMonth = int(pm.Card.ExpMonth)
-		result.ExpYear = int(pm.Card.ExpYear)
-	}
+	applyStripePaymentMethodDetails(result, pm)
 
 	return result, nil
 }
@@ -517,21 +412,16 @@ func (s *StripeProvider) DetachPaymentMethod(ctx context.Context, paymentMethodI
 		Created:  time.Unix(pm.Created, 0),
 	}
 
-	if pm.Card != nil {
-		result.LastFour = pm.Card.Last4
-		result.Brand = string(pm.Card.Brand)
-		result.ExpMonth = int(pm.Card.ExpMonth)
-		result.ExpYear = int(pm.Card.ExpYear)
-	}
+	applyStripePaymentMethodDetails(result, pm)
 
 	return result, nil
 }
 
 // ListPaymentMethods lists payment methods for a customer in Stripe
 func (s *StripeProvider) ListPaymentMethods(ctx context.Context, customerID string) ([]*PaymentMethodResult, error) {
+	// Payment methods of every type are listed, including SEPA debits, boletos and Pix
 	params := &stripe.PaymentMethodListParams{
 		Customer: stripe.String(customerID),
-		Type:     stripe.String("card"),
 	}
 
 	iter := paymentmethod.List(params)
@@ -547,12 +437,7 @@ func (s *StripeProvider) ListPaymentMethods(ctx context.Context, customerID stri
 			Created:    time.Unix(pm.Created, 0),
 		}
 
-		if pm.Card != nil {
-			result.LastFour = pm.Card.Last4
-			result.Brand = string(pm.Card.Brand)
-			result.ExpMonth = int(pm.Card.ExpMonth)
-			result.ExpYear = int(pm.Card.ExpYear)
-		}
+		applyStripePaymentMethodDetails(result, pm)
 
 		results = append(results, result)
 	}
@@ -576,6 +461,44 @@ func (s *StripeProvider) SetDefaultPaymentMethod(ctx context.Context, customerID
 	return s.GetPaymentMethod(ctx, paymentMethodID)
 }
 
+// GetPromotionCode looks up a promotion code in Stripe by the code customers enter
+func (s *StripeProvider) GetPromotionCode(ctx context.Context, code string) (*PromotionCodeResult, error) {
+	params := &stripe.PromotionCodeListParams{
+		Code: stripe.String(code),
+	}
+	params.Limit = stripe.Int64(1)
+
+	iter := promotioncode.List(params)
+	if !iter.Next() {
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s does not exist", ErrInvalidPromotionCode, code)
+	}
+
+	pc := iter.PromotionCode()
+	result := &PromotionCodeResult{
+		ID:             pc.ID,
+		Code:           pc.Code,
+		Active:         pc.Active,
+		MaxRedemptions: pc.MaxRedemptions,
+		TimesRedeemed:  pc.TimesRedeemed,
+	}
+	if pc.Coupon != nil {
+		result.Coupon = convertStripeCoupon(pc.Coupon)
+	}
+	if pc.ExpiresAt != 0 {
+		expiresAt := time.Unix(pc.ExpiresAt, 0)
+		result.ExpiresAt = &expiresAt
+	}
+	if pc.Restrictions != nil {
+		result.MinimumAmount = pc.Restrictions.MinimumAmount
+		result.MinimumAmountCurrency = string(pc.Restrictions.MinimumAmountCurrency)
+	}
+
+	return result, nil
+}
+
 // CreateRefund creates a refund in Stripe
 func (s *StripeProvider) CreateRefund(ctx context.Context, params *CreateRefundParams) (*RefundResult, error) {
 	stripeParams := &stripe.RefundParams{
@@ -635,6 +558,318 @@ func (s *StripeProvider) GetRefund(ctx context.Context, refundID string) (*Refun
 	}, nil
 }
 
+// ListInvoices lists every invoice of a customer from Stripe, newest first
+func (s *StripeProvider) ListInvoices(ctx context.Context, customerID string) ([]*InvoiceResult, error) {
+	params := &stripe.InvoiceListParams{
+		Customer: stripe.String(customerID),
+	}
+
+	iter := invoice.List(params)
+	var results []*InvoiceResult
+
+	for iter.Next() {
+		results = append(results, convertStripeInvoice(iter.Invoice()))
+	}
+
+	return results, iter.Err()
+}
+
+// GetInvoice retrieves an invoice from Stripe
+func (s *StripeProvider) GetInvoice(ctx context.Context, invoiceID string) (*InvoiceResult, error) {
+	inv, err := invoice.Get(invoiceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertStripeInvoice(inv), nil
+}
+
+// CreateConnectedAccount creates an Express connected account in Stripe which can receive card payments
+func (s *StripeProvider) CreateConnectedAccount(ctx context.Context, params *CreateConnectedAccountParams) (*ConnectedAccountResult, error) {
+	stripeParams := &stripe.AccountParams{
+		Type:  stripe.String(string(stripe.AccountTypeExpress)),
+		Email: stripe.String(params.Email),
+		Capabilities: &stripe.AccountCapabilitiesParams{
+			CardPayments: &stripe.AccountCapabilitiesCardPaymentsParams{
+				Requested: stripe.Bool(true),
+			},
+			Transfers: &stripe.AccountCapabilitiesTransfersParams{
+				Requested: stripe.Bool(true),
+			},
+		},
+	}
+
+	if params.Metadata != nil {
+		stripeParams.Metadata = make(map[string]string)
+		for k, v := range params.Metadata {
+			if str, ok := v.(string); ok {
+				stripeParams.Metadata[k] = str
+			}
+		}
+	}
+
+	acct, err := account.New(stripeParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertStripeAccount(acct), nil
+}
+
+// GetConnectedAccount retrieves a connected account from Stripe
+func (s *StripeProvider) GetConnectedAccount(ctx context.Context, accountID string) (*ConnectedAccountResult, error) {
+	acct, err := account.GetByID(accountID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertStripeAccount(acct), nil
+}
+
+// CreateAccountOnboardingLink creates a link to Stripe's hosted onboarding of a connected account
+func (s *StripeProvider) CreateAccountOnboardingLink(ctx context.Context, accountID, refreshURL, returnURL string) (string, error) {
+	link, err := accountlink.New(&stripe.AccountLinkParams{
+		Account:    stripe.String(accountID),
+		RefreshURL: stripe.String(refreshURL),
+		ReturnURL:  stripe.String(returnURL),
+		Type:       stripe.String("account_onboarding"),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return link.URL, nil
+}
+
+// ConstructWebhookEvent verifies the Stripe-Signature header of a webhook payload against the
+// webhook secret, or the Connect webhook secret for events of connected accounts, and converts the event's object
+// into a PaymentEvent.
+func (s *StripeProvider) ConstructWebhookEvent(payload []byte, signature string) (*PaymentEvent, error) {
+	// Events are parsed into our own types, so they don't need to match the library's API version
+	opts := webhook.ConstructEventOptions{
+		IgnoreAPIVersionMismatch: true,
+	}
+	event, err := webhook.ConstructEventWithOptions(payload, signature, s.config.Payment.Stripe.WebhookSecret, opts)
+	if err != nil && s.config.Payment.Stripe.ConnectWebhookSecret != "" {
+		event, err = webhook.ConstructEventWithOptions(payload, signature, s.config.Payment.Stripe.ConnectWebhookSecret, opts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWebhookSignature, err)
+	}
+
+	result := &PaymentEvent{
+		ID:      event.ID,
+		Type:    string(event.Type),
+		Created: time.Unix(event.Created, 0),
+	}
+
+	switch {
+	case strings.HasPrefix(result.Type, "customer.subscription."):
+		var sub stripe.Subscription
+		if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
+			return nil, err
+		}
+		result.Subscription = convertStripeSubscription(&sub)
+
+	case strings.HasPrefix(result.Type, "payment_intent."):
+		var pi stripe.PaymentIntent
+		if err := json.Unmarshal(event.Data.Raw, &pi); err != nil {
+			return nil, err
+		}
+		result.PaymentIntent = convertStripePaymentIntent(&pi)
+
+	case result.Type == "charge.refunded":
+		var ch stripe.Charge
+		if err := json.Unmarshal(event.Data.Raw, &ch); err != nil {
+			return nil, err
+		}
+		result.Charge = &ChargeResult{
+			ID:             ch.ID,
+			Amount:         ch.Amount,
+			AmountRefunded: ch.AmountRefunded,
+			Currency:       string(ch.Currency),
+			Refunded:       ch.Refunded,
+		}
+		if ch.PaymentIntent != nil {
+			result.Charge.PaymentIntentID = ch.PaymentIntent.ID
+		}
+
+	case strings.HasPrefix(result.Type, "invoice."):
+		var inv stripe.Invoice
+		if err := json.Unmarshal(event.Data.Raw, &inv); err != nil {
+			return nil, err
+		}
+		result.Invoice = convertStripeInvoice(&inv)
+
+	case strings.HasPrefix(result.Type, "payment_method."):
+		var pm stripe.PaymentMethod
+		if err := json.Unmarshal(event.Data.Raw, &pm); err != nil {
+			return nil, err
+		}
+		result.PaymentMethod = &PaymentMethodResult{
+			ID:       pm.ID,
+			Type:     string(pm.Type),
+			Metadata: convertStripeMetadata(pm.Metadata),
+			Created:  time.Unix(pm.Created, 0),
+		}
+		if pm.Customer != nil {
+			result.PaymentMethod.CustomerID = pm.Customer.ID
+		}
+		applyStripePaymentMethodDetails(result.PaymentMethod, &pm)
+
+	case result.Type == "account.updated":
+		var acct stripe.Account
+		if err := json.Unmarshal(event.Data.Raw, &acct); err != nil {
+			return nil, err
+		}
+		result.Account = convertStripeAccount(&acct)
+	}
+
+	return result, nil
+}
+
+// Helper function to convert a Stripe subscription to a SubscriptionResult
+func convertStripeSubscription(sub *stripe.Subscription) *SubscriptionResult {
+	result := &SubscriptionResult{
+		ID:       sub.ID,
+		Status:   string(sub.Status),
+		Metadata: convertStripeMetadata(sub.Metadata),
+		Created:  time.Unix(sub.Created, 0),
+	}
+
+	if sub.Customer != nil {
+		result.CustomerID = sub.Customer.ID
+	}
+
+	// Get pricing information and period information from the first item
+	if sub.Items != nil && len(sub.Items.Data) > 0 && sub.Items.Data[0].Price != nil {
+		item := sub.Items.Data[0]
+		result.PriceID = item.Price.ID
+		result.Amount = item.Price.UnitAmount
+		result.Currency = string(item.Price.Currency)
+		if item.Price.Recurring != nil {
+			result.Interval = string(item.Price.Recurring.Interval)
+			result.IntervalCount = int(item.Price.Recurring.IntervalCount)
+		}
+
+		// Period information is now at the subscription item level
+		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
+		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
+	}
+
+	// Discounts are only expanded when subscriptions are created with a promotion code
+	for _, d := range sub.Discounts {
+		if d.Coupon == nil {
+			continue
+		}
+		result.Discount = &DiscountResult{
+			ID:     d.ID,
+			Coupon: convertStripeCoupon(d.Coupon),
+			Start:  time.Unix(d.Start, 0),
+		}
+		if d.PromotionCode != nil {
+			result.Discount.PromotionCodeID = d.PromotionCode.ID
+			result.Discount.Code = d.PromotionCode.Code
+		}
+		if d.End != 0 {
+			end := time.Unix(d.End, 0)
+			result.Discount.End = &end
+		}
+		break
+	}
+
+	if sub.TrialStart != 0 {
+		trialStart := time.Unix(sub.TrialStart, 0)
+		result.TrialStart = &trialStart
+	}
+
+	if sub.TrialEnd != 0 {
+		trialEnd := time.Unix(sub.TrialEnd, 0)
+		result.TrialEnd = &trialEnd
+	}
+
+	if sub.CanceledAt != 0 {
+		canceledAt := time.Unix(sub.CanceledAt, 0)
+		result.CanceledAt = &canceledAt
+	}
+
+	if sub.EndedAt != 0 {
+		endedAt := time.Unix(sub.EndedAt, 0)
+		result.EndedAt = &endedAt
+	}
+
+	result.CancelAtPeriodEnd = sub.CancelAtPeriodEnd
+
+	return result
+}
+
+// convertStripePaymentIntent converts a Stripe payment intent to our PaymentIntentResult type
+func convertStripePaymentIntent(pi *stripe.PaymentIntent) *PaymentIntentResult {
+	result := &PaymentIntentResult{
+		ID:           pi.ID,
+		Status:       string(pi.Status),
+		Amount:       pi.Amount,
+		Currency:     string(pi.Currency),
+		Description:  pi.Description,
+		ClientSecret: pi.ClientSecret,
+		Metadata:     convertStripeMetadata(pi.Metadata),
+		Created:      time.Unix(pi.Created, 0),
+	}
+
+	if pi.Customer != nil {
+		result.CustomerID = pi.Customer.ID
+	}
+	if pi.TransferData != nil && pi.TransferData.Destination != nil {
+		result.ConnectedAccountID = pi.TransferData.Destination.ID
+	}
+
+	return result
+}
+
+// applyStripePaymentMethodDetails copies the details shown to customers of a Stripe payment method to a result.
+// Cards have a brand and an expiry date, while SEPA debits are identified by the end of their IBAN and boletos
+// by the end of the customer's tax ID. Pix payment methods have no details.
+func applyStripePaymentMethodDetails(result *PaymentMethodResult, pm *stripe.PaymentMethod) {
+	switch {
+	case pm.Card != nil:
+		result.LastFour = pm.Card.Last4
+		result.Brand = string(pm.Card.Brand)
+		result.ExpMonth = int(pm.Card.ExpMonth)
+		result.ExpYear = int(pm.Card.ExpYear)
+	case pm.SEPADebit != nil:
+		result.LastFour = pm.SEPADebit.Last4
+		result.Brand = pm.SEPADebit.BankCode
+	case pm.Boleto != nil && len(pm.Boleto.TaxID) >= 4:
+		result.LastFour = pm.Boleto.TaxID[len(pm.Boleto.TaxID)-4:]
+	}
+}
+
+// convertStripeCoupon converts a Stripe coupon to a CouponResult
+func convertStripeCoupon(c *stripe.Coupon) CouponResult {
+	return CouponResult{
+		ID:               c.ID,
+		Name:             c.Name,
+		PercentOff:       c.PercentOff,
+		AmountOff:        c.AmountOff,
+		Currency:         string(c.Currency),
+		Duration:         string(c.Duration),
+		DurationInMonths: int(c.DurationInMonths),
+		Valid:            c.Valid,
+	}
+}
+
+// convertStripeAccount converts a Stripe connected account to our ConnectedAccountResult type
+func convertStripeAccount(acct *stripe.Account) *ConnectedAccountResult {
+	return &ConnectedAccountResult{
+		ID:               acct.ID,
+		Email:            acct.Email,
+		ChargesEnabled:   acct.ChargesEnabled,
+		DetailsSubmitted: acct.DetailsSubmitted,
+		Metadata:         convertStripeMetadata(acct.Metadata),
+		Created:          time.Unix(acct.Created, 0),
+	}
+}
+
 // Helper function to convert Stripe metadata to map[string]interface{}
 func convertStripeMetadata(metadata map[string]string) map[string]interface{} {
 	result := make(map[string]interface{})
@@ -644,3 +879,40 @@ func convertStripeMetadata(metadata map[string]string) map[string]interface{} {
 	return result
 }
 
+// convertStripeInvoice converts a Stripe invoice to our InvoiceResult
+func convertStripeInvoice(inv *stripe.Invoice) *InvoiceResult {
+	result := &InvoiceResult{
+		ID:          inv.ID,
+		Number:      inv.Number,
+		Status:      string(inv.Status),
+		Currency:    string(inv.Currency),
+		Subtotal:    inv.Subtotal,
+		Total:       inv.Total,
+		AmountDue:   inv.AmountDue,
+		AmountPaid:  inv.AmountPaid,
+		HostedURL:   inv.HostedInvoiceURL,
+		PDFURL:      inv.InvoicePDF,
+		PeriodStart: time.Unix(inv.PeriodStart, 0),
+		PeriodEnd:   time.Unix(inv.PeriodEnd, 0),
+		Created:     time.Unix(inv.Created, 0),
+	}
+
+	if inv.Customer != nil {
+		result.CustomerID = inv.Customer.ID
+	}
+
+	if inv.Parent != nil && inv.Parent.SubscriptionDetails != nil && inv.Parent.SubscriptionDetails.Subscription != nil {
+		result.SubscriptionID = inv.Parent.SubscriptionDetails.Subscription.ID
+	}
+
+	for _, tax := range inv.TotalTaxes {
+		result.Tax += tax.Amount
+	}
+
+	if inv.StatusTransitions != nil && inv.StatusTransitions.PaidAt > 0 {
+		paidAt := time.Unix(inv.StatusTransitions.PaidAt, 0)
+		result.PaidAt = &paidAt
+	}
+
+	return result
+}
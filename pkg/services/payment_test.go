@@ -0,0 +1,46 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentClient_AttachPaymentMethodToCustomer(t *testing.T) {
+	client := NewPaymentClient(c.Config, c.ORM, NewFakeProvider(c.Config))
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := client.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+
+	types := map[string]paymentmethod.Type{
+		"pm_card_visa":  paymentmethod.TypeCard,
+		"pm_sepa_debit": paymentmethod.TypeSepaDebit,
+		"pm_boleto":     paymentmethod.TypeBoleto,
+		"pm_pix":        paymentmethod.TypePix,
+	}
+
+	for id, expected := range types {
+		t.Run(id, func(t *testing.T) {
+			pm, err := client.AttachPaymentMethodToCustomer(ctx, customer, id, false)
+			require.NoError(t, err)
+			assert.Equal(t, expected, pm.Type)
+			if expected != paymentmethod.TypeCard {
+				assert.Zero(t, pm.ExpMonth)
+			}
+		})
+	}
+}
+
+func TestPaymentMethodType(t *testing.T) {
+	assert.Equal(t, paymentmethod.TypeCard, paymentMethodType("card"))
+	assert.Equal(t, paymentmethod.TypeSepaDebit, paymentMethodType("sepa_debit"))
+	assert.Equal(t, paymentmethod.TypeBoleto, paymentMethodType("boleto"))
+	assert.Equal(t, paymentmethod.TypePix, paymentMethodType("pix"))
+	assert.Equal(t, paymentmethod.TypeBankAccount, paymentMethodType("us_bank_account"))
+	assert.Equal(t, paymentmethod.TypeWallet, paymentMethodType("link"))
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/subscription"
+)
+
+// TrialNotifier is called for every trial ProcessTrialReminders reminds the user of, within the transaction
+// recording the reminder.
+type TrialNotifier func(ctx context.Context, tx *ent.Tx, sub *ent.Subscription) error
+
+// ProcessTrialReminders reminds the users whose trial ends within the configured reminder period at the given
+// time, and returns how many were reminded. Users are only reminded once per trial, and not at all if their
+// subscription is set to cancel when the trial ends.
+func (c *PaymentClient) ProcessTrialReminders(ctx context.Context, now time.Time, notify TrialNotifier) (int, error) {
+	if c.config.Payment.Trials.Reminder <= 0 {
+		return 0, nil
+	}
+
+	subs, err := c.orm.Subscription.Query().
+		Where(
+			subscription.StatusEQ(subscription.StatusTrialing),
+			subscription.CancelAtPeriodEnd(false),
+			subscription.TrialEndGT(now),
+			subscription.TrialEndLTE(now.Add(c.config.Payment.Trials.Reminder)),
+			subscription.TrialRemindedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var reminded int
+	var errs []error
+	for _, sub := range subs {
+		sent, err := c.remindTrial(ctx, sub, now, notify)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %s: %w", sub.ProviderSubscriptionID, err))
+			continue
+		}
+		if sent {
+			reminded++
+		}
+	}
+
+	return reminded, errors.Join(errs...)
+}
+
+// remindTrial records that the user of a trial was reminded and notifies them. Nothing is sent if another run
+// recorded it first.
+func (c *PaymentClient) remindTrial(ctx context.Context, sub *ent.Subscription, now time.Time, notify TrialNotifier) (bool, error) {
+	tx, err := c.orm.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := tx.Subscription.Update().
+		Where(
+			subscription.ID(sub.ID),
+			subscription.TrialRemindedAtIsNil(),
+		).
+		SetTrialRemindedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, rollback(tx, err)
+	}
+	if updated == 0 {
+		return false, tx.Rollback()
+	}
+
+	if err := notify(ctx, tx, sub); err != nil {
+		return false, rollback(tx, err)
+	}
+	return true, tx.Commit()
+}
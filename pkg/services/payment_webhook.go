@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/log"
+)
+
+// ErrInvalidWebhookSignature is returned when a webhook payload cannot be verified as coming from the provider.
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// PaymentEvent represents a webhook event from the provider.
+// At most one of the objects is set, depending on the event type; none are set for types which are not synced.
+type PaymentEvent struct {
+	ID            string                  `json:"id"`
+	Type          string                  `json:"type"`
+	Created       time.Time               `json:"created"`
+	Subscription  *SubscriptionResult     `json:"subscription,omitempty"`
+	PaymentIntent *PaymentIntentResult    `json:"payment_intent,omitempty"`
+	PaymentMethod *PaymentMethodResult    `json:"payment_method,omitempty"`
+	Charge        *ChargeResult           `json:"charge,omitempty"`
+	Invoice       *InvoiceResult          `json:"invoice,omitempty"`
+	Account       *ConnectedAccountResult `json:"account,omitempty"`
+}
+
+// ChargeResult represents a charge carried by a webhook event, used to track refunds
+type ChargeResult struct {
+	ID              string `json:"id"`
+	PaymentIntentID string `json:"payment_intent_id"`
+	Amount          int64  `json:"amount"`
+	AmountRefunded  int64  `json:"amount_refunded"`
+	Currency        string `json:"currency"`
+	Refunded        bool   `json:"refunded"`
+}
+
+// ParseWebhook verifies the signature of a webhook payload and parses the event it contains.
+// ErrInvalidWebhookSignature is returned if the payload was not signed by the provider.
+func (c *PaymentClient) ParseWebhook(payload []byte, signature string) (*PaymentEvent, error) {
+	return c.provider.ConstructWebhookEvent(payload, signature)
+}
+
+// ProcessWebhookEvent applies a webhook event to the subscriptions, payment intents, payment methods,
+// invoices and connected accounts in the database. Every event is recorded so redelivered events are skipped, in which case false is returned.
+// Objects belonging to customers which are unknown to this application are ignored.
+func (c *PaymentClient) ProcessWebhookEvent(ctx context.Context, event *PaymentEvent) (bool, error) {
+	tx, err := c.orm.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.ProcessedEvent.Create().
+		SetProvider(c.config.Payment.Provider).
+		SetEventID(event.ID).
+		SetType(event.Type).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := c.applyWebhookEvent(ctx, tx, event); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// applyWebhookEvent syncs the object carried by an event.
+func (c *PaymentClient) applyWebhookEvent(ctx context.Context, tx *ent.Tx, event *PaymentEvent) error {
+	client := tx.Client()
+	switch {
+	case event.Subscription != nil:
+		return c.syncSubscription(ctx, client, event.Subscription, event.Created)
+	case event.PaymentIntent != nil:
+		return c.syncPaymentIntent(ctx, tx, event.PaymentIntent)
+	case event.Charge != nil:
+		return c.syncCharge(ctx, client, event.Charge)
+	case event.PaymentMethod != nil:
+		return c.syncPaymentMethod(ctx, client, event.PaymentMethod)
+	case event.Invoice != nil:
+		return c.syncInvoice(ctx, client, event.Invoice)
+	case event.Account != nil:
+		return c.syncPaymentAccount(ctx, client, event.Account)
+	default:
+		log.Default().Debug("Ignoring payment webhook event", "id", event.ID, "type", event.Type)
+		return nil
+	}
+}
+
+// findCustomer loads the payment customer with the given provider ID, returning nil if there isn't one.
+func (c *PaymentClient) findCustomer(ctx context.Context, client *ent.Client, providerCustomerID string) (*ent.PaymentCustomer, error) {
+	if providerCustomerID == "" {
+		return nil, nil
+	}
+
+	customer, err := client.PaymentCustomer.Query().
+		Where(paymentcustomer.ProviderCustomerID(providerCustomerID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return customer, err
+}
+
+// syncSubscription creates or updates the subscription to match the provider. The time the webhook event carrying
+// it was created is given so events which are delivered out of order don't overwrite newer ones, and is zero for
+// subscriptions which were just retrieved from the provider.
+func (c *PaymentClient) syncSubscription(ctx context.Context, client *ent.Client, s *SubscriptionResult, eventAt time.Time) error {
+	existing, err := client.Subscription.Query().
+		Where(subscription.ProviderSubscriptionID(s.ID)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		if !eventAt.IsZero() && eventAt.Before(existing.LastEventAt) {
+			log.Default().Debug("Ignoring out of order subscription event", "subscription", s.ID)
+			return nil
+		}
+
+		update := trackDunning(client.Subscription.UpdateOne(existing), existing, subscription.Status(s.Status)).
+			SetStatus(subscription.Status(s.Status)).
+			SetCancelAtPeriodEnd(s.CancelAtPeriodEnd).
+			SetMetadata(s.Metadata)
+
+		// A scheduled change is no longer pending once it has been applied or the subscription has ended.
+		if (s.PriceID != "" && s.PriceID == existing.PendingPriceID) || s.Status == string(subscription.StatusCanceled) {
+			update.
+				ClearPendingPriceID().
+				ClearPendingChangeAt()
+		}
+
+		if s.PriceID != "" {
+			update.
+				SetPriceID(s.PriceID).
+				SetAmount(s.Amount).
+				SetCurrency(s.Currency).
+				SetInterval(subscription.Interval(s.Interval)).
+				SetIntervalCount(s.IntervalCount).
+				SetCurrentPeriodStart(s.CurrentPeriodStart).
+				SetCurrentPeriodEnd(s.CurrentPeriodEnd)
+		}
+
+		if s.TrialStart != nil {
+			update.SetTrialStart(*s.TrialStart)
+		} else {
+			update.ClearTrialStart()
+		}
+		if s.TrialEnd != nil {
+			update.SetTrialEnd(*s.TrialEnd)
+		} else {
+			update.ClearTrialEnd()
+		}
+		if s.CanceledAt != nil {
+			update.SetCanceledAt(*s.CanceledAt)
+		} else {
+			update.ClearCanceledAt()
+		}
+		if s.EndedAt != nil {
+			update.SetEndedAt(*s.EndedAt)
+		} else {
+			update.ClearEndedAt()
+		}
+		if !eventAt.IsZero() {
+			update.SetLastEventAt(eventAt)
+		}
+
+		return update.Exec(ctx)
+
+	case !ent.IsNotFound(err):
+		return err
+	}
+
+	customer, err := c.findCustomer(ctx, client, s.CustomerID)
+	if err != nil || customer == nil {
+		return err
+	}
+
+	create := client.Subscription.Create()
+	if status := subscription.Status(s.Status); status == subscription.StatusPastDue || status == subscription.StatusUnpaid {
+		create.SetPastDueAt(time.Now())
+	}
+	if !eventAt.IsZero() {
+		create.SetLastEventAt(eventAt)
+	}
+
+	return create.
+		SetProviderSubscriptionID(s.ID).
+		SetProvider(c.config.Payment.Provider).
+		SetStatus(subscription.Status(s.Status)).
+		SetPriceID(s.PriceID).
+		SetAmount(s.Amount).
+		SetCurrency(s.Currency).
+		SetInterval(subscription.Interval(s.Interval)).
+		SetIntervalCount(s.IntervalCount).
+		SetCurrentPeriodStart(s.CurrentPeriodStart).
+		SetCurrentPeriodEnd(s.CurrentPeriodEnd).
+		SetNillableTrialStart(s.TrialStart).
+		SetNillableTrialEnd(s.TrialEnd).
+		SetNillableCanceledAt(s.CanceledAt).
+		SetNillableEndedAt(s.EndedAt).
+		SetCancelAtPeriodEnd(s.CancelAtPeriodEnd).
+		SetMetadata(s.Metadata).
+		SetCustomer(customer).
+		Exec(ctx)
+}
+
+// syncPaymentIntent creates or updates the payment intent to match the provider.
+func (c *PaymentClient) syncPaymentIntent(ctx context.Context, tx *ent.Tx, pi *PaymentIntentResult) error {
+	client := tx.Client()
+	existing, err := client.PaymentIntent.Query().
+		Where(paymentintent.ProviderPaymentIntentID(pi.ID)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		existing, err = client.PaymentIntent.UpdateOne(existing).
+			SetStatus(paymentintent.Status(pi.Status)).
+			SetAmount(pi.Amount).
+			SetMetadata(pi.Metadata).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return c.completeResponse(ctx, tx, existing)
+
+	case !ent.IsNotFound(err):
+		return err
+	}
+
+	customer, err := c.findCustomer(ctx, client, pi.CustomerID)
+	if err != nil || customer == nil {
+		return err
+	}
+
+	return client.PaymentIntent.Create().
+		SetProviderPaymentIntentID(pi.ID).
+		SetProvider(c.config.Payment.Provider).
+		SetStatus(paymentintent.Status(pi.Status)).
+		SetAmount(pi.Amount).
+		SetCurrency(pi.Currency).
+		SetDescription(pi.Description).
+		SetMetadata(pi.Metadata).
+		SetCustomer(customer).
+		Exec(ctx)
+}
+
+// syncCharge records how much of a charge's payment intent has been refunded.
+func (c *PaymentClient) syncCharge(ctx context.Context, client *ent.Client, charge *ChargeResult) error {
+	if charge.PaymentIntentID == "" {
+		return nil
+	}
+
+	return client.PaymentIntent.Update().
+		Where(paymentintent.ProviderPaymentIntentID(charge.PaymentIntentID)).
+		SetAmountRefunded(charge.AmountRefunded).
+		Exec(ctx)
+}
+
+// syncPaymentMethod creates or updates the payment method to match the provider.
+// A payment method without a customer has been detached, so it is deleted.
+func (c *PaymentClient) syncPaymentMethod(ctx context.Context, client *ent.Client, pm *PaymentMethodResult) error {
+	if pm.CustomerID == "" {
+		_, err := client.PaymentMethod.Delete().
+			Where(paymentmethod.ProviderPaymentMethodID(pm.ID)).
+			Exec(ctx)
+		return err
+	}
+
+	existing, err := client.PaymentMethod.Query().
+		Where(paymentmethod.ProviderPaymentMethodID(pm.ID)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		update := client.PaymentMethod.UpdateOne(existing).
+			SetType(paymentMethodType(pm.Type)).
+			SetLastFour(pm.LastFour).
+			SetBrand(pm.Brand).
+			SetMetadata(pm.Metadata)
+		if pm.ExpMonth > 0 {
+			update.SetExpMonth(pm.ExpMonth).SetExpYear(pm.ExpYear)
+		}
+		return update.Exec(ctx)
+
+	case !ent.IsNotFound(err):
+		return err
+	}
+
+	customer, err := c.findCustomer(ctx, client, pm.CustomerID)
+	if err != nil || customer == nil {
+		return err
+	}
+
+	create := client.PaymentMethod.Create().
+		SetProviderPaymentMethodID(pm.ID).
+		SetProvider(c.config.Payment.Provider).
+		SetType(paymentMethodType(pm.Type)).
+		SetLastFour(pm.LastFour).
+		SetBrand(pm.Brand).
+		SetMetadata(pm.Metadata).
+		SetCustomer(customer)
+	if pm.ExpMonth > 0 {
+		create.SetExpMonth(pm.ExpMonth).SetExpYear(pm.ExpYear)
+	}
+	return create.Exec(ctx)
+}
+
+// paymentMethodType maps a provider payment method type to the types stored in the database.
+func paymentMethodType(t string) paymentmethod.Type {
+	switch t {
+	case "card":
+		return paymentmethod.TypeCard
+	case "sepa_debit":
+		return paymentmethod.TypeSepaDebit
+	case "boleto":
+		return paymentmethod.TypeBoleto
+	case "pix":
+		return paymentmethod.TypePix
+	case "us_bank_account", "bacs_debit", "au_becs_debit", "acss_debit", "bank_account":
+		return paymentmethod.TypeBankAccount
+	default:
+		return paymentmethod.TypeWallet
+	}
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/invoice"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/processedevent"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v82/webhook"
+)
+
+// signedFixture loads a recorded Stripe event and signs it with the configured webhook secret.
+func signedFixture(t *testing.T, name string) ([]byte, string) {
+	payload, err := os.ReadFile(filepath.Join("testdata", "stripe", name+".json"))
+	require.NoError(t, err)
+
+	signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+		Payload: payload,
+		Secret:  c.Config.Payment.Stripe.WebhookSecret,
+	})
+	return payload, signed.Header
+}
+
+// replayFixture parses and processes a recorded Stripe event.
+func replayFixture(t *testing.T, name string) (*PaymentEvent, bool) {
+	payload, signature := signedFixture(t, name)
+	event, err := c.Payment.ParseWebhook(payload, signature)
+	require.NoError(t, err)
+
+	processed, err := c.Payment.ProcessWebhookEvent(context.Background(), event)
+	require.NoError(t, err)
+	return event, processed
+}
+
+func TestPaymentClient_Webhooks(t *testing.T) {
+	customer, err := c.ORM.PaymentCustomer.Create().
+		SetProviderCustomerID("cus_SWebhookTest01").
+		SetEmail(usr.Email).
+		SetUser(usr).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	t.Run("subscription", func(t *testing.T) {
+		event, processed := replayFixture(t, "customer.subscription.created")
+		assert.True(t, processed)
+		assert.Equal(t, "customer.subscription.created", event.Type)
+
+		sub, err := customer.QuerySubscriptions().
+			Where(subscription.ProviderSubscriptionID("sub_1RWebhookTest01")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, subscription.StatusActive, sub.Status)
+		assert.Equal(t, "price_1RWebhookMonthly", sub.PriceID)
+		assert.Equal(t, int64(1900), sub.Amount)
+		assert.Equal(t, subscription.IntervalMonth, sub.Interval)
+		assert.Equal(t, time.Unix(1751022000, 0), sub.CurrentPeriodEnd.Local())
+		assert.Equal(t, "pro", sub.Metadata["plan"])
+
+		_, processed = replayFixture(t, "customer.subscription.updated")
+		assert.True(t, processed)
+		sub, err = c.ORM.Subscription.Get(context.Background(), sub.ID)
+		require.NoError(t, err)
+		assert.Equal(t, subscription.StatusPastDue, sub.Status)
+		assert.Equal(t, time.Unix(1753700400, 0), sub.CurrentPeriodEnd.Local())
+
+		_, processed = replayFixture(t, "customer.subscription.deleted")
+		assert.True(t, processed)
+		sub, err = c.ORM.Subscription.Get(context.Background(), sub.ID)
+		require.NoError(t, err)
+		assert.Equal(t, subscription.StatusCanceled, sub.Status)
+		assert.Equal(t, time.Unix(1752000000, 0), sub.EndedAt.Local())
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		// An older event which is delivered late must not undo the changes of newer ones.
+		payload, signature := signedFixture(t, "customer.subscription.updated")
+		event, err := c.Payment.ParseWebhook(payload, signature)
+		require.NoError(t, err)
+		event.ID = "evt_1RWebhookSubUpdatedLate"
+
+		processed, err := c.Payment.ProcessWebhookEvent(context.Background(), event)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		sub, err := c.ORM.Subscription.Query().
+			Where(subscription.ProviderSubscriptionID("sub_1RWebhookTest01")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, subscription.StatusCanceled, sub.Status)
+		assert.Equal(t, time.Unix(1752000000, 0), sub.LastEventAt.Local())
+	})
+
+	t.Run("payment intent", func(t *testing.T) {
+		_, processed := replayFixture(t, "payment_intent.succeeded")
+		assert.True(t, processed)
+
+		pi, err := customer.QueryPaymentIntents().
+			Where(paymentintent.ProviderPaymentIntentID("pi_3RWebhookTest01")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusSucceeded, pi.Status)
+		assert.Equal(t, int64(4900), pi.Amount)
+		assert.Zero(t, pi.AmountRefunded)
+
+		_, processed = replayFixture(t, "charge.refunded")
+		assert.True(t, processed)
+		pi, err = c.ORM.PaymentIntent.Get(context.Background(), pi.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4900), pi.AmountRefunded)
+	})
+
+	t.Run("payment method", func(t *testing.T) {
+		_, processed := replayFixture(t, "payment_method.attached")
+		assert.True(t, processed)
+
+		pm, err := customer.QueryPaymentMethods().
+			Where(paymentmethod.ProviderPaymentMethodID("pm_1RWebhookCard01")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, paymentmethod.TypeCard, pm.Type)
+		assert.Equal(t, "4242", pm.LastFour)
+		assert.Equal(t, "visa", pm.Brand)
+		assert.Equal(t, 8, pm.ExpMonth)
+		assert.Equal(t, 2030, pm.ExpYear)
+
+		_, processed = replayFixture(t, "payment_method.detached")
+		assert.True(t, processed)
+		exists, err := customer.QueryPaymentMethods().Exist(context.Background())
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("invoice", func(t *testing.T) {
+		_, processed := replayFixture(t, "invoice.paid")
+		assert.True(t, processed)
+
+		inv, err := customer.QueryInvoices().
+			Where(invoice.ProviderInvoiceID("in_1RWebhookTest01")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, invoice.StatusPaid, inv.Status)
+		assert.Equal(t, "A1B2C3D4-0001", inv.Number)
+		assert.Equal(t, "sub_1RWebhookTest01", inv.ProviderSubscriptionID)
+		assert.Equal(t, int64(1900), inv.Subtotal)
+		assert.Equal(t, int64(190), inv.Tax)
+		assert.Equal(t, int64(2090), inv.Total)
+		assert.Equal(t, int64(2090), inv.AmountPaid)
+		assert.Contains(t, inv.PdfLink, "/pdf")
+		assert.Equal(t, time.Unix(1748430903, 0), inv.PaidAt.Local())
+	})
+
+	t.Run("account", func(t *testing.T) {
+		account, err := c.ORM.PaymentAccount.Create().
+			SetProviderAccountID("acct_1RWebhookTest01").
+			SetUser(usr).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		_, processed := replayFixture(t, "account.updated")
+		assert.True(t, processed)
+
+		account, err = c.ORM.PaymentAccount.Get(context.Background(), account.ID)
+		require.NoError(t, err)
+		assert.True(t, account.ChargesEnabled)
+		assert.True(t, account.DetailsSubmitted)
+	})
+
+	t.Run("redelivery", func(t *testing.T) {
+		// Replaying an older event must not undo the changes made since.
+		_, processed := replayFixture(t, "customer.subscription.created")
+		assert.False(t, processed)
+
+		sub, err := c.ORM.Subscription.Query().
+			Where(subscription.ProviderSubscriptionID("sub_1RWebhookTest01")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, subscription.StatusCanceled, sub.Status)
+
+		count, err := c.ORM.ProcessedEvent.Query().
+			Where(processedevent.EventID("evt_1RWebhookSubCreated")).
+			Count(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestPaymentClient_Webhooks_UnknownCustomer(t *testing.T) {
+	payload, signature := signedFixture(t, "payment_intent.succeeded")
+	event, err := c.Payment.ParseWebhook(payload, signature)
+	require.NoError(t, err)
+
+	// Events for customers this application doesn't know about are recorded but otherwise ignored.
+	event.ID = "evt_3RWebhookUnknownCus"
+	event.PaymentIntent.ID = "pi_3RWebhookUnknown"
+	event.PaymentIntent.CustomerID = "cus_SWebhookUnknown"
+	processed, err := c.Payment.ProcessWebhookEvent(context.Background(), event)
+	require.NoError(t, err)
+	assert.True(t, processed)
+
+	_, err = c.ORM.PaymentIntent.Query().
+		Where(paymentintent.ProviderPaymentIntentID("pi_3RWebhookUnknown")).
+		Only(context.Background())
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestPaymentClient_ParseWebhook_InvalidSignature(t *testing.T) {
+	payload, _ := signedFixture(t, "customer.subscription.created")
+
+	signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+		Payload: payload,
+		Secret:  "whsec_wrong",
+	})
+	_, err := c.Payment.ParseWebhook(payload, signed.Header)
+	assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+
+	_, err = c.Payment.ParseWebhook(payload, "")
+	assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+}
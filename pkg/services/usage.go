@@ -0,0 +1,381 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/predicate"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/ent/usage"
+	"github.com/occult/pagode/ent/user"
+)
+
+// Metric is something which is metered against the limits of a user's plan.
+type Metric string
+
+const (
+	// MetricResponses counts the responses received by a user's forms during the usage period.
+	MetricResponses Metric = "responses"
+
+	// MetricForms counts the forms a user owns. How many were created during the usage period is recorded too.
+	MetricForms Metric = "forms"
+
+	// MetricStorage counts the bytes of answers and files stored during the usage period.
+	MetricStorage Metric = "storage"
+)
+
+// Metrics lists every metric in the order they are displayed.
+var Metrics = []Metric{MetricResponses, MetricForms, MetricStorage}
+
+// ErrUsageLimit is returned by Consume when using more would go over the limit of the user's plan.
+// The status returned with it is at the limit.
+var ErrUsageLimit = errors.New("usage limit reached")
+
+// UsageThreshold is how close usage is to its limit.
+type UsageThreshold int
+
+const (
+	// ThresholdNone means usage is below the warning threshold.
+	ThresholdNone UsageThreshold = iota
+
+	// ThresholdWarning means usage reached the configured share of the limit.
+	ThresholdWarning
+
+	// ThresholdLimit means usage reached the limit.
+	ThresholdLimit
+)
+
+// String returns the name of the threshold.
+func (t UsageThreshold) String() string {
+	switch t {
+	case ThresholdWarning:
+		return "warning"
+	case ThresholdLimit:
+		return "limit"
+	default:
+		return "none"
+	}
+}
+
+// MarshalText marshals the threshold as its name.
+func (t UsageThreshold) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UsageStatus is a user's usage of a metric compared to the limit of their plan.
+type UsageStatus struct {
+	Metric Metric `json:"metric"`
+	Used   int64  `json:"used"`
+	// Limit is negative when the plan does not limit the metric.
+	Limit     int64          `json:"limit"`
+	Threshold UsageThreshold `json:"threshold"`
+}
+
+// Unlimited returns whether the plan does not limit the metric.
+func (s UsageStatus) Unlimited() bool {
+	return s.Limit < 0
+}
+
+// Fits returns whether the given amount can be added without going over the limit.
+func (s UsageStatus) Fits(amount int64) bool {
+	return s.Unlimited() || s.Used+amount <= s.Limit
+}
+
+// UsageReport is a user's usage of every metric during their current usage period.
+type UsageReport struct {
+	Plan         config.PlanConfig `json:"-"`
+	PeriodStart  time.Time         `json:"periodStart"`
+	PeriodEnd    time.Time         `json:"periodEnd"`
+	Metrics      []UsageStatus     `json:"metrics"`
+	FormsCreated int64             `json:"formsCreated"`
+	Overage      bool              `json:"overage"`
+}
+
+// UsageClient meters what users use against the limits of their plan.
+type UsageClient struct {
+	config       *config.Config
+	orm          *ent.Client
+	entitlements *EntitlementsClient
+}
+
+// NewUsageClient creates a new UsageClient.
+func NewUsageClient(cfg *config.Config, orm *ent.Client, entitlements *EntitlementsClient) *UsageClient {
+	return &UsageClient{
+		config:       cfg,
+		orm:          orm,
+		entitlements: entitlements,
+	}
+}
+
+// Period returns the usage period of a user at the given time. This is the current billing period of their
+// subscription, or the calendar month if they don't have one.
+func (c *UsageClient) Period(ctx context.Context, u *ent.User, now time.Time) (time.Time, time.Time, error) {
+	sub, err := c.orm.Subscription.Query().
+		Where(
+			subscription.HasCustomerWith(paymentcustomer.HasUserWith(user.ID(u.ID))),
+			subscription.StatusIn(subscription.StatusActive, subscription.StatusTrialing, subscription.StatusPastDue),
+			subscription.CurrentPeriodStartLTE(now),
+			subscription.CurrentPeriodEndGT(now),
+		).
+		Order(ent.Desc(subscription.FieldCurrentPeriodStart)).
+		First(ctx)
+	switch {
+	case err == nil:
+		return sub.CurrentPeriodStart.UTC(), sub.CurrentPeriodEnd.UTC(), nil
+	case !ent.IsNotFound(err):
+		return time.Time{}, time.Time{}, err
+	}
+
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// Status returns a user's usage of a metric during their current usage period.
+func (c *UsageClient) Status(ctx context.Context, u *ent.User, metric Metric) (UsageStatus, error) {
+	plan, err := c.entitlements.PlanFor(ctx, u)
+	if err != nil {
+		return UsageStatus{}, err
+	}
+
+	start, _, err := c.Period(ctx, u, time.Now())
+	if err != nil {
+		return UsageStatus{}, err
+	}
+
+	row, err := c.orm.Usage.Query().
+		Where(usage.UserID(u.ID), usage.PeriodStart(start)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return UsageStatus{}, err
+	}
+
+	used, err := c.used(ctx, c.orm, u, metric, row)
+	if err != nil {
+		return UsageStatus{}, err
+	}
+	return c.status(plan, metric, used), nil
+}
+
+// Allows returns whether the given amount of a metric can be used, along with the current usage.
+// Going over the limit is allowed when overage is enabled.
+func (c *UsageClient) Allows(ctx context.Context, u *ent.User, metric Metric, amount int64) (bool, UsageStatus, error) {
+	status, err := c.Status(ctx, u, metric)
+	if err != nil {
+		return false, status, err
+	}
+	return c.config.Usage.Overage || status.Fits(amount), status, nil
+}
+
+// Record adds the given amount to a user's usage of a metric during their current usage period, using the given
+// client so usage can be recorded in the same transaction as what is being used.
+// The usage after recording is returned along with the threshold which was crossed, if any, so users can be
+// warned once when they get close to or reach a limit.
+func (c *UsageClient) Record(ctx context.Context, client *ent.Client, u *ent.User, metric Metric, amount int64) (UsageStatus, UsageThreshold, error) {
+	return c.record(ctx, client, u, metric, amount, false)
+}
+
+// Consume records usage like Record, but returns ErrUsageLimit instead of going over the limit of the user's
+// plan, unless overage is enabled. The limit is checked by the same statement which records the usage, so
+// concurrent requests can't go over it together.
+func (c *UsageClient) Consume(ctx context.Context, client *ent.Client, u *ent.User, metric Metric, amount int64) (UsageStatus, UsageThreshold, error) {
+	return c.record(ctx, client, u, metric, amount, !c.config.Usage.Overage)
+}
+
+// record adds usage, enforcing the limit of the plan if asked to.
+func (c *UsageClient) record(ctx context.Context, client *ent.Client, u *ent.User, metric Metric, amount int64, enforce bool) (UsageStatus, UsageThreshold, error) {
+	plan, err := c.entitlements.PlanFor(ctx, u)
+	if err != nil {
+		return UsageStatus{}, ThresholdNone, err
+	}
+
+	start, end, err := c.Period(ctx, u, time.Now())
+	if err != nil {
+		return UsageStatus{}, ThresholdNone, err
+	}
+
+	limit := PlanLimit(plan, metric)
+	enforce = enforce && limit >= 0
+
+	// Create the row of the period if this is the first usage recorded in it. The unique index on the user and
+	// period makes concurrent requests wait for each other rather than fail.
+	err = client.Usage.Create().
+		SetUserID(u.ID).
+		SetPeriodStart(start).
+		SetPeriodEnd(end).
+		OnConflictColumns(usage.FieldUserID, usage.FieldPeriodStart).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return UsageStatus{}, ThresholdNone, err
+	}
+
+	inPeriod := []predicate.Usage{usage.UserID(u.ID), usage.PeriodStart(start)}
+	update := client.Usage.Update().Where(inPeriod...)
+	switch metric {
+	case MetricResponses:
+		update.AddResponses(amount)
+		if enforce {
+			update.Where(usage.ResponsesLTE(limit - amount))
+		}
+	case MetricStorage:
+		update.AddStorageBytes(amount)
+		if enforce {
+			update.Where(usage.StorageBytesLTE(limit - amount))
+		}
+	case MetricForms:
+		update.AddForms(amount)
+		if enforce {
+			// Forms are limited by how many the user owns rather than by a counter, so they are checked one
+			// request at a time. MySQL locks the usage row for that, while SQLite serializes writes and already
+			// holds its lock once the forms being recorded are created, which they must be.
+			if c.config.Database.Driver == dialect.MySQL {
+				if _, err := client.Usage.Query().Where(inPeriod...).ForUpdate().Only(ctx); err != nil {
+					return UsageStatus{}, ThresholdNone, err
+				}
+			}
+			owned, err := c.used(ctx, client, u, metric, nil)
+			if err != nil {
+				return UsageStatus{}, ThresholdNone, err
+			}
+			if owned > limit {
+				return c.status(plan, metric, limit), ThresholdNone, ErrUsageLimit
+			}
+		}
+	default:
+		return UsageStatus{}, ThresholdNone, fmt.Errorf("unknown metric: %s", metric)
+	}
+
+	// Nothing is changed when no usage is added, which MySQL does not count as an affected row.
+	if amount != 0 {
+		updated, err := update.Save(ctx)
+		switch {
+		case err != nil:
+			return UsageStatus{}, ThresholdNone, err
+		case updated == 0:
+			return c.status(plan, metric, limit), ThresholdNone, ErrUsageLimit
+		}
+	}
+
+	row, err := client.Usage.Query().Where(inPeriod...).Only(ctx)
+	if err != nil {
+		return UsageStatus{}, ThresholdNone, err
+	}
+
+	used, err := c.used(ctx, client, u, metric, row)
+	if err != nil {
+		return UsageStatus{}, ThresholdNone, err
+	}
+
+	after := c.status(plan, metric, used)
+	before := c.status(plan, metric, used-amount)
+	if after.Threshold > before.Threshold {
+		return after, after.Threshold, nil
+	}
+	return after, ThresholdNone, nil
+}
+
+// Report returns a user's usage of every metric during their current usage period.
+func (c *UsageClient) Report(ctx context.Context, u *ent.User) (UsageReport, error) {
+	plan, err := c.entitlements.PlanFor(ctx, u)
+	if err != nil {
+		return UsageReport{}, err
+	}
+
+	start, end, err := c.Period(ctx, u, time.Now())
+	if err != nil {
+		return UsageReport{}, err
+	}
+
+	row, err := c.orm.Usage.Query().
+		Where(usage.UserID(u.ID), usage.PeriodStart(start)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return UsageReport{}, err
+	}
+
+	report := UsageReport{
+		Plan:        plan,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Overage:     c.config.Usage.Overage,
+	}
+	if row != nil {
+		report.FormsCreated = row.Forms
+	}
+
+	for _, metric := range Metrics {
+		used, err := c.used(ctx, c.orm, u, metric, row)
+		if err != nil {
+			return UsageReport{}, err
+		}
+		report.Metrics = append(report.Metrics, c.status(plan, metric, used))
+	}
+
+	return report, nil
+}
+
+// used returns how much of a metric a user used. The usage row may be nil if nothing was recorded yet.
+func (c *UsageClient) used(ctx context.Context, client *ent.Client, u *ent.User, metric Metric, row *ent.Usage) (int64, error) {
+	if metric == MetricForms {
+		count, err := client.Form.Query().
+			Where(form.UserID(u.ID)).
+			Count(ctx)
+		return int64(count), err
+	}
+
+	if row == nil {
+		return 0, nil
+	}
+
+	switch metric {
+	case MetricResponses:
+		return row.Responses, nil
+	case MetricStorage:
+		return row.StorageBytes, nil
+	default:
+		return 0, fmt.Errorf("unknown metric: %s", metric)
+	}
+}
+
+// status compares how much of a metric was used to the limit of the plan.
+func (c *UsageClient) status(plan config.PlanConfig, metric Metric, used int64) UsageStatus {
+	s := UsageStatus{
+		Metric: metric,
+		Used:   used,
+		Limit:  PlanLimit(plan, metric),
+	}
+
+	switch {
+	case s.Unlimited():
+	case used >= s.Limit:
+		s.Threshold = ThresholdLimit
+	case c.config.Usage.WarnAt > 0 && float64(used) >= c.config.Usage.WarnAt*float64(s.Limit):
+		s.Threshold = ThresholdWarning
+	}
+	return s
+}
+
+// PlanLimit returns the limit a plan puts on a metric, which is negative if the metric is unlimited.
+func PlanLimit(plan config.PlanConfig, metric Metric) int64 {
+	switch metric {
+	case MetricResponses:
+		return int64(plan.Limits.ResponsesPerMonth)
+	case MetricForms:
+		return int64(plan.Limits.Forms)
+	case MetricStorage:
+		if plan.Limits.StorageMB < 0 {
+			return -1
+		}
+		return int64(plan.Limits.StorageMB) << 20
+	default:
+		return -1
+	}
+}
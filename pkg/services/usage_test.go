@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/enttest"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageClient_Period(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// Without a subscription the period is the calendar month.
+	now := time.Date(2025, time.March, 14, 10, 30, 0, 0, time.UTC)
+	start, end, err := c.Usage.Period(context.Background(), u, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), end)
+
+	// With one it follows the billing period.
+	customer, err := c.ORM.PaymentCustomer.Create().
+		SetProviderCustomerID("cus_usage_period").
+		SetEmail(u.Email).
+		SetUser(u).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	periodStart := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2025, time.April, 10, 12, 0, 0, 0, time.UTC)
+	err = createSubscription(t, customer, "price_your_stripe_price_id_here", subscription.StatusActive).
+		Update().
+		SetCurrentPeriodStart(periodStart).
+		SetCurrentPeriodEnd(periodEnd).
+		Exec(context.Background())
+	require.NoError(t, err)
+
+	start, end, err = c.Usage.Period(context.Background(), u, now)
+	require.NoError(t, err)
+	assert.True(t, periodStart.Equal(start))
+	assert.True(t, periodEnd.Equal(end))
+}
+
+func TestUsageClient_Record(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	record := func(amount int64, expected UsageThreshold) UsageStatus {
+		t.Helper()
+		status, crossed, err := c.Usage.Record(context.Background(), c.ORM, u, MetricResponses, amount)
+		require.NoError(t, err)
+		assert.Equal(t, expected, crossed)
+		return status
+	}
+
+	// The free plan allows 100 responses and warns at 80%.
+	status := record(79, ThresholdNone)
+	assert.Equal(t, int64(79), status.Used)
+	assert.Equal(t, int64(100), status.Limit)
+	assert.Equal(t, ThresholdNone, status.Threshold)
+
+	status = record(1, ThresholdWarning)
+	assert.Equal(t, ThresholdWarning, status.Threshold)
+
+	// Thresholds are only reported when they are crossed.
+	record(19, ThresholdNone)
+
+	allowed, status, err := c.Usage.Allows(context.Background(), u, MetricResponses, 1)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, int64(99), status.Used)
+
+	status = record(1, ThresholdLimit)
+	assert.Equal(t, ThresholdLimit, status.Threshold)
+
+	allowed, _, err = c.Usage.Allows(context.Background(), u, MetricResponses, 1)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	// Going over the limit is allowed when overage is enabled.
+	cfg := *c.Config
+	cfg.Usage.Overage = true
+	overage := NewUsageClient(&cfg, c.ORM, c.Entitlements)
+	allowed, _, err = overage.Allows(context.Background(), u, MetricResponses, 1)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	_, _, err = c.Usage.Record(context.Background(), c.ORM, u, Metric("bogus"), 1)
+	assert.Error(t, err)
+}
+
+func TestUsageClient_Consume(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// The free plan allows 100 responses.
+	_, _, err = c.Usage.Record(context.Background(), c.ORM, u, MetricResponses, 99)
+	require.NoError(t, err)
+
+	status, crossed, err := c.Usage.Consume(context.Background(), c.ORM, u, MetricResponses, 1)
+	require.NoError(t, err)
+	assert.Equal(t, ThresholdLimit, crossed)
+	assert.Equal(t, int64(100), status.Used)
+
+	status, _, err = c.Usage.Consume(context.Background(), c.ORM, u, MetricResponses, 1)
+	assert.ErrorIs(t, err, ErrUsageLimit)
+	assert.Equal(t, int64(100), status.Limit)
+
+	report, err := c.Usage.Report(context.Background(), u)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), report.Metrics[0].Used)
+
+	// Going over the limit is allowed when overage is enabled.
+	cfg := *c.Config
+	cfg.Usage.Overage = true
+	overage := NewUsageClient(&cfg, c.ORM, c.Entitlements)
+	status, _, err = overage.Consume(context.Background(), c.ORM, u, MetricResponses, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), status.Used)
+
+	// Forms are limited by how many the user owns, including the ones being recorded.
+	for i := range 4 {
+		_, err = c.ORM.Form.Create().
+			SetTitle("Limited").
+			SetSlug(fmt.Sprintf("limited-%d-%d", u.ID, i)).
+			SetOwner(u).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		_, _, err = c.Usage.Consume(context.Background(), c.ORM, u, MetricForms, 1)
+		if i < 3 {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorIs(t, err, ErrUsageLimit)
+		}
+	}
+}
+
+func TestUsageClient_ConsumeSQLite(t *testing.T) {
+	// SQLite can't lock rows, so the forms limit is checked within the transaction which creates them.
+	orm := enttest.Open(t, dialect.SQLite, fmt.Sprintf("file:usage-%d?mode=memory&cache=shared&_fk=1", time.Now().UnixNano()))
+	defer orm.Close()
+
+	cfg := *c.Config
+	cfg.Database.Driver = dialect.SQLite
+	entitlements, err := NewEntitlementsClient(&cfg, orm)
+	require.NoError(t, err)
+	usage := NewUsageClient(&cfg, orm, entitlements)
+
+	u, err := tests.CreateUser(orm)
+	require.NoError(t, err)
+
+	for i := range 4 {
+		tx, err := orm.Tx(context.Background())
+		require.NoError(t, err)
+		_, err = tx.Form.Create().
+			SetTitle("Limited").
+			SetSlug(fmt.Sprintf("limited-%d", i)).
+			SetOwner(u).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		_, _, err = usage.Consume(context.Background(), tx.Client(), u, MetricForms, 1)
+		if i < 3 {
+			require.NoError(t, err)
+			require.NoError(t, tx.Commit())
+		} else {
+			assert.ErrorIs(t, err, ErrUsageLimit)
+			require.NoError(t, tx.Rollback())
+		}
+	}
+
+	count, err := orm.Form.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestUsageClient_Report(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	_, err = c.ORM.Form.Create().
+		SetTitle("Usage").
+		SetDescription("Usage report").
+		SetSlug("usage-report").
+		SetOwner(u).
+		Save(context.Background())
+	require.NoError(t, err)
+	_, _, err = c.Usage.Record(context.Background(), c.ORM, u, MetricForms, 1)
+	require.NoError(t, err)
+	_, _, err = c.Usage.Record(context.Background(), c.ORM, u, MetricStorage, 2048)
+	require.NoError(t, err)
+
+	report, err := c.Usage.Report(context.Background(), u)
+	require.NoError(t, err)
+	assert.Equal(t, "free", report.Plan.ID)
+	assert.Equal(t, int64(1), report.FormsCreated)
+	assert.False(t, report.Overage)
+	require.Len(t, report.Metrics, len(Metrics))
+
+	byMetric := make(map[Metric]UsageStatus)
+	for _, m := range report.Metrics {
+		byMetric[m.Metric] = m
+	}
+	assert.Equal(t, int64(0), byMetric[MetricResponses].Used)
+	assert.Equal(t, int64(1), byMetric[MetricForms].Used)
+	assert.Equal(t, int64(3), byMetric[MetricForms].Limit)
+	assert.Equal(t, int64(2048), byMetric[MetricStorage].Used)
+	assert.Equal(t, int64(100<<20), byMetric[MetricStorage].Limit)
+}
+
+func TestPlanLimit(t *testing.T) {
+	plan := config.PlanConfig{
+		Limits: config.PlanLimits{
+			Forms:             -1,
+			ResponsesPerMonth: 500,
+			StorageMB:         2,
+		},
+	}
+	assert.Equal(t, int64(-1), PlanLimit(plan, MetricForms))
+	assert.Equal(t, int64(500), PlanLimit(plan, MetricResponses))
+	assert.Equal(t, int64(2<<20), PlanLimit(plan, MetricStorage))
+
+	plan.Limits.StorageMB = -1
+	assert.Equal(t, int64(-1), PlanLimit(plan, MetricStorage))
+}
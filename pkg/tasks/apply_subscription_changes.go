@@ -0,0 +1,25 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// subscriptionChangeLead is how long before the end of a period scheduled changes are applied, so that the
+// renewal is billed at the new price. It must be longer than the interval the job is scheduled at.
+const subscriptionChangeLead = time.Hour
+
+// ApplySubscriptionChanges switches subscriptions to the price they were scheduled to change to as their
+// period is about to end. Subscriptions which fail to change keep their schedule and are retried on the next run.
+func ApplySubscriptionChanges(c *services.Container) func(ctx context.Context, payload map[string]interface{}) error {
+	return func(ctx context.Context, payload map[string]interface{}) error {
+		changed, err := c.Payment.ApplyScheduledChanges(ctx, time.Now().Add(subscriptionChangeLead))
+		if changed > 0 {
+			log.Default().Info("Scheduled subscription changes applied", "count", changed)
+		}
+		return err
+	}
+}
@@ -0,0 +1,47 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplySubscriptionChanges(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	// Use the in-memory payment provider so billing can be tested offline
+	t.Setenv("PAGODA_PAYMENT_PROVIDER", "fake")
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := c.Payment.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	_, err = c.Payment.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_visa", true)
+	require.NoError(t, err)
+	sub, err := c.Payment.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &services.CreateSubscriptionParams{})
+	require.NoError(t, err)
+
+	// Scheduled changes are applied shortly before the end of the period.
+	sub, err = c.ORM.Subscription.UpdateOne(sub).
+		SetPriceID("price_previous").
+		SetPendingPriceID("price_your_stripe_price_id_here").
+		SetPendingChangeAt(time.Now().Add(subscriptionChangeLead / 2)).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	err = ApplySubscriptionChanges(c)(context.Background(), nil)
+	require.NoError(t, err)
+
+	sub, err = c.ORM.Subscription.Get(context.Background(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "price_your_stripe_price_id_here", sub.PriceID)
+	assert.Empty(t, sub.PendingPriceID)
+}
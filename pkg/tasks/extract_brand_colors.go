@@ -16,7 +16,7 @@ import (
 )
 
 type ExtractBrandColorsPayload struct {
-	UserID int `json:"user_id"`
+	UserID int `json:"user_id" validate:"required"`
 }
 
 type brandColorResponse struct {
@@ -25,15 +25,10 @@ type brandColorResponse struct {
 	TextColor       string `json:"text_color"`
 }
 
-func ExtractBrandColors(orm *ent.Client, apiKey string) func(ctx context.Context, payload map[string]interface{}) error {
-	return func(ctx context.Context, payload map[string]interface{}) error {
-		userID, ok := payload["user_id"].(float64)
-		if !ok {
-			return fmt.Errorf("invalid user_id in payload")
-		}
-
+func ExtractBrandColors(orm *ent.Client, apiKey string) func(ctx context.Context, payload ExtractBrandColorsPayload) error {
+	return func(ctx context.Context, payload ExtractBrandColorsPayload) error {
 		u, err := orm.User.Query().
-			Where(user.ID(int(userID))).
+			Where(user.ID(payload.UserID)).
 			Only(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to get user: %w", err)
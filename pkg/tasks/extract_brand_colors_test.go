@@ -28,8 +28,8 @@ func TestExtractBrandColors_NoWebsiteURL(t *testing.T) {
 	u, err := tests.CreateUser(c.ORM)
 	require.NoError(t, err)
 
-	payload := map[string]interface{}{
-		"user_id": float64(u.ID),
+	payload := ExtractBrandColorsPayload{
+		UserID: u.ID,
 	}
 
 	handler := ExtractBrandColors(c.ORM, c.Config.OpenAI.ApiKey)
@@ -45,14 +45,16 @@ func TestExtractBrandColors_InvalidPayload(t *testing.T) {
 
 	ctx := context.Background()
 
-	payload := map[string]interface{}{
-		"user_id": "invalid",
-	}
+	handler := services.TypedHandler(c.Validator, ExtractBrandColors(c.ORM, c.Config.OpenAI.ApiKey))
 
-	handler := ExtractBrandColors(c.ORM, c.Config.OpenAI.ApiKey)
-	err := handler(ctx, payload)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "invalid user_id")
+	for _, payload := range []map[string]interface{}{
+		{"user_id": "invalid"},
+		{},
+	} {
+		err := handler(ctx, payload)
+		assert.ErrorIs(t, err, services.ErrInvalidPayload)
+		assert.True(t, services.IsPermanent(err))
+	}
 }
 
 func TestExtractBrandColorsPayload_Marshal(t *testing.T) {
@@ -0,0 +1,57 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui/emails"
+)
+
+// NotifyJobAlert emails an alert about a failing queue to every admin user.
+// The alert is enqueued by services.QueueAlertHook. Failures to email an admin are logged rather than
+// returned, so a retry doesn't alert the admins who were already emailed again.
+func NotifyJobAlert(c *services.Container) func(ctx context.Context, alert services.JobAlert) error {
+	return func(ctx context.Context, alert services.JobAlert) error {
+		admins, err := c.ORM.User.Query().
+			Where(user.Admin(true)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load admins: %w", err)
+		}
+
+		summary := fmt.Sprintf(
+			"%.0f%% of the %s jobs which finished since %s failed (%d failed, %d completed).",
+			alert.FailureRate*100,
+			alert.Queue,
+			alert.Since.Format(time.RFC1123),
+			alert.Failed,
+			alert.Completed,
+		)
+		failedJobsURL := fmt.Sprintf(
+			"%s%s?queue=%s",
+			c.Config.App.Host,
+			c.Web.Reverse(routenames.AdminJobsDead),
+			url.QueryEscape(alert.Queue),
+		)
+
+		for _, admin := range admins {
+			err := c.Mail.
+				Compose().
+				To(admin.Email).
+				Subject(fmt.Sprintf("Jobs in the %s queue are failing", alert.Queue)).
+				Component(emails.JobAlert(summary, alert.Error, failedJobsURL)).
+				Send(nil)
+			if err != nil {
+				log.Default().Error("Failed to email job alert", "admin_id", admin.ID, "error", err)
+			}
+		}
+
+		return nil
+	}
+}
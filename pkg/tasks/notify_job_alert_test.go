@@ -0,0 +1,35 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyJobAlert(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	_, err = c.ORM.User.UpdateOne(u).SetAdmin(true).Save(ctx)
+	require.NoError(t, err)
+
+	notify := NotifyJobAlert(c)
+	assert.NoError(t, notify(ctx, services.JobAlert{
+		Queue:       "mail_queue",
+		FailureRate: 1,
+		Failed:      10,
+		Since:       time.Now().Add(-time.Hour),
+		Error:       "boom",
+	}))
+}
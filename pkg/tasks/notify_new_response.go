@@ -0,0 +1,51 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui/emails"
+)
+
+// NotifyNewResponsePayload is the payload of the notify_new_response job.
+type NotifyNewResponsePayload struct {
+	ResponseID int `json:"response_id" validate:"required"`
+}
+
+// NotifyNewResponse emails the owner of a form about a response that was submitted to it.
+// Nothing is sent if the response was deleted or notifications were turned off in the meantime.
+func NotifyNewResponse(c *services.Container) func(ctx context.Context, payload NotifyNewResponsePayload) error {
+	return func(ctx context.Context, payload NotifyNewResponsePayload) error {
+		r, err := c.ORM.Response.Query().
+			Where(response.ID(payload.ResponseID)).
+			WithForm(func(q *ent.FormQuery) {
+				q.WithOwner()
+			}).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to load response: %w", err)
+		}
+
+		f := r.Edges.Form
+		if !f.NotifyOnResponse {
+			return nil
+		}
+
+		owner := f.Edges.Owner
+		url := c.Config.App.Host + c.Web.Reverse(routenames.FormsResponsesShow, f.ID, r.ID)
+
+		return c.Mail.
+			Compose().
+			To(owner.Email).
+			Subject(fmt.Sprintf("New response to %s", f.Title)).
+			Component(emails.NewResponse(owner.Name, f.Title, url)).
+			Send(nil)
+	}
+}
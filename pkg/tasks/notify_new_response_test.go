@@ -0,0 +1,48 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyNewResponse(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	f, err := c.ORM.Form.Create().
+		SetTitle("Feedback").
+		SetSlug("feedback").
+		SetOwner(u).
+		SetNotifyOnResponse(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	r, err := c.ORM.Response.Create().
+		SetFormID(f.ID).
+		SetCompleted(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	notify := NotifyNewResponse(c)
+	assert.NoError(t, notify(ctx, NotifyNewResponsePayload{ResponseID: r.ID}))
+
+	// Responses deleted before the job runs are skipped.
+	assert.NoError(t, notify(ctx, NotifyNewResponsePayload{ResponseID: r.ID + 1000}))
+
+	// An invalid payload fails without being retried.
+	err = services.TypedHandler(c.Validator, notify)(ctx, map[string]interface{}{})
+	assert.ErrorIs(t, err, services.ErrInvalidPayload)
+	assert.True(t, services.IsPermanent(err))
+}
@@ -0,0 +1,70 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui/emails"
+)
+
+// NotifyUsagePayload is the payload of the notify_usage job.
+type NotifyUsagePayload struct {
+	UserID    int    `json:"user_id" validate:"required"`
+	Metric    string `json:"metric" validate:"required,oneof=responses forms storage"`
+	Threshold string `json:"threshold" validate:"required,oneof=warning limit"`
+}
+
+// usageNames are how metrics are referred to in emails.
+var usageNames = map[services.Metric]string{
+	services.MetricResponses: "responses this month",
+	services.MetricForms:     "forms",
+	services.MetricStorage:   "storage this month",
+}
+
+// NotifyUsage emails a user who got close to or reached a limit of their plan.
+// Nothing is sent if the user was deleted in the meantime.
+func NotifyUsage(c *services.Container) func(ctx context.Context, payload NotifyUsagePayload) error {
+	return func(ctx context.Context, payload NotifyUsagePayload) error {
+		u, err := c.ORM.User.Get(ctx, payload.UserID)
+		switch {
+		case ent.IsNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to load user: %w", err)
+		}
+
+		metric := services.Metric(payload.Metric)
+		var subject, summary string
+		if payload.Threshold == services.ThresholdLimit.String() {
+			subject = fmt.Sprintf("You reached your plan's limit of %s", usageNames[metric])
+			summary = fmt.Sprintf("You used all of the %s included in your plan.", usageNames[metric])
+			if c.Config.Usage.Overage {
+				summary += " Anything beyond the limit is counted as overage."
+			} else {
+				summary += " Nothing more can be added until your usage resets or you upgrade."
+			}
+		} else {
+			subject = fmt.Sprintf("You are close to your plan's limit of %s", usageNames[metric])
+			summary = fmt.Sprintf(
+				"You used %.0f%% of the %s included in your plan.",
+				c.Config.Usage.WarnAt*100,
+				usageNames[metric],
+			)
+		}
+
+		return c.Mail.
+			Compose().
+			To(u.Email).
+			Subject(subject).
+			Component(emails.UsageWarning(
+				u.Name,
+				summary,
+				c.Config.App.Host+c.Web.Reverse(routenames.Usage),
+				c.Config.App.Host+c.Web.Reverse(routenames.Plans),
+			)).
+			Send(nil)
+	}
+}
@@ -0,0 +1,39 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyUsage(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	notify := NotifyUsage(c)
+	assert.NoError(t, notify(ctx, NotifyUsagePayload{UserID: u.ID, Metric: "responses", Threshold: "warning"}))
+	assert.NoError(t, notify(ctx, NotifyUsagePayload{UserID: u.ID, Metric: "storage", Threshold: "limit"}))
+
+	// Users deleted before the job runs are skipped.
+	assert.NoError(t, notify(ctx, NotifyUsagePayload{UserID: u.ID + 1000, Metric: "forms", Threshold: "limit"}))
+
+	// Unknown metrics fail without being retried.
+	err = services.TypedHandler(c.Validator, notify)(ctx, map[string]interface{}{
+		"user_id":   u.ID,
+		"metric":    "bandwidth",
+		"threshold": "warning",
+	})
+	assert.ErrorIs(t, err, services.ErrInvalidPayload)
+	assert.True(t, services.IsPermanent(err))
+}
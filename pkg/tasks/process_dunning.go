@@ -0,0 +1,103 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui/emails"
+)
+
+// NotifyDunningPayload is the payload of the notify_dunning job.
+type NotifyDunningPayload struct {
+	SubscriptionID int    `json:"subscription_id" validate:"required"`
+	Stage          string `json:"stage" validate:"required,oneof=reminder downgrade"`
+}
+
+// ProcessDunning sends reminders to users whose subscription payments fail and downgrades them to the free
+// plan once the grace period ends. Emails are sent by notify_dunning jobs enqueued along with each step.
+func ProcessDunning(c *services.Container) func(ctx context.Context, payload map[string]interface{}) error {
+	return func(ctx context.Context, payload map[string]interface{}) error {
+		notify := func(ctx context.Context, tx *ent.Tx, sub *ent.Subscription, stage services.DunningStage) error {
+			return c.Jobs.EnqueueTxJSON(ctx, tx, "notify_dunning", NotifyDunningPayload{
+				SubscriptionID: sub.ID,
+				Stage:          string(stage),
+			})
+		}
+
+		taken, err := c.Payment.ProcessDunning(ctx, time.Now(), notify)
+		if taken > 0 {
+			log.Default().Info("Dunning steps taken", "count", taken)
+		}
+		return err
+	}
+}
+
+// NotifyDunning emails the owner of a subscription whose payments fail about a dunning step.
+// Reminders are not sent if the payment succeeded or the subscription was deleted in the meantime.
+func NotifyDunning(c *services.Container) func(ctx context.Context, payload NotifyDunningPayload) error {
+	return func(ctx context.Context, payload NotifyDunningPayload) error {
+		sub, err := c.ORM.Subscription.Get(ctx, payload.SubscriptionID)
+		switch {
+		case ent.IsNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to load subscription: %w", err)
+		}
+
+		u, err := sub.QueryCustomer().QueryUser().Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to load user: %w", err)
+		}
+
+		planName := "paid"
+		if p, _, ok := c.Entitlements.PlanForPrice(sub.PriceID); ok {
+			planName = p.Name
+		}
+
+		if services.DunningStage(payload.Stage) == services.DunningDowngrade {
+			return c.Mail.
+				Compose().
+				To(u.Email).
+				Subject(fmt.Sprintf("Your %s subscription was canceled", planName)).
+				Component(emails.SubscriptionDowngraded(
+					u.Name,
+					fmt.Sprintf(
+						"We could not collect the payment for your %s subscription, so it was canceled and your account moved to the %s plan.",
+						planName,
+						c.Entitlements.FreePlan().Name,
+					),
+					c.Config.App.Host+c.Web.Reverse(routenames.Plans),
+				)).
+				Send(nil)
+		}
+
+		if sub.Status != subscription.StatusPastDue && sub.Status != subscription.StatusUnpaid {
+			return nil
+		}
+
+		return c.Mail.
+			Compose().
+			To(u.Email).
+			Subject(fmt.Sprintf("Payment for your %s subscription failed", planName)).
+			Component(emails.PaymentReminder(
+				u.Name,
+				fmt.Sprintf(
+					"We could not collect the payment for your %s subscription. Please update your payment method before %s, otherwise your account will move to the %s plan.",
+					planName,
+					c.Payment.GracePeriodEnd(sub).Format("Jan 2, 2006"),
+					c.Entitlements.FreePlan().Name,
+				),
+				c.Config.App.Host+c.Web.Reverse(routenames.Billing),
+			)).
+			Send(nil)
+	}
+}
@@ -0,0 +1,75 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessDunning(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	// Use the in-memory payment provider so billing can be tested offline
+	t.Setenv("PAGODA_PAYMENT_PROVIDER", "fake")
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := c.Payment.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	_, err = c.Payment.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_visa", true)
+	require.NoError(t, err)
+	sub, err := c.Payment.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &services.CreateSubscriptionParams{})
+	require.NoError(t, err)
+
+	// A reminder is emailed while the subscription is within its grace period.
+	sub, err = c.ORM.Subscription.UpdateOne(sub).
+		SetStatus(subscription.StatusPastDue).
+		SetPastDueAt(time.Now().Add(-time.Hour)).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	err = ProcessDunning(c)(context.Background(), nil)
+	require.NoError(t, err)
+
+	sub, err = c.ORM.Subscription.Get(context.Background(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sub.DunningReminders)
+	assert.Equal(t, 1, c.ORM.Job.Query().Where(job.QueueEQ("notify_dunning")).CountX(context.Background()))
+
+	notify := NotifyDunning(c)
+	assert.NoError(t, notify(context.Background(), NotifyDunningPayload{SubscriptionID: sub.ID, Stage: "reminder"}))
+
+	// The subscription is canceled once the grace period ends.
+	sub, err = c.ORM.Subscription.UpdateOne(sub).
+		SetPastDueAt(time.Now().Add(-c.Config.Payment.Dunning.GracePeriod)).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	err = ProcessDunning(c)(context.Background(), nil)
+	require.NoError(t, err)
+
+	sub, err = c.ORM.Subscription.Get(context.Background(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusCanceled, sub.Status)
+	assert.Equal(t, 2, c.ORM.Job.Query().Where(job.QueueEQ("notify_dunning")).CountX(context.Background()))
+
+	plan, err := c.Entitlements.PlanFor(context.Background(), u)
+	require.NoError(t, err)
+	assert.Equal(t, c.Config.Payment.FreePlan, plan.ID)
+
+	assert.NoError(t, notify(context.Background(), NotifyDunningPayload{SubscriptionID: sub.ID, Stage: "downgrade"}))
+
+	// Reminders for subscriptions which are no longer past due or were deleted are skipped.
+	assert.NoError(t, notify(context.Background(), NotifyDunningPayload{SubscriptionID: sub.ID, Stage: "reminder"}))
+	assert.NoError(t, notify(context.Background(), NotifyDunningPayload{SubscriptionID: sub.ID + 1000, Stage: "reminder"}))
+}
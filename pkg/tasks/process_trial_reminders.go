@@ -0,0 +1,83 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui/emails"
+)
+
+// NotifyTrialEndingPayload is the payload of the notify_trial_ending job.
+type NotifyTrialEndingPayload struct {
+	SubscriptionID int `json:"subscription_id" validate:"required"`
+}
+
+// ProcessTrialReminders reminds users whose free trial ends soon that they will be charged. Emails are sent by
+// notify_trial_ending jobs enqueued along with each reminder.
+func ProcessTrialReminders(c *services.Container) func(ctx context.Context, payload map[string]interface{}) error {
+	return func(ctx context.Context, payload map[string]interface{}) error {
+		notify := func(ctx context.Context, tx *ent.Tx, sub *ent.Subscription) error {
+			return c.Jobs.EnqueueTxJSON(ctx, tx, "notify_trial_ending", NotifyTrialEndingPayload{
+				SubscriptionID: sub.ID,
+			})
+		}
+
+		reminded, err := c.Payment.ProcessTrialReminders(ctx, time.Now(), notify)
+		if reminded > 0 {
+			log.Default().Info("Trial reminders sent", "count", reminded)
+		}
+		return err
+	}
+}
+
+// NotifyTrialEnding emails the owner of a subscription that its free trial ends soon.
+// Reminders are not sent if the trial ended, was canceled or the subscription was deleted in the meantime.
+func NotifyTrialEnding(c *services.Container) func(ctx context.Context, payload NotifyTrialEndingPayload) error {
+	return func(ctx context.Context, payload NotifyTrialEndingPayload) error {
+		sub, err := c.ORM.Subscription.Get(ctx, payload.SubscriptionID)
+		switch {
+		case ent.IsNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to load subscription: %w", err)
+		}
+
+		if sub.Status != subscription.StatusTrialing || sub.CancelAtPeriodEnd {
+			return nil
+		}
+
+		u, err := sub.QueryCustomer().QueryUser().Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to load user: %w", err)
+		}
+
+		planName := "paid"
+		if p, _, ok := c.Entitlements.PlanForPrice(sub.PriceID); ok {
+			planName = p.Name
+		}
+
+		return c.Mail.
+			Compose().
+			To(u.Email).
+			Subject(fmt.Sprintf("Your %s trial ends soon", planName)).
+			Component(emails.TrialEnding(
+				u.Name,
+				fmt.Sprintf(
+					"Your free trial of the %s plan ends on %s. Your subscription will then start and your payment method will be charged, unless you cancel it before.",
+					planName,
+					sub.TrialEnd.Format("Jan 2, 2006"),
+				),
+				c.Config.App.Host+c.Web.Reverse(routenames.Billing),
+			)).
+			Send(nil)
+	}
+}
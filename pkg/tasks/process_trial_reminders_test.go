@@ -0,0 +1,66 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/job"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessTrialReminders(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	// Use the in-memory payment provider so billing can be tested offline
+	t.Setenv("PAGODA_PAYMENT_PROVIDER", "fake")
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	customer, err := c.Payment.CreateOrGetCustomer(ctx, u)
+	require.NoError(t, err)
+	_, err = c.Payment.AttachPaymentMethodToCustomer(ctx, customer, "pm_card_visa", true)
+	require.NoError(t, err)
+
+	// Trials which end after the reminder period are not reminded yet.
+	sub, err := c.Payment.CreateSubscription(ctx, customer, "price_your_stripe_price_id_here", &services.CreateSubscriptionParams{
+		TrialPeriodDays: 14,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusTrialing, sub.Status)
+
+	err = ProcessTrialReminders(c)(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Zero(t, c.ORM.Job.Query().Where(job.QueueEQ("notify_trial_ending")).CountX(context.Background()))
+
+	// The reminder is sent once when the trial is about to end.
+	sub, err = c.ORM.Subscription.UpdateOne(sub).
+		SetTrialEnd(sub.TrialStart.Add(c.Config.Payment.Trials.Reminder / 2)).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	for range 2 {
+		err = ProcessTrialReminders(c)(context.Background(), nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, c.ORM.Job.Query().Where(job.QueueEQ("notify_trial_ending")).CountX(context.Background()))
+
+	sub, err = c.ORM.Subscription.Get(context.Background(), sub.ID)
+	require.NoError(t, err)
+	assert.False(t, sub.TrialRemindedAt.IsZero())
+
+	notify := NotifyTrialEnding(c)
+	assert.NoError(t, notify(context.Background(), NotifyTrialEndingPayload{SubscriptionID: sub.ID}))
+
+	// Reminders for trials which were canceled or deleted are skipped.
+	_, err = c.ORM.Subscription.UpdateOne(sub).SetCancelAtPeriodEnd(true).Save(context.Background())
+	require.NoError(t, err)
+	assert.NoError(t, notify(context.Background(), NotifyTrialEndingPayload{SubscriptionID: sub.ID}))
+	assert.NoError(t, notify(context.Background(), NotifyTrialEndingPayload{SubscriptionID: sub.ID + 1000}))
+}
@@ -0,0 +1,30 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/pkg/log"
+)
+
+// PurgeExpiredPasswordTokens deletes password reset tokens that are older than the given expiration
+// since they can no longer be used.
+func PurgeExpiredPasswordTokens(orm *ent.Client, expiration time.Duration) func(ctx context.Context, payload map[string]interface{}) error {
+	return func(ctx context.Context, payload map[string]interface{}) error {
+		deleted, err := orm.PasswordToken.Delete().
+			Where(passwordtoken.CreatedAtLT(time.Now().Add(-expiration))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge password tokens: %w", err)
+		}
+
+		if deleted > 0 {
+			log.Default().Info("Expired password tokens purged", "count", deleted)
+		}
+
+		return nil
+	}
+}
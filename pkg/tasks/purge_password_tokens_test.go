@@ -0,0 +1,49 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeExpiredPasswordTokens(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	expired, err := c.ORM.PasswordToken.Create().
+		SetToken("expired-token").
+		SetUserID(u.ID).
+		SetCreatedAt(time.Now().Add(-2 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	valid, err := c.ORM.PasswordToken.Create().
+		SetToken("valid-token").
+		SetUserID(u.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	err = PurgeExpiredPasswordTokens(c.ORM, time.Hour)(ctx, nil)
+	require.NoError(t, err)
+
+	exists, err := c.ORM.PasswordToken.Query().Where(passwordtoken.ID(expired.ID)).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	exists, err = c.ORM.PasswordToken.Query().Where(passwordtoken.ID(valid.ID)).Exist(ctx)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/form"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/pkg/log"
+)
+
+// PurgeExpiredResponses deletes responses that are older than the retention period configured on their form.
+// Forms with a retention period of zero keep their responses forever.
+func PurgeExpiredResponses(orm *ent.Client) func(ctx context.Context, payload map[string]interface{}) error {
+	return func(ctx context.Context, payload map[string]interface{}) error {
+		forms, err := orm.Form.Query().
+			Where(form.RetentionDaysGT(0)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get forms: %w", err)
+		}
+
+		for _, f := range forms {
+			cutoff := time.Now().AddDate(0, 0, -f.RetentionDays)
+
+			deleted, err := orm.Response.Delete().
+				Where(
+					response.HasFormWith(form.ID(f.ID)),
+					response.SubmittedAtLT(cutoff),
+				).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to purge responses for form %d: %w", f.ID, err)
+			}
+
+			if deleted > 0 {
+				log.Default().Info("Expired responses purged", "form_id", f.ID, "count", deleted)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/response"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeExpiredResponses(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	retained, err := c.ORM.Form.Create().
+		SetTitle("Retained").
+		SetSlug("retained").
+		SetRetentionDays(30).
+		SetOwner(u).
+		Save(ctx)
+	require.NoError(t, err)
+
+	forever, err := c.ORM.Form.Create().
+		SetTitle("Forever").
+		SetSlug("forever").
+		SetOwner(u).
+		Save(ctx)
+	require.NoError(t, err)
+
+	expired, err := c.ORM.Response.Create().
+		SetFormID(retained.ID).
+		SetSubmittedAt(time.Now().AddDate(0, 0, -31)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	recent, err := c.ORM.Response.Create().
+		SetFormID(retained.ID).
+		SetSubmittedAt(time.Now().AddDate(0, 0, -29)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	old, err := c.ORM.Response.Create().
+		SetFormID(forever.ID).
+		SetSubmittedAt(time.Now().AddDate(-2, 0, 0)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	err = PurgeExpiredResponses(c.ORM)(ctx, nil)
+	require.NoError(t, err)
+
+	remaining, err := c.ORM.Response.Query().
+		Where(response.IDIn(expired.ID, recent.ID, old.ID)).
+		IDs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{recent.ID, old.ID}, remaining)
+}
@@ -1,6 +1,9 @@
 package tasks
 
 import (
+	"errors"
+	"time"
+
 	"github.com/occult/pagode/pkg/services"
 )
 
@@ -9,7 +12,33 @@ func Register(c *services.Container) {
 	c.Tasks.Register(NewExampleTaskQueue(c))
 }
 
-// RegisterJobs registers all job handlers with the job worker.
-func RegisterJobs(c *services.Container) {
-	c.Jobs.Register("extract_brand_colors", ExtractBrandColors(c.ORM, c.Config.OpenAI.ApiKey))
+// RegisterJobs registers all job handlers and recurring job schedules with the job worker.
+func RegisterJobs(c *services.Container) error {
+	services.Register(c.Jobs, "extract_brand_colors", ExtractBrandColors(c.ORM, c.Config.OpenAI.ApiKey))
+	// OpenAI rate limits are per minute, so back off for longer than the default.
+	c.Jobs.SetRetryPolicy("extract_brand_colors", services.RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     30 * time.Second,
+		MaxBackoff:  30 * time.Minute,
+	})
+	c.Jobs.Register("purge_expired_responses", PurgeExpiredResponses(c.ORM))
+	c.Jobs.Register("purge_expired_password_tokens", PurgeExpiredPasswordTokens(c.ORM, c.Config.App.PasswordToken.Expiration))
+	c.Jobs.Register("send_scheduled_reports", SendScheduledReports(c))
+	services.Register(c.Jobs, "notify_new_response", NotifyNewResponse(c))
+	services.Register(c.Jobs, "notify_usage", NotifyUsage(c))
+	c.Jobs.Register("apply_subscription_changes", ApplySubscriptionChanges(c))
+	c.Jobs.Register("process_dunning", ProcessDunning(c))
+	services.Register(c.Jobs, "notify_dunning", NotifyDunning(c))
+	c.Jobs.Register("process_trial_reminders", ProcessTrialReminders(c))
+	services.Register(c.Jobs, "notify_trial_ending", NotifyTrialEnding(c))
+	services.Register(c.Jobs, "notify_job_alert", NotifyJobAlert(c))
+
+	return errors.Join(
+		c.Jobs.Schedule("purge_expired_responses", "@hourly"),
+		c.Jobs.Schedule("purge_expired_password_tokens", "30 3 * * *"),
+		c.Jobs.Schedule("send_scheduled_reports", "5 * * * *"),
+		c.Jobs.Schedule("apply_subscription_changes", "*/15 * * * *"),
+		c.Jobs.Schedule("process_dunning", "@hourly"),
+		c.Jobs.Schedule("process_trial_reminders", "@hourly"),
+	)
 }
@@ -0,0 +1,108 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/reports"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui/emails"
+)
+
+// SendScheduledReports emails every report subscription whose most recent period has not been reported yet.
+// This is safe to run as often as needed since each period is claimed before it is sent.
+func SendScheduledReports(c *services.Container) func(ctx context.Context, payload map[string]interface{}) error {
+	return func(ctx context.Context, payload map[string]interface{}) error {
+		subs, err := c.ORM.ReportSubscription.Query().
+			WithUser().
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get report subscriptions: %w", err)
+		}
+
+		now := time.Now()
+		var errs []error
+		for _, sub := range subs {
+			if err := sendReport(ctx, c, sub, now); err != nil {
+				errs = append(errs, fmt.Errorf("subscription %d: %w", sub.ID, err))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+func sendReport(ctx context.Context, c *services.Container, sub *ent.ReportSubscription, now time.Time) error {
+	loc, err := time.LoadLocation(sub.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	period := reports.LastCompleted(sub.Frequency, now, loc)
+	start := period.Start.Local()
+	if sub.LastPeriodStart != nil && !sub.LastPeriodStart.Before(start) {
+		return nil
+	}
+
+	// Claim the period first so a restarted or concurrent worker cannot send it again.
+	claimed, err := c.ORM.ReportSubscription.Update().
+		Where(
+			reportsubscription.ID(sub.ID),
+			reportsubscription.Or(
+				reportsubscription.LastPeriodStartIsNil(),
+				reportsubscription.LastPeriodStartLT(start),
+			),
+		).
+		SetLastPeriodStart(start).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to claim report period: %w", err)
+	}
+	if claimed == 0 {
+		return nil
+	}
+
+	if err := deliverReport(ctx, c, sub, period); err != nil {
+		// Release the claim so the report is retried on the next run.
+		release := c.ORM.ReportSubscription.UpdateOneID(sub.ID)
+		if sub.LastPeriodStart == nil {
+			release.ClearLastPeriodStart()
+		} else {
+			release.SetLastPeriodStart(*sub.LastPeriodStart)
+		}
+		if rerr := release.Exec(ctx); rerr != nil {
+			log.Default().Error("Failed to release report period", "subscription_id", sub.ID, "error", rerr)
+		}
+		return err
+	}
+
+	log.Default().Info("Report sent", "subscription_id", sub.ID, "period_start", period.Start)
+	return nil
+}
+
+func deliverReport(ctx context.Context, c *services.Container, sub *ent.ReportSubscription, period reports.Period) error {
+	report, err := reports.Build(ctx, c.ORM, c.Encryption, sub, period)
+	if err != nil {
+		return fmt.Errorf("failed to build report: %w", err)
+	}
+
+	user := sub.Edges.User
+	email := c.Mail.
+		Compose().
+		To(user.Email).
+		Subject(fmt.Sprintf("Your %s response report", sub.Frequency)).
+		Component(emails.ResponseReport(user.Name, c.Config.App.Host, report))
+
+	for _, f := range report.Forms {
+		if len(f.CSV) > 0 {
+			email.Attach(fmt.Sprintf("%s-%s.csv", f.Form.Slug, period.Start.Format("2006-01-02")), f.CSV)
+		}
+	}
+
+	return email.Send(nil)
+}
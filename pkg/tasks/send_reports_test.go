@@ -0,0 +1,130 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/reportsubscription"
+	"github.com/occult/pagode/pkg/reports"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendScheduledReports(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	f, err := c.ORM.Form.Create().
+		SetTitle("Feedback").
+		SetSlug("feedback").
+		SetOwner(u).
+		Save(ctx)
+	require.NoError(t, err)
+
+	sub, err := c.ORM.ReportSubscription.Create().
+		SetUserID(u.ID).
+		SetFormID(f.ID).
+		SetFrequency(reportsubscription.FrequencyDaily).
+		SetTimezone("Europe/Berlin").
+		Save(ctx)
+	require.NoError(t, err)
+
+	run := SendScheduledReports(c)
+	require.NoError(t, run(ctx, nil))
+
+	sub, err = c.ORM.ReportSubscription.Get(ctx, sub.ID)
+	require.NoError(t, err)
+	require.NotNil(t, sub.LastPeriodStart)
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	expected := reports.LastCompleted(reportsubscription.FrequencyDaily, time.Now(), loc)
+	assert.True(t, expected.Start.Equal(*sub.LastPeriodStart))
+
+	// A second run within the same period must not claim it again.
+	claimed := *sub.LastPeriodStart
+	require.NoError(t, run(ctx, nil))
+
+	sub, err = c.ORM.ReportSubscription.Get(ctx, sub.ID)
+	require.NoError(t, err)
+	assert.True(t, claimed.Equal(*sub.LastPeriodStart))
+}
+
+func TestSendScheduledReports_SensitiveAnswers(t *testing.T) {
+	config.SwitchEnvironment(config.EnvTest)
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	ctx := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	f, err := c.ORM.Form.Create().
+		SetTitle("Intake").
+		SetSlug("intake").
+		SetOwner(u).
+		Save(ctx)
+	require.NoError(t, err)
+
+	name, err := c.ORM.Question.Create().
+		SetType("text").
+		SetTitle("Name").
+		SetOrder(0).
+		SetFormID(f.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	diagnosis, err := c.ORM.Question.Create().
+		SetType("radio").
+		SetTitle("Diagnosis").
+		SetSensitive(true).
+		SetOrder(1).
+		SetFormID(f.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	resp, err := c.ORM.Response.Create().
+		SetFormID(f.ID).
+		SetCompleted(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	encrypted, err := c.Encryption.Encrypt("Asthma")
+	require.NoError(t, err)
+	_, err = c.ORM.Answer.Create().SetResponseID(resp.ID).SetQuestionID(name.ID).SetValue("Alice").Save(ctx)
+	require.NoError(t, err)
+	_, err = c.ORM.Answer.Create().SetResponseID(resp.ID).SetQuestionID(diagnosis.ID).SetValue(encrypted).Save(ctx)
+	require.NoError(t, err)
+
+	sub, err := c.ORM.ReportSubscription.Create().
+		SetUserID(u.ID).
+		SetFormID(f.ID).
+		SetFrequency(reportsubscription.FrequencyDaily).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Reports are emailed, so sensitive questions are left out of the attached export and the summary.
+	period := reports.Period{Start: time.Now().Add(-time.Hour), End: time.Now().Add(time.Hour)}
+	report, err := reports.Build(ctx, c.ORM, c.Encryption, sub, period)
+	require.NoError(t, err)
+	require.Len(t, report.Forms, 1)
+
+	csv := string(report.Forms[0].CSV)
+	assert.Contains(t, csv, "Alice")
+	assert.NotContains(t, csv, "Diagnosis")
+	assert.NotContains(t, csv, "Asthma")
+	assert.NotContains(t, csv, encrypted)
+	assert.Empty(t, report.Forms[0].TopAnswers)
+}
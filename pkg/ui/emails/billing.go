@@ -0,0 +1,49 @@
+package emails
+
+import (
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// PaymentReminder asks a user whose subscription payment failed to update their payment method.
+func PaymentReminder(name, summary, billingURL string) Node {
+	return Group{
+		Strong(Textf("Hello %s,", name)),
+		Br(),
+		P(Text(summary)),
+		Br(),
+		P(
+			A(Href(billingURL), Text("Update your payment method")),
+			Text(" to keep your plan."),
+		),
+	}
+}
+
+// SubscriptionDowngraded tells a user their subscription was canceled because its payments kept failing.
+func SubscriptionDowngraded(name, summary, plansURL string) Node {
+	return Group{
+		Strong(Textf("Hello %s,", name)),
+		Br(),
+		P(Text(summary)),
+		Br(),
+		P(
+			Text("You can "),
+			A(Href(plansURL), Text("subscribe again")),
+			Text(" at any time."),
+		),
+	}
+}
+
+// TrialEnding reminds a user their free trial ends soon, after which they will be charged.
+func TrialEnding(name, summary, billingURL string) Node {
+	return Group{
+		Strong(Textf("Hello %s,", name)),
+		Br(),
+		P(Text(summary)),
+		Br(),
+		P(
+			A(Href(billingURL), Text("Manage your subscription")),
+			Text(" to change your plan or payment method."),
+		),
+	}
+}
@@ -0,0 +1,15 @@
+package emails
+
+import (
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// JobAlert tells an admin that the jobs of a queue are failing, linking to the queue's failed jobs.
+func JobAlert(summary, latestError, failedJobsURL string) Node {
+	return Group{
+		P(Text(summary)),
+		If(latestError != "", P(Textf("The latest error was: %s", latestError))),
+		P(A(Href(failedJobsURL), Text("View failed jobs"))),
+	}
+}
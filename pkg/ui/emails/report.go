@@ -0,0 +1,51 @@
+package emails
+
+import (
+	"fmt"
+
+	"github.com/occult/pagode/pkg/reports"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// ResponseReport renders a scheduled summary of the responses received during a report period.
+// The host is used to build absolute links back to each form's responses.
+func ResponseReport(name, host string, r *reports.Report) Node {
+	return Group{
+		Strong(Textf("Hello %s,", name)),
+		Br(),
+		P(Textf(
+			"Here is your response summary for %s to %s. You received %d responses in total.",
+			r.Period.Start.Format("Jan 2, 2006"),
+			r.Period.End.AddDate(0, 0, -1).Format("Jan 2, 2006"),
+			r.TotalResponses(),
+		)),
+		Map(r.Forms, func(f reports.FormSummary) Node {
+			return reportForm(host, f)
+		}),
+		P(Text("A CSV export of the new responses to each form is attached.")),
+	}
+}
+
+func reportForm(host string, f reports.FormSummary) Node {
+	trend := "no responses in the previous period"
+	if pct, ok := f.Trend(); ok {
+		trend = fmt.Sprintf("%+.0f%% versus the previous period", pct)
+	}
+
+	url := fmt.Sprintf("%s/forms/%d/responses", host, f.Form.ID)
+
+	return Div(
+		Style("margin-top: 24px;"),
+		H3(A(Href(url), Text(f.Form.Title))),
+		P(Textf("%d responses (%s)", f.Responses, trend)),
+		Map(f.TopAnswers, func(q reports.QuestionSummary) Node {
+			return Group{
+				P(Strong(Text(q.Question))),
+				Ul(Map(q.Answers, func(a reports.AnswerCount) Node {
+					return Li(Textf("%s: %d", a.Value, a.Count))
+				})),
+			}
+		}),
+	)
+}
@@ -0,0 +1,17 @@
+package emails
+
+import (
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// NewResponse notifies a form owner that a response was submitted.
+func NewResponse(name, formTitle, url string) Node {
+	return Group{
+		Strong(Textf("Hello %s,", name)),
+		Br(),
+		P(Textf("Your form %s received a new response.", formTitle)),
+		Br(),
+		A(Href(url), Text("View the response")),
+	}
+}
@@ -0,0 +1,22 @@
+package emails
+
+import (
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// UsageWarning warns a user that they are close to or reached a limit of their plan.
+func UsageWarning(name, summary, usageURL, plansURL string) Node {
+	return Group{
+		Strong(Textf("Hello %s,", name)),
+		Br(),
+		P(Text(summary)),
+		Br(),
+		P(
+			A(Href(usageURL), Text("View your usage")),
+			Text(" or "),
+			A(Href(plansURL), Text("upgrade your plan")),
+			Text("."),
+		),
+	}
+}
@@ -3,6 +3,7 @@ import AppLayoutTemplate from "@/Layouts/App/AppSidebarLayout";
 import { type BreadcrumbItem } from "@/types";
 import { type ReactNode } from "react";
 import { useFlashToasts } from "@/hooks/useFlashToast";
+import { DunningBanner } from "@/components/DunningBanner";
 import { SharedProps } from "@/types/global";
 import { Toaster } from "sonner";
 
@@ -16,7 +17,7 @@ export default function AppLayout({
   breadcrumbs,
   ...props
 }: AppLayoutProps) {
-  const { flash } = usePage<SharedProps>().props;
+  const { flash, dunning } = usePage<SharedProps>().props;
 
   useFlashToasts(flash);
 
@@ -24,6 +25,7 @@ export default function AppLayout({
     <AppLayoutTemplate breadcrumbs={breadcrumbs} {...props}>
       <Toaster richColors position="top-center" />
       <div className="flex flex-col h-full">
+        {dunning && <DunningBanner dunning={dunning} />}
         {children}
       </div>
     </AppLayoutTemplate>
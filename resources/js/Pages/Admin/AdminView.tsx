@@ -1,4 +1,4 @@
-import { Head, router, useForm } from "@inertiajs/react";
+import { Head, Link, router, useForm } from "@inertiajs/react";
 import AppLayout from "@/Layouts/AppLayout";
 import { BreadcrumbItem, User } from "@/types";
 import { Button } from "@/components/ui/button";
@@ -118,7 +118,12 @@ export default function AdminView({ users, pagination }: Props) {
           <Card className="h-full flex flex-col min-h-0">
             <CardHeader className="flex flex-row items-center justify-between">
               <CardTitle>User</CardTitle>
-              <Button onClick={openAddModal}>Add User</Button>
+              <div className="flex gap-2">
+                <Link href="/admin/jobs">
+                  <Button variant="outline">Jobs</Button>
+                </Link>
+                <Button onClick={openAddModal}>Add User</Button>
+              </div>
             </CardHeader>
 
             <CardContent className="flex flex-col gap-4 h-full min-h-0">
@@ -0,0 +1,190 @@
+import { Head, Link, router } from "@inertiajs/react";
+import AppLayout from "@/Layouts/AppLayout";
+import { BreadcrumbItem } from "@/types";
+import { Button } from "@/components/ui/button";
+import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import { Job, QueueStats } from "@/types/job";
+
+const formatDate = (value?: string) =>
+  value ? new Date(value).toLocaleString() : "—";
+
+type Props = {
+  jobs: Job[];
+  stats: QueueStats[];
+  queue: string;
+  pagination: {
+    total: number;
+    page: number;
+    perPage: number;
+    totalPages: number;
+  };
+};
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Admin",
+    href: "/admin/users",
+  },
+  {
+    title: "Jobs",
+    href: "/admin/jobs",
+  },
+  {
+    title: "Dead Letters",
+    href: "/admin/jobs/dead",
+  },
+];
+
+export default function Dead({ jobs, stats, queue, pagination }: Props) {
+  const filter = (queue: string) => {
+    router.get("/admin/jobs/dead", queue ? { queue } : {}, { preserveState: true });
+  };
+
+  const goToPage = (page: number) => {
+    router.get("/admin/jobs/dead", { ...(queue ? { queue } : {}), page }, { preserveState: true });
+  };
+
+  const retryAll = (queue: string, failed: number) => {
+    if (!confirm(`Retry all ${failed} failed ${queue} jobs?`)) {
+      return;
+    }
+    router.post("/admin/jobs/dead/retry", { queue }, { forceFormData: true });
+  };
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title="Dead Letters" />
+
+      <div className="flex flex-col gap-6 w-full h-full p-6">
+        <Card>
+          <CardHeader>
+            <CardTitle>Failing Queues</CardTitle>
+          </CardHeader>
+          <CardContent>
+            {stats.length > 0 ? (
+              <Table>
+                <TableHeader>
+                  <TableRow>
+                    <TableHead>Queue</TableHead>
+                    <TableHead>Failed</TableHead>
+                    <TableHead>Failed (24h)</TableHead>
+                    <TableHead>Completed (24h)</TableHead>
+                    <TableHead />
+                  </TableRow>
+                </TableHeader>
+                <TableBody>
+                  {stats.map((s) => (
+                    <TableRow key={s.queue}>
+                      <TableCell>
+                        <button className="font-mono underline" onClick={() => filter(s.queue)}>
+                          {s.queue}
+                        </button>
+                      </TableCell>
+                      <TableCell>{s.failed}</TableCell>
+                      <TableCell>{s.recentFailed}</TableCell>
+                      <TableCell>{s.recentCompleted}</TableCell>
+                      <TableCell className="text-right">
+                        <Button variant="outline" size="sm" onClick={() => retryAll(s.queue, s.failed)}>
+                          Retry all
+                        </Button>
+                      </TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+            ) : (
+              <p className="text-muted-foreground">No jobs have failed.</p>
+            )}
+          </CardContent>
+        </Card>
+
+        <Card>
+          <CardHeader className="flex flex-row items-center justify-between">
+            <CardTitle>{queue ? `Failed ${queue} jobs` : "Failed jobs"}</CardTitle>
+            {queue && (
+              <Button variant="outline" onClick={() => filter("")}>
+                All queues
+              </Button>
+            )}
+          </CardHeader>
+          <CardContent className="flex flex-col gap-4">
+            {jobs.length > 0 ? (
+              <Table>
+                <TableHeader>
+                  <TableRow>
+                    <TableHead>ID</TableHead>
+                    <TableHead>Queue</TableHead>
+                    <TableHead>Attempts</TableHead>
+                    <TableHead>Failed at</TableHead>
+                    <TableHead>Error</TableHead>
+                    <TableHead />
+                  </TableRow>
+                </TableHeader>
+                <TableBody>
+                  {jobs.map((job) => (
+                    <TableRow key={job.id}>
+                      <TableCell>
+                        <Link href={`/admin/jobs/${job.id}`} className="underline">
+                          {job.id}
+                        </Link>
+                      </TableCell>
+                      <TableCell className="font-mono">{job.queue}</TableCell>
+                      <TableCell>
+                        {job.attempts}/{job.max_attempts}
+                      </TableCell>
+                      <TableCell>{formatDate(job.processed_at)}</TableCell>
+                      <TableCell className="max-w-md truncate" title={job.error}>
+                        {job.error}
+                      </TableCell>
+                      <TableCell className="text-right">
+                        <Button
+                          variant="outline"
+                          size="sm"
+                          onClick={() => router.post(`/admin/jobs/${job.id}/retry`)}
+                        >
+                          Retry
+                        </Button>
+                      </TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+            ) : (
+              <p className="text-muted-foreground">No failed jobs.</p>
+            )}
+
+            {pagination.totalPages > 1 && (
+              <div className="flex justify-between items-center">
+                <Button
+                  variant="outline"
+                  disabled={pagination.page <= 1}
+                  onClick={() => goToPage(pagination.page - 1)}
+                >
+                  Previous
+                </Button>
+                <span className="text-sm text-muted-foreground">
+                  Page {pagination.page} of {pagination.totalPages}
+                </span>
+                <Button
+                  variant="outline"
+                  disabled={pagination.page >= pagination.totalPages}
+                  onClick={() => goToPage(pagination.page + 1)}
+                >
+                  Next
+                </Button>
+              </div>
+            )}
+          </CardContent>
+        </Card>
+      </div>
+    </AppLayout>
+  );
+}
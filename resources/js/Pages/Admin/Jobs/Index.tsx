@@ -0,0 +1,247 @@
+import { Head, Link, router, useForm } from "@inertiajs/react";
+import AppLayout from "@/Layouts/AppLayout";
+import { BreadcrumbItem } from "@/types";
+import { JobStatusBadge } from "@/components/Admin/JobStatusBadge";
+import { Button } from "@/components/ui/button";
+import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import { Job, JobStatus, QueueStats } from "@/types/job";
+
+const formatDate = (value?: string) =>
+  value ? new Date(value).toLocaleString() : "—";
+
+type Props = {
+  jobs: Job[];
+  stats: QueueStats[];
+  filters: {
+    queue: string;
+    status: string;
+  };
+  pagination: {
+    total: number;
+    page: number;
+    perPage: number;
+    totalPages: number;
+  };
+};
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Admin",
+    href: "/admin/users",
+  },
+  {
+    title: "Jobs",
+    href: "/admin/jobs",
+  },
+];
+
+const jobStatuses: JobStatus[] = ["pending", "processing", "completed", "failed", "cancelled"];
+
+const selectClassName = "px-3 py-2 border border-input rounded-md bg-background text-sm";
+
+export default function Index({ jobs, stats, filters, pagination }: Props) {
+  const purgeForm = useForm({ days: "30" });
+
+  const applyFilters = (changes: Partial<Props["filters"]>) => {
+    const query = { ...filters, ...changes };
+    router.get(
+      "/admin/jobs",
+      Object.fromEntries(Object.entries(query).filter(([, value]) => value !== "")),
+      { preserveState: true },
+    );
+  };
+
+  const goToPage = (page: number) => {
+    router.get("/admin/jobs", { ...filters, page }, { preserveState: true });
+  };
+
+  const handlePurge = (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!confirm(`Delete completed jobs older than ${purgeForm.data.days} days?`)) {
+      return;
+    }
+    purgeForm.post("/admin/jobs/purge", { forceFormData: true });
+  };
+
+  const failureRate = (s: QueueStats) => {
+    const total = s.recentCompleted + s.recentFailed;
+    return total === 0 ? "—" : `${Math.round((s.recentFailed / total) * 100)}%`;
+  };
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title="Jobs" />
+
+      <div className="flex flex-col gap-6 w-full h-full p-6">
+        <Card>
+          <CardHeader className="flex flex-row items-center justify-between">
+            <CardTitle>Queues</CardTitle>
+            <div className="flex gap-2">
+              <Link href="/admin/jobs/dead">
+                <Button variant="outline">Dead Letters</Button>
+              </Link>
+              <Link href="/admin/jobs/schedules">
+                <Button variant="outline">Scheduled Jobs</Button>
+              </Link>
+            </div>
+          </CardHeader>
+          <CardContent>
+            {stats.length > 0 ? (
+              <Table>
+                <TableHeader>
+                  <TableRow>
+                    <TableHead>Queue</TableHead>
+                    <TableHead>Pending</TableHead>
+                    <TableHead>Processing</TableHead>
+                    <TableHead>Failed</TableHead>
+                    <TableHead>Completed (24h)</TableHead>
+                    <TableHead>Failed (24h)</TableHead>
+                    <TableHead>Failure rate (24h)</TableHead>
+                  </TableRow>
+                </TableHeader>
+                <TableBody>
+                  {stats.map((s) => (
+                    <TableRow
+                      key={s.queue}
+                      className="cursor-pointer"
+                      onClick={() => applyFilters({ queue: s.queue })}
+                    >
+                      <TableCell className="font-mono">{s.queue}</TableCell>
+                      <TableCell>{s.pending}</TableCell>
+                      <TableCell>{s.processing}</TableCell>
+                      <TableCell>{s.failed}</TableCell>
+                      <TableCell>{s.recentCompleted}</TableCell>
+                      <TableCell>{s.recentFailed}</TableCell>
+                      <TableCell>{failureRate(s)}</TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+            ) : (
+              <p className="text-muted-foreground">No jobs have been enqueued yet.</p>
+            )}
+          </CardContent>
+        </Card>
+
+        <Card>
+          <CardHeader className="flex flex-col md:flex-row md:items-center justify-between gap-4">
+            <CardTitle>Jobs</CardTitle>
+            <div className="flex flex-wrap gap-2 items-center">
+              <select
+                value={filters.queue}
+                onChange={(e) => applyFilters({ queue: e.target.value })}
+                className={selectClassName}
+              >
+                <option value="">All queues</option>
+                {stats.map((s) => (
+                  <option key={s.queue} value={s.queue}>
+                    {s.queue}
+                  </option>
+                ))}
+              </select>
+              <select
+                value={filters.status}
+                onChange={(e) => applyFilters({ status: e.target.value })}
+                className={selectClassName}
+              >
+                <option value="">All statuses</option>
+                {jobStatuses.map((status) => (
+                  <option key={status} value={status}>
+                    {status}
+                  </option>
+                ))}
+              </select>
+              <form onSubmit={handlePurge} className="flex gap-2 items-center">
+                <input
+                  type="number"
+                  min={0}
+                  value={purgeForm.data.days}
+                  onChange={(e) => purgeForm.setData("days", e.target.value)}
+                  className={`${selectClassName} w-20`}
+                />
+                <Button type="submit" variant="outline" disabled={purgeForm.processing}>
+                  Purge completed older than days
+                </Button>
+              </form>
+            </div>
+          </CardHeader>
+
+          <CardContent className="flex flex-col gap-4">
+            {jobs.length > 0 ? (
+              <Table>
+                <TableHeader>
+                  <TableRow>
+                    <TableHead>ID</TableHead>
+                    <TableHead>Queue</TableHead>
+                    <TableHead>Status</TableHead>
+                    <TableHead>Attempts</TableHead>
+                    <TableHead>Priority</TableHead>
+                    <TableHead>Run at</TableHead>
+                    <TableHead>Processed at</TableHead>
+                    <TableHead>Error</TableHead>
+                  </TableRow>
+                </TableHeader>
+                <TableBody>
+                  {jobs.map((job) => (
+                    <TableRow key={job.id}>
+                      <TableCell>
+                        <Link href={`/admin/jobs/${job.id}`} className="underline">
+                          {job.id}
+                        </Link>
+                      </TableCell>
+                      <TableCell className="font-mono">{job.queue}</TableCell>
+                      <TableCell>
+                        <JobStatusBadge status={job.status} />
+                      </TableCell>
+                      <TableCell>
+                        {job.attempts}/{job.max_attempts}
+                      </TableCell>
+                      <TableCell>{job.priority}</TableCell>
+                      <TableCell>{formatDate(job.run_at)}</TableCell>
+                      <TableCell>{formatDate(job.processed_at)}</TableCell>
+                      <TableCell className="max-w-xs truncate" title={job.error}>
+                        {job.error}
+                      </TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+            ) : (
+              <p className="text-muted-foreground">No jobs match these filters.</p>
+            )}
+
+            {pagination.totalPages > 1 && (
+              <div className="flex justify-between items-center">
+                <Button
+                  variant="outline"
+                  disabled={pagination.page <= 1}
+                  onClick={() => goToPage(pagination.page - 1)}
+                >
+                  Previous
+                </Button>
+                <span className="text-sm text-muted-foreground">
+                  Page {pagination.page} of {pagination.totalPages}
+                </span>
+                <Button
+                  variant="outline"
+                  disabled={pagination.page >= pagination.totalPages}
+                  onClick={() => goToPage(pagination.page + 1)}
+                >
+                  Next
+                </Button>
+              </div>
+            )}
+          </CardContent>
+        </Card>
+      </div>
+    </AppLayout>
+  );
+}
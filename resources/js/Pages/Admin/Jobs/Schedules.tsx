@@ -0,0 +1,97 @@
+import { Head } from "@inertiajs/react";
+import AppLayout from "@/Layouts/AppLayout";
+import { BreadcrumbItem } from "@/types";
+import { Job } from "@/types/job";
+import { JobStatusBadge } from "@/components/Admin/JobStatusBadge";
+import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+
+type Schedule = {
+  queue: string;
+  spec: string;
+  next: string;
+  lastRun?: Job;
+};
+
+type Props = {
+  schedules: Schedule[];
+};
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Admin",
+    href: "/admin/users",
+  },
+  {
+    title: "Jobs",
+    href: "/admin/jobs",
+  },
+  {
+    title: "Scheduled Jobs",
+    href: "/admin/jobs/schedules",
+  },
+];
+
+const formatDate = (value?: string) =>
+  value ? new Date(value).toLocaleString() : "—";
+
+export default function Schedules({ schedules }: Props) {
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title="Scheduled Jobs" />
+
+      <div className="flex flex-col w-full h-full p-6">
+        <Card>
+          <CardHeader>
+            <CardTitle>Scheduled Jobs</CardTitle>
+            <p className="text-sm text-muted-foreground">
+              Recurring jobs are enqueued once per tick across all instances. Schedules are evaluated in UTC.
+            </p>
+          </CardHeader>
+
+          <CardContent>
+            {schedules.length > 0 ? (
+              <Table>
+                <TableHeader>
+                  <TableRow>
+                    <TableHead>Queue</TableHead>
+                    <TableHead>Schedule</TableHead>
+                    <TableHead>Next run</TableHead>
+                    <TableHead>Last run</TableHead>
+                    <TableHead>Last status</TableHead>
+                  </TableRow>
+                </TableHeader>
+                <TableBody>
+                  {schedules.map((schedule) => (
+                    <TableRow key={`${schedule.queue}-${schedule.spec}`}>
+                      <TableCell className="font-mono">{schedule.queue}</TableCell>
+                      <TableCell className="font-mono">{schedule.spec}</TableCell>
+                      <TableCell>{formatDate(schedule.next)}</TableCell>
+                      <TableCell>{formatDate(schedule.lastRun?.scheduled_for)}</TableCell>
+                      <TableCell>
+                        {schedule.lastRun ? (
+                          <JobStatusBadge status={schedule.lastRun.status} title={schedule.lastRun.error} />
+                        ) : (
+                          "—"
+                        )}
+                      </TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+            ) : (
+              <p className="text-muted-foreground">No recurring jobs are registered.</p>
+            )}
+          </CardContent>
+        </Card>
+      </div>
+    </AppLayout>
+  );
+}
@@ -0,0 +1,149 @@
+import { Head, router } from "@inertiajs/react";
+import AppLayout from "@/Layouts/AppLayout";
+import { BreadcrumbItem } from "@/types";
+import { JobStatusBadge } from "@/components/Admin/JobStatusBadge";
+import { Button } from "@/components/ui/button";
+import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import { Job, JobAttempt } from "@/types/job";
+
+const formatDate = (value?: string) =>
+  value ? new Date(value).toLocaleString() : "—";
+
+const formatDuration = (ms: number) =>
+  ms < 1000 ? `${ms}ms` : `${(ms / 1000).toFixed(1)}s`;
+
+type Props = {
+  job: Job;
+  attempts: JobAttempt[];
+};
+
+export default function Show({ job, attempts }: Props) {
+  const breadcrumbs: BreadcrumbItem[] = [
+    {
+      title: "Admin",
+      href: "/admin/users",
+    },
+    {
+      title: "Jobs",
+      href: "/admin/jobs",
+    },
+    {
+      title: `#${job.id}`,
+      href: `/admin/jobs/${job.id}`,
+    },
+  ];
+
+  const details: [string, React.ReactNode][] = [
+    ["Queue", <span className="font-mono">{job.queue}</span>],
+    ["Status", <JobStatusBadge status={job.status} />],
+    ["Attempts", `${job.attempts}/${job.max_attempts}`],
+    ["Priority", job.priority],
+    ["Created at", formatDate(job.created_at)],
+    ["Run at", formatDate(job.run_at)],
+    ["Scheduled for", formatDate(job.scheduled_for)],
+    ["Unique key", job.unique_key ? <span className="font-mono">{job.unique_key}</span> : "—"],
+    ["Processed at", formatDate(job.processed_at)],
+    ["Locked by", job.locked_by || "—"],
+    ["Locked until", formatDate(job.locked_until)],
+  ];
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title={`Job #${job.id}`} />
+
+      <div className="flex flex-col gap-6 w-full h-full p-6">
+        <Card>
+          <CardHeader className="flex flex-row items-center justify-between">
+            <CardTitle>Job #{job.id}</CardTitle>
+            <div className="flex gap-2">
+              {(job.status === "failed" || job.status === "cancelled") && (
+                <Button onClick={() => router.post(`/admin/jobs/${job.id}/retry`)}>Retry</Button>
+              )}
+              {job.status === "pending" && (
+                <Button variant="destructive" onClick={() => router.post(`/admin/jobs/${job.id}/cancel`)}>
+                  Cancel
+                </Button>
+              )}
+            </div>
+          </CardHeader>
+          <CardContent>
+            <dl className="grid grid-cols-1 md:grid-cols-2 gap-x-8 gap-y-3">
+              {details.map(([label, value]) => (
+                <div key={label} className="flex justify-between gap-4 border-b py-2">
+                  <dt className="text-muted-foreground">{label}</dt>
+                  <dd>{value}</dd>
+                </div>
+              ))}
+            </dl>
+          </CardContent>
+        </Card>
+
+        {job.error && (
+          <Card>
+            <CardHeader>
+              <CardTitle>Error</CardTitle>
+            </CardHeader>
+            <CardContent>
+              <pre className="whitespace-pre-wrap text-sm text-destructive">{job.error}</pre>
+            </CardContent>
+          </Card>
+        )}
+
+        <Card>
+          <CardHeader>
+            <CardTitle>Attempts</CardTitle>
+          </CardHeader>
+          <CardContent>
+            {attempts.length > 0 ? (
+              <Table>
+                <TableHeader>
+                  <TableRow>
+                    <TableHead>Attempt</TableHead>
+                    <TableHead>Started at</TableHead>
+                    <TableHead>Duration</TableHead>
+                    <TableHead>Worker</TableHead>
+                    <TableHead>Error</TableHead>
+                  </TableRow>
+                </TableHeader>
+                <TableBody>
+                  {attempts.map((attempt) => (
+                    <TableRow key={attempt.id}>
+                      <TableCell>{attempt.attempt}</TableCell>
+                      <TableCell>{formatDate(attempt.started_at)}</TableCell>
+                      <TableCell>{formatDuration(attempt.duration_ms)}</TableCell>
+                      <TableCell className="font-mono text-xs">{attempt.worker}</TableCell>
+                      <TableCell className="whitespace-pre-wrap text-destructive">
+                        {attempt.error || <span className="text-muted-foreground">Succeeded</span>}
+                      </TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+            ) : (
+              <p className="text-muted-foreground">This job has not run yet.</p>
+            )}
+          </CardContent>
+        </Card>
+
+        <Card>
+          <CardHeader>
+            <CardTitle>Payload</CardTitle>
+          </CardHeader>
+          <CardContent>
+            <pre className="whitespace-pre-wrap text-sm bg-muted rounded-md p-4 overflow-auto">
+              {JSON.stringify(job.payload, null, 2)}
+            </pre>
+          </CardContent>
+        </Card>
+      </div>
+    </AppLayout>
+  );
+}
@@ -1,15 +1,22 @@
+
 import { useState } from "react";
 import AppLayout from "@/Layouts/AppLayout";
 import { Button } from "@/components/ui/button";
 import { Card, CardContent, CardDescription, CardHeader, CardTitle } from "@/components/ui/card";
 import { type BreadcrumbItem } from "@/types";
 import { Head, router } from "@inertiajs/react";
+import { formatAmount } from "@/utils/currency";
 import { 
   CreditCardIcon, 
   CalendarIcon, 
   XCircleIcon, 
   CheckCircleIcon,
-  AlertTriangleIcon 
+  AlertTriangleIcon,
+  ArrowRightLeftIcon,
+  RotateCcwIcon,
+  DownloadIcon,
+  RefreshCwIcon,
+  WalletIcon
 } from "lucide-react";
 
 interface Subscription {
@@ -21,6 +28,43 @@ interface Subscription {
   currency: string;
   interval: string;
   metadata?: Record<string, any>;
+  priceId: string;
+  cancelAtPeriodEnd: boolean;
+  pendingPriceId?: string;
+  pendingChangeAt: string | null;
+  trialEnd: string | null;
+  discount: Discount | null;
+}
+
+interface Discount {
+  code: string;
+  percentOff: number;
+  amountOff: number;
+  currency: string;
+  duration: 'once' | 'repeating' | 'forever';
+  durationInMonths: number;
+  endsAt: string | null;
+}
+
+interface Price {
+  planId: string;
+  planName: string;
+  priceId: string;
+  amount: number;
+  currency: string;
+  interval: string;
+}
+
+interface ChangePreview {
+  subscriptionId: string;
+  priceId: string;
+  amount: number;
+  currency: string;
+  interval: string;
+  upgrade: boolean;
+  amountDue: number;
+  prorationDate: number | null;
+  effectiveAt: string;
 }
 
 interface PaymentMethod {
@@ -33,16 +77,40 @@ interface PaymentMethod {
   isDefault: boolean;
 }
 
+interface Invoice {
+  id: string;
+  number: string;
+  status: string;
+  currency: string;
+  subtotal: number;
+  tax: number;
+  total: number;
+  amountPaid: number;
+  periodStart: string;
+  periodEnd: string;
+  issuedAt: string;
+  downloadable: boolean;
+}
+
 interface User {
   id: number;
   name: string;
   email: string;
 }
 
+interface PaymentAccount {
+  chargesEnabled: boolean;
+  detailsSubmitted: boolean;
+}
+
 interface BillingProps {
   title: string;
   subscriptions: Subscription[];
   paymentMethods: PaymentMethod[];
+  prices: Price[];
+  changePreview: ChangePreview | null;
+  invoices: Invoice[];
+  paymentAccount: PaymentAccount | null;
   user: User;
 }
 
@@ -57,17 +125,10 @@ const breadcrumbs: BreadcrumbItem[] = [
   },
 ];
 
-export default function Billing({ title, subscriptions, paymentMethods, user }: BillingProps) {
+export default function Billing({ title, subscriptions, paymentMethods, prices, changePreview, invoices, paymentAccount, user }: BillingProps) {
   const [isProcessing, setIsProcessing] = useState(false);
   const [processingSubscriptionId, setProcessingSubscriptionId] = useState<string | null>(null);
 
-  const formatPrice = (price: number, currency: string) => {
-    return new Intl.NumberFormat('en-US', {
-      style: 'currency',
-      currency: currency.toUpperCase(),
-    }).format(price / 100);
-  };
-
   const formatDate = (dateString: string) => {
     return new Date(dateString).toLocaleDateString('en-US', {
       year: 'numeric',
@@ -84,6 +145,10 @@ export default function Billing({ title, subscriptions, paymentMethods, user }:
         return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-red-100 text-red-800">Canceled</span>;
       case 'past_due':
         return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-yellow-100 text-yellow-800">Past Due</span>;
+      case 'unpaid':
+        return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-red-100 text-red-800">Unpaid</span>;
+      case 'trialing':
+        return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800">Trial</span>;
       case 'incomplete':
         return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-800">Incomplete</span>;
       default:
@@ -91,6 +156,60 @@ export default function Billing({ title, subscriptions, paymentMethods, user }:
     }
   };
 
+  const formatDiscount = (discount: Discount) => {
+    const off = discount.percentOff > 0
+      ? `${discount.percentOff}% off`
+      : `${formatAmount(discount.amountOff, discount.currency)} off`;
+
+    switch (discount.duration) {
+      case 'forever':
+        return `${off} forever`;
+      case 'once':
+        return `${off} your first payment`;
+      default:
+        return discount.endsAt ? `${off} until ${formatDate(discount.endsAt)}` : off;
+    }
+  };
+
+  const priceName = (priceId?: string) => {
+    const price = prices.find(p => p.priceId === priceId);
+    return price ? `${price.planName} (${formatAmount(price.amount, price.currency)}/${price.interval})` : priceId;
+  };
+
+  const post = (url: string, subscriptionId: string, data: Record<string, any> = {}) => {
+    setIsProcessing(true);
+    setProcessingSubscriptionId(subscriptionId);
+
+    router.post(url, {
+      subscriptionId: subscriptionId,
+      ...data,
+    }, {
+      onFinish: () => {
+        setIsProcessing(false);
+        setProcessingSubscriptionId(null);
+      },
+    });
+  };
+
+  const handlePreviewChange = (subscriptionId: string, priceId: string) => {
+    router.get('/billing/preview', { subscriptionId, priceId }, { preserveScroll: true });
+  };
+
+  const handleConfirmChange = (preview: ChangePreview) => {
+    post('/billing/change', preview.subscriptionId, {
+      priceId: preview.priceId,
+      prorationDate: preview.prorationDate ?? '',
+    });
+  };
+
+  const handleCancelAtPeriodEnd = (subscription: Subscription) => {
+    if (!confirm(`Your subscription will stay active until ${formatDate(subscription.currentPeriodEnd)} and will not renew. Continue?`)) {
+      return;
+    }
+
+    post('/billing/cancel', subscription.id, { atPeriodEnd: true });
+  };
+
   const handleCancelSubscription = (subscriptionId: string) => {
     if (!confirm('Are you sure you want to cancel this subscription? This action cannot be undone.')) {
       return;
@@ -116,6 +235,53 @@ export default function Billing({ title, subscriptions, paymentMethods, user }:
     });
   };
 
+  const handleSyncInvoices = () => {
+    setIsProcessing(true);
+    router.post('/billing/invoices/sync', {}, {
+      preserveScroll: true,
+      onFinish: () => setIsProcessing(false),
+    });
+  };
+
+  const handleSetupPayouts = () => {
+    setIsProcessing(true);
+    router.post('/billing/payouts', {}, {
+      onFinish: () => setIsProcessing(false),
+    });
+  };
+
+  const getPaymentMethodLabel = (method: PaymentMethod) => {
+    switch (method.type) {
+      case 'card':
+        return method.brand?.toUpperCase() || 'Card';
+      case 'sepa_debit':
+        return 'SEPA Direct Debit';
+      case 'boleto':
+        return 'Boleto';
+      case 'pix':
+        return 'Pix';
+      case 'bank_account':
+        return 'Bank account';
+      default:
+        return method.brand?.toUpperCase() || method.type.toUpperCase();
+    }
+  };
+
+  const getInvoiceStatusBadge = (status: string) => {
+    switch (status) {
+      case 'paid':
+        return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-green-100 text-green-800">Paid</span>;
+      case 'open':
+        return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-yellow-100 text-yellow-800">Open</span>;
+      case 'uncollectible':
+        return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-red-100 text-red-800">Uncollectible</span>;
+      case 'void':
+        return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-800">Void</span>;
+      default:
+        return <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-800">{status}</span>;
+    }
+  };
+
   const hasActiveSubscriptions = subscriptions.some(sub => sub.status === 'active');
 
   return (
@@ -163,29 +329,50 @@ export default function Billing({ title, subscriptions, paymentMethods, user }:
                           {getStatusBadge(subscription.status)}
                         </CardTitle>
                         <CardDescription>
-                          {formatPrice(subscription.amount, subscription.currency)}/{subscription.interval}
+                          {formatAmount(subscription.amount, subscription.currency)}/{subscription.interval}
                         </CardDescription>
                       </div>
-                      {subscription.status === 'active' && (
+                      {subscription.status === 'active' && subscription.cancelAtPeriodEnd && (
                         <Button
                           variant="outline"
                           size="sm"
-                          onClick={() => handleCancelSubscription(subscription.id)}
+                          onClick={() => post('/billing/reactivate', subscription.id)}
                           disabled={isProcessing && processingSubscriptionId === subscription.id}
                         >
-                          {isProcessing && processingSubscriptionId === subscription.id ? (
-                            <div className="flex items-center gap-2">
-                              <div className="h-3 w-3 animate-spin rounded-full border-2 border-current border-t-transparent" />
-                              Canceling...
-                            </div>
-                          ) : (
-                            <>
-                              <XCircleIcon className="h-4 w-4 mr-2" />
-                              Cancel
-                            </>
-                          )}
+                          <RotateCcwIcon className="h-4 w-4 mr-2" />
+                          Reactivate
                         </Button>
                       )}
+                      {subscription.status === 'active' && !subscription.cancelAtPeriodEnd && (
+                        <div className="flex gap-2">
+                          <Button
+                            variant="outline"
+                            size="sm"
+                            onClick={() => handleCancelAtPeriodEnd(subscription)}
+                            disabled={isProcessing && processingSubscriptionId === subscription.id}
+                          >
+                            Cancel at period end
+                          </Button>
+                          <Button
+                            variant="outline"
+                            size="sm"
+                            onClick={() => handleCancelSubscription(subscription.id)}
+                            disabled={isProcessing && processingSubscriptionId === subscription.id}
+                          >
+                            {isProcessing && processingSubscriptionId === subscription.id ? (
+                              <div className="flex items-center gap-2">
+                                <div className="h-3 w-3 animate-spin rounded-full border-2 border-current border-t-transparent" />
+                                Canceling...
+                              </div>
+                            ) : (
+                              <>
+                                <XCircleIcon className="h-4 w-4 mr-2" />
+                                Cancel now
+                              </>
+                            )}
+                          </Button>
+                        </div>
+                      )}
                     </div>
                   </CardHeader>
                   <CardContent>
@@ -207,6 +394,95 @@ export default function Billing({ title, subscriptions, paymentMethods, user }:
                         <p className="text-sm font-medium capitalize">{subscription.status}</p>
                       </div>
                     </div>
+
+                    {subscription.trialEnd && (
+                      <div className="mt-4 rounded-lg border border-blue-200 bg-blue-50 p-3 text-sm text-blue-800">
+                        {subscription.cancelAtPeriodEnd
+                          ? `Your free trial ends on ${formatDate(subscription.trialEnd)}.`
+                          : `Your free trial ends on ${formatDate(subscription.trialEnd)}, when your first payment is charged.`}
+                      </div>
+                    )}
+
+                    {subscription.discount && (
+                      <div className="mt-4 rounded-lg border border-green-200 bg-green-50 p-3 text-sm text-green-800">
+                        Promotion {subscription.discount.code || 'discount'}: {formatDiscount(subscription.discount)}.
+                      </div>
+                    )}
+
+                    {subscription.cancelAtPeriodEnd && (
+                      <div className="mt-4 rounded-lg border border-yellow-200 bg-yellow-50 p-3 text-sm text-yellow-800">
+                        Your subscription will end on {formatDate(subscription.currentPeriodEnd)}.
+                      </div>
+                    )}
+
+                    {subscription.pendingPriceId && subscription.pendingChangeAt && (
+                      <div className="mt-4 flex items-center justify-between rounded-lg border border-blue-200 bg-blue-50 p-3 text-sm text-blue-800">
+                        <span>
+                          You will switch to {priceName(subscription.pendingPriceId)} on {formatDate(subscription.pendingChangeAt)}.
+                        </span>
+                        <Button
+                          variant="link"
+                          size="sm"
+                          className="h-auto p-0"
+                          onClick={() => post('/billing/change/cancel', subscription.id)}
+                          disabled={isProcessing && processingSubscriptionId === subscription.id}
+                        >
+                          Keep current plan
+                        </Button>
+                      </div>
+                    )}
+
+                    {['active', 'trialing'].includes(subscription.status) && !subscription.cancelAtPeriodEnd && (
+                      <div className="mt-4 space-y-2">
+                        <div className="flex items-center gap-2 text-sm">
+                          <ArrowRightLeftIcon className="h-4 w-4 text-muted-foreground" />
+                          <span className="text-muted-foreground">Change plan:</span>
+                        </div>
+                        <div className="flex flex-wrap gap-2">
+                          {prices.filter(price => price.priceId !== subscription.priceId).map((price) => (
+                            <Button
+                              key={price.priceId}
+                              variant="outline"
+                              size="sm"
+                              onClick={() => handlePreviewChange(subscription.id, price.priceId)}
+                            >
+                              {price.planName} · {formatAmount(price.amount, price.currency)}/{price.interval}
+                            </Button>
+                          ))}
+                        </div>
+                      </div>
+                    )}
+
+                    {changePreview && changePreview.subscriptionId === subscription.id && (
+                      <div className="mt-4 space-y-3 rounded-lg border p-4">
+                        <p className="text-sm font-medium">
+                          Switch to {priceName(changePreview.priceId)}
+                        </p>
+                        {changePreview.upgrade ? (
+                          <p className="text-sm text-muted-foreground">
+                            You will be charged {formatAmount(changePreview.amountDue, changePreview.currency)} now for the rest of the current period,
+                            then {formatAmount(changePreview.amount, changePreview.currency)}/{changePreview.interval}.
+                          </p>
+                        ) : (
+                          <p className="text-sm text-muted-foreground">
+                            Nothing is charged now. You keep your current plan until {formatDate(changePreview.effectiveAt)},
+                            then pay {formatAmount(changePreview.amount, changePreview.currency)}/{changePreview.interval}.
+                          </p>
+                        )}
+                        <div className="flex gap-2">
+                          <Button
+                            size="sm"
+                            onClick={() => handleConfirmChange(changePreview)}
+                            disabled={isProcessing && processingSubscriptionId === subscription.id}
+                          >
+                            Confirm change
+                          </Button>
+                          <Button variant="ghost" size="sm" asChild>
+                            <a href="/billing">Never mind</a>
+                          </Button>
+                        </div>
+                      </div>
+                    )}
                   </CardContent>
                 </Card>
               ))}
@@ -227,9 +503,9 @@ export default function Billing({ title, subscriptions, paymentMethods, user }:
                         <CreditCardIcon className="h-5 w-5 text-muted-foreground" />
                         <div>
                           <p className="font-medium">
-                            {method.brand?.toUpperCase() || method.type.toUpperCase()} ••••{method.lastFour}
+                            {getPaymentMethodLabel(method)}{method.lastFour && ` ••••${method.lastFour}`}
                           </p>
-                          {method.expiryMonth && method.expiryYear && (
+                          {!!method.expiryMonth && !!method.expiryYear && (
                             <p className="text-sm text-muted-foreground">
                               Expires {method.expiryMonth.toString().padStart(2, '0')}/{method.expiryYear}
                             </p>
@@ -247,6 +523,91 @@ export default function Billing({ title, subscriptions, paymentMethods, user }:
           </div>
         )}
 
+        {/* Billing History */}
+        <div className="space-y-4">
+          <div className="flex items-center justify-between">
+            <h2 className="text-xl font-semibold">Billing History</h2>
+            <Button variant="outline" size="sm" onClick={handleSyncInvoices} disabled={isProcessing}>
+              <RefreshCwIcon className="h-4 w-4 mr-2" />
+              Refresh
+            </Button>
+          </div>
+          <Card>
+            <CardContent className="pt-6">
+              {invoices.length === 0 ? (
+                <p className="text-sm text-muted-foreground">No invoices yet.</p>
+              ) : (
+                <div className="overflow-x-auto">
+                  <table className="w-full text-sm">
+                    <thead>
+                      <tr className="border-b text-left text-muted-foreground">
+                        <th className="pb-2 font-medium">Invoice</th>
+                        <th className="pb-2 font-medium">Date</th>
+                        <th className="pb-2 font-medium">Period</th>
+                        <th className="pb-2 font-medium">Status</th>
+                        <th className="pb-2 font-medium text-right">Tax</th>
+                        <th className="pb-2 font-medium text-right">Total</th>
+                        <th className="pb-2"></th>
+                      </tr>
+                    </thead>
+                    <tbody>
+                      {invoices.map((invoice) => (
+                        <tr key={invoice.id} className="border-b last:border-0">
+                          <td className="py-3 font-medium">{invoice.number || invoice.id}</td>
+                          <td className="py-3">{formatDate(invoice.issuedAt)}</td>
+                          <td className="py-3 text-muted-foreground">
+                            {formatDate(invoice.periodStart)} - {formatDate(invoice.periodEnd)}
+                          </td>
+                          <td className="py-3">{getInvoiceStatusBadge(invoice.status)}</td>
+                          <td className="py-3 text-right">{formatAmount(invoice.tax, invoice.currency)}</td>
+                          <td className="py-3 text-right font-medium">{formatAmount(invoice.total, invoice.currency)}</td>
+                          <td className="py-3 text-right">
+                            {invoice.downloadable && (
+                              <Button variant="ghost" size="sm" asChild>
+                                <a href={`/billing/invoices/${invoice.id}`} target="_blank" rel="noopener noreferrer">
+                                  <DownloadIcon className="h-4 w-4 mr-2" />
+                                  Download
+                                </a>
+                              </Button>
+                            )}
+                          </td>
+                        </tr>
+                      ))}
+                    </tbody>
+                  </table>
+                </div>
+              )}
+            </CardContent>
+          </Card>
+        </div>
+
+        {/* Form Payments */}
+        <Card>
+          <CardHeader>
+            <CardTitle className="flex items-center gap-2">
+              <WalletIcon className="h-5 w-5" />
+              Form Payments
+            </CardTitle>
+            <CardDescription>
+              Collect payments from respondents with payment questions in your forms.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="flex items-center justify-between gap-4">
+            {paymentAccount?.chargesEnabled ? (
+              <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-green-100 text-green-800">Payouts active</span>
+            ) : paymentAccount ? (
+              <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-yellow-100 text-yellow-800">Setup incomplete</span>
+            ) : (
+              <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-800">Not set up</span>
+            )}
+            {!paymentAccount?.chargesEnabled && (
+              <Button size="sm" onClick={handleSetupPayouts} disabled={isProcessing}>
+                {paymentAccount ? 'Continue setup' : 'Set up payouts'}
+              </Button>
+            )}
+          </CardContent>
+        </Card>
+
         {/* Quick Actions */}
         <Card>
           <CardHeader>
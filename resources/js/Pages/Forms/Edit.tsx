@@ -20,6 +20,9 @@ export default function Edit({ form, userIdentifier }: Props) {
     isSaving,
     isPublished,
     displayMode,
+    metadataStorage,
+    retentionDays,
+    notifyOnResponse,
     hasUnsavedChanges,
     showUnsavedDialog,
     setSelectedQuestionId,
@@ -30,6 +33,9 @@ export default function Edit({ form, userIdentifier }: Props) {
     handleSave,
     handlePublishToggle,
     handleDisplayModeChange,
+    handleMetadataStorageChange,
+    handleRetentionDaysChange,
+    handleNotifyOnResponseChange,
     handleConfirmLeave,
     handleCancelLeave,
     handleReset,
@@ -52,10 +58,16 @@ export default function Edit({ form, userIdentifier }: Props) {
           form={form}
           userIdentifier={userIdentifier}
           displayMode={displayMode}
+          metadataStorage={metadataStorage}
+          retentionDays={retentionDays}
+          notifyOnResponse={notifyOnResponse}
           isPublished={isPublished}
           isSaving={isSaving}
           hasUnsavedChanges={hasUnsavedChanges}
           onDisplayModeChange={handleDisplayModeChange}
+          onMetadataStorageChange={handleMetadataStorageChange}
+          onRetentionDaysChange={handleRetentionDaysChange}
+          onNotifyOnResponseChange={handleNotifyOnResponseChange}
           onPublishToggle={handlePublishToggle}
           onSave={handleSave}
           onReset={handleReset}
@@ -45,12 +45,24 @@ export default function Index({ forms, userIdentifier }: Props) {
               </p>
             </div>
             
-            <Link href="/forms/create">
-              <Button size="lg" className="group">
-                <Plus className="h-5 w-5 mr-2 group-hover:rotate-90 transition-transform duration-300" />
-                New Form
-              </Button>
-            </Link>
+            <div className="flex gap-3">
+              <Link href="/reports">
+                <Button size="lg" variant="outline">
+                  Email Reports
+                </Button>
+              </Link>
+              <Link href="/forms/respondents">
+                <Button size="lg" variant="outline">
+                  Respondent Data
+                </Button>
+              </Link>
+              <Link href="/forms/create">
+                <Button size="lg" className="group">
+                  <Plus className="h-5 w-5 mr-2 group-hover:rotate-90 transition-transform duration-300" />
+                  New Form
+                </Button>
+              </Link>
+            </div>
           </div>
 
           {forms && forms.length > 0 && (
@@ -0,0 +1,94 @@
+import { Head, router, usePage } from "@inertiajs/react";
+import { Clock } from "lucide-react";
+import { Toaster } from "sonner";
+import { useState } from "react";
+import { PaymentForm } from "@/components/PaymentForm";
+import { formatAmount } from "@/utils/currency";
+import { generateBrandStyles } from "@/utils/brandColors";
+import { useFlashToasts } from "@/hooks/useFlashToast";
+import { SharedProps } from "@/types/global";
+
+interface Payment {
+  id: string;
+  status: string;
+  amount: number;
+  currency: string;
+}
+
+interface BrandColors {
+  button?: string;
+  background?: string;
+  text?: string;
+}
+
+interface Props {
+  formTitle: string;
+  payment: Payment;
+  stripePublishableKey: string;
+  paymentProvider: string;
+  brandColors?: BrandColors;
+}
+
+export default function Payment({ formTitle, payment, stripePublishableKey, paymentProvider, brandColors }: Props) {
+  const { flash } = usePage<SharedProps>().props;
+  const [processing, setProcessing] = useState(false);
+
+  useFlashToasts(flash);
+
+  // Payments waiting for the respondent to authenticate or for the provider can't be charged again.
+  const pending = payment.status === "requires_action" || payment.status === "processing";
+
+  const handlePaymentSubmit = (paymentMethodId: string) => {
+    setProcessing(true);
+
+    router.post(window.location.pathname, { paymentMethodId }, {
+      onFinish: () => setProcessing(false),
+    });
+  };
+
+  const customStyles = generateBrandStyles(brandColors);
+
+  return (
+    <>
+      <Head title={`Payment - ${formTitle}`}>
+        {customStyles && <style>{customStyles}</style>}
+      </Head>
+      <Toaster richColors position="top-center" />
+
+      <div className="min-h-screen flex items-center justify-center bg-gradient-to-br from-background to-muted p-6">
+        <div className="max-w-md w-full space-y-6">
+          <div className="text-center">
+            <h1 className="text-3xl font-bold text-foreground mb-2">{formTitle}</h1>
+            <p className="text-muted-foreground">
+              Complete your payment to submit your response.
+            </p>
+          </div>
+
+          {pending ? (
+            <div className="p-6 rounded-lg bg-muted text-center space-y-3">
+              <Clock className="h-10 w-10 mx-auto text-muted-foreground" />
+              <p className="font-medium">Your payment of {formatAmount(payment.amount, payment.currency)} is pending.</p>
+              <p className="text-sm text-muted-foreground">
+                Your response will be submitted as soon as it completes. You can close this window now.
+              </p>
+            </div>
+          ) : (
+            <PaymentForm
+              plan={{
+                id: payment.id,
+                name: formTitle,
+                price: payment.amount,
+                currency: payment.currency,
+              }}
+              onSubmit={handlePaymentSubmit}
+              isProcessing={processing}
+              stripePublishableKey={stripePublishableKey}
+              paymentProvider={paymentProvider}
+              mode="payment"
+            />
+          )}
+        </div>
+      </div>
+    </>
+  );
+}
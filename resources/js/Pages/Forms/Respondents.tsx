@@ -0,0 +1,146 @@
+import { Head, Link, router } from '@inertiajs/react';
+import { useState } from 'react';
+import AppLayout from '@/Layouts/AppLayout';
+import { Button } from '@/components/ui/button';
+import { Card } from '@/components/ui/card';
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from '@/components/ui/table';
+import { Download, Eye, Search, Trash2 } from 'lucide-react';
+import { Response } from '@/types/response';
+
+interface RespondentResponse extends Response {
+  edges: Response['edges'] & {
+    form?: {
+      id: number;
+      title: string;
+    };
+  };
+}
+
+interface Props {
+  email: string;
+  responses: RespondentResponse[];
+}
+
+export default function Respondents({ email, responses }: Props) {
+  const [query, setQuery] = useState(email);
+  const [isErasing, setIsErasing] = useState(false);
+
+  const handleSearch = (e: React.FormEvent) => {
+    e.preventDefault();
+    router.get('/forms/respondents', { email: query }, { preserveState: true });
+  };
+
+  const handleErase = () => {
+    if (!confirm(`Permanently delete all ${responses.length} responses containing ${email}? This action cannot be undone.`)) {
+      return;
+    }
+
+    setIsErasing(true);
+    router.post('/forms/respondents/erase', { email }, {
+      forceFormData: true,
+      onFinish: () => setIsErasing(false),
+    });
+  };
+
+  const formatDate = (dateString: string) => {
+    return new Date(dateString).toLocaleString('en-US', {
+      year: 'numeric',
+      month: 'short',
+      day: '2-digit',
+      hour: '2-digit',
+      minute: '2-digit',
+    });
+  };
+
+  return (
+    <AppLayout>
+      <Head title="Respondent Data" />
+
+      <div className="container mx-auto py-8 px-4">
+        <div className="mb-8">
+          <h1 className="text-3xl font-bold">Respondent Data</h1>
+          <p className="text-muted-foreground">
+            Find every response containing an email address across your forms to answer access and erasure requests.
+          </p>
+        </div>
+
+        <form onSubmit={handleSearch} className="flex gap-3 mb-6">
+          <input
+            type="email"
+            placeholder="respondent@example.com"
+            value={query}
+            onChange={(e) => setQuery(e.target.value)}
+            className="w-full md:w-96 px-4 py-3 border border-input rounded-lg bg-background focus:ring-2 focus:ring-primary/20 focus:border-primary transition-all duration-200"
+          />
+          <Button type="submit" size="lg">
+            <Search className="h-4 w-4 mr-2" />
+            Search
+          </Button>
+        </form>
+
+        {email !== '' && (
+          <>
+            <div className="flex items-center justify-between mb-4">
+              <p className="text-sm text-muted-foreground">
+                {responses.length} responses found for <span className="font-medium text-foreground">{email}</span>
+              </p>
+              {responses.length > 0 && (
+                <div className="flex gap-2">
+                  <a href={`/forms/respondents/export?email=${encodeURIComponent(email)}`}>
+                    <Button variant="outline" size="sm">
+                      <Download className="h-4 w-4 mr-2" />
+                      Export
+                    </Button>
+                  </a>
+                  <Button variant="destructive" size="sm" onClick={handleErase} disabled={isErasing}>
+                    <Trash2 className="h-4 w-4 mr-2" />
+                    {isErasing ? 'Deleting...' : 'Delete All'}
+                  </Button>
+                </div>
+              )}
+            </div>
+
+            {responses.length > 0 && (
+              <Card>
+                <Table>
+                  <TableHeader>
+                    <TableRow>
+                      <TableHead>Form</TableHead>
+                      <TableHead>Submitted</TableHead>
+                      <TableHead>Answers</TableHead>
+                      <TableHead className="text-right">Actions</TableHead>
+                    </TableRow>
+                  </TableHeader>
+                  <TableBody>
+                    {responses.map((response) => (
+                      <TableRow key={response.id}>
+                        <TableCell className="font-medium">{response.edges.form?.title}</TableCell>
+                        <TableCell>{formatDate(response.submitted_at)}</TableCell>
+                        <TableCell>{response.edges.answers?.length || 0}</TableCell>
+                        <TableCell className="text-right">
+                          <Link href={`/forms/${response.edges.form?.id}/responses/${response.id}`}>
+                            <Button variant="ghost" size="sm">
+                              <Eye className="h-4 w-4 mr-2" />
+                              View
+                            </Button>
+                          </Link>
+                        </TableCell>
+                      </TableRow>
+                    ))}
+                  </TableBody>
+                </Table>
+              </Card>
+            )}
+          </>
+        )}
+      </div>
+    </AppLayout>
+  );
+}
@@ -1,13 +1,15 @@
 import { Head } from '@inertiajs/react';
 import AppLayout from '@/Layouts/AppLayout';
 import { useState } from 'react';
-import { Response } from '@/types/response';
+import { Response, ResponseTag } from '@/types/response';
 import { ResponsesHeader } from '@/components/Responses/ResponsesHeader';
 import { ResponsesStats } from '@/components/Responses/ResponsesStats';
 import { ResponsesSearch } from '@/components/Responses/ResponsesSearch';
 import { ResponsesTable } from '@/components/Responses/ResponsesTable';
 import { EmptyResponsesState } from '@/components/Responses/EmptyResponsesState';
 import { NoSearchResults } from '@/components/Responses/NoSearchResults';
+import { ResponsesFilters } from '@/components/Responses/ResponsesFilters';
+import { ResponsesBulkActions } from '@/components/Responses/ResponsesBulkActions';
 
 interface Form {
   id: number;
@@ -21,10 +23,17 @@ interface Props {
   totalResponses: number;
   completionRate: number;
   userIdentifier: string;
+  tags: ResponseTag[];
+  filters: {
+    status: string;
+    tag: string;
+  };
 }
 
-export default function Index({ form, responses, totalResponses, completionRate, userIdentifier }: Props) {
+export default function Index({ form, responses, totalResponses, completionRate, userIdentifier, tags, filters }: Props) {
   const [searchQuery, setSearchQuery] = useState('');
+  const [selectedIds, setSelectedIds] = useState<number[]>([]);
+  const isFiltered = filters.status !== '' || filters.tag !== '';
 
   const filteredResponses = responses.filter((response) => {
     const searchLower = searchQuery.toLowerCase();
@@ -48,13 +57,29 @@ export default function Index({ form, responses, totalResponses, completionRate,
           responses={responses}
         />
 
-        {totalResponses > 0 && (
-          <ResponsesSearch value={searchQuery} onChange={setSearchQuery} />
+        {(totalResponses > 0 || isFiltered) && (
+          <>
+            <ResponsesFilters formId={form.id} tags={tags} filters={filters} />
+            <ResponsesSearch value={searchQuery} onChange={setSearchQuery} />
+          </>
+        )}
+
+        {selectedIds.length > 0 && (
+          <ResponsesBulkActions
+            formId={form.id}
+            selectedIds={selectedIds}
+            onComplete={() => setSelectedIds([])}
+          />
         )}
 
         {filteredResponses.length > 0 ? (
-          <ResponsesTable responses={filteredResponses} formId={form.id} />
-        ) : totalResponses > 0 ? (
+          <ResponsesTable
+            responses={filteredResponses}
+            formId={form.id}
+            selectedIds={selectedIds}
+            onSelectionChange={setSelectedIds}
+          />
+        ) : totalResponses > 0 || isFiltered ? (
           <NoSearchResults onClearSearch={() => setSearchQuery('')} />
         ) : (
           <EmptyResponsesState formSlug={form.slug} userIdentifier={userIdentifier} />
@@ -1,9 +1,14 @@
 import { Head } from '@inertiajs/react';
+import { useState } from 'react';
 import AppLayout from '@/Layouts/AppLayout';
-import { Response } from '@/types/response';
+import { Button } from '@/components/ui/button';
+import { Pencil } from 'lucide-react';
+import { Response, ResponseStatus, ResponseTag } from '@/types/response';
 import { ResponseHeader } from '@/components/Responses/ResponseHeader';
 import { ResponseMetadata } from '@/components/Responses/ResponseMetadata';
 import { ResponseAnswers } from '@/components/Responses/ResponseAnswers';
+import { ResponseAnswersEditor } from '@/components/Responses/ResponseAnswersEditor';
+import { ResponseWorkflow } from '@/components/Responses/ResponseWorkflow';
 
 interface Question {
   id: number;
@@ -25,10 +30,12 @@ interface Form {
 interface Props {
   form: Form;
   response: Response;
+  tags: ResponseTag[];
+  statuses: ResponseStatus[];
 }
 
-export default function Show({ form, response }: Props) {
-
+export default function Show({ form, response, tags, statuses }: Props) {
+  const [editing, setEditing] = useState(false);
   return (
     <AppLayout>
       <Head title={`Response #${response.id} - ${form.title}`} />
@@ -42,10 +49,34 @@ export default function Show({ form, response }: Props) {
 
         <ResponseMetadata response={response} />
 
-        <ResponseAnswers 
-          response={response} 
-          questions={form.edges.questions} 
+        <ResponseWorkflow
+          formId={form.id}
+          response={response}
+          statuses={statuses}
+          tags={tags}
         />
+
+        {editing ? (
+          <ResponseAnswersEditor
+            formId={form.id}
+            response={response}
+            questions={form.edges.questions || []}
+            onCancel={() => setEditing(false)}
+          />
+        ) : (
+          <>
+            <div className="flex justify-end mb-4">
+              <Button variant="outline" size="sm" onClick={() => setEditing(true)}>
+                <Pencil className="h-4 w-4 mr-2" />
+                Edit Answers
+              </Button>
+            </div>
+            <ResponseAnswers 
+              response={response} 
+              questions={form.edges.questions} 
+            />
+          </>
+        )}
       </div>
     </AppLayout>
   );
@@ -6,7 +6,8 @@ import { Dialog, DialogContent, DialogHeader, DialogTitle } from "@/components/u
 import { PaymentForm } from "@/components/PaymentForm";
 import { type BreadcrumbItem } from "@/types";
 import { Head, router } from "@inertiajs/react";
-import { CheckIcon, CreditCardIcon } from "lucide-react";
+import { CheckIcon, CreditCardIcon, XCircleIcon } from "lucide-react";
+import { formatAmount } from "@/utils/currency";
 
 interface Plan {
   id: string;
@@ -17,12 +18,14 @@ interface Plan {
   interval: string;
   features: string[];
   priceId: string;
+  trialDays: number;
 }
 
 interface Form {
   data: {
     planId: string;
     paymentMethodId: string;
+    promotionCode: string;
   };
   errors: Record<string, string>;
   isSubmitted: boolean;
@@ -32,9 +35,11 @@ interface Form {
 interface PlansProps {
   title: string;
   hasActiveSubscription: boolean;
+  currentPlan: string;
   plans: Plan[];
   form: Form;
   stripePublishableKey: string;
+  paymentProvider: string;
 }
 
 const breadcrumbs: BreadcrumbItem[] = [
@@ -48,31 +53,26 @@ const breadcrumbs: BreadcrumbItem[] = [
   },
 ];
 
-export default function Plans({ title, hasActiveSubscription, plans, form, stripePublishableKey }: PlansProps) {
+export default function Plans({ title, hasActiveSubscription, currentPlan, plans, form, stripePublishableKey, paymentProvider }: PlansProps) {
   const [selectedPlan, setSelectedPlan] = useState<Plan | null>(null);
   const [isProcessing, setIsProcessing] = useState(false);
   const [showPaymentModal, setShowPaymentModal] = useState(false);
 
-  const formatPrice = (price: number, currency: string) => {
-    return new Intl.NumberFormat('en-US', {
-      style: 'currency',
-      currency: currency.toUpperCase(),
-    }).format(price / 100);
-  };
-
   const handleSelectPlan = (plan: Plan) => {
     setSelectedPlan(plan);
     setShowPaymentModal(true);
   };
 
-  const handlePaymentSubmit = (paymentMethodId: string) => {
+  const handlePaymentSubmit = (paymentMethodId: string, promotionCode: string) => {
     if (!selectedPlan) return;
     
     setIsProcessing(true);
     
     router.post('/plans/subscribe', {
       planId: selectedPlan.id,
+      priceId: selectedPlan.priceId,
       paymentMethodId: paymentMethodId,
+      promotionCode: promotionCode,
     }, {
       onSuccess: () => {
         setShowPaymentModal(false);
@@ -121,9 +121,9 @@ export default function Plans({ title, hasActiveSubscription, plans, form, strip
         <div className="grid gap-6 md:grid-cols-1 lg:grid-cols-3 max-w-4xl mx-auto w-full">
           {plans.map((plan) => (
             <Card
-              key={plan.id}
+              key={plan.priceId}
               className={`relative transition-all duration-200 hover:shadow-lg ${
-                selectedPlan?.id === plan.id ? 'ring-2 ring-primary' : ''
+                selectedPlan?.priceId === plan.priceId || currentPlan === plan.id ? 'ring-2 ring-primary' : ''
               }`}
             >
               <CardHeader className="text-center">
@@ -134,10 +134,15 @@ export default function Plans({ title, hasActiveSubscription, plans, form, strip
                 <CardDescription>{plan.description}</CardDescription>
                 <div className="mt-4">
                   <span className="text-4xl font-bold">
-                    {formatPrice(plan.price, plan.currency)}
+                    {formatAmount(plan.price, plan.currency)}
                   </span>
                   <span className="text-muted-foreground">/{plan.interval}</span>
                 </div>
+                {plan.trialDays > 0 && (
+                  <p className="mt-2 text-sm font-medium text-green-600">
+                    {plan.trialDays}-day free trial
+                  </p>
+                )}
               </CardHeader>
               
               <CardContent className="space-y-4">
@@ -155,16 +160,20 @@ export default function Plans({ title, hasActiveSubscription, plans, form, strip
                 <Button
                   className="w-full mt-6"
                   onClick={() => handleSelectPlan(plan)}
-                  disabled={isProcessing || hasActiveSubscription}
-                  variant={hasActiveSubscription ? "outline" : "default"}
+                  disabled={isProcessing || hasActiveSubscription || currentPlan === plan.id}
+                  variant={hasActiveSubscription || currentPlan === plan.id ? "outline" : "default"}
                 >
-                  {isProcessing && selectedPlan?.id === plan.id ? (
+                  {isProcessing && selectedPlan?.priceId === plan.priceId ? (
                     <div className="flex items-center gap-2">
                       <div className="h-4 w-4 animate-spin rounded-full border-2 border-current border-t-transparent" />
                       Processing...
                     </div>
+                  ) : currentPlan === plan.id ? (
+                    "Current Plan"
                   ) : hasActiveSubscription ? (
                     "Already Subscribed"
+                  ) : plan.trialDays > 0 ? (
+                    `Start free trial of ${plan.name}`
                   ) : (
                     `Subscribe to ${plan.name}`
                   )}
@@ -226,6 +235,7 @@ export default function Plans({ title, hasActiveSubscription, plans, form, strip
                 onSubmit={handlePaymentSubmit}
                 isProcessing={isProcessing}
                 stripePublishableKey={stripePublishableKey}
+                paymentProvider={paymentProvider}
               />
             )}
           </DialogContent>
@@ -1,3 +1,4 @@
+
 import AppLayout from "@/Layouts/AppLayout";
 import { type BreadcrumbItem } from "@/types";
 import { Head, router } from "@inertiajs/react";
@@ -8,6 +9,7 @@ import { PaymentForm } from "@/components/PaymentForm";
 import { Dialog, DialogContent, DialogHeader, DialogTitle } from "@/components/ui/dialog";
 import { Alert, AlertDescription } from "@/components/ui/alert";
 import { CheckCircle2 } from "lucide-react";
+import { formatAmount } from "@/utils/currency";
 
 const breadcrumbs: BreadcrumbItem[] = [
   {
@@ -32,24 +34,18 @@ interface ProductsProps {
   title: string;
   product: Product;
   stripePublishableKey: string;
+  paymentProvider: string;
   success?: boolean;
   paymentIntentId?: string;
 }
 
 
-export default function Products({ title, product, stripePublishableKey, success, paymentIntentId }: ProductsProps) {
+export default function Products({ title, product, stripePublishableKey, paymentProvider, success, paymentIntentId }: ProductsProps) {
   const [showPaymentModal, setShowPaymentModal] = useState(false);
   const [paymentError, setPaymentError] = useState<string>("");
   const [showSuccess, setShowSuccess] = useState(false);
   const [processing, setProcessing] = useState(false);
 
-  const formatPrice = (price: number) => {
-    return new Intl.NumberFormat('en-US', {
-      style: 'currency',
-      currency: 'USD',
-    }).format(price / 100);
-  };
-
 
   const handlePaymentSubmit = (paymentMethodId: string) => {
     setProcessing(true);
@@ -57,7 +53,6 @@ export default function Products({ title, product, stripePublishableKey, success
     router.post('/products/purchase', {
       productId: product.id,
       paymentMethodId: paymentMethodId,
-      amount: product.price,
     }, {
       onSuccess: () => {
         setShowPaymentModal(false);
@@ -101,7 +96,7 @@ export default function Products({ title, product, stripePublishableKey, success
               <CardDescription>{product.description}</CardDescription>
             </CardHeader>
             <CardContent>
-              <div className="text-3xl font-bold">{formatPrice(product.price)}</div>
+              <div className="text-3xl font-bold">{formatAmount(product.price, product.currency)}</div>
               <p className="text-sm text-muted-foreground mt-2">
                 One-time purchase
               </p>
@@ -126,7 +121,7 @@ export default function Products({ title, product, stripePublishableKey, success
             <div className="space-y-4">
               <div className="flex justify-between items-center py-2 border-b">
                 <span className="font-medium">{product.name}</span>
-                <span className="font-bold">{formatPrice(product.price)}</span>
+                <span className="font-bold">{formatAmount(product.price, product.currency)}</span>
               </div>
               
               <PaymentForm
@@ -139,6 +134,7 @@ export default function Products({ title, product, stripePublishableKey, success
                 onSubmit={handlePaymentSubmit}
                 isProcessing={processing}
                 stripePublishableKey={stripePublishableKey}
+                paymentProvider={paymentProvider}
                 mode="payment"
               />
               
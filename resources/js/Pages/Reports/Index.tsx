@@ -0,0 +1,173 @@
+import { Head, router, useForm } from '@inertiajs/react';
+import AppLayout from '@/Layouts/AppLayout';
+import { Button } from '@/components/ui/button';
+import { Card } from '@/components/ui/card';
+import { Label } from '@/components/ui/label';
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from '@/components/ui/table';
+import { Mail, Trash2 } from 'lucide-react';
+
+interface Form {
+  id: number;
+  title: string;
+}
+
+interface Subscription {
+  id: number;
+  frequency: 'daily' | 'weekly' | 'monthly';
+  timezone: string;
+  form_id?: number;
+  last_period_start?: string;
+  created_at: string;
+  edges: {
+    form?: Form;
+  };
+}
+
+interface Props {
+  subscriptions: Subscription[];
+  forms: Form[];
+}
+
+const selectClassName =
+  'w-full px-4 py-3 border border-input rounded-lg bg-background focus:ring-2 focus:ring-primary/20 focus:border-primary transition-all duration-200';
+
+export default function Index({ subscriptions, forms }: Props) {
+  const { data, setData, post, processing, errors } = useForm({
+    frequency: 'weekly',
+    timezone: Intl.DateTimeFormat().resolvedOptions().timeZone || 'UTC',
+    formId: '',
+  });
+
+  const handleSubmit = (e: React.FormEvent) => {
+    e.preventDefault();
+    post('/reports', { forceFormData: true });
+  };
+
+  const handleDelete = (id: number) => {
+    if (!confirm('Stop receiving this report?')) {
+      return;
+    }
+    router.delete(`/reports/${id}`);
+  };
+
+  const formatDate = (dateString?: string) => {
+    if (!dateString) {
+      return 'Never';
+    }
+    return new Date(dateString).toLocaleDateString('en-US', {
+      year: 'numeric',
+      month: 'short',
+      day: '2-digit',
+    });
+  };
+
+  return (
+    <AppLayout>
+      <Head title="Email Reports" />
+
+      <div className="container mx-auto py-8 px-4">
+        <div className="mb-8">
+          <h1 className="text-3xl font-bold">Email Reports</h1>
+          <p className="text-muted-foreground">
+            Receive a summary of new responses with a CSV export of the period attached.
+          </p>
+        </div>
+
+        <Card className="p-6 mb-8">
+          <form onSubmit={handleSubmit} className="grid grid-cols-1 md:grid-cols-4 gap-4 items-end">
+            <div className="space-y-2">
+              <Label htmlFor="frequency">Frequency</Label>
+              <select
+                id="frequency"
+                value={data.frequency}
+                onChange={(e) => setData('frequency', e.target.value)}
+                className={selectClassName}
+              >
+                <option value="daily">Daily</option>
+                <option value="weekly">Weekly</option>
+                <option value="monthly">Monthly</option>
+              </select>
+              {errors.frequency && <p className="text-sm text-destructive">{errors.frequency}</p>}
+            </div>
+
+            <div className="space-y-2">
+              <Label htmlFor="timezone">Timezone</Label>
+              <input
+                id="timezone"
+                type="text"
+                value={data.timezone}
+                onChange={(e) => setData('timezone', e.target.value)}
+                className={selectClassName}
+              />
+              {errors.timezone && <p className="text-sm text-destructive">{errors.timezone}</p>}
+            </div>
+
+            <div className="space-y-2">
+              <Label htmlFor="formId">Form</Label>
+              <select
+                id="formId"
+                value={data.formId}
+                onChange={(e) => setData('formId', e.target.value)}
+                className={selectClassName}
+              >
+                <option value="">All forms</option>
+                {forms.map((form) => (
+                  <option key={form.id} value={form.id}>
+                    {form.title}
+                  </option>
+                ))}
+              </select>
+            </div>
+
+            <Button type="submit" size="lg" disabled={processing}>
+              <Mail className="h-4 w-4 mr-2" />
+              Subscribe
+            </Button>
+          </form>
+        </Card>
+
+        {subscriptions.length > 0 ? (
+          <Card>
+            <Table>
+              <TableHeader>
+                <TableRow>
+                  <TableHead>Form</TableHead>
+                  <TableHead>Frequency</TableHead>
+                  <TableHead>Timezone</TableHead>
+                  <TableHead>Last Report</TableHead>
+                  <TableHead className="w-16" />
+                </TableRow>
+              </TableHeader>
+              <TableBody>
+                {subscriptions.map((subscription) => (
+                  <TableRow key={subscription.id}>
+                    <TableCell>{subscription.edges.form?.title ?? 'All forms'}</TableCell>
+                    <TableCell className="capitalize">{subscription.frequency}</TableCell>
+                    <TableCell>{subscription.timezone}</TableCell>
+                    <TableCell>{formatDate(subscription.last_period_start)}</TableCell>
+                    <TableCell>
+                      <Button variant="ghost" size="sm" onClick={() => handleDelete(subscription.id)}>
+                        <Trash2 className="h-4 w-4" />
+                      </Button>
+                    </TableCell>
+                  </TableRow>
+                ))}
+              </TableBody>
+            </Table>
+          </Card>
+        ) : (
+          <Card className="p-12 text-center">
+            <p className="text-muted-foreground">You are not subscribed to any reports yet.</p>
+          </Card>
+        )}
+      </div>
+    </AppLayout>
+  );
+}
@@ -0,0 +1,183 @@
+import AppLayout from "@/Layouts/AppLayout";
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import { Card, CardContent, CardDescription, CardHeader, CardTitle } from "@/components/ui/card";
+import { type BreadcrumbItem } from "@/types";
+import { Head, Link } from "@inertiajs/react";
+import { AlertTriangleIcon, DatabaseIcon, FileTextIcon, InboxIcon } from "lucide-react";
+
+type Metric = "responses" | "forms" | "storage";
+
+type Threshold = "none" | "warning" | "limit";
+
+interface MetricUsage {
+  metric: Metric;
+  used: number;
+  limit: number;
+  threshold: Threshold;
+}
+
+interface UsageProps {
+  title: string;
+  plan: {
+    id: string;
+    name: string;
+  };
+  usage: {
+    periodStart: string;
+    periodEnd: string;
+    metrics: MetricUsage[];
+    formsCreated: number;
+    overage: boolean;
+  };
+}
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Dashboard",
+    href: "/dashboard",
+  },
+  {
+    title: "Usage",
+    href: "/usage",
+  },
+];
+
+const metricLabels: Record<Metric, { title: string; description: string; icon: typeof InboxIcon }> = {
+  responses: {
+    title: "Responses",
+    description: "Responses received by your forms this period",
+    icon: InboxIcon,
+  },
+  forms: {
+    title: "Forms",
+    description: "Forms you currently own",
+    icon: FileTextIcon,
+  },
+  storage: {
+    title: "Storage",
+    description: "Answers and files stored this period",
+    icon: DatabaseIcon,
+  },
+};
+
+const formatAmount = (metric: Metric, amount: number) => {
+  if (metric !== "storage") {
+    return amount.toLocaleString();
+  }
+
+  const units = ["B", "KB", "MB", "GB", "TB"];
+  let value = amount;
+  let unit = 0;
+  while (value >= 1024 && unit < units.length - 1) {
+    value /= 1024;
+    unit++;
+  }
+  return `${value.toFixed(unit === 0 ? 0 : 1)} ${units[unit]}`;
+};
+
+const formatDate = (date: string) => {
+  return new Date(date).toLocaleDateString("en-US", {
+    year: "numeric",
+    month: "short",
+    day: "numeric",
+  });
+};
+
+export default function Usage({ title, plan, usage }: UsageProps) {
+  const atLimit = usage.metrics.some((m) => m.threshold === "limit");
+  const nearLimit = usage.metrics.some((m) => m.threshold === "warning");
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title={title} />
+      <div className="flex h-full flex-1 flex-col gap-6 rounded-xl p-6">
+        {/* Header */}
+        <div className="flex items-center justify-between">
+          <div className="space-y-1">
+            <h1 className="text-3xl font-bold tracking-tight">Usage</h1>
+            <p className="text-muted-foreground">
+              {plan.name} plan · {formatDate(usage.periodStart)} – {formatDate(usage.periodEnd)}
+            </p>
+          </div>
+          <Button asChild>
+            <Link href="/plans">Upgrade</Link>
+          </Button>
+        </div>
+
+        {/* Limit Notice */}
+        {(atLimit || nearLimit) && (
+          <Card className="border-yellow-200 bg-yellow-50 dark:border-yellow-800 dark:bg-yellow-950">
+            <CardContent className="flex items-center gap-3 pt-6">
+              <AlertTriangleIcon className="h-5 w-5 text-yellow-600" />
+              <div>
+                <p className="font-medium text-yellow-800 dark:text-yellow-200">
+                  {atLimit ? "You have reached a limit of your plan" : "You are close to a limit of your plan"}
+                </p>
+                <p className="text-sm text-yellow-700 dark:text-yellow-300">
+                  {usage.overage
+                    ? "Usage over your limits is billed as overage."
+                    : "Upgrade your plan to keep collecting responses and creating forms."}
+                </p>
+              </div>
+            </CardContent>
+          </Card>
+        )}
+
+        {/* Metrics */}
+        <div className="grid gap-6 md:grid-cols-3">
+          {usage.metrics.map((m) => {
+            const label = metricLabels[m.metric];
+            const Icon = label.icon;
+            const unlimited = m.limit < 0;
+            const percent = unlimited || m.limit === 0 ? 0 : Math.min(100, (m.used / m.limit) * 100);
+
+            return (
+              <Card key={m.metric}>
+                <CardHeader>
+                  <div className="flex items-center justify-between">
+                    <CardTitle className="flex items-center gap-2">
+                      <Icon className="h-5 w-5 text-muted-foreground" />
+                      {label.title}
+                    </CardTitle>
+                    {m.threshold === "limit" && <Badge variant="destructive">Limit reached</Badge>}
+                    {m.threshold === "warning" && <Badge variant="secondary">Almost full</Badge>}
+                  </div>
+                  <CardDescription>{label.description}</CardDescription>
+                </CardHeader>
+                <CardContent className="space-y-3">
+                  <p className="text-2xl font-bold">
+                    {formatAmount(m.metric, m.used)}
+                    <span className="text-base font-normal text-muted-foreground">
+                      {" "}
+                      / {unlimited ? "Unlimited" : formatAmount(m.metric, m.limit)}
+                    </span>
+                  </p>
+                  {!unlimited && (
+                    <div className="h-2 w-full overflow-hidden rounded-full bg-muted">
+                      <div
+                        className={`h-full rounded-full ${
+                          m.threshold === "limit"
+                            ? "bg-red-500"
+                            : m.threshold === "warning"
+                              ? "bg-yellow-500"
+                              : "bg-primary"
+                        }`}
+                        style={{ width: `${percent}%` }}
+                      />
+                    </div>
+                  )}
+                  {m.metric === "forms" && (
+                    <p className="text-sm text-muted-foreground">
+                      {usage.formsCreated.toLocaleString()} created this period
+                    </p>
+                  )}
+                </CardContent>
+              </Card>
+            );
+          })}
+        </div>
+      </div>
+    </AppLayout>
+  );
+}
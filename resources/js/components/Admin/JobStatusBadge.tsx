@@ -0,0 +1,18 @@
+import { Badge } from "@/components/ui/badge";
+import { JobStatus } from "@/types/job";
+
+const variants: Record<JobStatus, "default" | "secondary" | "destructive" | "outline"> = {
+  pending: "secondary",
+  processing: "secondary",
+  completed: "default",
+  failed: "destructive",
+  cancelled: "outline",
+};
+
+export function JobStatusBadge({ status, title }: { status: JobStatus; title?: string }) {
+  return (
+    <Badge variant={variants[status]} title={title}>
+      {status}
+    </Badge>
+  );
+}
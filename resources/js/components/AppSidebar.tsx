@@ -9,7 +9,7 @@ import {
 } from "@/components/ui/sidebar";
 import { type NavItem } from "@/types";
 import { Link, usePage } from "@inertiajs/react";
-import { BookOpen, FileText, Folder, LayoutGrid } from "lucide-react";
+import { BookOpen, FileText, Folder, Gauge, LayoutGrid } from "lucide-react";
 import { NavMain } from "./NavMain";
 import { NavFooter } from "./NavFooter";
 import { NavUser } from "./NavUser";
@@ -42,6 +42,11 @@ export function AppSidebar() {
       href: "/forms",
       icon: FileText,
     },
+    {
+      title: "Usage",
+      href: "/usage",
+      icon: Gauge,
+    },
     // {
     //   title: "Plans",
     //   href: "/plans",
@@ -0,0 +1,24 @@
+import { Alert, AlertDescription } from "@/components/ui/alert";
+import { Button } from "@/components/ui/button";
+import { type Dunning } from "@/types/global";
+import { AlertTriangleIcon } from "lucide-react";
+
+export function DunningBanner({ dunning }: { dunning: Dunning }) {
+  const graceEndsAt = new Date(dunning.graceEndsAt).toLocaleDateString("en-US", {
+    year: "numeric",
+    month: "long",
+    day: "numeric",
+  });
+
+  return (
+    <Alert className="rounded-none border-x-0 border-t-0 border-yellow-200 bg-yellow-50">
+      <AlertTriangleIcon className="h-4 w-4 text-yellow-600" />
+      <AlertDescription className="text-yellow-800">
+        We couldn't collect the payment for your subscription. Update your payment method before {graceEndsAt} to keep your plan.
+        <Button variant="link" className="p-0 ml-2 h-auto" asChild>
+          <a href="/billing">Go to billing</a>
+        </Button>
+      </AlertDescription>
+    </Alert>
+  );
+}
@@ -0,0 +1,85 @@
+import { Input } from '@/components/ui/input';
+import { PaymentOptions } from '@/types/form';
+import { currencyDecimals, formatAmount, toMajorUnits } from '@/utils/currency';
+
+interface PaymentFieldProps {
+  options?: PaymentOptions;
+  value?: string;
+  onChange?: (value: string) => void;
+  disabled?: boolean;
+  error?: string;
+}
+
+export function PaymentField({
+  options = {},
+  value = '',
+  onChange,
+  disabled = true,
+  error
+}: PaymentFieldProps) {
+  const mode = options.mode || 'fixed';
+  const currency = options.currency || 'usd';
+  const amount = options.amount || 0;
+  const step = 10 ** -currencyDecimals(currency);
+
+  const renderInput = () => {
+    switch (mode) {
+      case 'quantity': {
+        const quantity = parseInt(value, 10) || 0;
+        return (
+          <div className="space-y-2">
+            <div className="flex items-center gap-3">
+              <Input
+                type="number"
+                min={0}
+                max={options.maxQuantity || undefined}
+                step={1}
+                value={value}
+                onChange={(e) => onChange?.(e.target.value)}
+                disabled={disabled}
+                placeholder="0"
+                className={`w-28 ${error ? 'border-red-500' : ''}`}
+              />
+              <span className="text-sm text-muted-foreground">× {formatAmount(amount, currency)}</span>
+            </div>
+            <p className="text-sm font-medium">Total: {formatAmount(quantity * amount, currency)}</p>
+          </div>
+        );
+      }
+
+      case 'custom':
+        return (
+          <div className="space-y-2">
+            <div className="flex items-center gap-2">
+              <span className="text-sm text-muted-foreground">{currency.toUpperCase()}</span>
+              <Input
+                type="number"
+                min={toMajorUnits(options.minimum || 0, currency)}
+                step={step}
+                value={value}
+                onChange={(e) => onChange?.(e.target.value)}
+                disabled={disabled}
+                placeholder={(0).toFixed(currencyDecimals(currency))}
+                className={`w-40 ${error ? 'border-red-500' : ''}`}
+              />
+            </div>
+            {!!options.minimum && (
+              <p className="text-xs text-muted-foreground">Minimum {formatAmount(options.minimum, currency)}</p>
+            )}
+          </div>
+        );
+
+      default:
+        return <p className="text-2xl font-bold">{formatAmount(amount, currency)}</p>;
+    }
+  };
+
+  return (
+    <div className="space-y-2">
+      {renderInput()}
+      <p className="text-xs text-muted-foreground">
+        You'll be asked to pay after submitting the form.
+      </p>
+    </div>
+  );
+}
@@ -19,3 +19,4 @@ export { DateRangeField } from './DateRangeField';
 export { LegalField } from './LegalField';
 export { HiddenField } from './HiddenField';
 export { MultiInputField } from './MultiInputField';
+export { PaymentField } from './PaymentField';
@@ -6,6 +6,8 @@ import { Switch } from '@/components/ui/switch';
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select';
 import { X, Plus, GripVertical } from 'lucide-react';
 import React from 'react';
+import { PaymentOptions } from '@/types/form';
+import { currencyDecimals, toMajorUnits, toMinorUnits } from '@/utils/currency';
 
 interface SubInput {
   id: string;
@@ -22,11 +24,12 @@ interface Question {
   description?: string;
   placeholder?: string;
   required: boolean;
+  sensitive?: boolean;
   order: number;
-  options?: string[] | {
+  options?: string[] | ({
     items?: string[];
     subInputs?: SubInput[];
-  };
+  } & PaymentOptions);
 }
 
 interface FieldSettingsProps {
@@ -62,7 +65,8 @@ export function FieldSettings({ question, onUpdate, onClose }: FieldSettingsProp
   const isSelectionField = ['dropdown', 'radio', 'checkbox', 'multi-select', 'picture-choice'].includes(question.type);
   const isContentField = ['statement', 'legal', 'hidden'].includes(question.type);
   const isMultiInputField = question.type === 'multi-input';
-  const hasPlaceholder = !isSelectionField && !isContentField && !isMultiInputField;
+  const isPaymentField = question.type === 'payment';
+  const hasPlaceholder = !isSelectionField && !isContentField && !isMultiInputField && !isPaymentField;
 
   return (
     <div className="h-full flex flex-col bg-background border-l">
@@ -144,6 +148,18 @@ export function FieldSettings({ question, onUpdate, onClose }: FieldSettingsProp
           </div>
         )}
 
+        {isPaymentField && (
+          <div className="space-y-3">
+            <Label className="text-sm font-semibold">
+              Payment <span className="text-red-500">*</span>
+            </Label>
+            <PaymentEditor
+              options={typeof question.options === 'object' && !Array.isArray(question.options) ? question.options : {}}
+              onChange={(options) => onUpdate({ ...question, options })}
+            />
+          </div>
+        )}
+
         <div className="pt-4 border-t">
           <div className="flex items-center justify-between">
             <div>
@@ -162,6 +178,24 @@ export function FieldSettings({ question, onUpdate, onClose }: FieldSettingsProp
           </div>
         </div>
 
+        <div className="pt-4 border-t">
+          <div className="flex items-center justify-between">
+            <div>
+              <Label htmlFor="sensitive" className="text-sm font-semibold">
+                Sensitive Data
+              </Label>
+              <p className="text-xs text-muted-foreground mt-1">
+                Encrypt answers at rest, such as health or ID data
+              </p>
+            </div>
+            <Switch
+              id="sensitive"
+              checked={question.sensitive ?? false}
+              onCheckedChange={(checked) => onUpdate({ ...question, sensitive: checked })}
+            />
+          </div>
+        </div>
+
         <div className="pt-4 border-t">
           <div className="p-3 rounded-lg bg-muted/50">
             <p className="text-xs font-medium mb-1">Field Type</p>
@@ -173,6 +207,95 @@ export function FieldSettings({ question, onUpdate, onClose }: FieldSettingsProp
   );
 }
 
+// Amounts are entered in the major currency unit and stored in the smallest one, see utils/currency.ts
+function PaymentEditor({ options, onChange }: { options: PaymentOptions; onChange: (options: PaymentOptions) => void }) {
+  const mode = options.mode || 'fixed';
+  const currency = options.currency || 'usd';
+  const step = 10 ** -currencyDecimals(currency);
+
+  const update = (updates: Partial<PaymentOptions>) => {
+    onChange({ ...options, ...updates });
+  };
+
+  const toMinor = (value: string) => toMinorUnits(value, currency);
+
+  return (
+    <div className="p-3 border rounded-lg space-y-3">
+      <div className="space-y-1.5">
+        <Label className="text-xs">Amount</Label>
+        <Select
+          value={mode}
+          onValueChange={(value) => update({ mode: value as PaymentOptions['mode'] })}
+        >
+          <SelectTrigger>
+            <SelectValue />
+          </SelectTrigger>
+          <SelectContent>
+            <SelectItem value="fixed">Fixed price</SelectItem>
+            <SelectItem value="quantity">Price per unit</SelectItem>
+            <SelectItem value="custom">Respondent chooses</SelectItem>
+          </SelectContent>
+        </Select>
+      </div>
+
+      <div className="grid grid-cols-2 gap-2">
+        {mode !== 'custom' && (
+          <div className="space-y-1.5">
+            <Label className="text-xs">{mode === 'quantity' ? 'Unit price' : 'Price'}</Label>
+            <Input
+              type="number"
+              min={0}
+              step={step}
+              value={toMajorUnits(options.amount || 0, currency)}
+              onChange={(e) => update({ amount: toMinor(e.target.value) })}
+            />
+          </div>
+        )}
+
+        {mode === 'custom' && (
+          <div className="space-y-1.5">
+            <Label className="text-xs">Minimum</Label>
+            <Input
+              type="number"
+              min={0}
+              step={step}
+              value={toMajorUnits(options.minimum || 0, currency)}
+              onChange={(e) => update({ minimum: toMinor(e.target.value) })}
+            />
+          </div>
+        )}
+
+        <div className="space-y-1.5">
+          <Label className="text-xs">Currency</Label>
+          <Input
+            type="text"
+            maxLength={3}
+            value={currency.toUpperCase()}
+            onChange={(e) => update({ currency: e.target.value.toLowerCase() })}
+          />
+        </div>
+      </div>
+
+      {mode === 'quantity' && (
+        <div className="space-y-1.5">
+          <Label className="text-xs">Maximum quantity <span className="text-muted-foreground font-normal">(optional)</span></Label>
+          <Input
+            type="number"
+            min={0}
+            step={1}
+            value={options.maxQuantity || ''}
+            onChange={(e) => update({ maxQuantity: parseInt(e.target.value, 10) || 0 })}
+          />
+        </div>
+      )}
+
+      <p className="text-xs text-muted-foreground">
+        Payments are paid out to the account set up on the billing page.
+      </p>
+    </div>
+  );
+}
+
 function OptionsEditor({ options, onChange }: { options: string[]; onChange: (options: string[]) => void }) {
   const [draggedIndex, setDraggedIndex] = React.useState<number | null>(null);
 
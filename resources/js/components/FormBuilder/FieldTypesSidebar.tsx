@@ -24,7 +24,8 @@ import {
   CalendarRange,
   ShieldCheck,
   EyeOff,
-  Layers
+  Layers,
+  CreditCard
 } from 'lucide-react';
 import { useState } from 'react';
 
@@ -48,6 +49,7 @@ const inputFields: FieldType[] = [
   { type: 'file', label: 'File Upload', icon: <Upload className="h-5 w-5" />, description: 'Allow users to upload files or images.' },
   { type: 'signature', label: 'Signature', icon: <Pen className="h-5 w-5" />, description: 'Capture electronic signatures.' },
   { type: 'multi-input', label: 'Multi-Input', icon: <Layers className="h-5 w-5" />, description: 'Multiple related inputs in one question.' },
+  { type: 'payment', label: 'Payment', icon: <CreditCard className="h-5 w-5" />, description: 'Collect a fixed or calculated payment.' },
 ];
 
 const selectionFields: FieldType[] = [
@@ -20,7 +20,9 @@ import {
   LegalField,
   HiddenField,
   MultiInputField,
+  PaymentField,
 } from '@/components/Fields';
+import { PaymentOptions } from '@/types/form';
 
 interface SubInput {
   id: string;
@@ -36,7 +38,7 @@ interface Question {
   options?: {
     items?: string[];
     subInputs?: SubInput[];
-  };
+  } & PaymentOptions;
 }
 
 interface QuestionPreviewProps {
@@ -129,6 +131,9 @@ export function QuestionPreview({ question }: QuestionPreviewProps) {
           ? question.options.subInputs 
           : [];
         return <MultiInputField subInputs={subInputs} />;
+
+      case 'payment':
+        return <PaymentField options={question.options} />;
       
       default:
         return <p className="text-sm text-muted-foreground">Preview not available</p>;
@@ -1,7 +1,8 @@
 import { Button } from '@/components/ui/button';
 import { Switch } from '@/components/ui/switch';
 import { Label } from '@/components/ui/label';
-import { ArrowLeft, Save, Eye, HelpCircle, Lightbulb, RotateCcw } from 'lucide-react';
+import { Input } from '@/components/ui/input';
+import { ArrowLeft, Bell, Save, Eye, HelpCircle, Lightbulb, RotateCcw, ShieldCheck } from 'lucide-react';
 import { Link } from '@inertiajs/react';
 import {
   Tooltip,
@@ -21,10 +22,16 @@ interface FormEditorHeaderProps {
   form: Form;
   userIdentifier: string;
   displayMode: string;
+  metadataStorage: string;
+  retentionDays: string;
+  notifyOnResponse: boolean;
   isPublished: boolean;
   isSaving: boolean;
   hasUnsavedChanges: boolean;
   onDisplayModeChange: (mode: string) => void;
+  onMetadataStorageChange: (mode: string) => void;
+  onRetentionDaysChange: (days: string) => void;
+  onNotifyOnResponseChange: (checked: boolean) => void;
   onPublishToggle: (checked: boolean) => void;
   onSave: () => void;
   onReset: () => void;
@@ -34,10 +41,16 @@ export function FormEditorHeader({
   form,
   userIdentifier,
   displayMode,
+  metadataStorage,
+  retentionDays,
+  notifyOnResponse,
   isPublished,
   isSaving,
   hasUnsavedChanges,
   onDisplayModeChange,
+  onMetadataStorageChange,
+  onRetentionDaysChange,
+  onNotifyOnResponseChange,
   onPublishToggle,
   onSave,
   onReset,
@@ -118,6 +131,65 @@ export function FormEditorHeader({
             </Label>
           </div>
           
+          <Popover>
+            <PopoverTrigger asChild>
+              <Button variant="ghost" size="sm">
+                <ShieldCheck className="h-4 w-4 mr-2" />
+                Privacy
+              </Button>
+            </PopoverTrigger>
+            <PopoverContent className="w-80" align="end">
+              <div className="space-y-4">
+                <div className="space-y-2">
+                  <Label htmlFor="metadata-storage" className="text-sm">IP address & user agent</Label>
+                  <select
+                    id="metadata-storage"
+                    value={metadataStorage}
+                    onChange={(e) => onMetadataStorageChange(e.target.value)}
+                    className="w-full px-3 py-2 border border-input rounded-md bg-background text-sm"
+                  >
+                    <option value="full">Store in full</option>
+                    <option value="truncated">Store truncated</option>
+                    <option value="hashed">Store hashed</option>
+                    <option value="none">Don't store</option>
+                  </select>
+                </div>
+                <div className="space-y-2">
+                  <Label htmlFor="retention-days" className="text-sm">Delete responses after (days)</Label>
+                  <Input
+                    id="retention-days"
+                    type="number"
+                    min={0}
+                    value={retentionDays}
+                    onChange={(e) => onRetentionDaysChange(e.target.value)}
+                  />
+                  <p className="text-xs text-muted-foreground">Use 0 to keep responses forever.</p>
+                </div>
+              </div>
+            </PopoverContent>
+          </Popover>
+
+          <Popover>
+            <PopoverTrigger asChild>
+              <Button variant="ghost" size="sm">
+                <Bell className="h-4 w-4 mr-2" />
+                Notifications
+              </Button>
+            </PopoverTrigger>
+            <PopoverContent className="w-80" align="end">
+              <div className="flex items-center justify-between gap-4">
+                <Label htmlFor="notify-on-response" className="text-sm cursor-pointer">
+                  Email me when a response is submitted
+                </Label>
+                <Switch
+                  id="notify-on-response"
+                  checked={notifyOnResponse}
+                  onCheckedChange={onNotifyOnResponseChange}
+                />
+              </div>
+            </PopoverContent>
+          </Popover>
+
           <Popover>
             <PopoverTrigger asChild>
               <Button variant="ghost" size="sm">
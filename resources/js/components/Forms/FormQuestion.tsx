@@ -18,7 +18,9 @@ import {
   HiddenField,
   StatementField,
   MultiInputField,
+  PaymentField,
 } from "@/components/Fields";
+import { PaymentOptions } from "@/types/form";
 
 interface SubInput {
   id: string;
@@ -39,7 +41,7 @@ interface Question {
   options?: {
     items?: string[];
     subInputs?: SubInput[];
-  };
+  } & PaymentOptions;
 }
 
 interface FormQuestionProps {
@@ -307,6 +309,17 @@ export function FormQuestion({
           />
         );
 
+      case "payment":
+        return (
+          <PaymentField
+            options={question.options}
+            value={stringValue}
+            onChange={handleChange}
+            disabled={false}
+            error={error}
+          />
+        );
+
       default:
         return null;
     }
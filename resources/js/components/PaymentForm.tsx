@@ -1,7 +1,9 @@
+
 import { useState, useEffect } from "react";
 import { Button } from "@/components/ui/button";
 import { Card, CardContent, CardDescription, CardHeader, CardTitle } from "@/components/ui/card";
 import { CreditCardIcon, LockIcon } from "lucide-react";
+import { formatAmount } from "@/utils/currency";
 
 interface PaymentFormProps {
   plan: {
@@ -9,28 +11,40 @@ interface PaymentFormProps {
     name: string;
     price: number;
     currency: string;
+    trialDays?: number;
   };
-  onSubmit: (paymentMethodId: string) => void;
+  onSubmit: (paymentMethodId: string, promotionCode: string) => void;
   isProcessing: boolean;
   stripePublishableKey: string;
+  paymentProvider?: string;
   mode?: 'subscription' | 'payment';
 }
 
-export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey, mode = 'subscription' }: PaymentFormProps) {
+// Test cards of the fake payment provider, see pkg/services/payment_fake.go
+const fakeCards = [
+  { id: 'pm_card_visa', label: 'Visa 4242 (succeeds)' },
+  { id: 'pm_card_chargeDeclined', label: 'Visa 0002 (declined)' },
+  { id: 'pm_card_authenticationRequired', label: 'Visa 3184 (requires authentication)' },
+  { id: 'pm_sepa_debit', label: 'SEPA Direct Debit 3000 (succeeds)' },
+  { id: 'pm_boleto', label: 'Boleto (paid later)' },
+  { id: 'pm_pix', label: 'Pix (paid later)' },
+];
+
+export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey, paymentProvider = 'stripe', mode = 'subscription' }: PaymentFormProps) {
+  const isFake = paymentProvider === 'fake';
   const [stripe, setStripe] = useState<any>(null);
   const [elements, setElements] = useState<any>(null);
   const [cardElement, setCardElement] = useState<any>(null);
   const [error, setError] = useState<string>("");
   const [name, setName] = useState("");
-
-  const formatPrice = (price: number, currency: string) => {
-    return new Intl.NumberFormat('en-US', {
-      style: 'currency',
-      currency: currency.toUpperCase(),
-    }).format(price / 100);
-  };
+  const [fakeCard, setFakeCard] = useState(fakeCards[0].id);
+  const [promotionCode, setPromotionCode] = useState("");
+  const trialDays = mode === 'subscription' ? plan.trialDays ?? 0 : 0;
 
   useEffect(() => {
+    if (isFake) {
+      return;
+    }
 
     const initializeStripe = async () => {
       if (window.Stripe) {
@@ -70,10 +84,17 @@ export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey
     } else {
       initializeStripe();
     }
-  }, [stripePublishableKey]);
+  }, [stripePublishableKey, isFake]);
 
   const handleSubmit = async (e: React.FormEvent) => {
     e.preventDefault();
+
+    if (isFake) {
+      if (name.trim()) {
+        onSubmit(fakeCard, promotionCode.trim());
+      }
+      return;
+    }
     
     if (!stripe || !cardElement || !name.trim()) {
       return;
@@ -96,7 +117,7 @@ export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey
     }
 
 
-    onSubmit(paymentMethod.id);
+    onSubmit(paymentMethod.id, promotionCode.trim());
   };
 
   return (
@@ -107,7 +128,9 @@ export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey
         </div>
         <CardTitle>Payment Details</CardTitle>
         <CardDescription>
-          Subscribe to {plan.name} for {formatPrice(plan.price, plan.currency)}/month
+          {trialDays > 0
+            ? `Try ${plan.name} free for ${trialDays} days, then ${formatAmount(plan.price, plan.currency)}/month`
+            : `Subscribe to ${plan.name} for ${formatAmount(plan.price, plan.currency)}/month`}
         </CardDescription>
       </CardHeader>
       
@@ -130,15 +153,42 @@ export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey
           {/* Card Element */}
           <div className="space-y-2">
             <label className="text-sm font-medium">Card Information</label>
-            <div 
-              id="card-element" 
-              className="p-3 border border-gray-300 rounded-md focus-within:ring-2 focus-within:ring-blue-500"
-            />
+            {isFake ? (
+              <select
+                value={fakeCard}
+                onChange={(e) => setFakeCard(e.target.value)}
+                className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500"
+              >
+                {fakeCards.map((card) => (
+                  <option key={card.id} value={card.id}>{card.label}</option>
+                ))}
+              </select>
+            ) : (
+              <div 
+                id="card-element" 
+                className="p-3 border border-gray-300 rounded-md focus-within:ring-2 focus-within:ring-blue-500"
+              />
+            )}
             {error && (
               <div className="text-sm text-red-600">{error}</div>
             )}
           </div>
 
+          {/* Promotion Code */}
+          {mode === 'subscription' && (
+            <div className="space-y-2">
+              <label htmlFor="promotionCode" className="text-sm font-medium">Promotion Code</label>
+              <input
+                id="promotionCode"
+                type="text"
+                placeholder="Optional"
+                value={promotionCode}
+                onChange={(e) => setPromotionCode(e.target.value)}
+                className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500"
+              />
+            </div>
+          )}
+
           {/* Test Card Notice */}
           <div className="p-3 bg-blue-50 border border-blue-200 rounded-md dark:bg-blue-950 dark:border-blue-800">
             <div className="flex items-start gap-2">
@@ -146,7 +196,9 @@ export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey
               <div className="text-sm text-blue-800 dark:text-blue-200">
                 <p className="font-medium">Test Mode</p>
                 <p className="text-xs mt-1">
-                  Use test card: 4242 4242 4242 4242, any future expiry date, any CVC.
+                  {isFake
+                    ? "Payments are simulated. Pick the outcome of the charge above."
+                    : "Use test card: 4242 4242 4242 4242, any future expiry date, any CVC."}
                 </p>
               </div>
             </div>
@@ -156,7 +208,7 @@ export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey
           <Button 
             type="submit" 
             className="w-full mt-6" 
-            disabled={isProcessing || !stripe || !name.trim()}
+            disabled={isProcessing || (!isFake && !stripe) || !name.trim()}
           >
             {isProcessing ? (
               <div className="flex items-center gap-2">
@@ -165,8 +217,10 @@ export function PaymentForm({ plan, onSubmit, isProcessing, stripePublishableKey
               </div>
             ) : (
               mode === 'subscription' 
-                ? `Subscribe for ${formatPrice(plan.price, plan.currency)}/month`
-                : `Pay ${formatPrice(plan.price, plan.currency)}`
+                ? trialDays > 0
+                  ? `Start ${trialDays}-day free trial`
+                  : `Subscribe for ${formatAmount(plan.price, plan.currency)}/month`
+                : `Pay ${formatAmount(plan.price, plan.currency)}`
             )}
           </Button>
 
@@ -0,0 +1,90 @@
+import { useState } from 'react';
+import { router } from '@inertiajs/react';
+import { Card } from '@/components/ui/card';
+import { Button } from '@/components/ui/button';
+import { Textarea } from '@/components/ui/textarea';
+import { Response } from '@/types/response';
+
+interface Question {
+  id: number;
+  type: string;
+  title: string;
+  required: boolean;
+}
+
+interface ResponseAnswersEditorProps {
+  formId: number;
+  response: Response;
+  questions: Question[];
+  onCancel: () => void;
+}
+
+// Structured answers are edited as their stored JSON so they can be validated like a submission.
+const compositeTypes = ['matrix', 'ranking', 'multi-input'];
+
+export function ResponseAnswersEditor({ formId, response, questions, onCancel }: ResponseAnswersEditorProps) {
+  const initial: Record<string, string> = {};
+  questions.forEach((q) => {
+    const answer = response.edges.answers?.find((a) => a.edges.question?.id === q.id);
+    initial[q.id] = answer?.value ?? '';
+  });
+
+  const [values, setValues] = useState(initial);
+  const [processing, setProcessing] = useState(false);
+
+  const handleSave = () => {
+    const changed: Record<string, unknown> = {};
+    for (const q of questions) {
+      if (values[q.id] === initial[q.id]) continue;
+      if (compositeTypes.includes(q.type) && values[q.id] !== '') {
+        try {
+          changed[q.id] = JSON.parse(values[q.id]);
+        } catch {
+          alert(`Answer to '${q.title}' is not valid JSON`);
+          return;
+        }
+      } else {
+        changed[q.id] = values[q.id];
+      }
+    }
+
+    setProcessing(true);
+    router.post(
+      `/forms/${formId}/responses/${response.id}`,
+      { answers: JSON.stringify(changed) },
+      {
+        forceFormData: true,
+        onSuccess: onCancel,
+        onFinish: () => setProcessing(false),
+      },
+    );
+  };
+
+  return (
+    <div className="space-y-6">
+      <h2 className="text-2xl font-bold">Edit Answers</h2>
+      {questions.map((q) => (
+        <Card key={q.id} className="p-6 space-y-2">
+          <h3 className="text-lg font-semibold">
+            {q.title}
+            {q.required && <span className="text-red-500 ml-1">*</span>}
+          </h3>
+          <Textarea
+            value={values[q.id]}
+            onChange={(e) => setValues({ ...values, [q.id]: e.target.value })}
+            // Payment answers record what was charged.
+            disabled={q.type === 'payment'}
+          />
+        </Card>
+      ))}
+      <div className="flex gap-2">
+        <Button onClick={handleSave} disabled={processing}>
+          Save Changes
+        </Button>
+        <Button variant="outline" onClick={onCancel} disabled={processing}>
+          Cancel
+        </Button>
+      </div>
+    </div>
+  );
+}
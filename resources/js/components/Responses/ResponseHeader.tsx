@@ -1,6 +1,6 @@
-import { Link } from '@inertiajs/react';
+import { Link, router } from '@inertiajs/react';
 import { Button } from '@/components/ui/button';
-import { ArrowLeft } from 'lucide-react';
+import { ArrowLeft, Trash2 } from 'lucide-react';
 
 interface ResponseHeaderProps {
   responseId: number;
@@ -9,6 +9,12 @@ interface ResponseHeaderProps {
 }
 
 export function ResponseHeader({ responseId, formId, formTitle }: ResponseHeaderProps) {
+  const handleDelete = () => {
+    if (confirm('Are you sure you want to delete this response? This action cannot be undone.')) {
+      router.delete(`/forms/${formId}/responses/${responseId}`);
+    }
+  };
+
   return (
     <div className="flex items-center gap-4 mb-8">
       <Link href={`/forms/${formId}/responses`}>
@@ -22,6 +28,10 @@ export function ResponseHeader({ responseId, formId, formTitle }: ResponseHeader
         <h1 className="text-3xl font-bold">Response #{responseId}</h1>
         <p className="text-muted-foreground">{formTitle}</p>
       </div>
+      <Button variant="destructive" size="sm" className="ml-auto" onClick={handleDelete}>
+        <Trash2 className="h-4 w-4 mr-2" />
+        Delete
+      </Button>
     </div>
   );
 }
@@ -1,6 +1,7 @@
 import { Card } from '@/components/ui/card';
 import { Calendar, Globe, Monitor } from 'lucide-react';
 import { Response } from '@/types/response';
+import { ResponsePaymentBadge } from '@/components/Responses/ResponsePaymentBadge';
 
 interface ResponseMetadataProps {
   response: Response;
@@ -67,6 +68,17 @@ export function ResponseMetadata({ response }: ResponseMetadataProps) {
         </div>
       </Card>
 
+      {response.edges.payment && (
+        <Card className="p-6">
+          <div>
+            <p className="text-sm font-medium text-muted-foreground">Payment</p>
+            <div className="mt-2">
+              <ResponsePaymentBadge payment={response.edges.payment} />
+            </div>
+          </div>
+        </Card>
+      )}
+
       <Card className="p-6">
         <div>
           <p className="text-sm font-medium text-muted-foreground">Total Answers</p>
@@ -0,0 +1,39 @@
+import { ResponsePayment } from '@/types/response';
+import { formatAmount } from '@/utils/currency';
+
+const paymentClasses: Record<string, string> = {
+  succeeded: 'bg-green-100 text-green-800 dark:bg-green-900/30 dark:text-green-400',
+  processing: 'bg-blue-100 text-blue-800 dark:bg-blue-900/30 dark:text-blue-400',
+  canceled: 'bg-gray-100 text-gray-800 dark:bg-gray-800 dark:text-gray-300',
+};
+
+const pendingClasses = 'bg-amber-100 text-amber-800 dark:bg-amber-900/30 dark:text-amber-400';
+
+function paymentLabel(status: string) {
+  switch (status) {
+    case 'succeeded':
+      return 'Paid';
+    case 'processing':
+      return 'Processing';
+    case 'canceled':
+      return 'Canceled';
+    default:
+      return 'Unpaid';
+  }
+}
+
+interface ResponsePaymentBadgeProps {
+  payment?: ResponsePayment;
+}
+
+export function ResponsePaymentBadge({ payment }: ResponsePaymentBadgeProps) {
+  if (!payment) {
+    return <span className="text-muted-foreground">—</span>;
+  }
+
+  return (
+    <span className={`inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium ${paymentClasses[payment.status] ?? pendingClasses}`}>
+      {paymentLabel(payment.status)} · {formatAmount(payment.amount, payment.currency)}
+    </span>
+  );
+}
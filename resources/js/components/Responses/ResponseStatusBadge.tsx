@@ -0,0 +1,27 @@
+import { ResponseStatus } from '@/types/response';
+
+export const statusLabels: Record<ResponseStatus, string> = {
+  new: 'New',
+  in_progress: 'In Progress',
+  done: 'Done',
+  archived: 'Archived',
+};
+
+const statusClasses: Record<ResponseStatus, string> = {
+  new: 'bg-blue-100 text-blue-800 dark:bg-blue-900/30 dark:text-blue-400',
+  in_progress: 'bg-amber-100 text-amber-800 dark:bg-amber-900/30 dark:text-amber-400',
+  done: 'bg-green-100 text-green-800 dark:bg-green-900/30 dark:text-green-400',
+  archived: 'bg-gray-100 text-gray-800 dark:bg-gray-800 dark:text-gray-300',
+};
+
+interface ResponseStatusBadgeProps {
+  status: ResponseStatus;
+}
+
+export function ResponseStatusBadge({ status }: ResponseStatusBadgeProps) {
+  return (
+    <span className={`inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium ${statusClasses[status] ?? statusClasses.new}`}>
+      {statusLabels[status] ?? status}
+    </span>
+  );
+}
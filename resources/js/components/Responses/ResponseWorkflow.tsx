@@ -0,0 +1,124 @@
+import { useState } from 'react';
+import { router } from '@inertiajs/react';
+import { Card } from '@/components/ui/card';
+import { Button } from '@/components/ui/button';
+import { Input } from '@/components/ui/input';
+import { Textarea } from '@/components/ui/textarea';
+import { Response, ResponseStatus, ResponseTag } from '@/types/response';
+import { statusLabels } from '@/components/Responses/ResponseStatusBadge';
+
+interface ResponseWorkflowProps {
+  formId: number;
+  response: Response;
+  statuses: ResponseStatus[];
+  tags: ResponseTag[];
+}
+
+export function ResponseWorkflow({ formId, response, statuses, tags }: ResponseWorkflowProps) {
+  const baseUrl = `/forms/${formId}/responses/${response.id}`;
+  const [tagInput, setTagInput] = useState((response.edges.tags || []).map((t) => t.name).join(', '));
+  const [note, setNote] = useState('');
+  const [processing, setProcessing] = useState(false);
+
+  const post = (url: string, data: Record<string, string>, onSuccess?: () => void) => {
+    setProcessing(true);
+    router.post(url, data, {
+      forceFormData: true,
+      preserveScroll: true,
+      onSuccess,
+      onFinish: () => setProcessing(false),
+    });
+  };
+
+  const formatDate = (dateString: string) => {
+    return new Date(dateString).toLocaleString('en-US', {
+      year: 'numeric',
+      month: 'short',
+      day: '2-digit',
+      hour: '2-digit',
+      minute: '2-digit',
+    });
+  };
+
+  return (
+    <div className="grid grid-cols-1 md:grid-cols-2 gap-4 mb-8">
+      <Card className="p-6 space-y-3">
+        <p className="text-sm font-medium text-muted-foreground">Workflow Status</p>
+        <select
+          value={response.status}
+          disabled={processing}
+          onChange={(e) => post(`${baseUrl}/status`, { status: e.target.value })}
+          className="w-full px-4 py-2 border border-input rounded-lg bg-background"
+        >
+          {statuses.map((status) => (
+            <option key={status} value={status}>
+              {statusLabels[status] ?? status}
+            </option>
+          ))}
+        </select>
+      </Card>
+
+      <Card className="p-6 space-y-3">
+        <p className="text-sm font-medium text-muted-foreground">Tags</p>
+        <div className="flex gap-2">
+          <Input
+            value={tagInput}
+            onChange={(e) => setTagInput(e.target.value)}
+            placeholder="Tags, comma separated"
+            list="response-tags"
+          />
+          <datalist id="response-tags">
+            {tags.map((tag) => (
+              <option key={tag.id} value={tag.name} />
+            ))}
+          </datalist>
+          <Button variant="outline" disabled={processing} onClick={() => post(`${baseUrl}/tags`, { tags: tagInput })}>
+            Save
+          </Button>
+        </div>
+      </Card>
+
+      <Card className="p-6 space-y-4 md:col-span-2">
+        <p className="text-sm font-medium text-muted-foreground">Internal Notes</p>
+        <div className="space-y-2">
+          <Textarea value={note} onChange={(e) => setNote(e.target.value)} placeholder="Add a note for your team..." />
+          <Button
+            size="sm"
+            disabled={processing || note.trim() === ''}
+            onClick={() => post(`${baseUrl}/notes`, { body: note }, () => setNote(''))}
+          >
+            Add Note
+          </Button>
+        </div>
+        {response.edges.notes?.map((n) => (
+          <div key={n.id} className="border-l-2 pl-3">
+            <p className="text-sm whitespace-pre-wrap">{n.body}</p>
+            <p className="text-xs text-muted-foreground mt-1">
+              {n.edges.author?.name || 'Unknown'} · {formatDate(n.created_at)}
+            </p>
+          </div>
+        ))}
+      </Card>
+
+      <Card className="p-6 space-y-3 md:col-span-2">
+        <p className="text-sm font-medium text-muted-foreground">Activity</p>
+        {(response.edges.activities || []).length === 0 ? (
+          <p className="text-sm text-muted-foreground">No changes yet</p>
+        ) : (
+          <ul className="space-y-2">
+            {response.edges.activities?.map((activity) => (
+              <li key={activity.id} className="text-sm">
+                <span className="font-medium">{activity.edges.actor?.name || 'Unknown'}</span>{' '}
+                {activity.action.replace('_', ' ')}
+                {activity.action === 'status_changed' && activity.changes && (
+                  <> from {statusLabels[activity.changes.from as ResponseStatus]} to {statusLabels[activity.changes.to as ResponseStatus]}</>
+                )}
+                <span className="text-xs text-muted-foreground ml-2">{formatDate(activity.created_at)}</span>
+              </li>
+            ))}
+          </ul>
+        )}
+      </Card>
+    </div>
+  );
+}
@@ -0,0 +1,64 @@
+import { useState } from 'react';
+import { router } from '@inertiajs/react';
+import { Button } from '@/components/ui/button';
+import { Input } from '@/components/ui/input';
+import { Archive, Tag, Trash2 } from 'lucide-react';
+
+interface ResponsesBulkActionsProps {
+  formId: number;
+  selectedIds: number[];
+  onComplete: () => void;
+}
+
+export function ResponsesBulkActions({ formId, selectedIds, onComplete }: ResponsesBulkActionsProps) {
+  const [tags, setTags] = useState('');
+  const [processing, setProcessing] = useState(false);
+
+  const submit = (action: 'tag' | 'archive' | 'delete') => {
+    if (action === 'delete' && !confirm(`Delete ${selectedIds.length} responses? This action cannot be undone.`)) {
+      return;
+    }
+
+    setProcessing(true);
+    router.post(
+      `/forms/${formId}/responses/bulk`,
+      {
+        action,
+        ids: selectedIds.join(','),
+        tags,
+      },
+      {
+        forceFormData: true,
+        onSuccess: () => {
+          setTags('');
+          onComplete();
+        },
+        onFinish: () => setProcessing(false),
+      },
+    );
+  };
+
+  return (
+    <div className="flex flex-wrap items-center gap-3 mb-4 p-4 border rounded-lg bg-muted/40">
+      <span className="text-sm font-medium">{selectedIds.length} selected</span>
+      <Input
+        value={tags}
+        onChange={(e) => setTags(e.target.value)}
+        placeholder="Tags, comma separated"
+        className="w-56"
+      />
+      <Button variant="outline" size="sm" disabled={processing || tags.trim() === ''} onClick={() => submit('tag')}>
+        <Tag className="h-4 w-4 mr-2" />
+        Tag
+      </Button>
+      <Button variant="outline" size="sm" disabled={processing} onClick={() => submit('archive')}>
+        <Archive className="h-4 w-4 mr-2" />
+        Archive
+      </Button>
+      <Button variant="destructive" size="sm" disabled={processing} onClick={() => submit('delete')}>
+        <Trash2 className="h-4 w-4 mr-2" />
+        Delete
+      </Button>
+    </div>
+  );
+}
@@ -0,0 +1,59 @@
+import { router } from '@inertiajs/react';
+import { ResponseTag } from '@/types/response';
+import { statusLabels } from '@/components/Responses/ResponseStatusBadge';
+
+interface ResponsesFiltersProps {
+  formId: number;
+  tags: ResponseTag[];
+  filters: {
+    status: string;
+    tag: string;
+  };
+}
+
+export function ResponsesFilters({ formId, tags, filters }: ResponsesFiltersProps) {
+  const applyFilter = (key: 'status' | 'tag', value: string) => {
+    const params = { ...filters, [key]: value };
+    router.get(
+      `/forms/${formId}/responses`,
+      Object.fromEntries(Object.entries(params).filter(([, v]) => v !== '')),
+      { preserveState: true },
+    );
+  };
+
+  const selectClassName =
+    'px-4 py-3 border border-input rounded-lg bg-background focus:ring-2 focus:ring-primary/20 focus:border-primary transition-all duration-200';
+
+  return (
+    <div className="flex flex-wrap gap-3 mb-6">
+      <select
+        value={filters.status}
+        onChange={(e) => applyFilter('status', e.target.value)}
+        className={selectClassName}
+      >
+        <option value="">Active</option>
+        {Object.entries(statusLabels).map(([value, label]) => (
+          <option key={value} value={value}>
+            {label}
+          </option>
+        ))}
+        <option value="all">All</option>
+      </select>
+
+      {tags.length > 0 && (
+        <select
+          value={filters.tag}
+          onChange={(e) => applyFilter('tag', e.target.value)}
+          className={selectClassName}
+        >
+          <option value="">All tags</option>
+          {tags.map((tag) => (
+            <option key={tag.id} value={tag.name}>
+              {tag.name}
+            </option>
+          ))}
+        </select>
+      )}
+    </div>
+  );
+}
@@ -9,15 +9,31 @@ import {
   TableRow,
 } from '@/components/ui/table';
 import { Button } from '@/components/ui/button';
+import { Checkbox } from '@/components/ui/checkbox';
 import { Eye } from 'lucide-react';
 import { Response } from '@/types/response';
+import { ResponseStatusBadge } from '@/components/Responses/ResponseStatusBadge';
+import { ResponsePaymentBadge } from '@/components/Responses/ResponsePaymentBadge';
 
 interface ResponsesTableProps {
   responses: Response[];
   formId: number;
+  selectedIds: number[];
+  onSelectionChange: (ids: number[]) => void;
 }
 
-export function ResponsesTable({ responses, formId }: ResponsesTableProps) {
+export function ResponsesTable({ responses, formId, selectedIds, onSelectionChange }: ResponsesTableProps) {
+  const hasPayments = responses.some((r) => r.edges.payment);
+  const allSelected = responses.length > 0 && responses.every((r) => selectedIds.includes(r.id));
+
+  const toggleAll = (checked: boolean) => {
+    onSelectionChange(checked ? responses.map((r) => r.id) : []);
+  };
+
+  const toggleOne = (id: number, checked: boolean) => {
+    onSelectionChange(checked ? [...selectedIds, id] : selectedIds.filter((s) => s !== id));
+  };
+
   const formatDate = (dateString: string) => {
     return new Date(dateString).toLocaleString('en-US', {
       year: 'numeric',
@@ -44,8 +60,14 @@ export function ResponsesTable({ responses, formId }: ResponsesTableProps) {
       <Table>
         <TableHeader>
           <TableRow>
+            <TableHead className="w-10">
+              <Checkbox checked={allSelected} onCheckedChange={(checked) => toggleAll(checked === true)} />
+            </TableHead>
             <TableHead>Submitted</TableHead>
             <TableHead>Status</TableHead>
+            {hasPayments && <TableHead>Payment</TableHead>}
+            <TableHead>Workflow</TableHead>
+            <TableHead>Tags</TableHead>
             <TableHead>Preview</TableHead>
             <TableHead>IP Address</TableHead>
             <TableHead className="text-right">Actions</TableHead>
@@ -54,6 +76,12 @@ export function ResponsesTable({ responses, formId }: ResponsesTableProps) {
         <TableBody>
           {responses.map((response) => (
             <TableRow key={response.id}>
+              <TableCell>
+                <Checkbox
+                  checked={selectedIds.includes(response.id)}
+                  onCheckedChange={(checked) => toggleOne(response.id, checked === true)}
+                />
+              </TableCell>
               <TableCell className="font-medium">
                 {formatDate(response.submitted_at)}
               </TableCell>
@@ -68,6 +96,23 @@ export function ResponsesTable({ responses, formId }: ResponsesTableProps) {
                   {response.completed ? 'Completed' : 'Partial'}
                 </span>
               </TableCell>
+              {hasPayments && (
+                <TableCell>
+                  <ResponsePaymentBadge payment={response.edges.payment} />
+                </TableCell>
+              )}
+              <TableCell>
+                <ResponseStatusBadge status={response.status} />
+              </TableCell>
+              <TableCell>
+                <div className="flex flex-wrap gap-1">
+                  {response.edges.tags?.map((tag) => (
+                    <span key={tag.id} className="inline-flex items-center px-2 py-0.5 rounded-md text-xs bg-muted">
+                      {tag.name}
+                    </span>
+                  ))}
+                </div>
+              </TableCell>
               <TableCell className="max-w-xs truncate">
                 {getAnswerPreview(response)}
               </TableCell>
@@ -13,6 +13,9 @@ export function useFormEditor(form: Form) {
     questions: JSON.stringify(form.edges.questions?.sort((a, b) => a.order - b.order) || []),
     published: form.published ? '1' : '0',
     display_mode: form.display_mode || 'traditional',
+    metadata_storage: form.metadata_storage || 'full',
+    retention_days: String(form.retention_days || 0),
+    notify_on_response: form.notify_on_response ? '1' : '0',
   });
   
   const isSavingRef = useRef(false);
@@ -26,9 +29,25 @@ export function useFormEditor(form: Form) {
     return (
       currentQuestionsStr !== data.questions ||
       currentPublished !== (form.published ? '1' : '0') ||
-      currentDisplayMode !== (form.display_mode || 'traditional')
+      currentDisplayMode !== (form.display_mode || 'traditional') ||
+      data.metadata_storage !== (form.metadata_storage || 'full') ||
+      data.retention_days !== String(form.retention_days || 0) ||
+      data.notify_on_response !== (form.notify_on_response ? '1' : '0')
     );
-  }, [questions, data.published, data.display_mode, data.questions, form.published, form.display_mode]);
+  }, [
+    questions,
+    data.published,
+    data.display_mode,
+    data.questions,
+    data.metadata_storage,
+    data.retention_days,
+    data.notify_on_response,
+    form.published,
+    form.display_mode,
+    form.metadata_storage,
+    form.retention_days,
+    form.notify_on_response,
+  ]);
 
   useEffect(() => {
     const removeInertiaListener = router.on('before', (event) => {
@@ -70,6 +89,8 @@ export function useFormEditor(form: Form) {
       options = { items: ['Option 1', 'Option 2'] };
     } else if (type === 'multi-input') {
       options = { subInputs: [] };
+    } else if (type === 'payment') {
+      options = { mode: 'fixed', amount: 1000, currency: 'usd' };
     }
 
     const newQuestion: Question = {
@@ -146,6 +167,18 @@ export function useFormEditor(form: Form) {
     setData('display_mode', mode);
   };
 
+  const handleMetadataStorageChange = (mode: string) => {
+    setData('metadata_storage', mode as typeof data.metadata_storage);
+  };
+
+  const handleRetentionDaysChange = (days: string) => {
+    setData('retention_days', days);
+  };
+
+  const handleNotifyOnResponseChange = (checked: boolean) => {
+    setData('notify_on_response', checked ? '1' : '0');
+  };
+
   const handleReset = () => {
     const initialQuestions = JSON.parse(data.questions);
     setQuestions(initialQuestions);
@@ -162,6 +195,9 @@ export function useFormEditor(form: Form) {
     isSaving: processing,
     isPublished: data.published === '1',
     displayMode: data.display_mode,
+    metadataStorage: data.metadata_storage,
+    retentionDays: data.retention_days,
+    notifyOnResponse: data.notify_on_response === '1',
     hasUnsavedChanges,
     showUnsavedDialog,
     setSelectedQuestionId,
@@ -172,6 +208,9 @@ export function useFormEditor(form: Form) {
     handleSave,
     handlePublishToggle,
     handleDisplayModeChange,
+    handleMetadataStorageChange,
+    handleRetentionDaysChange,
+    handleNotifyOnResponseChange,
     handleConfirmLeave,
     handleCancelLeave,
     handleReset,
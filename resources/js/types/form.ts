@@ -6,6 +6,14 @@ export interface SubInput {
   required: boolean;
 }
 
+export interface PaymentOptions {
+  mode?: 'fixed' | 'quantity' | 'custom';
+  amount?: number;
+  currency?: string;
+  minimum?: number;
+  maxQuantity?: number;
+}
+
 export interface Question {
   id: string;
   type: string;
@@ -13,11 +21,14 @@ export interface Question {
   description?: string;
   placeholder?: string;
   required: boolean;
+  sensitive?: boolean;
   order: number;
   options?: {
     items?: string[];
+    rows?: string[];
+    columns?: string[];
     subInputs?: SubInput[];
-  };
+  } & PaymentOptions;
 }
 
 export interface Form {
@@ -27,6 +38,9 @@ export interface Form {
   slug: string;
   published: boolean;
   display_mode?: string;
+  metadata_storage?: 'full' | 'truncated' | 'hashed' | 'none';
+  retention_days?: number;
+  notify_on_response?: boolean;
   edges: {
     questions?: Question[];
   };
@@ -7,9 +7,17 @@ export type FlashMessages = {
   danger?: string[];
 };
 
+export type Dunning = {
+  status: string;
+  amount: number;
+  currency: string;
+  graceEndsAt: string;
+};
+
 export type SharedProps = {
   flash: FlashMessages;
   auth: {
     user: User | null;
   };
+  dunning: Dunning | null;
 };
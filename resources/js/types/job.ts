@@ -0,0 +1,41 @@
+export type JobStatus = "pending" | "processing" | "completed" | "failed" | "cancelled";
+
+export type Job = {
+  id: number;
+  queue: string;
+  payload: Record<string, unknown>;
+  attempts: number;
+  max_attempts: number;
+  status: JobStatus;
+  priority: number;
+  run_at: string;
+  scheduled_for?: string;
+  unique_key?: string;
+  error?: string;
+  created_at: string;
+  processed_at?: string;
+  locked_by?: string;
+  locked_until?: string;
+};
+
+export type QueueStats = {
+  queue: string;
+  pending: number;
+  processing: number;
+  completed: number;
+  failed: number;
+  cancelled: number;
+  recentCompleted: number;
+  recentFailed: number;
+};
+
+export type JobAttempt = {
+  id: number;
+  job_id: number;
+  attempt: number;
+  worker: string;
+  started_at: string;
+  finished_at: string;
+  duration_ms: number;
+  error?: string;
+};
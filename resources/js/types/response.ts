@@ -11,13 +11,58 @@ export interface Answer {
   };
 }
 
+export type ResponseStatus = 'new' | 'in_progress' | 'done' | 'archived';
+
+export interface ResponseTag {
+  id: number;
+  name: string;
+}
+
+export interface ResponseUser {
+  id: number;
+  name: string;
+  email: string;
+}
+
+export interface ResponseNote {
+  id: number;
+  body: string;
+  created_at: string;
+  edges: {
+    author?: ResponseUser;
+  };
+}
+
+export interface ResponseActivity {
+  id: number;
+  action: 'answers_updated' | 'status_changed' | 'note_added' | 'tags_updated' | 'deleted';
+  changes?: Record<string, any>;
+  created_at: string;
+  edges: {
+    actor?: ResponseUser;
+  };
+}
+
+export interface ResponsePayment {
+  id: number;
+  status: string;
+  amount: number;
+  amount_refunded: number;
+  currency: string;
+}
+
 export interface Response {
   id: number;
   submitted_at: string;
   completed: boolean;
   ip_address: string;
   user_agent: string;
+  status: ResponseStatus;
   edges: {
     answers?: Answer[];
+    tags?: ResponseTag[];
+    notes?: ResponseNote[];
+    activities?: ResponseActivity[];
+    payment?: ResponsePayment;
   };
 }
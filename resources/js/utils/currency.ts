@@ -0,0 +1,38 @@
+// Amounts are stored in the smallest unit of their currency, which has as many decimals as the currency's
+// major unit. Keep these in sync with pkg/currency/currency.go.
+const ZERO_DECIMAL = ['bif', 'clp', 'djf', 'gnf', 'jpy', 'kmf', 'krw', 'mga', 'pyg', 'rwf', 'ugx', 'vnd', 'vuv', 'xaf', 'xof', 'xpf'];
+const THREE_DECIMAL = ['bhd', 'jod', 'kwd', 'omr', 'tnd'];
+
+export function currencyDecimals(currency: string): number {
+  const c = currency.toLowerCase();
+  if (ZERO_DECIMAL.includes(c)) return 0;
+  if (THREE_DECIMAL.includes(c)) return 3;
+  return 2;
+}
+
+// Converts an amount in the smallest unit to the major unit, such as 1250 cents to 12.5 dollars.
+export function toMajorUnits(amount: number, currency: string): number {
+  return amount / 10 ** currencyDecimals(currency);
+}
+
+// Converts an amount entered in the major unit to the smallest one.
+export function toMinorUnits(value: string | number, currency: string): number {
+  const amount = typeof value === 'number' ? value : parseFloat(value);
+  return Math.round((amount || 0) * 10 ** currencyDecimals(currency));
+}
+
+export function formatAmount(amount: number, currency: string): string {
+  const decimals = currencyDecimals(currency);
+
+  try {
+    return new Intl.NumberFormat('en-US', {
+      style: 'currency',
+      currency: currency.toUpperCase(),
+      minimumFractionDigits: decimals,
+      maximumFractionDigits: decimals,
+    }).format(toMajorUnits(amount, currency));
+  } catch {
+    // Currencies being typed in the form builder may not be valid codes yet
+    return `${toMajorUnits(amount, currency).toFixed(decimals)} ${currency.toUpperCase()}`;
+  }
+}
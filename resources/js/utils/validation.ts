@@ -17,6 +17,7 @@ interface Question {
   options?: {
     items?: string[];
     subInputs?: SubInput[];
+    mode?: string;
   };
 }
 
@@ -59,6 +60,11 @@ export function validateAnswer(
     return { valid: true, error: '' };
   }
   
+  // Fixed payments are charged without an answer.
+  if (question.type === 'payment' && (question.options?.mode ?? 'fixed') === 'fixed') {
+    return { valid: true, error: '' };
+  }
+
   if (
     !answer ||
     (Array.isArray(answer) && answer.length === 0) ||